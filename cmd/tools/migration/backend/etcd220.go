@@ -47,6 +47,15 @@ func (b etcd220) save(saves map[string]string) error {
 }
 
 func (b etcd220) Save(metas *meta.Meta) error {
+	{
+		saves, err := metas.Meta220.Databases.GenerateSaves()
+		if err != nil {
+			return err
+		}
+		if err := b.save(saves); err != nil {
+			return err
+		}
+	}
 	{
 		saves, err := metas.Meta220.TtCollections.GenerateSaves(metas.SourceVersion)
 		if err != nil {
@@ -125,6 +134,7 @@ func (b etcd220) Save(metas *meta.Meta) error {
 
 func (b etcd220) Clean() error {
 	prefixes := []string{
+		rootcoord.DatabaseMetaPrefix,
 		rootcoord.CollectionMetaPrefix,
 		rootcoord.PartitionMetaPrefix,
 		rootcoord.FieldMetaPrefix,
@@ -17,6 +17,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
@@ -31,6 +32,7 @@ func alias210ToAlias220(record *pb.CollectionInfo, ts Timestamp) *model.Alias {
 		CollectionID: record.GetID(),
 		CreatedTime:  ts,
 		State:        pb.AliasState_AliasCreated,
+		DBID:         util.DefaultDBID,
 	}
 }
 
@@ -74,7 +76,12 @@ func getLatestFieldIndexes(colls map[Timestamp]*pb.CollectionInfo) *FieldIndexes
 }
 
 func collection210ToCollection220(coll *pb.CollectionInfo) *model.Collection {
-	return model.UnmarshalCollectionModel(coll)
+	collection := model.UnmarshalCollectionModel(coll)
+	if collection != nil {
+		// collections before 220 all belong to the default database.
+		collection.DBID = util.DefaultDBID
+	}
+	return collection
 }
 
 func (meta *TtCollectionsMeta210) to220() (TtCollectionsMeta220, FieldIndexes210, error) {
@@ -340,6 +347,7 @@ func From210To220(metas *Meta) (*Meta, error) {
 		SourceVersion: metas.Version,
 		Version:       versions.Version220,
 		Meta220: &All220{
+			Databases:           newDefaultDatabasesMeta220(),
 			TtCollections:       ttCollections,
 			Collections:         collections,
 			TtAliases:           ttAliases,
@@ -11,6 +11,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 )
 
+type DatabasesMeta220 map[UniqueID]*model.Database // db_id -> db
+
 type TtCollectionsMeta220 map[UniqueID]map[Timestamp]*model.Collection // coll_id -> ts -> coll
 type CollectionsMeta220 map[UniqueID]*model.Collection                 // coll_id -> coll
 
@@ -29,6 +31,25 @@ type SegmentIndexesMeta220 map[UniqueID]map[UniqueID]*model.SegmentIndex // seg_
 type CollectionLoadInfo220 map[UniqueID]*model.CollectionLoadInfo            // collectionID -> CollectionLoadInfo
 type PartitionLoadInfo220 map[UniqueID]map[UniqueID]*model.PartitionLoadInfo // collectionID, partitionID -> PartitionLoadInfo
 
+func newDefaultDatabasesMeta220() DatabasesMeta220 {
+	db := model.NewDefaultDatabase()
+	return DatabasesMeta220{db.ID: db}
+}
+
+func (meta *DatabasesMeta220) GenerateSaves() (map[string]string, error) {
+	saves := make(map[string]string)
+
+	for dbID, db := range *meta {
+		marshaledDBPb, err := proto.Marshal(model.MarshalDatabaseModel(db))
+		if err != nil {
+			return nil, err
+		}
+		saves[rootcoord.BuildDatabaseKey(dbID)] = string(marshaledDBPb)
+	}
+
+	return saves, nil
+}
+
 func (meta *TtCollectionsMeta220) GenerateSaves(sourceVersion semver.Version) (map[string]string, error) {
 	saves := make(map[string]string)
 
@@ -276,6 +297,8 @@ func (meta *PartitionLoadInfo220) GenerateSaves() (map[string]string, error) {
 }
 
 type All220 struct {
+	Databases DatabasesMeta220
+
 	TtCollections TtCollectionsMeta220
 	Collections   CollectionsMeta220
 
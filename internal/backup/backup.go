@@ -0,0 +1,592 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backup takes consistent point-in-time backups of collections without stopping the writes,
+// and restores them into new collections through the binlog import.
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"go.uber.org/zap"
+)
+
+// flushCheckInterval is the interval to check whether the segments sealed by the backup are flushed.
+var flushCheckInterval = time.Second
+
+// maxPinAttempts is the max times to list and pin the flushed segments, which may be compacted meanwhile.
+var maxPinAttempts = 5
+
+// CreateRequest is the request to back up a collection.
+type CreateRequest struct {
+	Name           string
+	DbName         string
+	CollectionName string
+	// the data written after the timestamp is not restored, 0 means now.
+	Timestamp uint64
+}
+
+// Manager creates, lists, verifies, deletes and restores the backups.
+// The files of the collections are read by the chunk manager of the cluster,
+// and the backups are saved under the root path of the backup chunk manager.
+type Manager struct {
+	rootCoord  types.RootCoord
+	dataCoord  types.DataCoord
+	indexCoord types.IndexCoord
+
+	chunkManager       storage.ChunkManager
+	backupChunkManager storage.ChunkManager
+	rootPath           string
+}
+
+// NewManager creates a backup manager.
+func NewManager(rootCoord types.RootCoord, dataCoord types.DataCoord, indexCoord types.IndexCoord,
+	chunkManager storage.ChunkManager, backupChunkManager storage.ChunkManager, rootPath string) *Manager {
+	return &Manager{
+		rootCoord:          rootCoord,
+		dataCoord:          dataCoord,
+		indexCoord:         indexCoord,
+		chunkManager:       chunkManager,
+		backupChunkManager: backupChunkManager,
+		rootPath:           rootPath,
+	}
+}
+
+func (m *Manager) backupDir(name string) string {
+	return path.Join(m.rootPath, name)
+}
+
+func (m *Manager) metaPath(name string) string {
+	return path.Join(m.backupDir(name), metaFileName)
+}
+
+func statusError(op string, status *commonpb.Status) error {
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("failed to %s, code: %s, reason: %s", op, status.GetErrorCode(), status.GetReason())
+	}
+	return nil
+}
+
+// Create backs up the collection at the timestamp. The collection is flushed, and the flushed segments are pinned
+// by the segment reference lock until their files are copied, so that they are not compacted or recycled meanwhile.
+func (m *Manager) Create(ctx context.Context, req *CreateRequest) (*Info, error) {
+	if err := validateName(req.Name); err != nil {
+		return nil, err
+	}
+	exist, err := m.backupChunkManager.Exist(ctx, m.metaPath(req.Name))
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		return nil, fmt.Errorf("backup %s already exists", req.Name)
+	}
+
+	log := log.Ctx(ctx).With(zap.String("backup", req.Name), zap.String("dbName", req.DbName),
+		zap.String("collection", req.CollectionName))
+	log.Info("start to create backup")
+
+	info, err := m.describeCollection(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	log = log.With(zap.Uint64("backupTs", info.BackupTs))
+
+	if err := m.flush(ctx, info.CollectionID); err != nil {
+		return nil, err
+	}
+
+	taskID, err := m.allocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := m.releaseSegmentLock(ctx, taskID); err != nil {
+			log.Warn("failed to release the segment lock of backup", zap.Int64("taskID", taskID), zap.Error(err))
+		}
+	}()
+	segments, err := m.pinFlushedSegments(ctx, info.CollectionID, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.copySegments(ctx, info, segments); err != nil {
+		log.Warn("failed to copy segments, cleaning up the backup", zap.Error(err))
+		if err := m.backupChunkManager.RemoveWithPrefix(ctx, m.backupDir(info.Name)+"/"); err != nil {
+			log.Warn("failed to clean up the backup", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	// the meta is saved at last, the backups without meta are not listed.
+	data, err := marshalInfo(info)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.backupChunkManager.Write(ctx, m.metaPath(info.Name), data); err != nil {
+		return nil, err
+	}
+
+	log.Info("backup created", zap.Int("numSegments", len(info.Segments)), zap.Int("numFiles", len(info.Files)),
+		zap.Int64("size", info.Size()))
+	return info, nil
+}
+
+func (m *Manager) describeCollection(ctx context.Context, req *CreateRequest) (*Info, error) {
+	ts := req.Timestamp
+	if ts == 0 {
+		resp, err := m.rootCoord.AllocTimestamp(ctx, &rootcoordpb.AllocTimestampRequest{
+			Base:  commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+			Count: 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := statusError("allocate timestamp", resp.GetStatus()); err != nil {
+			return nil, err
+		}
+		ts = resp.GetTimestamp()
+	}
+
+	coll, err := m.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		DbName:         req.DbName,
+		CollectionName: req.CollectionName,
+		TimeStamp:      ts,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := statusError("describe collection", coll.GetStatus()); err != nil {
+		return nil, err
+	}
+	schema, err := proto.Marshal(coll.GetSchema())
+	if err != nil {
+		return nil, err
+	}
+
+	partitions, err := m.rootCoord.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ShowPartitions),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		DbName:         req.DbName,
+		CollectionName: req.CollectionName,
+		CollectionID:   coll.GetCollectionID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := statusError("show partitions", partitions.GetStatus()); err != nil {
+		return nil, err
+	}
+
+	indexes, err := m.indexCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{CollectionID: coll.GetCollectionID()})
+	if err != nil {
+		return nil, err
+	}
+	// the collection without index is backed up as well.
+	if indexes.GetStatus().GetErrorCode() != commonpb.ErrorCode_IndexNotExist {
+		if err := statusError("describe index", indexes.GetStatus()); err != nil {
+			return nil, err
+		}
+	}
+
+	info := &Info{
+		Name:             req.Name,
+		BackupTs:         ts,
+		CreateTime:       time.Now().Unix(),
+		DbName:           req.DbName,
+		CollectionName:   req.CollectionName,
+		CollectionID:     coll.GetCollectionID(),
+		Schema:           schema,
+		ShardsNum:        coll.GetShardsNum(),
+		ConsistencyLevel: coll.GetConsistencyLevel(),
+		Properties:       coll.GetProperties(),
+		Indexes:          indexes.GetIndexInfos(),
+	}
+	for i, partitionID := range partitions.GetPartitionIDs() {
+		info.Partitions = append(info.Partitions, &PartitionInfo{ID: partitionID, Name: partitions.GetPartitionNames()[i]})
+	}
+	return info, nil
+}
+
+func (m *Manager) allocID(ctx context.Context) (int64, error) {
+	resp, err := m.rootCoord.AllocID(ctx, &rootcoordpb.AllocIDRequest{
+		Base:  commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		Count: 1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := statusError("allocate id", resp.GetStatus()); err != nil {
+		return 0, err
+	}
+	return resp.GetID(), nil
+}
+
+// flush seals the growing segments of the collection and waits until they are flushed.
+func (m *Manager) flush(ctx context.Context, collectionID int64) error {
+	resp, err := m.dataCoord.Flush(ctx, &datapb.FlushRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_Flush),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		return err
+	}
+	if err := statusError("flush", resp.GetStatus()); err != nil {
+		return err
+	}
+	return m.waitFlushed(ctx, resp.GetSegmentIDs())
+}
+
+// pinFlushedSegments lists the flushed segments of the collection and pins them by the segment reference lock
+// of the task, so that their files are not recycled until the lock is released. A segment may be compacted
+// after listed and before pinned, then the lock is released and the segments are listed and pinned again.
+func (m *Manager) pinFlushedSegments(ctx context.Context, collectionID int64, taskID int64) ([]*datapb.SegmentInfo, error) {
+	var err error
+	for i := 0; i < maxPinAttempts; i++ {
+		var segmentIDs []int64
+		segmentIDs, err = m.getFlushedSegments(ctx, collectionID)
+		if err != nil {
+			return nil, err
+		}
+		if len(segmentIDs) == 0 {
+			return nil, nil
+		}
+
+		// the lock fails if the segment is compacted and recycled.
+		err = m.acquireSegmentLock(ctx, taskID, segmentIDs)
+		if err == nil {
+			var segments []*datapb.SegmentInfo
+			segments, err = m.getSegmentInfo(ctx, segmentIDs)
+			if err == nil {
+				err = checkFlushed(segments)
+			}
+			if err == nil {
+				return segments, nil
+			}
+			if err := m.releaseSegmentLock(ctx, taskID); err != nil {
+				return nil, err
+			}
+		}
+		log.Ctx(ctx).Warn("failed to pin the flushed segments, retry", zap.Int64("collectionID", collectionID),
+			zap.Int("attempt", i+1), zap.Error(err))
+	}
+	return nil, err
+}
+
+// checkFlushed checks that none of the segments is compacted.
+func checkFlushed(segments []*datapb.SegmentInfo) error {
+	for _, segment := range segments {
+		if segment.GetState() != commonpb.SegmentState_Flushed {
+			return fmt.Errorf("segment %d is not flushed, state: %s", segment.GetID(), segment.GetState())
+		}
+	}
+	return nil
+}
+
+func (m *Manager) getFlushedSegments(ctx context.Context, collectionID int64) ([]int64, error) {
+	resp, err := m.dataCoord.GetFlushedSegments(ctx, &datapb.GetFlushedSegmentsRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		CollectionID: collectionID,
+		PartitionID:  common.InvalidPartitionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := statusError("get flushed segments", resp.GetStatus()); err != nil {
+		return nil, err
+	}
+	return resp.GetSegments(), nil
+}
+
+func (m *Manager) getSegmentInfo(ctx context.Context, segmentIDs []int64) ([]*datapb.SegmentInfo, error) {
+	resp, err := m.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		Base:             commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		SegmentIDs:       segmentIDs,
+		IncludeUnHealthy: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := statusError("get segment info", resp.GetStatus()); err != nil {
+		return nil, err
+	}
+	return resp.GetInfos(), nil
+}
+
+func (m *Manager) waitFlushed(ctx context.Context, segmentIDs []int64) error {
+	if len(segmentIDs) == 0 {
+		return nil
+	}
+	ticker := time.NewTicker(flushCheckInterval)
+	defer ticker.Stop()
+	for {
+		resp, err := m.dataCoord.GetFlushState(ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: segmentIDs})
+		if err != nil {
+			return err
+		}
+		if err := statusError("get flush state", resp.GetStatus()); err != nil {
+			return err
+		}
+		if resp.GetFlushed() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait segments flushed: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Manager) acquireSegmentLock(ctx context.Context, taskID int64, segmentIDs []int64) error {
+	status, err := m.dataCoord.AcquireSegmentLock(ctx, &datapb.AcquireSegmentLockRequest{
+		Base:       commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		TaskID:     taskID,
+		NodeID:     paramtable.GetNodeID(),
+		SegmentIDs: segmentIDs,
+	})
+	if err != nil {
+		return err
+	}
+	return statusError("acquire segment lock", status)
+}
+
+func (m *Manager) releaseSegmentLock(ctx context.Context, taskID int64) error {
+	status, err := m.dataCoord.ReleaseSegmentLock(ctx, &datapb.ReleaseSegmentLockRequest{
+		Base:   commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		TaskID: taskID,
+		NodeID: paramtable.GetNodeID(),
+	})
+	if err != nil {
+		return err
+	}
+	return statusError("release segment lock", status)
+}
+
+// segmentAfter returns true if all the rows of the segment are written after the timestamp.
+func segmentAfter(segment *datapb.SegmentInfo, ts uint64) bool {
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if binlog.GetTimestampFrom() <= ts {
+				return false
+			}
+		}
+	}
+	return len(segment.GetBinlogs()) > 0
+}
+
+func (m *Manager) copySegments(ctx context.Context, info *Info, segments []*datapb.SegmentInfo) error {
+	var copied []int64
+	for _, segment := range segments {
+		// the rows written after the backup timestamp are skipped by the restore anyway.
+		if segmentAfter(segment, info.BackupTs) {
+			continue
+		}
+		logs := []struct {
+			dir     string
+			binlogs []*datapb.FieldBinlog
+		}{
+			{common.SegmentInsertLogPath, segment.GetBinlogs()},
+			{common.SegmentStatslogPath, segment.GetStatslogs()},
+			{common.SegmentDeltaLogPath, segment.GetDeltalogs()},
+		}
+		for _, l := range logs {
+			for _, fieldBinlog := range l.binlogs {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					relative, err := relativeLogPath(binlog.GetLogPath(), l.dir)
+					if err != nil {
+						return err
+					}
+					if err := m.copyFile(ctx, info, binlog.GetLogPath(), path.Join(dataDirName, relative)); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		info.Segments = append(info.Segments, &SegmentInfo{
+			ID:          segment.GetID(),
+			PartitionID: segment.GetPartitionID(),
+			NumRows:     segment.GetNumOfRows(),
+		})
+		copied = append(copied, segment.GetID())
+	}
+
+	return m.copyIndexFiles(ctx, info, copied)
+}
+
+// copyIndexFiles copies the index files of the segments, the indexes are rebuilt when restored,
+// the files are kept so that the backup could be inspected or loaded by other tools.
+func (m *Manager) copyIndexFiles(ctx context.Context, info *Info, segmentIDs []int64) error {
+	if len(segmentIDs) == 0 {
+		return nil
+	}
+	// the paths of the index files don't contain the collection id, which is required to encrypt them.
+	ctx = storage.WithCollectionID(ctx, info.CollectionID)
+	for _, index := range info.Indexes {
+		resp, err := m.indexCoord.GetIndexInfos(ctx, &indexpb.GetIndexInfoRequest{
+			CollectionID: info.CollectionID,
+			SegmentIDs:   segmentIDs,
+			IndexName:    index.GetIndexName(),
+		})
+		if err != nil {
+			return err
+		}
+		if err := statusError("get index infos", resp.GetStatus()); err != nil {
+			return err
+		}
+		for _, segment := range resp.GetSegmentInfo() {
+			for _, indexInfo := range segment.GetIndexInfos() {
+				for _, filePath := range indexInfo.GetIndexFilePaths() {
+					relative, err := relativeLogPath(filePath, common.SegmentIndexPath)
+					if err != nil {
+						return err
+					}
+					if err := m.copyFile(ctx, info, filePath, relative); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Manager) copyFile(ctx context.Context, info *Info, src string, dst string) error {
+	content, err := m.chunkManager.Read(ctx, src)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", src, err)
+	}
+	if err := m.backupChunkManager.Write(ctx, path.Join(m.backupDir(info.Name), dst), content); err != nil {
+		return fmt.Errorf("failed to write %s: %w", dst, err)
+	}
+	info.Files = append(info.Files, &FileInfo{
+		Path:     dst,
+		Size:     int64(len(content)),
+		Checksum: crc32.ChecksumIEEE(content),
+	})
+	return nil
+}
+
+// Get returns the meta of the backup.
+func (m *Manager) Get(ctx context.Context, name string) (*Info, error) {
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+	data, err := m.backupChunkManager.Read(ctx, m.metaPath(name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the meta of backup %s: %w", name, err)
+	}
+	return unmarshalInfo(data)
+}
+
+// List returns the meta of all the backups, sorted by the create time.
+func (m *Manager) List(ctx context.Context) ([]*Info, error) {
+	paths, _, err := m.backupChunkManager.ListWithPrefix(ctx, m.rootPath+"/", true)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]*Info, 0)
+	for _, p := range paths {
+		// only the meta file right under the backup directory.
+		if path.Base(p) != metaFileName || path.Dir(path.Dir(p)) != path.Clean(m.rootPath) {
+			continue
+		}
+		info, err := m.Get(ctx, path.Base(path.Dir(p)))
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].CreateTime < infos[j].CreateTime
+	})
+	return infos, nil
+}
+
+// Verify checks that all the files of the backup exist and are not corrupted.
+func (m *Manager) Verify(ctx context.Context, name string) error {
+	info, err := m.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	var broken []string
+	for _, file := range info.Files {
+		content, err := m.backupChunkManager.Read(ctx, path.Join(m.backupDir(name), file.Path))
+		if err != nil {
+			broken = append(broken, file.Path)
+			continue
+		}
+		if int64(len(content)) != file.Size || crc32.ChecksumIEEE(content) != file.Checksum {
+			broken = append(broken, file.Path)
+		}
+	}
+	if len(broken) > 0 {
+		log.Ctx(ctx).Warn("backup is broken", zap.String("backup", name), zap.Strings("files", broken))
+		return fmt.Errorf("backup %s is broken, %d of %d files are missing or corrupted: %s",
+			name, len(broken), len(info.Files), strings.Join(broken, ", "))
+	}
+	return nil
+}
+
+// Delete removes the backup and all of its files.
+func (m *Manager) Delete(ctx context.Context, name string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+	exist, err := m.backupChunkManager.Exist(ctx, m.metaPath(name))
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return errors.New("backup not found: " + name)
+	}
+	// the meta is removed first, so that a partially deleted backup is not listed.
+	if err := m.backupChunkManager.Remove(ctx, m.metaPath(name)); err != nil {
+		return err
+	}
+	if err := m.backupChunkManager.RemoveWithPrefix(ctx, m.backupDir(name)+"/"); err != nil {
+		return err
+	}
+	log.Ctx(ctx).Info("backup deleted", zap.String("backup", name))
+	return nil
+}
@@ -0,0 +1,293 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockDataCoord mocks the methods of DataCoord used by the backup.
+type mockDataCoord struct {
+	types.DataCoord
+	flushedSegments []int64
+	segments        []*datapb.SegmentInfo
+	lockedTasks     map[int64][]int64
+	// the flushed segments listed before flushedSegments, which are compacted before pinned.
+	compactedLists [][]int64
+}
+
+func (m *mockDataCoord) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
+	return &datapb.FlushResponse{Status: successStatus(), SegmentIDs: m.flushedSegments}, nil
+}
+
+func (m *mockDataCoord) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	return &milvuspb.GetFlushStateResponse{Status: successStatus(), Flushed: true}, nil
+}
+
+func (m *mockDataCoord) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+	if len(m.compactedLists) > 0 {
+		segments := m.compactedLists[0]
+		m.compactedLists = m.compactedLists[1:]
+		return &datapb.GetFlushedSegmentsResponse{Status: successStatus(), Segments: segments}, nil
+	}
+	return &datapb.GetFlushedSegmentsResponse{Status: successStatus(), Segments: m.flushedSegments}, nil
+}
+
+func (m *mockDataCoord) AcquireSegmentLock(ctx context.Context, req *datapb.AcquireSegmentLockRequest) (*commonpb.Status, error) {
+	m.lockedTasks[req.GetTaskID()] = req.GetSegmentIDs()
+	return successStatus(), nil
+}
+
+func (m *mockDataCoord) ReleaseSegmentLock(ctx context.Context, req *datapb.ReleaseSegmentLockRequest) (*commonpb.Status, error) {
+	delete(m.lockedTasks, req.GetTaskID())
+	return successStatus(), nil
+}
+
+func (m *mockDataCoord) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	infos := make([]*datapb.SegmentInfo, 0, len(req.GetSegmentIDs()))
+	for _, segment := range m.segments {
+		for _, segmentID := range req.GetSegmentIDs() {
+			if segment.GetID() == segmentID {
+				infos = append(infos, segment)
+			}
+		}
+	}
+	return &datapb.GetSegmentInfoResponse{Status: successStatus(), Infos: infos}, nil
+}
+
+func successStatus() *commonpb.Status {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+}
+
+func testSchema(name string) *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: name,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+}
+
+func TestManager(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	dir := t.TempDir()
+	cm := storage.NewLocalChunkManager(storage.RootPath(dir))
+	dataRoot := path.Join(dir, "files")
+	backupRoot := path.Join(dir, "backup")
+
+	backupTs := tsoutil.ComposeTS(1700000000000, 0)
+	insertLog := path.Join(dataRoot, common.SegmentInsertLogPath, "1", "2", "3", "100", "4")
+	deltaLog := path.Join(dataRoot, common.SegmentDeltaLogPath, "1", "2", "3", "5")
+	indexFile := path.Join(dataRoot, common.SegmentIndexPath, "6", "1", "2", "3", "index")
+	for _, p := range []string{insertLog, deltaLog, indexFile} {
+		require.NoError(t, cm.Write(ctx, p, []byte(p)))
+	}
+
+	rc := mocks.NewRootCoord(t)
+	dc := &mockDataCoord{
+		flushedSegments: []int64{3, 8},
+		segments: []*datapb.SegmentInfo{
+			{
+				ID:          3,
+				PartitionID: 2,
+				State:       commonpb.SegmentState_Flushed,
+				NumOfRows:   10,
+				Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{
+					{LogPath: insertLog, TimestampFrom: backupTs - 1},
+				}}},
+				Deltalogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: deltaLog}}}},
+			},
+			// all the rows are written after the backup timestamp.
+			{
+				ID:          8,
+				PartitionID: 7,
+				State:       commonpb.SegmentState_Flushed,
+				Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{
+					{LogPath: path.Join(dataRoot, common.SegmentInsertLogPath, "1", "7", "8", "100", "9"), TimestampFrom: backupTs + 1},
+				}}},
+			},
+			// compacted into segment 3 after listed.
+			{
+				ID:          12,
+				PartitionID: 2,
+				State:       commonpb.SegmentState_Dropped,
+			},
+		},
+		lockedTasks:    make(map[int64][]int64),
+		compactedLists: [][]int64{{3, 12}},
+	}
+	ic := mocks.NewMockIndexCoord(t)
+	m := NewManager(rc, dc, ic, cm, cm, backupRoot)
+
+	t.Run("create", func(t *testing.T) {
+		rc.EXPECT().DescribeCollection(mock.Anything, mock.MatchedBy(func(req *milvuspb.DescribeCollectionRequest) bool {
+			return req.GetCollectionName() == "coll"
+		})).Return(&milvuspb.DescribeCollectionResponse{
+			Status:       successStatus(),
+			Schema:       testSchema("coll"),
+			CollectionID: 1,
+			ShardsNum:    2,
+		}, nil).Once()
+		rc.EXPECT().ShowPartitions(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+			Status:         successStatus(),
+			PartitionNames: []string{"_default", "p1"},
+			PartitionIDs:   []int64{2, 7},
+		}, nil).Once()
+		rc.EXPECT().AllocID(mock.Anything, mock.Anything).Return(&rootcoordpb.AllocIDResponse{
+			Status: successStatus(),
+			ID:     10,
+		}, nil).Once()
+		ic.EXPECT().DescribeIndex(mock.Anything, mock.Anything).Return(&indexpb.DescribeIndexResponse{
+			Status:     successStatus(),
+			IndexInfos: []*indexpb.IndexInfo{{FieldID: 101, IndexName: "idx"}},
+		}, nil).Once()
+		ic.EXPECT().GetIndexInfos(mock.Anything, mock.Anything).Return(&indexpb.GetIndexInfoResponse{
+			Status: successStatus(),
+			SegmentInfo: map[int64]*indexpb.SegmentInfo{
+				3: {SegmentID: 3, IndexInfos: []*indexpb.IndexFilePathInfo{{IndexFilePaths: []string{indexFile}}}},
+			},
+		}, nil).Once()
+		info, err := m.Create(ctx, &CreateRequest{Name: "bak", CollectionName: "coll", Timestamp: backupTs})
+		assert.NoError(t, err)
+		assert.Equal(t, backupTs, info.BackupTs)
+		assert.Equal(t, 1, len(info.Segments))
+		assert.Equal(t, int64(3), info.Segments[0].ID)
+		assert.Equal(t, 3, len(info.Files))
+		// the compacted segment is listed again.
+		assert.Equal(t, 0, len(dc.compactedLists))
+		// the segments are unpinned after copied.
+		assert.Equal(t, 0, len(dc.lockedTasks))
+
+		content, err := cm.Read(ctx, path.Join(backupRoot, "bak", dataDirName, common.SegmentInsertLogPath, "1", "2", "3", "100", "4"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(insertLog), content)
+		exist, err := cm.Exist(ctx, path.Join(backupRoot, "bak", common.SegmentIndexPath, "6", "1", "2", "3", "index"))
+		assert.NoError(t, err)
+		assert.True(t, exist)
+
+		_, err = m.Create(ctx, &CreateRequest{Name: "bak", CollectionName: "coll", Timestamp: backupTs})
+		assert.Error(t, err)
+		_, err = m.Create(ctx, &CreateRequest{Name: "a/b", CollectionName: "coll"})
+		assert.Error(t, err)
+	})
+
+	t.Run("list and verify", func(t *testing.T) {
+		infos, err := m.List(ctx)
+		assert.NoError(t, err)
+		require.Equal(t, 1, len(infos))
+		assert.Equal(t, "bak", infos[0].Name)
+
+		assert.NoError(t, m.Verify(ctx, "bak"))
+		_, err = m.Get(ctx, "not_exist")
+		assert.Error(t, err)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		rc.EXPECT().CreateCollection(mock.Anything, mock.MatchedBy(func(req *milvuspb.CreateCollectionRequest) bool {
+			return req.GetCollectionName() == "restored" && req.GetShardsNum() == 2
+		})).Return(successStatus(), nil).Once()
+		rc.EXPECT().DescribeCollection(mock.Anything, mock.MatchedBy(func(req *milvuspb.DescribeCollectionRequest) bool {
+			return req.GetCollectionName() == "restored"
+		})).Return(&milvuspb.DescribeCollectionResponse{
+			Status:       successStatus(),
+			Schema:       testSchema("restored"),
+			CollectionID: 11,
+		}, nil).Once()
+		rc.EXPECT().CreatePartition(mock.Anything, mock.MatchedBy(func(req *milvuspb.CreatePartitionRequest) bool {
+			return req.GetPartitionName() == "p1"
+		})).Return(successStatus(), nil).Once()
+		ic.EXPECT().CreateIndex(mock.Anything, mock.MatchedBy(func(req *indexpb.CreateIndexRequest) bool {
+			return req.GetCollectionID() == 11 && req.GetFieldID() == 101 && req.GetIndexName() == "idx"
+		})).Return(successStatus(), nil).Once()
+		rc.EXPECT().Import(mock.Anything, mock.MatchedBy(func(req *milvuspb.ImportRequest) bool {
+			_, endTs, err := importutil.ParseTSFromOptions(req.GetOptions())
+			return req.GetPartitionName() == "_default" &&
+				importutil.IsBackup(req.GetOptions()) &&
+				err == nil && endTs == backupTs &&
+				req.GetFiles()[0] == path.Join(backupRoot, "bak", dataDirName, common.SegmentInsertLogPath, "1", "2") &&
+				req.GetFiles()[1] == path.Join(backupRoot, "bak", dataDirName, common.SegmentDeltaLogPath, "1", "2")
+		})).Return(&milvuspb.ImportResponse{Status: successStatus(), Tasks: []int64{100}}, nil).Once()
+
+		tasks, err := m.Restore(ctx, &RestoreRequest{BackupName: "bak", CollectionName: "restored"})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{100}, tasks)
+	})
+
+	t.Run("verify broken backup", func(t *testing.T) {
+		err := cm.Write(ctx, path.Join(backupRoot, "bak", dataDirName, common.SegmentDeltaLogPath, "1", "2", "3", "5"), []byte("broken"))
+		require.NoError(t, err)
+		assert.Error(t, m.Verify(ctx, "bak"))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		assert.NoError(t, m.Delete(ctx, "bak"))
+		infos, err := m.List(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(infos))
+		assert.Error(t, m.Delete(ctx, "bak"))
+	})
+}
+
+func TestManager_pinFlushedSegments(t *testing.T) {
+	ctx := context.Background()
+	dc := &mockDataCoord{
+		segments:       []*datapb.SegmentInfo{{ID: 1, State: commonpb.SegmentState_Dropped}},
+		lockedTasks:    make(map[int64][]int64),
+		compactedLists: [][]int64{{1}, {1}, {1}, {1}, {1}},
+	}
+	m := NewManager(nil, dc, nil, nil, nil, "backup")
+	_, err := m.pinFlushedSegments(ctx, 1, 10)
+	assert.Error(t, err)
+	// the segments are released if not pinned.
+	assert.Equal(t, 0, len(dc.lockedTasks))
+}
+
+func TestRelativeLogPath(t *testing.T) {
+	p, err := relativeLogPath("files/insert_log/1/2/3/100/4", common.SegmentInsertLogPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "insert_log/1/2/3/100/4", p)
+
+	p, err = relativeLogPath("insert_log/1/2/3/100/4", common.SegmentInsertLogPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "insert_log/1/2/3/100/4", p)
+
+	_, err = relativeLogPath("files/delta_log/1/2/3/4", common.SegmentInsertLogPath)
+	assert.Error(t, err)
+}
@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+const (
+	// metaFileName is the name of the meta file under the directory of a backup.
+	metaFileName = "meta.json"
+	// dataDirName is the directory of the binlogs under the directory of a backup,
+	// the layout under it is the same as the one of the storage, so that it could be imported directly.
+	dataDirName = "data"
+)
+
+// PartitionInfo is the partition of the backed up collection.
+type PartitionInfo struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// SegmentInfo is the segment of the backed up collection.
+type SegmentInfo struct {
+	ID          int64 `json:"id"`
+	PartitionID int64 `json:"partition_id"`
+	NumRows     int64 `json:"num_rows"`
+}
+
+// FileInfo is a file copied into the backup, the path is relative to the directory of the backup.
+type FileInfo struct {
+	Path     string `json:"path"`
+	Size     int64  `json:"size"`
+	Checksum uint32 `json:"checksum"`
+}
+
+// Info is the meta of a backup, it's saved with the files of the backup,
+// so that the backup could be restored without the meta of the cluster.
+type Info struct {
+	Name       string `json:"name"`
+	BackupTs   uint64 `json:"backup_ts"`
+	CreateTime int64  `json:"create_time"`
+
+	DbName           string                    `json:"db_name"`
+	CollectionName   string                    `json:"collection_name"`
+	CollectionID     int64                     `json:"collection_id"`
+	Schema           []byte                    `json:"schema"`
+	ShardsNum        int32                     `json:"shards_num"`
+	ConsistencyLevel commonpb.ConsistencyLevel `json:"consistency_level"`
+	Properties       []*commonpb.KeyValuePair  `json:"properties,omitempty"`
+	Partitions       []*PartitionInfo          `json:"partitions"`
+	Indexes          []*indexpb.IndexInfo      `json:"indexes,omitempty"`
+	Segments         []*SegmentInfo            `json:"segments"`
+	Files            []*FileInfo               `json:"files"`
+}
+
+// Size returns the total size of the files in the backup.
+func (info *Info) Size() int64 {
+	var size int64
+	for _, file := range info.Files {
+		size += file.Size
+	}
+	return size
+}
+
+func marshalInfo(info *Info) ([]byte, error) {
+	return json.Marshal(info)
+}
+
+func unmarshalInfo(data []byte) (*Info, error) {
+	info := &Info{}
+	if err := json.Unmarshal(data, info); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal backup meta: %w", err)
+	}
+	return info, nil
+}
+
+// validateName checks the backup name, it's used as a directory name.
+func validateName(name string) error {
+	if name == "" {
+		return fmt.Errorf("backup name should not be empty")
+	}
+	if strings.ContainsAny(name, "/\\") || name == "." || name == ".." {
+		return fmt.Errorf("invalid backup name: %s", name)
+	}
+	return nil
+}
+
+// relativeLogPath returns the path of the log relative to the root path of the storage,
+// which starts with the log type directory, e.g. insert_log/1/2/3/100/4.
+func relativeLogPath(logPath string, logDir string) (string, error) {
+	if strings.HasPrefix(logPath, logDir+"/") {
+		return logPath, nil
+	}
+	idx := strings.Index(logPath, "/"+logDir+"/")
+	if idx < 0 {
+		return "", fmt.Errorf("invalid %s path: %s", logDir, logPath)
+	}
+	return logPath[idx+1:], nil
+}
+
+// partitionLogDirs returns the insert log and delta log directories of the partition in the backup,
+// which are the files of the binlog import request.
+func partitionLogDirs(backupDir string, collectionID int64, partitionID int64) []string {
+	dataDir := path.Join(backupDir, dataDirName)
+	return []string{
+		path.Join(dataDir, common.SegmentInsertLogPath, fmt.Sprint(collectionID), fmt.Sprint(partitionID)),
+		path.Join(dataDir, common.SegmentDeltaLogPath, fmt.Sprint(collectionID), fmt.Sprint(partitionID)),
+	}
+}
@@ -0,0 +1,246 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+)
+
+// RestoreRequest is the request to restore a backup into a new collection.
+type RestoreRequest struct {
+	BackupName     string
+	CollectionName string
+}
+
+// Restore creates a new collection with the schema, partitions and indexes of the backup,
+// and imports the backed up binlogs into it. It returns the ids of the import tasks,
+// the progress of the restore could be checked by GetImportState.
+//
+// The binlogs are imported by the data nodes through the chunk manager of the cluster,
+// so the backups to restore should be saved in the same bucket as the cluster.
+// The rows and the deletions after the backup timestamp are filtered out by the import.
+func (m *Manager) Restore(ctx context.Context, req *RestoreRequest) ([]int64, error) {
+	info, err := m.Get(ctx, req.BackupName)
+	if err != nil {
+		return nil, err
+	}
+	log := log.Ctx(ctx).With(zap.String("backup", req.BackupName), zap.String("collection", req.CollectionName))
+	log.Info("start to restore backup")
+
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(info.Schema, schema); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the schema of backup %s: %w", req.BackupName, err)
+	}
+
+	collectionID, err := m.createCollection(ctx, info, schema, req.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.createIndexes(ctx, info, collectionID); err != nil {
+		m.dropCollection(ctx, req.CollectionName)
+		return nil, err
+	}
+
+	// only the partitions with data are imported.
+	partitionIDs := make(typeutil.UniqueSet)
+	for _, segment := range info.Segments {
+		partitionIDs.Insert(segment.PartitionID)
+	}
+	var tasks []int64
+	for _, partition := range info.Partitions {
+		if !partitionIDs.Contain(partition.ID) {
+			continue
+		}
+		resp, err := m.rootCoord.Import(ctx, &milvuspb.ImportRequest{
+			CollectionName: req.CollectionName,
+			PartitionName:  partition.Name,
+			Files:          partitionLogDirs(m.backupDir(info.Name), info.CollectionID, partition.ID),
+			Options: []*commonpb.KeyValuePair{
+				{Key: importutil.BackupFlag, Value: "true"},
+				{Key: importutil.EndTso, Value: strconv.FormatUint(info.BackupTs, 10)},
+			},
+		})
+		if err == nil {
+			err = statusError("import", resp.GetStatus())
+		}
+		if err != nil {
+			log.Warn("failed to import partition", zap.String("partition", partition.Name), zap.Int64s("tasks", tasks), zap.Error(err))
+			if len(tasks) == 0 {
+				m.dropCollection(ctx, req.CollectionName)
+			}
+			return nil, err
+		}
+		tasks = append(tasks, resp.GetTasks()...)
+	}
+
+	log.Info("backup restoring", zap.Int64("collectionID", collectionID), zap.Int64s("tasks", tasks))
+	return tasks, nil
+}
+
+// createCollection creates the collection and the partitions of the backup, returns the id of the new collection.
+func (m *Manager) createCollection(ctx context.Context, info *Info, schema *schemapb.CollectionSchema, collectionName string) (int64, error) {
+	// the system fields are appended by RootCoord.
+	userSchema := &schemapb.CollectionSchema{
+		Name:        collectionName,
+		Description: schema.GetDescription(),
+		AutoID:      schema.GetAutoID(),
+	}
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() >= common.StartOfUserFieldID {
+			userSchema.Fields = append(userSchema.Fields, field)
+		}
+	}
+	marshaledSchema, err := proto.Marshal(userSchema)
+	if err != nil {
+		return 0, err
+	}
+	status, err := m.rootCoord.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionName:   collectionName,
+		Schema:           marshaledSchema,
+		ShardsNum:        info.ShardsNum,
+		ConsistencyLevel: info.ConsistencyLevel,
+		Properties:       info.Properties,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := statusError("create collection", status); err != nil {
+		return 0, err
+	}
+
+	collectionID, err := m.checkFields(ctx, schema, collectionName)
+	if err == nil {
+		err = m.createPartitions(ctx, info, schema, collectionName)
+	}
+	if err != nil {
+		m.dropCollection(ctx, collectionName)
+		return 0, err
+	}
+	return collectionID, nil
+}
+
+// checkFields checks the field ids of the new collection, since the binlogs are imported by the field ids.
+func (m *Manager) checkFields(ctx context.Context, schema *schemapb.CollectionSchema, collectionName string) (int64, error) {
+	resp, err := m.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionName: collectionName,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := statusError("describe collection", resp.GetStatus()); err != nil {
+		return 0, err
+	}
+	fieldIDs := make(map[string]int64)
+	for _, field := range resp.GetSchema().GetFields() {
+		fieldIDs[field.GetName()] = field.GetFieldID()
+	}
+	for _, field := range schema.GetFields() {
+		if id, ok := fieldIDs[field.GetName()]; !ok || id != field.GetFieldID() {
+			return 0, fmt.Errorf("the id of field %s is changed from %d to %d, the backup could not be restored",
+				field.GetName(), field.GetFieldID(), id)
+		}
+	}
+	return resp.GetCollectionID(), nil
+}
+
+func (m *Manager) createPartitions(ctx context.Context, info *Info, schema *schemapb.CollectionSchema, collectionName string) error {
+	// the partitions of partition key are created with the collection.
+	if typeutil.HasPartitionKey(schema) {
+		return nil
+	}
+	defaultPartitionName := paramtable.Get().CommonCfg.DefaultPartitionName.GetValue()
+	for _, partition := range info.Partitions {
+		if partition.Name == defaultPartitionName {
+			continue
+		}
+		status, err := m.rootCoord.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_CreatePartition),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			CollectionName: collectionName,
+			PartitionName:  partition.Name,
+		})
+		if err != nil {
+			return err
+		}
+		if err := statusError("create partition", status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Manager) createIndexes(ctx context.Context, info *Info, collectionID int64) error {
+	for _, index := range info.Indexes {
+		status, err := m.indexCoord.CreateIndex(ctx, &indexpb.CreateIndexRequest{
+			CollectionID:    collectionID,
+			FieldID:         index.GetFieldID(),
+			IndexName:       index.GetIndexName(),
+			TypeParams:      index.GetTypeParams(),
+			IndexParams:     index.GetIndexParams(),
+			IsAutoIndex:     index.GetIsAutoIndex(),
+			UserIndexParams: index.GetUserIndexParams(),
+		})
+		if err != nil {
+			return err
+		}
+		if err := statusError("create index", status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Manager) dropCollection(ctx context.Context, collectionName string) {
+	status, err := m.rootCoord.DropCollection(ctx, &milvuspb.DropCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_DropCollection),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionName: collectionName,
+	})
+	if err == nil {
+		err = statusError("drop collection", status)
+	}
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to drop the collection of failed restore", zap.String("collection", collectionName), zap.Error(err))
+	}
+}
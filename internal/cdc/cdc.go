@@ -0,0 +1,66 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cdc defines the change events of a collection, the inserts, deletes and DDLs read from
+// the DML channels are decoded into row-oriented events for the downstream systems to follow the collection.
+package cdc
+
+// EventType is the type of a change event.
+type EventType string
+
+const (
+	EventInsert           EventType = "insert"
+	EventDelete           EventType = "delete"
+	EventCreateCollection EventType = "create_collection"
+	EventDropCollection   EventType = "drop_collection"
+	EventCreatePartition  EventType = "create_partition"
+	EventDropPartition    EventType = "drop_partition"
+)
+
+// TimestampKey is the key of the row timestamp in the rows of insert and delete events.
+const TimestampKey = "$timestamp"
+
+// Row is a row of an insert or delete event, keyed by field name.
+// The row of a delete event only contains the primary key.
+type Row map[string]interface{}
+
+// Event is a change of the collection.
+type Event struct {
+	Type      EventType `json:"type"`
+	Timestamp uint64    `json:"timestamp"`
+	// Channel is the virtual channel of the insert and delete events.
+	Channel       string `json:"channel,omitempty"`
+	PartitionName string `json:"partition_name,omitempty"`
+	Rows          []Row  `json:"rows,omitempty"`
+}
+
+// Batch is the events between two time ticks of the DML channels, in the order of the timestamp.
+// Checkpoint is the position after the batch, a subscription resumes from it returns the events after the batch.
+type Batch struct {
+	Events     []*Event `json:"events"`
+	Checkpoint string   `json:"checkpoint"`
+}
+
+// SubscribeRequest is the request to subscribe the changes of a collection.
+type SubscribeRequest struct {
+	DbName         string `json:"db_name"`
+	CollectionName string `json:"collection_name"`
+	// StartTs is the timestamp the subscription starts from, only the events after it are returned,
+	// 0 means from the creation of the collection. It's ignored if Checkpoint is set.
+	StartTs uint64 `json:"start_ts"`
+	// Checkpoint is returned by a previous subscription of the collection to resume from.
+	Checkpoint string `json:"checkpoint"`
+}
@@ -65,6 +65,10 @@ const (
 	// SegmentInsertLogPath storage path const for segment insert binlog.
 	SegmentInsertLogPath = `insert_log`
 
+	// SegmentColumnarLogDir is the directory of the columnar binlogs under the segment insert binlog path,
+	// it takes the place of the field id as the binlogs are shared by all the fields.
+	SegmentColumnarLogDir = `columnar`
+
 	// SegmentDeltaLogPath storage path const for segment delta log.
 	SegmentDeltaLogPath = `delta_log`
 
@@ -82,10 +86,35 @@ const (
 	DimKey         = "dim"
 )
 
+// Field type params key
+
+const (
+	// PartitionKeyKey marks the scalar field whose value decides the partition of a row.
+	PartitionKeyKey = "is_partition_key"
+	// ElementTypeKey is the data type name of the elements of an array field, such as "Int64".
+	ElementTypeKey = "element_type"
+	// MaxCapacityKey is the max number of elements in a row of an array field.
+	MaxCapacityKey = "max_capacity"
+	// NullableKey marks the field which accepts rows without value.
+	NullableKey = "nullable"
+	// DefaultValueKey is the value in text filled to the rows without value of the field.
+	DefaultValueKey = "default_value"
+)
+
 //  Collection properties key
 
 const (
 	CollectionTTLConfigKey = "collection.ttl.seconds"
+	// NumPartitionsKey is the number of hash partitions created for a partition key collection.
+	NumPartitionsKey = "partition_key.num_partitions"
+	// CollectionAddFieldKey carries the JSON encoded schema of the field added by AlterCollection, it's never persisted.
+	CollectionAddFieldKey = "collection.add_field"
+	// CollectionSchemaVersionKey is the version of collection schema, bumped each time a field is added.
+	CollectionSchemaVersionKey = "collection.schema.version"
+	// CollectionBinlogCompressionKey is the codec to compress the insert and delete binlogs, zstd, lz4 or snappy.
+	CollectionBinlogCompressionKey = "collection.binlog.compression"
+	// CollectionBinlogCompressionLevelKey is the compression level of the binlog codec, ignored by snappy.
+	CollectionBinlogCompressionLevelKey = "collection.binlog.compression.level"
 )
 
 const (
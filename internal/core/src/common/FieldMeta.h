@@ -20,6 +20,7 @@
 #include <stdexcept>
 #include <string>
 
+#include "common/Consts.h"
 #include "common/Types.h"
 #include "exceptions/EasyAssert.h"
 #include "utils/Status.h"
@@ -75,6 +76,10 @@ datatype_name(DataType data_type) {
             return "double";
         case DataType::VARCHAR:
             return "varChar";
+        case DataType::ARRAY:
+            return "array";
+        case DataType::JSON:
+            return "json";
         case DataType::VECTOR_FLOAT:
             return "vector_float";
         case DataType::VECTOR_BINARY: {
@@ -103,6 +108,22 @@ datatype_is_string(DataType datatype) {
     }
 }
 
+inline bool
+datatype_is_json(DataType datatype) {
+    return datatype == DataType::JSON;
+}
+
+inline bool
+datatype_is_array(DataType datatype) {
+    return datatype == DataType::ARRAY;
+}
+
+// json documents and serialized arrays are stored as binary
+inline bool
+datatype_is_binary(DataType datatype) {
+    return datatype_is_json(datatype) || datatype_is_array(datatype);
+}
+
 inline bool
 datatype_is_integer(DataType datatype) {
     switch (datatype) {
@@ -200,12 +221,25 @@ class FieldMeta {
         return type_;
     }
 
+    bool
+    is_nullable() const {
+        return nullable_;
+    }
+
+    void
+    set_nullable(bool nullable) {
+        nullable_ = nullable;
+    }
+
     int64_t
     get_sizeof() const {
         if (is_vector()) {
             return datatype_sizeof(type_, get_dim());
         } else if (is_string()) {
             return string_info_->max_length;
+        } else if (datatype_is_binary(type_)) {
+            // json documents and arrays have no fixed size, estimate with the average length
+            return DEFAULT_BINARY_AVG_LENGTH;
         } else {
             return datatype_sizeof(type_);
         }
@@ -224,6 +258,7 @@ class FieldMeta {
     DataType type_ = DataType::NONE;
     std::optional<VectorInfo> vector_info_;
     std::optional<StringInfo> string_info_;
+    bool nullable_ = false;
 };
 
 }  // namespace milvus
@@ -27,6 +27,10 @@ struct SearchInfo {
     FieldId field_id_;
     MetricType metric_type_;
     Config search_params_;
+    // range search keeps the results whose distances lie in the range bounded by radius_ and range_filter_
+    bool is_range_search_ = false;
+    float radius_ = 0;
+    float range_filter_ = 0;
 };
 
 using SearchInfoPtr = std::shared_ptr<SearchInfo>;
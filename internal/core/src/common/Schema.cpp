@@ -35,6 +35,12 @@ RepeatedKeyValToMap(const google::protobuf::RepeatedPtrField<proto::common::KeyV
     return mapping;
 }
 
+// IsTrue parses the boolean type param like strconv.ParseBool in go
+static bool
+IsTrue(const string& value) {
+    return value == "1" || value == "t" || value == "T" || value == "true" || value == "TRUE" || value == "True";
+}
+
 std::shared_ptr<Schema>
 Schema::ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto) {
     auto schema = std::make_shared<Schema>();
@@ -77,6 +83,11 @@ Schema::ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto) {
             schema->AddField(name, field_id, data_type);
         }
 
+        auto type_map = RepeatedKeyValToMap(child.type_params());
+        if (type_map.count(NULLABLE) && IsTrue(type_map.at(NULLABLE))) {
+            schema->set_nullable(field_id);
+        }
+
         if (child.is_primary_key()) {
             AssertInfo(!schema->get_primary_field_id().has_value(), "repetitive primary key");
             schema->set_primary_field_id(field_id);
@@ -84,6 +84,13 @@ class Schema {
         this->primary_field_id_opt_ = field_id;
     }
 
+    void
+    set_nullable(FieldId field_id) {
+        AssertInfo(fields_.find(field_id) != fields_.end(),
+                   "Cannot find field with field_id: " + std::to_string(field_id.get()));
+        fields_.at(field_id).set_nullable(true);
+    }
+
     auto
     begin() const {
         return fields_.begin();
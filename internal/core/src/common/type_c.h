@@ -74,6 +74,7 @@ typedef struct CLoadFieldDataInfo {
     const uint8_t* blob;
     uint64_t blob_size;
     int64_t row_count;
+    const bool* valid_data;  // null rows of the nullable field, nullptr if all the rows are valid
 } CLoadFieldDataInfo;
 
 typedef struct CLoadDeletedRecordInfo {
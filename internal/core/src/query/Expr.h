@@ -91,12 +91,15 @@ struct LogicalBinaryExpr : BinaryExprBase {
 struct TermExpr : Expr {
     const FieldId field_id_;
     const DataType data_type_;
+    // keys to the value inside a json field, empty for the other data types
+    const std::vector<std::string> nested_path_;
 
  protected:
     // prevent accidential instantiation
     TermExpr() = delete;
 
-    TermExpr(const FieldId field_id, const DataType data_type) : field_id_(field_id), data_type_(data_type) {
+    TermExpr(const FieldId field_id, const DataType data_type, const std::vector<std::string>& nested_path = {})
+        : field_id_(field_id), data_type_(data_type), nested_path_(nested_path) {
     }
 
  public:
@@ -149,13 +152,17 @@ struct UnaryRangeExpr : Expr {
     const FieldId field_id_;
     const DataType data_type_;
     const OpType op_type_;
+    const std::vector<std::string> nested_path_;
 
  protected:
     // prevent accidential instantiation
     UnaryRangeExpr() = delete;
 
-    UnaryRangeExpr(const FieldId field_id, const DataType data_type, const OpType op_type)
-        : field_id_(field_id), data_type_(data_type), op_type_(op_type) {
+    UnaryRangeExpr(const FieldId field_id,
+                   const DataType data_type,
+                   const OpType op_type,
+                   const std::vector<std::string>& nested_path = {})
+        : field_id_(field_id), data_type_(data_type), op_type_(op_type), nested_path_(nested_path) {
     }
 
  public:
@@ -168,6 +175,7 @@ struct BinaryRangeExpr : Expr {
     const DataType data_type_;
     const bool lower_inclusive_;
     const bool upper_inclusive_;
+    const std::vector<std::string> nested_path_;
 
  protected:
     // prevent accidential instantiation
@@ -176,11 +184,53 @@ struct BinaryRangeExpr : Expr {
     BinaryRangeExpr(const FieldId field_id,
                     const DataType data_type,
                     const bool lower_inclusive,
-                    const bool upper_inclusive)
+                    const bool upper_inclusive,
+                    const std::vector<std::string>& nested_path = {})
         : field_id_(field_id),
           data_type_(data_type),
           lower_inclusive_(lower_inclusive),
-          upper_inclusive_(upper_inclusive) {
+          upper_inclusive_(upper_inclusive),
+          nested_path_(nested_path) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
+struct ArrayContainsExpr : Expr {
+    enum class OpType { Invalid = 0, Contains = 1, ContainsAll = 2, ContainsAny = 3 };
+    const FieldId field_id_;
+    const DataType data_type_;
+    const OpType op_type_;
+    // elements have been casted to the element type of the array
+    const std::vector<proto::plan::GenericValue> elements_;
+
+    ArrayContainsExpr(const FieldId field_id,
+                      const DataType data_type,
+                      const OpType op_type,
+                      const std::vector<proto::plan::GenericValue>& elements)
+        : field_id_(field_id), data_type_(data_type), op_type_(op_type), elements_(elements) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
+struct NullExpr : Expr {
+    enum class OpType { Invalid = 0, IsNull = 1, IsNotNull = 2 };
+    const FieldId field_id_;
+    const DataType data_type_;
+    const OpType op_type_;
+    // a missing nested path of json field is regarded as null
+    const std::vector<std::string> nested_path_;
+
+    NullExpr(const FieldId field_id,
+             const DataType data_type,
+             const OpType op_type,
+             const std::vector<std::string>& nested_path = {})
+        : field_id_(field_id), data_type_(data_type), op_type_(op_type), nested_path_(nested_path) {
     }
 
  public:
@@ -28,8 +28,11 @@ template <typename T>
 struct TermExprImpl : TermExpr {
     const std::vector<T> terms_;
 
-    TermExprImpl(const FieldId field_id, const DataType data_type, const std::vector<T>& terms)
-        : TermExpr(field_id, data_type), terms_(terms) {
+    TermExprImpl(const FieldId field_id,
+                 const DataType data_type,
+                 const std::vector<T>& terms,
+                 const std::vector<std::string>& nested_path = {})
+        : TermExpr(field_id, data_type, nested_path), terms_(terms) {
     }
 };
 
@@ -54,8 +57,12 @@ template <typename T>
 struct UnaryRangeExprImpl : UnaryRangeExpr {
     const T value_;
 
-    UnaryRangeExprImpl(const FieldId field_id, const DataType data_type, const OpType op_type, const T value)
-        : UnaryRangeExpr(field_id, data_type, op_type), value_(value) {
+    UnaryRangeExprImpl(const FieldId field_id,
+                       const DataType data_type,
+                       const OpType op_type,
+                       const T value,
+                       const std::vector<std::string>& nested_path = {})
+        : UnaryRangeExpr(field_id, data_type, op_type, nested_path), value_(value) {
     }
 };
 
@@ -69,8 +76,9 @@ struct BinaryRangeExprImpl : BinaryRangeExpr {
                         const bool lower_inclusive,
                         const bool upper_inclusive,
                         const T lower_value,
-                        const T upper_value)
-        : BinaryRangeExpr(field_id, data_type, lower_inclusive, upper_inclusive),
+                        const T upper_value,
+                        const std::vector<std::string>& nested_path = {})
+        : BinaryRangeExpr(field_id, data_type, lower_inclusive, upper_inclusive, nested_path),
           lower_value_(lower_value),
           upper_value_(upper_value) {
     }
@@ -126,6 +126,33 @@ ExtractBinaryArithOpEvalRangeExprImpl(FieldId field_id,
         static_cast<OpType>(expr_proto.op()), getValue(expr_proto.value()));
 }
 
+// the type of a value inside a json field is only known at evaluation, so the operands are kept as generic values
+std::vector<std::string>
+ExtractNestedPath(const planpb::ColumnInfo& column_info) {
+    return std::vector<std::string>(column_info.nested_path().begin(), column_info.nested_path().end());
+}
+
+std::unique_ptr<TermExprImpl<planpb::GenericValue>>
+ExtractJSONTermExprImpl(FieldId field_id, DataType data_type, const planpb::TermExpr& expr_proto) {
+    std::vector<planpb::GenericValue> terms(expr_proto.values().begin(), expr_proto.values().end());
+    return std::make_unique<TermExprImpl<planpb::GenericValue>>(field_id, data_type, terms,
+                                                                ExtractNestedPath(expr_proto.column_info()));
+}
+
+std::unique_ptr<UnaryRangeExprImpl<planpb::GenericValue>>
+ExtractJSONUnaryRangeExprImpl(FieldId field_id, DataType data_type, const planpb::UnaryRangeExpr& expr_proto) {
+    return std::make_unique<UnaryRangeExprImpl<planpb::GenericValue>>(
+        field_id, data_type, static_cast<OpType>(expr_proto.op()), expr_proto.value(),
+        ExtractNestedPath(expr_proto.column_info()));
+}
+
+std::unique_ptr<BinaryRangeExprImpl<planpb::GenericValue>>
+ExtractJSONBinaryRangeExprImpl(FieldId field_id, DataType data_type, const planpb::BinaryRangeExpr& expr_proto) {
+    return std::make_unique<BinaryRangeExprImpl<planpb::GenericValue>>(
+        field_id, data_type, expr_proto.lower_inclusive(), expr_proto.upper_inclusive(), expr_proto.lower_value(),
+        expr_proto.upper_value(), ExtractNestedPath(expr_proto.column_info()));
+}
+
 std::unique_ptr<VectorPlanNode>
 ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     // TODO: add more buffs
@@ -149,6 +176,9 @@ ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     search_info.topk_ = query_info_proto.topk();
     search_info.round_decimal_ = query_info_proto.round_decimal();
     search_info.search_params_ = json::parse(query_info_proto.search_params());
+    search_info.is_range_search_ = query_info_proto.is_range_search();
+    search_info.radius_ = query_info_proto.radius();
+    search_info.range_filter_ = query_info_proto.range_filter();
 
     auto plan_node = [&]() -> std::unique_ptr<VectorPlanNode> {
         if (anns_proto.is_binary()) {
@@ -246,6 +276,9 @@ ProtoParser::ParseUnaryRangeExpr(const proto::plan::UnaryRangeExpr& expr_pb) {
             case DataType::VARCHAR: {
                 return ExtractUnaryRangeExprImpl<std::string>(field_id, data_type, expr_pb);
             }
+            case DataType::JSON: {
+                return ExtractJSONUnaryRangeExprImpl(field_id, data_type, expr_pb);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -287,6 +320,9 @@ ProtoParser::ParseBinaryRangeExpr(const proto::plan::BinaryRangeExpr& expr_pb) {
             case DataType::VARCHAR: {
                 return ExtractBinaryRangeExprImpl<std::string>(field_id, data_type, expr_pb);
             }
+            case DataType::JSON: {
+                return ExtractJSONBinaryRangeExprImpl(field_id, data_type, expr_pb);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -352,6 +388,9 @@ ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
             case DataType::VARCHAR: {
                 return ExtractTermExprImpl<std::string>(field_id, data_type, expr_pb);
             }
+            case DataType::JSON: {
+                return ExtractJSONTermExprImpl(field_id, data_type, expr_pb);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -403,6 +442,11 @@ ProtoParser::ParseBinaryArithOpEvalRangeExpr(const proto::plan::BinaryArithOpEva
             case DataType::DOUBLE: {
                 return ExtractBinaryArithOpEvalRangeExprImpl<double>(field_id, data_type, expr_pb);
             }
+            case DataType::ARRAY: {
+                // array_length(field) is the only arithmetic on array fields
+                Assert(expr_pb.arith_op() == proto::plan::ArithOpType::ArrayLength);
+                return ExtractBinaryArithOpEvalRangeExprImpl<int64_t>(field_id, data_type, expr_pb);
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -411,6 +455,30 @@ ProtoParser::ParseBinaryArithOpEvalRangeExpr(const proto::plan::BinaryArithOpEva
     return result;
 }
 
+ExprPtr
+ProtoParser::ParseArrayContainsExpr(const proto::plan::ArrayContainsExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto data_type = schema[field_id].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    Assert(datatype_is_array(data_type));
+
+    std::vector<planpb::GenericValue> elements(expr_pb.elements().begin(), expr_pb.elements().end());
+    return std::make_unique<ArrayContainsExpr>(field_id, data_type,
+                                               static_cast<ArrayContainsExpr::OpType>(expr_pb.op()), elements);
+}
+
+ExprPtr
+ProtoParser::ParseNullExpr(const proto::plan::NullExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto data_type = schema[field_id].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+
+    return std::make_unique<NullExpr>(field_id, data_type, static_cast<NullExpr::OpType>(expr_pb.op()),
+                                      ExtractNestedPath(column_info));
+}
+
 ExprPtr
 ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
     using ppe = proto::plan::Expr;
@@ -436,6 +504,12 @@ ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
         case ppe::kBinaryArithOpEvalRangeExpr: {
             return ParseBinaryArithOpEvalRangeExpr(expr_pb.binary_arith_op_eval_range_expr());
         }
+        case ppe::kArrayContainsExpr: {
+            return ParseArrayContainsExpr(expr_pb.array_contains_expr());
+        }
+        case ppe::kNullExpr: {
+            return ParseNullExpr(expr_pb.null_expr());
+        }
         default:
             PanicInfo("unsupported expr proto node");
     }
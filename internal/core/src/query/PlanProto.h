@@ -50,6 +50,12 @@ class ProtoParser {
     ExprPtr
     ParseBinaryExpr(const proto::plan::BinaryExpr& expr_pb);
 
+    ExprPtr
+    ParseArrayContainsExpr(const proto::plan::ArrayContainsExpr& expr_pb);
+
+    ExprPtr
+    ParseNullExpr(const proto::plan::NullExpr& expr_pb);
+
     ExprPtr
     ParseExpr(const proto::plan::Expr& expr_pb);
 
@@ -44,6 +44,12 @@ class ExecExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(ArrayContainsExpr& expr) override;
+
+    void
+    visit(NullExpr& expr) override;
+
  public:
     ExecExprVisitor(const segcore::SegmentInternalInterface& segment, int64_t row_count, Timestamp timestamp)
         : segment_(segment), row_count_(row_count), timestamp_(timestamp) {
@@ -92,6 +98,34 @@ class ExecExprVisitor : public ExprVisitor {
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> BitsetType;
 
+    template <typename ElementFunc>
+    auto
+    ExecBinaryFieldVisitorImpl(FieldId field_id, ElementFunc element_func) -> BitsetType;
+
+    template <typename ElementFunc>
+    auto
+    ExecJSONVisitorImpl(FieldId field_id, const std::vector<std::string>& nested_path, ElementFunc element_func)
+        -> BitsetType;
+
+    template <typename ElementFunc>
+    auto
+    ExecArrayVisitorImpl(FieldId field_id, ElementFunc element_func) -> BitsetType;
+
+    auto
+    ExecUnaryRangeVisitorDispatcherJSON(UnaryRangeExpr& expr_raw) -> BitsetType;
+
+    auto
+    ExecBinaryRangeVisitorDispatcherJSON(BinaryRangeExpr& expr_raw) -> BitsetType;
+
+    auto
+    ExecTermVisitorImplJSON(TermExpr& expr_raw) -> BitsetType;
+
+    auto
+    ExecArrayLengthVisitor(BinaryArithOpEvalRangeExpr& expr_raw) -> BitsetType;
+
+    auto
+    ExecNullVisitorImpl(FieldId field_id) -> BitsetType;
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     Timestamp timestamp_;
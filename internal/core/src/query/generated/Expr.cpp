@@ -49,4 +49,14 @@ CompareExpr::accept(ExprVisitor& visitor) {
     visitor.visit(*this);
 }
 
+void
+ArrayContainsExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
+void
+NullExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
 }  // namespace milvus::query
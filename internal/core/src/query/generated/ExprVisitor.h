@@ -39,5 +39,11 @@ class ExprVisitor {
 
     virtual void
     visit(CompareExpr&) = 0;
+
+    virtual void
+    visit(ArrayContainsExpr&) = 0;
+
+    virtual void
+    visit(NullExpr&) = 0;
 };
 }  // namespace milvus::query
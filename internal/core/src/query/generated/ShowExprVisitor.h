@@ -40,6 +40,12 @@ class ShowExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(ArrayContainsExpr& expr) override;
+
+    void
+    visit(NullExpr& expr) override;
+
  public:
     Json
 
@@ -9,6 +9,7 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <algorithm>
 #include <deque>
 #include <optional>
 #include <unordered_set>
@@ -20,6 +21,8 @@
 #include "segcore/SegmentGrowingImpl.h"
 #include "query/Utils.h"
 #include "query/Relational.h"
+#include "pb/schema.pb.h"
+#include "utils/Json.h"
 
 namespace milvus::query {
 // THIS CONTAINS EXTRA BODY FOR VISITOR
@@ -70,6 +73,34 @@ class ExecExprVisitor : ExprVisitor {
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> BitsetType;
 
+    template <typename ElementFunc>
+    auto
+    ExecBinaryFieldVisitorImpl(FieldId field_id, ElementFunc element_func) -> BitsetType;
+
+    template <typename ElementFunc>
+    auto
+    ExecJSONVisitorImpl(FieldId field_id, const std::vector<std::string>& nested_path, ElementFunc element_func)
+        -> BitsetType;
+
+    template <typename ElementFunc>
+    auto
+    ExecArrayVisitorImpl(FieldId field_id, ElementFunc element_func) -> BitsetType;
+
+    auto
+    ExecUnaryRangeVisitorDispatcherJSON(UnaryRangeExpr& expr_raw) -> BitsetType;
+
+    auto
+    ExecBinaryRangeVisitorDispatcherJSON(BinaryRangeExpr& expr_raw) -> BitsetType;
+
+    auto
+    ExecTermVisitorImplJSON(TermExpr& expr_raw) -> BitsetType;
+
+    auto
+    ExecArrayLengthVisitor(BinaryArithOpEvalRangeExpr& expr_raw) -> BitsetType;
+
+    auto
+    ExecNullVisitorImpl(FieldId field_id) -> BitsetType;
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     int64_t row_count_;
@@ -436,6 +467,291 @@ ExecExprVisitor::ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) ->
 }
 #pragma clang diagnostic pop
 
+// returns the value at the nested path of the json document, or nullptr if the path doesn't exist
+static const json*
+FindJSONValue(const json& document, const std::vector<std::string>& nested_path) {
+    auto value = &document;
+    for (const auto& key : nested_path) {
+        if (value->is_object()) {
+            auto it = value->find(key);
+            if (it == value->end()) {
+                return nullptr;
+            }
+            value = &(*it);
+        } else if (value->is_array() && !key.empty() && std::all_of(key.begin(), key.end(), ::isdigit)) {
+            auto index = std::strtoull(key.c_str(), nullptr, 10);
+            if (index >= value->size()) {
+                return nullptr;
+            }
+            value = &(*value)[index];
+        } else {
+            return nullptr;
+        }
+    }
+    return value;
+}
+
+// compares the json value with the operand of the expression,
+// returns std::nullopt if their types are incomparable
+static std::optional<int>
+CompareJSONValue(const json& value, const proto::plan::GenericValue& operand) {
+    auto compare = [](const auto& a, const auto& b) { return a < b ? -1 : (b < a ? 1 : 0); };
+    switch (operand.val_case()) {
+        case proto::plan::GenericValue::kBoolVal: {
+            if (!value.is_boolean()) {
+                return std::nullopt;
+            }
+            return compare(value.get<bool>(), operand.bool_val());
+        }
+        case proto::plan::GenericValue::kInt64Val: {
+            if (value.is_number_integer()) {
+                return compare(value.get<int64_t>(), operand.int64_val());
+            }
+            if (!value.is_number()) {
+                return std::nullopt;
+            }
+            return compare(value.get<double>(), static_cast<double>(operand.int64_val()));
+        }
+        case proto::plan::GenericValue::kFloatVal: {
+            if (!value.is_number()) {
+                return std::nullopt;
+            }
+            return compare(value.get<double>(), operand.float_val());
+        }
+        case proto::plan::GenericValue::kStringVal: {
+            if (!value.is_string()) {
+                return std::nullopt;
+            }
+            return compare(value.get_ref<const std::string&>(), operand.string_val());
+        }
+        default: {
+            return std::nullopt;
+        }
+    }
+}
+
+// returns the number of elements of an array row
+static int64_t
+GetArrayLength(const proto::schema::ScalarField& array) {
+    switch (array.data_case()) {
+        case proto::schema::ScalarField::kBoolData:
+            return array.bool_data().data_size();
+        case proto::schema::ScalarField::kIntData:
+            return array.int_data().data_size();
+        case proto::schema::ScalarField::kLongData:
+            return array.long_data().data_size();
+        case proto::schema::ScalarField::kFloatData:
+            return array.float_data().data_size();
+        case proto::schema::ScalarField::kDoubleData:
+            return array.double_data().data_size();
+        case proto::schema::ScalarField::kStringData:
+            return array.string_data().data_size();
+        default:
+            return 0;
+    }
+}
+
+// the elements of the expression have been casted to the element type of the array by the proxy
+static bool
+ArrayContainsValue(const proto::schema::ScalarField& array, const proto::plan::GenericValue& value) {
+    auto contains = [](const auto& data, const auto& x) { return std::find(data.begin(), data.end(), x) != data.end(); };
+    switch (array.data_case()) {
+        case proto::schema::ScalarField::kBoolData:
+            return value.val_case() == proto::plan::GenericValue::kBoolVal &&
+                   contains(array.bool_data().data(), value.bool_val());
+        case proto::schema::ScalarField::kIntData:
+            return value.val_case() == proto::plan::GenericValue::kInt64Val &&
+                   contains(array.int_data().data(), value.int64_val());
+        case proto::schema::ScalarField::kLongData:
+            return value.val_case() == proto::plan::GenericValue::kInt64Val &&
+                   contains(array.long_data().data(), value.int64_val());
+        case proto::schema::ScalarField::kFloatData:
+            return value.val_case() == proto::plan::GenericValue::kFloatVal &&
+                   contains(array.float_data().data(), static_cast<float>(value.float_val()));
+        case proto::schema::ScalarField::kDoubleData:
+            return value.val_case() == proto::plan::GenericValue::kFloatVal &&
+                   contains(array.double_data().data(), value.float_val());
+        case proto::schema::ScalarField::kStringData:
+            return value.val_case() == proto::plan::GenericValue::kStringVal &&
+                   contains(array.string_data().data(), value.string_val());
+        default:
+            return false;
+    }
+}
+
+// json and array fields have no scalar index, so the raw data is always evaluated.
+template <typename ElementFunc>
+auto
+ExecExprVisitor::ExecBinaryFieldVisitorImpl(FieldId field_id, ElementFunc element_func) -> BitsetType {
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    AssertInfo(segment_.num_chunk_data(field_id) >= num_chunk, "[ExecExprVisitor]Raw data of binary field not loaded");
+    std::deque<BitsetType> results;
+    for (auto chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto this_size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        BitsetType result(this_size);
+        auto chunk = segment_.chunk_data<std::string>(field_id, chunk_id);
+        const std::string* data = chunk.data();
+        for (int index = 0; index < this_size; ++index) {
+            result[index] = element_func(data[index]);
+        }
+        results.emplace_back(std::move(result));
+    }
+    auto final_result = Assemble(results);
+    AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Final result size not equal to row count");
+    return final_result;
+}
+
+// rows without the nested path or with an incomparable value never match.
+template <typename ElementFunc>
+auto
+ExecExprVisitor::ExecJSONVisitorImpl(FieldId field_id,
+                                     const std::vector<std::string>& nested_path,
+                                     ElementFunc element_func) -> BitsetType {
+    return ExecBinaryFieldVisitorImpl(field_id, [&](const std::string& raw) {
+        auto document = json::parse(raw, nullptr, false);
+        if (document.is_discarded()) {
+            return false;
+        }
+        auto value = FindJSONValue(document, nested_path);
+        return value != nullptr && element_func(*value);
+    });
+}
+
+template <typename ElementFunc>
+auto
+ExecExprVisitor::ExecArrayVisitorImpl(FieldId field_id, ElementFunc element_func) -> BitsetType {
+    return ExecBinaryFieldVisitorImpl(field_id, [&](const std::string& raw) {
+        proto::schema::ScalarField array;
+        if (!array.ParseFromString(raw)) {
+            return false;
+        }
+        return element_func(array);
+    });
+}
+
+// the rows are null only if the field is nullable and the validity of them is false.
+auto
+ExecExprVisitor::ExecNullVisitorImpl(FieldId field_id) -> BitsetType {
+    auto size_per_chunk = segment_.size_per_chunk();
+    auto num_chunk = upper_div(row_count_, size_per_chunk);
+    std::deque<BitsetType> results;
+    for (auto chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto this_size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+        BitsetType result(this_size);
+        auto valid_data = segment_.chunk_valid_data(field_id, chunk_id);
+        if (valid_data != nullptr) {
+            for (int index = 0; index < this_size; ++index) {
+                result[index] = !valid_data[index];
+            }
+        }
+        results.emplace_back(std::move(result));
+    }
+    auto final_result = Assemble(results);
+    AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Final result size not equal to row count");
+    return final_result;
+}
+
+auto
+ExecExprVisitor::ExecUnaryRangeVisitorDispatcherJSON(UnaryRangeExpr& expr_raw) -> BitsetType {
+    auto& expr = static_cast<UnaryRangeExprImpl<proto::plan::GenericValue>&>(expr_raw);
+    auto op = expr.op_type_;
+    const auto& val = expr.value_;
+    if (op == OpType::PrefixMatch) {
+        AssertInfo(val.val_case() == proto::plan::GenericValue::kStringVal, "prefix of json value should be string");
+        const auto& prefix = val.string_val();
+        auto elem_func = [&prefix](const json& x) {
+            return x.is_string() && PrefixMatch(x.get_ref<const std::string&>(), prefix);
+        };
+        return ExecJSONVisitorImpl(expr.field_id_, expr.nested_path_, elem_func);
+    }
+
+    auto elem_func = [op, &val](const json& x) {
+        auto cmp = CompareJSONValue(x, val);
+        if (!cmp.has_value()) {
+            return false;
+        }
+        switch (op) {
+            case OpType::Equal:
+                return cmp.value() == 0;
+            case OpType::NotEqual:
+                return cmp.value() != 0;
+            case OpType::GreaterEqual:
+                return cmp.value() >= 0;
+            case OpType::GreaterThan:
+                return cmp.value() > 0;
+            case OpType::LessEqual:
+                return cmp.value() <= 0;
+            case OpType::LessThan:
+                return cmp.value() < 0;
+            default:
+                PanicInfo("unsupported range node");
+        }
+    };
+    return ExecJSONVisitorImpl(expr.field_id_, expr.nested_path_, elem_func);
+}
+
+auto
+ExecExprVisitor::ExecBinaryRangeVisitorDispatcherJSON(BinaryRangeExpr& expr_raw) -> BitsetType {
+    auto& expr = static_cast<BinaryRangeExprImpl<proto::plan::GenericValue>&>(expr_raw);
+    bool lower_inclusive = expr.lower_inclusive_;
+    bool upper_inclusive = expr.upper_inclusive_;
+    const auto& val1 = expr.lower_value_;
+    const auto& val2 = expr.upper_value_;
+
+    auto elem_func = [&](const json& x) {
+        auto lower = CompareJSONValue(x, val1);
+        auto upper = CompareJSONValue(x, val2);
+        if (!lower.has_value() || !upper.has_value()) {
+            return false;
+        }
+        return (lower_inclusive ? lower.value() >= 0 : lower.value() > 0) &&
+               (upper_inclusive ? upper.value() <= 0 : upper.value() < 0);
+    };
+    return ExecJSONVisitorImpl(expr.field_id_, expr.nested_path_, elem_func);
+}
+
+auto
+ExecExprVisitor::ExecTermVisitorImplJSON(TermExpr& expr_raw) -> BitsetType {
+    auto& expr = static_cast<TermExprImpl<proto::plan::GenericValue>&>(expr_raw);
+    const auto& terms = expr.terms_;
+    auto elem_func = [&terms](const json& x) {
+        return std::any_of(terms.begin(), terms.end(), [&x](const proto::plan::GenericValue& term) {
+            auto cmp = CompareJSONValue(x, term);
+            return cmp.has_value() && cmp.value() == 0;
+        });
+    };
+    return ExecJSONVisitorImpl(expr.field_id_, expr.nested_path_, elem_func);
+}
+
+auto
+ExecExprVisitor::ExecArrayLengthVisitor(BinaryArithOpEvalRangeExpr& expr_raw) -> BitsetType {
+    auto& expr = static_cast<BinaryArithOpEvalRangeExprImpl<int64_t>&>(expr_raw);
+    AssertInfo(expr.arith_op_ == ArithOpType::ArrayLength, "[ExecExprVisitor]Array field only supports array_length");
+    auto op = expr.op_type_;
+    auto val = expr.value_;
+    auto elem_func = [op, val](const proto::schema::ScalarField& array) {
+        auto length = GetArrayLength(array);
+        switch (op) {
+            case OpType::Equal:
+                return length == val;
+            case OpType::NotEqual:
+                return length != val;
+            case OpType::GreaterEqual:
+                return length >= val;
+            case OpType::GreaterThan:
+                return length > val;
+            case OpType::LessEqual:
+                return length <= val;
+            case OpType::LessThan:
+                return length < val;
+            default:
+                PanicInfo("unsupported range node");
+        }
+    };
+    return ExecArrayVisitorImpl(expr.field_id_, elem_func);
+}
+
 void
 ExecExprVisitor::visit(UnaryRangeExpr& expr) {
     auto& field_meta = segment_.get_schema()[expr.field_id_];
@@ -475,6 +791,10 @@ ExecExprVisitor::visit(UnaryRangeExpr& expr) {
             res = ExecUnaryRangeVisitorDispatcher<std::string>(expr);
             break;
         }
+        case DataType::JSON: {
+            res = ExecUnaryRangeVisitorDispatcherJSON(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
@@ -513,6 +833,10 @@ ExecExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
             res = ExecBinaryArithOpEvalRangeVisitorDispatcher<double>(expr);
             break;
         }
+        case DataType::ARRAY: {
+            res = ExecArrayLengthVisitor(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
@@ -559,6 +883,10 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
             res = ExecBinaryRangeVisitorDispatcher<std::string>(expr);
             break;
         }
+        case DataType::JSON: {
+            res = ExecBinaryRangeVisitorDispatcherJSON(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
@@ -897,10 +1225,85 @@ ExecExprVisitor::visit(TermExpr& expr) {
             res = ExecTermVisitorImpl<std::string>(expr);
             break;
         }
+        case DataType::JSON: {
+            res = ExecTermVisitorImplJSON(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
 }
+
+void
+ExecExprVisitor::visit(ArrayContainsExpr& expr) {
+    using OpType = ArrayContainsExpr::OpType;
+    auto& field_meta = segment_.get_schema()[expr.field_id_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    AssertInfo(datatype_is_array(expr.data_type_), "[ExecExprVisitor]array_contains only supports array field");
+    const auto& elements = expr.elements_;
+    auto contains = [&elements](const proto::schema::ScalarField& array) {
+        return [&elements, &array](const proto::plan::GenericValue& element) {
+            return ArrayContainsValue(array, element);
+        };
+    };
+    BitsetType res;
+    switch (expr.op_type_) {
+        case OpType::Contains:
+        case OpType::ContainsAny: {
+            auto elem_func = [&](const proto::schema::ScalarField& array) {
+                return std::any_of(elements.begin(), elements.end(), contains(array));
+            };
+            res = ExecArrayVisitorImpl(expr.field_id_, elem_func);
+            break;
+        }
+        case OpType::ContainsAll: {
+            auto elem_func = [&](const proto::schema::ScalarField& array) {
+                return std::all_of(elements.begin(), elements.end(), contains(array));
+            };
+            res = ExecArrayVisitorImpl(expr.field_id_, elem_func);
+            break;
+        }
+        default:
+            PanicInfo("Invalid Array Op");
+    }
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    bitset_opt_ = std::move(res);
+}
+
+void
+ExecExprVisitor::visit(NullExpr& expr) {
+    using OpType = NullExpr::OpType;
+    auto& field_meta = segment_.get_schema()[expr.field_id_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    auto res = ExecNullVisitorImpl(expr.field_id_);
+    if (!expr.nested_path_.empty()) {
+        AssertInfo(datatype_is_json(expr.data_type_), "[ExecExprVisitor]nested path only supports json field");
+        const auto& nested_path = expr.nested_path_;
+        res |= ExecBinaryFieldVisitorImpl(expr.field_id_, [&nested_path](const std::string& raw) {
+            auto document = json::parse(raw, nullptr, false);
+            if (document.is_discarded()) {
+                return true;
+            }
+            auto value = FindJSONValue(document, nested_path);
+            return value == nullptr || value->is_null();
+        });
+    }
+    switch (expr.op_type_) {
+        case OpType::IsNull: {
+            break;
+        }
+        case OpType::IsNotNull: {
+            res.flip();
+            break;
+        }
+        default:
+            PanicInfo("Invalid Null Op");
+    }
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    bitset_opt_ = std::move(res);
+}
 }  // namespace milvus::query
@@ -9,6 +9,7 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <algorithm>
 #include <utility>
 
 #include "query/PlanImpl.h"
@@ -66,6 +67,74 @@ empty_search_result(int64_t num_queries, SearchInfo& search_info) {
     return final_result;
 }
 
+// range search keeps at most topk results for each query whose distances lie in the range,
+// for L2, range_filter <= distance < radius; for IP, radius < distance <= range_filter.
+// the nearest topk results may all lie on the near side of range_filter, so the search is repeated
+// with a doubled topk until topk results are found in range, the far side of radius is reached,
+// or the segment is exhausted. the invalid results are filtered out in reduce,
+// so the result count of each query may differ.
+static void
+range_search(const segcore::SegmentInternalInterface& segment,
+             const SearchInfo& search_info,
+             const void* query_data,
+             int64_t num_queries,
+             Timestamp timestamp,
+             const BitsetView& bitset,
+             int64_t active_count,
+             SearchResult& search_result) {
+    auto is_ip = PositivelyRelated(search_info.metric_type_);
+    auto radius = search_info.radius_;
+    auto range_filter = search_info.range_filter_;
+    auto in_range = [&](float dis) {
+        return is_ip ? (dis > radius && dis <= range_filter) : (dis >= range_filter && dis < radius);
+    };
+    auto beyond_radius = [&](float dis) { return is_ip ? dis <= radius : dis >= radius; };
+
+    auto topk = search_info.topk_;
+    auto info = search_info;
+    SearchResult result;
+    while (true) {
+        result = SearchResult();
+        segment.vector_search(info, query_data, num_queries, timestamp, bitset, result);
+        if (info.topk_ >= active_count) {
+            break;
+        }
+        auto need_more = false;
+        for (int64_t i = 0; i < num_queries && !need_more; ++i) {
+            auto offset = i * info.topk_;
+            auto count = std::count_if(result.distances_.begin() + offset,
+                                       result.distances_.begin() + offset + info.topk_, in_range);
+            auto last = offset + info.topk_ - 1;
+            need_more = count < topk && result.seg_offsets_[last] != INVALID_SEG_OFFSET &&
+                        !beyond_radius(result.distances_[last]);
+        }
+        if (!need_more) {
+            break;
+        }
+        info.topk_ = std::min(info.topk_ * 2, active_count);
+    }
+
+    SubSearchResult final_result(num_queries, topk, search_info.metric_type_, search_info.round_decimal_);
+    auto& seg_offsets = final_result.mutable_seg_offsets();
+    auto& distances = final_result.mutable_distances();
+    for (int64_t i = 0; i < num_queries; ++i) {
+        int64_t count = 0;
+        for (int64_t j = 0; j < info.topk_ && count < topk; ++j) {
+            auto index = i * info.topk_ + j;
+            if (result.seg_offsets_[index] == INVALID_SEG_OFFSET || !in_range(result.distances_[index])) {
+                continue;
+            }
+            seg_offsets[i * topk + count] = result.seg_offsets_[index];
+            distances[i * topk + count] = result.distances_[index];
+            ++count;
+        }
+    }
+    search_result.total_nq_ = num_queries;
+    search_result.unity_topK_ = topk;
+    search_result.seg_offsets_ = std::move(seg_offsets);
+    search_result.distances_ = std::move(distances);
+}
+
 template <typename VectorType>
 void
 ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
@@ -105,7 +174,12 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         return;
     }
     BitsetView final_view = *bitset_holder;
-    segment->vector_search(node.search_info_, src_data, num_queries, timestamp_, final_view, search_result);
+    if (node.search_info_.is_range_search_) {
+        range_search(*segment, node.search_info_, src_data, num_queries, timestamp_, final_view, active_count,
+                     search_result);
+    } else {
+        segment->vector_search(node.search_info_, src_data, num_queries, timestamp_, final_view, search_result);
+    }
 
     search_result_opt_ = std::move(search_result);
 }
@@ -64,4 +64,14 @@ ExtractInfoExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
     plan_info_.add_involved_field(expr.field_id_);
 }
 
+void
+ExtractInfoExprVisitor::visit(ArrayContainsExpr& expr) {
+    plan_info_.add_involved_field(expr.field_id_);
+}
+
+void
+ExtractInfoExprVisitor::visit(NullExpr& expr) {
+    plan_info_.add_involved_field(expr.field_id_);
+}
+
 }  // namespace milvus::query
@@ -297,4 +297,36 @@ ShowExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
     }
 }
 
+
+void
+ShowExprVisitor::visit(ArrayContainsExpr& expr) {
+    using proto::plan::ArrayContainsExpr_ArrayOp;
+    using proto::plan::ArrayContainsExpr_ArrayOp_Name;
+    AssertInfo(!json_opt_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    std::vector<std::string> elements;
+    for (const auto& element : expr.elements_) {
+        elements.push_back(element.ShortDebugString());
+    }
+    Json res{{"expr_type", "ArrayContains"},
+             {"field_id", expr.field_id_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"op", ArrayContainsExpr_ArrayOp_Name(static_cast<ArrayContainsExpr_ArrayOp>(expr.op_type_))},
+             {"elements", elements}};
+    json_opt_ = res;
+}
+
+void
+ShowExprVisitor::visit(NullExpr& expr) {
+    using proto::plan::NullExpr_NullOp;
+    using proto::plan::NullExpr_NullOp_Name;
+    AssertInfo(!json_opt_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    Json res{{"expr_type", "Null"},
+             {"field_id", expr.field_id_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"nested_path", expr.nested_path_},
+             {"op", NullExpr_NullOp_Name(static_cast<NullExpr_NullOp>(expr.op_type_))}};
+    json_opt_ = res;
+}
 }  // namespace milvus::query
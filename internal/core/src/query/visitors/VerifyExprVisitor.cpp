@@ -47,4 +47,14 @@ VerifyExprVisitor::visit(CompareExpr& expr) {
     // TODO
 }
 
+void
+VerifyExprVisitor::visit(ArrayContainsExpr& expr) {
+    // TODO
+}
+
+void
+VerifyExprVisitor::visit(NullExpr& expr) {
+    // TODO
+}
+
 }  // namespace milvus::query
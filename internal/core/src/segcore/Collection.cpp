@@ -45,4 +45,11 @@ Collection::parse() {
     schema_ = Schema::ParseFrom(collection_schema);
 }
 
+void
+Collection::update_schema(const std::string& collection_proto) {
+    old_schemas_.push_back(schema_);
+    schema_proto_ = collection_proto;
+    parse();
+}
+
 }  // namespace milvus::segcore
@@ -13,6 +13,7 @@
 
 #include <memory>
 #include <string>
+#include <vector>
 
 #include "common/Schema.h"
 
@@ -25,6 +26,10 @@ class Collection {
     void
     parse();
 
+    // replace the schema with the one altered, the plans and segments created before keep referring to the old one
+    void
+    update_schema(const std::string& collection_proto);
+
  public:
     SchemaPtr&
     get_schema() {
@@ -40,6 +45,7 @@ class Collection {
     std::string collection_name_;
     std::string schema_proto_;
     SchemaPtr schema_;
+    std::vector<SchemaPtr> old_schemas_;
 };
 
 using CollectionPtr = std::unique_ptr<Collection>;
@@ -62,6 +62,13 @@ VectorBase::set_data_raw(ssize_t element_offset,
             std::vector<std::string> data_raw(begin, end);
             return set_data_raw(element_offset, data_raw.data(), element_count);
         }
+        case DataType::JSON:
+        case DataType::ARRAY: {
+            auto begin = data->scalars().bytes_data().data().begin();
+            auto end = data->scalars().bytes_data().data().end();
+            std::vector<std::string> data_raw(begin, end);
+            return set_data_raw(element_offset, data_raw.data(), element_count);
+        }
         default: {
             PanicInfo("unsupported");
         }
@@ -114,6 +121,13 @@ VectorBase::fill_chunk_data(ssize_t element_count, const DataArray* data, const
             std::vector<std::string> data_raw(begin, end);
             return fill_chunk_data(data_raw.data(), element_count);
         }
+        case DataType::JSON:
+        case DataType::ARRAY: {
+            auto begin = data->scalars().bytes_data().data().begin();
+            auto end = data->scalars().bytes_data().data().end();
+            std::vector<std::string> data_raw(begin, end);
+            return fill_chunk_data(data_raw.data(), element_count);
+        }
         default: {
             PanicInfo("unsupported");
         }
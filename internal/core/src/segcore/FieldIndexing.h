@@ -132,6 +132,11 @@ class IndexingRecord {
                 }
             }
 
+            // json documents and arrays have no scalar index
+            if (datatype_is_binary(field_meta.get_data_type())) {
+                continue;
+            }
+
             field_indexings_.try_emplace(field_id, CreateIndex(field_meta, segcore_config_));
         }
         assert(offset_id == schema_.size());
@@ -208,10 +208,19 @@ struct InsertRecord {
                     this->append_field_data<std::string>(field_id, size_per_chunk);
                     break;
                 }
+                case DataType::JSON:
+                case DataType::ARRAY: {
+                    // json documents and serialized arrays are kept verbatim, they are parsed when the expressions are evaluated
+                    this->append_field_data<std::string>(field_id, size_per_chunk);
+                    break;
+                }
                 default: {
                     PanicInfo("unsupported");
                 }
             }
+            if (field_meta.is_nullable()) {
+                valid_data_.emplace(field_id, std::make_unique<ConcurrentVector<bool>>(size_per_chunk));
+            }
         }
     }
 
@@ -306,11 +315,27 @@ struct InsertRecord {
     void
     drop_field_data(FieldId field_id) {
         fields_data_.erase(field_id);
+        valid_data_.erase(field_id);
+    }
+
+    // get the validity of the rows of the field, nullptr if the field is not nullable
+    const ConcurrentVector<bool>*
+    get_valid_data(FieldId field_id) const {
+        auto iter = valid_data_.find(field_id);
+        return iter == valid_data_.end() ? nullptr : iter->second.get();
+    }
+
+    ConcurrentVector<bool>*
+    get_valid_data(FieldId field_id) {
+        auto iter = valid_data_.find(field_id);
+        return iter == valid_data_.end() ? nullptr : iter->second.get();
     }
 
  private:
     //    std::vector<std::unique_ptr<VectorBase>> fields_data_;
     std::unordered_map<FieldId, std::unique_ptr<VectorBase>> fields_data_;
+    // validity of the rows of the nullable fields, false means the row is null
+    std::unordered_map<FieldId, std::unique_ptr<ConcurrentVector<bool>>> valid_data_;
     mutable std::shared_mutex shared_mutex_;
 };
 
@@ -81,6 +81,26 @@ SegmentGrowingImpl::Insert(int64_t reserved_offset,
                                                                    &insert_data->fields_data(data_offset), field_meta);
     }
 
+    // step 3.1: fill validity of the nullable fields, the rows are valid if the field has no valid data
+    std::unordered_map<FieldId, const proto::segcore::FieldValidData*> field_id_to_valid_data;
+    for (auto& valid_data : insert_data->valid_data()) {
+        field_id_to_valid_data.emplace(FieldId(valid_data.field_id()), &valid_data);
+    }
+    for (auto& [field_id, field_meta] : schema_->get_fields()) {
+        auto valid_vec = insert_record_.get_valid_data(field_id);
+        if (valid_vec == nullptr) {
+            continue;
+        }
+        auto iter = field_id_to_valid_data.find(field_id);
+        if (iter != field_id_to_valid_data.end()) {
+            AssertInfo(iter->second->valid_size() == size, "valid data count not equal to insert size");
+            valid_vec->set_data_raw(reserved_offset, iter->second->valid().data(), size);
+        } else {
+            FixedVector<bool> all_valid(size, true);
+            valid_vec->set_data_raw(reserved_offset, all_valid.data(), size);
+        }
+    }
+
     // step 4: set pks to offset
     auto field_id = schema_->get_primary_field_id().value_or(FieldId(-1));
     AssertInfo(field_id.get() != INVALID_FIELD_ID, "Primary key is -1");
@@ -166,6 +186,15 @@ SegmentGrowingImpl::chunk_data_impl(FieldId field_id, int64_t chunk_id) const {
     return vec->get_span_base(chunk_id);
 }
 
+const bool*
+SegmentGrowingImpl::chunk_valid_data(FieldId field_id, int64_t chunk_id) const {
+    auto vec = get_insert_record().get_valid_data(field_id);
+    if (vec == nullptr) {
+        return nullptr;
+    }
+    return vec->get_chunk(chunk_id).data();
+}
+
 int64_t
 SegmentGrowingImpl::num_chunk() const {
     auto size = get_insert_record().ack_responder_.GetAck();
@@ -242,7 +271,9 @@ SegmentGrowingImpl::bulk_subscript(FieldId field_id, const int64_t* seg_offsets,
             bulk_subscript_impl<double>(*vec_ptr, seg_offsets, count, output.data());
             return CreateScalarDataArrayFrom(output.data(), count, field_meta);
         }
-        case DataType::VARCHAR: {
+        case DataType::VARCHAR:
+        case DataType::JSON:
+        case DataType::ARRAY: {
             FixedVector<std::string> output(count);
             bulk_subscript_impl<std::string>(*vec_ptr, seg_offsets, count, output.data());
             return CreateScalarDataArrayFrom(output.data(), count, field_meta);
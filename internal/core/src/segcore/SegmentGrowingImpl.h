@@ -204,6 +204,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
         return true;
     }
 
+    const bool*
+    chunk_valid_data(FieldId field_id, int64_t chunk_id) const override;
+
  protected:
     int64_t
     num_chunk() const override;
@@ -149,6 +149,10 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual int64_t
     num_chunk_data(FieldId field_id) const = 0;
 
+    // validity of the rows in the chunk of the nullable field, nullptr means all the rows are valid
+    virtual const bool*
+    chunk_valid_data(FieldId field_id, int64_t chunk_id) const = 0;
+
     virtual void
     mask_with_timestamps(BitsetType& bitset_chunk, Timestamp timestamp) const = 0;
 
@@ -202,6 +202,18 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
         field_data->fill_chunk_data(size, info.field_data, field_meta);
         AssertInfo(field_data->num_chunk() == 1, "num chunk not equal to 1 for sealed segment");
 
+        // the rows of the nullable field are valid if no valid data is given
+        auto valid_vec = insert_record_.get_valid_data(field_id);
+        if (valid_vec != nullptr) {
+            AssertInfo(valid_vec->empty(), "valid data already exists");
+            if (info.valid_data != nullptr) {
+                valid_vec->fill_chunk_data(info.valid_data, size);
+            } else {
+                FixedVector<bool> all_valid(size, true);
+                valid_vec->fill_chunk_data(all_valid.data(), size);
+            }
+        }
+
         // set pks to offset
         if (schema_->get_primary_field_id() == field_id) {
             AssertInfo(field_id.get() != -1, "Primary key is -1");
@@ -280,6 +292,17 @@ SegmentSealedImpl::chunk_data_impl(FieldId field_id, int64_t chunk_id) const {
     return field_data->get_span_base(0);
 }
 
+const bool*
+SegmentSealedImpl::chunk_valid_data(FieldId field_id, int64_t chunk_id) const {
+    std::shared_lock lck(mutex_);
+    auto valid_vec = insert_record_.get_valid_data(field_id);
+    // the validity is unknown if the field is loaded as index only, regard all the rows as valid
+    if (valid_vec == nullptr || valid_vec->num_chunk() == 0) {
+        return nullptr;
+    }
+    return valid_vec->get_chunk(0).data();
+}
+
 const index::IndexBase*
 SegmentSealedImpl::chunk_index_impl(FieldId field_id, int64_t chunk_id) const {
     AssertInfo(scalar_indexings_.find(field_id) != scalar_indexings_.end(),
@@ -544,7 +567,9 @@ SegmentSealedImpl::bulk_subscript(FieldId field_id, const int64_t* seg_offsets,
             bulk_subscript_impl<double>(src_vec, seg_offsets, count, output.data());
             return CreateScalarDataArrayFrom(output.data(), count, field_meta);
         }
-        case DataType::VARCHAR: {
+        case DataType::VARCHAR:
+        case DataType::JSON:
+        case DataType::ARRAY: {
             FixedVector<std::string> output(count);
             bulk_subscript_impl<std::string>(src_vec, seg_offsets, count, output.data());
             return CreateScalarDataArrayFrom(output.data(), count, field_meta);
@@ -77,6 +77,9 @@ class SegmentSealedImpl : public SegmentSealed {
     int64_t
     num_chunk_data(FieldId field_id) const override;
 
+    const bool*
+    chunk_valid_data(FieldId field_id, int64_t chunk_id) const override;
+
     int64_t
     num_chunk() const override;
 
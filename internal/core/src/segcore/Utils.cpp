@@ -119,6 +119,13 @@ CreateScalarDataArray(int64_t count, const FieldMeta& field_meta) {
             for (auto i = 0; i < count; i++) *(obj->mutable_data()->Add()) = std::string();
             break;
         }
+        case DataType::JSON:
+        case DataType::ARRAY: {
+            auto obj = scalar_array->mutable_bytes_data();
+            obj->mutable_data()->Reserve(count);
+            for (auto i = 0; i < count; i++) *(obj->mutable_data()->Add()) = std::string();
+            break;
+        }
         default: {
             PanicInfo("unsupported datatype");
         }
@@ -215,6 +222,13 @@ CreateScalarDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta&
             for (auto i = 0; i < count; i++) *(obj->mutable_data()->Add()) = data[i];
             break;
         }
+        case DataType::JSON:
+        case DataType::ARRAY: {
+            auto data = reinterpret_cast<const std::string*>(data_raw);
+            auto obj = scalar_array->mutable_bytes_data();
+            for (auto i = 0; i < count; i++) *(obj->mutable_data()->Add()) = data[i];
+            break;
+        }
         default: {
             PanicInfo("unsupported datatype");
         }
@@ -339,6 +353,13 @@ MergeDataArray(std::vector<std::pair<milvus::SearchResult*, int64_t>>& result_of
                 *(obj->mutable_data()->Add()) = data.data(src_offset);
                 continue;
             }
+            case DataType::JSON:
+            case DataType::ARRAY: {
+                auto data = src_field_data->scalars().bytes_data();
+                auto obj = scalar_array->mutable_bytes_data();
+                *(obj->mutable_data()->Add()) = data.data(src_offset);
+                continue;
+            }
             default: {
                 PanicInfo("unsupported datatype");
             }
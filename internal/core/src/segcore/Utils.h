@@ -106,8 +106,9 @@ get_deleted_bitmap(int64_t del_barrier,
             AssertInfo(insert_row_offset < insert_barrier, "Timestamp offset is larger than insert barrier");
 
             // insert after delete with same pk, delete will not task effect on this insert record
-            // and reset bitmap to 0
-            if (insert_record.timestamps_[insert_row_offset] > delete_timestamp) {
+            // and reset bitmap to 0. an upsert writes the delete and the insert with the same
+            // timestamp, the inserted record replaces the deleted one in this case.
+            if (insert_record.timestamps_[insert_row_offset] >= delete_timestamp) {
                 bitmap->reset(insert_row_offset);
                 continue;
             }
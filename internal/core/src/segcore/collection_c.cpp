@@ -35,3 +35,9 @@ GetCollectionName(CCollection collection) {
     auto col = (milvus::segcore::Collection*)collection;
     return strdup(col->get_collection_name().data());
 }
+
+void
+UpdateSchema(CCollection collection, const char* schema_proto_blob) {
+    auto col = (milvus::segcore::Collection*)collection;
+    col->update_schema(std::string(schema_proto_blob));
+}
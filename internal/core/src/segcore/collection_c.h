@@ -26,6 +26,9 @@ DeleteCollection(CCollection collection);
 const char*
 GetCollectionName(CCollection collection);
 
+void
+UpdateSchema(CCollection collection, const char* schema_proto_blob);
+
 #ifdef __cplusplus
 }
 #endif
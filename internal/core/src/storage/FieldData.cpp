@@ -61,10 +61,12 @@ FieldData::get_bool_payload(int idx) const {
 void
 FieldData::get_one_string_payload(int idx, char** cstr, int* str_size) const {
     AssertInfo(array_ != nullptr, "null arrow array");
-    AssertInfo(array_->type()->id() == arrow::Type::type::STRING, "inconsistent data type");
-    auto array = std::dynamic_pointer_cast<arrow::StringArray>(array_);
+    AssertInfo(array_->type()->id() == arrow::Type::type::STRING || array_->type()->id() == arrow::Type::type::BINARY,
+               "inconsistent data type");
+    // json documents and arrays are stored as binary, StringArray is a BinaryArray as well
+    auto array = std::dynamic_pointer_cast<arrow::BinaryArray>(array_);
     AssertInfo(idx < array->length(), "index out of range array.length");
-    arrow::StringArray::offset_type length;
+    arrow::BinaryArray::offset_type length;
     *cstr = (char*)array->GetValue(idx, &length);
     *str_size = length;
 }
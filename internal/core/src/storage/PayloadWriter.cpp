@@ -18,6 +18,7 @@
 #include "exceptions/EasyAssert.h"
 #include "common/FieldMeta.h"
 #include "storage/Util.h"
+#include <arrow/util/bitmap_ops.h>
 
 namespace milvus::storage {
 
@@ -52,6 +53,21 @@ PayloadWriter::add_one_string_payload(const char* str, int str_size) {
     rows_.fetch_add(1);
 }
 
+void
+PayloadWriter::add_one_binary_payload(const uint8_t* data, int length) {
+    AssertInfo(output_ == nullptr, "payload writer has been finished");
+    AssertInfo(milvus::datatype_is_binary(column_type_), "mismatch data type");
+    AddOneBinaryToArrowBuilder(builder_, data, length);
+    rows_.fetch_add(1);
+}
+
+void
+PayloadWriter::add_valid_data(const bool* valid_data, int length) {
+    AssertInfo(output_ == nullptr, "payload writer has been finished");
+    AssertInfo(!milvus::datatype_is_vector(column_type_), "vector field is not nullable");
+    valid_data_.insert(valid_data_.end(), valid_data, valid_data + length);
+}
+
 void
 PayloadWriter::add_payload(const Payload& raw_data) {
     AssertInfo(output_ == nullptr, "payload writer has been finished");
@@ -72,6 +88,17 @@ PayloadWriter::finish() {
     std::shared_ptr<arrow::Array> array;
     auto ast = builder_->Finish(&array);
     AssertInfo(ast.ok(), ast.ToString());
+    if (!valid_data_.empty()) {
+        // the null rows are recorded in the validity bitmap of the arrow array,
+        // which is written as the definition levels of the parquet column.
+        AssertInfo(valid_data_.size() == rows_, "the size of valid data mismatches the rows of payload");
+        auto bitmap = arrow::internal::BytesToBits(valid_data_);
+        AssertInfo(bitmap.ok(), bitmap.status().ToString());
+        auto data = array->data()->Copy();
+        data->buffers[0] = bitmap.ValueOrDie();
+        data->null_count = arrow::kUnknownNullCount;
+        array = arrow::MakeArray(data);
+    }
 
     auto table = arrow::Table::Make(schema_, {array});
     output_ = std::make_shared<storage::PayloadOutputStream>();
@@ -35,6 +35,12 @@ class PayloadWriter {
     void
     add_one_string_payload(const char* str, int str_size);
 
+    void
+    add_one_binary_payload(const uint8_t* data, int length);
+
+    void
+    add_valid_data(const bool* valid_data, int length);
+
     void
     finish();
 
@@ -60,5 +66,6 @@ class PayloadWriter {
     std::shared_ptr<PayloadOutputStream> output_;
     std::atomic<int> rows_ = 0;
     std::optional<int> dimension_;  // binary vector, float vector
+    std::vector<uint8_t> valid_data_;  // nullable scalar fields, empty if all the rows are valid
 };
 }  // namespace milvus::storage
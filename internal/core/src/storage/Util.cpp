@@ -123,6 +123,19 @@ AddOneStringToArrowBuilder(std::shared_ptr<arrow::ArrayBuilder> builder, const c
     AssertInfo(ast.ok(), "append value to arrow builder failed");
 }
 
+void
+AddOneBinaryToArrowBuilder(std::shared_ptr<arrow::ArrayBuilder> builder, const uint8_t* data, int length) {
+    AssertInfo(builder != nullptr, "empty arrow builder");
+    auto binary_builder = std::dynamic_pointer_cast<arrow::BinaryBuilder>(builder);
+    arrow::Status ast;
+    if (data == nullptr || length < 0) {
+        ast = binary_builder->AppendNull();
+    } else {
+        ast = binary_builder->Append(data, length);
+    }
+    AssertInfo(ast.ok(), "append value to arrow builder failed");
+}
+
 std::shared_ptr<arrow::ArrayBuilder>
 CreateArrowBuilder(DataType data_type) {
     switch (static_cast<DataType>(data_type)) {
@@ -151,6 +164,10 @@ CreateArrowBuilder(DataType data_type) {
         case DataType::STRING: {
             return std::make_shared<arrow::StringBuilder>();
         }
+        case DataType::JSON:
+        case DataType::ARRAY: {
+            return std::make_shared<arrow::BinaryBuilder>();
+        }
         default: {
             PanicInfo("unsupported numeric data type");
         }
@@ -202,6 +219,10 @@ CreateArrowSchema(DataType data_type) {
         case DataType::STRING: {
             return arrow::schema({arrow::field("val", arrow::utf8())});
         }
+        case DataType::JSON:
+        case DataType::ARRAY: {
+            return arrow::schema({arrow::field("val", arrow::binary())});
+        }
         default: {
             PanicInfo("unsupported numeric data type");
         }
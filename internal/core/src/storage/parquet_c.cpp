@@ -113,6 +113,39 @@ AddOneStringToPayload(CPayloadWriter payloadWriter, char* cstr, int str_size) {
     }
 }
 
+extern "C" CStatus
+AddOneJSONToPayload(CPayloadWriter payloadWriter, uint8_t* data, int length) {
+    try {
+        auto p = reinterpret_cast<PayloadWriter*>(payloadWriter);
+        p->add_one_binary_payload(data, length);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
+extern "C" CStatus
+AddOneArrayToPayload(CPayloadWriter payloadWriter, uint8_t* data, int length) {
+    try {
+        auto p = reinterpret_cast<PayloadWriter*>(payloadWriter);
+        p->add_one_binary_payload(data, length);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
+extern "C" CStatus
+AddValidDataToPayload(CPayloadWriter payloadWriter, bool* valid_data, int length) {
+    try {
+        auto p = reinterpret_cast<PayloadWriter*>(payloadWriter);
+        p->add_valid_data(valid_data, length);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 extern "C" CStatus
 AddBinaryVectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int dimension, int length) {
     try {
@@ -192,6 +225,8 @@ NewPayloadReader(int columnType, uint8_t* buffer, int64_t buf_size) {
         case milvus::DataType::DOUBLE:
         case milvus::DataType::STRING:
         case milvus::DataType::VARCHAR:
+        case milvus::DataType::JSON:
+        case milvus::DataType::ARRAY:
         case milvus::DataType::VECTOR_BINARY:
         case milvus::DataType::VECTOR_FLOAT: {
             break;
@@ -225,6 +225,16 @@ TEST(CApiTest, GetCollectionNameTest) {
     DeleteCollection(collection);
 }
 
+TEST(CApiTest, UpdateSchemaTest) {
+    auto collection = NewCollection(get_default_schema_config());
+    auto old_segment = NewSegment(collection, Growing, -1);
+    UpdateSchema(collection, get_default_schema_config());
+    auto segment = NewSegment(collection, Growing, -1);
+    DeleteSegment(old_segment);
+    DeleteSegment(segment);
+    DeleteCollection(collection);
+}
+
 TEST(CApiTest, SegmentTest) {
     auto collection = NewCollection(get_default_schema_config());
     auto segment = NewSegment(collection, Growing, -1);
@@ -409,6 +409,232 @@ TEST(Expr, TestTerm) {
     }
 }
 
+TEST(Expr, TestJSON) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, knowhere::metric::L2);
+    auto i64_fid = schema->AddDebugField("id", DataType::INT64);
+    auto json_fid = schema->AddDebugField("meta", DataType::JSON);
+    schema->set_primary_field_id(i64_fid);
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<int> age_col;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto new_json_col = raw_data.get_col<std::string>(json_fid);
+        for (auto& doc : new_json_col) {
+            age_col.push_back(nlohmann::json::parse(doc)["age"].get<int>());
+        }
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto int_val = [](int64_t v) {
+        proto::plan::GenericValue value;
+        value.set_int64_val(v);
+        return value;
+    };
+    auto float_val = [](double v) {
+        proto::plan::GenericValue value;
+        value.set_float_val(v);
+        return value;
+    };
+    auto string_val = [](const std::string& v) {
+        proto::plan::GenericValue value;
+        value.set_string_val(v);
+        return value;
+    };
+    using Path = std::vector<std::string>;
+    using Value = proto::plan::GenericValue;
+
+    std::vector<std::tuple<ExprPtr, std::function<bool(int)>>> testcases;
+    testcases.emplace_back(std::make_unique<UnaryRangeExprImpl<Value>>(json_fid, DataType::JSON, OpType::LessThan,
+                                                                       int_val(500), Path{"age"}),
+                           [](int v) { return v < 500; });
+    testcases.emplace_back(std::make_unique<UnaryRangeExprImpl<Value>>(json_fid, DataType::JSON, OpType::Equal,
+                                                                       float_val(300), Path{"tags", "0"}),
+                           [](int v) { return v == 300; });
+    testcases.emplace_back(std::make_unique<UnaryRangeExprImpl<Value>>(json_fid, DataType::JSON, OpType::PrefixMatch,
+                                                                       string_val("x"), Path{"tags", "1"}),
+                           [](int v) { return true; });
+    // incomparable types and missing keys never match
+    testcases.emplace_back(std::make_unique<UnaryRangeExprImpl<Value>>(json_fid, DataType::JSON, OpType::NotEqual,
+                                                                       string_val("1"), Path{"age"}),
+                           [](int v) { return false; });
+    testcases.emplace_back(std::make_unique<UnaryRangeExprImpl<Value>>(json_fid, DataType::JSON, OpType::NotEqual,
+                                                                       int_val(1), Path{"tags", "2"}),
+                           [](int v) { return false; });
+    testcases.emplace_back(std::make_unique<TermExprImpl<Value>>(json_fid, DataType::JSON,
+                                                                 std::vector<Value>{int_val(1), float_val(2)},
+                                                                 Path{"age"}),
+                           [](int v) { return v == 1 || v == 2; });
+    testcases.emplace_back(std::make_unique<BinaryRangeExprImpl<Value>>(json_fid, DataType::JSON, false, true,
+                                                                        float_val(100.5), int_val(200), Path{"age"}),
+                           [](int v) { return 100.5 < v && v <= 200; });
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto& [expr, ref_func] : testcases) {
+        auto final = visitor.call_child(*expr);
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ans = final[i];
+
+            auto val = age_col[i];
+            auto ref = ref_func(val);
+            ASSERT_EQ(ans, ref) << "@" << i << "!!" << val;
+        }
+    }
+}
+
+TEST(Expr, TestArray) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, knowhere::metric::L2);
+    auto i64_fid = schema->AddDebugField("id", DataType::INT64);
+    auto array_fid = schema->AddDebugField("tags", DataType::ARRAY);
+    schema->set_primary_field_id(i64_fid);
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    std::vector<std::vector<int64_t>> tags_col;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto new_array_col = raw_data.get_col<std::string>(array_fid);
+        for (auto& raw : new_array_col) {
+            proto::schema::ScalarField array;
+            ASSERT_TRUE(array.ParseFromString(raw));
+            tags_col.emplace_back(array.long_data().data().begin(), array.long_data().data().end());
+        }
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto int_vals = [](std::vector<int64_t> vals) {
+        std::vector<proto::plan::GenericValue> values(vals.size());
+        for (size_t i = 0; i < vals.size(); i++) {
+            values[i].set_int64_val(vals[i]);
+        }
+        return values;
+    };
+    auto has = [](const std::vector<int64_t>& tags, int64_t v) {
+        return std::find(tags.begin(), tags.end(), v) != tags.end();
+    };
+    using ArrayOp = ArrayContainsExpr::OpType;
+
+    std::vector<std::tuple<ExprPtr, std::function<bool(const std::vector<int64_t>&)>>> testcases;
+    testcases.emplace_back(
+        std::make_unique<ArrayContainsExpr>(array_fid, DataType::ARRAY, ArrayOp::Contains, int_vals({1})),
+        [&](const std::vector<int64_t>& tags) { return has(tags, 1); });
+    testcases.emplace_back(
+        std::make_unique<ArrayContainsExpr>(array_fid, DataType::ARRAY, ArrayOp::ContainsAll, int_vals({1, 2})),
+        [&](const std::vector<int64_t>& tags) { return has(tags, 1) && has(tags, 2); });
+    testcases.emplace_back(
+        std::make_unique<ArrayContainsExpr>(array_fid, DataType::ARRAY, ArrayOp::ContainsAny, int_vals({1, 2})),
+        [&](const std::vector<int64_t>& tags) { return has(tags, 1) || has(tags, 2); });
+    testcases.emplace_back(std::make_unique<BinaryArithOpEvalRangeExprImpl<int64_t>>(
+                               array_fid, DataType::ARRAY, ArithOpType::ArrayLength, 0, OpType::GreaterEqual, 2),
+                           [](const std::vector<int64_t>& tags) { return tags.size() >= 2; });
+    testcases.emplace_back(std::make_unique<BinaryArithOpEvalRangeExprImpl<int64_t>>(
+                               array_fid, DataType::ARRAY, ArithOpType::ArrayLength, 0, OpType::Equal, 0),
+                           [](const std::vector<int64_t>& tags) { return tags.empty(); });
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto& [expr, ref_func] : testcases) {
+        auto final = visitor.call_child(*expr);
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ans = final[i];
+            auto ref = ref_func(tags_col[i]);
+            ASSERT_EQ(ans, ref) << "@" << i;
+        }
+    }
+}
+
+TEST(Expr, TestNull) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, knowhere::metric::L2);
+    auto i64_fid = schema->AddDebugField("id", DataType::INT64);
+    auto age_fid = schema->AddDebugField("age", DataType::INT64);
+    auto json_fid = schema->AddDebugField("meta", DataType::JSON);
+    schema->set_primary_field_id(i64_fid);
+    schema->set_nullable(age_fid);
+
+    // every third row of age is null
+    auto is_null = [](int64_t offset) { return offset % 3 == 0; };
+    using NullOp = NullExpr::OpType;
+    std::vector<std::tuple<ExprPtr, std::function<bool(int64_t)>>> testcases;
+    testcases.emplace_back(std::make_unique<NullExpr>(age_fid, DataType::INT64, NullOp::IsNull), is_null);
+    testcases.emplace_back(std::make_unique<NullExpr>(age_fid, DataType::INT64, NullOp::IsNotNull),
+                           [&](int64_t offset) { return !is_null(offset); });
+    testcases.emplace_back(
+        std::make_unique<NullExpr>(json_fid, DataType::JSON, NullOp::IsNull, std::vector<std::string>{"age"}),
+        [](int64_t) { return false; });
+    testcases.emplace_back(
+        std::make_unique<NullExpr>(json_fid, DataType::JSON, NullOp::IsNull, std::vector<std::string>{"name"}),
+        [](int64_t) { return true; });
+    testcases.emplace_back(
+        std::make_unique<NullExpr>(json_fid, DataType::JSON, NullOp::IsNotNull, std::vector<std::string>{"name"}),
+        [](int64_t) { return false; });
+
+    auto check = [&](const SegmentInternalInterface& segment, int64_t row_count) {
+        ExecExprVisitor visitor(segment, row_count, MAX_TIMESTAMP);
+        for (auto& [expr, ref_func] : testcases) {
+            auto final = visitor.call_child(*expr);
+            EXPECT_EQ(final.size(), row_count);
+            for (int i = 0; i < row_count; ++i) {
+                ASSERT_EQ(final[i], ref_func(i)) << "@" << i;
+            }
+        }
+    };
+
+    // growing segment gets the validity from insert data
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    int num_iters = 10;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto valid_data = raw_data.raw_->add_valid_data();
+        valid_data->set_field_id(age_fid.get());
+        for (int i = 0; i < N; ++i) {
+            valid_data->add_valid(!is_null(iter * N + i));
+        }
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+    check(*seg, N * num_iters);
+
+    // sealed segment gets the validity from load info
+    auto dataset = DataGen(schema, N);
+    auto sealed_seg = CreateSealedSegment(schema);
+    SealedLoadFieldData(dataset, *sealed_seg, {age_fid.get()});
+    FixedVector<bool> valid(N);
+    for (int i = 0; i < N; ++i) {
+        valid[i] = !is_null(i);
+    }
+    for (auto& field_data : dataset.raw_->fields_data()) {
+        if (field_data.field_id() == age_fid.get()) {
+            LoadFieldDataInfo info;
+            info.field_id = age_fid.get();
+            info.row_count = N;
+            info.field_data = &field_data;
+            info.valid_data = valid.data();
+            sealed_seg->LoadFieldData(info);
+        }
+    }
+    check(*sealed_seg, N);
+}
+
 TEST(Expr, TestSimpleDsl) {
     using namespace milvus::query;
     using namespace milvus::segcore;
@@ -743,6 +743,106 @@ TEST(Sealed, BF) {
     EXPECT_EQ(result2->get_total_result_count(), 0);
 }
 
+TEST(Sealed, RangeSearch) {
+    auto schema = std::make_shared<Schema>();
+    auto dim = 128;
+    auto metric_type = "L2";
+    auto fake_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, dim, metric_type);
+    auto i64_fid = schema->AddDebugField("counter", DataType::INT64);
+    schema->set_primary_field_id(i64_fid);
+
+    int64_t N = 10000;
+    auto base = GenRandomFloatVecs(N, dim);
+    auto base_arr = transfer_to_fields_data(base);
+    base_arr->set_type(proto::schema::DataType::FloatVector);
+    LoadFieldDataInfo load_info{100, base_arr.get(), N};
+    auto dataset = DataGen(schema, N);
+    auto segment = CreateSealedSegment(schema);
+    SealedLoadFieldData(dataset, *segment, {fake_id.get()});
+    segment->LoadFieldData(load_info);
+
+    auto topK = 10;
+    auto num_queries = 10;
+    auto query = GenQueryVecs(num_queries, dim);
+    auto ph_group_raw = CreatePlaceholderGroup(num_queries, dim, query);
+
+    auto fmt = boost::format(R"(vector_anns: <
+                                            field_id: 100
+                                            query_info: <
+                                                topk: %1%
+                                                metric_type: "L2"
+                                                search_params: "{\"nprobe\": 10}"
+                                            >
+                                            placeholder_tag: "$0">
+                                            output_field_ids: 101)") %
+               topK;
+    auto binary_plan = translate_text_plan_to_binary_plan(fmt.str().data());
+    auto plan = CreateSearchPlanByExpr(*schema, binary_plan.data(), binary_plan.size());
+    auto ph_group = ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+    auto result = segment->Search(plan.get(), ph_group.get(), MAX_TIMESTAMP);
+
+    // take the 5th distance of the first query as radius, the farther results are filtered out
+    auto radius = result->distances_[4];
+    auto range_fmt = boost::format(R"(vector_anns: <
+                                            field_id: 100
+                                            query_info: <
+                                                topk: %1%
+                                                metric_type: "L2"
+                                                search_params: "{\"nprobe\": 10}"
+                                                is_range_search: true
+                                                radius: %2%
+                                                range_filter: 0
+                                            >
+                                            placeholder_tag: "$0">
+                                            output_field_ids: 101)") %
+                     topK % radius;
+    auto range_binary_plan = translate_text_plan_to_binary_plan(range_fmt.str().data());
+    auto range_plan = CreateSearchPlanByExpr(*schema, range_binary_plan.data(), range_binary_plan.size());
+    auto range_ph_group = ParsePlaceholderGroup(range_plan.get(), ph_group_raw.SerializeAsString());
+    auto range_result = segment->Search(range_plan.get(), range_ph_group.get(), MAX_TIMESTAMP);
+
+    // radius may be rounded when the plan is formatted, check against the parsed one
+    radius = range_plan->plan_node_->search_info_.radius_;
+    ASSERT_EQ(range_result->seg_offsets_.size(), num_queries * topK);
+    int64_t valid_count = 0;
+    for (int64_t i = 0; i < num_queries * topK; ++i) {
+        if (range_result->seg_offsets_[i] == INVALID_SEG_OFFSET) {
+            continue;
+        }
+        EXPECT_LT(range_result->distances_[i], radius);
+        EXPECT_GE(range_result->distances_[i], 0);
+        if (i < topK) {
+            ++valid_count;
+        }
+    }
+    EXPECT_LE(valid_count, 5);
+
+    // the nearest results lie on the near side of range_filter, range search looks beyond topk
+    auto skip_fmt = boost::format(R"(vector_anns: <
+                                            field_id: 100
+                                            query_info: <
+                                                topk: %1%
+                                                metric_type: "L2"
+                                                search_params: "{\"nprobe\": 10}"
+                                                is_range_search: true
+                                                radius: 3.4e+38
+                                                range_filter: %2%
+                                            >
+                                            placeholder_tag: "$0">
+                                            output_field_ids: 101)") %
+                    topK % radius;
+    auto skip_binary_plan = translate_text_plan_to_binary_plan(skip_fmt.str().data());
+    auto skip_plan = CreateSearchPlanByExpr(*schema, skip_binary_plan.data(), skip_binary_plan.size());
+    auto skip_ph_group = ParsePlaceholderGroup(skip_plan.get(), ph_group_raw.SerializeAsString());
+    auto skip_result = segment->Search(skip_plan.get(), skip_ph_group.get(), MAX_TIMESTAMP);
+    auto range_filter = skip_plan->plan_node_->search_info_.range_filter_;
+    ASSERT_EQ(skip_result->seg_offsets_.size(), num_queries * topK);
+    for (int64_t i = 0; i < topK; ++i) {
+        EXPECT_NE(skip_result->seg_offsets_[i], INVALID_SEG_OFFSET);
+        EXPECT_GE(skip_result->distances_[i], range_filter);
+    }
+}
+
 TEST(Sealed, BF_Overflow) {
     auto schema = std::make_shared<Schema>();
     auto dim = 128;
@@ -85,9 +85,10 @@ TEST(Util, GetDeleteBitmap) {
     delete_record.pks_.set_data_raw(offset, delete_pk.data(), 1);
     delete_record.ack_responder_.AddSegment(offset, offset + 1);
 
+    // the record inserted with the same timestamp as the deletion is kept, as the insert part of an upsert
     del_barrier = get_barrier(delete_record, query_timestamp);
     res_bitmap = get_deleted_bitmap(del_barrier, insert_barrier, delete_record, insert_record, query_timestamp);
-    ASSERT_EQ(res_bitmap->bitmap_ptr->count(), N);
+    ASSERT_EQ(res_bitmap->bitmap_ptr->count(), N - 1);
 
     // test case insert repeated pk1 (ts = {1 ... N}) -> delete pk1 (ts = N) -> query (ts = N/2)
     query_timestamp = tss[N - 1] / 2;
@@ -104,6 +104,14 @@ struct GeneratedData {
 
                     break;
                 }
+                case DataType::JSON:
+                case DataType::ARRAY: {
+                    auto ret_data = reinterpret_cast<std::string*>(ret.data());
+                    auto src_data = target_field_data.scalars().bytes_data().data();
+                    std::copy(src_data.begin(), src_data.end(), ret_data);
+
+                    break;
+                }
                 default: {
                     PanicInfo("unsupported");
                 }
@@ -241,6 +249,29 @@ DataGen(SchemaPtr schema, int64_t N, uint64_t seed = 42, uint64_t ts_offset = 0,
                 insert_cols(data, N, field_meta);
                 break;
             }
+            case DataType::JSON: {
+                vector<std::string> data(N);
+                for (auto& x : data) {
+                    auto age = std::to_string(er() % (2 * N));
+                    x = R"({"age":)" + age + R"(,"tags":[)" + age + R"(,"x"]})";
+                }
+                insert_cols(data, N, field_meta);
+                break;
+            }
+            case DataType::ARRAY: {
+                // arrays of int64 with at most 3 elements
+                vector<std::string> data(N);
+                for (auto& x : data) {
+                    milvus::proto::schema::ScalarField array;
+                    auto length = er() % 4;
+                    for (int i = 0; i < length; i++) {
+                        array.mutable_long_data()->add_data(er() % 10);
+                    }
+                    x = array.SerializeAsString();
+                }
+                insert_cols(data, N, field_meta);
+                break;
+            }
             default: {
                 throw std::runtime_error("unimplemented");
             }
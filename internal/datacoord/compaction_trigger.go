@@ -344,7 +344,9 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 			isSegmentHealthy(segment) &&
 			isFlush(segment) &&
 			!segment.isCompacting && // not compacting now
-			!segment.GetIsImporting() // not importing now
+			!segment.GetIsImporting() && // not importing now
+			segment.GetMaxRowTimestamp() == 0 && // the rows after it are filtered only when loaded
+			segment.GetMinRowTimestamp() == 0 // the rows before it are filtered only when loaded
 	}) // m is list of chanPartSegments, which is channel-partition organized segments
 
 	if len(m) == 0 {
@@ -740,7 +742,9 @@ func (t *compactionTrigger) getCandidateSegments(channel string, partitionID Uni
 			s.GetInsertChannel() != channel ||
 			s.GetPartitionID() != partitionID ||
 			s.isCompacting ||
-			s.GetIsImporting() {
+			s.GetIsImporting() ||
+			s.GetMaxRowTimestamp() != 0 ||
+			s.GetMinRowTimestamp() != 0 {
 			continue
 		}
 		res = append(res, s)
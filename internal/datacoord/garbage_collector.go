@@ -206,7 +206,13 @@ func (gc *garbageCollector) clearEtcd() {
 	all := gc.meta.SelectSegments(func(si *SegmentInfo) bool { return true })
 	drops := make(map[int64]*SegmentInfo, 0)
 	compactTo := make(map[int64]*SegmentInfo)
+	// the binlogs are shared between the source and the cloned segments,
+	// they are removed only if no other segment refers to them.
+	logRefs := make(map[string]int)
 	for _, segment := range all {
+		for _, l := range getLogs(segment) {
+			logRefs[l.GetLogPath()]++
+		}
 		if segment.GetState() == commonpb.SegmentState_Dropped && !gc.segRefer.HasSegmentLock(segment.ID) {
 			drops[segment.GetID()] = segment
 			continue
@@ -238,10 +244,17 @@ func (gc *garbageCollector) clearEtcd() {
 			continue
 		}
 		logs := getLogs(segment)
+		unreferenced := lo.Filter(logs, func(l *datapb.Binlog, _ int) bool {
+			return logRefs[l.GetLogPath()] <= 1
+		})
 		log.Info("GC segment",
-			zap.Int64("segmentID", segment.GetID()))
-		if gc.removeLogs(logs) {
+			zap.Int64("segmentID", segment.GetID()),
+			zap.Int("sharedLogs", len(logs)-len(unreferenced)))
+		if gc.removeLogs(unreferenced) {
 			_ = gc.meta.DropSegment(segment.GetID())
+			for _, l := range logs {
+				logRefs[l.GetLogPath()]--
+			}
 		}
 	}
 }
@@ -264,7 +277,10 @@ func getLogs(sinfo *SegmentInfo) []*datapb.Binlog {
 	for _, flog := range sinfo.GetDeltalogs() {
 		logs = append(logs, flog.GetBinlogs()...)
 	}
-	return logs
+	// the fields of a segment of StorageV2 share the same binlogs
+	return lo.UniqBy(logs, func(l *datapb.Binlog) string {
+		return l.GetLogPath()
+	})
 }
 
 func (gc *garbageCollector) removeLogs(logs []*datapb.Binlog) bool {
@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -107,6 +108,27 @@ func validateMinioPrefixElements(t *testing.T, cli *minio.Client, bucketName str
 	assert.ElementsMatch(t, elements, current)
 }
 
+func Test_getLogs(t *testing.T) {
+	// the fields of a segment of StorageV2 refer to the same binlog
+	segment := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+		ID:             1,
+		StorageVersion: storage.StorageV2,
+		Binlogs: []*datapb.FieldBinlog{
+			getFieldBinlogPaths(0, "insert_log/1/2/1/columnar/10", "insert_log/1/2/1/columnar/20"),
+			getFieldBinlogPaths(1, "insert_log/1/2/1/columnar/10", "insert_log/1/2/1/columnar/20"),
+		},
+		Statslogs: []*datapb.FieldBinlog{getFieldBinlogPaths(0, "stats_log/1/2/1/0/11")},
+		Deltalogs: []*datapb.FieldBinlog{getFieldBinlogPaths(0, "delta_log/1/2/1/12")},
+	}}
+	paths := lo.Map(getLogs(segment), func(l *datapb.Binlog, _ int) string { return l.GetLogPath() })
+	assert.ElementsMatch(t, []string{
+		"insert_log/1/2/1/columnar/10",
+		"insert_log/1/2/1/columnar/20",
+		"stats_log/1/2/1/0/11",
+		"delta_log/1/2/1/12",
+	}, paths)
+}
+
 func Test_garbageCollector_scan(t *testing.T) {
 	bucketName := `datacoord-ut` + strings.ToLower(funcutil.RandomString(8))
 	rootPath := `gc` + funcutil.RandomString(8)
@@ -241,6 +263,51 @@ func Test_garbageCollector_scan(t *testing.T) {
 
 		gc.close()
 	})
+	t.Run("dropped gc shared logs", func(t *testing.T) {
+		segment := buildSegment(1, 10, 102, "ch", false)
+		segment.State = commonpb.SegmentState_Dropped
+		segment.DroppedAt = uint64(time.Now().Add(-time.Hour).UnixNano())
+		segment.Binlogs = []*datapb.FieldBinlog{getFieldBinlogPaths(0, inserts[2])}
+		segment.Statslogs = []*datapb.FieldBinlog{getFieldBinlogPaths(0, stats[2])}
+		segment.Deltalogs = []*datapb.FieldBinlog{getFieldBinlogPaths(0, delta[2])}
+		err = meta.AddSegment(segment)
+		require.NoError(t, err)
+		// the cloned segment shares the logs with the dropped one.
+		cloned := buildSegment(2, 20, 202, "ch", false)
+		cloned.State = commonpb.SegmentState_Flushed
+		cloned.ClonedFrom = 102
+		cloned.Binlogs = []*datapb.FieldBinlog{getFieldBinlogPaths(0, inserts[2])}
+		cloned.Statslogs = []*datapb.FieldBinlog{getFieldBinlogPaths(0, stats[2])}
+		cloned.Deltalogs = []*datapb.FieldBinlog{getFieldBinlogPaths(0, delta[2])}
+		err = meta.AddSegment(cloned)
+		require.NoError(t, err)
+
+		indexCoord := mocks.NewMockIndexCoord(t)
+		gc := newGarbageCollector(meta, newMockHandler(), segRefer, indexCoord, GcOption{
+			cli:              cli,
+			enabled:          true,
+			checkInterval:    time.Minute * 30,
+			missingTolerance: time.Hour * 24,
+			dropTolerance:    0,
+		})
+		gc.clearEtcd()
+		assert.Nil(t, meta.GetSegmentUnsafe(102))
+		validateMinioPrefixElements(t, cli.Client, bucketName, path.Join(rootPath, insertLogPrefix), inserts[1:])
+		validateMinioPrefixElements(t, cli.Client, bucketName, path.Join(rootPath, statsLogPrefix), stats[1:])
+		validateMinioPrefixElements(t, cli.Client, bucketName, path.Join(rootPath, deltaLogPrefix), delta[1:])
+
+		// the logs are removed once the last segment referring them is dropped.
+		err = meta.SetState(202, commonpb.SegmentState_Dropped)
+		require.NoError(t, err)
+		gc.clearEtcd()
+		assert.Nil(t, meta.GetSegmentUnsafe(202))
+		validateMinioPrefixElements(t, cli.Client, bucketName, path.Join(rootPath, insertLogPrefix), []string{inserts[1], inserts[3]})
+		validateMinioPrefixElements(t, cli.Client, bucketName, path.Join(rootPath, statsLogPrefix), []string{stats[1], stats[3]})
+		validateMinioPrefixElements(t, cli.Client, bucketName, path.Join(rootPath, deltaLogPrefix), []string{delta[1], delta[3]})
+		validateMinioPrefixElements(t, cli.Client, bucketName, path.Join(rootPath, `indexes`), others)
+
+		gc.close()
+	})
 	t.Run("missing gc all", func(t *testing.T) {
 		indexCoord := mocks.NewMockIndexCoord(t)
 		gc := newGarbageCollector(meta, newMockHandler(), segRefer, indexCoord, GcOption{
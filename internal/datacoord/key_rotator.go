@@ -0,0 +1,141 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// keyRotationCheckInterval is how often the data keys are checked for rotation.
+const keyRotationCheckInterval = time.Hour
+
+// keyRotator rotates the data keys of the collections which have healthy segments once they are older than
+// the rotation interval, the new binlogs and index files are encrypted by the new data keys and
+// the old files are still readable. The data keys are rewrapped by the current master key on start,
+// since the master keys of the KMS are loaded on start.
+type keyRotator struct {
+	meta     *meta
+	cm       *storage.EncryptedChunkManager
+	interval time.Duration
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	wg        sync.WaitGroup
+	closeCh   chan struct{}
+}
+
+// newKeyRotator returns nil if the files are not encrypted by @cm.
+func newKeyRotator(meta *meta, cm storage.ChunkManager, interval time.Duration) *keyRotator {
+	ecm, ok := cm.(*storage.EncryptedChunkManager)
+	if !ok {
+		return nil
+	}
+	log.Info("data key rotator created", zap.Duration("interval", interval))
+	return &keyRotator{
+		meta:     meta,
+		cm:       ecm,
+		interval: interval,
+		closeCh:  make(chan struct{}),
+	}
+}
+
+func (r *keyRotator) start() {
+	if r == nil {
+		return
+	}
+	r.startOnce.Do(func() {
+		r.wg.Add(1)
+		go r.work()
+	})
+}
+
+func (r *keyRotator) work() {
+	defer r.wg.Done()
+	r.rewrap()
+	if r.interval <= 0 {
+		return
+	}
+	r.rotate()
+	ticker := time.NewTicker(keyRotationCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.rotate()
+		case <-r.closeCh:
+			log.Info("data key rotator quit")
+			return
+		}
+	}
+}
+
+func (r *keyRotator) close() {
+	if r == nil {
+		return
+	}
+	r.stopOnce.Do(func() {
+		close(r.closeCh)
+		r.wg.Wait()
+	})
+}
+
+func (r *keyRotator) rewrap() {
+	if _, err := r.cm.RewrapKeys(context.Background()); err != nil {
+		log.Warn("failed to rewrap data keys", zap.Error(err))
+	}
+}
+
+// rotate creates new data keys for the collections whose current data keys are older than the interval.
+func (r *keyRotator) rotate() {
+	ctx := context.Background()
+	collectionIDs := typeutil.NewUniqueSet()
+	for _, segment := range r.meta.GetAllSegmentsUnsafe() {
+		if isSegmentHealthy(segment) {
+			collectionIDs.Insert(segment.GetCollectionID())
+		}
+	}
+	for collectionID := range collectionIDs {
+		keyID, err := r.cm.CurrentKeyID(ctx, collectionID)
+		if err != nil {
+			log.Warn("failed to get current data key", zap.Int64("collectionID", collectionID), zap.Error(err))
+			continue
+		}
+		createTime, err := storage.DataKeyCreateTime(keyID)
+		if err != nil {
+			log.Warn("failed to get creation time of data key", zap.Int64("collectionID", collectionID), zap.Error(err))
+			continue
+		}
+		if time.Since(createTime) < r.interval {
+			continue
+		}
+		newKeyID, err := r.cm.RotateKey(ctx, collectionID)
+		if err != nil {
+			log.Warn("failed to rotate data key", zap.Int64("collectionID", collectionID), zap.Error(err))
+			continue
+		}
+		log.Info("data key rotated", zap.Int64("collectionID", collectionID),
+			zap.String("oldKeyID", keyID), zap.String("newKeyID", newKeyID))
+	}
+}
@@ -0,0 +1,91 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestKeyRotator(t *testing.T) {
+	ctx := context.Background()
+	keyFile := path.Join(t.TempDir(), "keys.json")
+	masterKey := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, os.WriteFile(keyFile, []byte(`{"current": "key1", "keys": {"key1": "`+masterKey+`"}}`), 0600))
+	kms, err := storage.NewLocalKMS(keyFile)
+	require.NoError(t, err)
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	ecm := storage.NewEncryptedChunkManager(cm, kms)
+
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, CollectionID: 1, State: commonpb.SegmentState_Flushed})))
+	require.NoError(t, meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 2, CollectionID: 2, State: commonpb.SegmentState_Dropped})))
+
+	t.Run("not encrypted", func(t *testing.T) {
+		r := newKeyRotator(meta, cm, time.Hour)
+		assert.Nil(t, r)
+		r.start()
+		r.close()
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		keyID, err := ecm.CurrentKeyID(ctx, 1)
+		require.NoError(t, err)
+
+		// the data key is not old enough.
+		r := newKeyRotator(meta, ecm, time.Hour)
+		require.NotNil(t, r)
+		r.rotate()
+		current, err := ecm.CurrentKeyID(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, keyID, current)
+
+		r = newKeyRotator(meta, ecm, time.Nanosecond)
+		r.rotate()
+		current, err = ecm.CurrentKeyID(ctx, 1)
+		require.NoError(t, err)
+		assert.Greater(t, current, keyID)
+
+		// the collection without healthy segments is skipped.
+		keys, _, err := cm.ListWithPrefix(ctx, path.Join(cm.RootPath(), "encryption_keys", "2")+"/", true)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("start and close", func(t *testing.T) {
+		r := newKeyRotator(meta, ecm, 0)
+		r.start()
+		r.close()
+
+		r = newKeyRotator(meta, ecm, time.Hour)
+		r.start()
+		r.close()
+	})
+}
@@ -382,6 +382,37 @@ func (m *meta) UnsetIsImporting(segmentID UniqueID) error {
 	return nil
 }
 
+// SetMinRowTimestamp sets the min row timestamp of a segment, the rows at or before it are filtered out
+// when the segment is loaded.
+func (m *meta) SetMinRowTimestamp(segmentID UniqueID, ts Timestamp) error {
+	log.Info("meta update: setting min row timestamp of segment",
+		zap.Int64("segment ID", segmentID),
+		zap.Uint64("timestamp", ts))
+	m.Lock()
+	defer m.Unlock()
+	curSegInfo := m.segments.GetSegment(segmentID)
+	if curSegInfo == nil {
+		return fmt.Errorf("segment not found %d", segmentID)
+	}
+	if curSegInfo.GetMinRowTimestamp() >= ts {
+		return nil
+	}
+	// Persist segment updates first.
+	clonedSegment := curSegInfo.Clone()
+	clonedSegment.MinRowTimestamp = ts
+	if err := m.catalog.AlterSegment(m.ctx, clonedSegment.SegmentInfo, curSegInfo.SegmentInfo); err != nil {
+		log.Error("meta update: setting min row timestamp of segment - failed to alter segment",
+			zap.Int64("segment ID", segmentID),
+			zap.Error(err))
+		return err
+	}
+	// Update in-memory meta.
+	m.segments.SetSegment(segmentID, clonedSegment)
+	log.Info("meta update: setting min row timestamp of segment - complete",
+		zap.Int64("segment ID", segmentID))
+	return nil
+}
+
 // UpdateFlushSegmentsInfo update segment partial/completed flush info
 // `flushed` parameter indicating whether segment is flushed completely or partially
 // `binlogs`, `checkpoints` and `statPositions` are persistence data for segment
@@ -390,6 +421,7 @@ func (m *meta) UpdateFlushSegmentsInfo(
 	flushed bool,
 	dropped bool,
 	importing bool,
+	storageVersion int64,
 	binlogs, statslogs, deltalogs []*datapb.FieldBinlog,
 	checkpoints []*datapb.CheckPoint,
 	startPositions []*datapb.SegmentStartPosition,
@@ -403,7 +435,8 @@ func (m *meta) UpdateFlushSegmentsInfo(
 		zap.Bool("dropped", dropped),
 		zap.Any("check points", checkpoints),
 		zap.Any("start position", startPositions),
-		zap.Bool("importing", importing))
+		zap.Bool("importing", importing),
+		zap.Int64("storageVersion", storageVersion))
 	m.Lock()
 	defer m.Unlock()
 
@@ -438,6 +471,10 @@ func (m *meta) UpdateFlushSegmentsInfo(
 	}
 	// TODO add diff encoding and compression
 	currBinlogs := clonedSegment.GetBinlogs()
+	// the storage version is decided by the first flush writing binlogs, the later flushes keep it
+	if len(currBinlogs) == 0 && len(binlogs) > 0 {
+		clonedSegment.StorageVersion = storageVersion
+	}
 	var getFieldBinlogs = func(id UniqueID, binlogs []*datapb.FieldBinlog) *datapb.FieldBinlog {
 		for _, binlog := range binlogs {
 			if id == binlog.GetFieldID() {
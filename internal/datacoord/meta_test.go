@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
@@ -356,6 +357,15 @@ func TestMeta_Basic(t *testing.T) {
 		err = meta.UnsetIsImporting(segID1_0)
 		assert.Error(t, err)
 
+		err = meta.SetMinRowTimestamp(segID0_0, 100)
+		assert.NoError(t, err)
+		// the min row timestamp never goes back.
+		err = meta.SetMinRowTimestamp(segID0_0, 50)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(100), meta.GetSegment(segID0_0).GetMinRowTimestamp())
+		err = meta.SetMinRowTimestamp(segID1_0, 100)
+		assert.Error(t, err)
+
 		info1_1 := meta.GetSegment(segID1_1)
 		assert.NotNil(t, info1_1)
 		assert.Equal(t, false, info1_1.GetIsImporting())
@@ -532,7 +542,7 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		err = meta.AddSegment(segment1)
 		assert.Nil(t, err)
 
-		err = meta.UpdateFlushSegmentsInfo(1, true, false, true, []*datapb.FieldBinlog{getFieldBinlogPaths(1, getInsertLogPath("binlog1", 1))},
+		err = meta.UpdateFlushSegmentsInfo(1, true, false, true, storage.StorageV1, []*datapb.FieldBinlog{getFieldBinlogPaths(1, getInsertLogPath("binlog1", 1))},
 			[]*datapb.FieldBinlog{getFieldBinlogPaths(1, getStatsLogPath("statslog1", 1))},
 			[]*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 1, TimestampFrom: 100, TimestampTo: 200, LogSize: 1000, LogPath: getDeltaLogPath("deltalog1", 1)}}}},
 			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
@@ -561,11 +571,36 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 
 	})
 
+	t.Run("storage version", func(t *testing.T) {
+		meta, err := newMeta(context.TODO(), memkv.NewMemoryKV(), "", nil)
+		assert.Nil(t, err)
+
+		err = meta.AddSegment(&SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing}})
+		assert.Nil(t, err)
+
+		// no binlogs flushed, the storage version is not decided yet
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, storage.StorageV2, nil, nil, nil, nil, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, storage.StorageV1, meta.GetSegment(1).GetStorageVersion())
+
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, storage.StorageV2, []*datapb.FieldBinlog{getFieldBinlogPaths(1, getInsertLogPath("binlog0", 1))},
+			nil, nil, nil, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, storage.StorageV2, meta.GetSegment(1).GetStorageVersion())
+
+		// the version of the segment having binlogs is kept
+		err = meta.UpdateFlushSegmentsInfo(1, true, false, false, storage.StorageV1, []*datapb.FieldBinlog{getFieldBinlogPaths(1, getInsertLogPath("binlog1", 1))},
+			nil, nil, nil, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, storage.StorageV2, meta.GetSegment(1).GetStorageVersion())
+		assert.Equal(t, 2, len(meta.GetSegment(1).GetBinlogs()[0].GetBinlogs()))
+	})
+
 	t.Run("update non-existed segment", func(t *testing.T) {
 		meta, err := newMeta(context.TODO(), memkv.NewMemoryKV(), "", nil)
 		assert.Nil(t, err)
 
-		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, nil, nil, nil)
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, storage.StorageV1, nil, nil, nil, nil, nil)
 		assert.Nil(t, err)
 	})
 
@@ -577,7 +612,7 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		err = meta.AddSegment(segment1)
 		assert.Nil(t, err)
 
-		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, nil, []*datapb.CheckPoint{{SegmentID: 2, NumOfRows: 10}},
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, storage.StorageV1, nil, nil, nil, []*datapb.CheckPoint{{SegmentID: 2, NumOfRows: 10}},
 
 			[]*datapb.SegmentStartPosition{{SegmentID: 2, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
 		assert.Nil(t, err)
@@ -599,7 +634,7 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		}
 		meta.segments.SetSegment(1, segmentInfo)
 
-		err = meta.UpdateFlushSegmentsInfo(1, true, false, false, []*datapb.FieldBinlog{getFieldBinlogPaths(1, getInsertLogPath("binlog", 1))},
+		err = meta.UpdateFlushSegmentsInfo(1, true, false, false, storage.StorageV1, []*datapb.FieldBinlog{getFieldBinlogPaths(1, getInsertLogPath("binlog", 1))},
 			[]*datapb.FieldBinlog{getFieldBinlogPaths(1, getInsertLogPath("statslog", 1))},
 			[]*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 1, TimestampFrom: 100, TimestampTo: 200, LogSize: 1000, LogPath: getDeltaLogPath("deltalog", 1)}}}},
 			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
@@ -375,6 +375,30 @@ func (m *mockRootCoordService) ShowCollections(ctx context.Context, req *milvusp
 	}, nil
 }
 
+func (m *mockRootCoordService) CreateDatabase(ctx context.Context, req *rootcoordpb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) DropDatabase(ctx context.Context, req *rootcoordpb.DropDatabaseRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) ListDatabases(ctx context.Context, req *rootcoordpb.ListDatabasesRequest) (*rootcoordpb.ListDatabasesResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) RenameCollection(ctx context.Context, req *rootcoordpb.RenameCollectionRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) TruncateCollection(ctx context.Context, req *rootcoordpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordService) CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 func (m *mockRootCoordService) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	panic("not implemented") // TODO: Implement
 }
@@ -701,6 +725,54 @@ func (m *mockRootCoordService) GetCredential(ctx context.Context, req *rootcoord
 	panic("implement me")
 }
 
+func (m *mockRootCoordService) CreateApiKey(ctx context.Context, req *rootcoordpb.CreateApiKeyRequest) (*rootcoordpb.CreateApiKeyResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) ListApiKeys(ctx context.Context, req *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) RevokeApiKey(ctx context.Context, req *rootcoordpb.RevokeApiKeyRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) GetApiKey(ctx context.Context, req *rootcoordpb.GetApiKeyRequest) (*rootcoordpb.GetApiKeyResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) CreateBackup(ctx context.Context, req *rootcoordpb.CreateBackupRequest) (*rootcoordpb.CreateBackupResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) ListBackups(ctx context.Context, req *rootcoordpb.ListBackupsRequest) (*rootcoordpb.ListBackupsResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) VerifyBackup(ctx context.Context, req *rootcoordpb.VerifyBackupRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) DeleteBackup(ctx context.Context, req *rootcoordpb.DeleteBackupRequest) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordService) RestoreBackup(ctx context.Context, req *rootcoordpb.RestoreBackupRequest) (*rootcoordpb.RestoreBackupResponse, error) {
+	panic("implement me")
+}
+
 func (m *mockRootCoordService) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
 	panic("implement me")
 }
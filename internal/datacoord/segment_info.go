@@ -17,6 +17,9 @@
 package datacoord
 
 import (
+	"errors"
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -366,5 +369,102 @@ func (s *SegmentInfo) getSegmentSize() int64 {
 	return s.size
 }
 
+var errNoRowsBeforeTimestamp = errors.New("no rows before the clone timestamp")
+
+// rowTimestampRange returns the range of the row timestamps of the segment,
+// the dml position is used as the upper bound if the binlogs don't record the timestamps.
+func rowTimestampRange(segment *SegmentInfo) (Timestamp, Timestamp) {
+	var min, max Timestamp = math.MaxUint64, 0
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if binlog.GetTimestampTo() == 0 {
+				return 0, segment.GetDmlPosition().GetTimestamp()
+			}
+			if binlog.GetTimestampFrom() < min {
+				min = binlog.GetTimestampFrom()
+			}
+			if binlog.GetTimestampTo() > max {
+				max = binlog.GetTimestampTo()
+			}
+		}
+	}
+	if min > max {
+		return 0, segment.GetDmlPosition().GetTimestamp()
+	}
+	return min, max
+}
+
+// rowsAfter returns true if some rows of the segment visible to the queries are after the timestamp.
+func rowsAfter(segment *SegmentInfo, ts Timestamp) bool {
+	_, maxTs := rowTimestampRange(segment)
+	if segment.GetMaxRowTimestamp() != 0 && segment.GetMaxRowTimestamp() < maxTs {
+		maxTs = segment.GetMaxRowTimestamp()
+	}
+	return maxTs > ts
+}
+
+// cloneSegmentInfo builds the segment info of the cloned segment in the target collection of the request,
+// which shares the binlogs with the source segment. If some rows or deletions of the source segment are after
+// the timestamp of the request, MaxRowTimestamp is set and they are filtered out when the segment is loaded,
+// errNoRowsBeforeTimestamp is returned if all the rows are after the timestamp.
+func cloneSegmentInfo(segment *SegmentInfo, segmentID UniqueID, req *datapb.CloneSegmentsRequest) (*datapb.SegmentInfo, error) {
+	minTs, maxTs := rowTimestampRange(segment)
+	if minTs > req.GetTimestamp() {
+		return nil, errNoRowsBeforeTimestamp
+	}
+	partitionID, ok := req.GetPartitionIDs()[segment.GetPartitionID()]
+	if !ok {
+		return nil, fmt.Errorf("target partition not found, source partition: %d", segment.GetPartitionID())
+	}
+	channel, ok := req.GetChannels()[segment.GetInsertChannel()]
+	if !ok {
+		return nil, fmt.Errorf("target channel not found, source channel: %s", segment.GetInsertChannel())
+	}
+	// the data of the cloned segment is older than the target collection,
+	// so the positions are set to the start position of the target channel.
+	pos := toMsgPosition(channel, req.GetStartPositions())
+	if pos == nil {
+		return nil, fmt.Errorf("start position not found, target channel: %s", channel)
+	}
+	pos.Timestamp = req.GetBase().GetTimestamp()
+
+	info := proto.Clone(segment.SegmentInfo).(*datapb.SegmentInfo)
+	info.ID = segmentID
+	info.CollectionID = req.GetTargetCollectionID()
+	info.PartitionID = partitionID
+	info.InsertChannel = channel
+	info.StartPosition = pos
+	info.DmlPosition = proto.Clone(pos).(*internalpb.MsgPosition)
+	info.CreatedByCompaction = false
+	info.CompactionFrom = nil
+	info.DroppedAt = 0
+	info.ClonedFrom = segment.GetID()
+	info.MaxRowTimestamp = 0
+	if maxTs > req.GetTimestamp() {
+		info.MaxRowTimestamp = req.GetTimestamp()
+	}
+
+	// the deletions after the timestamp don't belong to the cloned segment,
+	// the deltalogs straddling the timestamp are kept and filtered when loaded.
+	deltalogs := make([]*datapb.FieldBinlog, 0, len(info.GetDeltalogs()))
+	for _, fieldBinlog := range info.GetDeltalogs() {
+		binlogs := make([]*datapb.Binlog, 0, len(fieldBinlog.GetBinlogs()))
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if binlog.GetTimestampFrom() > req.GetTimestamp() {
+				continue
+			}
+			if binlog.GetTimestampTo() > req.GetTimestamp() {
+				info.MaxRowTimestamp = req.GetTimestamp()
+			}
+			binlogs = append(binlogs, binlog)
+		}
+		if len(binlogs) > 0 {
+			deltalogs = append(deltalogs, &datapb.FieldBinlog{FieldID: fieldBinlog.GetFieldID(), Binlogs: binlogs})
+		}
+	}
+	info.Deltalogs = deltalogs
+	return info, nil
+}
+
 // SegmentInfoSelector is the function type to select SegmentInfo from meta
 type SegmentInfoSelector func(*SegmentInfo) bool
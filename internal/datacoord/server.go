@@ -107,6 +107,7 @@ type Server struct {
 	channelManager   *ChannelManager
 	rootCoordClient  types.RootCoord
 	garbageCollector *garbageCollector
+	keyRotator       *keyRotator
 	gcOpt            GcOption
 	handler          Handler
 
@@ -289,6 +290,7 @@ func (s *Server) Init() error {
 	s.initSegmentManager()
 
 	s.initGarbageCollection(storageCli)
+	s.keyRotator = newKeyRotator(s.meta, storageCli, Params.CommonCfg.EncryptionKeyRotationInterval.GetAsDuration(time.Hour))
 
 	return nil
 }
@@ -473,6 +475,7 @@ func (s *Server) startServerLoop() {
 	s.startWatchService(s.serverLoopCtx)
 	s.startFlushLoop(s.serverLoopCtx)
 	s.garbageCollector.start()
+	s.keyRotator.start()
 }
 
 // startDataNodeTtLoop start a goroutine to recv data node tt msg from msgstream
@@ -821,6 +824,7 @@ func (s *Server) Stop() error {
 	logutil.Logger(s.ctx).Info("server shutdown")
 	s.cluster.Close()
 	s.garbageCollector.close()
+	s.keyRotator.close()
 	s.stopServerLoop()
 	s.session.Revoke(time.Second)
 
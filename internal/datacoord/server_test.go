@@ -3434,6 +3434,169 @@ func TestDataCoord_MarkSegmentsDropped(t *testing.T) {
 		// Returning success as SetState will succeed if segment does not exist. This should probably get fixed.
 		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
 	})
+
+	t.Run("truncate timestamp", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		seg := buildSegment(100, 100, 100, "ch1", false)
+		seg.State = commonpb.SegmentState_Flushed
+		seg.Binlogs = []*datapb.FieldBinlog{{
+			FieldID: 101,
+			Binlogs: []*datapb.Binlog{{LogPath: "insert_log_path", TimestampFrom: 100, TimestampTo: 900}},
+		}}
+		assert.NoError(t, svr.meta.AddSegment(seg))
+		// the segment straddling the timestamp is kept, the rows inserted after the timestamp are still visible.
+		seg = buildSegment(100, 100, 101, "ch1", false)
+		seg.State = commonpb.SegmentState_Flushed
+		seg.Binlogs = []*datapb.FieldBinlog{{
+			FieldID: 101,
+			Binlogs: []*datapb.Binlog{
+				{LogPath: "insert_log_path_0", TimestampFrom: 900, TimestampTo: 950},
+				{LogPath: "insert_log_path_1", TimestampFrom: 960, TimestampTo: 1100},
+			},
+		}}
+		assert.NoError(t, svr.meta.AddSegment(seg))
+		// the rows after the timestamp are filtered out of the cloned segment.
+		seg = buildSegment(100, 100, 102, "ch1", false)
+		seg.State = commonpb.SegmentState_Flushed
+		seg.MaxRowTimestamp = 1000
+		seg.Binlogs = []*datapb.FieldBinlog{{
+			FieldID: 101,
+			Binlogs: []*datapb.Binlog{{LogPath: "insert_log_path_2", TimestampFrom: 900, TimestampTo: 1100}},
+		}}
+		assert.NoError(t, svr.meta.AddSegment(seg))
+
+		status, err := svr.MarkSegmentsDropped(context.Background(), &datapb.MarkSegmentsDroppedRequest{
+			SegmentIds: []int64{100, 101, 102},
+			Timestamp:  1000,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Equal(t, commonpb.SegmentState_Dropped, svr.meta.GetSegmentUnsafe(100).GetState())
+		assert.Equal(t, commonpb.SegmentState_Dropped, svr.meta.GetSegmentUnsafe(102).GetState())
+		straddling := svr.meta.GetSegment(101)
+		assert.Equal(t, commonpb.SegmentState_Flushed, straddling.GetState())
+		assert.Equal(t, uint64(1000), straddling.GetMinRowTimestamp())
+	})
+}
+
+func TestDataCoord_CloneSegments(t *testing.T) {
+	newRequest := func() *datapb.CloneSegmentsRequest {
+		return &datapb.CloneSegmentsRequest{
+			Base:               &commonpb.MsgBase{Timestamp: 2000},
+			SourceCollectionID: 100,
+			TargetCollectionID: 200,
+			PartitionIDs:       map[int64]int64{100: 200},
+			Channels:           map[string]string{"ch1": "ch2"},
+			StartPositions:     []*commonpb.KeyDataPair{{Key: funcutil.ToPhysicalChannel("ch2"), Data: []byte{1, 2, 3}}},
+			Timestamp:          1000,
+		}
+	}
+
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		seg := buildSegment(100, 100, 100, "ch1", false)
+		seg.State = commonpb.SegmentState_Flushed
+		seg.NumOfRows = 10
+		seg.DmlPosition = &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: 900}
+		seg.Binlogs = []*datapb.FieldBinlog{{
+			FieldID: 101,
+			Binlogs: []*datapb.Binlog{{LogPath: "insert_log_path", TimestampFrom: 100, TimestampTo: 900}},
+		}}
+		seg.Deltalogs = []*datapb.FieldBinlog{{
+			FieldID: 0,
+			Binlogs: []*datapb.Binlog{
+				{LogPath: "delta_log_path_0", TimestampFrom: 700, TimestampTo: 800},
+				{LogPath: "delta_log_path_1", TimestampFrom: 1100, TimestampTo: 1200},
+			},
+		}}
+		assert.NoError(t, svr.meta.AddSegment(seg))
+		// the segment straddling the timestamp is cloned with the rows after the timestamp filtered.
+		seg = buildSegment(100, 100, 101, "ch1", false)
+		seg.State = commonpb.SegmentState_Flushed
+		seg.DmlPosition = &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: 1100}
+		seg.Binlogs = []*datapb.FieldBinlog{{
+			FieldID: 101,
+			Binlogs: []*datapb.Binlog{{LogPath: "insert_log_path_1", TimestampFrom: 900, TimestampTo: 1100}},
+		}}
+		seg.Deltalogs = []*datapb.FieldBinlog{{
+			FieldID: 0,
+			Binlogs: []*datapb.Binlog{{LogPath: "delta_log_path_2", TimestampFrom: 950, TimestampTo: 1050}},
+		}}
+		assert.NoError(t, svr.meta.AddSegment(seg))
+		// the segment whose rows are all after the timestamp is not cloned.
+		seg = buildSegment(100, 100, 103, "ch1", false)
+		seg.State = commonpb.SegmentState_Flushed
+		seg.DmlPosition = &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: 1200}
+		seg.Binlogs = []*datapb.FieldBinlog{{
+			FieldID: 101,
+			Binlogs: []*datapb.Binlog{{LogPath: "insert_log_path_2", TimestampFrom: 1100, TimestampTo: 1200}},
+		}}
+		assert.NoError(t, svr.meta.AddSegment(seg))
+		// the growing segment is not cloned.
+		assert.NoError(t, svr.meta.AddSegment(buildSegment(100, 100, 102, "ch1", false)))
+
+		status, err := svr.CloneSegments(context.Background(), newRequest())
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		cloned := make(map[int64]*SegmentInfo)
+		for _, segment := range svr.meta.GetSegmentsOfCollection(200) {
+			cloned[segment.GetClonedFrom()] = segment
+		}
+		require.Equal(t, 2, len(cloned))
+		require.Contains(t, cloned, int64(100))
+		require.Contains(t, cloned, int64(101))
+
+		full := cloned[100]
+		assert.Equal(t, int64(200), full.GetPartitionID())
+		assert.Equal(t, "ch2", full.GetInsertChannel())
+		assert.Equal(t, commonpb.SegmentState_Flushed, full.GetState())
+		assert.Equal(t, int64(10), full.GetNumOfRows())
+		assert.Equal(t, uint64(2000), full.GetDmlPosition().GetTimestamp())
+		assert.Equal(t, "ch2", full.GetStartPosition().GetChannelName())
+		assert.Equal(t, "insert_log_path", full.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+		require.Equal(t, 1, len(full.GetDeltalogs()[0].GetBinlogs()))
+		assert.Equal(t, "delta_log_path_0", full.GetDeltalogs()[0].GetBinlogs()[0].GetLogPath())
+		assert.Equal(t, uint64(0), full.GetMaxRowTimestamp())
+
+		straddling := cloned[101]
+		assert.Equal(t, "insert_log_path_1", straddling.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+		require.Equal(t, 1, len(straddling.GetDeltalogs()[0].GetBinlogs()))
+		assert.Equal(t, "delta_log_path_2", straddling.GetDeltalogs()[0].GetBinlogs()[0].GetLogPath())
+		assert.Equal(t, uint64(1000), straddling.GetMaxRowTimestamp())
+		// the source segment is kept.
+		assert.NotNil(t, svr.meta.GetSegment(100))
+
+		// cloning again doesn't clone the segments twice.
+		status, err = svr.CloneSegments(context.Background(), newRequest())
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Equal(t, 2, len(svr.meta.GetSegmentsOfCollection(200)))
+	})
+
+	t.Run("channel not mapped", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		seg := buildSegment(100, 100, 100, "ch3", false)
+		seg.State = commonpb.SegmentState_Flushed
+		assert.NoError(t, svr.meta.AddSegment(seg))
+
+		status, err := svr.CloneSegments(context.Background(), newRequest())
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("with closed server", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		closeTestServer(t, svr)
+
+		status, err := svr.CloneSegments(context.Background(), newRequest())
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(paramtable.GetNodeID()), status.GetReason())
+	})
 }
 
 func TestDataCoordServer_UpdateChannelCheckpoint(t *testing.T) {
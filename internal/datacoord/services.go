@@ -439,6 +439,7 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 		req.GetFlushed(),
 		req.GetDropped(),
 		req.GetImporting(),
+		req.GetStorageVersion(),
 		req.GetField2BinlogPaths(),
 		req.GetField2StatslogPaths(),
 		req.GetDeltalogs(),
@@ -1405,9 +1406,18 @@ func (s *Server) UnsetIsImportingState(ctx context.Context, req *datapb.UnsetIsI
 // An error status will be returned and error will be logged, if we failed to mark *all* segments.
 func (s *Server) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
 	log.Info("marking segments dropped",
-		zap.Int64s("segments", req.GetSegmentIds()))
+		zap.Int64s("segments", req.GetSegmentIds()),
+		zap.Uint64("timestamp", req.GetTimestamp()))
 	failure := false
 	for _, segID := range req.GetSegmentIds() {
+		if segment := s.meta.GetSegment(segID); segment != nil && req.GetTimestamp() != 0 && rowsAfter(segment, req.GetTimestamp()) {
+			// the rows after the timestamp are kept, the others are filtered out when the segment is loaded.
+			if err := s.meta.SetMinRowTimestamp(segID, req.GetTimestamp()); err != nil {
+				log.Error("failed to set min row timestamp of segment", zap.Int64("segment ID", segID), zap.Error(err))
+				failure = true
+			}
+			continue
+		}
 		if err := s.meta.SetState(segID, commonpb.SegmentState_Dropped); err != nil {
 			// Fail-open.
 			log.Error("failed to set segment state as dropped", zap.Int64("segment ID", segID))
@@ -1424,6 +1434,68 @@ func (s *Server) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmen
 	}, nil
 }
 
+// CloneSegments clones the flushed segments of the source collection to the target collection.
+// The cloned segments share the binlogs with the source segments, and the binlogs are not recycled
+// by the garbage collector until all the segments referring to them are dropped.
+func (s *Server) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("sourceCollectionID", req.GetSourceCollectionID()),
+		zap.Int64("targetCollectionID", req.GetTargetCollectionID()),
+		zap.Uint64("timestamp", req.GetTimestamp()))
+	log.Info("receive CloneSegments request")
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	if s.isClosed() {
+		log.Warn("failed to clone segments for closed server")
+		resp.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+
+	// the segments already cloned are skipped, so that the request is idempotent.
+	cloned := make(typeutil.UniqueSet)
+	for _, segment := range s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == req.GetTargetCollectionID() && segment.GetClonedFrom() != 0
+	}) {
+		cloned.Insert(segment.GetClonedFrom())
+	}
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == req.GetSourceCollectionID() &&
+			segment.GetState() == commonpb.SegmentState_Flushed &&
+			!segment.GetIsImporting() && !segment.GetIsFake() &&
+			!cloned.Contain(segment.GetID())
+	})
+
+	for _, segment := range segments {
+		segmentID, err := s.allocator.allocID(ctx)
+		if err != nil {
+			log.Warn("failed to alloc segment id", zap.Error(err))
+			resp.Reason = err.Error()
+			return resp, nil
+		}
+		info, err := cloneSegmentInfo(segment, segmentID, req)
+		if err == errNoRowsBeforeTimestamp {
+			continue
+		}
+		if err != nil {
+			log.Warn("failed to clone segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			resp.Reason = err.Error()
+			return resp, nil
+		}
+		if err := s.meta.AddSegment(NewSegmentInfo(info)); err != nil {
+			log.Warn("failed to add cloned segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			resp.Reason = err.Error()
+			return resp, nil
+		}
+		log.Info("segment cloned", zap.Int64("sourceSegmentID", segment.GetID()), zap.Int64("segmentID", segmentID),
+			zap.Int64("numRows", info.GetNumOfRows()), zap.Uint64("maxRowTimestamp", info.GetMaxRowTimestamp()))
+	}
+
+	log.Info("clone segments done", zap.Int("numSegments", len(segments)))
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
 func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
 	errResp := &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -1460,6 +1532,10 @@ func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.Alt
 	}
 
 	clonedColl.Properties = properties
+	// the schema changes when a field is added
+	if req.GetSchema() != nil {
+		clonedColl.Schema = req.GetSchema()
+	}
 	s.meta.AddCollection(clonedColl)
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
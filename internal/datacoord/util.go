@@ -108,9 +108,8 @@ func FilterInIndexedSegments(handler Handler, indexCoord types.IndexCoord, segme
 
 	segmentMap := make(map[int64]*SegmentInfo)
 	collectionSegments := make(map[int64][]int64)
-	// TODO(yah01): This can't handle the case of multiple vector fields exist,
-	// modify it if we support multiple vector fields.
-	vecFieldID := make(map[int64]int64)
+	// a segment is indexed only if all the vector fields of the collection are indexed
+	vecFieldIDs := make(map[int64][]int64)
 	for _, segment := range segments {
 		collectionID := segment.GetCollectionID()
 		segmentMap[segment.GetID()] = segment
@@ -127,8 +126,7 @@ func FilterInIndexedSegments(handler Handler, indexCoord types.IndexCoord, segme
 		for _, field := range coll.Schema.GetFields() {
 			if field.GetDataType() == schemapb.DataType_BinaryVector ||
 				field.GetDataType() == schemapb.DataType_FloatVector {
-				vecFieldID[collection] = field.GetFieldID()
-				break
+				vecFieldIDs[collection] = append(vecFieldIDs[collection], field.GetFieldID())
 			}
 		}
 	}
@@ -152,7 +150,7 @@ func FilterInIndexedSegments(handler Handler, indexCoord types.IndexCoord, segme
 					zap.Int64("segmentID", segment.GetID()))
 				return
 			}
-			indexed := extractSegmentsWithVectorIndex(vecFieldID, resp.GetSegmentInfo())
+			indexed := extractSegmentsWithVectorIndex(vecFieldIDs, resp.GetSegmentInfo())
 			if len(indexed) == 0 {
 				log.Info("no vector index for the segment",
 					zap.Int64("collectionID", segment.GetCollectionID()),
@@ -178,14 +176,16 @@ func FilterInIndexedSegments(handler Handler, indexCoord types.IndexCoord, segme
 	return indexedSegments
 }
 
-func extractSegmentsWithVectorIndex(vecFieldID map[int64]int64, segentIndexInfo map[int64]*indexpb.SegmentInfo) []int64 {
+func extractSegmentsWithVectorIndex(vecFieldIDs map[int64][]int64, segentIndexInfo map[int64]*indexpb.SegmentInfo) []int64 {
 	indexedSegments := make(typeutil.UniqueSet)
 	for _, indexInfo := range segentIndexInfo {
+		indexedFields := make(typeutil.UniqueSet)
 		for _, index := range indexInfo.GetIndexInfos() {
-			if index.GetFieldID() == vecFieldID[indexInfo.GetCollectionID()] {
-				indexedSegments.Insert(indexInfo.GetSegmentID())
-				break
-			}
+			indexedFields.Insert(index.GetFieldID())
+		}
+		fieldIDs := vecFieldIDs[indexInfo.GetCollectionID()]
+		if len(fieldIDs) > 0 && indexedFields.Contain(fieldIDs...) {
+			indexedSegments.Insert(indexInfo.GetSegmentID())
 		}
 	}
 	return indexedSegments.Collect()
@@ -24,6 +24,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
@@ -195,3 +196,27 @@ func (suite *UtilSuite) TestGetCollectionTTL() {
 	suite.NoError(err)
 	suite.Equal(ttl, Params.CommonCfg.EntityExpirationTTL.GetAsDuration(time.Second))
 }
+
+func (suite *UtilSuite) TestExtractSegmentsWithVectorIndex() {
+	vecFieldIDs := map[int64][]int64{1: {101, 102}}
+	segmentIndexInfo := map[int64]*indexpb.SegmentInfo{
+		10: {
+			CollectionID: 1,
+			SegmentID:    10,
+			IndexInfos:   []*indexpb.IndexFilePathInfo{{FieldID: 101}, {FieldID: 102}},
+		},
+		// only one of the vector fields is indexed
+		11: {
+			CollectionID: 1,
+			SegmentID:    11,
+			IndexInfos:   []*indexpb.IndexFilePathInfo{{FieldID: 101}},
+		},
+		// collection without vector field
+		20: {
+			CollectionID: 2,
+			SegmentID:    20,
+			IndexInfos:   []*indexpb.IndexFilePathInfo{{FieldID: 201}},
+		},
+	}
+	suite.ElementsMatch([]int64{10}, extractSegmentsWithVectorIndex(vecFieldIDs, segmentIndexInfo))
+}
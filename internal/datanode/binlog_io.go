@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/metautil"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -130,6 +131,23 @@ func (b *binlogIO) uploadSegmentFiles(
 	return nil
 }
 
+// setEncryptionKeyID records the id of the data key encrypting the binlogs in the segment meta,
+// so the segments encrypted by the old data keys could be found after the rotation.
+func (b *binlogIO) setEncryptionKeyID(ctx context.Context, collectionID UniqueID, fieldBinlogs ...[]*datapb.FieldBinlog) error {
+	keyID, err := storage.EncryptionKeyID(ctx, b.ChunkManager, collectionID)
+	if err != nil || keyID == "" {
+		return err
+	}
+	for _, binlogs := range fieldBinlogs {
+		for _, fieldBinlog := range binlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				binlog.EncryptionKeyId = keyID
+			}
+		}
+	}
+	return nil
+}
+
 type segPaths struct {
 	inPaths    []*datapb.FieldBinlog
 	statsPaths []*datapb.FieldBinlog
@@ -205,7 +223,7 @@ func (b *binlogIO) upload(
 
 	// If there are delta binlogs
 	if dData.RowCount > 0 {
-		k, v, err := b.genDeltaBlobs(dData, meta.GetID(), partID, segID)
+		k, v, err := b.genDeltaBlobs(dData, meta, partID, segID)
 		if err != nil {
 			log.Warn("generate delta blobs wrong",
 				zap.Int64("collectionID", meta.GetID()),
@@ -225,7 +243,12 @@ func (b *binlogIO) upload(
 		})
 	}
 
-	err := b.uploadSegmentFiles(ctx, meta.GetID(), segID, kvs)
+	err := b.setEncryptionKeyID(ctx, meta.GetID(), p.inPaths, p.statsPaths, p.deltaInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.uploadSegmentFiles(ctx, meta.GetID(), segID, kvs)
 	if err != nil {
 		return nil, err
 	}
@@ -233,8 +256,13 @@ func (b *binlogIO) upload(
 }
 
 // genDeltaBlobs returns key, value
-func (b *binlogIO) genDeltaBlobs(data *DeleteData, collID, partID, segID UniqueID) (string, []byte, error) {
-	dCodec := storage.NewDeleteCodec()
+func (b *binlogIO) genDeltaBlobs(data *DeleteData, meta *etcdpb.CollectionMeta, partID, segID UniqueID) (string, []byte, error) {
+	collID := meta.GetID()
+	compression, err := storage.GetBinlogCompression(meta.GetProperties())
+	if err != nil {
+		return "", nil, err
+	}
+	dCodec := storage.NewDeleteCodecWithCompression(compression)
 
 	blob, err := dCodec.Serialize(collID, partID, segID, data)
 	if err != nil {
@@ -381,6 +409,11 @@ func (b *binlogIO) uploadInsertLog(
 		statsField2Path[fID] = tmpBinlog
 	}
 
+	err = b.setEncryptionKeyID(ctx, meta.GetID(), lo.Values(insertField2Path), lo.Values(statsField2Path))
+	if err != nil {
+		return nil, nil, err
+	}
+
 	err = b.uploadSegmentFiles(ctx, meta.GetID(), segID, kvs)
 	if err != nil {
 		return nil, nil, err
@@ -400,7 +433,7 @@ func (b *binlogIO) uploadDeltaLog(
 	)
 
 	if dData.RowCount > 0 {
-		k, v, err := b.genDeltaBlobs(dData, meta.GetID(), partID, segID)
+		k, v, err := b.genDeltaBlobs(dData, meta, partID, segID)
 		if err != nil {
 			log.Warn("generate delta blobs wrong",
 				zap.Int64("collectionID", meta.GetID()),
@@ -422,7 +455,12 @@ func (b *binlogIO) uploadDeltaLog(
 		return nil, nil
 	}
 
-	err := b.uploadSegmentFiles(ctx, meta.GetID(), segID, kvs)
+	err := b.setEncryptionKeyID(ctx, meta.GetID(), deltaInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.uploadSegmentFiles(ctx, meta.GetID(), segID, kvs)
 	if err != nil {
 		return nil, err
 	}
@@ -18,14 +18,19 @@ package datanode
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
+	"os"
 	"path"
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
@@ -287,7 +292,7 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 					k, v, err := b.genDeltaBlobs(&DeleteData{
 						Pks: []primaryKey{test.deletepk},
 						Tss: []uint64{test.ts},
-					}, meta.GetID(), 10, 1)
+					}, meta, 10, 1)
 
 					assert.NoError(t, err)
 					assert.NotEmpty(t, k)
@@ -301,7 +306,7 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 
 	t.Run("Test genDeltaBlobs error", func(t *testing.T) {
 		pk := newInt64PrimaryKey(1)
-		k, v, err := b.genDeltaBlobs(&DeleteData{Pks: []primaryKey{pk}, Tss: []uint64{}}, 1, 1, 1)
+		k, v, err := b.genDeltaBlobs(&DeleteData{Pks: []primaryKey{pk}, Tss: []uint64{}}, &etcdpb.CollectionMeta{ID: 1}, 1, 1)
 		assert.Error(t, err)
 		assert.Empty(t, k)
 		assert.Empty(t, v)
@@ -310,10 +315,32 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 		errAlloc.isvalid = false
 
 		bin := binlogIO{cm, errAlloc}
-		k, v, err = bin.genDeltaBlobs(&DeleteData{Pks: []primaryKey{pk}, Tss: []uint64{1}}, 1, 1, 1)
+		k, v, err = bin.genDeltaBlobs(&DeleteData{Pks: []primaryKey{pk}, Tss: []uint64{1}}, &etcdpb.CollectionMeta{ID: 1}, 1, 1)
 		assert.Error(t, err)
 		assert.Empty(t, k)
 		assert.Empty(t, v)
+
+		meta := &etcdpb.CollectionMeta{ID: 1, Properties: []*commonpb.KeyValuePair{
+			{Key: common.CollectionBinlogCompressionKey, Value: "gzip"},
+		}}
+		k, v, err = b.genDeltaBlobs(&DeleteData{Pks: []primaryKey{pk}, Tss: []uint64{1}}, meta, 1, 1)
+		assert.Error(t, err)
+		assert.Empty(t, k)
+		assert.Empty(t, v)
+	})
+
+	t.Run("Test genDeltaBlobs with compression", func(t *testing.T) {
+		meta := &etcdpb.CollectionMeta{ID: 1, Properties: []*commonpb.KeyValuePair{
+			{Key: common.CollectionBinlogCompressionKey, Value: "lz4"},
+		}}
+		deleteData := &DeleteData{Pks: []primaryKey{newInt64PrimaryKey(1)}, Tss: []uint64{1}, RowCount: 1}
+		k, v, err := b.genDeltaBlobs(deleteData, meta, 1, 1)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, k)
+
+		_, _, data, err := storage.NewDeleteCodec().Deserialize([]*Blob{{Key: k, Value: v}})
+		assert.NoError(t, err)
+		assert.Equal(t, deleteData, data)
 	})
 
 	t.Run("Test genInsertBlobs", func(t *testing.T) {
@@ -471,3 +498,45 @@ func (mk *mockCm) Remove(ctx context.Context, key string) error           { retu
 func (mk *mockCm) MultiRemove(ctx context.Context, keys []string) error   { return nil }
 func (mk *mockCm) RemoveWithPrefix(ctx context.Context, key string) error { return nil }
 func (mk *mockCm) Close()                                                 {}
+
+func TestBinlogIOEncryptionKeyID(t *testing.T) {
+	ctx := context.Background()
+	keyFile := path.Join(t.TempDir(), "keys.json")
+	masterKey := base64.StdEncoding.EncodeToString(make([]byte, 32))
+	require.NoError(t, os.WriteFile(keyFile, []byte(`{"current": "key1", "keys": {"key1": "`+masterKey+`"}}`), 0600))
+	kms, err := storage.NewLocalKMS(keyFile)
+	require.NoError(t, err)
+
+	cm := storage.NewEncryptedChunkManager(storage.NewLocalChunkManager(storage.RootPath(t.TempDir())), kms)
+	b := &binlogIO{cm, NewAllocatorFactory()}
+	f := &MetaFactory{}
+	meta := f.GetCollectionMeta(UniqueID(10001), "uploads", schemapb.DataType_Int64)
+	dData := &DeleteData{
+		RowCount: 1,
+		Pks:      []primaryKey{newInt64PrimaryKey(888)},
+		Tss:      []uint64{666666},
+	}
+
+	p, err := b.upload(ctx, 1, 10, []*InsertData{genInsertData()}, dData, meta)
+	require.NoError(t, err)
+	keyID, err := cm.CurrentKeyID(ctx, meta.GetID())
+	require.NoError(t, err)
+	assert.NotEmpty(t, keyID)
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{p.inPaths, p.statsPaths, p.deltaInfo} {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				assert.Equal(t, keyID, binlog.GetEncryptionKeyId())
+			}
+		}
+	}
+
+	blobs, err := b.download(ctx, []string{p.inPaths[0].GetBinlogs()[0].GetLogPath()})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(blobs))
+
+	// the binlogs are not encrypted by plain chunk manager.
+	b = &binlogIO{storage.NewLocalChunkManager(storage.RootPath(t.TempDir())), NewAllocatorFactory()}
+	deltas, err := b.uploadDeltaLog(ctx, 1, 10, dData, meta)
+	require.NoError(t, err)
+	assert.Empty(t, deltas[0].GetBinlogs()[0].GetEncryptionKeyId())
+}
@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
@@ -46,12 +47,18 @@ var (
 	newVarCharPrimaryKey = storage.NewVarCharPrimaryKey
 )
 
+// collectionPropertiesTTL is how long the collection properties are cached by the channel.
+const collectionPropertiesTTL = time.Minute
+
 // Channel is DataNode unique replication
 type Channel interface {
 	getCollectionID() UniqueID
 	getCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
+	refreshCollectionSchema(collectionID UniqueID, schemaVersion int32, ts Timestamp) error
+	getCollectionProperties(collectionID UniqueID) ([]*commonpb.KeyValuePair, error)
 	getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error)
 	getChannelName(segID UniqueID) string
+	getSegmentStorageVersion(segID UniqueID) int64
 
 	listAllSegmentIDs() []UniqueID
 	listNotFlushedSegmentIDs() []UniqueID
@@ -92,7 +99,13 @@ type ChannelMeta struct {
 	collectionID UniqueID
 	channelName  string
 	collSchema   *schemapb.CollectionSchema
-	schemaMut    sync.RWMutex
+	// schemaVersion is the newest schema version seen in the insert messages, it's bumped each time a field is added
+	schemaVersion int32
+	schemaMut     sync.RWMutex
+
+	collProperties     []*commonpb.KeyValuePair
+	propertiesExpireAt time.Time
+	propertiesMut      sync.Mutex
 
 	segMu    sync.RWMutex
 	segments map[UniqueID]*Segment
@@ -111,6 +124,7 @@ type addSegmentReq struct {
 	statsBinLogs               []*datapb.FieldBinlog
 	recoverTs                  Timestamp
 	importing                  bool
+	storageVersion             int64
 }
 
 var _ Channel = &ChannelMeta{}
@@ -169,6 +183,17 @@ func (c *ChannelMeta) getChannelName(segID UniqueID) string {
 	return c.channelName
 }
 
+// getSegmentStorageVersion returns the storage version of the binlogs flushed for the segment.
+func (c *ChannelMeta) getSegmentStorageVersion(segID UniqueID) int64 {
+	c.segMu.RLock()
+	defer c.segMu.RUnlock()
+
+	if seg, ok := c.segments[segID]; ok {
+		return seg.storageVersion
+	}
+	return storage.StorageV1
+}
+
 // maxRowCountPerSegment returns max row count for a segment based on estimation of row size.
 func (c *ChannelMeta) maxRowCountPerSegment(ts Timestamp) (int64, error) {
 	log := log.With(zap.Int64("collectionID", c.collectionID), zap.Uint64("timpstamp", ts))
@@ -205,6 +230,7 @@ func (c *ChannelMeta) addSegment(req addSegmentReq) error {
 		zap.Any("endPosition", req.endPos),
 		zap.Uint64("recoverTs", req.recoverTs),
 		zap.Bool("importing", req.importing),
+		zap.Int64("storageVersion", req.storageVersion),
 	)
 	seg := &Segment{
 		collectionID:     req.collID,
@@ -215,6 +241,7 @@ func (c *ChannelMeta) addSegment(req addSegmentReq) error {
 		historyDeleteBuf: make([]*DelDataBuf, 0),
 		startPos:         req.startPos,
 		lastSyncTs:       req.recoverTs,
+		storageVersion:   req.storageVersion,
 	}
 	seg.setType(req.segType)
 	// Set up pk stats
@@ -523,6 +550,53 @@ func (c *ChannelMeta) getCollectionSchema(collID UniqueID, ts Timestamp) (*schem
 	return c.collSchema, nil
 }
 
+// refreshCollectionSchema reloads collection schema from rootcoord if the schema version is newer than the cached one,
+// the insert messages are tagged with the schema version of the proxy cache.
+func (c *ChannelMeta) refreshCollectionSchema(collID UniqueID, schemaVersion int32, ts Timestamp) error {
+	if !c.validCollection(collID) {
+		return fmt.Errorf("mismatch collection, want %d, actual %d", c.collectionID, collID)
+	}
+
+	c.schemaMut.Lock()
+	defer c.schemaMut.Unlock()
+	if schemaVersion <= c.schemaVersion {
+		return nil
+	}
+	sch, err := c.metaService.getCollectionSchema(context.Background(), collID, ts)
+	if err != nil {
+		return err
+	}
+	log.Info("collection schema refreshed", zap.Int64("collectionID", collID),
+		zap.Int32("old version", c.schemaVersion), zap.Int32("new version", schemaVersion))
+	c.collSchema = sch
+	c.schemaVersion = schemaVersion
+	return nil
+}
+
+// getCollectionProperties gets the collection properties from rootcoord, the properties are cached for
+// collectionPropertiesTTL so the altered ones are picked up later. The cached properties are returned
+// if rootcoord is unavailable.
+func (c *ChannelMeta) getCollectionProperties(collID UniqueID) ([]*commonpb.KeyValuePair, error) {
+	if !c.validCollection(collID) {
+		return nil, fmt.Errorf("mismatch collection, want %d, actual %d", c.collectionID, collID)
+	}
+
+	c.propertiesMut.Lock()
+	defer c.propertiesMut.Unlock()
+	if time.Now().Before(c.propertiesExpireAt) {
+		return c.collProperties, nil
+	}
+	info, err := c.metaService.getCollectionInfo(context.Background(), collID, 0)
+	if err != nil {
+		log.Warn("failed to get collection properties, use the cached ones",
+			zap.Int64("collectionID", collID), zap.Error(err))
+		return c.collProperties, nil
+	}
+	c.collProperties = info.GetProperties()
+	c.propertiesExpireAt = time.Now().Add(collectionPropertiesTTL)
+	return c.collProperties, nil
+}
+
 func (c *ChannelMeta) validCollection(collID UniqueID) bool {
 	return collID == c.collectionID
 }
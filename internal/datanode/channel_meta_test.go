@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/samber/lo"
@@ -30,6 +31,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -462,6 +464,31 @@ func TestChannelMeta_InterfaceMethod(t *testing.T) {
 		rc.setCollectionID(1)
 	})
 
+	t.Run("Test_getCollectionProperties", func(t *testing.T) {
+		channel := newChannel("a", 1, nil, rc, cm)
+		_, err := channel.getCollectionProperties(2)
+		assert.Error(t, err)
+
+		properties, err := channel.getCollectionProperties(1)
+		assert.NoError(t, err)
+		assert.Empty(t, properties)
+		assert.True(t, channel.propertiesExpireAt.After(time.Now()))
+
+		// the cached properties are used if rootcoord fails
+		cached := []*commonpb.KeyValuePair{{Key: common.CollectionBinlogCompressionKey, Value: "lz4"}}
+		channel.collProperties = cached
+		channel.propertiesExpireAt = time.Now()
+		rc.setCollectionID(-1)
+		properties, err = channel.getCollectionProperties(1)
+		assert.NoError(t, err)
+		assert.Equal(t, cached, properties)
+
+		rc.setCollectionID(1)
+		properties, err = channel.getCollectionProperties(1)
+		assert.NoError(t, err)
+		assert.Empty(t, properties)
+	})
+
 	t.Run("Test listAllSegmentIDs", func(t *testing.T) {
 		s1 := Segment{segmentID: 1}
 		s2 := Segment{segmentID: 2}
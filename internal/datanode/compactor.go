@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/samber/lo"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -251,6 +252,7 @@ func (t *compactionTask) merge(
 
 		fID2Type    = make(map[UniqueID]schemapb.DataType)
 		fID2Content = make(map[UniqueID][]interface{})
+		fID2Default = make(map[UniqueID]interface{})
 
 		insertField2Path = make(map[UniqueID]*datapb.FieldBinlog)
 		insertPaths      = make([]*datapb.FieldBinlog, 0)
@@ -261,7 +263,8 @@ func (t *compactionTask) merge(
 
 	isDeletedValue := func(v *storage.Value) bool {
 		ts, ok := delta[v.PK.GetValue()]
-		if ok && uint64(v.Timestamp) <= ts {
+		// the row inserted by an upsert shares the timestamp with the delete of the old row
+		if ok && uint64(v.Timestamp) < ts {
 			return true
 		}
 		return false
@@ -298,6 +301,17 @@ func (t *compactionTask) merge(
 			pkID = fs.GetFieldID()
 			pkType = fs.GetDataType()
 		}
+		// the rows written before a field was added read back the default value, or null
+		if typeutil.IsNullByDefault(fs) {
+			fID2Default[fs.GetFieldID()] = nil
+		} else if typeutil.HasDefaultValue(fs) {
+			defaultData, err := storage.GenDefaultFieldData(fs, 1)
+			if err != nil {
+				log.Warn("failed to generate default value", zap.Int64("fieldID", fs.GetFieldID()), zap.Error(err))
+				return nil, nil, 0, err
+			}
+			fID2Default[fs.GetFieldID()] = defaultData.GetRow(0)
+		}
 	}
 
 	// estimate Rows per binlog
@@ -360,6 +374,11 @@ func (t *compactionTask) merge(
 				return nil, nil, 0, errors.New("unexpected error")
 			}
 
+			for fID, value := range fID2Default {
+				if _, ok := row[fID]; !ok {
+					row[fID] = value
+				}
+			}
 			for fID, vInter := range row {
 				if _, ok := fID2Content[fID]; !ok {
 					fID2Content[fID] = make([]interface{}, 0)
@@ -595,7 +614,8 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 			for _, f := range s.GetFieldBinlogs() {
 				ps = append(ps, f.GetBinlogs()[idx].GetLogPath())
 			}
-			allPs = append(allPs, ps)
+			// the fields share the same columnar binlog if the segment is of StorageV2
+			allPs = append(allPs, lo.Uniq(ps))
 		}
 
 		segID := s.GetSegmentID()
@@ -691,9 +711,52 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 }
 
 // TODO copy maybe expensive, but this seems to be the only convinent way.
+// nullRowValue returns the value written to the null rows of nullable fields.
+func nullRowValue(schemaDataType schemapb.DataType) interface{} {
+	switch schemaDataType {
+	case schemapb.DataType_Bool:
+		return false
+	case schemapb.DataType_Int8:
+		return int8(0)
+	case schemapb.DataType_Int16:
+		return int16(0)
+	case schemapb.DataType_Int32:
+		return int32(0)
+	case schemapb.DataType_Int64:
+		return int64(0)
+	case schemapb.DataType_Float:
+		return float32(0)
+	case schemapb.DataType_Double:
+		return float64(0)
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return ""
+	case typeutil.DataTypeJSON:
+		return []byte("{}")
+	case typeutil.DataTypeArray:
+		return &schemapb.ScalarField{}
+	}
+	return nil
+}
+
 func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}, numRows int64) (storage.FieldData, error) {
 	var rst storage.FieldData
 	numOfRows := []int64{numRows}
+
+	// the null rows are nil, they are written as placeholders with validity false
+	var validData []bool
+	for i, c := range content {
+		if c != nil {
+			continue
+		}
+		if validData == nil {
+			validData = make([]bool, len(content))
+			for j := range validData {
+				validData[j] = true
+			}
+		}
+		validData[i] = false
+		content[i] = nullRowValue(schemaDataType)
+	}
 	switch schemaDataType {
 	case schemapb.DataType_Bool:
 		var data = &storage.BoolFieldData{
@@ -815,6 +878,37 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 		}
 		rst = data
 
+	case typeutil.DataTypeJSON:
+		var data = &storage.JSONFieldData{
+			NumRows: numOfRows,
+			Data:    make([][]byte, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r)
+		}
+		rst = data
+
+	case typeutil.DataTypeArray:
+		// the element type is only informative, the arrays are serialized as they are
+		var data = &storage.ArrayFieldData{
+			NumRows: numOfRows,
+			Data:    make([]*schemapb.ScalarField, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.(*schemapb.ScalarField)
+			if !ok {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r)
+		}
+		rst = data
+
 	case schemapb.DataType_FloatVector:
 		var data = &storage.FloatVectorFieldData{
 			NumRows: numOfRows,
@@ -853,6 +947,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 		return nil, errUnknownDataType
 	}
 
+	if validData != nil {
+		nullableData, ok := rst.(storage.NullableFieldData)
+		if !ok {
+			return nil, errTransferType
+		}
+		nullableData.SetValidData(validData)
+	}
+
 	return rst, nil
 }
 
@@ -868,10 +970,15 @@ func (t *compactionTask) getSegmentMeta(segID UniqueID) (UniqueID, UniqueID, *et
 	if err != nil {
 		return -1, -1, nil, err
 	}
+	properties, err := t.getCollectionProperties(collID)
+	if err != nil {
+		return -1, -1, nil, err
+	}
 
 	meta := &etcdpb.CollectionMeta{
-		ID:     collID,
-		Schema: sch,
+		ID:         collID,
+		Schema:     sch,
+		Properties: properties,
 	}
 	return collID, partID, meta, nil
 }
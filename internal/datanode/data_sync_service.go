@@ -206,14 +206,16 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		segment := us
 		future := getOrCreateIOPool().Submit(func() (interface{}, error) {
 			if err := dsService.channel.addSegment(addSegmentReq{
-				segType:      datapb.SegmentType_Normal,
-				segID:        segment.GetID(),
-				collID:       segment.CollectionID,
-				partitionID:  segment.PartitionID,
-				numOfRows:    segment.GetNumOfRows(),
-				statsBinLogs: segment.Statslogs,
-				endPos:       segment.GetDmlPosition(),
-				recoverTs:    vchanInfo.GetSeekPosition().GetTimestamp()}); err != nil {
+				segType:        datapb.SegmentType_Normal,
+				segID:          segment.GetID(),
+				collID:         segment.CollectionID,
+				partitionID:    segment.PartitionID,
+				numOfRows:      segment.GetNumOfRows(),
+				statsBinLogs:   segment.Statslogs,
+				endPos:         segment.GetDmlPosition(),
+				recoverTs:      vchanInfo.GetSeekPosition().GetTimestamp(),
+				storageVersion: storageVersionOf(segment),
+			}); err != nil {
 				return nil, err
 			}
 			return nil, nil
@@ -241,13 +243,14 @@ func (dsService *dataSyncService) initNodes(vchanInfo *datapb.VchannelInfo) erro
 		segment := fs
 		future := getOrCreateIOPool().Submit(func() (interface{}, error) {
 			if err := dsService.channel.addSegment(addSegmentReq{
-				segType:      datapb.SegmentType_Flushed,
-				segID:        segment.GetID(),
-				collID:       segment.CollectionID,
-				partitionID:  segment.PartitionID,
-				numOfRows:    segment.GetNumOfRows(),
-				statsBinLogs: segment.Statslogs,
-				recoverTs:    vchanInfo.GetSeekPosition().GetTimestamp(),
+				segType:        datapb.SegmentType_Flushed,
+				segID:          segment.GetID(),
+				collID:         segment.CollectionID,
+				partitionID:    segment.PartitionID,
+				numOfRows:      segment.GetNumOfRows(),
+				statsBinLogs:   segment.Statslogs,
+				recoverTs:      vchanInfo.GetSeekPosition().GetTimestamp(),
+				storageVersion: segment.GetStorageVersion(),
 			}); err != nil {
 				return nil, err
 			}
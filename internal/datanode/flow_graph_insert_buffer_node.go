@@ -473,12 +473,13 @@ func (ibNode *insertBufferNode) updateSegmentStates(insertMsgs []*msgstream.Inse
 		if !ibNode.channel.hasSegment(currentSegID, true) {
 			err = ibNode.channel.addSegment(
 				addSegmentReq{
-					segType:     datapb.SegmentType_New,
-					segID:       currentSegID,
-					collID:      collID,
-					partitionID: partitionID,
-					startPos:    startPos,
-					endPos:      endPos,
+					segType:        datapb.SegmentType_New,
+					segID:          currentSegID,
+					collID:         collID,
+					partitionID:    partitionID,
+					startPos:       startPos,
+					endPos:         endPos,
+					storageVersion: storageVersionOf(nil), // a new segment has no binlogs yet
 				})
 			if err != nil {
 				log.Warn("add segment wrong",
@@ -517,6 +518,10 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, startP
 	currentSegID := msg.GetSegmentID()
 	collectionID := msg.GetCollectionID()
 
+	if err := ibNode.channel.refreshCollectionSchema(collectionID, msg.GetSchemaVersion(), msg.EndTs()); err != nil {
+		log.Warn("refresh schema wrong:", zap.Error(err))
+		return err
+	}
 	collSchema, err := ibNode.channel.getCollectionSchema(collectionID, msg.EndTs())
 	if err != nil {
 		log.Warn("Get schema wrong:", zap.Error(err))
@@ -532,6 +537,11 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, startP
 		if err != nil {
 			return fmt.Errorf("newBufferData failed, segment=%d, channel=%s, err=%w", currentSegID, ibNode.channelName, err)
 		}
+	} else if buffer.size > 0 {
+		// the rows buffered before a field was added read back the default value
+		if err := storage.FillMissingFields(collSchema, buffer.buffer, int(buffer.size)); err != nil {
+			return err
+		}
 	}
 
 	addedBuffer, err := storage.InsertMsgToInsertData(msg, collSchema)
@@ -364,6 +364,10 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 	// encode data and convert output data
 	inCodec := storage.NewInsertCodec(meta)
 
+	if m.getSegmentStorageVersion(segmentID) == storage.StorageV2 {
+		return m.flushBufferDataV2(inCodec, data, fieldMemorySize, collID, partID, segmentID, flushed, dropped, pos, tr)
+	}
+
 	binLogs, statsBinlogs, err := inCodec.Serialize(partID, segmentID, data.buffer)
 	if err != nil {
 		return nil, err
@@ -375,6 +379,12 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		return nil, err
 	}
 
+	// the id of the data key encrypting the binlogs, empty if the encryption is disabled
+	keyID, err := storage.EncryptionKeyID(context.Background(), m.ChunkManager, collID)
+	if err != nil {
+		return nil, err
+	}
+
 	field2Insert := make(map[UniqueID]*datapb.Binlog, len(binLogs))
 	kvs := make(map[string][]byte, len(binLogs))
 	for idx, blob := range binLogs {
@@ -393,11 +403,12 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		key := path.Join(m.ChunkManager.RootPath(), common.SegmentInsertLogPath, k)
 		kvs[key] = blob.Value[:]
 		field2Insert[fieldID] = &datapb.Binlog{
-			EntriesNum:    data.size,
-			TimestampFrom: data.tsFrom,
-			TimestampTo:   data.tsTo,
-			LogPath:       key,
-			LogSize:       int64(fieldMemorySize[fieldID]),
+			EntriesNum:      data.size,
+			TimestampFrom:   data.tsFrom,
+			TimestampTo:     data.tsTo,
+			LogPath:         key,
+			LogSize:         int64(fieldMemorySize[fieldID]),
+			EncryptionKeyId: keyID,
 		}
 	}
 
@@ -418,11 +429,75 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		key := path.Join(m.ChunkManager.RootPath(), common.SegmentStatslogPath, k)
 		kvs[key] = blob.Value
 		field2Stats[fieldID] = &datapb.Binlog{
-			EntriesNum:    0,
-			TimestampFrom: 0, //TODO
-			TimestampTo:   0, //TODO,
-			LogPath:       key,
-			LogSize:       int64(len(blob.Value)),
+			EntriesNum:      0,
+			TimestampFrom:   0, //TODO
+			TimestampTo:     0, //TODO,
+			LogPath:         key,
+			LogSize:         int64(len(blob.Value)),
+			EncryptionKeyId: keyID,
+		}
+	}
+
+	m.handleInsertTask(segmentID, &flushBufferInsertTask{
+		ChunkManager: m.ChunkManager,
+		data:         kvs,
+	}, field2Insert, field2Stats, flushed, dropped, pos)
+
+	metrics.DataNodeEncodeBufferLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return statsBinlogs, nil
+}
+
+// flushBufferDataV2 writes all the fields of the buffer into a columnar binlog shared by the fields.
+func (m *rendezvousFlushManager) flushBufferDataV2(inCodec *storage.InsertCodec, data *BufferData, fieldMemorySize map[int64]int,
+	collID, partID, segmentID UniqueID, flushed bool, dropped bool, pos *internalpb.MsgPosition, tr *timerecord.TimeRecorder) ([]*Blob, error) {
+	binLog, statsBinlogs, err := inCodec.SerializeV2(partID, segmentID, data.buffer)
+	if err != nil {
+		return nil, err
+	}
+
+	start, _, err := m.allocIDBatch(uint32(1 + len(statsBinlogs)))
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, err := storage.EncryptionKeyID(context.Background(), m.ChunkManager, collID)
+	if err != nil {
+		return nil, err
+	}
+
+	// [rootPath]/[insert_log]/[collID]/[partID]/[segmentID]/[columnar]/[logID]
+	key := path.Join(m.ChunkManager.RootPath(), common.SegmentInsertLogPath,
+		metautil.JoinIDPath(collID, partID, segmentID), common.SegmentColumnarLogDir, strconv.FormatInt(start, 10))
+	kvs := map[string][]byte{key: binLog.Value}
+
+	// every field refers to the shared binlog, the log size of a field is its memory size
+	field2Insert := make(map[UniqueID]*datapb.Binlog, len(data.buffer.Data))
+	for fieldID := range data.buffer.Data {
+		field2Insert[fieldID] = &datapb.Binlog{
+			EntriesNum:      data.size,
+			TimestampFrom:   data.tsFrom,
+			TimestampTo:     data.tsTo,
+			LogPath:         key,
+			LogSize:         int64(fieldMemorySize[fieldID]),
+			EncryptionKeyId: keyID,
+		}
+	}
+
+	field2Stats := make(map[UniqueID]*datapb.Binlog)
+	for idx, blob := range statsBinlogs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		if err != nil {
+			log.Error("Flush failed ... cannot parse string to fieldID ..", zap.Error(err))
+			return nil, err
+		}
+
+		k := metautil.JoinIDPath(collID, partID, segmentID, fieldID, start+UniqueID(1+idx))
+		statsKey := path.Join(m.ChunkManager.RootPath(), common.SegmentStatslogPath, k)
+		kvs[statsKey] = blob.Value
+		field2Stats[fieldID] = &datapb.Binlog{
+			LogPath:         statsKey,
+			LogSize:         int64(len(blob.Value)),
+			EncryptionKeyId: keyID,
 		}
 	}
 
@@ -450,7 +525,15 @@ func (m *rendezvousFlushManager) flushDelData(data *DelDataBuf, segmentID Unique
 		return err
 	}
 
-	delCodec := storage.NewDeleteCodec()
+	properties, err := m.getCollectionProperties(collID)
+	if err != nil {
+		return err
+	}
+	compression, err := storage.GetBinlogCompression(properties)
+	if err != nil {
+		return err
+	}
+	delCodec := storage.NewDeleteCodecWithCompression(compression)
 
 	blob, err := delCodec.Serialize(collID, partID, segmentID, data.delData)
 	if err != nil {
@@ -463,11 +546,17 @@ func (m *rendezvousFlushManager) flushDelData(data *DelDataBuf, segmentID Unique
 		return err
 	}
 
+	keyID, err := storage.EncryptionKeyID(context.Background(), m.ChunkManager, collID)
+	if err != nil {
+		return err
+	}
+
 	blobKey := metautil.JoinIDPath(collID, partID, segmentID, logID)
 	blobPath := path.Join(m.ChunkManager.RootPath(), common.SegmentDeltaLogPath, blobKey)
 	kvs := map[string][]byte{blobPath: blob.Value[:]}
 	data.LogSize = int64(len(blob.Value))
 	data.LogPath = blobPath
+	data.EncryptionKeyId = keyID
 	log.Info("delete blob path", zap.String("path", blobPath))
 	m.handleDeleteTask(segmentID, &flushBufferDeleteTask{
 		ChunkManager: m.ChunkManager,
@@ -499,10 +588,15 @@ func (m *rendezvousFlushManager) getSegmentMeta(segmentID UniqueID, pos *interna
 	if err != nil {
 		return -1, -1, nil, err
 	}
+	properties, err := m.getCollectionProperties(collID)
+	if err != nil {
+		return -1, -1, nil, err
+	}
 
 	meta := &etcdpb.CollectionMeta{
-		ID:     collID,
-		Schema: sch,
+		ID:         collID,
+		Schema:     sch,
+		Properties: properties,
 	}
 	return collID, partID, meta, nil
 }
@@ -811,6 +905,7 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 			StartPositions: startPos,
 			Flushed:        pack.flushed,
 			Dropped:        pack.dropped,
+			StorageVersion: dsService.channel.getSegmentStorageVersion(pack.segmentID),
 		}
 		err := retry.Do(context.Background(), func() error {
 			rsp, err := dsService.dataCoord.SaveBinlogPaths(context.Background(), req)
@@ -20,12 +20,14 @@ import (
 	"context"
 	"crypto/rand"
 	"errors"
+	"path"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -185,6 +187,51 @@ func TestRendezvousFlushManager(t *testing.T) {
 	assert.EqualValues(t, size, counter.Load())
 }
 
+func TestRendezvousFlushManager_flushBufferDataV2(t *testing.T) {
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	collID := UniqueID(1)
+	channel := newChannel("channel", collID, nil, &RootCoordFactory{collectionID: collID, pkType: schemapb.DataType_Int64}, cm)
+	require.NoError(t, channel.addSegment(addSegmentReq{
+		segType:        datapb.SegmentType_New,
+		segID:          10,
+		collID:         collID,
+		partitionID:    2,
+		storageVersion: storage.StorageV2,
+	}))
+
+	packCh := make(chan *segmentFlushPack, 1)
+	m := NewRendezvousFlushManager(NewAllocatorFactory(), cm, channel, func(pack *segmentFlushPack) {
+		packCh <- pack
+	}, emptyFlushAndDropFunc)
+
+	data := genInsertData()
+	pos := &internalpb.MsgPosition{MsgID: []byte{1}}
+	_, err := m.flushBufferData(&BufferData{buffer: data, size: 2}, 10, true, false, pos)
+	require.NoError(t, err)
+	require.NoError(t, m.flushDelData(nil, 10, pos))
+	pack := <-packCh
+	require.NoError(t, pack.err)
+
+	// all the fields refer to the same columnar binlog
+	require.Equal(t, len(data.Data), len(pack.insertLogs))
+	logPath := pack.insertLogs[common.RowIDField].GetLogPath()
+	assert.Equal(t, common.SegmentColumnarLogDir, path.Base(path.Dir(logPath)))
+	for _, binlog := range pack.insertLogs {
+		assert.Equal(t, logPath, binlog.GetLogPath())
+		assert.EqualValues(t, 2, binlog.GetEntriesNum())
+	}
+	assert.Equal(t, 1, len(pack.statsLogs))
+
+	value, err := cm.Read(ctx, logPath)
+	require.NoError(t, err)
+	assert.True(t, storage.IsColumnarBinlog(value))
+	_, _, _, insertData, err := storage.NewInsertCodec(nil).DeserializeAll([]*Blob{{Key: logPath, Value: value}})
+	require.NoError(t, err)
+	assert.Equal(t, len(data.Data), len(insertData.Data))
+	assert.Equal(t, 2, insertData.Data[common.RowIDField].RowNum())
+}
+
 func TestRendezvousFlushManager_Inject(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
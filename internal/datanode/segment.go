@@ -52,6 +52,20 @@ type Segment struct {
 
 	lastSyncTs Timestamp
 	startPos   *internalpb.MsgPosition // TODO readonly
+
+	storageVersion int64
+}
+
+// storageVersionOf returns the storage version to flush the segment with,
+// the segments already having binlogs keep their version, the others use the configured one.
+func storageVersionOf(info *datapb.SegmentInfo) int64 {
+	if len(info.GetBinlogs()) > 0 {
+		return info.GetStorageVersion()
+	}
+	if Params.CommonCfg.EnableStorageV2.GetAsBool() {
+		return storage.StorageV2
+	}
+	return storage.StorageV1
 }
 
 func (s *Segment) isValid() bool {
@@ -565,7 +565,7 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 	importWrapper := importutil.NewImportWrapper(newCtx, colInfo.GetSchema(), colInfo.GetShardsNum(), segmentSize, node.rowIDAllocator,
 		node.chunkManager, importResult, reportFunc)
 	importWrapper.SetCallbackFunctions(assignSegmentFunc(node, req),
-		createBinLogsFunc(node, req, colInfo.GetSchema(), ts),
+		createBinLogsFunc(node, req, colInfo.GetSchema(), colInfo.GetProperties(), ts),
 		saveSegmentFunc(node, req, importResult, ts))
 	// todo: pass tsStart and tsStart after import_wrapper support
 	tsStart, tsEnd, err := importutil.ParseTSFromOptions(req.GetImportTask().GetInfos())
@@ -573,9 +573,14 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 	if err != nil {
 		return returnFailFunc(err)
 	}
+	csvDelimiter, csvQuote, err := importutil.ParseCSVFromOptions(req.GetImportTask().GetInfos())
+	if err != nil {
+		return returnFailFunc(err)
+	}
 	log.Info("import time range", zap.Uint64("start_ts", tsStart), zap.Uint64("end_ts", tsEnd))
 	err = importWrapper.Import(req.GetImportTask().GetFiles(),
-		importutil.ImportOptions{OnlyValidate: false, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup})
+		importutil.ImportOptions{OnlyValidate: false, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup,
+			CSVDelimiter: csvDelimiter, CSVQuote: csvQuote})
 	if err != nil {
 		return returnFailFunc(err)
 	}
@@ -717,7 +722,8 @@ func assignSegmentFunc(node *DataNode, req *datapb.ImportTaskRequest) importutil
 	}
 }
 
-func createBinLogsFunc(node *DataNode, req *datapb.ImportTaskRequest, schema *schemapb.CollectionSchema, ts Timestamp) importutil.CreateBinlogsFunc {
+func createBinLogsFunc(node *DataNode, req *datapb.ImportTaskRequest, schema *schemapb.CollectionSchema,
+	properties []*commonpb.KeyValuePair, ts Timestamp) importutil.CreateBinlogsFunc {
 	return func(fields map[storage.FieldID]storage.FieldData, segmentID int64) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, error) {
 		var rowNum int
 		for _, field := range fields {
@@ -739,7 +745,7 @@ func createBinLogsFunc(node *DataNode, req *datapb.ImportTaskRequest, schema *sc
 		colID := req.GetImportTask().GetCollectionId()
 		partID := req.GetImportTask().GetPartitionId()
 
-		fieldInsert, fieldStats, err := createBinLogs(rowNum, schema, ts, fields, node, segmentID, colID, partID)
+		fieldInsert, fieldStats, err := createBinLogs(rowNum, schema, properties, ts, fields, node, segmentID, colID, partID)
 		if err != nil {
 			log.Error("failed to create binlogs",
 				zap.Int64("task ID", importTaskID),
@@ -855,7 +861,7 @@ func composeAssignSegmentIDRequest(rowNum int, shardID int, chNames []string,
 	return segmentIDReq
 }
 
-func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
+func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, properties []*commonpb.KeyValuePair, ts Timestamp,
 	fields map[storage.FieldID]storage.FieldData, node *DataNode, segmentID, colID, partID UniqueID) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, error) {
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -884,8 +890,9 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 	}}
 	data.updateSize(int64(rowNum))
 	meta := &etcdpb.CollectionMeta{
-		ID:     colID,
-		Schema: schema,
+		ID:         colID,
+		Schema:     schema,
+		Properties: properties,
 	}
 	binLogs, statsBinLogs, err := storage.NewInsertCodec(meta).Serialize(partID, segmentID, data.buffer)
 	if err != nil {
@@ -898,6 +905,11 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 		return nil, nil, err
 	}
 
+	keyID, err := storage.EncryptionKeyID(ctx, node.chunkManager, colID)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	field2Insert := make(map[UniqueID]*datapb.Binlog, len(binLogs))
 	kvs := make(map[string][]byte, len(binLogs))
 	field2Logidx := make(map[UniqueID]UniqueID, len(binLogs))
@@ -916,11 +928,12 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 		key := path.Join(node.chunkManager.RootPath(), common.SegmentInsertLogPath, k)
 		kvs[key] = blob.Value[:]
 		field2Insert[fieldID] = &datapb.Binlog{
-			EntriesNum:    data.size,
-			TimestampFrom: ts,
-			TimestampTo:   ts,
-			LogPath:       key,
-			LogSize:       int64(len(blob.Value)),
+			EntriesNum:      data.size,
+			TimestampFrom:   ts,
+			TimestampTo:     ts,
+			LogPath:         key,
+			LogSize:         int64(len(blob.Value)),
+			EncryptionKeyId: keyID,
 		}
 		field2Logidx[fieldID] = logidx
 	}
@@ -942,11 +955,12 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 		key := path.Join(node.chunkManager.RootPath(), common.SegmentStatslogPath, k)
 		kvs[key] = blob.Value
 		field2Stats[fieldID] = &datapb.Binlog{
-			EntriesNum:    data.size,
-			TimestampFrom: ts,
-			TimestampTo:   ts,
-			LogPath:       key,
-			LogSize:       int64(len(blob.Value)),
+			EntriesNum:      data.size,
+			TimestampFrom:   ts,
+			TimestampTo:     ts,
+			LogPath:         key,
+			LogSize:         int64(len(blob.Value)),
+			EncryptionKeyId: keyID,
 		}
 	}
 
@@ -407,6 +407,11 @@ func (s *Server) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmen
 	return s.dataCoord.MarkSegmentsDropped(ctx, req)
 }
 
+// CloneSegments is the distributed caller of CloneSegments.
+func (s *Server) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CloneSegments(ctx, req)
+}
+
 func (s *Server) BroadcastAlteredCollection(ctx context.Context, request *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return s.dataCoord.BroadcastAlteredCollection(ctx, request)
 }
@@ -1,12 +1,21 @@
 package httpserver
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/cdc"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Handlers handles http requests
@@ -34,6 +43,14 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.DELETE("/collection/load", wrapHandler(h.handleReleaseCollection))
 	router.GET("/collection/statistics", wrapHandler(h.handleGetCollectionStatistics))
 	router.GET("/collections", wrapHandler(h.handleShowCollections))
+	router.POST("/collection/rename", wrapHandler(h.handleRenameCollection))
+	router.POST("/collection/truncate", wrapHandler(h.handleTruncateCollection))
+	router.POST("/collection/clone", wrapHandler(h.handleCloneCollection))
+	router.POST("/collection/changes", h.handleSubscribeChanges)
+
+	router.POST("/database", wrapHandler(h.handleCreateDatabase))
+	router.DELETE("/database", wrapHandler(h.handleDropDatabase))
+	router.GET("/databases", wrapHandler(h.handleListDatabases))
 
 	router.POST("/partition", wrapHandler(h.handleCreatePartition))
 	router.DELETE("/partition", wrapHandler(h.handleDropPartition))
@@ -54,6 +71,7 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.DELETE("/index", wrapHandler(h.handleDropIndex))
 
 	router.POST("/entities", wrapHandler(h.handleInsert))
+	router.PUT("/entities", wrapHandler(h.handleUpsert))
 	router.DELETE("/entities", wrapHandler(h.handleDelete))
 	router.POST("/search", wrapHandler(h.handleSearch))
 	router.POST("/query", wrapHandler(h.handleQuery))
@@ -74,6 +92,15 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.POST("/import", wrapHandler(h.handleImport))
 	router.GET("/import/state", wrapHandler(h.handleGetImportState))
 	router.GET("/import/tasks", wrapHandler(h.handleListImportTasks))
+	router.POST("/export", wrapHandler(h.handleExport))
+	router.GET("/export/state", wrapHandler(h.handleGetExportState))
+	router.GET("/export/tasks", wrapHandler(h.handleListExportTasks))
+
+	router.POST("/backup", wrapHandler(h.handleCreateBackup))
+	router.GET("/backups", wrapHandler(h.handleListBackups))
+	router.POST("/backup/verify", wrapHandler(h.handleVerifyBackup))
+	router.DELETE("/backup", wrapHandler(h.handleDeleteBackup))
+	router.POST("/backup/restore", wrapHandler(h.handleRestoreBackup))
 
 	router.POST("/credential", wrapHandler(h.handleCreateCredential))
 	router.PATCH("/credential", wrapHandler(h.handleUpdateCredential))
@@ -82,6 +109,15 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 
 }
 
+// RegisterApiKeyRoutesTo registers the routes managing the api keys to given router, the callers are
+// authenticated by @authenticate, since the api keys of a user are only managed by the user itself or an admin.
+func (h *Handlers) RegisterApiKeyRoutesTo(router gin.IRouter, authenticate Authenticator) {
+	apiKeys := router.Group("/credential", authenticated(authenticate))
+	apiKeys.POST("/api-key", wrapHandler(h.handleCreateApiKey))
+	apiKeys.DELETE("/api-key", wrapHandler(h.handleRevokeApiKey))
+	apiKeys.GET("/api-keys", wrapHandler(h.handleListApiKeys))
+}
+
 func (h *Handlers) handleGetHealth(c *gin.Context) (interface{}, error) {
 	return gin.H{"status": "ok"}, nil
 }
@@ -181,6 +217,106 @@ func (h *Handlers) handleShowCollections(c *gin.Context) (interface{}, error) {
 	return h.proxy.ShowCollections(c, &req)
 }
 
+func (h *Handlers) handleRenameCollection(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.RenameCollectionRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.RenameCollection(c, &req)
+}
+
+func (h *Handlers) handleTruncateCollection(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.TruncateCollectionRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.TruncateCollection(c, &req)
+}
+
+func (h *Handlers) handleCloneCollection(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.CloneCollectionRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.CloneCollection(c, &req)
+}
+
+// handleSubscribeChanges streams the changes of the collection as newline delimited JSON batches,
+// until the client disconnects or the collection is dropped.
+func (h *Handlers) handleSubscribeChanges(c *gin.Context) {
+	req := cdc.SubscribeRequest{}
+	if err := shouldBind(c, &req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrResponse{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    fmt.Sprintf("%v: parse body failed: %v", errBadRequest, err),
+		})
+		return
+	}
+	// the credentials are authenticated by the proxy.
+	ctx := withAuthorization(c)
+
+	c.Header("Content-Type", "application/x-ndjson")
+	encoder := json.NewEncoder(c.Writer)
+	err := h.proxy.SubscribeChanges(ctx, &req, func(batch *cdc.Batch) error {
+		if err := encoder.Encode(batch); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if err != nil && !errors.Is(err, context.Canceled) {
+		// the status code could not be changed once the batches are sent.
+		if !c.Writer.Written() {
+			code := http.StatusInternalServerError
+			switch status.Code(err) {
+			case codes.Unauthenticated:
+				code = http.StatusUnauthorized
+			case codes.PermissionDenied:
+				code = http.StatusForbidden
+			}
+			c.JSON(code, ErrResponse{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			})
+			return
+		}
+		encoder.Encode(ErrResponse{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		})
+	}
+}
+
+func (h *Handlers) handleCreateDatabase(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.CreateDatabaseRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.CreateDatabase(c, &req)
+}
+
+func (h *Handlers) handleDropDatabase(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.DropDatabaseRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.DropDatabase(c, &req)
+}
+
+func (h *Handlers) handleListDatabases(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.ListDatabasesRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.ListDatabases(c, &req)
+}
+
 func (h *Handlers) handleCreatePartition(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CreatePartitionRequest{}
 	err := shouldBind(c, &req)
@@ -329,6 +465,19 @@ func (h *Handlers) handleInsert(c *gin.Context) (interface{}, error) {
 	return h.proxy.Insert(c, req)
 }
 
+func (h *Handlers) handleUpsert(c *gin.Context) (interface{}, error) {
+	wrappedReq := WrappedInsertRequest{}
+	err := shouldBind(c, &wrappedReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	req, err := wrappedReq.AsInsertRequest()
+	if err != nil {
+		return nil, fmt.Errorf("%w: convert body to pb failed: %v", errBadRequest, err)
+	}
+	return h.proxy.Upsert(c, req)
+}
+
 func (h *Handlers) handleDelete(c *gin.Context) (interface{}, error) {
 	req := milvuspb.DeleteRequest{}
 	err := shouldBind(c, &req)
@@ -362,7 +511,7 @@ func (h *Handlers) handleSearch(c *gin.Context) (interface{}, error) {
 	} else {
 		req.PlaceholderGroup = vector2Bytes(wrappedReq.Vectors)
 	}
-	return h.proxy.Search(c, &req)
+	return h.proxy.Search(withResponseHeader(c), &req)
 }
 
 func (h *Handlers) handleQuery(c *gin.Context) (interface{}, error) {
@@ -371,7 +520,7 @@ func (h *Handlers) handleQuery(c *gin.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
 	}
-	return h.proxy.Query(c, &req)
+	return h.proxy.Query(withResponseHeader(c), &req)
 }
 
 func (h *Handlers) handleFlush(c *gin.Context) (interface{}, error) {
@@ -507,6 +656,78 @@ func (h *Handlers) handleListImportTasks(c *gin.Context) (interface{}, error) {
 	return h.proxy.ListImportTasks(c, &req)
 }
 
+func (h *Handlers) handleExport(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.ExportRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.Export(c, &req)
+}
+
+func (h *Handlers) handleGetExportState(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.GetExportStateRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetExportState(c, &req)
+}
+
+func (h *Handlers) handleListExportTasks(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.ListExportTasksRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.ListExportTasks(c, &req)
+}
+
+func (h *Handlers) handleCreateBackup(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.CreateBackupRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.CreateBackup(c, &req)
+}
+
+func (h *Handlers) handleListBackups(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.ListBackupsRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.ListBackups(c, &req)
+}
+
+func (h *Handlers) handleVerifyBackup(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.VerifyBackupRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.VerifyBackup(c, &req)
+}
+
+func (h *Handlers) handleDeleteBackup(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.DeleteBackupRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.DeleteBackup(c, &req)
+}
+
+func (h *Handlers) handleRestoreBackup(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.RestoreBackupRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.RestoreBackup(c, &req)
+}
+
 func (h *Handlers) handleCreateCredential(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CreateCredentialRequest{}
 	err := shouldBind(c, &req)
@@ -542,3 +763,30 @@ func (h *Handlers) handleListCredUsers(c *gin.Context) (interface{}, error) {
 	}
 	return h.proxy.ListCredUsers(c, &req)
 }
+
+func (h *Handlers) handleCreateApiKey(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.CreateApiKeyRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.CreateApiKey(c.Request.Context(), &req)
+}
+
+func (h *Handlers) handleRevokeApiKey(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.RevokeApiKeyRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.RevokeApiKey(c.Request.Context(), &req)
+}
+
+func (h *Handlers) handleListApiKeys(c *gin.Context) (interface{}, error) {
+	req := rootcoordpb.ListApiKeysRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.ListApiKeys(c.Request.Context(), &req)
+}
@@ -15,8 +15,14 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/cdc"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func Test_WrappedInsertRequest_JSONMarshal_AsInsertRequest(t *testing.T) {
@@ -80,6 +86,61 @@ func (m *mockProxyComponent) ShowCollections(ctx context.Context, request *milvu
 	return &milvuspb.ShowCollectionsResponse{Status: testStatus}, nil
 }
 
+func (m *mockProxyComponent) CreateDatabase(ctx context.Context, request *rootcoordpb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) DropDatabase(ctx context.Context, request *rootcoordpb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) ListDatabases(ctx context.Context, request *rootcoordpb.ListDatabasesRequest) (*rootcoordpb.ListDatabasesResponse, error) {
+	return &rootcoordpb.ListDatabasesResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) RenameCollection(ctx context.Context, request *rootcoordpb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) TruncateCollection(ctx context.Context, request *rootcoordpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) CloneCollection(ctx context.Context, request *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) SubscribeChanges(ctx context.Context, request *cdc.SubscribeRequest, send func(*cdc.Batch) error) error {
+	if request.CollectionName == "not_exist" {
+		return errors.New("collection not found")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	switch request.CollectionName {
+	case "unauthenticated":
+		if len(md.Get(util.HeaderAuthorize)) == 0 {
+			return status.Error(codes.Unauthenticated, "unauthenticated")
+		}
+	case "denied":
+		// only root:Milvus is granted.
+		if auth := md.Get(util.HeaderAuthorize); len(auth) == 0 || auth[0] != "cm9vdDpNaWx2dXM=" {
+			return status.Error(codes.PermissionDenied, "permission deny")
+		}
+	}
+	for i := 0; i < 2; i++ {
+		err := send(&cdc.Batch{
+			Events:     []*cdc.Event{{Type: cdc.EventInsert, Timestamp: uint64(i), Rows: []cdc.Row{{"pk": i}}}},
+			Checkpoint: fmt.Sprint(i),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if request.CollectionName == "dropping" {
+		return errors.New("collection dropped")
+	}
+	return nil
+}
+
 func (m *mockProxyComponent) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return testStatus, nil
 }
@@ -149,6 +210,13 @@ func (m *mockProxyComponent) Insert(ctx context.Context, request *milvuspb.Inser
 	return &milvuspb.MutationResult{Acknowledged: true}, nil
 }
 
+func (m *mockProxyComponent) Upsert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	if request.CollectionName == "" {
+		return nil, errors.New("body parse err")
+	}
+	return &milvuspb.MutationResult{Acknowledged: true, UpsertCnt: int64(request.NumRows)}, nil
+}
+
 func (m *mockProxyComponent) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	if request.Expr == "" {
 		return nil, errors.New("body parse err")
@@ -254,6 +322,38 @@ func (m *mockProxyComponent) ListImportTasks(ctx context.Context, request *milvu
 	return &milvuspb.ListImportTasksResponse{Status: testStatus}, nil
 }
 
+func (m *mockProxyComponent) Export(ctx context.Context, request *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	return &rootcoordpb.ExportResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) GetExportState(ctx context.Context, request *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	return &rootcoordpb.GetExportStateResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) ListExportTasks(ctx context.Context, request *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	return &rootcoordpb.ListExportTasksResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) CreateBackup(ctx context.Context, request *rootcoordpb.CreateBackupRequest) (*rootcoordpb.CreateBackupResponse, error) {
+	return &rootcoordpb.CreateBackupResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) ListBackups(ctx context.Context, request *rootcoordpb.ListBackupsRequest) (*rootcoordpb.ListBackupsResponse, error) {
+	return &rootcoordpb.ListBackupsResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) VerifyBackup(ctx context.Context, request *rootcoordpb.VerifyBackupRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) DeleteBackup(ctx context.Context, request *rootcoordpb.DeleteBackupRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) RestoreBackup(ctx context.Context, request *rootcoordpb.RestoreBackupRequest) (*rootcoordpb.RestoreBackupResponse, error) {
+	return &rootcoordpb.RestoreBackupResponse{Status: testStatus}, nil
+}
+
 func (m *mockProxyComponent) CreateCredential(ctx context.Context, request *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
 	return testStatus, nil
 }
@@ -270,6 +370,24 @@ func (m *mockProxyComponent) ListCredUsers(ctx context.Context, request *milvusp
 	return &milvuspb.ListCredUsersResponse{Status: testStatus}, nil
 }
 
+type testUserKey struct{}
+
+func (m *mockProxyComponent) CreateApiKey(ctx context.Context, request *rootcoordpb.CreateApiKeyRequest) (*rootcoordpb.CreateApiKeyResponse, error) {
+	// the api key is created for the authenticated user.
+	if username, ok := ctx.Value(testUserKey{}).(string); ok {
+		return &rootcoordpb.CreateApiKeyResponse{Status: testStatus, KeyId: username}, nil
+	}
+	return &rootcoordpb.CreateApiKeyResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) ListApiKeys(ctx context.Context, request *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	return &rootcoordpb.ListApiKeysResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) RevokeApiKey(ctx context.Context, request *rootcoordpb.RevokeApiKeyRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
 func TestHandlers(t *testing.T) {
 	mockProxy := &mockProxyComponent{}
 	h := NewHandlers(mockProxy)
@@ -374,6 +492,30 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/collections", emptyBody,
 			http.StatusOK, &milvuspb.ShowCollectionsResponse{Status: testStatus},
 		},
+		{
+			http.MethodPost, "/collection/rename", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodPost, "/collection/truncate", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodPost, "/collection/clone", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodPost, "/database", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodDelete, "/database", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodGet, "/databases", emptyBody,
+			http.StatusOK, &rootcoordpb.ListDatabasesResponse{Status: testStatus},
+		},
 		{
 			http.MethodPost, "/partition", emptyBody,
 			http.StatusOK, testStatus,
@@ -438,6 +580,10 @@ func TestHandlers(t *testing.T) {
 			http.MethodPost, "/entities", &milvuspb.InsertRequest{CollectionName: "c1"},
 			http.StatusOK, &milvuspb.MutationResult{Acknowledged: true},
 		},
+		{
+			http.MethodPut, "/entities", &milvuspb.InsertRequest{CollectionName: "c1", NumRows: 2},
+			http.StatusOK, &milvuspb.MutationResult{Acknowledged: true, UpsertCnt: 2},
+		},
 		{
 			http.MethodDelete, "/entities", milvuspb.DeleteRequest{Expr: "some expr"},
 			http.StatusOK, &milvuspb.MutationResult{Acknowledged: true},
@@ -509,6 +655,38 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/import/tasks", emptyBody,
 			http.StatusOK, &milvuspb.ListImportTasksResponse{Status: testStatus},
 		},
+		{
+			http.MethodPost, "/export", emptyBody,
+			http.StatusOK, &rootcoordpb.ExportResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/export/state", emptyBody,
+			http.StatusOK, &rootcoordpb.GetExportStateResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/export/tasks", emptyBody,
+			http.StatusOK, &rootcoordpb.ListExportTasksResponse{Status: testStatus},
+		},
+		{
+			http.MethodPost, "/backup", emptyBody,
+			http.StatusOK, &rootcoordpb.CreateBackupResponse{Status: testStatus},
+		},
+		{
+			http.MethodGet, "/backups", emptyBody,
+			http.StatusOK, &rootcoordpb.ListBackupsResponse{Status: testStatus},
+		},
+		{
+			http.MethodPost, "/backup/verify", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodDelete, "/backup", emptyBody,
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodPost, "/backup/restore", emptyBody,
+			http.StatusOK, &rootcoordpb.RestoreBackupResponse{Status: testStatus},
+		},
 		{
 			http.MethodPost, "/credential", emptyBody,
 			http.StatusOK, testStatus,
@@ -550,3 +728,126 @@ func TestHandlers(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlers_SubscribeChanges(t *testing.T) {
+	h := NewHandlers(&mockProxyComponent{})
+	testEngine := gin.New()
+	h.RegisterRoutesTo(testEngine)
+
+	subscribeWithAuth := func(collectionName string, authorization string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(&cdc.SubscribeRequest{CollectionName: collectionName})
+		req := httptest.NewRequest(http.MethodPost, "/collection/changes", bytes.NewReader(body))
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		return w
+	}
+	subscribe := func(collectionName string) *httptest.ResponseRecorder {
+		return subscribeWithAuth(collectionName, "")
+	}
+
+	t.Run("stream batches", func(t *testing.T) {
+		w := subscribe("coll")
+		assert.Equal(t, http.StatusOK, w.Code)
+		decoder := json.NewDecoder(w.Body)
+		for i := 0; i < 2; i++ {
+			batch := &cdc.Batch{}
+			assert.NoError(t, decoder.Decode(batch))
+			assert.Equal(t, fmt.Sprint(i), batch.Checkpoint)
+			assert.Equal(t, 1, len(batch.Events))
+		}
+		assert.False(t, decoder.More())
+	})
+
+	t.Run("failed before streaming", func(t *testing.T) {
+		w := subscribe("not_exist")
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("failed while streaming", func(t *testing.T) {
+		w := subscribe("dropping")
+		assert.Equal(t, http.StatusOK, w.Code)
+		decoder := json.NewDecoder(w.Body)
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, decoder.Decode(&cdc.Batch{}))
+		}
+		errResp := &ErrResponse{}
+		assert.NoError(t, decoder.Decode(errResp))
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, errResp.GetErrorCode())
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		w := subscribe("unauthenticated")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		w = subscribeWithAuth("unauthenticated", "Basic cm9vdDpNaWx2dXM=")
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		w := subscribeWithAuth("denied", "Basic Zm9vOmJhcg==")
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		w = subscribeWithAuth("denied", "Basic cm9vdDpNaWx2dXM=")
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("bad request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/collection/changes", bytes.NewReader([]byte("bad request")))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestHandlers_ApiKeys(t *testing.T) {
+	authenticate := func(ctx context.Context) (context.Context, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if authorization := md.Get(util.HeaderAuthorize); len(authorization) == 0 || authorization[0] != "dXNlcjE6cGFzc3dvcmQ=" {
+			return nil, status.Error(codes.Unauthenticated, "auth check failure")
+		}
+		return context.WithValue(ctx, testUserKey{}, "user1"), nil
+	}
+	h := NewHandlers(&mockProxyComponent{})
+	testEngine := gin.New()
+	h.RegisterApiKeyRoutesTo(testEngine, authenticate)
+
+	testCases := []struct {
+		httpMethod    string
+		path          string
+		authorization string
+		expectedCode  int
+		expectedBody  interface{}
+	}{
+		{http.MethodPost, "/credential/api-key", "", http.StatusUnauthorized, nil},
+		{http.MethodPost, "/credential/api-key", "Basic d3Jvbmc=", http.StatusUnauthorized, nil},
+		{
+			http.MethodPost, "/credential/api-key", "Basic dXNlcjE6cGFzc3dvcmQ=",
+			http.StatusOK, &rootcoordpb.CreateApiKeyResponse{Status: testStatus, KeyId: "user1"},
+		},
+		{http.MethodDelete, "/credential/api-key", "", http.StatusUnauthorized, nil},
+		{http.MethodDelete, "/credential/api-key", "Basic dXNlcjE6cGFzc3dvcmQ=", http.StatusOK, testStatus},
+		{http.MethodGet, "/credential/api-keys", "", http.StatusUnauthorized, nil},
+		{
+			http.MethodGet, "/credential/api-keys", "Basic dXNlcjE6cGFzc3dvcmQ=",
+			http.StatusOK, &rootcoordpb.ListApiKeysResponse{Status: testStatus},
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(fmt.Sprintf("%s %s %d", tt.httpMethod, tt.path, tt.expectedCode), func(t *testing.T) {
+			req := httptest.NewRequest(tt.httpMethod, tt.path, bytes.NewReader([]byte("{}")))
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedCode, w.Code)
+			if tt.expectedBody != nil {
+				bodyBytes, err := json.Marshal(tt.expectedBody)
+				assert.NoError(t, err)
+				assert.Equal(t, bodyBytes, w.Body.Bytes())
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // We wrap original protobuf structure for 2 reasons:
@@ -178,6 +179,27 @@ func (f FieldData) AsSchemapb() (*schemapb.FieldData, error) {
 			},
 		}
 
+	case typeutil.DataTypeJSON:
+		// every document is kept verbatim
+		data := []json.RawMessage{}
+		err := json.Unmarshal(raw, &data)
+		if err != nil {
+			return nil, newFieldDataError(f.FieldName, err)
+		}
+		docs := make([][]byte, len(data))
+		for i := range data {
+			docs[i] = data[i]
+		}
+		ret.Field = &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_BytesData{
+					BytesData: &schemapb.BytesArray{
+						Data: docs,
+					},
+				},
+			},
+		}
+
 	case schemapb.DataType_FloatVector:
 		wrappedData := [][]float32{}
 		err := json.Unmarshal(raw, &wrappedData)
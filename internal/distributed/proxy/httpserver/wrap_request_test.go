@@ -6,6 +6,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -151,6 +152,27 @@ func TestFieldData_AsSchemapb(t *testing.T) {
 		_, err := fieldData.AsSchemapb()
 		assert.Error(t, err)
 	})
+	t.Run("json_ok", func(t *testing.T) {
+		fieldData := FieldData{
+			Type:  typeutil.DataTypeJSON,
+			Field: []byte(`[{"color":"red"},{"price":10}]`),
+		}
+		raw, _ := json.Marshal(fieldData)
+		json.Unmarshal(raw, &fieldData)
+		ret, err := fieldData.AsSchemapb()
+		assert.NoError(t, err)
+		assert.Equal(t, [][]byte{[]byte(`{"color":"red"}`), []byte(`{"price":10}`)}, ret.GetScalars().GetBytesData().GetData())
+	})
+	t.Run("json_error", func(t *testing.T) {
+		fieldData := FieldData{
+			Type:  typeutil.DataTypeJSON,
+			Field: []byte(`{"color": "red"}`),
+		}
+		raw, _ := json.Marshal(fieldData)
+		json.Unmarshal(raw, &fieldData)
+		_, err := fieldData.AsSchemapb()
+		assert.Error(t, err)
+	})
 	t.Run("string_not_support", func(t *testing.T) {
 		fieldData := FieldData{
 			Type:  schemapb.DataType_String,
@@ -1,13 +1,18 @@
 package httpserver
 
 import (
+	"context"
 	"errors"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 var (
@@ -53,6 +58,37 @@ func wrapHandler(handle handlerFunc) gin.HandlerFunc {
 	}
 }
 
+// Authenticator authenticates the credentials in the incoming grpc metadata of the context,
+// returns the context carrying the authenticated user.
+type Authenticator func(ctx context.Context) (context.Context, error)
+
+// withAuthorization returns the context carrying the credentials of the request as the grpc metadata, in the form of
+// "Basic base64<username:password>", or "Bearer <token>" with an api key or a JWT.
+func withAuthorization(c *gin.Context) context.Context {
+	md := metadata.MD{}
+	if authorization := c.GetHeader("Authorization"); authorization != "" {
+		md.Set(util.HeaderAuthorize, strings.TrimPrefix(authorization, "Basic "))
+	}
+	return metadata.NewIncomingContext(c.Request.Context(), md)
+}
+
+// authenticated authenticates the request by @authenticate before handling it,
+// the request context carries the authenticated user afterwards.
+func authenticated(authenticate Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, err := authenticate(withAuthorization(c))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, ErrResponse{
+				ErrorCode: commonpb.ErrorCode_PermissionDenied,
+				Reason:    err.Error(),
+			})
+			return
+		}
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
 // gin.ShouldBind() default as `form`, but we want JSON
 func shouldBind(c *gin.Context, obj interface{}) error {
 	b := getBinding(c.ContentType())
@@ -72,3 +108,35 @@ func getBinding(contentType string) binding.Binding {
 		return binding.JSON
 	}
 }
+
+// responseHeaderStream passes the headers set by grpc.SetHeader to the http response,
+// so that http clients get the same response metadata as grpc clients, such as the iterator token.
+type responseHeaderStream struct {
+	c *gin.Context
+}
+
+func (s *responseHeaderStream) Method() string {
+	return s.c.FullPath()
+}
+
+func (s *responseHeaderStream) SetHeader(md metadata.MD) error {
+	for k, vs := range md {
+		for _, v := range vs {
+			s.c.Writer.Header().Add(k, v)
+		}
+	}
+	return nil
+}
+
+func (s *responseHeaderStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *responseHeaderStream) SetTrailer(md metadata.MD) error {
+	return nil
+}
+
+// withResponseHeader returns the context which carries the http response as a grpc server transport stream.
+func withResponseHeader(c *gin.Context) context.Context {
+	return grpc.NewContextWithServerTransportStream(c, &responseHeaderStream{c: c})
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestWrapHandler(t *testing.T) {
@@ -56,3 +58,12 @@ func TestWrapHandler(t *testing.T) {
 	})
 
 }
+
+func TestWithResponseHeader(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	ctx := withResponseHeader(c)
+	err := grpc.SetHeader(ctx, metadata.Pairs("iterator_token", "token"))
+	assert.NoError(t, err)
+	assert.Equal(t, "token", w.Header().Get("iterator_token"))
+}
@@ -126,7 +126,9 @@ func (s *Server) registerHTTPServer() {
 	}
 	ginHandler := gin.Default()
 	apiv1 := ginHandler.Group(apiPathPrefix)
-	httpserver.NewHandlers(s.proxy).RegisterRoutesTo(apiv1)
+	handlers := httpserver.NewHandlers(s.proxy)
+	handlers.RegisterRoutesTo(apiv1)
+	handlers.RegisterApiKeyRoutesTo(apiv1, proxy.AuthenticationInterceptor)
 	http.Handle("/", ginHandler)
 }
 
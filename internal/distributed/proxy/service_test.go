@@ -37,6 +37,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/cdc"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
@@ -141,6 +142,30 @@ func (m *MockRootCoord) ShowCollections(ctx context.Context, req *milvuspb.ShowC
 	return nil, nil
 }
 
+func (m *MockRootCoord) CreateDatabase(ctx context.Context, req *rootcoordpb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DropDatabase(ctx context.Context, req *rootcoordpb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListDatabases(ctx context.Context, req *rootcoordpb.ListDatabasesRequest) (*rootcoordpb.ListDatabasesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) RenameCollection(ctx context.Context, req *rootcoordpb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) TruncateCollection(ctx context.Context, req *rootcoordpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -237,6 +262,54 @@ func (m *MockRootCoord) GetCredential(ctx context.Context, req *rootcoordpb.GetC
 	return nil, nil
 }
 
+func (m *MockRootCoord) CreateApiKey(ctx context.Context, req *rootcoordpb.CreateApiKeyRequest) (*rootcoordpb.CreateApiKeyResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListApiKeys(ctx context.Context, req *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) RevokeApiKey(ctx context.Context, req *rootcoordpb.RevokeApiKeyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) GetApiKey(ctx context.Context, req *rootcoordpb.GetApiKeyRequest) (*rootcoordpb.GetApiKeyResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) CreateBackup(ctx context.Context, req *rootcoordpb.CreateBackupRequest) (*rootcoordpb.CreateBackupResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListBackups(ctx context.Context, req *rootcoordpb.ListBackupsRequest) (*rootcoordpb.ListBackupsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) VerifyBackup(ctx context.Context, req *rootcoordpb.VerifyBackupRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DeleteBackup(ctx context.Context, req *rootcoordpb.DeleteBackupRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) RestoreBackup(ctx context.Context, req *rootcoordpb.RestoreBackupRequest) (*rootcoordpb.RestoreBackupResponse, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -501,6 +574,10 @@ func (m *MockDataCoord) MarkSegmentsDropped(ctx context.Context, req *datapb.Mar
 	return nil, nil
 }
 
+func (m *MockDataCoord) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -676,6 +753,78 @@ func (m *MockProxy) ShowCollections(ctx context.Context, request *milvuspb.ShowC
 	return nil, nil
 }
 
+func (m *MockProxy) CreateDatabase(ctx context.Context, request *rootcoordpb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropDatabase(ctx context.Context, request *rootcoordpb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListDatabases(ctx context.Context, request *rootcoordpb.ListDatabasesRequest) (*rootcoordpb.ListDatabasesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RenameCollection(ctx context.Context, request *rootcoordpb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) TruncateCollection(ctx context.Context, request *rootcoordpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CloneCollection(ctx context.Context, request *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) SubscribeChanges(ctx context.Context, request *cdc.SubscribeRequest, send func(*cdc.Batch) error) error {
+	return nil
+}
+
+func (m *MockProxy) CreateApiKey(ctx context.Context, request *rootcoordpb.CreateApiKeyRequest) (*rootcoordpb.CreateApiKeyResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListApiKeys(ctx context.Context, request *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RevokeApiKey(ctx context.Context, request *rootcoordpb.RevokeApiKeyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) Export(ctx context.Context, request *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetExportState(ctx context.Context, request *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListExportTasks(ctx context.Context, request *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CreateBackup(ctx context.Context, request *rootcoordpb.CreateBackupRequest) (*rootcoordpb.CreateBackupResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListBackups(ctx context.Context, request *rootcoordpb.ListBackupsRequest) (*rootcoordpb.ListBackupsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) VerifyBackup(ctx context.Context, request *rootcoordpb.VerifyBackupRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DeleteBackup(ctx context.Context, request *rootcoordpb.DeleteBackupRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RestoreBackup(ctx context.Context, request *rootcoordpb.RestoreBackupRequest) (*rootcoordpb.RestoreBackupResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -744,6 +893,10 @@ func (m *MockProxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	return nil, nil
 }
 
+func (m *MockProxy) Upsert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	return nil, nil
 }
@@ -357,6 +357,36 @@ func (s *Server) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectio
 	return s.rootCoord.ShowCollections(ctx, in)
 }
 
+// CreateDatabase creates a database
+func (s *Server) CreateDatabase(ctx context.Context, in *rootcoordpb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateDatabase(ctx, in)
+}
+
+// DropDatabase drops a database
+func (s *Server) DropDatabase(ctx context.Context, in *rootcoordpb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropDatabase(ctx, in)
+}
+
+// ListDatabases lists all databases
+func (s *Server) ListDatabases(ctx context.Context, in *rootcoordpb.ListDatabasesRequest) (*rootcoordpb.ListDatabasesResponse, error) {
+	return s.rootCoord.ListDatabases(ctx, in)
+}
+
+// RenameCollection renames a collection
+func (s *Server) RenameCollection(ctx context.Context, in *rootcoordpb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RenameCollection(ctx, in)
+}
+
+// TruncateCollection drops all the data of a collection
+func (s *Server) TruncateCollection(ctx context.Context, in *rootcoordpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.TruncateCollection(ctx, in)
+}
+
+// CloneCollection creates a collection sharing the flushed data of the source collection
+func (s *Server) CloneCollection(ctx context.Context, in *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CloneCollection(ctx, in)
+}
+
 // CreatePartition creates a partition in a collection
 func (s *Server) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.CreatePartition(ctx, in)
@@ -440,6 +470,62 @@ func (s *Server) GetCredential(ctx context.Context, request *rootcoordpb.GetCred
 	return s.rootCoord.GetCredential(ctx, request)
 }
 
+func (s *Server) CreateApiKey(ctx context.Context, request *rootcoordpb.CreateApiKeyRequest) (*rootcoordpb.CreateApiKeyResponse, error) {
+	return s.rootCoord.CreateApiKey(ctx, request)
+}
+
+func (s *Server) ListApiKeys(ctx context.Context, request *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	return s.rootCoord.ListApiKeys(ctx, request)
+}
+
+func (s *Server) RevokeApiKey(ctx context.Context, request *rootcoordpb.RevokeApiKeyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RevokeApiKey(ctx, request)
+}
+
+func (s *Server) GetApiKey(ctx context.Context, request *rootcoordpb.GetApiKeyRequest) (*rootcoordpb.GetApiKeyResponse, error) {
+	return s.rootCoord.GetApiKey(ctx, request)
+}
+
+// Export writes the rows of a collection into files
+func (s *Server) Export(ctx context.Context, request *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	return s.rootCoord.Export(ctx, request)
+}
+
+// GetExportState returns the state of an export task
+func (s *Server) GetExportState(ctx context.Context, request *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	return s.rootCoord.GetExportState(ctx, request)
+}
+
+// ListExportTasks lists the export tasks
+func (s *Server) ListExportTasks(ctx context.Context, request *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	return s.rootCoord.ListExportTasks(ctx, request)
+}
+
+// CreateBackup backs up a collection
+func (s *Server) CreateBackup(ctx context.Context, request *rootcoordpb.CreateBackupRequest) (*rootcoordpb.CreateBackupResponse, error) {
+	return s.rootCoord.CreateBackup(ctx, request)
+}
+
+// ListBackups lists all the backups
+func (s *Server) ListBackups(ctx context.Context, request *rootcoordpb.ListBackupsRequest) (*rootcoordpb.ListBackupsResponse, error) {
+	return s.rootCoord.ListBackups(ctx, request)
+}
+
+// VerifyBackup checks the files of a backup
+func (s *Server) VerifyBackup(ctx context.Context, request *rootcoordpb.VerifyBackupRequest) (*commonpb.Status, error) {
+	return s.rootCoord.VerifyBackup(ctx, request)
+}
+
+// DeleteBackup removes a backup
+func (s *Server) DeleteBackup(ctx context.Context, request *rootcoordpb.DeleteBackupRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DeleteBackup(ctx, request)
+}
+
+// RestoreBackup restores a backup into a new collection
+func (s *Server) RestoreBackup(ctx context.Context, request *rootcoordpb.RestoreBackupRequest) (*rootcoordpb.RestoreBackupResponse, error) {
+	return s.rootCoord.RestoreBackup(ctx, request)
+}
+
 func (s *Server) UpdateCredential(ctx context.Context, request *internalpb.CredentialInfo) (*commonpb.Status, error) {
 	return s.rootCoord.UpdateCredential(ctx, request)
 }
@@ -302,6 +302,7 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 			IndexParams:     indexParams,
 			TypeParams:      typeParams,
 			NumRows:         meta.NumRows,
+			FieldID:         fieldID,
 		}
 		if err := ib.ic.assignTask(client, req); err != nil {
 			// need to release lock then reassign, so set task state to retry
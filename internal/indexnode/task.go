@@ -313,6 +313,13 @@ func (it *indexBuildTask) BuildDiskAnnIndex(ctx context.Context) error {
 		return errors.New("index node don't support build disk index")
 	}
 
+	// the disk index files are uploaded and loaded by segcore directly, which could not be encrypted.
+	if Params.CommonCfg.EncryptionEnabled.GetAsBool() {
+		log.Ctx(ctx).Error("IndexNode don't support build disk index when the encryption is enabled",
+			zap.String("index type", it.newIndexParams["index_type"]))
+		return errors.New("disk index is not supported when the encryption is enabled")
+	}
+
 	// check load size and size of field data
 	localUsedSize, err := indexcgowrapper.GetLocalUsedSize()
 	if err != nil {
@@ -411,6 +418,8 @@ func (it *indexBuildTask) SaveIndexFiles(ctx context.Context) error {
 		return it.SaveDiskAnnIndexFiles(ctx)
 	}
 
+	// the index files are encrypted with the data key of the collection if the encryption is enabled.
+	ctx = storage.WithCollectionID(ctx, it.collectionID)
 	blobCnt := len(it.indexBlobs)
 	savePaths := make([]string, blobCnt)
 	saveFileKeys := make([]string, blobCnt)
@@ -484,6 +493,7 @@ func (it *indexBuildTask) SaveDiskAnnIndexFiles(ctx context.Context) error {
 
 	indexParamPath := metautil.BuildSegmentIndexFilePath(it.cm.RootPath(), it.req.BuildID, it.req.IndexVersion,
 		it.partitionID, it.segmentID, indexParamBlob.Key)
+	ctx = storage.WithCollectionID(ctx, it.collectionID)
 
 	saveFn := func() error {
 		return it.cm.Write(ctx, indexParamPath, indexParamBlob.Value)
@@ -510,7 +520,12 @@ func (it *indexBuildTask) SaveDiskAnnIndexFiles(ctx context.Context) error {
 
 func (it *indexBuildTask) decodeBlobs(ctx context.Context, blobs []*storage.Blob) error {
 	var insertCodec storage.InsertCodec
-	collectionID, partitionID, segmentID, insertData, err2 := insertCodec.DeserializeAll(blobs)
+	// the columnar binlogs of StorageV2 contain all the fields, only the indexed one is read
+	var fieldIDs []storage.FieldID
+	if it.req.GetFieldID() != 0 {
+		fieldIDs = append(fieldIDs, it.req.GetFieldID())
+	}
+	collectionID, partitionID, segmentID, insertData, err2 := insertCodec.DeserializeFields(blobs, fieldIDs...)
 	if err2 != nil {
 		return err2
 	}
@@ -13,10 +13,14 @@ import (
 
 //go:generate mockery --name=RootCoordCatalog
 type RootCoordCatalog interface {
+	CreateDatabase(ctx context.Context, db *model.Database, ts typeutil.Timestamp) error
+	DropDatabase(ctx context.Context, dbID int64, ts typeutil.Timestamp) error
+	ListDatabases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Database, error)
+
 	CreateCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error
 	GetCollectionByID(ctx context.Context, collectionID typeutil.UniqueID, ts typeutil.Timestamp) (*model.Collection, error)
-	GetCollectionByName(ctx context.Context, collectionName string, ts typeutil.Timestamp) (*model.Collection, error)
-	ListCollections(ctx context.Context, ts typeutil.Timestamp) (map[string]*model.Collection, error)
+	GetCollectionByName(ctx context.Context, dbID int64, collectionName string, ts typeutil.Timestamp) (*model.Collection, error)
+	ListCollections(ctx context.Context, dbID int64, ts typeutil.Timestamp) (map[string]*model.Collection, error)
 	CollectionExists(ctx context.Context, collectionID typeutil.UniqueID, ts typeutil.Timestamp) bool
 	DropCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error
 	AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType AlterType, ts typeutil.Timestamp) error
@@ -26,9 +30,9 @@ type RootCoordCatalog interface {
 	AlterPartition(ctx context.Context, oldPart *model.Partition, newPart *model.Partition, alterType AlterType, ts typeutil.Timestamp) error
 
 	CreateAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error
-	DropAlias(ctx context.Context, alias string, ts typeutil.Timestamp) error
+	DropAlias(ctx context.Context, dbID int64, alias string, ts typeutil.Timestamp) error
 	AlterAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error
-	ListAliases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Alias, error)
+	ListAliases(ctx context.Context, dbID int64, ts typeutil.Timestamp) ([]*model.Alias, error)
 
 	// GetCredential gets the credential info for the username, returns error if no credential exists for this username.
 	GetCredential(ctx context.Context, username string) (*model.Credential, error)
@@ -41,6 +45,15 @@ type RootCoordCatalog interface {
 	// ListCredentials gets all usernames.
 	ListCredentials(ctx context.Context) ([]string, error)
 
+	// CreateApiKey persists the api key, only the hashed secret of the key is saved.
+	CreateApiKey(ctx context.Context, apiKey *model.ApiKey) error
+	// GetApiKey gets the api key by the key id, returns error if the key doesn't exist.
+	GetApiKey(ctx context.Context, keyID string) (*model.ApiKey, error)
+	// DropApiKey removes the api key of the key id.
+	DropApiKey(ctx context.Context, keyID string) error
+	// ListApiKeys gets all api keys.
+	ListApiKeys(ctx context.Context) ([]*model.ApiKey, error)
+
 	// CreateRole creates role by the entity for the tenant. Please make sure the tenent and entity.Name aren't empty. Empty entity.Name may end up with deleting all roles
 	// Returns common.IgnorableError if the role already existes
 	CreateRole(ctx context.Context, tenant string, entity *milvuspb.RoleEntity) error
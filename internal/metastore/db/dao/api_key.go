@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type apiKeyDb struct {
+	db *gorm.DB
+}
+
+func (s *apiKeyDb) GetByKeyID(tenantID string, keyID string) (*dbmodel.ApiKey, error) {
+	var r *dbmodel.ApiKey
+
+	err := s.db.Model(&dbmodel.ApiKey{}).Where("tenant_id = ? AND key_id = ? AND is_deleted = false", tenantID, keyID).Take(&r).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, common.NewKeyNotExistError(fmt.Sprintf("%s/%s", tenantID, keyID))
+	}
+	if err != nil {
+		log.Error("get api key by key id failed", zap.String("tenant", tenantID), zap.String("keyID", keyID), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *apiKeyDb) ListApiKeys(tenantID string) ([]*dbmodel.ApiKey, error) {
+	var apiKeys []*dbmodel.ApiKey
+
+	err := s.db.Model(&dbmodel.ApiKey{}).Where("tenant_id = ? AND is_deleted = false", tenantID).Find(&apiKeys).Error
+	if err != nil {
+		log.Error("list api keys failed", zap.String("tenant", tenantID), zap.Error(err))
+		return nil, err
+	}
+
+	return apiKeys, nil
+}
+
+func (s *apiKeyDb) Insert(in *dbmodel.ApiKey) error {
+	err := s.db.Create(in).Error
+	if err != nil {
+		log.Error("insert credential_api_keys failed", zap.String("tenant", in.TenantID), zap.String("keyID", in.KeyID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *apiKeyDb) MarkDeletedByKeyID(tenantID string, keyID string) error {
+	err := s.db.Model(&dbmodel.ApiKey{}).Where("tenant_id = ? AND key_id = ?", tenantID, keyID).Update("is_deleted", true).Error
+	if err != nil {
+		log.Error("update credential_api_keys is_deleted=true failed", zap.String("tenant", tenantID), zap.String("keyID", keyID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
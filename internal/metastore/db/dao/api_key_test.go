@@ -0,0 +1,171 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestApiKey_GetByKeyID(t *testing.T) {
+	keyID := "test_key_id_1"
+	var apiKey = &dbmodel.ApiKey{
+		TenantID:     tenantID,
+		KeyID:        keyID,
+		Username:     "test_username_1",
+		HashedSecret: "xxx",
+	}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `credential_api_keys` WHERE tenant_id = ? AND key_id = ? AND is_deleted = false LIMIT 1").
+		WithArgs(tenantID, keyID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "key_id", "username", "hashed_secret"}).
+				AddRow(apiKey.TenantID, apiKey.KeyID, apiKey.Username, apiKey.HashedSecret))
+
+	// actual
+	res, err := apiKeyTestDb.GetByKeyID(tenantID, keyID)
+	assert.Nil(t, err)
+	assert.Equal(t, apiKey, res)
+}
+
+func TestApiKey_GetByKeyID_ErrRecordNotFound(t *testing.T) {
+	keyID := "test_key_id_1"
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `credential_api_keys` WHERE tenant_id = ? AND key_id = ? AND is_deleted = false LIMIT 1").
+		WithArgs(tenantID, keyID).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	// actual
+	res, err := apiKeyTestDb.GetByKeyID(tenantID, keyID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestApiKey_GetByKeyID_Error(t *testing.T) {
+	keyID := "test_key_id_1"
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `credential_api_keys` WHERE tenant_id = ? AND key_id = ? AND is_deleted = false LIMIT 1").
+		WithArgs(tenantID, keyID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := apiKeyTestDb.GetByKeyID(tenantID, keyID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestApiKey_ListApiKeys(t *testing.T) {
+	keyIDs := []string{"test_key_id_1", "test_key_id_2"}
+
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `credential_api_keys` WHERE tenant_id = ? AND is_deleted = false").
+		WithArgs(tenantID).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "key_id", "username", "hashed_secret"}).
+				AddRow(tenantID, keyIDs[0], "test_username_1", "xxx").
+				AddRow(tenantID, keyIDs[1], "test_username_2", "xxx"))
+
+	// actual
+	res, err := apiKeyTestDb.ListApiKeys(tenantID)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(res))
+	assert.Equal(t, keyIDs[0], res[0].KeyID)
+	assert.Equal(t, keyIDs[1], res[1].KeyID)
+}
+
+func TestApiKey_ListApiKeys_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT * FROM `credential_api_keys` WHERE tenant_id = ? AND is_deleted = false").
+		WithArgs(tenantID).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := apiKeyTestDb.ListApiKeys(tenantID)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
+
+func TestApiKey_Insert(t *testing.T) {
+	var apiKey = &dbmodel.ApiKey{
+		TenantID:     tenantID,
+		KeyID:        "test_key_id_1",
+		Username:     "test_username_1",
+		Description:  "for service a",
+		HashedSecret: "xxx",
+		IsDeleted:    false,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `credential_api_keys` (`tenant_id`,`key_id`,`username`,`description`,`hashed_secret`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?)").
+		WithArgs(apiKey.TenantID, apiKey.KeyID, apiKey.Username, apiKey.Description, apiKey.HashedSecret, apiKey.IsDeleted, apiKey.CreatedAt, apiKey.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := apiKeyTestDb.Insert(apiKey)
+	assert.Nil(t, err)
+}
+
+func TestApiKey_Insert_Error(t *testing.T) {
+	var apiKey = &dbmodel.ApiKey{
+		TenantID:     tenantID,
+		KeyID:        "test_key_id_1",
+		Username:     "test_username_1",
+		Description:  "for service a",
+		HashedSecret: "xxx",
+		IsDeleted:    false,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `credential_api_keys` (`tenant_id`,`key_id`,`username`,`description`,`hashed_secret`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?)").
+		WithArgs(apiKey.TenantID, apiKey.KeyID, apiKey.Username, apiKey.Description, apiKey.HashedSecret, apiKey.IsDeleted, apiKey.CreatedAt, apiKey.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := apiKeyTestDb.Insert(apiKey)
+	assert.Error(t, err)
+}
+
+func TestApiKey_MarkDeletedByKeyID(t *testing.T) {
+	keyID := "test_key_id_1"
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `credential_api_keys` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND key_id = ?").
+		WithArgs(true, AnyTime{}, tenantID, keyID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := apiKeyTestDb.MarkDeletedByKeyID(tenantID, keyID)
+	assert.Nil(t, err)
+}
+
+func TestApiKey_MarkDeletedByKeyID_Error(t *testing.T) {
+	keyID := "test_key_id_1"
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `credential_api_keys` SET `is_deleted`=?,`updated_at`=? WHERE tenant_id = ? AND key_id = ?").
+		WithArgs(true, AnyTime{}, tenantID, keyID).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := apiKeyTestDb.MarkDeletedByKeyID(tenantID, keyID)
+	assert.Error(t, err)
+}
@@ -61,16 +61,16 @@ func (s *collectionDb) Get(tenantID string, collectionID typeutil.UniqueID, ts t
 	return &r, nil
 }
 
-func (s *collectionDb) GetCollectionIDByName(tenantID string, collectionName string, ts typeutil.Timestamp) (typeutil.UniqueID, error) {
+func (s *collectionDb) GetCollectionIDByName(tenantID string, dbID int64, collectionName string, ts typeutil.Timestamp) (typeutil.UniqueID, error) {
 	var r dbmodel.Collection
 
-	err := s.db.Model(&dbmodel.Collection{}).Select("collection_id").Where("tenant_id = ? AND collection_name = ? AND ts <= ?", tenantID, collectionName, ts).Order("ts desc").Take(&r).Error
+	err := s.db.Model(&dbmodel.Collection{}).Select("collection_id").Where("tenant_id = ? AND db_id = ? AND collection_name = ? AND ts <= ?", tenantID, dbID, collectionName, ts).Order("ts desc").Take(&r).Error
 
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return 0, fmt.Errorf("get collection_id by collection_name not found, collName=%s, ts=%d", collectionName, ts)
 	}
 	if err != nil {
-		log.Error("get collection_id by collection_name failed", zap.String("tenant", tenantID), zap.String("collName", collectionName), zap.Uint64("ts", ts), zap.Error(err))
+		log.Error("get collection_id by collection_name failed", zap.String("tenant", tenantID), zap.Int64("dbID", dbID), zap.String("collName", collectionName), zap.Uint64("ts", ts), zap.Error(err))
 		return 0, err
 	}
 
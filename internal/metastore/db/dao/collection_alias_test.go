@@ -7,6 +7,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
@@ -27,8 +28,8 @@ func TestCollectionAlias_Insert(t *testing.T) {
 
 	// expectation
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO `collection_aliases` (`tenant_id`,`collection_id`,`collection_alias`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
-		WithArgs(collAliases[0].TenantID, collAliases[0].CollectionID, collAliases[0].CollectionAlias, collAliases[0].Ts, collAliases[0].IsDeleted, collAliases[0].CreatedAt, collAliases[0].UpdatedAt).
+	mock.ExpectExec("INSERT INTO `collection_aliases` (`tenant_id`,`db_id`,`collection_id`,`collection_alias`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(collAliases[0].TenantID, collAliases[0].DBID, collAliases[0].CollectionID, collAliases[0].CollectionAlias, collAliases[0].Ts, collAliases[0].IsDeleted, collAliases[0].CreatedAt, collAliases[0].UpdatedAt).
 		WillReturnResult(sqlmock.NewResult(100, 2))
 	mock.ExpectCommit()
 
@@ -52,8 +53,8 @@ func TestCollectionAlias_Insert_Error(t *testing.T) {
 
 	// expectation
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO `collection_aliases` (`tenant_id`,`collection_id`,`collection_alias`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
-		WithArgs(collAliases[0].TenantID, collAliases[0].CollectionID, collAliases[0].CollectionAlias, collAliases[0].Ts, collAliases[0].IsDeleted, collAliases[0].CreatedAt, collAliases[0].UpdatedAt).
+	mock.ExpectExec("INSERT INTO `collection_aliases` (`tenant_id`,`db_id`,`collection_id`,`collection_alias`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(collAliases[0].TenantID, collAliases[0].DBID, collAliases[0].CollectionID, collAliases[0].CollectionAlias, collAliases[0].Ts, collAliases[0].IsDeleted, collAliases[0].CreatedAt, collAliases[0].UpdatedAt).
 		WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
 
@@ -66,14 +67,14 @@ func TestCollectionAlias_GetCollectionIDByName(t *testing.T) {
 	alias := "test_alias_name_1"
 
 	// expectation
-	mock.ExpectQuery("SELECT `collection_id` FROM `collection_aliases` WHERE tenant_id = ? AND collection_alias = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
-		WithArgs(tenantID, alias, ts).
+	mock.ExpectQuery("SELECT `collection_id` FROM `collection_aliases` WHERE tenant_id = ? AND db_id = ? AND collection_alias = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
+		WithArgs(tenantID, util.DefaultDBID, alias, ts).
 		WillReturnRows(
 			sqlmock.NewRows([]string{"collection_id"}).
 				AddRow(collID1))
 
 	// actual
-	res, err := aliasTestDb.GetCollectionIDByAlias(tenantID, alias, ts)
+	res, err := aliasTestDb.GetCollectionIDByAlias(tenantID, util.DefaultDBID, alias, ts)
 	assert.Nil(t, err)
 	assert.Equal(t, collID1, res)
 }
@@ -82,12 +83,12 @@ func TestCollectionAlias_GetCollectionIDByName_Error(t *testing.T) {
 	alias := "test_alias_name_1"
 
 	// expectation
-	mock.ExpectQuery("SELECT `collection_id` FROM `collection_aliases` WHERE tenant_id = ? AND collection_alias = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
-		WithArgs(tenantID, alias, ts).
+	mock.ExpectQuery("SELECT `collection_id` FROM `collection_aliases` WHERE tenant_id = ? AND db_id = ? AND collection_alias = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
+		WithArgs(tenantID, util.DefaultDBID, alias, ts).
 		WillReturnError(errors.New("test error"))
 
 	// actual
-	res, err := aliasTestDb.GetCollectionIDByAlias(tenantID, alias, ts)
+	res, err := aliasTestDb.GetCollectionIDByAlias(tenantID, util.DefaultDBID, alias, ts)
 	assert.Equal(t, typeutil.UniqueID(0), res)
 	assert.Error(t, err)
 }
@@ -96,12 +97,12 @@ func TestCollectionAlias_GetCollectionIDByName_ErrRecordNotFound(t *testing.T) {
 	alias := "test_alias_name_1"
 
 	// expectation
-	mock.ExpectQuery("SELECT `collection_id` FROM `collection_aliases` WHERE tenant_id = ? AND collection_alias = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
-		WithArgs(tenantID, alias, ts).
+	mock.ExpectQuery("SELECT `collection_id` FROM `collection_aliases` WHERE tenant_id = ? AND db_id = ? AND collection_alias = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
+		WithArgs(tenantID, util.DefaultDBID, alias, ts).
 		WillReturnError(gorm.ErrRecordNotFound)
 
 	// actual
-	res, err := aliasTestDb.GetCollectionIDByAlias(tenantID, alias, ts)
+	res, err := aliasTestDb.GetCollectionIDByAlias(tenantID, util.DefaultDBID, alias, ts)
 	assert.Equal(t, typeutil.UniqueID(0), res)
 	assert.Error(t, err)
 }
@@ -167,7 +168,7 @@ func TestCollectionAlias_List(t *testing.T) {
 	}
 
 	// expectation
-	mock.ExpectQuery("SELECT collection_id, collection_alias FROM `collection_aliases` WHERE tenant_id = ? AND is_deleted = false AND (collection_id, ts) IN ((?,?),(?,?))").
+	mock.ExpectQuery("SELECT db_id, collection_id, collection_alias FROM `collection_aliases` WHERE tenant_id = ? AND is_deleted = false AND (collection_id, ts) IN ((?,?),(?,?))").
 		WithArgs(tenantID, cidTsPairs[0].CollectionID, cidTsPairs[0].Ts, cidTsPairs[1].CollectionID, cidTsPairs[1].Ts).
 		WillReturnRows(
 			sqlmock.NewRows([]string{"collection_id", "collection_alias"}).
@@ -193,7 +194,7 @@ func TestCollectionAlias_List_Error(t *testing.T) {
 	}
 
 	// expectation
-	mock.ExpectQuery("SELECT collection_id, collection_alias FROM `collection_aliases` WHERE tenant_id = ? AND is_deleted = false AND (collection_id, ts) IN ((?,?),(?,?))").
+	mock.ExpectQuery("SELECT db_id, collection_id, collection_alias FROM `collection_aliases` WHERE tenant_id = ? AND is_deleted = false AND (collection_id, ts) IN ((?,?),(?,?))").
 		WithArgs(tenantID, cidTsPairs[0].CollectionID, cidTsPairs[0].Ts, cidTsPairs[1].CollectionID, cidTsPairs[1].Ts).
 		WillReturnError(errors.New("test error"))
 
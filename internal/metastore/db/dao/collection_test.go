@@ -15,6 +15,7 @@ import (
 	commonpb "github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/metastore/db/dbcore"
 	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/mysql"
@@ -39,6 +40,7 @@ const (
 
 var (
 	mock            sqlmock.Sqlmock
+	databaseTestDb  dbmodel.IDatabaseDb
 	collTestDb      dbmodel.ICollectionDb
 	aliasTestDb     dbmodel.ICollAliasDb
 	channelTestDb   dbmodel.ICollChannelDb
@@ -47,6 +49,7 @@ var (
 	indexTestDb     dbmodel.IIndexDb
 	segIndexTestDb  dbmodel.ISegmentIndexDb
 	userTestDb      dbmodel.IUserDb
+	apiKeyTestDb    dbmodel.IApiKeyDb
 	roleTestDb      dbmodel.IRoleDb
 	userRoleTestDb  dbmodel.IUserRoleDb
 	grantTestDb     dbmodel.IGrantDb
@@ -86,6 +89,7 @@ func TestMain(m *testing.M) {
 	// set mocked database
 	dbcore.SetGlobalDB(DB)
 
+	databaseTestDb = NewMetaDomain().DatabaseDb(ctx)
 	collTestDb = NewMetaDomain().CollectionDb(ctx)
 	aliasTestDb = NewMetaDomain().CollAliasDb(ctx)
 	channelTestDb = NewMetaDomain().CollChannelDb(ctx)
@@ -94,6 +98,7 @@ func TestMain(m *testing.M) {
 	indexTestDb = NewMetaDomain().IndexDb(ctx)
 	segIndexTestDb = NewMetaDomain().SegmentIndexDb(ctx)
 	userTestDb = NewMetaDomain().UserDb(ctx)
+	apiKeyTestDb = NewMetaDomain().ApiKeyDb(ctx)
 	roleTestDb = NewMetaDomain().RoleDb(ctx)
 	userRoleTestDb = NewMetaDomain().UserRoleDb(ctx)
 	grantTestDb = NewMetaDomain().GrantDb(ctx)
@@ -310,14 +315,14 @@ func TestCollection_GetCollectionIDByName(t *testing.T) {
 	collectionName := "test_collection_name_1"
 
 	// expectation
-	mock.ExpectQuery("SELECT `collection_id` FROM `collections` WHERE tenant_id = ? AND collection_name = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
-		WithArgs(tenantID, collectionName, ts).
+	mock.ExpectQuery("SELECT `collection_id` FROM `collections` WHERE tenant_id = ? AND db_id = ? AND collection_name = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
+		WithArgs(tenantID, util.DefaultDBID, collectionName, ts).
 		WillReturnRows(
 			sqlmock.NewRows([]string{"collection_id"}).
 				AddRow(collID1))
 
 	// actual
-	res, err := collTestDb.GetCollectionIDByName(tenantID, collectionName, ts)
+	res, err := collTestDb.GetCollectionIDByName(tenantID, util.DefaultDBID, collectionName, ts)
 	assert.Nil(t, err)
 	assert.Equal(t, collID1, res)
 }
@@ -326,12 +331,12 @@ func TestCollection_GetCollectionIDByName_Error(t *testing.T) {
 	collectionName := "test_collection_name_1"
 
 	// expectation
-	mock.ExpectQuery("SELECT `collection_id` FROM `collections` WHERE tenant_id = ? AND collection_name = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
-		WithArgs(tenantID, collectionName, ts).
+	mock.ExpectQuery("SELECT `collection_id` FROM `collections` WHERE tenant_id = ? AND db_id = ? AND collection_name = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
+		WithArgs(tenantID, util.DefaultDBID, collectionName, ts).
 		WillReturnError(errors.New("test error"))
 
 	// actual
-	res, err := collTestDb.GetCollectionIDByName(tenantID, collectionName, ts)
+	res, err := collTestDb.GetCollectionIDByName(tenantID, util.DefaultDBID, collectionName, ts)
 	assert.Equal(t, typeutil.UniqueID(0), res)
 	assert.Error(t, err)
 }
@@ -340,12 +345,12 @@ func TestCollection_GetCollectionIDByName_ErrRecordNotFound(t *testing.T) {
 	collectionName := "test_collection_name_1"
 
 	// expectation
-	mock.ExpectQuery("SELECT `collection_id` FROM `collections` WHERE tenant_id = ? AND collection_name = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
-		WithArgs(tenantID, collectionName, ts).
+	mock.ExpectQuery("SELECT `collection_id` FROM `collections` WHERE tenant_id = ? AND db_id = ? AND collection_name = ? AND ts <= ? ORDER BY ts desc LIMIT 1").
+		WithArgs(tenantID, util.DefaultDBID, collectionName, ts).
 		WillReturnError(gorm.ErrRecordNotFound)
 
 	// actual
-	res, err := collTestDb.GetCollectionIDByName(tenantID, collectionName, ts)
+	res, err := collTestDb.GetCollectionIDByName(tenantID, util.DefaultDBID, collectionName, ts)
 	assert.Equal(t, typeutil.UniqueID(0), res)
 	assert.Error(t, err)
 }
@@ -369,8 +374,8 @@ func TestCollection_Insert(t *testing.T) {
 
 	// expectation
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO `collections` (`tenant_id`,`collection_id`,`collection_name`,`description`,`auto_id`,`shards_num`,`start_position`,`consistency_level`,`status`,`properties`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
-		WithArgs(collection.TenantID, collection.CollectionID, collection.CollectionName, collection.Description, collection.AutoID, collection.ShardsNum, collection.StartPosition, collection.ConsistencyLevel, collection.Status, collection.Properties, collection.Ts, collection.IsDeleted, collection.CreatedAt, collection.UpdatedAt).
+	mock.ExpectExec("INSERT INTO `collections` (`tenant_id`,`db_id`,`collection_id`,`collection_name`,`description`,`auto_id`,`shards_num`,`start_position`,`consistency_level`,`status`,`properties`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(collection.TenantID, collection.DBID, collection.CollectionID, collection.CollectionName, collection.Description, collection.AutoID, collection.ShardsNum, collection.StartPosition, collection.ConsistencyLevel, collection.Status, collection.Properties, collection.Ts, collection.IsDeleted, collection.CreatedAt, collection.UpdatedAt).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -397,8 +402,8 @@ func TestCollection_Insert_Error(t *testing.T) {
 
 	// expectation
 	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO `collections` (`tenant_id`,`collection_id`,`collection_name`,`description`,`auto_id`,`shards_num`,`start_position`,`consistency_level`,`status`,`properties`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
-		WithArgs(collection.TenantID, collection.CollectionID, collection.CollectionName, collection.Description, collection.AutoID, collection.ShardsNum, collection.StartPosition, collection.ConsistencyLevel, collection.Status, collection.Properties, collection.Ts, collection.IsDeleted, collection.CreatedAt, collection.UpdatedAt).
+	mock.ExpectExec("INSERT INTO `collections` (`tenant_id`,`db_id`,`collection_id`,`collection_name`,`description`,`auto_id`,`shards_num`,`start_position`,`consistency_level`,`status`,`properties`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(collection.TenantID, collection.DBID, collection.CollectionID, collection.CollectionName, collection.Description, collection.AutoID, collection.ShardsNum, collection.StartPosition, collection.ConsistencyLevel, collection.Status, collection.Properties, collection.Ts, collection.IsDeleted, collection.CreatedAt, collection.UpdatedAt).
 		WillReturnError(errors.New("test error"))
 	mock.ExpectRollback()
 
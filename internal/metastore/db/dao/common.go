@@ -13,6 +13,10 @@ func NewMetaDomain() *metaDomain {
 	return &metaDomain{}
 }
 
+func (*metaDomain) DatabaseDb(ctx context.Context) dbmodel.IDatabaseDb {
+	return &databaseDb{dbcore.GetDB(ctx)}
+}
+
 func (*metaDomain) CollectionDb(ctx context.Context) dbmodel.ICollectionDb {
 	return &collectionDb{dbcore.GetDB(ctx)}
 }
@@ -45,6 +49,10 @@ func (*metaDomain) UserDb(ctx context.Context) dbmodel.IUserDb {
 	return &userDb{dbcore.GetDB(ctx)}
 }
 
+func (*metaDomain) ApiKeyDb(ctx context.Context) dbmodel.IApiKeyDb {
+	return &apiKeyDb{dbcore.GetDB(ctx)}
+}
+
 func (d *metaDomain) RoleDb(ctx context.Context) dbmodel.IRoleDb {
 	return &roleDb{dbcore.GetDB(ctx)}
 }
@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type databaseDb struct {
+	db *gorm.DB
+}
+
+// Insert used in create & drop database, needs be an idempotent operation, so we use DoNothing strategy here so it will not throw exception for retry
+func (s *databaseDb) Insert(in *dbmodel.Database) error {
+	err := s.db.Clauses(clause.OnConflict{
+		// constraint UNIQUE (tenant_id, db_id, ts)
+		DoNothing: true,
+	}).Create(&in).Error
+
+	if err != nil {
+		log.Error("insert database failed", zap.String("tenant", in.TenantID), zap.Int64("dbID", in.DBID), zap.Uint64("ts", in.Ts), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *databaseDb) ListDatabases(tenantID string, ts typeutil.Timestamp) ([]*dbmodel.Database, error) {
+	var idTsPairs []*dbmodel.Database
+
+	// find each db_id with latest ts <= @param ts
+	err := s.db.Model(&dbmodel.Database{}).Select("db_id, MAX(ts) ts").Where("tenant_id = ? AND ts <= ?", tenantID, ts).Group("db_id").Find(&idTsPairs).Error
+	if err != nil {
+		log.Error("list db_id & latest ts pairs in databases failed", zap.String("tenant", tenantID), zap.Uint64("ts", ts), zap.Error(err))
+		return nil, err
+	}
+	if len(idTsPairs) == 0 {
+		return []*dbmodel.Database{}, nil
+	}
+
+	inValues := make([][]interface{}, 0, len(idTsPairs))
+	for _, pair := range idTsPairs {
+		inValues = append(inValues, []interface{}{pair.DBID, pair.Ts})
+	}
+
+	var r []*dbmodel.Database
+	err = s.db.Model(&dbmodel.Database{}).Where("tenant_id = ? AND is_deleted = false AND (db_id, ts) IN ?", tenantID, inValues).Find(&r).Error
+	if err != nil {
+		log.Error("list databases by db_id & ts pairs failed", zap.String("tenant", tenantID), zap.Any("dbIdTs", inValues), zap.Error(err))
+		return nil, err
+	}
+
+	return r, nil
+}
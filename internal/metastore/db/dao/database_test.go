@@ -0,0 +1,103 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+)
+
+const dbID1 = typeutil.UniqueID(11)
+
+func TestDatabase_Insert(t *testing.T) {
+	var database = &dbmodel.Database{
+		TenantID:  tenantID,
+		DBID:      dbID1,
+		DBName:    "test_db_1",
+		Ts:        ts,
+		IsDeleted: false,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `databases` (`tenant_id`,`db_id`,`db_name`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(database.TenantID, database.DBID, database.DBName, database.Ts, database.IsDeleted, database.CreatedAt, database.UpdatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	// actual
+	err := databaseTestDb.Insert(database)
+	assert.Nil(t, err)
+}
+
+func TestDatabase_Insert_Error(t *testing.T) {
+	var database = &dbmodel.Database{
+		TenantID:  tenantID,
+		DBID:      dbID1,
+		DBName:    "test_db_1",
+		Ts:        ts,
+		IsDeleted: false,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	// expectation
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO `databases` (`tenant_id`,`db_id`,`db_name`,`ts`,`is_deleted`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`").
+		WithArgs(database.TenantID, database.DBID, database.DBName, database.Ts, database.IsDeleted, database.CreatedAt, database.UpdatedAt).
+		WillReturnError(errors.New("test error"))
+	mock.ExpectRollback()
+
+	// actual
+	err := databaseTestDb.Insert(database)
+	assert.Error(t, err)
+}
+
+func TestDatabase_ListDatabases(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT db_id, MAX(ts) ts FROM `databases` WHERE tenant_id = ? AND ts <= ? GROUP BY `db_id`").
+		WithArgs(tenantID, ts).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"db_id", "ts"}).
+				AddRow(dbID1, typeutil.Timestamp(2)))
+	mock.ExpectQuery("SELECT * FROM `databases` WHERE tenant_id = ? AND is_deleted = false AND (db_id, ts) IN ((?,?))").
+		WithArgs(tenantID, dbID1, typeutil.Timestamp(2)).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"tenant_id", "db_id", "db_name", "ts"}).
+				AddRow(tenantID, dbID1, "test_db_1", typeutil.Timestamp(2)))
+
+	// actual
+	res, err := databaseTestDb.ListDatabases(tenantID, ts)
+	assert.Nil(t, err)
+	assert.Equal(t, []*dbmodel.Database{{TenantID: tenantID, DBID: dbID1, DBName: "test_db_1", Ts: typeutil.Timestamp(2)}}, res)
+}
+
+func TestDatabase_ListDatabases_Empty(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT db_id, MAX(ts) ts FROM `databases` WHERE tenant_id = ? AND ts <= ? GROUP BY `db_id`").
+		WithArgs(tenantID, ts).
+		WillReturnRows(sqlmock.NewRows([]string{"db_id", "ts"}))
+
+	// actual
+	res, err := databaseTestDb.ListDatabases(tenantID, ts)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(res))
+}
+
+func TestDatabase_ListDatabases_Error(t *testing.T) {
+	// expectation
+	mock.ExpectQuery("SELECT db_id, MAX(ts) ts FROM `databases` WHERE tenant_id = ? AND ts <= ? GROUP BY `db_id`").
+		WithArgs(tenantID, ts).
+		WillReturnError(errors.New("test error"))
+
+	// actual
+	res, err := databaseTestDb.ListDatabases(tenantID, ts)
+	assert.Nil(t, res)
+	assert.Error(t, err)
+}
@@ -0,0 +1,48 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+)
+
+type ApiKey struct {
+	ID           int64     `gorm:"id"`
+	TenantID     string    `gorm:"tenant_id"`
+	KeyID        string    `gorm:"key_id"`
+	Username     string    `gorm:"username"`
+	Description  string    `gorm:"description"`
+	HashedSecret string    `gorm:"hashed_secret"`
+	IsDeleted    bool      `gorm:"is_deleted"`
+	CreatedAt    time.Time `gorm:"created_at"`
+	UpdatedAt    time.Time `gorm:"updated_at"`
+}
+
+func (v ApiKey) TableName() string {
+	return "credential_api_keys"
+}
+
+//go:generate mockery --name=IApiKeyDb
+type IApiKeyDb interface {
+	GetByKeyID(tenantID string, keyID string) (*ApiKey, error)
+	ListApiKeys(tenantID string) ([]*ApiKey, error)
+	Insert(in *ApiKey) error
+	MarkDeletedByKeyID(tenantID string, keyID string) error
+}
+
+// model <---> db
+
+func UnmarshalApiKeyModel(apiKey *ApiKey) *model.ApiKey {
+	if apiKey == nil {
+		return nil
+	}
+
+	return &model.ApiKey{
+		TenantID:     apiKey.TenantID,
+		KeyID:        apiKey.KeyID,
+		Username:     apiKey.Username,
+		Description:  apiKey.Description,
+		HashedSecret: apiKey.HashedSecret,
+		CreatedTime:  uint64(apiKey.CreatedAt.Unix()),
+	}
+}
@@ -15,6 +15,7 @@ import (
 type Collection struct {
 	ID               int64              `gorm:"id"`
 	TenantID         string             `gorm:"tenant_id"`
+	DBID             int64              `gorm:"db_id"`
 	CollectionID     int64              `gorm:"collection_id"`
 	CollectionName   string             `gorm:"collection_name"`
 	Description      string             `gorm:"description"`
@@ -40,7 +41,7 @@ type ICollectionDb interface {
 	GetCollectionIDTs(tenantID string, collectionID typeutil.UniqueID, ts typeutil.Timestamp) (*Collection, error)
 	ListCollectionIDTs(tenantID string, ts typeutil.Timestamp) ([]*Collection, error)
 	Get(tenantID string, collectionID typeutil.UniqueID, ts typeutil.Timestamp) (*Collection, error)
-	GetCollectionIDByName(tenantID string, collectionName string, ts typeutil.Timestamp) (typeutil.UniqueID, error)
+	GetCollectionIDByName(tenantID string, dbID int64, collectionName string, ts typeutil.Timestamp) (typeutil.UniqueID, error)
 	Insert(in *Collection) error
 	Update(in *Collection) error
 }
@@ -66,6 +67,7 @@ func UnmarshalCollectionModel(coll *Collection) (*model.Collection, error) {
 
 	return &model.Collection{
 		TenantID:         coll.TenantID,
+		DBID:             coll.DBID,
 		CollectionID:     coll.CollectionID,
 		Name:             coll.CollectionName,
 		Description:      coll.Description,
@@ -4,6 +4,7 @@ import "context"
 
 //go:generate mockery --name=IMetaDomain
 type IMetaDomain interface {
+	DatabaseDb(ctx context.Context) IDatabaseDb
 	CollectionDb(ctx context.Context) ICollectionDb
 	FieldDb(ctx context.Context) IFieldDb
 	CollChannelDb(ctx context.Context) ICollChannelDb
@@ -12,6 +13,7 @@ type IMetaDomain interface {
 	IndexDb(ctx context.Context) IIndexDb
 	SegmentIndexDb(ctx context.Context) ISegmentIndexDb
 	UserDb(ctx context.Context) IUserDb
+	ApiKeyDb(ctx context.Context) IApiKeyDb
 	RoleDb(ctx context.Context) IRoleDb
 	UserRoleDb(ctx context.Context) IUserRoleDb
 	GrantDb(ctx context.Context) IGrantDb
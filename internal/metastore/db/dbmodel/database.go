@@ -0,0 +1,41 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type Database struct {
+	ID        int64              `gorm:"id"`
+	TenantID  string             `gorm:"tenant_id"`
+	DBID      int64              `gorm:"db_id"`
+	DBName    string             `gorm:"db_name"`
+	Ts        typeutil.Timestamp `gorm:"ts"`
+	IsDeleted bool               `gorm:"is_deleted"`
+	CreatedAt time.Time          `gorm:"created_at"`
+	UpdatedAt time.Time          `gorm:"updated_at"`
+}
+
+func (v Database) TableName() string {
+	return "databases"
+}
+
+//go:generate mockery --name=IDatabaseDb
+type IDatabaseDb interface {
+	Insert(in *Database) error
+	// ListDatabases returns the databases which are not deleted at param ts.
+	ListDatabases(tenantID string, ts typeutil.Timestamp) ([]*Database, error)
+}
+
+// model <---> db
+
+func UnmarshalDatabaseModel(db *Database) *model.Database {
+	return &model.Database{
+		TenantID:    db.TenantID,
+		ID:          db.DBID,
+		Name:        db.DBName,
+		CreatedTime: db.Ts,
+	}
+}
@@ -14,6 +14,22 @@ type IMetaDomain struct {
 	mock.Mock
 }
 
+// ApiKeyDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) ApiKeyDb(ctx context.Context) dbmodel.IApiKeyDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.IApiKeyDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IApiKeyDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IApiKeyDb)
+		}
+	}
+
+	return r0
+}
+
 // CollAliasDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) CollAliasDb(ctx context.Context) dbmodel.ICollAliasDb {
 	ret := _m.Called(ctx)
@@ -62,6 +78,22 @@ func (_m *IMetaDomain) CollectionDb(ctx context.Context) dbmodel.ICollectionDb {
 	return r0
 }
 
+// DatabaseDb provides a mock function with given fields: ctx
+func (_m *IMetaDomain) DatabaseDb(ctx context.Context) dbmodel.IDatabaseDb {
+	ret := _m.Called(ctx)
+
+	var r0 dbmodel.IDatabaseDb
+	if rf, ok := ret.Get(0).(func(context.Context) dbmodel.IDatabaseDb); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(dbmodel.IDatabaseDb)
+		}
+	}
+
+	return r0
+}
+
 // FieldDb provides a mock function with given fields: ctx
 func (_m *IMetaDomain) FieldDb(ctx context.Context) dbmodel.IFieldDb {
 	ret := _m.Called(ctx)
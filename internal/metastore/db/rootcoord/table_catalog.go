@@ -37,6 +37,45 @@ func NewTableCatalog(txImpl dbmodel.ITransaction, metaDomain dbmodel.IMetaDomain
 	}
 }
 
+func (tc *Catalog) CreateDatabase(ctx context.Context, db *model.Database, ts typeutil.Timestamp) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	return tc.metaDomain.DatabaseDb(ctx).Insert(&dbmodel.Database{
+		TenantID: tenantID,
+		DBID:     db.ID,
+		DBName:   db.Name,
+		Ts:       ts,
+	})
+}
+
+func (tc *Catalog) DropDatabase(ctx context.Context, dbID int64, ts typeutil.Timestamp) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	// insert a mark-deleted record for databases
+	return tc.metaDomain.DatabaseDb(ctx).Insert(&dbmodel.Database{
+		TenantID:  tenantID,
+		DBID:      dbID,
+		Ts:        ts,
+		IsDeleted: true,
+	})
+}
+
+func (tc *Catalog) ListDatabases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Database, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	dbs, err := tc.metaDomain.DatabaseDb(ctx).ListDatabases(tenantID, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	r := make([]*model.Database, 0, len(dbs))
+	for _, db := range dbs {
+		r = append(r, dbmodel.UnmarshalDatabaseModel(db))
+	}
+
+	return r, nil
+}
+
 func (tc *Catalog) CreateCollection(ctx context.Context, collection *model.Collection, ts typeutil.Timestamp) error {
 	tenantID := contextutil.TenantID(ctx)
 
@@ -59,6 +98,7 @@ func (tc *Catalog) CreateCollection(ctx context.Context, collection *model.Colle
 
 		err = tc.metaDomain.CollectionDb(txCtx).Insert(&dbmodel.Collection{
 			TenantID:         tenantID,
+			DBID:             model.ResolveDBID(collection.DBID),
 			CollectionID:     collection.CollectionID,
 			CollectionName:   collection.Name,
 			Description:      collection.Description,
@@ -218,11 +258,11 @@ func (tc *Catalog) populateCollection(ctx context.Context, collectionID typeutil
 	return mCollection, nil
 }
 
-func (tc *Catalog) GetCollectionByName(ctx context.Context, collectionName string, ts typeutil.Timestamp) (*model.Collection, error) {
+func (tc *Catalog) GetCollectionByName(ctx context.Context, dbID int64, collectionName string, ts typeutil.Timestamp) (*model.Collection, error) {
 	tenantID := contextutil.TenantID(ctx)
 
 	// Since collection name will not change for different ts
-	collectionID, err := tc.metaDomain.CollectionDb(ctx).GetCollectionIDByName(tenantID, collectionName, ts)
+	collectionID, err := tc.metaDomain.CollectionDb(ctx).GetCollectionIDByName(tenantID, model.ResolveDBID(dbID), collectionName, ts)
 	if err != nil {
 		return nil, err
 	}
@@ -237,7 +277,7 @@ func (tc *Catalog) GetCollectionByName(ctx context.Context, collectionName strin
 // [collection3, t3, is_deleted=false]
 // t1, t2, t3 are the largest timestamp that less than or equal to @param ts
 // the final result will only return collection2 and collection3 since collection1 is deleted
-func (tc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (map[string]*model.Collection, error) {
+func (tc *Catalog) ListCollections(ctx context.Context, dbID int64, ts typeutil.Timestamp) (map[string]*model.Collection, error) {
 	tenantID := contextutil.TenantID(ctx)
 
 	// 1. find each collection_id with latest ts <= @param ts
@@ -274,6 +314,9 @@ func (tc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 
 	r := map[string]*model.Collection{}
 	for _, c := range collections {
+		if model.ResolveDBID(c.DBID) != model.ResolveDBID(dbID) {
+			continue
+		}
 		r[c.Name] = c
 	}
 
@@ -313,6 +356,7 @@ func (tc *Catalog) DropCollection(ctx context.Context, collection *model.Collect
 		// 1. insert a mark-deleted record for collections
 		coll := &dbmodel.Collection{
 			TenantID:     tenantID,
+			DBID:         model.ResolveDBID(collection.DBID),
 			CollectionID: collection.CollectionID,
 			Ts:           ts,
 			IsDeleted:    true,
@@ -330,6 +374,7 @@ func (tc *Catalog) DropCollection(ctx context.Context, collection *model.Collect
 			for _, alias := range collection.Aliases {
 				collAliases = append(collAliases, &dbmodel.CollectionAlias{
 					TenantID:        tenantID,
+					DBID:            model.ResolveDBID(collection.DBID),
 					CollectionID:    collection.CollectionID,
 					CollectionAlias: alias,
 					Ts:              ts,
@@ -503,6 +548,7 @@ func (tc *Catalog) CreateAlias(ctx context.Context, alias *model.Alias, ts typeu
 
 	collAlias := &dbmodel.CollectionAlias{
 		TenantID:        tenantID,
+		DBID:            model.ResolveDBID(alias.DBID),
 		CollectionID:    alias.CollectionID,
 		CollectionAlias: alias.Name,
 		Ts:              ts,
@@ -516,16 +562,18 @@ func (tc *Catalog) CreateAlias(ctx context.Context, alias *model.Alias, ts typeu
 	return nil
 }
 
-func (tc *Catalog) DropAlias(ctx context.Context, alias string, ts typeutil.Timestamp) error {
+func (tc *Catalog) DropAlias(ctx context.Context, dbID int64, alias string, ts typeutil.Timestamp) error {
 	tenantID := contextutil.TenantID(ctx)
+	dbID = model.ResolveDBID(dbID)
 
-	collectionID, err := tc.metaDomain.CollAliasDb(ctx).GetCollectionIDByAlias(tenantID, alias, ts)
+	collectionID, err := tc.metaDomain.CollAliasDb(ctx).GetCollectionIDByAlias(tenantID, dbID, alias, ts)
 	if err != nil {
 		return err
 	}
 
 	collAlias := &dbmodel.CollectionAlias{
 		TenantID:        tenantID,
+		DBID:            dbID,
 		CollectionID:    collectionID,
 		CollectionAlias: alias,
 		Ts:              ts,
@@ -552,7 +600,7 @@ func (tc *Catalog) AlterAlias(ctx context.Context, alias *model.Alias, ts typeut
 }
 
 // ListAliases query collection ID and aliases only, other information are not needed
-func (tc *Catalog) ListAliases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Alias, error) {
+func (tc *Catalog) ListAliases(ctx context.Context, dbID int64, ts typeutil.Timestamp) ([]*model.Alias, error) {
 	tenantID := contextutil.TenantID(ctx)
 
 	// 1. find each collection with latest ts
@@ -574,9 +622,13 @@ func (tc *Catalog) ListAliases(ctx context.Context, ts typeutil.Timestamp) ([]*m
 
 	r := make([]*model.Alias, 0, len(collAliases))
 	for _, record := range collAliases {
+		if model.ResolveDBID(record.DBID) != model.ResolveDBID(dbID) {
+			continue
+		}
 		r = append(r, &model.Alias{
 			CollectionID: record.CollectionID,
 			Name:         record.CollectionAlias,
+			DBID:         record.DBID,
 		})
 	}
 
@@ -647,6 +699,60 @@ func (tc *Catalog) ListCredentials(ctx context.Context) ([]string, error) {
 	return usernames, nil
 }
 
+func (tc *Catalog) CreateApiKey(ctx context.Context, apiKey *model.ApiKey) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.metaDomain.ApiKeyDb(ctx).Insert(&dbmodel.ApiKey{
+		TenantID:     tenantID,
+		KeyID:        apiKey.KeyID,
+		Username:     apiKey.Username,
+		Description:  apiKey.Description,
+		HashedSecret: apiKey.HashedSecret,
+		CreatedAt:    time.Unix(int64(apiKey.CreatedTime), 0),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tc *Catalog) GetApiKey(ctx context.Context, keyID string) (*model.ApiKey, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	apiKey, err := tc.metaDomain.ApiKeyDb(ctx).GetByKeyID(tenantID, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return dbmodel.UnmarshalApiKeyModel(apiKey), nil
+}
+
+func (tc *Catalog) DropApiKey(ctx context.Context, keyID string) error {
+	tenantID := contextutil.TenantID(ctx)
+
+	err := tc.metaDomain.ApiKeyDb(ctx).MarkDeletedByKeyID(tenantID, keyID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (tc *Catalog) ListApiKeys(ctx context.Context) ([]*model.ApiKey, error) {
+	tenantID := contextutil.TenantID(ctx)
+
+	apiKeys, err := tc.metaDomain.ApiKeyDb(ctx).ListApiKeys(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	r := make([]*model.ApiKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		r = append(r, dbmodel.UnmarshalApiKeyModel(apiKey))
+	}
+	return r, nil
+}
+
 func (tc *Catalog) CreateRole(ctx context.Context, tenant string, entity *milvuspb.RoleEntity) error {
 	var err error
 	if _, err = tc.GetRoleIDByName(ctx, tenant, entity.Name); err != nil && !common.IsKeyNotExistError(err) {
@@ -19,6 +19,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel"
 	"github.com/milvus-io/milvus/internal/metastore/db/dbmodel/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/contextutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -48,6 +49,7 @@ const (
 var (
 	ctx               context.Context
 	metaDomainMock    *mocks.IMetaDomain
+	databaseDbMock    *mocks.IDatabaseDb
 	collDbMock        *mocks.ICollectionDb
 	fieldDbMock       *mocks.IFieldDb
 	partitionDbMock   *mocks.IPartitionDb
@@ -56,6 +58,7 @@ var (
 	aliasDbMock       *mocks.ICollAliasDb
 	segIndexDbMock    *mocks.ISegmentIndexDb
 	userDbMock        *mocks.IUserDb
+	apiKeyDbMock      *mocks.IApiKeyDb
 	roleDbMock        *mocks.IRoleDb
 	userRoleDbMock    *mocks.IUserRoleDb
 	grantDbMock       *mocks.IGrantDb
@@ -68,6 +71,7 @@ var (
 func TestMain(m *testing.M) {
 	ctx = contextutil.WithTenantID(context.Background(), tenantID)
 
+	databaseDbMock = &mocks.IDatabaseDb{}
 	collDbMock = &mocks.ICollectionDb{}
 	fieldDbMock = &mocks.IFieldDb{}
 	partitionDbMock = &mocks.IPartitionDb{}
@@ -76,12 +80,14 @@ func TestMain(m *testing.M) {
 	aliasDbMock = &mocks.ICollAliasDb{}
 	segIndexDbMock = &mocks.ISegmentIndexDb{}
 	userDbMock = &mocks.IUserDb{}
+	apiKeyDbMock = &mocks.IApiKeyDb{}
 	roleDbMock = &mocks.IRoleDb{}
 	userRoleDbMock = &mocks.IUserRoleDb{}
 	grantDbMock = &mocks.IGrantDb{}
 	grantIDDbMock = &mocks.IGrantIDDb{}
 
 	metaDomainMock = &mocks.IMetaDomain{}
+	metaDomainMock.On("DatabaseDb", ctx).Return(databaseDbMock)
 	metaDomainMock.On("CollectionDb", ctx).Return(collDbMock)
 	metaDomainMock.On("FieldDb", ctx).Return(fieldDbMock)
 	metaDomainMock.On("PartitionDb", ctx).Return(partitionDbMock)
@@ -90,6 +96,7 @@ func TestMain(m *testing.M) {
 	metaDomainMock.On("CollAliasDb", ctx).Return(aliasDbMock)
 	metaDomainMock.On("SegmentIndexDb", ctx).Return(segIndexDbMock)
 	metaDomainMock.On("UserDb", ctx).Return(userDbMock)
+	metaDomainMock.On("ApiKeyDb", ctx).Return(apiKeyDbMock)
 	metaDomainMock.On("RoleDb", ctx).Return(roleDbMock)
 	metaDomainMock.On("UserRoleDb", ctx).Return(userRoleDbMock)
 	metaDomainMock.On("GrantDb", ctx).Return(grantDbMock)
@@ -111,6 +118,52 @@ func mockMetaCatalog(petDomain dbmodel.IMetaDomain) *Catalog {
 	return NewTableCatalog(&NoopTransaction{}, petDomain)
 }
 
+func TestTableCatalog_CreateDatabase(t *testing.T) {
+	db := &model.Database{ID: 1000, Name: "db"}
+
+	// expectation
+	databaseDbMock.On("Insert", mock.Anything).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.CreateDatabase(ctx, db, ts)
+	require.NoError(t, gotErr)
+}
+
+func TestTableCatalog_DropDatabase(t *testing.T) {
+	errTest := errors.New("test error")
+	// expectation
+	databaseDbMock.On("Insert", mock.MatchedBy(func(db *dbmodel.Database) bool {
+		return db.DBID == 1000 && db.IsDeleted
+	})).Return(errTest).Once()
+
+	// actual
+	gotErr := mockCatalog.DropDatabase(ctx, 1000, ts)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_ListDatabases(t *testing.T) {
+	dbs := []*dbmodel.Database{
+		{TenantID: tenantID, DBID: 1000, DBName: "db", Ts: ts},
+	}
+
+	// expectation
+	databaseDbMock.On("ListDatabases", tenantID, ts).Return(dbs, nil).Once()
+
+	// actual
+	res, gotErr := mockCatalog.ListDatabases(ctx, ts)
+	require.NoError(t, gotErr)
+	require.Equal(t, []*model.Database{{TenantID: tenantID, ID: 1000, Name: "db", CreatedTime: ts}}, res)
+
+	errTest := errors.New("test error")
+	// expectation
+	databaseDbMock.On("ListDatabases", tenantID, ts).Return(nil, errTest).Once()
+
+	// actual
+	res, gotErr = mockCatalog.ListDatabases(ctx, ts)
+	require.Nil(t, res)
+	require.Error(t, gotErr)
+}
+
 func TestTableCatalog_CreateCollection(t *testing.T) {
 	coll := &model.Collection{
 		CollectionID: collID1,
@@ -441,7 +494,7 @@ func TestTableCatalog_GetCollectionByName(t *testing.T) {
 	}
 
 	// expectation
-	collDbMock.On("GetCollectionIDByName", tenantID, collName1, ts).Return(collID1, nil).Once()
+	collDbMock.On("GetCollectionIDByName", tenantID, util.DefaultDBID, collName1, ts).Return(collID1, nil).Once()
 	collDbMock.On("GetCollectionIDTs", tenantID, collID1, ts).Return(&dbmodel.Collection{CollectionID: collID1, Ts: ts}, nil).Once()
 	collDbMock.On("Get", tenantID, collID1, ts).Return(coll, nil).Once()
 	fieldDbMock.On("GetByCollectionID", tenantID, collID1, ts).Return(fields, nil).Once()
@@ -450,7 +503,7 @@ func TestTableCatalog_GetCollectionByName(t *testing.T) {
 	indexDbMock.On("Get", tenantID, collID1).Return(indexes, nil).Once()
 
 	// actual
-	res, gotErr := mockCatalog.GetCollectionByName(ctx, collName1, ts)
+	res, gotErr := mockCatalog.GetCollectionByName(ctx, util.DefaultDBID, collName1, ts)
 	// collection basic info
 	require.Equal(t, nil, gotErr)
 	require.Equal(t, coll.TenantID, res.TenantID)
@@ -469,10 +522,10 @@ func TestTableCatalog_GetCollectionByName(t *testing.T) {
 func TestTableCatalog_GetCollectionByName_SelectCollIDError(t *testing.T) {
 	// expectation
 	errTest := errors.New("select fields error")
-	collDbMock.On("GetCollectionIDByName", tenantID, collName1, ts).Return(typeutil.UniqueID(0), errTest).Once()
+	collDbMock.On("GetCollectionIDByName", tenantID, util.DefaultDBID, collName1, ts).Return(typeutil.UniqueID(0), errTest).Once()
 
 	// actual
-	res, gotErr := mockCatalog.GetCollectionByName(ctx, collName1, ts)
+	res, gotErr := mockCatalog.GetCollectionByName(ctx, util.DefaultDBID, collName1, ts)
 	require.Nil(t, res)
 	require.Error(t, gotErr)
 }
@@ -532,7 +585,7 @@ func TestTableCatalog_ListCollections(t *testing.T) {
 	indexDbMock.On("Get", tenantID, collID1).Return(indexes, nil).Once()
 
 	// actual
-	res, gotErr := mockCatalog.ListCollections(ctx, ts)
+	res, gotErr := mockCatalog.ListCollections(ctx, util.DefaultDBID, ts)
 	// collection basic info
 	require.Equal(t, nil, gotErr)
 	require.Equal(t, 1, len(res))
@@ -615,6 +668,7 @@ func TestTableCatalog_DropCollection_TsNot0(t *testing.T) {
 	}
 	inColl := &dbmodel.Collection{
 		TenantID:     tenantID,
+		DBID:         util.DefaultDBID,
 		CollectionID: coll.CollectionID,
 		Ts:           ts,
 		IsDeleted:    true,
@@ -622,6 +676,7 @@ func TestTableCatalog_DropCollection_TsNot0(t *testing.T) {
 	inAliases := []*dbmodel.CollectionAlias{
 		{
 			TenantID:        tenantID,
+			DBID:            util.DefaultDBID,
 			CollectionID:    coll.CollectionID,
 			CollectionAlias: coll.Aliases[0],
 			Ts:              ts,
@@ -629,6 +684,7 @@ func TestTableCatalog_DropCollection_TsNot0(t *testing.T) {
 		},
 		{
 			TenantID:        tenantID,
+			DBID:            util.DefaultDBID,
 			CollectionID:    coll.CollectionID,
 			CollectionAlias: coll.Aliases[1],
 			Ts:              ts,
@@ -964,32 +1020,32 @@ func TestTableCatalog_CreateAlias_InsertAliasError(t *testing.T) {
 
 func TestTableCatalog_DropAlias_TsNot0(t *testing.T) {
 	// expectation
-	aliasDbMock.On("GetCollectionIDByAlias", tenantID, collAlias1, ts).Return(collID1, nil).Once()
+	aliasDbMock.On("GetCollectionIDByAlias", tenantID, util.DefaultDBID, collAlias1, ts).Return(collID1, nil).Once()
 	aliasDbMock.On("Insert", mock.Anything).Return(nil).Once()
 
 	// actual
-	gotErr := mockCatalog.DropAlias(ctx, collAlias1, ts)
+	gotErr := mockCatalog.DropAlias(ctx, util.DefaultDBID, collAlias1, ts)
 	require.NoError(t, gotErr)
 }
 
 func TestTableCatalog_DropAlias_TsNot0_SelectCollectionIDByAliasError(t *testing.T) {
 	// expectation
 	errTest := errors.New("test error")
-	aliasDbMock.On("GetCollectionIDByAlias", tenantID, collAlias1, ts).Return(typeutil.UniqueID(0), errTest).Once()
+	aliasDbMock.On("GetCollectionIDByAlias", tenantID, util.DefaultDBID, collAlias1, ts).Return(typeutil.UniqueID(0), errTest).Once()
 
 	// actual
-	gotErr := mockCatalog.DropAlias(ctx, collAlias1, ts)
+	gotErr := mockCatalog.DropAlias(ctx, util.DefaultDBID, collAlias1, ts)
 	require.Error(t, gotErr)
 }
 
 func TestTableCatalog_DropAlias_TsNot0_InsertIndexError(t *testing.T) {
 	// expectation
 	errTest := errors.New("test error")
-	aliasDbMock.On("GetCollectionIDByAlias", tenantID, collAlias1, ts).Return(collID1, nil).Once()
+	aliasDbMock.On("GetCollectionIDByAlias", tenantID, util.DefaultDBID, collAlias1, ts).Return(collID1, nil).Once()
 	aliasDbMock.On("Insert", mock.Anything).Return(errTest).Once()
 
 	// actual
-	gotErr := mockCatalog.DropAlias(ctx, collAlias1, ts)
+	gotErr := mockCatalog.DropAlias(ctx, util.DefaultDBID, collAlias1, ts)
 	require.Error(t, gotErr)
 }
 
@@ -1027,7 +1083,7 @@ func TestTableCatalog_ListAliases(t *testing.T) {
 	aliasDbMock.On("List", tenantID, cidTsPairs).Return(collAliases, nil).Once()
 
 	// actual
-	res, gotErr := mockCatalog.ListAliases(ctx, ts)
+	res, gotErr := mockCatalog.ListAliases(ctx, util.DefaultDBID, ts)
 	require.Equal(t, nil, gotErr)
 	require.Equal(t, out, res)
 }
@@ -1037,7 +1093,7 @@ func TestTableCatalog_ListAliases_NoResult(t *testing.T) {
 	aliasDbMock.On("ListCollectionIDTs", tenantID, ts).Return(nil, nil).Once()
 
 	// actual
-	res, gotErr := mockCatalog.ListAliases(ctx, ts)
+	res, gotErr := mockCatalog.ListAliases(ctx, util.DefaultDBID, ts)
 	require.Equal(t, nil, gotErr)
 	require.Empty(t, res)
 }
@@ -1048,7 +1104,7 @@ func TestTableCatalog_ListAliases_ListCidTsError(t *testing.T) {
 	aliasDbMock.On("ListCollectionIDTs", tenantID, ts).Return(nil, errTest).Once()
 
 	// actual
-	res, gotErr := mockCatalog.ListAliases(ctx, ts)
+	res, gotErr := mockCatalog.ListAliases(ctx, util.DefaultDBID, ts)
 	require.Nil(t, res)
 	require.Error(t, gotErr)
 }
@@ -1061,7 +1117,7 @@ func TestTableCatalog_ListAliases_SelectAliasError(t *testing.T) {
 	aliasDbMock.On("List", tenantID, mock.Anything).Return(nil, errTest).Once()
 
 	// actual
-	res, gotErr := mockCatalog.ListAliases(ctx, ts)
+	res, gotErr := mockCatalog.ListAliases(ctx, util.DefaultDBID, ts)
 	require.Nil(t, res)
 	require.Error(t, gotErr)
 }
@@ -1199,6 +1255,88 @@ func TestTableCatalog_ListCredentials_SelectUsernamesError(t *testing.T) {
 	require.Error(t, gotErr)
 }
 
+func TestTableCatalog_CreateApiKey(t *testing.T) {
+	in := &model.ApiKey{
+		KeyID:        "key1",
+		Username:     username,
+		HashedSecret: password,
+		CreatedTime:  100,
+	}
+
+	// expectation
+	apiKeyDbMock.On("Insert", mock.MatchedBy(func(apiKey *dbmodel.ApiKey) bool {
+		return apiKey.TenantID == tenantID && apiKey.KeyID == "key1" && apiKey.CreatedAt.Unix() == 100
+	})).Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.CreateApiKey(ctx, in)
+	require.NoError(t, gotErr)
+
+	// insert error
+	apiKeyDbMock.On("Insert", mock.Anything).Return(errors.New("test error")).Once()
+	gotErr = mockCatalog.CreateApiKey(ctx, in)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_GetApiKey(t *testing.T) {
+	apiKey := &dbmodel.ApiKey{
+		KeyID:        "key1",
+		Username:     username,
+		HashedSecret: password,
+		CreatedAt:    time.Unix(100, 0),
+	}
+
+	// expectation
+	apiKeyDbMock.On("GetByKeyID", tenantID, "key1").Return(apiKey, nil).Once()
+
+	// actual
+	res, gotErr := mockCatalog.GetApiKey(ctx, "key1")
+	require.NoError(t, gotErr)
+	require.Equal(t, &model.ApiKey{KeyID: "key1", Username: username, HashedSecret: password, CreatedTime: 100}, res)
+
+	// select error
+	apiKeyDbMock.On("GetByKeyID", tenantID, "key1").Return(nil, errors.New("test error")).Once()
+	res, gotErr = mockCatalog.GetApiKey(ctx, "key1")
+	require.Nil(t, res)
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_DropApiKey(t *testing.T) {
+	// expectation
+	apiKeyDbMock.On("MarkDeletedByKeyID", tenantID, "key1").Return(nil).Once()
+
+	// actual
+	gotErr := mockCatalog.DropApiKey(ctx, "key1")
+	require.NoError(t, gotErr)
+
+	// update error
+	apiKeyDbMock.On("MarkDeletedByKeyID", tenantID, "key1").Return(errors.New("test error")).Once()
+	gotErr = mockCatalog.DropApiKey(ctx, "key1")
+	require.Error(t, gotErr)
+}
+
+func TestTableCatalog_ListApiKeys(t *testing.T) {
+	apiKey := &dbmodel.ApiKey{
+		KeyID:    "key1",
+		Username: username,
+	}
+
+	// expectation
+	apiKeyDbMock.On("ListApiKeys", tenantID).Return([]*dbmodel.ApiKey{apiKey}, nil).Once()
+
+	// actual
+	res, gotErr := mockCatalog.ListApiKeys(ctx)
+	require.NoError(t, gotErr)
+	require.Equal(t, 1, len(res))
+	require.Equal(t, "key1", res[0].KeyID)
+
+	// select error
+	apiKeyDbMock.On("ListApiKeys", tenantID).Return(nil, errors.New("test error")).Once()
+	res, gotErr = mockCatalog.ListApiKeys(ctx)
+	require.Nil(t, res)
+	require.Error(t, gotErr)
+}
+
 func TestTableCatalog_CreateRole(t *testing.T) {
 	var (
 		roleName = "foo"
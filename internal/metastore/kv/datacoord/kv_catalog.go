@@ -97,6 +97,15 @@ func (kc *Catalog) AddSegment(ctx context.Context, segment *datapb.SegmentInfo)
 	if err != nil {
 		return err
 	}
+	// the cloned segments are added as flushed, notify IndexCoord to build index for them.
+	if segment.GetClonedFrom() != 0 && segment.GetState() == commonpb.SegmentState_Flushed {
+		flushSegKey := buildFlushedSegmentPath(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID())
+		segBytes, err := marshalSegmentInfo(&datapb.SegmentInfo{ID: segment.GetID()})
+		if err != nil {
+			return err
+		}
+		kvs[flushSegKey] = segBytes
+	}
 	return kc.Txn.MultiSave(kvs)
 }
 
@@ -225,7 +234,8 @@ func (kc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments [
 			// convert to new format that include segment key and three binlog keys,
 			// or GC can not find data path on the storage.
 			if !hasBinlogkeys {
-				binlogsKvs, err := buildBinlogKvsWithLogID(noBinlogsSegment.CollectionID, noBinlogsSegment.PartitionID, noBinlogsSegment.ID, binlogs, deltalogs, statslogs)
+				binlogsKvs, err := buildBinlogKvsWithLogID(noBinlogsSegment.CollectionID, noBinlogsSegment.PartitionID, noBinlogsSegment.ID,
+					binlogs, deltalogs, statslogs, noBinlogsSegment.GetClonedFrom() != 0)
 				if err != nil {
 					return err
 				}
@@ -438,6 +448,10 @@ func (kc *Catalog) unmarshalBinlog(binlogType storage.BinlogType, collectionID,
 func fillLogPathByLogID(chunkManagerRootPath string, binlogType storage.BinlogType, collectionID, partitionID,
 	segmentID typeutil.UniqueID, fieldBinlog *datapb.FieldBinlog) error {
 	for _, binlog := range fieldBinlog.Binlogs {
+		// the path of binlog shared from other segment is stored as it is.
+		if binlog.GetLogPath() != "" {
+			continue
+		}
 		path, err := buildLogPath(chunkManagerRootPath, binlogType, collectionID, partitionID,
 			segmentID, fieldBinlog.GetFieldID(), binlog.GetLogID())
 		if err != nil {
@@ -450,30 +464,46 @@ func fillLogPathByLogID(chunkManagerRootPath string, binlogType storage.BinlogTy
 	return nil
 }
 
-func fillLogIDByLogPath(multiFieldBinlogs ...[]*datapb.FieldBinlog) error {
-	for _, fieldBinlogs := range multiFieldBinlogs {
-		for _, fieldBinlog := range fieldBinlogs {
-			for _, binlog := range fieldBinlog.Binlogs {
-				logPath := binlog.LogPath
-				idx := strings.LastIndex(logPath, "/")
-				if idx == -1 {
-					return fmt.Errorf("invailed binlog path: %s", logPath)
-				}
-				logPathStr := logPath[(idx + 1):]
-				logID, err := strconv.ParseInt(logPathStr, 10, 64)
-				if err != nil {
-					return err
-				}
+func fillLogIDByLogPath(binlogType storage.BinlogType, segmentID typeutil.UniqueID, fieldBinlogs []*datapb.FieldBinlog) error {
+	for _, fieldBinlog := range fieldBinlogs {
+		for _, binlog := range fieldBinlog.Binlogs {
+			logPath := binlog.LogPath
+			idx := strings.LastIndex(logPath, "/")
+			if idx == -1 {
+				return fmt.Errorf("invailed binlog path: %s", logPath)
+			}
+			logPathStr := logPath[(idx + 1):]
+			logID, err := strconv.ParseInt(logPathStr, 10, 64)
+			if err != nil {
+				return err
+			}
 
-				// set log path to empty and only store log id
+			binlog.LogID = logID
+			// set log path to empty and only store log id,
+			// except the binlog shared from other segment, whose path can't be built from the segment meta,
+			// and the columnar binlog shared by the fields, whose path doesn't contain the field id.
+			if getSegmentIDFromLogPath(binlogType, logPath) == segmentID && !(binlogType == storage.InsertBinlog && storage.IsColumnarLogPath(logPath)) {
 				binlog.LogPath = ""
-				binlog.LogID = logID
 			}
 		}
 	}
 	return nil
 }
 
+func getSegmentIDFromLogPath(binlogType storage.BinlogType, logPath string) typeutil.UniqueID {
+	switch binlogType {
+	case storage.InsertBinlog:
+		return metautil.GetSegmentIDFromInsertLogPath(logPath)
+	case storage.DeleteBinlog:
+		return metautil.GetSegmentIDFromDeltaLogPath(logPath)
+	case storage.StatsBinlog:
+		return metautil.GetSegmentIDFromStatsLogPath(logPath)
+	default:
+		log.Panic("invalid binlog type")
+	}
+	return 0
+}
+
 // build a binlog path on the storage by metadata
 func buildLogPath(chunkManagerRootPath string, binlogType storage.BinlogType, collectionID, partitionID, segmentID, filedID, logID typeutil.UniqueID) (string, error) {
 	switch binlogType {
@@ -491,11 +521,12 @@ func buildLogPath(chunkManagerRootPath string, binlogType storage.BinlogType, co
 	}
 }
 
-func checkBinlogs(binlogType storage.BinlogType, segmentID typeutil.UniqueID, logs []*datapb.FieldBinlog) {
+// checkBinlogs checks the binlogs belong to the segment, only the cloned segment could share the binlogs of other segments.
+func checkBinlogs(binlogType storage.BinlogType, segmentID typeutil.UniqueID, logs []*datapb.FieldBinlog, cloned bool) {
 	check := func(getSegmentID func(logPath string) typeutil.UniqueID) {
 		for _, fieldBinlog := range logs {
 			for _, binlog := range fieldBinlog.Binlogs {
-				if segmentID != getSegmentID(binlog.LogPath) {
+				if segmentID != getSegmentID(binlog.LogPath) && !cloned {
 					log.Panic("the segment path doesn't match the segment id", zap.Int64("segment_id", segmentID), zap.String("path", binlog.LogPath))
 				}
 			}
@@ -514,13 +545,15 @@ func checkBinlogs(binlogType storage.BinlogType, segmentID typeutil.UniqueID, lo
 }
 
 func buildBinlogKvsWithLogID(collectionID, partitionID, segmentID typeutil.UniqueID,
-	binlogs, deltalogs, statslogs []*datapb.FieldBinlog) (map[string]string, error) {
+	binlogs, deltalogs, statslogs []*datapb.FieldBinlog, cloned bool) (map[string]string, error) {
 
-	checkBinlogs(storage.InsertBinlog, segmentID, binlogs)
-	checkBinlogs(storage.DeleteBinlog, segmentID, deltalogs)
-	checkBinlogs(storage.StatsBinlog, segmentID, statslogs)
+	checkBinlogs(storage.InsertBinlog, segmentID, binlogs, cloned)
+	checkBinlogs(storage.DeleteBinlog, segmentID, deltalogs, cloned)
+	checkBinlogs(storage.StatsBinlog, segmentID, statslogs, cloned)
 
-	fillLogIDByLogPath(binlogs, deltalogs, statslogs)
+	fillLogIDByLogPath(storage.InsertBinlog, segmentID, binlogs)
+	fillLogIDByLogPath(storage.DeleteBinlog, segmentID, deltalogs)
+	fillLogIDByLogPath(storage.StatsBinlog, segmentID, statslogs)
 	kvs, err := buildBinlogKvs(collectionID, partitionID, segmentID, binlogs, deltalogs, statslogs)
 	if err != nil {
 		return nil, err
@@ -535,7 +568,8 @@ func buildSegmentAndBinlogsKvs(segment *datapb.SegmentInfo) (map[string]string,
 	segmentutil.ReCalcRowCount(segment, noBinlogsSegment)
 
 	// save binlogs separately
-	kvs, err := buildBinlogKvsWithLogID(noBinlogsSegment.CollectionID, noBinlogsSegment.PartitionID, noBinlogsSegment.ID, binlogs, deltalogs, statslogs)
+	kvs, err := buildBinlogKvsWithLogID(noBinlogsSegment.CollectionID, noBinlogsSegment.PartitionID, noBinlogsSegment.ID,
+		binlogs, deltalogs, statslogs, noBinlogsSegment.GetClonedFrom() != 0)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,8 @@ package datacoord
 import (
 	"context"
 	"errors"
+	"path"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -12,10 +14,12 @@ import (
 	"golang.org/x/exp/maps"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/metautil"
 )
 
@@ -324,6 +328,66 @@ func Test_AddSegments(t *testing.T) {
 		assert.Equal(t, 4, len(savedKvs))
 		verifySavedKvsForSegment(t, savedKvs)
 	})
+
+	t.Run("save cloned segment", func(t *testing.T) {
+		txn := &MockedTxnKV{}
+		savedKvs := make(map[string]string)
+		txn.multiSave = func(kvs map[string]string) error {
+			savedKvs = kvs
+			return nil
+		}
+
+		cloned := &datapb.SegmentInfo{
+			ID:           segmentID2,
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+			NumOfRows:    5,
+			State:        commonpb.SegmentState_Flushed,
+			Binlogs:      getlogs(binlogPath),
+			Deltalogs: []*datapb.FieldBinlog{{
+				FieldID: 1,
+				Binlogs: append(getlogs(deltalogPath)[0].GetBinlogs(), getlogs(deltalogPath2)[0].GetBinlogs()...),
+			}},
+			Statslogs:  getlogs(statslogPath),
+			ClonedFrom: segmentID,
+		}
+
+		catalog := &Catalog{txn, "a"}
+		err := catalog.AddSegment(context.TODO(), cloned)
+		assert.NoError(t, err)
+		_, ok := savedKvs[buildFlushedSegmentPath(collectionID, partitionID, segmentID2)]
+		assert.True(t, ok)
+
+		// the paths of shared binlogs are kept
+		fieldBinlog := &datapb.FieldBinlog{}
+		assert.NoError(t, proto.Unmarshal([]byte(savedKvs[k7]), fieldBinlog))
+		assert.Equal(t, binlogPath, fieldBinlog.GetBinlogs()[0].GetLogPath())
+		assert.NoError(t, proto.Unmarshal([]byte(savedKvs[k8]), fieldBinlog))
+		assert.Equal(t, 2, len(fieldBinlog.GetBinlogs()))
+		assert.Equal(t, deltalogPath, fieldBinlog.GetBinlogs()[0].GetLogPath())
+		assert.Equal(t, "", fieldBinlog.GetBinlogs()[1].GetLogPath())
+
+		fillLogPathByLogID("a", storage.DeleteBinlog, collectionID, partitionID, segmentID2, fieldBinlog)
+		assert.Equal(t, deltalogPath, fieldBinlog.GetBinlogs()[0].GetLogPath())
+		assert.Equal(t, deltalogPath2, fieldBinlog.GetBinlogs()[1].GetLogPath())
+	})
+}
+
+func Test_fillLogIDByLogPath(t *testing.T) {
+	columnarPath := metautil.BuildInsertLogPath("a", collectionID, partitionID, segmentID, fieldID, logID)
+	columnarPath = path.Join(path.Dir(path.Dir(columnarPath)), common.SegmentColumnarLogDir, strconv.FormatInt(logID, 10))
+	fieldBinlogs := []*datapb.FieldBinlog{
+		{FieldID: fieldID, Binlogs: []*datapb.Binlog{{LogPath: binlogPath}, {LogPath: columnarPath}}},
+	}
+	assert.NoError(t, fillLogIDByLogPath(storage.InsertBinlog, segmentID, fieldBinlogs))
+	assert.Equal(t, int64(logID), fieldBinlogs[0].GetBinlogs()[1].GetLogID())
+	// the path of the columnar binlog can't be built from the field id, so it's kept
+	assert.Equal(t, "", fieldBinlogs[0].GetBinlogs()[0].GetLogPath())
+	assert.Equal(t, columnarPath, fieldBinlogs[0].GetBinlogs()[1].GetLogPath())
+
+	assert.NoError(t, fillLogPathByLogID("a", storage.InsertBinlog, collectionID, partitionID, segmentID, fieldBinlogs[0]))
+	assert.Equal(t, binlogPath, fieldBinlogs[0].GetBinlogs()[0].GetLogPath())
+	assert.Equal(t, columnarPath, fieldBinlogs[0].GetBinlogs()[1].GetLogPath())
 }
 
 func Test_AlterSegments(t *testing.T) {
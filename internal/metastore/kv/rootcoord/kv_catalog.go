@@ -23,6 +23,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -35,6 +36,8 @@ const (
 // prefix/partitions/collection_id/partition_id		-> PartitionInfo
 // prefix/aliases/alias_name						-> AliasInfo
 // prefix/fields/collection_id/field_id				-> FieldSchema
+// prefix/database/db-info/db_id					-> DatabaseInfo
+// prefix/database/aliases/db_id/alias_name			-> AliasInfo of the databases except the default one
 type Catalog struct {
 	Txn      kv.TxnKV
 	Snapshot kv.SnapShotKV
@@ -68,6 +71,22 @@ func BuildAliasKey(aliasName string) string {
 	return fmt.Sprintf("%s/%s", AliasMetaPrefix, aliasName)
 }
 
+func BuildAliasPrefixWithDB(dbID int64) string {
+	return fmt.Sprintf("%s/%d", AliasMetaPrefixWithDB, dbID)
+}
+
+func BuildAliasKeyWithDB(dbID int64, aliasName string) string {
+	return fmt.Sprintf("%s/%s", BuildAliasPrefixWithDB(dbID), aliasName)
+}
+
+func BuildDatabaseKey(dbID int64) string {
+	return fmt.Sprintf("%s/%d", DBInfoMetaPrefix, dbID)
+}
+
+func isDefaultDB(dbID int64) bool {
+	return model.ResolveDBID(dbID) == util.DefaultDBID
+}
+
 func batchMultiSaveAndRemoveWithPrefix(snapshot kv.SnapShotKV, maxTxnNum int, saves map[string]string, removals []string, ts typeutil.Timestamp) error {
 	saveFn := func(partialKvs map[string]string) error {
 		return snapshot.MultiSave(partialKvs, ts)
@@ -82,6 +101,36 @@ func batchMultiSaveAndRemoveWithPrefix(snapshot kv.SnapShotKV, maxTxnNum int, sa
 	return etcd.RemoveByBatch(removals, removeFn)
 }
 
+func (kc *Catalog) CreateDatabase(ctx context.Context, db *model.Database, ts typeutil.Timestamp) error {
+	k := BuildDatabaseKey(db.ID)
+	v, err := proto.Marshal(model.MarshalDatabaseModel(db))
+	if err != nil {
+		return err
+	}
+	return kc.Snapshot.Save(k, string(v), ts)
+}
+
+func (kc *Catalog) DropDatabase(ctx context.Context, dbID int64, ts typeutil.Timestamp) error {
+	k := BuildDatabaseKey(dbID)
+	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(nil, []string{k}, ts)
+}
+
+func (kc *Catalog) ListDatabases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Database, error) {
+	_, values, err := kc.Snapshot.LoadWithPrefix(DBInfoMetaPrefix, ts)
+	if err != nil {
+		return nil, err
+	}
+	dbs := make([]*model.Database, 0, len(values))
+	for _, v := range values {
+		info := &pb.DatabaseInfo{}
+		if err := proto.Unmarshal([]byte(v), info); err != nil {
+			return nil, err
+		}
+		dbs = append(dbs, model.UnmarshalDatabaseModel(info))
+	}
+	return dbs, nil
+}
+
 func (kc *Catalog) CreateCollection(ctx context.Context, coll *model.Collection, ts typeutil.Timestamp) error {
 	if coll.State != pb.CollectionState_CollectionCreating {
 		return fmt.Errorf("cannot create collection with state: %s, collection: %s", coll.State.String(), coll.Name)
@@ -202,13 +251,16 @@ func (kc *Catalog) CreatePartition(ctx context.Context, partition *model.Partiti
 }
 
 func (kc *Catalog) CreateAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error {
-	oldKBefore210 := BuildAliasKey210(alias.Name)
-	k := BuildAliasKey(alias.Name)
 	aliasInfo := model.MarshalAliasModel(alias)
 	v, err := proto.Marshal(aliasInfo)
 	if err != nil {
 		return err
 	}
+	if !isDefaultDB(alias.DBID) {
+		return kc.Snapshot.Save(BuildAliasKeyWithDB(alias.DBID, alias.Name), string(v), ts)
+	}
+	oldKBefore210 := BuildAliasKey210(alias.Name)
+	k := BuildAliasKey(alias.Name)
 	kvs := map[string]string{k: string(v)}
 	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(kvs, []string{oldKBefore210}, ts)
 }
@@ -374,12 +426,27 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 	oldCollClone.CreateTime = newColl.CreateTime
 	oldCollClone.ConsistencyLevel = newColl.ConsistencyLevel
 	oldCollClone.State = newColl.State
+	oldCollClone.Properties = newColl.Properties
+	oldCollClone.SchemaVersion = newColl.SchemaVersion
 	key := BuildCollectionKey(oldColl.CollectionID)
 	value, err := proto.Marshal(model.MarshalCollectionModel(oldCollClone))
 	if err != nil {
 		return err
 	}
-	return kc.Snapshot.Save(key, string(value), ts)
+	if len(newColl.Fields) == len(oldColl.Fields) {
+		return kc.Snapshot.Save(key, string(value), ts)
+	}
+
+	// fields were added, save all of them to the newly path along with the collection.
+	kvs := map[string]string{key: string(value)}
+	for _, field := range newColl.Fields {
+		v, err := proto.Marshal(model.MarshalFieldModel(field))
+		if err != nil {
+			return err
+		}
+		kvs[BuildFieldKey(newColl.CollectionID, field.FieldID)] = string(v)
+	}
+	return kc.Snapshot.MultiSave(kvs, ts)
 }
 
 func (kc *Catalog) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType metastore.AlterType, ts typeutil.Timestamp) error {
@@ -465,13 +532,16 @@ func (kc *Catalog) DropCredential(ctx context.Context, username string) error {
 	return nil
 }
 
-func (kc *Catalog) DropAlias(ctx context.Context, alias string, ts typeutil.Timestamp) error {
+func (kc *Catalog) DropAlias(ctx context.Context, dbID int64, alias string, ts typeutil.Timestamp) error {
+	if !isDefaultDB(dbID) {
+		return kc.Snapshot.MultiSaveAndRemoveWithPrefix(nil, []string{BuildAliasKeyWithDB(dbID, alias)}, ts)
+	}
 	oldKBefore210 := BuildAliasKey210(alias)
 	k := BuildAliasKey(alias)
 	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(nil, []string{k, oldKBefore210}, ts)
 }
 
-func (kc *Catalog) GetCollectionByName(ctx context.Context, collectionName string, ts typeutil.Timestamp) (*model.Collection, error) {
+func (kc *Catalog) GetCollectionByName(ctx context.Context, dbID int64, collectionName string, ts typeutil.Timestamp) (*model.Collection, error) {
 	_, vals, err := kc.Snapshot.LoadWithPrefix(CollectionMetaPrefix, ts)
 	if err != nil {
 		log.Warn("get collection meta fail", zap.String("collectionName", collectionName), zap.Error(err))
@@ -485,7 +555,7 @@ func (kc *Catalog) GetCollectionByName(ctx context.Context, collectionName strin
 			log.Warn("get collection meta unmarshal fail", zap.String("collectionName", collectionName), zap.Error(err))
 			continue
 		}
-		if colMeta.Schema.Name == collectionName {
+		if model.ResolveDBID(colMeta.GetDbId()) == model.ResolveDBID(dbID) && colMeta.Schema.Name == collectionName {
 			// compatibility handled by kc.GetCollectionByID.
 			return kc.GetCollectionByID(ctx, colMeta.GetID(), ts)
 		}
@@ -494,7 +564,7 @@ func (kc *Catalog) GetCollectionByName(ctx context.Context, collectionName strin
 	return nil, common.NewCollectionNotExistError(fmt.Sprintf("can't find collection: %s, at timestamp = %d", collectionName, ts))
 }
 
-func (kc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (map[string]*model.Collection, error) {
+func (kc *Catalog) ListCollections(ctx context.Context, dbID int64, ts typeutil.Timestamp) (map[string]*model.Collection, error) {
 	_, vals, err := kc.Snapshot.LoadWithPrefix(CollectionMetaPrefix, ts)
 	if err != nil {
 		log.Error("get collections meta fail",
@@ -512,6 +582,9 @@ func (kc *Catalog) ListCollections(ctx context.Context, ts typeutil.Timestamp) (
 			log.Warn("unmarshal collection info failed", zap.Error(err))
 			continue
 		}
+		if model.ResolveDBID(collMeta.GetDbId()) != model.ResolveDBID(dbID) {
+			continue
+		}
 		collection, err := kc.GetCollectionByID(ctx, collMeta.GetID(), ts)
 		if err != nil {
 			return nil, err
@@ -544,8 +617,8 @@ func (kc *Catalog) listAliasesBefore210(ctx context.Context, ts typeutil.Timesta
 	return aliases, nil
 }
 
-func (kc *Catalog) listAliasesAfter210(ctx context.Context, ts typeutil.Timestamp) ([]*model.Alias, error) {
-	_, values, err := kc.Snapshot.LoadWithPrefix(AliasMetaPrefix, ts)
+func (kc *Catalog) listAliasesAfter210(ctx context.Context, prefix string, ts typeutil.Timestamp) ([]*model.Alias, error) {
+	_, values, err := kc.Snapshot.LoadWithPrefix(prefix, ts)
 	if err != nil {
 		return nil, err
 	}
@@ -561,17 +634,21 @@ func (kc *Catalog) listAliasesAfter210(ctx context.Context, ts typeutil.Timestam
 			Name:         info.GetAliasName(),
 			CollectionID: info.GetCollectionId(),
 			CreatedTime:  info.GetCreatedTime(),
+			DBID:         info.GetDbId(),
 		})
 	}
 	return aliases, nil
 }
 
-func (kc *Catalog) ListAliases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Alias, error) {
+func (kc *Catalog) ListAliases(ctx context.Context, dbID int64, ts typeutil.Timestamp) ([]*model.Alias, error) {
+	if !isDefaultDB(dbID) {
+		return kc.listAliasesAfter210(ctx, BuildAliasPrefixWithDB(dbID)+"/", ts)
+	}
 	aliases1, err := kc.listAliasesBefore210(ctx, ts)
 	if err != nil {
 		return nil, err
 	}
-	aliases2, err := kc.listAliasesAfter210(ctx, ts)
+	aliases2, err := kc.listAliasesAfter210(ctx, AliasMetaPrefix, ts)
 	if err != nil {
 		return nil, err
 	}
@@ -599,6 +676,71 @@ func (kc *Catalog) ListCredentials(ctx context.Context) ([]string, error) {
 	return usernames, nil
 }
 
+func (kc *Catalog) CreateApiKey(ctx context.Context, apiKey *model.ApiKey) error {
+	k := fmt.Sprintf("%s/%s", ApiKeyPrefix, apiKey.KeyID)
+	v, err := json.Marshal(model.MarshalApiKeyModel(apiKey))
+	if err != nil {
+		log.Error("create api key marshal fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	err = kc.Txn.Save(k, string(v))
+	if err != nil {
+		log.Error("create api key persist meta fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (kc *Catalog) GetApiKey(ctx context.Context, keyID string) (*model.ApiKey, error) {
+	k := fmt.Sprintf("%s/%s", ApiKeyPrefix, keyID)
+	v, err := kc.Txn.Load(k)
+	if err != nil {
+		log.Warn("get api key meta fail", zap.String("key", k), zap.Error(err))
+		return nil, err
+	}
+
+	apiKeyInfo := &rootcoordpb.ApiKeyInfo{}
+	err = json.Unmarshal([]byte(v), apiKeyInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal api key info err:%w", err)
+	}
+
+	return model.UnmarshalApiKeyModel(apiKeyInfo), nil
+}
+
+func (kc *Catalog) DropApiKey(ctx context.Context, keyID string) error {
+	k := fmt.Sprintf("%s/%s", ApiKeyPrefix, keyID)
+	err := kc.Txn.Remove(k)
+	if err != nil {
+		log.Error("drop api key update meta fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (kc *Catalog) ListApiKeys(ctx context.Context) ([]*model.ApiKey, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(ApiKeyPrefix)
+	if err != nil {
+		log.Error("list all api keys fail", zap.String("prefix", ApiKeyPrefix), zap.Error(err))
+		return nil, err
+	}
+
+	apiKeys := make([]*model.ApiKey, 0, len(values))
+	for _, v := range values {
+		apiKeyInfo := &rootcoordpb.ApiKeyInfo{}
+		err = json.Unmarshal([]byte(v), apiKeyInfo)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal api key info err:%w", err)
+		}
+		apiKeys = append(apiKeys, model.UnmarshalApiKeyModel(apiKeyInfo))
+	}
+
+	return apiKeys, nil
+}
+
 func (kc *Catalog) save(k string) error {
 	var err error
 	if _, err = kc.Txn.Load(k); err != nil && !common.IsKeyNotExistError(err) {
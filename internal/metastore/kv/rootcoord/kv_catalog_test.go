@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -578,13 +579,13 @@ func TestCatalog_DropAliasV2(t *testing.T) {
 
 	kc := Catalog{Snapshot: snapshot}
 
-	err := kc.DropAlias(ctx, "alias", 0)
+	err := kc.DropAlias(ctx, util.DefaultDBID, "alias", 0)
 	assert.Error(t, err)
 
 	snapshot.MultiSaveAndRemoveWithPrefixFunc = func(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
 		return nil
 	}
-	err = kc.DropAlias(ctx, "alias", 0)
+	err = kc.DropAlias(ctx, util.DefaultDBID, "alias", 0)
 	assert.NoError(t, err)
 }
 
@@ -649,7 +650,7 @@ func TestCatalog_listAliasesAfter210(t *testing.T) {
 
 		kc := Catalog{Snapshot: snapshot}
 
-		_, err := kc.listAliasesAfter210(ctx, 0)
+		_, err := kc.listAliasesAfter210(ctx, AliasMetaPrefix, 0)
 		assert.Error(t, err)
 	})
 
@@ -663,7 +664,7 @@ func TestCatalog_listAliasesAfter210(t *testing.T) {
 
 		kc := Catalog{Snapshot: snapshot}
 
-		_, err := kc.listAliasesAfter210(ctx, 0)
+		_, err := kc.listAliasesAfter210(ctx, AliasMetaPrefix, 0)
 		assert.Error(t, err)
 	})
 
@@ -681,7 +682,7 @@ func TestCatalog_listAliasesAfter210(t *testing.T) {
 
 		kc := Catalog{Snapshot: snapshot}
 
-		got, err := kc.listAliasesAfter210(ctx, 0)
+		got, err := kc.listAliasesAfter210(ctx, AliasMetaPrefix, 0)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(got))
 		assert.Equal(t, int64(100), got[0].CollectionID)
@@ -699,7 +700,7 @@ func TestCatalog_ListAliasesV2(t *testing.T) {
 
 		kc := Catalog{Snapshot: snapshot}
 
-		_, err := kc.ListAliases(ctx, 0)
+		_, err := kc.ListAliases(ctx, util.DefaultDBID, 0)
 		assert.Error(t, err)
 	})
 
@@ -720,7 +721,7 @@ func TestCatalog_ListAliasesV2(t *testing.T) {
 
 		kc := Catalog{Snapshot: snapshot}
 
-		_, err = kc.ListAliases(ctx, 0)
+		_, err = kc.ListAliases(ctx, util.DefaultDBID, 0)
 		assert.Error(t, err)
 	})
 
@@ -745,7 +746,7 @@ func TestCatalog_ListAliasesV2(t *testing.T) {
 
 		kc := Catalog{Snapshot: snapshot}
 
-		got, err := kc.ListAliases(ctx, 0)
+		got, err := kc.ListAliases(ctx, util.DefaultDBID, 0)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(got))
 		assert.Equal(t, "alias1", got[0].Name)
@@ -753,6 +754,163 @@ func TestCatalog_ListAliasesV2(t *testing.T) {
 	})
 }
 
+func TestCatalog_ListAliasesWithDB(t *testing.T) {
+	ctx := context.Background()
+
+	alias := &pb.AliasInfo{CollectionId: 101, AliasName: "alias", DbId: 10}
+	value, err := proto.Marshal(alias)
+	assert.NoError(t, err)
+
+	snapshot := kv.NewMockSnapshotKV()
+	snapshot.LoadWithPrefixFunc = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+		if key == BuildAliasPrefixWithDB(10)+"/" {
+			return []string{"key"}, []string{string(value)}, nil
+		}
+		return nil, nil, errors.New("mock")
+	}
+
+	kc := Catalog{Snapshot: snapshot}
+
+	got, err := kc.ListAliases(ctx, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, "alias", got[0].Name)
+	assert.Equal(t, int64(10), got[0].DBID)
+}
+
+func TestCatalog_AliasWithDB(t *testing.T) {
+	ctx := context.Background()
+
+	var savedKey string
+	var removals []string
+	snapshot := kv.NewMockSnapshotKV()
+	snapshot.SaveFunc = func(key string, value string, ts typeutil.Timestamp) error {
+		savedKey = key
+		return nil
+	}
+	snapshot.MultiSaveAndRemoveWithPrefixFunc = func(saves map[string]string, keys []string, ts typeutil.Timestamp) error {
+		removals = keys
+		return nil
+	}
+
+	kc := Catalog{Snapshot: snapshot}
+
+	err := kc.CreateAlias(ctx, &model.Alias{Name: "alias", CollectionID: 100, DBID: 10}, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, BuildAliasKeyWithDB(10, "alias"), savedKey)
+
+	err = kc.DropAlias(ctx, 10, "alias", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{BuildAliasKeyWithDB(10, "alias")}, removals)
+}
+
+func TestCatalog_Database(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create and drop", func(t *testing.T) {
+		kvs := map[string]string{}
+		snapshot := kv.NewMockSnapshotKV()
+		snapshot.SaveFunc = func(key string, value string, ts typeutil.Timestamp) error {
+			kvs[key] = value
+			return nil
+		}
+		snapshot.MultiSaveAndRemoveWithPrefixFunc = func(saves map[string]string, removals []string, ts typeutil.Timestamp) error {
+			for _, key := range removals {
+				delete(kvs, key)
+			}
+			return nil
+		}
+		snapshot.LoadWithPrefixFunc = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+			keys, values := make([]string, 0), make([]string, 0)
+			for k, v := range kvs {
+				if strings.HasPrefix(k, key) {
+					keys = append(keys, k)
+					values = append(values, v)
+				}
+			}
+			return keys, values, nil
+		}
+
+		kc := Catalog{Snapshot: snapshot}
+
+		db := &model.Database{ID: 10, Name: "db", CreatedTime: 1000}
+		err := kc.CreateDatabase(ctx, db, 0)
+		assert.NoError(t, err)
+		dbs, err := kc.ListDatabases(ctx, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.Database{db}, dbs)
+
+		err = kc.DropDatabase(ctx, 10, 0)
+		assert.NoError(t, err)
+		dbs, err = kc.ListDatabases(ctx, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(dbs))
+	})
+
+	t.Run("list failed", func(t *testing.T) {
+		snapshot := kv.NewMockSnapshotKV()
+		snapshot.LoadWithPrefixFunc = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+			return nil, nil, errors.New("mock")
+		}
+		kc := Catalog{Snapshot: snapshot}
+		_, err := kc.ListDatabases(ctx, 0)
+		assert.Error(t, err)
+
+		snapshot.LoadWithPrefixFunc = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+			return []string{"key"}, []string{"not in pb format"}, nil
+		}
+		_, err = kc.ListDatabases(ctx, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestCatalog_ListCollectionsWithDB(t *testing.T) {
+	ctx := context.Background()
+
+	colls := map[string]*pb.CollectionInfo{
+		BuildCollectionKey(100): {ID: 100, Schema: &schemapb.CollectionSchema{Name: "coll"}},
+		BuildCollectionKey(101): {ID: 101, Schema: &schemapb.CollectionSchema{Name: "coll"}, DbId: 10},
+	}
+	snapshot := kv.NewMockSnapshotKV()
+	snapshot.LoadWithPrefixFunc = func(key string, ts typeutil.Timestamp) ([]string, []string, error) {
+		if key != CollectionMetaPrefix {
+			return []string{}, []string{}, nil
+		}
+		keys, values := make([]string, 0), make([]string, 0)
+		for k, coll := range colls {
+			v, err := proto.Marshal(coll)
+			assert.NoError(t, err)
+			keys = append(keys, k)
+			values = append(values, string(v))
+		}
+		return keys, values, nil
+	}
+	snapshot.LoadFunc = func(key string, ts typeutil.Timestamp) (string, error) {
+		v, err := proto.Marshal(colls[key])
+		return string(v), err
+	}
+
+	kc := Catalog{Snapshot: snapshot}
+
+	got, err := kc.ListCollections(ctx, util.DefaultDBID, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, int64(100), got["coll"].CollectionID)
+
+	got, err = kc.ListCollections(ctx, 10, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, int64(101), got["coll"].CollectionID)
+
+	coll, err := kc.GetCollectionByName(ctx, 10, "coll", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), coll.CollectionID)
+	assert.Equal(t, int64(10), coll.DBID)
+
+	_, err = kc.GetCollectionByName(ctx, 11, "coll", 0)
+	assert.Error(t, err)
+}
+
 func Test_batchMultiSaveAndRemoveWithPrefix(t *testing.T) {
 	t.Run("failed to save", func(t *testing.T) {
 		snapshot := kv.NewMockSnapshotKV()
@@ -830,6 +988,35 @@ func TestCatalog_AlterCollection(t *testing.T) {
 		assert.Equal(t, pb.CollectionState_CollectionCreated, got.State)
 	})
 
+	t.Run("modify, add field", func(t *testing.T) {
+		snapshot := kv.NewMockSnapshotKV()
+		kvs := map[string]string{}
+		snapshot.MultiSaveFunc = func(saves map[string]string, ts typeutil.Timestamp) error {
+			for k, v := range saves {
+				kvs[k] = v
+			}
+			return nil
+		}
+		kc := &Catalog{Snapshot: snapshot}
+		ctx := context.Background()
+		var collectionID int64 = 1
+		oldC := &model.Collection{CollectionID: collectionID, Fields: []*model.Field{{FieldID: 100, Name: "pk"}}}
+		newC := oldC.Clone()
+		newC.Fields = append(newC.Fields, &model.Field{FieldID: 101, Name: "price"})
+		newC.SchemaVersion = 1
+		err := kc.AlterCollection(ctx, oldC, newC, metastore.MODIFY, 0)
+		assert.NoError(t, err)
+
+		var collPb pb.CollectionInfo
+		err = proto.Unmarshal([]byte(kvs[BuildCollectionKey(collectionID)]), &collPb)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), collPb.GetSchemaVersion())
+		var fieldPb schemapb.FieldSchema
+		err = proto.Unmarshal([]byte(kvs[BuildFieldKey(collectionID, 101)]), &fieldPb)
+		assert.NoError(t, err)
+		assert.Equal(t, "price", fieldPb.GetName())
+	})
+
 	t.Run("modify, tenant id changed", func(t *testing.T) {
 		kc := &Catalog{}
 		ctx := context.Background()
@@ -1252,6 +1439,90 @@ func TestRBAC_Credential(t *testing.T) {
 	})
 }
 
+func TestRBAC_ApiKey(t *testing.T) {
+	ctx := context.TODO()
+
+	apiKeyValue := func(keyID string) string {
+		v, err := json.Marshal(model.MarshalApiKeyModel(&model.ApiKey{
+			KeyID:        keyID,
+			Username:     "user1",
+			HashedSecret: "hashed",
+			CreatedTime:  100,
+		}))
+		require.NoError(t, err)
+		return string(v)
+	}
+
+	t.Run("test CreateApiKey", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := &Catalog{Txn: kvmock}
+
+		kvmock.EXPECT().Save(fmt.Sprintf("%s/%s", ApiKeyPrefix, "invalid"), mock.Anything).Return(errors.New("Mock invalid save"))
+		kvmock.EXPECT().Save(fmt.Sprintf("%s/%s", ApiKeyPrefix, "key1"), apiKeyValue("key1")).Return(nil)
+
+		err := c.CreateApiKey(ctx, &model.ApiKey{KeyID: "key1", Username: "user1", HashedSecret: "hashed", CreatedTime: 100})
+		assert.NoError(t, err)
+		err = c.CreateApiKey(ctx, &model.ApiKey{KeyID: "invalid"})
+		assert.Error(t, err)
+	})
+
+	t.Run("test GetApiKey", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := &Catalog{Txn: kvmock}
+
+		kvmock.EXPECT().Load(fmt.Sprintf("%s/%s", ApiKeyPrefix, "key1")).Return(apiKeyValue("key1"), nil)
+		kvmock.EXPECT().Load(fmt.Sprintf("%s/%s", ApiKeyPrefix, "marshal")).Return("random", nil)
+		kvmock.EXPECT().Load(fmt.Sprintf("%s/%s", ApiKeyPrefix, "invalid")).Return("", errors.New("Mock invalid load"))
+
+		apiKey, err := c.GetApiKey(ctx, "key1")
+		assert.NoError(t, err)
+		assert.Equal(t, "key1", apiKey.KeyID)
+		assert.Equal(t, "user1", apiKey.Username)
+		assert.Equal(t, "hashed", apiKey.HashedSecret)
+		assert.Equal(t, uint64(100), apiKey.CreatedTime)
+
+		_, err = c.GetApiKey(ctx, "marshal")
+		assert.Error(t, err)
+		_, err = c.GetApiKey(ctx, "invalid")
+		assert.Error(t, err)
+	})
+
+	t.Run("test DropApiKey", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := &Catalog{Txn: kvmock}
+
+		kvmock.EXPECT().Remove(fmt.Sprintf("%s/%s", ApiKeyPrefix, "invalid")).Return(errors.New("Mock invalid remove"))
+		kvmock.EXPECT().Remove(fmt.Sprintf("%s/%s", ApiKeyPrefix, "key1")).Return(nil)
+
+		assert.NoError(t, c.DropApiKey(ctx, "key1"))
+		assert.Error(t, c.DropApiKey(ctx, "invalid"))
+	})
+
+	t.Run("test ListApiKeys", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := &Catalog{Txn: kvmock}
+
+		kvmock.EXPECT().LoadWithPrefix(ApiKeyPrefix).Return(
+			[]string{fmt.Sprintf("%s/%s", ApiKeyPrefix, "key1"), fmt.Sprintf("%s/%s", ApiKeyPrefix, "key2")},
+			[]string{apiKeyValue("key1"), apiKeyValue("key2")},
+			nil).Once()
+		apiKeys, err := c.ListApiKeys(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(apiKeys))
+		assert.Equal(t, "key1", apiKeys[0].KeyID)
+		assert.Equal(t, "key2", apiKeys[1].KeyID)
+
+		kvmock.EXPECT().LoadWithPrefix(ApiKeyPrefix).Return(
+			[]string{fmt.Sprintf("%s/%s", ApiKeyPrefix, "key1")}, []string{"random"}, nil).Once()
+		_, err = c.ListApiKeys(ctx)
+		assert.Error(t, err)
+
+		kvmock.EXPECT().LoadWithPrefix(ApiKeyPrefix).Return(nil, nil, errors.New("Mock load with prefix")).Once()
+		_, err = c.ListApiKeys(ctx)
+		assert.Error(t, err)
+	})
+}
+
 func TestRBAC_Role(t *testing.T) {
 	ctx := context.TODO()
 	tenant := "default"
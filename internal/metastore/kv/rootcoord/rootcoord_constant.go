@@ -11,6 +11,13 @@ const (
 	AliasMetaPrefix     = ComponentPrefix + "/aliases"
 	FieldMetaPrefix     = ComponentPrefix + "/fields"
 
+	// DatabaseMetaPrefix prefix for database related meta
+	DatabaseMetaPrefix = ComponentPrefix + "/database"
+	// DBInfoMetaPrefix prefix for database info
+	DBInfoMetaPrefix = DatabaseMetaPrefix + "/db-info"
+	// AliasMetaPrefixWithDB prefix for aliases of the databases except the default one
+	AliasMetaPrefixWithDB = DatabaseMetaPrefix + "/aliases"
+
 	// CollectionAliasMetaPrefix210 prefix for collection alias meta
 	CollectionAliasMetaPrefix210 = ComponentPrefix + "/collection-alias"
 
@@ -27,6 +34,9 @@ const (
 	// CredentialPrefix prefix for credential user
 	CredentialPrefix = ComponentPrefix + UserSubPrefix
 
+	// ApiKeyPrefix prefix for api key
+	ApiKeyPrefix = ComponentPrefix + CommonCredentialPrefix + "/api-keys"
+
 	// RolePrefix prefix for role
 	RolePrefix = ComponentPrefix + CommonCredentialPrefix + "/roles"
 
@@ -135,6 +135,20 @@ func (_m *RootCoordCatalog) CreateAlias(ctx context.Context, alias *model.Alias,
 	return r0
 }
 
+// CreateApiKey provides a mock function with given fields: ctx, apiKey
+func (_m *RootCoordCatalog) CreateApiKey(ctx context.Context, apiKey *model.ApiKey) error {
+	ret := _m.Called(ctx, apiKey)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ApiKey) error); ok {
+		r0 = rf(ctx, apiKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateCollection provides a mock function with given fields: ctx, collectionInfo, ts
 func (_m *RootCoordCatalog) CreateCollection(ctx context.Context, collectionInfo *model.Collection, ts uint64) error {
 	ret := _m.Called(ctx, collectionInfo, ts)
@@ -163,6 +177,20 @@ func (_m *RootCoordCatalog) CreateCredential(ctx context.Context, credential *mo
 	return r0
 }
 
+// CreateDatabase provides a mock function with given fields: ctx, db, ts
+func (_m *RootCoordCatalog) CreateDatabase(ctx context.Context, db *model.Database, ts uint64) error {
+	ret := _m.Called(ctx, db, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Database, uint64) error); ok {
+		r0 = rf(ctx, db, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreatePartition provides a mock function with given fields: ctx, partition, ts
 func (_m *RootCoordCatalog) CreatePartition(ctx context.Context, partition *model.Partition, ts uint64) error {
 	ret := _m.Called(ctx, partition, ts)
@@ -205,13 +233,27 @@ func (_m *RootCoordCatalog) DeleteGrant(ctx context.Context, tenant string, role
 	return r0
 }
 
-// DropAlias provides a mock function with given fields: ctx, alias, ts
-func (_m *RootCoordCatalog) DropAlias(ctx context.Context, alias string, ts uint64) error {
-	ret := _m.Called(ctx, alias, ts)
+// DropAlias provides a mock function with given fields: ctx, dbID, alias, ts
+func (_m *RootCoordCatalog) DropAlias(ctx context.Context, dbID int64, alias string, ts uint64) error {
+	ret := _m.Called(ctx, dbID, alias, ts)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) error); ok {
-		r0 = rf(ctx, alias, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, uint64) error); ok {
+		r0 = rf(ctx, dbID, alias, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DropApiKey provides a mock function with given fields: ctx, keyID
+func (_m *RootCoordCatalog) DropApiKey(ctx context.Context, keyID string) error {
+	ret := _m.Called(ctx, keyID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, keyID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -247,6 +289,20 @@ func (_m *RootCoordCatalog) DropCredential(ctx context.Context, username string)
 	return r0
 }
 
+// DropDatabase provides a mock function with given fields: ctx, dbID, ts
+func (_m *RootCoordCatalog) DropDatabase(ctx context.Context, dbID int64, ts uint64) error {
+	ret := _m.Called(ctx, dbID, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) error); ok {
+		r0 = rf(ctx, dbID, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DropPartition provides a mock function with given fields: ctx, collectionID, partitionID, ts
 func (_m *RootCoordCatalog) DropPartition(ctx context.Context, collectionID int64, partitionID int64, ts uint64) error {
 	ret := _m.Called(ctx, collectionID, partitionID, ts)
@@ -275,6 +331,29 @@ func (_m *RootCoordCatalog) DropRole(ctx context.Context, tenant string, roleNam
 	return r0
 }
 
+// GetApiKey provides a mock function with given fields: ctx, keyID
+func (_m *RootCoordCatalog) GetApiKey(ctx context.Context, keyID string) (*model.ApiKey, error) {
+	ret := _m.Called(ctx, keyID)
+
+	var r0 *model.ApiKey
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ApiKey); ok {
+		r0 = rf(ctx, keyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApiKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, keyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCollectionByID provides a mock function with given fields: ctx, collectionID, ts
 func (_m *RootCoordCatalog) GetCollectionByID(ctx context.Context, collectionID int64, ts uint64) (*model.Collection, error) {
 	ret := _m.Called(ctx, collectionID, ts)
@@ -298,13 +377,13 @@ func (_m *RootCoordCatalog) GetCollectionByID(ctx context.Context, collectionID
 	return r0, r1
 }
 
-// GetCollectionByName provides a mock function with given fields: ctx, collectionName, ts
-func (_m *RootCoordCatalog) GetCollectionByName(ctx context.Context, collectionName string, ts uint64) (*model.Collection, error) {
-	ret := _m.Called(ctx, collectionName, ts)
+// GetCollectionByName provides a mock function with given fields: ctx, dbID, collectionName, ts
+func (_m *RootCoordCatalog) GetCollectionByName(ctx context.Context, dbID int64, collectionName string, ts uint64) (*model.Collection, error) {
+	ret := _m.Called(ctx, dbID, collectionName, ts)
 
 	var r0 *model.Collection
-	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) *model.Collection); ok {
-		r0 = rf(ctx, collectionName, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, uint64) *model.Collection); ok {
+		r0 = rf(ctx, dbID, collectionName, ts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Collection)
@@ -312,8 +391,8 @@ func (_m *RootCoordCatalog) GetCollectionByName(ctx context.Context, collectionN
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, uint64) error); ok {
-		r1 = rf(ctx, collectionName, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string, uint64) error); ok {
+		r1 = rf(ctx, dbID, collectionName, ts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -344,13 +423,13 @@ func (_m *RootCoordCatalog) GetCredential(ctx context.Context, username string)
 	return r0, r1
 }
 
-// ListAliases provides a mock function with given fields: ctx, ts
-func (_m *RootCoordCatalog) ListAliases(ctx context.Context, ts uint64) ([]*model.Alias, error) {
-	ret := _m.Called(ctx, ts)
+// ListAliases provides a mock function with given fields: ctx, dbID, ts
+func (_m *RootCoordCatalog) ListAliases(ctx context.Context, dbID int64, ts uint64) ([]*model.Alias, error) {
+	ret := _m.Called(ctx, dbID, ts)
 
 	var r0 []*model.Alias
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*model.Alias); ok {
-		r0 = rf(ctx, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) []*model.Alias); ok {
+		r0 = rf(ctx, dbID, ts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Alias)
@@ -358,8 +437,8 @@ func (_m *RootCoordCatalog) ListAliases(ctx context.Context, ts uint64) ([]*mode
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
-		r1 = rf(ctx, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uint64) error); ok {
+		r1 = rf(ctx, dbID, ts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -367,13 +446,36 @@ func (_m *RootCoordCatalog) ListAliases(ctx context.Context, ts uint64) ([]*mode
 	return r0, r1
 }
 
-// ListCollections provides a mock function with given fields: ctx, ts
-func (_m *RootCoordCatalog) ListCollections(ctx context.Context, ts uint64) (map[string]*model.Collection, error) {
-	ret := _m.Called(ctx, ts)
+// ListApiKeys provides a mock function with given fields: ctx
+func (_m *RootCoordCatalog) ListApiKeys(ctx context.Context) ([]*model.ApiKey, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.ApiKey
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.ApiKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ApiKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCollections provides a mock function with given fields: ctx, dbID, ts
+func (_m *RootCoordCatalog) ListCollections(ctx context.Context, dbID int64, ts uint64) (map[string]*model.Collection, error) {
+	ret := _m.Called(ctx, dbID, ts)
 
 	var r0 map[string]*model.Collection
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) map[string]*model.Collection); ok {
-		r0 = rf(ctx, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, uint64) map[string]*model.Collection); ok {
+		r0 = rf(ctx, dbID, ts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string]*model.Collection)
@@ -381,8 +483,8 @@ func (_m *RootCoordCatalog) ListCollections(ctx context.Context, ts uint64) (map
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
-		r1 = rf(ctx, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, uint64) error); ok {
+		r1 = rf(ctx, dbID, ts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -413,6 +515,29 @@ func (_m *RootCoordCatalog) ListCredentials(ctx context.Context) ([]string, erro
 	return r0, r1
 }
 
+// ListDatabases provides a mock function with given fields: ctx, ts
+func (_m *RootCoordCatalog) ListDatabases(ctx context.Context, ts uint64) ([]*model.Database, error) {
+	ret := _m.Called(ctx, ts)
+
+	var r0 []*model.Database
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*model.Database); ok {
+		r0 = rf(ctx, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Database)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListGrant provides a mock function with given fields: ctx, tenant, entity
 func (_m *RootCoordCatalog) ListGrant(ctx context.Context, tenant string, entity *milvuspb.GrantEntity) ([]*milvuspb.GrantEntity, error) {
 	ret := _m.Called(ctx, tenant, entity)
@@ -0,0 +1,39 @@
+package model
+
+import "github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+
+// ApiKey is a long-lived key a user authenticates with, only the hash of the secret is persisted.
+type ApiKey struct {
+	TenantID     string
+	KeyID        string
+	Username     string
+	Description  string
+	HashedSecret string
+	CreatedTime  uint64
+}
+
+func MarshalApiKeyModel(apiKey *ApiKey) *rootcoordpb.ApiKeyInfo {
+	if apiKey == nil {
+		return nil
+	}
+	return &rootcoordpb.ApiKeyInfo{
+		KeyId:               apiKey.KeyID,
+		Username:            apiKey.Username,
+		Description:         apiKey.Description,
+		CreatedUtcTimestamp: apiKey.CreatedTime,
+		HashedSecret:        apiKey.HashedSecret,
+	}
+}
+
+func UnmarshalApiKeyModel(info *rootcoordpb.ApiKeyInfo) *ApiKey {
+	if info == nil {
+		return nil
+	}
+	return &ApiKey{
+		KeyID:        info.GetKeyId(),
+		Username:     info.GetUsername(),
+		Description:  info.GetDescription(),
+		HashedSecret: info.GetHashedSecret(),
+		CreatedTime:  info.GetCreatedUtcTimestamp(),
+	}
+}
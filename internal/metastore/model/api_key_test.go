@@ -0,0 +1,30 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApiKey_MarshalUnmarshal(t *testing.T) {
+	apiKey := &ApiKey{
+		KeyID:        "key",
+		Username:     "user",
+		Description:  "desc",
+		HashedSecret: "hash",
+		CreatedTime:  1000,
+	}
+	info := MarshalApiKeyModel(apiKey)
+	assert.Equal(t, &rootcoordpb.ApiKeyInfo{
+		KeyId:               "key",
+		Username:            "user",
+		Description:         "desc",
+		CreatedUtcTimestamp: 1000,
+		HashedSecret:        "hash",
+	}, info)
+	assert.Equal(t, apiKey, UnmarshalApiKeyModel(info))
+
+	assert.Nil(t, MarshalApiKeyModel(nil))
+	assert.Nil(t, UnmarshalApiKeyModel(nil))
+}
@@ -10,6 +10,7 @@ import (
 
 type Collection struct {
 	TenantID             string
+	DBID                 int64
 	CollectionID         int64
 	Partitions           []*Partition
 	Name                 string
@@ -25,6 +26,7 @@ type Collection struct {
 	Aliases              []string // TODO: deprecate this.
 	Properties           []*commonpb.KeyValuePair
 	State                pb.CollectionState
+	SchemaVersion        int32
 }
 
 func (c Collection) Available() bool {
@@ -34,6 +36,7 @@ func (c Collection) Available() bool {
 func (c Collection) Clone() *Collection {
 	return &Collection{
 		TenantID:             c.TenantID,
+		DBID:                 c.DBID,
 		CollectionID:         c.CollectionID,
 		Name:                 c.Name,
 		Description:          c.Description,
@@ -49,6 +52,7 @@ func (c Collection) Clone() *Collection {
 		Aliases:              common.CloneStringList(c.Aliases),
 		Properties:           common.CloneKeyValuePairs(c.Properties),
 		State:                c.State,
+		SchemaVersion:        c.SchemaVersion,
 	}
 }
 
@@ -61,13 +65,15 @@ func (c Collection) GetPartitionNum(filterUnavailable bool) int {
 
 func (c Collection) Equal(other Collection) bool {
 	return c.TenantID == other.TenantID &&
+		c.DBID == other.DBID &&
 		CheckPartitionsEqual(c.Partitions, other.Partitions) &&
 		c.Name == other.Name &&
 		c.Description == other.Description &&
 		c.AutoID == other.AutoID &&
 		CheckFieldsEqual(c.Fields, other.Fields) &&
 		c.ShardsNum == other.ShardsNum &&
-		c.ConsistencyLevel == other.ConsistencyLevel
+		c.ConsistencyLevel == other.ConsistencyLevel &&
+		c.SchemaVersion == other.SchemaVersion
 }
 
 func UnmarshalCollectionModel(coll *pb.CollectionInfo) *Collection {
@@ -87,6 +93,7 @@ func UnmarshalCollectionModel(coll *pb.CollectionInfo) *Collection {
 
 	return &Collection{
 		CollectionID:         coll.ID,
+		DBID:                 coll.DbId,
 		Name:                 coll.Schema.Name,
 		Description:          coll.Schema.Description,
 		AutoID:               coll.Schema.AutoID,
@@ -100,6 +107,7 @@ func UnmarshalCollectionModel(coll *pb.CollectionInfo) *Collection {
 		StartPositions:       coll.StartPositions,
 		State:                coll.State,
 		Properties:           coll.Properties,
+		SchemaVersion:        coll.SchemaVersion,
 	}
 }
 
@@ -150,6 +158,7 @@ func marshalCollectionModelWithConfig(coll *Collection, c *config) *pb.Collectio
 
 	collectionPb := &pb.CollectionInfo{
 		ID:                   coll.CollectionID,
+		DbId:                 coll.DBID,
 		Schema:               collSchema,
 		CreateTime:           coll.CreateTime,
 		VirtualChannelNames:  coll.VirtualChannelNames,
@@ -159,6 +168,7 @@ func marshalCollectionModelWithConfig(coll *Collection, c *config) *pb.Collectio
 		StartPositions:       coll.StartPositions,
 		State:                coll.State,
 		Properties:           coll.Properties,
+		SchemaVersion:        coll.SchemaVersion,
 	}
 
 	if c.withPartitions {
@@ -0,0 +1,70 @@
+package model
+
+import (
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+type Database struct {
+	TenantID    string
+	ID          int64
+	Name        string
+	CreatedTime uint64
+}
+
+// NewDefaultDatabase returns the database which the collections without database belong to.
+func NewDefaultDatabase() *Database {
+	return &Database{
+		TenantID: util.DefaultTenant,
+		ID:       util.DefaultDBID,
+		Name:     util.DefaultDBName,
+	}
+}
+
+func (d Database) Clone() *Database {
+	return &Database{
+		TenantID:    d.TenantID,
+		ID:          d.ID,
+		Name:        d.Name,
+		CreatedTime: d.CreatedTime,
+	}
+}
+
+func (d Database) Equal(other Database) bool {
+	return d.TenantID == other.TenantID &&
+		d.ID == other.ID &&
+		d.Name == other.Name
+}
+
+func MarshalDatabaseModel(db *Database) *pb.DatabaseInfo {
+	if db == nil {
+		return nil
+	}
+	return &pb.DatabaseInfo{
+		TenantId:    db.TenantID,
+		Name:        db.Name,
+		Id:          db.ID,
+		CreatedTime: db.CreatedTime,
+	}
+}
+
+func UnmarshalDatabaseModel(info *pb.DatabaseInfo) *Database {
+	if info == nil {
+		return nil
+	}
+	return &Database{
+		TenantID:    info.GetTenantId(),
+		Name:        info.GetName(),
+		ID:          info.GetId(),
+		CreatedTime: info.GetCreatedTime(),
+	}
+}
+
+// ResolveDBID returns the id of the database, the collections and aliases persisted before databases
+// were introduced have no database id, they belong to the default database.
+func ResolveDBID(dbID int64) int64 {
+	if dbID == 0 {
+		return util.DefaultDBID
+	}
+	return dbID
+}
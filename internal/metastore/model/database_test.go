@@ -0,0 +1,32 @@
+package model
+
+import (
+	"testing"
+
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatabase_MarshalUnmarshal(t *testing.T) {
+	db := &Database{
+		TenantID:    "tenant",
+		ID:          100,
+		Name:        "db",
+		CreatedTime: 1000,
+	}
+	info := MarshalDatabaseModel(db)
+	assert.Equal(t, &pb.DatabaseInfo{TenantId: "tenant", Name: "db", Id: 100, CreatedTime: 1000}, info)
+	assert.Equal(t, db, UnmarshalDatabaseModel(info))
+	assert.True(t, db.Equal(*db.Clone()))
+
+	assert.Nil(t, MarshalDatabaseModel(nil))
+	assert.Nil(t, UnmarshalDatabaseModel(nil))
+}
+
+func TestResolveDBID(t *testing.T) {
+	assert.Equal(t, util.DefaultDBID, ResolveDBID(0))
+	assert.Equal(t, int64(100), ResolveDBID(100))
+	assert.Equal(t, util.DefaultDBID, NewDefaultDatabase().ID)
+	assert.Equal(t, util.DefaultDBName, NewDefaultDatabase().Name)
+}
@@ -217,6 +217,53 @@ func (_c *DataCoord_CheckHealth_Call) Return(_a0 *milvuspb.CheckHealthResponse,
 	return _c
 }
 
+// CloneSegments provides a mock function with given fields: ctx, req
+func (_m *DataCoord) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CloneSegmentsRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CloneSegmentsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_CloneSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneSegments'
+type DataCoord_CloneSegments_Call struct {
+	*mock.Call
+}
+
+// CloneSegments is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.CloneSegmentsRequest
+func (_e *DataCoord_Expecter) CloneSegments(ctx interface{}, req interface{}) *DataCoord_CloneSegments_Call {
+	return &DataCoord_CloneSegments_Call{Call: _e.mock.On("CloneSegments", ctx, req)}
+}
+
+func (_c *DataCoord_CloneSegments_Call) Run(run func(ctx context.Context, req *datapb.CloneSegmentsRequest)) *DataCoord_CloneSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CloneSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_CloneSegments_Call) Return(_a0 *commonpb.Status, _a1 error) *DataCoord_CloneSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // DropVirtualChannel provides a mock function with given fields: ctx, req
 func (_m *DataCoord) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -266,6 +266,53 @@ func (_c *RootCoord_CheckHealth_Call) Return(_a0 *milvuspb.CheckHealthResponse,
 	return _c
 }
 
+// CloneCollection provides a mock function with given fields: ctx, req
+func (_m *RootCoord) CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CloneCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CloneCollectionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CloneCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCollection'
+type RootCoord_CloneCollection_Call struct {
+	*mock.Call
+}
+
+// CloneCollection is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.CloneCollectionRequest
+func (_e *RootCoord_Expecter) CloneCollection(ctx interface{}, req interface{}) *RootCoord_CloneCollection_Call {
+	return &RootCoord_CloneCollection_Call{Call: _e.mock.On("CloneCollection", ctx, req)}
+}
+
+func (_c *RootCoord_CloneCollection_Call) Run(run func(ctx context.Context, req *rootcoordpb.CloneCollectionRequest)) *RootCoord_CloneCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CloneCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CloneCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_CloneCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // CreateAlias provides a mock function with given fields: ctx, req
 func (_m *RootCoord) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -313,6 +360,100 @@ func (_c *RootCoord_CreateAlias_Call) Return(_a0 *commonpb.Status, _a1 error) *R
 	return _c
 }
 
+// CreateApiKey provides a mock function with given fields: ctx, req
+func (_m *RootCoord) CreateApiKey(ctx context.Context, req *rootcoordpb.CreateApiKeyRequest) (*rootcoordpb.CreateApiKeyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.CreateApiKeyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateApiKeyRequest) *rootcoordpb.CreateApiKeyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.CreateApiKeyResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CreateApiKeyRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CreateApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateApiKey'
+type RootCoord_CreateApiKey_Call struct {
+	*mock.Call
+}
+
+// CreateApiKey is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.CreateApiKeyRequest
+func (_e *RootCoord_Expecter) CreateApiKey(ctx interface{}, req interface{}) *RootCoord_CreateApiKey_Call {
+	return &RootCoord_CreateApiKey_Call{Call: _e.mock.On("CreateApiKey", ctx, req)}
+}
+
+func (_c *RootCoord_CreateApiKey_Call) Run(run func(ctx context.Context, req *rootcoordpb.CreateApiKeyRequest)) *RootCoord_CreateApiKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CreateApiKeyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CreateApiKey_Call) Return(_a0 *rootcoordpb.CreateApiKeyResponse, _a1 error) *RootCoord_CreateApiKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// CreateBackup provides a mock function with given fields: ctx, req
+func (_m *RootCoord) CreateBackup(ctx context.Context, req *rootcoordpb.CreateBackupRequest) (*rootcoordpb.CreateBackupResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.CreateBackupResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateBackupRequest) *rootcoordpb.CreateBackupResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.CreateBackupResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CreateBackupRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CreateBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBackup'
+type RootCoord_CreateBackup_Call struct {
+	*mock.Call
+}
+
+// CreateBackup is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.CreateBackupRequest
+func (_e *RootCoord_Expecter) CreateBackup(ctx interface{}, req interface{}) *RootCoord_CreateBackup_Call {
+	return &RootCoord_CreateBackup_Call{Call: _e.mock.On("CreateBackup", ctx, req)}
+}
+
+func (_c *RootCoord_CreateBackup_Call) Run(run func(ctx context.Context, req *rootcoordpb.CreateBackupRequest)) *RootCoord_CreateBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CreateBackupRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CreateBackup_Call) Return(_a0 *rootcoordpb.CreateBackupResponse, _a1 error) *RootCoord_CreateBackup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // CreateCollection provides a mock function with given fields: ctx, req
 func (_m *RootCoord) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -407,6 +548,53 @@ func (_c *RootCoord_CreateCredential_Call) Return(_a0 *commonpb.Status, _a1 erro
 	return _c
 }
 
+// CreateDatabase provides a mock function with given fields: ctx, req
+func (_m *RootCoord) CreateDatabase(ctx context.Context, req *rootcoordpb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.CreateDatabaseRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.CreateDatabaseRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CreateDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatabase'
+type RootCoord_CreateDatabase_Call struct {
+	*mock.Call
+}
+
+// CreateDatabase is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.CreateDatabaseRequest
+func (_e *RootCoord_Expecter) CreateDatabase(ctx interface{}, req interface{}) *RootCoord_CreateDatabase_Call {
+	return &RootCoord_CreateDatabase_Call{Call: _e.mock.On("CreateDatabase", ctx, req)}
+}
+
+func (_c *RootCoord_CreateDatabase_Call) Run(run func(ctx context.Context, req *rootcoordpb.CreateDatabaseRequest)) *RootCoord_CreateDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.CreateDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CreateDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_CreateDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // CreatePartition provides a mock function with given fields: ctx, req
 func (_m *RootCoord) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -501,6 +689,53 @@ func (_c *RootCoord_CreateRole_Call) Return(_a0 *commonpb.Status, _a1 error) *Ro
 	return _c
 }
 
+// DeleteBackup provides a mock function with given fields: ctx, req
+func (_m *RootCoord) DeleteBackup(ctx context.Context, req *rootcoordpb.DeleteBackupRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DeleteBackupRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.DeleteBackupRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DeleteBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBackup'
+type RootCoord_DeleteBackup_Call struct {
+	*mock.Call
+}
+
+// DeleteBackup is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.DeleteBackupRequest
+func (_e *RootCoord_Expecter) DeleteBackup(ctx interface{}, req interface{}) *RootCoord_DeleteBackup_Call {
+	return &RootCoord_DeleteBackup_Call{Call: _e.mock.On("DeleteBackup", ctx, req)}
+}
+
+func (_c *RootCoord_DeleteBackup_Call) Run(run func(ctx context.Context, req *rootcoordpb.DeleteBackupRequest)) *RootCoord_DeleteBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.DeleteBackupRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DeleteBackup_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_DeleteBackup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // DeleteCredential provides a mock function with given fields: ctx, req
 func (_m *RootCoord) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -689,6 +924,53 @@ func (_c *RootCoord_DropCollection_Call) Return(_a0 *commonpb.Status, _a1 error)
 	return _c
 }
 
+// DropDatabase provides a mock function with given fields: ctx, req
+func (_m *RootCoord) DropDatabase(ctx context.Context, req *rootcoordpb.DropDatabaseRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.DropDatabaseRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.DropDatabaseRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DropDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropDatabase'
+type RootCoord_DropDatabase_Call struct {
+	*mock.Call
+}
+
+// DropDatabase is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.DropDatabaseRequest
+func (_e *RootCoord_Expecter) DropDatabase(ctx interface{}, req interface{}) *RootCoord_DropDatabase_Call {
+	return &RootCoord_DropDatabase_Call{Call: _e.mock.On("DropDatabase", ctx, req)}
+}
+
+func (_c *RootCoord_DropDatabase_Call) Run(run func(ctx context.Context, req *rootcoordpb.DropDatabaseRequest)) *RootCoord_DropDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.DropDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DropDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_DropDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // DropPartition provides a mock function with given fields: ctx, req
 func (_m *RootCoord) DropPartition(ctx context.Context, req *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -783,6 +1065,100 @@ func (_c *RootCoord_DropRole_Call) Return(_a0 *commonpb.Status, _a1 error) *Root
 	return _c
 }
 
+// Export provides a mock function with given fields: ctx, req
+func (_m *RootCoord) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.ExportResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ExportRequest) *rootcoordpb.ExportResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ExportResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ExportRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type RootCoord_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.ExportRequest
+func (_e *RootCoord_Expecter) Export(ctx interface{}, req interface{}) *RootCoord_Export_Call {
+	return &RootCoord_Export_Call{Call: _e.mock.On("Export", ctx, req)}
+}
+
+func (_c *RootCoord_Export_Call) Run(run func(ctx context.Context, req *rootcoordpb.ExportRequest)) *RootCoord_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ExportRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_Export_Call) Return(_a0 *rootcoordpb.ExportResponse, _a1 error) *RootCoord_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetApiKey provides a mock function with given fields: ctx, req
+func (_m *RootCoord) GetApiKey(ctx context.Context, req *rootcoordpb.GetApiKeyRequest) (*rootcoordpb.GetApiKeyResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.GetApiKeyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetApiKeyRequest) *rootcoordpb.GetApiKeyResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetApiKeyResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetApiKeyRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_GetApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetApiKey'
+type RootCoord_GetApiKey_Call struct {
+	*mock.Call
+}
+
+// GetApiKey is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.GetApiKeyRequest
+func (_e *RootCoord_Expecter) GetApiKey(ctx interface{}, req interface{}) *RootCoord_GetApiKey_Call {
+	return &RootCoord_GetApiKey_Call{Call: _e.mock.On("GetApiKey", ctx, req)}
+}
+
+func (_c *RootCoord_GetApiKey_Call) Run(run func(ctx context.Context, req *rootcoordpb.GetApiKeyRequest)) *RootCoord_GetApiKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetApiKeyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_GetApiKey_Call) Return(_a0 *rootcoordpb.GetApiKeyResponse, _a1 error) *RootCoord_GetApiKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx
 func (_m *RootCoord) GetComponentStates(ctx context.Context) (*milvuspb.ComponentStates, error) {
 	ret := _m.Called(ctx)
@@ -876,6 +1252,53 @@ func (_c *RootCoord_GetCredential_Call) Return(_a0 *rootcoordpb.GetCredentialRes
 	return _c
 }
 
+// GetExportState provides a mock function with given fields: ctx, req
+func (_m *RootCoord) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.GetExportStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetExportStateRequest) *rootcoordpb.GetExportStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetExportStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetExportStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_GetExportState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportState'
+type RootCoord_GetExportState_Call struct {
+	*mock.Call
+}
+
+// GetExportState is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.GetExportStateRequest
+func (_e *RootCoord_Expecter) GetExportState(ctx interface{}, req interface{}) *RootCoord_GetExportState_Call {
+	return &RootCoord_GetExportState_Call{Call: _e.mock.On("GetExportState", ctx, req)}
+}
+
+func (_c *RootCoord_GetExportState_Call) Run(run func(ctx context.Context, req *rootcoordpb.GetExportStateRequest)) *RootCoord_GetExportState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetExportStateRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_GetExportState_Call) Return(_a0 *rootcoordpb.GetExportStateResponse, _a1 error) *RootCoord_GetExportState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetImportState provides a mock function with given fields: ctx, req
 func (_m *RootCoord) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1184,77 +1607,265 @@ type RootCoord_Import_Call struct {
 	*mock.Call
 }
 
-// Import is a helper method to define mock.On call
+// Import is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *milvuspb.ImportRequest
+func (_e *RootCoord_Expecter) Import(ctx interface{}, req interface{}) *RootCoord_Import_Call {
+	return &RootCoord_Import_Call{Call: _e.mock.On("Import", ctx, req)}
+}
+
+func (_c *RootCoord_Import_Call) Run(run func(ctx context.Context, req *milvuspb.ImportRequest)) *RootCoord_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.ImportRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_Import_Call) Return(_a0 *milvuspb.ImportResponse, _a1 error) *RootCoord_Import_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Init provides a mock function with given fields:
+func (_m *RootCoord) Init() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoord_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
+type RootCoord_Init_Call struct {
+	*mock.Call
+}
+
+// Init is a helper method to define mock.On call
+func (_e *RootCoord_Expecter) Init() *RootCoord_Init_Call {
+	return &RootCoord_Init_Call{Call: _e.mock.On("Init")}
+}
+
+func (_c *RootCoord_Init_Call) Run(run func()) *RootCoord_Init_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RootCoord_Init_Call) Return(_a0 error) *RootCoord_Init_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// InvalidateCollectionMetaCache provides a mock function with given fields: ctx, request
+func (_m *RootCoord) InvalidateCollectionMetaCache(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_InvalidateCollectionMetaCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateCollectionMetaCache'
+type RootCoord_InvalidateCollectionMetaCache_Call struct {
+	*mock.Call
+}
+
+// InvalidateCollectionMetaCache is a helper method to define mock.On call
+//  - ctx context.Context
+//  - request *proxypb.InvalidateCollMetaCacheRequest
+func (_e *RootCoord_Expecter) InvalidateCollectionMetaCache(ctx interface{}, request interface{}) *RootCoord_InvalidateCollectionMetaCache_Call {
+	return &RootCoord_InvalidateCollectionMetaCache_Call{Call: _e.mock.On("InvalidateCollectionMetaCache", ctx, request)}
+}
+
+func (_c *RootCoord_InvalidateCollectionMetaCache_Call) Run(run func(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest)) *RootCoord_InvalidateCollectionMetaCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.InvalidateCollMetaCacheRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_InvalidateCollectionMetaCache_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_InvalidateCollectionMetaCache_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ListApiKeys provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ListApiKeys(ctx context.Context, req *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.ListApiKeysResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListApiKeysRequest) *rootcoordpb.ListApiKeysResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListApiKeysResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListApiKeysRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListApiKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListApiKeys'
+type RootCoord_ListApiKeys_Call struct {
+	*mock.Call
+}
+
+// ListApiKeys is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.ListApiKeysRequest
+func (_e *RootCoord_Expecter) ListApiKeys(ctx interface{}, req interface{}) *RootCoord_ListApiKeys_Call {
+	return &RootCoord_ListApiKeys_Call{Call: _e.mock.On("ListApiKeys", ctx, req)}
+}
+
+func (_c *RootCoord_ListApiKeys_Call) Run(run func(ctx context.Context, req *rootcoordpb.ListApiKeysRequest)) *RootCoord_ListApiKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListApiKeysRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListApiKeys_Call) Return(_a0 *rootcoordpb.ListApiKeysResponse, _a1 error) *RootCoord_ListApiKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ListBackups provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ListBackups(ctx context.Context, req *rootcoordpb.ListBackupsRequest) (*rootcoordpb.ListBackupsResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.ListBackupsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListBackupsRequest) *rootcoordpb.ListBackupsResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.ListBackupsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListBackupsRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListBackups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBackups'
+type RootCoord_ListBackups_Call struct {
+	*mock.Call
+}
+
+// ListBackups is a helper method to define mock.On call
 //  - ctx context.Context
-//  - req *milvuspb.ImportRequest
-func (_e *RootCoord_Expecter) Import(ctx interface{}, req interface{}) *RootCoord_Import_Call {
-	return &RootCoord_Import_Call{Call: _e.mock.On("Import", ctx, req)}
+//  - req *rootcoordpb.ListBackupsRequest
+func (_e *RootCoord_Expecter) ListBackups(ctx interface{}, req interface{}) *RootCoord_ListBackups_Call {
+	return &RootCoord_ListBackups_Call{Call: _e.mock.On("ListBackups", ctx, req)}
 }
 
-func (_c *RootCoord_Import_Call) Run(run func(ctx context.Context, req *milvuspb.ImportRequest)) *RootCoord_Import_Call {
+func (_c *RootCoord_ListBackups_Call) Run(run func(ctx context.Context, req *rootcoordpb.ListBackupsRequest)) *RootCoord_ListBackups_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.ImportRequest))
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListBackupsRequest))
 	})
 	return _c
 }
 
-func (_c *RootCoord_Import_Call) Return(_a0 *milvuspb.ImportResponse, _a1 error) *RootCoord_Import_Call {
+func (_c *RootCoord_ListBackups_Call) Return(_a0 *rootcoordpb.ListBackupsResponse, _a1 error) *RootCoord_ListBackups_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// Init provides a mock function with given fields:
-func (_m *RootCoord) Init() error {
-	ret := _m.Called()
+// ListCredUsers provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	var r0 *milvuspb.ListCredUsersResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListCredUsersRequest) *milvuspb.ListCredUsersResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ListCredUsersResponse)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListCredUsersRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// RootCoord_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
-type RootCoord_Init_Call struct {
+// RootCoord_ListCredUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCredUsers'
+type RootCoord_ListCredUsers_Call struct {
 	*mock.Call
 }
 
-// Init is a helper method to define mock.On call
-func (_e *RootCoord_Expecter) Init() *RootCoord_Init_Call {
-	return &RootCoord_Init_Call{Call: _e.mock.On("Init")}
+// ListCredUsers is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *milvuspb.ListCredUsersRequest
+func (_e *RootCoord_Expecter) ListCredUsers(ctx interface{}, req interface{}) *RootCoord_ListCredUsers_Call {
+	return &RootCoord_ListCredUsers_Call{Call: _e.mock.On("ListCredUsers", ctx, req)}
 }
 
-func (_c *RootCoord_Init_Call) Run(run func()) *RootCoord_Init_Call {
+func (_c *RootCoord_ListCredUsers_Call) Run(run func(ctx context.Context, req *milvuspb.ListCredUsersRequest)) *RootCoord_ListCredUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(*milvuspb.ListCredUsersRequest))
 	})
 	return _c
 }
 
-func (_c *RootCoord_Init_Call) Return(_a0 error) *RootCoord_Init_Call {
-	_c.Call.Return(_a0)
+func (_c *RootCoord_ListCredUsers_Call) Return(_a0 *milvuspb.ListCredUsersResponse, _a1 error) *RootCoord_ListCredUsers_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// InvalidateCollectionMetaCache provides a mock function with given fields: ctx, request
-func (_m *RootCoord) InvalidateCollectionMetaCache(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
-	ret := _m.Called(ctx, request)
+// ListDatabases provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ListDatabases(ctx context.Context, req *rootcoordpb.ListDatabasesRequest) (*rootcoordpb.ListDatabasesResponse, error) {
+	ret := _m.Called(ctx, req)
 
-	var r0 *commonpb.Status
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest) *commonpb.Status); ok {
-		r0 = rf(ctx, request)
+	var r0 *rootcoordpb.ListDatabasesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListDatabasesRequest) *rootcoordpb.ListDatabasesResponse); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*rootcoordpb.ListDatabasesResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest) error); ok {
-		r1 = rf(ctx, request)
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListDatabasesRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1262,45 +1873,45 @@ func (_m *RootCoord) InvalidateCollectionMetaCache(ctx context.Context, request
 	return r0, r1
 }
 
-// RootCoord_InvalidateCollectionMetaCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateCollectionMetaCache'
-type RootCoord_InvalidateCollectionMetaCache_Call struct {
+// RootCoord_ListDatabases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDatabases'
+type RootCoord_ListDatabases_Call struct {
 	*mock.Call
 }
 
-// InvalidateCollectionMetaCache is a helper method to define mock.On call
+// ListDatabases is a helper method to define mock.On call
 //  - ctx context.Context
-//  - request *proxypb.InvalidateCollMetaCacheRequest
-func (_e *RootCoord_Expecter) InvalidateCollectionMetaCache(ctx interface{}, request interface{}) *RootCoord_InvalidateCollectionMetaCache_Call {
-	return &RootCoord_InvalidateCollectionMetaCache_Call{Call: _e.mock.On("InvalidateCollectionMetaCache", ctx, request)}
+//  - req *rootcoordpb.ListDatabasesRequest
+func (_e *RootCoord_Expecter) ListDatabases(ctx interface{}, req interface{}) *RootCoord_ListDatabases_Call {
+	return &RootCoord_ListDatabases_Call{Call: _e.mock.On("ListDatabases", ctx, req)}
 }
 
-func (_c *RootCoord_InvalidateCollectionMetaCache_Call) Run(run func(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest)) *RootCoord_InvalidateCollectionMetaCache_Call {
+func (_c *RootCoord_ListDatabases_Call) Run(run func(ctx context.Context, req *rootcoordpb.ListDatabasesRequest)) *RootCoord_ListDatabases_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*proxypb.InvalidateCollMetaCacheRequest))
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListDatabasesRequest))
 	})
 	return _c
 }
 
-func (_c *RootCoord_InvalidateCollectionMetaCache_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_InvalidateCollectionMetaCache_Call {
+func (_c *RootCoord_ListDatabases_Call) Return(_a0 *rootcoordpb.ListDatabasesResponse, _a1 error) *RootCoord_ListDatabases_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// ListCredUsers provides a mock function with given fields: ctx, req
-func (_m *RootCoord) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+// ListExportTasks provides a mock function with given fields: ctx, req
+func (_m *RootCoord) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
 	ret := _m.Called(ctx, req)
 
-	var r0 *milvuspb.ListCredUsersResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListCredUsersRequest) *milvuspb.ListCredUsersResponse); ok {
+	var r0 *rootcoordpb.ListExportTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ListExportTasksRequest) *rootcoordpb.ListExportTasksResponse); ok {
 		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ListCredUsersResponse)
+			r0 = ret.Get(0).(*rootcoordpb.ListExportTasksResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListCredUsersRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ListExportTasksRequest) error); ok {
 		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
@@ -1309,26 +1920,26 @@ func (_m *RootCoord) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUs
 	return r0, r1
 }
 
-// RootCoord_ListCredUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCredUsers'
-type RootCoord_ListCredUsers_Call struct {
+// RootCoord_ListExportTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExportTasks'
+type RootCoord_ListExportTasks_Call struct {
 	*mock.Call
 }
 
-// ListCredUsers is a helper method to define mock.On call
+// ListExportTasks is a helper method to define mock.On call
 //  - ctx context.Context
-//  - req *milvuspb.ListCredUsersRequest
-func (_e *RootCoord_Expecter) ListCredUsers(ctx interface{}, req interface{}) *RootCoord_ListCredUsers_Call {
-	return &RootCoord_ListCredUsers_Call{Call: _e.mock.On("ListCredUsers", ctx, req)}
+//  - req *rootcoordpb.ListExportTasksRequest
+func (_e *RootCoord_Expecter) ListExportTasks(ctx interface{}, req interface{}) *RootCoord_ListExportTasks_Call {
+	return &RootCoord_ListExportTasks_Call{Call: _e.mock.On("ListExportTasks", ctx, req)}
 }
 
-func (_c *RootCoord_ListCredUsers_Call) Run(run func(ctx context.Context, req *milvuspb.ListCredUsersRequest)) *RootCoord_ListCredUsers_Call {
+func (_c *RootCoord_ListExportTasks_Call) Run(run func(ctx context.Context, req *rootcoordpb.ListExportTasksRequest)) *RootCoord_ListExportTasks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.ListCredUsersRequest))
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ListExportTasksRequest))
 	})
 	return _c
 }
 
-func (_c *RootCoord_ListCredUsers_Call) Return(_a0 *milvuspb.ListCredUsersResponse, _a1 error) *RootCoord_ListCredUsers_Call {
+func (_c *RootCoord_ListExportTasks_Call) Return(_a0 *rootcoordpb.ListExportTasksResponse, _a1 error) *RootCoord_ListExportTasks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
@@ -1557,6 +2168,53 @@ func (_c *RootCoord_Register_Call) Return(_a0 error) *RootCoord_Register_Call {
 	return _c
 }
 
+// RenameCollection provides a mock function with given fields: ctx, req
+func (_m *RootCoord) RenameCollection(ctx context.Context, req *rootcoordpb.RenameCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RenameCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.RenameCollectionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_RenameCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameCollection'
+type RootCoord_RenameCollection_Call struct {
+	*mock.Call
+}
+
+// RenameCollection is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.RenameCollectionRequest
+func (_e *RootCoord_Expecter) RenameCollection(ctx interface{}, req interface{}) *RootCoord_RenameCollection_Call {
+	return &RootCoord_RenameCollection_Call{Call: _e.mock.On("RenameCollection", ctx, req)}
+}
+
+func (_c *RootCoord_RenameCollection_Call) Run(run func(ctx context.Context, req *rootcoordpb.RenameCollectionRequest)) *RootCoord_RenameCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.RenameCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_RenameCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_RenameCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // ReportImport provides a mock function with given fields: ctx, req
 func (_m *RootCoord) ReportImport(ctx context.Context, req *rootcoordpb.ImportResult) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -1604,6 +2262,100 @@ func (_c *RootCoord_ReportImport_Call) Return(_a0 *commonpb.Status, _a1 error) *
 	return _c
 }
 
+// RestoreBackup provides a mock function with given fields: ctx, req
+func (_m *RootCoord) RestoreBackup(ctx context.Context, req *rootcoordpb.RestoreBackupRequest) (*rootcoordpb.RestoreBackupResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *rootcoordpb.RestoreBackupResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RestoreBackupRequest) *rootcoordpb.RestoreBackupResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.RestoreBackupResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.RestoreBackupRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_RestoreBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreBackup'
+type RootCoord_RestoreBackup_Call struct {
+	*mock.Call
+}
+
+// RestoreBackup is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.RestoreBackupRequest
+func (_e *RootCoord_Expecter) RestoreBackup(ctx interface{}, req interface{}) *RootCoord_RestoreBackup_Call {
+	return &RootCoord_RestoreBackup_Call{Call: _e.mock.On("RestoreBackup", ctx, req)}
+}
+
+func (_c *RootCoord_RestoreBackup_Call) Run(run func(ctx context.Context, req *rootcoordpb.RestoreBackupRequest)) *RootCoord_RestoreBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.RestoreBackupRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_RestoreBackup_Call) Return(_a0 *rootcoordpb.RestoreBackupResponse, _a1 error) *RootCoord_RestoreBackup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RevokeApiKey provides a mock function with given fields: ctx, req
+func (_m *RootCoord) RevokeApiKey(ctx context.Context, req *rootcoordpb.RevokeApiKeyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.RevokeApiKeyRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.RevokeApiKeyRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_RevokeApiKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeApiKey'
+type RootCoord_RevokeApiKey_Call struct {
+	*mock.Call
+}
+
+// RevokeApiKey is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.RevokeApiKeyRequest
+func (_e *RootCoord_Expecter) RevokeApiKey(ctx interface{}, req interface{}) *RootCoord_RevokeApiKey_Call {
+	return &RootCoord_RevokeApiKey_Call{Call: _e.mock.On("RevokeApiKey", ctx, req)}
+}
+
+func (_c *RootCoord_RevokeApiKey_Call) Run(run func(ctx context.Context, req *rootcoordpb.RevokeApiKeyRequest)) *RootCoord_RevokeApiKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.RevokeApiKeyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_RevokeApiKey_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_RevokeApiKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: ctx, req
 func (_m *RootCoord) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -2005,6 +2757,53 @@ func (_c *RootCoord_Stop_Call) Return(_a0 error) *RootCoord_Stop_Call {
 	return _c
 }
 
+// TruncateCollection provides a mock function with given fields: ctx, req
+func (_m *RootCoord) TruncateCollection(ctx context.Context, req *rootcoordpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.TruncateCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.TruncateCollectionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_TruncateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TruncateCollection'
+type RootCoord_TruncateCollection_Call struct {
+	*mock.Call
+}
+
+// TruncateCollection is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.TruncateCollectionRequest
+func (_e *RootCoord_Expecter) TruncateCollection(ctx interface{}, req interface{}) *RootCoord_TruncateCollection_Call {
+	return &RootCoord_TruncateCollection_Call{Call: _e.mock.On("TruncateCollection", ctx, req)}
+}
+
+func (_c *RootCoord_TruncateCollection_Call) Run(run func(ctx context.Context, req *rootcoordpb.TruncateCollectionRequest)) *RootCoord_TruncateCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.TruncateCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_TruncateCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_TruncateCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // UpdateChannelTimeTick provides a mock function with given fields: ctx, req
 func (_m *RootCoord) UpdateChannelTimeTick(ctx context.Context, req *internalpb.ChannelTimeTickMsg) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -2099,6 +2898,53 @@ func (_c *RootCoord_UpdateCredential_Call) Return(_a0 *commonpb.Status, _a1 erro
 	return _c
 }
 
+// VerifyBackup provides a mock function with given fields: ctx, req
+func (_m *RootCoord) VerifyBackup(ctx context.Context, req *rootcoordpb.VerifyBackupRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.VerifyBackupRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.VerifyBackupRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_VerifyBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyBackup'
+type RootCoord_VerifyBackup_Call struct {
+	*mock.Call
+}
+
+// VerifyBackup is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *rootcoordpb.VerifyBackupRequest
+func (_e *RootCoord_Expecter) VerifyBackup(ctx interface{}, req interface{}) *RootCoord_VerifyBackup_Call {
+	return &RootCoord_VerifyBackup_Call{Call: _e.mock.On("VerifyBackup", ctx, req)}
+}
+
+func (_c *RootCoord_VerifyBackup_Call) Run(run func(ctx context.Context, req *rootcoordpb.VerifyBackupRequest)) *RootCoord_VerifyBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.VerifyBackupRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_VerifyBackup_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_VerifyBackup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 type mockConstructorTestingTNewRootCoord interface {
 	mock.TestingT
 	Cleanup(func())
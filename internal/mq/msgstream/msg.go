@@ -236,6 +236,7 @@ func (it *InsertMsg) rowBasedIndexRequest(index int) internalpb.InsertRequest {
 		RowIDs:         []int64{it.RowIDs[index]},
 		RowData:        []*commonpb.Blob{it.RowData[index]},
 		Version:        internalpb.InsertDataVersion_RowBased,
+		SchemaVersion:  it.SchemaVersion,
 	}
 }
 
@@ -262,6 +263,7 @@ func (it *InsertMsg) columnBasedIndexRequest(index int) internalpb.InsertRequest
 		FieldsData:     fieldsData,
 		NumRows:        1,
 		Version:        internalpb.InsertDataVersion_ColumnBased,
+		SchemaVersion:  it.SchemaVersion,
 	}
 }
 
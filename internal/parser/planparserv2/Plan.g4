@@ -6,7 +6,9 @@ expr:
 	| BooleanConstant										                # Boolean
 	| StringLiteral											                # String
 	| Identifier											                # Identifier
+	| JSONIdentifier										                # JSONIdentifier
 	| '(' expr ')'											                # Parens
+	| '[' expr (',' expr)* ','? ']'                                         # Array
 	| expr LIKE StringLiteral                                               # Like
 	| expr POW expr											                # Power
 	| op = (ADD | SUB | BNOT | NOT) expr					                # Unary
@@ -16,15 +18,21 @@ expr:
 	| expr op = (SHL | SHR) expr							                # Shift
 	| expr op = (IN | NIN) ('[' expr (',' expr)* ','? ']')                  # Term
 	| expr op = (IN | NIN) EmptyTerm                                        # EmptyTerm
-	| expr op1 = (LT | LE) Identifier op2 = (LT | LE) expr		            # Range
-	| expr op1 = (GT | GE) Identifier op2 = (GT | GE) expr                  # ReverseRange
+	| ArrayContains '(' expr ',' expr ')'                                   # ArrayContains
+	| ArrayContainsAll '(' expr ',' expr ')'                                # ArrayContainsAll
+	| ArrayContainsAny '(' expr ',' expr ')'                                # ArrayContainsAny
+	| ArrayLength '(' (Identifier | JSONIdentifier) ')'                     # ArrayLength
+	| expr op1 = (LT | LE) (Identifier | JSONIdentifier) op2 = (LT | LE) expr	# Range
+	| expr op1 = (GT | GE) (Identifier | JSONIdentifier) op2 = (GT | GE) expr	# ReverseRange
 	| expr op = (LT | LE | GT | GE) expr					                # Relational
 	| expr op = (EQ | NE) expr								                # Equality
 	| expr BAND expr										                # BitAnd
 	| expr BXOR expr										                # BitXor
 	| expr BOR expr											                # BitOr
 	| expr AND expr											                # LogicalAnd
-	| expr OR expr											                # LogicalOr;
+	| expr OR expr											                # LogicalOr
+	| (Identifier | JSONIdentifier) ISNULL                                  # IsNull
+	| (Identifier | JSONIdentifier) ISNOTNULL                               # IsNotNull;
 
 // typeName: ty = (BOOL | INT8 | INT16 | INT32 | INT64 | FLOAT | DOUBLE);
 
@@ -65,8 +73,15 @@ NOT: '!' | 'not';
 
 IN: 'in';
 NIN: 'not in';
+ISNULL: ('is' | 'IS') [ \t]+ ('null' | 'NULL');
+ISNOTNULL: ('is' | 'IS') [ \t]+ ('not' | 'NOT') [ \t]+ ('null' | 'NULL');
 EmptyTerm: '[' (Whitespace | Newline)* ']';
 
+ArrayContains: 'array_contains' | 'ARRAY_CONTAINS';
+ArrayContainsAll: 'array_contains_all' | 'ARRAY_CONTAINS_ALL';
+ArrayContainsAny: 'array_contains_any' | 'ARRAY_CONTAINS_ANY';
+ArrayLength: 'array_length' | 'ARRAY_LENGTH';
+
 BooleanConstant: 'true' | 'True' | 'TRUE' | 'false' | 'False' | 'FALSE';
 
 IntegerConstant:
@@ -80,6 +95,7 @@ FloatingConstant:
 	| HexadecimalFloatingConstant;
 
 Identifier: Nondigit (Nondigit | Digit)*;
+JSONIdentifier: Identifier ('[' (StringLiteral | DigitSequence) ']')+;
 
 StringLiteral: EncodingPrefix? '"' SCharSequence? '"';
 
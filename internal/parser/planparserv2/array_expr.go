@@ -0,0 +1,149 @@
+package planparserv2
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	parser "github.com/milvus-io/milvus/internal/parser/planparserv2/generated"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	arrayContains    = "array_contains"
+	arrayContainsAll = "array_contains_all"
+	arrayContainsAny = "array_contains_any"
+	arrayLength      = "array_length"
+)
+
+// translateArrayColumn translates the array field which the array function is applied on,
+// returns the column info and the element type of the array.
+func (v *ParserVisitor) translateArrayColumn(funcName string, ctx parser.IExprContext) (*planpb.ColumnInfo, schemapb.DataType, error) {
+	child := ctx.Accept(v)
+	if err := getError(child); err != nil {
+		return nil, schemapb.DataType_None, err
+	}
+	childExpr := getExpr(child)
+	if childExpr == nil || childExpr.expr.GetColumnExpr() == nil {
+		return nil, schemapb.DataType_None, fmt.Errorf("%s can only be used on single field, but got: %s", funcName, ctx.GetText())
+	}
+	columnInfo := toColumnInfo(childExpr)
+	if !typeutil.IsArrayType(columnInfo.GetDataType()) {
+		return nil, schemapb.DataType_None, fmt.Errorf("%s is only supported on array field, but %s is not", funcName, ctx.GetText())
+	}
+	field, err := v.schema.GetFieldFromID(columnInfo.GetFieldId())
+	if err != nil {
+		return nil, schemapb.DataType_None, err
+	}
+	elementType, err := typeutil.GetArrayElementType(field)
+	if err != nil {
+		return nil, schemapb.DataType_None, err
+	}
+	return columnInfo, elementType, nil
+}
+
+// translateArrayElement translates an element to be searched in the array, which should be a constant.
+func (v *ParserVisitor) translateArrayElement(funcName string, elementType schemapb.DataType, ctx parser.IExprContext) (*planpb.GenericValue, error) {
+	element := ctx.Accept(v)
+	if err := getError(element); err != nil {
+		return nil, err
+	}
+	value := getGenericValue(element)
+	if value == nil {
+		return nil, fmt.Errorf("element '%s' of %s cannot be a non-const expression", ctx.GetText(), funcName)
+	}
+	castedValue, err := castValue(elementType, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid element of %s: %s", funcName, err.Error())
+	}
+	return castedValue, nil
+}
+
+// translateArrayContains translates the array_contains functions to the expression evaluated by segcore.
+func (v *ParserVisitor) translateArrayContains(funcName string, op planpb.ArrayContainsExpr_ArrayOp, column, elements parser.IExprContext) interface{} {
+	columnInfo, elementType, err := v.translateArrayColumn(funcName, column)
+	if err != nil {
+		return err
+	}
+
+	elementCtxs := []parser.IExprContext{elements}
+	if op != planpb.ArrayContainsExpr_Contains {
+		list, ok := elements.(*parser.ArrayContext)
+		if !ok {
+			return fmt.Errorf("%s expects a list of elements, but got: %s", funcName, elements.GetText())
+		}
+		elementCtxs = list.AllExpr()
+	}
+
+	values := make([]*planpb.GenericValue, 0, len(elementCtxs))
+	for _, elementCtx := range elementCtxs {
+		value, err := v.translateArrayElement(funcName, elementType, elementCtx)
+		if err != nil {
+			return err
+		}
+		values = append(values, value)
+	}
+
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_ArrayContainsExpr{
+				ArrayContainsExpr: &planpb.ArrayContainsExpr{
+					ColumnInfo: columnInfo,
+					Elements:   values,
+					Op:         op,
+				},
+			},
+		},
+		dataType: schemapb.DataType_Bool,
+	}
+}
+
+// VisitArray rejects the lists which are not the argument of array functions.
+func (v *ParserVisitor) VisitArray(ctx *parser.ArrayContext) interface{} {
+	return fmt.Errorf("list can only be used as the argument of array functions, but got: %s", ctx.GetText())
+}
+
+// VisitArrayContains translates expr to array contains plan.
+func (v *ParserVisitor) VisitArrayContains(ctx *parser.ArrayContainsContext) interface{} {
+	return v.translateArrayContains(arrayContains, planpb.ArrayContainsExpr_Contains, ctx.Expr(0), ctx.Expr(1))
+}
+
+// VisitArrayContainsAll translates expr to array contains plan.
+func (v *ParserVisitor) VisitArrayContainsAll(ctx *parser.ArrayContainsAllContext) interface{} {
+	return v.translateArrayContains(arrayContainsAll, planpb.ArrayContainsExpr_ContainsAll, ctx.Expr(0), ctx.Expr(1))
+}
+
+// VisitArrayContainsAny translates expr to array contains plan.
+func (v *ParserVisitor) VisitArrayContainsAny(ctx *parser.ArrayContainsAnyContext) interface{} {
+	return v.translateArrayContains(arrayContainsAny, planpb.ArrayContainsExpr_ContainsAny, ctx.Expr(0), ctx.Expr(1))
+}
+
+// VisitArrayLength translates expr to arithmetic plan, array_length(tags) is compared like an arithmetic
+// expression, such as array_length(tags) > 1.
+func (v *ParserVisitor) VisitArrayLength(ctx *parser.ArrayLengthContext) interface{} {
+	identifier := ctx.Identifier()
+	if identifier == nil {
+		identifier = ctx.JSONIdentifier()
+	}
+	column, err := v.translateIdentifier(identifier.GetText())
+	if err != nil {
+		return err
+	}
+	columnInfo := toColumnInfo(column)
+	if !typeutil.IsArrayType(columnInfo.GetDataType()) {
+		return fmt.Errorf("%s is only supported on array field, but %s is not", arrayLength, identifier.GetText())
+	}
+
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_BinaryArithExpr{
+				BinaryArithExpr: &planpb.BinaryArithExpr{
+					Left:  column.expr,
+					Right: &planpb.Expr{Expr: &planpb.Expr_ValueExpr{ValueExpr: &planpb.ValueExpr{Value: NewInt(0)}}},
+					Op:    planpb.ArithOpType_ArrayLength,
+				},
+			},
+		},
+		dataType: schemapb.DataType_Int64,
+	}
+}
@@ -7,79 +7,111 @@ type BasePlanVisitor struct {
 	*antlr.BaseParseTreeVisitor
 }
 
-func (v *BasePlanVisitor) VisitShift(ctx *ShiftContext) interface{} {
+func (v *BasePlanVisitor) VisitJSONIdentifier(ctx *JSONIdentifierContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitReverseRange(ctx *ReverseRangeContext) interface{} {
+func (v *BasePlanVisitor) VisitParens(ctx *ParensContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitBitOr(ctx *BitOrContext) interface{} {
+func (v *BasePlanVisitor) VisitString(ctx *StringContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitAddSub(ctx *AddSubContext) interface{} {
+func (v *BasePlanVisitor) VisitFloating(ctx *FloatingContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitParens(ctx *ParensContext) interface{} {
+func (v *BasePlanVisitor) VisitLogicalOr(ctx *LogicalOrContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitRelational(ctx *RelationalContext) interface{} {
+func (v *BasePlanVisitor) VisitIsNotNull(ctx *IsNotNullContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitString(ctx *StringContext) interface{} {
+func (v *BasePlanVisitor) VisitMulDivMod(ctx *MulDivModContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitTerm(ctx *TermContext) interface{} {
+func (v *BasePlanVisitor) VisitIdentifier(ctx *IdentifierContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitFloating(ctx *FloatingContext) interface{} {
+func (v *BasePlanVisitor) VisitLike(ctx *LikeContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitRange(ctx *RangeContext) interface{} {
+func (v *BasePlanVisitor) VisitArrayContains(ctx *ArrayContainsContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitUnary(ctx *UnaryContext) interface{} {
+func (v *BasePlanVisitor) VisitLogicalAnd(ctx *LogicalAndContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitLogicalOr(ctx *LogicalOrContext) interface{} {
+func (v *BasePlanVisitor) VisitEquality(ctx *EqualityContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitInteger(ctx *IntegerContext) interface{} {
+func (v *BasePlanVisitor) VisitBoolean(ctx *BooleanContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitMulDivMod(ctx *MulDivModContext) interface{} {
+func (v *BasePlanVisitor) VisitShift(ctx *ShiftContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitIdentifier(ctx *IdentifierContext) interface{} {
+func (v *BasePlanVisitor) VisitReverseRange(ctx *ReverseRangeContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitBitXor(ctx *BitXorContext) interface{} {
+func (v *BasePlanVisitor) VisitBitOr(ctx *BitOrContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitLike(ctx *LikeContext) interface{} {
+func (v *BasePlanVisitor) VisitAddSub(ctx *AddSubContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitBitAnd(ctx *BitAndContext) interface{} {
+func (v *BasePlanVisitor) VisitArrayContainsAll(ctx *ArrayContainsAllContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitLogicalAnd(ctx *LogicalAndContext) interface{} {
+func (v *BasePlanVisitor) VisitRelational(ctx *RelationalContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePlanVisitor) VisitArrayLength(ctx *ArrayLengthContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePlanVisitor) VisitTerm(ctx *TermContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePlanVisitor) VisitRange(ctx *RangeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePlanVisitor) VisitUnary(ctx *UnaryContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePlanVisitor) VisitInteger(ctx *IntegerContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePlanVisitor) VisitArray(ctx *ArrayContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePlanVisitor) VisitBitXor(ctx *BitXorContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BasePlanVisitor) VisitBitAnd(ctx *BitAndContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
@@ -87,11 +119,11 @@ func (v *BasePlanVisitor) VisitEmptyTerm(ctx *EmptyTermContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitEquality(ctx *EqualityContext) interface{} {
+func (v *BasePlanVisitor) VisitArrayContainsAny(ctx *ArrayContainsAnyContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BasePlanVisitor) VisitBoolean(ctx *BooleanContext) interface{} {
+func (v *BasePlanVisitor) VisitIsNull(ctx *IsNullContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 39, 444,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 46, 657,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -26,199 +26,295 @@ var serializedLexerAtn = []uint16{
 	9, 44, 4, 45, 9, 45, 4, 46, 9, 46, 4, 47, 9, 47, 4, 48, 9, 48, 4, 49, 9,
 	49, 4, 50, 9, 50, 4, 51, 9, 51, 4, 52, 9, 52, 4, 53, 9, 53, 4, 54, 9, 54,
 	4, 55, 9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 4, 58, 9, 58, 4, 59, 9, 59, 4,
-	60, 9, 60, 4, 61, 9, 61, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5,
-	3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10, 3,
-	10, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13,
-	3, 13, 3, 13, 3, 13, 3, 13, 5, 13, 158, 10, 13, 3, 14, 3, 14, 3, 15, 3,
-	15, 3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 20,
-	3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 23, 3, 23, 3, 24, 3,
-	24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 5, 25, 190, 10, 25, 3, 26, 3, 26,
-	3, 26, 3, 26, 5, 26, 196, 10, 26, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3,
-	28, 5, 28, 204, 10, 28, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30,
-	3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 7, 31, 219, 10, 31, 12, 31, 14,
-	31, 222, 11, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32,
-	3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3,
-	32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32,
-	5, 32, 253, 10, 32, 3, 33, 3, 33, 3, 33, 3, 33, 5, 33, 259, 10, 33, 3,
-	34, 3, 34, 5, 34, 263, 10, 34, 3, 35, 3, 35, 3, 35, 7, 35, 268, 10, 35,
-	12, 35, 14, 35, 271, 11, 35, 3, 36, 5, 36, 274, 10, 36, 3, 36, 3, 36, 5,
-	36, 278, 10, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 5, 37, 285, 10, 37,
-	3, 38, 6, 38, 288, 10, 38, 13, 38, 14, 38, 289, 3, 39, 3, 39, 3, 39, 3,
-	39, 3, 39, 3, 39, 3, 39, 5, 39, 299, 10, 39, 3, 40, 3, 40, 3, 41, 3, 41,
-	3, 42, 3, 42, 3, 42, 6, 42, 308, 10, 42, 13, 42, 14, 42, 309, 3, 43, 3,
-	43, 7, 43, 314, 10, 43, 12, 43, 14, 43, 317, 11, 43, 3, 44, 3, 44, 7, 44,
-	321, 10, 44, 12, 44, 14, 44, 324, 11, 44, 3, 45, 3, 45, 3, 45, 3, 45, 3,
-	46, 3, 46, 3, 47, 3, 47, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49,
-	3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 5,
-	50, 351, 10, 50, 3, 51, 3, 51, 5, 51, 355, 10, 51, 3, 51, 3, 51, 3, 51,
-	5, 51, 360, 10, 51, 3, 52, 3, 52, 3, 52, 3, 52, 5, 52, 366, 10, 52, 3,
-	52, 3, 52, 3, 53, 5, 53, 371, 10, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53,
-	5, 53, 378, 10, 53, 3, 54, 3, 54, 5, 54, 382, 10, 54, 3, 54, 3, 54, 3,
-	55, 6, 55, 387, 10, 55, 13, 55, 14, 55, 388, 3, 56, 5, 56, 392, 10, 56,
-	3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 5, 56, 399, 10, 56, 3, 57, 6, 57, 402,
-	10, 57, 13, 57, 14, 57, 403, 3, 58, 3, 58, 5, 58, 408, 10, 58, 3, 58, 3,
-	58, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 5, 59, 417, 10, 59, 3, 59, 5, 59,
-	420, 10, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 5, 59, 427, 10, 59, 3,
-	60, 6, 60, 430, 10, 60, 13, 60, 14, 60, 431, 3, 60, 3, 60, 3, 61, 3, 61,
-	5, 61, 438, 10, 61, 3, 61, 5, 61, 441, 10, 61, 3, 61, 3, 61, 2, 2, 62,
-	3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23,
-	13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41,
-	22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59,
-	31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 2, 75, 2, 77, 2,
-	79, 2, 81, 2, 83, 2, 85, 2, 87, 2, 89, 2, 91, 2, 93, 2, 95, 2, 97, 2, 99,
-	2, 101, 2, 103, 2, 105, 2, 107, 2, 109, 2, 111, 2, 113, 2, 115, 2, 117,
-	2, 119, 38, 121, 39, 3, 2, 17, 5, 2, 78, 78, 87, 87, 119, 119, 6, 2, 12,
+	60, 9, 60, 4, 61, 9, 61, 4, 62, 9, 62, 4, 63, 9, 63, 4, 64, 9, 64, 4, 65,
+	9, 65, 4, 66, 9, 66, 4, 67, 9, 67, 4, 68, 9, 68, 3, 2, 3, 2, 3, 3, 3, 3,
+	3, 4, 3, 4, 3, 5, 3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 9,
+	3, 9, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3,
+	13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 5, 13, 172, 10, 13,
+	3, 14, 3, 14, 3, 15, 3, 15, 3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3,
+	19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22,
+	3, 23, 3, 23, 3, 24, 3, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 5, 25, 204,
+	10, 25, 3, 26, 3, 26, 3, 26, 3, 26, 5, 26, 210, 10, 26, 3, 27, 3, 27, 3,
+	28, 3, 28, 3, 28, 3, 28, 5, 28, 218, 10, 28, 3, 29, 3, 29, 3, 29, 3, 30,
+	3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 31, 5,
+	31, 234, 10, 31, 3, 31, 6, 31, 237, 10, 31, 13, 31, 14, 31, 238, 3, 31,
+	3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 5, 31, 249, 10, 31, 3,
+	32, 3, 32, 3, 32, 3, 32, 5, 32, 255, 10, 32, 3, 32, 6, 32, 258, 10, 32,
+	13, 32, 14, 32, 259, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 5, 32, 268,
+	10, 32, 3, 32, 6, 32, 271, 10, 32, 13, 32, 14, 32, 272, 3, 32, 3, 32, 3,
+	32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 5, 32, 283, 10, 32, 3, 33, 3, 33,
+	3, 33, 7, 33, 288, 10, 33, 12, 33, 14, 33, 291, 11, 33, 3, 33, 3, 33, 3,
+	34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34,
+	3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3,
+	34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 5, 34, 323, 10, 34, 3, 35,
+	3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3,
+	35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35,
+	3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3,
+	35, 3, 35, 3, 35, 3, 35, 5, 35, 361, 10, 35, 3, 36, 3, 36, 3, 36, 3, 36,
+	3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3,
+	36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36,
+	3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3,
+	36, 5, 36, 399, 10, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37,
+	3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3,
+	37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 5, 37, 425, 10, 37, 3, 38,
+	3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3,
+	38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38,
+	3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 5, 38, 454, 10, 38, 3, 39, 3, 39, 3,
+	39, 3, 39, 5, 39, 460, 10, 39, 3, 40, 3, 40, 5, 40, 464, 10, 40, 3, 41,
+	3, 41, 3, 41, 7, 41, 469, 10, 41, 12, 41, 14, 41, 472, 11, 41, 3, 42, 3,
+	42, 3, 42, 3, 42, 5, 42, 478, 10, 42, 3, 42, 3, 42, 6, 42, 482, 10, 42,
+	13, 42, 14, 42, 483, 3, 43, 5, 43, 487, 10, 43, 3, 43, 3, 43, 5, 43, 491,
+	10, 43, 3, 43, 3, 43, 3, 44, 3, 44, 3, 44, 5, 44, 498, 10, 44, 3, 45, 6,
+	45, 501, 10, 45, 13, 45, 14, 45, 502, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46,
+	3, 46, 3, 46, 5, 46, 512, 10, 46, 3, 47, 3, 47, 3, 48, 3, 48, 3, 49, 3,
+	49, 3, 49, 6, 49, 521, 10, 49, 13, 49, 14, 49, 522, 3, 50, 3, 50, 7, 50,
+	527, 10, 50, 12, 50, 14, 50, 530, 11, 50, 3, 51, 3, 51, 7, 51, 534, 10,
+	51, 12, 51, 14, 51, 537, 11, 51, 3, 52, 3, 52, 3, 52, 3, 52, 3, 53, 3,
+	53, 3, 54, 3, 54, 3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 57,
+	3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 5, 57, 564,
+	10, 57, 3, 58, 3, 58, 5, 58, 568, 10, 58, 3, 58, 3, 58, 3, 58, 5, 58, 573,
+	10, 58, 3, 59, 3, 59, 3, 59, 3, 59, 5, 59, 579, 10, 59, 3, 59, 3, 59, 3,
+	60, 5, 60, 584, 10, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 5, 60, 591,
+	10, 60, 3, 61, 3, 61, 5, 61, 595, 10, 61, 3, 61, 3, 61, 3, 62, 6, 62, 600,
+	10, 62, 13, 62, 14, 62, 601, 3, 63, 5, 63, 605, 10, 63, 3, 63, 3, 63, 3,
+	63, 3, 63, 3, 63, 5, 63, 612, 10, 63, 3, 64, 6, 64, 615, 10, 64, 13, 64,
+	14, 64, 616, 3, 65, 3, 65, 5, 65, 621, 10, 65, 3, 65, 3, 65, 3, 66, 3,
+	66, 3, 66, 3, 66, 3, 66, 5, 66, 630, 10, 66, 3, 66, 5, 66, 633, 10, 66,
+	3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 5, 66, 640, 10, 66, 3, 67, 6, 67, 643,
+	10, 67, 13, 67, 14, 67, 644, 3, 67, 3, 67, 3, 68, 3, 68, 5, 68, 651, 10,
+	68, 3, 68, 5, 68, 654, 10, 68, 3, 68, 3, 68, 2, 2, 69, 3, 3, 5, 4, 7, 5,
+	9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27,
+	15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45,
+	24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31, 61, 32, 63,
+	33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 38, 75, 39, 77, 40, 79, 41, 81,
+	42, 83, 43, 85, 44, 87, 2, 89, 2, 91, 2, 93, 2, 95, 2, 97, 2, 99, 2, 101,
+	2, 103, 2, 105, 2, 107, 2, 109, 2, 111, 2, 113, 2, 115, 2, 117, 2, 119,
+	2, 121, 2, 123, 2, 125, 2, 127, 2, 129, 2, 131, 2, 133, 45, 135, 46, 3,
+	2, 17, 4, 2, 11, 11, 34, 34, 5, 2, 78, 78, 87, 87, 119, 119, 6, 2, 12,
 	12, 15, 15, 36, 36, 94, 94, 5, 2, 67, 92, 97, 97, 99, 124, 3, 2, 50, 59,
 	4, 2, 68, 68, 100, 100, 3, 2, 50, 51, 4, 2, 90, 90, 122, 122, 3, 2, 51,
 	59, 3, 2, 50, 57, 5, 2, 50, 59, 67, 72, 99, 104, 4, 2, 71, 71, 103, 103,
 	4, 2, 45, 45, 47, 47, 4, 2, 82, 82, 114, 114, 12, 2, 36, 36, 41, 41, 65,
 	65, 94, 94, 99, 100, 104, 104, 112, 112, 116, 116, 118, 118, 120, 120,
-	4, 2, 11, 11, 34, 34, 2, 467, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7,
-	3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2,
-	15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2,
-	2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2,
-	2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2,
-	2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3,
-	2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53,
-	3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2,
-	61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2,
-	2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 119, 3, 2, 2, 2, 2, 121, 3, 2,
-	2, 2, 3, 123, 3, 2, 2, 2, 5, 125, 3, 2, 2, 2, 7, 127, 3, 2, 2, 2, 9, 129,
-	3, 2, 2, 2, 11, 131, 3, 2, 2, 2, 13, 133, 3, 2, 2, 2, 15, 135, 3, 2, 2,
-	2, 17, 138, 3, 2, 2, 2, 19, 140, 3, 2, 2, 2, 21, 143, 3, 2, 2, 2, 23, 146,
-	3, 2, 2, 2, 25, 157, 3, 2, 2, 2, 27, 159, 3, 2, 2, 2, 29, 161, 3, 2, 2,
-	2, 31, 163, 3, 2, 2, 2, 33, 165, 3, 2, 2, 2, 35, 167, 3, 2, 2, 2, 37, 169,
-	3, 2, 2, 2, 39, 172, 3, 2, 2, 2, 41, 175, 3, 2, 2, 2, 43, 178, 3, 2, 2,
-	2, 45, 180, 3, 2, 2, 2, 47, 182, 3, 2, 2, 2, 49, 189, 3, 2, 2, 2, 51, 195,
-	3, 2, 2, 2, 53, 197, 3, 2, 2, 2, 55, 203, 3, 2, 2, 2, 57, 205, 3, 2, 2,
-	2, 59, 208, 3, 2, 2, 2, 61, 215, 3, 2, 2, 2, 63, 252, 3, 2, 2, 2, 65, 258,
-	3, 2, 2, 2, 67, 262, 3, 2, 2, 2, 69, 264, 3, 2, 2, 2, 71, 273, 3, 2, 2,
-	2, 73, 284, 3, 2, 2, 2, 75, 287, 3, 2, 2, 2, 77, 298, 3, 2, 2, 2, 79, 300,
-	3, 2, 2, 2, 81, 302, 3, 2, 2, 2, 83, 304, 3, 2, 2, 2, 85, 311, 3, 2, 2,
-	2, 87, 318, 3, 2, 2, 2, 89, 325, 3, 2, 2, 2, 91, 329, 3, 2, 2, 2, 93, 331,
-	3, 2, 2, 2, 95, 333, 3, 2, 2, 2, 97, 335, 3, 2, 2, 2, 99, 350, 3, 2, 2,
-	2, 101, 359, 3, 2, 2, 2, 103, 361, 3, 2, 2, 2, 105, 377, 3, 2, 2, 2, 107,
-	379, 3, 2, 2, 2, 109, 386, 3, 2, 2, 2, 111, 398, 3, 2, 2, 2, 113, 401,
-	3, 2, 2, 2, 115, 405, 3, 2, 2, 2, 117, 426, 3, 2, 2, 2, 119, 429, 3, 2,
-	2, 2, 121, 440, 3, 2, 2, 2, 123, 124, 7, 42, 2, 2, 124, 4, 3, 2, 2, 2,
-	125, 126, 7, 43, 2, 2, 126, 6, 3, 2, 2, 2, 127, 128, 7, 93, 2, 2, 128,
-	8, 3, 2, 2, 2, 129, 130, 7, 46, 2, 2, 130, 10, 3, 2, 2, 2, 131, 132, 7,
-	95, 2, 2, 132, 12, 3, 2, 2, 2, 133, 134, 7, 62, 2, 2, 134, 14, 3, 2, 2,
-	2, 135, 136, 7, 62, 2, 2, 136, 137, 7, 63, 2, 2, 137, 16, 3, 2, 2, 2, 138,
-	139, 7, 64, 2, 2, 139, 18, 3, 2, 2, 2, 140, 141, 7, 64, 2, 2, 141, 142,
-	7, 63, 2, 2, 142, 20, 3, 2, 2, 2, 143, 144, 7, 63, 2, 2, 144, 145, 7, 63,
-	2, 2, 145, 22, 3, 2, 2, 2, 146, 147, 7, 35, 2, 2, 147, 148, 7, 63, 2, 2,
-	148, 24, 3, 2, 2, 2, 149, 150, 7, 110, 2, 2, 150, 151, 7, 107, 2, 2, 151,
-	152, 7, 109, 2, 2, 152, 158, 7, 103, 2, 2, 153, 154, 7, 78, 2, 2, 154,
-	155, 7, 75, 2, 2, 155, 156, 7, 77, 2, 2, 156, 158, 7, 71, 2, 2, 157, 149,
-	3, 2, 2, 2, 157, 153, 3, 2, 2, 2, 158, 26, 3, 2, 2, 2, 159, 160, 7, 45,
-	2, 2, 160, 28, 3, 2, 2, 2, 161, 162, 7, 47, 2, 2, 162, 30, 3, 2, 2, 2,
-	163, 164, 7, 44, 2, 2, 164, 32, 3, 2, 2, 2, 165, 166, 7, 49, 2, 2, 166,
-	34, 3, 2, 2, 2, 167, 168, 7, 39, 2, 2, 168, 36, 3, 2, 2, 2, 169, 170, 7,
-	44, 2, 2, 170, 171, 7, 44, 2, 2, 171, 38, 3, 2, 2, 2, 172, 173, 7, 62,
-	2, 2, 173, 174, 7, 62, 2, 2, 174, 40, 3, 2, 2, 2, 175, 176, 7, 64, 2, 2,
-	176, 177, 7, 64, 2, 2, 177, 42, 3, 2, 2, 2, 178, 179, 7, 40, 2, 2, 179,
-	44, 3, 2, 2, 2, 180, 181, 7, 126, 2, 2, 181, 46, 3, 2, 2, 2, 182, 183,
-	7, 96, 2, 2, 183, 48, 3, 2, 2, 2, 184, 185, 7, 40, 2, 2, 185, 190, 7, 40,
-	2, 2, 186, 187, 7, 99, 2, 2, 187, 188, 7, 112, 2, 2, 188, 190, 7, 102,
-	2, 2, 189, 184, 3, 2, 2, 2, 189, 186, 3, 2, 2, 2, 190, 50, 3, 2, 2, 2,
-	191, 192, 7, 126, 2, 2, 192, 196, 7, 126, 2, 2, 193, 194, 7, 113, 2, 2,
-	194, 196, 7, 116, 2, 2, 195, 191, 3, 2, 2, 2, 195, 193, 3, 2, 2, 2, 196,
-	52, 3, 2, 2, 2, 197, 198, 7, 128, 2, 2, 198, 54, 3, 2, 2, 2, 199, 204,
-	7, 35, 2, 2, 200, 201, 7, 112, 2, 2, 201, 202, 7, 113, 2, 2, 202, 204,
-	7, 118, 2, 2, 203, 199, 3, 2, 2, 2, 203, 200, 3, 2, 2, 2, 204, 56, 3, 2,
-	2, 2, 205, 206, 7, 107, 2, 2, 206, 207, 7, 112, 2, 2, 207, 58, 3, 2, 2,
-	2, 208, 209, 7, 112, 2, 2, 209, 210, 7, 113, 2, 2, 210, 211, 7, 118, 2,
-	2, 211, 212, 7, 34, 2, 2, 212, 213, 7, 107, 2, 2, 213, 214, 7, 112, 2,
-	2, 214, 60, 3, 2, 2, 2, 215, 220, 7, 93, 2, 2, 216, 219, 5, 119, 60, 2,
-	217, 219, 5, 121, 61, 2, 218, 216, 3, 2, 2, 2, 218, 217, 3, 2, 2, 2, 219,
-	222, 3, 2, 2, 2, 220, 218, 3, 2, 2, 2, 220, 221, 3, 2, 2, 2, 221, 223,
-	3, 2, 2, 2, 222, 220, 3, 2, 2, 2, 223, 224, 7, 95, 2, 2, 224, 62, 3, 2,
-	2, 2, 225, 226, 7, 118, 2, 2, 226, 227, 7, 116, 2, 2, 227, 228, 7, 119,
-	2, 2, 228, 253, 7, 103, 2, 2, 229, 230, 7, 86, 2, 2, 230, 231, 7, 116,
-	2, 2, 231, 232, 7, 119, 2, 2, 232, 253, 7, 103, 2, 2, 233, 234, 7, 86,
-	2, 2, 234, 235, 7, 84, 2, 2, 235, 236, 7, 87, 2, 2, 236, 253, 7, 71, 2,
-	2, 237, 238, 7, 104, 2, 2, 238, 239, 7, 99, 2, 2, 239, 240, 7, 110, 2,
-	2, 240, 241, 7, 117, 2, 2, 241, 253, 7, 103, 2, 2, 242, 243, 7, 72, 2,
-	2, 243, 244, 7, 99, 2, 2, 244, 245, 7, 110, 2, 2, 245, 246, 7, 117, 2,
-	2, 246, 253, 7, 103, 2, 2, 247, 248, 7, 72, 2, 2, 248, 249, 7, 67, 2, 2,
-	249, 250, 7, 78, 2, 2, 250, 251, 7, 85, 2, 2, 251, 253, 7, 71, 2, 2, 252,
-	225, 3, 2, 2, 2, 252, 229, 3, 2, 2, 2, 252, 233, 3, 2, 2, 2, 252, 237,
-	3, 2, 2, 2, 252, 242, 3, 2, 2, 2, 252, 247, 3, 2, 2, 2, 253, 64, 3, 2,
-	2, 2, 254, 259, 5, 85, 43, 2, 255, 259, 5, 87, 44, 2, 256, 259, 5, 89,
-	45, 2, 257, 259, 5, 83, 42, 2, 258, 254, 3, 2, 2, 2, 258, 255, 3, 2, 2,
-	2, 258, 256, 3, 2, 2, 2, 258, 257, 3, 2, 2, 2, 259, 66, 3, 2, 2, 2, 260,
-	263, 5, 101, 51, 2, 261, 263, 5, 103, 52, 2, 262, 260, 3, 2, 2, 2, 262,
-	261, 3, 2, 2, 2, 263, 68, 3, 2, 2, 2, 264, 269, 5, 79, 40, 2, 265, 268,
-	5, 79, 40, 2, 266, 268, 5, 81, 41, 2, 267, 265, 3, 2, 2, 2, 267, 266, 3,
-	2, 2, 2, 268, 271, 3, 2, 2, 2, 269, 267, 3, 2, 2, 2, 269, 270, 3, 2, 2,
-	2, 270, 70, 3, 2, 2, 2, 271, 269, 3, 2, 2, 2, 272, 274, 5, 73, 37, 2, 273,
-	272, 3, 2, 2, 2, 273, 274, 3, 2, 2, 2, 274, 275, 3, 2, 2, 2, 275, 277,
-	7, 36, 2, 2, 276, 278, 5, 75, 38, 2, 277, 276, 3, 2, 2, 2, 277, 278, 3,
-	2, 2, 2, 278, 279, 3, 2, 2, 2, 279, 280, 7, 36, 2, 2, 280, 72, 3, 2, 2,
-	2, 281, 282, 7, 119, 2, 2, 282, 285, 7, 58, 2, 2, 283, 285, 9, 2, 2, 2,
-	284, 281, 3, 2, 2, 2, 284, 283, 3, 2, 2, 2, 285, 74, 3, 2, 2, 2, 286, 288,
-	5, 77, 39, 2, 287, 286, 3, 2, 2, 2, 288, 289, 3, 2, 2, 2, 289, 287, 3,
-	2, 2, 2, 289, 290, 3, 2, 2, 2, 290, 76, 3, 2, 2, 2, 291, 299, 10, 3, 2,
-	2, 292, 299, 5, 117, 59, 2, 293, 294, 7, 94, 2, 2, 294, 299, 7, 12, 2,
-	2, 295, 296, 7, 94, 2, 2, 296, 297, 7, 15, 2, 2, 297, 299, 7, 12, 2, 2,
-	298, 291, 3, 2, 2, 2, 298, 292, 3, 2, 2, 2, 298, 293, 3, 2, 2, 2, 298,
-	295, 3, 2, 2, 2, 299, 78, 3, 2, 2, 2, 300, 301, 9, 4, 2, 2, 301, 80, 3,
-	2, 2, 2, 302, 303, 9, 5, 2, 2, 303, 82, 3, 2, 2, 2, 304, 305, 7, 50, 2,
-	2, 305, 307, 9, 6, 2, 2, 306, 308, 9, 7, 2, 2, 307, 306, 3, 2, 2, 2, 308,
-	309, 3, 2, 2, 2, 309, 307, 3, 2, 2, 2, 309, 310, 3, 2, 2, 2, 310, 84, 3,
-	2, 2, 2, 311, 315, 5, 91, 46, 2, 312, 314, 5, 81, 41, 2, 313, 312, 3, 2,
-	2, 2, 314, 317, 3, 2, 2, 2, 315, 313, 3, 2, 2, 2, 315, 316, 3, 2, 2, 2,
-	316, 86, 3, 2, 2, 2, 317, 315, 3, 2, 2, 2, 318, 322, 7, 50, 2, 2, 319,
-	321, 5, 93, 47, 2, 320, 319, 3, 2, 2, 2, 321, 324, 3, 2, 2, 2, 322, 320,
-	3, 2, 2, 2, 322, 323, 3, 2, 2, 2, 323, 88, 3, 2, 2, 2, 324, 322, 3, 2,
-	2, 2, 325, 326, 7, 50, 2, 2, 326, 327, 9, 8, 2, 2, 327, 328, 5, 113, 57,
-	2, 328, 90, 3, 2, 2, 2, 329, 330, 9, 9, 2, 2, 330, 92, 3, 2, 2, 2, 331,
-	332, 9, 10, 2, 2, 332, 94, 3, 2, 2, 2, 333, 334, 9, 11, 2, 2, 334, 96,
-	3, 2, 2, 2, 335, 336, 5, 95, 48, 2, 336, 337, 5, 95, 48, 2, 337, 338, 5,
-	95, 48, 2, 338, 339, 5, 95, 48, 2, 339, 98, 3, 2, 2, 2, 340, 341, 7, 94,
-	2, 2, 341, 342, 7, 119, 2, 2, 342, 343, 3, 2, 2, 2, 343, 351, 5, 97, 49,
-	2, 344, 345, 7, 94, 2, 2, 345, 346, 7, 87, 2, 2, 346, 347, 3, 2, 2, 2,
-	347, 348, 5, 97, 49, 2, 348, 349, 5, 97, 49, 2, 349, 351, 3, 2, 2, 2, 350,
-	340, 3, 2, 2, 2, 350, 344, 3, 2, 2, 2, 351, 100, 3, 2, 2, 2, 352, 354,
-	5, 105, 53, 2, 353, 355, 5, 107, 54, 2, 354, 353, 3, 2, 2, 2, 354, 355,
-	3, 2, 2, 2, 355, 360, 3, 2, 2, 2, 356, 357, 5, 109, 55, 2, 357, 358, 5,
-	107, 54, 2, 358, 360, 3, 2, 2, 2, 359, 352, 3, 2, 2, 2, 359, 356, 3, 2,
-	2, 2, 360, 102, 3, 2, 2, 2, 361, 362, 7, 50, 2, 2, 362, 365, 9, 8, 2, 2,
-	363, 366, 5, 111, 56, 2, 364, 366, 5, 113, 57, 2, 365, 363, 3, 2, 2, 2,
-	365, 364, 3, 2, 2, 2, 366, 367, 3, 2, 2, 2, 367, 368, 5, 115, 58, 2, 368,
-	104, 3, 2, 2, 2, 369, 371, 5, 109, 55, 2, 370, 369, 3, 2, 2, 2, 370, 371,
-	3, 2, 2, 2, 371, 372, 3, 2, 2, 2, 372, 373, 7, 48, 2, 2, 373, 378, 5, 109,
-	55, 2, 374, 375, 5, 109, 55, 2, 375, 376, 7, 48, 2, 2, 376, 378, 3, 2,
-	2, 2, 377, 370, 3, 2, 2, 2, 377, 374, 3, 2, 2, 2, 378, 106, 3, 2, 2, 2,
-	379, 381, 9, 12, 2, 2, 380, 382, 9, 13, 2, 2, 381, 380, 3, 2, 2, 2, 381,
-	382, 3, 2, 2, 2, 382, 383, 3, 2, 2, 2, 383, 384, 5, 109, 55, 2, 384, 108,
-	3, 2, 2, 2, 385, 387, 5, 81, 41, 2, 386, 385, 3, 2, 2, 2, 387, 388, 3,
-	2, 2, 2, 388, 386, 3, 2, 2, 2, 388, 389, 3, 2, 2, 2, 389, 110, 3, 2, 2,
-	2, 390, 392, 5, 113, 57, 2, 391, 390, 3, 2, 2, 2, 391, 392, 3, 2, 2, 2,
-	392, 393, 3, 2, 2, 2, 393, 394, 7, 48, 2, 2, 394, 399, 5, 113, 57, 2, 395,
-	396, 5, 113, 57, 2, 396, 397, 7, 48, 2, 2, 397, 399, 3, 2, 2, 2, 398, 391,
-	3, 2, 2, 2, 398, 395, 3, 2, 2, 2, 399, 112, 3, 2, 2, 2, 400, 402, 5, 95,
-	48, 2, 401, 400, 3, 2, 2, 2, 402, 403, 3, 2, 2, 2, 403, 401, 3, 2, 2, 2,
-	403, 404, 3, 2, 2, 2, 404, 114, 3, 2, 2, 2, 405, 407, 9, 14, 2, 2, 406,
-	408, 9, 13, 2, 2, 407, 406, 3, 2, 2, 2, 407, 408, 3, 2, 2, 2, 408, 409,
-	3, 2, 2, 2, 409, 410, 5, 109, 55, 2, 410, 116, 3, 2, 2, 2, 411, 412, 7,
-	94, 2, 2, 412, 427, 9, 15, 2, 2, 413, 414, 7, 94, 2, 2, 414, 416, 5, 93,
-	47, 2, 415, 417, 5, 93, 47, 2, 416, 415, 3, 2, 2, 2, 416, 417, 3, 2, 2,
-	2, 417, 419, 3, 2, 2, 2, 418, 420, 5, 93, 47, 2, 419, 418, 3, 2, 2, 2,
-	419, 420, 3, 2, 2, 2, 420, 427, 3, 2, 2, 2, 421, 422, 7, 94, 2, 2, 422,
-	423, 7, 122, 2, 2, 423, 424, 3, 2, 2, 2, 424, 427, 5, 113, 57, 2, 425,
-	427, 5, 99, 50, 2, 426, 411, 3, 2, 2, 2, 426, 413, 3, 2, 2, 2, 426, 421,
-	3, 2, 2, 2, 426, 425, 3, 2, 2, 2, 427, 118, 3, 2, 2, 2, 428, 430, 9, 16,
-	2, 2, 429, 428, 3, 2, 2, 2, 430, 431, 3, 2, 2, 2, 431, 429, 3, 2, 2, 2,
-	431, 432, 3, 2, 2, 2, 432, 433, 3, 2, 2, 2, 433, 434, 8, 60, 2, 2, 434,
-	120, 3, 2, 2, 2, 435, 437, 7, 15, 2, 2, 436, 438, 7, 12, 2, 2, 437, 436,
-	3, 2, 2, 2, 437, 438, 3, 2, 2, 2, 438, 441, 3, 2, 2, 2, 439, 441, 7, 12,
-	2, 2, 440, 435, 3, 2, 2, 2, 440, 439, 3, 2, 2, 2, 441, 442, 3, 2, 2, 2,
-	442, 443, 8, 61, 2, 2, 443, 122, 3, 2, 2, 2, 40, 2, 157, 189, 195, 203,
-	218, 220, 252, 258, 262, 267, 269, 273, 277, 284, 289, 298, 309, 315, 322,
-	350, 354, 359, 365, 370, 377, 381, 388, 391, 398, 403, 407, 416, 419, 426,
-	431, 437, 440, 3, 8, 2, 2,
+	2, 694, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3,
+	2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17,
+	3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2,
+	25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2,
+	2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2,
+	2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2,
+	2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3,
+	2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63,
+	3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2,
+	71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2,
+	2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2,
+	2, 2, 133, 3, 2, 2, 2, 2, 135, 3, 2, 2, 2, 3, 137, 3, 2, 2, 2, 5, 139,
+	3, 2, 2, 2, 7, 141, 3, 2, 2, 2, 9, 143, 3, 2, 2, 2, 11, 145, 3, 2, 2, 2,
+	13, 147, 3, 2, 2, 2, 15, 149, 3, 2, 2, 2, 17, 152, 3, 2, 2, 2, 19, 154,
+	3, 2, 2, 2, 21, 157, 3, 2, 2, 2, 23, 160, 3, 2, 2, 2, 25, 171, 3, 2, 2,
+	2, 27, 173, 3, 2, 2, 2, 29, 175, 3, 2, 2, 2, 31, 177, 3, 2, 2, 2, 33, 179,
+	3, 2, 2, 2, 35, 181, 3, 2, 2, 2, 37, 183, 3, 2, 2, 2, 39, 186, 3, 2, 2,
+	2, 41, 189, 3, 2, 2, 2, 43, 192, 3, 2, 2, 2, 45, 194, 3, 2, 2, 2, 47, 196,
+	3, 2, 2, 2, 49, 203, 3, 2, 2, 2, 51, 209, 3, 2, 2, 2, 53, 211, 3, 2, 2,
+	2, 55, 217, 3, 2, 2, 2, 57, 219, 3, 2, 2, 2, 59, 222, 3, 2, 2, 2, 61, 233,
+	3, 2, 2, 2, 63, 254, 3, 2, 2, 2, 65, 284, 3, 2, 2, 2, 67, 322, 3, 2, 2,
+	2, 69, 360, 3, 2, 2, 2, 71, 398, 3, 2, 2, 2, 73, 424, 3, 2, 2, 2, 75, 453,
+	3, 2, 2, 2, 77, 459, 3, 2, 2, 2, 79, 463, 3, 2, 2, 2, 81, 465, 3, 2, 2,
+	2, 83, 473, 3, 2, 2, 2, 85, 486, 3, 2, 2, 2, 87, 497, 3, 2, 2, 2, 89, 500,
+	3, 2, 2, 2, 91, 511, 3, 2, 2, 2, 93, 513, 3, 2, 2, 2, 95, 515, 3, 2, 2,
+	2, 97, 517, 3, 2, 2, 2, 99, 524, 3, 2, 2, 2, 101, 531, 3, 2, 2, 2, 103,
+	538, 3, 2, 2, 2, 105, 542, 3, 2, 2, 2, 107, 544, 3, 2, 2, 2, 109, 546,
+	3, 2, 2, 2, 111, 548, 3, 2, 2, 2, 113, 563, 3, 2, 2, 2, 115, 572, 3, 2,
+	2, 2, 117, 574, 3, 2, 2, 2, 119, 590, 3, 2, 2, 2, 121, 592, 3, 2, 2, 2,
+	123, 599, 3, 2, 2, 2, 125, 611, 3, 2, 2, 2, 127, 614, 3, 2, 2, 2, 129,
+	618, 3, 2, 2, 2, 131, 639, 3, 2, 2, 2, 133, 642, 3, 2, 2, 2, 135, 653,
+	3, 2, 2, 2, 137, 138, 7, 42, 2, 2, 138, 4, 3, 2, 2, 2, 139, 140, 7, 43,
+	2, 2, 140, 6, 3, 2, 2, 2, 141, 142, 7, 93, 2, 2, 142, 8, 3, 2, 2, 2, 143,
+	144, 7, 46, 2, 2, 144, 10, 3, 2, 2, 2, 145, 146, 7, 95, 2, 2, 146, 12,
+	3, 2, 2, 2, 147, 148, 7, 62, 2, 2, 148, 14, 3, 2, 2, 2, 149, 150, 7, 62,
+	2, 2, 150, 151, 7, 63, 2, 2, 151, 16, 3, 2, 2, 2, 152, 153, 7, 64, 2, 2,
+	153, 18, 3, 2, 2, 2, 154, 155, 7, 64, 2, 2, 155, 156, 7, 63, 2, 2, 156,
+	20, 3, 2, 2, 2, 157, 158, 7, 63, 2, 2, 158, 159, 7, 63, 2, 2, 159, 22,
+	3, 2, 2, 2, 160, 161, 7, 35, 2, 2, 161, 162, 7, 63, 2, 2, 162, 24, 3, 2,
+	2, 2, 163, 164, 7, 110, 2, 2, 164, 165, 7, 107, 2, 2, 165, 166, 7, 109,
+	2, 2, 166, 172, 7, 103, 2, 2, 167, 168, 7, 78, 2, 2, 168, 169, 7, 75, 2,
+	2, 169, 170, 7, 77, 2, 2, 170, 172, 7, 71, 2, 2, 171, 163, 3, 2, 2, 2,
+	171, 167, 3, 2, 2, 2, 172, 26, 3, 2, 2, 2, 173, 174, 7, 45, 2, 2, 174,
+	28, 3, 2, 2, 2, 175, 176, 7, 47, 2, 2, 176, 30, 3, 2, 2, 2, 177, 178, 7,
+	44, 2, 2, 178, 32, 3, 2, 2, 2, 179, 180, 7, 49, 2, 2, 180, 34, 3, 2, 2,
+	2, 181, 182, 7, 39, 2, 2, 182, 36, 3, 2, 2, 2, 183, 184, 7, 44, 2, 2, 184,
+	185, 7, 44, 2, 2, 185, 38, 3, 2, 2, 2, 186, 187, 7, 62, 2, 2, 187, 188,
+	7, 62, 2, 2, 188, 40, 3, 2, 2, 2, 189, 190, 7, 64, 2, 2, 190, 191, 7, 64,
+	2, 2, 191, 42, 3, 2, 2, 2, 192, 193, 7, 40, 2, 2, 193, 44, 3, 2, 2, 2,
+	194, 195, 7, 126, 2, 2, 195, 46, 3, 2, 2, 2, 196, 197, 7, 96, 2, 2, 197,
+	48, 3, 2, 2, 2, 198, 199, 7, 40, 2, 2, 199, 204, 7, 40, 2, 2, 200, 201,
+	7, 99, 2, 2, 201, 202, 7, 112, 2, 2, 202, 204, 7, 102, 2, 2, 203, 198,
+	3, 2, 2, 2, 203, 200, 3, 2, 2, 2, 204, 50, 3, 2, 2, 2, 205, 206, 7, 126,
+	2, 2, 206, 210, 7, 126, 2, 2, 207, 208, 7, 113, 2, 2, 208, 210, 7, 116,
+	2, 2, 209, 205, 3, 2, 2, 2, 209, 207, 3, 2, 2, 2, 210, 52, 3, 2, 2, 2,
+	211, 212, 7, 128, 2, 2, 212, 54, 3, 2, 2, 2, 213, 218, 7, 35, 2, 2, 214,
+	215, 7, 112, 2, 2, 215, 216, 7, 113, 2, 2, 216, 218, 7, 118, 2, 2, 217,
+	213, 3, 2, 2, 2, 217, 214, 3, 2, 2, 2, 218, 56, 3, 2, 2, 2, 219, 220, 7,
+	107, 2, 2, 220, 221, 7, 112, 2, 2, 221, 58, 3, 2, 2, 2, 222, 223, 7, 112,
+	2, 2, 223, 224, 7, 113, 2, 2, 224, 225, 7, 118, 2, 2, 225, 226, 7, 34,
+	2, 2, 226, 227, 7, 107, 2, 2, 227, 228, 7, 112, 2, 2, 228, 60, 3, 2, 2,
+	2, 229, 230, 7, 107, 2, 2, 230, 234, 7, 117, 2, 2, 231, 232, 7, 75, 2,
+	2, 232, 234, 7, 85, 2, 2, 233, 229, 3, 2, 2, 2, 233, 231, 3, 2, 2, 2, 234,
+	236, 3, 2, 2, 2, 235, 237, 9, 2, 2, 2, 236, 235, 3, 2, 2, 2, 237, 238,
+	3, 2, 2, 2, 238, 236, 3, 2, 2, 2, 238, 239, 3, 2, 2, 2, 239, 248, 3, 2,
+	2, 2, 240, 241, 7, 112, 2, 2, 241, 242, 7, 119, 2, 2, 242, 243, 7, 110,
+	2, 2, 243, 249, 7, 110, 2, 2, 244, 245, 7, 80, 2, 2, 245, 246, 7, 87, 2,
+	2, 246, 247, 7, 78, 2, 2, 247, 249, 7, 78, 2, 2, 248, 240, 3, 2, 2, 2,
+	248, 244, 3, 2, 2, 2, 249, 62, 3, 2, 2, 2, 250, 251, 7, 107, 2, 2, 251,
+	255, 7, 117, 2, 2, 252, 253, 7, 75, 2, 2, 253, 255, 7, 85, 2, 2, 254, 250,
+	3, 2, 2, 2, 254, 252, 3, 2, 2, 2, 255, 257, 3, 2, 2, 2, 256, 258, 9, 2,
+	2, 2, 257, 256, 3, 2, 2, 2, 258, 259, 3, 2, 2, 2, 259, 257, 3, 2, 2, 2,
+	259, 260, 3, 2, 2, 2, 260, 267, 3, 2, 2, 2, 261, 262, 7, 112, 2, 2, 262,
+	263, 7, 113, 2, 2, 263, 268, 7, 118, 2, 2, 264, 265, 7, 80, 2, 2, 265,
+	266, 7, 81, 2, 2, 266, 268, 7, 86, 2, 2, 267, 261, 3, 2, 2, 2, 267, 264,
+	3, 2, 2, 2, 268, 270, 3, 2, 2, 2, 269, 271, 9, 2, 2, 2, 270, 269, 3, 2,
+	2, 2, 271, 272, 3, 2, 2, 2, 272, 270, 3, 2, 2, 2, 272, 273, 3, 2, 2, 2,
+	273, 282, 3, 2, 2, 2, 274, 275, 7, 112, 2, 2, 275, 276, 7, 119, 2, 2, 276,
+	277, 7, 110, 2, 2, 277, 283, 7, 110, 2, 2, 278, 279, 7, 80, 2, 2, 279,
+	280, 7, 87, 2, 2, 280, 281, 7, 78, 2, 2, 281, 283, 7, 78, 2, 2, 282, 274,
+	3, 2, 2, 2, 282, 278, 3, 2, 2, 2, 283, 64, 3, 2, 2, 2, 284, 289, 7, 93,
+	2, 2, 285, 288, 5, 133, 67, 2, 286, 288, 5, 135, 68, 2, 287, 285, 3, 2,
+	2, 2, 287, 286, 3, 2, 2, 2, 288, 291, 3, 2, 2, 2, 289, 287, 3, 2, 2, 2,
+	289, 290, 3, 2, 2, 2, 290, 292, 3, 2, 2, 2, 291, 289, 3, 2, 2, 2, 292,
+	293, 7, 95, 2, 2, 293, 66, 3, 2, 2, 2, 294, 295, 7, 99, 2, 2, 295, 296,
+	7, 116, 2, 2, 296, 297, 7, 116, 2, 2, 297, 298, 7, 99, 2, 2, 298, 299,
+	7, 123, 2, 2, 299, 300, 7, 97, 2, 2, 300, 301, 7, 101, 2, 2, 301, 302,
+	7, 113, 2, 2, 302, 303, 7, 112, 2, 2, 303, 304, 7, 118, 2, 2, 304, 305,
+	7, 99, 2, 2, 305, 306, 7, 107, 2, 2, 306, 307, 7, 112, 2, 2, 307, 323,
+	7, 117, 2, 2, 308, 309, 7, 67, 2, 2, 309, 310, 7, 84, 2, 2, 310, 311, 7,
+	84, 2, 2, 311, 312, 7, 67, 2, 2, 312, 313, 7, 91, 2, 2, 313, 314, 7, 97,
+	2, 2, 314, 315, 7, 69, 2, 2, 315, 316, 7, 81, 2, 2, 316, 317, 7, 80, 2,
+	2, 317, 318, 7, 86, 2, 2, 318, 319, 7, 67, 2, 2, 319, 320, 7, 75, 2, 2,
+	320, 321, 7, 80, 2, 2, 321, 323, 7, 85, 2, 2, 322, 294, 3, 2, 2, 2, 322,
+	308, 3, 2, 2, 2, 323, 68, 3, 2, 2, 2, 324, 325, 7, 99, 2, 2, 325, 326,
+	7, 116, 2, 2, 326, 327, 7, 116, 2, 2, 327, 328, 7, 99, 2, 2, 328, 329,
+	7, 123, 2, 2, 329, 330, 7, 97, 2, 2, 330, 331, 7, 101, 2, 2, 331, 332,
+	7, 113, 2, 2, 332, 333, 7, 112, 2, 2, 333, 334, 7, 118, 2, 2, 334, 335,
+	7, 99, 2, 2, 335, 336, 7, 107, 2, 2, 336, 337, 7, 112, 2, 2, 337, 338,
+	7, 117, 2, 2, 338, 339, 7, 97, 2, 2, 339, 340, 7, 99, 2, 2, 340, 341, 7,
+	110, 2, 2, 341, 361, 7, 110, 2, 2, 342, 343, 7, 67, 2, 2, 343, 344, 7,
+	84, 2, 2, 344, 345, 7, 84, 2, 2, 345, 346, 7, 67, 2, 2, 346, 347, 7, 91,
+	2, 2, 347, 348, 7, 97, 2, 2, 348, 349, 7, 69, 2, 2, 349, 350, 7, 81, 2,
+	2, 350, 351, 7, 80, 2, 2, 351, 352, 7, 86, 2, 2, 352, 353, 7, 67, 2, 2,
+	353, 354, 7, 75, 2, 2, 354, 355, 7, 80, 2, 2, 355, 356, 7, 85, 2, 2, 356,
+	357, 7, 97, 2, 2, 357, 358, 7, 67, 2, 2, 358, 359, 7, 78, 2, 2, 359, 361,
+	7, 78, 2, 2, 360, 324, 3, 2, 2, 2, 360, 342, 3, 2, 2, 2, 361, 70, 3, 2,
+	2, 2, 362, 363, 7, 99, 2, 2, 363, 364, 7, 116, 2, 2, 364, 365, 7, 116,
+	2, 2, 365, 366, 7, 99, 2, 2, 366, 367, 7, 123, 2, 2, 367, 368, 7, 97, 2,
+	2, 368, 369, 7, 101, 2, 2, 369, 370, 7, 113, 2, 2, 370, 371, 7, 112, 2,
+	2, 371, 372, 7, 118, 2, 2, 372, 373, 7, 99, 2, 2, 373, 374, 7, 107, 2,
+	2, 374, 375, 7, 112, 2, 2, 375, 376, 7, 117, 2, 2, 376, 377, 7, 97, 2,
+	2, 377, 378, 7, 99, 2, 2, 378, 379, 7, 112, 2, 2, 379, 399, 7, 123, 2,
+	2, 380, 381, 7, 67, 2, 2, 381, 382, 7, 84, 2, 2, 382, 383, 7, 84, 2, 2,
+	383, 384, 7, 67, 2, 2, 384, 385, 7, 91, 2, 2, 385, 386, 7, 97, 2, 2, 386,
+	387, 7, 69, 2, 2, 387, 388, 7, 81, 2, 2, 388, 389, 7, 80, 2, 2, 389, 390,
+	7, 86, 2, 2, 390, 391, 7, 67, 2, 2, 391, 392, 7, 75, 2, 2, 392, 393, 7,
+	80, 2, 2, 393, 394, 7, 85, 2, 2, 394, 395, 7, 97, 2, 2, 395, 396, 7, 67,
+	2, 2, 396, 397, 7, 80, 2, 2, 397, 399, 7, 91, 2, 2, 398, 362, 3, 2, 2,
+	2, 398, 380, 3, 2, 2, 2, 399, 72, 3, 2, 2, 2, 400, 401, 7, 99, 2, 2, 401,
+	402, 7, 116, 2, 2, 402, 403, 7, 116, 2, 2, 403, 404, 7, 99, 2, 2, 404,
+	405, 7, 123, 2, 2, 405, 406, 7, 97, 2, 2, 406, 407, 7, 110, 2, 2, 407,
+	408, 7, 103, 2, 2, 408, 409, 7, 112, 2, 2, 409, 410, 7, 105, 2, 2, 410,
+	411, 7, 118, 2, 2, 411, 425, 7, 106, 2, 2, 412, 413, 7, 67, 2, 2, 413,
+	414, 7, 84, 2, 2, 414, 415, 7, 84, 2, 2, 415, 416, 7, 67, 2, 2, 416, 417,
+	7, 91, 2, 2, 417, 418, 7, 97, 2, 2, 418, 419, 7, 78, 2, 2, 419, 420, 7,
+	71, 2, 2, 420, 421, 7, 80, 2, 2, 421, 422, 7, 73, 2, 2, 422, 423, 7, 86,
+	2, 2, 423, 425, 7, 74, 2, 2, 424, 400, 3, 2, 2, 2, 424, 412, 3, 2, 2, 2,
+	425, 74, 3, 2, 2, 2, 426, 427, 7, 118, 2, 2, 427, 428, 7, 116, 2, 2, 428,
+	429, 7, 119, 2, 2, 429, 454, 7, 103, 2, 2, 430, 431, 7, 86, 2, 2, 431,
+	432, 7, 116, 2, 2, 432, 433, 7, 119, 2, 2, 433, 454, 7, 103, 2, 2, 434,
+	435, 7, 86, 2, 2, 435, 436, 7, 84, 2, 2, 436, 437, 7, 87, 2, 2, 437, 454,
+	7, 71, 2, 2, 438, 439, 7, 104, 2, 2, 439, 440, 7, 99, 2, 2, 440, 441, 7,
+	110, 2, 2, 441, 442, 7, 117, 2, 2, 442, 454, 7, 103, 2, 2, 443, 444, 7,
+	72, 2, 2, 444, 445, 7, 99, 2, 2, 445, 446, 7, 110, 2, 2, 446, 447, 7, 117,
+	2, 2, 447, 454, 7, 103, 2, 2, 448, 449, 7, 72, 2, 2, 449, 450, 7, 67, 2,
+	2, 450, 451, 7, 78, 2, 2, 451, 452, 7, 85, 2, 2, 452, 454, 7, 71, 2, 2,
+	453, 426, 3, 2, 2, 2, 453, 430, 3, 2, 2, 2, 453, 434, 3, 2, 2, 2, 453,
+	438, 3, 2, 2, 2, 453, 443, 3, 2, 2, 2, 453, 448, 3, 2, 2, 2, 454, 76, 3,
+	2, 2, 2, 455, 460, 5, 99, 50, 2, 456, 460, 5, 101, 51, 2, 457, 460, 5,
+	103, 52, 2, 458, 460, 5, 97, 49, 2, 459, 455, 3, 2, 2, 2, 459, 456, 3,
+	2, 2, 2, 459, 457, 3, 2, 2, 2, 459, 458, 3, 2, 2, 2, 460, 78, 3, 2, 2,
+	2, 461, 464, 5, 115, 58, 2, 462, 464, 5, 117, 59, 2, 463, 461, 3, 2, 2,
+	2, 463, 462, 3, 2, 2, 2, 464, 80, 3, 2, 2, 2, 465, 470, 5, 93, 47, 2, 466,
+	469, 5, 93, 47, 2, 467, 469, 5, 95, 48, 2, 468, 466, 3, 2, 2, 2, 468, 467,
+	3, 2, 2, 2, 469, 472, 3, 2, 2, 2, 470, 468, 3, 2, 2, 2, 470, 471, 3, 2,
+	2, 2, 471, 82, 3, 2, 2, 2, 472, 470, 3, 2, 2, 2, 473, 481, 5, 81, 41, 2,
+	474, 477, 7, 93, 2, 2, 475, 478, 5, 85, 43, 2, 476, 478, 5, 123, 62, 2,
+	477, 475, 3, 2, 2, 2, 477, 476, 3, 2, 2, 2, 478, 479, 3, 2, 2, 2, 479,
+	480, 7, 95, 2, 2, 480, 482, 3, 2, 2, 2, 481, 474, 3, 2, 2, 2, 482, 483,
+	3, 2, 2, 2, 483, 481, 3, 2, 2, 2, 483, 484, 3, 2, 2, 2, 484, 84, 3, 2,
+	2, 2, 485, 487, 5, 87, 44, 2, 486, 485, 3, 2, 2, 2, 486, 487, 3, 2, 2,
+	2, 487, 488, 3, 2, 2, 2, 488, 490, 7, 36, 2, 2, 489, 491, 5, 89, 45, 2,
+	490, 489, 3, 2, 2, 2, 490, 491, 3, 2, 2, 2, 491, 492, 3, 2, 2, 2, 492,
+	493, 7, 36, 2, 2, 493, 86, 3, 2, 2, 2, 494, 495, 7, 119, 2, 2, 495, 498,
+	7, 58, 2, 2, 496, 498, 9, 3, 2, 2, 497, 494, 3, 2, 2, 2, 497, 496, 3, 2,
+	2, 2, 498, 88, 3, 2, 2, 2, 499, 501, 5, 91, 46, 2, 500, 499, 3, 2, 2, 2,
+	501, 502, 3, 2, 2, 2, 502, 500, 3, 2, 2, 2, 502, 503, 3, 2, 2, 2, 503,
+	90, 3, 2, 2, 2, 504, 512, 10, 4, 2, 2, 505, 512, 5, 131, 66, 2, 506, 507,
+	7, 94, 2, 2, 507, 512, 7, 12, 2, 2, 508, 509, 7, 94, 2, 2, 509, 510, 7,
+	15, 2, 2, 510, 512, 7, 12, 2, 2, 511, 504, 3, 2, 2, 2, 511, 505, 3, 2,
+	2, 2, 511, 506, 3, 2, 2, 2, 511, 508, 3, 2, 2, 2, 512, 92, 3, 2, 2, 2,
+	513, 514, 9, 5, 2, 2, 514, 94, 3, 2, 2, 2, 515, 516, 9, 6, 2, 2, 516, 96,
+	3, 2, 2, 2, 517, 518, 7, 50, 2, 2, 518, 520, 9, 7, 2, 2, 519, 521, 9, 8,
+	2, 2, 520, 519, 3, 2, 2, 2, 521, 522, 3, 2, 2, 2, 522, 520, 3, 2, 2, 2,
+	522, 523, 3, 2, 2, 2, 523, 98, 3, 2, 2, 2, 524, 528, 5, 105, 53, 2, 525,
+	527, 5, 95, 48, 2, 526, 525, 3, 2, 2, 2, 527, 530, 3, 2, 2, 2, 528, 526,
+	3, 2, 2, 2, 528, 529, 3, 2, 2, 2, 529, 100, 3, 2, 2, 2, 530, 528, 3, 2,
+	2, 2, 531, 535, 7, 50, 2, 2, 532, 534, 5, 107, 54, 2, 533, 532, 3, 2, 2,
+	2, 534, 537, 3, 2, 2, 2, 535, 533, 3, 2, 2, 2, 535, 536, 3, 2, 2, 2, 536,
+	102, 3, 2, 2, 2, 537, 535, 3, 2, 2, 2, 538, 539, 7, 50, 2, 2, 539, 540,
+	9, 9, 2, 2, 540, 541, 5, 127, 64, 2, 541, 104, 3, 2, 2, 2, 542, 543, 9,
+	10, 2, 2, 543, 106, 3, 2, 2, 2, 544, 545, 9, 11, 2, 2, 545, 108, 3, 2,
+	2, 2, 546, 547, 9, 12, 2, 2, 547, 110, 3, 2, 2, 2, 548, 549, 5, 109, 55,
+	2, 549, 550, 5, 109, 55, 2, 550, 551, 5, 109, 55, 2, 551, 552, 5, 109,
+	55, 2, 552, 112, 3, 2, 2, 2, 553, 554, 7, 94, 2, 2, 554, 555, 7, 119, 2,
+	2, 555, 556, 3, 2, 2, 2, 556, 564, 5, 111, 56, 2, 557, 558, 7, 94, 2, 2,
+	558, 559, 7, 87, 2, 2, 559, 560, 3, 2, 2, 2, 560, 561, 5, 111, 56, 2, 561,
+	562, 5, 111, 56, 2, 562, 564, 3, 2, 2, 2, 563, 553, 3, 2, 2, 2, 563, 557,
+	3, 2, 2, 2, 564, 114, 3, 2, 2, 2, 565, 567, 5, 119, 60, 2, 566, 568, 5,
+	121, 61, 2, 567, 566, 3, 2, 2, 2, 567, 568, 3, 2, 2, 2, 568, 573, 3, 2,
+	2, 2, 569, 570, 5, 123, 62, 2, 570, 571, 5, 121, 61, 2, 571, 573, 3, 2,
+	2, 2, 572, 565, 3, 2, 2, 2, 572, 569, 3, 2, 2, 2, 573, 116, 3, 2, 2, 2,
+	574, 575, 7, 50, 2, 2, 575, 578, 9, 9, 2, 2, 576, 579, 5, 125, 63, 2, 577,
+	579, 5, 127, 64, 2, 578, 576, 3, 2, 2, 2, 578, 577, 3, 2, 2, 2, 579, 580,
+	3, 2, 2, 2, 580, 581, 5, 129, 65, 2, 581, 118, 3, 2, 2, 2, 582, 584, 5,
+	123, 62, 2, 583, 582, 3, 2, 2, 2, 583, 584, 3, 2, 2, 2, 584, 585, 3, 2,
+	2, 2, 585, 586, 7, 48, 2, 2, 586, 591, 5, 123, 62, 2, 587, 588, 5, 123,
+	62, 2, 588, 589, 7, 48, 2, 2, 589, 591, 3, 2, 2, 2, 590, 583, 3, 2, 2,
+	2, 590, 587, 3, 2, 2, 2, 591, 120, 3, 2, 2, 2, 592, 594, 9, 13, 2, 2, 593,
+	595, 9, 14, 2, 2, 594, 593, 3, 2, 2, 2, 594, 595, 3, 2, 2, 2, 595, 596,
+	3, 2, 2, 2, 596, 597, 5, 123, 62, 2, 597, 122, 3, 2, 2, 2, 598, 600, 5,
+	95, 48, 2, 599, 598, 3, 2, 2, 2, 600, 601, 3, 2, 2, 2, 601, 599, 3, 2,
+	2, 2, 601, 602, 3, 2, 2, 2, 602, 124, 3, 2, 2, 2, 603, 605, 5, 127, 64,
+	2, 604, 603, 3, 2, 2, 2, 604, 605, 3, 2, 2, 2, 605, 606, 3, 2, 2, 2, 606,
+	607, 7, 48, 2, 2, 607, 612, 5, 127, 64, 2, 608, 609, 5, 127, 64, 2, 609,
+	610, 7, 48, 2, 2, 610, 612, 3, 2, 2, 2, 611, 604, 3, 2, 2, 2, 611, 608,
+	3, 2, 2, 2, 612, 126, 3, 2, 2, 2, 613, 615, 5, 109, 55, 2, 614, 613, 3,
+	2, 2, 2, 615, 616, 3, 2, 2, 2, 616, 614, 3, 2, 2, 2, 616, 617, 3, 2, 2,
+	2, 617, 128, 3, 2, 2, 2, 618, 620, 9, 15, 2, 2, 619, 621, 9, 14, 2, 2,
+	620, 619, 3, 2, 2, 2, 620, 621, 3, 2, 2, 2, 621, 622, 3, 2, 2, 2, 622,
+	623, 5, 123, 62, 2, 623, 130, 3, 2, 2, 2, 624, 625, 7, 94, 2, 2, 625, 640,
+	9, 16, 2, 2, 626, 627, 7, 94, 2, 2, 627, 629, 5, 107, 54, 2, 628, 630,
+	5, 107, 54, 2, 629, 628, 3, 2, 2, 2, 629, 630, 3, 2, 2, 2, 630, 632, 3,
+	2, 2, 2, 631, 633, 5, 107, 54, 2, 632, 631, 3, 2, 2, 2, 632, 633, 3, 2,
+	2, 2, 633, 640, 3, 2, 2, 2, 634, 635, 7, 94, 2, 2, 635, 636, 7, 122, 2,
+	2, 636, 637, 3, 2, 2, 2, 637, 640, 5, 127, 64, 2, 638, 640, 5, 113, 57,
+	2, 639, 624, 3, 2, 2, 2, 639, 626, 3, 2, 2, 2, 639, 634, 3, 2, 2, 2, 639,
+	638, 3, 2, 2, 2, 640, 132, 3, 2, 2, 2, 641, 643, 9, 2, 2, 2, 642, 641,
+	3, 2, 2, 2, 643, 644, 3, 2, 2, 2, 644, 642, 3, 2, 2, 2, 644, 645, 3, 2,
+	2, 2, 645, 646, 3, 2, 2, 2, 646, 647, 8, 67, 2, 2, 647, 134, 3, 2, 2, 2,
+	648, 650, 7, 15, 2, 2, 649, 651, 7, 12, 2, 2, 650, 649, 3, 2, 2, 2, 650,
+	651, 3, 2, 2, 2, 651, 654, 3, 2, 2, 2, 652, 654, 7, 12, 2, 2, 653, 648,
+	3, 2, 2, 2, 653, 652, 3, 2, 2, 2, 654, 655, 3, 2, 2, 2, 655, 656, 8, 68,
+	2, 2, 656, 136, 3, 2, 2, 2, 54, 2, 171, 203, 209, 217, 233, 238, 248, 254,
+	259, 267, 272, 282, 287, 289, 322, 360, 398, 424, 453, 459, 463, 468, 470,
+	477, 483, 486, 490, 497, 502, 511, 522, 528, 535, 563, 567, 572, 578, 583,
+	590, 594, 601, 604, 611, 616, 620, 629, 632, 639, 644, 650, 653, 3, 8,
+	2, 2,
 }
 
 var lexerChannelNames = []string{
@@ -238,18 +334,21 @@ var lexerLiteralNames = []string{
 var lexerSymbolicNames = []string{
 	"", "", "", "", "", "", "LT", "LE", "GT", "GE", "EQ", "NE", "LIKE", "ADD",
 	"SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND", "BOR", "BXOR",
-	"AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm", "BooleanConstant",
-	"IntegerConstant", "FloatingConstant", "Identifier", "StringLiteral", "Whitespace",
-	"Newline",
+	"AND", "OR", "BNOT", "NOT", "IN", "NIN", "ISNULL", "ISNOTNULL", "EmptyTerm",
+	"ArrayContains", "ArrayContainsAll", "ArrayContainsAny", "ArrayLength",
+	"BooleanConstant", "IntegerConstant", "FloatingConstant", "Identifier",
+	"JSONIdentifier", "StringLiteral", "Whitespace", "Newline",
 }
 
 var lexerRuleNames = []string{
 	"T__0", "T__1", "T__2", "T__3", "T__4", "LT", "LE", "GT", "GE", "EQ", "NE",
 	"LIKE", "ADD", "SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND",
-	"BOR", "BXOR", "AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm", "BooleanConstant",
-	"IntegerConstant", "FloatingConstant", "Identifier", "StringLiteral", "EncodingPrefix",
-	"SCharSequence", "SChar", "Nondigit", "Digit", "BinaryConstant", "DecimalConstant",
-	"OctalConstant", "HexadecimalConstant", "NonzeroDigit", "OctalDigit", "HexadecimalDigit",
+	"BOR", "BXOR", "AND", "OR", "BNOT", "NOT", "IN", "NIN", "ISNULL", "ISNOTNULL",
+	"EmptyTerm", "ArrayContains", "ArrayContainsAll", "ArrayContainsAny", "ArrayLength",
+	"BooleanConstant", "IntegerConstant", "FloatingConstant", "Identifier",
+	"JSONIdentifier", "StringLiteral", "EncodingPrefix", "SCharSequence", "SChar",
+	"Nondigit", "Digit", "BinaryConstant", "DecimalConstant", "OctalConstant",
+	"HexadecimalConstant", "NonzeroDigit", "OctalDigit", "HexadecimalDigit",
 	"HexQuad", "UniversalCharacterName", "DecimalFloatingConstant", "HexadecimalFloatingConstant",
 	"FractionalConstant", "ExponentPart", "DigitSequence", "HexadecimalFractionalConstant",
 	"HexadecimalDigitSequence", "BinaryExponentPart", "EscapeSequence", "Whitespace",
@@ -322,12 +421,19 @@ const (
 	PlanLexerNOT              = 27
 	PlanLexerIN               = 28
 	PlanLexerNIN              = 29
-	PlanLexerEmptyTerm        = 30
-	PlanLexerBooleanConstant  = 31
-	PlanLexerIntegerConstant  = 32
-	PlanLexerFloatingConstant = 33
-	PlanLexerIdentifier       = 34
-	PlanLexerStringLiteral    = 35
-	PlanLexerWhitespace       = 36
-	PlanLexerNewline          = 37
+	PlanLexerISNULL           = 30
+	PlanLexerISNOTNULL        = 31
+	PlanLexerEmptyTerm        = 32
+	PlanLexerArrayContains    = 33
+	PlanLexerArrayContainsAll = 34
+	PlanLexerArrayContainsAny = 35
+	PlanLexerArrayLength      = 36
+	PlanLexerBooleanConstant  = 37
+	PlanLexerIntegerConstant  = 38
+	PlanLexerFloatingConstant = 39
+	PlanLexerIdentifier       = 40
+	PlanLexerJSONIdentifier   = 41
+	PlanLexerStringLiteral    = 42
+	PlanLexerWhitespace       = 43
+	PlanLexerNewline          = 44
 )
@@ -15,48 +15,69 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 39, 89, 4,
-	2, 9, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 5, 2, 17, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 71, 10, 2, 12,
-	2, 14, 2, 74, 11, 2, 3, 2, 5, 2, 77, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3,
-	2, 7, 2, 84, 10, 2, 12, 2, 14, 2, 87, 11, 2, 3, 2, 2, 3, 2, 3, 2, 2, 11,
-	4, 2, 15, 16, 28, 29, 3, 2, 17, 19, 3, 2, 15, 16, 3, 2, 21, 22, 3, 2, 8,
-	9, 3, 2, 10, 11, 3, 2, 8, 11, 3, 2, 12, 13, 3, 2, 30, 31, 2, 111, 2, 16,
-	3, 2, 2, 2, 4, 5, 8, 2, 1, 2, 5, 17, 7, 34, 2, 2, 6, 17, 7, 35, 2, 2, 7,
-	17, 7, 33, 2, 2, 8, 17, 7, 37, 2, 2, 9, 17, 7, 36, 2, 2, 10, 11, 7, 3,
-	2, 2, 11, 12, 5, 2, 2, 2, 12, 13, 7, 4, 2, 2, 13, 17, 3, 2, 2, 2, 14, 15,
-	9, 2, 2, 2, 15, 17, 5, 2, 2, 17, 16, 4, 3, 2, 2, 2, 16, 6, 3, 2, 2, 2,
-	16, 7, 3, 2, 2, 2, 16, 8, 3, 2, 2, 2, 16, 9, 3, 2, 2, 2, 16, 10, 3, 2,
-	2, 2, 16, 14, 3, 2, 2, 2, 17, 85, 3, 2, 2, 2, 18, 19, 12, 18, 2, 2, 19,
-	20, 7, 20, 2, 2, 20, 84, 5, 2, 2, 19, 21, 22, 12, 16, 2, 2, 22, 23, 9,
-	3, 2, 2, 23, 84, 5, 2, 2, 17, 24, 25, 12, 15, 2, 2, 25, 26, 9, 4, 2, 2,
-	26, 84, 5, 2, 2, 16, 27, 28, 12, 14, 2, 2, 28, 29, 9, 5, 2, 2, 29, 84,
-	5, 2, 2, 15, 30, 31, 12, 11, 2, 2, 31, 32, 9, 6, 2, 2, 32, 33, 7, 36, 2,
-	2, 33, 34, 9, 6, 2, 2, 34, 84, 5, 2, 2, 12, 35, 36, 12, 10, 2, 2, 36, 37,
-	9, 7, 2, 2, 37, 38, 7, 36, 2, 2, 38, 39, 9, 7, 2, 2, 39, 84, 5, 2, 2, 11,
-	40, 41, 12, 9, 2, 2, 41, 42, 9, 8, 2, 2, 42, 84, 5, 2, 2, 10, 43, 44, 12,
-	8, 2, 2, 44, 45, 9, 9, 2, 2, 45, 84, 5, 2, 2, 9, 46, 47, 12, 7, 2, 2, 47,
-	48, 7, 23, 2, 2, 48, 84, 5, 2, 2, 8, 49, 50, 12, 6, 2, 2, 50, 51, 7, 25,
-	2, 2, 51, 84, 5, 2, 2, 7, 52, 53, 12, 5, 2, 2, 53, 54, 7, 24, 2, 2, 54,
-	84, 5, 2, 2, 6, 55, 56, 12, 4, 2, 2, 56, 57, 7, 26, 2, 2, 57, 84, 5, 2,
-	2, 5, 58, 59, 12, 3, 2, 2, 59, 60, 7, 27, 2, 2, 60, 84, 5, 2, 2, 4, 61,
-	62, 12, 19, 2, 2, 62, 63, 7, 14, 2, 2, 63, 84, 7, 37, 2, 2, 64, 65, 12,
-	13, 2, 2, 65, 66, 9, 10, 2, 2, 66, 67, 7, 5, 2, 2, 67, 72, 5, 2, 2, 2,
-	68, 69, 7, 6, 2, 2, 69, 71, 5, 2, 2, 2, 70, 68, 3, 2, 2, 2, 71, 74, 3,
-	2, 2, 2, 72, 70, 3, 2, 2, 2, 72, 73, 3, 2, 2, 2, 73, 76, 3, 2, 2, 2, 74,
-	72, 3, 2, 2, 2, 75, 77, 7, 6, 2, 2, 76, 75, 3, 2, 2, 2, 76, 77, 3, 2, 2,
-	2, 77, 78, 3, 2, 2, 2, 78, 79, 7, 7, 2, 2, 79, 84, 3, 2, 2, 2, 80, 81,
-	12, 12, 2, 2, 81, 82, 9, 10, 2, 2, 82, 84, 7, 32, 2, 2, 83, 18, 3, 2, 2,
-	2, 83, 21, 3, 2, 2, 2, 83, 24, 3, 2, 2, 2, 83, 27, 3, 2, 2, 2, 83, 30,
-	3, 2, 2, 2, 83, 35, 3, 2, 2, 2, 83, 40, 3, 2, 2, 2, 83, 43, 3, 2, 2, 2,
-	83, 46, 3, 2, 2, 2, 83, 49, 3, 2, 2, 2, 83, 52, 3, 2, 2, 2, 83, 55, 3,
-	2, 2, 2, 83, 58, 3, 2, 2, 2, 83, 61, 3, 2, 2, 2, 83, 64, 3, 2, 2, 2, 83,
-	80, 3, 2, 2, 2, 84, 87, 3, 2, 2, 2, 85, 83, 3, 2, 2, 2, 85, 86, 3, 2, 2,
-	2, 86, 3, 3, 2, 2, 2, 87, 85, 3, 2, 2, 2, 7, 16, 72, 76, 83, 85,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 46, 133,
+	4, 2, 9, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 20, 10, 2, 12, 2, 14, 2, 23, 11, 2,
+	3, 2, 5, 2, 26, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 5, 2, 61, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 7, 2, 115, 10, 2, 12, 2,
+	14, 2, 118, 11, 2, 3, 2, 5, 2, 121, 10, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2,
+	7, 2, 128, 10, 2, 12, 2, 14, 2, 131, 11, 2, 3, 2, 2, 3, 2, 3, 2, 2, 12,
+	4, 2, 15, 16, 28, 29, 3, 2, 42, 43, 3, 2, 17, 19, 3, 2, 15, 16, 3, 2, 21,
+	22, 3, 2, 8, 9, 3, 2, 10, 11, 3, 2, 8, 11, 3, 2, 12, 13, 3, 2, 30, 31,
+	2, 165, 2, 60, 3, 2, 2, 2, 4, 5, 8, 2, 1, 2, 5, 61, 7, 40, 2, 2, 6, 61,
+	7, 41, 2, 2, 7, 61, 7, 39, 2, 2, 8, 61, 7, 44, 2, 2, 9, 61, 7, 42, 2, 2,
+	10, 61, 7, 43, 2, 2, 11, 12, 7, 3, 2, 2, 12, 13, 5, 2, 2, 2, 13, 14, 7,
+	4, 2, 2, 14, 61, 3, 2, 2, 2, 15, 16, 7, 5, 2, 2, 16, 21, 5, 2, 2, 2, 17,
+	18, 7, 6, 2, 2, 18, 20, 5, 2, 2, 2, 19, 17, 3, 2, 2, 2, 20, 23, 3, 2, 2,
+	2, 21, 19, 3, 2, 2, 2, 21, 22, 3, 2, 2, 2, 22, 25, 3, 2, 2, 2, 23, 21,
+	3, 2, 2, 2, 24, 26, 7, 6, 2, 2, 25, 24, 3, 2, 2, 2, 25, 26, 3, 2, 2, 2,
+	26, 27, 3, 2, 2, 2, 27, 28, 7, 7, 2, 2, 28, 61, 3, 2, 2, 2, 29, 30, 9,
+	2, 2, 2, 30, 61, 5, 2, 2, 23, 31, 32, 7, 35, 2, 2, 32, 33, 7, 3, 2, 2,
+	33, 34, 5, 2, 2, 2, 34, 35, 7, 6, 2, 2, 35, 36, 5, 2, 2, 2, 36, 37, 7,
+	4, 2, 2, 37, 61, 3, 2, 2, 2, 38, 39, 7, 36, 2, 2, 39, 40, 7, 3, 2, 2, 40,
+	41, 5, 2, 2, 2, 41, 42, 7, 6, 2, 2, 42, 43, 5, 2, 2, 2, 43, 44, 7, 4, 2,
+	2, 44, 61, 3, 2, 2, 2, 45, 46, 7, 37, 2, 2, 46, 47, 7, 3, 2, 2, 47, 48,
+	5, 2, 2, 2, 48, 49, 7, 6, 2, 2, 49, 50, 5, 2, 2, 2, 50, 51, 7, 4, 2, 2,
+	51, 61, 3, 2, 2, 2, 52, 53, 7, 38, 2, 2, 53, 54, 7, 3, 2, 2, 54, 55, 9,
+	3, 2, 2, 55, 61, 7, 4, 2, 2, 56, 57, 9, 3, 2, 2, 57, 61, 7, 32, 2, 2, 58,
+	59, 9, 3, 2, 2, 59, 61, 7, 33, 2, 2, 60, 4, 3, 2, 2, 2, 60, 6, 3, 2, 2,
+	2, 60, 7, 3, 2, 2, 2, 60, 8, 3, 2, 2, 2, 60, 9, 3, 2, 2, 2, 60, 10, 3,
+	2, 2, 2, 60, 11, 3, 2, 2, 2, 60, 15, 3, 2, 2, 2, 60, 29, 3, 2, 2, 2, 60,
+	31, 3, 2, 2, 2, 60, 38, 3, 2, 2, 2, 60, 45, 3, 2, 2, 2, 60, 52, 3, 2, 2,
+	2, 60, 56, 3, 2, 2, 2, 60, 58, 3, 2, 2, 2, 61, 129, 3, 2, 2, 2, 62, 63,
+	12, 24, 2, 2, 63, 64, 7, 20, 2, 2, 64, 128, 5, 2, 2, 25, 65, 66, 12, 22,
+	2, 2, 66, 67, 9, 4, 2, 2, 67, 128, 5, 2, 2, 23, 68, 69, 12, 21, 2, 2, 69,
+	70, 9, 5, 2, 2, 70, 128, 5, 2, 2, 22, 71, 72, 12, 20, 2, 2, 72, 73, 9,
+	6, 2, 2, 73, 128, 5, 2, 2, 21, 74, 75, 12, 13, 2, 2, 75, 76, 9, 7, 2, 2,
+	76, 77, 9, 3, 2, 2, 77, 78, 9, 7, 2, 2, 78, 128, 5, 2, 2, 14, 79, 80, 12,
+	12, 2, 2, 80, 81, 9, 8, 2, 2, 81, 82, 9, 3, 2, 2, 82, 83, 9, 8, 2, 2, 83,
+	128, 5, 2, 2, 13, 84, 85, 12, 11, 2, 2, 85, 86, 9, 9, 2, 2, 86, 128, 5,
+	2, 2, 12, 87, 88, 12, 10, 2, 2, 88, 89, 9, 10, 2, 2, 89, 128, 5, 2, 2,
+	11, 90, 91, 12, 9, 2, 2, 91, 92, 7, 23, 2, 2, 92, 128, 5, 2, 2, 10, 93,
+	94, 12, 8, 2, 2, 94, 95, 7, 25, 2, 2, 95, 128, 5, 2, 2, 9, 96, 97, 12,
+	7, 2, 2, 97, 98, 7, 24, 2, 2, 98, 128, 5, 2, 2, 8, 99, 100, 12, 6, 2, 2,
+	100, 101, 7, 26, 2, 2, 101, 128, 5, 2, 2, 7, 102, 103, 12, 5, 2, 2, 103,
+	104, 7, 27, 2, 2, 104, 128, 5, 2, 2, 6, 105, 106, 12, 25, 2, 2, 106, 107,
+	7, 14, 2, 2, 107, 128, 7, 44, 2, 2, 108, 109, 12, 19, 2, 2, 109, 110, 9,
+	11, 2, 2, 110, 111, 7, 5, 2, 2, 111, 116, 5, 2, 2, 2, 112, 113, 7, 6, 2,
+	2, 113, 115, 5, 2, 2, 2, 114, 112, 3, 2, 2, 2, 115, 118, 3, 2, 2, 2, 116,
+	114, 3, 2, 2, 2, 116, 117, 3, 2, 2, 2, 117, 120, 3, 2, 2, 2, 118, 116,
+	3, 2, 2, 2, 119, 121, 7, 6, 2, 2, 120, 119, 3, 2, 2, 2, 120, 121, 3, 2,
+	2, 2, 121, 122, 3, 2, 2, 2, 122, 123, 7, 7, 2, 2, 123, 128, 3, 2, 2, 2,
+	124, 125, 12, 18, 2, 2, 125, 126, 9, 11, 2, 2, 126, 128, 7, 34, 2, 2, 127,
+	62, 3, 2, 2, 2, 127, 65, 3, 2, 2, 2, 127, 68, 3, 2, 2, 2, 127, 71, 3, 2,
+	2, 2, 127, 74, 3, 2, 2, 2, 127, 79, 3, 2, 2, 2, 127, 84, 3, 2, 2, 2, 127,
+	87, 3, 2, 2, 2, 127, 90, 3, 2, 2, 2, 127, 93, 3, 2, 2, 2, 127, 96, 3, 2,
+	2, 2, 127, 99, 3, 2, 2, 2, 127, 102, 3, 2, 2, 2, 127, 105, 3, 2, 2, 2,
+	127, 108, 3, 2, 2, 2, 127, 124, 3, 2, 2, 2, 128, 131, 3, 2, 2, 2, 129,
+	127, 3, 2, 2, 2, 129, 130, 3, 2, 2, 2, 130, 3, 3, 2, 2, 2, 131, 129, 3,
+	2, 2, 2, 9, 21, 25, 60, 116, 120, 127, 129,
 }
 var literalNames = []string{
 	"", "'('", "')'", "'['", "','", "']'", "'<'", "'<='", "'>'", "'>='", "'=='",
@@ -66,9 +87,10 @@ var literalNames = []string{
 var symbolicNames = []string{
 	"", "", "", "", "", "", "LT", "LE", "GT", "GE", "EQ", "NE", "LIKE", "ADD",
 	"SUB", "MUL", "DIV", "MOD", "POW", "SHL", "SHR", "BAND", "BOR", "BXOR",
-	"AND", "OR", "BNOT", "NOT", "IN", "NIN", "EmptyTerm", "BooleanConstant",
-	"IntegerConstant", "FloatingConstant", "Identifier", "StringLiteral", "Whitespace",
-	"Newline",
+	"AND", "OR", "BNOT", "NOT", "IN", "NIN", "ISNULL", "ISNOTNULL", "EmptyTerm",
+	"ArrayContains", "ArrayContainsAll", "ArrayContainsAny", "ArrayLength",
+	"BooleanConstant", "IntegerConstant", "FloatingConstant", "Identifier",
+	"JSONIdentifier", "StringLiteral", "Whitespace", "Newline",
 }
 
 var ruleNames = []string{
@@ -136,14 +158,21 @@ const (
 	PlanParserNOT              = 27
 	PlanParserIN               = 28
 	PlanParserNIN              = 29
-	PlanParserEmptyTerm        = 30
-	PlanParserBooleanConstant  = 31
-	PlanParserIntegerConstant  = 32
-	PlanParserFloatingConstant = 33
-	PlanParserIdentifier       = 34
-	PlanParserStringLiteral    = 35
-	PlanParserWhitespace       = 36
-	PlanParserNewline          = 37
+	PlanParserISNULL           = 30
+	PlanParserISNOTNULL        = 31
+	PlanParserEmptyTerm        = 32
+	PlanParserArrayContains    = 33
+	PlanParserArrayContainsAll = 34
+	PlanParserArrayContainsAny = 35
+	PlanParserArrayLength      = 36
+	PlanParserBooleanConstant  = 37
+	PlanParserIntegerConstant  = 38
+	PlanParserFloatingConstant = 39
+	PlanParserIdentifier       = 40
+	PlanParserJSONIdentifier   = 41
+	PlanParserStringLiteral    = 42
+	PlanParserWhitespace       = 43
+	PlanParserNewline          = 44
 )
 
 // PlanParserRULE_expr is the PlanParser rule.
@@ -199,6 +228,587 @@ func (s *ExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) s
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
+type JSONIdentifierContext struct {
+	*ExprContext
+}
+
+func NewJSONIdentifierContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *JSONIdentifierContext {
+	var p = new(JSONIdentifierContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *JSONIdentifierContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *JSONIdentifierContext) JSONIdentifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserJSONIdentifier, 0)
+}
+
+func (s *JSONIdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitJSONIdentifier(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ParensContext struct {
+	*ExprContext
+}
+
+func NewParensContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParensContext {
+	var p = new(ParensContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *ParensContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParensContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ParensContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitParens(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type StringContext struct {
+	*ExprContext
+}
+
+func NewStringContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StringContext {
+	var p = new(StringContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *StringContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StringContext) StringLiteral() antlr.TerminalNode {
+	return s.GetToken(PlanParserStringLiteral, 0)
+}
+
+func (s *StringContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitString(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type FloatingContext struct {
+	*ExprContext
+}
+
+func NewFloatingContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FloatingContext {
+	var p = new(FloatingContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *FloatingContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FloatingContext) FloatingConstant() antlr.TerminalNode {
+	return s.GetToken(PlanParserFloatingConstant, 0)
+}
+
+func (s *FloatingContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitFloating(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type LogicalOrContext struct {
+	*ExprContext
+}
+
+func NewLogicalOrContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LogicalOrContext {
+	var p = new(LogicalOrContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *LogicalOrContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LogicalOrContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *LogicalOrContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *LogicalOrContext) OR() antlr.TerminalNode {
+	return s.GetToken(PlanParserOR, 0)
+}
+
+func (s *LogicalOrContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitLogicalOr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type IsNotNullContext struct {
+	*ExprContext
+}
+
+func NewIsNotNullContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IsNotNullContext {
+	var p = new(IsNotNullContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *IsNotNullContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IsNotNullContext) ISNOTNULL() antlr.TerminalNode {
+	return s.GetToken(PlanParserISNOTNULL, 0)
+}
+
+func (s *IsNotNullContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserIdentifier, 0)
+}
+
+func (s *IsNotNullContext) JSONIdentifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserJSONIdentifier, 0)
+}
+
+func (s *IsNotNullContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitIsNotNull(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type MulDivModContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewMulDivModContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MulDivModContext {
+	var p = new(MulDivModContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *MulDivModContext) GetOp() antlr.Token { return s.op }
+
+func (s *MulDivModContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *MulDivModContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MulDivModContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *MulDivModContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *MulDivModContext) MUL() antlr.TerminalNode {
+	return s.GetToken(PlanParserMUL, 0)
+}
+
+func (s *MulDivModContext) DIV() antlr.TerminalNode {
+	return s.GetToken(PlanParserDIV, 0)
+}
+
+func (s *MulDivModContext) MOD() antlr.TerminalNode {
+	return s.GetToken(PlanParserMOD, 0)
+}
+
+func (s *MulDivModContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitMulDivMod(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type IdentifierContext struct {
+	*ExprContext
+}
+
+func NewIdentifierContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IdentifierContext {
+	var p = new(IdentifierContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *IdentifierContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IdentifierContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserIdentifier, 0)
+}
+
+func (s *IdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitIdentifier(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type LikeContext struct {
+	*ExprContext
+}
+
+func NewLikeContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LikeContext {
+	var p = new(LikeContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *LikeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LikeContext) Expr() IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *LikeContext) LIKE() antlr.TerminalNode {
+	return s.GetToken(PlanParserLIKE, 0)
+}
+
+func (s *LikeContext) StringLiteral() antlr.TerminalNode {
+	return s.GetToken(PlanParserStringLiteral, 0)
+}
+
+func (s *LikeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitLike(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ArrayContainsContext struct {
+	*ExprContext
+}
+
+func NewArrayContainsContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ArrayContainsContext {
+	var p = new(ArrayContainsContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *ArrayContainsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArrayContainsContext) ArrayContains() antlr.TerminalNode {
+	return s.GetToken(PlanParserArrayContains, 0)
+}
+
+func (s *ArrayContainsContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ArrayContainsContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ArrayContainsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitArrayContains(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type LogicalAndContext struct {
+	*ExprContext
+}
+
+func NewLogicalAndContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LogicalAndContext {
+	var p = new(LogicalAndContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *LogicalAndContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LogicalAndContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *LogicalAndContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *LogicalAndContext) AND() antlr.TerminalNode {
+	return s.GetToken(PlanParserAND, 0)
+}
+
+func (s *LogicalAndContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitLogicalAnd(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type EqualityContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewEqualityContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EqualityContext {
+	var p = new(EqualityContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *EqualityContext) GetOp() antlr.Token { return s.op }
+
+func (s *EqualityContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *EqualityContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EqualityContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *EqualityContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *EqualityContext) EQ() antlr.TerminalNode {
+	return s.GetToken(PlanParserEQ, 0)
+}
+
+func (s *EqualityContext) NE() antlr.TerminalNode {
+	return s.GetToken(PlanParserNE, 0)
+}
+
+func (s *EqualityContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitEquality(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type BooleanContext struct {
+	*ExprContext
+}
+
+func NewBooleanContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BooleanContext {
+	var p = new(BooleanContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *BooleanContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BooleanContext) BooleanConstant() antlr.TerminalNode {
+	return s.GetToken(PlanParserBooleanConstant, 0)
+}
+
+func (s *BooleanContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PlanVisitor:
+		return t.VisitBoolean(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
 type ShiftContext struct {
 	*ExprContext
 	op antlr.Token
@@ -318,6 +928,10 @@ func (s *ReverseRangeContext) Identifier() antlr.TerminalNode {
 	return s.GetToken(PlanParserIdentifier, 0)
 }
 
+func (s *ReverseRangeContext) JSONIdentifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserJSONIdentifier, 0)
+}
+
 func (s *ReverseRangeContext) AllGT() []antlr.TerminalNode {
 	return s.GetTokens(PlanParserGT)
 }
@@ -463,12 +1077,12 @@ func (s *AddSubContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
-type ParensContext struct {
+type ArrayContainsAllContext struct {
 	*ExprContext
 }
 
-func NewParensContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParensContext {
-	var p = new(ParensContext)
+func NewArrayContainsAllContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ArrayContainsAllContext {
+	var p = new(ArrayContainsAllContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -477,12 +1091,29 @@ func NewParensContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParensC
 	return p
 }
 
-func (s *ParensContext) GetRuleContext() antlr.RuleContext {
+func (s *ArrayContainsAllContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ParensContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
+func (s *ArrayContainsAllContext) ArrayContainsAll() antlr.TerminalNode {
+	return s.GetToken(PlanParserArrayContainsAll, 0)
+}
+
+func (s *ArrayContainsAllContext) AllExpr() []IExprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
+	var tst = make([]IExprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ArrayContainsAllContext) Expr(i int) IExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -491,10 +1122,10 @@ func (s *ParensContext) Expr() IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *ParensContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArrayContainsAllContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case PlanVisitor:
-		return t.VisitParens(s)
+		return t.VisitArrayContainsAll(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -573,12 +1204,12 @@ func (s *RelationalContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
-type StringContext struct {
+type ArrayLengthContext struct {
 	*ExprContext
 }
 
-func NewStringContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StringContext {
-	var p = new(StringContext)
+func NewArrayLengthContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ArrayLengthContext {
+	var p = new(ArrayLengthContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -587,18 +1218,26 @@ func NewStringContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StringC
 	return p
 }
 
-func (s *StringContext) GetRuleContext() antlr.RuleContext {
+func (s *ArrayLengthContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StringContext) StringLiteral() antlr.TerminalNode {
-	return s.GetToken(PlanParserStringLiteral, 0)
+func (s *ArrayLengthContext) ArrayLength() antlr.TerminalNode {
+	return s.GetToken(PlanParserArrayLength, 0)
 }
 
-func (s *StringContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArrayLengthContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserIdentifier, 0)
+}
+
+func (s *ArrayLengthContext) JSONIdentifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserJSONIdentifier, 0)
+}
+
+func (s *ArrayLengthContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case PlanVisitor:
-		return t.VisitString(s)
+		return t.VisitArrayLength(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -669,38 +1308,6 @@ func (s *TermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
-type FloatingContext struct {
-	*ExprContext
-}
-
-func NewFloatingContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FloatingContext {
-	var p = new(FloatingContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
-
-	return p
-}
-
-func (s *FloatingContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *FloatingContext) FloatingConstant() antlr.TerminalNode {
-	return s.GetToken(PlanParserFloatingConstant, 0)
-}
-
-func (s *FloatingContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case PlanVisitor:
-		return t.VisitFloating(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
 type RangeContext struct {
 	*ExprContext
 	op1 antlr.Token
@@ -756,6 +1363,10 @@ func (s *RangeContext) Identifier() antlr.TerminalNode {
 	return s.GetToken(PlanParserIdentifier, 0)
 }
 
+func (s *RangeContext) JSONIdentifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserJSONIdentifier, 0)
+}
+
 func (s *RangeContext) AllLT() []antlr.TerminalNode {
 	return s.GetTokens(PlanParserLT)
 }
@@ -819,189 +1430,34 @@ func (s *UnaryContext) ADD() antlr.TerminalNode {
 	return s.GetToken(PlanParserADD, 0)
 }
 
-func (s *UnaryContext) SUB() antlr.TerminalNode {
-	return s.GetToken(PlanParserSUB, 0)
-}
-
-func (s *UnaryContext) BNOT() antlr.TerminalNode {
-	return s.GetToken(PlanParserBNOT, 0)
-}
-
-func (s *UnaryContext) NOT() antlr.TerminalNode {
-	return s.GetToken(PlanParserNOT, 0)
-}
-
-func (s *UnaryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case PlanVisitor:
-		return t.VisitUnary(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-type LogicalOrContext struct {
-	*ExprContext
-}
-
-func NewLogicalOrContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LogicalOrContext {
-	var p = new(LogicalOrContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
-
-	return p
-}
-
-func (s *LogicalOrContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *LogicalOrContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExprContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *LogicalOrContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExprContext)
-}
-
-func (s *LogicalOrContext) OR() antlr.TerminalNode {
-	return s.GetToken(PlanParserOR, 0)
-}
-
-func (s *LogicalOrContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case PlanVisitor:
-		return t.VisitLogicalOr(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-type IntegerContext struct {
-	*ExprContext
-}
-
-func NewIntegerContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IntegerContext {
-	var p = new(IntegerContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
-
-	return p
-}
-
-func (s *IntegerContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *IntegerContext) IntegerConstant() antlr.TerminalNode {
-	return s.GetToken(PlanParserIntegerConstant, 0)
-}
-
-func (s *IntegerContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case PlanVisitor:
-		return t.VisitInteger(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-type MulDivModContext struct {
-	*ExprContext
-	op antlr.Token
-}
-
-func NewMulDivModContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MulDivModContext {
-	var p = new(MulDivModContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
-
-	return p
-}
-
-func (s *MulDivModContext) GetOp() antlr.Token { return s.op }
-
-func (s *MulDivModContext) SetOp(v antlr.Token) { s.op = v }
-
-func (s *MulDivModContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *MulDivModContext) AllExpr() []IExprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
-	var tst = make([]IExprContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExprContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *MulDivModContext) Expr(i int) IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExprContext)
-}
-
-func (s *MulDivModContext) MUL() antlr.TerminalNode {
-	return s.GetToken(PlanParserMUL, 0)
+func (s *UnaryContext) SUB() antlr.TerminalNode {
+	return s.GetToken(PlanParserSUB, 0)
 }
 
-func (s *MulDivModContext) DIV() antlr.TerminalNode {
-	return s.GetToken(PlanParserDIV, 0)
+func (s *UnaryContext) BNOT() antlr.TerminalNode {
+	return s.GetToken(PlanParserBNOT, 0)
 }
 
-func (s *MulDivModContext) MOD() antlr.TerminalNode {
-	return s.GetToken(PlanParserMOD, 0)
+func (s *UnaryContext) NOT() antlr.TerminalNode {
+	return s.GetToken(PlanParserNOT, 0)
 }
 
-func (s *MulDivModContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *UnaryContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case PlanVisitor:
-		return t.VisitMulDivMod(s)
+		return t.VisitUnary(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type IdentifierContext struct {
+type IntegerContext struct {
 	*ExprContext
 }
 
-func NewIdentifierContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IdentifierContext {
-	var p = new(IdentifierContext)
+func NewIntegerContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IntegerContext {
+	var p = new(IntegerContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1010,30 +1466,30 @@ func NewIdentifierContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Ide
 	return p
 }
 
-func (s *IdentifierContext) GetRuleContext() antlr.RuleContext {
+func (s *IntegerContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IdentifierContext) Identifier() antlr.TerminalNode {
-	return s.GetToken(PlanParserIdentifier, 0)
+func (s *IntegerContext) IntegerConstant() antlr.TerminalNode {
+	return s.GetToken(PlanParserIntegerConstant, 0)
 }
 
-func (s *IdentifierContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IntegerContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case PlanVisitor:
-		return t.VisitIdentifier(s)
+		return t.VisitInteger(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type BitXorContext struct {
+type ArrayContext struct {
 	*ExprContext
 }
 
-func NewBitXorContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitXorContext {
-	var p = new(BitXorContext)
+func NewArrayContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ArrayContext {
+	var p = new(ArrayContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1042,11 +1498,11 @@ func NewBitXorContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitXorC
 	return p
 }
 
-func (s *BitXorContext) GetRuleContext() antlr.RuleContext {
+func (s *ArrayContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BitXorContext) AllExpr() []IExprContext {
+func (s *ArrayContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
 
@@ -1059,7 +1515,7 @@ func (s *BitXorContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *BitXorContext) Expr(i int) IExprContext {
+func (s *ArrayContext) Expr(i int) IExprContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -1069,72 +1525,22 @@ func (s *BitXorContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *BitXorContext) BXOR() antlr.TerminalNode {
-	return s.GetToken(PlanParserBXOR, 0)
-}
-
-func (s *BitXorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case PlanVisitor:
-		return t.VisitBitXor(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-type LikeContext struct {
-	*ExprContext
-}
-
-func NewLikeContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LikeContext {
-	var p = new(LikeContext)
-
-	p.ExprContext = NewEmptyExprContext()
-	p.parser = parser
-	p.CopyFrom(ctx.(*ExprContext))
-
-	return p
-}
-
-func (s *LikeContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *LikeContext) Expr() IExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExprContext)
-}
-
-func (s *LikeContext) LIKE() antlr.TerminalNode {
-	return s.GetToken(PlanParserLIKE, 0)
-}
-
-func (s *LikeContext) StringLiteral() antlr.TerminalNode {
-	return s.GetToken(PlanParserStringLiteral, 0)
-}
-
-func (s *LikeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArrayContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case PlanVisitor:
-		return t.VisitLike(s)
+		return t.VisitArray(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type BitAndContext struct {
+type BitXorContext struct {
 	*ExprContext
 }
 
-func NewBitAndContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitAndContext {
-	var p = new(BitAndContext)
+func NewBitXorContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitXorContext {
+	var p = new(BitXorContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1143,11 +1549,11 @@ func NewBitAndContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitAndC
 	return p
 }
 
-func (s *BitAndContext) GetRuleContext() antlr.RuleContext {
+func (s *BitXorContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BitAndContext) AllExpr() []IExprContext {
+func (s *BitXorContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
 
@@ -1160,7 +1566,7 @@ func (s *BitAndContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *BitAndContext) Expr(i int) IExprContext {
+func (s *BitXorContext) Expr(i int) IExprContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -1170,26 +1576,26 @@ func (s *BitAndContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *BitAndContext) BAND() antlr.TerminalNode {
-	return s.GetToken(PlanParserBAND, 0)
+func (s *BitXorContext) BXOR() antlr.TerminalNode {
+	return s.GetToken(PlanParserBXOR, 0)
 }
 
-func (s *BitAndContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BitXorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case PlanVisitor:
-		return t.VisitBitAnd(s)
+		return t.VisitBitXor(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type LogicalAndContext struct {
+type BitAndContext struct {
 	*ExprContext
 }
 
-func NewLogicalAndContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LogicalAndContext {
-	var p = new(LogicalAndContext)
+func NewBitAndContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BitAndContext {
+	var p = new(BitAndContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1198,11 +1604,11 @@ func NewLogicalAndContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Log
 	return p
 }
 
-func (s *LogicalAndContext) GetRuleContext() antlr.RuleContext {
+func (s *BitAndContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LogicalAndContext) AllExpr() []IExprContext {
+func (s *BitAndContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
 
@@ -1215,7 +1621,7 @@ func (s *LogicalAndContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *LogicalAndContext) Expr(i int) IExprContext {
+func (s *BitAndContext) Expr(i int) IExprContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -1225,14 +1631,14 @@ func (s *LogicalAndContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *LogicalAndContext) AND() antlr.TerminalNode {
-	return s.GetToken(PlanParserAND, 0)
+func (s *BitAndContext) BAND() antlr.TerminalNode {
+	return s.GetToken(PlanParserBAND, 0)
 }
 
-func (s *LogicalAndContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BitAndContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case PlanVisitor:
-		return t.VisitLogicalAnd(s)
+		return t.VisitBitAnd(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -1294,13 +1700,12 @@ func (s *EmptyTermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	}
 }
 
-type EqualityContext struct {
+type ArrayContainsAnyContext struct {
 	*ExprContext
-	op antlr.Token
 }
 
-func NewEqualityContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EqualityContext {
-	var p = new(EqualityContext)
+func NewArrayContainsAnyContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ArrayContainsAnyContext {
+	var p = new(ArrayContainsAnyContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1309,15 +1714,15 @@ func NewEqualityContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Equal
 	return p
 }
 
-func (s *EqualityContext) GetOp() antlr.Token { return s.op }
-
-func (s *EqualityContext) SetOp(v antlr.Token) { s.op = v }
-
-func (s *EqualityContext) GetRuleContext() antlr.RuleContext {
+func (s *ArrayContainsAnyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *EqualityContext) AllExpr() []IExprContext {
+func (s *ArrayContainsAnyContext) ArrayContainsAny() antlr.TerminalNode {
+	return s.GetToken(PlanParserArrayContainsAny, 0)
+}
+
+func (s *ArrayContainsAnyContext) AllExpr() []IExprContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprContext)(nil)).Elem())
 	var tst = make([]IExprContext, len(ts))
 
@@ -1330,7 +1735,7 @@ func (s *EqualityContext) AllExpr() []IExprContext {
 	return tst
 }
 
-func (s *EqualityContext) Expr(i int) IExprContext {
+func (s *ArrayContainsAnyContext) Expr(i int) IExprContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprContext)(nil)).Elem(), i)
 
 	if t == nil {
@@ -1340,30 +1745,22 @@ func (s *EqualityContext) Expr(i int) IExprContext {
 	return t.(IExprContext)
 }
 
-func (s *EqualityContext) EQ() antlr.TerminalNode {
-	return s.GetToken(PlanParserEQ, 0)
-}
-
-func (s *EqualityContext) NE() antlr.TerminalNode {
-	return s.GetToken(PlanParserNE, 0)
-}
-
-func (s *EqualityContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ArrayContainsAnyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case PlanVisitor:
-		return t.VisitEquality(s)
+		return t.VisitArrayContainsAny(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-type BooleanContext struct {
+type IsNullContext struct {
 	*ExprContext
 }
 
-func NewBooleanContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BooleanContext {
-	var p = new(BooleanContext)
+func NewIsNullContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IsNullContext {
+	var p = new(IsNullContext)
 
 	p.ExprContext = NewEmptyExprContext()
 	p.parser = parser
@@ -1372,18 +1769,26 @@ func NewBooleanContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *Boolea
 	return p
 }
 
-func (s *BooleanContext) GetRuleContext() antlr.RuleContext {
+func (s *IsNullContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BooleanContext) BooleanConstant() antlr.TerminalNode {
-	return s.GetToken(PlanParserBooleanConstant, 0)
+func (s *IsNullContext) ISNULL() antlr.TerminalNode {
+	return s.GetToken(PlanParserISNULL, 0)
 }
 
-func (s *BooleanContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *IsNullContext) Identifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserIdentifier, 0)
+}
+
+func (s *IsNullContext) JSONIdentifier() antlr.TerminalNode {
+	return s.GetToken(PlanParserJSONIdentifier, 0)
+}
+
+func (s *IsNullContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case PlanVisitor:
-		return t.VisitBoolean(s)
+		return t.VisitIsNull(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -1459,126 +1864,335 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 	p.EnterRecursionRule(localctx, 0, PlanParserRULE_expr, _p)
 	var _la int
 
-	defer func() {
-		p.UnrollRecursionContexts(_parentctx)
-	}()
+	defer func() {
+		p.UnrollRecursionContexts(_parentctx)
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(58)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewIntegerContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+
+		{
+			p.SetState(3)
+			p.Match(PlanParserIntegerConstant)
+		}
+
+	case 2:
+		localctx = NewFloatingContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(4)
+			p.Match(PlanParserFloatingConstant)
+		}
+
+	case 3:
+		localctx = NewBooleanContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(5)
+			p.Match(PlanParserBooleanConstant)
+		}
+
+	case 4:
+		localctx = NewStringContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(6)
+			p.Match(PlanParserStringLiteral)
+		}
+
+	case 5:
+		localctx = NewIdentifierContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(7)
+			p.Match(PlanParserIdentifier)
+		}
+
+	case 6:
+		localctx = NewJSONIdentifierContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(8)
+			p.Match(PlanParserJSONIdentifier)
+		}
+
+	case 7:
+		localctx = NewParensContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(9)
+			p.Match(PlanParserT__0)
+		}
+		{
+			p.SetState(10)
+			p.expr(0)
+		}
+		{
+			p.SetState(11)
+			p.Match(PlanParserT__1)
+		}
+
+	case 8:
+		localctx = NewArrayContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(13)
+			p.Match(PlanParserT__2)
+		}
+		{
+			p.SetState(14)
+			p.expr(0)
+		}
+		p.SetState(19)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext())
+
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(15)
+					p.Match(PlanParserT__3)
+				}
+				{
+					p.SetState(16)
+					p.expr(0)
+				}
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
 			}
+			p.SetState(21)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext())
 		}
-	}()
+		p.SetState(23)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-	var _alt int
+		if _la == PlanParserT__3 {
+			{
+				p.SetState(22)
+				p.Match(PlanParserT__3)
+			}
 
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(14)
-	p.GetErrorHandler().Sync(p)
+		}
+		{
+			p.SetState(25)
+			p.Match(PlanParserT__4)
+		}
 
-	switch p.GetTokenStream().LA(1) {
-	case PlanParserIntegerConstant:
-		localctx = NewIntegerContext(p, localctx)
+	case 9:
+		localctx = NewUnaryContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
+		{
+			p.SetState(27)
+
+			var _lt = p.GetTokenStream().LT(1)
+
+			localctx.(*UnaryContext).op = _lt
+
+			_la = p.GetTokenStream().LA(1)
+
+			if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<PlanParserADD)|(1<<PlanParserSUB)|(1<<PlanParserBNOT)|(1<<PlanParserNOT))) != 0) {
+				var _ri = p.GetErrorHandler().RecoverInline(p)
 
+				localctx.(*UnaryContext).op = _ri
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
 		{
-			p.SetState(3)
-			p.Match(PlanParserIntegerConstant)
+			p.SetState(28)
+			p.expr(21)
 		}
 
-	case PlanParserFloatingConstant:
-		localctx = NewFloatingContext(p, localctx)
+	case 10:
+		localctx = NewArrayContainsContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(4)
-			p.Match(PlanParserFloatingConstant)
+			p.SetState(29)
+			p.Match(PlanParserArrayContains)
 		}
-
-	case PlanParserBooleanConstant:
-		localctx = NewBooleanContext(p, localctx)
-		p.SetParserRuleContext(localctx)
-		_prevctx = localctx
 		{
-			p.SetState(5)
-			p.Match(PlanParserBooleanConstant)
+			p.SetState(30)
+			p.Match(PlanParserT__0)
+		}
+		{
+			p.SetState(31)
+			p.expr(0)
+		}
+		{
+			p.SetState(32)
+			p.Match(PlanParserT__3)
+		}
+		{
+			p.SetState(33)
+			p.expr(0)
+		}
+		{
+			p.SetState(34)
+			p.Match(PlanParserT__1)
 		}
 
-	case PlanParserStringLiteral:
-		localctx = NewStringContext(p, localctx)
+	case 11:
+		localctx = NewArrayContainsAllContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(6)
-			p.Match(PlanParserStringLiteral)
+			p.SetState(36)
+			p.Match(PlanParserArrayContainsAll)
+		}
+		{
+			p.SetState(37)
+			p.Match(PlanParserT__0)
+		}
+		{
+			p.SetState(38)
+			p.expr(0)
+		}
+		{
+			p.SetState(39)
+			p.Match(PlanParserT__3)
+		}
+		{
+			p.SetState(40)
+			p.expr(0)
+		}
+		{
+			p.SetState(41)
+			p.Match(PlanParserT__1)
 		}
 
-	case PlanParserIdentifier:
-		localctx = NewIdentifierContext(p, localctx)
+	case 12:
+		localctx = NewArrayContainsAnyContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(7)
-			p.Match(PlanParserIdentifier)
+			p.SetState(43)
+			p.Match(PlanParserArrayContainsAny)
+		}
+		{
+			p.SetState(44)
+			p.Match(PlanParserT__0)
+		}
+		{
+			p.SetState(45)
+			p.expr(0)
+		}
+		{
+			p.SetState(46)
+			p.Match(PlanParserT__3)
+		}
+		{
+			p.SetState(47)
+			p.expr(0)
+		}
+		{
+			p.SetState(48)
+			p.Match(PlanParserT__1)
 		}
 
-	case PlanParserT__0:
-		localctx = NewParensContext(p, localctx)
+	case 13:
+		localctx = NewArrayLengthContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(8)
+			p.SetState(50)
+			p.Match(PlanParserArrayLength)
+		}
+		{
+			p.SetState(51)
 			p.Match(PlanParserT__0)
 		}
 		{
-			p.SetState(9)
-			p.expr(0)
+			p.SetState(52)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
 		}
 		{
-			p.SetState(10)
+			p.SetState(53)
 			p.Match(PlanParserT__1)
 		}
 
-	case PlanParserADD, PlanParserSUB, PlanParserBNOT, PlanParserNOT:
-		localctx = NewUnaryContext(p, localctx)
+	case 14:
+		localctx = NewIsNullContext(p, localctx)
 		p.SetParserRuleContext(localctx)
 		_prevctx = localctx
 		{
-			p.SetState(12)
-
-			var _lt = p.GetTokenStream().LT(1)
+			p.SetState(54)
+			_la = p.GetTokenStream().LA(1)
 
-			localctx.(*UnaryContext).op = _lt
+			if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(55)
+			p.Match(PlanParserISNULL)
+		}
 
+	case 15:
+		localctx = NewIsNotNullContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(56)
 			_la = p.GetTokenStream().LA(1)
 
-			if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<PlanParserADD)|(1<<PlanParserSUB)|(1<<PlanParserBNOT)|(1<<PlanParserNOT))) != 0) {
-				var _ri = p.GetErrorHandler().RecoverInline(p)
-
-				localctx.(*UnaryContext).op = _ri
+			if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
+				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
 				p.Consume()
 			}
 		}
 		{
-			p.SetState(13)
-			p.expr(15)
+			p.SetState(57)
+			p.Match(PlanParserISNOTNULL)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(83)
+	p.SetState(127)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -1586,36 +2200,36 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(81)
+			p.SetState(125)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewPowerContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(16)
+				p.SetState(60)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 22)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 22)", ""))
 				}
 				{
-					p.SetState(17)
+					p.SetState(61)
 					p.Match(PlanParserPOW)
 				}
 				{
-					p.SetState(18)
-					p.expr(17)
+					p.SetState(62)
+					p.expr(23)
 				}
 
 			case 2:
 				localctx = NewMulDivModContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(19)
+				p.SetState(63)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 14)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 14)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 20)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 20)", ""))
 				}
 				{
-					p.SetState(20)
+					p.SetState(64)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1633,20 +2247,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(21)
-					p.expr(15)
+					p.SetState(65)
+					p.expr(21)
 				}
 
 			case 3:
 				localctx = NewAddSubContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(22)
+				p.SetState(66)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 13)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 13)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 19)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 19)", ""))
 				}
 				{
-					p.SetState(23)
+					p.SetState(67)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1664,20 +2278,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(24)
-					p.expr(14)
+					p.SetState(68)
+					p.expr(20)
 				}
 
 			case 4:
 				localctx = NewShiftContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(25)
+				p.SetState(69)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 12)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 12)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 18)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 18)", ""))
 				}
 				{
-					p.SetState(26)
+					p.SetState(70)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1695,20 +2309,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(27)
-					p.expr(13)
+					p.SetState(71)
+					p.expr(19)
 				}
 
 			case 5:
 				localctx = NewRangeContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(28)
+				p.SetState(72)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
 				}
 				{
-					p.SetState(29)
+					p.SetState(73)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1726,11 +2340,18 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(30)
-					p.Match(PlanParserIdentifier)
+					p.SetState(74)
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
 				}
 				{
-					p.SetState(31)
+					p.SetState(75)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1748,20 +2369,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(32)
-					p.expr(10)
+					p.SetState(76)
+					p.expr(12)
 				}
 
 			case 6:
 				localctx = NewReverseRangeContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(33)
+				p.SetState(77)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
 				}
 				{
-					p.SetState(34)
+					p.SetState(78)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1779,11 +2400,18 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(35)
-					p.Match(PlanParserIdentifier)
+					p.SetState(79)
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == PlanParserIdentifier || _la == PlanParserJSONIdentifier) {
+						p.GetErrorHandler().RecoverInline(p)
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
 				}
 				{
-					p.SetState(36)
+					p.SetState(80)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1801,20 +2429,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(37)
-					p.expr(9)
+					p.SetState(81)
+					p.expr(11)
 				}
 
 			case 7:
 				localctx = NewRelationalContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(38)
+				p.SetState(82)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 9)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 9)", ""))
 				}
 				{
-					p.SetState(39)
+					p.SetState(83)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1832,20 +2460,20 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(40)
-					p.expr(8)
+					p.SetState(84)
+					p.expr(10)
 				}
 
 			case 8:
 				localctx = NewEqualityContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(41)
+				p.SetState(85)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 				}
 				{
-					p.SetState(42)
+					p.SetState(86)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1863,122 +2491,122 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(43)
-					p.expr(7)
+					p.SetState(87)
+					p.expr(9)
 				}
 
 			case 9:
 				localctx = NewBitAndContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(44)
+				p.SetState(88)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 				}
 				{
-					p.SetState(45)
+					p.SetState(89)
 					p.Match(PlanParserBAND)
 				}
 				{
-					p.SetState(46)
-					p.expr(6)
+					p.SetState(90)
+					p.expr(8)
 				}
 
 			case 10:
 				localctx = NewBitXorContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(47)
+				p.SetState(91)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
 				{
-					p.SetState(48)
+					p.SetState(92)
 					p.Match(PlanParserBXOR)
 				}
 				{
-					p.SetState(49)
-					p.expr(5)
+					p.SetState(93)
+					p.expr(7)
 				}
 
 			case 11:
 				localctx = NewBitOrContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(50)
+				p.SetState(94)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
 				{
-					p.SetState(51)
+					p.SetState(95)
 					p.Match(PlanParserBOR)
 				}
 				{
-					p.SetState(52)
-					p.expr(4)
+					p.SetState(96)
+					p.expr(6)
 				}
 
 			case 12:
 				localctx = NewLogicalAndContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(53)
+				p.SetState(97)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 				}
 				{
-					p.SetState(54)
+					p.SetState(98)
 					p.Match(PlanParserAND)
 				}
 				{
-					p.SetState(55)
-					p.expr(3)
+					p.SetState(99)
+					p.expr(5)
 				}
 
 			case 13:
 				localctx = NewLogicalOrContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(56)
+				p.SetState(100)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 				}
 				{
-					p.SetState(57)
+					p.SetState(101)
 					p.Match(PlanParserOR)
 				}
 				{
-					p.SetState(58)
-					p.expr(2)
+					p.SetState(102)
+					p.expr(4)
 				}
 
 			case 14:
 				localctx = NewLikeContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(59)
+				p.SetState(103)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 23)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 23)", ""))
 				}
 				{
-					p.SetState(60)
+					p.SetState(104)
 					p.Match(PlanParserLIKE)
 				}
 				{
-					p.SetState(61)
+					p.SetState(105)
 					p.Match(PlanParserStringLiteral)
 				}
 
 			case 15:
 				localctx = NewTermContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(62)
+				p.SetState(106)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 11)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 11)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 17)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 17)", ""))
 				}
 				{
-					p.SetState(63)
+					p.SetState(107)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -1997,59 +2625,59 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 				}
 
 				{
-					p.SetState(64)
+					p.SetState(108)
 					p.Match(PlanParserT__2)
 				}
 				{
-					p.SetState(65)
+					p.SetState(109)
 					p.expr(0)
 				}
-				p.SetState(70)
+				p.SetState(114)
 				p.GetErrorHandler().Sync(p)
-				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext())
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
 
 				for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 					if _alt == 1 {
 						{
-							p.SetState(66)
+							p.SetState(110)
 							p.Match(PlanParserT__3)
 						}
 						{
-							p.SetState(67)
+							p.SetState(111)
 							p.expr(0)
 						}
 
 					}
-					p.SetState(72)
+					p.SetState(116)
 					p.GetErrorHandler().Sync(p)
-					_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext())
+					_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
 				}
-				p.SetState(74)
+				p.SetState(118)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == PlanParserT__3 {
 					{
-						p.SetState(73)
+						p.SetState(117)
 						p.Match(PlanParserT__3)
 					}
 
 				}
 				{
-					p.SetState(76)
+					p.SetState(120)
 					p.Match(PlanParserT__4)
 				}
 
 			case 16:
 				localctx = NewEmptyTermContext(p, NewExprContext(p, _parentctx, _parentState))
 				p.PushNewRecursionContext(localctx, _startState, PlanParserRULE_expr)
-				p.SetState(78)
+				p.SetState(122)
 
-				if !(p.Precpred(p.GetParserRuleContext(), 10)) {
-					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 10)", ""))
+				if !(p.Precpred(p.GetParserRuleContext(), 16)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 16)", ""))
 				}
 				{
-					p.SetState(79)
+					p.SetState(123)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -2067,16 +2695,16 @@ func (p *PlanParser) expr(_p int) (localctx IExprContext) {
 					}
 				}
 				{
-					p.SetState(80)
+					p.SetState(124)
 					p.Match(PlanParserEmptyTerm)
 				}
 
 			}
 
 		}
-		p.SetState(85)
+		p.SetState(129)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -2099,52 +2727,52 @@ func (p *PlanParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex in
 func (p *PlanParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
-		return p.Precpred(p.GetParserRuleContext(), 16)
+		return p.Precpred(p.GetParserRuleContext(), 22)
 
 	case 1:
-		return p.Precpred(p.GetParserRuleContext(), 14)
+		return p.Precpred(p.GetParserRuleContext(), 20)
 
 	case 2:
-		return p.Precpred(p.GetParserRuleContext(), 13)
+		return p.Precpred(p.GetParserRuleContext(), 19)
 
 	case 3:
-		return p.Precpred(p.GetParserRuleContext(), 12)
+		return p.Precpred(p.GetParserRuleContext(), 18)
 
 	case 4:
-		return p.Precpred(p.GetParserRuleContext(), 9)
+		return p.Precpred(p.GetParserRuleContext(), 11)
 
 	case 5:
-		return p.Precpred(p.GetParserRuleContext(), 8)
+		return p.Precpred(p.GetParserRuleContext(), 10)
 
 	case 6:
-		return p.Precpred(p.GetParserRuleContext(), 7)
+		return p.Precpred(p.GetParserRuleContext(), 9)
 
 	case 7:
-		return p.Precpred(p.GetParserRuleContext(), 6)
+		return p.Precpred(p.GetParserRuleContext(), 8)
 
 	case 8:
-		return p.Precpred(p.GetParserRuleContext(), 5)
+		return p.Precpred(p.GetParserRuleContext(), 7)
 
 	case 9:
-		return p.Precpred(p.GetParserRuleContext(), 4)
+		return p.Precpred(p.GetParserRuleContext(), 6)
 
 	case 10:
-		return p.Precpred(p.GetParserRuleContext(), 3)
+		return p.Precpred(p.GetParserRuleContext(), 5)
 
 	case 11:
-		return p.Precpred(p.GetParserRuleContext(), 2)
+		return p.Precpred(p.GetParserRuleContext(), 4)
 
 	case 12:
-		return p.Precpred(p.GetParserRuleContext(), 1)
+		return p.Precpred(p.GetParserRuleContext(), 3)
 
 	case 13:
-		return p.Precpred(p.GetParserRuleContext(), 17)
+		return p.Precpred(p.GetParserRuleContext(), 23)
 
 	case 14:
-		return p.Precpred(p.GetParserRuleContext(), 11)
+		return p.Precpred(p.GetParserRuleContext(), 17)
 
 	case 15:
-		return p.Precpred(p.GetParserRuleContext(), 10)
+		return p.Precpred(p.GetParserRuleContext(), 16)
 
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
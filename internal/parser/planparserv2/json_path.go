@@ -0,0 +1,56 @@
+package planparserv2
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// skipStringLiteral returns the position right after the string literal starting at i.
+func skipStringLiteral(exprStr string, i int) int {
+	for j := i + 1; j < len(exprStr); j++ {
+		switch exprStr[j] {
+		case '\\':
+			j++
+		case '"':
+			return j + 1
+		}
+	}
+	return len(exprStr)
+}
+
+// parseJSONIdentifier splits the JSON identifier like meta["a"][0] into the field name and the keys ["a", "0"].
+func parseJSONIdentifier(identifier string) (string, []string, error) {
+	i := strings.IndexByte(identifier, '[')
+	if i < 0 {
+		return identifier, nil, nil
+	}
+	fieldName := identifier[:i]
+	var keys []string
+	for i < len(identifier) && identifier[i] == '[' {
+		j := i + 1
+		var key string
+		if c := identifier[j]; c >= '0' && c <= '9' {
+			k := j
+			for k < len(identifier) && identifier[k] >= '0' && identifier[k] <= '9' {
+				k++
+			}
+			key, j = identifier[j:k], k
+		} else {
+			// skip the encoding prefix of the string literal, such as u8"key"
+			q := j + strings.IndexByte(identifier[j:], '"')
+			k := skipStringLiteral(identifier, q)
+			unquoted, err := strconv.Unquote(identifier[q:k])
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid key of JSON field: %s", identifier[j:k])
+			}
+			key, j = unquoted, k
+		}
+		if j >= len(identifier) || identifier[j] != ']' {
+			return "", nil, fmt.Errorf("invalid JSON identifier: %s", identifier)
+		}
+		keys = append(keys, key)
+		i = j + 1
+	}
+	return fieldName, keys, nil
+}
@@ -0,0 +1,57 @@
+package planparserv2
+
+import (
+	"fmt"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	parser "github.com/milvus-io/milvus/internal/parser/planparserv2/generated"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// translateNullCheck translates a null check of the field to the expression evaluated by segcore,
+// a nested path of json field is null if it's missing in the document, so the json field needn't be nullable.
+func (v *ParserVisitor) translateNullCheck(identifier antlr.TerminalNode, op planpb.NullExpr_NullOp) interface{} {
+	column, err := v.translateIdentifier(identifier.GetText())
+	if err != nil {
+		return err
+	}
+	columnInfo := toColumnInfo(column)
+	field, err := v.schema.GetFieldFromID(columnInfo.GetFieldId())
+	if err != nil {
+		return err
+	}
+	if len(columnInfo.GetNestedPath()) == 0 && !typeutil.IsFieldNullable(field) {
+		return fmt.Errorf("field %s is not nullable", field.GetName())
+	}
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_NullExpr{
+				NullExpr: &planpb.NullExpr{
+					ColumnInfo: columnInfo,
+					Op:         op,
+				},
+			},
+		},
+		dataType: schemapb.DataType_Bool,
+	}
+}
+
+// VisitIsNull translates expr to null plan.
+func (v *ParserVisitor) VisitIsNull(ctx *parser.IsNullContext) interface{} {
+	identifier := ctx.Identifier()
+	if identifier == nil {
+		identifier = ctx.JSONIdentifier()
+	}
+	return v.translateNullCheck(identifier, planpb.NullExpr_IsNull)
+}
+
+// VisitIsNotNull translates expr to null plan.
+func (v *ParserVisitor) VisitIsNotNull(ctx *parser.IsNotNullContext) interface{} {
+	identifier := ctx.Identifier()
+	if identifier == nil {
+		identifier = ctx.JSONIdentifier()
+	}
+	return v.translateNullCheck(identifier, planpb.NullExpr_IsNotNull)
+}
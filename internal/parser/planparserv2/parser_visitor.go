@@ -25,10 +25,20 @@ func (v *ParserVisitor) VisitParens(ctx *parser.ParensContext) interface{} {
 }
 
 func (v *ParserVisitor) translateIdentifier(identifier string) (*ExprWithType, error) {
-	field, err := v.schema.GetFieldFromName(identifier)
+	fieldName, nestedPath, err := parseJSONIdentifier(identifier)
 	if err != nil {
 		return nil, err
 	}
+	field, err := v.schema.GetFieldFromName(fieldName)
+	if err != nil {
+		return nil, err
+	}
+	if len(nestedPath) > 0 && !typeutil.IsJSONType(field.DataType) {
+		return nil, fmt.Errorf("field %s is not a JSON field, cannot be accessed by key", fieldName)
+	}
+	if typeutil.IsJSONType(field.DataType) && len(nestedPath) == 0 {
+		return nil, fmt.Errorf("JSON field %s should be accessed by key, such as %s[\"key\"]", fieldName, fieldName)
+	}
 	return &ExprWithType{
 		expr: &planpb.Expr{
 			Expr: &planpb.Expr_ColumnExpr{
@@ -38,6 +48,7 @@ func (v *ParserVisitor) translateIdentifier(identifier string) (*ExprWithType, e
 						DataType:     field.DataType,
 						IsPrimaryKey: field.IsPrimaryKey,
 						IsAutoID:     field.AutoID,
+						NestedPath:   nestedPath,
 					},
 				},
 			},
@@ -56,6 +67,15 @@ func (v *ParserVisitor) VisitIdentifier(ctx *parser.IdentifierContext) interface
 	return expr
 }
 
+// VisitJSONIdentifier translates expr to column plan of the JSON field with the nested path.
+func (v *ParserVisitor) VisitJSONIdentifier(ctx *parser.JSONIdentifierContext) interface{} {
+	expr, err := v.translateIdentifier(ctx.JSONIdentifier().GetText())
+	if err != nil {
+		return err
+	}
+	return expr
+}
+
 // VisitBoolean translates expr to GenericValue.
 func (v *ParserVisitor) VisitBoolean(ctx *parser.BooleanContext) interface{} {
 	literal := ctx.BooleanConstant().GetText()
@@ -395,7 +415,7 @@ func (v *ParserVisitor) VisitLike(ctx *parser.LikeContext) interface{} {
 		return fmt.Errorf("the left operand of like is invalid")
 	}
 
-	if !typeutil.IsStringType(leftExpr.dataType) {
+	if !typeutil.IsStringType(leftExpr.dataType) && !typeutil.IsJSONType(leftExpr.dataType) {
 		return fmt.Errorf("like operation on non-string field is unsupported")
 	}
 
@@ -533,8 +553,11 @@ func (v *ParserVisitor) VisitEmptyTerm(ctx *parser.EmptyTermContext) interface{}
 
 // VisitRange translates expr to range plan.
 func (v *ParserVisitor) VisitRange(ctx *parser.RangeContext) interface{} {
-	identifier := ctx.Identifier().GetText()
-	childExpr, err := v.translateIdentifier(identifier)
+	identifier := ctx.Identifier()
+	if identifier == nil {
+		identifier = ctx.JSONIdentifier()
+	}
+	childExpr, err := v.translateIdentifier(identifier.GetText())
 	if err != nil {
 		return err
 	}
@@ -583,6 +606,10 @@ func (v *ParserVisitor) VisitRange(ctx *parser.RangeContext) interface{} {
 		if IsInteger(upperValue) {
 			upperValue = NewFloat(float64(upperValue.GetInt64Val()))
 		}
+	case typeutil.DataTypeJSON:
+		if !(IsString(lowerValue) && IsString(upperValue)) && !(IsNumber(lowerValue) && IsNumber(upperValue)) {
+			return fmt.Errorf("invalid range operations")
+		}
 	}
 
 	lowerInclusive := ctx.GetOp1().GetTokenType() == parser.PlanParserLE
@@ -617,8 +644,11 @@ func (v *ParserVisitor) VisitRange(ctx *parser.RangeContext) interface{} {
 
 // VisitReverseRange parses the expression like "1 > a > 0".
 func (v *ParserVisitor) VisitReverseRange(ctx *parser.ReverseRangeContext) interface{} {
-	identifier := ctx.Identifier().GetText()
-	childExpr, err := v.translateIdentifier(identifier)
+	identifier := ctx.Identifier()
+	if identifier == nil {
+		identifier = ctx.JSONIdentifier()
+	}
+	childExpr, err := v.translateIdentifier(identifier.GetText())
 	if err != nil {
 		return err
 	}
@@ -667,6 +697,10 @@ func (v *ParserVisitor) VisitReverseRange(ctx *parser.ReverseRangeContext) inter
 		if IsInteger(upperValue) {
 			upperValue = NewFloat(float64(upperValue.GetInt64Val()))
 		}
+	case typeutil.DataTypeJSON:
+		if !(IsString(lowerValue) && IsString(upperValue)) && !(IsNumber(lowerValue) && IsNumber(upperValue)) {
+			return fmt.Errorf("invalid range operations")
+		}
 	}
 
 	lowerInclusive := ctx.GetOp2().GetTokenType() == parser.PlanParserGE
@@ -8,7 +8,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -325,6 +327,175 @@ func TestExpr_Combinations(t *testing.T) {
 	}
 }
 
+func TestExpr_JSON(t *testing.T) {
+	schema := newTestSchema()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID: 1000, Name: "meta", DataType: typeutil.DataTypeJSON,
+	})
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	exprStrs := []string{
+		`meta["color"] == "red"`,
+		`meta["price"] > 10`,
+		`meta["price"] >= 10.5 && meta["color"] != "blue"`,
+		`meta["a"]["b"][0] == 1`,
+		`meta["a\"b"] == true`,
+		`meta["color"] in ["red", "blue"]`,
+		`meta["color"] like "re%"`,
+		`1 < meta["price"] < 10`,
+		`"a" <= meta["color"] <= "z"`,
+		`meta["price"] > 10 and VarCharField in ["meta[\"a\"]"]`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	expr, err := ParseExpr(helper, `meta["a"]["b"][0] == 1`)
+	assert.NoError(t, err)
+	columnInfo := expr.GetUnaryRangeExpr().GetColumnInfo()
+	assert.Equal(t, int64(1000), columnInfo.GetFieldId())
+	assert.Equal(t, typeutil.DataTypeJSON, columnInfo.GetDataType())
+	assert.Equal(t, []string{"a", "b", "0"}, columnInfo.GetNestedPath())
+
+	expr, err = ParseExpr(helper, `meta["a\"b"] == true`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`a"b`}, expr.GetUnaryRangeExpr().GetColumnInfo().GetNestedPath())
+
+	expr, err = ParseExpr(helper, `VarCharField in ["meta[\"a\"]"]`)
+	assert.NoError(t, err)
+	assert.Equal(t, `meta["a"]`, expr.GetTermExpr().GetValues()[0].GetStringVal())
+
+	invalidExprs := []string{
+		`meta == "red"`,
+		`meta > 10`,
+		`meta[ "a" ] == true`,
+		`meta[a] == 1`,
+		`Int64Field["a"] == 1`,
+		`not_exist["a"] == 1`,
+		`meta["a"] == meta["b"]`,
+		`1 < meta["price"] < "z"`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
+func TestExpr_Array(t *testing.T) {
+	schema := newTestSchema()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID: 1001, Name: "tags", DataType: typeutil.DataTypeArray,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: common.ElementTypeKey, Value: schemapb.DataType_Int64.String()},
+			{Key: common.MaxCapacityKey, Value: "16"},
+		},
+	})
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	exprStrs := []string{
+		`array_contains(tags, 1)`,
+		`array_contains (tags, -1)`,
+		`array_contains_all(tags, [1, 2])`,
+		`array_contains_any(tags, [1,2,])`,
+		`array_length(tags) == 3`,
+		`array_length(tags) > 1`,
+		`3 >= array_length(tags)`,
+		`array_contains(tags, 1) and not array_contains_any(tags, [2, 3]) || Int64Field > 1`,
+		`VarCharField == "array_contains(tags, 1)"`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	expr, err := ParseExpr(helper, `array_contains_all(tags, [1, 2])`)
+	assert.NoError(t, err)
+	containsExpr := expr.GetArrayContainsExpr()
+	assert.Equal(t, int64(1001), containsExpr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, planpb.ArrayContainsExpr_ContainsAll, containsExpr.GetOp())
+	assert.Equal(t, 2, len(containsExpr.GetElements()))
+	assert.Equal(t, int64(2), containsExpr.GetElements()[1].GetInt64Val())
+
+	expr, err = ParseExpr(helper, `array_length(tags) <= 3`)
+	assert.NoError(t, err)
+	lengthExpr := expr.GetBinaryArithOpEvalRangeExpr()
+	assert.Equal(t, planpb.ArithOpType_ArrayLength, lengthExpr.GetArithOp())
+	assert.Equal(t, planpb.OpType_LessEqual, lengthExpr.GetOp())
+	assert.Equal(t, int64(3), lengthExpr.GetValue().GetInt64Val())
+
+	invalidExprs := []string{
+		`tags == 1`,
+		`tags in [1, 2]`,
+		`array_contains(Int64Field, 1)`,
+		`array_contains(not_exist, 1)`,
+		`array_contains(tags, "a")`,
+		`array_contains(tags, 1.5)`,
+		`array_contains(tags, Int64Field)`,
+		`array_contains(tags)`,
+		`array_contains(tags, 1`,
+		`array_contains_all(tags, 1)`,
+		`array_contains_any(tags, [])`,
+		`array_contains_all(tags, [1, Int64Field])`,
+		`tags == [1, 2]`,
+		`array_length(tags)`,
+		`array_length(tags) == "a"`,
+		`array_length(tags, 1) == 1`,
+		`1 < array_length(tags) < 5`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
+func TestExpr_Null(t *testing.T) {
+	schema := newTestSchema()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID: 1001, Name: "age", DataType: schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+	}, &schemapb.FieldSchema{
+		FieldID: 1002, Name: "meta", DataType: typeutil.DataTypeJSON,
+	})
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	exprStrs := []string{
+		`age is null`,
+		`age IS NOT NULL`,
+		`age is  not	null and age > 18`,
+		`not (age is null) || Int64Field in [1, 2]`,
+		`VarCharField == "age is null"`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	expr, err := ParseExpr(helper, `age is not null`)
+	assert.NoError(t, err)
+	nullExpr := expr.GetNullExpr()
+	assert.Equal(t, int64(1001), nullExpr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, planpb.NullExpr_IsNotNull, nullExpr.GetOp())
+
+	expr, err = ParseExpr(helper, `Int64Field > 1 && age is null`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.NullExpr_IsNull, expr.GetBinaryExpr().GetRight().GetNullExpr().GetOp())
+
+	expr, err = ParseExpr(helper, `meta["address"]["city"] is null`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"address", "city"}, expr.GetNullExpr().GetColumnInfo().GetNestedPath())
+
+	invalidExprs := []string{
+		`Int64Field is null`,
+		`meta is null`,
+		`not_exist is not null`,
+		`age is nil`,
+		`age is not`,
+		`agenull is null`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
 func TestCreateRetrievePlan(t *testing.T) {
 	schema := newTestSchema()
 	_, err := CreateRetrievePlan(schema, "Int64Field > 0")
@@ -59,6 +59,10 @@ func (v *ShowExprVisitor) VisitExpr(expr *planpb.Expr) interface{} {
 		js["expr"] = v.VisitValueExpr(realExpr.ValueExpr)
 	case *planpb.Expr_ColumnExpr:
 		js["expr"] = v.VisitColumnExpr(realExpr.ColumnExpr)
+	case *planpb.Expr_ArrayContainsExpr:
+		js["expr"] = v.VisitArrayContainsExpr(realExpr.ArrayContainsExpr)
+	case *planpb.Expr_NullExpr:
+		js["expr"] = v.VisitNullExpr(realExpr.NullExpr)
 	default:
 		js["expr"] = ""
 	}
@@ -155,6 +159,27 @@ func (v *ShowExprVisitor) VisitColumnExpr(expr *planpb.ColumnExpr) interface{} {
 	return js
 }
 
+func (v *ShowExprVisitor) VisitArrayContainsExpr(expr *planpb.ArrayContainsExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "array_contains"
+	js["column_info"] = extractColumnInfo(expr.GetColumnInfo())
+	elements := make([]interface{}, 0, len(expr.GetElements()))
+	for _, e := range expr.GetElements() {
+		elements = append(elements, extractGenericValue(e))
+	}
+	js["elements"] = elements
+	js["op"] = expr.GetOp().String()
+	return js
+}
+
+func (v *ShowExprVisitor) VisitNullExpr(expr *planpb.NullExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "null"
+	js["column_info"] = extractColumnInfo(expr.GetColumnInfo())
+	js["op"] = expr.GetOp().String()
+	return js
+}
+
 func NewShowExprVisitor() LogicalExprVisitor {
 	return &ShowExprVisitor{}
 }
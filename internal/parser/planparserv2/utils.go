@@ -155,6 +155,11 @@ func toColumnInfo(left *ExprWithType) *planpb.ColumnInfo {
 }
 
 func castValue(dataType schemapb.DataType, value *planpb.GenericValue) (*planpb.GenericValue, error) {
+	// the type of a JSON value is only known when the document is evaluated.
+	if typeutil.IsJSONType(dataType) {
+		return value, nil
+	}
+
 	if typeutil.IsStringType(dataType) && IsString(value) {
 		return value, nil
 	}
@@ -200,6 +205,24 @@ func combineBinaryArithExpr(op planpb.OpType, arithOp planpb.ArithOpType, column
 }
 
 func handleBinaryArithExpr(op planpb.OpType, arithExpr *planpb.BinaryArithExpr, valueExpr *planpb.ValueExpr) (*planpb.Expr, error) {
+	if arithExpr.GetOp() == planpb.ArithOpType_ArrayLength {
+		// array_length(a) > 3
+		if op < planpb.OpType_GreaterThan || op > planpb.OpType_NotEqual {
+			return nil, fmt.Errorf("%s is not supported on array_length", op)
+		}
+		return &planpb.Expr{
+			Expr: &planpb.Expr_BinaryArithOpEvalRangeExpr{
+				BinaryArithOpEvalRangeExpr: &planpb.BinaryArithOpEvalRangeExpr{
+					ColumnInfo:   arithExpr.GetLeft().GetColumnExpr().GetInfo(),
+					ArithOp:      planpb.ArithOpType_ArrayLength,
+					RightOperand: arithExpr.GetRight().GetValueExpr().GetValue(),
+					Op:           op,
+					Value:        valueExpr.GetValue(),
+				},
+			},
+		}, nil
+	}
+
 	switch op {
 	case planpb.OpType_Equal, planpb.OpType_NotEqual:
 		break
@@ -288,6 +311,14 @@ func handleCompare(op planpb.OpType, left *ExprWithType, right *ExprWithType) (*
 		return nil, fmt.Errorf("only comparison between two fields is supported")
 	}
 
+	if typeutil.IsJSONType(left.dataType) || typeutil.IsJSONType(right.dataType) {
+		return nil, fmt.Errorf("comparison between JSON field and other fields is unsupported")
+	}
+
+	if typeutil.IsArrayType(left.dataType) || typeutil.IsArrayType(right.dataType) {
+		return nil, fmt.Errorf("comparison between array field and other fields is unsupported")
+	}
+
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_CompareExpr{
 			CompareExpr: &planpb.CompareExpr{
@@ -307,6 +338,9 @@ func handleCompare(op planpb.OpType, left *ExprWithType, right *ExprWithType) (*
 }
 
 func relationalCompatible(t1, t2 schemapb.DataType) bool {
+	if typeutil.IsJSONType(t1) || typeutil.IsJSONType(t2) {
+		return true
+	}
 	both := typeutil.IsStringType(t1) && typeutil.IsStringType(t2)
 	neither := !typeutil.IsStringType(t1) && !typeutil.IsStringType(t2)
 	return both || neither
@@ -1600,8 +1600,19 @@ type SegmentInfo struct {
 	// A flag indicating if:
 	// (1) this segment is created by bulk insert, and
 	// (2) the bulk insert task that creates this segment has not yet reached `ImportCompleted` state.
-	IsImporting          bool     `protobuf:"varint,17,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
-	IsFake               bool     `protobuf:"varint,18,opt,name=is_fake,json=isFake,proto3" json:"is_fake,omitempty"`
+	IsImporting bool `protobuf:"varint,17,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
+	IsFake      bool `protobuf:"varint,18,opt,name=is_fake,json=isFake,proto3" json:"is_fake,omitempty"`
+	// the source segment if the segment is cloned, the binlogs are shared with the source segment.
+	ClonedFrom int64 `protobuf:"varint,19,opt,name=cloned_from,json=clonedFrom,proto3" json:"cloned_from,omitempty"`
+	// the storage format of the insert binlogs, 0 means each field is stored in separate binlogs,
+	// 2 means all the fields of a flush are stored in a parquet file shared by the fields.
+	StorageVersion int64 `protobuf:"varint,20,opt,name=storage_version,json=storageVersion,proto3" json:"storage_version,omitempty"`
+	// set if the segment is cloned while some rows or deletions of it are after the clone timestamp,
+	// they are filtered out when the segment is loaded.
+	MaxRowTimestamp uint64 `protobuf:"varint,21,opt,name=max_row_timestamp,json=maxRowTimestamp,proto3" json:"max_row_timestamp,omitempty"`
+	// set if the segment is truncated while some rows of it are after the truncate timestamp,
+	// the rows at or before it are filtered out when the segment is loaded.
+	MinRowTimestamp      uint64   `protobuf:"varint,22,opt,name=min_row_timestamp,json=minRowTimestamp,proto3" json:"min_row_timestamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1758,6 +1769,34 @@ func (m *SegmentInfo) GetIsFake() bool {
 	return false
 }
 
+func (m *SegmentInfo) GetClonedFrom() int64 {
+	if m != nil {
+		return m.ClonedFrom
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetStorageVersion() int64 {
+	if m != nil {
+		return m.StorageVersion
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetMaxRowTimestamp() uint64 {
+	if m != nil {
+		return m.MaxRowTimestamp
+	}
+	return 0
+}
+
+func (m *SegmentInfo) GetMinRowTimestamp() uint64 {
+	if m != nil {
+		return m.MinRowTimestamp
+	}
+	return 0
+}
+
 type SegmentStartPosition struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1817,6 +1856,7 @@ type SaveBinlogPathsRequest struct {
 	Deltalogs            []*FieldBinlog          `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
 	Dropped              bool                    `protobuf:"varint,10,opt,name=dropped,proto3" json:"dropped,omitempty"`
 	Importing            bool                    `protobuf:"varint,11,opt,name=importing,proto3" json:"importing,omitempty"`
+	StorageVersion       int64                   `protobuf:"varint,12,opt,name=storage_version,json=storageVersion,proto3" json:"storage_version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1924,6 +1964,13 @@ func (m *SaveBinlogPathsRequest) GetImporting() bool {
 	return false
 }
 
+func (m *SaveBinlogPathsRequest) GetStorageVersion() int64 {
+	if m != nil {
+		return m.StorageVersion
+	}
+	return 0
+}
+
 type CheckPoint struct {
 	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	Position             *internalpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
@@ -2401,9 +2448,11 @@ type Binlog struct {
 	TimestampFrom uint64 `protobuf:"varint,2,opt,name=timestamp_from,json=timestampFrom,proto3" json:"timestamp_from,omitempty"`
 	TimestampTo   uint64 `protobuf:"varint,3,opt,name=timestamp_to,json=timestampTo,proto3" json:"timestamp_to,omitempty"`
 	// deprecated
-	LogPath              string   `protobuf:"bytes,4,opt,name=log_path,json=logPath,proto3" json:"log_path,omitempty"`
-	LogSize              int64    `protobuf:"varint,5,opt,name=log_size,json=logSize,proto3" json:"log_size,omitempty"`
-	LogID                int64    `protobuf:"varint,6,opt,name=logID,proto3" json:"logID,omitempty"`
+	LogPath string `protobuf:"bytes,4,opt,name=log_path,json=logPath,proto3" json:"log_path,omitempty"`
+	LogSize int64  `protobuf:"varint,5,opt,name=log_size,json=logSize,proto3" json:"log_size,omitempty"`
+	LogID   int64  `protobuf:"varint,6,opt,name=logID,proto3" json:"logID,omitempty"`
+	// the id of the data key encrypted the binlog, empty if the binlog is not encrypted
+	EncryptionKeyId      string   `protobuf:"bytes,7,opt,name=encryption_key_id,json=encryptionKeyId,proto3" json:"encryption_key_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2476,6 +2525,13 @@ func (m *Binlog) GetLogID() int64 {
 	return 0
 }
 
+func (m *Binlog) GetEncryptionKeyId() string {
+	if m != nil {
+		return m.EncryptionKeyId
+	}
+	return ""
+}
+
 type GetRecoveryInfoResponse struct {
 	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Channels             []*VchannelInfo   `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
@@ -4718,11 +4774,14 @@ func (m *UnsetIsImportingStateRequest) GetSegmentIds() []int64 {
 }
 
 type MarkSegmentsDroppedRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	SegmentIds           []int64           `protobuf:"varint,2,rep,packed,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base       *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SegmentIds []int64           `protobuf:"varint,2,rep,packed,name=segment_ids,json=segmentIds,proto3" json:"segment_ids,omitempty"`
+	// if set, only the rows at or before it are dropped, the segments with rows after it are kept
+	// with the min row timestamp set.
+	Timestamp            uint64   `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MarkSegmentsDroppedRequest) Reset()         { *m = MarkSegmentsDroppedRequest{} }
@@ -4764,6 +4823,100 @@ func (m *MarkSegmentsDroppedRequest) GetSegmentIds() []int64 {
 	return nil
 }
 
+func (m *MarkSegmentsDroppedRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type CloneSegmentsRequest struct {
+	Base                 *commonpb.MsgBase       `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SourceCollectionID   int64                   `protobuf:"varint,2,opt,name=source_collectionID,json=sourceCollectionID,proto3" json:"source_collectionID,omitempty"`
+	TargetCollectionID   int64                   `protobuf:"varint,3,opt,name=target_collectionID,json=targetCollectionID,proto3" json:"target_collectionID,omitempty"`
+	PartitionIDs         map[int64]int64         `protobuf:"bytes,4,rep,name=partitionIDs,proto3" json:"partitionIDs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Channels             map[string]string       `protobuf:"bytes,5,rep,name=channels,proto3" json:"channels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StartPositions       []*commonpb.KeyDataPair `protobuf:"bytes,6,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
+	Timestamp            uint64                  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CloneSegmentsRequest) Reset()         { *m = CloneSegmentsRequest{} }
+func (m *CloneSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*CloneSegmentsRequest) ProtoMessage()    {}
+func (*CloneSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{74}
+}
+
+func (m *CloneSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneSegmentsRequest.Unmarshal(m, b)
+}
+func (m *CloneSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *CloneSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneSegmentsRequest.Merge(m, src)
+}
+func (m *CloneSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_CloneSegmentsRequest.Size(m)
+}
+func (m *CloneSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneSegmentsRequest proto.InternalMessageInfo
+
+func (m *CloneSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CloneSegmentsRequest) GetSourceCollectionID() int64 {
+	if m != nil {
+		return m.SourceCollectionID
+	}
+	return 0
+}
+
+func (m *CloneSegmentsRequest) GetTargetCollectionID() int64 {
+	if m != nil {
+		return m.TargetCollectionID
+	}
+	return 0
+}
+
+func (m *CloneSegmentsRequest) GetPartitionIDs() map[int64]int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *CloneSegmentsRequest) GetChannels() map[string]string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *CloneSegmentsRequest) GetStartPositions() []*commonpb.KeyDataPair {
+	if m != nil {
+		return m.StartPositions
+	}
+	return nil
+}
+
+func (m *CloneSegmentsRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 type SegmentReferenceLock struct {
 	TaskID               int64    `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
 	NodeID               int64    `protobuf:"varint,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
@@ -4777,7 +4930,7 @@ func (m *SegmentReferenceLock) Reset()         { *m = SegmentReferenceLock{} }
 func (m *SegmentReferenceLock) String() string { return proto.CompactTextString(m) }
 func (*SegmentReferenceLock) ProtoMessage()    {}
 func (*SegmentReferenceLock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{74}
+	return fileDescriptor_82cd95f524594f49, []int{75}
 }
 
 func (m *SegmentReferenceLock) XXX_Unmarshal(b []byte) error {
@@ -4834,7 +4987,7 @@ func (m *AlterCollectionRequest) Reset()         { *m = AlterCollectionRequest{}
 func (m *AlterCollectionRequest) String() string { return proto.CompactTextString(m) }
 func (*AlterCollectionRequest) ProtoMessage()    {}
 func (*AlterCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82cd95f524594f49, []int{75}
+	return fileDescriptor_82cd95f524594f49, []int{76}
 }
 
 func (m *AlterCollectionRequest) XXX_Unmarshal(b []byte) error {
@@ -4969,6 +5122,9 @@ func init() {
 	proto.RegisterType((*SaveImportSegmentRequest)(nil), "milvus.proto.data.SaveImportSegmentRequest")
 	proto.RegisterType((*UnsetIsImportingStateRequest)(nil), "milvus.proto.data.UnsetIsImportingStateRequest")
 	proto.RegisterType((*MarkSegmentsDroppedRequest)(nil), "milvus.proto.data.MarkSegmentsDroppedRequest")
+	proto.RegisterType((*CloneSegmentsRequest)(nil), "milvus.proto.data.CloneSegmentsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "milvus.proto.data.CloneSegmentsRequest.ChannelsEntry")
+	proto.RegisterMapType((map[int64]int64)(nil), "milvus.proto.data.CloneSegmentsRequest.PartitionIDsEntry")
 	proto.RegisterType((*SegmentReferenceLock)(nil), "milvus.proto.data.SegmentReferenceLock")
 	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.data.AlterCollectionRequest")
 }
@@ -4976,288 +5132,301 @@ func init() {
 func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
 
 var fileDescriptor_82cd95f524594f49 = []byte{
-	// 4492 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4d, 0x6c, 0x1c, 0x59,
-	0x5a, 0xa9, 0xfe, 0x73, 0xf7, 0xd7, 0xed, 0x76, 0xfb, 0x25, 0xe3, 0x74, 0x3a, 0xbf, 0x53, 0x99,
-	0xcc, 0x64, 0x32, 0x99, 0x64, 0x26, 0xc3, 0x68, 0xc3, 0x66, 0x67, 0x96, 0xd8, 0x4e, 0x32, 0x0d,
-	0xb6, 0xd7, 0x5b, 0x76, 0x26, 0xd2, 0x2e, 0x52, 0xab, 0xdc, 0xf5, 0xdc, 0xae, 0x75, 0x75, 0x55,
-	0xa7, 0xaa, 0xda, 0x8e, 0x97, 0xc3, 0x0e, 0x20, 0x81, 0x16, 0x21, 0x16, 0x21, 0x21, 0xe0, 0x80,
-	0x84, 0x38, 0x2d, 0x8b, 0x16, 0x21, 0xad, 0xb8, 0x70, 0xe1, 0x3a, 0x82, 0xc3, 0x08, 0x21, 0x71,
-	0xe4, 0x08, 0xdc, 0xb9, 0x72, 0x40, 0xef, 0xa7, 0x5e, 0xfd, 0xbd, 0xea, 0x2e, 0x77, 0x27, 0x13,
-	0x04, 0xb7, 0x7e, 0x5f, 0x7d, 0xef, 0x7b, 0x7f, 0xdf, 0xff, 0xfb, 0x5e, 0x43, 0xcb, 0xd0, 0x7d,
-	0xbd, 0xd7, 0x77, 0x1c, 0xd7, 0xb8, 0x33, 0x72, 0x1d, 0xdf, 0x41, 0xcb, 0x43, 0xd3, 0x3a, 0x1a,
-	0x7b, 0xac, 0x75, 0x87, 0x7c, 0xee, 0x34, 0xfa, 0xce, 0x70, 0xe8, 0xd8, 0x0c, 0xd4, 0x69, 0x9a,
-	0xb6, 0x8f, 0x5d, 0x5b, 0xb7, 0x78, 0xbb, 0x11, 0xed, 0xd0, 0x69, 0x78, 0xfd, 0x03, 0x3c, 0xd4,
-	0x59, 0x4b, 0x5d, 0x80, 0xf2, 0xa3, 0xe1, 0xc8, 0x3f, 0x51, 0xff, 0x54, 0x81, 0xc6, 0x63, 0x6b,
-	0xec, 0x1d, 0x68, 0xf8, 0xf9, 0x18, 0x7b, 0x3e, 0xfa, 0x00, 0x4a, 0x7b, 0xba, 0x87, 0xdb, 0xca,
-	0x35, 0xe5, 0x66, 0xfd, 0xde, 0xa5, 0x3b, 0xb1, 0x51, 0xf9, 0x78, 0x9b, 0xde, 0x60, 0x55, 0xf7,
-	0xb0, 0x46, 0x31, 0x11, 0x82, 0x92, 0xb1, 0xd7, 0x5d, 0x6f, 0x17, 0xae, 0x29, 0x37, 0x8b, 0x1a,
-	0xfd, 0x8d, 0xae, 0x00, 0x78, 0x78, 0x30, 0xc4, 0xb6, 0xdf, 0x5d, 0xf7, 0xda, 0xc5, 0x6b, 0xc5,
-	0x9b, 0x45, 0x2d, 0x02, 0x41, 0x2a, 0x34, 0xfa, 0x8e, 0x65, 0xe1, 0xbe, 0x6f, 0x3a, 0x76, 0x77,
-	0xbd, 0x5d, 0xa2, 0x7d, 0x63, 0x30, 0xf5, 0xdf, 0x15, 0x58, 0xe4, 0x53, 0xf3, 0x46, 0x8e, 0xed,
-	0x61, 0xf4, 0x11, 0x54, 0x3c, 0x5f, 0xf7, 0xc7, 0x1e, 0x9f, 0xdd, 0x45, 0xe9, 0xec, 0x76, 0x28,
-	0x8a, 0xc6, 0x51, 0xa5, 0xd3, 0x4b, 0x0e, 0x5f, 0x4c, 0x0f, 0x9f, 0x58, 0x42, 0x29, 0xb5, 0x84,
-	0x9b, 0xb0, 0xb4, 0x4f, 0x66, 0xb7, 0x13, 0x22, 0x95, 0x29, 0x52, 0x12, 0x4c, 0x28, 0xf9, 0xe6,
-	0x10, 0x7f, 0x67, 0x7f, 0x07, 0xeb, 0x56, 0xbb, 0x42, 0xc7, 0x8a, 0x40, 0xd4, 0x7f, 0x56, 0xa0,
-	0x25, 0xd0, 0x83, 0x73, 0x38, 0x07, 0xe5, 0xbe, 0x33, 0xb6, 0x7d, 0xba, 0xd4, 0x45, 0x8d, 0x35,
-	0xd0, 0x9b, 0xd0, 0xe8, 0x1f, 0xe8, 0xb6, 0x8d, 0xad, 0x9e, 0xad, 0x0f, 0x31, 0x5d, 0x54, 0x4d,
-	0xab, 0x73, 0xd8, 0x96, 0x3e, 0xc4, 0xb9, 0xd6, 0x76, 0x0d, 0xea, 0x23, 0xdd, 0xf5, 0xcd, 0xd8,
-	0xee, 0x47, 0x41, 0xa8, 0x03, 0x55, 0xd3, 0xeb, 0x0e, 0x47, 0x8e, 0xeb, 0xb7, 0xcb, 0xd7, 0x94,
-	0x9b, 0x55, 0x4d, 0xb4, 0xc9, 0x08, 0x26, 0xfd, 0xb5, 0xab, 0x7b, 0x87, 0xdd, 0x75, 0xbe, 0xa2,
-	0x18, 0x4c, 0xfd, 0x0b, 0x05, 0x56, 0x1e, 0x7a, 0x9e, 0x39, 0xb0, 0x53, 0x2b, 0x5b, 0x81, 0x8a,
-	0xed, 0x18, 0xb8, 0xbb, 0x4e, 0x97, 0x56, 0xd4, 0x78, 0x0b, 0x5d, 0x84, 0xda, 0x08, 0x63, 0xb7,
-	0xe7, 0x3a, 0x56, 0xb0, 0xb0, 0x2a, 0x01, 0x68, 0x8e, 0x85, 0xd1, 0x77, 0x61, 0xd9, 0x4b, 0x10,
-	0x62, 0x7c, 0x55, 0xbf, 0x77, 0xfd, 0x4e, 0x4a, 0x32, 0xee, 0x24, 0x07, 0xd5, 0xd2, 0xbd, 0xd5,
-	0x2f, 0x0a, 0x70, 0x56, 0xe0, 0xb1, 0xb9, 0x92, 0xdf, 0x64, 0xe7, 0x3d, 0x3c, 0x10, 0xd3, 0x63,
-	0x8d, 0x3c, 0x3b, 0x2f, 0x8e, 0xac, 0x18, 0x3d, 0xb2, 0x1c, 0xac, 0x9e, 0x3c, 0x8f, 0x72, 0xfa,
-	0x3c, 0xae, 0x42, 0x1d, 0xbf, 0x18, 0x99, 0x2e, 0xee, 0x11, 0xc6, 0xa1, 0x5b, 0x5e, 0xd2, 0x80,
-	0x81, 0x76, 0xcd, 0x61, 0x54, 0x36, 0x16, 0x72, 0xcb, 0x86, 0xfa, 0x97, 0x0a, 0x9c, 0x4f, 0x9d,
-	0x12, 0x17, 0x36, 0x0d, 0x5a, 0x74, 0xe5, 0xe1, 0xce, 0x10, 0xb1, 0x23, 0x1b, 0xfe, 0xf6, 0xa4,
-	0x0d, 0x0f, 0xd1, 0xb5, 0x54, 0xff, 0xc8, 0x24, 0x0b, 0xf9, 0x27, 0x79, 0x08, 0xe7, 0x9f, 0x60,
-	0x9f, 0x0f, 0x40, 0xbe, 0x61, 0x6f, 0x76, 0x65, 0x15, 0x97, 0xea, 0x42, 0x52, 0xaa, 0xd5, 0xbf,
-	0x2d, 0x08, 0x59, 0xa4, 0x43, 0x75, 0xed, 0x7d, 0x07, 0x5d, 0x82, 0x9a, 0x40, 0xe1, 0x5c, 0x11,
-	0x02, 0xd0, 0x37, 0xa0, 0x4c, 0x66, 0xca, 0x58, 0xa2, 0x79, 0xef, 0x4d, 0xf9, 0x9a, 0x22, 0x34,
-	0x35, 0x86, 0x8f, 0xba, 0xd0, 0xf4, 0x7c, 0xdd, 0xf5, 0x7b, 0x23, 0xc7, 0xa3, 0xe7, 0x4c, 0x19,
-	0xa7, 0x7e, 0x4f, 0x8d, 0x53, 0x10, 0x6a, 0x7d, 0xd3, 0x1b, 0x6c, 0x73, 0x4c, 0x6d, 0x91, 0xf6,
-	0x0c, 0x9a, 0xe8, 0x11, 0x34, 0xb0, 0x6d, 0x84, 0x84, 0x4a, 0xb9, 0x09, 0xd5, 0xb1, 0x6d, 0x08,
-	0x32, 0xe1, 0xf9, 0x94, 0xf3, 0x9f, 0xcf, 0xef, 0x2b, 0xd0, 0x4e, 0x1f, 0xd0, 0x3c, 0x2a, 0xfb,
-	0x01, 0xeb, 0x84, 0xd9, 0x01, 0x4d, 0x94, 0x70, 0x71, 0x48, 0x1a, 0xef, 0xa2, 0xfe, 0xb1, 0x02,
-	0x6f, 0x84, 0xd3, 0xa1, 0x9f, 0x5e, 0x15, 0xb7, 0xa0, 0x5b, 0xd0, 0x32, 0xed, 0xbe, 0x35, 0x36,
-	0xf0, 0x53, 0xfb, 0x33, 0xac, 0x5b, 0xfe, 0xc1, 0x09, 0x3d, 0xc3, 0xaa, 0x96, 0x82, 0xab, 0xff,
-	0x56, 0x80, 0x95, 0xe4, 0xbc, 0xe6, 0xd9, 0xa4, 0x5f, 0x82, 0xb2, 0x69, 0xef, 0x3b, 0xc1, 0x1e,
-	0x5d, 0x99, 0x20, 0x94, 0x64, 0x2c, 0x86, 0x8c, 0x1c, 0x40, 0x81, 0x1a, 0xeb, 0x1f, 0xe0, 0xfe,
-	0xe1, 0xc8, 0x31, 0xa9, 0xc2, 0x22, 0x24, 0x7e, 0x45, 0x42, 0x42, 0x3e, 0xe3, 0x3b, 0x6b, 0x8c,
-	0xc6, 0x9a, 0x20, 0xf1, 0xc8, 0xf6, 0xdd, 0x13, 0x6d, 0xb9, 0x9f, 0x84, 0x77, 0x0e, 0x60, 0x45,
-	0x8e, 0x8c, 0x5a, 0x50, 0x3c, 0xc4, 0x27, 0x74, 0xc9, 0x35, 0x8d, 0xfc, 0x44, 0xf7, 0xa1, 0x7c,
-	0xa4, 0x5b, 0x63, 0xcc, 0xb5, 0x43, 0x1e, 0xf6, 0x65, 0x1d, 0xbe, 0x59, 0xb8, 0xaf, 0xa8, 0x43,
-	0xb8, 0xf8, 0x04, 0xfb, 0x5d, 0xdb, 0xc3, 0xae, 0xbf, 0x6a, 0xda, 0x96, 0x33, 0xd8, 0xd6, 0xfd,
-	0x83, 0x39, 0x74, 0x45, 0x4c, 0xec, 0x0b, 0x09, 0xb1, 0x57, 0x7f, 0xaa, 0xc0, 0x25, 0xf9, 0x78,
-	0xfc, 0x54, 0x3b, 0x50, 0xdd, 0x37, 0xb1, 0x65, 0x10, 0xd6, 0x51, 0x28, 0xeb, 0x88, 0x36, 0xd1,
-	0x19, 0x23, 0x82, 0xcc, 0x0f, 0xef, 0xcd, 0x8c, 0x95, 0xee, 0xf8, 0xae, 0x69, 0x0f, 0x36, 0x4c,
-	0xcf, 0xd7, 0x18, 0x7e, 0x84, 0x55, 0x8a, 0xf9, 0x25, 0xf4, 0xf7, 0x14, 0xb8, 0xf2, 0x04, 0xfb,
-	0x6b, 0xc2, 0xe4, 0x90, 0xef, 0xa6, 0xe7, 0x9b, 0x7d, 0xef, 0xe5, 0xba, 0x7d, 0x39, 0x7c, 0x0f,
-	0xf5, 0x27, 0x0a, 0x5c, 0xcd, 0x9c, 0x0c, 0xdf, 0x3a, 0xae, 0x52, 0x03, 0x83, 0x23, 0x57, 0xa9,
-	0xbf, 0x86, 0x4f, 0x3e, 0x27, 0x87, 0xbf, 0xad, 0x9b, 0x2e, 0x53, 0xa9, 0x33, 0x1a, 0x98, 0x9f,
-	0x2b, 0x70, 0xf9, 0x09, 0xf6, 0xb7, 0x03, 0x73, 0xfb, 0x1a, 0x77, 0x87, 0xe0, 0x44, 0xcc, 0x7e,
-	0xe0, 0x77, 0xc6, 0x60, 0xea, 0x1f, 0xb0, 0xe3, 0x94, 0xce, 0xf7, 0xb5, 0x6c, 0xe0, 0x15, 0x2a,
-	0x09, 0x11, 0x3d, 0xc1, 0x25, 0x9e, 0x6f, 0x9f, 0xfa, 0xe7, 0x0a, 0x5c, 0x78, 0xd8, 0x7f, 0x3e,
-	0x36, 0x5d, 0xcc, 0x91, 0x36, 0x9c, 0xfe, 0xe1, 0xec, 0x9b, 0x1b, 0x7a, 0x90, 0x85, 0x98, 0x07,
-	0x39, 0x2d, 0xea, 0x58, 0x81, 0x8a, 0xcf, 0x5c, 0x56, 0xe6, 0x84, 0xf1, 0x16, 0x9d, 0x9f, 0x86,
-	0x2d, 0xac, 0x7b, 0xff, 0x3b, 0xe7, 0xf7, 0x93, 0x12, 0x34, 0x3e, 0xe7, 0xaa, 0x95, 0x3a, 0x24,
-	0x49, 0x4e, 0x52, 0xe4, 0x3e, 0x65, 0xc4, 0x39, 0x95, 0xf9, 0xab, 0x4f, 0x60, 0xd1, 0xc3, 0xf8,
-	0x70, 0x16, 0xf7, 0xa3, 0x41, 0x3a, 0x0a, 0xb7, 0x61, 0x03, 0x96, 0xc7, 0x36, 0x8d, 0x7a, 0xb0,
-	0xc1, 0x37, 0x90, 0x71, 0xee, 0x74, 0xb3, 0x94, 0xee, 0x88, 0x3e, 0xe3, 0x81, 0x55, 0x84, 0x56,
-	0x39, 0x17, 0xad, 0x64, 0x37, 0xd4, 0x85, 0x96, 0xe1, 0x3a, 0xa3, 0x11, 0x36, 0x7a, 0x5e, 0x40,
-	0xaa, 0x92, 0x8f, 0x14, 0xef, 0x27, 0x48, 0x7d, 0x00, 0x67, 0x93, 0x33, 0xed, 0x1a, 0xc4, 0xd7,
-	0x26, 0x67, 0x28, 0xfb, 0x84, 0x6e, 0xc3, 0x72, 0x1a, 0xbf, 0x4a, 0xf1, 0xd3, 0x1f, 0xd0, 0xfb,
-	0x80, 0x12, 0x53, 0x25, 0xe8, 0x35, 0x86, 0x1e, 0x9f, 0x4c, 0xd7, 0xf0, 0xd4, 0x1f, 0x2b, 0xb0,
-	0xf2, 0x4c, 0xf7, 0xfb, 0x07, 0xeb, 0x43, 0x2e, 0x6b, 0x73, 0xe8, 0xaa, 0x4f, 0xa0, 0x76, 0xc4,
-	0xf9, 0x22, 0x30, 0x48, 0x57, 0x25, 0xfb, 0x13, 0xe5, 0x40, 0x2d, 0xec, 0x41, 0x42, 0xbd, 0x73,
-	0x8f, 0x23, 0x21, 0xef, 0x6b, 0xd0, 0x9a, 0x53, 0x62, 0x75, 0xf5, 0x05, 0x00, 0x9f, 0xdc, 0xa6,
-	0x37, 0x98, 0x61, 0x5e, 0xf7, 0x61, 0x81, 0x53, 0xe3, 0x6a, 0x71, 0x1a, 0xff, 0x04, 0xe8, 0xea,
-	0xcf, 0x2a, 0x50, 0x8f, 0x7c, 0x40, 0x4d, 0x28, 0x08, 0x79, 0x2d, 0x48, 0x56, 0x57, 0x98, 0x1e,
-	0x1d, 0x16, 0xd3, 0xd1, 0xe1, 0x0d, 0x68, 0x9a, 0xd4, 0x0f, 0xe9, 0xf1, 0x53, 0xa1, 0x0a, 0xa4,
-	0xa6, 0x2d, 0x32, 0x28, 0x67, 0x11, 0x74, 0x05, 0xea, 0xf6, 0x78, 0xd8, 0x73, 0xf6, 0x7b, 0xae,
-	0x73, 0xec, 0xf1, 0x30, 0xb3, 0x66, 0x8f, 0x87, 0xdf, 0xd9, 0xd7, 0x9c, 0x63, 0x2f, 0x8c, 0x64,
-	0x2a, 0xa7, 0x8c, 0x64, 0xae, 0x40, 0x7d, 0xa8, 0xbf, 0x20, 0x54, 0x7b, 0xf6, 0x78, 0x48, 0x23,
-	0xd0, 0xa2, 0x56, 0x1b, 0xea, 0x2f, 0x34, 0xe7, 0x78, 0x6b, 0x3c, 0x44, 0x37, 0xa1, 0x65, 0xe9,
-	0x9e, 0xdf, 0x8b, 0x86, 0xb0, 0x55, 0x1a, 0xc2, 0x36, 0x09, 0xfc, 0x51, 0x18, 0xc6, 0xa6, 0x63,
-	0xa2, 0xda, 0x1c, 0x31, 0x91, 0x31, 0xb4, 0x42, 0x42, 0x90, 0x3f, 0x26, 0x32, 0x86, 0x96, 0x20,
-	0x73, 0x1f, 0x16, 0xf6, 0xa8, 0x77, 0xe7, 0xb5, 0xeb, 0x99, 0xba, 0xe3, 0x31, 0x71, 0xec, 0x98,
-	0x13, 0xa8, 0x05, 0xe8, 0xe8, 0x5b, 0x50, 0xa3, 0x46, 0x95, 0xf6, 0x6d, 0xe4, 0xea, 0x1b, 0x76,
-	0x20, 0xbd, 0x0d, 0x6c, 0xf9, 0x3a, 0xed, 0xbd, 0x98, 0xaf, 0xb7, 0xe8, 0x40, 0xf4, 0x55, 0xdf,
-	0xc5, 0xba, 0x8f, 0x8d, 0xd5, 0x93, 0x35, 0x67, 0x38, 0xd2, 0x29, 0x33, 0xb5, 0x9b, 0x34, 0x38,
-	0x91, 0x7d, 0x42, 0x6f, 0x43, 0xb3, 0x2f, 0x5a, 0x8f, 0x5d, 0x67, 0xd8, 0x5e, 0xa2, 0x72, 0x94,
-	0x80, 0xa2, 0xcb, 0x00, 0x81, 0xa6, 0xd2, 0xfd, 0x76, 0x8b, 0x9e, 0x62, 0x8d, 0x43, 0x1e, 0xd2,
-	0x0c, 0x95, 0xe9, 0xf5, 0x58, 0x2e, 0xc8, 0xb4, 0x07, 0xed, 0x65, 0x3a, 0x62, 0x3d, 0x48, 0x1e,
-	0x99, 0xf6, 0x00, 0x9d, 0x87, 0x05, 0xd3, 0xeb, 0xed, 0xeb, 0x87, 0xb8, 0x8d, 0xe8, 0xd7, 0x8a,
-	0xe9, 0x3d, 0xd6, 0x0f, 0xb1, 0xfa, 0x23, 0x38, 0x17, 0x72, 0x57, 0xe4, 0x24, 0xd3, 0x4c, 0xa1,
-	0xcc, 0xca, 0x14, 0x93, 0x7d, 0xfa, 0xaf, 0x4a, 0xb0, 0xb2, 0xa3, 0x1f, 0xe1, 0x57, 0x1f, 0x3e,
-	0xe4, 0x52, 0x6b, 0x1b, 0xb0, 0x4c, 0x23, 0x86, 0x7b, 0x91, 0xf9, 0x4c, 0xb0, 0xab, 0x51, 0x56,
-	0x48, 0x77, 0x44, 0xdf, 0x26, 0x0e, 0x01, 0xee, 0x1f, 0x6e, 0x93, 0x10, 0x2c, 0xb0, 0xa9, 0x97,
-	0x25, 0x74, 0xd6, 0x04, 0x96, 0x16, 0xed, 0x81, 0xb6, 0x61, 0x29, 0x7e, 0x0c, 0x81, 0x35, 0x7d,
-	0x67, 0x62, 0x7c, 0x1e, 0xee, 0xbe, 0xd6, 0x8c, 0x1d, 0x86, 0x87, 0xda, 0xb0, 0xc0, 0x4d, 0x21,
-	0xd5, 0x19, 0x55, 0x2d, 0x68, 0xa2, 0x6d, 0x38, 0xcb, 0x56, 0xb0, 0xc3, 0x05, 0x82, 0x2d, 0xbe,
-	0x9a, 0x6b, 0xf1, 0xb2, 0xae, 0x71, 0x79, 0xaa, 0x9d, 0x56, 0x9e, 0xda, 0xb0, 0xc0, 0x79, 0x9c,
-	0xea, 0x91, 0xaa, 0x16, 0x34, 0xc9, 0x31, 0x87, 0xdc, 0x5e, 0xa7, 0xdf, 0x42, 0x00, 0x09, 0xbd,
-	0x20, 0xdc, 0xcf, 0x29, 0x99, 0xa4, 0x4f, 0xa1, 0x2a, 0x38, 0x3c, 0x7f, 0x08, 0x2c, 0xfa, 0x24,
-	0xf5, 0x7b, 0x31, 0xa1, 0xdf, 0xd5, 0x7f, 0x52, 0xa0, 0xb1, 0x4e, 0x96, 0xb4, 0xe1, 0x0c, 0xa8,
-	0x35, 0xba, 0x01, 0x4d, 0x17, 0xf7, 0x1d, 0xd7, 0xe8, 0x61, 0xdb, 0x77, 0x4d, 0xcc, 0x12, 0x10,
-	0x25, 0x6d, 0x91, 0x41, 0x1f, 0x31, 0x20, 0x41, 0x23, 0x2a, 0xdb, 0xf3, 0xf5, 0xe1, 0xa8, 0xb7,
-	0x4f, 0x54, 0x43, 0x81, 0xa1, 0x09, 0x28, 0xd5, 0x0c, 0x6f, 0x42, 0x23, 0x44, 0xf3, 0x1d, 0x3a,
-	0x7e, 0x49, 0xab, 0x0b, 0xd8, 0xae, 0x83, 0xde, 0x82, 0x26, 0xdd, 0xd3, 0x9e, 0xe5, 0x0c, 0x7a,
-	0x24, 0xa2, 0xe5, 0x86, 0xaa, 0x61, 0xf0, 0x69, 0x91, 0xb3, 0x8a, 0x63, 0x79, 0xe6, 0x0f, 0x31,
-	0x37, 0x55, 0x02, 0x6b, 0xc7, 0xfc, 0x21, 0x56, 0xff, 0x51, 0x81, 0xc5, 0x75, 0xdd, 0xd7, 0xb7,
-	0x1c, 0x03, 0xef, 0xce, 0x68, 0xd8, 0x73, 0x64, 0x75, 0x2f, 0x41, 0x4d, 0xac, 0x80, 0x2f, 0x29,
-	0x04, 0xa0, 0xc7, 0xd0, 0x0c, 0x5c, 0xcb, 0x1e, 0x8b, 0xb8, 0x4a, 0x99, 0x0e, 0x54, 0xc4, 0x72,
-	0x7a, 0xda, 0x62, 0xd0, 0x8d, 0x36, 0xd5, 0xc7, 0xd0, 0x88, 0x7e, 0x26, 0xa3, 0xee, 0x24, 0x19,
-	0x45, 0x00, 0x08, 0x37, 0x6e, 0x8d, 0x87, 0xe4, 0x4c, 0xb9, 0x62, 0x09, 0x9a, 0xea, 0x6f, 0x2b,
-	0xb0, 0xc8, 0xcd, 0xfd, 0x8e, 0xb8, 0xff, 0xa0, 0x4b, 0x63, 0x79, 0x16, 0xfa, 0x1b, 0x7d, 0x33,
-	0x9e, 0xb2, 0x7c, 0x4b, 0xaa, 0x04, 0x28, 0x11, 0xea, 0x64, 0xc6, 0x6c, 0x7d, 0x9e, 0x18, 0xff,
-	0x0b, 0xc2, 0x68, 0xfc, 0x68, 0x28, 0xa3, 0xb5, 0x61, 0x41, 0x37, 0x0c, 0x17, 0x7b, 0x1e, 0x9f,
-	0x47, 0xd0, 0x24, 0x5f, 0x8e, 0xb0, 0xeb, 0x05, 0x2c, 0x5f, 0xd4, 0x82, 0x26, 0xfa, 0x16, 0x54,
-	0x85, 0x57, 0xca, 0x12, 0x54, 0xd7, 0xb2, 0xe7, 0xc9, 0x23, 0x52, 0xd1, 0x43, 0xfd, 0xbb, 0x02,
-	0x34, 0xf9, 0x86, 0xad, 0x72, 0x7b, 0x3c, 0x59, 0xf8, 0x56, 0xa1, 0xb1, 0x1f, 0xca, 0xfe, 0xa4,
-	0xb4, 0x5a, 0x54, 0x45, 0xc4, 0xfa, 0x4c, 0x13, 0xc0, 0xb8, 0x47, 0x50, 0x9a, 0xcb, 0x23, 0x28,
-	0x9f, 0x56, 0x83, 0xa5, 0x7d, 0xc4, 0x8a, 0xc4, 0x47, 0x54, 0x7f, 0x1d, 0xea, 0x11, 0x02, 0x54,
-	0x43, 0xb3, 0xa4, 0x15, 0xdf, 0xb1, 0xa0, 0x89, 0x3e, 0x0a, 0xfd, 0x22, 0xb6, 0x55, 0x17, 0x24,
-	0x73, 0x49, 0xb8, 0x44, 0xea, 0x3f, 0x28, 0x50, 0xe1, 0x94, 0xaf, 0x42, 0x9d, 0x2b, 0x1d, 0xea,
-	0x33, 0x32, 0xea, 0xc0, 0x41, 0xc4, 0x69, 0x7c, 0x79, 0x5a, 0xe7, 0x02, 0x54, 0x13, 0xfa, 0x66,
-	0x81, 0x9b, 0x85, 0xe0, 0x53, 0x44, 0xc9, 0x90, 0x4f, 0x44, 0xbf, 0xa0, 0x73, 0x50, 0xb6, 0x9c,
-	0x81, 0xb8, 0xdf, 0x62, 0x0d, 0xf5, 0x4b, 0x85, 0x5e, 0x47, 0x68, 0xb8, 0xef, 0x1c, 0x61, 0xf7,
-	0x64, 0xfe, 0x3c, 0xee, 0x83, 0x08, 0x9b, 0xe7, 0x0c, 0xbe, 0x44, 0x07, 0xf4, 0x20, 0x3c, 0x84,
-	0xa2, 0x2c, 0xd3, 0x13, 0xd5, 0x3b, 0x9c, 0x49, 0xc3, 0xc3, 0xf8, 0x43, 0x85, 0x66, 0xa4, 0xe3,
-	0x4b, 0x99, 0xd5, 0xdb, 0x79, 0x29, 0x81, 0x8c, 0xfa, 0x95, 0x02, 0x9d, 0x30, 0x95, 0xe4, 0xad,
-	0x9e, 0xcc, 0x7b, 0xdf, 0xf3, 0x72, 0xe2, 0xab, 0x5f, 0x16, 0x17, 0x12, 0x44, 0x68, 0x73, 0x45,
-	0x46, 0xc1, 0x75, 0x84, 0x4d, 0xb3, 0xd2, 0xe9, 0x05, 0xcd, 0xc3, 0x32, 0x1d, 0xa8, 0x8a, 0x7c,
-	0x06, 0xbb, 0x94, 0x10, 0x6d, 0x22, 0x61, 0x17, 0x9e, 0x60, 0xff, 0x71, 0x3c, 0x15, 0xf2, 0xba,
-	0x37, 0x30, 0x7a, 0x51, 0x72, 0xc0, 0x2f, 0x4a, 0x4a, 0x89, 0x8b, 0x12, 0x0e, 0x57, 0x87, 0x94,
-	0x05, 0x52, 0x0b, 0x78, 0x55, 0x1b, 0xf6, 0x3b, 0x0a, 0xb4, 0xf9, 0x28, 0x74, 0x4c, 0x12, 0x12,
-	0x59, 0xd8, 0xc7, 0xc6, 0xd7, 0x9d, 0x2a, 0xf8, 0x6f, 0x05, 0x5a, 0x51, 0xab, 0x4b, 0x0d, 0xe7,
-	0xc7, 0x50, 0xa6, 0x99, 0x16, 0x3e, 0x83, 0xa9, 0xaa, 0x81, 0x61, 0x13, 0xb5, 0x4d, 0x5d, 0xed,
-	0x5d, 0xe1, 0x20, 0xf0, 0x66, 0x68, 0xfa, 0x8b, 0xa7, 0x37, 0xfd, 0xdc, 0x15, 0x72, 0xc6, 0x84,
-	0x2e, 0x4b, 0x51, 0x86, 0x00, 0xf4, 0x09, 0x54, 0x58, 0x8d, 0x09, 0xbf, 0x3c, 0xbc, 0x11, 0x27,
-	0xcd, 0xeb, 0x4f, 0x22, 0x79, 0x7f, 0x0a, 0xd0, 0x78, 0x27, 0xf5, 0x57, 0x61, 0x25, 0x8c, 0x46,
-	0xd9, 0xb0, 0xb3, 0x32, 0xad, 0xfa, 0xaf, 0x0a, 0x9c, 0xdd, 0x39, 0xb1, 0xfb, 0x49, 0xf6, 0x5f,
-	0x81, 0xca, 0xc8, 0xd2, 0xc3, 0x8c, 0x29, 0x6f, 0x51, 0x37, 0x90, 0x8d, 0x8d, 0x0d, 0x62, 0x43,
-	0xd8, 0x9e, 0xd5, 0x05, 0x6c, 0xd7, 0x99, 0x6a, 0xda, 0x6f, 0x88, 0xf0, 0x19, 0x1b, 0xcc, 0x5a,
-	0xb1, 0x34, 0xd4, 0xa2, 0x80, 0x52, 0x6b, 0xf5, 0x09, 0x00, 0x35, 0xe8, 0xbd, 0xd3, 0x18, 0x71,
-	0xda, 0x63, 0x83, 0xa8, 0xec, 0x5f, 0x14, 0xa0, 0x1d, 0xd9, 0xa5, 0xaf, 0xdb, 0xbf, 0xc9, 0x88,
-	0xca, 0x8a, 0x2f, 0x29, 0x2a, 0x2b, 0xcd, 0xef, 0xd3, 0x94, 0x65, 0x3e, 0xcd, 0x6f, 0x16, 0xa1,
-	0x19, 0xee, 0xda, 0xb6, 0xa5, 0xdb, 0x99, 0x9c, 0xb0, 0x23, 0xfc, 0xf9, 0xf8, 0x3e, 0xbd, 0x27,
-	0x93, 0x93, 0x8c, 0x83, 0xd0, 0x12, 0x24, 0xd0, 0x65, 0x7a, 0xe8, 0xae, 0xcf, 0x12, 0x5f, 0x3c,
-	0x86, 0x60, 0x02, 0x69, 0x0e, 0x31, 0xba, 0x0d, 0x88, 0x4b, 0x51, 0xcf, 0xb4, 0x7b, 0x1e, 0xee,
-	0x3b, 0xb6, 0xc1, 0xe4, 0xab, 0xac, 0xb5, 0xf8, 0x97, 0xae, 0xbd, 0xc3, 0xe0, 0xe8, 0x63, 0x28,
-	0xf9, 0x27, 0x23, 0xe6, 0xad, 0x34, 0xa5, 0xf6, 0x3e, 0x9c, 0xd7, 0xee, 0xc9, 0x08, 0x6b, 0x14,
-	0x3d, 0x28, 0x42, 0xf2, 0x5d, 0xfd, 0x88, 0xbb, 0x7e, 0x25, 0x2d, 0x02, 0x21, 0x1a, 0x23, 0xd8,
-	0xc3, 0x05, 0xe6, 0x22, 0xf1, 0x26, 0xe3, 0xec, 0x40, 0x68, 0x7b, 0xbe, 0x6f, 0xd1, 0xd4, 0x1d,
-	0xe5, 0xec, 0x00, 0xba, 0xeb, 0x5b, 0x64, 0x91, 0xbe, 0xe3, 0xeb, 0x16, 0x93, 0x8f, 0x1a, 0xd7,
-	0x0e, 0x04, 0x42, 0x03, 0x93, 0x7f, 0x29, 0x40, 0x2b, 0x9c, 0x98, 0x86, 0xbd, 0xb1, 0x95, 0x2d,
-	0x8f, 0x93, 0x53, 0x27, 0xd3, 0x44, 0xf1, 0xdb, 0x50, 0xe7, 0x5c, 0x71, 0x0a, 0xae, 0x02, 0xd6,
-	0x65, 0x63, 0x02, 0x9b, 0x97, 0x5f, 0x12, 0x9b, 0x57, 0x66, 0x48, 0x3e, 0xc8, 0xcf, 0x46, 0xfd,
-	0xa9, 0x02, 0x6f, 0xa4, 0xb4, 0xe6, 0xc4, 0xad, 0x9d, 0x1c, 0xfa, 0x71, 0x6d, 0x9a, 0x24, 0xc9,
-	0xf5, 0xff, 0x03, 0xa8, 0xb8, 0x94, 0x3a, 0xbf, 0x29, 0xba, 0x3e, 0x91, 0xf9, 0xd8, 0x44, 0x34,
-	0xde, 0x45, 0xfd, 0x23, 0x05, 0xce, 0xa7, 0xa7, 0x3a, 0x87, 0x51, 0x5f, 0x85, 0x05, 0x46, 0x3a,
-	0x90, 0xd1, 0x9b, 0x93, 0x65, 0x34, 0xdc, 0x1c, 0x2d, 0xe8, 0xa8, 0xee, 0xc0, 0x4a, 0x60, 0xfb,
-	0xc3, 0xad, 0xdf, 0xc4, 0xbe, 0x3e, 0x21, 0xf0, 0xb9, 0x0a, 0x75, 0xe6, 0x41, 0xb3, 0x80, 0x82,
-	0xa5, 0x0c, 0x60, 0x4f, 0x64, 0xda, 0xd4, 0xff, 0x54, 0xe0, 0x1c, 0x35, 0x9e, 0xc9, 0xab, 0x99,
-	0x3c, 0xd7, 0x76, 0xaa, 0xc8, 0x48, 0x6c, 0xe9, 0x43, 0x5e, 0x01, 0x53, 0xd3, 0x62, 0x30, 0xd4,
-	0x4d, 0x27, 0xe2, 0xa4, 0x01, 0x72, 0x78, 0xcf, 0x4b, 0x82, 0x71, 0x7a, 0xcd, 0x9b, 0xcc, 0xc0,
-	0x85, 0x46, 0xbb, 0x34, 0x8b, 0xd1, 0xde, 0x80, 0x37, 0x12, 0x2b, 0x9d, 0xe3, 0x44, 0xd5, 0xbf,
-	0x52, 0xc8, 0x71, 0xc4, 0x2a, 0x89, 0x66, 0x77, 0x5c, 0x2f, 0x8b, 0x3b, 0xa1, 0x9e, 0x69, 0x24,
-	0x95, 0x88, 0x81, 0x3e, 0x85, 0x9a, 0x8d, 0x8f, 0x7b, 0x51, 0x5f, 0x28, 0x87, 0x57, 0x5f, 0xb5,
-	0xf1, 0x31, 0xfd, 0xa5, 0x6e, 0xc1, 0xf9, 0xd4, 0x54, 0xe7, 0x59, 0xfb, 0xdf, 0x2b, 0x70, 0x61,
-	0xdd, 0x75, 0x46, 0x9f, 0x9b, 0xae, 0x3f, 0xd6, 0xad, 0xf8, 0x0d, 0xfa, 0xab, 0xc9, 0x6c, 0x7d,
-	0x16, 0xf1, 0x8a, 0x19, 0xff, 0xdc, 0x96, 0x48, 0x50, 0x7a, 0x52, 0x7c, 0xd1, 0x11, 0x1f, 0xfa,
-	0x3f, 0x8a, 0xb2, 0xc9, 0x73, 0xbc, 0x29, 0x7e, 0x49, 0x9e, 0x00, 0x43, 0x9a, 0x08, 0x2f, 0xce,
-	0x9a, 0x08, 0xcf, 0x50, 0xef, 0xa5, 0x97, 0xa4, 0xde, 0x4f, 0x9d, 0x99, 0xf9, 0x0c, 0xe2, 0x97,
-	0x14, 0xd4, 0x3a, 0xcf, 0x74, 0xbb, 0xb1, 0x0a, 0x10, 0x26, 0xec, 0x79, 0x21, 0x68, 0x1e, 0x32,
-	0x91, 0x5e, 0xe4, 0xb4, 0x84, 0x29, 0xe5, 0x96, 0x3e, 0x92, 0x42, 0xfe, 0x2e, 0x74, 0x64, 0x5c,
-	0x3a, 0x0f, 0xe7, 0xff, 0xa2, 0x00, 0xd0, 0x15, 0xb5, 0xc3, 0xb3, 0xd9, 0x82, 0xeb, 0x10, 0xf1,
-	0x46, 0x42, 0x79, 0x8f, 0x72, 0x91, 0x41, 0x44, 0x42, 0xc4, 0xa4, 0x04, 0x27, 0x15, 0xa7, 0x1a,
-	0x94, 0x4e, 0x44, 0x6a, 0x18, 0x53, 0x24, 0xd5, 0xef, 0x45, 0xa8, 0xb9, 0xce, 0x71, 0x8f, 0x88,
-	0x99, 0x11, 0x14, 0x47, 0xbb, 0xce, 0x31, 0x11, 0x3e, 0x03, 0x9d, 0x87, 0x05, 0x5f, 0xf7, 0x0e,
-	0x09, 0xfd, 0x4a, 0xa4, 0x88, 0xc3, 0x40, 0xe7, 0xa0, 0xbc, 0x6f, 0x5a, 0x98, 0xd5, 0x0c, 0xd4,
-	0x34, 0xd6, 0x40, 0xdf, 0x08, 0xaa, 0xf8, 0xaa, 0xb9, 0x0b, 0x75, 0x28, 0xbe, 0xfa, 0xa5, 0x02,
-	0x4b, 0xe1, 0xae, 0x51, 0x05, 0x44, 0x74, 0x1a, 0xd5, 0x67, 0x6b, 0x8e, 0xc1, 0x54, 0x45, 0x33,
-	0xc3, 0x22, 0xb0, 0x8e, 0x4c, 0x6b, 0x85, 0x5d, 0x26, 0x85, 0xc9, 0x64, 0x5d, 0x64, 0xd1, 0xa6,
-	0x11, 0x14, 0xae, 0x54, 0x5c, 0xe7, 0xb8, 0x6b, 0x88, 0xdd, 0x60, 0x95, 0xcf, 0x2c, 0x28, 0x24,
-	0xbb, 0xb1, 0x46, 0x8b, 0x9f, 0xaf, 0xc3, 0x22, 0x76, 0x5d, 0xc7, 0xed, 0x0d, 0xb1, 0xe7, 0xe9,
-	0x03, 0xcc, 0xfd, 0xf3, 0x06, 0x05, 0x6e, 0x32, 0x98, 0xfa, 0x27, 0x25, 0x68, 0x86, 0x4b, 0x09,
-	0xae, 0xc9, 0x4d, 0x23, 0xb8, 0x26, 0x37, 0xc9, 0xd1, 0x81, 0xcb, 0x54, 0xa1, 0x38, 0xdc, 0xd5,
-	0x42, 0x5b, 0xd1, 0x6a, 0x1c, 0xda, 0x35, 0x88, 0x59, 0x26, 0x42, 0x66, 0x3b, 0x06, 0x0e, 0x0f,
-	0x17, 0x02, 0x10, 0x3f, 0xdb, 0x18, 0x8f, 0x94, 0x72, 0xf0, 0x48, 0x39, 0x07, 0x8f, 0x54, 0x24,
-	0x3c, 0xb2, 0x02, 0x95, 0xbd, 0x71, 0xff, 0x10, 0xfb, 0xdc, 0x63, 0xe3, 0xad, 0x38, 0xef, 0x54,
-	0x13, 0xbc, 0x23, 0x58, 0xa4, 0x16, 0x65, 0x91, 0x8b, 0x50, 0x63, 0xf7, 0xb5, 0x3d, 0xdf, 0xa3,
-	0x97, 0x4f, 0x45, 0xad, 0xca, 0x00, 0xbb, 0x1e, 0xba, 0x1f, 0xb8, 0x73, 0x75, 0x99, 0xb0, 0x53,
-	0xad, 0x93, 0xe0, 0x92, 0xc0, 0x99, 0x7b, 0x07, 0x96, 0x22, 0xdb, 0x41, 0x6d, 0x44, 0x83, 0x4e,
-	0x35, 0xe2, 0xed, 0x53, 0x33, 0x71, 0x03, 0x9a, 0xe1, 0x96, 0x50, 0xbc, 0x45, 0x16, 0x64, 0x09,
-	0x28, 0x45, 0x13, 0x9c, 0xdc, 0x3c, 0x1d, 0x27, 0xa3, 0x0b, 0x50, 0xe5, 0xd1, 0x91, 0xd7, 0x5e,
-	0x8a, 0x25, 0x2b, 0xd4, 0x1f, 0x00, 0x0a, 0x67, 0x3f, 0x9f, 0xb7, 0x98, 0x60, 0x8f, 0x42, 0x92,
-	0x3d, 0xd4, 0x9f, 0x29, 0xb0, 0x1c, 0x1d, 0x6c, 0x56, 0xc3, 0xfb, 0x29, 0xd4, 0xd9, 0xf5, 0x5f,
-	0x8f, 0x08, 0x3e, 0x4f, 0x02, 0x5d, 0x9e, 0x78, 0x2e, 0x1a, 0x84, 0x6f, 0x27, 0x08, 0x7b, 0x1d,
-	0x3b, 0xee, 0xa1, 0x69, 0x0f, 0x7a, 0x64, 0x66, 0x81, 0xb8, 0x35, 0x38, 0x70, 0x8b, 0xc0, 0xd4,
-	0x1f, 0x2b, 0x70, 0xe5, 0xe9, 0xc8, 0xd0, 0x7d, 0x1c, 0xf1, 0x40, 0xe6, 0xad, 0x59, 0xfc, 0x38,
-	0x28, 0x1a, 0x2c, 0xe4, 0xbb, 0xc2, 0x62, 0xd8, 0xea, 0xdf, 0x88, 0xb9, 0xa4, 0x0a, 0x7d, 0x67,
-	0x9f, 0x4b, 0x07, 0xaa, 0x47, 0x9c, 0x5c, 0xf0, 0x16, 0x24, 0x68, 0xc7, 0xae, 0x49, 0x8b, 0xa7,
-	0xbf, 0x26, 0x55, 0x37, 0xe1, 0x82, 0x86, 0x3d, 0x6c, 0x1b, 0xb1, 0xd5, 0xcc, 0x9c, 0x6c, 0x1a,
-	0x41, 0x47, 0x46, 0x6e, 0x1e, 0x66, 0x65, 0xbe, 0x6b, 0xcf, 0x25, 0x64, 0x7d, 0xae, 0x8a, 0x89,
-	0xcb, 0x44, 0xc7, 0xf1, 0xd5, 0xbf, 0x2e, 0xc0, 0xf9, 0x87, 0x86, 0xc1, 0xb5, 0x38, 0xf7, 0xc6,
-	0x5e, 0x95, 0xa3, 0x9c, 0x74, 0x24, 0x8b, 0x69, 0x47, 0xf2, 0x65, 0x69, 0x56, 0x6e, 0x63, 0xec,
-	0xf1, 0x30, 0xb0, 0x9d, 0x2e, 0xab, 0x1f, 0x7a, 0xc0, 0xef, 0xcd, 0x48, 0x40, 0x4f, 0xed, 0xe7,
-	0x74, 0xff, 0xaa, 0x1a, 0x24, 0xcd, 0xd4, 0x11, 0xb4, 0xd3, 0x9b, 0x35, 0xa7, 0x2a, 0x09, 0x76,
-	0x64, 0xe4, 0xb0, 0x04, 0x6b, 0x83, 0xb8, 0x50, 0x14, 0xb4, 0xed, 0x78, 0xea, 0x7f, 0x15, 0xa0,
-	0xbd, 0xa3, 0x1f, 0xe1, 0xff, 0x3f, 0x07, 0xf4, 0x3d, 0x38, 0xe7, 0xe9, 0x47, 0xb8, 0x17, 0x09,
-	0x8c, 0x7b, 0x2e, 0x7e, 0xce, 0x5d, 0xd0, 0x77, 0x65, 0x9a, 0x44, 0x5a, 0x66, 0xa3, 0x2d, 0x7b,
-	0x31, 0xb8, 0x86, 0x9f, 0xa3, 0xb7, 0x61, 0x29, 0x5a, 0xc7, 0x45, 0xa6, 0x56, 0xa5, 0x5b, 0xbe,
-	0x18, 0x29, 0xd3, 0xea, 0x1a, 0xea, 0x73, 0xb8, 0xf4, 0xd4, 0xf6, 0xb0, 0xdf, 0x0d, 0x4b, 0x8d,
-	0xe6, 0x0c, 0x21, 0xaf, 0x42, 0x3d, 0xdc, 0xf8, 0xd4, 0xfb, 0x0f, 0xc3, 0x53, 0x1d, 0xe8, 0x6c,
-	0xea, 0xee, 0x61, 0x90, 0x66, 0x5e, 0x67, 0x25, 0x21, 0xaf, 0x70, 0xc0, 0x7d, 0x51, 0x21, 0xa5,
-	0xe1, 0x7d, 0xec, 0x62, 0xbb, 0x8f, 0x37, 0x9c, 0xfe, 0x61, 0xa4, 0x72, 0x58, 0x89, 0x56, 0x0e,
-	0xcf, 0x5a, 0x89, 0xac, 0xfe, 0xbc, 0x00, 0x2b, 0x0f, 0x2d, 0x1f, 0xbb, 0x61, 0xe4, 0x7f, 0x9a,
-	0x24, 0x46, 0x98, 0x55, 0x28, 0xcc, 0x90, 0x55, 0x48, 0x15, 0xc1, 0x17, 0xd3, 0x45, 0xf0, 0xb2,
-	0x1c, 0x48, 0x69, 0xc6, 0x1c, 0xc8, 0x43, 0x80, 0x91, 0xeb, 0x8c, 0xb0, 0xeb, 0x9b, 0x38, 0x08,
-	0xdf, 0x72, 0xb8, 0x2f, 0x91, 0x4e, 0xb7, 0x3e, 0x15, 0x55, 0x9e, 0xbb, 0x27, 0x23, 0x8c, 0x16,
-	0xa0, 0xb8, 0x85, 0x8f, 0x5b, 0x67, 0x10, 0x40, 0x65, 0xcb, 0x71, 0x87, 0xba, 0xd5, 0x52, 0x50,
-	0x1d, 0x16, 0xf8, 0xa5, 0x56, 0xab, 0x80, 0x16, 0xa1, 0xb6, 0x16, 0x5c, 0x0c, 0xb4, 0x8a, 0xb7,
-	0xfe, 0x4c, 0x81, 0xe5, 0xd4, 0xb5, 0x0b, 0x6a, 0x02, 0x3c, 0xb5, 0xfb, 0xfc, 0x3e, 0xaa, 0x75,
-	0x06, 0x35, 0xa0, 0x1a, 0xdc, 0x4e, 0x31, 0x7a, 0xbb, 0x0e, 0xc5, 0x6e, 0x15, 0x50, 0x0b, 0x1a,
-	0xac, 0xe3, 0xb8, 0xdf, 0xc7, 0x9e, 0xd7, 0x2a, 0x0a, 0xc8, 0x63, 0xdd, 0xb4, 0xc6, 0x2e, 0x6e,
-	0x95, 0xc8, 0x98, 0xbb, 0x0e, 0xaf, 0x73, 0x6f, 0x95, 0x11, 0x82, 0x66, 0x50, 0xf4, 0xce, 0x3b,
-	0x55, 0x22, 0xb0, 0xa0, 0xdb, 0xc2, 0xad, 0x67, 0xd1, 0xe4, 0x39, 0x5d, 0xde, 0x79, 0x38, 0xfb,
-	0xd4, 0x36, 0xf0, 0xbe, 0x69, 0x63, 0x23, 0xfc, 0xd4, 0x3a, 0x83, 0xce, 0xc2, 0xd2, 0x26, 0x76,
-	0x07, 0x38, 0x02, 0x2c, 0xa0, 0x65, 0x58, 0xdc, 0x34, 0x5f, 0x44, 0x40, 0x45, 0xb5, 0x54, 0x55,
-	0x5a, 0xca, 0xbd, 0xdf, 0xbd, 0x0c, 0x35, 0x72, 0x28, 0x6b, 0x8e, 0xe3, 0x1a, 0xc8, 0x02, 0x44,
-	0x9f, 0x85, 0x0c, 0x47, 0x8e, 0x2d, 0xde, 0x91, 0xa1, 0x3b, 0xf1, 0x73, 0xe0, 0x8d, 0x34, 0x22,
-	0xe7, 0xce, 0xce, 0x5b, 0x52, 0xfc, 0x04, 0xb2, 0x7a, 0x06, 0x0d, 0xe9, 0x68, 0xbb, 0xe6, 0x10,
-	0xef, 0x9a, 0xfd, 0xc3, 0xc0, 0xb3, 0xf8, 0x20, 0xc3, 0x8f, 0x48, 0xa3, 0x06, 0xe3, 0x5d, 0x97,
-	0x8e, 0xc7, 0xde, 0xed, 0x04, 0x56, 0x46, 0x3d, 0x83, 0x9e, 0xc3, 0xb9, 0x27, 0x38, 0xe2, 0xa4,
-	0x05, 0x03, 0xde, 0xcb, 0x1e, 0x30, 0x85, 0x7c, 0xca, 0x21, 0x37, 0xa0, 0x4c, 0xd9, 0x0d, 0xc9,
-	0xfc, 0xb8, 0xe8, 0x93, 0xef, 0xce, 0xb5, 0x6c, 0x04, 0x41, 0xed, 0x07, 0xb0, 0x94, 0x78, 0x28,
-	0x8a, 0x64, 0x5a, 0x5d, 0xfe, 0xe4, 0xb7, 0x73, 0x2b, 0x0f, 0xaa, 0x18, 0x6b, 0x00, 0xcd, 0xf8,
-	0x73, 0x12, 0x74, 0x33, 0xc7, 0xcb, 0x34, 0x36, 0xd2, 0xbb, 0xb9, 0xdf, 0xb0, 0x51, 0x26, 0x68,
-	0x25, 0x1f, 0x2e, 0xa2, 0x5b, 0x13, 0x09, 0xc4, 0x99, 0xed, 0xbd, 0x5c, 0xb8, 0x62, 0xb8, 0x13,
-	0xca, 0x04, 0xa9, 0x07, 0x63, 0x49, 0x1e, 0x0f, 0xc8, 0x64, 0xbd, 0x64, 0xeb, 0xdc, 0xcd, 0x8d,
-	0x2f, 0x86, 0xfe, 0x2d, 0x56, 0xb5, 0x22, 0x7b, 0x74, 0x85, 0x3e, 0x94, 0x93, 0x9b, 0xf0, 0x5a,
-	0xac, 0x73, 0xef, 0x34, 0x5d, 0xc4, 0x24, 0x7e, 0x44, 0xcb, 0x4d, 0x24, 0xcf, 0x96, 0x92, 0x72,
-	0x17, 0xd0, 0xcb, 0x7e, 0x91, 0xd5, 0xf9, 0xf0, 0x14, 0x3d, 0xc4, 0x04, 0x9c, 0xe4, 0xcb, 0xd0,
-	0x40, 0x0c, 0xef, 0x4e, 0xe5, 0x9a, 0xd9, 0x64, 0xf0, 0xfb, 0xb0, 0x94, 0xf0, 0x73, 0x50, 0x7e,
-	0x5f, 0xa8, 0x33, 0xc9, 0x19, 0x65, 0x22, 0x99, 0xa8, 0xde, 0x41, 0x19, 0xdc, 0x2f, 0xa9, 0xf0,
-	0xe9, 0xdc, 0xca, 0x83, 0x2a, 0x16, 0xe2, 0x51, 0x75, 0x99, 0xa8, 0xc9, 0x40, 0xb7, 0xe5, 0x34,
-	0xe4, 0xb5, 0x27, 0x9d, 0xf7, 0x73, 0x62, 0x8b, 0x41, 0x8f, 0xe0, 0xac, 0xa4, 0x74, 0x06, 0xbd,
-	0x3f, 0xf1, 0xb0, 0x92, 0x35, 0x43, 0x9d, 0x3b, 0x79, 0xd1, 0xc5, 0xb8, 0xbf, 0x01, 0x68, 0xe7,
-	0xc0, 0x39, 0x5e, 0x73, 0xec, 0x7d, 0x73, 0x30, 0x76, 0x75, 0xe6, 0x25, 0x64, 0xd9, 0x86, 0x34,
-	0x6a, 0x06, 0x8f, 0x4e, 0xec, 0x21, 0x06, 0xef, 0x01, 0x3c, 0xc1, 0xfe, 0x26, 0xf6, 0x5d, 0x22,
-	0x18, 0x6f, 0x67, 0x99, 0x3f, 0x8e, 0x10, 0x0c, 0xf5, 0xce, 0x54, 0xbc, 0x88, 0x29, 0x6a, 0x6d,
-	0xea, 0xf6, 0x58, 0xb7, 0x22, 0xb5, 0xff, 0xb7, 0xa5, 0xdd, 0x93, 0x68, 0x19, 0x07, 0x99, 0x89,
-	0x2d, 0x86, 0x3c, 0x16, 0xa6, 0x3d, 0x72, 0x15, 0x37, 0xd9, 0xb4, 0xa7, 0xcb, 0x40, 0x92, 0x6a,
-	0x6f, 0x02, 0xbe, 0x18, 0xf8, 0x0b, 0x85, 0x56, 0x5f, 0x25, 0x10, 0x9e, 0x99, 0xfe, 0xc1, 0xb6,
-	0xa5, 0xdb, 0x5e, 0x9e, 0x29, 0x50, 0xc4, 0x53, 0x4c, 0x81, 0xe3, 0x8b, 0x29, 0x18, 0xb0, 0x18,
-	0xbb, 0x21, 0x43, 0xb2, 0x62, 0x79, 0xd9, 0x6d, 0x61, 0xe7, 0xe6, 0x74, 0x44, 0x31, 0xca, 0x01,
-	0x2c, 0x06, 0xa2, 0xc4, 0x36, 0xf7, 0xdd, 0xac, 0x99, 0x86, 0x38, 0x19, 0x9a, 0x40, 0x8e, 0x1a,
-	0xd5, 0x04, 0xe9, 0x0b, 0x00, 0x94, 0xef, 0xe2, 0x68, 0x92, 0x26, 0xc8, 0xbe, 0x55, 0x60, 0xaa,
-	0x2e, 0x71, 0xd9, 0x26, 0xd7, 0xa3, 0xd2, 0xbb, 0x43, 0xa9, 0xaa, 0xcb, 0xb8, 0xbb, 0x53, 0xcf,
-	0xa0, 0x67, 0x50, 0xe1, 0xff, 0x73, 0xf2, 0xd6, 0xe4, 0xa4, 0x1d, 0xa7, 0x7e, 0x63, 0x0a, 0x96,
-	0x20, 0x7c, 0x08, 0xe7, 0x33, 0x52, 0x76, 0x52, 0x13, 0x3c, 0x39, 0xbd, 0x37, 0xcd, 0x38, 0x88,
-	0xc1, 0x52, 0x39, 0xb9, 0x09, 0x83, 0x65, 0xe5, 0xef, 0xa6, 0x0d, 0xa6, 0x03, 0x4a, 0x3f, 0xef,
-	0x95, 0xf2, 0x44, 0xe6, 0x2b, 0xe0, 0x1c, 0x43, 0xa4, 0x5f, 0xe8, 0x4a, 0x87, 0xc8, 0x7c, 0xc8,
-	0x3b, 0x6d, 0x88, 0x1e, 0x2c, 0xa7, 0x92, 0x36, 0xe8, 0xbd, 0x0c, 0x73, 0x2d, 0x4b, 0xed, 0x4c,
-	0x1b, 0x60, 0x00, 0x6f, 0x48, 0x13, 0x14, 0x52, 0xf7, 0x63, 0x52, 0x2a, 0x63, 0xda, 0x40, 0x7d,
-	0x38, 0x2b, 0x49, 0x4b, 0x48, 0x0d, 0x67, 0x76, 0xfa, 0x62, 0xda, 0x20, 0xfb, 0xd0, 0x59, 0x75,
-	0x1d, 0xdd, 0xe8, 0xeb, 0x9e, 0x4f, 0x53, 0x05, 0x24, 0x16, 0x0c, 0xfc, 0x3f, 0x79, 0x70, 0x20,
-	0x4d, 0x28, 0x4c, 0x1b, 0x67, 0x0f, 0xea, 0x94, 0x21, 0xd9, 0xff, 0x68, 0x20, 0xb9, 0xa5, 0x8b,
-	0x60, 0x64, 0xa8, 0x4f, 0x19, 0x62, 0x20, 0x9a, 0xf7, 0xbe, 0xac, 0x41, 0x35, 0x78, 0xaf, 0xf0,
-	0x35, 0x07, 0xa2, 0xaf, 0x21, 0x32, 0xfc, 0x3e, 0x2c, 0x25, 0xde, 0x0e, 0x4b, 0x8f, 0x4b, 0xfe,
-	0xbe, 0x78, 0xda, 0x71, 0x3d, 0xe3, 0x7f, 0xda, 0x25, 0x9c, 0xc4, 0x77, 0xb2, 0xa2, 0xcb, 0xa4,
-	0x7f, 0x38, 0x85, 0xf0, 0xff, 0x6d, 0xaf, 0x6c, 0x0b, 0x20, 0xe2, 0x8f, 0x4d, 0xae, 0xea, 0x23,
-	0x2e, 0xc6, 0xb4, 0xdd, 0x1a, 0x4a, 0x5d, 0xae, 0x77, 0xf3, 0x54, 0x48, 0x65, 0x1b, 0xcd, 0x6c,
-	0x47, 0xeb, 0x29, 0x34, 0xa2, 0xf5, 0xb6, 0x48, 0xfa, 0x17, 0x51, 0xe9, 0x82, 0xdc, 0x69, 0xab,
-	0xd8, 0x3c, 0xa5, 0x2d, 0x9e, 0x42, 0xce, 0x23, 0x46, 0x24, 0x79, 0x53, 0x93, 0x61, 0x44, 0x32,
-	0xee, 0x87, 0xa4, 0xbe, 0x4b, 0xf6, 0xf5, 0x0f, 0x4b, 0x32, 0x24, 0xaf, 0x1f, 0xa4, 0x49, 0x86,
-	0x8c, 0x0b, 0x1d, 0x69, 0x92, 0x21, 0xeb, 0x3e, 0x43, 0x3d, 0xb3, 0xfa, 0xd1, 0xf7, 0x3e, 0x1c,
-	0x98, 0xfe, 0xc1, 0x78, 0x8f, 0xac, 0xfe, 0x2e, 0xeb, 0xfa, 0xbe, 0xe9, 0xf0, 0x5f, 0x77, 0x03,
-	0x76, 0xbf, 0x4b, 0xa9, 0xdd, 0x25, 0xd4, 0x46, 0x7b, 0x7b, 0x15, 0xda, 0xfa, 0xe8, 0x7f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x50, 0x04, 0x47, 0xc9, 0x76, 0x50, 0x00, 0x00,
+	// 4701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4d, 0x8c, 0x1b, 0x59,
+	0x5a, 0x29, 0xdb, 0xed, 0xb6, 0x3f, 0xbb, 0xdd, 0xee, 0xd7, 0x9d, 0x8e, 0xe3, 0xfc, 0xd7, 0x4c,
+	0x66, 0x32, 0x99, 0xa4, 0x33, 0xd3, 0xc3, 0x88, 0xd9, 0xcd, 0xce, 0x0c, 0xe9, 0xee, 0x24, 0x63,
+	0x36, 0x9d, 0xed, 0xa9, 0xee, 0x4c, 0xa4, 0x5d, 0x50, 0xa9, 0xe2, 0x7a, 0xed, 0xae, 0x6d, 0xbb,
+	0xca, 0xa9, 0x2a, 0x77, 0xa7, 0x97, 0xc3, 0x0e, 0x20, 0x21, 0x81, 0x80, 0x45, 0x48, 0x08, 0x38,
+	0x20, 0x21, 0x4e, 0xfc, 0x68, 0x11, 0xd2, 0x8a, 0x0b, 0x17, 0x0e, 0x5c, 0x56, 0x80, 0x84, 0x10,
+	0x12, 0x47, 0xc4, 0x09, 0x90, 0x38, 0x72, 0xe1, 0xc0, 0x01, 0xbd, 0x9f, 0x7a, 0xf5, 0xf7, 0xca,
+	0xae, 0xb6, 0x93, 0x09, 0x62, 0x6f, 0x7e, 0x5f, 0x7d, 0xef, 0x7d, 0xef, 0xe7, 0xfb, 0xff, 0xde,
+	0x33, 0x34, 0x4d, 0xc3, 0x37, 0xf4, 0xae, 0xe3, 0xb8, 0xe6, 0xda, 0xd0, 0x75, 0x7c, 0x07, 0x2d,
+	0x0d, 0xac, 0xfe, 0xd1, 0xc8, 0x63, 0xad, 0x35, 0xf2, 0xb9, 0x5d, 0xef, 0x3a, 0x83, 0x81, 0x63,
+	0x33, 0x50, 0xbb, 0x61, 0xd9, 0x3e, 0x76, 0x6d, 0xa3, 0xcf, 0xdb, 0xf5, 0x68, 0x87, 0x76, 0xdd,
+	0xeb, 0x1e, 0xe0, 0x81, 0xc1, 0x5a, 0xea, 0x3c, 0xcc, 0xdd, 0x1f, 0x0c, 0xfd, 0x13, 0xf5, 0xf7,
+	0x14, 0xa8, 0x3f, 0xe8, 0x8f, 0xbc, 0x03, 0x0d, 0x3f, 0x1f, 0x61, 0xcf, 0x47, 0xef, 0x41, 0xe9,
+	0x99, 0xe1, 0xe1, 0x96, 0x72, 0x55, 0xb9, 0x51, 0x5b, 0xbf, 0xb8, 0x16, 0xa3, 0xca, 0xe9, 0x6d,
+	0x7b, 0xbd, 0x0d, 0xc3, 0xc3, 0x1a, 0xc5, 0x44, 0x08, 0x4a, 0xe6, 0xb3, 0xce, 0x56, 0xab, 0x70,
+	0x55, 0xb9, 0x51, 0xd4, 0xe8, 0x6f, 0x74, 0x19, 0xc0, 0xc3, 0xbd, 0x01, 0xb6, 0xfd, 0xce, 0x96,
+	0xd7, 0x2a, 0x5e, 0x2d, 0xde, 0x28, 0x6a, 0x11, 0x08, 0x52, 0xa1, 0xde, 0x75, 0xfa, 0x7d, 0xdc,
+	0xf5, 0x2d, 0xc7, 0xee, 0x6c, 0xb5, 0x4a, 0xb4, 0x6f, 0x0c, 0xa6, 0xfe, 0x9b, 0x02, 0x0b, 0x7c,
+	0x6a, 0xde, 0xd0, 0xb1, 0x3d, 0x8c, 0x3e, 0x80, 0xb2, 0xe7, 0x1b, 0xfe, 0xc8, 0xe3, 0xb3, 0xbb,
+	0x20, 0x9d, 0xdd, 0x2e, 0x45, 0xd1, 0x38, 0xaa, 0x74, 0x7a, 0x49, 0xf2, 0xc5, 0x34, 0xf9, 0xc4,
+	0x12, 0x4a, 0xa9, 0x25, 0xdc, 0x80, 0xc5, 0x7d, 0x32, 0xbb, 0xdd, 0x10, 0x69, 0x8e, 0x22, 0x25,
+	0xc1, 0x64, 0x24, 0xdf, 0x1a, 0xe0, 0x6f, 0xed, 0xef, 0x62, 0xa3, 0xdf, 0x2a, 0x53, 0x5a, 0x11,
+	0x88, 0xfa, 0x8f, 0x0a, 0x34, 0x05, 0x7a, 0x70, 0x0e, 0x2b, 0x30, 0xd7, 0x75, 0x46, 0xb6, 0x4f,
+	0x97, 0xba, 0xa0, 0xb1, 0x06, 0xba, 0x06, 0xf5, 0xee, 0x81, 0x61, 0xdb, 0xb8, 0xaf, 0xdb, 0xc6,
+	0x00, 0xd3, 0x45, 0x55, 0xb5, 0x1a, 0x87, 0x3d, 0x36, 0x06, 0x38, 0xd7, 0xda, 0xae, 0x42, 0x6d,
+	0x68, 0xb8, 0xbe, 0x15, 0xdb, 0xfd, 0x28, 0x08, 0xb5, 0xa1, 0x62, 0x79, 0x9d, 0xc1, 0xd0, 0x71,
+	0xfd, 0xd6, 0xdc, 0x55, 0xe5, 0x46, 0x45, 0x13, 0x6d, 0x42, 0xc1, 0xa2, 0xbf, 0xf6, 0x0c, 0xef,
+	0xb0, 0xb3, 0xc5, 0x57, 0x14, 0x83, 0xa9, 0x7f, 0xa8, 0xc0, 0xea, 0x3d, 0xcf, 0xb3, 0x7a, 0x76,
+	0x6a, 0x65, 0xab, 0x50, 0xb6, 0x1d, 0x13, 0x77, 0xb6, 0xe8, 0xd2, 0x8a, 0x1a, 0x6f, 0xa1, 0x0b,
+	0x50, 0x1d, 0x62, 0xec, 0xea, 0xae, 0xd3, 0x0f, 0x16, 0x56, 0x21, 0x00, 0xcd, 0xe9, 0x63, 0xf4,
+	0x39, 0x2c, 0x79, 0x89, 0x81, 0x18, 0x5f, 0xd5, 0xd6, 0xdf, 0x58, 0x4b, 0x49, 0xc6, 0x5a, 0x92,
+	0xa8, 0x96, 0xee, 0xad, 0x7e, 0x59, 0x80, 0x65, 0x81, 0xc7, 0xe6, 0x4a, 0x7e, 0x93, 0x9d, 0xf7,
+	0x70, 0x4f, 0x4c, 0x8f, 0x35, 0xf2, 0xec, 0xbc, 0x38, 0xb2, 0x62, 0xf4, 0xc8, 0x72, 0xb0, 0x7a,
+	0xf2, 0x3c, 0xe6, 0xd2, 0xe7, 0x71, 0x05, 0x6a, 0xf8, 0xc5, 0xd0, 0x72, 0xb1, 0x4e, 0x18, 0x87,
+	0x6e, 0x79, 0x49, 0x03, 0x06, 0xda, 0xb3, 0x06, 0x51, 0xd9, 0x98, 0xcf, 0x2d, 0x1b, 0xea, 0x1f,
+	0x29, 0x70, 0x2e, 0x75, 0x4a, 0x5c, 0xd8, 0x34, 0x68, 0xd2, 0x95, 0x87, 0x3b, 0x43, 0xc4, 0x8e,
+	0x6c, 0xf8, 0x5b, 0xe3, 0x36, 0x3c, 0x44, 0xd7, 0x52, 0xfd, 0x23, 0x93, 0x2c, 0xe4, 0x9f, 0xe4,
+	0x21, 0x9c, 0x7b, 0x88, 0x7d, 0x4e, 0x80, 0x7c, 0xc3, 0xde, 0xf4, 0xca, 0x2a, 0x2e, 0xd5, 0x85,
+	0xa4, 0x54, 0xab, 0x7f, 0x51, 0x80, 0x66, 0x94, 0x54, 0xc7, 0xde, 0x77, 0xd0, 0x45, 0xa8, 0x0a,
+	0x14, 0xce, 0x15, 0x21, 0x00, 0xfd, 0x34, 0xcc, 0x91, 0x99, 0x32, 0x96, 0x68, 0xac, 0x5f, 0x93,
+	0xaf, 0x29, 0x32, 0xa6, 0xc6, 0xf0, 0x51, 0x07, 0x1a, 0x9e, 0x6f, 0xb8, 0xbe, 0x3e, 0x74, 0x3c,
+	0x7a, 0xce, 0x94, 0x71, 0x6a, 0xeb, 0x6a, 0x7c, 0x04, 0xa1, 0xd6, 0xb7, 0xbd, 0xde, 0x0e, 0xc7,
+	0xd4, 0x16, 0x68, 0xcf, 0xa0, 0x89, 0xee, 0x43, 0x1d, 0xdb, 0x66, 0x38, 0x50, 0x29, 0xf7, 0x40,
+	0x35, 0x6c, 0x9b, 0x62, 0x98, 0xf0, 0x7c, 0xe6, 0xf2, 0x9f, 0xcf, 0xaf, 0x2b, 0xd0, 0x4a, 0x1f,
+	0xd0, 0x2c, 0x2a, 0xfb, 0x2e, 0xeb, 0x84, 0xd9, 0x01, 0x8d, 0x95, 0x70, 0x71, 0x48, 0x1a, 0xef,
+	0xa2, 0xfe, 0x8e, 0x02, 0x67, 0xc3, 0xe9, 0xd0, 0x4f, 0xaf, 0x8a, 0x5b, 0xd0, 0x4d, 0x68, 0x5a,
+	0x76, 0xb7, 0x3f, 0x32, 0xf1, 0x13, 0xfb, 0x33, 0x6c, 0xf4, 0xfd, 0x83, 0x13, 0x7a, 0x86, 0x15,
+	0x2d, 0x05, 0x57, 0xff, 0xa5, 0x00, 0xab, 0xc9, 0x79, 0xcd, 0xb2, 0x49, 0x3f, 0x05, 0x73, 0x96,
+	0xbd, 0xef, 0x04, 0x7b, 0x74, 0x79, 0x8c, 0x50, 0x12, 0x5a, 0x0c, 0x19, 0x39, 0x80, 0x02, 0x35,
+	0xd6, 0x3d, 0xc0, 0xdd, 0xc3, 0xa1, 0x63, 0x51, 0x85, 0x45, 0x86, 0xf8, 0x19, 0xc9, 0x10, 0xf2,
+	0x19, 0xaf, 0x6d, 0xb2, 0x31, 0x36, 0xc5, 0x10, 0xf7, 0x6d, 0xdf, 0x3d, 0xd1, 0x96, 0xba, 0x49,
+	0x78, 0xfb, 0x00, 0x56, 0xe5, 0xc8, 0xa8, 0x09, 0xc5, 0x43, 0x7c, 0x42, 0x97, 0x5c, 0xd5, 0xc8,
+	0x4f, 0xf4, 0x11, 0xcc, 0x1d, 0x19, 0xfd, 0x11, 0x6e, 0x15, 0x72, 0xb3, 0x2f, 0xeb, 0xf0, 0xf5,
+	0xc2, 0x47, 0x8a, 0x3a, 0x80, 0x0b, 0x0f, 0xb1, 0xdf, 0xb1, 0x3d, 0xec, 0xfa, 0x1b, 0x96, 0xdd,
+	0x77, 0x7a, 0x3b, 0x86, 0x7f, 0x30, 0x83, 0xae, 0x88, 0x89, 0x7d, 0x21, 0x21, 0xf6, 0xea, 0x1f,
+	0x2b, 0x70, 0x51, 0x4e, 0x8f, 0x9f, 0x6a, 0x1b, 0x2a, 0xfb, 0x16, 0xee, 0x9b, 0x9d, 0x2d, 0xa6,
+	0x38, 0x8b, 0x9a, 0x68, 0x13, 0x9d, 0x31, 0x24, 0xc8, 0xfc, 0xf0, 0xae, 0x65, 0xac, 0x74, 0xd7,
+	0x77, 0x2d, 0xbb, 0xf7, 0xc8, 0xf2, 0x7c, 0x8d, 0xe1, 0x47, 0x58, 0xa5, 0x98, 0x5f, 0x42, 0x7f,
+	0x4d, 0x81, 0xcb, 0x0f, 0xb1, 0xbf, 0x29, 0x4c, 0x0e, 0xf9, 0x6e, 0x79, 0xbe, 0xd5, 0xf5, 0x5e,
+	0xae, 0xdb, 0x97, 0xc3, 0xf7, 0x50, 0x7f, 0xa0, 0xc0, 0x95, 0xcc, 0xc9, 0xf0, 0xad, 0xe3, 0x2a,
+	0x35, 0x30, 0x38, 0x72, 0x95, 0xfa, 0x4d, 0x7c, 0xf2, 0x05, 0x39, 0xfc, 0x1d, 0xc3, 0x72, 0x99,
+	0x4a, 0x9d, 0xd2, 0xc0, 0xfc, 0x50, 0x81, 0x4b, 0x0f, 0xb1, 0xbf, 0x13, 0x98, 0xdb, 0xd7, 0xb8,
+	0x3b, 0x04, 0x27, 0x62, 0xf6, 0x03, 0xbf, 0x33, 0x06, 0x53, 0x7f, 0x93, 0x1d, 0xa7, 0x74, 0xbe,
+	0xaf, 0x65, 0x03, 0x2f, 0xc3, 0xc5, 0xb8, 0x9e, 0xe0, 0x12, 0xcf, 0xb7, 0x4f, 0xfd, 0x03, 0x05,
+	0xce, 0xdf, 0xeb, 0x3e, 0x1f, 0x59, 0x2e, 0xe6, 0x48, 0x8f, 0x9c, 0xee, 0xe1, 0xf4, 0x9b, 0x1b,
+	0x7a, 0x90, 0x85, 0x98, 0x07, 0x39, 0x29, 0xea, 0x58, 0x85, 0xb2, 0xcf, 0x5c, 0x56, 0xe6, 0x84,
+	0xf1, 0x16, 0x9d, 0x9f, 0x86, 0xfb, 0xd8, 0xf0, 0xfe, 0x6f, 0xce, 0xef, 0x07, 0x25, 0xa8, 0x7f,
+	0xc1, 0x55, 0x2b, 0x75, 0x48, 0x92, 0x9c, 0xa4, 0xc8, 0x7d, 0xca, 0x88, 0x73, 0x2a, 0xf3, 0x57,
+	0x1f, 0xc2, 0x82, 0x87, 0xf1, 0xe1, 0x34, 0xee, 0x47, 0x9d, 0x74, 0x0c, 0x5a, 0xe8, 0x11, 0x2c,
+	0x8d, 0x6c, 0x1a, 0xf5, 0x60, 0x93, 0x6f, 0x20, 0xe3, 0xdc, 0xc9, 0x66, 0x29, 0xdd, 0x11, 0x7d,
+	0x06, 0x8b, 0x09, 0x50, 0x6b, 0x2e, 0xd7, 0x58, 0xc9, 0x6e, 0xa8, 0x03, 0x4d, 0xd3, 0x75, 0x86,
+	0x43, 0x6c, 0xea, 0x5e, 0x30, 0x54, 0x39, 0xdf, 0x50, 0xbc, 0x9f, 0x18, 0xea, 0x3d, 0x58, 0x4e,
+	0xce, 0xb4, 0x63, 0x12, 0x5f, 0x9b, 0x9c, 0xa1, 0xec, 0x13, 0xba, 0x05, 0x4b, 0x69, 0xfc, 0x0a,
+	0xc5, 0x4f, 0x7f, 0x40, 0xb7, 0x01, 0x25, 0xa6, 0x4a, 0xd0, 0xab, 0x0c, 0x3d, 0x3e, 0x99, 0x8e,
+	0xe9, 0xa9, 0xbf, 0xaa, 0xc0, 0xea, 0x53, 0xc3, 0xef, 0x1e, 0x6c, 0x0d, 0xb8, 0xac, 0xcd, 0xa0,
+	0xab, 0x3e, 0x86, 0xea, 0x11, 0xe7, 0x8b, 0xc0, 0x20, 0x5d, 0x91, 0xec, 0x4f, 0x94, 0x03, 0xb5,
+	0xb0, 0x07, 0x09, 0xf5, 0x56, 0x1e, 0x44, 0x42, 0xde, 0xd7, 0xa0, 0x35, 0x27, 0xc4, 0xea, 0xea,
+	0x0b, 0x00, 0x3e, 0xb9, 0x6d, 0xaf, 0x37, 0xc5, 0xbc, 0x3e, 0x82, 0x79, 0x3e, 0x1a, 0x57, 0x8b,
+	0x93, 0xf8, 0x27, 0x40, 0x57, 0xff, 0x7e, 0x1e, 0x6a, 0x91, 0x0f, 0xa8, 0x01, 0x05, 0x21, 0xaf,
+	0x05, 0xc9, 0xea, 0x0a, 0x93, 0xa3, 0xc3, 0x62, 0x3a, 0x3a, 0xbc, 0x0e, 0x0d, 0x8b, 0xfa, 0x21,
+	0x3a, 0x3f, 0x15, 0xaa, 0x40, 0xaa, 0xda, 0x02, 0x83, 0x72, 0x16, 0x41, 0x97, 0xa1, 0x66, 0x8f,
+	0x06, 0xba, 0xb3, 0xaf, 0xbb, 0xce, 0xb1, 0xc7, 0xc3, 0xcc, 0xaa, 0x3d, 0x1a, 0x7c, 0x6b, 0x5f,
+	0x73, 0x8e, 0xbd, 0x30, 0x92, 0x29, 0x9f, 0x32, 0x92, 0xb9, 0x0c, 0xb5, 0x81, 0xf1, 0x82, 0x8c,
+	0xaa, 0xdb, 0xa3, 0x01, 0x8d, 0x40, 0x8b, 0x5a, 0x75, 0x60, 0xbc, 0xd0, 0x9c, 0xe3, 0xc7, 0xa3,
+	0x01, 0xba, 0x01, 0xcd, 0xbe, 0xe1, 0xf9, 0x7a, 0x34, 0x84, 0xad, 0xd0, 0x10, 0xb6, 0x41, 0xe0,
+	0xf7, 0xc3, 0x30, 0x36, 0x1d, 0x13, 0x55, 0x67, 0x88, 0x89, 0xcc, 0x41, 0x3f, 0x1c, 0x08, 0xf2,
+	0xc7, 0x44, 0xe6, 0xa0, 0x2f, 0x86, 0xf9, 0x08, 0xe6, 0x9f, 0x51, 0xef, 0xce, 0x6b, 0xd5, 0x32,
+	0x75, 0xc7, 0x03, 0xe2, 0xd8, 0x31, 0x27, 0x50, 0x0b, 0xd0, 0xd1, 0x37, 0xa0, 0x4a, 0x8d, 0x2a,
+	0xed, 0x5b, 0xcf, 0xd5, 0x37, 0xec, 0x40, 0x7a, 0x9b, 0xb8, 0xef, 0x1b, 0xb4, 0xf7, 0x42, 0xbe,
+	0xde, 0xa2, 0x03, 0xd1, 0x57, 0x5d, 0x17, 0x1b, 0x3e, 0x36, 0x37, 0x4e, 0x36, 0x9d, 0xc1, 0xd0,
+	0xa0, 0xcc, 0xd4, 0x6a, 0xd0, 0xe0, 0x44, 0xf6, 0x09, 0xbd, 0x05, 0x8d, 0xae, 0x68, 0x3d, 0x70,
+	0x9d, 0x41, 0x6b, 0x91, 0xca, 0x51, 0x02, 0x8a, 0x2e, 0x01, 0x04, 0x9a, 0xca, 0xf0, 0x5b, 0x4d,
+	0x7a, 0x8a, 0x55, 0x0e, 0xb9, 0x47, 0x33, 0x54, 0x96, 0xa7, 0xb3, 0x5c, 0x90, 0x65, 0xf7, 0x5a,
+	0x4b, 0x94, 0x62, 0x2d, 0x48, 0x1e, 0x59, 0x76, 0x0f, 0x9d, 0x83, 0x79, 0xcb, 0xd3, 0xf7, 0x8d,
+	0x43, 0xdc, 0x42, 0xf4, 0x6b, 0xd9, 0xf2, 0x1e, 0x18, 0x87, 0x98, 0x24, 0x39, 0xba, 0x7d, 0xc7,
+	0xc6, 0xa6, 0xbe, 0x4f, 0xe8, 0x2f, 0xb3, 0x4c, 0x19, 0x03, 0x51, 0xda, 0x6f, 0xc3, 0xa2, 0xe7,
+	0x3b, 0xae, 0xd1, 0xc3, 0xfa, 0x11, 0x76, 0x3d, 0xb2, 0xa2, 0x15, 0x8a, 0xd4, 0xe0, 0xe0, 0x2f,
+	0x18, 0x14, 0xdd, 0x84, 0xa5, 0x80, 0x21, 0x09, 0xb3, 0x79, 0xbe, 0x31, 0x18, 0xb6, 0xce, 0xd2,
+	0xb9, 0x2e, 0x32, 0xb6, 0xdc, 0x0b, 0xc0, 0x14, 0xd7, 0xb2, 0x13, 0xb8, 0xab, 0x1c, 0xd7, 0xb2,
+	0xa3, 0xb8, 0xea, 0xf7, 0x61, 0x25, 0xe4, 0xff, 0x08, 0xaf, 0xa5, 0xd9, 0x56, 0x99, 0x96, 0x6d,
+	0xc7, 0x47, 0x1d, 0xff, 0x5d, 0x82, 0xd5, 0x5d, 0xe3, 0x08, 0xbf, 0xfa, 0x00, 0x27, 0x97, 0xe2,
+	0x7d, 0x04, 0x4b, 0x34, 0xa6, 0x59, 0x8f, 0xcc, 0xa7, 0x55, 0xca, 0xc5, 0xac, 0xe9, 0x8e, 0xe8,
+	0x53, 0xe2, 0xb2, 0xe0, 0xee, 0xe1, 0x8e, 0x63, 0x85, 0x56, 0xff, 0x92, 0x64, 0x9c, 0x4d, 0x81,
+	0xa5, 0x45, 0x7b, 0xa0, 0x1d, 0x58, 0x8c, 0x1f, 0x43, 0x60, 0xef, 0xdf, 0x1e, 0x9b, 0x41, 0x08,
+	0x77, 0x5f, 0x6b, 0xc4, 0x0e, 0xc3, 0x43, 0x2d, 0x98, 0xe7, 0xc6, 0x9a, 0x6a, 0xb5, 0x8a, 0x16,
+	0x34, 0xd1, 0x0e, 0x2c, 0xb3, 0x15, 0xec, 0x72, 0x91, 0x65, 0x8b, 0xaf, 0xe4, 0x5a, 0xbc, 0xac,
+	0x6b, 0x5c, 0xe2, 0xab, 0xa7, 0x95, 0xf8, 0x16, 0xcc, 0x73, 0x29, 0xa4, 0x9a, 0xae, 0xa2, 0x05,
+	0x4d, 0x72, 0xcc, 0xa1, 0x3c, 0xd6, 0xe8, 0xb7, 0x10, 0x20, 0x93, 0xa9, 0xba, 0x4c, 0xa6, 0x48,
+	0x14, 0x09, 0xe1, 0xc6, 0x4f, 0x48, 0x8a, 0x7d, 0x02, 0x15, 0x21, 0x0a, 0xf9, 0xa3, 0x79, 0xd1,
+	0x27, 0x69, 0xaa, 0x8a, 0x09, 0x53, 0xa5, 0xfe, 0x9d, 0x02, 0xf5, 0x2d, 0xb2, 0xf6, 0x47, 0x4e,
+	0x8f, 0x1a, 0xd6, 0xeb, 0xd0, 0x70, 0x71, 0xd7, 0x71, 0x4d, 0x1d, 0xdb, 0xbe, 0x6b, 0x61, 0x96,
+	0x4b, 0x29, 0x69, 0x0b, 0x0c, 0x7a, 0x9f, 0x01, 0x09, 0x9a, 0x10, 0x72, 0xa6, 0x65, 0x0a, 0x0c,
+	0x4d, 0x40, 0xa9, 0xa2, 0xb9, 0x06, 0xf5, 0x10, 0xcd, 0x77, 0x28, 0xfd, 0x92, 0x56, 0x13, 0xb0,
+	0x3d, 0x07, 0xbd, 0x09, 0x0d, 0xba, 0xf9, 0x7a, 0xdf, 0xe9, 0xe9, 0x24, 0x38, 0xe7, 0x36, 0xb7,
+	0x6e, 0xf2, 0x69, 0x91, 0x43, 0x8d, 0x63, 0x79, 0xd6, 0xf7, 0x30, 0xb7, 0xba, 0x02, 0x6b, 0xd7,
+	0xfa, 0x1e, 0x56, 0xff, 0x56, 0x81, 0x85, 0x2d, 0xc3, 0x37, 0x1e, 0x3b, 0x26, 0xde, 0x9b, 0xd2,
+	0x47, 0xc9, 0x91, 0xa0, 0xbe, 0x08, 0xd5, 0x50, 0xc3, 0xb1, 0x25, 0x85, 0x00, 0xf4, 0x00, 0x1a,
+	0x81, 0x97, 0xac, 0xb3, 0xe0, 0xb1, 0x94, 0xe9, 0x0b, 0x46, 0x9c, 0x00, 0x4f, 0x5b, 0x08, 0xba,
+	0xd1, 0xa6, 0xfa, 0x00, 0xea, 0xd1, 0xcf, 0x84, 0xea, 0x6e, 0x92, 0x51, 0x04, 0x80, 0xb0, 0xed,
+	0xe3, 0xd1, 0x80, 0x9c, 0x29, 0xd7, 0x40, 0x41, 0x53, 0xfd, 0x65, 0x05, 0x16, 0xb8, 0xe7, 0xb2,
+	0x2b, 0x4a, 0x39, 0x74, 0x69, 0x2c, 0x65, 0x44, 0x7f, 0xa3, 0xaf, 0xc7, 0xb3, 0xaf, 0x6f, 0x4a,
+	0xb5, 0x05, 0x1d, 0x84, 0xfa, 0xcb, 0x31, 0xb7, 0x25, 0x4f, 0xba, 0xe2, 0x4b, 0xc2, 0x68, 0xfc,
+	0x68, 0x28, 0xa3, 0xb5, 0x60, 0xde, 0x30, 0x4d, 0x17, 0x7b, 0x1e, 0x9f, 0x47, 0xd0, 0x24, 0x5f,
+	0x02, 0x09, 0xe2, 0x4b, 0xe1, 0x4d, 0xf4, 0x0d, 0xa8, 0x08, 0x07, 0x9b, 0xe5, 0xda, 0xae, 0x66,
+	0xcf, 0x93, 0x07, 0xd7, 0xa2, 0x87, 0xfa, 0x97, 0x05, 0x68, 0xf0, 0x0d, 0xdb, 0xe0, 0xae, 0xc5,
+	0x78, 0xe1, 0xdb, 0x80, 0xfa, 0x7e, 0xa8, 0x24, 0xc6, 0x65, 0x08, 0xa3, 0xba, 0x24, 0xd6, 0x67,
+	0x92, 0x00, 0xc6, 0x9d, 0x9b, 0xd2, 0x4c, 0xce, 0xcd, 0xdc, 0x69, 0x55, 0x5d, 0xda, 0xdd, 0x2d,
+	0x4b, 0xdc, 0x5d, 0xf5, 0xe7, 0xa0, 0x16, 0x19, 0x80, 0xaa, 0x72, 0x96, 0x7f, 0xe3, 0x3b, 0x16,
+	0x34, 0xd1, 0x07, 0xa1, 0x8b, 0xc7, 0xb6, 0xea, 0xbc, 0x64, 0x2e, 0x09, 0xef, 0x4e, 0xfd, 0x4f,
+	0x05, 0xca, 0x7c, 0x64, 0x52, 0x9c, 0x61, 0xfa, 0x85, 0xba, 0xbf, 0x6c, 0x74, 0xe0, 0x20, 0xe2,
+	0xff, 0xbe, 0x3c, 0xad, 0x73, 0x1e, 0x2a, 0x09, 0x7d, 0x33, 0xcf, 0xed, 0x47, 0xf0, 0x29, 0xa2,
+	0x64, 0xe6, 0xfb, 0x4c, 0xbf, 0x90, 0xca, 0x54, 0xdf, 0xe9, 0x89, 0x52, 0x1d, 0x6b, 0x10, 0xc7,
+	0x07, 0xdb, 0x5d, 0xf7, 0x64, 0x48, 0x58, 0x5d, 0x3f, 0xc4, 0x27, 0xba, 0xc5, 0xac, 0x5c, 0x55,
+	0x5b, 0x0c, 0x3f, 0x7c, 0x13, 0x9f, 0x74, 0x4c, 0xf5, 0xc7, 0x0a, 0xad, 0xc2, 0x68, 0xb8, 0xeb,
+	0x1c, 0x61, 0xf7, 0x64, 0xf6, 0xf4, 0xf5, 0xdd, 0x88, 0x48, 0xe4, 0x8c, 0x39, 0x45, 0x07, 0x74,
+	0x37, 0x3c, 0xb0, 0xa2, 0x2c, 0xc1, 0x15, 0xd5, 0x51, 0x9c, 0xa1, 0xc3, 0x83, 0xfb, 0x2d, 0x05,
+	0x56, 0x53, 0x4b, 0x99, 0xd6, 0x85, 0x7a, 0x29, 0xf1, 0x9b, 0xfa, 0x0f, 0x0a, 0xb4, 0xc3, 0x0c,
+	0x9a, 0xb7, 0x71, 0x32, 0x6b, 0x99, 0xeb, 0xe5, 0x84, 0x95, 0x5f, 0x13, 0x75, 0x18, 0x22, 0xe0,
+	0xb9, 0x02, 0x42, 0xde, 0x41, 0xb5, 0x69, 0x32, 0x3e, 0xbd, 0xa0, 0x59, 0x58, 0xa6, 0x0d, 0x15,
+	0x91, 0xc6, 0x61, 0xb5, 0x18, 0xd1, 0x56, 0xff, 0x5a, 0x81, 0xf3, 0x0f, 0xb1, 0xff, 0x20, 0x9e,
+	0x01, 0x7a, 0xdd, 0x1b, 0x18, 0xad, 0x0f, 0x1d, 0xf0, 0xfa, 0x50, 0x29, 0x51, 0x1f, 0xe2, 0x70,
+	0x75, 0x00, 0x6d, 0xd9, 0x02, 0x5e, 0xd5, 0x86, 0xfd, 0x8a, 0x02, 0x2d, 0x4e, 0x85, 0xd2, 0x24,
+	0x91, 0x60, 0x1f, 0xfb, 0xd8, 0xfc, 0xaa, 0x33, 0x24, 0xff, 0xa3, 0x40, 0x33, 0x6a, 0xa1, 0xc9,
+	0x57, 0xf4, 0x21, 0xcc, 0xd1, 0x04, 0x13, 0x9f, 0xc1, 0x44, 0xd5, 0xc0, 0xb0, 0x89, 0x8a, 0xa7,
+	0xfe, 0xfb, 0x9e, 0x70, 0x26, 0x78, 0x33, 0x74, 0x13, 0x8a, 0xa7, 0x77, 0x13, 0xb8, 0xdb, 0xe4,
+	0x8c, 0xc8, 0xb8, 0x2c, 0x33, 0x1b, 0x02, 0xd0, 0xc7, 0x50, 0x66, 0x57, 0x6b, 0x78, 0xcd, 0xf4,
+	0x7a, 0x7c, 0x68, 0xf6, 0x6d, 0x2d, 0x52, 0xee, 0xa0, 0x00, 0x8d, 0x77, 0x52, 0x7f, 0x16, 0x56,
+	0xc3, 0x20, 0x9c, 0x91, 0x9d, 0x96, 0x69, 0xd5, 0x7f, 0x56, 0x60, 0x79, 0xf7, 0xc4, 0xee, 0x26,
+	0xd9, 0x7f, 0x15, 0xca, 0xc3, 0xbe, 0x11, 0x26, 0x8a, 0x79, 0x8b, 0xba, 0x8c, 0x8c, 0x36, 0x36,
+	0x89, 0xbd, 0x61, 0x7b, 0x56, 0x13, 0xb0, 0x3d, 0x67, 0xa2, 0x1b, 0x70, 0x5d, 0x64, 0x0d, 0x82,
+	0xa8, 0x9d, 0x65, 0xdf, 0x16, 0x04, 0x94, 0x5a, 0xb6, 0x8f, 0x01, 0xa8, 0xf1, 0xd7, 0x4f, 0x63,
+	0xf0, 0x69, 0x8f, 0x47, 0x44, 0x65, 0xff, 0xa8, 0x00, 0xad, 0xc8, 0x2e, 0x7d, 0xd5, 0xbe, 0x50,
+	0x46, 0xa8, 0x57, 0x7c, 0x49, 0xa1, 0x5e, 0x69, 0x76, 0xff, 0x67, 0x4e, 0xe6, 0xff, 0xfc, 0x62,
+	0x11, 0x1a, 0xe1, 0xae, 0xed, 0xf4, 0x0d, 0x3b, 0x93, 0x13, 0x76, 0x85, 0xef, 0x1f, 0xdf, 0xa7,
+	0x77, 0x65, 0x72, 0x92, 0x71, 0x10, 0x5a, 0x62, 0x08, 0x92, 0x29, 0x62, 0xd1, 0x38, 0xcd, 0xf7,
+	0xf1, 0x78, 0x83, 0x09, 0x24, 0x49, 0xf5, 0xdd, 0x02, 0xc4, 0xa5, 0x48, 0xb7, 0x6c, 0xdd, 0xc3,
+	0x5d, 0xc7, 0x36, 0x99, 0x7c, 0xcd, 0x69, 0x4d, 0xfe, 0xa5, 0x63, 0xef, 0x32, 0x38, 0xfa, 0x10,
+	0x4a, 0xfe, 0xc9, 0x90, 0x79, 0x36, 0x8d, 0xf5, 0x6b, 0x63, 0xe7, 0xb5, 0x77, 0x32, 0xc4, 0x1a,
+	0x45, 0x0f, 0xee, 0x5e, 0xf9, 0xae, 0x71, 0xc4, 0xdd, 0xc4, 0x92, 0x16, 0x81, 0x10, 0x8d, 0x11,
+	0xec, 0x21, 0xf3, 0x7c, 0x82, 0x26, 0xe3, 0xec, 0x40, 0x68, 0x75, 0xdf, 0xef, 0xd3, 0x8c, 0x25,
+	0xe5, 0xec, 0x00, 0xba, 0xe7, 0xf7, 0xc9, 0x22, 0x7d, 0xc7, 0x37, 0xfa, 0x4c, 0x3e, 0xaa, 0x5c,
+	0x3b, 0x10, 0x08, 0x0d, 0x62, 0xfe, 0xa9, 0x00, 0xcd, 0x70, 0x62, 0x1a, 0xf6, 0x46, 0xfd, 0x6c,
+	0x79, 0x1c, 0x9f, 0x8f, 0x99, 0x24, 0x8a, 0x9f, 0x42, 0x8d, 0x73, 0xc5, 0x29, 0xb8, 0x0a, 0x58,
+	0x97, 0x47, 0x63, 0xd8, 0x7c, 0xee, 0x25, 0xb1, 0x79, 0x79, 0x8a, 0x8c, 0x86, 0xfc, 0x6c, 0x48,
+	0xed, 0xfd, 0x6c, 0x4a, 0x6b, 0x8e, 0xdd, 0xda, 0xf1, 0x61, 0x22, 0xd7, 0xa6, 0xc9, 0x21, 0xb9,
+	0xfe, 0xbf, 0x0b, 0x65, 0x97, 0x8e, 0xce, 0x0b, 0x64, 0x6f, 0x8c, 0x65, 0x3e, 0x36, 0x11, 0x8d,
+	0x77, 0x51, 0x7f, 0x5b, 0x81, 0x73, 0xe9, 0xa9, 0xce, 0x60, 0xd4, 0x37, 0x60, 0x9e, 0x0d, 0x1d,
+	0xc8, 0xe8, 0x8d, 0xf1, 0x32, 0x1a, 0x6e, 0x8e, 0x16, 0x74, 0x54, 0x77, 0x61, 0x35, 0xb0, 0xfd,
+	0xe1, 0xd6, 0x6f, 0x63, 0xdf, 0x18, 0x13, 0x24, 0x5d, 0x81, 0x1a, 0xf3, 0xa0, 0x59, 0xf0, 0xc1,
+	0xd2, 0x0b, 0xf0, 0x4c, 0xa4, 0xef, 0xd4, 0xff, 0x50, 0x60, 0x85, 0x1a, 0xcf, 0x64, 0x45, 0x2a,
+	0x4f, 0xb5, 0x52, 0x85, 0x7a, 0x24, 0x53, 0xc1, 0x96, 0x56, 0xd5, 0x62, 0x30, 0xd4, 0x49, 0x67,
+	0xf7, 0xa4, 0xc1, 0x74, 0x58, 0xde, 0x26, 0x81, 0x3b, 0xad, 0x6e, 0x27, 0xd3, 0x7a, 0xa1, 0xd1,
+	0x2e, 0x4d, 0x63, 0xb4, 0x1f, 0xc1, 0xd9, 0xc4, 0x4a, 0x67, 0x38, 0x51, 0xf5, 0x4f, 0x14, 0x72,
+	0x1c, 0xb1, 0x0b, 0x54, 0xd3, 0x3b, 0xae, 0x97, 0x44, 0x29, 0x8c, 0x44, 0x73, 0x09, 0x25, 0x62,
+	0xa2, 0x4f, 0xa0, 0x6a, 0xe3, 0x63, 0x3d, 0xea, 0x0b, 0xe5, 0xf0, 0xea, 0x2b, 0x36, 0x3e, 0xa6,
+	0xbf, 0xd4, 0xc7, 0x70, 0x2e, 0x35, 0xd5, 0x59, 0xd6, 0xfe, 0x57, 0x0a, 0x9c, 0xdf, 0x72, 0x9d,
+	0xe1, 0x17, 0x96, 0xeb, 0x8f, 0x8c, 0x7e, 0xfc, 0xe2, 0xc0, 0xab, 0xc9, 0x82, 0x7d, 0x16, 0xf1,
+	0x8a, 0x19, 0xff, 0xdc, 0x92, 0x48, 0x50, 0x7a, 0x52, 0x7c, 0xd1, 0x11, 0x1f, 0xfa, 0xdf, 0x8b,
+	0x70, 0x3e, 0x13, 0x6f, 0x82, 0x5f, 0x92, 0x27, 0xc0, 0x90, 0x66, 0xd7, 0x8b, 0xd3, 0x66, 0xd7,
+	0x33, 0xd4, 0x7b, 0xe9, 0x25, 0xa9, 0xf7, 0x53, 0x67, 0x71, 0x3e, 0x83, 0x78, 0xe5, 0xa3, 0x55,
+	0xce, 0x9d, 0x27, 0x8e, 0x77, 0x44, 0x1b, 0x00, 0x61, 0x15, 0xa0, 0x35, 0x9f, 0x7b, 0x98, 0x48,
+	0x2f, 0x72, 0x5a, 0xc2, 0x94, 0x72, 0x4b, 0x1f, 0x02, 0xd4, 0xcf, 0xa1, 0x2d, 0xe3, 0xd2, 0x59,
+	0x38, 0xff, 0x47, 0x05, 0x80, 0x8e, 0xb8, 0x32, 0x3d, 0x9d, 0x2d, 0x78, 0x03, 0x22, 0xde, 0x48,
+	0x28, 0xef, 0x51, 0x2e, 0x32, 0x89, 0x48, 0x88, 0x98, 0x94, 0xe0, 0xa4, 0xe2, 0x54, 0x93, 0x8e,
+	0x13, 0x91, 0x1a, 0xc6, 0x14, 0x49, 0xf5, 0x7b, 0x01, 0xaa, 0xa4, 0x46, 0x46, 0xc4, 0xcc, 0x0c,
+	0xee, 0x84, 0xbb, 0xce, 0x31, 0x11, 0x3e, 0x93, 0xd4, 0xf4, 0xc8, 0x65, 0x15, 0x32, 0x7e, 0x39,
+	0x72, 0x77, 0xc5, 0x24, 0xa9, 0xa7, 0x7d, 0xab, 0x8f, 0xd9, 0x55, 0x89, 0xaa, 0xc6, 0x1a, 0xa4,
+	0xd2, 0xcc, 0x2e, 0x2f, 0x56, 0x72, 0xdf, 0x4f, 0xa2, 0xf8, 0x24, 0x0f, 0xb5, 0x18, 0xee, 0x1a,
+	0x55, 0x40, 0x44, 0xa7, 0x51, 0x7d, 0xb6, 0xe9, 0x98, 0x4c, 0x55, 0x34, 0x32, 0x2c, 0x02, 0xeb,
+	0xc8, 0xb4, 0x56, 0xd8, 0x65, 0x5c, 0x98, 0x4c, 0xd6, 0x45, 0x16, 0x6d, 0x99, 0xc1, 0x7d, 0x9d,
+	0xb2, 0xeb, 0x1c, 0x77, 0x4c, 0xb1, 0x1b, 0xec, 0xc2, 0x37, 0x0b, 0x0a, 0xc9, 0x6e, 0x6c, 0x92,
+	0x36, 0xd9, 0x4f, 0xec, 0xba, 0x8e, 0xab, 0x0f, 0xb0, 0xe7, 0x19, 0x3d, 0xcc, 0xfd, 0xf3, 0x3a,
+	0x05, 0x6e, 0x33, 0x98, 0xfa, 0xbb, 0x25, 0x68, 0x84, 0x4b, 0x09, 0x6e, 0x07, 0x58, 0x66, 0x70,
+	0x3b, 0xc0, 0x22, 0x47, 0x07, 0x2e, 0x53, 0x85, 0xe2, 0x70, 0x37, 0x0a, 0x2d, 0x45, 0xab, 0x72,
+	0x68, 0xc7, 0x24, 0x66, 0x99, 0x08, 0x99, 0xed, 0x98, 0x38, 0x3c, 0x5c, 0x08, 0x40, 0xfc, 0x6c,
+	0x63, 0x3c, 0x52, 0xca, 0xc1, 0x23, 0x73, 0x39, 0x78, 0xa4, 0x2c, 0xe1, 0x91, 0x55, 0x28, 0x3f,
+	0x1b, 0x75, 0x0f, 0xb1, 0xcf, 0x3d, 0x36, 0xde, 0x8a, 0xf3, 0x4e, 0x25, 0xc1, 0x3b, 0x82, 0x45,
+	0xaa, 0x51, 0x16, 0xb9, 0x00, 0x55, 0x56, 0xa6, 0xd6, 0x7d, 0x8f, 0x56, 0xb4, 0x8a, 0x5a, 0x85,
+	0x01, 0xf6, 0x3c, 0x72, 0x53, 0x94, 0x99, 0xb0, 0x9a, 0x4c, 0xd8, 0xa9, 0xd6, 0x49, 0x70, 0x49,
+	0xe0, 0xcc, 0xbd, 0x0d, 0x8b, 0x91, 0xed, 0xa0, 0x36, 0xa2, 0x4e, 0xa7, 0x1a, 0xf1, 0xf6, 0xa9,
+	0x99, 0xb8, 0x0e, 0x8d, 0x70, 0x4b, 0x28, 0xde, 0x02, 0x0b, 0xb2, 0x04, 0x94, 0xa2, 0x09, 0x4e,
+	0x6e, 0x9c, 0x8e, 0x93, 0x49, 0xba, 0x96, 0x47, 0x47, 0x5e, 0x6b, 0x31, 0x96, 0xac, 0x50, 0xbf,
+	0x0b, 0x28, 0x9c, 0xfd, 0x6c, 0xde, 0x62, 0x82, 0x3d, 0x0a, 0x49, 0xf6, 0x50, 0xff, 0x54, 0x81,
+	0xa5, 0x28, 0xb1, 0x69, 0x0d, 0xef, 0x27, 0x50, 0x63, 0x35, 0x45, 0x9d, 0x08, 0x3e, 0x4f, 0x02,
+	0x5d, 0x1a, 0x7b, 0x2e, 0x1a, 0x84, 0x4f, 0x46, 0x08, 0x7b, 0x1d, 0x3b, 0xee, 0xa1, 0x65, 0xf7,
+	0x74, 0x32, 0xb3, 0x40, 0xdc, 0xea, 0x1c, 0x48, 0xca, 0x2f, 0xf4, 0xda, 0xd3, 0xe5, 0x27, 0x43,
+	0xd3, 0xf0, 0x71, 0xc4, 0x03, 0x99, 0xf5, 0xaa, 0xe6, 0x87, 0xc1, 0x5d, 0xc9, 0x42, 0xbe, 0x72,
+	0x17, 0xc3, 0x56, 0xff, 0x5c, 0xcc, 0x25, 0x75, 0xbf, 0x79, 0xfa, 0xb9, 0xb4, 0xa1, 0x72, 0xc4,
+	0x87, 0x0b, 0x9e, 0xc0, 0x04, 0xed, 0x58, 0x49, 0xb5, 0x78, 0xfa, 0x92, 0xaa, 0xba, 0x4d, 0x2e,
+	0x39, 0x7a, 0xd8, 0x36, 0x63, 0xab, 0x99, 0x3a, 0xd9, 0x34, 0x84, 0xb6, 0x6c, 0xb8, 0x59, 0x98,
+	0x95, 0xf9, 0xae, 0xba, 0x8b, 0x3d, 0x96, 0x47, 0x2c, 0x72, 0x97, 0x89, 0xd2, 0xf1, 0xd5, 0x3f,
+	0x2b, 0xc0, 0xb9, 0x7b, 0xa6, 0xc9, 0xb5, 0x38, 0xf7, 0xc6, 0x5e, 0x95, 0xa3, 0x9c, 0x74, 0x24,
+	0x8b, 0x69, 0x47, 0xf2, 0x65, 0x69, 0x56, 0x6e, 0x63, 0x48, 0xe9, 0x88, 0xdb, 0x4e, 0x97, 0x5d,
+	0x9b, 0xba, 0xcb, 0x6b, 0x6c, 0x24, 0xa0, 0x6f, 0xcd, 0xe7, 0xf2, 0xaf, 0x2a, 0x41, 0xd2, 0x4c,
+	0x1d, 0x42, 0x2b, 0xbd, 0x59, 0x33, 0xaa, 0x92, 0x60, 0x47, 0x86, 0x0e, 0x4b, 0xb0, 0xd6, 0x35,
+	0xe0, 0xa0, 0x1d, 0xc7, 0x53, 0xff, 0xab, 0x00, 0x2d, 0x72, 0x37, 0xe5, 0x27, 0xe7, 0x80, 0xbe,
+	0x0d, 0x2b, 0x9e, 0x71, 0x84, 0xf5, 0x48, 0x60, 0xac, 0xbb, 0xf8, 0x39, 0x77, 0x41, 0xdf, 0x91,
+	0x69, 0x12, 0xe9, 0xdd, 0x1d, 0x6d, 0xc9, 0x8b, 0xc1, 0x35, 0xfc, 0x1c, 0xbd, 0x05, 0x8b, 0xd1,
+	0xeb, 0x6b, 0xba, 0xc5, 0x0c, 0x67, 0x5d, 0x5b, 0x88, 0xdc, 0x4e, 0xeb, 0x98, 0xea, 0x73, 0xb8,
+	0xf8, 0xc4, 0xf6, 0xb0, 0xdf, 0x09, 0x6f, 0x58, 0xcd, 0x18, 0x42, 0x5e, 0x81, 0x5a, 0xb8, 0xf1,
+	0xa9, 0x67, 0x2f, 0xa6, 0xa7, 0xfe, 0x86, 0x02, 0xed, 0x6d, 0xc3, 0x3d, 0xe4, 0x47, 0xec, 0x6d,
+	0xb1, 0x8b, 0x26, 0xaf, 0x8e, 0xe2, 0xf8, 0x9b, 0x0b, 0xea, 0xdf, 0x94, 0x60, 0x65, 0xb3, 0xef,
+	0xd8, 0x78, 0xf6, 0xba, 0xcf, 0x1d, 0x58, 0xf6, 0x9c, 0x91, 0xdb, 0xc5, 0xba, 0x24, 0x3a, 0x43,
+	0xec, 0xd3, 0x66, 0xe4, 0x0b, 0xe9, 0xe0, 0x1b, 0x6e, 0x0f, 0xfb, 0xba, 0xe4, 0x2a, 0x01, 0x62,
+	0x9f, 0x62, 0x1d, 0x7e, 0x5e, 0x72, 0xc3, 0xbf, 0xb6, 0xfe, 0x35, 0x59, 0x12, 0x47, 0xb2, 0xa4,
+	0xb5, 0x9d, 0x48, 0x5f, 0xf6, 0xe8, 0x26, 0x36, 0x1c, 0xfa, 0x3c, 0x52, 0x57, 0x65, 0x21, 0xd9,
+	0x87, 0x79, 0x87, 0x0e, 0xb2, 0x19, 0x6c, 0x58, 0x31, 0x8c, 0x2c, 0xef, 0x52, 0x9e, 0x32, 0xef,
+	0x12, 0x3b, 0xc7, 0xf9, 0xc4, 0x39, 0xb6, 0x3f, 0x85, 0xa5, 0xd4, 0xf2, 0xa2, 0xcf, 0x84, 0x8a,
+	0xec, 0x99, 0xd0, 0x4a, 0xf4, 0x99, 0x50, 0x31, 0xf2, 0x04, 0xa8, 0x7d, 0x57, 0xdc, 0x18, 0xf1,
+	0xb2, 0xde, 0x18, 0xc5, 0x3a, 0x57, 0x23, 0x9d, 0xd5, 0x7d, 0x71, 0xb7, 0x4f, 0xc3, 0xfb, 0xd8,
+	0xc5, 0x76, 0x17, 0x93, 0x67, 0x00, 0x91, 0x5b, 0xf9, 0x4a, 0xf4, 0x56, 0xfe, 0xb4, 0xb7, 0xfc,
+	0xd5, 0x1f, 0x16, 0x60, 0xf5, 0x5e, 0xdf, 0xc7, 0x6e, 0xc8, 0x16, 0xa7, 0xc9, 0x94, 0x85, 0xa9,
+	0xab, 0xc2, 0x14, 0xa9, 0xab, 0xd4, 0x03, 0x93, 0x62, 0xfa, 0x81, 0x89, 0xec, 0xc0, 0x4b, 0x53,
+	0x1e, 0xf8, 0x3d, 0x80, 0xa1, 0xeb, 0x0c, 0xb1, 0xeb, 0x5b, 0x38, 0x60, 0xc8, 0x1c, 0x3e, 0x72,
+	0xa4, 0xd3, 0xcd, 0x4f, 0xc4, 0x0d, 0x6a, 0x92, 0xd7, 0x47, 0xf3, 0x50, 0x7c, 0x8c, 0x8f, 0x9b,
+	0x67, 0x10, 0x40, 0xf9, 0xb1, 0xe3, 0x0e, 0x8c, 0x7e, 0x53, 0x41, 0x35, 0x98, 0xe7, 0x95, 0xd3,
+	0x66, 0x01, 0x2d, 0x40, 0x75, 0x33, 0xa8, 0x3e, 0x35, 0x8b, 0x37, 0x7f, 0x5f, 0x81, 0xa5, 0x54,
+	0x6d, 0x0f, 0x35, 0x00, 0x9e, 0xd8, 0x5d, 0x5e, 0xf4, 0x6c, 0x9e, 0x41, 0x75, 0xa8, 0x04, 0x25,
+	0x50, 0x36, 0xde, 0x9e, 0x43, 0xb1, 0x9b, 0x05, 0xd4, 0x84, 0x3a, 0xeb, 0x38, 0xea, 0x76, 0xb1,
+	0xe7, 0x35, 0x8b, 0x02, 0xf2, 0xc0, 0xb0, 0xfa, 0x23, 0x17, 0x37, 0x4b, 0x84, 0xe6, 0x9e, 0xc3,
+	0xdf, 0x90, 0x34, 0xe7, 0x10, 0x82, 0x06, 0x6f, 0x04, 0x9d, 0xca, 0x11, 0x58, 0xd0, 0x6d, 0xfe,
+	0xe6, 0xd3, 0x68, 0x85, 0x86, 0x2e, 0xef, 0x1c, 0x2c, 0x3f, 0xb1, 0x4d, 0xbc, 0x6f, 0xd9, 0xd8,
+	0x0c, 0x3f, 0x35, 0xcf, 0xa0, 0x65, 0x58, 0xdc, 0xc6, 0x6e, 0x0f, 0x47, 0x80, 0x05, 0xb4, 0x04,
+	0x0b, 0xdb, 0xd6, 0x8b, 0x08, 0xa8, 0xa8, 0x96, 0x2a, 0x4a, 0x53, 0x59, 0xff, 0xd7, 0x4b, 0x50,
+	0x25, 0x87, 0xb2, 0xe9, 0x38, 0xae, 0x89, 0xfa, 0x80, 0xe8, 0x93, 0xab, 0xc1, 0xd0, 0xb1, 0xc5,
+	0x1b, 0x4d, 0xb4, 0x16, 0x3f, 0x07, 0xde, 0x48, 0x23, 0x72, 0xee, 0x6c, 0xbf, 0x29, 0xc5, 0x4f,
+	0x20, 0xab, 0x67, 0xd0, 0x80, 0x52, 0x23, 0x35, 0x9e, 0x3d, 0xab, 0x7b, 0x18, 0xb8, 0xaf, 0xef,
+	0x65, 0x38, 0xab, 0x69, 0xd4, 0x80, 0xde, 0x1b, 0x52, 0x7a, 0xec, 0x4d, 0x5c, 0xe0, 0xca, 0xa8,
+	0x67, 0xd0, 0x73, 0x58, 0x79, 0x88, 0x23, 0x91, 0x40, 0x40, 0x70, 0x3d, 0x9b, 0x60, 0x0a, 0xf9,
+	0x94, 0x24, 0x1f, 0xc1, 0x1c, 0x65, 0x37, 0x24, 0x0b, 0x16, 0xa2, 0x7f, 0xa7, 0xd0, 0xbe, 0x9a,
+	0x8d, 0x20, 0x46, 0xfb, 0x2e, 0x2c, 0x26, 0x1e, 0x61, 0x23, 0x99, 0xeb, 0x20, 0x7f, 0x4e, 0xdf,
+	0xbe, 0x99, 0x07, 0x55, 0xd0, 0xea, 0x41, 0x23, 0xfe, 0x54, 0x0b, 0xdd, 0xc8, 0xf1, 0xea, 0x93,
+	0x51, 0x7a, 0x27, 0xf7, 0xfb, 0x50, 0xca, 0x04, 0xcd, 0xe4, 0xa3, 0x60, 0x74, 0x73, 0xec, 0x00,
+	0x71, 0x66, 0x7b, 0x37, 0x17, 0xae, 0x20, 0x77, 0x02, 0x2b, 0xb2, 0xc7, 0x98, 0x68, 0x4d, 0x3e,
+	0x4c, 0xd6, 0x2b, 0xd1, 0xf6, 0x9d, 0xdc, 0xf8, 0x82, 0xf4, 0x2f, 0xb1, 0xab, 0x51, 0xb2, 0x07,
+	0x8d, 0xe8, 0x7d, 0xf9, 0x70, 0x63, 0x5e, 0x62, 0xb6, 0xd7, 0x4f, 0xd3, 0x45, 0x4c, 0xe2, 0xfb,
+	0xf4, 0x4e, 0x93, 0xe4, 0x49, 0x20, 0x7a, 0x4f, 0x3e, 0x5e, 0xf6, 0x6b, 0xc7, 0xf6, 0xfb, 0xa7,
+	0xe8, 0x21, 0x26, 0xe0, 0x24, 0x5f, 0x5d, 0x07, 0x62, 0x78, 0x67, 0x22, 0xd7, 0x4c, 0x27, 0x83,
+	0xdf, 0x81, 0xc5, 0x84, 0x33, 0x8d, 0xf2, 0x3b, 0xdc, 0xed, 0x71, 0x11, 0x0f, 0x13, 0xc9, 0xc4,
+	0x15, 0x31, 0x94, 0xc1, 0xfd, 0x92, 0x6b, 0x64, 0xed, 0x9b, 0x79, 0x50, 0xc5, 0x42, 0x3c, 0xaa,
+	0x2e, 0x13, 0x17, 0x7f, 0xd0, 0x2d, 0xf9, 0x18, 0xf2, 0x0b, 0x4e, 0xed, 0xdb, 0x39, 0xb1, 0x05,
+	0xd1, 0x23, 0x58, 0x96, 0xdc, 0xcf, 0x42, 0xb7, 0xc7, 0x1e, 0x56, 0xf2, 0x62, 0x5a, 0x7b, 0x2d,
+	0x2f, 0xba, 0xa0, 0xfb, 0x0b, 0x80, 0x76, 0x0f, 0x48, 0x9a, 0xd4, 0xde, 0xb7, 0x7a, 0x23, 0xd7,
+	0x60, 0x5e, 0x42, 0x96, 0x6d, 0x48, 0xa3, 0x66, 0xf0, 0xe8, 0xd8, 0x1e, 0x82, 0xb8, 0x0e, 0xf0,
+	0x10, 0xfb, 0xdb, 0xd8, 0x77, 0x89, 0x60, 0xbc, 0x95, 0x65, 0xfe, 0x38, 0x42, 0x40, 0xea, 0xed,
+	0x89, 0x78, 0x11, 0x53, 0xd4, 0xdc, 0x36, 0x6c, 0x52, 0x21, 0x08, 0xdf, 0xd5, 0xdc, 0x92, 0x76,
+	0x4f, 0xa2, 0x65, 0x1c, 0x64, 0x26, 0xb6, 0x20, 0x79, 0x2c, 0x4c, 0x7b, 0xa4, 0xde, 0x3b, 0xde,
+	0xb4, 0xa7, 0xef, 0x1a, 0xb5, 0xef, 0xe4, 0xc6, 0x17, 0x84, 0xbf, 0x54, 0xe0, 0x42, 0x1a, 0xe1,
+	0xa9, 0xe5, 0x1f, 0x90, 0x9b, 0x26, 0x5e, 0x9e, 0x29, 0x50, 0xc4, 0x53, 0x4c, 0x81, 0xe3, 0x8b,
+	0x29, 0x98, 0xb0, 0x10, 0x2b, 0xc3, 0x22, 0xd9, 0x33, 0x0f, 0x59, 0x49, 0xba, 0x7d, 0x63, 0x32,
+	0xa2, 0xa0, 0x72, 0x00, 0x0b, 0x81, 0x28, 0xb1, 0xcd, 0x7d, 0x27, 0x6b, 0xa6, 0x21, 0x4e, 0x86,
+	0x26, 0x90, 0xa3, 0x46, 0x35, 0x41, 0xba, 0xca, 0x84, 0xf2, 0x55, 0x27, 0xc7, 0x69, 0x82, 0xec,
+	0xd2, 0x15, 0x53, 0x75, 0x89, 0x8a, 0xae, 0x5c, 0x8f, 0x4a, 0x0b, 0xd4, 0xed, 0x9b, 0x79, 0x50,
+	0x05, 0xad, 0xa7, 0x50, 0xe6, 0xff, 0x21, 0xf4, 0xe6, 0xf8, 0xcc, 0x30, 0x1f, 0xfd, 0xfa, 0x04,
+	0x2c, 0x31, 0xf0, 0x21, 0x9c, 0xcb, 0xc8, 0x0b, 0x4b, 0x4d, 0xf0, 0xf8, 0x1c, 0xf2, 0x24, 0xe3,
+	0x20, 0x88, 0xa5, 0x12, 0xbf, 0x63, 0x88, 0x65, 0x25, 0x89, 0x27, 0x11, 0x33, 0x00, 0xa5, 0x9f,
+	0xce, 0x4b, 0x79, 0x22, 0xf3, 0x85, 0x7d, 0x0e, 0x12, 0xe9, 0xd7, 0xef, 0x52, 0x12, 0x99, 0x8f,
+	0xe4, 0x27, 0x91, 0xd0, 0x61, 0x29, 0x95, 0x19, 0x44, 0xef, 0x66, 0x98, 0x6b, 0x59, 0xfe, 0x70,
+	0x12, 0x81, 0x1e, 0x9c, 0x95, 0x66, 0xc1, 0xa4, 0xee, 0xc7, 0xb8, 0x7c, 0xd9, 0x24, 0x42, 0x5d,
+	0x58, 0x96, 0xa4, 0xbe, 0xa4, 0x86, 0x33, 0x3b, 0x45, 0x36, 0x89, 0xc8, 0x53, 0x58, 0x88, 0x65,
+	0x68, 0xa4, 0x8a, 0x4d, 0x96, 0xc3, 0x99, 0x34, 0xf0, 0x3e, 0xb4, 0x37, 0x5c, 0xc7, 0x30, 0xbb,
+	0x86, 0xe7, 0xd3, 0x1c, 0x04, 0x36, 0x43, 0xc7, 0x52, 0x1e, 0x75, 0x48, 0x33, 0x15, 0x93, 0xe8,
+	0x3c, 0x83, 0x1a, 0xe5, 0x74, 0xf6, 0xe7, 0x37, 0x48, 0x6e, 0x42, 0x23, 0x18, 0x19, 0x7a, 0x59,
+	0x86, 0x18, 0xc8, 0xfc, 0xfa, 0x8f, 0xab, 0x50, 0x09, 0x5e, 0xe6, 0x7c, 0xc5, 0x11, 0xee, 0x6b,
+	0x08, 0x39, 0xbf, 0x03, 0x8b, 0x89, 0x07, 0xff, 0xd2, 0xe3, 0x92, 0xff, 0x29, 0x40, 0x0e, 0x7e,
+	0x8b, 0xbd, 0xe0, 0x97, 0xf2, 0x9b, 0xec, 0x8d, 0xff, 0xa4, 0x81, 0xff, 0x7f, 0xbb, 0x7b, 0x8f,
+	0x01, 0x22, 0x8e, 0xde, 0xf8, 0x3b, 0xa9, 0xc4, 0x77, 0x99, 0xb4, 0x5b, 0x03, 0xa9, 0x2f, 0xf7,
+	0x4e, 0x9e, 0xfb, 0x7d, 0xd9, 0xd6, 0x38, 0xdb, 0x83, 0x7b, 0x02, 0xf5, 0xe8, 0x6d, 0x71, 0x24,
+	0xfd, 0x5f, 0xb7, 0xf4, 0x75, 0xf2, 0x49, 0xab, 0xd8, 0x3e, 0xa5, 0x91, 0x9f, 0x30, 0x9c, 0x07,
+	0x28, 0x5d, 0x67, 0xcc, 0xb0, 0x4e, 0x19, 0xd5, 0xcd, 0xf6, 0xed, 0x9c, 0xd8, 0xd1, 0xec, 0x45,
+	0xb2, 0x78, 0x26, 0xcd, 0x5e, 0x64, 0x94, 0x23, 0xdb, 0xef, 0xe6, 0xc2, 0x0d, 0xc8, 0x6d, 0x7c,
+	0xf0, 0xed, 0xf7, 0x7b, 0x96, 0x7f, 0x30, 0x7a, 0x46, 0x56, 0x7f, 0x87, 0x75, 0xbd, 0x6d, 0x39,
+	0xfc, 0xd7, 0x9d, 0x80, 0xdd, 0xef, 0xd0, 0xd1, 0xee, 0x90, 0xd1, 0x86, 0xcf, 0x9e, 0x95, 0x69,
+	0xeb, 0x83, 0xff, 0x1d, 0x00, 0x35, 0x69, 0xf0, 0x7e, 0x2b, 0x54, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5306,6 +5475,7 @@ type DataCoordClient interface {
 	SaveImportSegment(ctx context.Context, in *SaveImportSegmentRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	UnsetIsImportingState(ctx context.Context, in *UnsetIsImportingStateRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	MarkSegmentsDropped(ctx context.Context, in *MarkSegmentsDroppedRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CloneSegments(ctx context.Context, in *CloneSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
 }
@@ -5606,6 +5776,15 @@ func (c *dataCoordClient) MarkSegmentsDropped(ctx context.Context, in *MarkSegme
 	return out, nil
 }
 
+func (c *dataCoordClient) CloneSegments(ctx context.Context, in *CloneSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CloneSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/BroadcastAlteredCollection", in, out, opts...)
@@ -5660,6 +5839,7 @@ type DataCoordServer interface {
 	SaveImportSegment(context.Context, *SaveImportSegmentRequest) (*commonpb.Status, error)
 	UnsetIsImportingState(context.Context, *UnsetIsImportingStateRequest) (*commonpb.Status, error)
 	MarkSegmentsDropped(context.Context, *MarkSegmentsDroppedRequest) (*commonpb.Status, error)
+	CloneSegments(context.Context, *CloneSegmentsRequest) (*commonpb.Status, error)
 	BroadcastAlteredCollection(context.Context, *AlterCollectionRequest) (*commonpb.Status, error)
 	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
 }
@@ -5764,6 +5944,9 @@ func (*UnimplementedDataCoordServer) UnsetIsImportingState(ctx context.Context,
 func (*UnimplementedDataCoordServer) MarkSegmentsDropped(ctx context.Context, req *MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkSegmentsDropped not implemented")
 }
+func (*UnimplementedDataCoordServer) CloneSegments(ctx context.Context, req *CloneSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneSegments not implemented")
+}
 func (*UnimplementedDataCoordServer) BroadcastAlteredCollection(ctx context.Context, req *AlterCollectionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BroadcastAlteredCollection not implemented")
 }
@@ -6351,6 +6534,24 @@ func _DataCoord_MarkSegmentsDropped_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_CloneSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CloneSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/CloneSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CloneSegments(ctx, req.(*CloneSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_BroadcastAlteredCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AlterCollectionRequest)
 	if err := dec(in); err != nil {
@@ -6519,6 +6720,10 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MarkSegmentsDropped",
 			Handler:    _DataCoord_MarkSegmentsDropped_Handler,
 		},
+		{
+			MethodName: "CloneSegments",
+			Handler:    _DataCoord_CloneSegments_Handler,
+		},
 		{
 			MethodName: "BroadcastAlteredCollection",
 			Handler:    _DataCoord_BroadcastAlteredCollection_Handler,
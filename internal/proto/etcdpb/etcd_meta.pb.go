@@ -252,6 +252,8 @@ type CollectionInfo struct {
 	ConsistencyLevel           commonpb.ConsistencyLevel `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
 	State                      CollectionState           `protobuf:"varint,13,opt,name=state,proto3,enum=milvus.proto.etcd.CollectionState" json:"state,omitempty"`
 	Properties                 []*commonpb.KeyValuePair  `protobuf:"bytes,14,rep,name=properties,proto3" json:"properties,omitempty"`
+	DbId                       int64                     `protobuf:"varint,15,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
+	SchemaVersion              int32                     `protobuf:"varint,16,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                  `json:"-"`
 	XXX_unrecognized           []byte                    `json:"-"`
 	XXX_sizecache              int32                     `json:"-"`
@@ -380,6 +382,20 @@ func (m *CollectionInfo) GetProperties() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *CollectionInfo) GetDbId() int64 {
+	if m != nil {
+		return m.DbId
+	}
+	return 0
+}
+
+func (m *CollectionInfo) GetSchemaVersion() int32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
 type PartitionInfo struct {
 	PartitionID               int64          `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
 	PartitionName             string         `protobuf:"bytes,2,opt,name=partitionName,proto3" json:"partitionName,omitempty"`
@@ -456,6 +472,7 @@ type AliasInfo struct {
 	CollectionId         int64      `protobuf:"varint,2,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
 	CreatedTime          uint64     `protobuf:"varint,3,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
 	State                AliasState `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.etcd.AliasState" json:"state,omitempty"`
+	DbId                 int64      `protobuf:"varint,5,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -514,6 +531,76 @@ func (m *AliasInfo) GetState() AliasState {
 	return AliasState_AliasCreated
 }
 
+func (m *AliasInfo) GetDbId() int64 {
+	if m != nil {
+		return m.DbId
+	}
+	return 0
+}
+
+type DatabaseInfo struct {
+	TenantId             string   `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id                   int64    `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedTime          uint64   `protobuf:"varint,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseInfo) Reset()         { *m = DatabaseInfo{} }
+func (m *DatabaseInfo) String() string { return proto.CompactTextString(m) }
+func (*DatabaseInfo) ProtoMessage()    {}
+func (*DatabaseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{5}
+}
+
+func (m *DatabaseInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseInfo.Unmarshal(m, b)
+}
+func (m *DatabaseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseInfo.Marshal(b, m, deterministic)
+}
+func (m *DatabaseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseInfo.Merge(m, src)
+}
+func (m *DatabaseInfo) XXX_Size() int {
+	return xxx_messageInfo_DatabaseInfo.Size(m)
+}
+func (m *DatabaseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseInfo proto.InternalMessageInfo
+
+func (m *DatabaseInfo) GetTenantId() string {
+	if m != nil {
+		return m.TenantId
+	}
+	return ""
+}
+
+func (m *DatabaseInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseInfo) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DatabaseInfo) GetCreatedTime() uint64 {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return 0
+}
+
 type SegmentIndexInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -532,7 +619,7 @@ func (m *SegmentIndexInfo) Reset()         { *m = SegmentIndexInfo{} }
 func (m *SegmentIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndexInfo) ProtoMessage()    {}
 func (*SegmentIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{5}
+	return fileDescriptor_975d306d62b73e88, []int{6}
 }
 
 func (m *SegmentIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -617,6 +704,7 @@ type CollectionMeta struct {
 	SegmentIDs           []int64                    `protobuf:"varint,4,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
 	PartitionTags        []string                   `protobuf:"bytes,5,rep,name=partition_tags,json=partitionTags,proto3" json:"partition_tags,omitempty"`
 	PartitionIDs         []int64                    `protobuf:"varint,6,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	Properties           []*commonpb.KeyValuePair   `protobuf:"bytes,7,rep,name=properties,proto3" json:"properties,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -626,7 +714,7 @@ func (m *CollectionMeta) Reset()         { *m = CollectionMeta{} }
 func (m *CollectionMeta) String() string { return proto.CompactTextString(m) }
 func (*CollectionMeta) ProtoMessage()    {}
 func (*CollectionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{6}
+	return fileDescriptor_975d306d62b73e88, []int{7}
 }
 
 func (m *CollectionMeta) XXX_Unmarshal(b []byte) error {
@@ -689,6 +777,13 @@ func (m *CollectionMeta) GetPartitionIDs() []int64 {
 	return nil
 }
 
+func (m *CollectionMeta) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 type CredentialInfo struct {
 	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	// encrypted by bcrypt (for higher security level)
@@ -706,7 +801,7 @@ func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
 func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
 func (*CredentialInfo) ProtoMessage()    {}
 func (*CredentialInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{7}
+	return fileDescriptor_975d306d62b73e88, []int{8}
 }
 
 func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
@@ -771,6 +866,7 @@ func init() {
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.etcd.CollectionInfo")
 	proto.RegisterType((*PartitionInfo)(nil), "milvus.proto.etcd.PartitionInfo")
 	proto.RegisterType((*AliasInfo)(nil), "milvus.proto.etcd.AliasInfo")
+	proto.RegisterType((*DatabaseInfo)(nil), "milvus.proto.etcd.DatabaseInfo")
 	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.etcd.SegmentIndexInfo")
 	proto.RegisterType((*CollectionMeta)(nil), "milvus.proto.etcd.CollectionMeta")
 	proto.RegisterType((*CredentialInfo)(nil), "milvus.proto.etcd.CredentialInfo")
@@ -779,69 +875,75 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 1020 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcb, 0x8e, 0xdc, 0x44,
-	0x14, 0x8d, 0xdb, 0xfd, 0xf2, 0xed, 0xc7, 0x74, 0x17, 0xc9, 0xc8, 0x19, 0x12, 0x70, 0x1a, 0x02,
-	0x56, 0xa4, 0xcc, 0x88, 0x19, 0x5e, 0x1b, 0x10, 0x61, 0xac, 0x48, 0x2d, 0x20, 0x6a, 0x79, 0x46,
-	0x59, 0xb0, 0xb1, 0xaa, 0xed, 0x9a, 0xee, 0x42, 0x7e, 0xc9, 0x55, 0x3d, 0x30, 0x7f, 0xc0, 0x9f,
-	0xf0, 0x09, 0x7c, 0x01, 0x5f, 0xc3, 0x9a, 0x15, 0x1b, 0x54, 0x55, 0x7e, 0x77, 0x0f, 0x62, 0xc5,
-	0xce, 0xf7, 0x54, 0xdd, 0x5b, 0xf7, 0xdc, 0xc7, 0x31, 0x1c, 0x11, 0xee, 0x07, 0x5e, 0x44, 0x38,
-	0x3e, 0x4d, 0xb3, 0x84, 0x27, 0x68, 0x1e, 0xd1, 0xf0, 0x76, 0xc7, 0x94, 0x75, 0x2a, 0x4e, 0x4f,
-	0xc6, 0x7e, 0x12, 0x45, 0x49, 0xac, 0xa0, 0x93, 0x31, 0xf3, 0xb7, 0x24, 0xca, 0xaf, 0x2f, 0xfe,
-	0xd0, 0xc0, 0x58, 0xc6, 0x01, 0xf9, 0x65, 0x19, 0xdf, 0x24, 0xe8, 0x29, 0x00, 0x15, 0x86, 0x17,
-	0xe3, 0x88, 0x98, 0x9a, 0xa5, 0xd9, 0x86, 0x6b, 0x48, 0xe4, 0x0d, 0x8e, 0x08, 0x32, 0x61, 0x20,
-	0x8d, 0xa5, 0x63, 0x76, 0x2c, 0xcd, 0xd6, 0xdd, 0xc2, 0x44, 0x0e, 0x8c, 0x95, 0x63, 0x8a, 0x33,
-	0x1c, 0x31, 0x53, 0xb7, 0x74, 0x7b, 0x74, 0xfe, 0xec, 0xb4, 0x91, 0x4c, 0x9e, 0xc6, 0x77, 0xe4,
-	0xee, 0x2d, 0x0e, 0x77, 0x64, 0x85, 0x69, 0xe6, 0x8e, 0xa4, 0xdb, 0x4a, 0x7a, 0x89, 0xf8, 0x01,
-	0x09, 0x09, 0x27, 0x81, 0xd9, 0xb5, 0x34, 0x7b, 0xe8, 0x16, 0x26, 0x7a, 0x1f, 0x46, 0x7e, 0x46,
-	0x30, 0x27, 0x1e, 0xa7, 0x11, 0x31, 0x7b, 0x96, 0x66, 0x77, 0x5d, 0x50, 0xd0, 0x35, 0x8d, 0xc8,
-	0xc2, 0x81, 0xe9, 0x6b, 0x4a, 0xc2, 0xa0, 0xe2, 0x62, 0xc2, 0xe0, 0x86, 0x86, 0x24, 0x58, 0x3a,
-	0x92, 0x88, 0xee, 0x16, 0xe6, 0xfd, 0x34, 0x16, 0x7f, 0xf7, 0x60, 0x7a, 0x99, 0x84, 0x21, 0xf1,
-	0x39, 0x4d, 0x62, 0x19, 0x66, 0x0a, 0x9d, 0x32, 0x42, 0x67, 0xe9, 0xa0, 0xaf, 0xa0, 0xaf, 0x0a,
-	0x28, 0x7d, 0x47, 0xe7, 0xcf, 0x9b, 0x1c, 0xf3, 0xe2, 0x56, 0x41, 0xae, 0x24, 0xe0, 0xe6, 0x4e,
-	0x6d, 0x22, 0x7a, 0x9b, 0x08, 0x5a, 0xc0, 0x38, 0xc5, 0x19, 0xa7, 0x32, 0x01, 0x87, 0x99, 0x5d,
-	0x4b, 0xb7, 0x75, 0xb7, 0x81, 0xa1, 0x8f, 0x60, 0x5a, 0xda, 0xa2, 0x31, 0xcc, 0xec, 0x59, 0xba,
-	0x6d, 0xb8, 0x2d, 0x14, 0xbd, 0x86, 0xc9, 0x8d, 0x28, 0x8a, 0x27, 0xf9, 0x11, 0x66, 0xf6, 0x0f,
-	0xb5, 0x45, 0xcc, 0xc8, 0x69, 0xb3, 0x78, 0xee, 0xf8, 0xa6, 0xb4, 0x09, 0x43, 0xe7, 0xf0, 0xe8,
-	0x96, 0x66, 0x7c, 0x87, 0x43, 0xcf, 0xdf, 0xe2, 0x38, 0x26, 0xa1, 0x1c, 0x10, 0x66, 0x0e, 0xe4,
-	0xb3, 0xef, 0xe4, 0x87, 0x97, 0xea, 0x4c, 0xbd, 0xfd, 0x29, 0x1c, 0xa7, 0xdb, 0x3b, 0x46, 0xfd,
-	0x3d, 0xa7, 0xa1, 0x74, 0x7a, 0x58, 0x9c, 0x36, 0xbc, 0xbe, 0x81, 0x27, 0x25, 0x07, 0x4f, 0x55,
-	0x25, 0x90, 0x95, 0x62, 0x1c, 0x47, 0x29, 0x33, 0x0d, 0x4b, 0xb7, 0xbb, 0xee, 0x49, 0x79, 0xe7,
-	0x52, 0x5d, 0xb9, 0x2e, 0x6f, 0x88, 0x11, 0x66, 0x5b, 0x9c, 0x05, 0xcc, 0x8b, 0x77, 0x91, 0x09,
-	0x96, 0x66, 0xf7, 0x5c, 0x43, 0x21, 0x6f, 0x76, 0x11, 0x5a, 0xc2, 0x11, 0xe3, 0x38, 0xe3, 0x5e,
-	0x9a, 0x30, 0x19, 0x81, 0x99, 0x23, 0x59, 0x14, 0xeb, 0xbe, 0x59, 0x75, 0x30, 0xc7, 0x72, 0x54,
-	0xa7, 0xd2, 0x71, 0x55, 0xf8, 0x21, 0x17, 0xe6, 0x7e, 0x12, 0x33, 0xca, 0x38, 0x89, 0xfd, 0x3b,
-	0x2f, 0x24, 0xb7, 0x24, 0x34, 0xc7, 0x96, 0x66, 0x4f, 0xdb, 0x43, 0x91, 0x07, 0xbb, 0xac, 0x6e,
-	0x7f, 0x2f, 0x2e, 0xbb, 0x33, 0xbf, 0x85, 0xa0, 0x2f, 0xa1, 0xc7, 0x38, 0xe6, 0xc4, 0x9c, 0xc8,
-	0x38, 0x8b, 0x03, 0x9d, 0xaa, 0x8d, 0x96, 0xb8, 0xe9, 0x2a, 0x07, 0xf4, 0x0a, 0x20, 0xcd, 0x92,
-	0x94, 0x64, 0x9c, 0x12, 0x66, 0x4e, 0xff, 0xeb, 0xfe, 0xd5, 0x9c, 0x16, 0x7f, 0x69, 0x30, 0x59,
-	0x95, 0x73, 0x26, 0x86, 0xdf, 0x82, 0x51, 0x6d, 0xf0, 0xf2, 0x2d, 0xa8, 0x43, 0xe8, 0x43, 0x98,
-	0x34, 0x86, 0x4e, 0x6e, 0x85, 0xe1, 0x36, 0x41, 0xf4, 0x35, 0xbc, 0xfb, 0x2f, 0x6d, 0xcd, 0xb7,
-	0xe0, 0xf1, 0xbd, 0x5d, 0x45, 0x1f, 0xc0, 0xc4, 0x2f, 0x69, 0x7b, 0x54, 0xc9, 0x83, 0xee, 0x8e,
-	0x2b, 0x70, 0x19, 0xa0, 0x2f, 0x8a, 0xda, 0xf5, 0x64, 0xed, 0x0e, 0x4d, 0x79, 0xc9, 0xae, 0x5e,
-	0xba, 0xc5, 0x6f, 0x1a, 0x18, 0xaf, 0x42, 0x8a, 0x59, 0xa1, 0x81, 0x58, 0x18, 0x0d, 0x0d, 0x94,
-	0x88, 0xa4, 0xb2, 0x97, 0x4a, 0xe7, 0x40, 0x2a, 0xcf, 0x60, 0x5c, 0x67, 0x99, 0x13, 0xcc, 0x37,
-	0x5f, 0xf2, 0x42, 0x17, 0x45, 0xb6, 0x5d, 0x99, 0xed, 0xd3, 0x03, 0xd9, 0xca, 0x9c, 0x1a, 0x99,
-	0xfe, 0xda, 0x81, 0xd9, 0x15, 0xd9, 0x44, 0x24, 0xe6, 0x95, 0xd0, 0x2d, 0xa0, 0xfe, 0x78, 0xd1,
-	0xa5, 0x06, 0xd6, 0x6e, 0x64, 0x67, 0xbf, 0x91, 0x4f, 0xc0, 0x60, 0x79, 0x64, 0x47, 0xe6, 0xab,
-	0xbb, 0x15, 0xa0, 0xc4, 0x54, 0x28, 0x82, 0x93, 0x97, 0xbe, 0x30, 0xeb, 0x62, 0xda, 0x6b, 0xfe,
-	0x13, 0x4c, 0x18, 0xac, 0x77, 0x54, 0xfa, 0xf4, 0xd5, 0x49, 0x6e, 0x8a, 0xf2, 0x90, 0x18, 0xaf,
-	0x43, 0xa2, 0x84, 0xc9, 0x1c, 0x48, 0xb1, 0x1f, 0x29, 0x4c, 0x12, 0x6b, 0xeb, 0xe4, 0x70, 0x4f,
-	0xf0, 0xff, 0xd4, 0xea, 0x52, 0xfd, 0x03, 0xe1, 0xf8, 0x7f, 0x97, 0xea, 0xf7, 0x00, 0xca, 0x0a,
-	0x15, 0x42, 0x5d, 0x43, 0xd0, 0xf3, 0x9a, 0x4c, 0x7b, 0x1c, 0x6f, 0x0a, 0x99, 0xae, 0x96, 0xe3,
-	0x1a, 0x6f, 0xd8, 0x9e, 0xe2, 0xf7, 0xf7, 0x15, 0x7f, 0xf1, 0xbb, 0x60, 0x9b, 0x91, 0x80, 0xc4,
-	0x9c, 0xe2, 0x50, 0xb6, 0xfd, 0x04, 0x86, 0x3b, 0x46, 0xb2, 0xda, 0x94, 0x96, 0x36, 0x7a, 0x09,
-	0x88, 0xc4, 0x7e, 0x76, 0x97, 0x8a, 0x09, 0x4c, 0x31, 0x63, 0x3f, 0x27, 0x59, 0x90, 0xaf, 0xe6,
-	0xbc, 0x3c, 0x59, 0xe5, 0x07, 0xe8, 0x18, 0xfa, 0x9c, 0xc4, 0x38, 0xe6, 0x92, 0xa4, 0xe1, 0xe6,
-	0x16, 0x7a, 0x0c, 0x43, 0xca, 0x3c, 0xb6, 0x4b, 0x49, 0x56, 0xfc, 0x90, 0x29, 0xbb, 0x12, 0x26,
-	0xfa, 0x18, 0x8e, 0xd8, 0x16, 0x9f, 0x7f, 0xf6, 0x79, 0x15, 0xbe, 0x27, 0x7d, 0xa7, 0x0a, 0x2e,
-	0x62, 0xbf, 0x48, 0xe0, 0xa8, 0xa5, 0x58, 0xe8, 0x11, 0xcc, 0x2b, 0x28, 0xdf, 0xf5, 0xd9, 0x03,
-	0x74, 0x0c, 0xa8, 0x05, 0xd3, 0x78, 0x33, 0xd3, 0x9a, 0xb8, 0x93, 0x25, 0x69, 0x2a, 0xf0, 0x4e,
-	0x33, 0x8c, 0xc4, 0x49, 0x30, 0xd3, 0x5f, 0xfc, 0x04, 0xd3, 0xe6, 0x9a, 0xa3, 0x87, 0x30, 0x5b,
-	0xb5, 0xa4, 0x65, 0xf6, 0x40, 0xb8, 0x37, 0x51, 0xf5, 0x5a, 0x1d, 0xae, 0x3d, 0x56, 0x8f, 0x51,
-	0xbd, 0xf5, 0x16, 0xa0, 0x5a, 0x52, 0x34, 0x83, 0xb1, 0xb4, 0xaa, 0x37, 0xe6, 0x30, 0xa9, 0x10,
-	0x15, 0xbf, 0x80, 0x6a, 0xb1, 0x0b, 0xbf, 0x32, 0xee, 0xb7, 0x17, 0x3f, 0x7e, 0xb2, 0xa1, 0x7c,
-	0xbb, 0x5b, 0x0b, 0xcd, 0x3e, 0x53, 0x53, 0xfb, 0x92, 0x26, 0xf9, 0xd7, 0x19, 0x8d, 0xb9, 0x68,
-	0x74, 0x78, 0x26, 0x07, 0xf9, 0x4c, 0x88, 0x45, 0xba, 0x5e, 0xf7, 0xa5, 0x75, 0xf1, 0x4f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xf9, 0x76, 0x1c, 0x4f, 0x13, 0x0a, 0x00, 0x00,
+	// 1108 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x0e, 0x45, 0x3d, 0x47, 0x12, 0x2d, 0x6f, 0x12, 0x63, 0xe3, 0x24, 0x2d, 0xc3, 0xd6, 0xad,
+	0x10, 0x20, 0x36, 0x6a, 0xf7, 0x75, 0x69, 0xd1, 0xd4, 0x42, 0x00, 0xa1, 0x6d, 0x20, 0xd0, 0x86,
+	0x0f, 0xbd, 0x10, 0x2b, 0x72, 0x6d, 0x6d, 0xc1, 0x17, 0xb8, 0x2b, 0xb7, 0xfe, 0x07, 0xfd, 0x35,
+	0xbd, 0xe6, 0xd2, 0x6b, 0x7f, 0x52, 0xef, 0xc5, 0xee, 0xf2, 0x29, 0xc9, 0x45, 0xd1, 0x43, 0x6e,
+	0x9c, 0x6f, 0x67, 0x66, 0x67, 0x66, 0xbf, 0x99, 0x21, 0xec, 0x51, 0xe1, 0x07, 0x5e, 0x44, 0x05,
+	0x39, 0x4e, 0xb3, 0x44, 0x24, 0x68, 0x3f, 0x62, 0xe1, 0xed, 0x9a, 0x6b, 0xe9, 0x58, 0x9e, 0x1e,
+	0x8e, 0xfc, 0x24, 0x8a, 0x92, 0x58, 0x43, 0x87, 0x23, 0xee, 0xaf, 0x68, 0x94, 0xab, 0x3b, 0x7f,
+	0x19, 0x30, 0x98, 0xc7, 0x01, 0xfd, 0x6d, 0x1e, 0x5f, 0x27, 0xe8, 0x39, 0x00, 0x93, 0x82, 0x17,
+	0x93, 0x88, 0x62, 0xc3, 0x36, 0xa6, 0x03, 0x77, 0xa0, 0x90, 0xb7, 0x24, 0xa2, 0x08, 0x43, 0x4f,
+	0x09, 0xf3, 0x19, 0x6e, 0xd9, 0xc6, 0xd4, 0x74, 0x0b, 0x11, 0xcd, 0x60, 0xa4, 0x0d, 0x53, 0x92,
+	0x91, 0x88, 0x63, 0xd3, 0x36, 0xa7, 0xc3, 0xd3, 0x17, 0xc7, 0x8d, 0x60, 0xf2, 0x30, 0x7e, 0xa0,
+	0x77, 0x57, 0x24, 0x5c, 0xd3, 0x05, 0x61, 0x99, 0x3b, 0x54, 0x66, 0x0b, 0x65, 0x25, 0xfd, 0x07,
+	0x34, 0xa4, 0x82, 0x06, 0xb8, 0x6d, 0x1b, 0xd3, 0xbe, 0x5b, 0x88, 0xe8, 0x43, 0x18, 0xfa, 0x19,
+	0x25, 0x82, 0x7a, 0x82, 0x45, 0x14, 0x77, 0x6c, 0x63, 0xda, 0x76, 0x41, 0x43, 0x97, 0x2c, 0xa2,
+	0xce, 0x0c, 0xac, 0x37, 0x8c, 0x86, 0x41, 0x95, 0x0b, 0x86, 0xde, 0x35, 0x0b, 0x69, 0x30, 0x9f,
+	0xa9, 0x44, 0x4c, 0xb7, 0x10, 0xef, 0x4f, 0xc3, 0x79, 0xd7, 0x05, 0xeb, 0x3c, 0x09, 0x43, 0xea,
+	0x0b, 0x96, 0xc4, 0xca, 0x8d, 0x05, 0xad, 0xd2, 0x43, 0x6b, 0x3e, 0x43, 0xdf, 0x40, 0x57, 0x17,
+	0x50, 0xd9, 0x0e, 0x4f, 0x8f, 0x9a, 0x39, 0xe6, 0xc5, 0xad, 0x9c, 0x5c, 0x28, 0xc0, 0xcd, 0x8d,
+	0x36, 0x13, 0x31, 0x37, 0x13, 0x41, 0x0e, 0x8c, 0x52, 0x92, 0x09, 0xa6, 0x02, 0x98, 0x71, 0xdc,
+	0xb6, 0xcd, 0xa9, 0xe9, 0x36, 0x30, 0xf4, 0x09, 0x58, 0xa5, 0x2c, 0x1f, 0x86, 0xe3, 0x8e, 0x6d,
+	0x4e, 0x07, 0xee, 0x06, 0x8a, 0xde, 0xc0, 0xf8, 0x5a, 0x16, 0xc5, 0x53, 0xf9, 0x51, 0x8e, 0xbb,
+	0xbb, 0x9e, 0x45, 0x72, 0xe4, 0xb8, 0x59, 0x3c, 0x77, 0x74, 0x5d, 0xca, 0x94, 0xa3, 0x53, 0x78,
+	0x7c, 0xcb, 0x32, 0xb1, 0x26, 0xa1, 0xe7, 0xaf, 0x48, 0x1c, 0xd3, 0x50, 0x11, 0x84, 0xe3, 0x9e,
+	0xba, 0xf6, 0x61, 0x7e, 0x78, 0xae, 0xcf, 0xf4, 0xdd, 0x9f, 0xc3, 0x41, 0xba, 0xba, 0xe3, 0xcc,
+	0xdf, 0x32, 0xea, 0x2b, 0xa3, 0x47, 0xc5, 0x69, 0xc3, 0xea, 0x3b, 0x78, 0x56, 0xe6, 0xe0, 0xe9,
+	0xaa, 0x04, 0xaa, 0x52, 0x5c, 0x90, 0x28, 0xe5, 0x78, 0x60, 0x9b, 0xd3, 0xb6, 0x7b, 0x58, 0xea,
+	0x9c, 0x6b, 0x95, 0xcb, 0x52, 0x43, 0x52, 0x98, 0xaf, 0x48, 0x16, 0x70, 0x2f, 0x5e, 0x47, 0x18,
+	0x6c, 0x63, 0xda, 0x71, 0x07, 0x1a, 0x79, 0xbb, 0x8e, 0xd0, 0x1c, 0xf6, 0xb8, 0x20, 0x99, 0xf0,
+	0xd2, 0x84, 0x2b, 0x0f, 0x1c, 0x0f, 0x55, 0x51, 0xec, 0xfb, 0xb8, 0x3a, 0x23, 0x82, 0x28, 0xaa,
+	0x5a, 0xca, 0x70, 0x51, 0xd8, 0x21, 0x17, 0xf6, 0xfd, 0x24, 0xe6, 0x8c, 0x0b, 0x1a, 0xfb, 0x77,
+	0x5e, 0x48, 0x6f, 0x69, 0x88, 0x47, 0xb6, 0x31, 0xb5, 0x4e, 0x8f, 0x76, 0x3a, 0x3b, 0xaf, 0xb4,
+	0x7f, 0x94, 0xca, 0xee, 0xc4, 0xdf, 0x40, 0xd0, 0xd7, 0xd0, 0xe1, 0x82, 0x08, 0x8a, 0xc7, 0xca,
+	0x8f, 0xb3, 0xe3, 0xa5, 0x6a, 0xd4, 0x92, 0x9a, 0xae, 0x36, 0x40, 0xaf, 0x01, 0xd2, 0x2c, 0x49,
+	0x69, 0x26, 0x18, 0xe5, 0xd8, 0xfa, 0xaf, 0xfd, 0x57, 0x33, 0x42, 0x0f, 0xa1, 0x13, 0x2c, 0x3d,
+	0x16, 0xe0, 0x3d, 0xc5, 0xf6, 0x76, 0xb0, 0x9c, 0x07, 0xe8, 0x08, 0x2c, 0x4d, 0x5d, 0xef, 0x96,
+	0x66, 0x9c, 0x25, 0x31, 0x9e, 0xa8, 0x9a, 0x8e, 0x35, 0x7a, 0xa5, 0x41, 0xe7, 0x6f, 0x03, 0xc6,
+	0x8b, 0x92, 0xa3, 0xb2, 0x71, 0x6c, 0x18, 0xd6, 0x48, 0x9b, 0x77, 0x50, 0x1d, 0x42, 0x1f, 0xc3,
+	0xb8, 0x41, 0x58, 0xd5, 0x51, 0x03, 0xb7, 0x09, 0xa2, 0x6f, 0xe1, 0xe9, 0xbf, 0x50, 0x22, 0xef,
+	0xa0, 0x27, 0xf7, 0x32, 0x02, 0x7d, 0x04, 0x63, 0xbf, 0x2c, 0x99, 0xc7, 0xf4, 0x68, 0x31, 0xdd,
+	0x51, 0x05, 0xce, 0x03, 0xf4, 0x55, 0x51, 0xf7, 0x8e, 0xaa, 0xfb, 0xae, 0x0e, 0x29, 0xb3, 0xab,
+	0x97, 0xdd, 0xf9, 0xd3, 0x80, 0xc1, 0xeb, 0x90, 0x11, 0x5e, 0xcc, 0x4f, 0x22, 0x85, 0xc6, 0xfc,
+	0x54, 0x88, 0x4a, 0x65, 0x2b, 0x94, 0xd6, 0x8e, 0x50, 0x5e, 0xc0, 0xa8, 0x9e, 0x65, 0x9e, 0xe0,
+	0xd0, 0xaf, 0xf2, 0x42, 0x67, 0x45, 0xb4, 0x6d, 0x15, 0xed, 0xf3, 0x1d, 0xd1, 0xaa, 0x98, 0x1a,
+	0x04, 0x29, 0x5f, 0xb7, 0x53, 0xbd, 0xae, 0x93, 0xc1, 0x48, 0xf2, 0x7b, 0x49, 0x38, 0x55, 0x09,
+	0x3c, 0x85, 0x81, 0xa0, 0x31, 0x89, 0x85, 0x54, 0xd4, 0xf1, 0xf7, 0x35, 0x30, 0x0f, 0x10, 0x82,
+	0x76, 0x5c, 0x3d, 0x93, 0xfa, 0x96, 0xe3, 0x91, 0x05, 0x2a, 0x46, 0xd3, 0x6d, 0xb1, 0xed, 0xe8,
+	0xdb, 0x5b, 0xd1, 0x3b, 0xbf, 0xb7, 0x60, 0x72, 0x41, 0x6f, 0x22, 0x1a, 0x8b, 0x6a, 0x5a, 0x3b,
+	0x50, 0xaf, 0x42, 0x41, 0x97, 0x06, 0xb6, 0xc9, 0xa8, 0xd6, 0x36, 0xa3, 0x9e, 0xc1, 0x80, 0xe7,
+	0x9e, 0x67, 0x79, 0x50, 0x15, 0xa0, 0x37, 0x82, 0x1c, 0x6b, 0xb3, 0x9c, 0x03, 0x85, 0x58, 0xdf,
+	0x08, 0x9d, 0xe6, 0x62, 0xc3, 0xd0, 0x5b, 0xae, 0x99, 0xb2, 0xe9, 0xea, 0x93, 0x5c, 0x94, 0x99,
+	0xd2, 0x98, 0x2c, 0x43, 0xaa, 0xa7, 0x2b, 0xee, 0xa9, 0x8d, 0x35, 0xd4, 0x98, 0x4a, 0x6c, 0x73,
+	0xd8, 0xf7, 0xb7, 0xb6, 0xd6, 0x1f, 0xad, 0xfa, 0xbe, 0xf9, 0x89, 0x0a, 0xf2, 0xde, 0xf7, 0xcd,
+	0x07, 0x00, 0x65, 0x85, 0x8a, 0x6d, 0x53, 0x43, 0x64, 0xff, 0x57, 0xed, 0x27, 0xc8, 0x4d, 0xb1,
+	0x6b, 0xaa, 0x2e, 0xbd, 0x24, 0x37, 0x7c, 0x6b, 0x6d, 0x75, 0x77, 0xac, 0xad, 0xe6, 0x88, 0xea,
+	0xfd, 0x8f, 0x11, 0xe5, 0xbc, 0x33, 0xc0, 0x3a, 0xcf, 0x68, 0x40, 0x63, 0xc1, 0x48, 0xa8, 0x98,
+	0x73, 0x08, 0xfd, 0x35, 0xa7, 0x59, 0xad, 0xe3, 0x4a, 0x19, 0xbd, 0x02, 0x44, 0x63, 0x3f, 0xbb,
+	0x4b, 0x25, 0x1f, 0x53, 0xc2, 0xf9, 0xaf, 0x49, 0x16, 0xe4, 0xfc, 0xdd, 0x2f, 0x4f, 0x16, 0xf9,
+	0x01, 0x3a, 0x80, 0xae, 0x26, 0xbb, 0xaa, 0xd3, 0xc0, 0xcd, 0x25, 0xf4, 0x04, 0xfa, 0x8c, 0x7b,
+	0x7c, 0x9d, 0xd2, 0xac, 0xf8, 0x31, 0x61, 0xfc, 0x42, 0x8a, 0xe8, 0x53, 0xd8, 0xe3, 0x2b, 0x72,
+	0xfa, 0xc5, 0x97, 0x95, 0xfb, 0x8e, 0xb2, 0xb5, 0x34, 0x5c, 0xf8, 0x7e, 0x99, 0xc0, 0xde, 0xc6,
+	0xe4, 0x46, 0x8f, 0x61, 0xbf, 0x82, 0xf2, 0xb9, 0x35, 0x79, 0x80, 0x0e, 0x00, 0x6d, 0xc0, 0x2c,
+	0xbe, 0x99, 0x18, 0x4d, 0x7c, 0x96, 0x25, 0x69, 0x2a, 0xf1, 0x56, 0xd3, 0x8d, 0xc2, 0x69, 0x30,
+	0x31, 0x5f, 0xfe, 0x02, 0x56, 0x73, 0x64, 0xa1, 0x47, 0x30, 0x59, 0x6c, 0x8c, 0xc9, 0xc9, 0x03,
+	0x69, 0xde, 0x44, 0xf5, 0x6d, 0x75, 0xb8, 0x76, 0x59, 0xdd, 0x47, 0x75, 0xd7, 0x15, 0x40, 0x35,
+	0x70, 0xd0, 0x04, 0x46, 0x4a, 0xaa, 0xee, 0xd8, 0x87, 0x71, 0x85, 0x68, 0xff, 0x05, 0x54, 0xf3,
+	0x5d, 0xd8, 0x95, 0x7e, 0xbf, 0x3f, 0xfb, 0xf9, 0xb3, 0x1b, 0x26, 0x56, 0xeb, 0xa5, 0x24, 0xc6,
+	0x89, 0x66, 0xca, 0x2b, 0x96, 0xe4, 0x5f, 0x27, 0x2c, 0x16, 0xf2, 0xa1, 0xc3, 0x13, 0x45, 0x9e,
+	0x13, 0x39, 0xf8, 0xd2, 0xe5, 0xb2, 0xab, 0xa4, 0xb3, 0x7f, 0x06, 0x00, 0x8b, 0xe0, 0x17, 0x96,
+	0x1b, 0x0b, 0x00, 0x00,
 }
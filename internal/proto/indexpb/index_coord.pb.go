@@ -1469,6 +1469,7 @@ type CreateJobRequest struct {
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
 	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,10,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
 	NumRows              int64                    `protobuf:"varint,11,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	FieldID              int64                    `protobuf:"varint,12,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -1576,6 +1577,13 @@ func (m *CreateJobRequest) GetNumRows() int64 {
 	return 0
 }
 
+func (m *CreateJobRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
 type QueryJobsRequest struct {
 	ClusterID            string   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
 	BuildIDs             []int64  `protobuf:"varint,2,rep,packed,name=buildIDs,proto3" json:"buildIDs,omitempty"`
@@ -2029,144 +2037,144 @@ func init() {
 func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
 
 var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 2184 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcd, 0x8e, 0xdb, 0xc8,
-	0xf1, 0x37, 0x45, 0xcd, 0x8c, 0x58, 0x94, 0xe6, 0xa3, 0xed, 0xfd, 0xff, 0x65, 0xd9, 0x8e, 0xc7,
-	0xf4, 0xda, 0x56, 0x02, 0xec, 0xd8, 0x99, 0xcd, 0x06, 0x9b, 0x20, 0x09, 0x30, 0x9e, 0x59, 0xdb,
-	0xb2, 0xd7, 0xc6, 0x84, 0x32, 0x16, 0xc8, 0x22, 0x80, 0x42, 0x89, 0xad, 0x99, 0xde, 0xa1, 0xd8,
-	0x32, 0xbb, 0x69, 0x7b, 0x1c, 0x20, 0xc8, 0x65, 0x0f, 0x59, 0x2c, 0x10, 0x20, 0x08, 0x92, 0x17,
-	0xc8, 0x69, 0x73, 0xc8, 0x3d, 0x97, 0xbc, 0x40, 0x4e, 0x79, 0x84, 0xbc, 0x44, 0xae, 0x41, 0x7f,
-	0x90, 0x22, 0x29, 0x6a, 0xa4, 0xf9, 0xc8, 0x25, 0xb9, 0xa9, 0x8b, 0xd5, 0x5f, 0x55, 0xbf, 0xaa,
-	0xfa, 0x55, 0x0b, 0x36, 0x48, 0xe8, 0xe3, 0xb7, 0xbd, 0x01, 0xa5, 0x91, 0xbf, 0x35, 0x8e, 0x28,
-	0xa7, 0x08, 0x8d, 0x48, 0xf0, 0x3a, 0x66, 0x6a, 0xb4, 0x25, 0xbf, 0xb7, 0xea, 0x03, 0x3a, 0x1a,
-	0xd1, 0x50, 0xc9, 0x5a, 0xab, 0x24, 0xe4, 0x38, 0x0a, 0xbd, 0x40, 0x8f, 0xeb, 0xd9, 0x19, 0xce,
-	0x5f, 0xaa, 0x60, 0x75, 0xc4, 0xac, 0x4e, 0x38, 0xa4, 0xc8, 0x81, 0xfa, 0x80, 0x06, 0x01, 0x1e,
-	0x70, 0x42, 0xc3, 0xce, 0x5e, 0xd3, 0xd8, 0x34, 0xda, 0xa6, 0x9b, 0x93, 0xa1, 0x26, 0xac, 0x0c,
-	0x09, 0x0e, 0xfc, 0xce, 0x5e, 0xb3, 0x22, 0x3f, 0x27, 0x43, 0x74, 0x03, 0x40, 0x1d, 0x30, 0xf4,
-	0x46, 0xb8, 0x69, 0x6e, 0x1a, 0x6d, 0xcb, 0xb5, 0xa4, 0xe4, 0x85, 0x37, 0xc2, 0x62, 0xa2, 0x1c,
-	0x74, 0xf6, 0x9a, 0x55, 0x35, 0x51, 0x0f, 0xd1, 0x43, 0xb0, 0xf9, 0xf1, 0x18, 0xf7, 0xc6, 0x5e,
-	0xe4, 0x8d, 0x58, 0x73, 0x69, 0xd3, 0x6c, 0xdb, 0xdb, 0xb7, 0xb6, 0x72, 0x57, 0xd3, 0x77, 0x7a,
-	0x86, 0x8f, 0x3f, 0xf3, 0x82, 0x18, 0xef, 0x7b, 0x24, 0x72, 0x41, 0xcc, 0xda, 0x97, 0x93, 0xd0,
-	0x1e, 0xd4, 0xd5, 0xe6, 0x7a, 0x91, 0xe5, 0x45, 0x17, 0xb1, 0xe5, 0x34, 0xbd, 0xca, 0x2d, 0xbd,
-	0x0a, 0xf6, 0x7b, 0x11, 0x7d, 0xc3, 0x9a, 0x2b, 0xf2, 0xa0, 0xb6, 0x96, 0xb9, 0xf4, 0x0d, 0x13,
-	0xb7, 0xe4, 0x94, 0x7b, 0x81, 0x52, 0xa8, 0x49, 0x05, 0x4b, 0x4a, 0xe4, 0xe7, 0x8f, 0x60, 0x89,
-	0x71, 0x8f, 0xe3, 0xa6, 0xb5, 0x69, 0xb4, 0x57, 0xb7, 0x6f, 0x96, 0x1e, 0x40, 0x5a, 0xbc, 0x2b,
-	0xd4, 0x5c, 0xa5, 0x8d, 0x3e, 0x82, 0xff, 0x57, 0xc7, 0x97, 0xc3, 0xde, 0xd0, 0x23, 0x41, 0x2f,
-	0xc2, 0x1e, 0xa3, 0x61, 0x13, 0xa4, 0x21, 0xaf, 0x90, 0x74, 0xce, 0x23, 0x8f, 0x04, 0xae, 0xfc,
-	0x86, 0x1c, 0x68, 0x10, 0xd6, 0xf3, 0x62, 0x4e, 0x7b, 0xf2, 0x7b, 0xd3, 0xde, 0x34, 0xda, 0x35,
-	0xd7, 0x26, 0x6c, 0x27, 0xe6, 0x54, 0x6e, 0x83, 0x9e, 0xc3, 0x46, 0xcc, 0x70, 0xd4, 0xcb, 0x99,
-	0xa7, 0xbe, 0xa8, 0x79, 0xd6, 0xc4, 0xdc, 0xce, 0xc4, 0x44, 0xce, 0x97, 0x06, 0xc0, 0x23, 0xe9,
-	0x71, 0xb9, 0xfa, 0x8f, 0x12, 0xa7, 0x93, 0x70, 0x48, 0x25, 0x60, 0xec, 0xed, 0x1b, 0x5b, 0xd3,
-	0xa8, 0xdc, 0x4a, 0x51, 0xa6, 0x31, 0x21, 0x01, 0xd7, 0x84, 0x15, 0x1f, 0x07, 0x98, 0x63, 0x5f,
-	0x82, 0xa9, 0xe6, 0x26, 0x43, 0x74, 0x13, 0xec, 0x41, 0x84, 0x85, 0x2d, 0x38, 0xd1, 0x68, 0xaa,
-	0xba, 0xa0, 0x44, 0x2f, 0xc9, 0x08, 0x3b, 0x5f, 0x56, 0xa1, 0xde, 0xc5, 0x07, 0x23, 0x1c, 0x72,
-	0x75, 0x92, 0x45, 0xc0, 0xbb, 0x09, 0xf6, 0xd8, 0x8b, 0x38, 0xd1, 0x2a, 0x0a, 0xc0, 0x59, 0x11,
-	0xba, 0x0e, 0x16, 0xd3, 0xab, 0xee, 0xc9, 0x5d, 0x4d, 0x77, 0x22, 0x40, 0x57, 0xa1, 0x16, 0xc6,
-	0x23, 0xe5, 0x7a, 0x0d, 0xe2, 0x30, 0x1e, 0x49, 0xc7, 0x67, 0xe0, 0xbd, 0x94, 0x87, 0x77, 0x13,
-	0x56, 0xfa, 0x31, 0x91, 0x11, 0xb3, 0xac, 0xbe, 0xe8, 0x21, 0xfa, 0x3f, 0x58, 0x0e, 0xa9, 0x8f,
-	0x3b, 0x7b, 0x1a, 0x68, 0x7a, 0x84, 0x6e, 0x43, 0x43, 0x19, 0xf5, 0x35, 0x8e, 0x18, 0xa1, 0xa1,
-	0x86, 0x99, 0xc2, 0xe6, 0x67, 0x4a, 0x76, 0x56, 0xa4, 0xdd, 0x04, 0x7b, 0x1a, 0x5d, 0x30, 0x9c,
-	0x60, 0xea, 0x2e, 0xac, 0xa9, 0xcd, 0x87, 0x24, 0xc0, 0xbd, 0x23, 0x7c, 0xcc, 0x9a, 0xf6, 0xa6,
-	0xd9, 0xb6, 0x5c, 0x75, 0xa6, 0x47, 0x24, 0xc0, 0xcf, 0xf0, 0x31, 0xcb, 0xfa, 0xae, 0x7e, 0xa2,
-	0xef, 0x1a, 0x45, 0xdf, 0xa1, 0x3b, 0xb0, 0xca, 0x70, 0x44, 0xbc, 0x80, 0xbc, 0xc3, 0x3d, 0x46,
-	0xde, 0xe1, 0xe6, 0xaa, 0xd4, 0x69, 0xa4, 0xd2, 0x2e, 0x79, 0x87, 0x85, 0x19, 0xde, 0x44, 0x84,
-	0xe3, 0xde, 0xa1, 0x17, 0xfa, 0x74, 0x38, 0x6c, 0xae, 0xc9, 0x7d, 0xea, 0x52, 0xf8, 0x44, 0xc9,
-	0x9c, 0x3f, 0x1a, 0x70, 0xd9, 0xc5, 0x07, 0x84, 0x71, 0x1c, 0xbd, 0xa0, 0x3e, 0x76, 0xf1, 0xab,
-	0x18, 0x33, 0x8e, 0x1e, 0x40, 0xb5, 0xef, 0x31, 0xac, 0x21, 0x79, 0xbd, 0xd4, 0x3a, 0xcf, 0xd9,
-	0xc1, 0x43, 0x8f, 0x61, 0x57, 0x6a, 0xa2, 0xef, 0xc3, 0x8a, 0xe7, 0xfb, 0x11, 0x66, 0x4c, 0x02,
-	0x63, 0xd6, 0xa4, 0x1d, 0xa5, 0xe3, 0x26, 0xca, 0x19, 0x2f, 0x9a, 0x59, 0x2f, 0x3a, 0xbf, 0x35,
-	0xe0, 0x4a, 0xfe, 0x64, 0x6c, 0x4c, 0x43, 0x86, 0xd1, 0x87, 0xb0, 0x2c, 0x7c, 0x11, 0x33, 0x7d,
-	0xb8, 0x6b, 0xa5, 0xfb, 0x74, 0xa5, 0x8a, 0xab, 0x55, 0x45, 0x92, 0x24, 0x21, 0xe1, 0x49, 0x00,
-	0xab, 0x13, 0xde, 0x2a, 0x46, 0x9a, 0x4e, 0xf5, 0x9d, 0x90, 0x70, 0x15, 0xaf, 0x2e, 0x90, 0xf4,
-	0xb7, 0xf3, 0x33, 0xb8, 0xf2, 0x18, 0xf3, 0x0c, 0x26, 0xb4, 0xad, 0x16, 0x09, 0x9d, 0x7c, 0x76,
-	0xaf, 0x14, 0xb2, 0xbb, 0xf3, 0x27, 0x03, 0xde, 0x2b, 0xac, 0x7d, 0x9e, 0xdb, 0xa6, 0xe0, 0xae,
-	0x9c, 0x07, 0xdc, 0x66, 0x11, 0xdc, 0xce, 0xaf, 0x0d, 0xb8, 0xf6, 0x18, 0xf3, 0x6c, 0xe2, 0xb8,
-	0x60, 0x4b, 0xa0, 0x6f, 0x01, 0xa4, 0x09, 0x83, 0x35, 0xcd, 0x4d, 0xb3, 0x6d, 0xba, 0x19, 0x89,
-	0xf3, 0x1b, 0x03, 0x36, 0xa6, 0xf6, 0xcf, 0xe7, 0x1d, 0xa3, 0x98, 0x77, 0xfe, 0x53, 0xe6, 0xf8,
-	0x9d, 0x01, 0xd7, 0xcb, 0xcd, 0x71, 0x1e, 0xe7, 0xfd, 0x58, 0x4d, 0xc2, 0x02, 0xa5, 0xa2, 0xcc,
-	0xdc, 0x29, 0xab, 0x07, 0xd3, 0x7b, 0xea, 0x49, 0xce, 0xd7, 0x26, 0xa0, 0x5d, 0x99, 0x2c, 0xe4,
-	0xc7, 0xd3, 0xb8, 0xe6, 0xcc, 0xe4, 0xa4, 0x40, 0x41, 0xaa, 0x17, 0x41, 0x41, 0x96, 0xce, 0x44,
-	0x41, 0xae, 0x83, 0x25, 0xb2, 0x26, 0xe3, 0xde, 0x68, 0x2c, 0xeb, 0x45, 0xd5, 0x9d, 0x08, 0xa6,
-	0x0b, 0xfe, 0xca, 0x82, 0x05, 0xbf, 0x76, 0xe6, 0x82, 0xff, 0x16, 0x2e, 0x27, 0x81, 0x2d, 0xcb,
-	0xf7, 0x29, 0xdc, 0x91, 0x0f, 0x85, 0x4a, 0x31, 0x14, 0xe6, 0x38, 0xc5, 0xf9, 0x57, 0x05, 0x36,
-	0x3a, 0x49, 0xcd, 0xd9, 0xf7, 0xf8, 0xa1, 0xe4, 0x0c, 0x27, 0x47, 0xca, 0x6c, 0x04, 0x64, 0x0a,
-	0xb4, 0x39, 0xb3, 0x40, 0x57, 0xf3, 0x05, 0x3a, 0x7f, 0xc0, 0xa5, 0x22, 0x6a, 0x2e, 0x86, 0x74,
-	0xb6, 0x61, 0x3d, 0x53, 0x70, 0xc7, 0x1e, 0x3f, 0x14, 0xc4, 0x53, 0x54, 0xdc, 0x55, 0x92, 0xbd,
-	0x3d, 0x43, 0xf7, 0x60, 0x2d, 0xad, 0x90, 0xbe, 0x2a, 0x9c, 0x35, 0x89, 0x90, 0x49, 0x39, 0xf5,
-	0x93, 0xca, 0x99, 0x27, 0x10, 0x56, 0x09, 0x81, 0xc8, 0x92, 0x19, 0xc8, 0x91, 0x19, 0xe7, 0xaf,
-	0x06, 0xd8, 0x69, 0x80, 0x2e, 0xd8, 0x18, 0xe4, 0xfc, 0x52, 0x29, 0xfa, 0xe5, 0x16, 0xd4, 0x71,
-	0xe8, 0xf5, 0x03, 0xac, 0x71, 0x6b, 0x2a, 0xdc, 0x2a, 0x99, 0xc2, 0xed, 0x23, 0xb0, 0x27, 0x54,
-	0x32, 0x89, 0xc1, 0x3b, 0x33, 0xb9, 0x64, 0x16, 0x14, 0x2e, 0xa4, 0x9c, 0x92, 0x39, 0x5f, 0x55,
-	0x26, 0x65, 0x4e, 0x21, 0xf6, 0x3c, 0xc9, 0xec, 0xe7, 0x50, 0xd7, 0xb7, 0x50, 0x14, 0x57, 0xa5,
-	0xb4, 0x1f, 0x94, 0x1d, 0xab, 0x6c, 0xd3, 0xad, 0x8c, 0x19, 0x3f, 0x09, 0x79, 0x74, 0xec, 0xda,
-	0x6c, 0x22, 0x69, 0xf5, 0x60, 0xbd, 0xa8, 0x80, 0xd6, 0xc1, 0x3c, 0xc2, 0xc7, 0xda, 0xc6, 0xe2,
-	0xa7, 0x48, 0xff, 0xaf, 0x05, 0x76, 0x74, 0xd5, 0xbf, 0x79, 0x62, 0x3e, 0x1d, 0x52, 0x57, 0x69,
-	0xff, 0xb0, 0xf2, 0xb1, 0xe1, 0xfc, 0xde, 0x80, 0xf5, 0xbd, 0x88, 0x8e, 0x4f, 0x9d, 0x4a, 0x1d,
-	0xa8, 0x67, 0x78, 0x71, 0x12, 0xbd, 0x39, 0xd9, 0xbc, 0xa4, 0x7a, 0x15, 0x6a, 0x7e, 0x44, 0xc7,
-	0x3d, 0x2f, 0x08, 0x64, 0x60, 0x09, 0x8a, 0x18, 0xd1, 0xf1, 0x4e, 0x10, 0x08, 0x26, 0xb2, 0x87,
-	0xd9, 0x20, 0x22, 0xfd, 0xd3, 0x27, 0xf9, 0x39, 0x4c, 0xe4, 0x6b, 0x03, 0xde, 0x2b, 0xac, 0x7d,
-	0x1e, 0xff, 0xff, 0x24, 0x8f, 0x4a, 0xe5, 0xfe, 0x39, 0x1d, 0x4e, 0x16, 0x8d, 0x9e, 0xac, 0xb0,
-	0xf2, 0xdb, 0x43, 0x91, 0x55, 0xf6, 0x23, 0x7a, 0x20, 0xf9, 0xe3, 0xc5, 0xdd, 0xf8, 0x0f, 0x06,
-	0xdc, 0x98, 0xb1, 0xc7, 0x79, 0x6e, 0x5e, 0x6c, 0x86, 0x2b, 0xf3, 0x9a, 0x61, 0xb3, 0xd0, 0x0c,
-	0x3b, 0x7f, 0xae, 0x40, 0xa3, 0xcb, 0x69, 0xe4, 0x1d, 0xe0, 0x5d, 0x1a, 0x0e, 0xc9, 0x81, 0x48,
-	0xb5, 0x09, 0xc7, 0x36, 0xe4, 0x35, 0x52, 0x16, 0x7d, 0x0b, 0xea, 0xde, 0x60, 0x80, 0x19, 0x13,
-	0x2d, 0x87, 0xce, 0x20, 0x96, 0x6b, 0x2b, 0xd9, 0x33, 0x21, 0x42, 0xdf, 0x81, 0x0d, 0x86, 0x07,
-	0x11, 0xe6, 0xbd, 0x89, 0xa6, 0x46, 0xdd, 0x9a, 0xfa, 0xb0, 0x93, 0x68, 0x0b, 0x52, 0x1e, 0x33,
-	0xdc, 0xed, 0x7e, 0xaa, 0x91, 0xa7, 0x47, 0x82, 0x12, 0xf5, 0xe3, 0xc1, 0x11, 0xe6, 0xd9, 0x94,
-	0x0e, 0x4a, 0x24, 0x41, 0x7b, 0x0d, 0xac, 0x88, 0x52, 0x2e, 0xf3, 0xb0, 0xac, 0xbf, 0x96, 0x5b,
-	0x13, 0x02, 0x91, 0x6a, 0xf4, 0xaa, 0x9d, 0x9d, 0xe7, 0xba, 0xee, 0xea, 0x91, 0xe8, 0x2b, 0x3b,
-	0x3b, 0xcf, 0x3f, 0x09, 0xfd, 0x31, 0x25, 0x21, 0x97, 0x49, 0xd9, 0x72, 0xb3, 0x22, 0x71, 0x3d,
-	0xa6, 0x2c, 0xd1, 0x13, 0x94, 0x41, 0x26, 0x64, 0xcb, 0xb5, 0xb5, 0xec, 0xe5, 0xf1, 0x18, 0x3b,
-	0xff, 0x34, 0x61, 0x5d, 0xf1, 0x9e, 0xa7, 0xb4, 0x9f, 0xc0, 0xe3, 0x3a, 0x58, 0x83, 0x20, 0x16,
-	0x2d, 0x84, 0xc6, 0x86, 0xe5, 0x4e, 0x04, 0xc2, 0x22, 0xd9, 0xd2, 0x11, 0xe1, 0x21, 0x79, 0xab,
-	0x2d, 0xb7, 0x36, 0xa9, 0x1d, 0x52, 0x9c, 0xad, 0x72, 0xe6, 0x54, 0x95, 0xf3, 0x3d, 0xee, 0xe9,
-	0xd2, 0x53, 0x95, 0xa5, 0xc7, 0x12, 0x12, 0x55, 0x75, 0xa6, 0x8a, 0xc9, 0x52, 0x49, 0x31, 0xc9,
-	0x54, 0xd7, 0xe5, 0x7c, 0x75, 0xcd, 0x83, 0x77, 0xa5, 0x98, 0x24, 0x9e, 0xc0, 0x6a, 0x62, 0x98,
-	0x81, 0xc4, 0x88, 0xb4, 0x5e, 0x49, 0x6b, 0x23, 0x93, 0x5c, 0x16, 0x4c, 0x6e, 0x83, 0xe5, 0xb0,
-	0x55, 0xac, 0xc6, 0xd6, 0x99, 0xaa, 0x71, 0x81, 0x09, 0xc2, 0x59, 0x98, 0x60, 0xb6, 0xb2, 0xda,
-	0xf9, 0xca, 0xfa, 0x29, 0xac, 0xff, 0x34, 0xc6, 0xd1, 0xf1, 0x53, 0xda, 0x67, 0x8b, 0xf9, 0xb8,
-	0x05, 0x35, 0xed, 0xa8, 0x24, 0x09, 0xa7, 0x63, 0xe7, 0x1f, 0x06, 0x34, 0x64, 0xd8, 0xbf, 0xf4,
-	0xd8, 0x51, 0xf2, 0xa2, 0x92, 0x78, 0xd9, 0xc8, 0x7b, 0xf9, 0x8c, 0x3d, 0x44, 0xc9, 0x73, 0x80,
-	0x59, 0xf6, 0x1c, 0x50, 0xc2, 0x4d, 0xaa, 0xa5, 0xdc, 0xa4, 0xd0, 0x94, 0x2c, 0x4d, 0x35, 0x25,
-	0xdf, 0x18, 0xb0, 0x91, 0xb1, 0xd1, 0x79, 0x52, 0x58, 0xce, 0xb2, 0x95, 0xa2, 0x65, 0x1f, 0xe6,
-	0x53, 0xbb, 0x59, 0xe6, 0xea, 0x4c, 0x6a, 0x4f, 0x6c, 0x9c, 0x4b, 0xef, 0xcf, 0x60, 0x4d, 0x94,
-	0xd7, 0x8b, 0x71, 0xe7, 0xdf, 0x0d, 0x58, 0x79, 0x4a, 0xfb, 0xd2, 0x91, 0x59, 0x0c, 0x19, 0xf9,
-	0xa7, 0xa6, 0x75, 0x30, 0x7d, 0x32, 0xd2, 0xf9, 0x58, 0xfc, 0x14, 0x31, 0xc6, 0xb8, 0x17, 0xf1,
-	0xc9, 0x63, 0x99, 0x20, 0x5f, 0x42, 0x22, 0xdf, 0x5b, 0xae, 0x42, 0x0d, 0x87, 0xbe, 0xfa, 0xa8,
-	0x19, 0x2e, 0x0e, 0x7d, 0xf9, 0xe9, 0x62, 0x9a, 0x96, 0x2b, 0xb0, 0x34, 0xa6, 0x93, 0x07, 0x2e,
-	0x35, 0x70, 0xae, 0x00, 0x7a, 0x8c, 0xf9, 0x53, 0xda, 0x17, 0x5e, 0x49, 0xcc, 0xe3, 0xfc, 0xad,
-	0x22, 0x1b, 0x8a, 0x89, 0xf8, 0x3c, 0x0e, 0x76, 0xa0, 0xa1, 0x0a, 0xd0, 0x17, 0xb4, 0xdf, 0x0b,
-	0xe3, 0xc4, 0x28, 0xb6, 0x14, 0x3e, 0xa5, 0xfd, 0x17, 0xf1, 0x08, 0x7d, 0x00, 0x97, 0x49, 0xd8,
-	0x1b, 0xeb, 0x9a, 0x98, 0x6a, 0x2a, 0x2b, 0xad, 0x93, 0x30, 0xa9, 0x96, 0x5a, 0xfd, 0x2e, 0xac,
-	0xe1, 0xf0, 0x55, 0x8c, 0x63, 0x9c, 0xaa, 0x2a, 0x9b, 0x35, 0xb4, 0x58, 0xeb, 0x89, 0xda, 0xe7,
-	0xb1, 0xa3, 0x1e, 0x0b, 0x28, 0x67, 0x3a, 0x27, 0x5a, 0x42, 0xd2, 0x15, 0x02, 0xf4, 0x31, 0x58,
-	0x62, 0xba, 0x82, 0x96, 0x6a, 0x0c, 0xae, 0x95, 0x41, 0x4b, 0xfb, 0xdb, 0xad, 0x7d, 0xa1, 0x7e,
-	0x30, 0x11, 0x20, 0x9a, 0x2a, 0xfb, 0x84, 0x1d, 0xe9, 0x4a, 0x03, 0x4a, 0xb4, 0x47, 0xd8, 0xd1,
-	0xf6, 0x57, 0x00, 0x20, 0x11, 0xb9, 0x4b, 0x69, 0xe4, 0xa3, 0x40, 0x9a, 0x79, 0x97, 0x8e, 0xc6,
-	0x34, 0xc4, 0x21, 0x97, 0xd1, 0xcb, 0xd0, 0x56, 0x7e, 0x33, 0x3d, 0x98, 0x56, 0xd4, 0x6e, 0x69,
-	0xbd, 0x5f, 0xaa, 0x5f, 0x50, 0x76, 0x2e, 0xa1, 0x57, 0x92, 0x5c, 0x8b, 0x21, 0x61, 0x9c, 0x0c,
-	0xd8, 0xee, 0xa1, 0x17, 0x86, 0x38, 0x40, 0xdb, 0x33, 0x9e, 0xa2, 0xca, 0x94, 0x93, 0x3d, 0x6f,
-	0x97, 0xee, 0xd9, 0xe5, 0x11, 0x09, 0x0f, 0x12, 0x5c, 0x38, 0x97, 0xd0, 0x4b, 0xb0, 0x33, 0xef,
-	0x01, 0xe8, 0x6e, 0x99, 0x19, 0xa7, 0x1f, 0x0c, 0x5a, 0x27, 0x01, 0xc8, 0xb9, 0x84, 0x86, 0xd0,
-	0xc8, 0x3d, 0x58, 0xa1, 0xf6, 0x49, 0x9c, 0x3e, 0xfb, 0x4a, 0xd4, 0xfa, 0xf6, 0x02, 0x9a, 0xe9,
-	0xe9, 0x7f, 0xa9, 0x0c, 0x36, 0xf5, 0xe2, 0x73, 0x7f, 0xc6, 0x22, 0xb3, 0xde, 0xa6, 0x5a, 0x0f,
-	0x16, 0x9f, 0x90, 0x6e, 0xee, 0x4f, 0x2e, 0xa9, 0xc0, 0x75, 0x6f, 0x7e, 0xe3, 0xa2, 0x76, 0x6b,
-	0x2f, 0xda, 0xe1, 0x38, 0x97, 0xd0, 0x3e, 0x58, 0x69, 0x8f, 0x81, 0xde, 0x2f, 0x9b, 0x58, 0x6c,
-	0x41, 0x16, 0x70, 0x4e, 0x8e, 0xc3, 0x97, 0x3b, 0xa7, 0xac, 0x85, 0x28, 0x77, 0x4e, 0x69, 0x43,
-	0xe0, 0x5c, 0x42, 0xbf, 0x9a, 0xbc, 0x5a, 0xe6, 0x98, 0x33, 0x7a, 0x70, 0xd2, 0xf5, 0xcb, 0x88,
-	0x7c, 0xeb, 0xbb, 0xa7, 0x98, 0x91, 0x01, 0x07, 0xea, 0x1e, 0xd2, 0x37, 0x8a, 0xc1, 0xc4, 0x91,
-	0x27, 0x08, 0x7f, 0xc9, 0xe6, 0x3a, 0x96, 0xa6, 0x55, 0x67, 0x6e, 0x7e, 0xc2, 0x8c, 0x74, 0xf3,
-	0x1e, 0xc0, 0x63, 0xcc, 0x9f, 0x63, 0x1e, 0x91, 0x01, 0x2b, 0x86, 0xd5, 0x24, 0x61, 0x68, 0x85,
-	0x64, 0xab, 0x7b, 0x73, 0xf5, 0xd2, 0x0d, 0xfa, 0x60, 0xef, 0x1e, 0xe2, 0xc1, 0xd1, 0x13, 0xec,
-	0x05, 0xfc, 0x10, 0x95, 0xcf, 0xcc, 0x68, 0xcc, 0xc0, 0x5e, 0x99, 0x62, 0xb2, 0xc7, 0xf6, 0x37,
-	0xcb, 0xfa, 0x1f, 0xcc, 0x17, 0xd4, 0xc7, 0xff, 0xfd, 0xb9, 0x70, 0x1f, 0xac, 0xb4, 0x47, 0x28,
-	0x0f, 0xb5, 0x62, 0x0b, 0x31, 0x2f, 0xd4, 0x3e, 0x07, 0x2b, 0x65, 0x5b, 0xe5, 0x2b, 0x16, 0x09,
-	0x6b, 0xeb, 0xce, 0x1c, 0xad, 0xf4, 0xb4, 0x2f, 0xa0, 0x96, 0xb0, 0x23, 0x74, 0x7b, 0x56, 0x5e,
-	0xc8, 0xae, 0x3c, 0xe7, 0xac, 0xbf, 0x00, 0x3b, 0x43, 0x1d, 0xca, 0x2b, 0xc1, 0x34, 0xe5, 0x68,
-	0xdd, 0x9b, 0xab, 0xf7, 0xbf, 0x11, 0x90, 0x0f, 0xbf, 0xf7, 0xf9, 0xf6, 0x01, 0xe1, 0x87, 0x71,
-	0x5f, 0x58, 0xf6, 0xbe, 0xd2, 0xfc, 0x80, 0x50, 0xfd, 0xeb, 0x7e, 0x72, 0xca, 0xfb, 0x72, 0xa5,
-	0xfb, 0xd2, 0x4e, 0xe3, 0x7e, 0x7f, 0x59, 0x0e, 0x3f, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x34, 0xde, 0x9f, 0x9a, 0x80, 0x20, 0x00, 0x00,
+	// 2179 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4f, 0x8f, 0x1b, 0x49,
+	0x15, 0x4f, 0xbb, 0x3d, 0x33, 0xee, 0xd7, 0xf6, 0xfc, 0xa9, 0x24, 0xe0, 0x38, 0x09, 0x99, 0x74,
+	0x36, 0x89, 0x17, 0x69, 0x27, 0x61, 0x96, 0x45, 0x0b, 0x02, 0xa4, 0xc9, 0xcc, 0x26, 0x71, 0xb2,
+	0x89, 0x86, 0x76, 0xb4, 0x12, 0x2b, 0x24, 0xd3, 0x76, 0x97, 0x67, 0x6a, 0xa7, 0xdd, 0xe5, 0x74,
+	0x55, 0x27, 0x99, 0x20, 0x21, 0x2e, 0x7b, 0x60, 0xb5, 0x12, 0x12, 0x42, 0xf0, 0x05, 0x38, 0x2d,
+	0x07, 0xee, 0x5c, 0xf8, 0x02, 0x9c, 0xf8, 0x32, 0x5c, 0x38, 0xa0, 0xfa, 0xd3, 0xed, 0xee, 0x76,
+	0x7b, 0xec, 0xcc, 0x0c, 0x17, 0xb8, 0xb9, 0x5e, 0xbf, 0xfa, 0xf7, 0xde, 0xaf, 0xde, 0xef, 0x57,
+	0x65, 0xd8, 0x20, 0xa1, 0x8f, 0xdf, 0xf4, 0x06, 0x94, 0x46, 0xfe, 0xd6, 0x38, 0xa2, 0x9c, 0x22,
+	0x34, 0x22, 0xc1, 0xab, 0x98, 0xa9, 0xd6, 0x96, 0xfc, 0xde, 0xaa, 0x0f, 0xe8, 0x68, 0x44, 0x43,
+	0x65, 0x6b, 0xad, 0x92, 0x90, 0xe3, 0x28, 0xf4, 0x02, 0xdd, 0xae, 0x67, 0x7b, 0x38, 0x7f, 0xad,
+	0x82, 0xd5, 0x11, 0xbd, 0x3a, 0xe1, 0x90, 0x22, 0x07, 0xea, 0x03, 0x1a, 0x04, 0x78, 0xc0, 0x09,
+	0x0d, 0x3b, 0x7b, 0x4d, 0x63, 0xd3, 0x68, 0x9b, 0x6e, 0xce, 0x86, 0x9a, 0xb0, 0x32, 0x24, 0x38,
+	0xf0, 0x3b, 0x7b, 0xcd, 0x8a, 0xfc, 0x9c, 0x34, 0xd1, 0x75, 0x00, 0xb5, 0xc0, 0xd0, 0x1b, 0xe1,
+	0xa6, 0xb9, 0x69, 0xb4, 0x2d, 0xd7, 0x92, 0x96, 0xe7, 0xde, 0x08, 0x8b, 0x8e, 0xb2, 0xd1, 0xd9,
+	0x6b, 0x56, 0x55, 0x47, 0xdd, 0x44, 0x0f, 0xc0, 0xe6, 0xc7, 0x63, 0xdc, 0x1b, 0x7b, 0x91, 0x37,
+	0x62, 0xcd, 0xa5, 0x4d, 0xb3, 0x6d, 0x6f, 0xdf, 0xdc, 0xca, 0x6d, 0x4d, 0xef, 0xe9, 0x29, 0x3e,
+	0xfe, 0xcc, 0x0b, 0x62, 0xbc, 0xef, 0x91, 0xc8, 0x05, 0xd1, 0x6b, 0x5f, 0x76, 0x42, 0x7b, 0x50,
+	0x57, 0x93, 0xeb, 0x41, 0x96, 0x17, 0x1d, 0xc4, 0x96, 0xdd, 0xf4, 0x28, 0x37, 0xf5, 0x28, 0xd8,
+	0xef, 0x45, 0xf4, 0x35, 0x6b, 0xae, 0xc8, 0x85, 0xda, 0xda, 0xe6, 0xd2, 0xd7, 0x4c, 0xec, 0x92,
+	0x53, 0xee, 0x05, 0xca, 0xa1, 0x26, 0x1d, 0x2c, 0x69, 0x91, 0x9f, 0x3f, 0x82, 0x25, 0xc6, 0x3d,
+	0x8e, 0x9b, 0xd6, 0xa6, 0xd1, 0x5e, 0xdd, 0xbe, 0x51, 0xba, 0x00, 0x19, 0xf1, 0xae, 0x70, 0x73,
+	0x95, 0x37, 0xfa, 0x08, 0xbe, 0xad, 0x96, 0x2f, 0x9b, 0xbd, 0xa1, 0x47, 0x82, 0x5e, 0x84, 0x3d,
+	0x46, 0xc3, 0x26, 0xc8, 0x40, 0x5e, 0x22, 0x69, 0x9f, 0x87, 0x1e, 0x09, 0x5c, 0xf9, 0x0d, 0x39,
+	0xd0, 0x20, 0xac, 0xe7, 0xc5, 0x9c, 0xf6, 0xe4, 0xf7, 0xa6, 0xbd, 0x69, 0xb4, 0x6b, 0xae, 0x4d,
+	0xd8, 0x4e, 0xcc, 0xa9, 0x9c, 0x06, 0x3d, 0x83, 0x8d, 0x98, 0xe1, 0xa8, 0x97, 0x0b, 0x4f, 0x7d,
+	0xd1, 0xf0, 0xac, 0x89, 0xbe, 0x9d, 0x49, 0x88, 0x9c, 0x2f, 0x0d, 0x80, 0x87, 0x32, 0xe3, 0x72,
+	0xf4, 0x1f, 0x27, 0x49, 0x27, 0xe1, 0x90, 0x4a, 0xc0, 0xd8, 0xdb, 0xd7, 0xb7, 0xa6, 0x51, 0xb9,
+	0x95, 0xa2, 0x4c, 0x63, 0x42, 0xfc, 0x14, 0x98, 0xf0, 0x71, 0x80, 0x39, 0xf6, 0x25, 0x98, 0x6a,
+	0x6e, 0xd2, 0x44, 0x37, 0xc0, 0x1e, 0x44, 0x58, 0xc4, 0x82, 0x13, 0x8d, 0xa6, 0xaa, 0x0b, 0xca,
+	0xf4, 0x82, 0x8c, 0xb0, 0xf3, 0x65, 0x15, 0xea, 0x5d, 0x7c, 0x30, 0xc2, 0x21, 0x57, 0x2b, 0x59,
+	0x04, 0xbc, 0x9b, 0x60, 0x8f, 0xbd, 0x88, 0x13, 0xed, 0xa2, 0x00, 0x9c, 0x35, 0xa1, 0x6b, 0x60,
+	0x31, 0x3d, 0xea, 0x9e, 0x9c, 0xd5, 0x74, 0x27, 0x06, 0x74, 0x05, 0x6a, 0x61, 0x3c, 0x52, 0xa9,
+	0xd7, 0x20, 0x0e, 0xe3, 0x91, 0x4c, 0x7c, 0x06, 0xde, 0x4b, 0x79, 0x78, 0x37, 0x61, 0xa5, 0x1f,
+	0x13, 0x79, 0x62, 0x96, 0xd5, 0x17, 0xdd, 0x44, 0xdf, 0x82, 0xe5, 0x90, 0xfa, 0xb8, 0xb3, 0xa7,
+	0x81, 0xa6, 0x5b, 0xe8, 0x16, 0x34, 0x54, 0x50, 0x5f, 0xe1, 0x88, 0x11, 0x1a, 0x6a, 0x98, 0x29,
+	0x6c, 0x7e, 0xa6, 0x6c, 0xa7, 0x45, 0xda, 0x0d, 0xb0, 0xa7, 0xd1, 0x05, 0xc3, 0x09, 0xa6, 0xee,
+	0xc0, 0x9a, 0x9a, 0x7c, 0x48, 0x02, 0xdc, 0x3b, 0xc2, 0xc7, 0xac, 0x69, 0x6f, 0x9a, 0x6d, 0xcb,
+	0x55, 0x6b, 0x7a, 0x48, 0x02, 0xfc, 0x14, 0x1f, 0xb3, 0x6c, 0xee, 0xea, 0x27, 0xe6, 0xae, 0x51,
+	0xcc, 0x1d, 0xba, 0x0d, 0xab, 0x0c, 0x47, 0xc4, 0x0b, 0xc8, 0x5b, 0xdc, 0x63, 0xe4, 0x2d, 0x6e,
+	0xae, 0x4a, 0x9f, 0x46, 0x6a, 0xed, 0x92, 0xb7, 0x58, 0x84, 0xe1, 0x75, 0x44, 0x38, 0xee, 0x1d,
+	0x7a, 0xa1, 0x4f, 0x87, 0xc3, 0xe6, 0x9a, 0x9c, 0xa7, 0x2e, 0x8d, 0x8f, 0x95, 0xcd, 0xf9, 0x93,
+	0x01, 0x17, 0x5d, 0x7c, 0x40, 0x18, 0xc7, 0xd1, 0x73, 0xea, 0x63, 0x17, 0xbf, 0x8c, 0x31, 0xe3,
+	0xe8, 0x3e, 0x54, 0xfb, 0x1e, 0xc3, 0x1a, 0x92, 0xd7, 0x4a, 0xa3, 0xf3, 0x8c, 0x1d, 0x3c, 0xf0,
+	0x18, 0x76, 0xa5, 0x27, 0xfa, 0x01, 0xac, 0x78, 0xbe, 0x1f, 0x61, 0xc6, 0x9a, 0x95, 0x13, 0x3a,
+	0xed, 0x28, 0x1f, 0x37, 0x71, 0xce, 0x64, 0xd1, 0xcc, 0x66, 0xd1, 0xf9, 0x9d, 0x01, 0x97, 0xf2,
+	0x2b, 0x63, 0x63, 0x1a, 0x32, 0x8c, 0x3e, 0x84, 0x65, 0x91, 0x8b, 0x98, 0xe9, 0xc5, 0x5d, 0x2d,
+	0x9d, 0xa7, 0x2b, 0x5d, 0x5c, 0xed, 0x2a, 0x8a, 0x24, 0x09, 0x09, 0x4f, 0x0e, 0xb0, 0x5a, 0xe1,
+	0xcd, 0xe2, 0x49, 0xd3, 0xa5, 0xbe, 0x13, 0x12, 0xae, 0xce, 0xab, 0x0b, 0x24, 0xfd, 0xed, 0xfc,
+	0x1c, 0x2e, 0x3d, 0xc2, 0x3c, 0x83, 0x09, 0x1d, 0xab, 0x45, 0x8e, 0x4e, 0xbe, 0xba, 0x57, 0x0a,
+	0xd5, 0xdd, 0xf9, 0xb3, 0x01, 0x97, 0x0b, 0x63, 0x9f, 0x65, 0xb7, 0x29, 0xb8, 0x2b, 0x67, 0x01,
+	0xb7, 0x59, 0x04, 0xb7, 0xf3, 0x1b, 0x03, 0xae, 0x3e, 0xc2, 0x3c, 0x5b, 0x38, 0xce, 0x39, 0x12,
+	0xe8, 0x3b, 0x00, 0x69, 0xc1, 0x60, 0x4d, 0x73, 0xd3, 0x6c, 0x9b, 0x6e, 0xc6, 0xe2, 0xfc, 0xd6,
+	0x80, 0x8d, 0xa9, 0xf9, 0xf3, 0x75, 0xc7, 0x28, 0xd6, 0x9d, 0xff, 0x56, 0x38, 0x7e, 0x6f, 0xc0,
+	0xb5, 0xf2, 0x70, 0x9c, 0x25, 0x79, 0x3f, 0x51, 0x9d, 0xb0, 0x40, 0xa9, 0xa0, 0x99, 0xdb, 0x65,
+	0x7c, 0x30, 0x3d, 0xa7, 0xee, 0xe4, 0x7c, 0x6d, 0x02, 0xda, 0x95, 0xc5, 0x42, 0x7e, 0x7c, 0x97,
+	0xd4, 0x9c, 0x5a, 0x9c, 0x14, 0x24, 0x48, 0xf5, 0x3c, 0x24, 0xc8, 0xd2, 0xa9, 0x24, 0xc8, 0x35,
+	0xb0, 0x44, 0xd5, 0x64, 0xdc, 0x1b, 0x8d, 0x25, 0x5f, 0x54, 0xdd, 0x89, 0x61, 0x9a, 0xf0, 0x57,
+	0x16, 0x24, 0xfc, 0xda, 0xa9, 0x09, 0xff, 0x0d, 0x5c, 0x4c, 0x0e, 0xb6, 0xa4, 0xef, 0x77, 0x48,
+	0x47, 0xfe, 0x28, 0x54, 0x8a, 0x47, 0x61, 0x4e, 0x52, 0x9c, 0x7f, 0x55, 0x60, 0xa3, 0x93, 0x70,
+	0xce, 0xbe, 0xc7, 0x0f, 0xa5, 0x66, 0x38, 0xf9, 0xa4, 0xcc, 0x46, 0x40, 0x86, 0xa0, 0xcd, 0x99,
+	0x04, 0x5d, 0xcd, 0x13, 0x74, 0x7e, 0x81, 0x4b, 0x45, 0xd4, 0x9c, 0x8f, 0xe8, 0x6c, 0xc3, 0x7a,
+	0x86, 0x70, 0xc7, 0x1e, 0x3f, 0x14, 0xc2, 0x53, 0x30, 0xee, 0x2a, 0xc9, 0xee, 0x9e, 0xa1, 0xbb,
+	0xb0, 0x96, 0x32, 0xa4, 0xaf, 0x88, 0xb3, 0x26, 0x11, 0x32, 0xa1, 0x53, 0x3f, 0x61, 0xce, 0xbc,
+	0x80, 0xb0, 0x4a, 0x04, 0x44, 0x56, 0xcc, 0x40, 0x4e, 0xcc, 0x38, 0x7f, 0x33, 0xc0, 0x4e, 0x0f,
+	0xe8, 0x82, 0x17, 0x83, 0x5c, 0x5e, 0x2a, 0xc5, 0xbc, 0xdc, 0x84, 0x3a, 0x0e, 0xbd, 0x7e, 0x80,
+	0x35, 0x6e, 0x4d, 0x85, 0x5b, 0x65, 0x53, 0xb8, 0x7d, 0x08, 0xf6, 0x44, 0x4a, 0x26, 0x67, 0xf0,
+	0xf6, 0x4c, 0x2d, 0x99, 0x05, 0x85, 0x0b, 0xa9, 0xa6, 0x64, 0xce, 0x57, 0x95, 0x09, 0xcd, 0xc9,
+	0x8f, 0x67, 0x2a, 0x66, 0xbf, 0x80, 0xba, 0xde, 0x85, 0x92, 0xb8, 0xaa, 0xa4, 0xfd, 0xb0, 0x6c,
+	0x59, 0x65, 0x93, 0x6e, 0x65, 0xc2, 0xf8, 0x49, 0xc8, 0xa3, 0x63, 0xd7, 0x66, 0x13, 0x4b, 0xab,
+	0x07, 0xeb, 0x45, 0x07, 0xb4, 0x0e, 0xe6, 0x11, 0x3e, 0xd6, 0x31, 0x16, 0x3f, 0x45, 0xf9, 0x7f,
+	0x25, 0xb0, 0xa3, 0x59, 0xff, 0xc6, 0x89, 0xf5, 0x74, 0x48, 0x5d, 0xe5, 0xfd, 0xa3, 0xca, 0xc7,
+	0x86, 0xf3, 0x07, 0x03, 0xd6, 0xf7, 0x22, 0x3a, 0x7e, 0xe7, 0x52, 0xea, 0x40, 0x3d, 0xa3, 0x8b,
+	0x93, 0xd3, 0x9b, 0xb3, 0xcd, 0x2b, 0xaa, 0x57, 0xa0, 0xe6, 0x47, 0x74, 0xdc, 0xf3, 0x82, 0xa0,
+	0x59, 0xd5, 0x12, 0x31, 0xa2, 0xe3, 0x9d, 0x20, 0x10, 0x4a, 0x64, 0x0f, 0xb3, 0x41, 0x44, 0xfa,
+	0xef, 0x5e, 0xe4, 0xe7, 0x28, 0x91, 0xaf, 0x0d, 0xb8, 0x5c, 0x18, 0xfb, 0x2c, 0xf9, 0xff, 0x69,
+	0x1e, 0x95, 0x2a, 0xfd, 0x73, 0x6e, 0x38, 0x59, 0x34, 0x7a, 0x92, 0x61, 0xe5, 0xb7, 0x07, 0xa2,
+	0xaa, 0xec, 0x47, 0xf4, 0x40, 0xea, 0xc7, 0xf3, 0xdb, 0xf1, 0x1f, 0x0d, 0xb8, 0x3e, 0x63, 0x8e,
+	0xb3, 0xec, 0xbc, 0x78, 0x19, 0xae, 0xcc, 0xbb, 0x0c, 0x9b, 0x85, 0xcb, 0xb0, 0xf3, 0x97, 0x0a,
+	0x34, 0xba, 0x9c, 0x46, 0xde, 0x01, 0xde, 0xa5, 0xe1, 0x90, 0x1c, 0x88, 0x52, 0x9b, 0x68, 0x6c,
+	0x43, 0x6e, 0x23, 0x69, 0x8a, 0xd9, 0xbc, 0xc1, 0x00, 0x33, 0x26, 0xae, 0x1c, 0xba, 0x82, 0x58,
+	0xae, 0xad, 0x6c, 0x4f, 0x85, 0x09, 0x7d, 0x17, 0x36, 0x18, 0x1e, 0x44, 0x98, 0xf7, 0x26, 0x9e,
+	0x1a, 0x75, 0x6b, 0xea, 0xc3, 0x4e, 0xe2, 0x2d, 0x44, 0x79, 0xcc, 0x70, 0xb7, 0xfb, 0xa9, 0x46,
+	0x9e, 0x6e, 0x09, 0x49, 0xd4, 0x8f, 0x07, 0x47, 0x98, 0x67, 0x4b, 0x3a, 0x28, 0x93, 0x04, 0xed,
+	0x55, 0xb0, 0x22, 0x4a, 0xb9, 0xac, 0xc3, 0x92, 0x7f, 0x2d, 0xb7, 0x26, 0x0c, 0xa2, 0xd4, 0xe8,
+	0x51, 0x3b, 0x3b, 0xcf, 0x34, 0xef, 0xea, 0x96, 0xb8, 0x57, 0x76, 0x76, 0x9e, 0x7d, 0x12, 0xfa,
+	0x63, 0x4a, 0x42, 0x2e, 0x8b, 0xb2, 0xe5, 0x66, 0x4d, 0x62, 0x7b, 0x4c, 0x45, 0xa2, 0x27, 0x24,
+	0x83, 0x2c, 0xc8, 0x96, 0x6b, 0x6b, 0xdb, 0x8b, 0xe3, 0x31, 0x76, 0xfe, 0x6d, 0xc2, 0xba, 0xd2,
+	0x3d, 0x4f, 0x68, 0x3f, 0x81, 0xc7, 0x35, 0xb0, 0x06, 0x41, 0xcc, 0x38, 0x8e, 0x34, 0x36, 0x2c,
+	0x77, 0x62, 0x10, 0x11, 0xc9, 0x52, 0x47, 0x84, 0x87, 0xe4, 0x8d, 0x8e, 0xdc, 0xda, 0x84, 0x3b,
+	0xa4, 0x39, 0xcb, 0x72, 0xe6, 0x14, 0xcb, 0xf9, 0x1e, 0xf7, 0x34, 0xf5, 0x54, 0x25, 0xf5, 0x58,
+	0xc2, 0xa2, 0x58, 0x67, 0x8a, 0x4c, 0x96, 0x4a, 0xc8, 0x24, 0xc3, 0xae, 0xcb, 0x79, 0x76, 0xcd,
+	0x83, 0x77, 0xa5, 0x58, 0x24, 0x1e, 0xc3, 0x6a, 0x12, 0x98, 0x81, 0xc4, 0x88, 0x8c, 0x5e, 0xc9,
+	0xd5, 0x46, 0x16, 0xb9, 0x2c, 0x98, 0xdc, 0x06, 0xcb, 0x36, 0xa7, 0xd8, 0xd8, 0x3a, 0x15, 0x1b,
+	0x17, 0x94, 0x20, 0x9c, 0x46, 0x09, 0x66, 0x99, 0xd5, 0x9e, 0x7a, 0x26, 0x48, 0xf4, 0x49, 0x3d,
+	0xa7, 0x4f, 0x9c, 0x4f, 0x61, 0xfd, 0x67, 0x31, 0x8e, 0x8e, 0x9f, 0xd0, 0x3e, 0x5b, 0x2c, 0xfb,
+	0x2d, 0xa8, 0xe9, 0x14, 0x26, 0xe5, 0x39, 0x6d, 0x3b, 0xff, 0x34, 0xa0, 0x21, 0x0b, 0xc2, 0x0b,
+	0x8f, 0x1d, 0x25, 0x6f, 0x2d, 0x49, 0xfe, 0x8d, 0x7c, 0xfe, 0x4f, 0x79, 0xbb, 0x28, 0x79, 0x28,
+	0x30, 0xcb, 0x1e, 0x0a, 0x4a, 0x54, 0x4b, 0xb5, 0x54, 0xb5, 0x14, 0xae, 0x2b, 0x4b, 0x53, 0xd7,
+	0x95, 0x6f, 0x0c, 0xd8, 0xc8, 0xc4, 0xe8, 0x2c, 0xc5, 0x2d, 0x17, 0xd9, 0x4a, 0x31, 0xb2, 0x0f,
+	0xf2, 0x45, 0xdf, 0x2c, 0x03, 0x41, 0xa6, 0xe8, 0x27, 0x31, 0xce, 0x15, 0xfe, 0xa7, 0xb0, 0x26,
+	0x88, 0xf7, 0x7c, 0xd2, 0xf9, 0x0f, 0x03, 0x56, 0x9e, 0xd0, 0xbe, 0x4c, 0x64, 0x16, 0x5d, 0x46,
+	0x1e, 0x5d, 0xeb, 0x60, 0xfa, 0x64, 0xa4, 0x2b, 0xb5, 0xf8, 0x29, 0x4e, 0x1f, 0xe3, 0x5e, 0xc4,
+	0x27, 0xcf, 0x68, 0x42, 0x96, 0x09, 0x8b, 0x7c, 0x89, 0xb9, 0x02, 0x35, 0x1c, 0xfa, 0xea, 0xa3,
+	0xd6, 0xbe, 0x38, 0xf4, 0xe5, 0xa7, 0xf3, 0xb9, 0xce, 0x5c, 0x82, 0xa5, 0x31, 0x9d, 0x3c, 0x7d,
+	0xa9, 0x86, 0x73, 0x09, 0xd0, 0x23, 0xcc, 0x9f, 0xd0, 0xbe, 0xc8, 0x4a, 0x12, 0x1e, 0xe7, 0xef,
+	0x15, 0xb8, 0x98, 0x33, 0x9f, 0x25, 0xc1, 0x0e, 0x34, 0x14, 0x35, 0x7d, 0x41, 0xfb, 0xbd, 0x30,
+	0x4e, 0x82, 0x62, 0x4b, 0xe3, 0x13, 0xda, 0x7f, 0x1e, 0x8f, 0xd0, 0x07, 0x70, 0x91, 0x84, 0xbd,
+	0xb1, 0x66, 0xcb, 0xd4, 0x53, 0x45, 0x69, 0x9d, 0x84, 0x09, 0x8f, 0x6a, 0xf7, 0x3b, 0xb0, 0x86,
+	0xc3, 0x97, 0x31, 0x8e, 0x71, 0xea, 0xaa, 0x62, 0xd6, 0xd0, 0x66, 0xed, 0x27, 0x58, 0xd1, 0x63,
+	0x47, 0x3d, 0x16, 0x50, 0xce, 0x74, 0xb5, 0xb4, 0x84, 0xa5, 0x2b, 0x0c, 0xe8, 0x63, 0xb0, 0x44,
+	0x77, 0x05, 0x2d, 0x75, 0x65, 0xb8, 0x5a, 0x06, 0x2d, 0x9d, 0x6f, 0xb7, 0xf6, 0x85, 0xfa, 0xc1,
+	0xc4, 0x01, 0xd1, 0x22, 0xda, 0x27, 0xec, 0x48, 0x73, 0x10, 0x28, 0xd3, 0x1e, 0x61, 0x47, 0xdb,
+	0x5f, 0x01, 0x80, 0x44, 0xe4, 0x2e, 0xa5, 0x91, 0x8f, 0x02, 0x19, 0xe6, 0x5d, 0x3a, 0x1a, 0xd3,
+	0x10, 0x87, 0x5c, 0x9e, 0x5e, 0x86, 0xb6, 0xf2, 0x93, 0xe9, 0xc6, 0xb4, 0xa3, 0x4e, 0x4b, 0xeb,
+	0xbd, 0x52, 0xff, 0x82, 0xb3, 0x73, 0x01, 0xbd, 0x94, 0xb2, 0x5b, 0x34, 0x09, 0xe3, 0x64, 0xc0,
+	0x76, 0x0f, 0xbd, 0x30, 0xc4, 0x01, 0xda, 0x9e, 0xf1, 0x48, 0x55, 0xe6, 0x9c, 0xcc, 0x79, 0xab,
+	0x74, 0xce, 0x2e, 0x8f, 0x48, 0x78, 0x90, 0xe0, 0xc2, 0xb9, 0x80, 0x5e, 0x80, 0x9d, 0x79, 0x29,
+	0x40, 0x77, 0xca, 0xc2, 0x38, 0xfd, 0x94, 0xd0, 0x3a, 0x09, 0x40, 0xce, 0x05, 0x34, 0x84, 0x46,
+	0xee, 0x29, 0x0b, 0xb5, 0x4f, 0x52, 0xfb, 0xd9, 0xf7, 0xa3, 0xd6, 0xfb, 0x0b, 0x78, 0xa6, 0xab,
+	0xff, 0x95, 0x0a, 0xd8, 0xd4, 0x5b, 0xd0, 0xbd, 0x19, 0x83, 0xcc, 0x7a, 0xb5, 0x6a, 0xdd, 0x5f,
+	0xbc, 0x43, 0x3a, 0xb9, 0x3f, 0xd9, 0xa4, 0x02, 0xd7, 0xdd, 0xf9, 0x57, 0x1a, 0x35, 0x5b, 0x7b,
+	0xd1, 0xbb, 0x8f, 0x73, 0x01, 0xed, 0x83, 0x95, 0xde, 0x3e, 0xd0, 0x7b, 0x65, 0x1d, 0x8b, 0x97,
+	0x93, 0x05, 0x92, 0x93, 0x53, 0xf7, 0xe5, 0xc9, 0x29, 0xbb, 0x5c, 0xb4, 0xde, 0x5f, 0xc0, 0x33,
+	0x5d, 0xf9, 0xaf, 0xe1, 0x72, 0xa9, 0xa6, 0x46, 0xf7, 0x4f, 0xda, 0x7e, 0x99, 0xc4, 0x6f, 0x7d,
+	0xef, 0x1d, 0x7a, 0x64, 0xc0, 0x81, 0xba, 0x87, 0xf4, 0xb5, 0xd2, 0x36, 0x71, 0xe4, 0x71, 0x42,
+	0xc3, 0x92, 0xc9, 0xf5, 0x59, 0x9a, 0x76, 0x9d, 0x39, 0xf9, 0x09, 0x3d, 0xd2, 0xc9, 0x7b, 0x00,
+	0x8f, 0x30, 0x7f, 0x86, 0x79, 0x44, 0x06, 0xac, 0x78, 0xac, 0x26, 0x05, 0x43, 0x3b, 0x24, 0x53,
+	0xdd, 0x9d, 0xeb, 0x97, 0x4e, 0xd0, 0x07, 0x7b, 0xf7, 0x10, 0x0f, 0x8e, 0x1e, 0x63, 0x2f, 0xe0,
+	0x87, 0xa8, 0xbc, 0x67, 0xc6, 0x63, 0x06, 0xf6, 0xca, 0x1c, 0x93, 0x39, 0xb6, 0xbf, 0x59, 0xd6,
+	0xff, 0x6d, 0x8a, 0xc7, 0xf7, 0xff, 0xfd, 0x5a, 0xb8, 0x0f, 0x56, 0x7a, 0x7b, 0x28, 0x3f, 0x6a,
+	0xc5, 0xcb, 0xc5, 0xbc, 0xa3, 0xf6, 0x39, 0x58, 0xa9, 0xda, 0x2a, 0x1f, 0xb1, 0x28, 0x58, 0x5b,
+	0xb7, 0xe7, 0x78, 0xa5, 0xab, 0x7d, 0x0e, 0xb5, 0x44, 0x1d, 0xa1, 0x5b, 0xb3, 0xea, 0x42, 0x76,
+	0xe4, 0x39, 0x6b, 0xfd, 0x25, 0xd8, 0x19, 0xe9, 0x50, 0xce, 0x04, 0xd3, 0x92, 0xa3, 0x75, 0x77,
+	0xae, 0xdf, 0xff, 0xc7, 0x81, 0x7c, 0xf0, 0xfd, 0xcf, 0xb7, 0x0f, 0x08, 0x3f, 0x8c, 0xfb, 0x22,
+	0xb2, 0xf7, 0x94, 0xe7, 0x07, 0x84, 0xea, 0x5f, 0xf7, 0x92, 0x55, 0xde, 0x93, 0x23, 0xdd, 0x93,
+	0x71, 0x1a, 0xf7, 0xfb, 0xcb, 0xb2, 0xf9, 0xe1, 0x7f, 0x06, 0x00, 0xe0, 0x26, 0xbb, 0x9e, 0x9a,
+	0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -48,6 +48,40 @@ func (InsertDataVersion) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_41f4a519b878ee3b, []int{0}
 }
 
+type AggregateType int32
+
+const (
+	AggregateType_Count AggregateType = 0
+	AggregateType_Min   AggregateType = 1
+	AggregateType_Max   AggregateType = 2
+	AggregateType_Sum   AggregateType = 3
+	AggregateType_Avg   AggregateType = 4
+)
+
+var AggregateType_name = map[int32]string{
+	0: "Count",
+	1: "Min",
+	2: "Max",
+	3: "Sum",
+	4: "Avg",
+}
+
+var AggregateType_value = map[string]int32{
+	"Count": 0,
+	"Min":   1,
+	"Max":   2,
+	"Sum":   3,
+	"Avg":   4,
+}
+
+func (x AggregateType) String() string {
+	return proto.EnumName(AggregateType_name, int32(x))
+}
+
+func (AggregateType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{1}
+}
+
 type RateType int32
 
 const (
@@ -94,7 +128,7 @@ func (x RateType) String() string {
 }
 
 func (RateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{1}
+	return fileDescriptor_41f4a519b878ee3b, []int{2}
 }
 
 type GetTimeTickChannelRequest struct {
@@ -530,6 +564,7 @@ type CreateCollectionRequest struct {
 	Schema               []byte   `protobuf:"bytes,8,opt,name=schema,proto3" json:"schema,omitempty"`
 	VirtualChannelNames  []string `protobuf:"bytes,9,rep,name=virtualChannelNames,proto3" json:"virtualChannelNames,omitempty"`
 	PhysicalChannelNames []string `protobuf:"bytes,10,rep,name=physicalChannelNames,proto3" json:"physicalChannelNames,omitempty"`
+	PartitionIDs         []int64  `protobuf:"varint,11,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -630,6 +665,13 @@ func (m *CreateCollectionRequest) GetPhysicalChannelNames() []string {
 	return nil
 }
 
+func (m *CreateCollectionRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
 type DropCollectionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -1168,6 +1210,8 @@ type InsertRequest struct {
 	FieldsData           []*schemapb.FieldData `protobuf:"bytes,13,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
 	NumRows              uint64                `protobuf:"varint,14,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
 	Version              InsertDataVersion     `protobuf:"varint,15,opt,name=version,proto3,enum=milvus.proto.internal.InsertDataVersion" json:"version,omitempty"`
+	SchemaVersion        int32                 `protobuf:"varint,16,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	ValidData            []*FieldValidData     `protobuf:"bytes,17,rep,name=valid_data,json=validData,proto3" json:"valid_data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1303,6 +1347,68 @@ func (m *InsertRequest) GetVersion() InsertDataVersion {
 	return InsertDataVersion_RowBased
 }
 
+func (m *InsertRequest) GetSchemaVersion() int32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+func (m *InsertRequest) GetValidData() []*FieldValidData {
+	if m != nil {
+		return m.ValidData
+	}
+	return nil
+}
+
+// FieldValidData marks the null rows of a nullable field, valid[i] is false if the i-th row is null.
+type FieldValidData struct {
+	FieldId              int64    `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Valid                []bool   `protobuf:"varint,2,rep,packed,name=valid,proto3" json:"valid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldValidData) Reset()         { *m = FieldValidData{} }
+func (m *FieldValidData) String() string { return proto.CompactTextString(m) }
+func (*FieldValidData) ProtoMessage()    {}
+func (*FieldValidData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{18}
+}
+
+func (m *FieldValidData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldValidData.Unmarshal(m, b)
+}
+func (m *FieldValidData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldValidData.Marshal(b, m, deterministic)
+}
+func (m *FieldValidData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldValidData.Merge(m, src)
+}
+func (m *FieldValidData) XXX_Size() int {
+	return xxx_messageInfo_FieldValidData.Size(m)
+}
+func (m *FieldValidData) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldValidData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldValidData proto.InternalMessageInfo
+
+func (m *FieldValidData) GetFieldId() int64 {
+	if m != nil {
+		return m.FieldId
+	}
+	return 0
+}
+
+func (m *FieldValidData) GetValid() []bool {
+	if m != nil {
+		return m.Valid
+	}
+	return nil
+}
+
 type SearchRequest struct {
 	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ReqID        int64             `protobuf:"varint,2,opt,name=reqID,proto3" json:"reqID,omitempty"`
@@ -1311,26 +1417,30 @@ type SearchRequest struct {
 	PartitionIDs []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	Dsl          string            `protobuf:"bytes,6,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	SerializedExprPlan   []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	TimeoutTimestamp     uint64           `protobuf:"varint,13,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
-	Nq                   int64            `protobuf:"varint,14,opt,name=nq,proto3" json:"nq,omitempty"`
-	Topk                 int64            `protobuf:"varint,15,opt,name=topk,proto3" json:"topk,omitempty"`
-	MetricType           string           `protobuf:"bytes,16,opt,name=metricType,proto3" json:"metricType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	PlaceholderGroup   []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType            commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	SerializedExprPlan []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	TimeoutTimestamp   uint64           `protobuf:"varint,13,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Nq                 int64            `protobuf:"varint,14,opt,name=nq,proto3" json:"nq,omitempty"`
+	Topk               int64            `protobuf:"varint,15,opt,name=topk,proto3" json:"topk,omitempty"`
+	MetricType         string           `protobuf:"bytes,16,opt,name=metricType,proto3" json:"metricType,omitempty"`
+	// results are grouped by the field if it's set, the best hit of each group is kept.
+	// topk is the number of candidates searched, group_topk is the number of groups returned.
+	GroupByFieldId       int64    `protobuf:"varint,17,opt,name=group_by_field_id,json=groupByFieldId,proto3" json:"group_by_field_id,omitempty"`
+	GroupTopk            int64    `protobuf:"varint,18,opt,name=group_topk,json=groupTopk,proto3" json:"group_topk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{18}
+	return fileDescriptor_41f4a519b878ee3b, []int{19}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -1463,6 +1573,20 @@ func (m *SearchRequest) GetMetricType() string {
 	return ""
 }
 
+func (m *SearchRequest) GetGroupByFieldId() int64 {
+	if m != nil {
+		return m.GroupByFieldId
+	}
+	return 0
+}
+
+func (m *SearchRequest) GetGroupTopk() int64 {
+	if m != nil {
+		return m.GroupTopk
+	}
+	return 0
+}
+
 type SearchResults struct {
 	Base                     *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                   *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1474,9 +1598,11 @@ type SearchResults struct {
 	ChannelIDsSearched       []string          `protobuf:"bytes,8,rep,name=channelIDs_searched,json=channelIDsSearched,proto3" json:"channelIDs_searched,omitempty"`
 	GlobalSealedSegmentIDs   []int64           `protobuf:"varint,9,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
 	// schema.SearchResultsData inside
-	SlicedBlob           []byte   `protobuf:"bytes,10,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
-	SlicedNumCount       int64    `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
-	SlicedOffset         int64    `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
+	SlicedBlob     []byte `protobuf:"bytes,10,opt,name=sliced_blob,json=slicedBlob,proto3" json:"sliced_blob,omitempty"`
+	SlicedNumCount int64  `protobuf:"varint,11,opt,name=sliced_num_count,json=slicedNumCount,proto3" json:"sliced_num_count,omitempty"`
+	SlicedOffset   int64  `protobuf:"varint,12,opt,name=sliced_offset,json=slicedOffset,proto3" json:"sliced_offset,omitempty"`
+	// whether more groups of each query could be found by searching more candidates, set by group by search
+	GroupsTruncated      []bool   `protobuf:"varint,13,rep,packed,name=groups_truncated,json=groupsTruncated,proto3" json:"groups_truncated,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1486,7 +1612,7 @@ func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{19}
+	return fileDescriptor_41f4a519b878ee3b, []int{20}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -1591,28 +1717,40 @@ func (m *SearchResults) GetSlicedOffset() int64 {
 	return 0
 }
 
+func (m *SearchResults) GetGroupsTruncated() []bool {
+	if m != nil {
+		return m.GroupsTruncated
+	}
+	return nil
+}
+
 type RetrieveRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	ReqID                int64             `protobuf:"varint,2,opt,name=reqID,proto3" json:"reqID,omitempty"`
-	DbID                 int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionIDs         []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
-	SerializedExprPlan   []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	TimeoutTimestamp     uint64            `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
-	Limit                int64             `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base               *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ReqID              int64             `protobuf:"varint,2,opt,name=reqID,proto3" json:"reqID,omitempty"`
+	DbID               int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID       int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs       []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	SerializedExprPlan []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	TimeoutTimestamp   uint64            `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Limit              int64             `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	// the retrieved rows are aggregated if aggregates are set, grouped by group_by_field_id if it's set.
+	Aggregates     []*Aggregate `protobuf:"bytes,12,rep,name=aggregates,proto3" json:"aggregates,omitempty"`
+	GroupByFieldId int64        `protobuf:"varint,13,opt,name=group_by_field_id,json=groupByFieldId,proto3" json:"group_by_field_id,omitempty"`
+	// the retrieved rows are sorted by the fields in order, then by primary key.
+	OrderBy              []*OrderByField `protobuf:"bytes,14,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
 func (m *RetrieveRequest) String() string { return proto.CompactTextString(m) }
 func (*RetrieveRequest) ProtoMessage()    {}
 func (*RetrieveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{20}
+	return fileDescriptor_41f4a519b878ee3b, []int{21}
 }
 
 func (m *RetrieveRequest) XXX_Unmarshal(b []byte) error {
@@ -1710,6 +1848,122 @@ func (m *RetrieveRequest) GetLimit() int64 {
 	return 0
 }
 
+func (m *RetrieveRequest) GetAggregates() []*Aggregate {
+	if m != nil {
+		return m.Aggregates
+	}
+	return nil
+}
+
+func (m *RetrieveRequest) GetGroupByFieldId() int64 {
+	if m != nil {
+		return m.GroupByFieldId
+	}
+	return 0
+}
+
+func (m *RetrieveRequest) GetOrderBy() []*OrderByField {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
+}
+
+type OrderByField struct {
+	FieldId              int64    `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Ascending            bool     `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderByField) Reset()         { *m = OrderByField{} }
+func (m *OrderByField) String() string { return proto.CompactTextString(m) }
+func (*OrderByField) ProtoMessage()    {}
+func (*OrderByField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{22}
+}
+
+func (m *OrderByField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderByField.Unmarshal(m, b)
+}
+func (m *OrderByField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderByField.Marshal(b, m, deterministic)
+}
+func (m *OrderByField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderByField.Merge(m, src)
+}
+func (m *OrderByField) XXX_Size() int {
+	return xxx_messageInfo_OrderByField.Size(m)
+}
+func (m *OrderByField) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderByField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderByField proto.InternalMessageInfo
+
+func (m *OrderByField) GetFieldId() int64 {
+	if m != nil {
+		return m.FieldId
+	}
+	return 0
+}
+
+func (m *OrderByField) GetAscending() bool {
+	if m != nil {
+		return m.Ascending
+	}
+	return false
+}
+
+type Aggregate struct {
+	Type AggregateType `protobuf:"varint,1,opt,name=type,proto3,enum=milvus.proto.internal.AggregateType" json:"type,omitempty"`
+	// field_id is 0 for count(*)
+	FieldId              int64    `protobuf:"varint,2,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Aggregate) Reset()         { *m = Aggregate{} }
+func (m *Aggregate) String() string { return proto.CompactTextString(m) }
+func (*Aggregate) ProtoMessage()    {}
+func (*Aggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{23}
+}
+
+func (m *Aggregate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Aggregate.Unmarshal(m, b)
+}
+func (m *Aggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Aggregate.Marshal(b, m, deterministic)
+}
+func (m *Aggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Aggregate.Merge(m, src)
+}
+func (m *Aggregate) XXX_Size() int {
+	return xxx_messageInfo_Aggregate.Size(m)
+}
+func (m *Aggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Aggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Aggregate proto.InternalMessageInfo
+
+func (m *Aggregate) GetType() AggregateType {
+	if m != nil {
+		return m.Type
+	}
+	return AggregateType_Count
+}
+
+func (m *Aggregate) GetFieldId() int64 {
+	if m != nil {
+		return m.FieldId
+	}
+	return 0
+}
+
 type RetrieveResults struct {
 	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1719,16 +1973,18 @@ type RetrieveResults struct {
 	SealedSegmentIDsRetrieved []int64               `protobuf:"varint,6,rep,packed,name=sealed_segmentIDs_retrieved,json=sealedSegmentIDsRetrieved,proto3" json:"sealed_segmentIDs_retrieved,omitempty"`
 	ChannelIDsRetrieved       []string              `protobuf:"bytes,7,rep,name=channelIDs_retrieved,json=channelIDsRetrieved,proto3" json:"channelIDs_retrieved,omitempty"`
 	GlobalSealedSegmentIDs    []int64               `protobuf:"varint,8,rep,packed,name=global_sealed_segmentIDs,json=globalSealedSegmentIDs,proto3" json:"global_sealed_segmentIDs,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{}              `json:"-"`
-	XXX_unrecognized          []byte                `json:"-"`
-	XXX_sizecache             int32                 `json:"-"`
+	// the partial aggregates of the retrieved rows, which are merged by the proxy
+	AggregatesData       []*schemapb.FieldData `protobuf:"bytes,9,rep,name=aggregates_data,json=aggregatesData,proto3" json:"aggregates_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
 func (m *RetrieveResults) String() string { return proto.CompactTextString(m) }
 func (*RetrieveResults) ProtoMessage()    {}
 func (*RetrieveResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{21}
+	return fileDescriptor_41f4a519b878ee3b, []int{24}
 }
 
 func (m *RetrieveResults) XXX_Unmarshal(b []byte) error {
@@ -1805,6 +2061,13 @@ func (m *RetrieveResults) GetGlobalSealedSegmentIDs() []int64 {
 	return nil
 }
 
+func (m *RetrieveResults) GetAggregatesData() []*schemapb.FieldData {
+	if m != nil {
+		return m.AggregatesData
+	}
+	return nil
+}
+
 type DeleteRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ShardName            string            `protobuf:"bytes,2,opt,name=shardName,proto3" json:"shardName,omitempty"`
@@ -1827,7 +2090,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{22}
+	return fileDescriptor_41f4a519b878ee3b, []int{25}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1948,7 +2211,7 @@ func (m *LoadIndex) Reset()         { *m = LoadIndex{} }
 func (m *LoadIndex) String() string { return proto.CompactTextString(m) }
 func (*LoadIndex) ProtoMessage()    {}
 func (*LoadIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{23}
+	return fileDescriptor_41f4a519b878ee3b, []int{26}
 }
 
 func (m *LoadIndex) XXX_Unmarshal(b []byte) error {
@@ -2023,7 +2286,7 @@ func (m *IndexStats) Reset()         { *m = IndexStats{} }
 func (m *IndexStats) String() string { return proto.CompactTextString(m) }
 func (*IndexStats) ProtoMessage()    {}
 func (*IndexStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{24}
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
 }
 
 func (m *IndexStats) XXX_Unmarshal(b []byte) error {
@@ -2071,7 +2334,7 @@ func (m *FieldStats) Reset()         { *m = FieldStats{} }
 func (m *FieldStats) String() string { return proto.CompactTextString(m) }
 func (*FieldStats) ProtoMessage()    {}
 func (*FieldStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{25}
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
 }
 
 func (m *FieldStats) XXX_Unmarshal(b []byte) error {
@@ -2127,7 +2390,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{26}
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2190,7 +2453,7 @@ func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2253,7 +2516,7 @@ func (m *ChannelTimeTickMsg) Reset()         { *m = ChannelTimeTickMsg{} }
 func (m *ChannelTimeTickMsg) String() string { return proto.CompactTextString(m) }
 func (*ChannelTimeTickMsg) ProtoMessage()    {}
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{28}
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
 }
 
 func (m *ChannelTimeTickMsg) XXX_Unmarshal(b []byte) error {
@@ -2319,7 +2582,7 @@ func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
 func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
 func (*CredentialInfo) ProtoMessage()    {}
 func (*CredentialInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{29}
+	return fileDescriptor_41f4a519b878ee3b, []int{32}
 }
 
 func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
@@ -2387,7 +2650,7 @@ func (m *ListPolicyRequest) Reset()         { *m = ListPolicyRequest{} }
 func (m *ListPolicyRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPolicyRequest) ProtoMessage()    {}
 func (*ListPolicyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{30}
+	return fileDescriptor_41f4a519b878ee3b, []int{33}
 }
 
 func (m *ListPolicyRequest) XXX_Unmarshal(b []byte) error {
@@ -2429,7 +2692,7 @@ func (m *ListPolicyResponse) Reset()         { *m = ListPolicyResponse{} }
 func (m *ListPolicyResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPolicyResponse) ProtoMessage()    {}
 func (*ListPolicyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{31}
+	return fileDescriptor_41f4a519b878ee3b, []int{34}
 }
 
 func (m *ListPolicyResponse) XXX_Unmarshal(b []byte) error {
@@ -2483,7 +2746,7 @@ func (m *ShowConfigurationsRequest) Reset()         { *m = ShowConfigurationsReq
 func (m *ShowConfigurationsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowConfigurationsRequest) ProtoMessage()    {}
 func (*ShowConfigurationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{32}
+	return fileDescriptor_41f4a519b878ee3b, []int{35}
 }
 
 func (m *ShowConfigurationsRequest) XXX_Unmarshal(b []byte) error {
@@ -2530,7 +2793,7 @@ func (m *ShowConfigurationsResponse) Reset()         { *m = ShowConfigurationsRe
 func (m *ShowConfigurationsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowConfigurationsResponse) ProtoMessage()    {}
 func (*ShowConfigurationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{33}
+	return fileDescriptor_41f4a519b878ee3b, []int{36}
 }
 
 func (m *ShowConfigurationsResponse) XXX_Unmarshal(b []byte) error {
@@ -2577,7 +2840,7 @@ func (m *Rate) Reset()         { *m = Rate{} }
 func (m *Rate) String() string { return proto.CompactTextString(m) }
 func (*Rate) ProtoMessage()    {}
 func (*Rate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{34}
+	return fileDescriptor_41f4a519b878ee3b, []int{37}
 }
 
 func (m *Rate) XXX_Unmarshal(b []byte) error {
@@ -2614,6 +2877,7 @@ func (m *Rate) GetR() float64 {
 
 func init() {
 	proto.RegisterEnum("milvus.proto.internal.InsertDataVersion", InsertDataVersion_name, InsertDataVersion_value)
+	proto.RegisterEnum("milvus.proto.internal.AggregateType", AggregateType_name, AggregateType_value)
 	proto.RegisterEnum("milvus.proto.internal.RateType", RateType_name, RateType_value)
 	proto.RegisterType((*GetTimeTickChannelRequest)(nil), "milvus.proto.internal.GetTimeTickChannelRequest")
 	proto.RegisterType((*GetStatisticsChannelRequest)(nil), "milvus.proto.internal.GetStatisticsChannelRequest")
@@ -2633,9 +2897,12 @@ func init() {
 	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.internal.AlterAliasRequest")
 	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.internal.CreateIndexRequest")
 	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.internal.InsertRequest")
+	proto.RegisterType((*FieldValidData)(nil), "milvus.proto.internal.FieldValidData")
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.internal.SearchRequest")
 	proto.RegisterType((*SearchResults)(nil), "milvus.proto.internal.SearchResults")
 	proto.RegisterType((*RetrieveRequest)(nil), "milvus.proto.internal.RetrieveRequest")
+	proto.RegisterType((*OrderByField)(nil), "milvus.proto.internal.OrderByField")
+	proto.RegisterType((*Aggregate)(nil), "milvus.proto.internal.Aggregate")
 	proto.RegisterType((*RetrieveResults)(nil), "milvus.proto.internal.RetrieveResults")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.internal.DeleteRequest")
 	proto.RegisterType((*LoadIndex)(nil), "milvus.proto.internal.LoadIndex")
@@ -2655,143 +2922,160 @@ func init() {
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 2194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x5f, 0x8a, 0x92, 0x25, 0x3d, 0xc9, 0x0a, 0x3d, 0x71, 0xb2, 0x8c, 0x93, 0x6c, 0x1c, 0xf6,
-	0xcb, 0x4d, 0xba, 0x49, 0xea, 0xdd, 0x4d, 0x0a, 0xb4, 0xe8, 0x22, 0xb6, 0xb2, 0x81, 0x11, 0x3b,
-	0x75, 0xe8, 0x20, 0x40, 0x7b, 0x21, 0x46, 0xe2, 0x58, 0x9a, 0x86, 0xe4, 0x30, 0x33, 0x43, 0x3b,
-	0xca, 0xa9, 0x87, 0x9e, 0xba, 0x68, 0x6f, 0xbd, 0x14, 0x68, 0xcf, 0x45, 0x81, 0x02, 0xbd, 0xed,
-	0xb1, 0x40, 0x4f, 0x3d, 0xf5, 0xd4, 0xbf, 0xa6, 0xe8, 0xa1, 0x98, 0x19, 0x92, 0xfa, 0xb0, 0xe2,
-	0xd8, 0x0e, 0x76, 0x37, 0x05, 0xf6, 0xc6, 0x79, 0xef, 0xcd, 0xd7, 0xef, 0xfd, 0xde, 0xe3, 0x7b,
-	0x24, 0x74, 0x68, 0x22, 0x09, 0x4f, 0x70, 0x74, 0x2b, 0xe5, 0x4c, 0x32, 0x74, 0x21, 0xa6, 0xd1,
-	0x41, 0x26, 0xcc, 0xe8, 0x56, 0xa1, 0x5c, 0x69, 0xf7, 0x59, 0x1c, 0xb3, 0xc4, 0x88, 0x57, 0xda,
-	0xa2, 0x3f, 0x24, 0x31, 0x36, 0x23, 0xef, 0x32, 0x5c, 0x7a, 0x48, 0xe4, 0x53, 0x1a, 0x93, 0xa7,
-	0xb4, 0xff, 0x7c, 0x73, 0x88, 0x93, 0x84, 0x44, 0x3e, 0x79, 0x91, 0x11, 0x21, 0xbd, 0xab, 0x70,
-	0xf9, 0x21, 0x91, 0x7b, 0x12, 0x4b, 0x2a, 0x24, 0xed, 0x8b, 0x19, 0xf5, 0x05, 0x38, 0xff, 0x90,
-	0xc8, 0x6e, 0x38, 0x23, 0x7e, 0x06, 0x8d, 0xc7, 0x2c, 0x24, 0x5b, 0xc9, 0x3e, 0x43, 0x77, 0xa1,
-	0x8e, 0xc3, 0x90, 0x13, 0x21, 0x5c, 0x6b, 0xd5, 0x5a, 0x6b, 0xad, 0x5f, 0xb9, 0x35, 0x75, 0xc6,
-	0xfc, 0x64, 0xf7, 0x8d, 0x8d, 0x5f, 0x18, 0x23, 0x04, 0x55, 0xce, 0x22, 0xe2, 0x56, 0x56, 0xad,
-	0xb5, 0xa6, 0xaf, 0x9f, 0xbd, 0x5f, 0x02, 0x6c, 0x25, 0x54, 0xee, 0x62, 0x8e, 0x63, 0x81, 0x2e,
-	0xc2, 0x42, 0xa2, 0x76, 0xe9, 0xea, 0x85, 0x6d, 0x3f, 0x1f, 0xa1, 0x2e, 0xb4, 0x85, 0xc4, 0x5c,
-	0x06, 0xa9, 0xb6, 0x73, 0x2b, 0xab, 0xf6, 0x5a, 0x6b, 0xfd, 0xfa, 0xdc, 0x6d, 0x1f, 0x91, 0xd1,
-	0x33, 0x1c, 0x65, 0x64, 0x17, 0x53, 0xee, 0xb7, 0xf4, 0x34, 0xb3, 0xba, 0xf7, 0x73, 0x80, 0x3d,
-	0xc9, 0x69, 0x32, 0xd8, 0xa6, 0x42, 0xaa, 0xbd, 0x0e, 0x94, 0x9d, 0xba, 0x84, 0xbd, 0xd6, 0xf4,
-	0xf3, 0x11, 0xfa, 0x08, 0x16, 0x84, 0xc4, 0x32, 0x13, 0xfa, 0x9c, 0xad, 0xf5, 0xcb, 0x73, 0x77,
-	0xd9, 0xd3, 0x26, 0x7e, 0x6e, 0xea, 0x7d, 0x0a, 0xad, 0x02, 0xee, 0x1d, 0x31, 0x40, 0x77, 0xa0,
-	0xda, 0xc3, 0x82, 0x1c, 0x0b, 0xcf, 0x8e, 0x18, 0x6c, 0x60, 0x41, 0x7c, 0x6d, 0xe9, 0xfd, 0xb5,
-	0x02, 0xcb, 0x53, 0x6e, 0xc9, 0x81, 0x3f, 0xfd, 0x52, 0x0a, 0xe6, 0xb0, 0xb7, 0xd5, 0xd5, 0xc7,
-	0xb7, 0x7d, 0xfd, 0x8c, 0x3c, 0x68, 0xf7, 0x59, 0x14, 0x91, 0xbe, 0xa4, 0x2c, 0xd9, 0xea, 0xba,
-	0xb6, 0xd6, 0x4d, 0xc9, 0x94, 0x4d, 0x8a, 0xb9, 0xa4, 0x66, 0x28, 0xdc, 0xea, 0xaa, 0xad, 0x6c,
-	0x26, 0x65, 0xe8, 0xfb, 0xe0, 0x48, 0x8e, 0x0f, 0x48, 0x14, 0x48, 0x1a, 0x13, 0x21, 0x71, 0x9c,
-	0xba, 0xb5, 0x55, 0x6b, 0xad, 0xea, 0x9f, 0x33, 0xf2, 0xa7, 0x85, 0x18, 0xdd, 0x86, 0xf3, 0x83,
-	0x0c, 0x73, 0x9c, 0x48, 0x42, 0x26, 0xac, 0x17, 0xb4, 0x35, 0x2a, 0x55, 0xe3, 0x09, 0x37, 0x61,
-	0x49, 0x99, 0xb1, 0x4c, 0x4e, 0x98, 0xd7, 0xb5, 0xb9, 0x93, 0x2b, 0x4a, 0x63, 0xef, 0x0b, 0x0b,
-	0x2e, 0xcc, 0xe0, 0x25, 0x52, 0x96, 0x08, 0x72, 0x06, 0xc0, 0xce, 0xe2, 0x71, 0x74, 0x0f, 0x6a,
-	0xea, 0x49, 0xb8, 0xf6, 0x49, 0xb9, 0x68, 0xec, 0xbd, 0xdf, 0xd8, 0xf0, 0xfe, 0x26, 0x27, 0x58,
-	0x92, 0xcd, 0x12, 0xfd, 0xb3, 0x3b, 0xfb, 0x7d, 0xa8, 0x87, 0xbd, 0x20, 0xc1, 0x71, 0x11, 0x56,
-	0x0b, 0x61, 0xef, 0x31, 0x8e, 0x09, 0xfa, 0x2e, 0x74, 0xc6, 0xde, 0x55, 0x12, 0xed, 0xf3, 0xa6,
-	0x3f, 0x23, 0x45, 0xdf, 0x86, 0xc5, 0xd2, 0xc3, 0xda, 0xac, 0xaa, 0xcd, 0xa6, 0x85, 0x25, 0xa7,
-	0x6a, 0xc7, 0x70, 0x6a, 0x61, 0x0e, 0xa7, 0x56, 0xa1, 0x35, 0xc1, 0x1f, 0xed, 0x4d, 0xdb, 0x9f,
-	0x14, 0xa9, 0x30, 0x34, 0xb9, 0xcb, 0x6d, 0xac, 0x5a, 0x6b, 0x6d, 0x3f, 0x1f, 0xa1, 0x3b, 0x70,
-	0xfe, 0x80, 0x72, 0x99, 0xe1, 0x28, 0xcf, 0x44, 0xea, 0x1c, 0xc2, 0x6d, 0xea, 0x58, 0x9d, 0xa7,
-	0x42, 0xeb, 0xb0, 0x9c, 0x0e, 0x47, 0x82, 0xf6, 0x67, 0xa6, 0x80, 0x9e, 0x32, 0x57, 0xe7, 0xfd,
-	0xc3, 0x82, 0x0b, 0x5d, 0xce, 0xd2, 0x77, 0xc2, 0x15, 0x05, 0xc8, 0xd5, 0x63, 0x40, 0xae, 0x1d,
-	0x05, 0xd9, 0xfb, 0x6d, 0x05, 0x2e, 0x1a, 0x46, 0xed, 0x16, 0xc0, 0x7e, 0x09, 0xb7, 0xf8, 0x1e,
-	0x9c, 0x1b, 0xef, 0x6a, 0x0c, 0xe6, 0x5f, 0xe3, 0x3b, 0xd0, 0x29, 0x1d, 0x6c, 0xec, 0xbe, 0x5a,
-	0x4a, 0x79, 0x9f, 0x57, 0x60, 0x59, 0x39, 0xf5, 0x1b, 0x34, 0x14, 0x1a, 0x7f, 0xb2, 0x00, 0x19,
-	0x76, 0xdc, 0x8f, 0x28, 0x16, 0x5f, 0x27, 0x16, 0xcb, 0x50, 0xc3, 0xea, 0x0c, 0x39, 0x04, 0x66,
-	0xe0, 0x09, 0x70, 0x94, 0xb7, 0xbe, 0xac, 0xd3, 0x95, 0x9b, 0xda, 0x93, 0x9b, 0xfe, 0xd1, 0x82,
-	0xa5, 0xfb, 0x91, 0x24, 0xfc, 0x1d, 0x05, 0xe5, 0xef, 0x95, 0xc2, 0x6b, 0x5b, 0x49, 0x48, 0x5e,
-	0x7e, 0x9d, 0x07, 0xbc, 0x0a, 0xb0, 0x4f, 0x49, 0x14, 0x4e, 0xb2, 0xb7, 0xa9, 0x25, 0x6f, 0xc5,
-	0x5c, 0x17, 0xea, 0x7a, 0x91, 0x92, 0xb5, 0xc5, 0x50, 0x55, 0x7b, 0xe4, 0xa5, 0xe4, 0xb8, 0xa8,
-	0xf6, 0x1a, 0x27, 0xae, 0xf6, 0xf4, 0xb4, 0xbc, 0xda, 0xfb, 0x57, 0x15, 0x16, 0xb7, 0x12, 0x41,
-	0xb8, 0x3c, 0x3b, 0x78, 0x57, 0xa0, 0x29, 0x86, 0x98, 0xeb, 0x8b, 0xe6, 0xf0, 0x8d, 0x05, 0x93,
-	0xd0, 0xda, 0x6f, 0x82, 0xb6, 0x7a, 0xc2, 0xe4, 0x50, 0x3b, 0x2e, 0x39, 0x2c, 0x1c, 0x03, 0x71,
-	0xfd, 0xcd, 0xc9, 0xa1, 0x71, 0xf4, 0xed, 0xab, 0x2e, 0x48, 0x06, 0x31, 0x49, 0xe4, 0x56, 0xd7,
-	0x6d, 0x6a, 0xfd, 0x58, 0x80, 0x3e, 0x00, 0x28, 0x2b, 0x31, 0xf3, 0x1e, 0xad, 0xfa, 0x13, 0x12,
-	0xf5, 0xee, 0xe6, 0xec, 0x50, 0xd5, 0x8a, 0x2d, 0x5d, 0x2b, 0xe6, 0x23, 0xf4, 0x31, 0x34, 0x38,
-	0x3b, 0x0c, 0x42, 0x2c, 0xb1, 0xdb, 0xd6, 0xce, 0xbb, 0x34, 0x17, 0xec, 0x8d, 0x88, 0xf5, 0xfc,
-	0x3a, 0x67, 0x87, 0x5d, 0x2c, 0x31, 0xfa, 0x14, 0x5a, 0x9a, 0x01, 0xc2, 0x4c, 0x5c, 0xd4, 0x13,
-	0x3f, 0x98, 0x9e, 0x98, 0xb7, 0x39, 0x9f, 0x29, 0x3b, 0x35, 0xc9, 0x37, 0xd4, 0x14, 0x7a, 0x81,
-	0x4b, 0xd0, 0x48, 0xb2, 0x38, 0xe0, 0xec, 0x50, 0xb8, 0x1d, 0x5d, 0x37, 0xd6, 0x93, 0x2c, 0xf6,
-	0xd9, 0xa1, 0x40, 0x1b, 0x50, 0x3f, 0x20, 0x5c, 0x50, 0x96, 0xb8, 0xe7, 0x56, 0xad, 0xb5, 0xce,
-	0xfa, 0xda, 0xad, 0xb9, 0x6d, 0xd5, 0x2d, 0xc3, 0x18, 0xb5, 0xdc, 0x33, 0x63, 0xef, 0x17, 0x13,
-	0xbd, 0x7f, 0x57, 0x61, 0x71, 0x8f, 0x60, 0xde, 0x1f, 0x9e, 0x9d, 0x50, 0xcb, 0x50, 0xe3, 0xe4,
-	0x45, 0x59, 0x9c, 0x9b, 0x41, 0xe9, 0x5f, 0xfb, 0x18, 0xff, 0x56, 0x4f, 0x50, 0xb1, 0xd7, 0xe6,
-	0x54, 0xec, 0x0e, 0xd8, 0xa1, 0x88, 0x34, 0x75, 0x9a, 0xbe, 0x7a, 0x54, 0x75, 0x76, 0x1a, 0xe1,
-	0x3e, 0x19, 0xb2, 0x28, 0x24, 0x3c, 0x18, 0x70, 0x96, 0x99, 0x3a, 0xbb, 0xed, 0x3b, 0x13, 0x8a,
-	0x87, 0x4a, 0x8e, 0xee, 0x41, 0x23, 0x14, 0x51, 0x20, 0x47, 0x29, 0xd1, 0xfc, 0xe9, 0xbc, 0xe6,
-	0x9a, 0x5d, 0x11, 0x3d, 0x1d, 0xa5, 0xc4, 0xaf, 0x87, 0xe6, 0x01, 0xdd, 0x81, 0x65, 0x41, 0x38,
-	0xc5, 0x11, 0x7d, 0x45, 0xc2, 0x80, 0xbc, 0x4c, 0x79, 0x90, 0x46, 0x38, 0xd1, 0x24, 0x6b, 0xfb,
-	0x68, 0xac, 0x7b, 0xf0, 0x32, 0xe5, 0xbb, 0x11, 0x4e, 0xd0, 0x1a, 0x38, 0x2c, 0x93, 0x69, 0x26,
-	0x83, 0x9c, 0x06, 0x34, 0xd4, 0x9c, 0xb3, 0xfd, 0x8e, 0x91, 0x6b, 0xaf, 0x8b, 0xad, 0x70, 0x6e,
-	0x17, 0xd2, 0x3a, 0x55, 0x17, 0xd2, 0x3e, 0x5d, 0x17, 0xb2, 0x38, 0xbf, 0x0b, 0x41, 0x1d, 0xa8,
-	0x24, 0x2f, 0x34, 0xd7, 0x6c, 0xbf, 0x92, 0xbc, 0x50, 0x8e, 0x94, 0x2c, 0x7d, 0xae, 0x39, 0x66,
-	0xfb, 0xfa, 0x59, 0x05, 0x51, 0x4c, 0x24, 0xa7, 0x7d, 0x05, 0x8b, 0xeb, 0x68, 0x3f, 0x4c, 0x48,
-	0xbc, 0xff, 0xda, 0x63, 0x5a, 0x89, 0x2c, 0x92, 0xe2, 0xab, 0xea, 0x60, 0x4a, 0x2e, 0xda, 0x93,
-	0x5c, 0xbc, 0x06, 0x2d, 0x73, 0x38, 0xe3, 0xf3, 0xea, 0xec, 0x79, 0x95, 0x81, 0x8a, 0xb2, 0x17,
-	0x19, 0xe1, 0x94, 0x88, 0x3c, 0xed, 0x43, 0x92, 0xc5, 0x4f, 0x8c, 0x04, 0x9d, 0x87, 0x9a, 0x64,
-	0x69, 0xf0, 0xbc, 0x48, 0x57, 0x92, 0xa5, 0x8f, 0xd0, 0x4f, 0x60, 0x45, 0x10, 0x1c, 0x91, 0x30,
-	0x28, 0xd3, 0x8b, 0x08, 0x84, 0xbe, 0x36, 0x09, 0xdd, 0xba, 0x76, 0xb3, 0x6b, 0x2c, 0xf6, 0x4a,
-	0x83, 0xbd, 0x5c, 0xaf, 0xbc, 0xd8, 0x37, 0x65, 0xfb, 0xd4, 0xb4, 0x86, 0xae, 0xec, 0xd1, 0x58,
-	0x55, 0x4e, 0xf8, 0x11, 0xb8, 0x83, 0x88, 0xf5, 0x70, 0x14, 0x1c, 0xd9, 0x55, 0xb7, 0x10, 0xb6,
-	0x7f, 0xd1, 0xe8, 0xf7, 0x66, 0xb6, 0x54, 0xd7, 0x13, 0x11, 0xed, 0x93, 0x30, 0xe8, 0x45, 0xac,
-	0xe7, 0x82, 0xa6, 0x2b, 0x18, 0x91, 0xca, 0x57, 0x8a, 0xa6, 0xb9, 0x81, 0x82, 0xa1, 0xcf, 0xb2,
-	0x44, 0x6a, 0xf2, 0xd9, 0x7e, 0xc7, 0xc8, 0x1f, 0x67, 0xf1, 0xa6, 0x92, 0xa2, 0x6f, 0xc1, 0x62,
-	0x6e, 0xc9, 0xf6, 0xf7, 0x05, 0x91, 0x9a, 0x75, 0xb6, 0xdf, 0x36, 0xc2, 0x9f, 0x69, 0x99, 0xf7,
-	0x37, 0x1b, 0xce, 0xf9, 0x0a, 0x5d, 0x72, 0x40, 0xfe, 0x9f, 0xf2, 0xca, 0xeb, 0xe2, 0x7b, 0xe1,
-	0x54, 0xf1, 0x5d, 0x3f, 0x71, 0x7c, 0x37, 0x4e, 0x15, 0xdf, 0xcd, 0xd3, 0xc5, 0x37, 0xbc, 0x26,
-	0xbe, 0x97, 0xa1, 0x16, 0xd1, 0x98, 0x16, 0x0e, 0x36, 0x03, 0xef, 0xcf, 0x53, 0x2e, 0x7b, 0x07,
-	0x62, 0xf6, 0x06, 0xd8, 0x34, 0x34, 0x05, 0x64, 0x6b, 0xdd, 0x9d, 0xfb, 0xc6, 0xdc, 0xea, 0x0a,
-	0x5f, 0x19, 0xcd, 0xbe, 0x65, 0x6b, 0xa7, 0x7e, 0xcb, 0xfe, 0x14, 0x2e, 0x1f, 0x8d, 0x64, 0x9e,
-	0xc3, 0x11, 0xba, 0x0b, 0xda, 0xa3, 0x97, 0x66, 0x43, 0xb9, 0xc0, 0x2b, 0x44, 0x3f, 0x84, 0xe5,
-	0x89, 0x58, 0x1e, 0x4f, 0xac, 0x9b, 0xce, 0x7e, 0xac, 0x1b, 0x4f, 0x39, 0x2e, 0x9a, 0x1b, 0xc7,
-	0x45, 0xb3, 0xf7, 0x4f, 0x1b, 0x16, 0xbb, 0x24, 0x22, 0x92, 0x7c, 0x53, 0x04, 0xbe, 0xb6, 0x08,
-	0xfc, 0x01, 0x20, 0x9a, 0xc8, 0xbb, 0x1f, 0x07, 0x29, 0xa7, 0x31, 0xe6, 0xa3, 0xe0, 0x39, 0x19,
-	0x15, 0x69, 0xd2, 0xd1, 0x9a, 0x5d, 0xa3, 0x78, 0x44, 0x46, 0xe2, 0x8d, 0x45, 0xe1, 0x64, 0x15,
-	0x66, 0xc2, 0xa6, 0xac, 0xc2, 0x7e, 0x0c, 0xed, 0xa9, 0x2d, 0xda, 0x6f, 0x20, 0x6c, 0x2b, 0x1d,
-	0xef, 0xeb, 0xfd, 0xc7, 0x82, 0xe6, 0x36, 0xc3, 0xa1, 0xee, 0x87, 0xce, 0xe8, 0xc6, 0xb2, 0xd4,
-	0xad, 0xcc, 0x96, 0xba, 0x57, 0x60, 0xdc, 0xd2, 0xe4, 0x8e, 0x9c, 0xe8, 0x71, 0x26, 0x7a, 0x95,
-	0xea, 0x74, 0xaf, 0x72, 0x0d, 0x5a, 0x54, 0x1d, 0x28, 0x48, 0xb1, 0x1c, 0x9a, 0x4c, 0xd9, 0xf4,
-	0x41, 0x8b, 0x76, 0x95, 0x44, 0x35, 0x33, 0x85, 0x81, 0x6e, 0x66, 0x16, 0x4e, 0xdc, 0xcc, 0xe4,
-	0x8b, 0xe8, 0x66, 0xe6, 0xd7, 0x16, 0x80, 0xbe, 0xb8, 0xca, 0x07, 0x47, 0x17, 0xb5, 0xce, 0xb2,
-	0xa8, 0x4a, 0xe1, 0xda, 0x53, 0x24, 0xc2, 0x72, 0x1c, 0x54, 0x22, 0x07, 0x07, 0x29, 0xaf, 0x19,
-	0x55, 0x1e, 0x50, 0xc2, 0xfb, 0x9d, 0x05, 0xa0, 0xb3, 0x82, 0x39, 0xc6, 0x2c, 0xfd, 0xac, 0xe3,
-	0xdb, 0xbc, 0xca, 0x34, 0x74, 0x1b, 0x05, 0x74, 0xc7, 0x7c, 0x47, 0x9d, 0xa8, 0xcb, 0x8b, 0xcb,
-	0xe7, 0xe8, 0xea, 0x67, 0xef, 0xf7, 0x16, 0xb4, 0xf3, 0xd3, 0x99, 0x23, 0x4d, 0x79, 0xd9, 0x9a,
-	0xf5, 0xb2, 0x2e, 0x6e, 0x62, 0xc6, 0x47, 0x81, 0xa0, 0xaf, 0x48, 0x7e, 0x20, 0x30, 0xa2, 0x3d,
-	0xfa, 0x8a, 0x4c, 0x91, 0xd7, 0x9e, 0x26, 0xef, 0x4d, 0x58, 0xe2, 0xa4, 0x4f, 0x12, 0x19, 0x8d,
-	0x82, 0x98, 0x85, 0x74, 0x9f, 0x92, 0x50, 0xb3, 0xa1, 0xe1, 0x3b, 0x85, 0x62, 0x27, 0x97, 0x7b,
-	0xbf, 0xb2, 0xa0, 0xb5, 0x23, 0x06, 0xbb, 0x4c, 0xe8, 0x20, 0x43, 0xd7, 0xa1, 0x9d, 0x27, 0x36,
-	0x13, 0xe1, 0x96, 0x66, 0x58, 0xab, 0x3f, 0xfe, 0x16, 0xa9, 0x52, 0x7b, 0x2c, 0x06, 0x39, 0x4c,
-	0x6d, 0xdf, 0x0c, 0xd0, 0x0a, 0x34, 0x62, 0x31, 0xd0, 0xb5, 0x78, 0x4e, 0xcb, 0x72, 0xac, 0xee,
-	0x3a, 0x7e, 0x85, 0x55, 0xf5, 0x2b, 0x6c, 0x2c, 0xf0, 0xbe, 0xb0, 0x00, 0xe5, 0xdf, 0x3a, 0xdf,
-	0xea, 0xd7, 0x84, 0xf6, 0xf2, 0xe4, 0xf7, 0xd4, 0x8a, 0xe6, 0xf8, 0x94, 0x6c, 0x26, 0x29, 0xd8,
-	0x47, 0x92, 0xc2, 0x4d, 0x58, 0x0a, 0xc9, 0x3e, 0xce, 0xa2, 0xc9, 0xb7, 0xae, 0x39, 0xb2, 0x93,
-	0x2b, 0xa6, 0xbe, 0xed, 0x77, 0x36, 0x39, 0x09, 0x49, 0x22, 0x29, 0x8e, 0xf4, 0x2f, 0xa7, 0x15,
-	0x68, 0x64, 0x42, 0x31, 0xa1, 0xc4, 0xae, 0x1c, 0xa3, 0x0f, 0x01, 0x91, 0xa4, 0xcf, 0x47, 0xa9,
-	0x22, 0x71, 0x8a, 0x85, 0x38, 0x64, 0x3c, 0xcc, 0x13, 0xf5, 0x52, 0xa9, 0xd9, 0xcd, 0x15, 0xaa,
-	0x69, 0x95, 0x24, 0xc1, 0x89, 0x2c, 0xf2, 0xb5, 0x19, 0x29, 0xd7, 0x53, 0x11, 0x88, 0x2c, 0x25,
-	0x3c, 0x77, 0x6b, 0x9d, 0x8a, 0x3d, 0x35, 0x54, 0xa9, 0x5c, 0x0c, 0xf1, 0xfa, 0x27, 0x77, 0xc7,
-	0xcb, 0x9b, 0x14, 0xdd, 0x31, 0xe2, 0x62, 0x6d, 0xef, 0x01, 0x2c, 0x6d, 0x53, 0x21, 0x77, 0x59,
-	0x44, 0xfb, 0xa3, 0x33, 0xbf, 0x71, 0xbc, 0xcf, 0x2d, 0x40, 0x93, 0xeb, 0xe4, 0x7f, 0x36, 0xc6,
-	0x15, 0x83, 0x75, 0xf2, 0x8a, 0xe1, 0x3a, 0xb4, 0x53, 0xbd, 0x4c, 0x40, 0x93, 0x7d, 0x56, 0x78,
-	0xaf, 0x65, 0x64, 0x0a, 0x5b, 0x81, 0xae, 0x02, 0x28, 0x30, 0x03, 0xce, 0x22, 0x62, 0x9c, 0xd7,
-	0xf4, 0x9b, 0x4a, 0xe2, 0x2b, 0x81, 0x37, 0x80, 0x4b, 0x7b, 0x43, 0x76, 0xb8, 0xc9, 0x92, 0x7d,
-	0x3a, 0xc8, 0x38, 0x56, 0x84, 0x7e, 0x8b, 0x2f, 0x66, 0x2e, 0xd4, 0x53, 0x2c, 0x55, 0x58, 0xe7,
-	0x3e, 0x2a, 0x86, 0xde, 0x1f, 0x2c, 0x58, 0x99, 0xb7, 0xd3, 0xdb, 0x5c, 0xff, 0x21, 0x2c, 0xf6,
-	0xcd, 0x72, 0x66, 0xb5, 0x93, 0xff, 0x3a, 0x9c, 0x9e, 0xe7, 0x3d, 0x80, 0xaa, 0x8f, 0x25, 0x41,
-	0xb7, 0xa1, 0xc2, 0xa5, 0x3e, 0x41, 0x67, 0xfd, 0xda, 0x6b, 0x92, 0x95, 0x32, 0xd4, 0xdd, 0x70,
-	0x85, 0x4b, 0xd4, 0x06, 0x8b, 0xeb, 0x9b, 0x5a, 0xbe, 0xc5, 0x6f, 0xac, 0xc3, 0xd2, 0x91, 0x4f,
-	0x0c, 0xa8, 0x0d, 0x0d, 0x9f, 0x1d, 0x2a, 0x8c, 0x42, 0xe7, 0x3d, 0x74, 0x0e, 0x5a, 0x9b, 0x2c,
-	0xca, 0xe2, 0xc4, 0x08, 0xac, 0x1b, 0x7f, 0xb1, 0xa0, 0x51, 0x2c, 0x89, 0x96, 0x60, 0xb1, 0xdb,
-	0xdd, 0x1e, 0xff, 0xaf, 0x70, 0xde, 0x43, 0x0e, 0xb4, 0xbb, 0xdd, 0xed, 0xf2, 0x6b, 0xb7, 0x63,
-	0xa9, 0x05, 0xbb, 0xdd, 0x6d, 0x9d, 0x33, 0x9d, 0x4a, 0x3e, 0xfa, 0x2c, 0xca, 0xc4, 0xd0, 0xb1,
-	0xcb, 0x05, 0xe2, 0x14, 0x9b, 0x05, 0xaa, 0x68, 0x11, 0x9a, 0xdd, 0x9d, 0x6d, 0x73, 0x2e, 0xa7,
-	0x96, 0x0f, 0x4d, 0xd9, 0xe4, 0x2c, 0xa8, 0xf3, 0x74, 0x77, 0xb6, 0x37, 0xb2, 0xe8, 0xb9, 0x7a,
-	0xfd, 0x3a, 0x75, 0xad, 0x7f, 0xb2, 0x6d, 0x7a, 0x2d, 0xa7, 0xa1, 0x97, 0x7f, 0xb2, 0xad, 0xba,
-	0xbf, 0x91, 0xd3, 0xdc, 0xb8, 0xf7, 0x8b, 0x4f, 0x06, 0x54, 0x0e, 0xb3, 0x9e, 0x02, 0xf5, 0xb6,
-	0xc1, 0xe7, 0x43, 0xca, 0xf2, 0xa7, 0xdb, 0x05, 0x46, 0xb7, 0x35, 0x64, 0xe5, 0x30, 0xed, 0xf5,
-	0x16, 0xb4, 0xe4, 0xa3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xad, 0xe9, 0xa6, 0xf9, 0x00, 0x1f,
-	0x00, 0x00,
+	// 2472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4b, 0x6f, 0x1c, 0xc7,
+	0x11, 0xf6, 0xec, 0xec, 0x72, 0x77, 0x6b, 0x1f, 0x1c, 0xb6, 0x28, 0x7b, 0x44, 0xc9, 0x16, 0x3d,
+	0xb6, 0x13, 0x5a, 0x8e, 0x25, 0x85, 0xb6, 0x25, 0x03, 0x09, 0xe4, 0x90, 0x5c, 0x89, 0x20, 0x44,
+	0xca, 0xd4, 0x90, 0x10, 0x90, 0x5c, 0x26, 0xbd, 0x3b, 0xcd, 0xe5, 0x84, 0xf3, 0x52, 0x77, 0x0f,
+	0xc9, 0xd5, 0x29, 0x87, 0xdc, 0x8c, 0xe4, 0x96, 0x4b, 0x80, 0xf8, 0x1a, 0x20, 0x40, 0x72, 0xcd,
+	0x21, 0x87, 0x00, 0x39, 0xe5, 0x4f, 0xe4, 0x47, 0xe4, 0x9a, 0x53, 0xd0, 0xdd, 0xf3, 0xda, 0xe5,
+	0x92, 0x22, 0x29, 0xd8, 0x56, 0x00, 0xdf, 0xa6, 0x1e, 0xfd, 0xa8, 0xaa, 0xaf, 0xaa, 0xbb, 0xa6,
+	0xa1, 0xeb, 0x85, 0x9c, 0xd0, 0x10, 0xfb, 0xb7, 0x63, 0x1a, 0xf1, 0x08, 0x5d, 0x0d, 0x3c, 0xff,
+	0x30, 0x61, 0x8a, 0xba, 0x9d, 0x09, 0x17, 0xda, 0x83, 0x28, 0x08, 0xa2, 0x50, 0xb1, 0x17, 0xda,
+	0x6c, 0xb0, 0x4f, 0x02, 0xac, 0x28, 0xeb, 0x3a, 0x5c, 0x5b, 0x27, 0x7c, 0xd7, 0x0b, 0xc8, 0xae,
+	0x37, 0x38, 0x58, 0xdb, 0xc7, 0x61, 0x48, 0x7c, 0x9b, 0x3c, 0x4f, 0x08, 0xe3, 0xd6, 0xdb, 0x70,
+	0x7d, 0x9d, 0xf0, 0x1d, 0x8e, 0xb9, 0xc7, 0xb8, 0x37, 0x60, 0x13, 0xe2, 0xab, 0x70, 0x65, 0x9d,
+	0xf0, 0x9e, 0x3b, 0xc1, 0x7e, 0x06, 0x8d, 0x27, 0x91, 0x4b, 0x36, 0xc2, 0xbd, 0x08, 0xdd, 0x83,
+	0x3a, 0x76, 0x5d, 0x4a, 0x18, 0x33, 0xb5, 0x45, 0x6d, 0xa9, 0xb5, 0x7c, 0xe3, 0xf6, 0xd8, 0x1e,
+	0xd3, 0x9d, 0xad, 0x28, 0x1d, 0x3b, 0x53, 0x46, 0x08, 0xaa, 0x34, 0xf2, 0x89, 0x59, 0x59, 0xd4,
+	0x96, 0x9a, 0xb6, 0xfc, 0xb6, 0x7e, 0x05, 0xb0, 0x11, 0x7a, 0x7c, 0x1b, 0x53, 0x1c, 0x30, 0xf4,
+	0x26, 0xcc, 0x84, 0x62, 0x95, 0x9e, 0x9c, 0x58, 0xb7, 0x53, 0x0a, 0xf5, 0xa0, 0xcd, 0x38, 0xa6,
+	0xdc, 0x89, 0xa5, 0x9e, 0x59, 0x59, 0xd4, 0x97, 0x5a, 0xcb, 0xef, 0x4e, 0x5d, 0xf6, 0x31, 0x19,
+	0x3d, 0xc3, 0x7e, 0x42, 0xb6, 0xb1, 0x47, 0xed, 0x96, 0x1c, 0xa6, 0x66, 0xb7, 0x7e, 0x0e, 0xb0,
+	0xc3, 0xa9, 0x17, 0x0e, 0x37, 0x3d, 0xc6, 0xc5, 0x5a, 0x87, 0x42, 0x4f, 0x18, 0xa1, 0x2f, 0x35,
+	0xed, 0x94, 0x42, 0x9f, 0xc0, 0x0c, 0xe3, 0x98, 0x27, 0x4c, 0xee, 0xb3, 0xb5, 0x7c, 0x7d, 0xea,
+	0x2a, 0x3b, 0x52, 0xc5, 0x4e, 0x55, 0xad, 0x2f, 0xa0, 0x95, 0xb9, 0x7b, 0x8b, 0x0d, 0xd1, 0x5d,
+	0xa8, 0xf6, 0x31, 0x23, 0x67, 0xba, 0x67, 0x8b, 0x0d, 0x57, 0x31, 0x23, 0xb6, 0xd4, 0xb4, 0xfe,
+	0x52, 0x81, 0xf9, 0xb1, 0xb0, 0xa4, 0x8e, 0xbf, 0xf8, 0x54, 0xc2, 0xcd, 0x6e, 0x7f, 0xa3, 0x27,
+	0xb7, 0xaf, 0xdb, 0xf2, 0x1b, 0x59, 0xd0, 0x1e, 0x44, 0xbe, 0x4f, 0x06, 0xdc, 0x8b, 0xc2, 0x8d,
+	0x9e, 0xa9, 0x4b, 0xd9, 0x18, 0x4f, 0xe8, 0xc4, 0x98, 0x72, 0x4f, 0x91, 0xcc, 0xac, 0x2e, 0xea,
+	0x42, 0xa7, 0xcc, 0x43, 0x1f, 0x82, 0xc1, 0x29, 0x3e, 0x24, 0xbe, 0xc3, 0xbd, 0x80, 0x30, 0x8e,
+	0x83, 0xd8, 0xac, 0x2d, 0x6a, 0x4b, 0x55, 0x7b, 0x56, 0xf1, 0x77, 0x33, 0x36, 0xba, 0x03, 0x57,
+	0x86, 0x09, 0xa6, 0x38, 0xe4, 0x84, 0x94, 0xb4, 0x67, 0xa4, 0x36, 0xca, 0x45, 0xc5, 0x80, 0x8f,
+	0x60, 0x4e, 0xa8, 0x45, 0x09, 0x2f, 0xa9, 0xd7, 0xa5, 0xba, 0x91, 0x0a, 0x72, 0x65, 0xeb, 0x6f,
+	0x1a, 0x5c, 0x9d, 0xf0, 0x17, 0x8b, 0xa3, 0x90, 0x91, 0x4b, 0x38, 0xec, 0x32, 0x11, 0x47, 0xf7,
+	0xa1, 0x26, 0xbe, 0x98, 0xa9, 0x9f, 0x17, 0x8b, 0x4a, 0xdf, 0xfa, 0xab, 0x0e, 0x6f, 0xad, 0x51,
+	0x82, 0x39, 0x59, 0xcb, 0xbd, 0x7f, 0xf9, 0x60, 0xbf, 0x05, 0x75, 0xb7, 0xef, 0x84, 0x38, 0xc8,
+	0xd2, 0x6a, 0xc6, 0xed, 0x3f, 0xc1, 0x01, 0x41, 0x3f, 0x80, 0x6e, 0x11, 0x5d, 0xc1, 0x91, 0x31,
+	0x6f, 0xda, 0x13, 0x5c, 0xf4, 0x3e, 0x74, 0xf2, 0x08, 0x4b, 0xb5, 0xaa, 0x54, 0x1b, 0x67, 0xe6,
+	0x98, 0xaa, 0x9d, 0x81, 0xa9, 0x99, 0x29, 0x98, 0x5a, 0x84, 0x56, 0x09, 0x3f, 0x32, 0x9a, 0xba,
+	0x5d, 0x66, 0x89, 0x34, 0x54, 0xb5, 0xcb, 0x6c, 0x2c, 0x6a, 0x4b, 0x6d, 0x3b, 0xa5, 0xd0, 0x5d,
+	0xb8, 0x72, 0xe8, 0x51, 0x9e, 0x60, 0x3f, 0xad, 0x44, 0x62, 0x1f, 0xcc, 0x6c, 0xca, 0x5c, 0x9d,
+	0x26, 0x42, 0xcb, 0x30, 0x1f, 0xef, 0x8f, 0x98, 0x37, 0x98, 0x18, 0x02, 0x72, 0xc8, 0x54, 0xd9,
+	0x09, 0xcc, 0xb7, 0x4e, 0x62, 0xde, 0xfa, 0xa7, 0x06, 0x57, 0x7b, 0x34, 0x8a, 0x5f, 0x8b, 0x70,
+	0x65, 0x81, 0xa8, 0x9e, 0x11, 0x88, 0xda, 0xc9, 0x40, 0x58, 0xbf, 0xad, 0xc0, 0x9b, 0x0a, 0x75,
+	0xdb, 0x99, 0x6d, 0xdf, 0x80, 0x15, 0x3f, 0x84, 0xd9, 0x62, 0x55, 0x27, 0x3c, 0xdd, 0x8c, 0x0f,
+	0xa0, 0x9b, 0xfb, 0x58, 0xe9, 0x7d, 0xbb, 0xb0, 0xb3, 0xbe, 0xaa, 0xc0, 0xbc, 0x08, 0xea, 0xf7,
+	0xde, 0x10, 0xde, 0xf8, 0x5a, 0x03, 0xa4, 0xd0, 0xb1, 0xe2, 0x7b, 0x98, 0x7d, 0x97, 0xbe, 0x98,
+	0x87, 0x1a, 0x16, 0x7b, 0x48, 0x5d, 0xa0, 0x08, 0x8b, 0x81, 0x21, 0xa2, 0xf5, 0x4d, 0xed, 0x2e,
+	0x5f, 0x54, 0x2f, 0x2f, 0xfa, 0x47, 0x0d, 0xe6, 0x56, 0x7c, 0x4e, 0xe8, 0x6b, 0xea, 0x94, 0x7f,
+	0x54, 0xb2, 0xa8, 0x6d, 0x84, 0x2e, 0x39, 0xfe, 0x2e, 0x37, 0xf8, 0x36, 0xc0, 0x9e, 0x47, 0x7c,
+	0xb7, 0x8c, 0xde, 0xa6, 0xe4, 0xbc, 0x12, 0x72, 0x4d, 0xa8, 0xcb, 0x49, 0x72, 0xd4, 0x66, 0xa4,
+	0xb8, 0x11, 0x92, 0x63, 0x4e, 0x71, 0x76, 0x23, 0x6c, 0x9c, 0xfb, 0x46, 0x28, 0x87, 0xa5, 0x37,
+	0xc2, 0xbf, 0xd7, 0xa0, 0xb3, 0x11, 0x32, 0x42, 0xf9, 0xe5, 0x9d, 0x77, 0x03, 0x9a, 0x6c, 0x1f,
+	0x53, 0xf7, 0x49, 0xe1, 0xbe, 0x82, 0x51, 0x76, 0xad, 0xfe, 0x32, 0xd7, 0x56, 0xcf, 0x59, 0x1c,
+	0x6a, 0x67, 0x15, 0x87, 0x99, 0x33, 0x5c, 0x5c, 0x7f, 0x79, 0x71, 0x68, 0x9c, 0x3c, 0xa1, 0x85,
+	0x81, 0x64, 0x18, 0x90, 0x90, 0x6f, 0xf4, 0xcc, 0xa6, 0x94, 0x17, 0x0c, 0xf4, 0x0e, 0x40, 0x7e,
+	0x5b, 0x53, 0x67, 0x6d, 0xd5, 0x2e, 0x71, 0xc4, 0xf9, 0x4e, 0xa3, 0xa3, 0xe2, 0x6c, 0x4d, 0x29,
+	0xf4, 0x29, 0x34, 0x68, 0x74, 0xe4, 0xb8, 0x98, 0x63, 0xb3, 0x2d, 0x83, 0x77, 0x6d, 0xaa, 0xb3,
+	0x57, 0xfd, 0xa8, 0x6f, 0xd7, 0x69, 0x74, 0xd4, 0xc3, 0x1c, 0xa3, 0x2f, 0xa0, 0x25, 0x11, 0xc0,
+	0xd4, 0xc0, 0x8e, 0x1c, 0xf8, 0xce, 0xf8, 0xc0, 0xb4, 0x15, 0x7a, 0x24, 0xf4, 0xc4, 0x20, 0x5b,
+	0x41, 0x93, 0xc9, 0x09, 0xae, 0x41, 0x23, 0x4c, 0x02, 0x87, 0x46, 0x47, 0xcc, 0xec, 0xca, 0xbb,
+	0x65, 0x3d, 0x4c, 0x02, 0x3b, 0x3a, 0x62, 0x68, 0x15, 0xea, 0x87, 0x84, 0x32, 0x2f, 0x0a, 0xcd,
+	0xd9, 0x45, 0x6d, 0xa9, 0xbb, 0xbc, 0x74, 0x7b, 0x6a, 0xeb, 0x75, 0x5b, 0x21, 0x46, 0x4c, 0xf7,
+	0x4c, 0xe9, 0xdb, 0xd9, 0x40, 0x11, 0x2c, 0xb5, 0xbc, 0x93, 0x4d, 0x65, 0x2c, 0x6a, 0x4b, 0x35,
+	0xbb, 0xa3, 0xb8, 0xa9, 0x3e, 0xea, 0x01, 0x1c, 0x62, 0xdf, 0x73, 0x95, 0x15, 0x73, 0xd2, 0x8a,
+	0x0f, 0x4e, 0x59, 0x4d, 0xda, 0xf1, 0x4c, 0x68, 0x4b, 0x63, 0x9a, 0x87, 0xd9, 0xa7, 0xb5, 0x02,
+	0xdd, 0x71, 0xa1, 0xb0, 0x4e, 0xa5, 0xa1, 0xe7, 0x9a, 0x5a, 0x39, 0x61, 0x5c, 0x51, 0x42, 0xe4,
+	0x48, 0xd9, 0x3b, 0x35, 0x6c, 0x45, 0x58, 0x5f, 0xd7, 0xa0, 0xb3, 0x43, 0x30, 0x1d, 0xec, 0x5f,
+	0x3e, 0x01, 0xe6, 0xa1, 0x46, 0xc9, 0xf3, 0xbc, 0xe1, 0x50, 0x44, 0x8e, 0x47, 0xfd, 0x0c, 0x3c,
+	0x56, 0xcf, 0xd1, 0x85, 0xd4, 0xa6, 0x74, 0x21, 0x06, 0xe8, 0x2e, 0xf3, 0x25, 0xd4, 0x9b, 0xb6,
+	0xf8, 0x14, 0xbd, 0x43, 0xec, 0xe3, 0x01, 0xd9, 0x8f, 0x7c, 0x97, 0x50, 0x67, 0x48, 0xa3, 0x44,
+	0xf5, 0x0e, 0x6d, 0xdb, 0x28, 0x09, 0xd6, 0x05, 0x1f, 0xdd, 0x87, 0x86, 0xcb, 0x7c, 0x87, 0x8f,
+	0x62, 0x22, 0xf1, 0xde, 0x3d, 0xc5, 0xcc, 0x1e, 0xf3, 0x77, 0x47, 0x31, 0xb1, 0xeb, 0xae, 0xfa,
+	0x40, 0x77, 0x61, 0x9e, 0x11, 0xea, 0x61, 0xdf, 0x7b, 0x41, 0x5c, 0x87, 0x1c, 0xc7, 0xd4, 0x89,
+	0x7d, 0x1c, 0xca, 0xa4, 0x68, 0xdb, 0xa8, 0x90, 0x3d, 0x3c, 0x8e, 0xe9, 0xb6, 0x8f, 0x43, 0xb4,
+	0x04, 0x46, 0x94, 0xf0, 0x38, 0xe1, 0x4e, 0x0a, 0x5b, 0xcf, 0x95, 0x39, 0xa2, 0xdb, 0x5d, 0xc5,
+	0x97, 0x01, 0x64, 0x1b, 0xee, 0xd4, 0xce, 0xaa, 0x75, 0xa1, 0xce, 0xaa, 0x7d, 0xb1, 0xce, 0xaa,
+	0x33, 0xbd, 0xb3, 0x42, 0x5d, 0xa8, 0x84, 0xcf, 0x65, 0x6e, 0xe8, 0x76, 0x25, 0x7c, 0x2e, 0x02,
+	0xc9, 0xa3, 0xf8, 0x40, 0xe6, 0x84, 0x6e, 0xcb, 0x6f, 0x91, 0xf4, 0x01, 0xe1, 0xd4, 0x1b, 0x08,
+	0xb7, 0x48, 0x88, 0x37, 0xed, 0x12, 0x07, 0x7d, 0x08, 0x73, 0x32, 0x04, 0x4e, 0x7f, 0xe4, 0xe4,
+	0x80, 0x9c, 0x93, 0x13, 0x74, 0xa5, 0x60, 0x75, 0xf4, 0x28, 0xc5, 0xe5, 0xdb, 0x00, 0x4a, 0x55,
+	0x2e, 0x82, 0x54, 0x79, 0x91, 0x9c, 0xdd, 0x28, 0x3e, 0xb0, 0xfe, 0x54, 0x2d, 0x00, 0xca, 0x12,
+	0x9f, 0xb3, 0x6f, 0xab, 0xbf, 0xcb, 0x51, 0xad, 0x97, 0x51, 0x7d, 0x13, 0x5a, 0xca, 0x4c, 0x85,
+	0x9e, 0xea, 0x09, 0xcb, 0x6f, 0x42, 0x4b, 0xd4, 0x97, 0xe7, 0x09, 0xa1, 0x1e, 0x61, 0xe9, 0x81,
+	0x07, 0x61, 0x12, 0x3c, 0x55, 0x1c, 0x74, 0x05, 0x6a, 0x3c, 0x8a, 0x9d, 0x83, 0xac, 0x50, 0xf3,
+	0x28, 0x7e, 0x8c, 0x7e, 0x0a, 0x0b, 0x8c, 0x60, 0x9f, 0xb8, 0x4e, 0x5e, 0x58, 0x99, 0xc3, 0xa4,
+	0xd9, 0xc4, 0x35, 0xeb, 0x12, 0x30, 0xa6, 0xd2, 0xd8, 0xc9, 0x15, 0x76, 0x52, 0xb9, 0xc0, 0xc3,
+	0x40, 0x35, 0x35, 0x63, 0xc3, 0x1a, 0xb2, 0xef, 0x41, 0x85, 0x28, 0x1f, 0xf0, 0x39, 0x98, 0x43,
+	0x3f, 0xea, 0x63, 0xdf, 0x39, 0xb1, 0xaa, 0x6c, 0xb0, 0x74, 0xfb, 0x4d, 0x25, 0xdf, 0x99, 0x58,
+	0x52, 0x98, 0xc7, 0x7c, 0x6f, 0x40, 0x5c, 0xa7, 0xef, 0x47, 0x7d, 0x13, 0x24, 0xf0, 0x41, 0xb1,
+	0x44, 0xa5, 0x16, 0x80, 0x4f, 0x15, 0x84, 0x1b, 0x06, 0x51, 0x12, 0x72, 0x09, 0x63, 0xdd, 0xee,
+	0x2a, 0xfe, 0x93, 0x24, 0x58, 0x13, 0x5c, 0xf4, 0x1e, 0x74, 0x52, 0xcd, 0x68, 0x6f, 0x8f, 0x11,
+	0x2e, 0xf1, 0xab, 0xdb, 0x6d, 0xc5, 0xfc, 0x52, 0xf2, 0x44, 0x56, 0x48, 0x2c, 0x30, 0x87, 0xd3,
+	0x24, 0x1c, 0x60, 0x4e, 0x5c, 0x59, 0xf4, 0x1b, 0xf6, 0xac, 0xe2, 0xef, 0x66, 0x6c, 0xeb, 0xdf,
+	0x55, 0x98, 0xb5, 0x45, 0x20, 0xc8, 0x21, 0xf9, 0x7f, 0x2a, 0x66, 0xa7, 0x15, 0x95, 0x99, 0x0b,
+	0x15, 0x95, 0xfa, 0xb9, 0x8b, 0x4a, 0xe3, 0x42, 0x45, 0xa5, 0x79, 0xb1, 0xa2, 0x02, 0xa7, 0x14,
+	0x95, 0x79, 0xa8, 0xf9, 0x5e, 0xe0, 0x65, 0x58, 0x50, 0x04, 0xfa, 0x19, 0x00, 0x1e, 0x0e, 0x29,
+	0x19, 0x62, 0x4e, 0x58, 0x7a, 0x0b, 0x58, 0x3c, 0xe5, 0x18, 0x5c, 0xc9, 0x14, 0xed, 0xd2, 0x98,
+	0xe9, 0x85, 0xa6, 0x33, 0xb5, 0xd0, 0x3c, 0x80, 0x46, 0x44, 0xc5, 0xe1, 0xd0, 0x1f, 0x99, 0x5d,
+	0xb9, 0xd4, 0x7b, 0xa7, 0x2c, 0xf5, 0xa5, 0x50, 0x4b, 0x07, 0xda, 0xf5, 0x48, 0x51, 0xd6, 0x3a,
+	0xb4, 0xcb, 0x82, 0xb3, 0xce, 0xda, 0x1b, 0xd0, 0xc4, 0x6c, 0x40, 0x42, 0xd7, 0x0b, 0x87, 0x12,
+	0x48, 0x0d, 0xbb, 0x60, 0x58, 0xbf, 0x84, 0x66, 0x6e, 0x0c, 0xfa, 0x1c, 0xaa, 0xb2, 0x92, 0x68,
+	0xf2, 0x1c, 0x7a, 0xff, 0x65, 0xc6, 0xcb, 0xf3, 0x48, 0x8e, 0x18, 0x5b, 0xbf, 0x32, 0xb6, 0xbe,
+	0xf5, 0x1f, 0xbd, 0x9c, 0x0a, 0xaf, 0x41, 0xd9, 0xbc, 0x05, 0xba, 0xe7, 0xaa, 0xee, 0xa5, 0xb5,
+	0x6c, 0x4e, 0xbd, 0xae, 0x6d, 0xf4, 0x98, 0x2d, 0x94, 0x26, 0xaf, 0x78, 0xb5, 0x0b, 0x5f, 0xf1,
+	0x1e, 0xc0, 0xf5, 0x93, 0xc5, 0x94, 0xa6, 0xee, 0x70, 0xcd, 0x19, 0x99, 0x29, 0xd7, 0x26, 0xab,
+	0x69, 0xe6, 0x2f, 0x17, 0xfd, 0x18, 0xe6, 0x4b, 0xe5, 0xb4, 0x18, 0x58, 0x57, 0xbf, 0x9e, 0x0a,
+	0x59, 0x31, 0xe4, 0xac, 0x82, 0xda, 0x38, 0xb3, 0xa0, 0xae, 0xc3, 0x6c, 0x01, 0x67, 0x65, 0x71,
+	0xf3, 0x5c, 0x16, 0x77, 0x8b, 0x61, 0x82, 0xb6, 0xfe, 0xa5, 0x43, 0xa7, 0x47, 0x7c, 0xc2, 0xc9,
+	0xf7, 0xad, 0xcc, 0xa9, 0xad, 0xcc, 0x8f, 0x00, 0x79, 0x21, 0xbf, 0xf7, 0xa9, 0x13, 0x53, 0x2f,
+	0xc0, 0x74, 0xe4, 0x1c, 0x90, 0x51, 0x76, 0xe4, 0x19, 0x52, 0xb2, 0xad, 0x04, 0x8f, 0xc9, 0x88,
+	0xbd, 0xb4, 0xb5, 0x29, 0xf7, 0x12, 0xaa, 0xae, 0xe5, 0xbd, 0xc4, 0x4f, 0xa0, 0x3d, 0xb6, 0x44,
+	0xfb, 0x25, 0xc8, 0x6f, 0xc5, 0xc5, 0xba, 0xd6, 0x7f, 0x35, 0x68, 0x6e, 0x46, 0xd8, 0x95, 0x5d,
+	0xfd, 0x25, 0xc3, 0x98, 0x37, 0x6c, 0x95, 0xc9, 0x86, 0xed, 0x06, 0x14, 0x8d, 0x79, 0x1a, 0xc8,
+	0x82, 0x51, 0xee, 0xb8, 0xab, 0xe3, 0x1d, 0xf7, 0x4d, 0x68, 0x79, 0x62, 0x43, 0x4e, 0x8c, 0xf9,
+	0xbe, 0x3a, 0xca, 0x9a, 0x36, 0x48, 0xd6, 0xb6, 0xe0, 0x88, 0x96, 0x3c, 0x53, 0x90, 0x2d, 0xf9,
+	0xcc, 0xb9, 0x5b, 0xf2, 0x74, 0x12, 0xd9, 0x92, 0xff, 0x46, 0x13, 0x2f, 0x42, 0x2e, 0x39, 0x16,
+	0x85, 0xe5, 0xe4, 0xa4, 0xda, 0x65, 0x26, 0x15, 0x67, 0xac, 0x8c, 0x14, 0xf1, 0x31, 0x2f, 0xb2,
+	0x93, 0xa5, 0xce, 0x41, 0x22, 0x6a, 0x4a, 0x94, 0x66, 0x26, 0xb3, 0x7e, 0xa7, 0x01, 0xc8, 0x64,
+	0x53, 0xdb, 0x98, 0x84, 0x9f, 0x76, 0xf6, 0xcf, 0x8a, 0xca, 0xb8, 0xeb, 0x56, 0x33, 0xd7, 0x9d,
+	0xf1, 0x62, 0x50, 0xea, 0x2e, 0x33, 0xe3, 0x53, 0xef, 0xca, 0x6f, 0xeb, 0xf7, 0x1a, 0xb4, 0xd3,
+	0xdd, 0xa9, 0x2d, 0x8d, 0x45, 0x59, 0x9b, 0x8c, 0xb2, 0xbc, 0xa8, 0x06, 0x11, 0x1d, 0x39, 0xcc,
+	0x7b, 0x41, 0xd2, 0x0d, 0x81, 0x62, 0xed, 0x78, 0x2f, 0xc8, 0x18, 0x78, 0xf5, 0x71, 0xf0, 0x7e,
+	0x04, 0x73, 0x94, 0x0c, 0x48, 0xc8, 0xfd, 0x91, 0x13, 0x44, 0xae, 0xb7, 0xe7, 0x11, 0x57, 0xa2,
+	0xa1, 0x61, 0x1b, 0x99, 0x60, 0x2b, 0xe5, 0x5b, 0xbf, 0xd6, 0xa0, 0xb5, 0xc5, 0x86, 0xdb, 0x11,
+	0x93, 0x49, 0x86, 0xde, 0x85, 0x76, 0x5a, 0x21, 0x55, 0x86, 0x6b, 0x12, 0x61, 0xad, 0x41, 0xf1,
+	0xd7, 0x5d, 0x9c, 0x11, 0x01, 0x1b, 0xa6, 0x6e, 0x6a, 0xdb, 0x8a, 0x40, 0x0b, 0xd0, 0x08, 0xd8,
+	0x50, 0x76, 0x68, 0x29, 0x2c, 0x73, 0x5a, 0xd8, 0x5a, 0xdc, 0x31, 0xaa, 0xf2, 0x8e, 0xd1, 0xe4,
+	0xe5, 0xb7, 0x20, 0x94, 0xfe, 0xd5, 0x7f, 0xa5, 0x47, 0x38, 0x19, 0xe5, 0xf2, 0xcb, 0x41, 0x45,
+	0x62, 0x7c, 0x8c, 0x37, 0x51, 0x14, 0xf4, 0x13, 0x45, 0xe1, 0x23, 0x98, 0x73, 0xc9, 0x1e, 0x4e,
+	0x7c, 0xee, 0x4c, 0x6e, 0xd9, 0x48, 0x05, 0x63, 0xaf, 0x58, 0xdd, 0x35, 0x4a, 0x5c, 0x12, 0x72,
+	0x0f, 0xfb, 0xf2, 0x71, 0x75, 0x01, 0x1a, 0x09, 0x23, 0xb4, 0xe4, 0xbb, 0x9c, 0x46, 0x1f, 0x03,
+	0x22, 0xe1, 0x80, 0x8e, 0x62, 0x01, 0xe2, 0x18, 0x33, 0x76, 0x14, 0x51, 0x37, 0x2d, 0xd4, 0x73,
+	0xb9, 0x64, 0x3b, 0x15, 0x88, 0x5f, 0x2f, 0x9c, 0x84, 0x38, 0xe4, 0x59, 0xbd, 0x56, 0x94, 0x08,
+	0xbd, 0xc7, 0x1c, 0x96, 0xc4, 0x84, 0xa6, 0x61, 0xad, 0x7b, 0x6c, 0x47, 0x90, 0xa2, 0x94, 0xb3,
+	0x7d, 0xbc, 0xfc, 0xd9, 0xbd, 0x62, 0x7a, 0x55, 0xa2, 0xbb, 0x8a, 0x9d, 0xcd, 0x6d, 0x3d, 0x84,
+	0x39, 0xf1, 0x8a, 0xba, 0x1d, 0xf9, 0xde, 0x60, 0x74, 0xe9, 0x13, 0xc7, 0xfa, 0x4a, 0x03, 0x54,
+	0x9e, 0x27, 0x7d, 0xc3, 0x2b, 0xae, 0x1e, 0xda, 0xf9, 0xaf, 0x1e, 0xef, 0x42, 0x3b, 0x96, 0xd3,
+	0x38, 0x5e, 0xb8, 0x17, 0x65, 0xd1, 0x6b, 0x29, 0x9e, 0xf0, 0x2d, 0x13, 0xcd, 0xa6, 0x70, 0xa6,
+	0x43, 0x23, 0x9f, 0xa8, 0xe0, 0x35, 0xed, 0xa6, 0xe0, 0xd8, 0x82, 0x61, 0x0d, 0xe1, 0xda, 0xce,
+	0x7e, 0x74, 0xb4, 0x16, 0x85, 0x7b, 0xde, 0x30, 0xa1, 0x58, 0x00, 0xfa, 0x15, 0xfe, 0xfb, 0x9a,
+	0x50, 0x8f, 0x31, 0x17, 0x69, 0x9d, 0xc6, 0x28, 0x23, 0xad, 0x3f, 0x68, 0xb0, 0x30, 0x6d, 0xa5,
+	0x57, 0x31, 0x7f, 0x1d, 0x3a, 0x03, 0x35, 0x9d, 0x9a, 0xed, 0xfc, 0x8f, 0xe4, 0xe3, 0xe3, 0xac,
+	0x87, 0x50, 0xb5, 0x31, 0x27, 0xe8, 0x0e, 0x54, 0x28, 0x4f, 0x2f, 0xa6, 0x37, 0x4f, 0x29, 0x56,
+	0x76, 0x76, 0x27, 0xad, 0x50, 0x8e, 0xda, 0xa0, 0x51, 0x69, 0xa9, 0x66, 0x6b, 0xf4, 0xd6, 0x32,
+	0xcc, 0x9d, 0xf8, 0x51, 0x86, 0xda, 0xd0, 0xb0, 0xa3, 0x23, 0xe1, 0x23, 0xd7, 0x78, 0x03, 0xcd,
+	0x42, 0x6b, 0x2d, 0xf2, 0x93, 0x20, 0x54, 0x0c, 0xed, 0xd6, 0x03, 0xe8, 0x8c, 0x5d, 0x75, 0x51,
+	0x13, 0x6a, 0xb2, 0x5b, 0x34, 0xde, 0x40, 0x75, 0xd0, 0xb7, 0xbc, 0xd0, 0xd0, 0xe4, 0x07, 0x3e,
+	0x36, 0x2a, 0xe2, 0x63, 0x27, 0x09, 0x0c, 0x5d, 0x7c, 0xac, 0x1c, 0x0e, 0x8d, 0xea, 0xad, 0x3f,
+	0x6b, 0xd0, 0xc8, 0xb6, 0x84, 0xe6, 0xa0, 0xd3, 0xeb, 0x6d, 0x16, 0xaf, 0x76, 0xc6, 0x1b, 0xc8,
+	0x80, 0x76, 0xaf, 0xb7, 0x99, 0xbf, 0xf9, 0x18, 0x9a, 0xd8, 0x50, 0xaf, 0xb7, 0x29, 0x6b, 0xae,
+	0x51, 0x49, 0xa9, 0x47, 0x7e, 0xc2, 0xf6, 0x0d, 0x3d, 0x9f, 0x20, 0x88, 0xb1, 0x9a, 0xa0, 0x8a,
+	0x3a, 0xd0, 0xec, 0x6d, 0x6d, 0x2a, 0xbb, 0x8c, 0x5a, 0x4a, 0xaa, 0x6b, 0x97, 0x31, 0x23, 0xec,
+	0xe9, 0x6d, 0x6d, 0xae, 0x26, 0xfe, 0x81, 0x38, 0xbe, 0x8d, 0xba, 0x94, 0x3f, 0xdd, 0x54, 0x7d,
+	0xb7, 0xd1, 0x90, 0xd3, 0x3f, 0xdd, 0x14, 0x7f, 0x02, 0x46, 0x46, 0x73, 0xf5, 0xfe, 0x2f, 0x3e,
+	0x1b, 0x7a, 0x7c, 0x3f, 0xe9, 0x8b, 0xa0, 0xdc, 0x51, 0xfe, 0xfd, 0xd8, 0x8b, 0xd2, 0xaf, 0x3b,
+	0x99, 0x8f, 0xef, 0x48, 0x97, 0xe7, 0x64, 0xdc, 0xef, 0xcf, 0x48, 0xce, 0x27, 0xff, 0x1b, 0x00,
+	0x81, 0x66, 0x15, 0x8c, 0x2a, 0x22, 0x00, 0x00,
 }
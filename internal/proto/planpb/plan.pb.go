@@ -82,12 +82,13 @@ func (OpType) EnumDescriptor() ([]byte, []int) {
 type ArithOpType int32
 
 const (
-	ArithOpType_Unknown ArithOpType = 0
-	ArithOpType_Add     ArithOpType = 1
-	ArithOpType_Sub     ArithOpType = 2
-	ArithOpType_Mul     ArithOpType = 3
-	ArithOpType_Div     ArithOpType = 4
-	ArithOpType_Mod     ArithOpType = 5
+	ArithOpType_Unknown     ArithOpType = 0
+	ArithOpType_Add         ArithOpType = 1
+	ArithOpType_Sub         ArithOpType = 2
+	ArithOpType_Mul         ArithOpType = 3
+	ArithOpType_Div         ArithOpType = 4
+	ArithOpType_Mod         ArithOpType = 5
+	ArithOpType_ArrayLength ArithOpType = 6
 )
 
 var ArithOpType_name = map[int32]string{
@@ -97,15 +98,17 @@ var ArithOpType_name = map[int32]string{
 	3: "Mul",
 	4: "Div",
 	5: "Mod",
+	6: "ArrayLength",
 }
 
 var ArithOpType_value = map[string]int32{
-	"Unknown": 0,
-	"Add":     1,
-	"Sub":     2,
-	"Mul":     3,
-	"Div":     4,
-	"Mod":     5,
+	"Unknown":     0,
+	"Add":         1,
+	"Sub":         2,
+	"Mul":         3,
+	"Div":         4,
+	"Mod":         5,
+	"ArrayLength": 6,
 }
 
 func (x ArithOpType) String() string {
@@ -116,6 +119,65 @@ func (ArithOpType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{1}
 }
 
+type ArrayContainsExpr_ArrayOp int32
+
+const (
+	ArrayContainsExpr_Invalid     ArrayContainsExpr_ArrayOp = 0
+	ArrayContainsExpr_Contains    ArrayContainsExpr_ArrayOp = 1
+	ArrayContainsExpr_ContainsAll ArrayContainsExpr_ArrayOp = 2
+	ArrayContainsExpr_ContainsAny ArrayContainsExpr_ArrayOp = 3
+)
+
+var ArrayContainsExpr_ArrayOp_name = map[int32]string{
+	0: "Invalid",
+	1: "Contains",
+	2: "ContainsAll",
+	3: "ContainsAny",
+}
+
+var ArrayContainsExpr_ArrayOp_value = map[string]int32{
+	"Invalid":     0,
+	"Contains":    1,
+	"ContainsAll": 2,
+	"ContainsAny": 3,
+}
+
+func (x ArrayContainsExpr_ArrayOp) String() string {
+	return proto.EnumName(ArrayContainsExpr_ArrayOp_name, int32(x))
+}
+
+func (ArrayContainsExpr_ArrayOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{9, 0}
+}
+
+type NullExpr_NullOp int32
+
+const (
+	NullExpr_Invalid   NullExpr_NullOp = 0
+	NullExpr_IsNull    NullExpr_NullOp = 1
+	NullExpr_IsNotNull NullExpr_NullOp = 2
+)
+
+var NullExpr_NullOp_name = map[int32]string{
+	0: "Invalid",
+	1: "IsNull",
+	2: "IsNotNull",
+}
+
+var NullExpr_NullOp_value = map[string]int32{
+	"Invalid":   0,
+	"IsNull":    1,
+	"IsNotNull": 2,
+}
+
+func (x NullExpr_NullOp) String() string {
+	return proto.EnumName(NullExpr_NullOp_name, int32(x))
+}
+
+func (NullExpr_NullOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
+}
+
 type UnaryExpr_UnaryOp int32
 
 const (
@@ -138,7 +200,7 @@ func (x UnaryExpr_UnaryOp) String() string {
 }
 
 func (UnaryExpr_UnaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{11, 0}
 }
 
 type BinaryExpr_BinaryOp int32
@@ -166,7 +228,7 @@ func (x BinaryExpr_BinaryOp) String() string {
 }
 
 func (BinaryExpr_BinaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{12, 0}
 }
 
 type GenericValue struct {
@@ -280,10 +342,15 @@ func (*GenericValue) XXX_OneofWrappers() []interface{} {
 }
 
 type QueryInfo struct {
-	Topk                 int64    `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
-	MetricType           string   `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
-	SearchParams         string   `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	RoundDecimal         int64    `protobuf:"varint,5,opt,name=round_decimal,json=roundDecimal,proto3" json:"round_decimal,omitempty"`
+	Topk         int64  `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
+	MetricType   string `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	SearchParams string `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	RoundDecimal int64  `protobuf:"varint,5,opt,name=round_decimal,json=roundDecimal,proto3" json:"round_decimal,omitempty"`
+	// range search returns the entities whose distances lie in the range bounded by
+	// radius and range_filter, at most topk of them for each query.
+	IsRangeSearch        bool     `protobuf:"varint,6,opt,name=is_range_search,json=isRangeSearch,proto3" json:"is_range_search,omitempty"`
+	Radius               float32  `protobuf:"fixed32,7,opt,name=radius,proto3" json:"radius,omitempty"`
+	RangeFilter          float32  `protobuf:"fixed32,8,opt,name=range_filter,json=rangeFilter,proto3" json:"range_filter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -342,14 +409,37 @@ func (m *QueryInfo) GetRoundDecimal() int64 {
 	return 0
 }
 
+func (m *QueryInfo) GetIsRangeSearch() bool {
+	if m != nil {
+		return m.IsRangeSearch
+	}
+	return false
+}
+
+func (m *QueryInfo) GetRadius() float32 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *QueryInfo) GetRangeFilter() float32 {
+	if m != nil {
+		return m.RangeFilter
+	}
+	return 0
+}
+
 type ColumnInfo struct {
-	FieldId              int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
-	DataType             schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
-	IsPrimaryKey         bool              `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
-	IsAutoID             bool              `protobuf:"varint,4,opt,name=is_autoID,json=isAutoID,proto3" json:"is_autoID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	FieldId      int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	DataType     schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
+	IsPrimaryKey bool              `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
+	IsAutoID     bool              `protobuf:"varint,4,opt,name=is_autoID,json=isAutoID,proto3" json:"is_autoID,omitempty"`
+	// keys from the root of the JSON document to the value, only for JSON field
+	NestedPath           []string `protobuf:"bytes,5,rep,name=nested_path,json=nestedPath,proto3" json:"nested_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ColumnInfo) Reset()         { *m = ColumnInfo{} }
@@ -405,6 +495,13 @@ func (m *ColumnInfo) GetIsAutoID() bool {
 	return false
 }
 
+func (m *ColumnInfo) GetNestedPath() []string {
+	if m != nil {
+		return m.NestedPath
+	}
+	return nil
+}
+
 type ColumnExpr struct {
 	Info                 *ColumnInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -711,6 +808,108 @@ func (m *TermExpr) GetValues() []*GenericValue {
 	return nil
 }
 
+type ArrayContainsExpr struct {
+	ColumnInfo           *ColumnInfo               `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Elements             []*GenericValue           `protobuf:"bytes,2,rep,name=elements,proto3" json:"elements,omitempty"`
+	Op                   ArrayContainsExpr_ArrayOp `protobuf:"varint,3,opt,name=op,proto3,enum=milvus.proto.plan.ArrayContainsExpr_ArrayOp" json:"op,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ArrayContainsExpr) Reset()         { *m = ArrayContainsExpr{} }
+func (m *ArrayContainsExpr) String() string { return proto.CompactTextString(m) }
+func (*ArrayContainsExpr) ProtoMessage()    {}
+func (*ArrayContainsExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{9}
+}
+
+func (m *ArrayContainsExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArrayContainsExpr.Unmarshal(m, b)
+}
+func (m *ArrayContainsExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArrayContainsExpr.Marshal(b, m, deterministic)
+}
+func (m *ArrayContainsExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArrayContainsExpr.Merge(m, src)
+}
+func (m *ArrayContainsExpr) XXX_Size() int {
+	return xxx_messageInfo_ArrayContainsExpr.Size(m)
+}
+func (m *ArrayContainsExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArrayContainsExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArrayContainsExpr proto.InternalMessageInfo
+
+func (m *ArrayContainsExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *ArrayContainsExpr) GetElements() []*GenericValue {
+	if m != nil {
+		return m.Elements
+	}
+	return nil
+}
+
+func (m *ArrayContainsExpr) GetOp() ArrayContainsExpr_ArrayOp {
+	if m != nil {
+		return m.Op
+	}
+	return ArrayContainsExpr_Invalid
+}
+
+type NullExpr struct {
+	ColumnInfo           *ColumnInfo     `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Op                   NullExpr_NullOp `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.NullExpr_NullOp" json:"op,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NullExpr) Reset()         { *m = NullExpr{} }
+func (m *NullExpr) String() string { return proto.CompactTextString(m) }
+func (*NullExpr) ProtoMessage()    {}
+func (*NullExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{10}
+}
+
+func (m *NullExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NullExpr.Unmarshal(m, b)
+}
+func (m *NullExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NullExpr.Marshal(b, m, deterministic)
+}
+func (m *NullExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NullExpr.Merge(m, src)
+}
+func (m *NullExpr) XXX_Size() int {
+	return xxx_messageInfo_NullExpr.Size(m)
+}
+func (m *NullExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_NullExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NullExpr proto.InternalMessageInfo
+
+func (m *NullExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *NullExpr) GetOp() NullExpr_NullOp {
+	if m != nil {
+		return m.Op
+	}
+	return NullExpr_Invalid
+}
+
 type UnaryExpr struct {
 	Op                   UnaryExpr_UnaryOp `protobuf:"varint,1,opt,name=op,proto3,enum=milvus.proto.plan.UnaryExpr_UnaryOp" json:"op,omitempty"`
 	Child                *Expr             `protobuf:"bytes,2,opt,name=child,proto3" json:"child,omitempty"`
@@ -723,7 +922,7 @@ func (m *UnaryExpr) Reset()         { *m = UnaryExpr{} }
 func (m *UnaryExpr) String() string { return proto.CompactTextString(m) }
 func (*UnaryExpr) ProtoMessage()    {}
 func (*UnaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9}
+	return fileDescriptor_2d655ab2f7683c23, []int{11}
 }
 
 func (m *UnaryExpr) XXX_Unmarshal(b []byte) error {
@@ -771,7 +970,7 @@ func (m *BinaryExpr) Reset()         { *m = BinaryExpr{} }
 func (m *BinaryExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryExpr) ProtoMessage()    {}
 func (*BinaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10}
+	return fileDescriptor_2d655ab2f7683c23, []int{12}
 }
 
 func (m *BinaryExpr) XXX_Unmarshal(b []byte) error {
@@ -826,7 +1025,7 @@ func (m *BinaryArithOp) Reset()         { *m = BinaryArithOp{} }
 func (m *BinaryArithOp) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithOp) ProtoMessage()    {}
 func (*BinaryArithOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{11}
+	return fileDescriptor_2d655ab2f7683c23, []int{13}
 }
 
 func (m *BinaryArithOp) XXX_Unmarshal(b []byte) error {
@@ -881,7 +1080,7 @@ func (m *BinaryArithExpr) Reset()         { *m = BinaryArithExpr{} }
 func (m *BinaryArithExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithExpr) ProtoMessage()    {}
 func (*BinaryArithExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{12}
+	return fileDescriptor_2d655ab2f7683c23, []int{14}
 }
 
 func (m *BinaryArithExpr) XXX_Unmarshal(b []byte) error {
@@ -938,7 +1137,7 @@ func (m *BinaryArithOpEvalRangeExpr) Reset()         { *m = BinaryArithOpEvalRan
 func (m *BinaryArithOpEvalRangeExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithOpEvalRangeExpr) ProtoMessage()    {}
 func (*BinaryArithOpEvalRangeExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{13}
+	return fileDescriptor_2d655ab2f7683c23, []int{15}
 }
 
 func (m *BinaryArithOpEvalRangeExpr) XXX_Unmarshal(b []byte) error {
@@ -1006,6 +1205,8 @@ type Expr struct {
 	//	*Expr_BinaryArithExpr
 	//	*Expr_ValueExpr
 	//	*Expr_ColumnExpr
+	//	*Expr_ArrayContainsExpr
+	//	*Expr_NullExpr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -1016,7 +1217,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{14}
+	return fileDescriptor_2d655ab2f7683c23, []int{16}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -1081,6 +1282,14 @@ type Expr_ColumnExpr struct {
 	ColumnExpr *ColumnExpr `protobuf:"bytes,10,opt,name=column_expr,json=columnExpr,proto3,oneof"`
 }
 
+type Expr_ArrayContainsExpr struct {
+	ArrayContainsExpr *ArrayContainsExpr `protobuf:"bytes,11,opt,name=array_contains_expr,json=arrayContainsExpr,proto3,oneof"`
+}
+
+type Expr_NullExpr struct {
+	NullExpr *NullExpr `protobuf:"bytes,12,opt,name=null_expr,json=nullExpr,proto3,oneof"`
+}
+
 func (*Expr_TermExpr) isExpr_Expr() {}
 
 func (*Expr_UnaryExpr) isExpr_Expr() {}
@@ -1101,6 +1310,10 @@ func (*Expr_ValueExpr) isExpr_Expr() {}
 
 func (*Expr_ColumnExpr) isExpr_Expr() {}
 
+func (*Expr_ArrayContainsExpr) isExpr_Expr() {}
+
+func (*Expr_NullExpr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -1178,6 +1391,20 @@ func (m *Expr) GetColumnExpr() *ColumnExpr {
 	return nil
 }
 
+func (m *Expr) GetArrayContainsExpr() *ArrayContainsExpr {
+	if x, ok := m.GetExpr().(*Expr_ArrayContainsExpr); ok {
+		return x.ArrayContainsExpr
+	}
+	return nil
+}
+
+func (m *Expr) GetNullExpr() *NullExpr {
+	if x, ok := m.GetExpr().(*Expr_NullExpr); ok {
+		return x.NullExpr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1191,6 +1418,8 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_BinaryArithExpr)(nil),
 		(*Expr_ValueExpr)(nil),
 		(*Expr_ColumnExpr)(nil),
+		(*Expr_ArrayContainsExpr)(nil),
+		(*Expr_NullExpr)(nil),
 	}
 }
 
@@ -1209,7 +1438,7 @@ func (m *VectorANNS) Reset()         { *m = VectorANNS{} }
 func (m *VectorANNS) String() string { return proto.CompactTextString(m) }
 func (*VectorANNS) ProtoMessage()    {}
 func (*VectorANNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{15}
+	return fileDescriptor_2d655ab2f7683c23, []int{17}
 }
 
 func (m *VectorANNS) XXX_Unmarshal(b []byte) error {
@@ -1280,7 +1509,7 @@ func (m *PlanNode) Reset()         { *m = PlanNode{} }
 func (m *PlanNode) String() string { return proto.CompactTextString(m) }
 func (*PlanNode) ProtoMessage()    {}
 func (*PlanNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{16}
+	return fileDescriptor_2d655ab2f7683c23, []int{18}
 }
 
 func (m *PlanNode) XXX_Unmarshal(b []byte) error {
@@ -1356,6 +1585,8 @@ func (*PlanNode) XXX_OneofWrappers() []interface{} {
 func init() {
 	proto.RegisterEnum("milvus.proto.plan.OpType", OpType_name, OpType_value)
 	proto.RegisterEnum("milvus.proto.plan.ArithOpType", ArithOpType_name, ArithOpType_value)
+	proto.RegisterEnum("milvus.proto.plan.ArrayContainsExpr_ArrayOp", ArrayContainsExpr_ArrayOp_name, ArrayContainsExpr_ArrayOp_value)
+	proto.RegisterEnum("milvus.proto.plan.NullExpr_NullOp", NullExpr_NullOp_name, NullExpr_NullOp_value)
 	proto.RegisterEnum("milvus.proto.plan.UnaryExpr_UnaryOp", UnaryExpr_UnaryOp_name, UnaryExpr_UnaryOp_value)
 	proto.RegisterEnum("milvus.proto.plan.BinaryExpr_BinaryOp", BinaryExpr_BinaryOp_name, BinaryExpr_BinaryOp_value)
 	proto.RegisterType((*GenericValue)(nil), "milvus.proto.plan.GenericValue")
@@ -1367,6 +1598,8 @@ func init() {
 	proto.RegisterType((*BinaryRangeExpr)(nil), "milvus.proto.plan.BinaryRangeExpr")
 	proto.RegisterType((*CompareExpr)(nil), "milvus.proto.plan.CompareExpr")
 	proto.RegisterType((*TermExpr)(nil), "milvus.proto.plan.TermExpr")
+	proto.RegisterType((*ArrayContainsExpr)(nil), "milvus.proto.plan.ArrayContainsExpr")
+	proto.RegisterType((*NullExpr)(nil), "milvus.proto.plan.NullExpr")
 	proto.RegisterType((*UnaryExpr)(nil), "milvus.proto.plan.UnaryExpr")
 	proto.RegisterType((*BinaryExpr)(nil), "milvus.proto.plan.BinaryExpr")
 	proto.RegisterType((*BinaryArithOp)(nil), "milvus.proto.plan.BinaryArithOp")
@@ -1380,93 +1613,108 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x72, 0xdc, 0x44,
-	0x10, 0x5e, 0xad, 0x76, 0xbd, 0x52, 0xef, 0x7a, 0xad, 0xe8, 0x42, 0x7e, 0x48, 0x6c, 0x44, 0x8a,
-	0x98, 0x50, 0xb1, 0x2b, 0x24, 0x24, 0x95, 0x50, 0x01, 0xff, 0x05, 0x7b, 0x8b, 0xc4, 0x36, 0x8a,
-	0xe3, 0x03, 0x17, 0xd5, 0xac, 0x34, 0xf6, 0x4e, 0x45, 0xab, 0x51, 0x46, 0xa3, 0x4d, 0xf6, 0xcc,
-	0x8d, 0x1b, 0x0f, 0xc0, 0x15, 0xee, 0xdc, 0xe0, 0xc2, 0x0b, 0x70, 0xe0, 0xc8, 0x9d, 0xb7, 0xe0,
-	0x44, 0x4d, 0x8f, 0xf6, 0x2f, 0xb5, 0x1b, 0xaf, 0x8b, 0x54, 0x71, 0x1b, 0xf5, 0x74, 0x7f, 0xd3,
-	0xfd, 0x4d, 0x77, 0x4f, 0x0b, 0x20, 0x8d, 0x49, 0xb2, 0x96, 0x0a, 0x2e, 0xb9, 0x7b, 0xa1, 0xcb,
-	0xe2, 0x5e, 0x9e, 0xe9, 0xaf, 0x35, 0xb5, 0x71, 0xb9, 0x91, 0x85, 0x1d, 0xda, 0x25, 0x5a, 0xe4,
-	0xfd, 0x60, 0x40, 0x63, 0x97, 0x26, 0x54, 0xb0, 0xf0, 0x98, 0xc4, 0x39, 0x75, 0xaf, 0x80, 0xd5,
-	0xe6, 0x3c, 0x0e, 0x7a, 0x24, 0xbe, 0x68, 0xac, 0x18, 0xab, 0xd6, 0x5e, 0xc9, 0xaf, 0x29, 0xc9,
-	0x31, 0x89, 0xdd, 0xab, 0x60, 0xb3, 0x44, 0xde, 0xbb, 0x8b, 0xbb, 0xe5, 0x15, 0x63, 0xd5, 0xdc,
-	0x2b, 0xf9, 0x16, 0x8a, 0x8a, 0xed, 0x93, 0x98, 0x13, 0x89, 0xdb, 0xe6, 0x8a, 0xb1, 0x6a, 0xa8,
-	0x6d, 0x14, 0xa9, 0xed, 0x65, 0x80, 0x4c, 0x0a, 0x96, 0x9c, 0xe2, 0x7e, 0x65, 0xc5, 0x58, 0xb5,
-	0xf7, 0x4a, 0xbe, 0xad, 0x65, 0xc7, 0x24, 0xde, 0xaa, 0x82, 0xd9, 0x23, 0xb1, 0xf7, 0xbd, 0x01,
-	0xf6, 0x37, 0x39, 0x15, 0xfd, 0x56, 0x72, 0xc2, 0x5d, 0x17, 0x2a, 0x92, 0xa7, 0x2f, 0xd0, 0x19,
-	0xd3, 0xc7, 0xb5, 0xbb, 0x0c, 0xf5, 0x2e, 0x95, 0x82, 0x85, 0x81, 0xec, 0xa7, 0x14, 0x8f, 0xb2,
-	0x7d, 0xd0, 0xa2, 0xa3, 0x7e, 0x4a, 0xdd, 0x0f, 0x61, 0x31, 0xa3, 0x44, 0x84, 0x9d, 0x20, 0x25,
-	0x82, 0x74, 0x33, 0x7d, 0x9a, 0xdf, 0xd0, 0xc2, 0x43, 0x94, 0x29, 0x25, 0xc1, 0xf3, 0x24, 0x0a,
-	0x22, 0x1a, 0xb2, 0x2e, 0x89, 0x2f, 0x56, 0xf1, 0x88, 0x06, 0x0a, 0x77, 0xb4, 0xcc, 0xfb, 0xc9,
-	0x00, 0xd8, 0xe6, 0x71, 0xde, 0x4d, 0xd0, 0x9b, 0x4b, 0x60, 0x9d, 0x30, 0x1a, 0x47, 0x01, 0x8b,
-	0x0a, 0x8f, 0x6a, 0xf8, 0xdd, 0x8a, 0xdc, 0x87, 0x60, 0x47, 0x44, 0x12, 0xed, 0x92, 0x22, 0xa7,
-	0xf9, 0xe9, 0xd5, 0xb5, 0x09, 0xfe, 0x0b, 0xe6, 0x77, 0x88, 0x24, 0xca, 0x4b, 0xdf, 0x8a, 0x8a,
-	0x95, 0x7b, 0x1d, 0x9a, 0x2c, 0x0b, 0x52, 0xc1, 0xba, 0x44, 0xf4, 0x83, 0x17, 0xb4, 0x8f, 0x31,
-	0x59, 0x7e, 0x83, 0x65, 0x87, 0x5a, 0xf8, 0x35, 0xed, 0xbb, 0x57, 0xc0, 0x66, 0x59, 0x40, 0x72,
-	0xc9, 0x5b, 0x3b, 0x18, 0x91, 0xe5, 0x5b, 0x2c, 0xdb, 0xc4, 0x6f, 0xef, 0xcb, 0x81, 0x9f, 0x8f,
-	0x5f, 0xa7, 0xc2, 0xbd, 0x0d, 0x15, 0x96, 0x9c, 0x70, 0xf4, 0xb1, 0xfe, 0xa6, 0x1f, 0x98, 0x20,
-	0xa3, 0xa0, 0x7c, 0x54, 0xf5, 0xb6, 0xc0, 0xc6, 0x14, 0x40, 0xfb, 0xcf, 0xa0, 0xda, 0x53, 0x1f,
-	0x05, 0xc0, 0xf2, 0x14, 0x80, 0xf1, 0xb4, 0xf1, 0xb5, 0xb6, 0xf7, 0x8b, 0x01, 0xcd, 0xe7, 0x09,
-	0x11, 0x7d, 0x9f, 0x24, 0xa7, 0x1a, 0xe9, 0x0b, 0xa8, 0x87, 0x78, 0x54, 0x30, 0xbf, 0x43, 0x10,
-	0x8e, 0x18, 0xff, 0x18, 0xca, 0x3c, 0x2d, 0xf8, 0xbc, 0x34, 0xc5, 0xec, 0x20, 0x45, 0x2e, 0xcb,
-	0x3c, 0x1d, 0x39, 0x6d, 0x9e, 0xcb, 0xe9, 0x9f, 0xcb, 0xb0, 0xb4, 0xc5, 0xde, 0xad, 0xd7, 0x37,
-	0x60, 0x29, 0xe6, 0xaf, 0xa8, 0x08, 0x58, 0x12, 0xc6, 0x79, 0xc6, 0x7a, 0x3a, 0x25, 0x2c, 0xbf,
-	0x89, 0xe2, 0xd6, 0x40, 0xaa, 0x14, 0xf3, 0x34, 0x9d, 0x50, 0xd4, 0x57, 0xdf, 0x44, 0xf1, 0x48,
-	0x71, 0x03, 0xea, 0x1a, 0x51, 0x87, 0x58, 0x99, 0x2f, 0x44, 0x40, 0x1b, 0x5d, 0xda, 0x1b, 0x50,
-	0xd7, 0x47, 0x69, 0x84, 0xea, 0x9c, 0x08, 0x68, 0x83, 0x6b, 0xef, 0x0f, 0x03, 0xea, 0xdb, 0xbc,
-	0x9b, 0x12, 0xa1, 0x59, 0xda, 0x05, 0x27, 0xa6, 0x27, 0x32, 0x38, 0x37, 0x55, 0x4d, 0x65, 0x36,
-	0x56, 0x56, 0x2d, 0xb8, 0x20, 0xd8, 0x69, 0x67, 0x12, 0xa9, 0x3c, 0x0f, 0xd2, 0x12, 0xda, 0x6d,
-	0xbf, 0x99, 0x2f, 0xe6, 0x1c, 0xf9, 0xe2, 0x7d, 0x67, 0x80, 0x75, 0x44, 0x45, 0xf7, 0x9d, 0xdc,
-	0xf8, 0x7d, 0x58, 0x40, 0x5e, 0xb3, 0x8b, 0xe5, 0x15, 0x73, 0x1e, 0x62, 0x0b, 0x75, 0xd5, 0x82,
-	0x6d, 0xac, 0x19, 0x74, 0xe3, 0x2e, 0xba, 0x6f, 0xa0, 0xfb, 0xd7, 0xa7, 0x40, 0x0c, 0x35, 0xf5,
-	0xea, 0x20, 0xc5, 0xcc, 0xbf, 0x05, 0xd5, 0xb0, 0xc3, 0xe2, 0xa8, 0xe0, 0xec, 0xbd, 0x29, 0x86,
-	0xca, 0xc6, 0xd7, 0x5a, 0xde, 0x32, 0xd4, 0x0a, 0x6b, 0xb7, 0x0e, 0xb5, 0x56, 0xd2, 0x23, 0x31,
-	0x8b, 0x9c, 0x92, 0x5b, 0x03, 0x73, 0x9f, 0x4b, 0xc7, 0xf0, 0xfe, 0x32, 0x00, 0x74, 0x49, 0xa0,
-	0x53, 0xf7, 0xc6, 0x9c, 0xfa, 0x68, 0x0a, 0xf6, 0x48, 0xb5, 0x58, 0x16, 0x6e, 0x7d, 0x02, 0x15,
-	0x75, 0xd1, 0x67, 0x79, 0x85, 0x4a, 0x2a, 0x06, 0xbc, 0xcb, 0xa2, 0x7a, 0x67, 0xc7, 0x80, 0x5a,
-	0xde, 0x3d, 0xb0, 0x06, 0x67, 0x4d, 0x06, 0xd1, 0x04, 0x78, 0xc2, 0x4f, 0x59, 0x48, 0xe2, 0xcd,
-	0x24, 0x72, 0x0c, 0x77, 0x11, 0xec, 0xe2, 0xfb, 0x40, 0x38, 0x65, 0xef, 0x4f, 0x03, 0x16, 0xb5,
-	0xe1, 0xa6, 0x60, 0xb2, 0x73, 0x90, 0xfe, 0xe7, 0x9b, 0x7f, 0x00, 0x16, 0x51, 0x50, 0xc1, 0xb0,
-	0x4f, 0x5d, 0x9b, 0x62, 0x5c, 0x9c, 0x86, 0xc9, 0x57, 0x23, 0xc5, 0xd1, 0x3b, 0xb0, 0xa8, 0xf3,
-	0x9e, 0xa7, 0x54, 0x90, 0x24, 0x9a, 0xb7, 0x73, 0x35, 0xd0, 0xea, 0x40, 0x1b, 0x79, 0x3f, 0x1a,
-	0x83, 0x06, 0x86, 0x87, 0xe0, 0x95, 0x0d, 0xa8, 0x37, 0xce, 0x45, 0x7d, 0x79, 0x1e, 0xea, 0xdd,
-	0xb5, 0xb1, 0x12, 0x3b, 0x2b, 0x54, 0x55, 0x67, 0xbf, 0x97, 0xe1, 0xf2, 0x04, 0xe5, 0x8f, 0x7b,
-	0x24, 0x7e, 0x77, 0xbd, 0xf6, 0xff, 0xe6, 0xbf, 0x68, 0x39, 0x95, 0x73, 0x3d, 0x51, 0xd5, 0x73,
-	0x3d, 0x51, 0xff, 0x54, 0xa1, 0x82, 0x5c, 0x3d, 0x04, 0x5b, 0x52, 0xd1, 0x0d, 0xe8, 0xeb, 0x54,
-	0x14, 0x4c, 0x5d, 0x99, 0x82, 0x31, 0xe8, 0x6a, 0x6a, 0xfe, 0x92, 0x83, 0x0e, 0xf7, 0x08, 0x20,
-	0x57, 0x97, 0xa0, 0x8d, 0xf5, 0x55, 0xbf, 0xff, 0xb6, 0x16, 0xa3, 0xa6, 0xb3, 0x7c, 0xd8, 0x04,
-	0x36, 0xa0, 0xde, 0x66, 0x23, 0x7b, 0x73, 0xe6, 0x35, 0x8d, 0xba, 0xc1, 0x5e, 0xc9, 0x87, 0xf6,
-	0xa8, 0x8d, 0x6c, 0x43, 0x23, 0xd4, 0xaf, 0x87, 0x86, 0xd0, 0x6f, 0xd8, 0xb5, 0xa9, 0x37, 0x3d,
-	0x7c, 0x64, 0xf6, 0x4a, 0x7e, 0x3d, 0x1c, 0x7b, 0x73, 0x9e, 0x82, 0xa3, 0xa3, 0x10, 0x2a, 0x81,
-	0x34, 0x90, 0x26, 0xf3, 0x83, 0x59, 0xb1, 0x0c, 0x53, 0x6d, 0xaf, 0xe4, 0x37, 0xf3, 0xc9, 0x87,
-	0xfe, 0x10, 0x2e, 0x14, 0x51, 0x8d, 0xe1, 0x2d, 0x20, 0x9e, 0x37, 0x33, 0xb6, 0x71, 0xc0, 0xa5,
-	0xf6, 0x1b, 0xa3, 0x83, 0x84, 0xe5, 0x02, 0x71, 0x90, 0x95, 0x01, 0xed, 0x91, 0x78, 0x1c, 0xbf,
-	0x86, 0xf8, 0xb7, 0x66, 0xe2, 0x4f, 0x2b, 0x93, 0xbd, 0x92, 0x7f, 0xb9, 0x3d, 0xbb, 0x88, 0x46,
-	0x71, 0xe8, 0x53, 0xf1, 0x1c, 0xeb, 0x8c, 0x38, 0x86, 0xed, 0x62, 0x14, 0xc7, 0xa8, 0x83, 0x3c,
-	0x02, 0xc0, 0xe4, 0xd3, 0x50, 0xf6, 0xcc, 0x74, 0x19, 0x0e, 0x8d, 0x2a, 0x5d, 0x7a, 0xc3, 0x09,
-	0x72, 0x63, 0x58, 0xd5, 0x68, 0x0f, 0x67, 0x54, 0xf5, 0x20, 0x5d, 0xc2, 0xe1, 0xd7, 0xd6, 0x02,
-	0x54, 0x94, 0xa9, 0xf7, 0xb7, 0x01, 0x70, 0x4c, 0x43, 0xc9, 0xc5, 0xe6, 0xfe, 0xfe, 0xb3, 0x62,
-	0x0a, 0xd6, 0xde, 0xea, 0x5f, 0x14, 0x35, 0x05, 0xeb, 0x80, 0x26, 0xe6, 0xf3, 0xf2, 0xe4, 0x7c,
-	0x7e, 0x1f, 0x20, 0x15, 0x34, 0x62, 0x21, 0x91, 0x34, 0x3b, 0xeb, 0x91, 0x19, 0x53, 0x75, 0x3f,
-	0x07, 0x78, 0xa9, 0x7e, 0x47, 0x74, 0x7b, 0xaa, 0xcc, 0x24, 0x62, 0xf8, 0xcf, 0xe2, 0xdb, 0x2f,
-	0x87, 0xbf, 0x2f, 0x37, 0x60, 0x29, 0x8d, 0x49, 0x48, 0x3b, 0x3c, 0x8e, 0xa8, 0x08, 0x24, 0x39,
-	0xc5, 0x6c, 0xb5, 0xfd, 0xe6, 0x98, 0xf8, 0x88, 0x9c, 0x7a, 0xbf, 0x1a, 0x60, 0x1d, 0xc6, 0x24,
-	0xd9, 0xe7, 0x11, 0x8e, 0x6a, 0x3d, 0x8c, 0x38, 0x20, 0x49, 0x92, 0xbd, 0xa5, 0x25, 0x8e, 0x78,
-	0x51, 0xe4, 0x69, 0x9b, 0xcd, 0x24, 0xc9, 0xdc, 0x07, 0x13, 0xd1, 0xbe, 0xbd, 0xaf, 0x2b, 0xd3,
-	0xb1, 0x78, 0x57, 0xc1, 0xe1, 0xb9, 0x4c, 0x73, 0x19, 0x0c, 0xa8, 0x54, 0x74, 0x99, 0xab, 0xa6,
-	0xdf, 0xd4, 0xf2, 0xaf, 0x34, 0xa3, 0x99, 0xba, 0xa1, 0x84, 0x47, 0xf4, 0xe6, 0x6f, 0x06, 0x2c,
-	0xe8, 0x26, 0x37, 0xf9, 0x14, 0x2f, 0x41, 0x7d, 0x57, 0x50, 0x22, 0xa9, 0x38, 0xea, 0x90, 0xc4,
-	0x31, 0x5c, 0x07, 0x1a, 0x85, 0xe0, 0xf1, 0xcb, 0x9c, 0xc4, 0x4e, 0xd9, 0x6d, 0x80, 0xf5, 0x84,
-	0x66, 0x19, 0xee, 0x9b, 0xf8, 0x56, 0xd3, 0x2c, 0xd3, 0x9b, 0x15, 0xd7, 0x86, 0xaa, 0x5e, 0x56,
-	0x95, 0xde, 0x3e, 0x97, 0xfa, 0x6b, 0x41, 0x01, 0x1f, 0x0a, 0x7a, 0xc2, 0x5e, 0x3f, 0x25, 0x32,
-	0xec, 0x38, 0x35, 0x05, 0x7c, 0xc8, 0x33, 0x39, 0x94, 0x58, 0xca, 0x56, 0x2f, 0x6d, 0xb5, 0xc4,
-	0x42, 0x71, 0xc0, 0x5d, 0x80, 0x72, 0x2b, 0x71, 0xea, 0x4a, 0xb4, 0xcf, 0x65, 0x2b, 0x71, 0x1a,
-	0x37, 0x77, 0xa1, 0x3e, 0xf6, 0x36, 0xa8, 0x00, 0x9e, 0x27, 0x2f, 0x12, 0xfe, 0x2a, 0xd1, 0x03,
-	0xd1, 0x66, 0xa4, 0x86, 0x88, 0x1a, 0x98, 0xcf, 0xf2, 0xb6, 0x53, 0x56, 0x8b, 0xa7, 0x79, 0xec,
-	0x98, 0x6a, 0xb1, 0xc3, 0x7a, 0x4e, 0x05, 0x25, 0x3c, 0x72, 0xaa, 0x5b, 0x77, 0xbe, 0xbd, 0x7d,
-	0xca, 0x64, 0x27, 0x6f, 0xaf, 0x85, 0xbc, 0xbb, 0xae, 0xa9, 0xbe, 0xc5, 0x78, 0xb1, 0x5a, 0x67,
-	0x89, 0xa4, 0x22, 0x21, 0xf1, 0x3a, 0xb2, 0xbf, 0xae, 0xd8, 0x4f, 0xdb, 0xed, 0x05, 0xfc, 0xba,
-	0xf3, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x23, 0x5b, 0x87, 0xb6, 0x0f, 0x00, 0x00,
+	// 1645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcb, 0x73, 0x1c, 0x49,
+	0xd1, 0x9f, 0x9e, 0x9e, 0x47, 0x4f, 0xce, 0x68, 0xd4, 0xaa, 0x2f, 0xe2, 0x63, 0xd6, 0x66, 0x57,
+	0xda, 0xc6, 0xb1, 0x88, 0x05, 0xcb, 0xec, 0x03, 0x6f, 0xec, 0x2e, 0x2f, 0x3d, 0x6c, 0x6b, 0x02,
+	0x5b, 0x12, 0x6d, 0xaf, 0x82, 0xe0, 0xd2, 0x51, 0xd3, 0x5d, 0xd2, 0x54, 0xb8, 0xa6, 0xba, 0x5d,
+	0x5d, 0x3d, 0xeb, 0x39, 0x73, 0x27, 0x82, 0x0b, 0x37, 0xce, 0x5c, 0x09, 0x6e, 0x70, 0xe1, 0xc8,
+	0x85, 0x03, 0x47, 0xee, 0x9c, 0xf9, 0x1f, 0x88, 0xca, 0xea, 0x79, 0x99, 0x91, 0x35, 0x0a, 0x14,
+	0xc1, 0x2d, 0xf3, 0x57, 0x95, 0x59, 0x99, 0xbf, 0xca, 0xaa, 0xca, 0x02, 0xc8, 0x04, 0x95, 0x7b,
+	0x99, 0x4a, 0x75, 0x4a, 0xb6, 0x46, 0x5c, 0x8c, 0x8b, 0xdc, 0x6a, 0x7b, 0x66, 0xe0, 0x4e, 0x27,
+	0x8f, 0x87, 0x6c, 0x44, 0x2d, 0x14, 0xfc, 0xc6, 0x81, 0xce, 0x13, 0x26, 0x99, 0xe2, 0xf1, 0x39,
+	0x15, 0x05, 0x23, 0x77, 0xc1, 0x1b, 0xa4, 0xa9, 0x88, 0xc6, 0x54, 0xf4, 0x9c, 0x1d, 0x67, 0xd7,
+	0x3b, 0xae, 0x84, 0x4d, 0x83, 0x9c, 0x53, 0x41, 0xde, 0x85, 0x16, 0x97, 0xfa, 0xe1, 0xa7, 0x38,
+	0x5a, 0xdd, 0x71, 0x76, 0xdd, 0xe3, 0x4a, 0xe8, 0x21, 0x54, 0x0e, 0x5f, 0x88, 0x94, 0x6a, 0x1c,
+	0x76, 0x77, 0x9c, 0x5d, 0xc7, 0x0c, 0x23, 0x64, 0x86, 0xb7, 0x01, 0x72, 0xad, 0xb8, 0xbc, 0xc4,
+	0xf1, 0xda, 0x8e, 0xb3, 0xdb, 0x3a, 0xae, 0x84, 0x2d, 0x8b, 0x9d, 0x53, 0x71, 0x50, 0x07, 0x77,
+	0x4c, 0x45, 0xf0, 0x2f, 0x07, 0x5a, 0x3f, 0x2f, 0x98, 0x9a, 0xf4, 0xe5, 0x45, 0x4a, 0x08, 0xd4,
+	0x74, 0x9a, 0xbd, 0xc4, 0x60, 0xdc, 0x10, 0x65, 0xb2, 0x0d, 0xed, 0x11, 0xd3, 0x8a, 0xc7, 0x91,
+	0x9e, 0x64, 0x0c, 0x97, 0x6a, 0x85, 0x60, 0xa1, 0x17, 0x93, 0x8c, 0x91, 0x6f, 0xc1, 0x46, 0xce,
+	0xa8, 0x8a, 0x87, 0x51, 0x46, 0x15, 0x1d, 0xe5, 0x76, 0xb5, 0xb0, 0x63, 0xc1, 0x33, 0xc4, 0xcc,
+	0x24, 0x95, 0x16, 0x32, 0x89, 0x12, 0x16, 0xf3, 0x11, 0x15, 0xbd, 0x3a, 0x2e, 0xd1, 0x41, 0xf0,
+	0xc8, 0x62, 0xe4, 0x03, 0xd8, 0xe4, 0x79, 0xa4, 0xa8, 0xbc, 0x64, 0x91, 0xb5, 0xee, 0x35, 0x0c,
+	0x2d, 0xe1, 0x06, 0xcf, 0x43, 0x83, 0x3e, 0x47, 0x90, 0xfc, 0x3f, 0x34, 0x14, 0x4d, 0x78, 0x91,
+	0xf7, 0x9a, 0x3b, 0xce, 0x6e, 0x35, 0x2c, 0x35, 0xf2, 0x3e, 0x74, 0xac, 0xf1, 0x05, 0x17, 0x9a,
+	0xa9, 0x9e, 0x87, 0xa3, 0x6d, 0xc4, 0x1e, 0x23, 0x14, 0xfc, 0xd5, 0x01, 0x38, 0x4c, 0x45, 0x31,
+	0x92, 0x98, 0xf0, 0x3b, 0xe0, 0x5d, 0x70, 0x26, 0x92, 0x88, 0x27, 0x65, 0xd2, 0x4d, 0xd4, 0xfb,
+	0x09, 0xf9, 0x02, 0x5a, 0x09, 0xd5, 0xd4, 0x66, 0x6d, 0xf8, 0xef, 0x7e, 0xfc, 0xee, 0xde, 0xd2,
+	0x16, 0x97, 0x9b, 0x7b, 0x44, 0x35, 0x35, 0x44, 0x84, 0x5e, 0x52, 0x4a, 0xe4, 0x1e, 0x74, 0x79,
+	0x1e, 0x65, 0x8a, 0x8f, 0xa8, 0x9a, 0x44, 0x2f, 0xd9, 0x04, 0x69, 0xf3, 0xc2, 0x0e, 0xcf, 0xcf,
+	0x2c, 0xf8, 0x33, 0x36, 0x21, 0x77, 0xa1, 0xc5, 0xf3, 0x88, 0x16, 0x3a, 0xed, 0x1f, 0x21, 0x69,
+	0x5e, 0xe8, 0xf1, 0x7c, 0x1f, 0x75, 0x43, 0xbb, 0x64, 0xb9, 0x66, 0x49, 0x94, 0x51, 0x3d, 0xec,
+	0xd5, 0x77, 0x5c, 0x43, 0xbb, 0x85, 0xce, 0xa8, 0x1e, 0x06, 0x3f, 0x99, 0x26, 0xf2, 0xe8, 0x75,
+	0xa6, 0xc8, 0x47, 0x50, 0xe3, 0xf2, 0x22, 0xc5, 0x24, 0xda, 0x6f, 0x06, 0x8a, 0x45, 0x3a, 0xcf,
+	0x3a, 0xc4, 0xa9, 0xc1, 0x01, 0xb4, 0xb0, 0x0c, 0xd1, 0xfe, 0x07, 0x50, 0x1f, 0x1b, 0xa5, 0x74,
+	0xb0, 0xbd, 0xc2, 0xc1, 0x62, 0xe9, 0x86, 0x76, 0x76, 0xf0, 0x47, 0x07, 0xba, 0x5f, 0x49, 0xaa,
+	0x26, 0xb8, 0x3d, 0xe8, 0xe9, 0xc7, 0xd0, 0x8e, 0x71, 0xa9, 0x68, 0xfd, 0x80, 0x20, 0x9e, 0x6f,
+	0xc9, 0x77, 0xa0, 0x9a, 0x66, 0x25, 0xe1, 0xef, 0xac, 0x30, 0x3b, 0xcd, 0x90, 0xec, 0x6a, 0x9a,
+	0xcd, 0x83, 0x76, 0x6f, 0x14, 0xf4, 0xef, 0xab, 0xb0, 0x79, 0xc0, 0x6f, 0x37, 0xea, 0x6f, 0xc3,
+	0xa6, 0x48, 0xbf, 0x66, 0x2a, 0xe2, 0x32, 0x16, 0x45, 0xce, 0xc7, 0xb6, 0x66, 0xbc, 0xb0, 0x8b,
+	0x70, 0x7f, 0x8a, 0x9a, 0x89, 0x45, 0x96, 0x2d, 0x4d, 0xb4, 0xb5, 0xd1, 0x45, 0x78, 0x3e, 0xf1,
+	0xa7, 0xd0, 0xb6, 0x1e, 0x6d, 0x8a, 0xb5, 0xf5, 0x52, 0x04, 0xb4, 0x41, 0xd9, 0x78, 0xb0, 0x4b,
+	0x59, 0x0f, 0xf5, 0x35, 0x3d, 0xa0, 0x0d, 0xca, 0xc1, 0xdf, 0x1c, 0x68, 0x1f, 0xa6, 0xa3, 0x8c,
+	0x2a, 0xcb, 0xd2, 0x13, 0xf0, 0x05, 0xbb, 0xd0, 0xd1, 0x8d, 0xa9, 0xea, 0x1a, 0xb3, 0xb9, 0x4e,
+	0xfa, 0xb0, 0xa5, 0xf8, 0xe5, 0x70, 0xd9, 0x53, 0x75, 0x1d, 0x4f, 0x9b, 0x68, 0x77, 0xf8, 0x66,
+	0xbd, 0xb8, 0x6b, 0xd4, 0x4b, 0xf0, 0x2b, 0x07, 0xbc, 0x17, 0x4c, 0x8d, 0x6e, 0x65, 0xc7, 0x3f,
+	0x83, 0x06, 0xf2, 0x9a, 0xf7, 0xaa, 0x3b, 0xee, 0x3a, 0xc4, 0x96, 0xd3, 0x83, 0xdf, 0x56, 0x61,
+	0x6b, 0x5f, 0x29, 0x3a, 0x39, 0x4c, 0xa5, 0xa6, 0x5c, 0xe6, 0xb7, 0x12, 0xce, 0x97, 0xe0, 0x31,
+	0xc1, 0x46, 0x4c, 0xea, 0xb5, 0x03, 0x9a, 0x19, 0x90, 0x1f, 0x2e, 0x70, 0xf8, 0xbd, 0x15, 0x66,
+	0xff, 0x11, 0xae, 0x45, 0x4e, 0x33, 0xa4, 0xf5, 0x31, 0x34, 0x4b, 0x95, 0xb4, 0xa1, 0xd9, 0x97,
+	0x63, 0x2a, 0x78, 0xe2, 0x57, 0x48, 0x07, 0xbc, 0xa9, 0x8d, 0xef, 0x90, 0x4d, 0x68, 0x4f, 0xb5,
+	0x7d, 0x21, 0xfc, 0xea, 0x12, 0x20, 0x27, 0xbe, 0x1b, 0xfc, 0xc1, 0x01, 0xef, 0xa4, 0x10, 0xe2,
+	0x56, 0xf8, 0xf8, 0x78, 0xe1, 0x1a, 0x09, 0x56, 0x98, 0x4d, 0x17, 0x42, 0xa1, 0x4c, 0xe4, 0xfb,
+	0xd0, 0xb0, 0xda, 0x72, 0x1e, 0x00, 0x8d, 0x7e, 0x6e, 0x06, 0x7c, 0x87, 0x6c, 0x40, 0xab, 0x9f,
+	0x9f, 0xa4, 0x1a, 0xd5, 0xaa, 0x79, 0xd2, 0x5b, 0x78, 0xff, 0x61, 0xcc, 0x9f, 0xe2, 0x9a, 0x0e,
+	0xae, 0x79, 0x6f, 0xc5, 0x9a, 0xb3, 0x99, 0x56, 0xb2, 0xab, 0x92, 0xfb, 0x50, 0x8f, 0x87, 0x5c,
+	0x24, 0x65, 0xfd, 0x7f, 0x63, 0x85, 0xa1, 0xb1, 0x09, 0xed, 0xac, 0x60, 0x1b, 0x9a, 0xa5, 0xf5,
+	0x72, 0x94, 0x4d, 0x70, 0x4f, 0x52, 0xed, 0x3b, 0xc1, 0x3f, 0x1c, 0x00, 0x7b, 0xbd, 0x61, 0x50,
+	0x0f, 0x17, 0x82, 0xfa, 0x60, 0x85, 0xef, 0xf9, 0xd4, 0x52, 0x2c, 0xc3, 0xfa, 0x2e, 0xd4, 0xcc,
+	0xa1, 0xbd, 0x2e, 0x2a, 0x9c, 0x64, 0x72, 0xc0, 0x73, 0xd9, 0x73, 0xdf, 0x3e, 0xdb, 0xce, 0x0a,
+	0x1e, 0x82, 0x77, 0xc0, 0x57, 0x25, 0xd1, 0x05, 0x78, 0x9a, 0x5e, 0xf2, 0x98, 0x8a, 0x7d, 0x99,
+	0x58, 0xba, 0x4b, 0xfd, 0x54, 0xf9, 0xd5, 0xe0, 0xef, 0x0e, 0x6c, 0x58, 0xc3, 0x7d, 0xc5, 0xf5,
+	0xf0, 0x34, 0xfb, 0xaf, 0xcb, 0xe4, 0x73, 0xf0, 0xa8, 0x71, 0x15, 0xcd, 0x8a, 0xe5, 0xbd, 0x95,
+	0xf5, 0x8f, 0xab, 0xe1, 0x45, 0xd2, 0xa4, 0xe5, 0xd2, 0x47, 0xb0, 0x61, 0xef, 0xb0, 0x34, 0x63,
+	0x8a, 0xca, 0x64, 0xdd, 0x57, 0xa8, 0x83, 0x56, 0xa7, 0xd6, 0x28, 0xf8, 0x9d, 0x33, 0x7d, 0x8c,
+	0x70, 0x11, 0xdc, 0xb2, 0x29, 0xf5, 0xce, 0x8d, 0xa8, 0xaf, 0xae, 0x43, 0x3d, 0xd9, 0x5b, 0x38,
+	0xea, 0xd7, 0xa5, 0x6a, 0xce, 0xc4, 0x5f, 0xaa, 0x70, 0x67, 0x89, 0xf2, 0x47, 0x63, 0x2a, 0x6e,
+	0xef, 0xdd, 0xfc, 0x5f, 0xf3, 0x5f, 0x3e, 0x1f, 0xb5, 0x1b, 0xb5, 0x1b, 0xf5, 0x1b, 0xb5, 0x1b,
+	0xbf, 0x6e, 0x42, 0x0d, 0xb9, 0xfa, 0x02, 0x5a, 0x9a, 0xa9, 0x51, 0xc4, 0x5e, 0x67, 0xaa, 0x64,
+	0xea, 0xee, 0x0a, 0x1f, 0xd3, 0x17, 0xca, 0xf4, 0xf3, 0xba, 0x94, 0xc9, 0x8f, 0x00, 0x0a, 0xb3,
+	0x09, 0xd6, 0xd8, 0x6e, 0xf5, 0x37, 0xdf, 0x76, 0xc5, 0x98, 0x6e, 0xbf, 0x98, 0x2a, 0xa6, 0x15,
+	0x18, 0xf0, 0xb9, 0xbd, 0x7b, 0xe5, 0x36, 0xcd, 0x6f, 0x83, 0xe3, 0x4a, 0x08, 0x83, 0x99, 0x46,
+	0x0e, 0xa1, 0x13, 0xdb, 0x4e, 0xc0, 0xba, 0xb0, 0xfd, 0xc8, 0x7b, 0x2b, 0x77, 0x7a, 0xd6, 0x30,
+	0x1c, 0x57, 0xc2, 0x76, 0x3c, 0x57, 0xc9, 0x33, 0xf0, 0x6d, 0x16, 0xb6, 0x4d, 0x47, 0x47, 0x96,
+	0xcc, 0xf7, 0xaf, 0xca, 0x65, 0x56, 0x6a, 0xc7, 0x95, 0xb0, 0x5b, 0x2c, 0x21, 0xe4, 0x0c, 0xb6,
+	0x06, 0xfc, 0x4d, 0x7f, 0x0d, 0xf4, 0x17, 0x5c, 0x99, 0xdb, 0xa2, 0xc3, 0xcd, 0xc1, 0x32, 0x44,
+	0x34, 0x6c, 0x97, 0x1e, 0xa7, 0x55, 0x19, 0xb1, 0x31, 0x15, 0x8b, 0xfe, 0x9b, 0xe8, 0xff, 0xfe,
+	0x95, 0xfe, 0x57, 0x1d, 0x93, 0xe3, 0x4a, 0x78, 0x67, 0x70, 0xf5, 0x21, 0x9a, 0xe7, 0x61, 0x57,
+	0xc5, 0x75, 0xbc, 0x6b, 0xf2, 0x98, 0x5d, 0x17, 0xf3, 0x3c, 0x66, 0x90, 0x29, 0x17, 0x2c, 0x3e,
+	0xeb, 0xaa, 0x75, 0x65, 0xb9, 0xcc, 0x3e, 0x00, 0xa6, 0x5c, 0xc6, 0x53, 0xc5, 0x94, 0x4b, 0x79,
+	0xaa, 0xd1, 0x1e, 0xae, 0x39, 0xd5, 0xd3, 0x72, 0x89, 0x67, 0x1a, 0x39, 0x87, 0xff, 0xa3, 0xa6,
+	0x27, 0x88, 0xe2, 0xf2, 0x89, 0xb7, 0x9e, 0xda, 0xe8, 0xe9, 0xde, 0x3a, 0x2d, 0xc6, 0x71, 0x25,
+	0xdc, 0xa2, 0x6f, 0x82, 0xe6, 0x0c, 0xc9, 0x42, 0x08, 0xeb, 0xad, 0x73, 0xe5, 0x19, 0x9a, 0xbe,
+	0xee, 0xe6, 0x0c, 0xc9, 0x52, 0x3e, 0x68, 0x40, 0xcd, 0x98, 0x05, 0xff, 0x74, 0x00, 0xce, 0x59,
+	0xac, 0x53, 0xb5, 0x7f, 0x72, 0xf2, 0xbc, 0xfc, 0x86, 0x59, 0x06, 0x7b, 0xce, 0xf4, 0x1b, 0x66,
+	0x49, 0x5e, 0xfa, 0x20, 0x56, 0x97, 0x3f, 0x88, 0x9f, 0x01, 0x64, 0x8a, 0x25, 0x3c, 0xa6, 0x9a,
+	0xe5, 0xd7, 0x3d, 0x7c, 0x0b, 0x53, 0xc9, 0x97, 0x00, 0xaf, 0xcc, 0x97, 0xdb, 0x5e, 0x99, 0xb5,
+	0x2b, 0x37, 0x67, 0xf6, 0x2f, 0x0f, 0x5b, 0xaf, 0xa6, 0xa2, 0xf9, 0x3f, 0x64, 0x82, 0xc6, 0x6c,
+	0x98, 0x8a, 0x84, 0xa9, 0x48, 0xd3, 0x4b, 0x3c, 0x41, 0xad, 0xb0, 0xbb, 0x00, 0xbf, 0xa0, 0x97,
+	0xc1, 0x9f, 0x1c, 0xf0, 0xce, 0x04, 0x95, 0x27, 0x69, 0x82, 0x5f, 0x81, 0x31, 0x66, 0x1c, 0x51,
+	0x29, 0xf3, 0xb7, 0x5c, 0xd3, 0x73, 0x5e, 0xcc, 0x86, 0x5a, 0x9b, 0x7d, 0x29, 0x73, 0xf2, 0xf9,
+	0x52, 0xb6, 0x6f, 0x7f, 0x6b, 0x8c, 0xe9, 0x42, 0xbe, 0xbb, 0xe0, 0xa7, 0x85, 0xce, 0x0a, 0x1d,
+	0x4d, 0xa9, 0x34, 0x74, 0xb9, 0xbb, 0x6e, 0xd8, 0xb5, 0xf8, 0x63, 0xcb, 0x68, 0x6e, 0x76, 0x48,
+	0xa6, 0x09, 0xfb, 0xf0, 0xcf, 0x0e, 0x34, 0xec, 0xc5, 0xbb, 0xdc, 0x1e, 0x6c, 0x42, 0xfb, 0x89,
+	0x62, 0x54, 0x33, 0xf5, 0x62, 0x48, 0xa5, 0xef, 0x10, 0x1f, 0x3a, 0x25, 0xf0, 0xe8, 0x55, 0x41,
+	0x4d, 0x57, 0xd9, 0x01, 0xef, 0x29, 0xcb, 0x73, 0x1c, 0x77, 0xb1, 0x7f, 0x60, 0x79, 0x6e, 0x07,
+	0x6b, 0xa4, 0x05, 0x75, 0x2b, 0xd6, 0xcd, 0xbc, 0x93, 0x54, 0x5b, 0xad, 0x61, 0x1c, 0x9f, 0x29,
+	0x76, 0xc1, 0x5f, 0x3f, 0xa3, 0x3a, 0x1e, 0xfa, 0x4d, 0xe3, 0xf8, 0x2c, 0xcd, 0xf5, 0x0c, 0xf1,
+	0x8c, 0xad, 0x15, 0x5b, 0x46, 0xc4, 0xc3, 0xeb, 0x03, 0x69, 0x40, 0xb5, 0x2f, 0xfd, 0xb6, 0x81,
+	0x4e, 0x52, 0xdd, 0x97, 0x7e, 0xe7, 0xc3, 0x5f, 0x40, 0x7b, 0xe1, 0xbd, 0x32, 0x09, 0x7c, 0x25,
+	0x5f, 0xca, 0xf4, 0x6b, 0x69, 0x9b, 0xb4, 0xfd, 0xc4, 0x34, 0x36, 0x4d, 0x70, 0x9f, 0x17, 0x03,
+	0xbf, 0x6a, 0x84, 0x67, 0x85, 0xf0, 0x5d, 0x23, 0x1c, 0xf1, 0xb1, 0x5f, 0x43, 0x24, 0x4d, 0xfc,
+	0xba, 0x09, 0x0a, 0x4f, 0xc5, 0x53, 0x26, 0x2f, 0xf5, 0xd0, 0x6f, 0x1c, 0x7c, 0xf2, 0xcb, 0x8f,
+	0x2e, 0xb9, 0x1e, 0x16, 0x83, 0xbd, 0x38, 0x1d, 0x3d, 0xb0, 0xdc, 0xdf, 0xe7, 0x69, 0x29, 0x3d,
+	0xe0, 0x52, 0x33, 0x25, 0xa9, 0x78, 0x80, 0xdb, 0xf1, 0xc0, 0x6c, 0x47, 0x36, 0x18, 0x34, 0x50,
+	0xfb, 0xe4, 0xdf, 0x03, 0x00, 0x5a, 0xe8, 0xed, 0xad, 0xab, 0x12, 0x00, 0x00,
 }
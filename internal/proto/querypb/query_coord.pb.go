@@ -1436,23 +1436,28 @@ func (m *UnsubDmChannelRequest) GetChannelName() string {
 }
 
 type SegmentLoadInfo struct {
-	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	PartitionID          int64                   `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	CollectionID         int64                   `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	DbID                 int64                   `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	FlushTime            int64                   `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
-	BinlogPaths          []*datapb.FieldBinlog   `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
-	NumOfRows            int64                   `protobuf:"varint,7,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	Statslogs            []*datapb.FieldBinlog   `protobuf:"bytes,8,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
-	Deltalogs            []*datapb.FieldBinlog   `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	CompactionFrom       []int64                 `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
-	IndexInfos           []*FieldIndexInfo       `protobuf:"bytes,11,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
-	SegmentSize          int64                   `protobuf:"varint,12,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
-	InsertChannel        string                  `protobuf:"bytes,13,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
-	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,14,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	SegmentID      int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	PartitionID    int64                   `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	CollectionID   int64                   `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	DbID           int64                   `protobuf:"varint,4,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	FlushTime      int64                   `protobuf:"varint,5,opt,name=flush_time,json=flushTime,proto3" json:"flush_time,omitempty"`
+	BinlogPaths    []*datapb.FieldBinlog   `protobuf:"bytes,6,rep,name=binlog_paths,json=binlogPaths,proto3" json:"binlog_paths,omitempty"`
+	NumOfRows      int64                   `protobuf:"varint,7,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	Statslogs      []*datapb.FieldBinlog   `protobuf:"bytes,8,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
+	Deltalogs      []*datapb.FieldBinlog   `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	CompactionFrom []int64                 `protobuf:"varint,10,rep,packed,name=compactionFrom,proto3" json:"compactionFrom,omitempty"`
+	IndexInfos     []*FieldIndexInfo       `protobuf:"bytes,11,rep,name=index_infos,json=indexInfos,proto3" json:"index_infos,omitempty"`
+	SegmentSize    int64                   `protobuf:"varint,12,opt,name=segment_size,json=segmentSize,proto3" json:"segment_size,omitempty"`
+	InsertChannel  string                  `protobuf:"bytes,13,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
+	StartPosition  *internalpb.MsgPosition `protobuf:"bytes,14,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
+	StorageVersion int64                   `protobuf:"varint,15,opt,name=storage_version,json=storageVersion,proto3" json:"storage_version,omitempty"`
+	// the rows and deletions after it are filtered out if it's not 0.
+	MaxRowTimestamp uint64 `protobuf:"varint,16,opt,name=max_row_timestamp,json=maxRowTimestamp,proto3" json:"max_row_timestamp,omitempty"`
+	// the rows at or before it are filtered out if it's not 0.
+	MinRowTimestamp      uint64   `protobuf:"varint,17,opt,name=min_row_timestamp,json=minRowTimestamp,proto3" json:"min_row_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SegmentLoadInfo) Reset()         { *m = SegmentLoadInfo{} }
@@ -1578,6 +1583,27 @@ func (m *SegmentLoadInfo) GetStartPosition() *internalpb.MsgPosition {
 	return nil
 }
 
+func (m *SegmentLoadInfo) GetStorageVersion() int64 {
+	if m != nil {
+		return m.StorageVersion
+	}
+	return 0
+}
+
+func (m *SegmentLoadInfo) GetMaxRowTimestamp() uint64 {
+	if m != nil {
+		return m.MaxRowTimestamp
+	}
+	return 0
+}
+
+func (m *SegmentLoadInfo) GetMinRowTimestamp() uint64 {
+	if m != nil {
+		return m.MinRowTimestamp
+	}
+	return 0
+}
+
 type FieldIndexInfo struct {
 	FieldID int64 `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	// deprecated
@@ -3734,241 +3760,244 @@ func init() {
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 3736 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x49, 0x6c, 0x24, 0x59,
-	0x56, 0x15, 0xb9, 0xd8, 0x99, 0x2f, 0x17, 0x87, 0xbf, 0xab, 0x5c, 0x39, 0x39, 0xd5, 0xd5, 0xee,
-	0xa8, 0xae, 0x6e, 0xe3, 0x9e, 0xb6, 0x7b, 0x5c, 0x33, 0x4d, 0x0d, 0x33, 0xa3, 0xa1, 0xca, 0x9e,
-	0x72, 0x9b, 0xee, 0xf2, 0x98, 0xc8, 0xaa, 0x02, 0xb5, 0x9a, 0xc9, 0x89, 0xcc, 0xf8, 0x99, 0x0e,
-	0x55, 0x2c, 0x59, 0x11, 0x91, 0xae, 0x76, 0x73, 0xe5, 0x32, 0x23, 0x40, 0x82, 0x03, 0x27, 0xc4,
-	0x09, 0x24, 0x90, 0x68, 0xc4, 0x01, 0x6e, 0x1c, 0x90, 0x90, 0xe0, 0x86, 0xb8, 0x71, 0xe4, 0x8a,
-	0x04, 0x12, 0x12, 0xd2, 0x1c, 0x38, 0x20, 0xa1, 0xbf, 0xc5, 0xfa, 0xc3, 0x19, 0x65, 0x77, 0xf5,
-	0x82, 0xe6, 0x96, 0xf1, 0xfe, 0xf2, 0xde, 0x7f, 0xfb, 0x7b, 0xff, 0x27, 0xac, 0x3e, 0x9b, 0x63,
-	0xff, 0x6c, 0x38, 0xf6, 0x3c, 0xdf, 0xdc, 0x9e, 0xf9, 0x5e, 0xe8, 0x21, 0xe4, 0x58, 0xf6, 0xe9,
-	0x3c, 0x60, 0x5f, 0xdb, 0x74, 0xbc, 0xdf, 0x1e, 0x7b, 0x8e, 0xe3, 0xb9, 0x0c, 0xd6, 0x6f, 0x27,
-	0x67, 0xf4, 0xbb, 0x96, 0x1b, 0x62, 0xdf, 0x35, 0x6c, 0x31, 0x1a, 0x8c, 0x4f, 0xb0, 0x63, 0xf0,
-	0x2f, 0xd5, 0x34, 0x42, 0x23, 0xb9, 0xbf, 0xf6, 0x3b, 0x0a, 0xac, 0x0f, 0x4e, 0xbc, 0xe7, 0x7b,
-	0x9e, 0x6d, 0xe3, 0x71, 0x68, 0x79, 0x6e, 0xa0, 0xe3, 0x67, 0x73, 0x1c, 0x84, 0xe8, 0x1d, 0xa8,
-	0x8d, 0x8c, 0x00, 0xf7, 0x94, 0x0d, 0x65, 0xb3, 0xb5, 0x7b, 0x63, 0x3b, 0x45, 0x09, 0x27, 0xe1,
-	0x61, 0x30, 0xbd, 0x6f, 0x04, 0x58, 0xa7, 0x33, 0x11, 0x82, 0x9a, 0x39, 0x3a, 0xdc, 0xef, 0x55,
-	0x36, 0x94, 0xcd, 0xaa, 0x4e, 0x7f, 0xa3, 0xd7, 0xa1, 0x33, 0x8e, 0xf6, 0x3e, 0xdc, 0x0f, 0x7a,
-	0xd5, 0x8d, 0xea, 0x66, 0x55, 0x4f, 0x03, 0xb5, 0x7f, 0x53, 0xe0, 0x7a, 0x8e, 0x8c, 0x60, 0xe6,
-	0xb9, 0x01, 0x46, 0x77, 0x60, 0x29, 0x08, 0x8d, 0x70, 0x1e, 0x70, 0x4a, 0xbe, 0x2e, 0xa5, 0x64,
-	0x40, 0xa7, 0xe8, 0x7c, 0x6a, 0x1e, 0x6d, 0x45, 0x82, 0x16, 0x7d, 0x13, 0xae, 0x5a, 0xee, 0x43,
-	0xec, 0x78, 0xfe, 0xd9, 0x70, 0x86, 0xfd, 0x31, 0x76, 0x43, 0x63, 0x8a, 0x05, 0x8d, 0x6b, 0x62,
-	0xec, 0x38, 0x1e, 0x42, 0xef, 0xc2, 0x75, 0x26, 0xa5, 0x00, 0xfb, 0xa7, 0xd6, 0x18, 0x0f, 0x8d,
-	0x53, 0xc3, 0xb2, 0x8d, 0x91, 0x8d, 0x7b, 0xb5, 0x8d, 0xea, 0x66, 0x43, 0xbf, 0x46, 0x87, 0x07,
-	0x6c, 0xf4, 0x9e, 0x18, 0xd4, 0xfe, 0x4c, 0x81, 0x6b, 0xe4, 0x84, 0xc7, 0x86, 0x1f, 0x5a, 0x2f,
-	0x81, 0xcf, 0x1a, 0xb4, 0x93, 0x67, 0xeb, 0x55, 0xe9, 0x58, 0x0a, 0x46, 0xe6, 0xcc, 0x04, 0x7a,
-	0xc2, 0x93, 0x1a, 0x3d, 0x66, 0x0a, 0xa6, 0xfd, 0x29, 0x57, 0x88, 0x24, 0x9d, 0x97, 0x11, 0x44,
-	0x16, 0x67, 0x25, 0x8f, 0xf3, 0x02, 0x62, 0xd0, 0x7e, 0x56, 0x85, 0x6b, 0x1f, 0x78, 0x86, 0x19,
-	0x2b, 0xcc, 0xe7, 0xcf, 0xce, 0xef, 0xc3, 0x12, 0xb3, 0xae, 0x5e, 0x8d, 0xe2, 0xba, 0x9d, 0xc6,
-	0xc5, 0x2d, 0x2f, 0xa6, 0x70, 0x40, 0x01, 0x3a, 0x5f, 0x84, 0x6e, 0x43, 0xd7, 0xc7, 0x33, 0xdb,
-	0x1a, 0x1b, 0x43, 0x77, 0xee, 0x8c, 0xb0, 0xdf, 0xab, 0x6f, 0x28, 0x9b, 0x75, 0xbd, 0xc3, 0xa1,
-	0x47, 0x14, 0x88, 0x7e, 0x02, 0x9d, 0x89, 0x85, 0x6d, 0x73, 0x68, 0xb9, 0x26, 0xfe, 0xf8, 0x70,
-	0xbf, 0xb7, 0xb4, 0x51, 0xdd, 0x6c, 0xed, 0x7e, 0x77, 0x3b, 0xef, 0x19, 0xb6, 0xa5, 0x1c, 0xd9,
-	0x7e, 0x40, 0x96, 0x1f, 0xb2, 0xd5, 0x3f, 0x74, 0x43, 0xff, 0x4c, 0x6f, 0x4f, 0x12, 0xa0, 0xfe,
-	0x0f, 0x60, 0x35, 0x37, 0x05, 0xa9, 0x50, 0x7d, 0x8a, 0xcf, 0x28, 0x17, 0xab, 0x3a, 0xf9, 0x89,
-	0xae, 0x42, 0xfd, 0xd4, 0xb0, 0xe7, 0x98, 0xf3, 0x89, 0x7d, 0xfc, 0x4a, 0xe5, 0xae, 0xa2, 0xfd,
-	0xb1, 0x02, 0x3d, 0x1d, 0xdb, 0xd8, 0x08, 0xf0, 0x17, 0x29, 0x8f, 0x75, 0x58, 0x72, 0x3d, 0x13,
-	0x1f, 0xee, 0x53, 0x79, 0x54, 0x75, 0xfe, 0xa5, 0xfd, 0x8f, 0x02, 0x57, 0x0f, 0x70, 0x48, 0x14,
-	0xd3, 0x0a, 0x42, 0x6b, 0x1c, 0x59, 0xde, 0xf7, 0xa1, 0xea, 0xe3, 0x67, 0x9c, 0xb2, 0xb7, 0xd2,
-	0x94, 0x45, 0x7e, 0x54, 0xb6, 0x52, 0x27, 0xeb, 0xd0, 0x6b, 0xd0, 0x36, 0x1d, 0x7b, 0x38, 0x3e,
-	0x31, 0x5c, 0x17, 0xdb, 0x4c, 0xb5, 0x9b, 0x7a, 0xcb, 0x74, 0xec, 0x3d, 0x0e, 0x42, 0x37, 0x01,
-	0x02, 0x3c, 0x75, 0xb0, 0x1b, 0xc6, 0xae, 0x2f, 0x01, 0x41, 0x5b, 0xb0, 0x3a, 0xf1, 0x3d, 0x67,
-	0x18, 0x9c, 0x18, 0xbe, 0x39, 0xb4, 0xb1, 0x61, 0x62, 0x9f, 0x52, 0xdf, 0xd0, 0x57, 0xc8, 0xc0,
-	0x80, 0xc0, 0x3f, 0xa0, 0x60, 0x74, 0x07, 0xea, 0xc1, 0xd8, 0x9b, 0x61, 0xaa, 0x26, 0xdd, 0xdd,
-	0x57, 0x64, 0x0a, 0xb0, 0x6f, 0x84, 0xc6, 0x80, 0x4c, 0xd2, 0xd9, 0x5c, 0xed, 0xaf, 0xb8, 0x9d,
-	0x7c, 0xc9, 0xdd, 0x4e, 0xc2, 0x96, 0xea, 0x9f, 0x8d, 0x2d, 0x2d, 0x95, 0xb2, 0xa5, 0xe5, 0xf3,
-	0x6d, 0x29, 0xc7, 0xb5, 0x97, 0x6f, 0x4b, 0x7f, 0x1f, 0xdb, 0xd2, 0x97, 0x5d, 0x66, 0xb1, 0xbd,
-	0xd5, 0x53, 0xf6, 0xf6, 0x17, 0x0a, 0x7c, 0xed, 0x00, 0x87, 0x11, 0xf9, 0xc4, 0x7c, 0xf0, 0x97,
-	0x34, 0xdc, 0x7d, 0xaa, 0x40, 0x5f, 0x46, 0xeb, 0x65, 0x42, 0xde, 0x87, 0xb0, 0x1e, 0xe1, 0x18,
-	0x9a, 0x38, 0x18, 0xfb, 0xd6, 0x8c, 0x8a, 0x91, 0x7a, 0x88, 0xd6, 0xee, 0x2d, 0x99, 0xba, 0x65,
-	0x29, 0xb8, 0x16, 0x6d, 0xb1, 0x9f, 0xd8, 0x41, 0xfb, 0x3d, 0x05, 0xae, 0x11, 0x8f, 0xc4, 0x5d,
-	0x88, 0x3b, 0xf1, 0x2e, 0xce, 0xd7, 0xb4, 0x73, 0xaa, 0xe4, 0x9c, 0x53, 0x09, 0x1e, 0xd3, 0xfc,
-	0x31, 0x4b, 0xcf, 0x65, 0x78, 0xf7, 0x6d, 0xa8, 0x5b, 0xee, 0xc4, 0x13, 0xac, 0x7a, 0x55, 0xc6,
-	0xaa, 0x24, 0x32, 0x36, 0x5b, 0x73, 0x19, 0x15, 0xb1, 0xb7, 0xbc, 0x84, 0xba, 0x65, 0x8f, 0x5d,
-	0x91, 0x1c, 0xfb, 0x77, 0x15, 0xb8, 0x9e, 0x43, 0x78, 0x99, 0x73, 0x7f, 0x0f, 0x96, 0x68, 0x0c,
-	0x10, 0x07, 0x7f, 0x5d, 0x7a, 0xf0, 0x04, 0xba, 0x0f, 0xac, 0x20, 0xd4, 0xf9, 0x1a, 0xcd, 0x03,
-	0x35, 0x3b, 0x46, 0xa2, 0x13, 0x8f, 0x4c, 0x43, 0xd7, 0x70, 0x18, 0x03, 0x9a, 0x7a, 0x8b, 0xc3,
-	0x8e, 0x0c, 0x07, 0xa3, 0xaf, 0x41, 0x83, 0x98, 0xec, 0xd0, 0x32, 0x85, 0xf8, 0x97, 0xa9, 0x09,
-	0x9b, 0x01, 0x7a, 0x05, 0x80, 0x0e, 0x19, 0xa6, 0xe9, 0xb3, 0xc0, 0xd5, 0xd4, 0x9b, 0x04, 0x72,
-	0x8f, 0x00, 0xb4, 0x3f, 0x50, 0xa0, 0x4d, 0x1c, 0xe4, 0x43, 0x1c, 0x1a, 0x44, 0x0e, 0xe8, 0x3b,
-	0xd0, 0xb4, 0x3d, 0xc3, 0x1c, 0x86, 0x67, 0x33, 0x86, 0xaa, 0x9b, 0xe5, 0x75, 0xec, 0x55, 0x1f,
-	0x9d, 0xcd, 0xb0, 0xde, 0xb0, 0xf9, 0xaf, 0x32, 0xfc, 0xce, 0x99, 0x72, 0x55, 0x62, 0xca, 0xff,
-	0x58, 0x87, 0xf5, 0xdf, 0x30, 0xc2, 0xf1, 0xc9, 0xbe, 0x23, 0xe2, 0xef, 0xc5, 0x95, 0x20, 0xf6,
-	0x6d, 0x95, 0xa4, 0x6f, 0xfb, 0xcc, 0x7c, 0x67, 0xa4, 0xe7, 0x75, 0x99, 0x9e, 0x93, 0x32, 0x6d,
-	0xfb, 0x09, 0x17, 0x55, 0x42, 0xcf, 0x13, 0x61, 0x72, 0xe9, 0x22, 0x61, 0x72, 0x0f, 0x3a, 0xf8,
-	0xe3, 0xb1, 0x3d, 0x27, 0x32, 0xa7, 0xd8, 0x59, 0xfc, 0xbb, 0x29, 0xc1, 0x9e, 0x34, 0xb2, 0x36,
-	0x5f, 0x74, 0xc8, 0x69, 0x60, 0xa2, 0x76, 0x70, 0x68, 0xf4, 0x1a, 0x94, 0x8c, 0x8d, 0x22, 0x51,
-	0x0b, 0xfd, 0x60, 0xe2, 0x26, 0x5f, 0xe8, 0x06, 0x34, 0x79, 0x50, 0x3e, 0xdc, 0xef, 0x35, 0x29,
-	0xfb, 0x62, 0x00, 0x32, 0xa0, 0xc3, 0x3d, 0x10, 0xa7, 0x10, 0x28, 0x85, 0xdf, 0x93, 0x21, 0x90,
-	0x0b, 0x3b, 0x49, 0x79, 0xc0, 0x43, 0x74, 0x90, 0x00, 0x91, 0xd2, 0xd0, 0x9b, 0x4c, 0x6c, 0xcb,
-	0xc5, 0x47, 0x4c, 0xc2, 0x2d, 0x4a, 0x44, 0x1a, 0x88, 0x7a, 0xb0, 0x7c, 0x8a, 0xfd, 0xc0, 0xf2,
-	0xdc, 0x5e, 0x9b, 0x8e, 0x8b, 0xcf, 0xfe, 0x10, 0x56, 0x73, 0x28, 0x24, 0x21, 0xfe, 0x5b, 0xc9,
-	0x10, 0xbf, 0x98, 0xc7, 0x89, 0x14, 0xe0, 0xcf, 0x15, 0xb8, 0xf6, 0xd8, 0x0d, 0xe6, 0xa3, 0xe8,
-	0x6c, 0x5f, 0x8c, 0x1e, 0x67, 0x3d, 0x48, 0x2d, 0xe7, 0x41, 0xb4, 0x9f, 0xd6, 0x61, 0x85, 0x9f,
-	0x82, 0x88, 0x9b, 0xba, 0x82, 0x1b, 0xd0, 0x8c, 0x82, 0x08, 0x67, 0x48, 0x0c, 0x40, 0x1b, 0xd0,
-	0x4a, 0x18, 0x02, 0xa7, 0x2a, 0x09, 0x2a, 0x45, 0x9a, 0x48, 0x09, 0x6a, 0x89, 0x94, 0xe0, 0x15,
-	0x80, 0x89, 0x3d, 0x0f, 0x4e, 0x86, 0xa1, 0xe5, 0x60, 0x9e, 0x92, 0x34, 0x29, 0xe4, 0x91, 0xe5,
-	0x60, 0x74, 0x0f, 0xda, 0x23, 0xcb, 0xb5, 0xbd, 0xe9, 0x70, 0x66, 0x84, 0x27, 0x01, 0x2f, 0xa3,
-	0x64, 0x62, 0xa1, 0x09, 0xdc, 0x7d, 0x3a, 0x57, 0x6f, 0xb1, 0x35, 0xc7, 0x64, 0x09, 0xba, 0x09,
-	0x2d, 0x77, 0xee, 0x0c, 0xbd, 0xc9, 0xd0, 0xf7, 0x9e, 0x13, 0xe3, 0xa1, 0x28, 0xdc, 0xb9, 0xf3,
-	0xa3, 0x89, 0xee, 0x3d, 0x27, 0x4e, 0xbc, 0x49, 0xdc, 0x79, 0x60, 0x7b, 0xd3, 0xa0, 0xd7, 0x28,
-	0xb5, 0x7f, 0xbc, 0x80, 0xac, 0x36, 0xb1, 0x1d, 0x1a, 0x74, 0x75, 0xb3, 0xdc, 0xea, 0x68, 0x01,
-	0x7a, 0x03, 0xba, 0x63, 0xcf, 0x99, 0x19, 0x94, 0x43, 0x0f, 0x7c, 0xcf, 0xa1, 0x96, 0x53, 0xd5,
-	0x33, 0x50, 0xb4, 0x07, 0x2d, 0x9a, 0xfc, 0x72, 0xf3, 0x6a, 0x51, 0x3c, 0x9a, 0xcc, 0xbc, 0x12,
-	0x79, 0x2c, 0x51, 0x50, 0xb0, 0xc4, 0xcf, 0x80, 0x68, 0x86, 0xb0, 0xd2, 0xc0, 0xfa, 0x04, 0x73,
-	0x0b, 0x69, 0x71, 0xd8, 0xc0, 0xfa, 0x04, 0x93, 0x8c, 0xdc, 0x72, 0x03, 0xec, 0x87, 0xa2, 0x3e,
-	0xea, 0x75, 0xa8, 0xfa, 0x74, 0x18, 0x94, 0x2b, 0x36, 0x3a, 0x84, 0x6e, 0x10, 0x1a, 0x7e, 0x38,
-	0x9c, 0x79, 0x01, 0x55, 0x80, 0x5e, 0x97, 0xea, 0xb6, 0x56, 0x50, 0x8d, 0x3d, 0x0c, 0xa6, 0xc7,
-	0x7c, 0xa6, 0xde, 0xa1, 0x2b, 0xc5, 0xa7, 0xf6, 0x5f, 0x15, 0xe8, 0xa6, 0x69, 0x26, 0x46, 0xcc,
-	0xb2, 0x73, 0xa1, 0x88, 0xe2, 0x93, 0x9c, 0x00, 0xbb, 0xc6, 0xc8, 0xc6, 0xac, 0x14, 0xa0, 0x7a,
-	0xd8, 0xd0, 0x5b, 0x0c, 0x46, 0x37, 0x20, 0xfa, 0xc4, 0x38, 0x45, 0x95, 0xbf, 0x4a, 0xa9, 0x6f,
-	0x52, 0x08, 0x0d, 0x9e, 0x3d, 0x58, 0x16, 0x55, 0x04, 0xd3, 0x42, 0xf1, 0x49, 0x46, 0x46, 0x73,
-	0x8b, 0x62, 0x65, 0x5a, 0x28, 0x3e, 0xd1, 0x3e, 0xb4, 0xd9, 0x96, 0x33, 0xc3, 0x37, 0x1c, 0xa1,
-	0x83, 0xaf, 0x49, 0xed, 0xf8, 0x7d, 0x7c, 0xf6, 0x84, 0xb8, 0x84, 0x63, 0xc3, 0xf2, 0x75, 0x26,
-	0xb3, 0x63, 0xba, 0x0a, 0x6d, 0x82, 0xca, 0x76, 0x99, 0x58, 0x36, 0xe6, 0xda, 0xbc, 0x4c, 0x23,
-	0x74, 0x97, 0xc2, 0x1f, 0x58, 0x36, 0x66, 0x0a, 0x1b, 0x1d, 0x81, 0x4a, 0xa9, 0xc1, 0xf4, 0x95,
-	0x42, 0xa8, 0x8c, 0x6e, 0x41, 0x87, 0x0d, 0x0b, 0x4f, 0xc7, 0xdc, 0x31, 0xa3, 0xf1, 0x09, 0x83,
-	0xd1, 0x24, 0x61, 0xee, 0x30, 0x8d, 0x07, 0x76, 0x1c, 0x77, 0xee, 0x10, 0x7d, 0xd7, 0xfe, 0xb0,
-	0x06, 0x6b, 0xc4, 0xec, 0xb9, 0x07, 0xb8, 0x44, 0xb8, 0x7d, 0x05, 0xc0, 0x0c, 0xc2, 0x61, 0xca,
-	0x55, 0x35, 0xcd, 0x20, 0xe4, 0xce, 0xf8, 0x3b, 0x22, 0x5a, 0x56, 0x8b, 0x13, 0xe8, 0x8c, 0x1b,
-	0xca, 0x47, 0xcc, 0x0b, 0x35, 0x69, 0x6e, 0x41, 0x27, 0xf0, 0xe6, 0xfe, 0x18, 0x0f, 0x53, 0xa5,
-	0x4e, 0x9b, 0x01, 0x8f, 0xe4, 0xce, 0x74, 0x49, 0xda, 0x2c, 0x4a, 0x44, 0xcd, 0xe5, 0xcb, 0x45,
-	0xcd, 0x46, 0x36, 0x6a, 0xbe, 0x0f, 0x2b, 0xd4, 0x13, 0x44, 0x56, 0x24, 0x1c, 0x48, 0x19, 0x33,
-	0xea, 0xd2, 0xa5, 0xe2, 0x33, 0x48, 0x46, 0x3e, 0x48, 0x45, 0x3e, 0xc2, 0x0c, 0x17, 0x63, 0x73,
-	0x18, 0xfa, 0x86, 0x1b, 0x4c, 0xb0, 0x4f, 0x23, 0x67, 0x43, 0x6f, 0x13, 0xe0, 0x23, 0x0e, 0xd3,
-	0xfe, 0xb9, 0x02, 0xeb, 0xbc, 0x80, 0xbd, 0xbc, 0x5e, 0x14, 0x85, 0x2f, 0xe1, 0xff, 0xab, 0xe7,
-	0x94, 0x84, 0xb5, 0x12, 0xa9, 0x59, 0x5d, 0x92, 0x9a, 0xa5, 0xcb, 0xa2, 0xa5, 0x5c, 0x59, 0x14,
-	0xf5, 0x61, 0x96, 0xcb, 0xf7, 0x61, 0x48, 0xc1, 0x4f, 0x73, 0x75, 0x2a, 0xbb, 0xa6, 0xce, 0x3e,
-	0xca, 0x31, 0xf4, 0x3f, 0x14, 0xe8, 0x0c, 0xb0, 0xe1, 0x8f, 0x4f, 0x04, 0x1f, 0xdf, 0x4d, 0xf6,
-	0xad, 0x5e, 0x2f, 0x10, 0x71, 0x6a, 0xc9, 0x57, 0xa7, 0x61, 0xf5, 0x9f, 0x0a, 0xb4, 0x7f, 0x9d,
-	0x0c, 0x89, 0xc3, 0xde, 0x4d, 0x1e, 0xf6, 0x8d, 0x82, 0xc3, 0xea, 0x38, 0xf4, 0x2d, 0x7c, 0x8a,
-	0xbf, 0x72, 0xc7, 0xfd, 0x27, 0x05, 0xfa, 0x83, 0x33, 0x77, 0xac, 0x33, 0x5b, 0xbe, 0xbc, 0xc5,
-	0xdc, 0x82, 0xce, 0x69, 0x2a, 0x6b, 0xab, 0x50, 0x85, 0x6b, 0x9f, 0x26, 0x0b, 0x3f, 0x1d, 0x54,
-	0xd1, 0x2e, 0xe3, 0x87, 0x15, 0xae, 0xf5, 0x4d, 0x19, 0xd5, 0x19, 0xe2, 0xa8, 0x6b, 0x5a, 0xf1,
-	0xd3, 0x40, 0xed, 0xf7, 0x15, 0x58, 0x93, 0x4c, 0x44, 0xd7, 0x61, 0x99, 0x17, 0x99, 0x3c, 0x06,
-	0x33, 0x1b, 0x36, 0x89, 0x78, 0xe2, 0x36, 0x89, 0x65, 0xe6, 0x53, 0x41, 0x13, 0xbd, 0x0a, 0xad,
-	0xa8, 0x1a, 0x30, 0x73, 0xf2, 0x31, 0x03, 0xd4, 0x87, 0x06, 0x77, 0x4e, 0xa2, 0xcc, 0x8a, 0xbe,
-	0xb5, 0xbf, 0x53, 0x60, 0xfd, 0x3d, 0xc3, 0x35, 0xbd, 0xc9, 0xe4, 0xf2, 0x6c, 0xdd, 0x83, 0x54,
-	0x11, 0x51, 0xb6, 0x3d, 0x91, 0xae, 0x3c, 0xde, 0x82, 0x55, 0x9f, 0x79, 0x46, 0x33, 0xcd, 0xf7,
-	0xaa, 0xae, 0x8a, 0x81, 0x88, 0x9f, 0x7f, 0x59, 0x01, 0x44, 0x82, 0xc1, 0x7d, 0xc3, 0x36, 0xdc,
-	0x31, 0xbe, 0x38, 0xe9, 0xb7, 0xa1, 0x9b, 0x0a, 0x61, 0xd1, 0x5d, 0x58, 0x32, 0x86, 0x05, 0xe8,
-	0x7d, 0xe8, 0x8e, 0x18, 0xaa, 0xa1, 0x8f, 0x8d, 0xc0, 0x73, 0xa9, 0x73, 0xed, 0xca, 0x3b, 0x11,
-	0x8f, 0x7c, 0x6b, 0x3a, 0xc5, 0xfe, 0x9e, 0xe7, 0x9a, 0x3c, 0x17, 0x1b, 0x09, 0x32, 0xc9, 0x52,
-	0x22, 0xb8, 0x38, 0x9e, 0x0b, 0xd1, 0x40, 0x14, 0xd0, 0x29, 0x2b, 0x02, 0x6c, 0xd8, 0x31, 0x23,
-	0x62, 0x6f, 0xac, 0xb2, 0x81, 0x41, 0x71, 0x23, 0x4a, 0x12, 0x5f, 0xb5, 0xbf, 0x51, 0x00, 0x45,
-	0xf5, 0x12, 0xad, 0x0c, 0xa9, 0xf6, 0x65, 0x97, 0x2a, 0x92, 0xa0, 0x70, 0x03, 0x9a, 0xa6, 0x58,
-	0xc9, 0xcd, 0x25, 0x06, 0x50, 0x1f, 0x4d, 0x89, 0x1e, 0x92, 0x60, 0x8c, 0x4d, 0x51, 0x8f, 0x30,
-	0xe0, 0x07, 0x14, 0x96, 0x0e, 0xcf, 0xb5, 0x6c, 0x78, 0x4e, 0xf6, 0x59, 0xea, 0xa9, 0x3e, 0x8b,
-	0xf6, 0x69, 0x05, 0x54, 0xea, 0xee, 0xf6, 0xe2, 0x62, 0xbf, 0x14, 0xd1, 0xb7, 0xa0, 0xc3, 0x6f,
-	0x8b, 0x53, 0x84, 0xb7, 0x9f, 0x25, 0x36, 0x43, 0xef, 0xc0, 0x55, 0x36, 0xc9, 0xc7, 0xc1, 0xdc,
-	0x8e, 0x53, 0x71, 0x96, 0xcc, 0xa2, 0x67, 0xcc, 0xcf, 0x92, 0x21, 0xb1, 0xe2, 0x31, 0xac, 0x4f,
-	0x6d, 0x6f, 0x64, 0xd8, 0xc3, 0xb4, 0x78, 0x98, 0x0c, 0x4b, 0x68, 0xfc, 0x55, 0xb6, 0x7c, 0x90,
-	0x94, 0x61, 0x80, 0x0e, 0x48, 0x59, 0x8f, 0x9f, 0xc6, 0x59, 0x7e, 0xbd, 0x74, 0x96, 0xdf, 0x26,
-	0x0b, 0xa3, 0x24, 0xff, 0x4f, 0x14, 0x58, 0xc9, 0xb4, 0x4a, 0xb3, 0x25, 0xa5, 0x92, 0x2f, 0x29,
-	0xef, 0x42, 0x9d, 0xd4, 0x59, 0xcc, 0x19, 0x76, 0xe5, 0xe5, 0x4e, 0x7a, 0x57, 0x9d, 0x2d, 0x40,
-	0x3b, 0xb0, 0x26, 0xb9, 0x9a, 0xe4, 0x3a, 0x80, 0xf2, 0x37, 0x93, 0xda, 0xcf, 0x6b, 0xd0, 0x4a,
-	0xf0, 0x63, 0x41, 0x35, 0x5c, 0xa6, 0xf7, 0x95, 0x39, 0x5e, 0x35, 0x7f, 0xbc, 0x82, 0x8b, 0x2f,
-	0xa2, 0x77, 0x0e, 0x76, 0x58, 0xf2, 0xcf, 0x2b, 0x11, 0x07, 0x3b, 0x34, 0xf5, 0x4f, 0x66, 0xf5,
-	0x4b, 0xa9, 0xac, 0x3e, 0x53, 0xf7, 0x2c, 0x9f, 0x53, 0xf7, 0x34, 0xd2, 0x75, 0x4f, 0xca, 0x8e,
-	0x9a, 0x59, 0x3b, 0x2a, 0x5b, 0xa0, 0xbe, 0x03, 0x6b, 0x63, 0x1f, 0x1b, 0x21, 0x36, 0xef, 0x9f,
-	0xed, 0x45, 0x43, 0x3c, 0x33, 0x92, 0x0d, 0xa1, 0x07, 0x71, 0xcf, 0x88, 0x49, 0xb9, 0x4d, 0xa5,
-	0x2c, 0x2f, 0xab, 0xb8, 0x6c, 0x98, 0x90, 0x85, 0x7b, 0xa6, 0x5f, 0xd9, 0xd2, 0xb8, 0x73, 0xa1,
-	0xd2, 0xf8, 0x55, 0x68, 0x89, 0xd0, 0x4a, 0xcc, 0xbd, 0xcb, 0x3c, 0x9f, 0xf0, 0x05, 0x66, 0x90,
-	0x72, 0x06, 0x2b, 0xe9, 0xa6, 0x6b, 0xb6, 0x28, 0x55, 0xf3, 0x45, 0xe9, 0x75, 0x58, 0xb6, 0x82,
-	0xe1, 0xc4, 0x78, 0x8a, 0x7b, 0xab, 0x74, 0x74, 0xc9, 0x0a, 0x1e, 0x18, 0x4f, 0xb1, 0xf6, 0x2f,
-	0x55, 0xe8, 0xc6, 0x55, 0x4c, 0x69, 0x37, 0x52, 0xe6, 0x7a, 0xfe, 0x08, 0xd4, 0x38, 0x50, 0x53,
-	0x0e, 0x9f, 0x5b, 0x88, 0x65, 0x6f, 0x32, 0x56, 0x66, 0x19, 0x7b, 0x4d, 0xf5, 0x8a, 0x6b, 0x2f,
-	0xd4, 0x2b, 0xbe, 0xe4, 0x35, 0xe1, 0x1d, 0xb8, 0x16, 0x05, 0xe0, 0xd4, 0xb1, 0x59, 0x96, 0x7f,
-	0x55, 0x0c, 0x1e, 0x27, 0x8f, 0x5f, 0xe0, 0x02, 0x96, 0x8b, 0x5c, 0x40, 0x56, 0x05, 0x1a, 0x39,
-	0x15, 0xc8, 0xdf, 0x56, 0x36, 0x25, 0xb7, 0x95, 0xda, 0x63, 0x58, 0xa3, 0x6d, 0xc0, 0x60, 0xec,
-	0x5b, 0x23, 0x1c, 0xe5, 0xac, 0x65, 0xc4, 0xda, 0x87, 0x46, 0x26, 0xed, 0x8d, 0xbe, 0xb5, 0x9f,
-	0x29, 0xb0, 0x9e, 0xdf, 0x97, 0x6a, 0x4c, 0xec, 0x48, 0x94, 0x94, 0x23, 0xf9, 0x4d, 0x58, 0x8b,
-	0xb7, 0x4f, 0x27, 0xd4, 0x05, 0x29, 0xa3, 0x84, 0x70, 0x1d, 0xc5, 0x7b, 0x08, 0x98, 0xf6, 0x73,
-	0x25, 0xea, 0xa6, 0x12, 0xd8, 0x94, 0xf6, 0x98, 0x49, 0x70, 0xf3, 0x5c, 0xdb, 0x72, 0xa3, 0xaa,
-	0x9b, 0x9f, 0x91, 0x01, 0x79, 0xd5, 0xfd, 0x1e, 0xac, 0xf0, 0x49, 0x51, 0x8c, 0x2a, 0x99, 0x95,
-	0x75, 0xd9, 0xba, 0x28, 0x3a, 0xdd, 0x86, 0x2e, 0x6f, 0xfe, 0x0a, 0x7c, 0x55, 0x59, 0x4b, 0xf8,
-	0xd7, 0x40, 0x15, 0xd3, 0x5e, 0x34, 0x2a, 0xae, 0xf0, 0x85, 0x51, 0x76, 0xf7, 0x53, 0x05, 0x7a,
-	0xe9, 0x18, 0x99, 0x38, 0xfe, 0x8b, 0xe7, 0x78, 0xdf, 0x4d, 0x5f, 0x9b, 0xdd, 0x3e, 0x87, 0x9e,
-	0x18, 0x8f, 0xb8, 0x3c, 0x3b, 0xa2, 0x57, 0xa0, 0xa4, 0x34, 0xd9, 0xb7, 0x82, 0xd0, 0xb7, 0x46,
-	0xf3, 0x4b, 0xbd, 0xdf, 0xd0, 0xfe, 0xb6, 0x02, 0x5f, 0x97, 0x6e, 0x78, 0x99, 0x0b, 0xb2, 0xa2,
-	0x4e, 0xc0, 0x7d, 0x68, 0x64, 0x4a, 0x98, 0x37, 0xce, 0x39, 0x3c, 0x6f, 0x6a, 0xb1, 0xe6, 0x8a,
-	0x58, 0x47, 0xf6, 0x88, 0x74, 0xba, 0x56, 0xbc, 0x07, 0x57, 0xda, 0xd4, 0x1e, 0x62, 0x1d, 0xba,
-	0x07, 0x6d, 0x56, 0x1e, 0x0e, 0x4f, 0x2d, 0xfc, 0x5c, 0xdc, 0xeb, 0xdc, 0x94, 0xfa, 0x35, 0x3a,
-	0xef, 0x89, 0x85, 0x9f, 0xeb, 0x2d, 0x3b, 0xfa, 0x1d, 0x68, 0xff, 0x5d, 0x05, 0x88, 0xc7, 0x48,
-	0x6d, 0x1a, 0x1b, 0x0c, 0xb7, 0x80, 0x04, 0x84, 0x04, 0xe2, 0x74, 0xee, 0x27, 0x3e, 0x91, 0x1e,
-	0xb7, 0x67, 0x4d, 0x2b, 0x08, 0x39, 0x5f, 0x76, 0xce, 0xa7, 0x45, 0xb0, 0x88, 0x88, 0x8c, 0x5d,
-	0x9b, 0x88, 0xda, 0x8b, 0x40, 0xd0, 0xdb, 0x80, 0xa6, 0xbe, 0xf7, 0xdc, 0x72, 0xa7, 0xc9, 0x8c,
-	0x9d, 0x25, 0xf6, 0xab, 0x7c, 0x24, 0x91, 0xb2, 0xff, 0x18, 0xd4, 0xcc, 0x74, 0xc1, 0x92, 0x3b,
-	0x0b, 0xc8, 0x38, 0x48, 0xed, 0xc5, 0x6f, 0x70, 0x56, 0xd2, 0x18, 0x82, 0xfe, 0x10, 0xd4, 0x2c,
-	0xbd, 0x92, 0x3b, 0x98, 0x6f, 0xa7, 0xef, 0x60, 0xce, 0x33, 0x53, 0xb2, 0x4d, 0xe2, 0x12, 0xa6,
-	0x3f, 0x81, 0xab, 0x32, 0x4a, 0x24, 0x48, 0xee, 0xa6, 0x91, 0x94, 0xc9, 0x69, 0x13, 0x97, 0x3d,
-	0x3f, 0x88, 0xd2, 0x45, 0xca, 0xe6, 0x22, 0x0f, 0x9c, 0x68, 0xca, 0x55, 0x52, 0x4d, 0x39, 0xed,
-	0x8f, 0x14, 0x40, 0x79, 0xed, 0x46, 0x5d, 0xa8, 0x44, 0x9b, 0x54, 0x0e, 0xf7, 0x33, 0xda, 0x54,
-	0xc9, 0x69, 0xd3, 0x0d, 0x68, 0x46, 0x11, 0x91, 0xbb, 0xbf, 0x18, 0x90, 0xd4, 0xb5, 0x5a, 0x5a,
-	0xd7, 0x12, 0x84, 0xd5, 0xd3, 0x84, 0x9d, 0x00, 0xca, 0x5b, 0x4c, 0x72, 0x27, 0x25, 0xbd, 0xd3,
-	0x22, 0x0a, 0x13, 0x98, 0xaa, 0x69, 0x4c, 0xff, 0x5e, 0x01, 0x14, 0xc7, 0xfc, 0xe8, 0x22, 0xaa,
-	0x4c, 0xa0, 0xdc, 0x81, 0xb5, 0x7c, 0x46, 0x20, 0xd2, 0x20, 0x94, 0xcb, 0x07, 0x64, 0xb1, 0xbb,
-	0x2a, 0x7b, 0x69, 0xf4, 0x6e, 0xe4, 0xe3, 0x58, 0x82, 0x73, 0xb3, 0x28, 0xc1, 0xc9, 0xb8, 0xb9,
-	0xdf, 0xca, 0xbe, 0x50, 0x62, 0x46, 0x73, 0x57, 0xea, 0x8f, 0x72, 0x47, 0x7e, 0xf9, 0xcf, 0x93,
-	0xfe, 0xb5, 0x02, 0xab, 0x11, 0x37, 0x5e, 0x88, 0xd3, 0x8b, 0x2f, 0xfe, 0x5e, 0x32, 0x6b, 0x3f,
-	0x92, 0xb3, 0xf6, 0x97, 0xcf, 0xcd, 0x61, 0x3f, 0x3f, 0xce, 0x0e, 0x60, 0x99, 0xb7, 0xcf, 0x72,
-	0xb6, 0x5b, 0xa6, 0x4a, 0xbc, 0x0a, 0x75, 0xe2, 0x2a, 0x44, 0x3f, 0x89, 0x7d, 0x68, 0x7f, 0xad,
-	0x00, 0x0c, 0xce, 0xdc, 0xf1, 0x3d, 0x66, 0x42, 0xef, 0x40, 0x6d, 0xd1, 0x03, 0x0d, 0x32, 0x9b,
-	0x26, 0xdd, 0x74, 0x66, 0x09, 0xa9, 0xa5, 0x0a, 0xdc, 0x6a, 0xb6, 0xc0, 0x2d, 0x2a, 0x4d, 0x8b,
-	0xdd, 0xc6, 0x3f, 0x28, 0x70, 0x9d, 0x10, 0xf1, 0x99, 0xe4, 0x22, 0xa5, 0x58, 0x97, 0x70, 0x49,
-	0xd5, 0xb4, 0x4b, 0xba, 0x0b, 0xcb, 0xac, 0xc6, 0x14, 0x79, 0xc1, 0xcd, 0x22, 0x96, 0x31, 0x06,
-	0xeb, 0x62, 0xfa, 0xd6, 0xaf, 0x42, 0x33, 0xea, 0xf5, 0xa2, 0x16, 0x2c, 0x3f, 0x76, 0xdf, 0x77,
-	0xbd, 0xe7, 0xae, 0x7a, 0x05, 0x2d, 0x43, 0xf5, 0x9e, 0x6d, 0xab, 0x0a, 0xea, 0x40, 0x73, 0x10,
-	0xfa, 0xd8, 0x70, 0x2c, 0x77, 0xaa, 0x56, 0x50, 0x17, 0xe0, 0x3d, 0x2b, 0x08, 0x3d, 0xdf, 0x1a,
-	0x1b, 0xb6, 0x5a, 0xdd, 0xfa, 0x04, 0xba, 0xe9, 0x4a, 0x0a, 0xb5, 0xa1, 0x71, 0xe4, 0x85, 0x3f,
-	0xfc, 0xd8, 0x0a, 0x42, 0xf5, 0x0a, 0x99, 0x7f, 0xe4, 0x85, 0xc7, 0x3e, 0x0e, 0xb0, 0x1b, 0xaa,
-	0x0a, 0x02, 0x58, 0xfa, 0x91, 0xbb, 0x6f, 0x05, 0x4f, 0xd5, 0x0a, 0x5a, 0xe3, 0x4d, 0x12, 0xc3,
-	0x3e, 0xe4, 0xe5, 0x89, 0x5a, 0x25, 0xcb, 0xa3, 0xaf, 0x1a, 0x52, 0xa1, 0x1d, 0x4d, 0x39, 0x38,
-	0x7e, 0xac, 0xd6, 0x51, 0x13, 0xea, 0xec, 0xe7, 0xd2, 0x96, 0x09, 0x6a, 0xb6, 0xc3, 0x47, 0xf6,
-	0x64, 0x87, 0x88, 0x40, 0xea, 0x15, 0x72, 0x32, 0xde, 0x62, 0x55, 0x15, 0xb4, 0x02, 0xad, 0x44,
-	0xc3, 0x52, 0xad, 0x10, 0xc0, 0x81, 0x3f, 0x1b, 0x73, 0xe9, 0x31, 0x12, 0x48, 0x2e, 0xbd, 0x4f,
-	0x38, 0x51, 0xdb, 0xba, 0x0f, 0x0d, 0x51, 0xe2, 0x91, 0xa9, 0x9c, 0x45, 0xe4, 0x53, 0xbd, 0x82,
-	0x56, 0xa1, 0x93, 0x7a, 0x81, 0xa9, 0x2a, 0x08, 0x41, 0x37, 0xfd, 0xc0, 0x59, 0xad, 0x6c, 0xed,
-	0x02, 0xc4, 0xa6, 0x4e, 0xc8, 0x39, 0x74, 0x4f, 0x0d, 0xdb, 0x32, 0x19, 0x6d, 0x64, 0x88, 0x70,
-	0x97, 0x72, 0x87, 0xb5, 0xea, 0xd4, 0xca, 0xd6, 0xab, 0xd0, 0x10, 0x5a, 0x4e, 0xe0, 0x3a, 0x76,
-	0xbc, 0x53, 0xcc, 0x24, 0x33, 0xc0, 0xa1, 0xaa, 0xec, 0xfe, 0x6f, 0x07, 0x80, 0x35, 0xe5, 0x3c,
-	0xcf, 0x37, 0x91, 0x0d, 0xe8, 0x00, 0x87, 0x7b, 0x9e, 0x33, 0xf3, 0x5c, 0xd1, 0x2c, 0x08, 0xd0,
-	0x76, 0x5a, 0x15, 0xf8, 0x47, 0x7e, 0x22, 0x3f, 0x7d, 0xff, 0x75, 0xe9, 0xfc, 0xcc, 0x64, 0xed,
-	0x0a, 0x72, 0x28, 0xb6, 0x47, 0x96, 0x83, 0x1f, 0x59, 0xe3, 0xa7, 0x51, 0x27, 0xaf, 0xf8, 0x75,
-	0x72, 0x66, 0xaa, 0xc0, 0x77, 0x4b, 0x8a, 0x6f, 0x10, 0xfa, 0x96, 0x3b, 0x15, 0xa9, 0xb8, 0x76,
-	0x05, 0x3d, 0xcb, 0xbc, 0x8d, 0x16, 0x08, 0x77, 0xcb, 0x3c, 0x87, 0xbe, 0x18, 0x4a, 0x1b, 0x56,
-	0x32, 0xff, 0xf5, 0x40, 0x5b, 0xf2, 0xe7, 0x6e, 0xb2, 0xff, 0xa5, 0xf4, 0xdf, 0x2a, 0x35, 0x37,
-	0xc2, 0x66, 0x41, 0x37, 0xfd, 0x7f, 0x06, 0xf4, 0x4b, 0x45, 0x1b, 0xe4, 0x1e, 0xdc, 0xf6, 0xb7,
-	0xca, 0x4c, 0x8d, 0x50, 0x7d, 0xc8, 0x14, 0x74, 0x11, 0x2a, 0xe9, 0xcb, 0xe2, 0xfe, 0x79, 0x55,
-	0x90, 0x76, 0x05, 0xfd, 0x04, 0x56, 0x73, 0xcf, 0x82, 0xd1, 0x37, 0xe4, 0xb7, 0x35, 0xf2, 0xd7,
-	0xc3, 0x8b, 0x30, 0x7c, 0x98, 0x35, 0xaf, 0x62, 0xea, 0x73, 0xaf, 0xfc, 0xcb, 0x53, 0x9f, 0xd8,
-	0xfe, 0x3c, 0xea, 0x5f, 0x18, 0xc3, 0x9c, 0x9a, 0x4d, 0xb6, 0x35, 0xfc, 0xb6, 0x0c, 0x45, 0xe1,
-	0xdb, 0xe4, 0xfe, 0x76, 0xd9, 0xe9, 0x49, 0xed, 0x4a, 0x3f, 0x7f, 0x95, 0x33, 0x4d, 0xfa, 0x64,
-	0x57, 0xae, 0x5d, 0xf2, 0xd7, 0xb4, 0xda, 0x15, 0xf4, 0x28, 0xe5, 0x5e, 0xd1, 0x1b, 0x45, 0xc2,
-	0x49, 0x5f, 0x18, 0x2d, 0xe2, 0xdb, 0x6f, 0x03, 0x62, 0xb6, 0xe3, 0x4e, 0xac, 0xe9, 0xdc, 0x37,
-	0x98, 0x62, 0x15, 0xb9, 0x9b, 0xfc, 0x54, 0x81, 0xe6, 0x9b, 0x2f, 0xb0, 0x22, 0x3a, 0xd2, 0x10,
-	0xe0, 0x00, 0x87, 0x0f, 0x71, 0xe8, 0x5b, 0xe3, 0x20, 0x7b, 0xa2, 0xd8, 0xa3, 0xf2, 0x09, 0x02,
-	0xd5, 0x9b, 0x0b, 0xe7, 0x45, 0x08, 0x46, 0xd0, 0x3a, 0xc0, 0x21, 0xcf, 0xab, 0x02, 0x54, 0xb8,
-	0x52, 0xcc, 0x10, 0x28, 0x36, 0x17, 0x4f, 0x4c, 0xba, 0xb3, 0xcc, 0x53, 0x60, 0x54, 0x28, 0xd8,
-	0xfc, 0x03, 0x65, 0xb9, 0x3b, 0x2b, 0x78, 0x5b, 0xcc, 0x4e, 0xb4, 0x77, 0x82, 0xc7, 0x4f, 0xdf,
-	0xc3, 0x86, 0x1d, 0x9e, 0x14, 0x9c, 0x28, 0x31, 0xe3, 0xfc, 0x13, 0xa5, 0x26, 0x0a, 0x1c, 0xbb,
-	0x9f, 0x76, 0xa1, 0x49, 0xe3, 0x1f, 0x09, 0xd6, 0xbf, 0x08, 0x7f, 0x9f, 0x71, 0xf8, 0xfb, 0x08,
-	0x56, 0x32, 0x2f, 0x57, 0xe5, 0xfa, 0x22, 0x7f, 0xde, 0x5a, 0xc2, 0x8b, 0xa7, 0xdf, 0x8e, 0xca,
-	0x1d, 0x92, 0xf4, 0x7d, 0xe9, 0xa2, 0xbd, 0x9f, 0xb0, 0x47, 0xdf, 0x51, 0xdf, 0xf4, 0xcd, 0xc2,
-	0xca, 0x2b, 0x7d, 0xdf, 0xfe, 0xc5, 0x47, 0x87, 0x97, 0x1f, 0x3d, 0x3f, 0x82, 0x95, 0xcc, 0xab,
-	0x27, 0xb9, 0x54, 0xe5, 0x4f, 0xa3, 0x16, 0xed, 0xfe, 0x39, 0x86, 0x19, 0x13, 0xd6, 0x24, 0x0f,
-	0x52, 0xd0, 0x76, 0x51, 0xe5, 0x23, 0x7f, 0xb9, 0xb2, 0xf8, 0x40, 0x9d, 0x94, 0x29, 0xa1, 0xcd,
-	0x22, 0x22, 0xb3, 0xff, 0xbd, 0xeb, 0x7f, 0xa3, 0xdc, 0x1f, 0xf5, 0xa2, 0x03, 0x0d, 0x60, 0x89,
-	0xbd, 0x85, 0x42, 0xaf, 0xc9, 0xfb, 0x7f, 0x89, 0x77, 0x52, 0xfd, 0x45, 0xaf, 0xa9, 0x82, 0xb9,
-	0x1d, 0x06, 0x74, 0xd3, 0x3a, 0xf5, 0x90, 0x48, 0xfa, 0x88, 0x2f, 0xf9, 0x80, 0xa9, 0xbf, 0xf8,
-	0xcd, 0x92, 0xd8, 0xf4, 0xff, 0x77, 0x2c, 0xfe, 0x18, 0xd6, 0x24, 0xb7, 0x02, 0xa8, 0x28, 0xe7,
-	0x2a, 0xb8, 0x8f, 0xe8, 0xef, 0x94, 0x9e, 0x1f, 0x61, 0xfe, 0x31, 0xa8, 0xd9, 0x8e, 0x02, 0x7a,
-	0xab, 0x48, 0x9f, 0x65, 0x38, 0xcf, 0x57, 0xe6, 0xfb, 0xdf, 0xfa, 0x70, 0x77, 0x6a, 0x85, 0x27,
-	0xf3, 0x11, 0x19, 0xd9, 0x61, 0x53, 0xdf, 0xb6, 0x3c, 0xfe, 0x6b, 0x47, 0xf0, 0x7f, 0x87, 0xae,
-	0xde, 0xa1, 0xa8, 0x66, 0xa3, 0xd1, 0x12, 0xfd, 0xbc, 0xf3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x37, 0x34, 0x97, 0xee, 0xf6, 0x3f, 0x00, 0x00,
+	// 3783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x8c, 0x1c, 0x49,
+	0x56, 0xce, 0xfa, 0x74, 0x57, 0xbd, 0xfa, 0x65, 0x47, 0xfb, 0x53, 0x5b, 0xeb, 0xf1, 0xf4, 0xa4,
+	0xc7, 0x33, 0x4d, 0x7b, 0xa7, 0x3d, 0xdb, 0xde, 0x1d, 0xbc, 0xec, 0xae, 0x16, 0xbb, 0x7b, 0xdd,
+	0xd3, 0xcc, 0xd8, 0xdb, 0x64, 0xdb, 0x06, 0x8d, 0x86, 0xad, 0xcd, 0xae, 0x8c, 0xaa, 0x4e, 0x39,
+	0x3f, 0xe5, 0x8c, 0xac, 0xb6, 0x7b, 0xb8, 0x72, 0x61, 0x04, 0x48, 0x70, 0xe0, 0x84, 0x38, 0x81,
+	0x04, 0x12, 0x83, 0x38, 0xc0, 0x8d, 0x03, 0x12, 0x12, 0xdc, 0x10, 0x37, 0x8e, 0x5c, 0x91, 0x40,
+	0x42, 0x42, 0xda, 0x03, 0x07, 0x24, 0x14, 0xbf, 0xfc, 0x46, 0x75, 0xa5, 0xbb, 0xc7, 0xf3, 0x41,
+	0xdc, 0x2a, 0x5f, 0xbc, 0x88, 0xf7, 0xe2, 0xc5, 0xfb, 0x47, 0x14, 0xac, 0x3c, 0x9b, 0xe1, 0xf0,
+	0x64, 0x38, 0x0a, 0x82, 0xd0, 0xde, 0x9c, 0x86, 0x41, 0x14, 0x20, 0xe4, 0x39, 0xee, 0xf1, 0x8c,
+	0xf0, 0xaf, 0x4d, 0x36, 0x3e, 0x68, 0x8f, 0x02, 0xcf, 0x0b, 0x7c, 0x0e, 0x1b, 0xb4, 0xd3, 0x18,
+	0x83, 0xae, 0xe3, 0x47, 0x38, 0xf4, 0x2d, 0x57, 0x8e, 0x92, 0xd1, 0x11, 0xf6, 0x2c, 0xf1, 0xa5,
+	0xdb, 0x56, 0x64, 0xa5, 0xd7, 0x37, 0x7e, 0x4b, 0x83, 0xcb, 0x07, 0x47, 0xc1, 0xf3, 0xed, 0xc0,
+	0x75, 0xf1, 0x28, 0x72, 0x02, 0x9f, 0x98, 0xf8, 0xd9, 0x0c, 0x93, 0x08, 0xbd, 0x0b, 0xb5, 0x43,
+	0x8b, 0xe0, 0xbe, 0xb6, 0xa6, 0xad, 0xb7, 0xb6, 0xae, 0x6e, 0x66, 0x38, 0x11, 0x2c, 0x3c, 0x20,
+	0x93, 0x7b, 0x16, 0xc1, 0x26, 0xc3, 0x44, 0x08, 0x6a, 0xf6, 0xe1, 0xde, 0x4e, 0xbf, 0xb2, 0xa6,
+	0xad, 0x57, 0x4d, 0xf6, 0x1b, 0xbd, 0x09, 0x9d, 0x51, 0xbc, 0xf6, 0xde, 0x0e, 0xe9, 0x57, 0xd7,
+	0xaa, 0xeb, 0x55, 0x33, 0x0b, 0x34, 0xfe, 0x55, 0x83, 0x2b, 0x05, 0x36, 0xc8, 0x34, 0xf0, 0x09,
+	0x46, 0xb7, 0x61, 0x89, 0x44, 0x56, 0x34, 0x23, 0x82, 0x93, 0x6f, 0x2a, 0x39, 0x39, 0x60, 0x28,
+	0xa6, 0x40, 0x2d, 0x92, 0xad, 0x28, 0xc8, 0xa2, 0x6f, 0xc3, 0x45, 0xc7, 0x7f, 0x80, 0xbd, 0x20,
+	0x3c, 0x19, 0x4e, 0x71, 0x38, 0xc2, 0x7e, 0x64, 0x4d, 0xb0, 0xe4, 0x71, 0x55, 0x8e, 0xed, 0x27,
+	0x43, 0xe8, 0x3d, 0xb8, 0xc2, 0x4f, 0x89, 0xe0, 0xf0, 0xd8, 0x19, 0xe1, 0xa1, 0x75, 0x6c, 0x39,
+	0xae, 0x75, 0xe8, 0xe2, 0x7e, 0x6d, 0xad, 0xba, 0xde, 0x30, 0x2f, 0xb1, 0xe1, 0x03, 0x3e, 0x7a,
+	0x57, 0x0e, 0x1a, 0x7f, 0xaa, 0xc1, 0x25, 0xba, 0xc3, 0x7d, 0x2b, 0x8c, 0x9c, 0x57, 0x20, 0x67,
+	0x03, 0xda, 0xe9, 0xbd, 0xf5, 0xab, 0x6c, 0x2c, 0x03, 0xa3, 0x38, 0x53, 0x49, 0x9e, 0xca, 0xa4,
+	0xc6, 0xb6, 0x99, 0x81, 0x19, 0x7f, 0x22, 0x14, 0x22, 0xcd, 0xe7, 0x79, 0x0e, 0x22, 0x4f, 0xb3,
+	0x52, 0xa4, 0x79, 0x86, 0x63, 0x30, 0x3e, 0xad, 0xc2, 0xa5, 0x0f, 0x03, 0xcb, 0x4e, 0x14, 0xe6,
+	0x8b, 0x17, 0xe7, 0x0f, 0x61, 0x89, 0x5b, 0x57, 0xbf, 0xc6, 0x68, 0xdd, 0xc8, 0xd2, 0xe2, 0x63,
+	0x9b, 0x09, 0x87, 0x07, 0x0c, 0x60, 0x8a, 0x49, 0xe8, 0x06, 0x74, 0x43, 0x3c, 0x75, 0x9d, 0x91,
+	0x35, 0xf4, 0x67, 0xde, 0x21, 0x0e, 0xfb, 0xf5, 0x35, 0x6d, 0xbd, 0x6e, 0x76, 0x04, 0xf4, 0x21,
+	0x03, 0xa2, 0x9f, 0x41, 0x67, 0xec, 0x60, 0xd7, 0x1e, 0x3a, 0xbe, 0x8d, 0x5f, 0xec, 0xed, 0xf4,
+	0x97, 0xd6, 0xaa, 0xeb, 0xad, 0xad, 0xef, 0x6f, 0x16, 0x3d, 0xc3, 0xa6, 0x52, 0x22, 0x9b, 0xf7,
+	0xe9, 0xf4, 0x3d, 0x3e, 0xfb, 0xc7, 0x7e, 0x14, 0x9e, 0x98, 0xed, 0x71, 0x0a, 0x34, 0xf8, 0x11,
+	0xac, 0x14, 0x50, 0x90, 0x0e, 0xd5, 0xa7, 0xf8, 0x84, 0x49, 0xb1, 0x6a, 0xd2, 0x9f, 0xe8, 0x22,
+	0xd4, 0x8f, 0x2d, 0x77, 0x86, 0x85, 0x9c, 0xf8, 0xc7, 0x2f, 0x55, 0xee, 0x68, 0xc6, 0x1f, 0x69,
+	0xd0, 0x37, 0xb1, 0x8b, 0x2d, 0x82, 0xbf, 0xcc, 0xf3, 0xb8, 0x0c, 0x4b, 0x7e, 0x60, 0xe3, 0xbd,
+	0x1d, 0x76, 0x1e, 0x55, 0x53, 0x7c, 0x19, 0xff, 0xad, 0xc1, 0xc5, 0x5d, 0x1c, 0x51, 0xc5, 0x74,
+	0x48, 0xe4, 0x8c, 0x62, 0xcb, 0xfb, 0x21, 0x54, 0x43, 0xfc, 0x4c, 0x70, 0x76, 0x33, 0xcb, 0x59,
+	0xec, 0x47, 0x55, 0x33, 0x4d, 0x3a, 0x0f, 0xbd, 0x01, 0x6d, 0xdb, 0x73, 0x87, 0xa3, 0x23, 0xcb,
+	0xf7, 0xb1, 0xcb, 0x55, 0xbb, 0x69, 0xb6, 0x6c, 0xcf, 0xdd, 0x16, 0x20, 0x74, 0x0d, 0x80, 0xe0,
+	0x89, 0x87, 0xfd, 0x28, 0x71, 0x7d, 0x29, 0x08, 0xda, 0x80, 0x95, 0x71, 0x18, 0x78, 0x43, 0x72,
+	0x64, 0x85, 0xf6, 0xd0, 0xc5, 0x96, 0x8d, 0x43, 0xc6, 0x7d, 0xc3, 0xec, 0xd1, 0x81, 0x03, 0x0a,
+	0xff, 0x90, 0x81, 0xd1, 0x6d, 0xa8, 0x93, 0x51, 0x30, 0xc5, 0x4c, 0x4d, 0xba, 0x5b, 0xaf, 0xa9,
+	0x14, 0x60, 0xc7, 0x8a, 0xac, 0x03, 0x8a, 0x64, 0x72, 0x5c, 0xe3, 0x2f, 0x85, 0x9d, 0x7c, 0xc5,
+	0xdd, 0x4e, 0xca, 0x96, 0xea, 0x9f, 0x8f, 0x2d, 0x2d, 0x95, 0xb2, 0xa5, 0xe5, 0xd3, 0x6d, 0xa9,
+	0x20, 0xb5, 0x57, 0x6f, 0x4b, 0x7f, 0x97, 0xd8, 0xd2, 0x57, 0xfd, 0xcc, 0x12, 0x7b, 0xab, 0x67,
+	0xec, 0xed, 0xcf, 0x35, 0xf8, 0xc6, 0x2e, 0x8e, 0x62, 0xf6, 0xa9, 0xf9, 0xe0, 0xaf, 0x68, 0xb8,
+	0xfb, 0x4c, 0x83, 0x81, 0x8a, 0xd7, 0xf3, 0x84, 0xbc, 0x8f, 0xe0, 0x72, 0x4c, 0x63, 0x68, 0x63,
+	0x32, 0x0a, 0x9d, 0x29, 0xfd, 0xcd, 0x3d, 0x44, 0x6b, 0xeb, 0xba, 0x4a, 0xdd, 0xf2, 0x1c, 0x5c,
+	0x8a, 0x97, 0xd8, 0x49, 0xad, 0x60, 0xfc, 0xae, 0x06, 0x97, 0xa8, 0x47, 0x12, 0x2e, 0xc4, 0x1f,
+	0x07, 0x67, 0x97, 0x6b, 0xd6, 0x39, 0x55, 0x0a, 0xce, 0xa9, 0x84, 0x8c, 0x59, 0xfe, 0x98, 0xe7,
+	0xe7, 0x3c, 0xb2, 0xfb, 0x2e, 0xd4, 0x1d, 0x7f, 0x1c, 0x48, 0x51, 0xbd, 0xae, 0x12, 0x55, 0x9a,
+	0x18, 0xc7, 0x36, 0x7c, 0xce, 0x45, 0xe2, 0x2d, 0xcf, 0xa1, 0x6e, 0xf9, 0x6d, 0x57, 0x14, 0xdb,
+	0xfe, 0x1d, 0x0d, 0xae, 0x14, 0x08, 0x9e, 0x67, 0xdf, 0x3f, 0x80, 0x25, 0x16, 0x03, 0xe4, 0xc6,
+	0xdf, 0x54, 0x6e, 0x3c, 0x45, 0xee, 0x43, 0x87, 0x44, 0xa6, 0x98, 0x63, 0x04, 0xa0, 0xe7, 0xc7,
+	0x68, 0x74, 0x12, 0x91, 0x69, 0xe8, 0x5b, 0x1e, 0x17, 0x40, 0xd3, 0x6c, 0x09, 0xd8, 0x43, 0xcb,
+	0xc3, 0xe8, 0x1b, 0xd0, 0xa0, 0x26, 0x3b, 0x74, 0x6c, 0x79, 0xfc, 0xcb, 0xcc, 0x84, 0x6d, 0x82,
+	0x5e, 0x03, 0x60, 0x43, 0x96, 0x6d, 0x87, 0x3c, 0x70, 0x35, 0xcd, 0x26, 0x85, 0xdc, 0xa5, 0x00,
+	0xe3, 0xf7, 0x35, 0x68, 0x53, 0x07, 0xf9, 0x00, 0x47, 0x16, 0x3d, 0x07, 0xf4, 0x3d, 0x68, 0xba,
+	0x81, 0x65, 0x0f, 0xa3, 0x93, 0x29, 0x27, 0xd5, 0xdd, 0xba, 0xaa, 0xda, 0x02, 0x9d, 0xf4, 0xe8,
+	0x64, 0x8a, 0xcd, 0x86, 0x2b, 0x7e, 0x95, 0x91, 0x77, 0xc1, 0x94, 0xab, 0x0a, 0x53, 0xfe, 0x87,
+	0x3a, 0x5c, 0xfe, 0x35, 0x2b, 0x1a, 0x1d, 0xed, 0x78, 0x32, 0xfe, 0x9e, 0x5d, 0x09, 0x12, 0xdf,
+	0x56, 0x49, 0xfb, 0xb6, 0xcf, 0xcd, 0x77, 0xc6, 0x7a, 0x5e, 0x57, 0xe9, 0x39, 0x2d, 0xd3, 0x36,
+	0x9f, 0x88, 0xa3, 0x4a, 0xe9, 0x79, 0x2a, 0x4c, 0x2e, 0x9d, 0x25, 0x4c, 0x6e, 0x43, 0x07, 0xbf,
+	0x18, 0xb9, 0x33, 0x7a, 0xe6, 0x8c, 0x3a, 0x8f, 0x7f, 0xd7, 0x14, 0xd4, 0xd3, 0x46, 0xd6, 0x16,
+	0x93, 0xf6, 0x04, 0x0f, 0xfc, 0xa8, 0x3d, 0x1c, 0x59, 0xfd, 0x06, 0x63, 0x63, 0x6d, 0xde, 0x51,
+	0x4b, 0xfd, 0xe0, 0xc7, 0x4d, 0xbf, 0xd0, 0x55, 0x68, 0x8a, 0xa0, 0xbc, 0xb7, 0xd3, 0x6f, 0x32,
+	0xf1, 0x25, 0x00, 0x64, 0x41, 0x47, 0x78, 0x20, 0xc1, 0x21, 0x30, 0x0e, 0x7f, 0xa0, 0x22, 0xa0,
+	0x3e, 0xec, 0x34, 0xe7, 0x44, 0x84, 0x68, 0x92, 0x02, 0xd1, 0xd2, 0x30, 0x18, 0x8f, 0x5d, 0xc7,
+	0xc7, 0x0f, 0xf9, 0x09, 0xb7, 0x18, 0x13, 0x59, 0x20, 0xea, 0xc3, 0xf2, 0x31, 0x0e, 0x89, 0x13,
+	0xf8, 0xfd, 0x36, 0x1b, 0x97, 0x9f, 0x83, 0x21, 0xac, 0x14, 0x48, 0x28, 0x42, 0xfc, 0x77, 0xd2,
+	0x21, 0x7e, 0xb1, 0x8c, 0x53, 0x29, 0xc0, 0x9f, 0x69, 0x70, 0xe9, 0xb1, 0x4f, 0x66, 0x87, 0xf1,
+	0xde, 0xbe, 0x1c, 0x3d, 0xce, 0x7b, 0x90, 0x5a, 0xc1, 0x83, 0x18, 0x9f, 0x2e, 0x41, 0x4f, 0xec,
+	0x82, 0x1e, 0x37, 0x73, 0x05, 0x57, 0xa1, 0x19, 0x07, 0x11, 0x21, 0x90, 0x04, 0x80, 0xd6, 0xa0,
+	0x95, 0x32, 0x04, 0xc1, 0x55, 0x1a, 0x54, 0x8a, 0x35, 0x99, 0x12, 0xd4, 0x52, 0x29, 0xc1, 0x6b,
+	0x00, 0x63, 0x77, 0x46, 0x8e, 0x86, 0x91, 0xe3, 0x61, 0x91, 0x92, 0x34, 0x19, 0xe4, 0x91, 0xe3,
+	0x61, 0x74, 0x17, 0xda, 0x87, 0x8e, 0xef, 0x06, 0x93, 0xe1, 0xd4, 0x8a, 0x8e, 0x88, 0x28, 0xa3,
+	0x54, 0xc7, 0xc2, 0x12, 0xb8, 0x7b, 0x0c, 0xd7, 0x6c, 0xf1, 0x39, 0xfb, 0x74, 0x0a, 0xba, 0x06,
+	0x2d, 0x7f, 0xe6, 0x0d, 0x83, 0xf1, 0x30, 0x0c, 0x9e, 0x53, 0xe3, 0x61, 0x24, 0xfc, 0x99, 0xf7,
+	0x93, 0xb1, 0x19, 0x3c, 0xa7, 0x4e, 0xbc, 0x49, 0xdd, 0x39, 0x71, 0x83, 0x09, 0xe9, 0x37, 0x4a,
+	0xad, 0x9f, 0x4c, 0xa0, 0xb3, 0x6d, 0xec, 0x46, 0x16, 0x9b, 0xdd, 0x2c, 0x37, 0x3b, 0x9e, 0x80,
+	0xde, 0x82, 0xee, 0x28, 0xf0, 0xa6, 0x16, 0x93, 0xd0, 0xfd, 0x30, 0xf0, 0x98, 0xe5, 0x54, 0xcd,
+	0x1c, 0x14, 0x6d, 0x43, 0x8b, 0x25, 0xbf, 0xc2, 0xbc, 0x5a, 0x8c, 0x8e, 0xa1, 0x32, 0xaf, 0x54,
+	0x1e, 0x4b, 0x15, 0x14, 0x1c, 0xf9, 0x93, 0x50, 0xcd, 0x90, 0x56, 0x4a, 0x9c, 0x4f, 0xb0, 0xb0,
+	0x90, 0x96, 0x80, 0x1d, 0x38, 0x9f, 0x60, 0x9a, 0x91, 0x3b, 0x3e, 0xc1, 0x61, 0x24, 0xeb, 0xa3,
+	0x7e, 0x87, 0xa9, 0x4f, 0x87, 0x43, 0x85, 0x62, 0xa3, 0x3d, 0xe8, 0x92, 0xc8, 0x0a, 0xa3, 0xe1,
+	0x34, 0x20, 0x4c, 0x01, 0xfa, 0xdd, 0x35, 0xad, 0xc8, 0x51, 0x5c, 0x8d, 0x3d, 0x20, 0x93, 0x7d,
+	0x81, 0x69, 0x76, 0xd8, 0x4c, 0xf9, 0x89, 0xde, 0x86, 0x1e, 0x89, 0x82, 0xd0, 0x9a, 0xe0, 0xa1,
+	0xb4, 0xdc, 0x1e, 0xe3, 0xab, 0x2b, 0xc0, 0x4f, 0x38, 0x94, 0x16, 0x5d, 0x9e, 0xf5, 0x82, 0x9e,
+	0x21, 0x53, 0x15, 0x12, 0x59, 0xde, 0xb4, 0xaf, 0xaf, 0x69, 0xeb, 0x35, 0xb3, 0xe7, 0x59, 0x2f,
+	0xcc, 0xe0, 0xf9, 0x23, 0x09, 0x66, 0xb8, 0x8e, 0x9f, 0xc3, 0x5d, 0x11, 0xb8, 0x8e, 0x9f, 0xc6,
+	0x35, 0xfe, 0xb3, 0x02, 0xdd, 0xac, 0xd0, 0xa8, 0x17, 0xe1, 0xe5, 0x81, 0xb4, 0x04, 0xf9, 0x49,
+	0x45, 0x88, 0x7d, 0xda, 0x19, 0xe2, 0xb5, 0x08, 0x33, 0x84, 0x86, 0xd9, 0xe2, 0x30, 0xb6, 0x00,
+	0x55, 0x68, 0x7e, 0x54, 0xcc, 0xfa, 0xaa, 0x4c, 0x7c, 0x4d, 0x06, 0x61, 0xd1, 0xbb, 0x0f, 0xcb,
+	0xb2, 0x8c, 0xe1, 0x66, 0x20, 0x3f, 0xe9, 0xc8, 0xe1, 0xcc, 0x61, 0x54, 0xb9, 0x19, 0xc8, 0x4f,
+	0xb4, 0x03, 0x6d, 0xbe, 0xe4, 0xd4, 0x0a, 0x2d, 0x4f, 0x1a, 0xc1, 0x1b, 0x4a, 0x47, 0xf2, 0x01,
+	0x3e, 0x79, 0x42, 0x7d, 0xd2, 0xbe, 0xe5, 0x84, 0x26, 0x57, 0x9a, 0x7d, 0x36, 0x0b, 0xad, 0x83,
+	0xce, 0x57, 0x19, 0x3b, 0x2e, 0x16, 0xe6, 0xb4, 0xcc, 0x52, 0x84, 0x2e, 0x83, 0xdf, 0x77, 0x5c,
+	0xcc, 0x2d, 0x26, 0xde, 0x02, 0x53, 0x93, 0x06, 0x37, 0x18, 0x06, 0x61, 0x4a, 0x72, 0x1d, 0x3a,
+	0x7c, 0x58, 0x1e, 0x18, 0x8f, 0x07, 0x9c, 0x47, 0x79, 0x5c, 0x34, 0x4b, 0x99, 0x79, 0xdc, 0xe4,
+	0x80, 0x6f, 0xc7, 0x9f, 0x79, 0xd4, 0xe0, 0x8c, 0x3f, 0xa8, 0xc1, 0x2a, 0xf5, 0x3b, 0xc2, 0x05,
+	0x9d, 0x23, 0xde, 0xbf, 0x06, 0x60, 0x93, 0x68, 0x98, 0xf1, 0x95, 0x4d, 0x9b, 0x44, 0x22, 0x1a,
+	0x7c, 0x4f, 0x86, 0xeb, 0xea, 0xfc, 0x0c, 0x3e, 0xe7, 0x07, 0x8b, 0x21, 0xfb, 0x4c, 0x5d, 0xa2,
+	0xeb, 0xd0, 0x21, 0xc1, 0x2c, 0x1c, 0xe1, 0x61, 0xa6, 0xd6, 0x6a, 0x73, 0xe0, 0x43, 0xb5, 0x37,
+	0x5f, 0x52, 0x76, 0xab, 0x52, 0x61, 0x7b, 0xf9, 0x7c, 0x61, 0xbb, 0x91, 0x0f, 0xdb, 0x1f, 0x40,
+	0x8f, 0xb9, 0xa2, 0xd8, 0x8c, 0xa5, 0x07, 0x2b, 0x63, 0xc7, 0x5d, 0x36, 0x55, 0x7e, 0x92, 0x74,
+	0xe8, 0x85, 0x4c, 0xe8, 0xa5, 0xc2, 0xf0, 0x31, 0xb6, 0x87, 0x51, 0x68, 0xf9, 0x64, 0x8c, 0x43,
+	0x16, 0xba, 0x1b, 0x66, 0x9b, 0x02, 0x1f, 0x09, 0x98, 0xf1, 0x4f, 0x15, 0xb8, 0x2c, 0x2a, 0xe8,
+	0xf3, 0xeb, 0xc5, 0xbc, 0xf8, 0x29, 0x03, 0x50, 0xf5, 0x94, 0x9a, 0xb4, 0x56, 0x22, 0x37, 0xac,
+	0x2b, 0x72, 0xc3, 0x6c, 0x5d, 0xb6, 0x54, 0xa8, 0xcb, 0xe2, 0x46, 0xd0, 0x72, 0xf9, 0x46, 0x10,
+	0xed, 0x38, 0xb0, 0x62, 0x81, 0x9d, 0x5d, 0xd3, 0xe4, 0x1f, 0xe5, 0x04, 0xfa, 0xef, 0x1a, 0x74,
+	0x0e, 0xb0, 0x15, 0x8e, 0x8e, 0xa4, 0x1c, 0xdf, 0x4b, 0x37, 0xce, 0xde, 0x9c, 0x73, 0xc4, 0x99,
+	0x29, 0x5f, 0x9f, 0x8e, 0xd9, 0x7f, 0x68, 0xd0, 0xfe, 0x55, 0x3a, 0x24, 0x37, 0x7b, 0x27, 0xbd,
+	0xd9, 0xb7, 0xe6, 0x6c, 0xd6, 0xc4, 0x51, 0xe8, 0xe0, 0x63, 0xfc, 0xb5, 0xdb, 0xee, 0x3f, 0x6a,
+	0x30, 0x38, 0x38, 0xf1, 0x47, 0x26, 0xb7, 0xe5, 0xf3, 0x5b, 0xcc, 0x75, 0xe8, 0x1c, 0x67, 0xd2,
+	0xc6, 0x0a, 0x53, 0xb8, 0xf6, 0x71, 0xba, 0xf2, 0x34, 0x41, 0x97, 0xfd, 0x3a, 0xb1, 0x59, 0xe9,
+	0x5a, 0xdf, 0x56, 0x71, 0x9d, 0x63, 0x8e, 0xb9, 0xa6, 0x5e, 0x98, 0x05, 0x1a, 0xbf, 0xa7, 0xc1,
+	0xaa, 0x02, 0x11, 0x5d, 0x81, 0x65, 0x51, 0xe5, 0xf6, 0xb5, 0x94, 0x0d, 0xdb, 0xf4, 0x78, 0x92,
+	0x3e, 0x8d, 0x63, 0x17, 0x73, 0x51, 0x1b, 0xbd, 0x0e, 0xad, 0xb8, 0x1c, 0xb1, 0x0b, 0xe7, 0x63,
+	0x13, 0x34, 0x80, 0x86, 0x70, 0x4e, 0xb2, 0xce, 0x8b, 0xbf, 0x8d, 0xbf, 0xd5, 0xe0, 0xf2, 0xfb,
+	0x96, 0x6f, 0x07, 0xe3, 0xf1, 0xf9, 0xc5, 0xba, 0x0d, 0x99, 0x2a, 0xa6, 0x6c, 0x7f, 0x24, 0x33,
+	0x09, 0xdd, 0x84, 0x95, 0x90, 0x7b, 0x46, 0x3b, 0x2b, 0xf7, 0xaa, 0xa9, 0xcb, 0x81, 0x58, 0x9e,
+	0x7f, 0x51, 0x01, 0x44, 0x83, 0xc1, 0x3d, 0xcb, 0xb5, 0xfc, 0x11, 0x3e, 0x3b, 0xeb, 0x37, 0xa0,
+	0x9b, 0x09, 0x61, 0xf1, 0x65, 0x5c, 0x3a, 0x86, 0x11, 0xf4, 0x01, 0x74, 0x0f, 0x39, 0xa9, 0x61,
+	0x88, 0x2d, 0x12, 0xf8, 0xcc, 0xb9, 0x76, 0xd5, 0xad, 0x90, 0x47, 0xa1, 0x33, 0x99, 0xe0, 0x70,
+	0x3b, 0xf0, 0x6d, 0x91, 0x0c, 0x1e, 0x4a, 0x36, 0xe9, 0x54, 0x7a, 0x70, 0x49, 0x3c, 0x97, 0x47,
+	0x03, 0x71, 0x40, 0x67, 0xa2, 0x20, 0xd8, 0x72, 0x13, 0x41, 0x24, 0xde, 0x58, 0xe7, 0x03, 0x07,
+	0xf3, 0x3b, 0x61, 0x8a, 0xf8, 0x6a, 0xfc, 0xb5, 0x06, 0x28, 0x2e, 0xd8, 0x58, 0x69, 0xca, 0xb4,
+	0x2f, 0x3f, 0x55, 0x2b, 0x4e, 0xa5, 0xb1, 0xd5, 0x96, 0x33, 0x85, 0xb9, 0x24, 0x00, 0xe6, 0xa3,
+	0x19, 0xd3, 0x43, 0x1a, 0x8c, 0xb1, 0x2d, 0x0b, 0x22, 0x0e, 0xfc, 0x90, 0xc1, 0xb2, 0xe1, 0xb9,
+	0x96, 0x0f, 0xcf, 0xe9, 0x46, 0x4f, 0x3d, 0xd3, 0xe8, 0x31, 0x3e, 0xab, 0x80, 0xce, 0xdc, 0xdd,
+	0x76, 0xd2, 0x6d, 0x28, 0xc5, 0xf4, 0x75, 0xe8, 0x88, 0xeb, 0xea, 0x0c, 0xe3, 0xed, 0x67, 0xa9,
+	0xc5, 0xd0, 0xbb, 0x70, 0x91, 0x23, 0x85, 0x98, 0xcc, 0xdc, 0xa4, 0x16, 0xe0, 0xc9, 0x2c, 0x7a,
+	0xc6, 0xfd, 0x2c, 0x1d, 0x92, 0x33, 0x1e, 0xc3, 0xe5, 0x89, 0x1b, 0x1c, 0x5a, 0xee, 0x30, 0x7b,
+	0x3c, 0xfc, 0x0c, 0x4b, 0x68, 0xfc, 0x45, 0x3e, 0xfd, 0x20, 0x7d, 0x86, 0x04, 0xed, 0xd2, 0xbe,
+	0x02, 0x7e, 0x9a, 0x94, 0x19, 0xf5, 0xd2, 0x65, 0x46, 0x9b, 0x4e, 0x94, 0x5f, 0xc6, 0x1f, 0x6b,
+	0xd0, 0xcb, 0xf5, 0x6a, 0xf3, 0x35, 0xad, 0x56, 0xac, 0x69, 0xef, 0x40, 0x9d, 0x50, 0x5c, 0x26,
+	0xa4, 0xae, 0xba, 0xde, 0xca, 0xae, 0x6a, 0xf2, 0x09, 0xe8, 0x16, 0xac, 0x2a, 0xee, 0x46, 0x85,
+	0x0e, 0xa0, 0xe2, 0xd5, 0xa8, 0xf1, 0xf3, 0x1a, 0xb4, 0x52, 0xf2, 0x58, 0x50, 0x8e, 0x97, 0x69,
+	0xbe, 0xe5, 0xb6, 0x57, 0x2d, 0x6e, 0x6f, 0xce, 0xcd, 0x1b, 0xd5, 0x3b, 0x0f, 0x7b, 0x3c, 0xf9,
+	0x17, 0x95, 0x88, 0x87, 0x3d, 0x96, 0xfa, 0xa7, 0xb3, 0xfa, 0xa5, 0x4c, 0x56, 0x9f, 0xab, 0x7b,
+	0x96, 0x4f, 0xa9, 0x7b, 0x1a, 0xd9, 0xba, 0x27, 0x63, 0x47, 0xcd, 0xbc, 0x1d, 0x95, 0xad, 0x90,
+	0xdf, 0x85, 0xd5, 0x51, 0x88, 0xad, 0x08, 0xdb, 0xf7, 0x4e, 0xb6, 0xe3, 0x21, 0x91, 0x19, 0xa9,
+	0x86, 0xd0, 0xfd, 0xa4, 0x69, 0xc5, 0x4f, 0xb9, 0xcd, 0x4e, 0x59, 0x5d, 0x56, 0x89, 0xb3, 0xe1,
+	0x87, 0xdc, 0x26, 0xa9, 0xaf, 0x7c, 0x6d, 0xde, 0x39, 0x53, 0x6d, 0xfe, 0x3a, 0xb4, 0x64, 0x68,
+	0xa5, 0xe6, 0xde, 0xe5, 0x9e, 0x4f, 0x80, 0x68, 0xc8, 0x4a, 0x3b, 0x83, 0x5e, 0xb6, 0xeb, 0x9b,
+	0x2f, 0x4a, 0xf5, 0x62, 0x51, 0x7a, 0x05, 0x96, 0x1d, 0x32, 0x1c, 0x5b, 0x4f, 0x31, 0x2b, 0x83,
+	0x1b, 0xe6, 0x92, 0x43, 0xee, 0x5b, 0x4f, 0xb1, 0xf1, 0xcf, 0x55, 0xe8, 0x26, 0x55, 0x4c, 0x69,
+	0x37, 0x52, 0xe6, 0x7d, 0xc0, 0x43, 0xd0, 0x93, 0x40, 0xcd, 0x24, 0x7c, 0x6a, 0x21, 0x96, 0xbf,
+	0x4a, 0xe9, 0x4d, 0xb3, 0x80, 0x6c, 0xb3, 0xba, 0xf6, 0x52, 0xcd, 0xea, 0x73, 0xde, 0x53, 0xde,
+	0x86, 0x4b, 0x71, 0x00, 0xce, 0x6c, 0x9b, 0x67, 0xf9, 0x17, 0xe5, 0xe0, 0x7e, 0x7a, 0xfb, 0x73,
+	0x5c, 0xc0, 0xf2, 0x3c, 0x17, 0x90, 0x57, 0x81, 0x46, 0x41, 0x05, 0x8a, 0xd7, 0xa5, 0x4d, 0xc5,
+	0x75, 0xa9, 0xf1, 0x18, 0x56, 0x59, 0x1f, 0x92, 0xde, 0x3f, 0x1d, 0xe2, 0x38, 0x67, 0x2d, 0x73,
+	0xac, 0x03, 0x68, 0xe4, 0xd2, 0xde, 0xf8, 0xdb, 0xf8, 0x54, 0x83, 0xcb, 0xc5, 0x75, 0x99, 0xc6,
+	0x24, 0x8e, 0x44, 0xcb, 0x38, 0x92, 0x5f, 0x87, 0xd5, 0x64, 0xf9, 0x6c, 0x42, 0x3d, 0x27, 0x65,
+	0x54, 0x30, 0x6e, 0xa2, 0x64, 0x0d, 0x09, 0x33, 0x7e, 0xae, 0xc5, 0xed, 0x5c, 0x0a, 0x9b, 0xb0,
+	0x26, 0x37, 0x0d, 0x6e, 0x81, 0xef, 0x3a, 0x3e, 0x1e, 0x66, 0xd8, 0x69, 0x73, 0xa0, 0xa8, 0xba,
+	0xdf, 0x87, 0x9e, 0x40, 0x8a, 0x63, 0x54, 0xc9, 0xac, 0xac, 0xcb, 0xe7, 0xc5, 0xd1, 0xe9, 0x06,
+	0x74, 0x45, 0xf7, 0x59, 0xd2, 0xab, 0xaa, 0x7a, 0xd2, 0xbf, 0x02, 0xba, 0x44, 0x7b, 0xd9, 0xa8,
+	0xd8, 0x13, 0x13, 0xe3, 0xec, 0xee, 0xb7, 0x35, 0xe8, 0x67, 0x63, 0x64, 0x6a, 0xfb, 0x2f, 0x9f,
+	0xe3, 0x7d, 0x3f, 0x7b, 0x6f, 0x77, 0xe3, 0x14, 0x7e, 0x12, 0x3a, 0xf2, 0xf6, 0xee, 0x21, 0xbb,
+	0x83, 0xa5, 0xa5, 0xc9, 0x8e, 0x43, 0xa2, 0xd0, 0x39, 0x9c, 0x9d, 0xeb, 0x01, 0x89, 0xf1, 0x37,
+	0x15, 0xf8, 0xa6, 0x72, 0xc1, 0xf3, 0xdc, 0xd0, 0xcd, 0xeb, 0x04, 0xdc, 0x83, 0x46, 0xae, 0x84,
+	0x79, 0xeb, 0x94, 0xcd, 0x8b, 0xa6, 0x16, 0x6f, 0xae, 0xc8, 0x79, 0x74, 0x8d, 0x58, 0xa7, 0x6b,
+	0xf3, 0xd7, 0x10, 0x4a, 0x9b, 0x59, 0x43, 0xce, 0xa3, 0xfd, 0x6d, 0x5e, 0x1e, 0x0e, 0x8f, 0x1d,
+	0xfc, 0x5c, 0x5e, 0x2c, 0x5d, 0x53, 0xfa, 0x35, 0x86, 0xf7, 0xc4, 0xc1, 0xcf, 0xcd, 0x96, 0x1b,
+	0xff, 0x26, 0xc6, 0x7f, 0x55, 0x01, 0x92, 0x31, 0x5a, 0x9b, 0x26, 0x06, 0x23, 0x2c, 0x20, 0x05,
+	0xa1, 0x81, 0x38, 0x9b, 0xfb, 0xc9, 0x4f, 0x64, 0x26, 0xfd, 0x61, 0xdb, 0x21, 0x91, 0x90, 0xcb,
+	0xad, 0xd3, 0x79, 0x91, 0x22, 0xa2, 0x47, 0xc6, 0xef, 0x6d, 0x5a, 0x24, 0x81, 0xa0, 0x77, 0x00,
+	0x4d, 0xc2, 0xe0, 0xb9, 0xe3, 0x4f, 0xd2, 0x19, 0x3b, 0x4f, 0xec, 0x57, 0xc4, 0x48, 0x2a, 0x65,
+	0xff, 0x29, 0xe8, 0x39, 0x74, 0x29, 0x92, 0xdb, 0x0b, 0xd8, 0xd8, 0xcd, 0xac, 0x25, 0xae, 0x90,
+	0x7a, 0x59, 0x0a, 0x64, 0x30, 0x04, 0x3d, 0xcf, 0xaf, 0xe2, 0x12, 0xe8, 0xbb, 0xd9, 0x4b, 0xa0,
+	0xd3, 0xcc, 0x94, 0x2e, 0x93, 0xba, 0x05, 0x1a, 0x8c, 0xe1, 0xa2, 0x8a, 0x13, 0x05, 0x91, 0x3b,
+	0x59, 0x22, 0x65, 0x72, 0xda, 0x84, 0x8e, 0xf1, 0x23, 0x68, 0xa5, 0x38, 0x98, 0xeb, 0x81, 0x53,
+	0x4d, 0xb9, 0x4a, 0xa6, 0x29, 0x67, 0xfc, 0xa1, 0x06, 0xa8, 0xa8, 0xdd, 0xa8, 0x0b, 0x95, 0x78,
+	0x91, 0xca, 0xde, 0x4e, 0x4e, 0x9b, 0x2a, 0x05, 0x6d, 0xba, 0x0a, 0xcd, 0x38, 0x22, 0x0a, 0xf7,
+	0x97, 0x00, 0xd2, 0xba, 0x56, 0xcb, 0xea, 0x5a, 0x8a, 0xb1, 0x7a, 0x96, 0xb1, 0x23, 0x40, 0x45,
+	0x8b, 0x49, 0xaf, 0xa4, 0x65, 0x57, 0x5a, 0xc4, 0x61, 0x8a, 0x52, 0x35, 0x4b, 0xe9, 0xdf, 0x2a,
+	0x80, 0x92, 0x98, 0x1f, 0xdf, 0x84, 0x95, 0x09, 0x94, 0xb7, 0x60, 0xb5, 0x98, 0x11, 0xc8, 0x34,
+	0x08, 0x15, 0xf2, 0x01, 0x55, 0xec, 0xae, 0xaa, 0x9e, 0x3a, 0xbd, 0x17, 0xfb, 0x38, 0x9e, 0xe0,
+	0x5c, 0x9b, 0x97, 0xe0, 0xe4, 0xdc, 0xdc, 0x6f, 0xe4, 0x9f, 0x48, 0x71, 0xa3, 0xb9, 0xa3, 0xf4,
+	0x47, 0x85, 0x2d, 0xbf, 0xfa, 0xf7, 0x51, 0xff, 0x52, 0x81, 0x95, 0x58, 0x1a, 0x2f, 0x25, 0xe9,
+	0xc5, 0x37, 0x8f, 0xaf, 0x58, 0xb4, 0x1f, 0xab, 0x45, 0xfb, 0x8b, 0xa7, 0xe6, 0xb0, 0x5f, 0x9c,
+	0x64, 0x0f, 0x60, 0x59, 0xb4, 0xcf, 0x0a, 0xb6, 0x5b, 0xa6, 0x4a, 0xbc, 0x08, 0x75, 0xea, 0x2a,
+	0x64, 0x3f, 0x89, 0x7f, 0x18, 0x7f, 0xa5, 0x01, 0xd0, 0xf6, 0xe2, 0x5d, 0x6e, 0x42, 0xef, 0x42,
+	0x6d, 0xd1, 0x0b, 0x11, 0x8a, 0xcd, 0x92, 0x6e, 0x86, 0x59, 0xe2, 0xd4, 0x32, 0x05, 0x6e, 0x35,
+	0x5f, 0xe0, 0xce, 0x2b, 0x4d, 0xe7, 0xbb, 0x8d, 0xbf, 0xa7, 0x6f, 0xd1, 0x4f, 0xfc, 0xd1, 0xe7,
+	0x92, 0x8b, 0x94, 0x12, 0x5d, 0xca, 0x25, 0x55, 0xb3, 0x2e, 0xe9, 0x0e, 0x2c, 0xf3, 0x1a, 0x53,
+	0xe6, 0x05, 0xd7, 0xe6, 0x89, 0x8c, 0x0b, 0xd8, 0x94, 0xe8, 0x1b, 0xbf, 0x0c, 0xcd, 0xb8, 0xd7,
+	0x8b, 0x5a, 0xb0, 0xfc, 0xd8, 0xff, 0xc0, 0x0f, 0x9e, 0xfb, 0xfa, 0x05, 0xb4, 0x0c, 0xd5, 0xbb,
+	0xae, 0xab, 0x6b, 0xa8, 0x03, 0xcd, 0x83, 0x28, 0xc4, 0x96, 0xe7, 0xf8, 0x13, 0xbd, 0x82, 0xba,
+	0x00, 0xef, 0x3b, 0x24, 0x0a, 0x42, 0x67, 0x64, 0xb9, 0x7a, 0x75, 0xe3, 0x13, 0xe8, 0x66, 0x2b,
+	0x29, 0xd4, 0x86, 0xc6, 0xc3, 0x20, 0xfa, 0xf1, 0x0b, 0x87, 0x44, 0xfa, 0x05, 0x8a, 0xff, 0x30,
+	0x88, 0xf6, 0x43, 0x4c, 0xb0, 0x1f, 0xe9, 0x1a, 0x02, 0x58, 0xfa, 0x89, 0xbf, 0xe3, 0x90, 0xa7,
+	0x7a, 0x05, 0xad, 0x8a, 0x26, 0x89, 0xe5, 0xee, 0x89, 0xf2, 0x44, 0xaf, 0xd2, 0xe9, 0xf1, 0x57,
+	0x0d, 0xe9, 0xd0, 0x8e, 0x51, 0x76, 0xf7, 0x1f, 0xeb, 0x75, 0xd4, 0x84, 0x3a, 0xff, 0xb9, 0xb4,
+	0x61, 0x83, 0x9e, 0xef, 0xf0, 0xd1, 0x35, 0xf9, 0x26, 0x62, 0x90, 0x7e, 0x81, 0xee, 0x4c, 0xb4,
+	0x58, 0x75, 0x0d, 0xf5, 0xa0, 0x95, 0x6a, 0x58, 0xea, 0x15, 0x0a, 0xd8, 0x0d, 0xa7, 0x23, 0x71,
+	0x7a, 0x9c, 0x05, 0x9a, 0x4b, 0xef, 0x50, 0x49, 0xd4, 0x36, 0xee, 0x41, 0x43, 0x96, 0x78, 0x14,
+	0x55, 0x88, 0x88, 0x7e, 0xea, 0x17, 0xd0, 0x0a, 0x74, 0x32, 0x4f, 0x40, 0x75, 0x0d, 0x21, 0xe8,
+	0x66, 0x5f, 0x58, 0xeb, 0x95, 0x8d, 0x2d, 0x80, 0xc4, 0xd4, 0x29, 0x3b, 0x7b, 0xfe, 0xb1, 0xe5,
+	0x3a, 0x36, 0xe7, 0x8d, 0x0e, 0x51, 0xe9, 0x32, 0xe9, 0xf0, 0x56, 0x9d, 0x5e, 0xd9, 0x78, 0x1d,
+	0x1a, 0x52, 0xcb, 0x29, 0xdc, 0xc4, 0x5e, 0x70, 0x8c, 0xf9, 0xc9, 0x1c, 0xe0, 0x48, 0xd7, 0xb6,
+	0xfe, 0xa7, 0x03, 0xc0, 0x9b, 0x72, 0x41, 0x10, 0xda, 0xc8, 0x05, 0xb4, 0x8b, 0x23, 0xda, 0x70,
+	0x08, 0x7c, 0xd9, 0x2c, 0x20, 0x68, 0x33, 0xab, 0x0a, 0xe2, 0xa3, 0x88, 0x28, 0x76, 0x3f, 0x78,
+	0x53, 0x89, 0x9f, 0x43, 0x36, 0x2e, 0x20, 0x8f, 0x51, 0xa3, 0xd7, 0xda, 0x8f, 0x9c, 0xd1, 0xd3,
+	0xb8, 0x93, 0x37, 0xff, 0x79, 0x74, 0x0e, 0x55, 0xd2, 0xbb, 0xae, 0xa4, 0x77, 0x10, 0x85, 0x8e,
+	0x3f, 0x91, 0xa9, 0xb8, 0x71, 0x01, 0x3d, 0xcb, 0x3d, 0xce, 0x96, 0x04, 0xb7, 0xca, 0xbc, 0xc7,
+	0x3e, 0x1b, 0x49, 0x17, 0x7a, 0xb9, 0x3f, 0x9b, 0xa0, 0x0d, 0xf5, 0x7b, 0x3b, 0xd5, 0x1f, 0x63,
+	0x06, 0x37, 0x4b, 0xe1, 0xc6, 0xd4, 0x1c, 0xe8, 0x66, 0xff, 0x50, 0x81, 0x7e, 0x61, 0xde, 0x02,
+	0x85, 0x17, 0xbf, 0x83, 0x8d, 0x32, 0xa8, 0x31, 0xa9, 0x8f, 0xb8, 0x82, 0x2e, 0x22, 0xa5, 0x7c,
+	0xda, 0x3c, 0x38, 0xad, 0x0a, 0x32, 0x2e, 0xa0, 0x9f, 0xc1, 0x4a, 0xe1, 0x5d, 0x32, 0xfa, 0x96,
+	0xfa, 0xb6, 0x46, 0xfd, 0x7c, 0x79, 0x11, 0x85, 0x8f, 0xf2, 0xe6, 0x35, 0x9f, 0xfb, 0xc2, 0xdf,
+	0x0c, 0xca, 0x73, 0x9f, 0x5a, 0xfe, 0x34, 0xee, 0x5f, 0x9a, 0xc2, 0x8c, 0x99, 0x4d, 0xbe, 0x35,
+	0xfc, 0x8e, 0x8a, 0xc4, 0xdc, 0xc7, 0xd1, 0x83, 0xcd, 0xb2, 0xe8, 0x69, 0xed, 0xca, 0xbe, 0xbf,
+	0x55, 0x0b, 0x4d, 0xf9, 0x66, 0x78, 0xb0, 0x51, 0x06, 0x35, 0x26, 0xf5, 0x28, 0xe3, 0x5e, 0xd1,
+	0x5b, 0xf3, 0x0e, 0x27, 0x7b, 0x61, 0xb4, 0x48, 0x6e, 0xbf, 0x09, 0x88, 0xdb, 0x8e, 0x3f, 0x76,
+	0x26, 0xb3, 0xd0, 0xe2, 0x8a, 0x35, 0xcf, 0xdd, 0x14, 0x51, 0x25, 0x99, 0x6f, 0xbf, 0xc4, 0x8c,
+	0x78, 0x4b, 0x43, 0x80, 0x5d, 0x1c, 0x3d, 0xc0, 0x51, 0xe8, 0x8c, 0x48, 0x7e, 0x47, 0x89, 0x47,
+	0x15, 0x08, 0x92, 0xd4, 0xdb, 0x0b, 0xf1, 0x62, 0x02, 0x87, 0xd0, 0xda, 0xc5, 0x91, 0xc8, 0xab,
+	0x08, 0x9a, 0x3b, 0x53, 0x62, 0x48, 0x12, 0xeb, 0x8b, 0x11, 0xd3, 0xee, 0x2c, 0xf7, 0x16, 0x19,
+	0xcd, 0x3d, 0xd8, 0xe2, 0x0b, 0xe9, 0xc1, 0xcd, 0x52, 0xb8, 0xe9, 0x1d, 0x6d, 0x1f, 0xe1, 0xd1,
+	0xd3, 0xf7, 0xb1, 0xe5, 0x46, 0x47, 0x73, 0x76, 0x94, 0xc2, 0x38, 0x7d, 0x47, 0x19, 0x44, 0x49,
+	0x63, 0xeb, 0xb3, 0x2e, 0x34, 0x59, 0xfc, 0xa3, 0xc1, 0xfa, 0xff, 0xc3, 0xdf, 0xe7, 0x1c, 0xfe,
+	0x3e, 0x86, 0x5e, 0xee, 0xe9, 0xac, 0x5a, 0x5f, 0xd4, 0xef, 0x6b, 0x4b, 0x78, 0xf1, 0xec, 0xe3,
+	0x55, 0xb5, 0x43, 0x52, 0x3e, 0x70, 0x5d, 0xb4, 0xf6, 0x13, 0xfe, 0xea, 0x3c, 0xee, 0x9b, 0xbe,
+	0x3d, 0xb7, 0xf2, 0xca, 0xde, 0xb7, 0x7f, 0xf9, 0xd1, 0xe1, 0xd5, 0x47, 0xcf, 0x8f, 0xa1, 0x97,
+	0x7b, 0xf5, 0xa4, 0x3e, 0x55, 0xf5, 0xd3, 0xa8, 0x45, 0xab, 0x7f, 0x81, 0x61, 0xc6, 0x86, 0x55,
+	0xc5, 0x83, 0x14, 0xb4, 0x39, 0xaf, 0xf2, 0x51, 0xbf, 0x5c, 0x59, 0xbc, 0xa1, 0x4e, 0xc6, 0x94,
+	0xd0, 0xfa, 0x3c, 0x26, 0xf3, 0x7f, 0xfe, 0x1b, 0x7c, 0xab, 0xdc, 0x3f, 0x05, 0xe3, 0x0d, 0x1d,
+	0xc0, 0x12, 0x7f, 0x0b, 0x85, 0xde, 0x50, 0xee, 0x21, 0xfd, 0x4e, 0x6a, 0xb0, 0xe8, 0x35, 0x15,
+	0x99, 0xb9, 0x11, 0x61, 0x8b, 0xd6, 0x99, 0x87, 0x44, 0xca, 0x47, 0x7c, 0xe9, 0x07, 0x4c, 0x83,
+	0xc5, 0x6f, 0x96, 0xe4, 0xa2, 0xff, 0xb7, 0x63, 0xf1, 0x0b, 0x58, 0x55, 0xdc, 0x0a, 0xa0, 0x79,
+	0x39, 0xd7, 0x9c, 0xfb, 0x88, 0xc1, 0xad, 0xd2, 0xf8, 0x31, 0xe5, 0x9f, 0x82, 0x9e, 0xef, 0x28,
+	0xa0, 0x9b, 0xf3, 0xf4, 0x59, 0x45, 0xf3, 0x74, 0x65, 0xbe, 0xf7, 0x9d, 0x8f, 0xb6, 0x26, 0x4e,
+	0x74, 0x34, 0x3b, 0xa4, 0x23, 0xb7, 0x38, 0xea, 0x3b, 0x4e, 0x20, 0x7e, 0xdd, 0x92, 0xf2, 0xbf,
+	0xc5, 0x66, 0xdf, 0x62, 0xa4, 0xa6, 0x87, 0x87, 0x4b, 0xec, 0xf3, 0xf6, 0xff, 0x0e, 0x00, 0xba,
+	0x2b, 0xb5, 0xfd, 0x77, 0x40, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
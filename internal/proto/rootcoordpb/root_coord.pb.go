@@ -29,6 +29,68 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ExportFormat int32
+
+const (
+	// one JSON file of rows per segment, it's the format of the row-based import.
+	ExportFormat_ExportJSON ExportFormat = 0
+	// one NumPy file of each field per segment, it's the format of the column-based import.
+	ExportFormat_ExportNumpy ExportFormat = 1
+	// one Parquet file per segment, each field is a column.
+	ExportFormat_ExportParquet ExportFormat = 2
+)
+
+var ExportFormat_name = map[int32]string{
+	0: "ExportJSON",
+	1: "ExportNumpy",
+	2: "ExportParquet",
+}
+
+var ExportFormat_value = map[string]int32{
+	"ExportJSON":    0,
+	"ExportNumpy":   1,
+	"ExportParquet": 2,
+}
+
+func (x ExportFormat) String() string {
+	return proto.EnumName(ExportFormat_name, int32(x))
+}
+
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{0}
+}
+
+type ExportState int32
+
+const (
+	ExportState_ExportPending   ExportState = 0
+	ExportState_ExportStarted   ExportState = 1
+	ExportState_ExportCompleted ExportState = 2
+	ExportState_ExportFailed    ExportState = 3
+)
+
+var ExportState_name = map[int32]string{
+	0: "ExportPending",
+	1: "ExportStarted",
+	2: "ExportCompleted",
+	3: "ExportFailed",
+}
+
+var ExportState_value = map[string]int32{
+	"ExportPending":   0,
+	"ExportStarted":   1,
+	"ExportCompleted": 2,
+	"ExportFailed":    3,
+}
+
+func (x ExportState) String() string {
+	return proto.EnumName(ExportState_name, int32(x))
+}
+
+func (ExportState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{1}
+}
+
 type AllocTimestampRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Count                uint32            `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
@@ -655,323 +717,2259 @@ func (m *GetCredentialResponse) GetPassword() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
-	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
-	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
-	proto.RegisterType((*AllocIDResponse)(nil), "milvus.proto.rootcoord.AllocIDResponse")
-	proto.RegisterType((*ImportResult)(nil), "milvus.proto.rootcoord.ImportResult")
-	proto.RegisterType((*DescribeSegmentsRequest)(nil), "milvus.proto.rootcoord.DescribeSegmentsRequest")
-	proto.RegisterType((*SegmentBaseInfo)(nil), "milvus.proto.rootcoord.SegmentBaseInfo")
-	proto.RegisterType((*SegmentInfos)(nil), "milvus.proto.rootcoord.SegmentInfos")
-	proto.RegisterMapType((map[int64]*etcdpb.IndexInfo)(nil), "milvus.proto.rootcoord.SegmentInfos.ExtraIndexInfosEntry")
-	proto.RegisterType((*DescribeSegmentsResponse)(nil), "milvus.proto.rootcoord.DescribeSegmentsResponse")
-	proto.RegisterMapType((map[int64]*SegmentInfos)(nil), "milvus.proto.rootcoord.DescribeSegmentsResponse.SegmentInfosEntry")
-	proto.RegisterType((*GetCredentialRequest)(nil), "milvus.proto.rootcoord.GetCredentialRequest")
-	proto.RegisterType((*GetCredentialResponse)(nil), "milvus.proto.rootcoord.GetCredentialResponse")
+type CreateDatabaseRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
+func (m *CreateDatabaseRequest) Reset()         { *m = CreateDatabaseRequest{} }
+func (m *CreateDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDatabaseRequest) ProtoMessage()    {}
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{11}
+}
 
-var fileDescriptor_4513485a144f6b06 = []byte{
-	// 1535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x6b, 0x93, 0xda, 0x36,
-	0x17, 0x0e, 0x90, 0xbd, 0x70, 0x60, 0x61, 0xa3, 0xc9, 0x85, 0x97, 0xe4, 0x7d, 0x43, 0x48, 0xde,
-	0x86, 0xdc, 0xd8, 0x74, 0x33, 0x93, 0xa6, 0xf9, 0x96, 0x85, 0xcc, 0x86, 0x69, 0x77, 0xb2, 0x35,
-	0x49, 0x27, 0xbd, 0xec, 0x50, 0x61, 0x2b, 0xe0, 0x59, 0x63, 0x11, 0x49, 0xec, 0x65, 0xfa, 0xa9,
-	0x33, 0xfd, 0xde, 0xff, 0xd4, 0xfe, 0x94, 0xfe, 0x90, 0x76, 0x64, 0xd9, 0xc2, 0x36, 0x36, 0xeb,
-	0x4d, 0xf2, 0x0d, 0x49, 0x8f, 0x9f, 0xe7, 0xe8, 0x1c, 0x9d, 0x73, 0x24, 0x60, 0x93, 0x51, 0x2a,
-	0x06, 0x26, 0xa5, 0xcc, 0x6a, 0x4f, 0x19, 0x15, 0x14, 0x5d, 0x9d, 0xd8, 0xce, 0xd1, 0x8c, 0xab,
-	0x51, 0x5b, 0x2e, 0x7b, 0xab, 0xf5, 0xb2, 0x49, 0x27, 0x13, 0xea, 0xaa, 0xf9, 0x7a, 0x39, 0x8c,
-	0xaa, 0x57, 0x6c, 0x57, 0x10, 0xe6, 0x62, 0xc7, 0x1f, 0x97, 0xa6, 0x8c, 0x9e, 0x9c, 0xfa, 0x83,
-	0x2a, 0x11, 0xa6, 0x35, 0x98, 0x10, 0x81, 0xd5, 0x44, 0x73, 0x00, 0x57, 0x5e, 0x38, 0x0e, 0x35,
-	0xdf, 0xd8, 0x13, 0xc2, 0x05, 0x9e, 0x4c, 0x0d, 0xf2, 0x61, 0x46, 0xb8, 0x40, 0x8f, 0xe1, 0xe2,
-	0x10, 0x73, 0x52, 0xcb, 0x35, 0x72, 0xad, 0xd2, 0xf6, 0x8d, 0x76, 0xc4, 0x12, 0x5f, 0x7e, 0x8f,
-	0x8f, 0x76, 0x30, 0x27, 0x86, 0x87, 0x44, 0x97, 0x61, 0xc5, 0xa4, 0x33, 0x57, 0xd4, 0x0a, 0x8d,
-	0x5c, 0x6b, 0xc3, 0x50, 0x83, 0xe6, 0x6f, 0x39, 0xb8, 0x1a, 0x57, 0xe0, 0x53, 0xea, 0x72, 0x82,
-	0x9e, 0xc0, 0x2a, 0x17, 0x58, 0xcc, 0xb8, 0x2f, 0x72, 0x3d, 0x51, 0xa4, 0xef, 0x41, 0x0c, 0x1f,
-	0x8a, 0x6e, 0x40, 0x51, 0x04, 0x4c, 0xb5, 0x7c, 0x23, 0xd7, 0xba, 0x68, 0xcc, 0x27, 0x52, 0x6c,
-	0x78, 0x07, 0x15, 0xcf, 0x84, 0x5e, 0xf7, 0x33, 0xec, 0x2e, 0x1f, 0x66, 0x76, 0xa0, 0xaa, 0x99,
-	0x3f, 0x65, 0x57, 0x15, 0xc8, 0xf7, 0xba, 0x1e, 0x75, 0xc1, 0xc8, 0xf7, 0xba, 0x29, 0xfb, 0xf8,
-	0x33, 0x0f, 0xe5, 0xde, 0x64, 0x4a, 0x99, 0x30, 0x08, 0x9f, 0x39, 0xe2, 0xe3, 0xb4, 0xae, 0xc1,
-	0x9a, 0xc0, 0xfc, 0x70, 0x60, 0x5b, 0xbe, 0xe0, 0xaa, 0x1c, 0xf6, 0x2c, 0x74, 0x13, 0x4a, 0x16,
-	0x16, 0xd8, 0xa5, 0x16, 0x91, 0x8b, 0x05, 0x6f, 0x11, 0x82, 0xa9, 0x9e, 0x85, 0x9e, 0xc2, 0x8a,
-	0xe4, 0x20, 0xb5, 0x8b, 0x8d, 0x5c, 0xab, 0xb2, 0xdd, 0x48, 0x54, 0x53, 0x06, 0x4a, 0x4d, 0x62,
-	0x28, 0x38, 0xaa, 0xc3, 0x3a, 0x27, 0xa3, 0x09, 0x71, 0x05, 0xaf, 0xad, 0x34, 0x0a, 0xad, 0x82,
-	0xa1, 0xc7, 0xe8, 0x3f, 0xb0, 0x8e, 0x67, 0x82, 0x0e, 0x6c, 0x8b, 0xd7, 0x56, 0xbd, 0xb5, 0x35,
-	0x39, 0xee, 0x59, 0x1c, 0x5d, 0x87, 0x22, 0xa3, 0xc7, 0x03, 0xe5, 0x88, 0x35, 0xcf, 0x9a, 0x75,
-	0x46, 0x8f, 0x3b, 0x72, 0x8c, 0xbe, 0x82, 0x15, 0xdb, 0x7d, 0x4f, 0x79, 0x6d, 0xbd, 0x51, 0x68,
-	0x95, 0xb6, 0x6f, 0x25, 0xda, 0xf2, 0x0d, 0x39, 0xfd, 0x1e, 0x3b, 0x33, 0xb2, 0x8f, 0x6d, 0x66,
-	0x28, 0x7c, 0xf3, 0x8f, 0x1c, 0x5c, 0xeb, 0x12, 0x6e, 0x32, 0x7b, 0x48, 0xfa, 0xbe, 0x15, 0x1f,
-	0x7f, 0x2c, 0x9a, 0x50, 0x36, 0xa9, 0xe3, 0x10, 0x53, 0xd8, 0xd4, 0xd5, 0x21, 0x8c, 0xcc, 0xa1,
-	0xff, 0x01, 0xf8, 0xdb, 0xed, 0x75, 0x79, 0xad, 0xe0, 0x6d, 0x32, 0x34, 0xd3, 0x9c, 0x41, 0xd5,
-	0x37, 0x44, 0x12, 0xf7, 0xdc, 0xf7, 0x74, 0x81, 0x36, 0x97, 0x40, 0xdb, 0x80, 0xd2, 0x14, 0x33,
-	0x61, 0x47, 0x94, 0xc3, 0x53, 0x32, 0x57, 0xb4, 0x8c, 0x1f, 0xce, 0xf9, 0x44, 0xf3, 0xef, 0x3c,
-	0x94, 0x7d, 0x5d, 0xa9, 0xc9, 0x51, 0x17, 0x8a, 0x72, 0x4f, 0x03, 0xe9, 0x27, 0xdf, 0x05, 0x77,
-	0xdb, 0xc9, 0x15, 0xa8, 0x1d, 0x33, 0xd8, 0x58, 0x1f, 0x06, 0xa6, 0x77, 0xa1, 0x64, 0xbb, 0x16,
-	0x39, 0x19, 0xa8, 0xf0, 0xe4, 0xbd, 0xf0, 0xdc, 0x8e, 0xf2, 0xc8, 0x2a, 0xd4, 0xd6, 0xda, 0x16,
-	0x39, 0xf1, 0x38, 0xc0, 0x0e, 0x7e, 0x72, 0x44, 0xe0, 0x12, 0x39, 0x11, 0x0c, 0x0f, 0xc2, 0x5c,
-	0x05, 0x8f, 0xeb, 0xeb, 0x33, 0x6c, 0xf2, 0x08, 0xda, 0x2f, 0xe5, 0xd7, 0x9a, 0x9b, 0xbf, 0x74,
-	0x05, 0x3b, 0x35, 0xaa, 0x24, 0x3a, 0x5b, 0xff, 0x05, 0x2e, 0x27, 0x01, 0xd1, 0x26, 0x14, 0x0e,
-	0xc9, 0xa9, 0xef, 0x76, 0xf9, 0x13, 0x6d, 0xc3, 0xca, 0x91, 0x3c, 0x4a, 0x9e, 0x9f, 0x17, 0xce,
-	0x86, 0xb7, 0xa1, 0xf9, 0x4e, 0x14, 0xf4, 0x79, 0xfe, 0x59, 0xae, 0xf9, 0x57, 0x1e, 0x6a, 0x8b,
-	0xc7, 0xed, 0x53, 0x6a, 0x45, 0x96, 0x23, 0x37, 0x82, 0x0d, 0x3f, 0xd0, 0x11, 0xd7, 0xed, 0xa4,
-	0xb9, 0x2e, 0xcd, 0xc2, 0x88, 0x4f, 0x95, 0x0f, 0xcb, 0x3c, 0x34, 0x55, 0x27, 0x70, 0x69, 0x01,
-	0x92, 0xe0, 0xbd, 0xe7, 0x51, 0xef, 0xdd, 0xc9, 0x12, 0xc2, 0xb0, 0x17, 0x2d, 0xb8, 0xbc, 0x4b,
-	0x44, 0x87, 0x11, 0x8b, 0xb8, 0xc2, 0xc6, 0xce, 0xc7, 0x27, 0x6c, 0x1d, 0xd6, 0x67, 0x5c, 0xf6,
-	0xc7, 0x89, 0x32, 0xa6, 0x68, 0xe8, 0x71, 0xf3, 0xf7, 0x1c, 0x5c, 0x89, 0xc9, 0x7c, 0x4a, 0xa0,
-	0x96, 0x48, 0xc9, 0xb5, 0x29, 0xe6, 0xfc, 0x98, 0x32, 0x55, 0x68, 0x8b, 0x86, 0x1e, 0x6f, 0xff,
-	0x73, 0x13, 0x8a, 0x06, 0xa5, 0xa2, 0x23, 0x5d, 0x82, 0x1c, 0x40, 0xd2, 0x26, 0x3a, 0x99, 0x52,
-	0x97, 0xb8, 0xaa, 0xb0, 0x72, 0xd4, 0x8e, 0x1a, 0xe0, 0x0f, 0x16, 0x81, 0xbe, 0xa3, 0xea, 0x77,
-	0x12, 0xf1, 0x31, 0x70, 0xf3, 0x02, 0x9a, 0x78, 0x6a, 0xb2, 0x57, 0xbf, 0xb1, 0xcd, 0xc3, 0xce,
-	0x18, 0xbb, 0x2e, 0x71, 0xd0, 0xe3, 0xe8, 0xd7, 0xfa, 0x86, 0xb1, 0x08, 0x0d, 0xf4, 0x6e, 0x27,
-	0xea, 0xf5, 0x05, 0xb3, 0xdd, 0x51, 0xe0, 0xd5, 0xe6, 0x05, 0xf4, 0xc1, 0x8b, 0xab, 0x54, 0xb7,
-	0xb9, 0xb0, 0x4d, 0x1e, 0x08, 0x6e, 0xa7, 0x0b, 0x2e, 0x80, 0xcf, 0x29, 0x39, 0x80, 0xcd, 0x0e,
-	0x23, 0x58, 0x90, 0x8e, 0x4e, 0x18, 0xf4, 0x30, 0xd9, 0x3b, 0x31, 0x58, 0x20, 0xb4, 0x2c, 0xf8,
-	0xcd, 0x0b, 0xe8, 0x27, 0xa8, 0x74, 0x19, 0x9d, 0x86, 0xe8, 0xef, 0x27, 0xd2, 0x47, 0x41, 0x19,
-	0xc9, 0x07, 0xb0, 0xf1, 0x0a, 0xf3, 0x10, 0xf7, 0xbd, 0x44, 0xee, 0x08, 0x26, 0xa0, 0xbe, 0x95,
-	0x08, 0xdd, 0xa1, 0xd4, 0x09, 0xb9, 0xe7, 0x18, 0x50, 0x50, 0x0c, 0x42, 0x2a, 0xc9, 0xc7, 0x6d,
-	0x11, 0x18, 0x48, 0x6d, 0x65, 0xc6, 0x6b, 0xe1, 0xb7, 0x50, 0x52, 0x0e, 0x7f, 0xe1, 0xd8, 0x98,
-	0xa3, 0xbb, 0x4b, 0x42, 0xe2, 0x21, 0x32, 0x3a, 0xec, 0x3b, 0x28, 0x4a, 0x47, 0x2b, 0xd2, 0xff,
-	0xa7, 0x06, 0xe2, 0x3c, 0x94, 0x7d, 0x80, 0x17, 0x8e, 0x20, 0x4c, 0x71, 0x7e, 0x91, 0xc8, 0x39,
-	0x07, 0x64, 0x24, 0x75, 0xa1, 0xda, 0x1f, 0xcb, 0xcb, 0x4d, 0xe0, 0x1a, 0x8e, 0x1e, 0x24, 0x1f,
-	0xe8, 0x28, 0x2a, 0xa0, 0x7f, 0x98, 0x0d, 0xac, 0xdd, 0x7d, 0x20, 0x6f, 0xae, 0x82, 0xb0, 0x50,
-	0x90, 0x1f, 0xa4, 0xef, 0xe4, 0xdc, 0xe7, 0xf4, 0x00, 0xaa, 0x2a, 0x56, 0xfb, 0xc1, 0x7d, 0x24,
-	0x85, 0x3e, 0x86, 0xca, 0x48, 0xff, 0x03, 0x6c, 0xc8, 0xa8, 0xcd, 0xc9, 0xef, 0xa5, 0x46, 0xf6,
-	0xbc, 0xd4, 0x07, 0x50, 0x7e, 0x85, 0xf9, 0x9c, 0xb9, 0x95, 0x96, 0x60, 0x0b, 0xc4, 0x99, 0xf2,
-	0xeb, 0x10, 0x2a, 0x32, 0x28, 0xfa, 0x63, 0x9e, 0x52, 0x1d, 0xa2, 0xa0, 0x40, 0xe2, 0x41, 0x26,
-	0xac, 0x16, 0x23, 0x50, 0x96, 0x6b, 0x41, 0x57, 0x4f, 0xd9, 0x4b, 0x18, 0x12, 0x08, 0xdd, 0xcb,
-	0x80, 0x0c, 0x55, 0xf1, 0x4a, 0xf4, 0x89, 0x87, 0x1e, 0xa5, 0x35, 0xf8, 0xc4, 0xc7, 0x66, 0xbd,
-	0x9d, 0x15, 0xae, 0x25, 0x7f, 0x86, 0x35, 0xff, 0xe1, 0x15, 0x4f, 0xc0, 0xd8, 0xc7, 0xfa, 0xcd,
-	0x57, 0xbf, 0x7b, 0x26, 0x4e, 0xb3, 0x63, 0xb8, 0xf2, 0x76, 0x6a, 0xc9, 0xe2, 0xaf, 0x5a, 0x4c,
-	0xd0, 0xe4, 0xe2, 0xc7, 0x4c, 0xf7, 0xa5, 0x18, 0x6e, 0x8f, 0x8f, 0xce, 0x3a, 0x66, 0x0c, 0xfe,
-	0xdb, 0x73, 0x8f, 0xb0, 0x63, 0x5b, 0x91, 0x1e, 0xb3, 0x47, 0x04, 0xee, 0x60, 0x73, 0x4c, 0xe2,
-	0x2d, 0x50, 0xbd, 0xe2, 0xa3, 0x9f, 0x68, 0x70, 0xc6, 0xa3, 0xfd, 0x2b, 0x20, 0x55, 0x10, 0xdc,
-	0xf7, 0xf6, 0x68, 0xc6, 0xb0, 0x3a, 0x7f, 0x69, 0xcd, 0x7d, 0x11, 0x1a, 0xc8, 0x7c, 0x79, 0x8e,
-	0x2f, 0x42, 0x7d, 0x17, 0x76, 0x89, 0xd8, 0x23, 0x82, 0xd9, 0x66, 0x5a, 0xd5, 0x9c, 0x03, 0x52,
-	0x82, 0x96, 0x80, 0xd3, 0x02, 0x7d, 0x58, 0x55, 0x6f, 0x4f, 0xd4, 0x4c, 0xfc, 0x28, 0x78, 0x39,
-	0x2f, 0xbb, 0x2d, 0xe8, 0xd7, 0x75, 0x28, 0x5d, 0x77, 0x89, 0x08, 0xbd, 0x69, 0x53, 0xd2, 0x35,
-	0x0a, 0x5a, 0x9e, 0xae, 0x71, 0xac, 0x16, 0x73, 0xa1, 0xfa, 0xad, 0xcd, 0xfd, 0xc5, 0x37, 0x98,
-	0x1f, 0xa6, 0xf5, 0x80, 0x18, 0x6a, 0x79, 0x0f, 0x58, 0x00, 0x87, 0x3c, 0x56, 0x36, 0x88, 0x5c,
-	0xf0, 0xfd, 0x96, 0x7a, 0x2d, 0x0f, 0xff, 0xe9, 0x70, 0xd6, 0x21, 0x7b, 0xa7, 0xef, 0x57, 0xfa,
-	0x1a, 0x1d, 0xef, 0xbb, 0xf3, 0xb4, 0xd1, 0x10, 0x79, 0xe3, 0xcf, 0xc0, 0xec, 0x67, 0xe5, 0xe7,
-	0x66, 0x1e, 0xc0, 0x66, 0x97, 0x38, 0x24, 0xc2, 0xfc, 0x30, 0xe5, 0x0a, 0x13, 0x85, 0x65, 0xcc,
-	0xbc, 0x31, 0x6c, 0xc8, 0x30, 0xc8, 0xef, 0xde, 0x72, 0xc2, 0x78, 0x4a, 0xbf, 0x8a, 0x60, 0x02,
-	0xea, 0xfb, 0x59, 0xa0, 0xa1, 0x33, 0xb4, 0x11, 0x79, 0xc2, 0xc4, 0xf7, 0x31, 0x0f, 0x6a, 0xd2,
-	0x83, 0xaa, 0xfe, 0x28, 0x23, 0x3a, 0x74, 0x86, 0x40, 0x85, 0xdb, 0xa0, 0x0e, 0x49, 0x49, 0xeb,
-	0x39, 0x20, 0xa3, 0xbb, 0x5e, 0xc3, 0xba, 0x6c, 0xdd, 0x1e, 0xe5, 0x9d, 0xd4, 0xce, 0x7e, 0x0e,
-	0xc2, 0x03, 0xa8, 0xbe, 0x9e, 0x12, 0x86, 0x05, 0x91, 0xfe, 0xf2, 0x78, 0x93, 0x33, 0x2b, 0x86,
-	0xca, 0x7c, 0x2b, 0x87, 0x3e, 0x91, 0x15, 0x7c, 0x89, 0x13, 0xe6, 0x80, 0xe5, 0xb5, 0x2d, 0x8c,
-	0x0b, 0x17, 0x4f, 0x35, 0x2f, 0x0d, 0x5b, 0x2a, 0xe0, 0x59, 0x9e, 0x41, 0x40, 0xe1, 0xc2, 0xaf,
-	0x22, 0x7f, 0xeb, 0xfb, 0xcc, 0x3e, 0xb2, 0x1d, 0x32, 0x22, 0x29, 0x19, 0x10, 0x87, 0x65, 0x74,
-	0xd1, 0x10, 0x4a, 0x4a, 0x78, 0x97, 0x61, 0x57, 0xa0, 0x65, 0xa6, 0x79, 0x88, 0x80, 0xb6, 0x75,
-	0x36, 0x50, 0x6f, 0xc2, 0x04, 0x90, 0x69, 0xb1, 0x4f, 0x1d, 0xdb, 0x3c, 0x8d, 0x5f, 0x76, 0x74,
-	0x69, 0x98, 0x43, 0x52, 0x2e, 0x3b, 0x89, 0x48, 0x2d, 0x32, 0x84, 0x52, 0x67, 0x4c, 0xcc, 0xc3,
-	0x57, 0x04, 0x3b, 0x62, 0x9c, 0xf6, 0x4e, 0x99, 0x23, 0x96, 0x6f, 0x24, 0x02, 0x0c, 0x34, 0x76,
-	0x9e, 0xfd, 0xf8, 0x74, 0x64, 0x8b, 0xf1, 0x6c, 0x28, 0xdd, 0xb8, 0xa5, 0xa0, 0x8f, 0x6c, 0xea,
-	0xff, 0xda, 0x0a, 0x0c, 0xdc, 0xf2, 0xa8, 0xb6, 0x74, 0x92, 0x4e, 0x87, 0xc3, 0x55, 0x6f, 0xea,
-	0xc9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x94, 0x82, 0xd4, 0x6e, 0x4c, 0x18, 0x00, 0x00,
+func (m *CreateDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDatabaseRequest.Unmarshal(m, b)
+}
+func (m *CreateDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDatabaseRequest.Merge(m, src)
+}
+func (m *CreateDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDatabaseRequest.Size(m)
+}
+func (m *CreateDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDatabaseRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_CreateDatabaseRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *CreateDatabaseRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
 
-// RootCoordClient is the client API for RootCoord service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RootCoordClient interface {
-	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
-	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	//*
-	// @brief This method is used to create collection
-	//
-	// @param CreateCollectionRequest, use to provide collection information to be created.
-	//
-	// @return Status
-	CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
-	// @brief This method is used to delete collection.
-	//
-	// @param DropCollectionRequest, collection name is going to be deleted.
-	//
-	// @return Status
-	DropCollection(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
-	// @brief This method is used to test collection existence.
-	//
-	// @param HasCollectionRequest, collection name is going to be tested.
-	//
-	// @return BoolResponse
-	HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error)
-	//*
-	// @brief This method is used to get collection schema.
-	//
-	// @param DescribeCollectionRequest, target collection name.
-	//
-	// @return CollectionSchema
-	DescribeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)
-	CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
-	// @brief This method is used to list all collections.
-	//
-	// @return StringListResponse, collection name list
-	ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error)
-	AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
-	// @brief This method is used to create partition
-	//
-	// @return Status
-	CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
-	// @brief This method is used to drop partition
-	//
-	// @return Status
-	DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	//*
-	// @brief This method is used to test partition existence.
-	//
-	// @return BoolResponse
-	HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error)
-	//*
-	// @brief This method is used to show partition information
-	//
-	// @param ShowPartitionRequest, target collection name.
-	//
-	// @return StringListResponse
-	ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error)
-	//    rpc DescribeSegment(milvus.DescribeSegmentRequest) returns (milvus.DescribeSegmentResponse) {}
-	ShowSegments(ctx context.Context, in *milvuspb.ShowSegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ShowSegmentsResponse, error)
-	AllocTimestamp(ctx context.Context, in *AllocTimestampRequest, opts ...grpc.CallOption) (*AllocTimestampResponse, error)
-	AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error)
-	UpdateChannelTimeTick(ctx context.Context, in *internalpb.ChannelTimeTickMsg, opts ...grpc.CallOption) (*commonpb.Status, error)
-	InvalidateCollectionMetaCache(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
-	Import(ctx context.Context, in *milvuspb.ImportRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error)
-	GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)
-	ListImportTasks(ctx context.Context, in *milvuspb.ListImportTasksRequest, opts ...grpc.CallOption) (*milvuspb.ListImportTasksResponse, error)
-	ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
-	CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
-	UpdateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error)
-	// userd by proxy, not exposed to sdk
-	GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+29+--+Support+Role-Based+Access+Control
-	CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	SelectRole(ctx context.Context, in *milvuspb.SelectRoleRequest, opts ...grpc.CallOption) (*milvuspb.SelectRoleResponse, error)
-	SelectUser(ctx context.Context, in *milvuspb.SelectUserRequest, opts ...grpc.CallOption) (*milvuspb.SelectUserResponse, error)
-	OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error)
-	ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest, opts ...grpc.CallOption) (*internalpb.ListPolicyResponse, error)
-	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
+func (m *CreateDatabaseRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-type rootCoordClient struct {
-	cc *grpc.ClientConn
+type DropDatabaseRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func NewRootCoordClient(cc *grpc.ClientConn) RootCoordClient {
-	return &rootCoordClient{cc}
+func (m *DropDatabaseRequest) Reset()         { *m = DropDatabaseRequest{} }
+func (m *DropDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*DropDatabaseRequest) ProtoMessage()    {}
+func (*DropDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{12}
 }
 
-func (c *rootCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
-	out := new(milvuspb.ComponentStates)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetComponentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DropDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDatabaseRequest.Unmarshal(m, b)
+}
+func (m *DropDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDatabaseRequest.Marshal(b, m, deterministic)
+}
+func (m *DropDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDatabaseRequest.Merge(m, src)
+}
+func (m *DropDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_DropDatabaseRequest.Size(m)
+}
+func (m *DropDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDatabaseRequest.DiscardUnknown(m)
 }
 
-func (c *rootCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetTimeTickChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DropDatabaseRequest proto.InternalMessageInfo
+
+func (m *DropDatabaseRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *rootCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetStatisticsChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DropDatabaseRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *rootCoordClient) CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ListDatabasesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (c *rootCoordClient) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListDatabasesRequest) Reset()         { *m = ListDatabasesRequest{} }
+func (m *ListDatabasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesRequest) ProtoMessage()    {}
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{13}
 }
 
-func (c *rootCoordClient) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
-	out := new(milvuspb.BoolResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/HasCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListDatabasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesRequest.Unmarshal(m, b)
+}
+func (m *ListDatabasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesRequest.Merge(m, src)
+}
+func (m *ListDatabasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesRequest.Size(m)
+}
+func (m *ListDatabasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesRequest.DiscardUnknown(m)
 }
 
-func (c *rootCoordClient) DescribeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error) {
-	out := new(milvuspb.DescribeCollectionResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DescribeCollection", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListDatabasesRequest proto.InternalMessageInfo
+
+func (m *ListDatabasesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *rootCoordClient) CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateAlias", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ListDatabasesResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbNames              []string         `protobuf:"bytes,2,rep,name=db_names,json=dbNames,proto3" json:"db_names,omitempty"`
+	CreatedTimestamp     []uint64         `protobuf:"varint,3,rep,packed,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (c *rootCoordClient) DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropAlias", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListDatabasesResponse) Reset()         { *m = ListDatabasesResponse{} }
+func (m *ListDatabasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesResponse) ProtoMessage()    {}
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{14}
 }
 
-func (c *rootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AlterAlias", in, out, opts...)
+func (m *ListDatabasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesResponse.Unmarshal(m, b)
+}
+func (m *ListDatabasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDatabasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesResponse.Merge(m, src)
+}
+func (m *ListDatabasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesResponse.Size(m)
+}
+func (m *ListDatabasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDatabasesResponse proto.InternalMessageInfo
+
+func (m *ListDatabasesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListDatabasesResponse) GetDbNames() []string {
+	if m != nil {
+		return m.DbNames
+	}
+	return nil
+}
+
+func (m *ListDatabasesResponse) GetCreatedTimestamp() []uint64 {
+	if m != nil {
+		return m.CreatedTimestamp
+	}
+	return nil
+}
+
+type RenameCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	OldName              string            `protobuf:"bytes,3,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName              string            `protobuf:"bytes,4,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RenameCollectionRequest) Reset()         { *m = RenameCollectionRequest{} }
+func (m *RenameCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameCollectionRequest) ProtoMessage()    {}
+func (*RenameCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{15}
+}
+
+func (m *RenameCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameCollectionRequest.Unmarshal(m, b)
+}
+func (m *RenameCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *RenameCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameCollectionRequest.Merge(m, src)
+}
+func (m *RenameCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameCollectionRequest.Size(m)
+}
+func (m *RenameCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameCollectionRequest proto.InternalMessageInfo
+
+func (m *RenameCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RenameCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *RenameCollectionRequest) GetOldName() string {
+	if m != nil {
+		return m.OldName
+	}
+	return ""
+}
+
+func (m *RenameCollectionRequest) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+type TruncateCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TruncateCollectionRequest) Reset()         { *m = TruncateCollectionRequest{} }
+func (m *TruncateCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*TruncateCollectionRequest) ProtoMessage()    {}
+func (*TruncateCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{16}
+}
+
+func (m *TruncateCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TruncateCollectionRequest.Unmarshal(m, b)
+}
+func (m *TruncateCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TruncateCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *TruncateCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateCollectionRequest.Merge(m, src)
+}
+func (m *TruncateCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_TruncateCollectionRequest.Size(m)
+}
+func (m *TruncateCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncateCollectionRequest proto.InternalMessageInfo
+
+func (m *TruncateCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *TruncateCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *TruncateCollectionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type CloneCollectionRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	SourceCollectionName string            `protobuf:"bytes,3,opt,name=source_collection_name,json=sourceCollectionName,proto3" json:"source_collection_name,omitempty"`
+	TargetCollectionName string            `protobuf:"bytes,4,opt,name=target_collection_name,json=targetCollectionName,proto3" json:"target_collection_name,omitempty"`
+	// the segments of the source collection flushed at or before the timestamp are cloned, 0 means now.
+	Timestamp            uint64   `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloneCollectionRequest) Reset()         { *m = CloneCollectionRequest{} }
+func (m *CloneCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*CloneCollectionRequest) ProtoMessage()    {}
+func (*CloneCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{17}
+}
+
+func (m *CloneCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneCollectionRequest.Unmarshal(m, b)
+}
+func (m *CloneCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *CloneCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneCollectionRequest.Merge(m, src)
+}
+func (m *CloneCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_CloneCollectionRequest.Size(m)
+}
+func (m *CloneCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneCollectionRequest proto.InternalMessageInfo
+
+func (m *CloneCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CloneCollectionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CloneCollectionRequest) GetSourceCollectionName() string {
+	if m != nil {
+		return m.SourceCollectionName
+	}
+	return ""
+}
+
+func (m *CloneCollectionRequest) GetTargetCollectionName() string {
+	if m != nil {
+		return m.TargetCollectionName
+	}
+	return ""
+}
+
+func (m *CloneCollectionRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ApiKeyInfo struct {
+	KeyId               string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Username            string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Description         string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedUtcTimestamp uint64 `protobuf:"varint,4,opt,name=created_utc_timestamp,json=createdUtcTimestamp,proto3" json:"created_utc_timestamp,omitempty"`
+	// sha256 of the secret of the api key, only returned to proxy.
+	HashedSecret         string   `protobuf:"bytes,5,opt,name=hashed_secret,json=hashedSecret,proto3" json:"hashed_secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiKeyInfo) Reset()         { *m = ApiKeyInfo{} }
+func (m *ApiKeyInfo) String() string { return proto.CompactTextString(m) }
+func (*ApiKeyInfo) ProtoMessage()    {}
+func (*ApiKeyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{18}
+}
+
+func (m *ApiKeyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiKeyInfo.Unmarshal(m, b)
+}
+func (m *ApiKeyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiKeyInfo.Marshal(b, m, deterministic)
+}
+func (m *ApiKeyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKeyInfo.Merge(m, src)
+}
+func (m *ApiKeyInfo) XXX_Size() int {
+	return xxx_messageInfo_ApiKeyInfo.Size(m)
+}
+func (m *ApiKeyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKeyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKeyInfo proto.InternalMessageInfo
+
+func (m *ApiKeyInfo) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *ApiKeyInfo) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ApiKeyInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ApiKeyInfo) GetCreatedUtcTimestamp() uint64 {
+	if m != nil {
+		return m.CreatedUtcTimestamp
+	}
+	return 0
+}
+
+func (m *ApiKeyInfo) GetHashedSecret() string {
+	if m != nil {
+		return m.HashedSecret
+	}
+	return ""
+}
+
+type CreateApiKeyRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the user the api key acts as.
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateApiKeyRequest) Reset()         { *m = CreateApiKeyRequest{} }
+func (m *CreateApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateApiKeyRequest) ProtoMessage()    {}
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{19}
+}
+
+func (m *CreateApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateApiKeyRequest.Unmarshal(m, b)
+}
+func (m *CreateApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateApiKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApiKeyRequest.Merge(m, src)
+}
+func (m *CreateApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateApiKeyRequest.Size(m)
+}
+func (m *CreateApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateApiKeyRequest proto.InternalMessageInfo
+
+func (m *CreateApiKeyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateApiKeyRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CreateApiKeyRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type CreateApiKeyResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	KeyId  string           `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// the api key is only returned when it's created, it can't be recovered from the stored hash.
+	ApiKey               string   `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateApiKeyResponse) Reset()         { *m = CreateApiKeyResponse{} }
+func (m *CreateApiKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateApiKeyResponse) ProtoMessage()    {}
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{20}
+}
+
+func (m *CreateApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateApiKeyResponse.Unmarshal(m, b)
+}
+func (m *CreateApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateApiKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApiKeyResponse.Merge(m, src)
+}
+func (m *CreateApiKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateApiKeyResponse.Size(m)
+}
+func (m *CreateApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApiKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateApiKeyResponse proto.InternalMessageInfo
+
+func (m *CreateApiKeyResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CreateApiKeyResponse) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *CreateApiKeyResponse) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
+type ListApiKeysRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// list the api keys of all the users if empty.
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListApiKeysRequest) Reset()         { *m = ListApiKeysRequest{} }
+func (m *ListApiKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*ListApiKeysRequest) ProtoMessage()    {}
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{21}
+}
+
+func (m *ListApiKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApiKeysRequest.Unmarshal(m, b)
+}
+func (m *ListApiKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApiKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *ListApiKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApiKeysRequest.Merge(m, src)
+}
+func (m *ListApiKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_ListApiKeysRequest.Size(m)
+}
+func (m *ListApiKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApiKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApiKeysRequest proto.InternalMessageInfo
+
+func (m *ListApiKeysRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListApiKeysRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type ListApiKeysResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ApiKeys              []*ApiKeyInfo    `protobuf:"bytes,2,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListApiKeysResponse) Reset()         { *m = ListApiKeysResponse{} }
+func (m *ListApiKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListApiKeysResponse) ProtoMessage()    {}
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{22}
+}
+
+func (m *ListApiKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApiKeysResponse.Unmarshal(m, b)
+}
+func (m *ListApiKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApiKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *ListApiKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApiKeysResponse.Merge(m, src)
+}
+func (m *ListApiKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListApiKeysResponse.Size(m)
+}
+func (m *ListApiKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApiKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApiKeysResponse proto.InternalMessageInfo
+
+func (m *ListApiKeysResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListApiKeysResponse) GetApiKeys() []*ApiKeyInfo {
+	if m != nil {
+		return m.ApiKeys
+	}
+	return nil
+}
+
+type RevokeApiKeyRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	KeyId                string            `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RevokeApiKeyRequest) Reset()         { *m = RevokeApiKeyRequest{} }
+func (m *RevokeApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeApiKeyRequest) ProtoMessage()    {}
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{23}
+}
+
+func (m *RevokeApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeApiKeyRequest.Unmarshal(m, b)
+}
+func (m *RevokeApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeApiKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeApiKeyRequest.Merge(m, src)
+}
+func (m *RevokeApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeApiKeyRequest.Size(m)
+}
+func (m *RevokeApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeApiKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeApiKeyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RevokeApiKeyRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+type GetApiKeyRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	KeyId                string            `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetApiKeyRequest) Reset()         { *m = GetApiKeyRequest{} }
+func (m *GetApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetApiKeyRequest) ProtoMessage()    {}
+func (*GetApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{24}
+}
+
+func (m *GetApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetApiKeyRequest.Unmarshal(m, b)
+}
+func (m *GetApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetApiKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *GetApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApiKeyRequest.Merge(m, src)
+}
+func (m *GetApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetApiKeyRequest.Size(m)
+}
+func (m *GetApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApiKeyRequest proto.InternalMessageInfo
+
+func (m *GetApiKeyRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetApiKeyRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+type GetApiKeyResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Info                 *ApiKeyInfo      `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetApiKeyResponse) Reset()         { *m = GetApiKeyResponse{} }
+func (m *GetApiKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetApiKeyResponse) ProtoMessage()    {}
+func (*GetApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{25}
+}
+
+func (m *GetApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetApiKeyResponse.Unmarshal(m, b)
+}
+func (m *GetApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetApiKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *GetApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApiKeyResponse.Merge(m, src)
+}
+func (m *GetApiKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_GetApiKeyResponse.Size(m)
+}
+func (m *GetApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApiKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApiKeyResponse proto.InternalMessageInfo
+
+func (m *GetApiKeyResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetApiKeyResponse) GetInfo() *ApiKeyInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type ExportRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// all the partitions are exported if empty.
+	PartitionNames []string     `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Format         ExportFormat `protobuf:"varint,5,opt,name=format,proto3,enum=milvus.proto.rootcoord.ExportFormat" json:"format,omitempty"`
+	// the directory of the exported files in the storage.
+	TargetPath string `protobuf:"bytes,6,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	// the rows inserted and deleted after the timestamp are not exported, 0 means now.
+	Timestamp            uint64   `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{26}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_ExportJSON
+}
+
+func (m *ExportRequest) GetTargetPath() string {
+	if m != nil {
+		return m.TargetPath
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ExportResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskId               int64            `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportResponse) Reset()         { *m = ExportResponse{} }
+func (m *ExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportResponse) ProtoMessage()    {}
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{27}
+}
+
+func (m *ExportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportResponse.Unmarshal(m, b)
+}
+func (m *ExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResponse.Merge(m, src)
+}
+func (m *ExportResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportResponse.Size(m)
+}
+func (m *ExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResponse proto.InternalMessageInfo
+
+func (m *ExportResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExportResponse) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+type ExportTaskInfo struct {
+	Id             int64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DbName         string       `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string       `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionId   int64        `protobuf:"varint,4,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	PartitionIds   []int64      `protobuf:"varint,5,rep,packed,name=partition_ids,json=partitionIds,proto3" json:"partition_ids,omitempty"`
+	Format         ExportFormat `protobuf:"varint,6,opt,name=format,proto3,enum=milvus.proto.rootcoord.ExportFormat" json:"format,omitempty"`
+	TargetPath     string       `protobuf:"bytes,7,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	Timestamp      uint64       `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	State          ExportState  `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.rootcoord.ExportState" json:"state,omitempty"`
+	// the unix seconds when the task is created, started and done.
+	CreateTs   int64 `protobuf:"varint,10,opt,name=create_ts,json=createTs,proto3" json:"create_ts,omitempty"`
+	StartTs    int64 `protobuf:"varint,11,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	CompleteTs int64 `protobuf:"varint,12,opt,name=complete_ts,json=completeTs,proto3" json:"complete_ts,omitempty"`
+	RowCount   int64 `protobuf:"varint,13,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	// the paths of the exported files.
+	Files                []string `protobuf:"bytes,14,rep,name=files,proto3" json:"files,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,15,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportTaskInfo) Reset()         { *m = ExportTaskInfo{} }
+func (m *ExportTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*ExportTaskInfo) ProtoMessage()    {}
+func (*ExportTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{28}
+}
+
+func (m *ExportTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTaskInfo.Unmarshal(m, b)
+}
+func (m *ExportTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *ExportTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTaskInfo.Merge(m, src)
+}
+func (m *ExportTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_ExportTaskInfo.Size(m)
+}
+func (m *ExportTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTaskInfo proto.InternalMessageInfo
+
+func (m *ExportTaskInfo) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetPartitionIds() []int64 {
+	if m != nil {
+		return m.PartitionIds
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_ExportJSON
+}
+
+func (m *ExportTaskInfo) GetTargetPath() string {
+	if m != nil {
+		return m.TargetPath
+	}
+	return ""
+}
+
+func (m *ExportTaskInfo) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetState() ExportState {
+	if m != nil {
+		return m.State
+	}
+	return ExportState_ExportPending
+}
+
+func (m *ExportTaskInfo) GetCreateTs() int64 {
+	if m != nil {
+		return m.CreateTs
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetStartTs() int64 {
+	if m != nil {
+		return m.StartTs
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetCompleteTs() int64 {
+	if m != nil {
+		return m.CompleteTs
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+type GetExportStateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskId               int64             `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetExportStateRequest) Reset()         { *m = GetExportStateRequest{} }
+func (m *GetExportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateRequest) ProtoMessage()    {}
+func (*GetExportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{29}
+}
+
+func (m *GetExportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateRequest.Unmarshal(m, b)
+}
+func (m *GetExportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateRequest.Merge(m, src)
+}
+func (m *GetExportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateRequest.Size(m)
+}
+func (m *GetExportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateRequest proto.InternalMessageInfo
+
+func (m *GetExportStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetExportStateRequest) GetTaskId() int64 {
+	if m != nil {
+		return m.TaskId
+	}
+	return 0
+}
+
+type GetExportStateResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Info                 *ExportTaskInfo  `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetExportStateResponse) Reset()         { *m = GetExportStateResponse{} }
+func (m *GetExportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateResponse) ProtoMessage()    {}
+func (*GetExportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{30}
+}
+
+func (m *GetExportStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateResponse.Unmarshal(m, b)
+}
+func (m *GetExportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateResponse.Merge(m, src)
+}
+func (m *GetExportStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateResponse.Size(m)
+}
+func (m *GetExportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateResponse proto.InternalMessageInfo
+
+func (m *GetExportStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetExportStateResponse) GetInfo() *ExportTaskInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type ListExportTasksRequest struct {
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// list the tasks of all the collections if empty.
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// list the latest tasks at most, 0 means all.
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListExportTasksRequest) Reset()         { *m = ListExportTasksRequest{} }
+func (m *ListExportTasksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListExportTasksRequest) ProtoMessage()    {}
+func (*ListExportTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{31}
+}
+
+func (m *ListExportTasksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExportTasksRequest.Unmarshal(m, b)
+}
+func (m *ListExportTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExportTasksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListExportTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExportTasksRequest.Merge(m, src)
+}
+func (m *ListExportTasksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListExportTasksRequest.Size(m)
+}
+func (m *ListExportTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExportTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExportTasksRequest proto.InternalMessageInfo
+
+func (m *ListExportTasksRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ListExportTasksRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ListExportTasksRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ListExportTasksRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListExportTasksResponse struct {
+	Status               *commonpb.Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Tasks                []*ExportTaskInfo `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListExportTasksResponse) Reset()         { *m = ListExportTasksResponse{} }
+func (m *ListExportTasksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListExportTasksResponse) ProtoMessage()    {}
+func (*ListExportTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{32}
+}
+
+func (m *ListExportTasksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListExportTasksResponse.Unmarshal(m, b)
+}
+func (m *ListExportTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListExportTasksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListExportTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListExportTasksResponse.Merge(m, src)
+}
+func (m *ListExportTasksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListExportTasksResponse.Size(m)
+}
+func (m *ListExportTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListExportTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListExportTasksResponse proto.InternalMessageInfo
+
+func (m *ListExportTasksResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListExportTasksResponse) GetTasks() []*ExportTaskInfo {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+type BackupInfo struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BackupTs uint64 `protobuf:"varint,2,opt,name=backup_ts,json=backupTs,proto3" json:"backup_ts,omitempty"`
+	// the unix seconds when the backup is created.
+	CreateTime     int64  `protobuf:"varint,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	DbName         string `protobuf:"bytes,4,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string `protobuf:"bytes,5,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionId   int64  `protobuf:"varint,6,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	NumSegments    int64  `protobuf:"varint,7,opt,name=num_segments,json=numSegments,proto3" json:"num_segments,omitempty"`
+	NumFiles       int64  `protobuf:"varint,8,opt,name=num_files,json=numFiles,proto3" json:"num_files,omitempty"`
+	// the total size of the files in bytes.
+	Size                 int64    `protobuf:"varint,9,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupInfo) Reset()         { *m = BackupInfo{} }
+func (m *BackupInfo) String() string { return proto.CompactTextString(m) }
+func (*BackupInfo) ProtoMessage()    {}
+func (*BackupInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{33}
+}
+
+func (m *BackupInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackupInfo.Unmarshal(m, b)
+}
+func (m *BackupInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackupInfo.Marshal(b, m, deterministic)
+}
+func (m *BackupInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupInfo.Merge(m, src)
+}
+func (m *BackupInfo) XXX_Size() int {
+	return xxx_messageInfo_BackupInfo.Size(m)
+}
+func (m *BackupInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupInfo proto.InternalMessageInfo
+
+func (m *BackupInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BackupInfo) GetBackupTs() uint64 {
+	if m != nil {
+		return m.BackupTs
+	}
+	return 0
+}
+
+func (m *BackupInfo) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *BackupInfo) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *BackupInfo) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *BackupInfo) GetCollectionId() int64 {
+	if m != nil {
+		return m.CollectionId
+	}
+	return 0
+}
+
+func (m *BackupInfo) GetNumSegments() int64 {
+	if m != nil {
+		return m.NumSegments
+	}
+	return 0
+}
+
+func (m *BackupInfo) GetNumFiles() int64 {
+	if m != nil {
+		return m.NumFiles
+	}
+	return 0
+}
+
+func (m *BackupInfo) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type CreateBackupRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Name           string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DbName         string            `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,4,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// the data written after the timestamp is not restored, 0 means now.
+	Timestamp            uint64   `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateBackupRequest) Reset()         { *m = CreateBackupRequest{} }
+func (m *CreateBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateBackupRequest) ProtoMessage()    {}
+func (*CreateBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{34}
+}
+
+func (m *CreateBackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateBackupRequest.Unmarshal(m, b)
+}
+func (m *CreateBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateBackupRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateBackupRequest.Merge(m, src)
+}
+func (m *CreateBackupRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateBackupRequest.Size(m)
+}
+func (m *CreateBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateBackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateBackupRequest proto.InternalMessageInfo
+
+func (m *CreateBackupRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CreateBackupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateBackupRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CreateBackupRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CreateBackupRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type CreateBackupResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Info                 *BackupInfo      `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CreateBackupResponse) Reset()         { *m = CreateBackupResponse{} }
+func (m *CreateBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateBackupResponse) ProtoMessage()    {}
+func (*CreateBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{35}
+}
+
+func (m *CreateBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateBackupResponse.Unmarshal(m, b)
+}
+func (m *CreateBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateBackupResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateBackupResponse.Merge(m, src)
+}
+func (m *CreateBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateBackupResponse.Size(m)
+}
+func (m *CreateBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateBackupResponse proto.InternalMessageInfo
+
+func (m *CreateBackupResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CreateBackupResponse) GetInfo() *BackupInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+type ListBackupsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListBackupsRequest) Reset()         { *m = ListBackupsRequest{} }
+func (m *ListBackupsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBackupsRequest) ProtoMessage()    {}
+func (*ListBackupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{36}
+}
+
+func (m *ListBackupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBackupsRequest.Unmarshal(m, b)
+}
+func (m *ListBackupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBackupsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListBackupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBackupsRequest.Merge(m, src)
+}
+func (m *ListBackupsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListBackupsRequest.Size(m)
+}
+func (m *ListBackupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBackupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBackupsRequest proto.InternalMessageInfo
+
+func (m *ListBackupsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type ListBackupsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Backups              []*BackupInfo    `protobuf:"bytes,2,rep,name=backups,proto3" json:"backups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListBackupsResponse) Reset()         { *m = ListBackupsResponse{} }
+func (m *ListBackupsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListBackupsResponse) ProtoMessage()    {}
+func (*ListBackupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{37}
+}
+
+func (m *ListBackupsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBackupsResponse.Unmarshal(m, b)
+}
+func (m *ListBackupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBackupsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListBackupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBackupsResponse.Merge(m, src)
+}
+func (m *ListBackupsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListBackupsResponse.Size(m)
+}
+func (m *ListBackupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBackupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBackupsResponse proto.InternalMessageInfo
+
+func (m *ListBackupsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ListBackupsResponse) GetBackups() []*BackupInfo {
+	if m != nil {
+		return m.Backups
+	}
+	return nil
+}
+
+type VerifyBackupRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *VerifyBackupRequest) Reset()         { *m = VerifyBackupRequest{} }
+func (m *VerifyBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyBackupRequest) ProtoMessage()    {}
+func (*VerifyBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{38}
+}
+
+func (m *VerifyBackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyBackupRequest.Unmarshal(m, b)
+}
+func (m *VerifyBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyBackupRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyBackupRequest.Merge(m, src)
+}
+func (m *VerifyBackupRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyBackupRequest.Size(m)
+}
+func (m *VerifyBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyBackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyBackupRequest proto.InternalMessageInfo
+
+func (m *VerifyBackupRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *VerifyBackupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteBackupRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DeleteBackupRequest) Reset()         { *m = DeleteBackupRequest{} }
+func (m *DeleteBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteBackupRequest) ProtoMessage()    {}
+func (*DeleteBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{39}
+}
+
+func (m *DeleteBackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteBackupRequest.Unmarshal(m, b)
+}
+func (m *DeleteBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteBackupRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteBackupRequest.Merge(m, src)
+}
+func (m *DeleteBackupRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteBackupRequest.Size(m)
+}
+func (m *DeleteBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteBackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteBackupRequest proto.InternalMessageInfo
+
+func (m *DeleteBackupRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *DeleteBackupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RestoreBackupRequest struct {
+	Base       *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	BackupName string            `protobuf:"bytes,2,opt,name=backup_name,json=backupName,proto3" json:"backup_name,omitempty"`
+	// the new collection to restore the backup into.
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreBackupRequest) Reset()         { *m = RestoreBackupRequest{} }
+func (m *RestoreBackupRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreBackupRequest) ProtoMessage()    {}
+func (*RestoreBackupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{40}
+}
+
+func (m *RestoreBackupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreBackupRequest.Unmarshal(m, b)
+}
+func (m *RestoreBackupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreBackupRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreBackupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreBackupRequest.Merge(m, src)
+}
+func (m *RestoreBackupRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreBackupRequest.Size(m)
+}
+func (m *RestoreBackupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreBackupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreBackupRequest proto.InternalMessageInfo
+
+func (m *RestoreBackupRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *RestoreBackupRequest) GetBackupName() string {
+	if m != nil {
+		return m.BackupName
+	}
+	return ""
+}
+
+func (m *RestoreBackupRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type RestoreBackupResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// the import tasks of the restore, whose states could be checked by GetImportState.
+	Tasks                []int64  `protobuf:"varint,2,rep,packed,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreBackupResponse) Reset()         { *m = RestoreBackupResponse{} }
+func (m *RestoreBackupResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreBackupResponse) ProtoMessage()    {}
+func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{41}
+}
+
+func (m *RestoreBackupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreBackupResponse.Unmarshal(m, b)
+}
+func (m *RestoreBackupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreBackupResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreBackupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreBackupResponse.Merge(m, src)
+}
+func (m *RestoreBackupResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreBackupResponse.Size(m)
+}
+func (m *RestoreBackupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreBackupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreBackupResponse proto.InternalMessageInfo
+
+func (m *RestoreBackupResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *RestoreBackupResponse) GetTasks() []int64 {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.rootcoord.ExportFormat", ExportFormat_name, ExportFormat_value)
+	proto.RegisterEnum("milvus.proto.rootcoord.ExportState", ExportState_name, ExportState_value)
+	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
+	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
+	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
+	proto.RegisterType((*AllocIDResponse)(nil), "milvus.proto.rootcoord.AllocIDResponse")
+	proto.RegisterType((*ImportResult)(nil), "milvus.proto.rootcoord.ImportResult")
+	proto.RegisterType((*DescribeSegmentsRequest)(nil), "milvus.proto.rootcoord.DescribeSegmentsRequest")
+	proto.RegisterType((*SegmentBaseInfo)(nil), "milvus.proto.rootcoord.SegmentBaseInfo")
+	proto.RegisterType((*SegmentInfos)(nil), "milvus.proto.rootcoord.SegmentInfos")
+	proto.RegisterMapType((map[int64]*etcdpb.IndexInfo)(nil), "milvus.proto.rootcoord.SegmentInfos.ExtraIndexInfosEntry")
+	proto.RegisterType((*DescribeSegmentsResponse)(nil), "milvus.proto.rootcoord.DescribeSegmentsResponse")
+	proto.RegisterMapType((map[int64]*SegmentInfos)(nil), "milvus.proto.rootcoord.DescribeSegmentsResponse.SegmentInfosEntry")
+	proto.RegisterType((*GetCredentialRequest)(nil), "milvus.proto.rootcoord.GetCredentialRequest")
+	proto.RegisterType((*GetCredentialResponse)(nil), "milvus.proto.rootcoord.GetCredentialResponse")
+	proto.RegisterType((*CreateDatabaseRequest)(nil), "milvus.proto.rootcoord.CreateDatabaseRequest")
+	proto.RegisterType((*DropDatabaseRequest)(nil), "milvus.proto.rootcoord.DropDatabaseRequest")
+	proto.RegisterType((*ListDatabasesRequest)(nil), "milvus.proto.rootcoord.ListDatabasesRequest")
+	proto.RegisterType((*ListDatabasesResponse)(nil), "milvus.proto.rootcoord.ListDatabasesResponse")
+	proto.RegisterType((*RenameCollectionRequest)(nil), "milvus.proto.rootcoord.RenameCollectionRequest")
+	proto.RegisterType((*TruncateCollectionRequest)(nil), "milvus.proto.rootcoord.TruncateCollectionRequest")
+	proto.RegisterType((*CloneCollectionRequest)(nil), "milvus.proto.rootcoord.CloneCollectionRequest")
+	proto.RegisterType((*ApiKeyInfo)(nil), "milvus.proto.rootcoord.ApiKeyInfo")
+	proto.RegisterType((*CreateApiKeyRequest)(nil), "milvus.proto.rootcoord.CreateApiKeyRequest")
+	proto.RegisterType((*CreateApiKeyResponse)(nil), "milvus.proto.rootcoord.CreateApiKeyResponse")
+	proto.RegisterType((*ListApiKeysRequest)(nil), "milvus.proto.rootcoord.ListApiKeysRequest")
+	proto.RegisterType((*ListApiKeysResponse)(nil), "milvus.proto.rootcoord.ListApiKeysResponse")
+	proto.RegisterType((*RevokeApiKeyRequest)(nil), "milvus.proto.rootcoord.RevokeApiKeyRequest")
+	proto.RegisterType((*GetApiKeyRequest)(nil), "milvus.proto.rootcoord.GetApiKeyRequest")
+	proto.RegisterType((*GetApiKeyResponse)(nil), "milvus.proto.rootcoord.GetApiKeyResponse")
+	proto.RegisterType((*ExportRequest)(nil), "milvus.proto.rootcoord.ExportRequest")
+	proto.RegisterType((*ExportResponse)(nil), "milvus.proto.rootcoord.ExportResponse")
+	proto.RegisterType((*ExportTaskInfo)(nil), "milvus.proto.rootcoord.ExportTaskInfo")
+	proto.RegisterType((*GetExportStateRequest)(nil), "milvus.proto.rootcoord.GetExportStateRequest")
+	proto.RegisterType((*GetExportStateResponse)(nil), "milvus.proto.rootcoord.GetExportStateResponse")
+	proto.RegisterType((*ListExportTasksRequest)(nil), "milvus.proto.rootcoord.ListExportTasksRequest")
+	proto.RegisterType((*ListExportTasksResponse)(nil), "milvus.proto.rootcoord.ListExportTasksResponse")
+	proto.RegisterType((*BackupInfo)(nil), "milvus.proto.rootcoord.BackupInfo")
+	proto.RegisterType((*CreateBackupRequest)(nil), "milvus.proto.rootcoord.CreateBackupRequest")
+	proto.RegisterType((*CreateBackupResponse)(nil), "milvus.proto.rootcoord.CreateBackupResponse")
+	proto.RegisterType((*ListBackupsRequest)(nil), "milvus.proto.rootcoord.ListBackupsRequest")
+	proto.RegisterType((*ListBackupsResponse)(nil), "milvus.proto.rootcoord.ListBackupsResponse")
+	proto.RegisterType((*VerifyBackupRequest)(nil), "milvus.proto.rootcoord.VerifyBackupRequest")
+	proto.RegisterType((*DeleteBackupRequest)(nil), "milvus.proto.rootcoord.DeleteBackupRequest")
+	proto.RegisterType((*RestoreBackupRequest)(nil), "milvus.proto.rootcoord.RestoreBackupRequest")
+	proto.RegisterType((*RestoreBackupResponse)(nil), "milvus.proto.rootcoord.RestoreBackupResponse")
+}
+
+func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
+
+var fileDescriptor_4513485a144f6b06 = []byte{
+	// 2844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcf, 0x73, 0x1b, 0xb7,
+	0xf5, 0xf7, 0x92, 0x14, 0x29, 0x3d, 0x52, 0x24, 0x0d, 0x51, 0x36, 0xc3, 0xe4, 0xfb, 0x8d, 0xb2,
+	0x4e, 0x6c, 0xf9, 0x97, 0x94, 0x28, 0x9d, 0x34, 0xc9, 0xa4, 0x87, 0x58, 0x4a, 0x6c, 0x36, 0x75,
+	0xe2, 0xae, 0xe4, 0x4c, 0xe2, 0xd4, 0x61, 0x96, 0x5c, 0x48, 0xdc, 0xd1, 0x72, 0x97, 0x59, 0x80,
+	0x96, 0xd5, 0x5c, 0xda, 0x69, 0x3b, 0xd3, 0xcc, 0x74, 0x26, 0x33, 0x9d, 0xf6, 0xd0, 0x1e, 0x7b,
+	0xeb, 0xb9, 0xb7, 0xf6, 0xd6, 0xfe, 0x29, 0x3d, 0xf7, 0x7f, 0xe8, 0x00, 0xd8, 0x1f, 0xd8, 0xe5,
+	0x82, 0x5c, 0x8b, 0x56, 0x73, 0x5b, 0x00, 0x1f, 0xbc, 0xf7, 0xf0, 0xde, 0xc3, 0x7b, 0x0f, 0x0b,
+	0x40, 0xd3, 0xf7, 0x3c, 0xda, 0x1b, 0x78, 0x9e, 0x6f, 0x6d, 0x8d, 0x7d, 0x8f, 0x7a, 0xe8, 0xd2,
+	0xc8, 0x76, 0x9e, 0x4c, 0x88, 0x68, 0x6d, 0xb1, 0x61, 0x3e, 0xda, 0xa9, 0x0d, 0xbc, 0xd1, 0xc8,
+	0x73, 0x45, 0x7f, 0xa7, 0x26, 0xa3, 0x3a, 0x75, 0xdb, 0xa5, 0xd8, 0x77, 0x4d, 0x27, 0x68, 0x57,
+	0xc7, 0xbe, 0xf7, 0xf4, 0x34, 0x68, 0x34, 0x30, 0x1d, 0x58, 0xbd, 0x11, 0xa6, 0xa6, 0xe8, 0xd0,
+	0x7b, 0xb0, 0xfe, 0xbe, 0xe3, 0x78, 0x83, 0x03, 0x7b, 0x84, 0x09, 0x35, 0x47, 0x63, 0x03, 0x7f,
+	0x3d, 0xc1, 0x84, 0xa2, 0xd7, 0xa1, 0xd4, 0x37, 0x09, 0x6e, 0x6b, 0x1b, 0xda, 0x66, 0x75, 0xe7,
+	0xa5, 0xad, 0x84, 0x24, 0x01, 0xfb, 0xfb, 0xe4, 0xe8, 0x8e, 0x49, 0xb0, 0xc1, 0x91, 0xa8, 0x05,
+	0x4b, 0x03, 0x6f, 0xe2, 0xd2, 0x76, 0x71, 0x43, 0xdb, 0x5c, 0x35, 0x44, 0x43, 0xff, 0xa5, 0x06,
+	0x97, 0xd2, 0x1c, 0xc8, 0xd8, 0x73, 0x09, 0x46, 0x6f, 0x42, 0x99, 0x50, 0x93, 0x4e, 0x48, 0xc0,
+	0xe4, 0xc5, 0x4c, 0x26, 0xfb, 0x1c, 0x62, 0x04, 0x50, 0xf4, 0x12, 0xac, 0xd0, 0x90, 0x52, 0xbb,
+	0xb0, 0xa1, 0x6d, 0x96, 0x8c, 0xb8, 0x43, 0x21, 0xc3, 0x67, 0x50, 0xe7, 0x22, 0x74, 0xf7, 0x9e,
+	0xc3, 0xea, 0x0a, 0x32, 0x65, 0x07, 0x1a, 0x11, 0xe5, 0x45, 0x56, 0x55, 0x87, 0x42, 0x77, 0x8f,
+	0x93, 0x2e, 0x1a, 0x85, 0xee, 0x9e, 0x62, 0x1d, 0xff, 0x2c, 0x40, 0xad, 0x3b, 0x1a, 0x7b, 0x3e,
+	0x35, 0x30, 0x99, 0x38, 0xf4, 0x6c, 0xbc, 0x2e, 0x43, 0x85, 0x9a, 0xe4, 0xb8, 0x67, 0x5b, 0x01,
+	0xc3, 0x32, 0x6b, 0x76, 0x2d, 0xf4, 0x32, 0x54, 0x2d, 0x93, 0x9a, 0xae, 0x67, 0x61, 0x36, 0x58,
+	0xe4, 0x83, 0x10, 0x76, 0x75, 0x2d, 0xf4, 0x16, 0x2c, 0x31, 0x1a, 0xb8, 0x5d, 0xda, 0xd0, 0x36,
+	0xeb, 0x3b, 0x1b, 0x99, 0xdc, 0x84, 0x80, 0x8c, 0x27, 0x36, 0x04, 0x1c, 0x75, 0x60, 0x99, 0xe0,
+	0xa3, 0x11, 0x76, 0x29, 0x69, 0x2f, 0x6d, 0x14, 0x37, 0x8b, 0x46, 0xd4, 0x46, 0x2f, 0xc0, 0xb2,
+	0x39, 0xa1, 0x5e, 0xcf, 0xb6, 0x48, 0xbb, 0xcc, 0xc7, 0x2a, 0xac, 0xdd, 0xb5, 0x08, 0x7a, 0x11,
+	0x56, 0x7c, 0xef, 0xa4, 0x27, 0x14, 0x51, 0xe1, 0xd2, 0x2c, 0xfb, 0xde, 0xc9, 0x2e, 0x6b, 0xa3,
+	0x1f, 0xc2, 0x92, 0xed, 0x1e, 0x7a, 0xa4, 0xbd, 0xbc, 0x51, 0xdc, 0xac, 0xee, 0xbc, 0x92, 0x29,
+	0xcb, 0x47, 0xf8, 0xf4, 0x53, 0xd3, 0x99, 0xe0, 0x07, 0xa6, 0xed, 0x1b, 0x02, 0xaf, 0x7f, 0xa7,
+	0xc1, 0xe5, 0x3d, 0x4c, 0x06, 0xbe, 0xdd, 0xc7, 0xfb, 0x81, 0x14, 0x67, 0x77, 0x0b, 0x1d, 0x6a,
+	0x03, 0xcf, 0x71, 0xf0, 0x80, 0xda, 0x9e, 0x1b, 0x99, 0x30, 0xd1, 0x87, 0xfe, 0x1f, 0x20, 0x58,
+	0x6e, 0x77, 0x8f, 0xb4, 0x8b, 0x7c, 0x91, 0x52, 0x8f, 0x3e, 0x81, 0x46, 0x20, 0x08, 0x23, 0xdc,
+	0x75, 0x0f, 0xbd, 0x29, 0xb2, 0x5a, 0x06, 0xd9, 0x0d, 0xa8, 0x8e, 0x4d, 0x9f, 0xda, 0x09, 0xce,
+	0x72, 0x17, 0xdb, 0x2b, 0x11, 0x9b, 0xc0, 0x9c, 0x71, 0x87, 0xfe, 0xef, 0x02, 0xd4, 0x02, 0xbe,
+	0x8c, 0x27, 0x41, 0x7b, 0xb0, 0xc2, 0xd6, 0xd4, 0x63, 0x7a, 0x0a, 0x54, 0x70, 0x6d, 0x2b, 0x3b,
+	0x02, 0x6d, 0xa5, 0x04, 0x36, 0x96, 0xfb, 0xa1, 0xe8, 0x7b, 0x50, 0xb5, 0x5d, 0x0b, 0x3f, 0xed,
+	0x09, 0xf3, 0x14, 0xb8, 0x79, 0xae, 0x24, 0xe9, 0xb0, 0x28, 0xb4, 0x15, 0xf1, 0xb6, 0xf0, 0x53,
+	0x4e, 0x03, 0xec, 0xf0, 0x93, 0x20, 0x0c, 0x17, 0xf1, 0x53, 0xea, 0x9b, 0x3d, 0x99, 0x56, 0x91,
+	0xd3, 0x7a, 0x67, 0x8e, 0x4c, 0x9c, 0xc0, 0xd6, 0x07, 0x6c, 0x76, 0x44, 0x9b, 0x7c, 0xe0, 0x52,
+	0xff, 0xd4, 0x68, 0xe0, 0x64, 0x6f, 0xe7, 0x2b, 0x68, 0x65, 0x01, 0x51, 0x13, 0x8a, 0xc7, 0xf8,
+	0x34, 0x50, 0x3b, 0xfb, 0x44, 0x3b, 0xb0, 0xf4, 0x84, 0xb9, 0x52, 0xbb, 0x90, 0xe5, 0x1b, 0x7c,
+	0x41, 0xf1, 0x4a, 0x04, 0xf4, 0xdd, 0xc2, 0xdb, 0x9a, 0xfe, 0xaf, 0x02, 0xb4, 0xa7, 0xdd, 0x6d,
+	0x91, 0x58, 0x91, 0xc7, 0xe5, 0x8e, 0x60, 0x35, 0x30, 0x74, 0x42, 0x75, 0x77, 0x54, 0xaa, 0x53,
+	0x49, 0x98, 0xd0, 0xa9, 0xd0, 0x61, 0x8d, 0x48, 0x5d, 0x1d, 0x0c, 0x17, 0xa7, 0x20, 0x19, 0xda,
+	0x7b, 0x37, 0xa9, 0xbd, 0x57, 0xf3, 0x98, 0x50, 0xd6, 0xa2, 0x05, 0xad, 0xbb, 0x98, 0xee, 0xfa,
+	0xd8, 0xc2, 0x2e, 0xb5, 0x4d, 0xe7, 0xec, 0x1b, 0xb6, 0x03, 0xcb, 0x13, 0xc2, 0xf2, 0xe3, 0x48,
+	0x08, 0xb3, 0x62, 0x44, 0x6d, 0xfd, 0xd7, 0x1a, 0xac, 0xa7, 0xd8, 0x2c, 0x62, 0xa8, 0x19, 0xac,
+	0xd8, 0xd8, 0xd8, 0x24, 0xe4, 0xc4, 0xf3, 0x45, 0xa0, 0x5d, 0x31, 0xa2, 0xb6, 0xde, 0x87, 0xf5,
+	0x5d, 0x1f, 0x9b, 0x14, 0xef, 0x99, 0xd4, 0x64, 0x42, 0x9f, 0x7d, 0xb5, 0x97, 0xa1, 0x62, 0xf5,
+	0x7b, 0x92, 0x04, 0x65, 0xab, 0xff, 0x31, 0x5b, 0xea, 0x57, 0xb0, 0xb6, 0xe7, 0x7b, 0xe3, 0x73,
+	0xe4, 0x70, 0x0f, 0x5a, 0x3f, 0xb1, 0x09, 0x0d, 0x39, 0x9c, 0x3d, 0xc6, 0xea, 0x7f, 0xd0, 0x60,
+	0x3d, 0x45, 0x6a, 0x11, 0xb3, 0xbc, 0x00, 0xcb, 0x81, 0xc4, 0x22, 0x3a, 0xad, 0x18, 0x15, 0x21,
+	0x32, 0x41, 0x37, 0xe1, 0xe2, 0x80, 0x6b, 0xde, 0xea, 0xc5, 0x45, 0x06, 0xdb, 0x3a, 0x25, 0xa3,
+	0x19, 0x0c, 0x44, 0x65, 0x8c, 0xfe, 0x67, 0x0d, 0x2e, 0x1b, 0x98, 0xd1, 0xd9, 0x8d, 0xb6, 0xde,
+	0xf3, 0xd7, 0x23, 0x13, 0xd7, 0x73, 0x2c, 0x31, 0x22, 0x3c, 0xa5, 0xe2, 0x39, 0x56, 0x38, 0xe4,
+	0xe2, 0x13, 0x31, 0x54, 0x12, 0x43, 0x2e, 0x3e, 0xe1, 0xda, 0xff, 0x4e, 0x83, 0x17, 0x0e, 0xfc,
+	0x89, 0x3b, 0x30, 0xe9, 0xf9, 0x8a, 0x77, 0x0d, 0x1a, 0x71, 0xe4, 0x91, 0xa5, 0xac, 0xc7, 0xdd,
+	0x5c, 0xa2, 0xff, 0x68, 0x70, 0x69, 0xd7, 0xf1, 0xdc, 0xf3, 0x15, 0xe7, 0x07, 0x70, 0x89, 0x78,
+	0x13, 0x7f, 0x80, 0x7b, 0xd9, 0x52, 0xb5, 0xc4, 0xe8, 0x6e, 0x42, 0x36, 0x36, 0x8b, 0x9a, 0xfe,
+	0x11, 0xa6, 0x53, 0xb3, 0x84, 0x5a, 0x5b, 0x62, 0x34, 0x35, 0x2b, 0x51, 0x8a, 0x2e, 0xa5, 0x4a,
+	0x51, 0xfd, 0xef, 0x1a, 0xc0, 0xfb, 0x63, 0xfb, 0x23, 0x7c, 0xca, 0xd3, 0xe2, 0x3a, 0x94, 0x8f,
+	0xf1, 0x29, 0xab, 0xab, 0x34, 0x4e, 0x72, 0xe9, 0x18, 0x9f, 0x76, 0xad, 0x99, 0x31, 0x62, 0x03,
+	0xaa, 0x16, 0x8f, 0xcb, 0x63, 0xc6, 0x32, 0x58, 0x80, 0xdc, 0x85, 0x76, 0x60, 0x3d, 0xf4, 0xd7,
+	0x09, 0x1d, 0x48, 0x3e, 0x5b, 0xe2, 0xd2, 0xac, 0x05, 0x83, 0x0f, 0x69, 0x5c, 0x7d, 0xa3, 0x2b,
+	0xb0, 0x3a, 0x34, 0xc9, 0x10, 0x5b, 0x3d, 0x82, 0x07, 0x3e, 0xa6, 0x5c, 0xf2, 0x15, 0xa3, 0x26,
+	0x3a, 0xf7, 0x79, 0x9f, 0xfe, 0x1b, 0x0d, 0xd6, 0x44, 0x0c, 0x12, 0x4b, 0x38, 0x97, 0x78, 0x3b,
+	0x7f, 0x81, 0xfa, 0x37, 0xd0, 0x4a, 0x8a, 0xb1, 0xc8, 0xc6, 0x8f, 0x4d, 0x50, 0x90, 0x4d, 0x70,
+	0x19, 0x2a, 0xe6, 0xd8, 0xee, 0xb1, 0x8c, 0x25, 0x24, 0x28, 0x9b, 0x9c, 0x99, 0xde, 0x07, 0xc4,
+	0xc2, 0x8e, 0x60, 0x4d, 0xce, 0x27, 0xe5, 0x7c, 0xab, 0xc1, 0x5a, 0x82, 0xc9, 0x22, 0x0b, 0xfc,
+	0x11, 0x2c, 0x07, 0x2b, 0x09, 0xeb, 0x2e, 0x5d, 0x95, 0x68, 0x63, 0xcf, 0x34, 0x2a, 0x62, 0xb9,
+	0x44, 0xff, 0x12, 0xd6, 0x0c, 0xfc, 0xc4, 0x3b, 0x5e, 0xd8, 0xe6, 0xd9, 0x8a, 0xd6, 0xbf, 0x80,
+	0xe6, 0x5d, 0x4c, 0xcf, 0x89, 0xf8, 0x2f, 0x34, 0xb8, 0x28, 0x51, 0x5f, 0x44, 0x8d, 0x6f, 0x41,
+	0x89, 0x97, 0xc0, 0xa2, 0x56, 0xc9, 0xa3, 0x42, 0x8e, 0xd7, 0xff, 0x5a, 0x80, 0xd5, 0x0f, 0x9e,
+	0x8a, 0xe3, 0xd9, 0xf7, 0x16, 0x67, 0x19, 0x30, 0x3a, 0x03, 0x04, 0x59, 0xae, 0xc4, 0xb3, 0x5c,
+	0x3d, 0xea, 0x16, 0xc9, 0xee, 0x3d, 0x28, 0x1f, 0x7a, 0xfe, 0xc8, 0x14, 0x11, 0xa0, 0xae, 0x2e,
+	0xca, 0xc4, 0x9a, 0x3e, 0xe4, 0x58, 0x23, 0x98, 0xc3, 0x0e, 0x8b, 0x41, 0xc8, 0x1c, 0x9b, 0x74,
+	0xd8, 0x2e, 0x73, 0x59, 0x40, 0x74, 0x3d, 0x30, 0xe9, 0x30, 0x19, 0x1d, 0x2b, 0xe9, 0xe8, 0xf8,
+	0x25, 0xd4, 0x43, 0x55, 0x2d, 0x62, 0x2a, 0xd5, 0x59, 0x56, 0xff, 0x63, 0x29, 0x64, 0x70, 0xc0,
+	0x3a, 0x58, 0x04, 0xae, 0x43, 0x21, 0x88, 0xbe, 0x45, 0xa3, 0x60, 0x5b, 0xcf, 0x41, 0xd5, 0x57,
+	0x60, 0x55, 0x02, 0xda, 0x56, 0xbb, 0x34, 0x55, 0x8a, 0x5b, 0x0c, 0x14, 0xdb, 0xc3, 0xb6, 0xc2,
+	0x13, 0x70, 0x2d, 0xea, 0xec, 0x5a, 0xb2, 0x2d, 0xca, 0x8b, 0xdb, 0xa2, 0x32, 0xdb, 0x16, 0xcb,
+	0xe9, 0x9f, 0x26, 0xef, 0x84, 0xc7, 0xfa, 0x15, 0xce, 0xfb, 0xca, 0x6c, 0xde, 0x89, 0x93, 0xfd,
+	0x8b, 0xb0, 0x22, 0x72, 0x4c, 0x8f, 0x92, 0x36, 0x88, 0x23, 0xba, 0xe8, 0x38, 0xe0, 0x85, 0x16,
+	0xa1, 0xa6, 0x4f, 0xd9, 0x58, 0x95, 0x8f, 0x55, 0x78, 0xfb, 0x80, 0x30, 0x89, 0x07, 0xde, 0x68,
+	0xec, 0x60, 0x31, 0xb3, 0xc6, 0x47, 0x21, 0xec, 0x3a, 0x48, 0x9d, 0xfd, 0x57, 0x53, 0x67, 0xff,
+	0x16, 0x2c, 0x1d, 0xda, 0x0e, 0x26, 0xed, 0x3a, 0x77, 0x6c, 0xd1, 0x60, 0x8a, 0xc6, 0xbe, 0xef,
+	0xf9, 0xbd, 0x11, 0x26, 0xc4, 0x3c, 0xc2, 0xed, 0x86, 0x48, 0x6c, 0xbc, 0xf3, 0xbe, 0xe8, 0x63,
+	0xb5, 0xf5, 0x5d, 0x4c, 0xe5, 0x95, 0x2c, 0xb2, 0x55, 0xb3, 0x7d, 0xef, 0x5b, 0x0d, 0x2e, 0xa5,
+	0x99, 0x2c, 0xe2, 0xe4, 0xef, 0x26, 0xe2, 0xd1, 0xd5, 0xd9, 0xe6, 0x09, 0xdd, 0x3d, 0x88, 0x49,
+	0x7f, 0xd1, 0xe0, 0x12, 0xcb, 0x2f, 0xf1, 0x20, 0xf9, 0x3e, 0x83, 0x53, 0x0b, 0x96, 0x1c, 0x7b,
+	0x64, 0xd3, 0x60, 0xa7, 0x88, 0x86, 0xfe, 0x3b, 0x0d, 0x2e, 0x4f, 0x09, 0xb9, 0x88, 0xc6, 0xde,
+	0x83, 0x25, 0x66, 0x8b, 0x30, 0x0b, 0xe6, 0x55, 0x99, 0x98, 0xa4, 0xff, 0xa9, 0x00, 0x70, 0xc7,
+	0x1c, 0x1c, 0x4f, 0xc6, 0xac, 0x17, 0x21, 0x28, 0xf1, 0x15, 0x89, 0xba, 0x8d, 0x7f, 0x33, 0xf7,
+	0xec, 0x73, 0x04, 0xf3, 0x5e, 0xf1, 0x17, 0x72, 0x59, 0x74, 0x04, 0xce, 0x1d, 0x6c, 0x0a, 0x3b,
+	0xd0, 0x04, 0x73, 0x6e, 0xb1, 0x2d, 0xec, 0x51, 0x42, 0x8f, 0xa5, 0x79, 0x7a, 0x5c, 0xca, 0x17,
+	0x79, 0xca, 0x19, 0x91, 0xe7, 0x15, 0xa8, 0xb9, 0x93, 0x51, 0x2f, 0xfa, 0xf5, 0x26, 0x7e, 0xa1,
+	0x55, 0xdd, 0xc9, 0x28, 0x3c, 0xe6, 0xb3, 0x75, 0x30, 0x88, 0xd8, 0x4d, 0xcb, 0x62, 0x9b, 0xb9,
+	0x93, 0xd1, 0x87, 0xac, 0xcd, 0x16, 0x4e, 0xec, 0x9f, 0x8b, 0xb0, 0x50, 0x34, 0xf8, 0xb7, 0xfe,
+	0x8f, 0xa8, 0x30, 0x14, 0x1a, 0x3a, 0xbb, 0x33, 0x85, 0x6a, 0x2d, 0x48, 0x6a, 0x95, 0x14, 0x53,
+	0x9c, 0xa7, 0x98, 0x52, 0xa6, 0x62, 0x66, 0xd7, 0xe4, 0xbf, 0xd2, 0xa0, 0x95, 0x94, 0xfe, 0x7f,
+	0x50, 0x27, 0xc4, 0xae, 0x14, 0xec, 0xc9, 0x0f, 0x45, 0x5d, 0x29, 0xfa, 0x17, 0x38, 0x17, 0xff,
+	0x36, 0xa8, 0x1d, 0x23, 0x42, 0x8b, 0x6d, 0x99, 0x8a, 0x70, 0xe0, 0xb9, 0xa5, 0xa3, 0xb4, 0x9e,
+	0x70, 0x8a, 0xfe, 0x05, 0xac, 0x7d, 0x8a, 0x7d, 0xfb, 0xf0, 0xf4, 0x1c, 0xbc, 0x82, 0x11, 0xdf,
+	0xc3, 0x2c, 0x2f, 0x9c, 0x07, 0xf1, 0xdf, 0x6b, 0xd0, 0x32, 0x30, 0xa1, 0x9e, 0xbf, 0x30, 0xf9,
+	0x97, 0xa1, 0x1a, 0x04, 0x05, 0x89, 0x0b, 0x88, 0xae, 0x67, 0x3b, 0x2b, 0xf7, 0x61, 0x3d, 0x25,
+	0xd3, 0x22, 0xa6, 0x6d, 0xc9, 0xd1, 0xb0, 0x18, 0x44, 0xb9, 0x1b, 0x77, 0xa0, 0x26, 0x17, 0x13,
+	0xa8, 0x0e, 0x20, 0xda, 0x3f, 0xde, 0xff, 0xe4, 0xe3, 0xe6, 0x05, 0xd4, 0x80, 0xaa, 0x68, 0x7f,
+	0x3c, 0x19, 0x8d, 0x4f, 0x9b, 0x1a, 0xba, 0x18, 0x56, 0xb7, 0x0f, 0x4c, 0xff, 0xeb, 0x09, 0xa6,
+	0xcd, 0xc2, 0x8d, 0x47, 0x21, 0x66, 0x9f, 0x57, 0x03, 0x31, 0x02, 0xbb, 0x96, 0xed, 0x1e, 0x35,
+	0x2f, 0xc4, 0x5d, 0xfb, 0x2c, 0xf3, 0x63, 0xab, 0xa9, 0xa1, 0x35, 0x68, 0x88, 0xae, 0xdd, 0x20,
+	0xdd, 0x5b, 0xcd, 0x02, 0x6a, 0x46, 0xd2, 0x98, 0xb6, 0x83, 0xad, 0x66, 0x71, 0xe7, 0x6f, 0x5b,
+	0xb0, 0x62, 0x78, 0x1e, 0xdd, 0x65, 0x4e, 0x87, 0x1c, 0x40, 0xec, 0xcf, 0x9c, 0x37, 0x1a, 0x7b,
+	0x2e, 0x76, 0x05, 0x3f, 0x82, 0xb6, 0x92, 0xcb, 0x0f, 0x1a, 0xd3, 0xc0, 0xc0, 0xa6, 0x9d, 0x57,
+	0x33, 0xf1, 0x29, 0xb0, 0x7e, 0x01, 0x8d, 0x38, 0x37, 0x16, 0xab, 0x0f, 0xec, 0xc1, 0xf1, 0xee,
+	0xd0, 0x74, 0x5d, 0xec, 0xa0, 0xd7, 0x93, 0xb3, 0xa3, 0x7b, 0xb6, 0x69, 0x68, 0xc8, 0xef, 0x4a,
+	0x26, 0xbf, 0x7d, 0xea, 0xdb, 0xee, 0x51, 0x68, 0x53, 0xfd, 0x02, 0xfa, 0x9a, 0xff, 0xdd, 0x64,
+	0xdc, 0x6d, 0x42, 0xed, 0x01, 0x09, 0x19, 0xee, 0xa8, 0x19, 0x4e, 0x81, 0x9f, 0x91, 0x65, 0x0f,
+	0x9a, 0x22, 0x10, 0xc6, 0xff, 0x34, 0xd0, 0xad, 0x6c, 0xed, 0xa4, 0x60, 0x21, 0xa3, 0x59, 0xae,
+	0xa7, 0x5f, 0x40, 0x5f, 0x40, 0x9d, 0xfd, 0x60, 0x94, 0xc8, 0xdf, 0xc8, 0x24, 0x9f, 0x04, 0xe5,
+	0x24, 0xde, 0x83, 0xd5, 0x7b, 0x26, 0x91, 0x68, 0x5f, 0xcf, 0xa4, 0x9d, 0xc0, 0x84, 0xa4, 0x5f,
+	0xc9, 0x84, 0xde, 0xf1, 0x3c, 0x47, 0x52, 0xcf, 0x09, 0xa0, 0xf0, 0x97, 0xb8, 0xc4, 0x25, 0xdb,
+	0xdd, 0xa6, 0x81, 0x21, 0xab, 0xed, 0xdc, 0xf8, 0x88, 0xf1, 0x43, 0xa8, 0x06, 0x3f, 0x3c, 0x1c,
+	0xdb, 0x24, 0xe8, 0xda, 0x0c, 0x93, 0x70, 0x44, 0x4e, 0x85, 0xfd, 0x14, 0x56, 0x98, 0xa2, 0x05,
+	0xd1, 0xd7, 0x94, 0x86, 0x78, 0x16, 0x92, 0xfb, 0x00, 0xef, 0x3b, 0x14, 0xfb, 0x82, 0xe6, 0xd5,
+	0x4c, 0x9a, 0x31, 0x20, 0x27, 0x51, 0x17, 0x1a, 0xfb, 0x43, 0xef, 0x24, 0x56, 0x0d, 0x41, 0x37,
+	0xb3, 0x1d, 0x3a, 0x89, 0x0a, 0xc9, 0xdf, 0xca, 0x07, 0x8e, 0xd4, 0xfd, 0x98, 0xdd, 0xdf, 0x52,
+	0xec, 0xc7, 0xa3, 0x0a, 0x7e, 0x29, 0x54, 0xce, 0xe5, 0x3c, 0x86, 0x86, 0xb0, 0xd5, 0x83, 0xf0,
+	0xb0, 0xa7, 0x20, 0x9f, 0x42, 0xe5, 0x24, 0xff, 0x39, 0xac, 0x32, 0xab, 0xc5, 0xc4, 0xaf, 0x2b,
+	0x2d, 0xfb, 0xac, 0xa4, 0x1f, 0x43, 0xed, 0x9e, 0x49, 0x62, 0xca, 0x9b, 0xaa, 0x0d, 0x36, 0x45,
+	0x38, 0xd7, 0xfe, 0x3a, 0x86, 0x3a, 0x33, 0x4a, 0x34, 0x99, 0x28, 0xa2, 0x43, 0x12, 0x14, 0xb2,
+	0xb8, 0x99, 0x0b, 0x1b, 0x31, 0xc3, 0x50, 0x63, 0x63, 0x51, 0xd1, 0xbb, 0xa9, 0x9c, 0x9e, 0xba,
+	0x0f, 0xee, 0x5c, 0xcf, 0x81, 0x94, 0xa2, 0x78, 0x3d, 0xf9, 0xd0, 0x01, 0xdd, 0x56, 0xfe, 0x3a,
+	0xca, 0x7a, 0x72, 0xd1, 0xd9, 0xca, 0x0b, 0x8f, 0x58, 0xfe, 0x0c, 0x2a, 0xc1, 0xf3, 0x03, 0x74,
+	0x75, 0xe6, 0xe4, 0xe8, 0xe5, 0x43, 0xe7, 0xda, 0x5c, 0x5c, 0x44, 0xdd, 0x84, 0xf5, 0x87, 0x63,
+	0x8b, 0x05, 0x7f, 0x91, 0x62, 0xc2, 0x24, 0x87, 0xae, 0x2b, 0xf2, 0x52, 0x0a, 0x77, 0x9f, 0x1c,
+	0xcd, 0x73, 0x33, 0x1f, 0xfe, 0xaf, 0xeb, 0x3e, 0x31, 0x1d, 0xdb, 0x4a, 0xe4, 0x98, 0xfb, 0x98,
+	0x9a, 0xbb, 0xe6, 0x60, 0x88, 0xd3, 0x29, 0x50, 0xbc, 0x65, 0x49, 0x4e, 0x89, 0xc0, 0x39, 0x5d,
+	0xfb, 0x1b, 0x40, 0x22, 0x20, 0xb8, 0x87, 0xf6, 0xd1, 0xc4, 0x37, 0x85, 0xff, 0xa9, 0x92, 0xfb,
+	0x34, 0x34, 0x64, 0xf3, 0xc6, 0x33, 0xcc, 0x90, 0xf2, 0x2e, 0xdc, 0xc5, 0xf4, 0x3e, 0xa6, 0xbe,
+	0x3d, 0x50, 0x45, 0xcd, 0x18, 0xa0, 0x30, 0x5a, 0x06, 0x2e, 0x62, 0xb0, 0x0f, 0x65, 0xf1, 0x02,
+	0x03, 0xe9, 0x99, 0x93, 0xc4, 0xe0, 0xec, 0x6a, 0x21, 0xc4, 0xc8, 0xdb, 0xf5, 0x2e, 0xa6, 0xd2,
+	0xcb, 0x0e, 0xc5, 0x76, 0x4d, 0x82, 0x66, 0x6f, 0xd7, 0x34, 0x36, 0x62, 0xe6, 0x42, 0x83, 0x9d,
+	0x6a, 0xba, 0xa3, 0xf0, 0x6c, 0xae, 0xca, 0x01, 0x29, 0xd4, 0xec, 0x1c, 0x30, 0x05, 0x96, 0x34,
+	0x56, 0x33, 0x30, 0x1b, 0x08, 0xf4, 0xa6, 0xfc, 0xf7, 0x26, 0x3f, 0xbd, 0x99, 0xe7, 0x64, 0x9f,
+	0x45, 0xf5, 0x55, 0x74, 0x99, 0x8c, 0x5e, 0x53, 0x38, 0x4c, 0x0c, 0x61, 0x87, 0xaa, 0x1c, 0x94,
+	0x83, 0x5d, 0xf9, 0xbc, 0x29, 0xf7, 0xa0, 0x29, 0xce, 0x59, 0x12, 0xe5, 0x5b, 0x8a, 0x12, 0x26,
+	0x09, 0xcb, 0xb9, 0xf3, 0x86, 0xb0, 0xca, 0xcc, 0xc0, 0xe6, 0x3d, 0x24, 0xd8, 0x27, 0x8a, 0x7c,
+	0x95, 0xc0, 0x84, 0xa4, 0x6f, 0xe4, 0x81, 0x4a, 0x3e, 0xb4, 0x9a, 0xb8, 0xc8, 0x47, 0xb7, 0x54,
+	0x46, 0xcd, 0x7a, 0x56, 0xd0, 0xb9, 0x9d, 0x13, 0x2d, 0xf9, 0x10, 0x08, 0x73, 0x1b, 0x9e, 0x83,
+	0x15, 0xdb, 0x3a, 0x06, 0xe4, 0x54, 0xd7, 0x27, 0xb0, 0xcc, 0x52, 0x37, 0x27, 0xf9, 0xaa, 0x32,
+	0xb3, 0x3f, 0x03, 0xc1, 0xc7, 0xd0, 0xf8, 0x64, 0x8c, 0x7d, 0x93, 0x62, 0xa6, 0x2f, 0x4e, 0x37,
+	0x7b, 0x67, 0xa5, 0x50, 0xb9, 0xab, 0x72, 0xd8, 0xc7, 0x2c, 0x82, 0xcf, 0x50, 0x42, 0x0c, 0x98,
+	0x1d, 0xdb, 0x64, 0x9c, 0x1c, 0x3c, 0x45, 0x3f, 0x13, 0x6c, 0x26, 0x03, 0x2e, 0x79, 0x0e, 0x06,
+	0x02, 0x27, 0x9f, 0x8a, 0x82, 0xa5, 0x3f, 0xf0, 0xed, 0x27, 0xb6, 0x83, 0x8f, 0xb0, 0x62, 0x07,
+	0xa4, 0x61, 0x39, 0x55, 0xd4, 0x87, 0xaa, 0x60, 0x7c, 0xd7, 0x37, 0x5d, 0x8a, 0x66, 0x89, 0xc6,
+	0x11, 0x21, 0xd9, 0xcd, 0xf9, 0xc0, 0x68, 0x11, 0x03, 0x00, 0xb6, 0x2d, 0x1e, 0x78, 0x8e, 0x3d,
+	0x38, 0x45, 0x9b, 0x8a, 0xd0, 0x10, 0x43, 0x14, 0xc5, 0x4e, 0x26, 0x32, 0x62, 0xd2, 0x87, 0xea,
+	0xee, 0x10, 0x0f, 0x8e, 0xef, 0x61, 0xd3, 0xa1, 0x43, 0xd5, 0x39, 0x25, 0x46, 0xcc, 0x5e, 0x48,
+	0x02, 0x18, 0xf1, 0xf8, 0x12, 0xea, 0xc9, 0x77, 0x30, 0xea, 0x82, 0x2a, 0xf3, 0xbd, 0xcc, 0x3c,
+	0x63, 0x3c, 0x82, 0x9a, 0xfc, 0x06, 0x06, 0xdd, 0x54, 0x51, 0xcf, 0x78, 0x29, 0x33, 0xff, 0x20,
+	0xb3, 0x9a, 0x78, 0xb2, 0xa2, 0x0e, 0x40, 0x59, 0x8f, 0x64, 0x3a, 0xb7, 0x73, 0xa2, 0x25, 0x7b,
+	0x34, 0xd3, 0x6f, 0x51, 0xd0, 0xb6, 0x8a, 0x88, 0xe2, 0xd5, 0xca, 0xbc, 0x35, 0x1d, 0x02, 0x9a,
+	0x7e, 0x52, 0x82, 0xde, 0x50, 0x71, 0x51, 0x3e, 0x3f, 0x99, 0xc7, 0xe7, 0x2b, 0x68, 0xa4, 0x1e,
+	0x8a, 0x20, 0x65, 0x69, 0x9c, 0xfd, 0xa2, 0x64, 0x1e, 0x87, 0x63, 0xa8, 0xc9, 0xcf, 0x0a, 0xd4,
+	0x96, 0xcf, 0x78, 0x03, 0xd1, 0xb9, 0x95, 0x0f, 0x1c, 0x99, 0x66, 0x08, 0x55, 0xe9, 0x86, 0x1f,
+	0xdd, 0x50, 0x4d, 0x9f, 0x7e, 0x6b, 0xd0, 0xb9, 0x99, 0x0b, 0x1b, 0x71, 0x7a, 0x04, 0x35, 0xf9,
+	0x02, 0x5f, 0xbd, 0xac, 0x8c, 0x6b, 0xfe, 0xf9, 0x91, 0x6b, 0x25, 0xba, 0x5e, 0x47, 0x9b, 0x2a,
+	0xc2, 0xe9, 0xfb, 0xfd, 0xce, 0xf5, 0x1c, 0xc8, 0x48, 0xfe, 0xcf, 0xa1, 0x2c, 0x7e, 0x02, 0xa2,
+	0xd7, 0x54, 0xd3, 0x12, 0xf7, 0xeb, 0x9d, 0xab, 0xf3, 0x60, 0xf2, 0xe1, 0x2c, 0x79, 0x25, 0x87,
+	0x66, 0xe5, 0xf8, 0xe9, 0xfb, 0xc1, 0xce, 0x56, 0x5e, 0x78, 0xc4, 0x92, 0x8a, 0x3a, 0x56, 0xba,
+	0xd4, 0x52, 0xbb, 0x71, 0xf6, 0x15, 0x5d, 0x67, 0x3b, 0x37, 0x5e, 0x2a, 0xd5, 0x6b, 0xf2, 0x0d,
+	0xc7, 0x3c, 0xd7, 0x4e, 0xfc, 0xf3, 0xee, 0xdc, 0xca, 0x07, 0x4e, 0xbb, 0xb6, 0xe8, 0x9f, 0xe3,
+	0xda, 0xc9, 0xeb, 0x8e, 0xce, 0xcd, 0x5c, 0x58, 0xd9, 0xb5, 0xe5, 0x0b, 0x06, 0xf5, 0xb2, 0x32,
+	0xae, 0x21, 0xf2, 0xe4, 0x01, 0xe9, 0x7e, 0x61, 0x46, 0x1e, 0x98, 0xbe, 0x85, 0xc8, 0x91, 0x07,
+	0x12, 0x7f, 0xf2, 0xd5, 0x79, 0x20, 0xeb, 0x12, 0xa2, 0x73, 0x3b, 0x27, 0x3a, 0xd4, 0xd3, 0x9d,
+	0xb7, 0x1f, 0xbd, 0x75, 0x64, 0xd3, 0xe1, 0xa4, 0xcf, 0x24, 0xd9, 0x16, 0x93, 0x6f, 0xdb, 0x5e,
+	0xf0, 0xb5, 0x1d, 0x26, 0xf5, 0x6d, 0x4e, 0x6f, 0x3b, 0xa2, 0x37, 0xee, 0xf7, 0xcb, 0xbc, 0xeb,
+	0xcd, 0xff, 0x0e, 0x00, 0x24, 0xe6, 0xea, 0x4f, 0x86, 0x32, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RootCoordClient is the client API for RootCoord service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RootCoordClient interface {
+	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
+	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	//*
+	// @brief This method is used to create collection
+	//
+	// @param CreateCollectionRequest, use to provide collection information to be created.
+	//
+	// @return Status
+	CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to delete collection.
+	//
+	// @param DropCollectionRequest, collection name is going to be deleted.
+	//
+	// @return Status
+	DropCollection(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to test collection existence.
+	//
+	// @param HasCollectionRequest, collection name is going to be tested.
+	//
+	// @return BoolResponse
+	HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error)
+	//*
+	// @brief This method is used to get collection schema.
+	//
+	// @param DescribeCollectionRequest, target collection name.
+	//
+	// @return CollectionSchema
+	DescribeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)
+	CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to list all collections.
+	//
+	// @return StringListResponse, collection name list
+	ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error)
+	AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to create partition
+	//
+	// @return Status
+	CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to drop partition
+	//
+	// @return Status
+	DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	//*
+	// @brief This method is used to test partition existence.
+	//
+	// @return BoolResponse
+	HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error)
+	//*
+	// @brief This method is used to show partition information
+	//
+	// @param ShowPartitionRequest, target collection name.
+	//
+	// @return StringListResponse
+	ShowPartitions(ctx context.Context, in *milvuspb.ShowPartitionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error)
+	//    rpc DescribeSegment(milvus.DescribeSegmentRequest) returns (milvus.DescribeSegmentResponse) {}
+	ShowSegments(ctx context.Context, in *milvuspb.ShowSegmentsRequest, opts ...grpc.CallOption) (*milvuspb.ShowSegmentsResponse, error)
+	AllocTimestamp(ctx context.Context, in *AllocTimestampRequest, opts ...grpc.CallOption) (*AllocTimestampResponse, error)
+	AllocID(ctx context.Context, in *AllocIDRequest, opts ...grpc.CallOption) (*AllocIDResponse, error)
+	UpdateChannelTimeTick(ctx context.Context, in *internalpb.ChannelTimeTickMsg, opts ...grpc.CallOption) (*commonpb.Status, error)
+	InvalidateCollectionMetaCache(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
+	Import(ctx context.Context, in *milvuspb.ImportRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error)
+	GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)
+	ListImportTasks(ctx context.Context, in *milvuspb.ListImportTasksRequest, opts ...grpc.CallOption) (*milvuspb.ListImportTasksResponse, error)
+	ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+27+--+Support+Basic+Authentication
+	CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error)
+	// userd by proxy, not exposed to sdk
+	GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+29+--+Support+Role-Based+Access+Control
+	CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SelectRole(ctx context.Context, in *milvuspb.SelectRoleRequest, opts ...grpc.CallOption) (*milvuspb.SelectRoleResponse, error)
+	SelectUser(ctx context.Context, in *milvuspb.SelectUserRequest, opts ...grpc.CallOption) (*milvuspb.SelectUserResponse, error)
+	OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error)
+	ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest, opts ...grpc.CallOption) (*internalpb.ListPolicyResponse, error)
+	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
+	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
+	RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	TruncateCollection(ctx context.Context, in *TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CloneCollection(ctx context.Context, in *CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// used by proxy, not exposed to sdk
+	GetApiKey(ctx context.Context, in *GetApiKeyRequest, opts ...grpc.CallOption) (*GetApiKeyResponse, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error)
+	ListExportTasks(ctx context.Context, in *ListExportTasksRequest, opts ...grpc.CallOption) (*ListExportTasksResponse, error)
+	CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*CreateBackupResponse, error)
+	ListBackups(ctx context.Context, in *ListBackupsRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error)
+	VerifyBackup(ctx context.Context, in *VerifyBackupRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteBackup(ctx context.Context, in *DeleteBackupRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error)
+}
+
+type rootCoordClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRootCoordClient(cc *grpc.ClientConn) RootCoordClient {
+	return &rootCoordClient{cc}
+}
+
+func (c *rootCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+	out := new(milvuspb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetTimeTickChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetStatisticsChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
+	out := new(milvuspb.BoolResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/HasCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DescribeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error) {
+	out := new(milvuspb.DescribeCollectionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DescribeCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AlterAlias", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1158,99 +3156,261 @@ func (c *rootCoordClient) DeleteCredential(ctx context.Context, in *milvuspb.Del
 	return out, nil
 }
 
-func (c *rootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error) {
-	out := new(milvuspb.ListCredUsersResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListCredUsers", in, out, opts...)
+func (c *rootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error) {
+	out := new(milvuspb.ListCredUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListCredUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error) {
+	out := new(GetCredentialResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperateUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) SelectRole(ctx context.Context, in *milvuspb.SelectRoleRequest, opts ...grpc.CallOption) (*milvuspb.SelectRoleResponse, error) {
+	out := new(milvuspb.SelectRoleResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SelectRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) SelectUser(ctx context.Context, in *milvuspb.SelectUserRequest, opts ...grpc.CallOption) (*milvuspb.SelectUserResponse, error) {
+	out := new(milvuspb.SelectUserResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SelectUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperatePrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error) {
+	out := new(milvuspb.SelectGrantResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SelectGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest, opts ...grpc.CallOption) (*internalpb.ListPolicyResponse, error) {
+	out := new(internalpb.ListPolicyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
+	out := new(milvuspb.CheckHealthResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CheckHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RenameCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) TruncateCollection(ctx context.Context, in *TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/TruncateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CloneCollection(ctx context.Context, in *CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CloneCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListApiKeys", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error) {
-	out := new(GetCredentialResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetCredential", in, out, opts...)
+func (c *rootCoordClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RevokeApiKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateRole", in, out, opts...)
+func (c *rootCoordClient) GetApiKey(ctx context.Context, in *GetApiKeyRequest, opts ...grpc.CallOption) (*GetApiKeyResponse, error) {
+	out := new(GetApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetApiKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropRole", in, out, opts...)
+func (c *rootCoordClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/Export", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperateUserRole", in, out, opts...)
+func (c *rootCoordClient) GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error) {
+	out := new(GetExportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetExportState", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) SelectRole(ctx context.Context, in *milvuspb.SelectRoleRequest, opts ...grpc.CallOption) (*milvuspb.SelectRoleResponse, error) {
-	out := new(milvuspb.SelectRoleResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SelectRole", in, out, opts...)
+func (c *rootCoordClient) ListExportTasks(ctx context.Context, in *ListExportTasksRequest, opts ...grpc.CallOption) (*ListExportTasksResponse, error) {
+	out := new(ListExportTasksResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListExportTasks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) SelectUser(ctx context.Context, in *milvuspb.SelectUserRequest, opts ...grpc.CallOption) (*milvuspb.SelectUserResponse, error) {
-	out := new(milvuspb.SelectUserResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SelectUser", in, out, opts...)
+func (c *rootCoordClient) CreateBackup(ctx context.Context, in *CreateBackupRequest, opts ...grpc.CallOption) (*CreateBackupResponse, error) {
+	out := new(CreateBackupResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateBackup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperatePrivilege", in, out, opts...)
+func (c *rootCoordClient) ListBackups(ctx context.Context, in *ListBackupsRequest, opts ...grpc.CallOption) (*ListBackupsResponse, error) {
+	out := new(ListBackupsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListBackups", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error) {
-	out := new(milvuspb.SelectGrantResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/SelectGrant", in, out, opts...)
+func (c *rootCoordClient) VerifyBackup(ctx context.Context, in *VerifyBackupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/VerifyBackup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest, opts ...grpc.CallOption) (*internalpb.ListPolicyResponse, error) {
-	out := new(internalpb.ListPolicyResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListPolicy", in, out, opts...)
+func (c *rootCoordClient) DeleteBackup(ctx context.Context, in *DeleteBackupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DeleteBackup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
-	out := new(milvuspb.CheckHealthResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CheckHealth", in, out, opts...)
+func (c *rootCoordClient) RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error) {
+	out := new(RestoreBackupResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RestoreBackup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1352,6 +3512,25 @@ type RootCoordServer interface {
 	SelectGrant(context.Context, *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(context.Context, *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)
 	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
+	RenameCollection(context.Context, *RenameCollectionRequest) (*commonpb.Status, error)
+	TruncateCollection(context.Context, *TruncateCollectionRequest) (*commonpb.Status, error)
+	CloneCollection(context.Context, *CloneCollectionRequest) (*commonpb.Status, error)
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*commonpb.Status, error)
+	// used by proxy, not exposed to sdk
+	GetApiKey(context.Context, *GetApiKeyRequest) (*GetApiKeyResponse, error)
+	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	GetExportState(context.Context, *GetExportStateRequest) (*GetExportStateResponse, error)
+	ListExportTasks(context.Context, *ListExportTasksRequest) (*ListExportTasksResponse, error)
+	CreateBackup(context.Context, *CreateBackupRequest) (*CreateBackupResponse, error)
+	ListBackups(context.Context, *ListBackupsRequest) (*ListBackupsResponse, error)
+	VerifyBackup(context.Context, *VerifyBackupRequest) (*commonpb.Status, error)
+	DeleteBackup(context.Context, *DeleteBackupRequest) (*commonpb.Status, error)
+	RestoreBackup(context.Context, *RestoreBackupRequest) (*RestoreBackupResponse, error)
 }
 
 // UnimplementedRootCoordServer can be embedded to have forward compatible implementations.
@@ -1460,766 +3639,1144 @@ func (*UnimplementedRootCoordServer) CreateRole(ctx context.Context, req *milvus
 func (*UnimplementedRootCoordServer) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
 }
-func (*UnimplementedRootCoordServer) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperateUserRole not implemented")
+func (*UnimplementedRootCoordServer) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateUserRole not implemented")
+}
+func (*UnimplementedRootCoordServer) SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectRole not implemented")
+}
+func (*UnimplementedRootCoordServer) SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectUser not implemented")
+}
+func (*UnimplementedRootCoordServer) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatePrivilege not implemented")
+}
+func (*UnimplementedRootCoordServer) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectGrant not implemented")
+}
+func (*UnimplementedRootCoordServer) ListPolicy(ctx context.Context, req *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicy not implemented")
+}
+func (*UnimplementedRootCoordServer) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateDatabase(ctx context.Context, req *CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) DropDatabase(ctx context.Context, req *DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDatabases(ctx context.Context, req *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (*UnimplementedRootCoordServer) RenameCollection(ctx context.Context, req *RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) TruncateCollection(ctx context.Context, req *TruncateCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TruncateCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) CloneCollection(ctx context.Context, req *CloneCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateApiKey(ctx context.Context, req *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (*UnimplementedRootCoordServer) ListApiKeys(ctx context.Context, req *ListApiKeysRequest) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (*UnimplementedRootCoordServer) RevokeApiKey(ctx context.Context, req *RevokeApiKeyRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (*UnimplementedRootCoordServer) GetApiKey(ctx context.Context, req *GetApiKeyRequest) (*GetApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiKey not implemented")
+}
+func (*UnimplementedRootCoordServer) Export(ctx context.Context, req *ExportRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedRootCoordServer) GetExportState(ctx context.Context, req *GetExportStateRequest) (*GetExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportState not implemented")
+}
+func (*UnimplementedRootCoordServer) ListExportTasks(ctx context.Context, req *ListExportTasksRequest) (*ListExportTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExportTasks not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateBackup(ctx context.Context, req *CreateBackupRequest) (*CreateBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBackup not implemented")
+}
+func (*UnimplementedRootCoordServer) ListBackups(ctx context.Context, req *ListBackupsRequest) (*ListBackupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackups not implemented")
+}
+func (*UnimplementedRootCoordServer) VerifyBackup(ctx context.Context, req *VerifyBackupRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBackup not implemented")
+}
+func (*UnimplementedRootCoordServer) DeleteBackup(ctx context.Context, req *DeleteBackupRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBackup not implemented")
+}
+func (*UnimplementedRootCoordServer) RestoreBackup(ctx context.Context, req *RestoreBackupRequest) (*RestoreBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBackup not implemented")
+}
+
+func RegisterRootCoordServer(s *grpc.Server, srv RootCoordServer) {
+	s.RegisterService(&_RootCoord_serviceDesc, srv)
+}
+
+func _RootCoord_GetComponentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetComponentStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetComponentStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetComponentStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetComponentStates(ctx, req.(*milvuspb.GetComponentStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetTimeTickChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetTimeTickChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetTimeTickChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetTimeTickChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetTimeTickChannel(ctx, req.(*internalpb.GetTimeTickChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetStatisticsChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.GetStatisticsChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetStatisticsChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetStatisticsChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetStatisticsChannel(ctx, req.(*internalpb.GetStatisticsChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateCollection(ctx, req.(*milvuspb.CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropCollection(ctx, req.(*milvuspb.DropCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_HasCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.HasCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).HasCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/HasCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).HasCollection(ctx, req.(*milvuspb.HasCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DescribeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DescribeCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DescribeCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DescribeCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DescribeCollection(ctx, req.(*milvuspb.DescribeCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateAlias(ctx, req.(*milvuspb.CreateAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropAlias(ctx, req.(*milvuspb.DropAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_AlterAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AlterAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AlterAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AlterAlias(ctx, req.(*milvuspb.AlterAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ShowCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ShowCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ShowCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ShowCollections(ctx, req.(*milvuspb.ShowCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRootCoordServer) SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SelectRole not implemented")
+
+func _RootCoord_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AlterCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AlterCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AlterCollection(ctx, req.(*milvuspb.AlterCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRootCoordServer) SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SelectUser not implemented")
+
+func _RootCoord_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreatePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreatePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreatePartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreatePartition(ctx, req.(*milvuspb.CreatePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRootCoordServer) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OperatePrivilege not implemented")
+
+func _RootCoord_DropPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropPartition(ctx, req.(*milvuspb.DropPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRootCoordServer) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SelectGrant not implemented")
+
+func _RootCoord_HasPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.HasPartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).HasPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/HasPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).HasPartition(ctx, req.(*milvuspb.HasPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRootCoordServer) ListPolicy(ctx context.Context, req *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPolicy not implemented")
+
+func _RootCoord_ShowPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ShowPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ShowPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ShowPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ShowPartitions(ctx, req.(*milvuspb.ShowPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedRootCoordServer) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
+
+func _RootCoord_ShowSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ShowSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ShowSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ShowSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ShowSegments(ctx, req.(*milvuspb.ShowSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRootCoordServer(s *grpc.Server, srv RootCoordServer) {
-	s.RegisterService(&_RootCoord_serviceDesc, srv)
+func _RootCoord_AllocTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AllocTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AllocTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AllocTimestamp(ctx, req.(*AllocTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_GetComponentStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetComponentStatesRequest)
+func _RootCoord_AllocID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).GetComponentStates(ctx, in)
+		return srv.(RootCoordServer).AllocID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetComponentStates",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AllocID",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).GetComponentStates(ctx, req.(*milvuspb.GetComponentStatesRequest))
+		return srv.(RootCoordServer).AllocID(ctx, req.(*AllocIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_GetTimeTickChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.GetTimeTickChannelRequest)
+func _RootCoord_UpdateChannelTimeTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.ChannelTimeTickMsg)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).GetTimeTickChannel(ctx, in)
+		return srv.(RootCoordServer).UpdateChannelTimeTick(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetTimeTickChannel",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/UpdateChannelTimeTick",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).GetTimeTickChannel(ctx, req.(*internalpb.GetTimeTickChannelRequest))
+		return srv.(RootCoordServer).UpdateChannelTimeTick(ctx, req.(*internalpb.ChannelTimeTickMsg))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_GetStatisticsChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.GetStatisticsChannelRequest)
+func _RootCoord_InvalidateCollectionMetaCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.InvalidateCollMetaCacheRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).GetStatisticsChannel(ctx, in)
+		return srv.(RootCoordServer).InvalidateCollectionMetaCache(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetStatisticsChannel",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/InvalidateCollectionMetaCache",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).GetStatisticsChannel(ctx, req.(*internalpb.GetStatisticsChannelRequest))
+		return srv.(RootCoordServer).InvalidateCollectionMetaCache(ctx, req.(*proxypb.InvalidateCollMetaCacheRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.CreateCollectionRequest)
+func _RootCoord_ShowConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.ShowConfigurationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).CreateCollection(ctx, in)
+		return srv.(RootCoordServer).ShowConfigurations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateCollection",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ShowConfigurations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).CreateCollection(ctx, req.(*milvuspb.CreateCollectionRequest))
+		return srv.(RootCoordServer).ShowConfigurations(ctx, req.(*internalpb.ShowConfigurationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_DropCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.DropCollectionRequest)
+func _RootCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetMetricsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).DropCollection(ctx, in)
+		return srv.(RootCoordServer).GetMetrics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropCollection",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetMetrics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).DropCollection(ctx, req.(*milvuspb.DropCollectionRequest))
+		return srv.(RootCoordServer).GetMetrics(ctx, req.(*milvuspb.GetMetricsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_HasCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.HasCollectionRequest)
+func _RootCoord_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ImportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).HasCollection(ctx, in)
+		return srv.(RootCoordServer).Import(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/HasCollection",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/Import",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).HasCollection(ctx, req.(*milvuspb.HasCollectionRequest))
+		return srv.(RootCoordServer).Import(ctx, req.(*milvuspb.ImportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_DescribeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.DescribeCollectionRequest)
+func _RootCoord_GetImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetImportStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).DescribeCollection(ctx, in)
+		return srv.(RootCoordServer).GetImportState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/DescribeCollection",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetImportState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).DescribeCollection(ctx, req.(*milvuspb.DescribeCollectionRequest))
+		return srv.(RootCoordServer).GetImportState(ctx, req.(*milvuspb.GetImportStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.CreateAliasRequest)
+func _RootCoord_ListImportTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListImportTasksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).CreateAlias(ctx, in)
+		return srv.(RootCoordServer).ListImportTasks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateAlias",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListImportTasks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).CreateAlias(ctx, req.(*milvuspb.CreateAliasRequest))
+		return srv.(RootCoordServer).ListImportTasks(ctx, req.(*milvuspb.ListImportTasksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_DropAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.DropAliasRequest)
+func _RootCoord_ReportImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportResult)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).DropAlias(ctx, in)
+		return srv.(RootCoordServer).ReportImport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropAlias",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ReportImport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).DropAlias(ctx, req.(*milvuspb.DropAliasRequest))
+		return srv.(RootCoordServer).ReportImport(ctx, req.(*ImportResult))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_AlterAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.AlterAliasRequest)
+func _RootCoord_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.CredentialInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).AlterAlias(ctx, in)
+		return srv.(RootCoordServer).CreateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterAlias",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateCredential",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).AlterAlias(ctx, req.(*milvuspb.AlterAliasRequest))
+		return srv.(RootCoordServer).CreateCredential(ctx, req.(*internalpb.CredentialInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.ShowCollectionsRequest)
+func _RootCoord_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.CredentialInfo)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).ShowCollections(ctx, in)
+		return srv.(RootCoordServer).UpdateCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/ShowCollections",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/UpdateCredential",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).ShowCollections(ctx, req.(*milvuspb.ShowCollectionsRequest))
+		return srv.(RootCoordServer).UpdateCredential(ctx, req.(*internalpb.CredentialInfo))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.AlterCollectionRequest)
+func _RootCoord_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DeleteCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).AlterCollection(ctx, in)
+		return srv.(RootCoordServer).DeleteCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterCollection",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DeleteCredential",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).AlterCollection(ctx, req.(*milvuspb.AlterCollectionRequest))
+		return srv.(RootCoordServer).DeleteCredential(ctx, req.(*milvuspb.DeleteCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_CreatePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.CreatePartitionRequest)
+func _RootCoord_ListCredUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListCredUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).CreatePartition(ctx, in)
+		return srv.(RootCoordServer).ListCredUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreatePartition",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListCredUsers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).CreatePartition(ctx, req.(*milvuspb.CreatePartitionRequest))
+		return srv.(RootCoordServer).ListCredUsers(ctx, req.(*milvuspb.ListCredUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_DropPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.DropPartitionRequest)
+func _RootCoord_GetCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCredentialRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).DropPartition(ctx, in)
+		return srv.(RootCoordServer).GetCredential(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropPartition",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetCredential",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).DropPartition(ctx, req.(*milvuspb.DropPartitionRequest))
+		return srv.(RootCoordServer).GetCredential(ctx, req.(*GetCredentialRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_HasPartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.HasPartitionRequest)
+func _RootCoord_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).HasPartition(ctx, in)
+		return srv.(RootCoordServer).CreateRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/HasPartition",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).HasPartition(ctx, req.(*milvuspb.HasPartitionRequest))
+		return srv.(RootCoordServer).CreateRole(ctx, req.(*milvuspb.CreateRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_ShowPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.ShowPartitionsRequest)
+func _RootCoord_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).ShowPartitions(ctx, in)
+		return srv.(RootCoordServer).DropRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/ShowPartitions",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).ShowPartitions(ctx, req.(*milvuspb.ShowPartitionsRequest))
+		return srv.(RootCoordServer).DropRole(ctx, req.(*milvuspb.DropRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_ShowSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.ShowSegmentsRequest)
+func _RootCoord_OperateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.OperateUserRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).ShowSegments(ctx, in)
+		return srv.(RootCoordServer).OperateUserRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/ShowSegments",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperateUserRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).ShowSegments(ctx, req.(*milvuspb.ShowSegmentsRequest))
+		return srv.(RootCoordServer).OperateUserRole(ctx, req.(*milvuspb.OperateUserRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_AllocTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllocTimestampRequest)
+func _RootCoord_SelectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.SelectRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).AllocTimestamp(ctx, in)
+		return srv.(RootCoordServer).SelectRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/AllocTimestamp",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/SelectRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).AllocTimestamp(ctx, req.(*AllocTimestampRequest))
+		return srv.(RootCoordServer).SelectRole(ctx, req.(*milvuspb.SelectRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_AllocID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllocIDRequest)
+func _RootCoord_SelectUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.SelectUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).AllocID(ctx, in)
+		return srv.(RootCoordServer).SelectUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/AllocID",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/SelectUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).AllocID(ctx, req.(*AllocIDRequest))
+		return srv.(RootCoordServer).SelectUser(ctx, req.(*milvuspb.SelectUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_UpdateChannelTimeTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.ChannelTimeTickMsg)
+func _RootCoord_OperatePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.OperatePrivilegeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).UpdateChannelTimeTick(ctx, in)
+		return srv.(RootCoordServer).OperatePrivilege(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/UpdateChannelTimeTick",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperatePrivilege",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).UpdateChannelTimeTick(ctx, req.(*internalpb.ChannelTimeTickMsg))
+		return srv.(RootCoordServer).OperatePrivilege(ctx, req.(*milvuspb.OperatePrivilegeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_InvalidateCollectionMetaCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(proxypb.InvalidateCollMetaCacheRequest)
+func _RootCoord_SelectGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.SelectGrantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).InvalidateCollectionMetaCache(ctx, in)
+		return srv.(RootCoordServer).SelectGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/InvalidateCollectionMetaCache",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/SelectGrant",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).InvalidateCollectionMetaCache(ctx, req.(*proxypb.InvalidateCollMetaCacheRequest))
+		return srv.(RootCoordServer).SelectGrant(ctx, req.(*milvuspb.SelectGrantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_ShowConfigurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.ShowConfigurationsRequest)
+func _RootCoord_ListPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.ListPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).ShowConfigurations(ctx, in)
+		return srv.(RootCoordServer).ListPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/ShowConfigurations",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).ShowConfigurations(ctx, req.(*internalpb.ShowConfigurationsRequest))
+		return srv.(RootCoordServer).ListPolicy(ctx, req.(*internalpb.ListPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetMetricsRequest)
+func _RootCoord_CheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CheckHealthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).GetMetrics(ctx, in)
+		return srv.(RootCoordServer).CheckHealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetMetrics",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CheckHealth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).GetMetrics(ctx, req.(*milvuspb.GetMetricsRequest))
+		return srv.(RootCoordServer).CheckHealth(ctx, req.(*milvuspb.CheckHealthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.ImportRequest)
+func _RootCoord_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).Import(ctx, in)
+		return srv.(RootCoordServer).CreateDatabase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/Import",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateDatabase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).Import(ctx, req.(*milvuspb.ImportRequest))
+		return srv.(RootCoordServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_GetImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.GetImportStateRequest)
+func _RootCoord_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropDatabaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).GetImportState(ctx, in)
+		return srv.(RootCoordServer).DropDatabase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetImportState",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropDatabase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).GetImportState(ctx, req.(*milvuspb.GetImportStateRequest))
+		return srv.(RootCoordServer).DropDatabase(ctx, req.(*DropDatabaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_ListImportTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.ListImportTasksRequest)
+func _RootCoord_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).ListImportTasks(ctx, in)
+		return srv.(RootCoordServer).ListDatabases(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListImportTasks",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDatabases",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).ListImportTasks(ctx, req.(*milvuspb.ListImportTasksRequest))
+		return srv.(RootCoordServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_ReportImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportResult)
+func _RootCoord_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).ReportImport(ctx, in)
+		return srv.(RootCoordServer).RenameCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/ReportImport",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RenameCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).ReportImport(ctx, req.(*ImportResult))
+		return srv.(RootCoordServer).RenameCollection(ctx, req.(*RenameCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.CredentialInfo)
+func _RootCoord_TruncateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TruncateCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).CreateCredential(ctx, in)
+		return srv.(RootCoordServer).TruncateCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateCredential",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/TruncateCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).CreateCredential(ctx, req.(*internalpb.CredentialInfo))
+		return srv.(RootCoordServer).TruncateCollection(ctx, req.(*TruncateCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.CredentialInfo)
+func _RootCoord_CloneCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).UpdateCredential(ctx, in)
+		return srv.(RootCoordServer).CloneCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/UpdateCredential",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CloneCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).UpdateCredential(ctx, req.(*internalpb.CredentialInfo))
+		return srv.(RootCoordServer).CloneCollection(ctx, req.(*CloneCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.DeleteCredentialRequest)
+func _RootCoord_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).DeleteCredential(ctx, in)
+		return srv.(RootCoordServer).CreateApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/DeleteCredential",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateApiKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).DeleteCredential(ctx, req.(*milvuspb.DeleteCredentialRequest))
+		return srv.(RootCoordServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_ListCredUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.ListCredUsersRequest)
+func _RootCoord_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).ListCredUsers(ctx, in)
+		return srv.(RootCoordServer).ListApiKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListCredUsers",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListApiKeys",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).ListCredUsers(ctx, req.(*milvuspb.ListCredUsersRequest))
+		return srv.(RootCoordServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_GetCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCredentialRequest)
+func _RootCoord_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).GetCredential(ctx, in)
+		return srv.(RootCoordServer).RevokeApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetCredential",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RevokeApiKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).GetCredential(ctx, req.(*GetCredentialRequest))
+		return srv.(RootCoordServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.CreateRoleRequest)
+func _RootCoord_GetApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).CreateRole(ctx, in)
+		return srv.(RootCoordServer).GetApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateRole",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetApiKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).CreateRole(ctx, req.(*milvuspb.CreateRoleRequest))
+		return srv.(RootCoordServer).GetApiKey(ctx, req.(*GetApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.DropRoleRequest)
+func _RootCoord_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).DropRole(ctx, in)
+		return srv.(RootCoordServer).Export(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropRole",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/Export",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).DropRole(ctx, req.(*milvuspb.DropRoleRequest))
+		return srv.(RootCoordServer).Export(ctx, req.(*ExportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_OperateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.OperateUserRoleRequest)
+func _RootCoord_GetExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).OperateUserRole(ctx, in)
+		return srv.(RootCoordServer).GetExportState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperateUserRole",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetExportState",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).OperateUserRole(ctx, req.(*milvuspb.OperateUserRoleRequest))
+		return srv.(RootCoordServer).GetExportState(ctx, req.(*GetExportStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_SelectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.SelectRoleRequest)
+func _RootCoord_ListExportTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExportTasksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).SelectRole(ctx, in)
+		return srv.(RootCoordServer).ListExportTasks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/SelectRole",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListExportTasks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).SelectRole(ctx, req.(*milvuspb.SelectRoleRequest))
+		return srv.(RootCoordServer).ListExportTasks(ctx, req.(*ListExportTasksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_SelectUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.SelectUserRequest)
+func _RootCoord_CreateBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).SelectUser(ctx, in)
+		return srv.(RootCoordServer).CreateBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/SelectUser",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).SelectUser(ctx, req.(*milvuspb.SelectUserRequest))
+		return srv.(RootCoordServer).CreateBackup(ctx, req.(*CreateBackupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_OperatePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.OperatePrivilegeRequest)
+func _RootCoord_ListBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackupsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).OperatePrivilege(ctx, in)
+		return srv.(RootCoordServer).ListBackups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperatePrivilege",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListBackups",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).OperatePrivilege(ctx, req.(*milvuspb.OperatePrivilegeRequest))
+		return srv.(RootCoordServer).ListBackups(ctx, req.(*ListBackupsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_SelectGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.SelectGrantRequest)
+func _RootCoord_VerifyBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).SelectGrant(ctx, in)
+		return srv.(RootCoordServer).VerifyBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/SelectGrant",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/VerifyBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).SelectGrant(ctx, req.(*milvuspb.SelectGrantRequest))
+		return srv.(RootCoordServer).VerifyBackup(ctx, req.(*VerifyBackupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_ListPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(internalpb.ListPolicyRequest)
+func _RootCoord_DeleteBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).ListPolicy(ctx, in)
+		return srv.(RootCoordServer).DeleteBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListPolicy",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DeleteBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).ListPolicy(ctx, req.(*internalpb.ListPolicyRequest))
+		return srv.(RootCoordServer).DeleteBackup(ctx, req.(*DeleteBackupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RootCoord_CheckHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(milvuspb.CheckHealthRequest)
+func _RootCoord_RestoreBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBackupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RootCoordServer).CheckHealth(ctx, in)
+		return srv.(RootCoordServer).RestoreBackup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.rootcoord.RootCoord/CheckHealth",
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RestoreBackup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RootCoordServer).CheckHealth(ctx, req.(*milvuspb.CheckHealthRequest))
+		return srv.(RootCoordServer).RestoreBackup(ctx, req.(*RestoreBackupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2392,6 +4949,78 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CheckHealth",
 			Handler:    _RootCoord_CheckHealth_Handler,
 		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _RootCoord_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _RootCoord_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _RootCoord_ListDatabases_Handler,
+		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _RootCoord_RenameCollection_Handler,
+		},
+		{
+			MethodName: "TruncateCollection",
+			Handler:    _RootCoord_TruncateCollection_Handler,
+		},
+		{
+			MethodName: "CloneCollection",
+			Handler:    _RootCoord_CloneCollection_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _RootCoord_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _RootCoord_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _RootCoord_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "GetApiKey",
+			Handler:    _RootCoord_GetApiKey_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _RootCoord_Export_Handler,
+		},
+		{
+			MethodName: "GetExportState",
+			Handler:    _RootCoord_GetExportState_Handler,
+		},
+		{
+			MethodName: "ListExportTasks",
+			Handler:    _RootCoord_ListExportTasks_Handler,
+		},
+		{
+			MethodName: "CreateBackup",
+			Handler:    _RootCoord_CreateBackup_Handler,
+		},
+		{
+			MethodName: "ListBackups",
+			Handler:    _RootCoord_ListBackups_Handler,
+		},
+		{
+			MethodName: "VerifyBackup",
+			Handler:    _RootCoord_VerifyBackup_Handler,
+		},
+		{
+			MethodName: "DeleteBackup",
+			Handler:    _RootCoord_DeleteBackup_Handler,
+		},
+		{
+			MethodName: "RestoreBackup",
+			Handler:    _RootCoord_RestoreBackup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "root_coord.proto",
@@ -179,9 +179,58 @@ func (m *LoadSegmentMeta) GetTotalSize() int64 {
 	return 0
 }
 
+// FieldValidData marks the null rows of a nullable field, valid[i] is false if the i-th row is null.
+type FieldValidData struct {
+	FieldId              int64    `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Valid                []bool   `protobuf:"varint,2,rep,packed,name=valid,proto3" json:"valid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldValidData) Reset()         { *m = FieldValidData{} }
+func (m *FieldValidData) String() string { return proto.CompactTextString(m) }
+func (*FieldValidData) ProtoMessage()    {}
+func (*FieldValidData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d79fce784797357, []int{3}
+}
+
+func (m *FieldValidData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldValidData.Unmarshal(m, b)
+}
+func (m *FieldValidData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldValidData.Marshal(b, m, deterministic)
+}
+func (m *FieldValidData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldValidData.Merge(m, src)
+}
+func (m *FieldValidData) XXX_Size() int {
+	return xxx_messageInfo_FieldValidData.Size(m)
+}
+func (m *FieldValidData) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldValidData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldValidData proto.InternalMessageInfo
+
+func (m *FieldValidData) GetFieldId() int64 {
+	if m != nil {
+		return m.FieldId
+	}
+	return 0
+}
+
+func (m *FieldValidData) GetValid() []bool {
+	if m != nil {
+		return m.Valid
+	}
+	return nil
+}
+
 type InsertRecord struct {
 	FieldsData           []*schemapb.FieldData `protobuf:"bytes,1,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
 	NumRows              int64                 `protobuf:"varint,2,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	ValidData            []*FieldValidData     `protobuf:"bytes,3,rep,name=valid_data,json=validData,proto3" json:"valid_data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -191,7 +240,7 @@ func (m *InsertRecord) Reset()         { *m = InsertRecord{} }
 func (m *InsertRecord) String() string { return proto.CompactTextString(m) }
 func (*InsertRecord) ProtoMessage()    {}
 func (*InsertRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1d79fce784797357, []int{3}
+	return fileDescriptor_1d79fce784797357, []int{4}
 }
 
 func (m *InsertRecord) XXX_Unmarshal(b []byte) error {
@@ -226,38 +275,49 @@ func (m *InsertRecord) GetNumRows() int64 {
 	return 0
 }
 
+func (m *InsertRecord) GetValidData() []*FieldValidData {
+	if m != nil {
+		return m.ValidData
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RetrieveResults)(nil), "milvus.proto.segcore.RetrieveResults")
 	proto.RegisterType((*LoadFieldMeta)(nil), "milvus.proto.segcore.LoadFieldMeta")
 	proto.RegisterType((*LoadSegmentMeta)(nil), "milvus.proto.segcore.LoadSegmentMeta")
+	proto.RegisterType((*FieldValidData)(nil), "milvus.proto.segcore.FieldValidData")
 	proto.RegisterType((*InsertRecord)(nil), "milvus.proto.segcore.InsertRecord")
 }
 
 func init() { proto.RegisterFile("segcore.proto", fileDescriptor_1d79fce784797357) }
 
 var fileDescriptor_1d79fce784797357 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0xc1, 0x4b, 0xeb, 0x40,
-	0x10, 0xc6, 0x49, 0xc3, 0xeb, 0x6b, 0xb7, 0x2d, 0x85, 0xf0, 0x78, 0x44, 0x45, 0x29, 0xe9, 0xa5,
-	0x08, 0x26, 0x50, 0x45, 0xf0, 0x24, 0x68, 0x11, 0x0a, 0x7a, 0xd9, 0x7a, 0xf2, 0x12, 0xb6, 0xc9,
-	0xb4, 0x5d, 0xcd, 0xee, 0x96, 0xdd, 0x49, 0x53, 0xfa, 0x87, 0xf8, 0xf7, 0x4a, 0x36, 0x11, 0xad,
-	0xf4, 0xe2, 0x6d, 0xe7, 0xdb, 0xdf, 0xcc, 0x37, 0xf3, 0x91, 0x9e, 0x81, 0x65, 0xa2, 0x34, 0x84,
-	0x6b, 0xad, 0x50, 0x79, 0xff, 0x04, 0xcf, 0x36, 0xb9, 0xa9, 0xaa, 0xb0, 0xfe, 0x3b, 0xee, 0x9a,
-	0x64, 0x05, 0x82, 0x55, 0x6a, 0xf0, 0xee, 0x90, 0x3e, 0x05, 0xd4, 0x1c, 0x36, 0x40, 0xc1, 0xe4,
-	0x19, 0x1a, 0xef, 0x9c, 0xb8, 0x3c, 0x35, 0xbe, 0x33, 0x70, 0x46, 0x9d, 0xb1, 0x1f, 0xee, 0x4f,
-	0xa9, 0x9a, 0xa7, 0x13, 0x43, 0x4b, 0xc8, 0xfb, 0x4f, 0x9a, 0x6a, 0xb1, 0x30, 0x80, 0x7e, 0x63,
-	0xe0, 0x8e, 0x5c, 0x5a, 0x57, 0xde, 0x2d, 0xe9, 0x2c, 0x38, 0x64, 0xa9, 0x89, 0x53, 0x86, 0xcc,
-	0x77, 0x07, 0xee, 0xa8, 0x33, 0x3e, 0x3b, 0x38, 0xeb, 0xa1, 0xe4, 0x26, 0x0c, 0x19, 0x25, 0x55,
-	0x4b, 0xf9, 0x0e, 0x36, 0xa4, 0xf7, 0xa8, 0x58, 0x6a, 0x3f, 0x9f, 0x00, 0x99, 0x37, 0x24, 0x3d,
-	0xc1, 0x65, 0x8c, 0x5c, 0x80, 0x41, 0x26, 0xd6, 0x76, 0x3f, 0x97, 0x76, 0x05, 0x97, 0xcf, 0x9f,
-	0x9a, 0x85, 0xd8, 0xf6, 0x1b, 0xd4, 0xa8, 0x21, 0xb6, 0xfd, 0x82, 0x4e, 0x48, 0x5b, 0xab, 0x22,
-	0x4e, 0x54, 0x2e, 0xd1, 0x77, 0x2d, 0xd0, 0xd2, 0xaa, 0xb8, 0x2f, 0xeb, 0xe0, 0x8d, 0xf4, 0x4b,
-	0xdf, 0x19, 0x2c, 0x05, 0x48, 0xb4, 0xce, 0x37, 0xe4, 0x8f, 0x00, 0x64, 0x65, 0x22, 0xe5, 0x15,
-	0xc3, 0xf0, 0x50, 0xae, 0xe1, 0xde, 0xb6, 0xb4, 0xea, 0xf0, 0x4e, 0x09, 0x41, 0x85, 0x2c, 0x8b,
-	0x0d, 0xdf, 0x41, 0xbd, 0x4c, 0xdb, 0x2a, 0x33, 0xbe, 0x83, 0xe0, 0x95, 0x74, 0xa7, 0xd2, 0x80,
-	0x46, 0x0a, 0x89, 0xd2, 0xe9, 0xcf, 0xd4, 0x9c, 0xdf, 0xa6, 0xe6, 0x1d, 0x91, 0x96, 0xcc, 0x45,
-	0xac, 0x55, 0x61, 0x6a, 0xb7, 0xbf, 0x32, 0x17, 0x54, 0x15, 0xe6, 0xee, 0xfa, 0xe5, 0x6a, 0xc9,
-	0x71, 0x95, 0xcf, 0xc3, 0x44, 0x89, 0xa8, 0x1a, 0x79, 0xc1, 0x55, 0xfd, 0x8a, 0xb8, 0x44, 0xd0,
-	0x92, 0x65, 0x91, 0x75, 0x89, 0xea, 0xab, 0xd6, 0xf3, 0x79, 0xd3, 0x0a, 0x97, 0x1f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xd6, 0x48, 0x04, 0x17, 0x5d, 0x02, 0x00, 0x00,
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0x51, 0x6b, 0x13, 0x41,
+	0x10, 0xe6, 0x7a, 0xb4, 0x4d, 0x26, 0x89, 0x85, 0xa5, 0xc8, 0xa9, 0x28, 0xe1, 0xea, 0x43, 0x10,
+	0xbc, 0x40, 0x15, 0xc1, 0x27, 0xd1, 0x16, 0x21, 0xa0, 0x2f, 0x5b, 0xf1, 0xc1, 0x97, 0x63, 0x73,
+	0x37, 0x49, 0x17, 0x6f, 0x77, 0xc3, 0xee, 0xdc, 0xa5, 0xf4, 0x87, 0xf8, 0x37, 0xfc, 0x8b, 0xb2,
+	0x7b, 0x5b, 0x4c, 0x24, 0x2f, 0xbe, 0xcd, 0x37, 0xfb, 0x7d, 0x33, 0xdf, 0x7c, 0x0b, 0x13, 0x87,
+	0xeb, 0xca, 0x58, 0x2c, 0x36, 0xd6, 0x90, 0x61, 0xe7, 0x4a, 0x36, 0x5d, 0xeb, 0x7a, 0x54, 0xc4,
+	0xb7, 0xa7, 0x63, 0x57, 0xdd, 0xa2, 0x12, 0x7d, 0x37, 0xff, 0x95, 0xc0, 0x19, 0x47, 0xb2, 0x12,
+	0x3b, 0xe4, 0xe8, 0xda, 0x86, 0x1c, 0x7b, 0x05, 0xa9, 0xac, 0x5d, 0x96, 0x4c, 0x93, 0xd9, 0xe8,
+	0x32, 0x2b, 0xf6, 0xa7, 0xf4, 0xe2, 0xc5, 0xb5, 0xe3, 0x9e, 0xc4, 0x1e, 0xc3, 0x89, 0x59, 0xad,
+	0x1c, 0x52, 0x76, 0x34, 0x4d, 0x67, 0x29, 0x8f, 0x88, 0x7d, 0x80, 0xd1, 0x4a, 0x62, 0x53, 0xbb,
+	0xb2, 0x16, 0x24, 0xb2, 0x74, 0x9a, 0xce, 0x46, 0x97, 0x2f, 0x0e, 0xce, 0xfa, 0xec, 0x79, 0xd7,
+	0x82, 0x04, 0x87, 0x5e, 0xe2, 0xeb, 0xbc, 0x83, 0xc9, 0x17, 0x23, 0xea, 0xf0, 0xf8, 0x15, 0x49,
+	0xb0, 0x0b, 0x98, 0x28, 0xa9, 0x4b, 0x92, 0x0a, 0x1d, 0x09, 0xb5, 0x09, 0xfe, 0x52, 0x3e, 0x56,
+	0x52, 0x7f, 0x7b, 0xe8, 0x05, 0x92, 0xb8, 0xdb, 0x21, 0x1d, 0x45, 0x92, 0xb8, 0xfb, 0x4b, 0x7a,
+	0x06, 0x43, 0x6b, 0xb6, 0x65, 0x65, 0x5a, 0x4d, 0x59, 0x1a, 0x08, 0x03, 0x6b, 0xb6, 0x57, 0x1e,
+	0xe7, 0x3f, 0xe1, 0xcc, 0xef, 0xbd, 0xc1, 0xb5, 0x42, 0x4d, 0x61, 0xf3, 0x7b, 0x38, 0x56, 0x48,
+	0xc2, 0x27, 0xe2, 0xaf, 0xb8, 0x28, 0x0e, 0xe5, 0x5a, 0xec, 0xb9, 0xe5, 0xbd, 0x82, 0x3d, 0x07,
+	0x20, 0x43, 0xa2, 0x29, 0x9d, 0xbc, 0xc7, 0x68, 0x66, 0x18, 0x3a, 0x37, 0xf2, 0x1e, 0xf3, 0x8f,
+	0xf0, 0x28, 0x48, 0xbe, 0x8b, 0x46, 0x86, 0x08, 0xd8, 0x13, 0x18, 0x84, 0x10, 0x4a, 0x59, 0xc7,
+	0x03, 0x4f, 0x03, 0x5e, 0xd4, 0xec, 0x1c, 0x8e, 0x3b, 0xcf, 0x0b, 0x49, 0x0f, 0x78, 0x0f, 0xf2,
+	0xdf, 0x09, 0x8c, 0x17, 0xda, 0xa1, 0x25, 0x8e, 0x95, 0xb1, 0xf5, 0xbf, 0xc9, 0x27, 0xff, 0x9b,
+	0xbc, 0xb7, 0xa0, 0x5b, 0x55, 0x5a, 0xb3, 0x75, 0xd1, 0xf1, 0xa9, 0x6e, 0x15, 0x37, 0x5b, 0xc7,
+	0xae, 0x00, 0xc2, 0xd6, 0xdd, 0x4f, 0x7d, 0x79, 0x38, 0x8e, 0xfd, 0xbb, 0xf8, 0xb0, 0x7b, 0x28,
+	0x3f, 0xbd, 0xfb, 0xf1, 0x76, 0x2d, 0xe9, 0xb6, 0x5d, 0x16, 0x95, 0x51, 0xf3, 0x5e, 0xfc, 0x5a,
+	0x9a, 0x58, 0xcd, 0xa5, 0x26, 0xb4, 0x5a, 0x34, 0xf3, 0x30, 0x6f, 0x1e, 0xe7, 0x6d, 0x96, 0xcb,
+	0x93, 0xd0, 0x78, 0xf3, 0x67, 0x00, 0x30, 0x94, 0x8a, 0x53, 0xe6, 0x02, 0x00, 0x00,
 }
@@ -2,15 +2,50 @@ package proxy
 
 import (
 	"context"
+	"fmt"
+	"os"
 	"strings"
 
+	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util"
 
 	"github.com/milvus-io/milvus/internal/util/crypto"
 )
 
+// bearerPrefix is the prefix of the authorization with an api key or a JWT.
+const bearerPrefix = "Bearer "
+
+// globalJWTVerifier verifies the JWT bearer tokens, the JWT authentication is disabled if it's nil.
+var globalJWTVerifier *crypto.JWTVerifier
+
+// authenticatedUserKey is the context key of the username authenticated by a bearer token.
+type authenticatedUserKey struct{}
+
+// initJWTVerifier loads the JSON Web Key Set configured to verify the JWT bearer tokens.
+func initJWTVerifier() error {
+	jwksFile := Params.CommonCfg.JWTJwksFile.GetValue()
+	if jwksFile == "" {
+		globalJWTVerifier = nil
+		return nil
+	}
+	jwks, err := os.ReadFile(jwksFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the json web key set file %s: %w", jwksFile, err)
+	}
+	verifier, err := crypto.NewJWTVerifier(jwks,
+		Params.CommonCfg.JWTIssuer.GetValue(),
+		Params.CommonCfg.JWTAudience.GetValue(),
+		Params.CommonCfg.JWTUsernameClaim.GetValue())
+	if err != nil {
+		return err
+	}
+	globalJWTVerifier = verifier
+	return nil
+}
+
 // validAuth validates the authentication
 func validAuth(ctx context.Context, authorization []string) bool {
 	if len(authorization) < 1 {
@@ -18,19 +53,59 @@ func validAuth(ctx context.Context, authorization []string) bool {
 		return false
 	}
 	// token format: base64<username:password>
-	//token := strings.TrimPrefix(authorization[0], "Bearer ")
 	token := authorization[0]
 	rawToken, err := crypto.Base64Decode(token)
 	if err != nil {
 		return false
 	}
 	secrets := strings.SplitN(rawToken, util.CredentialSeperator, 2)
+	if len(secrets) < 2 {
+		return false
+	}
 	username := secrets[0]
 	password := secrets[1]
 
 	return passwordVerify(ctx, username, password, globalMetaCache)
 }
 
+// validBearerToken validates the api key or the JWT in the bearer authorization, returns the username of the token.
+// The username maps onto the existing users and roles, the users authenticated by JWT are not required to
+// have a password, only the roles granted to the username take effect.
+func validBearerToken(ctx context.Context, authorization []string) (string, bool) {
+	if len(authorization) < 1 || !strings.HasPrefix(authorization[0], bearerPrefix) {
+		return "", false
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(authorization[0], bearerPrefix))
+
+	if crypto.IsJWT(token) {
+		if globalJWTVerifier == nil {
+			log.Warn("receive a JWT bearer token, but JWT authentication is not configured")
+			return "", false
+		}
+		username, err := globalJWTVerifier.Verify(token)
+		if err != nil {
+			log.Warn("fail to verify the JWT bearer token", zap.Error(err))
+			return "", false
+		}
+		return username, true
+	}
+
+	keyID, secret, ok := crypto.ParseApiKey(token)
+	if !ok {
+		return "", false
+	}
+	info, err := globalMetaCache.GetApiKeyInfo(ctx, keyID)
+	if err != nil {
+		log.Warn("found no api key", zap.String("keyID", keyID), zap.Error(err))
+		return "", false
+	}
+	if !crypto.VerifyApiKeySecret(keyID, secret, info.GetHashedSecret()) {
+		log.Warn("fail to verify the api key", zap.String("keyID", keyID))
+		return "", false
+	}
+	return info.GetUsername(), true
+}
+
 func validSourceID(ctx context.Context, authorization []string) bool {
 	if len(authorization) < 1 {
 		//log.Warn("key not found in header", zap.String("key", util.HeaderSourceID))
@@ -58,10 +133,17 @@ func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
 	}
 	// check:
 	//	1. if rpc call from a member (like index/query/data component)
-	// 	2. if rpc call from sdk
+	// 	2. if rpc call from sdk with an api key or a JWT
+	// 	3. if rpc call from sdk with username and password
 	if Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
-		if !validSourceID(ctx, md[strings.ToLower(util.HeaderSourceID)]) &&
-			!validAuth(ctx, md[strings.ToLower(util.HeaderAuthorize)]) {
+		if validSourceID(ctx, md[strings.ToLower(util.HeaderSourceID)]) {
+			return ctx, nil
+		}
+		authorization := md[strings.ToLower(util.HeaderAuthorize)]
+		if username, ok := validBearerToken(ctx, authorization); ok {
+			return context.WithValue(ctx, authenticatedUserKey{}, username), nil
+		}
+		if !validAuth(ctx, authorization) {
 			return nil, ErrUnauthenticated()
 		}
 	}
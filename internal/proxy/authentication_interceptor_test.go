@@ -2,7 +2,14 @@ package proxy
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc/metadata"
 
@@ -11,6 +18,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // validAuth validates the authentication
@@ -74,3 +82,80 @@ func TestAuthenticationInterceptor(t *testing.T) {
 	_, err = AuthenticationInterceptor(ctx)
 	assert.Nil(t, err)
 }
+
+func TestAuthenticationInterceptor_BearerToken(t *testing.T) {
+	ctx := context.Background()
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true") // mock authorization is turned on
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+	rootCoord := &MockRootCoordClientInterface{}
+	queryCoord := &MockQueryCoordClientInterface{}
+	mgr := newShardClientMgr()
+	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
+	require.NoError(t, err)
+
+	authenticate := func(authorization string) (string, error) {
+		md := metadata.Pairs(util.HeaderAuthorize, authorization)
+		newCtx, err := AuthenticationInterceptor(metadata.NewIncomingContext(ctx, md))
+		if err != nil {
+			return "", err
+		}
+		return GetCurUserFromContext(newCtx)
+	}
+
+	t.Run("api key", func(t *testing.T) {
+		username, err := authenticate("Bearer " + crypto.FormatApiKey("mockKey", "mockSecret"))
+		assert.NoError(t, err)
+		assert.Equal(t, "mockUser", username)
+
+		_, err = authenticate("Bearer " + crypto.FormatApiKey("mockKey", "wrongSecret"))
+		assert.Error(t, err)
+		_, err = authenticate("Bearer " + crypto.FormatApiKey("notExistKey", "mockSecret"))
+		assert.Error(t, err)
+		_, err = authenticate("Bearer invalid")
+		assert.Error(t, err)
+	})
+
+	t.Run("jwt", func(t *testing.T) {
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		jwks, err := json.Marshal(map[string]interface{}{
+			"keys": []map[string]string{{"kty": "OKP", "crv": "Ed25519", "kid": "k1", "x": base64.RawURLEncoding.EncodeToString(pub)}},
+		})
+		require.NoError(t, err)
+		jwksFile := path.Join(t.TempDir(), "jwks.json")
+		require.NoError(t, os.WriteFile(jwksFile, jwks, 0o600))
+
+		header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"EdDSA","kid":"k1"}`))
+		claims, err := json.Marshal(map[string]interface{}{"sub": "jwtUser", "exp": time.Now().Add(time.Hour).Unix()})
+		require.NoError(t, err)
+		signed := header + "." + base64.RawURLEncoding.EncodeToString(claims)
+		token := signed + "." + base64.RawURLEncoding.EncodeToString(ed25519.Sign(key, []byte(signed)))
+
+		// JWT is disabled
+		require.NoError(t, initJWTVerifier())
+		_, err = authenticate("Bearer " + token)
+		assert.Error(t, err)
+
+		paramtable.Get().Save(Params.CommonCfg.JWTJwksFile.Key, jwksFile)
+		defer func() {
+			paramtable.Get().Reset(Params.CommonCfg.JWTJwksFile.Key)
+			globalJWTVerifier = nil
+		}()
+		require.NoError(t, initJWTVerifier())
+		username, err := authenticate("Bearer " + token)
+		assert.NoError(t, err)
+		assert.Equal(t, "jwtUser", username)
+
+		_, err = authenticate("Bearer " + signed + ".invalid")
+		assert.Error(t, err)
+
+		paramtable.Get().Save(Params.CommonCfg.JWTJwksFile.Key, path.Join(t.TempDir(), "not_exist.json"))
+		assert.Error(t, initJWTVerifier())
+	})
+
+	t.Run("not authenticated bearer token", func(t *testing.T) {
+		md := metadata.Pairs(util.HeaderAuthorize, "Bearer "+crypto.FormatApiKey("mockKey", "mockSecret"))
+		_, err := GetCurUserFromContext(metadata.NewIncomingContext(ctx, md))
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,377 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/cdc"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+)
+
+// idleCheckpointInterval is the interval to return an empty batch when there is no change of the collection,
+// so that the checkpoint of the subscriber keeps up with the DML channels shared with the other collections.
+var cdcIdleCheckpointInterval = 10 * time.Second
+
+// cdcCollection is the collection to read the changes of.
+type cdcCollection struct {
+	ID               int64
+	Schema           *schemapb.CollectionSchema
+	PhysicalChannels []string
+	// StartPositions are the positions of the physical channels when the collection was created.
+	StartPositions []*commonpb.KeyDataPair
+}
+
+// cdcCheckpoint is the position a subscription resumes from, it's returned to client as an opaque token.
+type cdcCheckpoint struct {
+	CollectionID int64                     `json:"collection_id"`
+	Positions    []*internalpb.MsgPosition `json:"positions"`
+}
+
+func encodeCdcCheckpoint(cp *cdcCheckpoint) (string, error) {
+	bs, err := json.Marshal(cp)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
+
+func decodeCdcCheckpoint(token string) (*cdcCheckpoint, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("checkpoint [%s] is invalid", token)
+	}
+	cp := &cdcCheckpoint{}
+	if err := json.Unmarshal(bs, cp); err != nil {
+		return nil, fmt.Errorf("checkpoint [%s] is invalid", token)
+	}
+	return cp, nil
+}
+
+// cdcSeekPositions returns the positions of the physical channels to seek, from the checkpoint if it's provided,
+// otherwise from the start positions of the collection, skipping the messages before startTs.
+func cdcSeekPositions(collection *cdcCollection, startTs uint64, token string) ([]*internalpb.MsgPosition, error) {
+	if token != "" {
+		cp, err := decodeCdcCheckpoint(token)
+		if err != nil {
+			return nil, err
+		}
+		if cp.CollectionID != collection.ID {
+			return nil, fmt.Errorf("checkpoint doesn't belong to collection %d", collection.ID)
+		}
+		positions := make(map[string]*internalpb.MsgPosition, len(cp.Positions))
+		for _, position := range cp.Positions {
+			positions[position.GetChannelName()] = position
+		}
+		for _, channel := range collection.PhysicalChannels {
+			if _, ok := positions[channel]; !ok {
+				return nil, fmt.Errorf("checkpoint doesn't contain the position of channel %s", channel)
+			}
+		}
+		return cp.Positions, nil
+	}
+
+	startPositions := make(map[string][]byte, len(collection.StartPositions))
+	for _, position := range collection.StartPositions {
+		startPositions[position.GetKey()] = position.GetData()
+	}
+	positions := make([]*internalpb.MsgPosition, 0, len(collection.PhysicalChannels))
+	for _, channel := range collection.PhysicalChannels {
+		msgID, ok := startPositions[channel]
+		if !ok {
+			return nil, fmt.Errorf("start position of channel %s not found", channel)
+		}
+		positions = append(positions, &internalpb.MsgPosition{
+			ChannelName: channel,
+			MsgID:       msgID,
+			Timestamp:   startTs,
+		})
+	}
+	return positions, nil
+}
+
+// ddlKey identifies a DDL message, the DDL messages are broadcast to all the DML channels of the collection.
+type ddlKey struct {
+	eventType cdc.EventType
+	ts        uint64
+}
+
+// cdcReader reads the change events of a collection from its DML channels.
+type cdcReader struct {
+	collection *cdcCollection
+	schema     *typeutil.SchemaHelper
+	pkField    *schemapb.FieldSchema
+
+	factory msgstream.Factory
+	stream  msgstream.MsgStream
+	subName string
+
+	lastReturned time.Time
+	dropped      bool
+}
+
+// newCdcReader subscribes the DML channels of the collection with subName and seeks to the checkpoint,
+// or to startTs if checkpoint is empty.
+func newCdcReader(ctx context.Context, factory msgstream.Factory, collection *cdcCollection, subName string, startTs uint64, checkpoint string) (*cdcReader, error) {
+	positions, err := cdcSeekPositions(collection, startTs, checkpoint)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := typeutil.CreateSchemaHelper(collection.Schema)
+	if err != nil {
+		return nil, err
+	}
+	pkField, err := schema.GetPrimaryKeyField()
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := factory.NewTtMsgStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stream.AsConsumer(collection.PhysicalChannels, subName, mqwrapper.SubscriptionPositionUnknown)
+	log.Info("cdc reader seek", zap.Int64("collectionID", collection.ID), zap.String("subName", subName),
+		zap.Int("positions", len(positions)), zap.Uint64("startTs", startTs))
+	if err := stream.Seek(positions); err != nil {
+		stream.Close()
+		return nil, err
+	}
+
+	return &cdcReader{
+		collection:   collection,
+		schema:       schema,
+		pkField:      pkField,
+		factory:      factory,
+		stream:       stream,
+		subName:      subName,
+		lastReturned: time.Now(),
+	}, nil
+}
+
+// Next blocks until there are changes of the collection, or no batch has been returned for cdcIdleCheckpointInterval,
+// returns the batch of events with the checkpoint after it. io.EOF is returned after the collection is dropped.
+func (r *cdcReader) Next(ctx context.Context) (*cdc.Batch, error) {
+	if r.dropped {
+		return nil, io.EOF
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case pack, ok := <-r.stream.Chan():
+			if !ok || pack == nil {
+				return nil, errors.New("msg stream of cdc reader closed")
+			}
+			events, err := r.decode(pack)
+			if err != nil {
+				return nil, err
+			}
+			if len(events) == 0 && time.Since(r.lastReturned) < cdcIdleCheckpointInterval {
+				continue
+			}
+			token, err := encodeCdcCheckpoint(&cdcCheckpoint{
+				CollectionID: r.collection.ID,
+				Positions:    pack.EndPositions,
+			})
+			if err != nil {
+				return nil, err
+			}
+			r.lastReturned = time.Now()
+			return &cdc.Batch{Events: events, Checkpoint: token}, nil
+		}
+	}
+}
+
+// decode converts the messages of the collection in the pack into events.
+func (r *cdcReader) decode(pack *msgstream.MsgPack) ([]*cdc.Event, error) {
+	events := make([]*cdc.Event, 0)
+	ddls := make(map[ddlKey]struct{})
+	addDDL := func(event *cdc.Event) {
+		key := ddlKey{eventType: event.Type, ts: event.Timestamp}
+		if _, ok := ddls[key]; !ok {
+			ddls[key] = struct{}{}
+			events = append(events, event)
+		}
+	}
+
+	for _, msg := range pack.Msgs {
+		switch msg := msg.(type) {
+		case *msgstream.InsertMsg:
+			if msg.GetCollectionID() != r.collection.ID {
+				continue
+			}
+			event, err := insertChangeEvent(msg, r.schema)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		case *msgstream.DeleteMsg:
+			if msg.GetCollectionID() != r.collection.ID {
+				continue
+			}
+			events = append(events, deleteChangeEvent(msg, r.pkField))
+		case *msgstream.CreateCollectionMsg:
+			if msg.GetCollectionID() != r.collection.ID {
+				continue
+			}
+			addDDL(&cdc.Event{Type: cdc.EventCreateCollection, Timestamp: msg.EndTs()})
+		case *msgstream.DropCollectionMsg:
+			if msg.GetCollectionID() != r.collection.ID {
+				continue
+			}
+			addDDL(&cdc.Event{Type: cdc.EventDropCollection, Timestamp: msg.EndTs()})
+			r.dropped = true
+		case *msgstream.CreatePartitionMsg:
+			if msg.GetCollectionID() != r.collection.ID {
+				continue
+			}
+			addDDL(&cdc.Event{Type: cdc.EventCreatePartition, Timestamp: msg.EndTs(), PartitionName: msg.GetPartitionName()})
+		case *msgstream.DropPartitionMsg:
+			if msg.GetCollectionID() != r.collection.ID {
+				continue
+			}
+			addDDL(&cdc.Event{Type: cdc.EventDropPartition, Timestamp: msg.EndTs(), PartitionName: msg.GetPartitionName()})
+		}
+	}
+	// the messages of the pack are grouped by channel.
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp < events[j].Timestamp
+	})
+	return events, nil
+}
+
+// Close closes the msg stream and removes the subscription from the DML channels.
+func (r *cdcReader) Close() {
+	r.stream.Close()
+	if err := r.factory.NewMsgStreamDisposer(context.Background())(r.collection.PhysicalChannels, r.subName); err != nil {
+		log.Warn("failed to remove the subscription of cdc reader", zap.Int64("collectionID", r.collection.ID),
+			zap.String("subName", r.subName), zap.Error(err))
+	}
+}
+
+// insertChangeEvent converts the column-based insert message into rows.
+func insertChangeEvent(msg *msgstream.InsertMsg, schema *typeutil.SchemaHelper) (*cdc.Event, error) {
+	if !msg.IsColumnBased() {
+		return nil, fmt.Errorf("row based insert message is not supported, msgID: %d", msg.ID())
+	}
+	numRows := int(msg.NRows())
+	rows := make([]cdc.Row, numRows)
+	for i := range rows {
+		rows[i] = cdc.Row{cdc.TimestampKey: msg.GetTimestamps()[i]}
+	}
+	for _, fieldData := range msg.GetFieldsData() {
+		if fieldData.GetFieldId() < common.StartOfUserFieldID {
+			continue
+		}
+		name := fieldData.GetFieldName()
+		if name == "" {
+			field, err := schema.GetFieldFromID(fieldData.GetFieldId())
+			if err != nil {
+				return nil, err
+			}
+			name = field.GetName()
+		}
+		validData := typeutil.GetValidData(msg.GetValidData(), fieldData.GetFieldId())
+		for i := range rows {
+			if validData != nil && !validData[i] {
+				rows[i][name] = nil
+				continue
+			}
+			value, err := cdcFieldValue(fieldData, i)
+			if err != nil {
+				return nil, err
+			}
+			rows[i][name] = value
+		}
+	}
+	return &cdc.Event{
+		Type:          cdc.EventInsert,
+		Timestamp:     msg.EndTs(),
+		Channel:       msg.GetShardName(),
+		PartitionName: msg.GetPartitionName(),
+		Rows:          rows,
+	}, nil
+}
+
+// deleteChangeEvent converts the deleted primary keys into rows.
+func deleteChangeEvent(msg *msgstream.DeleteMsg, pkField *schemapb.FieldSchema) *cdc.Event {
+	rows := make([]cdc.Row, 0, msg.GetNumRows())
+	for i, ts := range msg.GetTimestamps() {
+		var pk interface{}
+		if msg.GetPrimaryKeys() != nil {
+			pk = typeutil.GetPK(msg.GetPrimaryKeys(), int64(i))
+		} else {
+			pk = msg.GetInt64PrimaryKeys()[i]
+		}
+		rows = append(rows, cdc.Row{pkField.GetName(): pk, cdc.TimestampKey: ts})
+	}
+	return &cdc.Event{
+		Type:          cdc.EventDelete,
+		Timestamp:     msg.EndTs(),
+		Channel:       msg.GetShardName(),
+		PartitionName: msg.GetPartitionName(),
+		Rows:          rows,
+	}
+}
+
+// cdcFieldValue returns the idx-th value of the field data, the vectors are returned as slices
+// and the JSON values are returned as raw JSON.
+func cdcFieldValue(fieldData *schemapb.FieldData, idx int) (interface{}, error) {
+	vectors := fieldData.GetVectors()
+	switch fieldData.GetType() {
+	case schemapb.DataType_FloatVector:
+		dim := int(vectors.GetDim())
+		data := vectors.GetFloatVector().GetData()
+		if (idx+1)*dim > len(data) {
+			return nil, fmt.Errorf("row %d of field %s is out of range", idx, fieldData.GetFieldName())
+		}
+		return data[idx*dim : (idx+1)*dim], nil
+	case schemapb.DataType_BinaryVector:
+		dim := int(vectors.GetDim()) / 8
+		data := vectors.GetBinaryVector()
+		if (idx+1)*dim > len(data) {
+			return nil, fmt.Errorf("row %d of field %s is out of range", idx, fieldData.GetFieldName())
+		}
+		return data[idx*dim : (idx+1)*dim], nil
+	}
+
+	value := typeutil.GetScalarData(fieldData, int64(idx))
+	if value == nil {
+		return nil, fmt.Errorf("unsupported data type %s or row %d of field %s is out of range",
+			fieldData.GetType().String(), idx, fieldData.GetFieldName())
+	}
+	if typeutil.IsJSONType(fieldData.GetType()) {
+		return json.RawMessage(value.([]byte)), nil
+	}
+	return value, nil
+}
@@ -0,0 +1,305 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/cdc"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cdcMockMsgStream struct {
+	msgstream.MsgStream
+	ch       chan *msgstream.MsgPack
+	consumed []string
+	seeked   []*internalpb.MsgPosition
+	closed   bool
+}
+
+func (ms *cdcMockMsgStream) AsConsumer(channels []string, subName string, position mqwrapper.SubscriptionInitialPosition) {
+	ms.consumed = channels
+}
+
+func (ms *cdcMockMsgStream) Seek(positions []*internalpb.MsgPosition) error {
+	ms.seeked = positions
+	return nil
+}
+
+func (ms *cdcMockMsgStream) Chan() <-chan *msgstream.MsgPack {
+	return ms.ch
+}
+
+func (ms *cdcMockMsgStream) Close() {
+	ms.closed = true
+}
+
+type cdcMockMsgStreamFactory struct {
+	msgstream.Factory
+	stream   *cdcMockMsgStream
+	disposed string
+}
+
+func (f *cdcMockMsgStreamFactory) NewTtMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
+	return f.stream, nil
+}
+
+func (f *cdcMockMsgStreamFactory) NewMsgStreamDisposer(ctx context.Context) func([]string, string) error {
+	return func(channels []string, subName string) error {
+		f.disposed = subName
+		return nil
+	}
+}
+
+func newCdcTestCollection() *cdcCollection {
+	return &cdcCollection{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Name: "coll",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+				{FieldID: 102, Name: "str", DataType: schemapb.DataType_VarChar},
+			},
+		},
+		PhysicalChannels: []string{"dml_0", "dml_1"},
+		StartPositions: []*commonpb.KeyDataPair{
+			{Key: "dml_0", Data: []byte{1}},
+			{Key: "dml_1", Data: []byte{2}},
+		},
+	}
+}
+
+func newCdcInsertMsg(collectionID int64, ts uint64) *msgstream.InsertMsg {
+	return &msgstream.InsertMsg{
+		BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+		InsertRequest: internalpb.InsertRequest{
+			Base:          &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+			ShardName:     "dml_0_1v0",
+			CollectionID:  collectionID,
+			PartitionName: "_default",
+			Timestamps:    []uint64{ts, ts},
+			RowIDs:        []int64{1, 2},
+			NumRows:       2,
+			Version:       internalpb.InsertDataVersion_ColumnBased,
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type: schemapb.DataType_Int64, FieldName: "pk", FieldId: 100,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+					}},
+				},
+				{
+					Type: schemapb.DataType_FloatVector, FieldName: "vec", FieldId: 101,
+					Field: &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+						Dim:  2,
+						Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: []float32{1, 2, 3, 4}}},
+					}},
+				},
+				{
+					Type: schemapb.DataType_VarChar, FieldId: 102,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", ""}}},
+					}},
+				},
+			},
+			ValidData: []*internalpb.FieldValidData{{FieldId: 102, Valid: []bool{true, false}}},
+		},
+	}
+}
+
+func newCdcDeleteMsg(collectionID int64, ts uint64) *msgstream.DeleteMsg {
+	return &msgstream.DeleteMsg{
+		BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+		DeleteRequest: internalpb.DeleteRequest{
+			Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete},
+			ShardName:    "dml_1_1v1",
+			CollectionID: collectionID,
+			Timestamps:   []uint64{ts},
+			NumRows:      1,
+			PrimaryKeys:  &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
+		},
+	}
+}
+
+func TestCdcSeekPositions(t *testing.T) {
+	collection := newCdcTestCollection()
+
+	t.Run("start ts", func(t *testing.T) {
+		positions, err := cdcSeekPositions(collection, 100, "")
+		assert.NoError(t, err)
+		require.Equal(t, 2, len(positions))
+		assert.Equal(t, "dml_1", positions[1].GetChannelName())
+		assert.Equal(t, []byte{2}, positions[1].GetMsgID())
+		assert.Equal(t, uint64(100), positions[1].GetTimestamp())
+	})
+
+	t.Run("start position not found", func(t *testing.T) {
+		_, err := cdcSeekPositions(&cdcCollection{ID: 1, PhysicalChannels: []string{"dml_2"}}, 100, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("checkpoint", func(t *testing.T) {
+		token, err := encodeCdcCheckpoint(&cdcCheckpoint{
+			CollectionID: 1,
+			Positions: []*internalpb.MsgPosition{
+				{ChannelName: "dml_0", MsgID: []byte{3}, Timestamp: 200},
+				{ChannelName: "dml_1", MsgID: []byte{4}, Timestamp: 200},
+			},
+		})
+		require.NoError(t, err)
+		positions, err := cdcSeekPositions(collection, 100, token)
+		assert.NoError(t, err)
+		require.Equal(t, 2, len(positions))
+		assert.Equal(t, []byte{3}, positions[0].GetMsgID())
+		assert.Equal(t, uint64(200), positions[0].GetTimestamp())
+	})
+
+	t.Run("invalid checkpoint", func(t *testing.T) {
+		_, err := cdcSeekPositions(collection, 0, "invalid token")
+		assert.Error(t, err)
+
+		token, err := encodeCdcCheckpoint(&cdcCheckpoint{CollectionID: 2})
+		require.NoError(t, err)
+		_, err = cdcSeekPositions(collection, 0, token)
+		assert.Error(t, err)
+
+		token, err = encodeCdcCheckpoint(&cdcCheckpoint{
+			CollectionID: 1,
+			Positions:    []*internalpb.MsgPosition{{ChannelName: "dml_0", MsgID: []byte{3}}},
+		})
+		require.NoError(t, err)
+		_, err = cdcSeekPositions(collection, 0, token)
+		assert.Error(t, err)
+	})
+}
+
+func TestCdcReader(t *testing.T) {
+	ctx := context.Background()
+	stream := &cdcMockMsgStream{ch: make(chan *msgstream.MsgPack, 10)}
+	factory := &cdcMockMsgStreamFactory{stream: stream}
+	reader, err := newCdcReader(ctx, factory, newCdcTestCollection(), "sub", 100, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dml_0", "dml_1"}, stream.consumed)
+	assert.Equal(t, 2, len(stream.seeked))
+
+	endPositions := []*internalpb.MsgPosition{
+		{ChannelName: "dml_0", MsgID: []byte{5}, Timestamp: 300},
+		{ChannelName: "dml_1", MsgID: []byte{6}, Timestamp: 300},
+	}
+	createPartition := func(ts uint64) *msgstream.CreatePartitionMsg {
+		return &msgstream.CreatePartitionMsg{
+			BaseMsg: msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts},
+			CreatePartitionRequest: internalpb.CreatePartitionRequest{
+				Base:          &commonpb.MsgBase{MsgType: commonpb.MsgType_CreatePartition},
+				CollectionID:  1,
+				PartitionName: "p1",
+			},
+		}
+	}
+	// the messages of the other collections are skipped, the broadcast DDLs are deduplicated.
+	stream.ch <- &msgstream.MsgPack{
+		Msgs: []msgstream.TsMsg{
+			newCdcDeleteMsg(1, 250),
+			createPartition(220),
+			newCdcInsertMsg(2, 210),
+			newCdcInsertMsg(1, 200),
+			createPartition(220),
+		},
+		EndPositions: endPositions,
+	}
+	// the empty packs are skipped.
+	stream.ch <- &msgstream.MsgPack{Msgs: []msgstream.TsMsg{newCdcInsertMsg(2, 310)}}
+	stream.ch <- &msgstream.MsgPack{
+		Msgs: []msgstream.TsMsg{
+			&msgstream.DropCollectionMsg{
+				BaseMsg: msgstream.BaseMsg{BeginTimestamp: 400, EndTimestamp: 400},
+				DropCollectionRequest: internalpb.DropCollectionRequest{
+					Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection},
+					CollectionID: 1,
+				},
+			},
+		},
+	}
+
+	batch, err := reader.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(batch.Events))
+
+	insert := batch.Events[0]
+	assert.Equal(t, cdc.EventInsert, insert.Type)
+	assert.Equal(t, uint64(200), insert.Timestamp)
+	assert.Equal(t, "dml_0_1v0", insert.Channel)
+	assert.Equal(t, "_default", insert.PartitionName)
+	require.Equal(t, 2, len(insert.Rows))
+	assert.Equal(t, int64(2), insert.Rows[1]["pk"])
+	assert.Equal(t, []float32{3, 4}, insert.Rows[1]["vec"])
+	assert.Equal(t, "a", insert.Rows[0]["str"])
+	assert.Nil(t, insert.Rows[1]["str"])
+	assert.Equal(t, uint64(200), insert.Rows[1][cdc.TimestampKey])
+
+	assert.Equal(t, cdc.EventCreatePartition, batch.Events[1].Type)
+	assert.Equal(t, "p1", batch.Events[1].PartitionName)
+
+	del := batch.Events[2]
+	assert.Equal(t, cdc.EventDelete, del.Type)
+	require.Equal(t, 1, len(del.Rows))
+	assert.Equal(t, int64(1), del.Rows[0]["pk"])
+
+	positions, err := cdcSeekPositions(newCdcTestCollection(), 0, batch.Checkpoint)
+	assert.NoError(t, err)
+	assert.Equal(t, endPositions[1].GetMsgID(), positions[1].GetMsgID())
+
+	_, err = json.Marshal(batch)
+	assert.NoError(t, err)
+
+	batch, err = reader.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(batch.Events))
+	assert.Equal(t, cdc.EventDropCollection, batch.Events[0].Type)
+
+	_, err = reader.Next(ctx)
+	assert.ErrorIs(t, err, io.EOF)
+
+	reader.Close()
+	assert.True(t, stream.closed)
+	assert.Equal(t, "sub", factory.disposed)
+}
+
+func TestCdcReader_Canceled(t *testing.T) {
+	stream := &cdcMockMsgStream{ch: make(chan *msgstream.MsgPack)}
+	reader, err := newCdcReader(context.Background(), &cdcMockMsgStreamFactory{stream: stream}, newCdcTestCollection(), "sub", 0, "")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = reader.Next(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	close(stream.ch)
+	_, err = reader.Next(context.Background())
+	assert.Error(t, err)
+}
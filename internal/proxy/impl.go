@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"sync"
@@ -29,6 +30,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/cdc"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
@@ -37,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/crypto"
@@ -121,7 +124,7 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 	var aliasName []string
 	if globalMetaCache != nil {
 		if collectionName != "" {
-			globalMetaCache.RemoveCollection(ctx, collectionName) // no need to return error, though collection may be not cached
+			globalMetaCache.RemoveCollection(ctx, request.GetDbName(), collectionName) // no need to return error, though collection may be not cached
 		}
 		if request.CollectionID != UniqueID(0) {
 			aliasName = globalMetaCache.RemoveCollectionsByID(ctx, collectionID)
@@ -144,6 +147,507 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 	}, nil
 }
 
+// CreateDatabase creates a database with the given name.
+func (node *Proxy) CreateDatabase(ctx context.Context, request *rootcoordpb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateDatabase")
+	defer sp.Finish()
+
+	method := "CreateDatabase"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	cdt := &createDatabaseTask{
+		ctx:                   ctx,
+		Condition:             NewTaskCondition(ctx),
+		CreateDatabaseRequest: request,
+		rootCoord:             node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName))
+
+	log.Debug(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(cdt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", cdt.BeginTs()),
+		zap.Uint64("EndTs", cdt.EndTs()))
+
+	if err := cdt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", cdt.BeginTs()),
+			zap.Uint64("EndTs", cdt.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", cdt.BeginTs()),
+		zap.Uint64("EndTs", cdt.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return cdt.result, nil
+}
+
+// DropDatabase drops the database with the given name, the database must be empty.
+func (node *Proxy) DropDatabase(ctx context.Context, request *rootcoordpb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropDatabase")
+	defer sp.Finish()
+
+	method := "DropDatabase"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	ddt := &dropDatabaseTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: request,
+		rootCoord:           node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName))
+
+	log.Debug(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(ddt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", ddt.BeginTs()),
+		zap.Uint64("EndTs", ddt.EndTs()))
+
+	if err := ddt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", ddt.BeginTs()),
+			zap.Uint64("EndTs", ddt.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", ddt.BeginTs()),
+		zap.Uint64("EndTs", ddt.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return ddt.result, nil
+}
+
+// ListDatabases lists all databases.
+func (node *Proxy) ListDatabases(ctx context.Context, request *rootcoordpb.ListDatabasesRequest) (*rootcoordpb.ListDatabasesResponse, error) {
+	if !node.checkHealthy() {
+		return &rootcoordpb.ListDatabasesResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ListDatabases")
+	defer sp.Finish()
+
+	method := "ListDatabases"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	ldt := &listDatabasesTask{
+		ctx:                  ctx,
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: request,
+		rootCoord:            node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole))
+
+	log.Debug(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(ldt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return &rootcoordpb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", ldt.BeginTs()),
+		zap.Uint64("EndTs", ldt.EndTs()))
+
+	if err := ldt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", ldt.BeginTs()),
+			zap.Uint64("EndTs", ldt.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return &rootcoordpb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Int("len(databases)", len(ldt.result.GetDbNames())),
+		zap.Uint64("BeginTs", ldt.BeginTs()),
+		zap.Uint64("EndTs", ldt.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return ldt.result, nil
+}
+
+// RenameCollection renames the collection, the collection ID, aliases and privileges are kept.
+func (node *Proxy) RenameCollection(ctx context.Context, request *rootcoordpb.RenameCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-RenameCollection")
+	defer sp.Finish()
+
+	method := "RenameCollection"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	rct := &renameCollectionTask{
+		ctx:                     ctx,
+		Condition:               NewTaskCondition(ctx),
+		RenameCollectionRequest: request,
+		rootCoord:               node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("oldName", request.OldName),
+		zap.String("newName", request.NewName))
+
+	log.Debug(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(rct); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", rct.BeginTs()),
+		zap.Uint64("EndTs", rct.EndTs()))
+
+	if err := rct.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", rct.BeginTs()),
+			zap.Uint64("EndTs", rct.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", rct.BeginTs()),
+		zap.Uint64("EndTs", rct.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return rct.result, nil
+}
+
+// TruncateCollection drops all the data of the collection, the schema, indexes and partitions are kept.
+func (node *Proxy) TruncateCollection(ctx context.Context, request *rootcoordpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-TruncateCollection")
+	defer sp.Finish()
+
+	method := "TruncateCollection"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	tct := &truncateCollectionTask{
+		ctx:                       ctx,
+		Condition:                 NewTaskCondition(ctx),
+		TruncateCollectionRequest: request,
+		rootCoord:                 node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+
+	log.Debug(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(tct); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", tct.BeginTs()),
+		zap.Uint64("EndTs", tct.EndTs()))
+
+	if err := tct.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", tct.BeginTs()),
+			zap.Uint64("EndTs", tct.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", tct.BeginTs()),
+		zap.Uint64("EndTs", tct.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return tct.result, nil
+}
+
+// CloneCollection creates a new collection sharing the flushed segments of the source collection, without copying the binlogs.
+func (node *Proxy) CloneCollection(ctx context.Context, request *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CloneCollection")
+	defer sp.Finish()
+
+	method := "CloneCollection"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	cct := &cloneCollectionTask{
+		ctx:                    ctx,
+		Condition:              NewTaskCondition(ctx),
+		CloneCollectionRequest: request,
+		rootCoord:              node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("source", request.SourceCollectionName),
+		zap.String("target", request.TargetCollectionName))
+
+	log.Debug(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(cct); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", cct.BeginTs()),
+		zap.Uint64("EndTs", cct.EndTs()))
+
+	if err := cct.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", cct.BeginTs()),
+			zap.Uint64("EndTs", cct.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", cct.BeginTs()),
+		zap.Uint64("EndTs", cct.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return cct.result, nil
+}
+
+// SubscribeChanges streams the inserts, deletes and DDLs of a collection from its DML channels,
+// the batches are sent with the checkpoints to resume the subscription from.
+func (node *Proxy) SubscribeChanges(ctx context.Context, request *cdc.SubscribeRequest, send func(*cdc.Batch) error) error {
+	if !node.checkHealthy() {
+		return errProxyIsUnhealthy(paramtable.GetNodeID())
+	}
+
+	method := "SubscribeChanges"
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Uint64("startTs", request.StartTs))
+
+	log.Debug(rpcReceived(method))
+
+	// the caller is authorized before any data is read from the DML channels.
+	ctx, err := SubscriptionInterceptor(ctx, request.DbName, request.CollectionName)
+	if err != nil {
+		log.Warn("failed to authorize the subscription", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return err
+	}
+
+	err = node.subscribeChanges(ctx, request, send)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return err
+	}
+
+	log.Debug(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	return nil
+}
+
+func (node *Proxy) subscribeChanges(ctx context.Context, request *cdc.SubscribeRequest, send func(*cdc.Batch) error) error {
+	if err := validateCollectionName(request.CollectionName); err != nil {
+		return err
+	}
+	resp, err := node.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		DbName:         request.DbName,
+		CollectionName: request.CollectionName,
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(resp.GetStatus().GetReason())
+	}
+
+	// every subscription has its own subscription name, so that it could seek independently.
+	ts, err := node.tsoAllocator.AllocOne()
+	if err != nil {
+		return err
+	}
+	subName := fmt.Sprintf("%s-%d-cdc-%d-%d", Params.CommonCfg.ProxySubName.GetValue(), paramtable.GetNodeID(), resp.GetCollectionID(), ts)
+	reader, err := newCdcReader(ctx, node.factory, &cdcCollection{
+		ID:               resp.GetCollectionID(),
+		Schema:           resp.GetSchema(),
+		PhysicalChannels: resp.GetPhysicalChannelNames(),
+		StartPositions:   resp.GetStartPositions(),
+	}, subName, request.StartTs, request.Checkpoint)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for {
+		batch, err := reader.Next(ctx)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := send(batch); err != nil {
+			return err
+		}
+	}
+}
+
 // CreateCollection create a collection by the schema.
 // TODO(dragondriver): add more detailed ut for ConsistencyLevel, should we support multiple consistency level in Proxy?
 func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
@@ -1450,7 +1954,7 @@ func (node *Proxy) getPartitionProgress(ctx context.Context, request *milvuspb.G
 	IDs2Names := make(map[int64]string)
 	partitionIDs := make([]int64, 0)
 	for _, partitionName := range request.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, request.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, "", request.CollectionName, partitionName)
 		if err != nil {
 			return 0, err
 		}
@@ -1510,7 +2014,7 @@ func (node *Proxy) GetLoadingProgress(ctx context.Context, request *milvuspb.Get
 	if err := validateCollectionName(request.CollectionName); err != nil {
 		return getErrResponse(err), nil
 	}
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, request.CollectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", request.CollectionName)
 	if err != nil {
 		return getErrResponse(err), nil
 	}
@@ -1997,6 +2501,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 					commonpbutil.WithMsgID(0),
 					commonpbutil.WithSourceID(paramtable.GetNodeID()),
 				),
+				DbName:         request.DbName,
 				CollectionName: request.CollectionName,
 				PartitionName:  request.PartitionName,
 				FieldsData:     request.FieldsData,
@@ -2011,10 +2516,6 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		chTicker:      node.chTicker,
 	}
 
-	if len(it.insertMsg.PartitionName) <= 0 {
-		it.insertMsg.PartitionName = Params.CommonCfg.DefaultPartitionName.GetValue()
-	}
-
 	constructFailedResponse := func(err error) *milvuspb.MutationResult {
 		numRows := request.NumRows
 		errIndex := make([]uint32, numRows)
@@ -2183,6 +2684,130 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	return dt.result, nil
 }
 
+// Upsert replaces the entities which have the same primary keys with the given rows,
+// entities that do not exist yet are inserted.
+func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Upsert")
+	defer sp.Finish()
+	log := log.Ctx(ctx)
+	log.Debug("Start processing upsert request in Proxy")
+	defer log.Debug("Finish processing upsert request in Proxy")
+
+	if !node.checkHealthy() {
+		return &milvuspb.MutationResult{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+	method := "Upsert"
+	tr := timerecord.NewTimeRecorder(method)
+	receiveSize := proto.Size(request)
+	rateCol.Add(internalpb.RateType_DMLInsert.String(), float64(receiveSize))
+	metrics.ProxyReceiveBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel).Add(float64(receiveSize))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+	ut := &upsertTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		insertMsg: &BaseInsertTask{
+			BaseMsg: msgstream.BaseMsg{
+				HashValues: request.HashKeys,
+			},
+			InsertRequest: internalpb.InsertRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_Insert),
+					commonpbutil.WithMsgID(0),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+				),
+				DbName:         request.DbName,
+				CollectionName: request.CollectionName,
+				PartitionName:  request.PartitionName,
+				FieldsData:     request.FieldsData,
+				NumRows:        uint64(request.NumRows),
+				Version:        internalpb.InsertDataVersion_ColumnBased,
+			},
+		},
+		deleteMsg: &BaseDeleteTask{
+			DeleteRequest: internalpb.DeleteRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_Delete),
+					commonpbutil.WithMsgID(0),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+				),
+				DbName:         request.DbName,
+				CollectionName: request.CollectionName,
+				PartitionName:  request.PartitionName,
+			},
+		},
+		idAllocator:   node.rowIDAllocator,
+		segIDAssigner: node.segAssigner,
+		chMgr:         node.chMgr,
+		chTicker:      node.chTicker,
+	}
+
+	constructFailedResponse := func(err error) *milvuspb.MutationResult {
+		numRows := request.NumRows
+		errIndex := make([]uint32, numRows)
+		for i := uint32(0); i < numRows; i++ {
+			errIndex[i] = i
+		}
+
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+			ErrIndex: errIndex,
+		}
+	}
+
+	log.Debug("Enqueue upsert request in Proxy",
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Int("len(FieldsData)", len(request.FieldsData)),
+		zap.Int("len(HashKeys)", len(request.HashKeys)),
+		zap.Uint32("NumRows", request.NumRows))
+
+	if err := node.sched.dmQueue.Enqueue(ut); err != nil {
+		log.Warn("Failed to enqueue upsert task: " + err.Error())
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.AbandonLabel).Inc()
+		return constructFailedResponse(err), nil
+	}
+
+	log.Debug("Detail of upsert request in Proxy",
+		zap.String("role", typeutil.ProxyRole),
+		zap.Uint64("BeginTS", ut.BeginTs()),
+		zap.Uint64("EndTS", ut.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Uint32("NumRows", request.NumRows))
+
+	if err := ut.WaitToFinish(); err != nil {
+		log.Warn("Failed to execute upsert task in task scheduler: " + err.Error())
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+		return constructFailedResponse(err), nil
+	}
+
+	if ut.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		numRows := request.NumRows
+		errIndex := make([]uint32, numRows)
+		for i := uint32(0); i < numRows; i++ {
+			errIndex[i] = i
+		}
+		ut.result.ErrIndex = errIndex
+	}
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.SuccessLabel).Inc()
+	metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.ProxyCollectionMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel, request.CollectionName).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return ut.result, nil
+}
+
 // Search search the most similar records of requests.
 func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	receiveSize := proto.Size(request)
@@ -2203,6 +2828,10 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Search")
 	defer sp.Finish()
 
+	if isHybridSearch(request.GetSearchParams()) {
+		return node.hybridSearch(ctx, request)
+	}
+
 	qt := &searchTask{
 		ctx:       ctx,
 		Condition: NewTaskCondition(ctx),
@@ -2274,10 +2903,100 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		}, nil
 	}
 
-	span := tr.CtxRecord(ctx, "wait search result")
-	metrics.ProxyWaitForSearchResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
-		metrics.SearchLabel).Observe(float64(span.Milliseconds()))
-	tr.CtxRecord(ctx, "wait search result")
+	span := tr.CtxRecord(ctx, "wait search result")
+	metrics.ProxyWaitForSearchResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+		metrics.SearchLabel).Observe(float64(span.Milliseconds()))
+	tr.CtxRecord(ctx, "wait search result")
+	log.Debug(rpcDone(method))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.SuccessLabel).Inc()
+	metrics.ProxySearchVectors.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(qt.result.GetResults().GetNumQueries()))
+	searchDur := tr.ElapseSpan().Milliseconds()
+	metrics.ProxySQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+		metrics.SearchLabel).Observe(float64(searchDur))
+	metrics.ProxyCollectionSQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+		metrics.SearchLabel, request.CollectionName).Observe(float64(searchDur))
+	if qt.result != nil {
+		sentSize := proto.Size(qt.result)
+		metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
+		rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
+	}
+	setIteratorToken(ctx, qt.iteratorToken)
+	return qt.result, nil
+}
+
+// hybridSearch searches several vector fields and fuses the results into one ranked list.
+func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	method := "HybridSearch"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+
+	qt := &hybridSearchTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_Search),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		request:  request,
+		qc:       node.queryCoord,
+		tr:       timerecord.NewTimeRecorder("hybrid search"),
+		shardMgr: node.shardMgr,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("partitions", request.PartitionNames),
+		zap.Any("dsl", request.Dsl),
+		zap.Any("len(PlaceholderGroup)", len(request.PlaceholderGroup)),
+		zap.Any("OutputFields", request.OutputFields),
+		zap.Any("search_params", request.SearchParams),
+		zap.Uint64("travel_timestamp", request.TravelTimestamp),
+		zap.Uint64("guarantee_timestamp", request.GuaranteeTimestamp))
+
+	log.Debug(
+		rpcReceived(method))
+
+	if err := node.sched.dqQueue.Enqueue(qt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.AbandonLabel).Inc()
+
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("timestamp", qt.Base.Timestamp))
+
+	if err := qt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+			metrics.FailLabel).Inc()
+
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
 	log.Debug(rpcDone(method))
 
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
@@ -2293,6 +3012,7 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
 		rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
 	}
+	setHybridScores(ctx, qt.annsFields, qt.subScores)
 	return qt.result, nil
 }
 
@@ -2476,6 +3196,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 	sentSize := proto.Size(qt.result)
 	rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
 	metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
+	setIteratorToken(ctx, qt.iteratorToken)
 	return ret, nil
 }
 
@@ -2810,7 +3531,7 @@ func (node *Proxy) GetPersistentSegmentInfo(ctx context.Context, req *milvuspb.G
 		metrics.TotalLabel).Inc()
 
 	// list segments
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.GetCollectionName())
 	if err != nil {
 		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 		resp.Status.Reason = fmt.Errorf("getCollectionID failed, err:%w", err).Error()
@@ -2900,7 +3621,7 @@ func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQue
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 		metrics.TotalLabel).Inc()
 
-	collID, err := globalMetaCache.GetCollectionID(ctx, req.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, req.GetDbName(), req.CollectionName)
 	if err != nil {
 		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
 		resp.Status.Reason = err.Error()
@@ -3214,7 +3935,7 @@ func (node *Proxy) LoadBalance(ctx context.Context, req *milvuspb.LoadBalanceReq
 		ErrorCode: commonpb.ErrorCode_UnexpectedError,
 	}
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, "", req.GetCollectionName())
 	if err != nil {
 		log.Warn("failed to get collection id",
 			zap.String("collection name", req.GetCollectionName()),
@@ -3526,6 +4247,265 @@ func (node *Proxy) ListImportTasks(ctx context.Context, req *milvuspb.ListImport
 	return resp, err
 }
 
+// Export writes the rows of a collection into JSON, NumPy or Parquet files on MinIO/S3 storage, the export task
+// is executed asynchronously by RootCoord, its state could be checked by GetExportState.
+func (node *Proxy) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Export")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.Strings("partitions", req.GetPartitionNames()),
+		zap.String("format", req.GetFormat().String()),
+		zap.String("targetPath", req.GetTargetPath()))
+
+	log.Info("received export request")
+	if !node.checkHealthy() {
+		return &rootcoordpb.ExportResponse{Status: unhealthyStatus()}, nil
+	}
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		return &rootcoordpb.ExportResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	if req.GetTargetPath() == "" {
+		return &rootcoordpb.ExportResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    "the target path of export is empty",
+			},
+		}, nil
+	}
+
+	method := "Export"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.rootCoord.Export(ctx, req)
+	if err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		log.Error("failed to execute export request", zap.Error(err))
+		return &rootcoordpb.ExportResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return resp, nil
+}
+
+// GetExportState checks export task state from RootCoord.
+func (node *Proxy) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetExportState")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(zap.Int64("taskID", req.GetTaskId()))
+
+	log.Debug("received get export state request")
+	if !node.checkHealthy() {
+		return &rootcoordpb.GetExportStateResponse{Status: unhealthyStatus()}, nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.rootCoord.GetExportState(ctx, req)
+	if err != nil {
+		log.Error("failed to execute get export state", zap.Error(err))
+		return &rootcoordpb.GetExportStateResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// ListExportTasks lists the export tasks from RootCoord.
+func (node *Proxy) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ListExportTasks")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(zap.String("collection", req.GetCollectionName()))
+
+	log.Debug("received list export tasks request")
+	if !node.checkHealthy() {
+		return &rootcoordpb.ListExportTasksResponse{Status: unhealthyStatus()}, nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.rootCoord.ListExportTasks(ctx, req)
+	if err != nil {
+		log.Error("failed to execute list export tasks", zap.Error(err))
+		return &rootcoordpb.ListExportTasksResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// CreateBackup backs up a collection by RootCoord, it returns after the files of the backup are copied.
+func (node *Proxy) CreateBackup(ctx context.Context, req *rootcoordpb.CreateBackupRequest) (*rootcoordpb.CreateBackupResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateBackup")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("backup", req.GetName()),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()),
+		zap.Uint64("timestamp", req.GetTimestamp()))
+
+	log.Info("received create backup request")
+	if !node.checkHealthy() {
+		return &rootcoordpb.CreateBackupResponse{Status: unhealthyStatus()}, nil
+	}
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		return &rootcoordpb.CreateBackupResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.rootCoord.CreateBackup(ctx, req)
+	if err != nil {
+		log.Error("failed to execute create backup", zap.Error(err))
+		return &rootcoordpb.CreateBackupResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// ListBackups lists the backups from RootCoord.
+func (node *Proxy) ListBackups(ctx context.Context, req *rootcoordpb.ListBackupsRequest) (*rootcoordpb.ListBackupsResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ListBackups")
+	defer sp.Finish()
+
+	log.Ctx(ctx).Debug("received list backups request")
+	if !node.checkHealthy() {
+		return &rootcoordpb.ListBackupsResponse{Status: unhealthyStatus()}, nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.rootCoord.ListBackups(ctx, req)
+	if err != nil {
+		log.Ctx(ctx).Error("failed to execute list backups", zap.Error(err))
+		return &rootcoordpb.ListBackupsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// VerifyBackup checks the files of a backup by RootCoord.
+func (node *Proxy) VerifyBackup(ctx context.Context, req *rootcoordpb.VerifyBackupRequest) (*commonpb.Status, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-VerifyBackup")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(zap.String("backup", req.GetName()))
+
+	log.Debug("received verify backup request")
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	status, err := node.rootCoord.VerifyBackup(ctx, req)
+	if err != nil {
+		log.Error("failed to execute verify backup", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return status, nil
+}
+
+// DeleteBackup removes a backup by RootCoord.
+func (node *Proxy) DeleteBackup(ctx context.Context, req *rootcoordpb.DeleteBackupRequest) (*commonpb.Status, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DeleteBackup")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(zap.String("backup", req.GetName()))
+
+	log.Info("received delete backup request")
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	status, err := node.rootCoord.DeleteBackup(ctx, req)
+	if err != nil {
+		log.Error("failed to execute delete backup", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return status, nil
+}
+
+// RestoreBackup restores a backup into a new collection by RootCoord, the rows are imported asynchronously,
+// the states of the returned import tasks could be checked by GetImportState.
+func (node *Proxy) RestoreBackup(ctx context.Context, req *rootcoordpb.RestoreBackupRequest) (*rootcoordpb.RestoreBackupResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-RestoreBackup")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("backup", req.GetBackupName()),
+		zap.String("collection", req.GetCollectionName()))
+
+	log.Info("received restore backup request")
+	if !node.checkHealthy() {
+		return &rootcoordpb.RestoreBackupResponse{Status: unhealthyStatus()}, nil
+	}
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		return &rootcoordpb.RestoreBackupResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	req.Base = commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID()))
+	resp, err := node.rootCoord.RestoreBackup(ctx, req)
+	if err != nil {
+		log.Error("failed to execute restore backup", zap.Error(err))
+		return &rootcoordpb.RestoreBackupResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
 // InvalidateCredentialCache invalidate the credential cache of specified username.
 func (node *Proxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
 	ctx = logutil.WithModule(ctx, moduleName)
@@ -3786,6 +4766,146 @@ func (node *Proxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUser
 	}, nil
 }
 
+// CreateApiKey creates an api key for the user, the plaintext key is only returned in the response.
+// The keys of a user are only managed by the user itself or an admin.
+func (node *Proxy) CreateApiKey(ctx context.Context, req *rootcoordpb.CreateApiKeyRequest) (*rootcoordpb.CreateApiKeyResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateApiKey")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("username", req.GetUsername()))
+
+	log.Debug("CreateApiKey")
+	if !node.checkHealthy() {
+		return &rootcoordpb.CreateApiKeyResponse{Status: unhealthyStatus()}, nil
+	}
+	if err := ValidateUsername(req.GetUsername()); err != nil {
+		return &rootcoordpb.CreateApiKeyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	operator, err := getApiKeyOperator(ctx)
+	if err == nil {
+		err = checkApiKeyOwner(operator, req.GetUsername())
+	}
+	if err != nil {
+		log.Warn("create api key is denied", zap.Error(err))
+		return &rootcoordpb.CreateApiKeyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_PermissionDenied,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_CreateCredential),
+	)
+	resp, err := node.rootCoord.CreateApiKey(ctx, req)
+	if err != nil {
+		log.Error("create api key fail", zap.Error(err))
+		return &rootcoordpb.CreateApiKeyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// ListApiKeys lists the api keys of the user, or all api keys if the username is empty, which is only allowed to an admin.
+func (node *Proxy) ListApiKeys(ctx context.Context, req *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ListApiKeys")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("username", req.GetUsername()))
+
+	log.Debug("ListApiKeys")
+	if !node.checkHealthy() {
+		return &rootcoordpb.ListApiKeysResponse{Status: unhealthyStatus()}, nil
+	}
+	operator, err := getApiKeyOperator(ctx)
+	if err == nil {
+		err = checkApiKeyOwner(operator, req.GetUsername())
+	}
+	if err != nil {
+		log.Warn("list api keys is denied", zap.Error(err))
+		return &rootcoordpb.ListApiKeysResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_PermissionDenied,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_ListCredUsernames),
+	)
+	resp, err := node.rootCoord.ListApiKeys(ctx, req)
+	if err != nil {
+		log.Error("list api keys fail", zap.Error(err))
+		return &rootcoordpb.ListApiKeysResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+	return resp, nil
+}
+
+// RevokeApiKey revokes the api key, the key is not authenticated any more once revoked.
+func (node *Proxy) RevokeApiKey(ctx context.Context, req *rootcoordpb.RevokeApiKeyRequest) (*commonpb.Status, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-RevokeApiKey")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("keyID", req.GetKeyId()))
+
+	log.Debug("RevokeApiKey")
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if req.GetKeyId() == "" {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    "the api key id is empty",
+		}, nil
+	}
+	operator, err := getApiKeyOperator(ctx)
+	if err == nil {
+		var info *rootcoordpb.ApiKeyInfo
+		if info, err = globalMetaCache.GetApiKeyInfo(ctx, req.GetKeyId()); err == nil {
+			err = checkApiKeyOwner(operator, info.GetUsername())
+		}
+	}
+	if err != nil {
+		log.Warn("revoke api key is denied", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_PermissionDenied,
+			Reason:    err.Error(),
+		}, nil
+	}
+	req.Base = commonpbutil.NewMsgBase(
+		commonpbutil.WithMsgType(commonpb.MsgType_DeleteCredential),
+	)
+	result, err := node.rootCoord.RevokeApiKey(ctx, req)
+	if err != nil {
+		log.Error("revoke api key fail", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
+
 func (node *Proxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateRole")
 	defer sp.Finish()
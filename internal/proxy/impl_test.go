@@ -9,10 +9,17 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestProxy_InvalidateCollectionMetaCache_remove_stream(t *testing.T) {
@@ -104,3 +111,233 @@ func TestProxy_CheckHealth(t *testing.T) {
 		assert.Equal(t, 4, len(resp.Reasons))
 	})
 }
+
+func TestProxy_ApiKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{ServerID: 1}}
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		createResp, err := node.CreateApiKey(ctx, &rootcoordpb.CreateApiKeyRequest{Username: "user1"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, createResp.GetStatus().GetErrorCode())
+		listResp, err := node.ListApiKeys(ctx, &rootcoordpb.ListApiKeysRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		status, err := node.RevokeApiKey(ctx, &rootcoordpb.RevokeApiKeyRequest{KeyId: "key1"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "false")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		node := &Proxy{rootCoord: mocks.NewRootCoord(t)}
+		node.stateCode.Store(commonpb.StateCode_Healthy)
+		createResp, err := node.CreateApiKey(GetContext(ctx, "root:Milvus"), &rootcoordpb.CreateApiKeyRequest{Username: "user1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_PermissionDenied, createResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("forward to root coord", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		client := &MockRootCoordClientInterface{}
+		client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+			return &internalpb.ListPolicyResponse{
+				Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				UserRoles: []string{funcutil.EncodeUserRoleCache("admin1", util.RoleAdmin)},
+			}, nil
+		}
+		err := InitMetaCache(ctx, client, &MockQueryCoordClientInterface{}, newShardClientMgr())
+		require.NoError(t, err)
+
+		rc := mocks.NewRootCoord(t)
+		rc.EXPECT().CreateApiKey(mock.Anything, mock.Anything).Return(&rootcoordpb.CreateApiKeyResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			KeyId:  "key1",
+			ApiKey: "key1.secret",
+		}, nil)
+		rc.EXPECT().ListApiKeys(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+		rc.EXPECT().RevokeApiKey(mock.Anything, mock.Anything).Return(&commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil)
+
+		node := &Proxy{rootCoord: rc}
+		node.stateCode.Store(commonpb.StateCode_Healthy)
+		userCtx := GetContext(ctx, "mockUser:mockPass")
+		adminCtx := GetContext(ctx, "admin1:123456")
+
+		createResp, err := node.CreateApiKey(ctx, &rootcoordpb.CreateApiKeyRequest{Username: "mockUser"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_PermissionDenied, createResp.GetStatus().GetErrorCode())
+
+		createResp, err = node.CreateApiKey(userCtx, &rootcoordpb.CreateApiKeyRequest{Username: "mockUser"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, createResp.GetStatus().GetErrorCode())
+		assert.Equal(t, "key1.secret", createResp.GetApiKey())
+
+		createResp, err = node.CreateApiKey(userCtx, &rootcoordpb.CreateApiKeyRequest{Username: "user2"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_PermissionDenied, createResp.GetStatus().GetErrorCode())
+
+		createResp, err = node.CreateApiKey(adminCtx, &rootcoordpb.CreateApiKeyRequest{Username: "user2"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, createResp.GetStatus().GetErrorCode())
+
+		createResp, err = node.CreateApiKey(userCtx, &rootcoordpb.CreateApiKeyRequest{Username: ""})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, createResp.GetStatus().GetErrorCode())
+
+		listResp, err := node.ListApiKeys(userCtx, &rootcoordpb.ListApiKeysRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_PermissionDenied, listResp.GetStatus().GetErrorCode())
+
+		listResp, err = node.ListApiKeys(userCtx, &rootcoordpb.ListApiKeysRequest{Username: "mockUser"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, listResp.GetStatus().GetErrorCode())
+
+		// the api key "mockKey" belongs to "mockUser".
+		status, err := node.RevokeApiKey(GetContext(ctx, "user2:123456"), &rootcoordpb.RevokeApiKeyRequest{KeyId: "mockKey"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_PermissionDenied, status.GetErrorCode())
+
+		status, err = node.RevokeApiKey(adminCtx, &rootcoordpb.RevokeApiKeyRequest{KeyId: "notExistKey"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_PermissionDenied, status.GetErrorCode())
+
+		status, err = node.RevokeApiKey(userCtx, &rootcoordpb.RevokeApiKeyRequest{KeyId: "mockKey"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		status, err = node.RevokeApiKey(userCtx, &rootcoordpb.RevokeApiKeyRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, status.GetErrorCode())
+	})
+}
+
+func TestProxy_Export(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{ServerID: 1}}
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err := node.Export(ctx, &rootcoordpb.ExportRequest{CollectionName: "coll", TargetPath: "export"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		stateResp, err := node.GetExportState(ctx, &rootcoordpb.GetExportStateRequest{TaskId: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, stateResp.GetStatus().GetErrorCode())
+		listResp, err := node.ListExportTasks(ctx, &rootcoordpb.ListExportTasksRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("forward to root coord", func(t *testing.T) {
+		rc := mocks.NewRootCoord(t)
+		rc.EXPECT().Export(mock.Anything, mock.Anything).Return(&rootcoordpb.ExportResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			TaskId: 100,
+		}, nil)
+		rc.EXPECT().GetExportState(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+		rc.EXPECT().ListExportTasks(mock.Anything, mock.Anything).Return(&rootcoordpb.ListExportTasksResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		}, nil)
+
+		node := &Proxy{rootCoord: rc}
+		node.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := node.Export(ctx, &rootcoordpb.ExportRequest{CollectionName: "coll", TargetPath: "export"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(100), resp.GetTaskId())
+
+		resp, err = node.Export(ctx, &rootcoordpb.ExportRequest{CollectionName: "", TargetPath: "export"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+		resp, err = node.Export(ctx, &rootcoordpb.ExportRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+
+		stateResp, err := node.GetExportState(ctx, &rootcoordpb.GetExportStateRequest{TaskId: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stateResp.GetStatus().GetErrorCode())
+
+		listResp, err := node.ListExportTasks(ctx, &rootcoordpb.ListExportTasksRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+	})
+}
+
+func TestProxy_Backup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{ServerID: 1}}
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		createResp, err := node.CreateBackup(ctx, &rootcoordpb.CreateBackupRequest{Name: "b1", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, createResp.GetStatus().GetErrorCode())
+		listResp, err := node.ListBackups(ctx, &rootcoordpb.ListBackupsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		status, err := node.VerifyBackup(ctx, &rootcoordpb.VerifyBackupRequest{Name: "b1"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		status, err = node.DeleteBackup(ctx, &rootcoordpb.DeleteBackupRequest{Name: "b1"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		restoreResp, err := node.RestoreBackup(ctx, &rootcoordpb.RestoreBackupRequest{BackupName: "b1", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, restoreResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("forward to root coord", func(t *testing.T) {
+		rc := mocks.NewRootCoord(t)
+		rc.EXPECT().CreateBackup(mock.Anything, mock.Anything).Return(&rootcoordpb.CreateBackupResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Info:   &rootcoordpb.BackupInfo{Name: "b1"},
+		}, nil)
+		rc.EXPECT().ListBackups(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+		rc.EXPECT().VerifyBackup(mock.Anything, mock.Anything).Return(&commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil)
+		rc.EXPECT().DeleteBackup(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+		rc.EXPECT().RestoreBackup(mock.Anything, mock.Anything).Return(&rootcoordpb.RestoreBackupResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Tasks:  []int64{100},
+		}, nil)
+
+		node := &Proxy{rootCoord: rc}
+		node.stateCode.Store(commonpb.StateCode_Healthy)
+
+		createResp, err := node.CreateBackup(ctx, &rootcoordpb.CreateBackupRequest{Name: "b1", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, createResp.GetStatus().GetErrorCode())
+		assert.Equal(t, "b1", createResp.GetInfo().GetName())
+
+		createResp, err = node.CreateBackup(ctx, &rootcoordpb.CreateBackupRequest{Name: "b1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, createResp.GetStatus().GetErrorCode())
+
+		listResp, err := node.ListBackups(ctx, &rootcoordpb.ListBackupsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, listResp.GetStatus().GetErrorCode())
+
+		status, err := node.VerifyBackup(ctx, &rootcoordpb.VerifyBackupRequest{Name: "b1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		status, err = node.DeleteBackup(ctx, &rootcoordpb.DeleteBackupRequest{Name: "b1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+		restoreResp, err := node.RestoreBackup(ctx, &rootcoordpb.RestoreBackupRequest{BackupName: "b1", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, restoreResp.GetStatus().GetErrorCode())
+		assert.Equal(t, []int64{100}, restoreResp.GetTasks())
+
+		restoreResp, err = node.RestoreBackup(ctx, &rootcoordpb.RestoreBackupRequest{BackupName: "b1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, restoreResp.GetStatus().GetErrorCode())
+	})
+}
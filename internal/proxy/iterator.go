@@ -0,0 +1,203 @@
+package proxy
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+// iteratorCursor is the position an iterator resumes from, it's returned to client as an opaque token.
+// A query iterator pages by primary key, the cursor holds the last returned primary key.
+// A search iterator pages by distance, the cursor holds the last returned distance and
+// the primary keys returned at that distance.
+type iteratorCursor struct {
+	CollectionID UniqueID `json:"collection_id"`
+	// Timestamp is the MVCC timestamp which all the pages of an iterator are read at.
+	Timestamp Timestamp `json:"timestamp"`
+	IntPKs    []int64   `json:"int_pks,omitempty"`
+	StrPKs    []string  `json:"str_pks,omitempty"`
+	Distance  float32   `json:"distance,omitempty"`
+}
+
+func encodeIteratorToken(cursor *iteratorCursor) (string, error) {
+	bs, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
+
+func decodeIteratorToken(token string) (*iteratorCursor, error) {
+	bs, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("%s [%s] is invalid", IteratorTokenKey, token)
+	}
+	cursor := &iteratorCursor{}
+	if err := json.Unmarshal(bs, cursor); err != nil {
+		return nil, fmt.Errorf("%s [%s] is invalid", IteratorTokenKey, token)
+	}
+	return cursor, nil
+}
+
+// parseIteratorParams returns whether the request is a page of an iterator, and the cursor to resume from
+// if the iterator token is provided. An iterator begins with iterator=true and resumes with the token
+// returned by the previous page.
+func parseIteratorParams(params []*commonpb.KeyValuePair, collectionID UniqueID) (bool, *iteratorCursor, error) {
+	token, err := funcutil.GetAttrByKeyFromRepeatedKV(IteratorTokenKey, params)
+	if err == nil && token != "" {
+		cursor, err := decodeIteratorToken(token)
+		if err != nil {
+			return false, nil, err
+		}
+		if cursor.CollectionID != collectionID {
+			return false, nil, fmt.Errorf("%s doesn't belong to collection %d", IteratorTokenKey, collectionID)
+		}
+		return true, cursor, nil
+	}
+
+	iteratorStr, err := funcutil.GetAttrByKeyFromRepeatedKV(IteratorKey, params)
+	if err != nil {
+		return false, nil, nil
+	}
+	iterator, err := strconv.ParseBool(iteratorStr)
+	if err != nil {
+		return false, nil, fmt.Errorf("%s [%s] is invalid", IteratorKey, iteratorStr)
+	}
+	return iterator, nil, nil
+}
+
+func (c *iteratorCursor) appendPK(pk interface{}) {
+	switch v := pk.(type) {
+	case int64:
+		c.IntPKs = append(c.IntPKs, v)
+	case string:
+		c.StrPKs = append(c.StrPKs, v)
+	}
+}
+
+func (c *iteratorCursor) pkValues() []*planpb.GenericValue {
+	values := make([]*planpb.GenericValue, 0, len(c.IntPKs)+len(c.StrPKs))
+	for _, pk := range c.IntPKs {
+		values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: pk}})
+	}
+	for _, pk := range c.StrPKs {
+		values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: pk}})
+	}
+	return values
+}
+
+func pkColumnInfo(pkField *schemapb.FieldSchema) *planpb.ColumnInfo {
+	return &planpb.ColumnInfo{
+		FieldId:      pkField.GetFieldID(),
+		DataType:     pkField.GetDataType(),
+		IsPrimaryKey: true,
+		IsAutoID:     pkField.GetAutoID(),
+	}
+}
+
+// pkGreaterThanExpr returns the expression which filters the entities after the cursor of a query iterator.
+func (c *iteratorCursor) pkGreaterThanExpr(pkField *schemapb.FieldSchema) *planpb.Expr {
+	values := c.pkValues()
+	if len(values) == 0 {
+		return nil
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: pkColumnInfo(pkField),
+				Op:         planpb.OpType_GreaterThan,
+				Value:      values[len(values)-1],
+			},
+		},
+	}
+}
+
+// pkNotInExpr returns the expression which excludes the entities returned at the cursor distance of a search iterator.
+func (c *iteratorCursor) pkNotInExpr(pkField *schemapb.FieldSchema) *planpb.Expr {
+	values := c.pkValues()
+	if len(values) == 0 {
+		return nil
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_UnaryExpr{
+			UnaryExpr: &planpb.UnaryExpr{
+				Op: planpb.UnaryExpr_Not,
+				Child: &planpb.Expr{
+					Expr: &planpb.Expr_TermExpr{
+						TermExpr: &planpb.TermExpr{
+							ColumnInfo: pkColumnInfo(pkField),
+							Values:     values,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resumeRangeSearch turns the search into a range search beginning at the cursor distance, so that
+// the entities closer than the last returned one are skipped without being searched again.
+func (c *iteratorCursor) resumeRangeSearch(queryInfo *planpb.QueryInfo) {
+	if distance.PositivelyRelated(queryInfo.GetMetricType()) {
+		if !queryInfo.GetIsRangeSearch() {
+			queryInfo.Radius = -math.MaxFloat32
+			queryInfo.RangeFilter = math.MaxFloat32
+		}
+		if c.Distance < queryInfo.GetRangeFilter() {
+			queryInfo.RangeFilter = c.Distance
+		}
+	} else {
+		if !queryInfo.GetIsRangeSearch() {
+			queryInfo.Radius = math.MaxFloat32
+			queryInfo.RangeFilter = -math.MaxFloat32
+		}
+		if c.Distance > queryInfo.GetRangeFilter() {
+			queryInfo.RangeFilter = c.Distance
+		}
+	}
+	queryInfo.IsRangeSearch = true
+}
+
+// andExpr combines two expressions by logical and, either of them could be nil.
+func andExpr(left, right *planpb.Expr) *planpb.Expr {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	return &planpb.Expr{
+		Expr: &planpb.Expr_BinaryExpr{
+			BinaryExpr: &planpb.BinaryExpr{
+				Op:    planpb.BinaryExpr_LogicalAnd,
+				Left:  left,
+				Right: right,
+			},
+		},
+	}
+}
+
+// setIteratorToken returns the iterator token to client in the response header, it is kept out of
+// the results so that the fields data stay aligned. An empty token means the iterator is exhausted and is not sent.
+func setIteratorToken(ctx context.Context, token string) {
+	if token == "" {
+		return
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(IteratorTokenKey, token)); err != nil {
+		log.Ctx(ctx).Warn("failed to set iterator token to response header", zap.Error(err))
+	}
+}
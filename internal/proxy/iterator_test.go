@@ -0,0 +1,142 @@
+package proxy
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+)
+
+func TestIteratorToken(t *testing.T) {
+	cursor := &iteratorCursor{
+		CollectionID: 1,
+		Timestamp:    100,
+		IntPKs:       []int64{1, 2},
+		Distance:     0.5,
+	}
+	token, err := encodeIteratorToken(cursor)
+	assert.NoError(t, err)
+
+	decoded, err := decodeIteratorToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+
+	_, err = decodeIteratorToken("invalid token")
+	assert.Error(t, err)
+	_, err = decodeIteratorToken("aW52YWxpZA")
+	assert.Error(t, err)
+}
+
+func TestParseIteratorParams(t *testing.T) {
+	iterator, cursor, err := parseIteratorParams(nil, 1)
+	assert.NoError(t, err)
+	assert.False(t, iterator)
+	assert.Nil(t, cursor)
+
+	iterator, cursor, err = parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorKey, Value: "true"}}, 1)
+	assert.NoError(t, err)
+	assert.True(t, iterator)
+	assert.Nil(t, cursor)
+
+	_, _, err = parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorKey, Value: "invalid"}}, 1)
+	assert.Error(t, err)
+
+	token, err := encodeIteratorToken(&iteratorCursor{CollectionID: 1, Timestamp: 100, StrPKs: []string{"a"}})
+	assert.NoError(t, err)
+	iterator, cursor, err = parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorTokenKey, Value: token}}, 1)
+	assert.NoError(t, err)
+	assert.True(t, iterator)
+	assert.Equal(t, Timestamp(100), cursor.Timestamp)
+	assert.Equal(t, []string{"a"}, cursor.StrPKs)
+
+	// token of another collection
+	_, _, err = parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorTokenKey, Value: token}}, 2)
+	assert.Error(t, err)
+
+	_, _, err = parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorTokenKey, Value: "invalid token"}}, 1)
+	assert.Error(t, err)
+}
+
+func TestIteratorCursor_Expr(t *testing.T) {
+	pkField := &schemapb.FieldSchema{
+		FieldID:      100,
+		Name:         "pk",
+		IsPrimaryKey: true,
+		DataType:     schemapb.DataType_Int64,
+	}
+
+	cursor := &iteratorCursor{}
+	assert.Nil(t, cursor.pkGreaterThanExpr(pkField))
+	assert.Nil(t, cursor.pkNotInExpr(pkField))
+
+	cursor.appendPK(int64(1))
+	cursor.appendPK(int64(2))
+	expr := cursor.pkGreaterThanExpr(pkField)
+	assert.Equal(t, planpb.OpType_GreaterThan, expr.GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, int64(2), expr.GetUnaryRangeExpr().GetValue().GetInt64Val())
+	assert.Equal(t, int64(100), expr.GetUnaryRangeExpr().GetColumnInfo().GetFieldId())
+
+	expr = cursor.pkNotInExpr(pkField)
+	assert.Equal(t, planpb.UnaryExpr_Not, expr.GetUnaryExpr().GetOp())
+	assert.Equal(t, 2, len(expr.GetUnaryExpr().GetChild().GetTermExpr().GetValues()))
+
+	assert.Equal(t, expr, andExpr(nil, expr))
+	assert.Equal(t, expr, andExpr(expr, nil))
+	and := andExpr(expr, expr)
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, and.GetBinaryExpr().GetOp())
+}
+
+func TestIteratorCursor_resumeRangeSearch(t *testing.T) {
+	cursor := &iteratorCursor{Distance: 0.5}
+
+	queryInfo := &planpb.QueryInfo{MetricType: distance.L2}
+	cursor.resumeRangeSearch(queryInfo)
+	assert.True(t, queryInfo.GetIsRangeSearch())
+	assert.Equal(t, float32(math.MaxFloat32), queryInfo.GetRadius())
+	assert.Equal(t, float32(0.5), queryInfo.GetRangeFilter())
+
+	queryInfo = &planpb.QueryInfo{MetricType: distance.L2, IsRangeSearch: true, Radius: 1, RangeFilter: 0.8}
+	cursor.resumeRangeSearch(queryInfo)
+	assert.Equal(t, float32(1), queryInfo.GetRadius())
+	assert.Equal(t, float32(0.8), queryInfo.GetRangeFilter())
+
+	queryInfo = &planpb.QueryInfo{MetricType: distance.IP}
+	cursor.resumeRangeSearch(queryInfo)
+	assert.True(t, queryInfo.GetIsRangeSearch())
+	assert.Equal(t, float32(-math.MaxFloat32), queryInfo.GetRadius())
+	assert.Equal(t, float32(0.5), queryInfo.GetRangeFilter())
+
+	queryInfo = &planpb.QueryInfo{MetricType: distance.IP, IsRangeSearch: true, Radius: 0.1, RangeFilter: 0.3}
+	cursor.resumeRangeSearch(queryInfo)
+	assert.Equal(t, float32(0.1), queryInfo.GetRadius())
+	assert.Equal(t, float32(0.3), queryInfo.GetRangeFilter())
+}
+
+type headerRecorder struct {
+	grpc.ServerTransportStream
+	md metadata.MD
+}
+
+func (r *headerRecorder) SetHeader(md metadata.MD) error {
+	r.md = metadata.Join(r.md, md)
+	return nil
+}
+
+func TestSetIteratorToken(t *testing.T) {
+	stream := &headerRecorder{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+	setIteratorToken(ctx, "")
+	assert.Empty(t, stream.md.Get(IteratorTokenKey))
+
+	setIteratorToken(ctx, "token")
+	assert.Equal(t, []string{"token"}, stream.md.Get(IteratorTokenKey))
+}
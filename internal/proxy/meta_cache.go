@@ -50,27 +50,31 @@ import (
 // Cache is the interface for system meta data cache
 type Cache interface {
 	// GetCollectionID get collection's id by name.
-	GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error)
+	GetCollectionID(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error)
 	// GetCollectionInfo get collection's information by name, such as collection id, schema, and etc.
-	GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error)
+	GetCollectionInfo(ctx context.Context, database, collectionName string) (*collectionInfo, error)
 	// GetPartitionID get partition's identifier of specific collection.
-	GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error)
+	GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error)
 	// GetPartitions get all partitions' id of specific collection.
-	GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
+	GetPartitions(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error)
 	// GetPartitionInfo get partition's info.
-	GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error)
+	GetPartitionInfo(ctx context.Context, database, collectionName string, partitionName string) (*partitionInfo, error)
 	// GetCollectionSchema get collection's schema.
-	GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
-	GetShards(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error)
-	ClearShards(collectionName string)
-	RemoveCollection(ctx context.Context, collectionName string)
+	GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error)
+	// GetCollectionSchemaVersion get the version of collection's schema, which is bumped each time a field is added.
+	GetCollectionSchemaVersion(ctx context.Context, database, collectionName string) (int32, error)
+	GetShards(ctx context.Context, withCache bool, database, collectionName string) (map[string][]nodeInfo, error)
+	ClearShards(database, collectionName string)
+	RemoveCollection(ctx context.Context, database, collectionName string)
 	RemoveCollectionsByID(ctx context.Context, collectionID UniqueID) []string
-	RemovePartition(ctx context.Context, collectionName string, partitionName string)
+	RemovePartition(ctx context.Context, database, collectionName string, partitionName string)
 
 	// GetCredentialInfo operate credential cache
 	GetCredentialInfo(ctx context.Context, username string) (*internalpb.CredentialInfo, error)
 	RemoveCredential(username string)
 	UpdateCredential(credInfo *internalpb.CredentialInfo)
+	// GetApiKeyInfo operate api key cache, the api keys of a user are removed by RemoveCredential
+	GetApiKeyInfo(ctx context.Context, keyID string) (*rootcoordpb.ApiKeyInfo, error)
 
 	GetPrivilegeInfo(ctx context.Context) []string
 	GetUserRole(username string) []string
@@ -87,6 +91,7 @@ type collectionInfo struct {
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
 	isLoaded            bool
+	schemaVersion       int32
 }
 
 // shardLeaders wraps shard leader mapping for iteration.
@@ -138,8 +143,9 @@ type MetaCache struct {
 	rootCoord  types.RootCoord
 	queryCoord types.QueryCoord
 
-	collInfo       map[string]*collectionInfo
+	collInfo       map[string]map[string]*collectionInfo // database -> collection name -> collection info
 	credMap        map[string]*internalpb.CredentialInfo // cache for credential, lazy load
+	apiKeyMap      map[string]*rootcoordpb.ApiKeyInfo    // cache for api key, lazy load
 	privilegeInfos map[string]struct{}                   // privileges cache
 	userToRoles    map[string]map[string]struct{}        // user to role cache
 	mu             sync.RWMutex
@@ -175,32 +181,50 @@ func NewMetaCache(rootCoord types.RootCoord, queryCoord types.QueryCoord, shardM
 	return &MetaCache{
 		rootCoord:      rootCoord,
 		queryCoord:     queryCoord,
-		collInfo:       map[string]*collectionInfo{},
+		collInfo:       map[string]map[string]*collectionInfo{},
 		credMap:        map[string]*internalpb.CredentialInfo{},
+		apiKeyMap:      map[string]*rootcoordpb.ApiKeyInfo{},
 		shardMgr:       shardMgr,
 		privilegeInfos: map[string]struct{}{},
 		userToRoles:    map[string]map[string]struct{}{},
 	}, nil
 }
 
+// getDatabaseName returns the database a request without db_name belongs to.
+func getDatabaseName(database string) string {
+	if database == "" {
+		return util.DefaultDBName
+	}
+	return database
+}
+
+// getCollInfo returns the cached collection info, caller must hold m.mu.
+func (m *MetaCache) getCollInfo(database, collectionName string) (*collectionInfo, bool) {
+	db, ok := m.collInfo[getDatabaseName(database)]
+	if !ok {
+		return nil, false
+	}
+	collInfo, ok := db[collectionName]
+	return collInfo, ok
+}
+
 // GetCollectionID returns the corresponding collection id for provided collection name
-func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+func (m *MetaCache) GetCollectionID(ctx context.Context, database, collectionName string) (typeutil.UniqueID, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(database, collectionName)
 
 	if !ok {
 		metrics.ProxyCacheStatsCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), "GeCollectionID", metrics.CacheMissLabel).Inc()
 		tr := timerecord.NewTimeRecorder("UpdateCache")
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			return 0, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
+		collInfo = m.updateCollection(coll, database, collectionName)
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
-		collInfo = m.collInfo[collectionName]
 		return collInfo.collID, nil
 	}
 	defer m.mu.RUnlock()
@@ -211,22 +235,21 @@ func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string)
 
 // GetCollectionInfo returns the collection information related to provided collection name
 // If the information is not found, proxy will try to fetch information for other source (RootCoord for now)
-func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error) {
+func (m *MetaCache) GetCollectionInfo(ctx context.Context, database, collectionName string) (*collectionInfo, error) {
 	m.mu.RLock()
 	var collInfo *collectionInfo
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(database, collectionName)
 	m.mu.RUnlock()
 
 	if !ok {
 		tr := timerecord.NewTimeRecorder("UpdateCache")
 		metrics.ProxyCacheStatsCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), "GetCollectionInfo", metrics.CacheMissLabel).Inc()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		m.mu.Lock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, database, collectionName)
 		m.mu.Unlock()
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
 	}
@@ -260,7 +283,9 @@ func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string
 		}
 		if loaded {
 			m.mu.Lock()
-			m.collInfo[collectionName].isLoaded = true
+			if info, ok := m.getCollInfo(database, collectionName); ok {
+				info.isLoaded = true
+			}
 			m.mu.Unlock()
 		}
 	}
@@ -269,27 +294,28 @@ func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string
 	return collInfo, nil
 }
 
-func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+func (m *MetaCache) GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemapb.CollectionSchema, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(database, collectionName)
 
 	if !ok {
 		metrics.ProxyCacheStatsCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), "GetCollectionSchema", metrics.CacheMissLabel).Inc()
 		tr := timerecord.NewTimeRecorder("UpdateCache")
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, database, collectionName)
 		if err != nil {
 			log.Warn("Failed to load collection from rootcoord ",
+				zap.String("db", database),
 				zap.String("collection name ", collectionName),
 				zap.Error(err))
 			return nil, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, database, collectionName)
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
 		log.Debug("Reload collection from root coordinator ",
+			zap.String("db", database),
 			zap.String("collection name ", collectionName),
 			zap.Any("time (milliseconds) take ", tr.ElapseSpan().Milliseconds()))
 		return collInfo.schema, nil
@@ -300,34 +326,78 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName stri
 	return collInfo.schema, nil
 }
 
-func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
+// GetCollectionSchemaVersion returns the version of collection schema, the inserted data are tagged with it,
+// so that datanodes and querynodes know whether the data miss the fields added later.
+func (m *MetaCache) GetCollectionSchemaVersion(ctx context.Context, database, collectionName string) (int32, error) {
+	m.mu.RLock()
+	collInfo, ok := m.getCollInfo(database, collectionName)
+	if ok {
+		defer m.mu.RUnlock()
+		return collInfo.schemaVersion, nil
+	}
+	m.mu.RUnlock()
+
+	coll, err := m.describeCollection(ctx, database, collectionName)
+	if err != nil {
+		return 0, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	collInfo = m.updateCollection(coll, database, collectionName)
+	return collInfo.schemaVersion, nil
+}
+
+// getSchemaVersion parses the schema version from the collection properties returned by rootcoord.
+func getSchemaVersion(properties []*commonpb.KeyValuePair) int32 {
+	for _, pair := range properties {
+		if pair.GetKey() == common.CollectionSchemaVersionKey {
+			version, err := strconv.ParseInt(pair.GetValue(), 10, 32)
+			if err != nil {
+				log.Warn("invalid collection schema version", zap.String("version", pair.GetValue()))
+				return 0
+			}
+			return int32(version)
+		}
+	}
+	return 0
+}
+
+// updateCollection refreshes the cached collection info, caller must hold m.mu.
+func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, database, collectionName string) *collectionInfo {
+	database = getDatabaseName(database)
+	if _, ok := m.collInfo[database]; !ok {
+		m.collInfo[database] = make(map[string]*collectionInfo)
+	}
+	collInfo, ok := m.collInfo[database][collectionName]
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{}
+		collInfo = &collectionInfo{}
+		m.collInfo[database][collectionName] = collInfo
 	}
-	m.collInfo[collectionName].schema = coll.Schema
-	m.collInfo[collectionName].collID = coll.CollectionID
-	m.collInfo[collectionName].createdTimestamp = coll.CreatedTimestamp
-	m.collInfo[collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
+	collInfo.schema = coll.Schema
+	collInfo.collID = coll.CollectionID
+	collInfo.createdTimestamp = coll.CreatedTimestamp
+	collInfo.createdUtcTimestamp = coll.CreatedUtcTimestamp
+	collInfo.schemaVersion = getSchemaVersion(coll.Properties)
+	return collInfo
 }
 
-func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
-	partInfo, err := m.GetPartitionInfo(ctx, collectionName, partitionName)
+func (m *MetaCache) GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+	partInfo, err := m.GetPartitionInfo(ctx, database, collectionName, partitionName)
 	if err != nil {
 		return 0, err
 	}
 	return partInfo.partitionID, nil
 }
 
-func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitions(ctx context.Context, database, collectionName string) (map[string]typeutil.UniqueID, error) {
+	_, err := m.GetCollectionID(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(database, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -338,7 +408,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 		metrics.ProxyCacheStatsCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), "GetPartitions", metrics.CacheMissLabel).Inc()
 		m.mu.RUnlock()
 
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
@@ -346,15 +416,15 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		err = m.updatePartitions(partitions, collectionName)
+		err = m.updatePartitions(partitions, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
 		log.Debug("proxy", zap.Any("GetPartitions:partitions after update", partitions), zap.Any("collectionName", collectionName))
 		ret := make(map[string]typeutil.UniqueID)
-		partInfo := m.collInfo[collectionName].partInfo
-		for k, v := range partInfo {
+		collInfo, _ = m.getCollInfo(database, collectionName)
+		for k, v := range collInfo.partInfo {
 			ret[k] = v.partitionID
 		}
 		return ret, nil
@@ -364,23 +434,22 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	metrics.ProxyCacheStatsCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), "GetPartitions", metrics.CacheHitLabel).Inc()
 
 	ret := make(map[string]typeutil.UniqueID)
-	partInfo := m.collInfo[collectionName].partInfo
-	for k, v := range partInfo {
+	for k, v := range collInfo.partInfo {
 		ret[k] = v.partitionID
 	}
 
 	return ret, nil
 }
 
-func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitionInfo(ctx context.Context, database, collectionName string, partitionName string) (*partitionInfo, error) {
+	_, err := m.GetCollectionID(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(database, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -393,20 +462,21 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 	if !ok {
 		tr := timerecord.NewTimeRecorder("UpdateCache")
 		metrics.ProxyCacheStatsCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), "GetPartitionInfo", metrics.CacheMissLabel).Inc()
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		err = m.updatePartitions(partitions, collectionName)
+		err = m.updatePartitions(partitions, database, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		metrics.ProxyUpdateCacheLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions after update", partitions), zap.Any("collectionName", collectionName))
-		partInfo, ok = m.collInfo[collectionName].partInfo[partitionName]
+		collInfo, _ = m.getCollInfo(database, collectionName)
+		partInfo, ok = collInfo.partInfo[partitionName]
 		if !ok {
 			return nil, ErrPartitionNotExist(partitionName)
 		}
@@ -420,11 +490,12 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 }
 
 // Get the collection information from rootcoord.
-func (m *MetaCache) describeCollection(ctx context.Context, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+func (m *MetaCache) describeCollection(ctx context.Context, database, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
 	req := &milvuspb.DescribeCollectionRequest{
 		Base: commonpbutil.NewMsgBase(
 			commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection),
 		),
+		DbName:         database,
 		CollectionName: collectionName,
 	}
 	coll, err := m.rootCoord.DescribeCollection(ctx, req)
@@ -447,6 +518,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 		PhysicalChannelNames: coll.PhysicalChannelNames,
 		CreatedTimestamp:     coll.CreatedTimestamp,
 		CreatedUtcTimestamp:  coll.CreatedUtcTimestamp,
+		Properties:           coll.Properties,
 	}
 	for _, field := range coll.Schema.Fields {
 		if field.FieldID >= common.StartOfUserFieldID {
@@ -456,11 +528,12 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 	return resp, nil
 }
 
-func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
+func (m *MetaCache) showPartitions(ctx context.Context, database, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
 	req := &milvuspb.ShowPartitionsRequest{
 		Base: commonpbutil.NewMsgBase(
 			commonpbutil.WithMsgType(commonpb.MsgType_ShowPartitions),
 		),
+		DbName:         database,
 		CollectionName: collectionName,
 	}
 
@@ -480,14 +553,19 @@ func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (
 	return partitions, nil
 }
 
-func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, collectionName string) error {
-	_, ok := m.collInfo[collectionName]
+func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, database, collectionName string) error {
+	database = getDatabaseName(database)
+	if _, ok := m.collInfo[database]; !ok {
+		m.collInfo[database] = make(map[string]*collectionInfo)
+	}
+	collInfo, ok := m.collInfo[database][collectionName]
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{
+		collInfo = &collectionInfo{
 			partInfo: map[string]*partitionInfo{},
 		}
+		m.collInfo[database][collectionName] = collInfo
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := collInfo.partInfo
 	if partInfo == nil {
 		partInfo = map[string]*partitionInfo{}
 	}
@@ -506,37 +584,42 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 			}
 		}
 	}
-	m.collInfo[collectionName].partInfo = partInfo
+	collInfo.partInfo = partInfo
 	return nil
 }
 
-func (m *MetaCache) RemoveCollection(ctx context.Context, collectionName string) {
+func (m *MetaCache) RemoveCollection(ctx context.Context, database, collectionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	delete(m.collInfo, collectionName)
+	db, ok := m.collInfo[getDatabaseName(database)]
+	if ok {
+		delete(db, collectionName)
+	}
 }
 
 func (m *MetaCache) RemoveCollectionsByID(ctx context.Context, collectionID UniqueID) []string {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	var collNames []string
-	for k, v := range m.collInfo {
-		if v.collID == collectionID {
-			delete(m.collInfo, k)
-			collNames = append(collNames, k)
+	for _, db := range m.collInfo {
+		for k, v := range db {
+			if v.collID == collectionID {
+				delete(db, k)
+				collNames = append(collNames, k)
+			}
 		}
 	}
 	return collNames
 }
 
-func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partitionName string) {
+func (m *MetaCache) RemovePartition(ctx context.Context, database, collectionName, partitionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	_, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(database, collectionName)
 	if !ok {
 		return
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := collInfo.partInfo
 	if partInfo == nil {
 		return
 	}
@@ -576,6 +659,12 @@ func (m *MetaCache) RemoveCredential(username string) {
 	defer m.credMut.Unlock()
 	// delete pair in credMap
 	delete(m.credMap, username)
+	// delete the api keys of the user, the revoked keys shall not be authenticated any more
+	for keyID, info := range m.apiKeyMap {
+		if info.GetUsername() == username {
+			delete(m.apiKeyMap, keyID)
+		}
+	}
 }
 
 func (m *MetaCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
@@ -592,9 +681,38 @@ func (m *MetaCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
 	m.credMap[username].Sha256Password = credInfo.Sha256Password
 }
 
+// GetApiKeyInfo returns the api key with its hashed secret related to provided key id
+// If the cache missed, proxy will try to fetch from storage
+func (m *MetaCache) GetApiKeyInfo(ctx context.Context, keyID string) (*rootcoordpb.ApiKeyInfo, error) {
+	m.credMut.RLock()
+	info, ok := m.apiKeyMap[keyID]
+	m.credMut.RUnlock()
+	if ok {
+		return info, nil
+	}
+
+	resp, err := m.rootCoord.GetApiKey(ctx, &rootcoordpb.GetApiKeyRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_GetCredential),
+		),
+		KeyId: keyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.GetStatus().GetReason())
+	}
+
+	m.credMut.Lock()
+	m.apiKeyMap[keyID] = resp.GetInfo()
+	m.credMut.Unlock()
+	return resp.GetInfo(), nil
+}
+
 // GetShards update cache if withCache == false
-func (m *MetaCache) GetShards(ctx context.Context, withCache bool, collectionName string) (map[string][]nodeInfo, error) {
-	info, err := m.GetCollectionInfo(ctx, collectionName)
+func (m *MetaCache) GetShards(ctx context.Context, withCache bool, database, collectionName string) (map[string][]nodeInfo, error) {
+	info, err := m.GetCollectionInfo(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -648,7 +766,7 @@ func (m *MetaCache) GetShards(ctx context.Context, withCache bool, collectionNam
 
 	shards := parseShardLeaderList2QueryNode(resp.GetShards())
 
-	info, err = m.GetCollectionInfo(ctx, collectionName)
+	info, err = m.GetCollectionInfo(ctx, database, collectionName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get shards, collection %s not found", collectionName)
 	}
@@ -691,12 +809,12 @@ func parseShardLeaderList2QueryNode(shardsLeaders []*querypb.ShardLeadersList) m
 }
 
 // ClearShards clear the shard leader cache of a collection
-func (m *MetaCache) ClearShards(collectionName string) {
-	log.Info("clearing shard cache for collection", zap.String("collectionName", collectionName))
+func (m *MetaCache) ClearShards(database, collectionName string) {
+	log.Info("clearing shard cache for collection", zap.String("db", database), zap.String("collectionName", collectionName))
 	m.mu.Lock()
-	info, ok := m.collInfo[collectionName]
+	info, ok := m.getCollInfo(database, collectionName)
 	if ok {
-		m.collInfo[collectionName].shardLeaders = nil
+		info.shardLeaders = nil
 	}
 	m.mu.Unlock()
 	// delete refcnt in shardClientMgr
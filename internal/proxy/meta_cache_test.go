@@ -35,10 +35,13 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
+const dbName = util.DefaultDBName
+
 type MockRootCoordClientInterface struct {
 	types.RootCoord
 	Error       bool
@@ -170,6 +173,32 @@ func (m *MockRootCoordClientInterface) GetCredential(ctx context.Context, req *r
 	return nil, err
 }
 
+func (m *MockRootCoordClientInterface) GetApiKey(ctx context.Context, req *rootcoordpb.GetApiKeyRequest) (*rootcoordpb.GetApiKeyResponse, error) {
+	if m.Error {
+		return nil, errors.New("mocked error")
+	}
+	m.AccessCount++
+	if req.KeyId == "mockKey" {
+		return &rootcoordpb.GetApiKeyResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			Info: &rootcoordpb.ApiKeyInfo{
+				KeyId:        "mockKey",
+				Username:     "mockUser",
+				HashedSecret: crypto.HashApiKeySecret("mockKey", "mockSecret"),
+			},
+		}, nil
+	}
+
+	return &rootcoordpb.GetApiKeyResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_GetCredentialFailure,
+			Reason:    "can't find api key: " + req.KeyId,
+		},
+	}, nil
+}
+
 func (m *MockRootCoordClientInterface) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
 	if m.Error {
 		return nil, errors.New("mocked error")
@@ -218,24 +247,24 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err := globalMetaCache.GetCollectionID(ctx, dbName, "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
 	assert.Equal(t, rootCoord.AccessCount, 1)
 
 	// should'nt be accessed to remote root coord.
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, "collection1")
 	assert.Equal(t, rootCoord.AccessCount, 1)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
 		Fields: []*schemapb.FieldSchema{},
 	})
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection2")
+	id, err = globalMetaCache.GetCollectionID(ctx, dbName, "collection2")
 	assert.Equal(t, rootCoord.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection2")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, dbName, "collection2")
 	assert.Equal(t, rootCoord.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -244,11 +273,11 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	})
 
 	// test to get from cache, this should trigger root request
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err = globalMetaCache.GetCollectionID(ctx, dbName, "collection1")
 	assert.Equal(t, rootCoord.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, dbName, "collection1")
 	assert.Equal(t, rootCoord.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -258,6 +287,40 @@ func TestMetaCache_GetCollection(t *testing.T) {
 
 }
 
+func TestMetaCache_GetCollectionWithDatabase(t *testing.T) {
+	ctx := context.Background()
+	rootCoord := &MockRootCoordClientInterface{}
+	queryCoord := &MockQueryCoordClientInterface{}
+	mgr := newShardClientMgr()
+	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
+	assert.Nil(t, err)
+
+	_, err = globalMetaCache.GetCollectionID(ctx, dbName, "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, rootCoord.AccessCount, 1)
+
+	// empty database name falls back to the default database.
+	_, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, rootCoord.AccessCount, 1)
+
+	// same collection name in another database is cached separately.
+	_, err = globalMetaCache.GetCollectionID(ctx, "db2", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, rootCoord.AccessCount, 2)
+
+	globalMetaCache.RemoveCollection(ctx, "db2", "collection1")
+	_, err = globalMetaCache.GetCollectionID(ctx, dbName, "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, rootCoord.AccessCount, 2)
+	_, err = globalMetaCache.GetCollectionID(ctx, "db2", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, rootCoord.AccessCount, 3)
+
+	collNames := globalMetaCache.RemoveCollectionsByID(ctx, 1)
+	assert.ElementsMatch(t, []string{"collection1", "collection1"}, collNames)
+}
+
 func TestMetaCache_GetCollectionFailure(t *testing.T) {
 	ctx := context.Background()
 	rootCoord := &MockRootCoordClientInterface{}
@@ -267,13 +330,13 @@ func TestMetaCache_GetCollectionFailure(t *testing.T) {
 	assert.Nil(t, err)
 	rootCoord.Error = true
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, "collection1")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 
 	rootCoord.Error = false
 
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, dbName, "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
@@ -297,10 +360,10 @@ func TestMetaCache_GetNonExistCollection(t *testing.T) {
 	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection3")
+	id, err := globalMetaCache.GetCollectionID(ctx, dbName, "collection3")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, int64(0))
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection3")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, "collection3")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 }
@@ -313,16 +376,16 @@ func TestMetaCache_GetPartitionID(t *testing.T) {
 	err := InitMetaCache(ctx, rootCoord, queryCoord, mgr)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetPartitionID(ctx, "collection1", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, dbName, "collection1", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, dbName, "collection1", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, dbName, "collection2", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(3))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, dbName, "collection2", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(4))
 }
@@ -336,24 +399,24 @@ func TestMetaCache_GetPartitionError(t *testing.T) {
 	assert.Nil(t, err)
 
 	// Test the case where ShowPartitionsResponse is not aligned
-	id, err := globalMetaCache.GetPartitionID(ctx, "errorCollection", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, dbName, "errorCollection", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
-	partitions, err2 := globalMetaCache.GetPartitions(ctx, "errorCollection")
+	partitions, err2 := globalMetaCache.GetPartitions(ctx, dbName, "errorCollection")
 	assert.NotNil(t, err2)
 	log.Debug(err.Error())
 	assert.Equal(t, len(partitions), 0)
 
 	// Test non existed tables
-	id, err = globalMetaCache.GetPartitionID(ctx, "nonExisted", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, dbName, "nonExisted", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
 	// Test non existed partition
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par3")
+	id, err = globalMetaCache.GetPartitionID(ctx, dbName, "collection1", "par3")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
@@ -376,21 +439,21 @@ func TestMetaCache_GetShards(t *testing.T) {
 	defer qc.Stop()
 
 	t.Run("No collection in meta cache", func(t *testing.T) {
-		shards, err := globalMetaCache.GetShards(ctx, true, "non-exists")
+		shards, err := globalMetaCache.GetShards(ctx, true, dbName, "non-exists")
 		assert.Error(t, err)
 		assert.Empty(t, shards)
 	})
 
 	t.Run("without shardLeaders in collection info invalid shardLeaders", func(t *testing.T) {
 		qc.validShardLeaders = false
-		shards, err := globalMetaCache.GetShards(ctx, false, collectionName)
+		shards, err := globalMetaCache.GetShards(ctx, false, dbName, collectionName)
 		assert.Error(t, err)
 		assert.Empty(t, shards)
 	})
 
 	t.Run("without shardLeaders in collection info", func(t *testing.T) {
 		qc.validShardLeaders = true
-		shards, err := globalMetaCache.GetShards(ctx, true, collectionName)
+		shards, err := globalMetaCache.GetShards(ctx, true, dbName, collectionName)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, shards)
 		assert.Equal(t, 1, len(shards))
@@ -398,7 +461,7 @@ func TestMetaCache_GetShards(t *testing.T) {
 
 		// get from cache
 		qc.validShardLeaders = false
-		shards, err = globalMetaCache.GetShards(ctx, true, collectionName)
+		shards, err = globalMetaCache.GetShards(ctx, true, dbName, collectionName)
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, shards)
@@ -424,26 +487,26 @@ func TestMetaCache_ClearShards(t *testing.T) {
 	defer qc.Stop()
 
 	t.Run("Clear with no collection info", func(t *testing.T) {
-		globalMetaCache.ClearShards("collection_not_exist")
+		globalMetaCache.ClearShards(dbName, "collection_not_exist")
 	})
 
 	t.Run("Clear valid collection empty cache", func(t *testing.T) {
-		globalMetaCache.ClearShards(collectionName)
+		globalMetaCache.ClearShards(dbName, collectionName)
 	})
 
 	t.Run("Clear valid collection valid cache", func(t *testing.T) {
 
 		qc.validShardLeaders = true
-		shards, err := globalMetaCache.GetShards(ctx, true, collectionName)
+		shards, err := globalMetaCache.GetShards(ctx, true, dbName, collectionName)
 		require.NoError(t, err)
 		require.NotEmpty(t, shards)
 		require.Equal(t, 1, len(shards))
 		require.Equal(t, 3, len(shards["channel-1"]))
 
-		globalMetaCache.ClearShards(collectionName)
+		globalMetaCache.ClearShards(dbName, collectionName)
 
 		qc.validShardLeaders = false
-		shards, err = globalMetaCache.GetShards(ctx, true, collectionName)
+		shards, err = globalMetaCache.GetShards(ctx, true, dbName, collectionName)
 		assert.Error(t, err)
 		assert.Empty(t, shards)
 	})
@@ -531,6 +594,38 @@ func TestMetaCache_PolicyInfo(t *testing.T) {
 	})
 }
 
+func TestMetaCache_ApiKey(t *testing.T) {
+	ctx := context.Background()
+	rootCoord := &MockRootCoordClientInterface{}
+	mgr := newShardClientMgr()
+	cache, err := NewMetaCache(rootCoord, nil, mgr)
+	assert.NoError(t, err)
+
+	info, err := cache.GetApiKeyInfo(ctx, "mockKey")
+	assert.NoError(t, err)
+	assert.Equal(t, "mockUser", info.GetUsername())
+	assert.Equal(t, 1, rootCoord.AccessCount)
+
+	// hit cache
+	_, err = cache.GetApiKeyInfo(ctx, "mockKey")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, rootCoord.AccessCount)
+
+	// the api keys of the user are removed with the credential
+	cache.RemoveCredential("mockUser")
+	_, err = cache.GetApiKeyInfo(ctx, "mockKey")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rootCoord.AccessCount)
+
+	_, err = cache.GetApiKeyInfo(ctx, "notExistKey")
+	assert.Error(t, err)
+
+	rootCoord.Error = true
+	cache.RemoveCredential("mockUser")
+	_, err = cache.GetApiKeyInfo(ctx, "mockKey")
+	assert.Error(t, err)
+}
+
 func TestMetaCache_LoadCache(t *testing.T) {
 	ctx := context.Background()
 	rootCoord := &MockRootCoordClientInterface{}
@@ -540,7 +635,7 @@ func TestMetaCache_LoadCache(t *testing.T) {
 	assert.Nil(t, err)
 
 	t.Run("test IsCollectionLoaded", func(t *testing.T) {
-		info, err := globalMetaCache.GetCollectionInfo(ctx, "collection1")
+		info, err := globalMetaCache.GetCollectionInfo(ctx, dbName, "collection1")
 		assert.NoError(t, err)
 		assert.True(t, info.isLoaded)
 		// no collectionInfo of collection1, should access RootCoord
@@ -548,7 +643,7 @@ func TestMetaCache_LoadCache(t *testing.T) {
 		// not loaded, should access QueryCoord
 		assert.Equal(t, queryCoord.AccessCount, 1)
 
-		info, err = globalMetaCache.GetCollectionInfo(ctx, "collection1")
+		info, err = globalMetaCache.GetCollectionInfo(ctx, dbName, "collection1")
 		assert.NoError(t, err)
 		assert.True(t, info.isLoaded)
 		// shouldn't access QueryCoord or RootCoord again
@@ -556,7 +651,7 @@ func TestMetaCache_LoadCache(t *testing.T) {
 		assert.Equal(t, queryCoord.AccessCount, 1)
 
 		// test collection2 not fully loaded
-		info, err = globalMetaCache.GetCollectionInfo(ctx, "collection2")
+		info, err = globalMetaCache.GetCollectionInfo(ctx, dbName, "collection2")
 		assert.NoError(t, err)
 		assert.False(t, info.isLoaded)
 		// no collectionInfo of collection2, should access RootCoord
@@ -566,8 +661,8 @@ func TestMetaCache_LoadCache(t *testing.T) {
 	})
 
 	t.Run("test RemoveCollectionLoadCache", func(t *testing.T) {
-		globalMetaCache.RemoveCollection(ctx, "collection1")
-		info, err := globalMetaCache.GetCollectionInfo(ctx, "collection1")
+		globalMetaCache.RemoveCollection(ctx, dbName, "collection1")
+		info, err := globalMetaCache.GetCollectionInfo(ctx, dbName, "collection1")
 		assert.NoError(t, err)
 		assert.True(t, info.isLoaded)
 		// should access QueryCoord
@@ -583,21 +678,21 @@ func TestMetaCache_RemoveCollection(t *testing.T) {
 	err := InitMetaCache(ctx, rootCoord, queryCoord, shardMgr)
 	assert.Nil(t, err)
 
-	info, err := globalMetaCache.GetCollectionInfo(ctx, "collection1")
+	info, err := globalMetaCache.GetCollectionInfo(ctx, dbName, "collection1")
 	assert.NoError(t, err)
 	assert.True(t, info.isLoaded)
 	// no collectionInfo of collection1, should access RootCoord
 	assert.Equal(t, rootCoord.AccessCount, 1)
 
-	info, err = globalMetaCache.GetCollectionInfo(ctx, "collection1")
+	info, err = globalMetaCache.GetCollectionInfo(ctx, dbName, "collection1")
 	assert.NoError(t, err)
 	assert.True(t, info.isLoaded)
 	// shouldn't access RootCoord again
 	assert.Equal(t, rootCoord.AccessCount, 1)
 
-	globalMetaCache.RemoveCollection(ctx, "collection1")
+	globalMetaCache.RemoveCollection(ctx, dbName, "collection1")
 	// no collectionInfo of collection2, should access RootCoord
-	info, err = globalMetaCache.GetCollectionInfo(ctx, "collection1")
+	info, err = globalMetaCache.GetCollectionInfo(ctx, dbName, "collection1")
 	assert.NoError(t, err)
 	assert.True(t, info.isLoaded)
 	// shouldn't access RootCoord again
@@ -605,7 +700,7 @@ func TestMetaCache_RemoveCollection(t *testing.T) {
 
 	globalMetaCache.RemoveCollectionsByID(ctx, UniqueID(1))
 	// no collectionInfo of collection2, should access RootCoord
-	info, err = globalMetaCache.GetCollectionInfo(ctx, "collection1")
+	info, err = globalMetaCache.GetCollectionInfo(ctx, dbName, "collection1")
 	assert.NoError(t, err)
 	assert.True(t, info.isLoaded)
 	// shouldn't access RootCoord again
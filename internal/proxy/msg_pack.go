@@ -105,6 +105,7 @@ func assignSegmentID(ctx context.Context, insertMsg *msgstream.InsertMsg, result
 			SegmentID:      segmentID,
 			ShardName:      channelName,
 			Version:        internalpb.InsertDataVersion_ColumnBased,
+			SchemaVersion:  insertMsg.SchemaVersion,
 		}
 		insertReq.FieldsData = make([]*schemapb.FieldData, len(insertMsg.GetFieldsData()))
 
@@ -145,6 +146,7 @@ func assignSegmentID(ctx context.Context, insertMsg *msgstream.InsertMsg, result
 			}
 
 			typeutil.AppendFieldData(msg.FieldsData, insertMsg.GetFieldsData(), int64(offset))
+			msg.ValidData = typeutil.AppendValidData(msg.ValidData, insertMsg.GetValidData(), int64(offset))
 			msg.HashValues = append(msg.HashValues, insertMsg.HashValues[offset])
 			msg.Timestamps = append(msg.Timestamps, insertMsg.Timestamps[offset])
 			msg.RowIDs = append(msg.RowIDs, insertMsg.RowIDs[offset])
@@ -182,3 +184,69 @@ func assignSegmentID(ctx context.Context, insertMsg *msgstream.InsertMsg, result
 
 	return msgPack, nil
 }
+
+// assignSegmentIDByPartitionKey routes the rows of a partition key collection to the hash partitions,
+// then assigns segment id for the rows of every partition.
+func assignSegmentIDByPartitionKey(ctx context.Context, insertMsg *msgstream.InsertMsg, result *milvuspb.MutationResult, keyField *schemapb.FieldSchema, partitionNames []string, partitionIDs []UniqueID, channelNames []string, idAllocator *allocator.IDAllocator, segIDAssigner *segIDAssigner) (*msgstream.MsgPack, error) {
+	msgs, ids, err := repackInsertDataByPartitionKey(insertMsg, result.GetIDs(), keyField, partitionNames, partitionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	msgPack := &msgstream.MsgPack{
+		BeginTs: insertMsg.BeginTs(),
+		EndTs:   insertMsg.EndTs(),
+	}
+	for i, msg := range msgs {
+		pack, err := assignSegmentID(ctx, msg, &milvuspb.MutationResult{IDs: ids[i]}, channelNames, idAllocator, segIDAssigner)
+		if err != nil {
+			return nil, err
+		}
+		msgPack.Msgs = append(msgPack.Msgs, pack.Msgs...)
+	}
+	return msgPack, nil
+}
+
+// repackDeleteMsgByHash hashes the primary keys of deleteMsg to the given dml channels,
+// and repacks them into one DeleteMsg per channel.
+func repackDeleteMsgByHash(ctx context.Context, deleteMsg *msgstream.DeleteMsg, channelNames []string) []msgstream.TsMsg {
+	deleteMsg.HashValues = typeutil.HashPK2Channels(deleteMsg.PrimaryKeys, channelNames)
+
+	result := make(map[uint32]*msgstream.DeleteMsg)
+	proxyID := deleteMsg.Base.SourceID
+	for index, key := range deleteMsg.HashValues {
+		ts := deleteMsg.Timestamps[index]
+		curMsg, ok := result[key]
+		if !ok {
+			curMsg = &msgstream.DeleteMsg{
+				BaseMsg: msgstream.BaseMsg{
+					Ctx: ctx,
+				},
+				DeleteRequest: internalpb.DeleteRequest{
+					Base: commonpbutil.NewMsgBase(
+						commonpbutil.WithMsgType(commonpb.MsgType_Delete),
+						commonpbutil.WithMsgID(deleteMsg.Base.MsgID),
+						commonpbutil.WithTimeStamp(ts),
+						commonpbutil.WithSourceID(proxyID),
+					),
+					CollectionID:   deleteMsg.CollectionID,
+					PartitionID:    deleteMsg.PartitionID,
+					CollectionName: deleteMsg.CollectionName,
+					PartitionName:  deleteMsg.PartitionName,
+					PrimaryKeys:    &schemapb.IDs{},
+				},
+			}
+			result[key] = curMsg
+		}
+		curMsg.HashValues = append(curMsg.HashValues, deleteMsg.HashValues[index])
+		curMsg.Timestamps = append(curMsg.Timestamps, deleteMsg.Timestamps[index])
+		typeutil.AppendIDs(curMsg.PrimaryKeys, deleteMsg.PrimaryKeys, index)
+		curMsg.NumRows++
+	}
+
+	msgs := make([]msgstream.TsMsg, 0, len(result))
+	for _, msg := range result {
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
@@ -0,0 +1,194 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// errPartitionKeyModeNotSupported is returned when a request manually specifies partitions of a partition key collection.
+var errPartitionKeyModeNotSupported = errors.New("not support manually specifying the partition names if partition key mode is used")
+
+// isPartitionKeyMode returns true if the collection has a partition key field.
+func isPartitionKeyMode(ctx context.Context, dbName string, collectionName string) (bool, error) {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, collectionName)
+	if err != nil {
+		return false, err
+	}
+	return typeutil.HasPartitionKey(schema), nil
+}
+
+// getPartitionKeyPartitions returns the names and ids of the hash partitions of a partition key collection,
+// the i-th partition holds the rows whose partition key hashes to i.
+func getPartitionKeyPartitions(ctx context.Context, dbName string, collectionName string) ([]string, []UniqueID, error) {
+	partitions, err := globalMetaCache.GetPartitions(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(partitions) == 0 {
+		return nil, nil, fmt.Errorf("no partition found in partition key collection %s", collectionName)
+	}
+
+	defaultPartitionName := Params.CommonCfg.DefaultPartitionName.GetValue()
+	names := make([]string, len(partitions))
+	ids := make([]UniqueID, len(partitions))
+	for i := range names {
+		names[i] = fmt.Sprintf("%s_%d", defaultPartitionName, i)
+		partitionID, ok := partitions[names[i]]
+		if !ok {
+			return nil, nil, fmt.Errorf("partition %s not found in partition key collection %s", names[i], collectionName)
+		}
+		ids[i] = partitionID
+	}
+	return names, ids, nil
+}
+
+func hashInt64PartitionKey(v int64, numPartitions uint32) uint32 {
+	h, _ := typeutil.Hash32Int64(v)
+	return h % numPartitions
+}
+
+func hashStringPartitionKey(v string, numPartitions uint32) uint32 {
+	return typeutil.HashString2Uint32(v) % numPartitions
+}
+
+// hashPartitionKeys returns the index of the hash partition every row belongs to.
+func hashPartitionKeys(fieldData *schemapb.FieldData, numPartitions uint32) ([]uint32, error) {
+	switch fieldData.GetType() {
+	case schemapb.DataType_Int64:
+		data := fieldData.GetScalars().GetLongData().GetData()
+		indexes := make([]uint32, len(data))
+		for i, v := range data {
+			indexes[i] = hashInt64PartitionKey(v, numPartitions)
+		}
+		return indexes, nil
+	case schemapb.DataType_VarChar:
+		data := fieldData.GetScalars().GetStringData().GetData()
+		indexes := make([]uint32, len(data))
+		for i, v := range data {
+			indexes[i] = hashStringPartitionKey(v, numPartitions)
+		}
+		return indexes, nil
+	default:
+		return nil, fmt.Errorf("unsupported partition key type: %s", fieldData.GetType().String())
+	}
+}
+
+// hashPartitionKeyValue returns the index of the hash partition a filter value belongs to,
+// false is returned if the value can't be a partition key.
+func hashPartitionKeyValue(value *planpb.GenericValue, numPartitions uint32) (uint32, bool) {
+	switch v := value.GetVal().(type) {
+	case *planpb.GenericValue_Int64Val:
+		return hashInt64PartitionKey(v.Int64Val, numPartitions), true
+	case *planpb.GenericValue_StringVal:
+		return hashStringPartitionKey(v.StringVal, numPartitions), true
+	default:
+		return 0, false
+	}
+}
+
+// prunePartitionsByExpr returns the indexes of the hash partitions which may contain the rows matching the expression.
+// false is returned if the expression doesn't restrict the partition key, then all the partitions should be searched.
+func prunePartitionsByExpr(expr *planpb.Expr, keyFieldID int64, numPartitions uint32) (map[uint32]struct{}, bool) {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_UnaryRangeExpr:
+		if e.UnaryRangeExpr.GetColumnInfo().GetFieldId() != keyFieldID || e.UnaryRangeExpr.GetOp() != planpb.OpType_Equal {
+			return nil, false
+		}
+		index, ok := hashPartitionKeyValue(e.UnaryRangeExpr.GetValue(), numPartitions)
+		if !ok {
+			return nil, false
+		}
+		return map[uint32]struct{}{index: {}}, true
+	case *planpb.Expr_TermExpr:
+		if e.TermExpr.GetColumnInfo().GetFieldId() != keyFieldID {
+			return nil, false
+		}
+		indexes := make(map[uint32]struct{})
+		for _, value := range e.TermExpr.GetValues() {
+			index, ok := hashPartitionKeyValue(value, numPartitions)
+			if !ok {
+				return nil, false
+			}
+			indexes[index] = struct{}{}
+		}
+		return indexes, true
+	case *planpb.Expr_BinaryExpr:
+		left, leftOk := prunePartitionsByExpr(e.BinaryExpr.GetLeft(), keyFieldID, numPartitions)
+		right, rightOk := prunePartitionsByExpr(e.BinaryExpr.GetRight(), keyFieldID, numPartitions)
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			if !leftOk {
+				return right, rightOk
+			}
+			if !rightOk {
+				return left, leftOk
+			}
+			indexes := make(map[uint32]struct{})
+			for index := range left {
+				if _, ok := right[index]; ok {
+					indexes[index] = struct{}{}
+				}
+			}
+			return indexes, true
+		case planpb.BinaryExpr_LogicalOr:
+			if !leftOk || !rightOk {
+				return nil, false
+			}
+			for index := range right {
+				left[index] = struct{}{}
+			}
+			return left, true
+		default:
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+}
+
+// getPartitionIDsByExpr returns the ids of the hash partitions which may contain the rows matching the expression,
+// nil is returned if the partitions can't be pruned.
+func getPartitionIDsByExpr(ctx context.Context, dbName string, collectionName string, schema *schemapb.CollectionSchema, expr *planpb.Expr) ([]UniqueID, error) {
+	keyField, err := typeutil.GetPartitionKeyFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	_, partitionIDs, err := getPartitionKeyPartitions(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes, ok := prunePartitionsByExpr(expr, keyField.GetFieldID(), uint32(len(partitionIDs)))
+	// no partition matches means nothing could be found, leave it to the segcore.
+	if !ok || len(indexes) == 0 {
+		return nil, nil
+	}
+	ret := make([]UniqueID, 0, len(indexes))
+	for i, partitionID := range partitionIDs {
+		if _, ok := indexes[uint32(i)]; ok {
+			ret = append(ret, partitionID)
+		}
+	}
+	return ret, nil
+}
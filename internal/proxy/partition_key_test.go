@@ -0,0 +1,245 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func newPartitionKeyTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "partition_key_collection",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{
+				FieldID:  101,
+				Name:     "tenant",
+				DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: "max_length", Value: "64"},
+					{Key: common.PartitionKeyKey, Value: "true"},
+				},
+			},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+}
+
+func newPartitionKeyTestPartitions(numPartitions int) map[string]typeutil.UniqueID {
+	partitions := make(map[string]typeutil.UniqueID)
+	for i := 0; i < numPartitions; i++ {
+		partitions[fmt.Sprintf("%s_%d", Params.CommonCfg.DefaultPartitionName.GetValue(), i)] = typeutil.UniqueID(1000 + i)
+	}
+	return partitions
+}
+
+func TestGetPartitionKeyPartitions(t *testing.T) {
+	ctx := context.Background()
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	mockCache := newMockCache()
+	globalMetaCache = mockCache
+
+	mockCache.setGetPartitionsFunc(func(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
+		return nil, errors.New("mock")
+	})
+	_, _, err := getPartitionKeyPartitions(ctx, "", "coll")
+	assert.Error(t, err)
+
+	mockCache.setGetPartitionsFunc(func(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
+		return map[string]typeutil.UniqueID{}, nil
+	})
+	_, _, err = getPartitionKeyPartitions(ctx, "", "coll")
+	assert.Error(t, err)
+
+	mockCache.setGetPartitionsFunc(func(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
+		return map[string]typeutil.UniqueID{"p1": 1, "p2": 2}, nil
+	})
+	_, _, err = getPartitionKeyPartitions(ctx, "", "coll")
+	assert.Error(t, err)
+
+	mockCache.setGetPartitionsFunc(func(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
+		return newPartitionKeyTestPartitions(16), nil
+	})
+	names, ids, err := getPartitionKeyPartitions(ctx, "", "coll")
+	assert.NoError(t, err)
+	assert.Equal(t, 16, len(names))
+	assert.Equal(t, 16, len(ids))
+	for i := range ids {
+		assert.Equal(t, fmt.Sprintf("%s_%d", Params.CommonCfg.DefaultPartitionName.GetValue(), i), names[i])
+		assert.Equal(t, typeutil.UniqueID(1000+i), ids[i])
+	}
+}
+
+func TestHashPartitionKeys(t *testing.T) {
+	int64Data := &schemapb.FieldData{
+		Type: schemapb.DataType_Int64,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 1}}},
+			},
+		},
+	}
+	indexes, err := hashPartitionKeys(int64Data, 16)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(indexes))
+	assert.Equal(t, indexes[0], indexes[2])
+	for _, index := range indexes {
+		assert.Less(t, index, uint32(16))
+	}
+	// a filter value is hashed to the same partition as the inserted one
+	index, ok := hashPartitionKeyValue(&planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 2}}, 16)
+	assert.True(t, ok)
+	assert.Equal(t, indexes[1], index)
+
+	varCharData := &schemapb.FieldData{
+		Type: schemapb.DataType_VarChar,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b"}}},
+			},
+		},
+	}
+	indexes, err = hashPartitionKeys(varCharData, 16)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(indexes))
+	index, ok = hashPartitionKeyValue(&planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: "b"}}, 16)
+	assert.True(t, ok)
+	assert.Equal(t, indexes[1], index)
+
+	_, err = hashPartitionKeys(&schemapb.FieldData{Type: schemapb.DataType_Float}, 16)
+	assert.Error(t, err)
+	_, ok = hashPartitionKeyValue(&planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: 1.0}}, 16)
+	assert.False(t, ok)
+}
+
+func TestPrunePartitionsByExpr(t *testing.T) {
+	schema := newPartitionKeyTestSchema()
+	numPartitions := uint32(64)
+	keyFieldID := int64(101)
+	hash := func(keys ...string) map[uint32]struct{} {
+		ret := make(map[uint32]struct{})
+		for _, key := range keys {
+			ret[hashStringPartitionKey(key, numPartitions)] = struct{}{}
+		}
+		return ret
+	}
+
+	cases := []struct {
+		expr    string
+		ok      bool
+		indexes map[uint32]struct{}
+	}{
+		{`tenant == "a"`, true, hash("a")},
+		{`tenant in ["a", "b", "c"]`, true, hash("a", "b", "c")},
+		{`tenant == "a" && age > 10`, true, hash("a")},
+		{`age > 10 && tenant in ["a", "b"]`, true, hash("a", "b")},
+		{`tenant in ["a", "b"] && tenant == "b"`, true, hash("b")},
+		{`tenant == "a" || tenant == "b"`, true, hash("a", "b")},
+		{`tenant == "a" || age > 10`, false, nil},
+		{`tenant != "a"`, false, nil},
+		{`tenant > "a"`, false, nil},
+		{`age in [1, 2]`, false, nil},
+		{`not (tenant == "a")`, false, nil},
+	}
+	for _, c := range cases {
+		plan, err := planparserv2.CreateRetrievePlan(schema, c.expr)
+		assert.NoError(t, err, c.expr)
+		indexes, ok := prunePartitionsByExpr(plan.GetPredicates(), keyFieldID, numPartitions)
+		assert.Equal(t, c.ok, ok, c.expr)
+		if c.ok {
+			assert.Equal(t, c.indexes, indexes, c.expr)
+		}
+	}
+}
+
+func TestGetPartitionIDsByExpr(t *testing.T) {
+	ctx := context.Background()
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	mockCache := newMockCache()
+	mockCache.setGetPartitionsFunc(func(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
+		return newPartitionKeyTestPartitions(16), nil
+	})
+	globalMetaCache = mockCache
+
+	schema := newPartitionKeyTestSchema()
+	plan, err := planparserv2.CreateRetrievePlan(schema, `tenant == "a"`)
+	assert.NoError(t, err)
+	partitionIDs, err := getPartitionIDsByExpr(ctx, "", schema.GetName(), schema, plan.GetPredicates())
+	assert.NoError(t, err)
+	assert.Equal(t, []typeutil.UniqueID{typeutil.UniqueID(1000 + hashStringPartitionKey("a", 16))}, partitionIDs)
+
+	plan, err = planparserv2.CreateRetrievePlan(schema, `age > 10`)
+	assert.NoError(t, err)
+	partitionIDs, err = getPartitionIDsByExpr(ctx, "", schema.GetName(), schema, plan.GetPredicates())
+	assert.NoError(t, err)
+	assert.Nil(t, partitionIDs)
+
+	// collection without partition key
+	_, err = getPartitionIDsByExpr(ctx, "", schema.GetName(), &schemapb.CollectionSchema{}, plan.GetPredicates())
+	assert.Error(t, err)
+}
+
+func TestPartitionKeyMode_RejectPartitionOperations(t *testing.T) {
+	ctx := context.Background()
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	mockCache := newMockCache()
+	mockCache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		return newPartitionKeyTestSchema(), nil
+	})
+	globalMetaCache = mockCache
+
+	partitionKeyMode, err := isPartitionKeyMode(ctx, "", "partition_key_collection")
+	assert.NoError(t, err)
+	assert.True(t, partitionKeyMode)
+
+	cpt := &createPartitionTask{
+		CreatePartitionRequest: &milvuspb.CreatePartitionRequest{
+			Base:           &commonpb.MsgBase{},
+			CollectionName: "partition_key_collection",
+			PartitionName:  "p1",
+		},
+	}
+	assert.Error(t, cpt.PreExecute(ctx))
+
+	dpt := &dropPartitionTask{
+		DropPartitionRequest: &milvuspb.DropPartitionRequest{
+			Base:           &commonpb.MsgBase{},
+			CollectionName: "partition_key_collection",
+			PartitionName:  "p1",
+		},
+	}
+	assert.Error(t, dpt.PreExecute(ctx))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/casbin/casbin/v2/model"
 	jsonadapter "github.com/casbin/json-adapter/v2"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -97,6 +98,7 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 	policyInfo := strings.Join(globalMetaCache.GetPrivilegeInfo(ctx), ",")
 
 	log.Debug("current request info", zap.String("username", username), zap.Strings("role_names", roleNames),
+		zap.String("db", getDatabaseNameFromRequest(req)),
 		zap.String("object_type", objectType), zap.String("object_privilege", objectPrivilege),
 		zap.Int32("object_index", objectNameIndex), zap.String("object_name", objectName),
 		zap.Int32("object_indexs", objectNameIndexs), zap.Strings("object_names", objectNames),
@@ -112,14 +114,20 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 		log.Error("NewEnforcer fail", zap.String("policy", policy), zap.Error(err))
 		return ctx, err
 	}
+	dbName := getDatabaseNameFromRequest(req)
 	for _, roleName := range roleNames {
 		permitFunc := func(resName string) (bool, error) {
-			object := funcutil.PolicyForResource(objectType, resName)
-			isPermit, err := e.Enforce(roleName, object, objectPrivilege)
-			if err != nil {
-				return false, err
+			for _, name := range getPrivilegeResourceNames(objectType, dbName, resName) {
+				object := funcutil.PolicyForResource(objectType, name)
+				isPermit, err := e.Enforce(roleName, object, objectPrivilege)
+				if err != nil {
+					return false, err
+				}
+				if isPermit {
+					return true, nil
+				}
 			}
-			return isPermit, nil
+			return false, nil
 		}
 
 		if objectNameIndex != 0 {
@@ -156,6 +164,23 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 	return ctx, status.Error(codes.PermissionDenied, fmt.Sprintf("%s: permission deny", objectPrivilege))
 }
 
+// SubscriptionInterceptor authenticates the caller of a change subscription, and checks that it has the privilege
+// to query the collection. The subscriptions are streamed over HTTP, out of the reach of the gRPC interceptors.
+func SubscriptionInterceptor(ctx context.Context, dbName string, collectionName string) (context.Context, error) {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return ctx, nil
+	}
+	ctx, err := AuthenticationInterceptor(ctx)
+	if err != nil {
+		return ctx, status.Error(codes.Unauthenticated, err.Error())
+	}
+	// the changes are readable by whom is able to query the collection.
+	return PrivilegeInterceptor(ctx, &milvuspb.QueryRequest{
+		DbName:         dbName,
+		CollectionName: collectionName,
+	})
+}
+
 // isCurUserObject Determine whether it is an Object of type User that operates on its own user information,
 // like updating password or viewing your own role information.
 // make users operate their own user information when the related privileges are not granted.
@@ -165,3 +190,26 @@ func isCurUserObject(objectType string, curUser string, object string) bool {
 	}
 	return curUser == object
 }
+
+// getDatabaseNameFromRequest returns the database the request refers to, empty for the default database.
+func getDatabaseNameFromRequest(req interface{}) string {
+	if r, ok := req.(interface{ GetDbName() string }); ok {
+		return r.GetDbName()
+	}
+	return ""
+}
+
+// getPrivilegeResourceNames returns the names a privilege of the object may be granted on.
+// Collection privileges are scoped by database like "db1.col1",
+// the ones granted without database prefix belong to the default database.
+func getPrivilegeResourceNames(objectType string, dbName string, objectName string) []string {
+	if objectType != commonpb.ObjectType_Collection.String() {
+		return []string{objectName}
+	}
+	dbName = getDatabaseName(dbName)
+	names := []string{funcutil.CombineObjectName(dbName, objectName)}
+	if dbName == util.DefaultDBName {
+		names = append(names, objectName)
+	}
+	return names
+}
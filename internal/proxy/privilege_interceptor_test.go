@@ -11,6 +11,8 @@ import (
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestUnaryServerInterceptor(t *testing.T) {
@@ -53,13 +55,15 @@ func TestPrivilegeInterceptor(t *testing.T) {
 					ErrorCode: commonpb.ErrorCode_Success,
 				},
 				PolicyInfos: []string{
-					funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "col1", commonpb.ObjectPrivilege_PrivilegeLoad.String()),
-					funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "col1", commonpb.ObjectPrivilege_PrivilegeFlush.String()),
+					funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), funcutil.CombineObjectName("db_test", "col1"), commonpb.ObjectPrivilege_PrivilegeLoad.String()),
+					funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), funcutil.CombineObjectName("db_test", "col1"), commonpb.ObjectPrivilege_PrivilegeFlush.String()),
 					funcutil.PolicyForPrivilege("role2", commonpb.ObjectType_Global.String(), "*", commonpb.ObjectPrivilege_PrivilegeAll.String()),
+					funcutil.PolicyForPrivilege("role3", commonpb.ObjectType_Collection.String(), "col1", commonpb.ObjectPrivilege_PrivilegeLoad.String()),
 				},
 				UserRoles: []string{
 					funcutil.EncodeUserRoleCache("alice", "role1"),
 					funcutil.EncodeUserRoleCache("fooo", "role2"),
+					funcutil.EncodeUserRoleCache("bob", "role3"),
 				},
 			}, nil
 		}
@@ -89,6 +93,23 @@ func TestPrivilegeInterceptor(t *testing.T) {
 		})
 		assert.Nil(t, err)
 
+		_, err = PrivilegeInterceptor(ctx, &milvuspb.LoadCollectionRequest{
+			DbName:         "db_other",
+			CollectionName: "col1",
+		})
+		assert.NotNil(t, err)
+
+		// privileges granted without database prefix belong to the default database
+		_, err = PrivilegeInterceptor(GetContext(context.Background(), "bob:123456"), &milvuspb.LoadCollectionRequest{
+			CollectionName: "col1",
+		})
+		assert.Nil(t, err)
+		_, err = PrivilegeInterceptor(GetContext(context.Background(), "bob:123456"), &milvuspb.LoadCollectionRequest{
+			DbName:         "db_test",
+			CollectionName: "col1",
+		})
+		assert.NotNil(t, err)
+
 		_, err = PrivilegeInterceptor(GetContext(context.Background(), "foo:123456"), &milvuspb.LoadCollectionRequest{
 			DbName:         "db_test",
 			CollectionName: "col1",
@@ -133,3 +154,45 @@ func TestPrivilegeInterceptor(t *testing.T) {
 	})
 
 }
+
+func TestSubscriptionInterceptor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Authorization Disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "false")
+		_, err := SubscriptionInterceptor(ctx, "db_test", "col1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("Authorization Enabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		client := &MockRootCoordClientInterface{}
+		client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+			return &internalpb.ListPolicyResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+				PolicyInfos: []string{
+					funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), funcutil.CombineObjectName("db_test", "col1"), commonpb.ObjectPrivilege_PrivilegeQuery.String()),
+				},
+				UserRoles: []string{
+					funcutil.EncodeUserRoleCache("mockUser", "role1"),
+				},
+			}, nil
+		}
+		err := InitMetaCache(ctx, client, &MockQueryCoordClientInterface{}, newShardClientMgr())
+		assert.NoError(t, err)
+
+		_, err = SubscriptionInterceptor(ctx, "db_test", "col1")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, err = SubscriptionInterceptor(GetContext(ctx, "mockUser:wrongPass"), "db_test", "col1")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = SubscriptionInterceptor(GetContext(ctx, "mockUser:mockPass"), "db_test", "col1")
+		assert.NoError(t, err)
+		_, err = SubscriptionInterceptor(GetContext(ctx, "mockUser:mockPass"), "db_test", "col2")
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -260,6 +260,11 @@ func (node *Proxy) Init() error {
 	}
 	log.Debug("init meta cache done", zap.String("role", typeutil.ProxyRole))
 
+	if err := initJWTVerifier(); err != nil {
+		log.Warn("failed to init JWT verifier", zap.Error(err), zap.String("role", typeutil.ProxyRole))
+		return err
+	}
+
 	return nil
 }
 
@@ -19,7 +19,10 @@ package proxy
 import (
 	"fmt"
 
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // insertRepackFunc deprecated, use defaultInsertRepackFunc instead.
@@ -83,3 +86,75 @@ func defaultInsertRepackFunc(
 	}
 	return pack, nil
 }
+
+// repackInsertDataByPartitionKey splits the insert message of a partition key collection into one message
+// per hash partition, rows are routed by hashing their partition key. The primary keys are split along with
+// the rows so that every sub message could be assigned segments separately.
+func repackInsertDataByPartitionKey(
+	insertMsg *msgstream.InsertMsg,
+	ids *schemapb.IDs,
+	keyField *schemapb.FieldSchema,
+	partitionNames []string,
+	partitionIDs []UniqueID,
+) ([]*msgstream.InsertMsg, []*schemapb.IDs, error) {
+
+	keyData := typeutil.GetFieldDataByID(insertMsg.GetFieldsData(), keyField.GetFieldID())
+	if keyData == nil {
+		return nil, nil, fmt.Errorf("partition key field %s not found in insert data", keyField.GetName())
+	}
+	indexes, err := hashPartitionKeys(keyData, uint32(len(partitionIDs)))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(indexes) != len(insertMsg.GetTimestamps()) {
+		return nil, nil, fmt.Errorf(
+			"the number of partition keys (%d) is not equal to the number of rows (%d)",
+			len(indexes),
+			len(insertMsg.GetTimestamps()),
+		)
+	}
+
+	msgs := make([]*msgstream.InsertMsg, len(partitionIDs))
+	subIDs := make([]*schemapb.IDs, len(partitionIDs))
+	for offset, index := range indexes {
+		msg := msgs[index]
+		if msg == nil {
+			msg = &msgstream.InsertMsg{
+				BaseMsg: msgstream.BaseMsg{
+					Ctx:            insertMsg.TraceCtx(),
+					BeginTimestamp: insertMsg.BeginTimestamp,
+					EndTimestamp:   insertMsg.EndTimestamp,
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base:           insertMsg.GetBase(),
+					DbName:         insertMsg.GetDbName(),
+					CollectionName: insertMsg.GetCollectionName(),
+					PartitionName:  partitionNames[index],
+					CollectionID:   insertMsg.GetCollectionID(),
+					PartitionID:    partitionIDs[index],
+					Version:        internalpb.InsertDataVersion_ColumnBased,
+					FieldsData:     make([]*schemapb.FieldData, len(insertMsg.GetFieldsData())),
+					SchemaVersion:  insertMsg.GetSchemaVersion(),
+				},
+			}
+			msgs[index] = msg
+			subIDs[index] = &schemapb.IDs{}
+		}
+		typeutil.AppendFieldData(msg.FieldsData, insertMsg.GetFieldsData(), int64(offset))
+		msg.ValidData = typeutil.AppendValidData(msg.ValidData, insertMsg.GetValidData(), int64(offset))
+		msg.Timestamps = append(msg.Timestamps, insertMsg.Timestamps[offset])
+		msg.RowIDs = append(msg.RowIDs, insertMsg.RowIDs[offset])
+		msg.NumRows++
+		typeutil.AppendIDs(subIDs[index], ids, offset)
+	}
+
+	retMsgs := make([]*msgstream.InsertMsg, 0, len(msgs))
+	retIDs := make([]*schemapb.IDs, 0, len(msgs))
+	for i, msg := range msgs {
+		if msg != nil {
+			retMsgs = append(retMsgs, msg)
+			retIDs = append(retIDs, subIDs[i])
+		}
+	}
+	return retMsgs, retIDs, nil
+}
@@ -20,7 +20,9 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -194,3 +196,68 @@ func Test_defaultInsertRepackFunc(t *testing.T) {
 		assert.Equal(t, histogram[key], len(ret7[key].Msgs))
 	}
 }
+
+func Test_repackInsertDataByPartitionKey(t *testing.T) {
+	schema := newPartitionKeyTestSchema()
+	keyField := schema.GetFields()[1]
+	partitionNames := []string{"_default_0", "_default_1", "_default_2", "_default_3"}
+	partitionIDs := []UniqueID{1000, 1001, 1002, 1003}
+
+	pks := []int64{1, 2, 3, 4, 5, 6}
+	tenants := []string{"a", "b", "c", "a", "b", "c"}
+	insertMsg := &msgstream.InsertMsg{
+		BaseMsg: msgstream.BaseMsg{BeginTimestamp: 100, EndTimestamp: 100},
+		InsertRequest: internalpb.InsertRequest{
+			CollectionName: schema.GetName(),
+			CollectionID:   1,
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:    schemapb.DataType_Int64,
+					FieldId: 100,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+						},
+					},
+				},
+				{
+					Type:    schemapb.DataType_VarChar,
+					FieldId: 101,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: tenants}},
+						},
+					},
+				},
+			},
+			Timestamps: []uint64{100, 100, 100, 100, 100, 100},
+			RowIDs:     []int64{11, 12, 13, 14, 15, 16},
+			NumRows:    6,
+			Version:    internalpb.InsertDataVersion_ColumnBased,
+		},
+	}
+	ids := &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}}
+
+	msgs, subIDs, err := repackInsertDataByPartitionKey(insertMsg, ids, keyField, partitionNames, partitionIDs)
+	assert.NoError(t, err)
+	assert.Equal(t, len(msgs), len(subIDs))
+	numRows := 0
+	for i, msg := range msgs {
+		index := hashStringPartitionKey(msg.GetFieldsData()[1].GetScalars().GetStringData().GetData()[0], uint32(len(partitionIDs)))
+		assert.Equal(t, partitionIDs[index], msg.GetPartitionID())
+		assert.Equal(t, partitionNames[index], msg.GetPartitionName())
+		for _, tenant := range msg.GetFieldsData()[1].GetScalars().GetStringData().GetData() {
+			assert.Equal(t, index, hashStringPartitionKey(tenant, uint32(len(partitionIDs))))
+		}
+		assert.Equal(t, msg.GetFieldsData()[0].GetScalars().GetLongData().GetData(), subIDs[i].GetIntId().GetData())
+		assert.Equal(t, int(msg.GetNumRows()), len(msg.GetRowIDs()))
+		assert.Equal(t, int(msg.GetNumRows()), len(msg.GetTimestamps()))
+		numRows += int(msg.GetNumRows())
+	}
+	assert.Equal(t, 6, numRows)
+
+	// partition key field is missing
+	insertMsg.FieldsData = insertMsg.FieldsData[:1]
+	_, _, err = repackInsertDataByPartitionKey(insertMsg, ids, keyField, partitionNames, partitionIDs)
+	assert.Error(t, err)
+}
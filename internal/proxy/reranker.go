@@ -0,0 +1,117 @@
+package proxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+const (
+	RRFRerankName      = "rrf"
+	WeightedRerankName = "weighted"
+
+	defaultRRFParamK = 60
+)
+
+// reranker fuses the ranked results of the sub searches of a hybrid search into one ranked list,
+// the larger the fused score is, the higher the entity ranks.
+type reranker interface {
+	// fieldScore returns the score contributed by the hit ranked at rank (starting from 0)
+	// with the raw score in the idx-th sub search.
+	fieldScore(idx int, rank int, score float32) float32
+	// fuse returns the fused score of an entity by the field scores of all the sub searches,
+	// the field score of a sub search which doesn't hit the entity is 0.
+	fuse(fieldScores []float32) float32
+}
+
+// rrfReranker is the reciprocal rank fusion, which scores a hit by 1/(k+rank) and sums up the scores.
+type rrfReranker struct {
+	k float64
+}
+
+func (r *rrfReranker) fieldScore(idx int, rank int, score float32) float32 {
+	return float32(1 / (r.k + float64(rank+1)))
+}
+
+func (r *rrfReranker) fuse(fieldScores []float32) float32 {
+	var fused float32
+	for _, s := range fieldScores {
+		fused += s
+	}
+	return fused
+}
+
+// weightedReranker normalizes the raw scores of each sub search into [0, 1] and sums them up by weights.
+type weightedReranker struct {
+	weights     []float32
+	metricTypes []string
+}
+
+func (r *weightedReranker) fieldScore(idx int, rank int, score float32) float32 {
+	// the similarity of IP is within (-inf, +inf), the distances of the others are within [0, +inf)
+	if distance.PositivelyRelated(r.metricTypes[idx]) {
+		return float32(0.5 + math.Atan(float64(score))/math.Pi)
+	}
+	return float32(1 - 2*math.Atan(float64(score))/math.Pi)
+}
+
+func (r *weightedReranker) fuse(fieldScores []float32) float32 {
+	var fused float32
+	for i, s := range fieldScores {
+		fused += r.weights[i] * s
+	}
+	return fused
+}
+
+// newReranker creates the reranker specified by rerank and rerank_params in search params,
+// reciprocal rank fusion is used by default.
+func newReranker(searchParamsPair []*commonpb.KeyValuePair, metricTypes []string) (reranker, error) {
+	name, err := funcutil.GetAttrByKeyFromRepeatedKV(RerankKey, searchParamsPair)
+	if err != nil {
+		name = RRFRerankName
+	}
+	paramsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RerankParamsKey, searchParamsPair)
+	if err != nil {
+		paramsStr = "{}"
+	}
+
+	switch name {
+	case RRFRerankName:
+		params := struct {
+			K *float64 `json:"k"`
+		}{}
+		if err := json.Unmarshal([]byte(paramsStr), &params); err != nil {
+			return nil, fmt.Errorf("%s [%s] is invalid", RerankParamsKey, paramsStr)
+		}
+		k := float64(defaultRRFParamK)
+		if params.K != nil {
+			k = *params.K
+		}
+		if k <= 0 {
+			return nil, fmt.Errorf("%s [%s] is invalid, k of %s should be positive", RerankParamsKey, paramsStr, RRFRerankName)
+		}
+		return &rrfReranker{k: k}, nil
+	case WeightedRerankName:
+		params := struct {
+			Weights []float32 `json:"weights"`
+		}{}
+		if err := json.Unmarshal([]byte(paramsStr), &params); err != nil {
+			return nil, fmt.Errorf("%s [%s] is invalid", RerankParamsKey, paramsStr)
+		}
+		if len(params.Weights) != len(metricTypes) {
+			return nil, fmt.Errorf("%s [%s] is invalid, the number of weights should be %d", RerankParamsKey, paramsStr, len(metricTypes))
+		}
+		for _, w := range params.Weights {
+			if w < 0 || w > 1 {
+				return nil, fmt.Errorf("%s [%s] is invalid, weights should be in range [0, 1]", RerankParamsKey, paramsStr)
+			}
+		}
+		return &weightedReranker{weights: params.Weights, metricTypes: metricTypes}, nil
+	default:
+		return nil, fmt.Errorf("%s [%s] is invalid, should be %s or %s", RerankKey, name, RRFRerankName, WeightedRerankName)
+	}
+}
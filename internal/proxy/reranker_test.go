@@ -0,0 +1,74 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+)
+
+func TestNewReranker(t *testing.T) {
+	metricTypes := []string{distance.L2, distance.IP}
+
+	rr, err := newReranker(nil, metricTypes)
+	assert.NoError(t, err)
+	assert.Equal(t, &rrfReranker{k: defaultRRFParamK}, rr)
+
+	rr, err = newReranker([]*commonpb.KeyValuePair{
+		{Key: RerankKey, Value: RRFRerankName},
+		{Key: RerankParamsKey, Value: `{"k": 10}`},
+	}, metricTypes)
+	assert.NoError(t, err)
+	assert.Equal(t, &rrfReranker{k: 10}, rr)
+
+	_, err = newReranker([]*commonpb.KeyValuePair{
+		{Key: RerankKey, Value: RRFRerankName},
+		{Key: RerankParamsKey, Value: `{"k": 0}`},
+	}, metricTypes)
+	assert.Error(t, err)
+
+	rr, err = newReranker([]*commonpb.KeyValuePair{
+		{Key: RerankKey, Value: WeightedRerankName},
+		{Key: RerankParamsKey, Value: `{"weights": [0.7, 0.3]}`},
+	}, metricTypes)
+	assert.NoError(t, err)
+	assert.Equal(t, &weightedReranker{weights: []float32{0.7, 0.3}, metricTypes: metricTypes}, rr)
+
+	_, err = newReranker([]*commonpb.KeyValuePair{
+		{Key: RerankKey, Value: WeightedRerankName},
+		{Key: RerankParamsKey, Value: `{"weights": [0.7]}`},
+	}, metricTypes)
+	assert.Error(t, err)
+
+	_, err = newReranker([]*commonpb.KeyValuePair{
+		{Key: RerankKey, Value: WeightedRerankName},
+		{Key: RerankParamsKey, Value: `{"weights": [0.7, 1.3]}`},
+	}, metricTypes)
+	assert.Error(t, err)
+
+	_, err = newReranker([]*commonpb.KeyValuePair{
+		{Key: RerankKey, Value: WeightedRerankName},
+		{Key: RerankParamsKey, Value: "invalid"},
+	}, metricTypes)
+	assert.Error(t, err)
+
+	_, err = newReranker([]*commonpb.KeyValuePair{{Key: RerankKey, Value: "invalid"}}, metricTypes)
+	assert.Error(t, err)
+}
+
+func TestReranker_Score(t *testing.T) {
+	rrf := &rrfReranker{k: 60}
+	assert.InDelta(t, 1.0/61, rrf.fieldScore(0, 0, 0.5), 1e-6)
+	assert.InDelta(t, 1.0/63, rrf.fieldScore(1, 2, 0.5), 1e-6)
+	assert.InDelta(t, 1.0/61+1.0/63, rrf.fuse([]float32{1.0 / 61, 1.0 / 63}), 1e-6)
+
+	weighted := &weightedReranker{weights: []float32{0.5, 0.5}, metricTypes: []string{distance.L2, distance.IP}}
+	// distance 0 of L2 is the most similar, similarity 0 of IP is neutral
+	assert.InDelta(t, 1, weighted.fieldScore(0, 0, 0), 1e-6)
+	assert.InDelta(t, 0.5, weighted.fieldScore(1, 0, 0), 1e-6)
+	assert.Less(t, weighted.fieldScore(0, 0, 2), weighted.fieldScore(0, 0, 1))
+	assert.Greater(t, weighted.fieldScore(1, 0, 2), weighted.fieldScore(1, 0, 1))
+	assert.InDelta(t, 0.75, weighted.fuse([]float32{1, 0.5}), 1e-6)
+}
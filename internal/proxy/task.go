@@ -49,8 +49,23 @@ const (
 	RoundDecimalKey = "round_decimal"
 	OffsetKey       = "offset"
 	LimitKey        = "limit"
+	RadiusKey       = "radius"
+	RangeFilterKey  = "range_filter"
+
+	IteratorKey      = "iterator"
+	IteratorTokenKey = "iterator_token"
+
+	GroupByFieldKey = "group_by_field"
+
+	OrderByKey = "order_by"
+
+	HybridSearchKey = "hybrid_search"
+	HybridScoresKey = "hybrid_scores"
+	RerankKey       = "rerank"
+	RerankParamsKey = "rerank_params"
 
 	InsertTaskName             = "InsertTask"
+	UpsertTaskName             = "UpsertTask"
 	CreateCollectionTaskName   = "CreateCollectionTask"
 	DropCollectionTaskName     = "DropCollectionTask"
 	HasCollectionTaskName      = "HasCollectionTask"
@@ -70,6 +85,12 @@ const (
 	DropAliasTaskName          = "DropAliasTask"
 	AlterAliasTaskName         = "AlterAliasTask"
 	AlterCollectionTaskName    = "AlterCollectionTask"
+	CreateDatabaseTaskName     = "CreateDatabaseTask"
+	DropDatabaseTaskName       = "DropDatabaseTask"
+	ListDatabasesTaskName      = "ListDatabasesTask"
+	RenameCollectionTaskName   = "RenameCollectionTask"
+	TruncateCollectionTaskName = "TruncateCollectionTask"
+	CloneCollectionTaskName    = "CloneCollectionTask"
 
 	// minFloat32 minimum float.
 	minFloat32 = -1 * float32(math.MaxFloat32)
@@ -182,6 +203,11 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	// validate partition key definition
+	if err := validatePartitionKey(cct.schema); err != nil {
+		return err
+	}
+
 	// validate auto id definition
 	if err := ValidateFieldAutoID(cct.schema); err != nil {
 		return err
@@ -582,7 +608,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 		}
 		collectionIDs := make([]UniqueID, 0)
 		for _, collectionName := range sct.CollectionNames {
-			collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+			collectionID, err := globalMetaCache.GetCollectionID(ctx, sct.GetDbName(), collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -635,7 +661,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
 				return errors.New("failed to show collections")
 			}
-			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, sct.GetDbName(), collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection info.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -780,6 +806,14 @@ func (cpt *createPartitionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	partitionKeyMode, err := isPartitionKeyMode(ctx, cpt.GetDbName(), collName)
+	if err != nil {
+		return err
+	}
+	if partitionKeyMode {
+		return errors.New("disable create partition if partition key mode is used")
+	}
+
 	return nil
 }
 
@@ -858,11 +892,19 @@ func (dpt *dropPartitionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	collID, err := globalMetaCache.GetCollectionID(ctx, dpt.GetCollectionName())
+	partitionKeyMode, err := isPartitionKeyMode(ctx, dpt.GetDbName(), collName)
+	if err != nil {
+		return err
+	}
+	if partitionKeyMode {
+		return errors.New("disable drop partition if partition key mode is used")
+	}
+
+	collID, err := globalMetaCache.GetCollectionID(ctx, dpt.GetDbName(), dpt.GetCollectionName())
 	if err != nil {
 		return err
 	}
-	partID, err := globalMetaCache.GetPartitionID(ctx, dpt.GetCollectionName(), dpt.GetPartitionName())
+	partID, err := globalMetaCache.GetPartitionID(ctx, dpt.GetDbName(), dpt.GetCollectionName(), dpt.GetPartitionName())
 	if err != nil {
 		if err.Error() == ErrPartitionNotExist(dpt.GetPartitionName()).Error() {
 			return nil
@@ -1059,7 +1101,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 
 	if spt.GetType() == milvuspb.ShowType_InMemory {
 		collectionName := spt.CollectionName
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, spt.GetDbName(), collectionName)
 		if err != nil {
 			log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 				zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -1072,7 +1114,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 		}
 		partitionIDs := make([]UniqueID, 0)
 		for _, partitionName := range spt.PartitionNames {
-			partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, partitionName)
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, spt.GetDbName(), collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -1118,7 +1160,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
 				return errors.New("failed to show partitions")
 			}
-			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, collectionName, partitionName)
+			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, spt.GetDbName(), collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -1197,7 +1239,7 @@ func (ft *flushTask) Execute(ctx context.Context) error {
 	flushColl2Segments := make(map[string]*schemapb.LongArray)
 	coll2SealTimes := make(map[string]int64)
 	for _, collName := range ft.CollectionNames {
-		collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+		collID, err := globalMetaCache.GetCollectionID(ctx, ft.GetDbName(), collName)
 		if err != nil {
 			return err
 		}
@@ -1306,7 +1348,7 @@ func (lct *loadCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
-	collID, err := globalMetaCache.GetCollectionID(ctx, lct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lct.GetDbName(), lct.CollectionName)
 
 	log := log.Ctx(ctx).With(
 		zap.String("role", typeutil.ProxyRole),
@@ -1318,7 +1360,7 @@ func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 	}
 
 	lct.collectionID = collID
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.GetDbName(), lct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -1370,7 +1412,7 @@ func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 }
 
 func (lct *loadCollectionTask) PostExecute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, lct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lct.GetDbName(), lct.CollectionName)
 	log.Ctx(ctx).Debug("loadCollectionTask PostExecute",
 		zap.String("role", typeutil.ProxyRole),
 		zap.Int64("collectionID", collID))
@@ -1442,7 +1484,7 @@ func (rct *releaseCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (rct *releaseCollectionTask) Execute(ctx context.Context) (err error) {
-	collID, err := globalMetaCache.GetCollectionID(ctx, rct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rct.GetDbName(), rct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -1458,13 +1500,13 @@ func (rct *releaseCollectionTask) Execute(ctx context.Context) (err error) {
 
 	rct.result, err = rct.queryCoord.ReleaseCollection(ctx, request)
 
-	globalMetaCache.RemoveCollection(ctx, rct.CollectionName)
+	globalMetaCache.RemoveCollection(ctx, rct.GetDbName(), rct.CollectionName)
 
 	return err
 }
 
 func (rct *releaseCollectionTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.ClearShards(rct.CollectionName)
+	globalMetaCache.ClearShards(rct.GetDbName(), rct.CollectionName)
 	return nil
 }
 
@@ -1531,12 +1573,12 @@ func (lpt *loadPartitionsTask) PreExecute(ctx context.Context) error {
 
 func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.GetDbName(), lpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	lpt.collectionID = collID
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.CollectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.GetDbName(), lpt.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -1568,7 +1610,7 @@ func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 		return errors.New(errMsg)
 	}
 	for _, partitionName := range lpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.GetDbName(), lpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -1656,13 +1698,13 @@ func (rpt *releasePartitionsTask) PreExecute(ctx context.Context) error {
 
 func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.GetDbName(), rpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	rpt.collectionID = collID
 	for _, partitionName := range rpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.GetDbName(), rpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -1682,7 +1724,7 @@ func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 }
 
 func (rpt *releasePartitionsTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.ClearShards(rpt.CollectionName)
+	globalMetaCache.ClearShards(rpt.GetDbName(), rpt.CollectionName)
 	return nil
 }
 
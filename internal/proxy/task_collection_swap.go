@@ -0,0 +1,233 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// renameCollectionTask is the task to rename a collection
+type renameCollectionTask struct {
+	Condition
+	*rootcoordpb.RenameCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (t *renameCollectionTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *renameCollectionTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *renameCollectionTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *renameCollectionTask) Name() string {
+	return RenameCollectionTaskName
+}
+
+func (t *renameCollectionTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *renameCollectionTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *renameCollectionTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *renameCollectionTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *renameCollectionTask) OnEnqueue() error {
+	t.Base = commonpbutil.NewMsgBase()
+	return nil
+}
+
+func (t *renameCollectionTask) PreExecute(ctx context.Context) error {
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if err := validateCollectionName(t.GetOldName()); err != nil {
+		return err
+	}
+	if err := validateCollectionName(t.GetNewName()); err != nil {
+		return err
+	}
+	if t.GetOldName() == t.GetNewName() {
+		return fmt.Errorf("the new collection name is the same as the old one: %s", t.GetOldName())
+	}
+	return nil
+}
+
+func (t *renameCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.RenameCollection(ctx, t.RenameCollectionRequest)
+	return err
+}
+
+func (t *renameCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// truncateCollectionTask is the task to drop all the data of a collection,
+// the schema, indexes and partitions of the collection are kept.
+type truncateCollectionTask struct {
+	Condition
+	*rootcoordpb.TruncateCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (t *truncateCollectionTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *truncateCollectionTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *truncateCollectionTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *truncateCollectionTask) Name() string {
+	return TruncateCollectionTaskName
+}
+
+func (t *truncateCollectionTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *truncateCollectionTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *truncateCollectionTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *truncateCollectionTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *truncateCollectionTask) OnEnqueue() error {
+	t.Base = commonpbutil.NewMsgBase()
+	return nil
+}
+
+func (t *truncateCollectionTask) PreExecute(ctx context.Context) error {
+	t.Base.SourceID = paramtable.GetNodeID()
+	return validateCollectionName(t.GetCollectionName())
+}
+
+func (t *truncateCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.TruncateCollection(ctx, t.TruncateCollectionRequest)
+	return err
+}
+
+func (t *truncateCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// cloneCollectionTask is the task to create a collection from the flushed segments of another collection,
+// the binlogs are shared until the two collections diverge.
+type cloneCollectionTask struct {
+	Condition
+	*rootcoordpb.CloneCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (t *cloneCollectionTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *cloneCollectionTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *cloneCollectionTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *cloneCollectionTask) Name() string {
+	return CloneCollectionTaskName
+}
+
+func (t *cloneCollectionTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *cloneCollectionTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *cloneCollectionTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *cloneCollectionTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *cloneCollectionTask) OnEnqueue() error {
+	t.Base = commonpbutil.NewMsgBase()
+	return nil
+}
+
+func (t *cloneCollectionTask) PreExecute(ctx context.Context) error {
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if err := validateCollectionName(t.GetSourceCollectionName()); err != nil {
+		return err
+	}
+	if err := validateCollectionName(t.GetTargetCollectionName()); err != nil {
+		return err
+	}
+	if t.GetSourceCollectionName() == t.GetTargetCollectionName() {
+		return fmt.Errorf("the target collection name is the same as the source one: %s", t.GetSourceCollectionName())
+	}
+	return nil
+}
+
+func (t *cloneCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.CloneCollection(ctx, t.CloneCollectionRequest)
+	return err
+}
+
+func (t *cloneCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,101 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
+)
+
+func TestRenameCollectionTask(t *testing.T) {
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	task := &renameCollectionTask{
+		Condition:               NewTaskCondition(ctx),
+		RenameCollectionRequest: &rootcoordpb.RenameCollectionRequest{OldName: "coll_blue", NewName: "coll_green"},
+		ctx:                     ctx,
+		rootCoord:               rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	assert.Equal(t, RenameCollectionTaskName, task.Name())
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+	assert.NoError(t, task.PostExecute(ctx))
+
+	task.NewName = "coll_blue"
+	assert.Error(t, task.PreExecute(ctx))
+	task.NewName = "1coll"
+	assert.Error(t, task.PreExecute(ctx))
+	task.NewName = "coll_green"
+	task.OldName = ""
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestTruncateCollectionTask(t *testing.T) {
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	task := &truncateCollectionTask{
+		Condition:                 NewTaskCondition(ctx),
+		TruncateCollectionRequest: &rootcoordpb.TruncateCollectionRequest{CollectionName: "coll"},
+		ctx:                       ctx,
+		rootCoord:                 rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.Equal(t, TruncateCollectionTaskName, task.Name())
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+	assert.NoError(t, task.PostExecute(ctx))
+
+	task.CollectionName = ""
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestCloneCollectionTask(t *testing.T) {
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	task := &cloneCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		CloneCollectionRequest: &rootcoordpb.CloneCollectionRequest{
+			SourceCollectionName: "coll",
+			TargetCollectionName: "coll_clone",
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.Equal(t, CloneCollectionTaskName, task.Name())
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+	assert.NoError(t, task.PostExecute(ctx))
+
+	task.TargetCollectionName = "coll"
+	assert.Error(t, task.PreExecute(ctx))
+	task.TargetCollectionName = ""
+	assert.Error(t, task.PreExecute(ctx))
+}
@@ -0,0 +1,210 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// createDatabaseTask is the task to create a database
+type createDatabaseTask struct {
+	Condition
+	*rootcoordpb.CreateDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (t *createDatabaseTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *createDatabaseTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *createDatabaseTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *createDatabaseTask) Name() string {
+	return CreateDatabaseTaskName
+}
+
+func (t *createDatabaseTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *createDatabaseTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *createDatabaseTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *createDatabaseTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *createDatabaseTask) OnEnqueue() error {
+	t.Base = commonpbutil.NewMsgBase()
+	return nil
+}
+
+func (t *createDatabaseTask) PreExecute(ctx context.Context) error {
+	t.Base.SourceID = paramtable.GetNodeID()
+	return validateDatabaseName(t.GetDbName())
+}
+
+func (t *createDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.CreateDatabase(ctx, t.CreateDatabaseRequest)
+	return err
+}
+
+func (t *createDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// dropDatabaseTask is the task to drop a database
+type dropDatabaseTask struct {
+	Condition
+	*rootcoordpb.DropDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (t *dropDatabaseTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *dropDatabaseTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *dropDatabaseTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *dropDatabaseTask) Name() string {
+	return DropDatabaseTaskName
+}
+
+func (t *dropDatabaseTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *dropDatabaseTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *dropDatabaseTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *dropDatabaseTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *dropDatabaseTask) OnEnqueue() error {
+	t.Base = commonpbutil.NewMsgBase()
+	return nil
+}
+
+func (t *dropDatabaseTask) PreExecute(ctx context.Context) error {
+	t.Base.SourceID = paramtable.GetNodeID()
+	return validateDatabaseName(t.GetDbName())
+}
+
+func (t *dropDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.DropDatabase(ctx, t.DropDatabaseRequest)
+	return err
+}
+
+func (t *dropDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// listDatabasesTask is the task to list all databases
+type listDatabasesTask struct {
+	Condition
+	*rootcoordpb.ListDatabasesRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *rootcoordpb.ListDatabasesResponse
+}
+
+func (t *listDatabasesTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *listDatabasesTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *listDatabasesTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *listDatabasesTask) Name() string {
+	return ListDatabasesTaskName
+}
+
+func (t *listDatabasesTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *listDatabasesTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *listDatabasesTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *listDatabasesTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *listDatabasesTask) OnEnqueue() error {
+	t.Base = commonpbutil.NewMsgBase()
+	return nil
+}
+
+func (t *listDatabasesTask) PreExecute(ctx context.Context) error {
+	t.Base.SourceID = paramtable.GetNodeID()
+	return nil
+}
+
+func (t *listDatabasesTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.ListDatabases(ctx, t.ListDatabasesRequest)
+	return err
+}
+
+func (t *listDatabasesTask) PostExecute(ctx context.Context) error {
+	return nil
+}
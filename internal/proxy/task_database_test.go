@@ -0,0 +1,91 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
+)
+
+func TestCreateDatabaseTask(t *testing.T) {
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	task := &createDatabaseTask{
+		Condition:             NewTaskCondition(ctx),
+		CreateDatabaseRequest: &rootcoordpb.CreateDatabaseRequest{DbName: "db1"},
+		ctx:                   ctx,
+		rootCoord:             rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	assert.Equal(t, CreateDatabaseTaskName, task.Name())
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+	assert.NoError(t, task.PostExecute(ctx))
+
+	task.DbName = "1db"
+	assert.Error(t, task.PreExecute(ctx))
+	task.DbName = ""
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestDropDatabaseTask(t *testing.T) {
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	task := &dropDatabaseTask{
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: &rootcoordpb.DropDatabaseRequest{DbName: "db1"},
+		ctx:                 ctx,
+		rootCoord:           rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.Equal(t, DropDatabaseTaskName, task.Name())
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+	assert.NoError(t, task.PostExecute(ctx))
+
+	task.DbName = ""
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestListDatabasesTask(t *testing.T) {
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	task := &listDatabasesTask{
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: &rootcoordpb.ListDatabasesRequest{},
+		ctx:                  ctx,
+		rootCoord:            rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.Equal(t, ListDatabasesTaskName, task.Name())
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.NoError(t, task.Execute(ctx))
+	assert.Equal(t, []string{util.DefaultDBName}, task.result.GetDbNames())
+	assert.NoError(t, task.PostExecute(ctx))
+}
@@ -14,13 +14,11 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
-	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
-	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type BaseDeleteTask = msgstream.DeleteMsg
@@ -99,7 +97,7 @@ func (dt *deleteTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (dt *deleteTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(dt.ctx, dt.deleteMsg.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(dt.ctx, dt.deleteMsg.GetDbName(), dt.deleteMsg.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +170,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		log.Info("Invalid collection name", zap.String("collectionName", collName), zap.Error(err))
 		return err
 	}
-	collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dt.deleteMsg.GetDbName(), collName)
 	if err != nil {
 		log.Info("Failed to get collection id", zap.String("collectionName", collName), zap.Error(err))
 		return err
@@ -187,7 +185,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 			log.Info("Invalid partition name", zap.String("partitionName", partName), zap.Error(err))
 			return err
 		}
-		partID, err := globalMetaCache.GetPartitionID(ctx, collName, partName)
+		partID, err := globalMetaCache.GetPartitionID(ctx, dt.deleteMsg.GetDbName(), collName, partName)
 		if err != nil {
 			log.Info("Failed to get partition id", zap.String("collectionName", collName), zap.String("partitionName", partName), zap.Error(err))
 			return err
@@ -197,7 +195,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		dt.deleteMsg.PartitionID = common.InvalidPartitionID
 	}
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, collName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dt.deleteMsg.GetDbName(), collName)
 	if err != nil {
 		log.Info("Failed to get collection schema", zap.String("collectionName", collName), zap.Error(err))
 		return err
@@ -247,7 +245,6 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 		dt.result.Status.Reason = err.Error()
 		return err
 	}
-	dt.deleteMsg.HashValues = typeutil.HashPK2Channels(dt.result.IDs, channelNames)
 
 	log.Debug("send delete request to virtual channels",
 		zap.String("collection", dt.deleteMsg.GetCollectionName()),
@@ -257,53 +254,10 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 
 	tr.Record("get vchannels")
 	// repack delete msg by dmChannel
-	result := make(map[uint32]msgstream.TsMsg)
-	collectionName := dt.deleteMsg.CollectionName
-	collectionID := dt.deleteMsg.CollectionID
-	partitionID := dt.deleteMsg.PartitionID
-	partitionName := dt.deleteMsg.PartitionName
-	proxyID := dt.deleteMsg.Base.SourceID
-	for index, key := range dt.deleteMsg.HashValues {
-		ts := dt.deleteMsg.Timestamps[index]
-		_, ok := result[key]
-		if !ok {
-			sliceRequest := internalpb.DeleteRequest{
-				Base: commonpbutil.NewMsgBase(
-					commonpbutil.WithMsgType(commonpb.MsgType_Delete),
-					commonpbutil.WithMsgID(dt.deleteMsg.Base.MsgID),
-					commonpbutil.WithTimeStamp(ts),
-					commonpbutil.WithSourceID(proxyID),
-				),
-				CollectionID:   collectionID,
-				PartitionID:    partitionID,
-				CollectionName: collectionName,
-				PartitionName:  partitionName,
-				PrimaryKeys:    &schemapb.IDs{},
-			}
-			deleteMsg := &msgstream.DeleteMsg{
-				BaseMsg: msgstream.BaseMsg{
-					Ctx: ctx,
-				},
-				DeleteRequest: sliceRequest,
-			}
-			result[key] = deleteMsg
-		}
-		curMsg := result[key].(*msgstream.DeleteMsg)
-		curMsg.HashValues = append(curMsg.HashValues, dt.deleteMsg.HashValues[index])
-		curMsg.Timestamps = append(curMsg.Timestamps, dt.deleteMsg.Timestamps[index])
-		typeutil.AppendIDs(curMsg.PrimaryKeys, dt.deleteMsg.PrimaryKeys, index)
-		curMsg.NumRows++
-	}
-
-	// send delete request to log broker
 	msgPack := &msgstream.MsgPack{
 		BeginTs: dt.BeginTs(),
 		EndTs:   dt.EndTs(),
-	}
-	for _, msg := range result {
-		if msg != nil {
-			msgPack.Msgs = append(msgPack.Msgs, msg)
-		}
+		Msgs:    repackDeleteMsgByHash(ctx, dt.deleteMsg, channelNames),
 	}
 
 	tr.Record("pack messages")
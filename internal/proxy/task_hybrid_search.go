@@ -0,0 +1,429 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	HybridSearchTaskName = "HybridSearchTask"
+)
+
+// hybridSearchTask searches several vector fields of a collection by one ANN sub search for each of them,
+// and fuses the results of the sub searches into one ranked list by a reranker.
+//
+// The sub searches are specified by hybrid_search in search params, which is a json list of the search params
+// of each sub search, such as [{"anns_field": "text_vector", "metric_type": "IP", "params": "{\"nprobe\": 10}"}].
+// The placeholder group carries the query vectors of the sub searches in the same order. The expression,
+// partitions and output fields are shared by all the sub searches. The field scores of the sub searches are
+// returned in the response header, see setHybridScores.
+type hybridSearchTask struct {
+	Condition
+	ctx  context.Context
+	Base *commonpb.MsgBase
+
+	result   *milvuspb.SearchResults
+	request  *milvuspb.SearchRequest
+	qc       types.QueryCoord
+	tr       *timerecord.TimeRecorder
+	shardMgr *shardClientMgr
+
+	collectionName string
+	schema         *schemapb.CollectionSchema
+	topk           int64
+	offset         int64
+	annsFields     []string
+	reranker       reranker
+	subTasks       []*searchTask
+	// the field scores of each sub search, aligned with the hits of result
+	subScores [][]float32
+}
+
+// isHybridSearch returns whether the search params specify a hybrid search.
+func isHybridSearch(searchParamsPair []*commonpb.KeyValuePair) bool {
+	_, err := funcutil.GetAttrByKeyFromRepeatedKV(HybridSearchKey, searchParamsPair)
+	return err == nil
+}
+
+// setHybridScores returns the field scores of the sub searches to client in the response header, it is a json
+// object keyed by the anns fields, the scores of each anns field are aligned with the hits of the results.
+// They are kept out of the fields data so that the fields data only carry the output fields.
+func setHybridScores(ctx context.Context, annsFields []string, subScores [][]float32) {
+	if len(subScores) == 0 {
+		return
+	}
+	scores := make(map[string][]float32, len(annsFields))
+	for idx, annsField := range annsFields {
+		scores[annsField] = subScores[idx]
+	}
+	bs, err := json.Marshal(scores)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to marshal hybrid scores", zap.Error(err))
+		return
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(HybridScoresKey, string(bs))); err != nil {
+		log.Ctx(ctx).Warn("failed to set hybrid scores to response header", zap.Error(err))
+	}
+}
+
+// parseHybridSearchInfo returns the topk and offset of the fused results, and the search params of each sub search.
+func parseHybridSearchInfo(searchParamsPair []*commonpb.KeyValuePair) (int64, int64, [][]*commonpb.KeyValuePair, error) {
+	topKStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, searchParamsPair)
+	if err != nil {
+		return 0, 0, nil, errors.New(TopKKey + " not found in search_params")
+	}
+	topK, err := strconv.ParseInt(topKStr, 0, 64)
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("%s [%s] is invalid", TopKKey, topKStr)
+	}
+	var offset int64
+	offsetStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, searchParamsPair)
+	if err == nil {
+		offset, err = strconv.ParseInt(offsetStr, 0, 64)
+		if err != nil || offset < 0 {
+			return 0, 0, nil, fmt.Errorf("%s [%s] is invalid", OffsetKey, offsetStr)
+		}
+	}
+	if err := validateLimit(topK + offset); err != nil {
+		return 0, 0, nil, fmt.Errorf("%s+%s [%d] is invalid, %w", OffsetKey, TopKKey, topK+offset, err)
+	}
+
+	subSearchesStr, err := funcutil.GetAttrByKeyFromRepeatedKV(HybridSearchKey, searchParamsPair)
+	if err != nil {
+		return 0, 0, nil, errors.New(HybridSearchKey + " not found in search_params")
+	}
+	var subSearches []map[string]string
+	if err := json.Unmarshal([]byte(subSearchesStr), &subSearches); err != nil {
+		return 0, 0, nil, fmt.Errorf("%s [%s] is invalid, %w", HybridSearchKey, subSearchesStr, err)
+	}
+	if len(subSearches) == 0 {
+		return 0, 0, nil, fmt.Errorf("%s [%s] is invalid, no sub search specified", HybridSearchKey, subSearchesStr)
+	}
+
+	subSearchParams := make([][]*commonpb.KeyValuePair, 0, len(subSearches))
+	for _, subSearch := range subSearches {
+		if _, ok := subSearch[AnnsFieldKey]; !ok {
+			return 0, 0, nil, fmt.Errorf("%s not found in sub search of %s", AnnsFieldKey, HybridSearchKey)
+		}
+		for _, key := range []string{OffsetKey, IteratorKey, IteratorTokenKey, GroupByFieldKey} {
+			if _, ok := subSearch[key]; ok {
+				return 0, 0, nil, fmt.Errorf("%s isn't supported by the sub search of %s", key, HybridSearchKey)
+			}
+		}
+		// each sub search retrieves enough candidates for the fused results by default
+		if _, ok := subSearch[TopKKey]; !ok {
+			subSearch[TopKKey] = strconv.FormatInt(topK+offset, 10)
+		}
+		subSearchParams = append(subSearchParams, funcutil.Map2KeyValuePair(subSearch))
+	}
+	return topK, offset, subSearchParams, nil
+}
+
+func (t *hybridSearchTask) PreExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(t.TraceCtx(), "Proxy-HybridSearch-PreExecute")
+	defer sp.Finish()
+
+	t.collectionName = t.request.GetCollectionName()
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), t.collectionName)
+	if err != nil {
+		return err
+	}
+	t.schema = schema
+
+	topk, offset, subSearchParams, err := parseHybridSearchInfo(t.request.GetSearchParams())
+	if err != nil {
+		return err
+	}
+	t.topk, t.offset = topk, offset
+
+	placeholderGroup := &commonpb.PlaceholderGroup{}
+	if err := proto.Unmarshal(t.request.GetPlaceholderGroup(), placeholderGroup); err != nil {
+		return err
+	}
+	if len(placeholderGroup.GetPlaceholders()) != len(subSearchParams) {
+		return fmt.Errorf("the number of placeholders (%d) mis-match with the number of sub searches (%d)",
+			len(placeholderGroup.GetPlaceholders()), len(subSearchParams))
+	}
+
+	t.annsFields = make([]string, 0, len(subSearchParams))
+	metricTypes := make([]string, 0, len(subSearchParams))
+	t.subTasks = make([]*searchTask, 0, len(subSearchParams))
+	for i, params := range subSearchParams {
+		annsField, _ := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, params)
+		if funcutil.SliceContain(t.annsFields, annsField) {
+			return fmt.Errorf("%s [%s] is duplicated in %s", AnnsFieldKey, annsField, HybridSearchKey)
+		}
+		t.annsFields = append(t.annsFields, annsField)
+		metricType, _ := funcutil.GetAttrByKeyFromRepeatedKV(common.MetricTypeKey, params)
+		metricTypes = append(metricTypes, metricType)
+
+		placeholder := proto.Clone(placeholderGroup.GetPlaceholders()[i]).(*commonpb.PlaceholderValue)
+		// the query vectors are always tagged as $0 in search plan
+		placeholder.Tag = "$0"
+		subPlaceholderGroup, err := proto.Marshal(&commonpb.PlaceholderGroup{
+			Placeholders: []*commonpb.PlaceholderValue{placeholder},
+		})
+		if err != nil {
+			return err
+		}
+
+		subTask := &searchTask{
+			ctx:       t.ctx,
+			Condition: NewTaskCondition(t.ctx),
+			SearchRequest: &internalpb.SearchRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_Search),
+					commonpbutil.WithMsgID(t.ID()),
+					commonpbutil.WithTimeStamp(t.BeginTs()),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+				),
+				ReqID: paramtable.GetNodeID(),
+			},
+			request: &milvuspb.SearchRequest{
+				Base:               t.request.GetBase(),
+				DbName:             t.request.GetDbName(),
+				CollectionName:     t.request.GetCollectionName(),
+				PartitionNames:     t.request.GetPartitionNames(),
+				Dsl:                t.request.GetDsl(),
+				PlaceholderGroup:   subPlaceholderGroup,
+				DslType:            t.request.GetDslType(),
+				OutputFields:       append([]string{}, t.request.GetOutputFields()...),
+				SearchParams:       params,
+				TravelTimestamp:    t.request.GetTravelTimestamp(),
+				GuaranteeTimestamp: t.request.GetGuaranteeTimestamp(),
+			},
+			qc:       t.qc,
+			tr:       timerecord.NewTimeRecorder("search"),
+			shardMgr: t.shardMgr,
+		}
+		if err := subTask.PreExecute(ctx); err != nil {
+			return fmt.Errorf("invalid sub search on %s, %w", annsField, err)
+		}
+		if len(t.subTasks) > 0 && subTask.GetNq() != t.subTasks[0].GetNq() {
+			return fmt.Errorf("the number of query vectors of the sub searches mis-match, %d and %d",
+				t.subTasks[0].GetNq(), subTask.GetNq())
+		}
+		t.subTasks = append(t.subTasks, subTask)
+	}
+
+	t.reranker, err = newReranker(t.request.GetSearchParams(), metricTypes)
+	if err != nil {
+		return err
+	}
+
+	log.Ctx(ctx).Debug("hybrid search PreExecute done.",
+		zap.Strings("anns fields", t.annsFields), zap.Int64("topk", t.topk), zap.Int64("offset", t.offset))
+	return nil
+}
+
+func (t *hybridSearchTask) Execute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(t.TraceCtx(), "Proxy-HybridSearch-Execute")
+	defer sp.Finish()
+
+	group, ctx := errgroup.WithContext(ctx)
+	for _, subTask := range t.subTasks {
+		subTask := subTask
+		group.Go(func() error {
+			return subTask.Execute(ctx)
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return err
+	}
+
+	log.Ctx(ctx).Debug("hybrid search Execute done.")
+	return nil
+}
+
+func (t *hybridSearchTask) PostExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(t.TraceCtx(), "Proxy-HybridSearch-PostExecute")
+	defer sp.Finish()
+
+	tr := timerecord.NewTimeRecorder("hybridSearchTask PostExecute")
+	defer func() {
+		tr.CtxElapse(ctx, "done")
+	}()
+
+	subResults := make([]*schemapb.SearchResultData, 0, len(t.subTasks))
+	for _, subTask := range t.subTasks {
+		if err := subTask.PostExecute(ctx); err != nil {
+			return err
+		}
+		subResults = append(subResults, subTask.result.GetResults())
+	}
+
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(t.schema)
+	if err != nil {
+		return err
+	}
+	t.result, t.subScores, err = rerankSearchResultData(ctx, subResults, t.reranker,
+		t.subTasks[0].GetNq(), t.topk, t.offset, primaryFieldSchema.GetDataType())
+	if err != nil {
+		return err
+	}
+	t.result.CollectionName = t.collectionName
+
+	log.Ctx(ctx).Debug("hybrid search PostExecute done.")
+	return nil
+}
+
+// rerankSearchResultData fuses the results of the sub searches into topk results of each query, skipping the
+// first offset ones. The results of each sub search are scored by the reranker and sorted by the fused scores,
+// then they are merged by reduceSearchResultData. The field scores of each sub search are returned aligned with
+// the hits of the fused results.
+func rerankSearchResultData(ctx context.Context, subResults []*schemapb.SearchResultData, rr reranker,
+	nq int64, topk int64, offset int64, pkType schemapb.DataType) (*milvuspb.SearchResults, [][]float32, error) {
+	// the field scores of each entity in each query
+	fieldScores := make([]map[interface{}][]float32, nq)
+	for i := range fieldScores {
+		fieldScores[i] = make(map[interface{}][]float32)
+	}
+	for idx, data := range subResults {
+		var start int64
+		for qi := int64(0); qi < nq && qi < int64(len(data.GetTopks())); qi++ {
+			for rank := int64(0); rank < data.GetTopks()[qi]; rank++ {
+				id := typeutil.GetPK(data.GetIds(), start+rank)
+				scores, ok := fieldScores[qi][id]
+				if !ok {
+					scores = make([]float32, len(subResults))
+					fieldScores[qi][id] = scores
+				}
+				scores[idx] = rr.fieldScore(idx, int(rank), data.GetScores()[start+rank])
+			}
+			start += data.GetTopks()[qi]
+		}
+	}
+
+	sortedResults := make([]*schemapb.SearchResultData, 0, len(subResults))
+	for _, data := range subResults {
+		if len(data.GetScores()) == 0 {
+			continue
+		}
+		sorted := &schemapb.SearchResultData{
+			NumQueries: nq,
+			TopK:       topk + offset,
+			FieldsData: make([]*schemapb.FieldData, len(data.GetFieldsData())),
+			Scores:     make([]float32, 0, len(data.GetScores())),
+			Ids:        &schemapb.IDs{},
+			Topks:      make([]int64, 0, nq),
+		}
+
+		var start int64
+		for qi := int64(0); qi < nq; qi++ {
+			hits := make([]int64, data.GetTopks()[qi])
+			fused := make([]float32, data.GetTopks()[qi])
+			for k := range hits {
+				hits[k] = start + int64(k)
+				fused[k] = rr.fuse(fieldScores[qi][typeutil.GetPK(data.GetIds(), hits[k])])
+			}
+			sort.SliceStable(hits, func(a, b int) bool {
+				return fused[hits[a]-start] > fused[hits[b]-start]
+			})
+			for _, hit := range hits {
+				id := typeutil.GetPK(data.GetIds(), hit)
+				typeutil.AppendFieldData(sorted.FieldsData, data.GetFieldsData(), hit)
+				typeutil.AppendPKs(sorted.Ids, id)
+				sorted.Scores = append(sorted.Scores, fused[hit-start])
+			}
+			sorted.Topks = append(sorted.Topks, data.GetTopks()[qi])
+			start += data.GetTopks()[qi]
+		}
+
+		sortedResults = append(sortedResults, sorted)
+	}
+
+	if len(sortedResults) == 0 {
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+				Reason:    "search result is empty",
+			},
+			Results: &schemapb.SearchResultData{
+				NumQueries: nq,
+				Topks:      make([]int64, nq),
+			},
+		}, nil, nil
+	}
+	// the fused scores are similarities, the larger the better, as scores of IP
+	result, err := reduceSearchResultData(ctx, sortedResults, nq, topk+offset, distance.IP, pkType, offset, 0, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subScores := make([][]float32, len(subResults))
+	var start int64
+	for qi, hits := range result.GetResults().GetTopks() {
+		for k := start; k < start+hits; k++ {
+			id := typeutil.GetPK(result.GetResults().GetIds(), k)
+			for idx, score := range fieldScores[qi][id] {
+				subScores[idx] = append(subScores[idx], score)
+			}
+		}
+		start += hits
+	}
+	return result, subScores, nil
+}
+
+func (t *hybridSearchTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *hybridSearchTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *hybridSearchTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *hybridSearchTask) Name() string {
+	return HybridSearchTaskName
+}
+
+func (t *hybridSearchTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *hybridSearchTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *hybridSearchTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *hybridSearchTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *hybridSearchTask) OnEnqueue() error {
+	t.Base = commonpbutil.NewMsgBase()
+	t.Base.MsgType = commonpb.MsgType_Search
+	t.Base.SourceID = paramtable.GetNodeID()
+	return nil
+}
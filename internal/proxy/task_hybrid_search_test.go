@@ -0,0 +1,107 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+func TestHybridSearch_parseHybridSearchInfo(t *testing.T) {
+	assert.False(t, isHybridSearch(getValidSearchParams()))
+
+	params := []*commonpb.KeyValuePair{
+		{Key: TopKKey, Value: "10"},
+		{Key: OffsetKey, Value: "5"},
+		{Key: HybridSearchKey, Value: `[{"anns_field": "a", "metric_type": "L2"}, {"anns_field": "b", "metric_type": "IP", "topk": "100"}]`},
+	}
+	assert.True(t, isHybridSearch(params))
+	topk, offset, subSearchParams, err := parseHybridSearchInfo(params)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), topk)
+	assert.Equal(t, int64(5), offset)
+	assert.Equal(t, 2, len(subSearchParams))
+	assert.Equal(t, map[string]string{AnnsFieldKey: "a", "metric_type": "L2", TopKKey: "15"},
+		funcutil.KeyValuePair2Map(subSearchParams[0]))
+	assert.Equal(t, map[string]string{AnnsFieldKey: "b", "metric_type": "IP", TopKKey: "100"},
+		funcutil.KeyValuePair2Map(subSearchParams[1]))
+
+	invalidCases := [][]*commonpb.KeyValuePair{
+		{{Key: HybridSearchKey, Value: `[{"anns_field": "a"}]`}},
+		{{Key: TopKKey, Value: "invalid"}, {Key: HybridSearchKey, Value: `[{"anns_field": "a"}]`}},
+		{{Key: TopKKey, Value: "10"}, {Key: OffsetKey, Value: "-1"}, {Key: HybridSearchKey, Value: `[{"anns_field": "a"}]`}},
+		{{Key: TopKKey, Value: "20000"}, {Key: HybridSearchKey, Value: `[{"anns_field": "a"}]`}},
+		{{Key: TopKKey, Value: "10"}},
+		{{Key: TopKKey, Value: "10"}, {Key: HybridSearchKey, Value: "invalid"}},
+		{{Key: TopKKey, Value: "10"}, {Key: HybridSearchKey, Value: "[]"}},
+		{{Key: TopKKey, Value: "10"}, {Key: HybridSearchKey, Value: `[{"metric_type": "L2"}]`}},
+		{{Key: TopKKey, Value: "10"}, {Key: HybridSearchKey, Value: `[{"anns_field": "a", "group_by_field": "b"}]`}},
+	}
+	for _, params := range invalidCases {
+		_, _, _, err := parseHybridSearchInfo(params)
+		assert.Error(t, err)
+	}
+}
+
+func TestHybridSearch_rerankSearchResultData(t *testing.T) {
+	genResultData := func(ids []int64, scores []float32) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       int64(len(ids)),
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+			Scores:     scores,
+			Topks:      []int64{int64(len(ids))},
+		}
+	}
+	subResults := []*schemapb.SearchResultData{
+		genResultData([]int64{1, 2}, []float32{0.1, 0.2}),
+		genResultData([]int64{3, 1, 4}, []float32{0.9, 0.8, 0.7}),
+	}
+	rr := &rrfReranker{k: 60}
+
+	t.Run("rrf", func(t *testing.T) {
+		result, subScores, err := rerankSearchResultData(context.TODO(), subResults, rr, 1, 3, 0, schemapb.DataType_Int64)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 3, 2}, result.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{3}, result.GetResults().GetTopks())
+		assert.InDeltaSlice(t, []float32{1.0/61 + 1.0/62, 1.0 / 61, 1.0 / 62}, result.GetResults().GetScores(), 1e-6)
+		// the field scores are kept out of the fields data
+		assert.Empty(t, result.GetResults().GetFieldsData())
+
+		assert.Equal(t, 2, len(subScores))
+		assert.InDeltaSlice(t, []float32{1.0 / 61, 0, 1.0 / 62}, subScores[0], 1e-6)
+		assert.InDeltaSlice(t, []float32{1.0 / 62, 1.0 / 61, 0}, subScores[1], 1e-6)
+	})
+
+	t.Run("offset", func(t *testing.T) {
+		result, subScores, err := rerankSearchResultData(context.TODO(), subResults, rr, 1, 2, 1, schemapb.DataType_Int64)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3, 2}, result.GetResults().GetIds().GetIntId().GetData())
+		assert.InDeltaSlice(t, []float32{0, 1.0 / 62}, subScores[0], 1e-6)
+		assert.InDeltaSlice(t, []float32{1.0 / 61, 0}, subScores[1], 1e-6)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		empty := &schemapb.SearchResultData{NumQueries: 1, Topks: []int64{0}}
+		result, subScores, err := rerankSearchResultData(context.TODO(), []*schemapb.SearchResultData{empty, empty}, rr, 1, 3, 0, schemapb.DataType_Int64)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{0}, result.GetResults().GetTopks())
+		assert.Nil(t, subScores)
+	})
+}
+
+func TestHybridSearch_setHybridScores(t *testing.T) {
+	stream := &headerRecorder{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+	setHybridScores(ctx, []string{"a", "b"}, nil)
+	assert.Empty(t, stream.md.Get(HybridScoresKey))
+
+	setHybridScores(ctx, []string{"a", "b"}, [][]float32{{0.5, 0}, {0.25, 1}})
+	assert.Equal(t, []string{`{"a":[0.5,0],"b":[0.25,1]}`}, stream.md.Get(HybridScoresKey))
+}
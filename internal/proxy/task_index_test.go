@@ -27,9 +27,11 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -295,3 +297,25 @@ func TestCreateIndexTask_PreExecute(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestCreateIndexTask_parseIndexParams_Encryption(t *testing.T) {
+	paramtable.Get().Save(Params.CommonCfg.EncryptionEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.EncryptionEnabled.Key)
+
+	cit := createIndexTask{
+		req: &milvuspb.CreateIndexRequest{
+			ExtraParams: []*commonpb.KeyValuePair{
+				{Key: common.IndexTypeKey, Value: indexparamcheck.IndexDISKANN},
+				{Key: "metric_type", Value: "L2"},
+			},
+		},
+		fieldSchema: &schemapb.FieldSchema{
+			FieldID:    101,
+			Name:       "vec",
+			DataType:   schemapb.DataType_FloatVector,
+			TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "128"}},
+		},
+	}
+	err := cit.parseIndexParams()
+	assert.Error(t, err)
+}
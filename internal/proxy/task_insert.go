@@ -15,6 +15,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
 
@@ -89,7 +90,7 @@ func (it *insertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (it *insertTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.insertMsg.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.insertMsg.GetDbName(), it.insertMsg.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -120,19 +121,29 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	partitionTag := it.insertMsg.PartitionName
-	if err := validatePartitionTag(partitionTag, true); err != nil {
-		log.Error("valid partition name failed", zap.String("partition name", partitionTag), zap.Error(err))
-		return err
-	}
-
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, it.insertMsg.GetDbName(), collectionName)
 	if err != nil {
 		log.Error("get collection schema from global meta cache failed", zap.String("collectionName", collectionName), zap.Error(err))
 		return err
 	}
 	it.schema = collSchema
 
+	// the rows of a partition key collection are routed to partitions by their partition key
+	if typeutil.HasPartitionKey(collSchema) {
+		if len(it.insertMsg.PartitionName) > 0 {
+			return errPartitionKeyModeNotSupported
+		}
+	} else {
+		if len(it.insertMsg.PartitionName) <= 0 {
+			it.insertMsg.PartitionName = Params.CommonCfg.DefaultPartitionName.GetValue()
+		}
+		partitionTag := it.insertMsg.PartitionName
+		if err := validatePartitionTag(partitionTag, true); err != nil {
+			log.Error("valid partition name failed", zap.String("partition name", partitionTag), zap.Error(err))
+			return err
+		}
+	}
+
 	rowNums := uint32(it.insertMsg.NRows())
 	// set insertTask.rowIDs
 	var rowIDBegin UniqueID
@@ -160,11 +171,25 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 	}
 	it.result.SuccIndex = sliceIndex
 
+	log := log.Ctx(ctx).With(zap.String("collectionName", collectionName))
+	// fill the nullable or defaulted fields absent in the request, the fields could be added after the client cached the schema,
+	// the rows of the absent nullable fields without default value are null.
+	it.insertMsg.ValidData = typeutil.FillMissingValidData(collSchema, it.insertMsg.GetFieldsData(), it.insertMsg.GetValidData(), int(rowNum))
+	it.insertMsg.FieldsData, err = typeutil.FillMissingFieldData(collSchema, it.insertMsg.GetFieldsData(), int(rowNum))
+	if err != nil {
+		log.Error("fill default values of absent fields failed", zap.Error(err))
+		return err
+	}
+	it.insertMsg.SchemaVersion, err = globalMetaCache.GetCollectionSchemaVersion(ctx, it.insertMsg.GetDbName(), collectionName)
+	if err != nil {
+		log.Error("get collection schema version from global meta cache failed", zap.Error(err))
+		return err
+	}
+
 	// check primaryFieldData whether autoID is true or not
 	// set rowIDs as primary data if autoID == true
 	// TODO(dragondriver): in fact, NumRows is not trustable, we should check all input fields
 	it.result.IDs, err = checkPrimaryFieldData(it.schema, it.insertMsg)
-	log := log.Ctx(ctx).With(zap.String("collectionName", collectionName))
 	if err != nil {
 		log.Error("check primary field data and hash primary key failed",
 			zap.Error(err))
@@ -179,6 +204,18 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err = validateJSONFieldData(it.insertMsg.GetFieldsData()); err != nil {
+		log.Error("invalid JSON field data",
+			zap.Error(err))
+		return err
+	}
+
+	if err = validateArrayFieldData(it.insertMsg.GetFieldsData(), collSchema); err != nil {
+		log.Error("invalid array field data",
+			zap.Error(err))
+		return err
+	}
+
 	// check that all field's number rows are equal
 	if err = it.insertMsg.CheckAligned(); err != nil {
 		log.Error("field data is not aligned",
@@ -199,19 +236,27 @@ func (it *insertTask) Execute(ctx context.Context) error {
 	defer tr.Elapse("insert execute done")
 
 	collectionName := it.insertMsg.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, it.insertMsg.GetDbName(), collectionName)
 	if err != nil {
 		return err
 	}
 	it.insertMsg.CollectionID = collID
+	keyField, _ := typeutil.GetPartitionKeyFieldSchema(it.schema)
 	var partitionID UniqueID
-	if len(it.insertMsg.PartitionName) > 0 {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, it.insertMsg.PartitionName)
+	var partitionNames []string
+	var partitionIDs []UniqueID
+	if keyField != nil {
+		partitionNames, partitionIDs, err = getPartitionKeyPartitions(ctx, it.insertMsg.GetDbName(), collectionName)
+		if err != nil {
+			return err
+		}
+	} else if len(it.insertMsg.PartitionName) > 0 {
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.insertMsg.GetDbName(), collectionName, it.insertMsg.PartitionName)
 		if err != nil {
 			return err
 		}
 	} else {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, Params.CommonCfg.DefaultPartitionName.GetValue())
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.insertMsg.GetDbName(), collectionName, Params.CommonCfg.DefaultPartitionName.GetValue())
 		if err != nil {
 			return err
 		}
@@ -245,7 +290,11 @@ func (it *insertTask) Execute(ctx context.Context) error {
 
 	// assign segmentID for insert data and repack data by segmentID
 	var msgPack *msgstream.MsgPack
-	msgPack, err = assignSegmentID(it.TraceCtx(), it.insertMsg, it.result, channelNames, it.idAllocator, it.segIDAssigner)
+	if keyField != nil {
+		msgPack, err = assignSegmentIDByPartitionKey(it.TraceCtx(), it.insertMsg, it.result, keyField, partitionNames, partitionIDs, channelNames, it.idAllocator, it.segIDAssigner)
+	} else {
+		msgPack, err = assignSegmentID(it.TraceCtx(), it.insertMsg, it.result, channelNames, it.idAllocator, it.segIDAssigner)
+	}
 	if err != nil {
 		log.Error("assign segmentID and repack insert data failed",
 			zap.Int64("collectionID", collID),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 )
 
@@ -53,6 +55,12 @@ type queryTask struct {
 	queryParams    *queryParams
 	schema         *schemapb.CollectionSchema
 
+	iterator       bool
+	iteratorCursor *iteratorCursor
+	iteratorToken  string
+
+	orderByOnlyFieldIDs typeutil.UniqueSet
+
 	resultBuf       chan *internalpb.RetrieveResults
 	toReduceResults []*internalpb.RetrieveResults
 
@@ -63,6 +71,14 @@ type queryTask struct {
 type queryParams struct {
 	limit  int64
 	offset int64
+	// orderBy are parsed from query params, orderByFields are resolved by schema in PreExecute
+	orderBy       []*orderByParam
+	orderByFields []*internalpb.OrderByField
+}
+
+type orderByParam struct {
+	fieldName string
+	ascending bool
 }
 
 // translateOutputFields translates output fields name to output fields id.
@@ -112,6 +128,85 @@ func translateToOutputFieldIDs(outputFields []string, schema *schemapb.Collectio
 	return outputFieldIDs, nil
 }
 
+// aggregatePattern matches the aggregates in the output fields, such as count(*) and avg(score).
+var aggregatePattern = regexp.MustCompile(`^\s*([a-zA-Z]+)\s*\(\s*([^()\s]+)\s*\)\s*$`)
+
+// parseAggregates parses the aggregates in the output fields, nil if there isn't any. The output fields other than
+// the aggregates could only be the group by field.
+func parseAggregates(outputFields []string, groupByField *schemapb.FieldSchema, schema *schemapb.CollectionSchema) ([]*internalpb.Aggregate, error) {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	var aggregates []*internalpb.Aggregate
+	var others []string
+	for _, outputField := range outputFields {
+		matches := aggregatePattern.FindStringSubmatch(outputField)
+		if matches == nil {
+			others = append(others, outputField)
+			continue
+		}
+		aggregateType, ok := internalpb.AggregateType(-1), false
+		for name, value := range internalpb.AggregateType_value {
+			if strings.EqualFold(name, matches[1]) {
+				aggregateType, ok = internalpb.AggregateType(value), true
+			}
+		}
+		if !ok {
+			return nil, fmt.Errorf("unknown aggregate %s in output fields", outputField)
+		}
+
+		aggregate := &internalpb.Aggregate{Type: aggregateType}
+		fieldName := matches[2]
+		if aggregateType == internalpb.AggregateType_Count {
+			if fieldName != "*" {
+				return nil, fmt.Errorf("only count(*) is supported, but got %s", outputField)
+			}
+		} else {
+			field, err := helper.GetFieldFromName(fieldName)
+			if err != nil {
+				return nil, err
+			}
+			aggregate.FieldId = field.GetFieldID()
+		}
+		aggregates = append(aggregates, aggregate)
+	}
+
+	if len(aggregates) == 0 {
+		if groupByField != nil {
+			return nil, fmt.Errorf("%s requires aggregates in output fields", GroupByFieldKey)
+		}
+		return nil, nil
+	}
+	for _, other := range others {
+		if groupByField == nil || other != groupByField.GetName() {
+			return nil, fmt.Errorf("output field %s is neither an aggregate nor the %s", other, GroupByFieldKey)
+		}
+	}
+	return aggregates, nil
+}
+
+// getAggregatedFieldIDs returns the ids of the fields retrieved to compute the aggregates.
+func getAggregatedFieldIDs(aggregates []*internalpb.Aggregate, groupByField *schemapb.FieldSchema, schema *schemapb.CollectionSchema) ([]UniqueID, error) {
+	fieldIDs := typeutil.NewUniqueSet()
+	for _, aggregate := range aggregates {
+		if aggregate.GetType() != internalpb.AggregateType_Count {
+			fieldIDs.Insert(aggregate.GetFieldId())
+		}
+	}
+	if groupByField != nil {
+		fieldIDs.Insert(groupByField.GetFieldID())
+	}
+	// the primary keys and timestamps are retrieved to deduplicate the rows of different segments,
+	// count(*) counts the retrieved primary keys
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	fieldIDs.Insert(pkField.GetFieldID(), common.TimeStampField)
+	return fieldIDs.Collect(), nil
+}
+
 func filterSystemFields(outputFieldIDs []UniqueID) []UniqueID {
 	filtered := make([]UniqueID, 0, len(outputFieldIDs))
 	for _, outputFieldID := range outputFieldIDs {
@@ -122,18 +217,77 @@ func filterSystemFields(outputFieldIDs []UniqueID) []UniqueID {
 	return filtered
 }
 
-// parseQueryParams get limit and offset from queryParamsPair, both are optional.
+// parseOrderByParams parses the order by keys like "age desc, name", the keys are in ascending order by default.
+func parseOrderByParams(orderByStr string) ([]*orderByParam, error) {
+	var params []*orderByParam
+	fieldNames := make(map[string]struct{})
+	for _, key := range strings.Split(orderByStr, ",") {
+		words := strings.Fields(key)
+		if len(words) == 0 || len(words) > 2 {
+			return nil, fmt.Errorf("%s [%s] is invalid, key [%s] should be a field name followed by an optional asc or desc", OrderByKey, orderByStr, strings.TrimSpace(key))
+		}
+		param := &orderByParam{fieldName: words[0], ascending: true}
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				param.ascending = false
+			default:
+				return nil, fmt.Errorf("%s [%s] is invalid, unknown order %s", OrderByKey, orderByStr, words[1])
+			}
+		}
+		if _, ok := fieldNames[param.fieldName]; ok {
+			return nil, fmt.Errorf("%s [%s] is invalid, duplicated field %s", OrderByKey, orderByStr, param.fieldName)
+		}
+		fieldNames[param.fieldName] = struct{}{}
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+// resolveOrderByFields returns the order by fields of the order by params.
+func resolveOrderByFields(params []*orderByParam, schema *schemapb.CollectionSchema) ([]*internalpb.OrderByField, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]*internalpb.OrderByField, 0, len(params))
+	for _, param := range params {
+		field, err := helper.GetFieldFromName(param.fieldName)
+		if err != nil {
+			return nil, fmt.Errorf("%s is invalid, %w", OrderByKey, err)
+		}
+		if !typeutil.IsOrderableType(field.GetDataType()) {
+			return nil, fmt.Errorf("%s is invalid, field %s of type %s can't be ordered by", OrderByKey, field.GetName(), field.GetDataType().String())
+		}
+		fields = append(fields, &internalpb.OrderByField{FieldId: field.GetFieldID(), Ascending: param.ascending})
+	}
+	return fields, nil
+}
+
+// parseQueryParams get limit, offset and order by keys from queryParamsPair, all of them are optional.
 func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, error) {
 	var (
-		limit  int64
-		offset int64
-		err    error
+		limit   int64
+		offset  int64
+		orderBy []*orderByParam
+		err     error
 	)
 
+	orderByStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OrderByKey, queryParamsPair)
+	if err == nil {
+		if orderBy, err = parseOrderByParams(orderByStr); err != nil {
+			return nil, err
+		}
+	}
+
 	limitStr, err := funcutil.GetAttrByKeyFromRepeatedKV(LimitKey, queryParamsPair)
 	// if limit is not provided
 	if err != nil {
-		return &queryParams{limit: typeutil.Unlimited}, nil
+		return &queryParams{limit: typeutil.Unlimited, orderBy: orderBy}, nil
 	}
 	limit, err = strconv.ParseInt(limitStr, 0, 64)
 	if err != nil {
@@ -165,8 +319,9 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 	}
 
 	return &queryParams{
-		limit:  limit,
-		offset: offset,
+		limit:   limit,
+		offset:  offset,
+		orderBy: orderBy,
 	}, nil
 }
 
@@ -191,7 +346,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		zap.Any("collectionName", collectionName),
 		zap.Any("requestType", "query"))
 
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, t.request.GetDbName(), collectionName)
 	if err != nil {
 		log.Ctx(ctx).Warn("Failed to get collection id.",
 			zap.Any("collectionName", collectionName),
@@ -216,7 +371,7 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	log.Ctx(ctx).Debug("Validate partition names.",
 		zap.Any("requestType", "query"))
 
-	t.RetrieveRequest.PartitionIDs, err = getPartitionIDs(ctx, collectionName, t.request.GetPartitionNames())
+	t.RetrieveRequest.PartitionIDs, err = getPartitionIDs(ctx, t.request.GetDbName(), collectionName, t.request.GetPartitionNames())
 	if err != nil {
 		log.Ctx(ctx).Warn("failed to get partitions in collection.", zap.String("collectionName", collectionName),
 			zap.Error(err),
@@ -234,7 +389,15 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	t.queryParams = queryParams
 	t.RetrieveRequest.Limit = queryParams.limit + queryParams.offset
 
-	loaded, err := checkIfLoaded(ctx, t.qc, collectionName, t.RetrieveRequest.GetPartitionIDs())
+	t.iterator, t.iteratorCursor, err = parseIteratorParams(t.request.GetQueryParams(), collID)
+	if err != nil {
+		return err
+	}
+	if t.iterator && (queryParams.limit <= 0 || queryParams.offset != 0) {
+		return fmt.Errorf("query iterator requires %s as the batch size and doesn't support %s", LimitKey, OffsetKey)
+	}
+
+	loaded, err := checkIfLoaded(ctx, t.qc, t.request.GetDbName(), collectionName, t.RetrieveRequest.GetPartitionIDs())
 	if err != nil {
 		return fmt.Errorf("checkIfLoaded failed when query, collection:%v, partitions:%v, err = %s", collectionName, t.request.GetPartitionNames(), err)
 	}
@@ -242,8 +405,12 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("collection:%v or partition:%v not loaded into memory when query", collectionName, t.request.GetPartitionNames())
 	}
 
-	schema, _ := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	schema, _ := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), collectionName)
 	t.schema = schema
+	partitionKeyMode := typeutil.HasPartitionKey(schema)
+	if partitionKeyMode && len(t.request.GetPartitionNames()) > 0 {
+		return errPartitionKeyModeNotSupported
+	}
 
 	if t.ids != nil {
 		pkField := ""
@@ -263,19 +430,81 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	t.request.OutputFields, err = translateOutputFields(t.request.OutputFields, schema, true)
+	if partitionKeyMode {
+		// only retrieve from the hash partitions the filtered partition keys belong to
+		t.RetrieveRequest.PartitionIDs, err = getPartitionIDsByExpr(ctx, t.request.GetDbName(), collectionName, schema, plan.GetPredicates())
+		if err != nil {
+			return err
+		}
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
 	if err != nil {
 		return err
 	}
-	log.Ctx(ctx).Debug("translate output fields",
-		zap.Any("OutputFields", t.request.OutputFields),
-		zap.Any("requestType", "query"))
-
-	outputFieldIDs, err := translateToOutputFieldIDs(t.request.GetOutputFields(), schema)
+	if t.iteratorCursor != nil {
+		// skip the entities returned by the previous pages
+		plan.Node = &planpb.PlanNode_Predicates{
+			Predicates: andExpr(plan.GetPredicates(), t.iteratorCursor.pkGreaterThanExpr(pkField)),
+		}
+	}
+	groupByField, err := parseGroupByField(t.request.GetQueryParams(), schema)
+	if err != nil {
+		return err
+	}
+	t.RetrieveRequest.Aggregates, err = parseAggregates(t.request.GetOutputFields(), groupByField, schema)
+	if err != nil {
+		return err
+	}
+	queryParams.orderByFields, err = resolveOrderByFields(queryParams.orderBy, schema)
 	if err != nil {
 		return err
 	}
-	outputFieldIDs = append(outputFieldIDs, common.TimeStampField)
+	if len(queryParams.orderByFields) > 0 && (t.iterator || len(t.RetrieveRequest.Aggregates) > 0) {
+		return fmt.Errorf("%s doesn't support %s and aggregates", OrderByKey, IteratorKey)
+	}
+	if t.iterator {
+		// the sealed segments return rows in insert order, the pages of an iterator are ordered by primary key
+		// on querynodes and proxy, so that the last primary key of a page is the cursor of the next page
+		queryParams.orderByFields = []*internalpb.OrderByField{{FieldId: pkField.GetFieldID(), Ascending: true}}
+	}
+	t.RetrieveRequest.OrderBy = queryParams.orderByFields
+
+	var outputFieldIDs []UniqueID
+	if len(t.RetrieveRequest.Aggregates) > 0 {
+		if t.iterator {
+			return fmt.Errorf("query iterator doesn't support aggregates")
+		}
+		// the rows are aggregated on querynodes, limit and offset apply to the groups in PostExecute
+		t.RetrieveRequest.GroupByFieldId = groupByField.GetFieldID()
+		t.RetrieveRequest.Limit = typeutil.Unlimited
+		outputFieldIDs, err = getAggregatedFieldIDs(t.RetrieveRequest.Aggregates, groupByField, schema)
+		if err != nil {
+			return err
+		}
+	} else {
+		t.request.OutputFields, err = translateOutputFields(t.request.OutputFields, schema, true)
+		if err != nil {
+			return err
+		}
+		log.Ctx(ctx).Debug("translate output fields",
+			zap.Any("OutputFields", t.request.OutputFields),
+			zap.Any("requestType", "query"))
+
+		outputFieldIDs, err = translateToOutputFieldIDs(t.request.GetOutputFields(), schema)
+		if err != nil {
+			return err
+		}
+		// the order by fields are retrieved to sort and merge the results, they are removed in PostExecute
+		// if they aren't in the output fields
+		t.orderByOnlyFieldIDs = typeutil.NewUniqueSet()
+		for _, field := range t.RetrieveRequest.OrderBy {
+			if !funcutil.SliceContain(outputFieldIDs, field.GetFieldId()) {
+				outputFieldIDs = append(outputFieldIDs, field.GetFieldId())
+				t.orderByOnlyFieldIDs.Insert(field.GetFieldId())
+			}
+		}
+		outputFieldIDs = append(outputFieldIDs, common.TimeStampField)
+	}
 	t.RetrieveRequest.OutputFieldsId = outputFieldIDs
 	plan.OutputFieldIds = outputFieldIDs
 	log.Ctx(ctx).Debug("translate output fields to field ids",
@@ -287,7 +516,10 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	if t.request.TravelTimestamp == 0 {
+	if t.iteratorCursor != nil {
+		// all the pages of an iterator are read at the same timestamp
+		t.TravelTimestamp = t.iteratorCursor.Timestamp
+	} else if t.request.TravelTimestamp == 0 {
 		t.TravelTimestamp = t.BeginTs()
 	} else {
 		t.TravelTimestamp = t.request.TravelTimestamp
@@ -320,7 +552,7 @@ func (t *queryTask) Execute(ctx context.Context) error {
 	defer tr.CtxElapse(ctx, "done")
 
 	executeQuery := func(withCache bool) error {
-		shards, err := globalMetaCache.GetShards(ctx, withCache, t.collectionName)
+		shards, err := globalMetaCache.GetShards(ctx, withCache, t.request.GetDbName(), t.collectionName)
 		if err != nil {
 			return err
 		}
@@ -371,6 +603,9 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 
 	metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(0.0)
 	tr.CtxRecord(ctx, "reduceResultStart")
+	if len(t.GetAggregates()) > 0 {
+		return t.reduceAggregates(ctx)
+	}
 	t.result, err = reduceRetrieveResultsAndFillIfEmpty(ctx, t.toReduceResults, t.queryParams, t.GetOutputFieldsId(), t.schema)
 	if err != nil {
 		return err
@@ -392,29 +627,89 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		return nil
 	}
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.CollectionName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), t.request.CollectionName)
 	if err != nil {
 		return err
 	}
-	for i := 0; i < len(t.result.FieldsData); i++ {
-		if t.OutputFieldsId[i] == common.TimeStampField {
-			t.result.FieldsData = append(t.result.FieldsData[:i], t.result.FieldsData[(i+1):]...)
-			i--
+	fieldsData := make([]*schemapb.FieldData, 0, len(t.result.FieldsData))
+	for i, fieldData := range t.result.FieldsData {
+		fieldID := t.OutputFieldsId[i]
+		if fieldID == common.TimeStampField || t.orderByOnlyFieldIDs.Contain(fieldID) {
 			continue
 		}
 		for _, field := range schema.Fields {
-			if field.FieldID == t.OutputFieldsId[i] {
-				t.result.FieldsData[i].FieldName = field.Name
-				t.result.FieldsData[i].FieldId = field.FieldID
-				t.result.FieldsData[i].Type = field.DataType
+			if field.FieldID == fieldID {
+				fieldData.FieldName = field.Name
+				fieldData.FieldId = field.FieldID
+				fieldData.Type = field.DataType
 			}
 		}
+		fieldsData = append(fieldsData, fieldData)
+	}
+	t.result.FieldsData = fieldsData
+	if t.iterator {
+		if err := t.fillInIteratorToken(); err != nil {
+			return err
+		}
 	}
 	log.Ctx(ctx).Debug("Query PostExecute done",
 		zap.String("requestType", "query"))
 	return nil
 }
 
+// reduceAggregates merges the partial aggregates of the shards into the query results.
+func (t *queryTask) reduceAggregates(ctx context.Context) error {
+	aggregator, err := typeutil.NewAggregator(t.schema, t.GetAggregates(), t.GetGroupByFieldId())
+	if err != nil {
+		return err
+	}
+	for _, res := range t.toReduceResults {
+		if err := aggregator.MergePartialResults(res.GetAggregatesData()); err != nil {
+			return err
+		}
+	}
+	t.result = &milvuspb.QueryResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		FieldsData:     aggregator.Results(t.queryParams.offset, t.queryParams.limit),
+		CollectionName: t.collectionName,
+	}
+	log.Ctx(ctx).Debug("Query PostExecute done",
+		zap.String("requestType", "query"),
+		zap.Int("numAggregates", len(t.GetAggregates())))
+	return nil
+}
+
+// fillInIteratorToken sets the token to resume the query iterator after the last returned entity,
+// the token is left empty if the iterator is exhausted.
+func (t *queryTask) fillInIteratorToken() error {
+	pkField, err := typeutil.GetPrimaryFieldSchema(t.schema)
+	if err != nil {
+		return err
+	}
+	pkData, err := typeutil.GetPrimaryFieldData(t.result.GetFieldsData(), pkField)
+	if err != nil {
+		return err
+	}
+	ids, err := parsePrimaryFieldData2IDs(pkData)
+	if err != nil {
+		return err
+	}
+	size := typeutil.GetSizeOfIDs(ids)
+	if int64(size) < t.queryParams.limit {
+		return nil
+	}
+	cursor := &iteratorCursor{
+		CollectionID: t.CollectionID,
+		Timestamp:    t.TravelTimestamp,
+	}
+	// the results are ordered by primary key, see PreExecute
+	cursor.appendPK(typeutil.GetPK(ids, int64(size-1)))
+	t.iteratorToken, err = encodeIteratorToken(cursor)
+	return err
+}
+
 func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.QueryNode, channelIDs []string) error {
 	retrieveReq := typeutil.Clone(t.RetrieveRequest)
 	retrieveReq.GetBase().TargetID = nodeID
@@ -489,12 +784,21 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
 
+	// the results of shards are sorted by primary key, or by the order by fields if they are set
+	var orderBy []*internalpb.OrderByField
+	if queryParams != nil {
+		orderBy = queryParams.orderByFields
+	}
+
 	if queryParams != nil && queryParams.limit != typeutil.Unlimited {
 		loopEnd = int(queryParams.limit)
 
 		if queryParams.offset > 0 {
 			for i := int64(0); i < queryParams.offset; i++ {
-				sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
+				sel, err := typeutil.SelectMinByOrder(validRetrieveResults, cursors, orderBy)
+				if err != nil {
+					return nil, err
+				}
 				if sel == -1 {
 					return ret, nil
 				}
@@ -504,7 +808,10 @@ func reduceRetrieveResults(ctx context.Context, retrieveResults []*internalpb.Re
 	}
 
 	for j := 0; j < loopEnd; j++ {
-		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
+		sel, err := typeutil.SelectMinByOrder(validRetrieveResults, cursors, orderBy)
+		if err != nil {
+			return nil, err
+		}
 		if sel == -1 {
 			break
 		}
@@ -94,7 +94,7 @@ func TestQueryTask_all(t *testing.T) {
 	require.NoError(t, createColT.Execute(ctx))
 	require.NoError(t, createColT.PostExecute(ctx))
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
@@ -663,3 +663,108 @@ func Test_filterSystemFields(t *testing.T) {
 	filtered := filterSystemFields(outputFieldIDs)
 	assert.ElementsMatch(t, []UniqueID{common.StartOfUserFieldID}, filtered)
 }
+
+func Test_parseAggregates(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "color", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+	color := schema.Fields[1]
+
+	t.Run("no aggregates", func(t *testing.T) {
+		aggregates, err := parseAggregates([]string{"pk", "age"}, nil, schema)
+		assert.NoError(t, err)
+		assert.Nil(t, aggregates)
+
+		_, err = parseAggregates([]string{"pk", "age"}, color, schema)
+		assert.Error(t, err)
+	})
+
+	t.Run("aggregates", func(t *testing.T) {
+		aggregates, err := parseAggregates([]string{"color", "COUNT(*)", "avg( age )", "max(color)"}, color, schema)
+		assert.NoError(t, err)
+		assert.Equal(t, []*internalpb.Aggregate{
+			{Type: internalpb.AggregateType_Count},
+			{Type: internalpb.AggregateType_Avg, FieldId: 102},
+			{Type: internalpb.AggregateType_Max, FieldId: 101},
+		}, aggregates)
+
+		fieldIDs, err := getAggregatedFieldIDs(aggregates, color, schema)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []int64{100, 101, 102, common.TimeStampField}, fieldIDs)
+
+		fieldIDs, err = getAggregatedFieldIDs(aggregates[:1], nil, schema)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []int64{100, common.TimeStampField}, fieldIDs)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, outputFields := range [][]string{
+			{"count(age)"},
+			{"median(age)"},
+			{"sum(height)"},
+			{"count(*)", "age"},
+		} {
+			_, err := parseAggregates(outputFields, nil, schema)
+			assert.Error(t, err, outputFields)
+		}
+	})
+}
+
+func Test_parseOrderByParams(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "name", DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+
+	params, err := parseQueryParams([]*commonpb.KeyValuePair{{Key: OrderByKey, Value: "age DESC, name"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []*orderByParam{{fieldName: "age", ascending: false}, {fieldName: "name", ascending: true}}, params.orderBy)
+
+	fields, err := resolveOrderByFields(params.orderBy, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, []*internalpb.OrderByField{{FieldId: 101, Ascending: false}, {FieldId: 102, Ascending: true}}, fields)
+
+	for _, orderBy := range []string{"", "age,", "age up", "age desc asc", "age, age desc"} {
+		_, err := parseOrderByParams(orderBy)
+		assert.Error(t, err, orderBy)
+	}
+	for _, fieldName := range []string{"vec", "height"} {
+		_, err := resolveOrderByFields([]*orderByParam{{fieldName: fieldName}}, schema)
+		assert.Error(t, err, fieldName)
+	}
+}
+
+func Test_reduceRetrieveResultsOrderBy(t *testing.T) {
+	newResult := func(pks []int64, ages []int64) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+			FieldsData: []*schemapb.FieldData{
+				getFieldData("pk", 100, schemapb.DataType_Int64, pks, 1),
+				getFieldData("age", 101, schemapb.DataType_Int64, ages, 1),
+			},
+		}
+	}
+	// the results of shards are sorted by age in descending order
+	results := []*internalpb.RetrieveResults{
+		newResult([]int64{1, 3, 5}, []int64{50, 30, 10}),
+		newResult([]int64{2, 4}, []int64{40, 20}),
+	}
+	params := &queryParams{
+		limit:         2,
+		offset:        1,
+		orderByFields: []*internalpb.OrderByField{{FieldId: 101, Ascending: false}},
+	}
+
+	ret, err := reduceRetrieveResults(context.Background(), results, params)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 3}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []int64{40, 30}, ret.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 
@@ -40,6 +41,10 @@ import (
 const (
 	SearchTaskName = "SearchTask"
 	SearchLevelKey = "level"
+
+	// groupBySearchFactor is how many times topk candidates are searched by a group by search at first,
+	// the candidates are doubled until they cover topk groups, see expandGroupBySearch.
+	groupBySearchFactor = 10
 )
 
 type searchTask struct {
@@ -60,16 +65,20 @@ type searchTask struct {
 
 	searchShardPolicy pickShardPolicy
 	shardMgr          *shardClientMgr
+
+	iterator       bool
+	iteratorCursor *iteratorCursor
+	iteratorToken  string
 }
 
-func getPartitionIDs(ctx context.Context, collectionName string, partitionNames []string) (partitionIDs []UniqueID, err error) {
+func getPartitionIDs(ctx context.Context, database, collectionName string, partitionNames []string) (partitionIDs []UniqueID, err error) {
 	for _, tag := range partitionNames {
 		if err := validatePartitionTag(tag, false); err != nil {
 			return nil, err
 		}
 	}
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, database, collectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -211,12 +220,79 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair) (*planpb.QueryIn
 	if err != nil {
 		return nil, 0, err
 	}
-	return &planpb.QueryInfo{
+	queryInfo := &planpb.QueryInfo{
 		Topk:         queryTopK,
 		MetricType:   metricType,
 		SearchParams: searchParamStr,
 		RoundDecimal: roundDecimal,
-	}, offset, nil
+	}
+	if err := parseRangeSearchInfo(searchParamsPair, queryInfo); err != nil {
+		return nil, 0, err
+	}
+	return queryInfo, offset, nil
+}
+
+// parseGroupByField returns the field which search results are grouped by, nil if group_by_field isn't specified.
+func parseGroupByField(searchParamsPair []*commonpb.KeyValuePair, schema *schemapb.CollectionSchema) (*schemapb.FieldSchema, error) {
+	fieldName, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, searchParamsPair)
+	if err != nil || fieldName == "" {
+		return nil, nil
+	}
+	for _, field := range schema.GetFields() {
+		if field.GetName() != fieldName {
+			continue
+		}
+		switch field.GetDataType() {
+		case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+			schemapb.DataType_Int64, schemapb.DataType_VarChar:
+			return field, nil
+		default:
+			return nil, fmt.Errorf("%s [%s] is invalid, field of type %s can't be grouped by", GroupByFieldKey, fieldName, field.GetDataType().String())
+		}
+	}
+	return nil, fmt.Errorf("%s [%s] is invalid, field not found", GroupByFieldKey, fieldName)
+}
+
+// parseRangeSearchInfo turns the search into a range search if radius is specified in search params.
+// For the metric types whose larger distance means more similar (IP), range search returns the
+// entities satisfying radius < distance <= range_filter, otherwise range_filter <= distance < radius.
+func parseRangeSearchInfo(searchParamsPair []*commonpb.KeyValuePair, queryInfo *planpb.QueryInfo) error {
+	radiusStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RadiusKey, searchParamsPair)
+	if err != nil {
+		// not a range search
+		return nil
+	}
+	radius, err := strconv.ParseFloat(radiusStr, 32)
+	if err != nil {
+		return fmt.Errorf("%s [%s] is invalid", RadiusKey, radiusStr)
+	}
+
+	positivelyRelated := distance.PositivelyRelated(queryInfo.GetMetricType())
+	// range_filter is unbounded by default
+	rangeFilter := -math.MaxFloat32
+	if positivelyRelated {
+		rangeFilter = math.MaxFloat32
+	}
+	rangeFilterStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RangeFilterKey, searchParamsPair)
+	if err == nil {
+		rangeFilter, err = strconv.ParseFloat(rangeFilterStr, 32)
+		if err != nil {
+			return fmt.Errorf("%s [%s] is invalid", RangeFilterKey, rangeFilterStr)
+		}
+		if positivelyRelated && rangeFilter <= radius {
+			return fmt.Errorf("%s [%s] must be greater than %s [%s] for metric type %s",
+				RangeFilterKey, rangeFilterStr, RadiusKey, radiusStr, queryInfo.GetMetricType())
+		}
+		if !positivelyRelated && rangeFilter >= radius {
+			return fmt.Errorf("%s [%s] must be less than %s [%s] for metric type %s",
+				RangeFilterKey, rangeFilterStr, RadiusKey, radiusStr, queryInfo.GetMetricType())
+		}
+	}
+
+	queryInfo.IsRangeSearch = true
+	queryInfo.Radius = float32(radius)
+	queryInfo.RangeFilter = float32(rangeFilter)
+	return nil
 }
 
 func getOutputFieldIDs(schema *schemapb.CollectionSchema, outputFields []string) (outputFieldIDs []UniqueID, err error) {
@@ -271,23 +347,28 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 
 	collectionName := t.request.CollectionName
 	t.collectionName = collectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, t.request.GetDbName(), collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
 
 	t.SearchRequest.DbID = 0 // todo
 	t.SearchRequest.CollectionID = collID
-	t.schema, _ = globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	t.schema, _ = globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), collectionName)
+
+	partitionKeyMode := typeutil.HasPartitionKey(t.schema)
+	if partitionKeyMode && len(t.request.GetPartitionNames()) > 0 {
+		return errPartitionKeyModeNotSupported
+	}
 
 	// translate partition name to partition ids. Use regex-pattern to match partition name.
-	t.SearchRequest.PartitionIDs, err = getPartitionIDs(ctx, collectionName, t.request.GetPartitionNames())
+	t.SearchRequest.PartitionIDs, err = getPartitionIDs(ctx, t.request.GetDbName(), collectionName, t.request.GetPartitionNames())
 	if err != nil {
 		return err
 	}
 
 	// check if collection/partitions are loaded into query node
-	loaded, err := checkIfLoaded(ctx, t.qc, collectionName, t.SearchRequest.GetPartitionIDs())
+	loaded, err := checkIfLoaded(ctx, t.qc, t.request.GetDbName(), collectionName, t.SearchRequest.GetPartitionIDs())
 	if err != nil {
 		return fmt.Errorf("checkIfLoaded failed when search, collection:%v, partitions:%v, err = %s", collectionName, t.request.GetPartitionNames(), err)
 	}
@@ -314,6 +395,38 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		}
 		t.offset = offset
 
+		t.iterator, t.iteratorCursor, err = parseIteratorParams(t.request.GetSearchParams(), collID)
+		if err != nil {
+			return err
+		}
+		if t.iterator && (offset != 0 || queryInfo.GetRoundDecimal() != -1) {
+			return fmt.Errorf("search iterator doesn't support %s and %s", OffsetKey, RoundDecimalKey)
+		}
+		if t.iteratorCursor != nil {
+			t.iteratorCursor.resumeRangeSearch(queryInfo)
+		}
+
+		groupByField, err := parseGroupByField(t.request.GetSearchParams(), t.schema)
+		if err != nil {
+			return err
+		}
+		if groupByField != nil {
+			if t.iterator || offset != 0 {
+				return fmt.Errorf("%s doesn't support %s and %s", GroupByFieldKey, IteratorKey, OffsetKey)
+			}
+			// the group value is returned along with the best hit of each group
+			if !funcutil.SliceContain(t.request.OutputFields, groupByField.GetName()) {
+				t.request.OutputFields = append(t.request.OutputFields, groupByField.GetName())
+			}
+			t.SearchRequest.GroupByFieldId = groupByField.GetFieldID()
+			t.SearchRequest.GroupTopk = queryInfo.GetTopk()
+			// search more candidates than topk since the hits of a group are reduced to one
+			queryInfo.Topk = queryInfo.GetTopk() * groupBySearchFactor
+			if queryInfo.Topk > searchCountLimit {
+				queryInfo.Topk = searchCountLimit
+			}
+		}
+
 		plan, err := planparserv2.CreateSearchPlan(t.schema, t.request.Dsl, annsField, queryInfo)
 		if err != nil {
 			log.Ctx(ctx).Warn("failed to create query plan", zap.Error(err),
@@ -325,6 +438,24 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
 			zap.String("anns field", annsField), zap.Any("query info", queryInfo))
 
+		if partitionKeyMode {
+			// only search the hash partitions the filtered partition keys belong to
+			t.SearchRequest.PartitionIDs, err = getPartitionIDsByExpr(ctx, t.request.GetDbName(), collectionName, t.schema, plan.GetVectorAnns().GetPredicates())
+			if err != nil {
+				return err
+			}
+		}
+
+		if t.iteratorCursor != nil {
+			// skip the entities at the cursor distance returned by the previous pages
+			pkField, err := typeutil.GetPrimaryFieldSchema(t.schema)
+			if err != nil {
+				return err
+			}
+			vectorAnns := plan.GetVectorAnns()
+			vectorAnns.Predicates = andExpr(vectorAnns.GetPredicates(), t.iteratorCursor.pkNotInExpr(pkField))
+		}
+
 		outputFieldIDs, err := getOutputFieldIDs(t.schema, t.request.GetOutputFields())
 		if err != nil {
 			return err
@@ -347,7 +478,12 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	}
 
 	travelTimestamp := t.request.TravelTimestamp
-	if travelTimestamp == 0 {
+	if t.iteratorCursor != nil {
+		// all the pages of an iterator are read at the same timestamp
+		travelTimestamp = t.iteratorCursor.Timestamp
+	} else if t.iterator && travelTimestamp == 0 {
+		travelTimestamp = t.BeginTs()
+	} else if travelTimestamp == 0 {
 		travelTimestamp = typeutil.MaxTimestamp
 	}
 	err = validateTravelTimestamp(travelTimestamp, t.BeginTs())
@@ -376,6 +512,9 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	if err := validateLimit(nq); err != nil {
 		return fmt.Errorf("%s [%d] is invalid, %w", NQKey, nq, err)
 	}
+	if t.iterator && nq != 1 {
+		return fmt.Errorf("search iterator supports only one query vector, %s [%d] is invalid", NQKey, nq)
+	}
 	t.SearchRequest.Nq = nq
 
 	log.Ctx(ctx).Debug("search PreExecute done.",
@@ -393,7 +532,7 @@ func (t *searchTask) Execute(ctx context.Context) error {
 	defer tr.CtxElapse(ctx, "done")
 
 	executeSearch := func(withCache bool) error {
-		shard2Leaders, err := globalMetaCache.GetShards(ctx, withCache, t.collectionName)
+		shard2Leaders, err := globalMetaCache.GetShards(ctx, withCache, t.request.GetDbName(), t.collectionName)
 		if err != nil {
 			return err
 		}
@@ -431,53 +570,123 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 
 	var (
 		Nq         = t.SearchRequest.GetNq()
-		Topk       = t.SearchRequest.GetTopk()
 		MetricType = t.SearchRequest.GetMetricType()
 	)
 
-	if err := t.collectSearchResults(ctx); err != nil {
-		return err
-	}
-
-	// Decode all search results
-	tr.CtxRecord(ctx, "decodeResultStart")
-	validSearchResults, err := decodeSearchResults(ctx, t.toReduceResults)
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(t.schema)
 	if err != nil {
 		return err
 	}
-	metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
-		metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
-	if len(validSearchResults) <= 0 {
-		log.Ctx(ctx).Warn("search result is empty")
+	for {
+		Topk := t.SearchRequest.GetTopk()
+		if err := t.collectSearchResults(ctx); err != nil {
+			return err
+		}
 
-		t.fillInEmptyResult(Nq)
-		return nil
-	}
+		// Decode all search results
+		tr.CtxRecord(ctx, "decodeResultStart")
+		validSearchResults, err := decodeSearchResults(ctx, t.toReduceResults)
+		if err != nil {
+			return err
+		}
+		metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+			metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
-	// Reduce all search results
-	log.Ctx(ctx).Debug("proxy search post execute reduce",
-		zap.Int("number of valid search results", len(validSearchResults)))
-	tr.CtxRecord(ctx, "reduceResultStart")
-	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(t.schema)
-	if err != nil {
-		return err
-	}
+		if len(validSearchResults) <= 0 {
+			log.Ctx(ctx).Warn("search result is empty")
 
-	t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset)
-	if err != nil {
-		return err
-	}
+			t.fillInEmptyResult(Nq)
+			return nil
+		}
 
-	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
+		// Reduce all search results
+		log.Ctx(ctx).Debug("proxy search post execute reduce",
+			zap.Int("number of valid search results", len(validSearchResults)))
+		tr.CtxRecord(ctx, "reduceResultStart")
+		t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset,
+			t.SearchRequest.GetGroupByFieldId(), t.SearchRequest.GetGroupTopk())
+		if err != nil {
+			return err
+		}
+
+		metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
+
+		if t.SearchRequest.GetGroupByFieldId() == 0 ||
+			!needMoreGroups(t.toReduceResults, t.result.GetResults().GetTopks(), t.SearchRequest.GetGroupTopk()) {
+			break
+		}
+		expanded, err := t.expandGroupBySearch()
+		if err != nil {
+			return err
+		}
+		if !expanded {
+			break
+		}
+		log.Ctx(ctx).Debug("search more candidates to find topk groups", zap.Int64("topk", t.SearchRequest.GetTopk()))
+		if err := t.Execute(ctx); err != nil {
+			return err
+		}
+	}
 
 	t.result.CollectionName = t.collectionName
 	t.fillInFieldInfo()
+	if t.iterator {
+		if err := t.fillInIteratorToken(); err != nil {
+			return err
+		}
+	}
 
 	log.Ctx(ctx).Debug("Search post execute done")
 	return nil
 }
 
+// needMoreGroups returns true if some queries get fewer than groupTopk groups in @topks while more groups could be
+// found by searching more candidates, as told by the shard leaders.
+func needMoreGroups(results []*internalpb.SearchResults, topks []int64, groupTopk int64) bool {
+	for i, topk := range topks {
+		if topk >= groupTopk {
+			continue
+		}
+		for _, result := range results {
+			if truncated := result.GetGroupsTruncated(); i < len(truncated) && truncated[i] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// expandGroupBySearch doubles the candidates searched by the group by search, returns false if
+// the candidates already reach the limit.
+func (t *searchTask) expandGroupBySearch() (bool, error) {
+	topk := t.SearchRequest.GetTopk()
+	if topk >= searchCountLimit {
+		return false, nil
+	}
+	topk *= 2
+	if topk > searchCountLimit {
+		topk = searchCountLimit
+	}
+
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(t.SearchRequest.GetSerializedExprPlan(), plan); err != nil {
+		return false, err
+	}
+	queryInfo := plan.GetVectorAnns().GetQueryInfo()
+	if queryInfo == nil {
+		return false, fmt.Errorf("invalid search plan without query info")
+	}
+	queryInfo.Topk = topk
+	serializedPlan, err := proto.Marshal(plan)
+	if err != nil {
+		return false, err
+	}
+	t.SearchRequest.SerializedExprPlan = serializedPlan
+	t.SearchRequest.Topk = topk
+	return true, nil
+}
+
 func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.QueryNode, channelIDs []string) error {
 	searchReq := typeutil.Clone(t.SearchRequest)
 	searchReq.GetBase().TargetID = nodeID
@@ -539,6 +748,33 @@ func (t *searchTask) fillInFieldInfo() {
 	}
 }
 
+// fillInIteratorToken sets the token to resume the search iterator after the last returned entity,
+// the token is left empty if the iterator is exhausted.
+func (t *searchTask) fillInIteratorToken() error {
+	scores := t.result.GetResults().GetScores()
+	ids := t.result.GetResults().GetIds()
+	if int64(len(scores)) < t.SearchRequest.GetTopk() {
+		return nil
+	}
+	last := scores[len(scores)-1]
+	cursor := &iteratorCursor{
+		CollectionID: t.CollectionID,
+		Timestamp:    t.TravelTimestamp,
+		Distance:     last,
+	}
+	// the entities at the same distance may be returned by several pages
+	if t.iteratorCursor != nil && t.iteratorCursor.Distance == last {
+		cursor.IntPKs = append(cursor.IntPKs, t.iteratorCursor.IntPKs...)
+		cursor.StrPKs = append(cursor.StrPKs, t.iteratorCursor.StrPKs...)
+	}
+	for i := len(scores) - 1; i >= 0 && scores[i] == last; i-- {
+		cursor.appendPK(typeutil.GetPK(ids, int64(i)))
+	}
+	var err error
+	t.iteratorToken, err = encodeIteratorToken(cursor)
+	return err
+}
+
 func (t *searchTask) collectSearchResults(ctx context.Context) error {
 	select {
 	case <-t.TraceCtx().Done():
@@ -557,8 +793,8 @@ func (t *searchTask) collectSearchResults(ctx context.Context) error {
 }
 
 // checkIfLoaded check if collection was loaded into QueryNode
-func checkIfLoaded(ctx context.Context, qc types.QueryCoord, collectionName string, searchPartitionIDs []UniqueID) (bool, error) {
-	info, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+func checkIfLoaded(ctx context.Context, qc types.QueryCoord, database, collectionName string, searchPartitionIDs []UniqueID) (bool, error) {
+	info, err := globalMetaCache.GetCollectionInfo(ctx, database, collectionName)
 	if err != nil {
 		return false, fmt.Errorf("GetCollectionInfo failed, collection = %s, err = %s", collectionName, err)
 	}
@@ -664,18 +900,42 @@ func selectHighestScoreIndex(subSearchResultData []*schemapb.SearchResultData, s
 	return subSearchIdx, resultDataIdx
 }
 
-func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64) (*milvuspb.SearchResults, error) {
+// getGroupByFieldData returns the data of the group by field in each subSearchResultData,
+// nil if the results aren't grouped.
+func getGroupByFieldData(subSearchResultData []*schemapb.SearchResultData, groupByFieldID int64) ([]*schemapb.FieldData, error) {
+	if groupByFieldID == 0 {
+		return nil, nil
+	}
+	groupByFieldData := make([]*schemapb.FieldData, len(subSearchResultData))
+	for i, sData := range subSearchResultData {
+		groupByFieldData[i] = typeutil.GetFieldDataByID(sData.GetFieldsData(), groupByFieldID)
+		if groupByFieldData[i] == nil && len(sData.GetScores()) > 0 {
+			return nil, fmt.Errorf("group by field %d not found in search results", groupByFieldID)
+		}
+	}
+	return groupByFieldData, nil
+}
+
+// reduceSearchResultData merges the sub search results into topk results of each query, skipping the
+// first offset ones. If groupByFieldID is set, only the best hit of each distinct value of the field
+// is kept, at most groupTopk of them.
+func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64,
+	groupByFieldID int64, groupTopk int64) (*milvuspb.SearchResults, error) {
 	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
 	defer func() {
 		tr.CtxElapse(ctx, "done")
 	}()
 
 	limit := topk - offset
+	if groupByFieldID != 0 {
+		limit = groupTopk
+	}
 	log.Ctx(ctx).Debug("reduceSearchResultData",
 		zap.Int("len(subSearchResultData)", len(subSearchResultData)),
 		zap.Int64("nq", nq),
 		zap.Int64("offset", offset),
 		zap.Int64("limit", limit),
+		zap.Int64("groupByFieldID", groupByFieldID),
 		zap.String("metricType", metricType))
 
 	ret := &milvuspb.SearchResults{
@@ -722,6 +982,12 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 		//printSearchResultData(sData, strconv.FormatInt(int64(i), 10))
 	}
 
+	groupByFieldData, err := getGroupByFieldData(subSearchResultData, groupByFieldID)
+	if err != nil {
+		log.Ctx(ctx).Warn("invalid search results", zap.Error(err))
+		return ret, err
+	}
+
 	var (
 		subSearchNum = len(subSearchResultData)
 		// for results of each subSearchResultData, storing the start offset of each query of nq queries
@@ -735,8 +1001,9 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 	}
 
 	var (
-		skipDupCnt int64
-		realTopK   int64 = -1
+		skipDupCnt   int64
+		skipGroupCnt int64
+		maxTopK      int64
 	)
 
 	// reducing nq * topk results
@@ -747,17 +1014,23 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 			// sum(cursors) == j
 			cursors = make([]int64, subSearchNum)
 
-			j     int64
-			idSet = make(map[interface{}]struct{})
+			j        int64
+			idSet    = make(map[interface{}]struct{})
+			groupSet = make(map[interface{}]struct{})
 		)
 
-		// skip offset results
-		for k := int64(0); k < offset; k++ {
-			subSearchIdx, _ := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+		// skip offset results, the duplicated ones are counted only once
+		for k := int64(0); k < offset; {
+			subSearchIdx, resultDataIdx := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
 			if subSearchIdx == -1 {
 				break
 			}
 
+			id := typeutil.GetPK(subSearchResultData[subSearchIdx].GetIds(), resultDataIdx)
+			if _, ok := idSet[id]; !ok {
+				idSet[id] = struct{}{}
+				k++
+			}
 			cursors[subSearchIdx]++
 		}
 
@@ -775,32 +1048,45 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 			score := subSearchResultData[subSearchIdx].Scores[resultDataIdx]
 
 			// remove duplicates
-			if _, ok := idSet[id]; !ok {
-				typeutil.AppendFieldData(ret.Results.FieldsData, subSearchResultData[subSearchIdx].FieldsData, resultDataIdx)
-				typeutil.AppendPKs(ret.Results.Ids, id)
-				ret.Results.Scores = append(ret.Results.Scores, score)
-				idSet[id] = struct{}{}
-				j++
-			} else {
+			if _, ok := idSet[id]; ok {
 				// skip entity with same id
 				skipDupCnt++
+				cursors[subSearchIdx]++
+				continue
+			}
+			if groupByFieldData != nil {
+				group := typeutil.GetScalarData(groupByFieldData[subSearchIdx], resultDataIdx)
+				if _, ok := groupSet[group]; ok {
+					// skip entity of a group which already has a better hit
+					skipGroupCnt++
+					cursors[subSearchIdx]++
+					continue
+				}
+				groupSet[group] = struct{}{}
 			}
+			typeutil.AppendFieldData(ret.Results.FieldsData, subSearchResultData[subSearchIdx].FieldsData, resultDataIdx)
+			typeutil.AppendPKs(ret.Results.Ids, id)
+			ret.Results.Scores = append(ret.Results.Scores, score)
+			idSet[id] = struct{}{}
+			j++
 			cursors[subSearchIdx]++
 		}
-		if realTopK != -1 && realTopK != j {
-			log.Ctx(ctx).Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
-			// return nil, errors.New("the length (topk) between all result of query is different")
+		// the number of results may differ between queries, such as in range search
+		if j > maxTopK {
+			maxTopK = j
 		}
-		realTopK = j
-		ret.Results.Topks = append(ret.Results.Topks, realTopK)
+		ret.Results.Topks = append(ret.Results.Topks, j)
 	}
 	log.Ctx(ctx).Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
 
 	if skipDupCnt > 0 {
 		log.Info("skip duplicated search result", zap.Int64("count", skipDupCnt))
 	}
+	if skipGroupCnt > 0 {
+		log.Ctx(ctx).Debug("skip grouped search result", zap.Int64("count", skipGroupCnt))
+	}
 
-	ret.Results.TopK = realTopK // realTopK is the topK of the nq-th query
+	ret.Results.TopK = maxTopK // maxTopK is the largest number of results among all queries
 	if !distance.PositivelyRelated(metricType) {
 		for k := range ret.Results.Scores {
 			ret.Results.Scores[k] *= -1
@@ -24,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 
 	"github.com/milvus-io/milvus/internal/util/distance"
@@ -229,7 +230,7 @@ func TestSearchTask_PreExecute(t *testing.T) {
 	t.Run("test checkIfLoaded error", func(t *testing.T) {
 		collName := "test_checkIfLoaded_error" + funcutil.GenRandomStr()
 		createColl(t, collName, rc)
-		_, err := globalMetaCache.GetCollectionID(context.TODO(), collName)
+		_, err := globalMetaCache.GetCollectionID(context.TODO(), dbName, collName)
 		require.NoError(t, err)
 		task := getSearchTask(t, collName)
 		task.collectionName = collName
@@ -255,7 +256,7 @@ func TestSearchTask_PreExecute(t *testing.T) {
 	t.Run("search with timeout", func(t *testing.T) {
 		collName := "search_with_timeout" + funcutil.GenRandomStr()
 		createColl(t, collName, rc)
-		collID, err := globalMetaCache.GetCollectionID(context.TODO(), collName)
+		collID, err := globalMetaCache.GetCollectionID(context.TODO(), dbName, collName)
 		require.NoError(t, err)
 		status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -462,7 +463,7 @@ func TestSearchTaskWithInvalidRoundDecimal(t *testing.T) {
 	// query := newMockGetChannelsService()
 	// factory := newSimpleMockMsgStreamFactory()
 	//
-	// collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	// collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	// assert.NoError(t, err)
 	//
 	// qc := NewQueryCoordMock()
@@ -705,7 +706,7 @@ func TestSearchTaskV2_all(t *testing.T) {
 	// query := newMockGetChannelsService()
 	// factory := newSimpleMockMsgStreamFactory()
 	//
-	// collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	// collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	// assert.NoError(t, err)
 	//
 	// qc := NewQueryCoordMock()
@@ -942,7 +943,7 @@ func TestSearchTaskV2_7803_reduce(t *testing.T) {
 	// query := newMockGetChannelsService()
 	// factory := newSimpleMockMsgStreamFactory()
 	//
-	// collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	// collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	// assert.NoError(t, err)
 	//
 	// qc := NewQueryCoordMock()
@@ -1459,7 +1460,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 
 		for _, test := range tests {
 			t.Run(test.description, func(t *testing.T) {
-				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_Int64, test.offset)
+				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_Int64, test.offset, 0, 0)
 				assert.NoError(t, err)
 				assert.Equal(t, test.outData, reduced.GetResults().GetIds().GetIntId().GetData())
 				assert.Equal(t, []int64{test.limit, test.limit}, reduced.GetResults().GetTopks())
@@ -1499,7 +1500,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 
 		for _, test := range lessThanLimitTests {
 			t.Run(test.description, func(t *testing.T) {
-				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_Int64, test.offset)
+				reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_Int64, test.offset, 0, 0)
 				assert.NoError(t, err)
 				assert.Equal(t, test.outData, reduced.GetResults().GetIds().GetIntId().GetData())
 				assert.Equal(t, []int64{test.outLimit, test.outLimit}, reduced.GetResults().GetTopks())
@@ -1523,7 +1524,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 			results = append(results, r)
 		}
 
-		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_Int64, 0)
+		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_Int64, 0, 0, 0)
 
 		assert.NoError(t, err)
 		assert.Equal(t, resultData, reduced.GetResults().GetIds().GetIntId().GetData())
@@ -1532,6 +1533,62 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 		assert.InDeltaSlice(t, resultScore, reduced.GetResults().GetScores(), 10e-8)
 	})
 
+	t.Run("variable length", func(t *testing.T) {
+		// results of range search, the number of results differs between queries
+		r1 := getSearchResultData(nq, topk)
+		r1.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}
+		r1.Scores = []float32{-1, -3, -2}
+		r1.Topks = []int64{2, 1}
+
+		r2 := getSearchResultData(nq, topk)
+		r2.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{4}}}
+		r2.Scores = []float32{-2}
+		r2.Topks = []int64{1, 0}
+
+		reduced, err := reduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{r1, r2}, nq, topk, distance.L2, schemapb.DataType_Int64, 0, 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 4, 2, 3}, reduced.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{3, 1}, reduced.GetResults().GetTopks())
+		assert.Equal(t, int64(3), reduced.GetResults().GetTopK())
+		assert.InDeltaSlice(t, []float32{1, 2, 3, 2}, reduced.GetResults().GetScores(), 10e-8)
+	})
+
+	t.Run("group by", func(t *testing.T) {
+		groupFieldData := func(data []int64) []*schemapb.FieldData {
+			return []*schemapb.FieldData{{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 101,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}},
+					},
+				},
+			}}
+		}
+		r1 := getSearchResultData(nq, topk)
+		r1.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 4}}}
+		r1.Scores = []float32{-1, -2, -3, -1}
+		r1.Topks = []int64{3, 1}
+		r1.FieldsData = groupFieldData([]int64{10, 10, 20, 10})
+
+		r2 := getSearchResultData(nq, topk)
+		r2.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{5, 6, 7, 8}}}
+		r2.Scores = []float32{-1.5, -2.5, -2, -3}
+		r2.Topks = []int64{2, 2}
+		r2.FieldsData = groupFieldData([]int64{20, 30, 10, 20})
+
+		reduced, err := reduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{r1, r2}, nq, topk, distance.L2, schemapb.DataType_Int64, 0, 101, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 5, 4, 8}, reduced.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{10, 20, 10, 20}, reduced.GetResults().GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int64{2, 2}, reduced.GetResults().GetTopks())
+		assert.InDeltaSlice(t, []float32{1, 1.5, 1, 3}, reduced.GetResults().GetScores(), 10e-8)
+
+		// group by field not found in results
+		_, err = reduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{r1, r2}, nq, topk, distance.L2, schemapb.DataType_Int64, 0, 102, 2)
+		assert.Error(t, err)
+	})
+
 	t.Run("String ID", func(t *testing.T) {
 		resultData := []string{"50", "49", "48", "47", "46", "45", "44", "43", "42", "41"}
 
@@ -1550,7 +1607,7 @@ func TestTaskSearch_reduceSearchResultData(t *testing.T) {
 			results = append(results, r)
 		}
 
-		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_VarChar, 0)
+		reduced, err := reduceSearchResultData(context.TODO(), results, nq, topk, distance.L2, schemapb.DataType_VarChar, 0, 0, 0)
 
 		assert.NoError(t, err)
 		assert.Equal(t, resultData, reduced.GetResults().GetIds().GetStrId().GetData())
@@ -1568,7 +1625,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		})
 		globalMetaCache = cache
 		var qc types.QueryCoord
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{})
+		_, err := checkIfLoaded(context.Background(), qc, dbName, "test", []UniqueID{})
 		assert.Error(t, err)
 	})
 
@@ -1579,7 +1636,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		})
 		globalMetaCache = cache
 		var qc types.QueryCoord
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{})
+		loaded, err := checkIfLoaded(context.Background(), qc, dbName, "test", []UniqueID{})
 		assert.NoError(t, err)
 		assert.True(t, loaded)
 	})
@@ -1594,7 +1651,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return nil, errors.New("mock")
 		})
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		_, err := checkIfLoaded(context.Background(), qc, dbName, "test", []UniqueID{1, 2})
 		assert.Error(t, err)
 	})
 
@@ -1608,7 +1665,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists}}, nil
 		})
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		_, err := checkIfLoaded(context.Background(), qc, dbName, "test", []UniqueID{1, 2})
 		assert.Error(t, err)
 	})
 
@@ -1622,7 +1679,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, InMemoryPercentages: []int64{100, 100}}, nil
 		})
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		loaded, err := checkIfLoaded(context.Background(), qc, dbName, "test", []UniqueID{1, 2})
 		assert.NoError(t, err)
 		assert.True(t, loaded)
 	})
@@ -1637,7 +1694,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, InMemoryPercentages: []int64{100, 50}}, nil
 		})
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		loaded, err := checkIfLoaded(context.Background(), qc, dbName, "test", []UniqueID{1, 2})
 		assert.NoError(t, err)
 		assert.False(t, loaded)
 	})
@@ -1652,7 +1709,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return nil, errors.New("mock")
 		})
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		_, err := checkIfLoaded(context.Background(), qc, dbName, "test", []UniqueID{1, 2})
 		assert.Error(t, err)
 	})
 
@@ -1666,7 +1723,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists}}, nil
 		})
-		_, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{1, 2})
+		_, err := checkIfLoaded(context.Background(), qc, dbName, "test", []UniqueID{1, 2})
 		assert.Error(t, err)
 	})
 
@@ -1680,7 +1737,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, PartitionIDs: []UniqueID{1, 2}}, nil
 		})
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{})
+		loaded, err := checkIfLoaded(context.Background(), qc, dbName, "test", []UniqueID{})
 		assert.NoError(t, err)
 		assert.False(t, loaded)
 	})
@@ -1695,7 +1752,7 @@ func Test_checkIfLoaded(t *testing.T) {
 		qc.SetShowPartitionsFunc(func(ctx context.Context, request *querypb.ShowPartitionsRequest) (*querypb.ShowPartitionsResponse, error) {
 			return &querypb.ShowPartitionsResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, PartitionIDs: []UniqueID{}}, nil
 		})
-		loaded, err := checkIfLoaded(context.Background(), qc, "test", []UniqueID{})
+		loaded, err := checkIfLoaded(context.Background(), qc, dbName, "test", []UniqueID{})
 		assert.NoError(t, err)
 		assert.False(t, loaded)
 	})
@@ -1764,7 +1821,7 @@ func TestSearchTask_ErrExecute(t *testing.T) {
 	require.NoError(t, createColT.Execute(ctx))
 	require.NoError(t, createColT.PostExecute(ctx))
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
@@ -1847,6 +1904,191 @@ func TestSearchTask_ErrExecute(t *testing.T) {
 	assert.NoError(t, task.Execute(ctx))
 }
 
+func TestSearchTask_GroupByExpand(t *testing.T) {
+	var (
+		ctx = context.TODO()
+
+		rc = NewRootCoordMock()
+		qc = NewQueryCoordMock(withValidShardLeaders())
+		qn = &QueryNodeMock{}
+
+		collectionName = t.Name() + funcutil.GenRandomStr()
+		groupTopk      = int64(3)
+	)
+
+	mgr := newShardClientMgr(withShardClientCreator(func(ctx context.Context, address string) (types.QueryNode, error) {
+		return qn, nil
+	}))
+	rc.Start()
+	defer rc.Stop()
+	qc.Start()
+	defer qc.Stop()
+	require.NoError(t, InitMetaCache(ctx, rc, qc, mgr))
+
+	fieldName2Types := map[string]schemapb.DataType{
+		testInt64Field:    schemapb.DataType_Int64,
+		testInt32Field:    schemapb.DataType_Int32,
+		testFloatVecField: schemapb.DataType_FloatVector,
+	}
+	marshaledSchema, err := proto.Marshal(constructCollectionSchemaByDataType(collectionName, fieldName2Types, testInt64Field, false))
+	require.NoError(t, err)
+	createColT := &createCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+			CollectionName: collectionName,
+			Schema:         marshaledSchema,
+			ShardsNum:      testShardsNum,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+	require.NoError(t, createColT.OnEnqueue())
+	require.NoError(t, createColT.PreExecute(ctx))
+	require.NoError(t, createColT.Execute(ctx))
+	require.NoError(t, createColT.PostExecute(ctx))
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	require.NoError(t, err)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, collectionName)
+	require.NoError(t, err)
+	var groupByField *schemapb.FieldSchema
+	for _, field := range schema.GetFields() {
+		if field.GetName() == testInt32Field {
+			groupByField = field
+		}
+	}
+	require.NotNil(t, groupByField)
+	status, err := qc.LoadCollection(ctx, &querypb.LoadCollectionRequest{
+		Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_LoadCollection},
+		CollectionID: collectionID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+	// the first 40 of @numEntities candidates belong to one group, the others are distinct groups,
+	// the shard leader returns the best topk of them.
+	leaderSearchResults := func(topk int64, numEntities int) *internalpb.SearchResults {
+		n := numEntities
+		if int64(n) > topk {
+			n = int(topk)
+		}
+		ids := make([]int64, n)
+		scores := make([]float32, n)
+		groups := make([]int32, n)
+		for i := 0; i < n; i++ {
+			ids[i] = int64(i + 1)
+			scores[i] = float32(numEntities - i)
+			if i >= 40 {
+				groups[i] = int32(i)
+			}
+		}
+		data := &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       topk,
+			Scores:     scores,
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+			Topks:      []int64{int64(n)},
+			FieldsData: []*schemapb.FieldData{{
+				Type:    schemapb.DataType_Int32,
+				FieldId: groupByField.GetFieldID(),
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: groups}}},
+				},
+			}},
+		}
+		blob, err := proto.Marshal(data)
+		require.NoError(t, err)
+		return &internalpb.SearchResults{
+			Status:          &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			MetricType:      distance.IP,
+			NumQueries:      1,
+			TopK:            topk,
+			SlicedBlob:      blob,
+			GroupsTruncated: []bool{n < numEntities},
+		}
+	}
+
+	newTask := func(numEntities int) *searchTask {
+		topk := groupTopk * groupBySearchFactor
+		plan, err := proto.Marshal(&planpb.PlanNode{
+			Node: &planpb.PlanNode_VectorAnns{
+				VectorAnns: &planpb.VectorANNS{QueryInfo: &planpb.QueryInfo{Topk: topk, MetricType: distance.IP}},
+			},
+		})
+		require.NoError(t, err)
+		task := &searchTask{
+			Condition: NewTaskCondition(ctx),
+			SearchRequest: &internalpb.SearchRequest{
+				Base:               &commonpb.MsgBase{MsgType: commonpb.MsgType_Search},
+				CollectionID:       collectionID,
+				SerializedExprPlan: plan,
+				MetricType:         distance.IP,
+				Nq:                 1,
+				Topk:               topk,
+				GroupByFieldId:     groupByField.GetFieldID(),
+				GroupTopk:          groupTopk,
+			},
+			ctx:            ctx,
+			tr:             timerecord.NewTimeRecorder("search"),
+			request:        &milvuspb.SearchRequest{CollectionName: collectionName, OutputFields: []string{testInt32Field}},
+			collectionName: collectionName,
+			schema:         schema,
+			qc:             qc,
+			shardMgr:       mgr,
+		}
+		task.searchShardPolicy = func(context.Context, *shardClientMgr, func(context.Context, int64, types.QueryNode, []string) error, map[string][]nodeInfo) error {
+			task.resultBuf <- leaderSearchResults(task.SearchRequest.GetTopk(), numEntities)
+			return nil
+		}
+		return task
+	}
+
+	t.Run("one group dominates", func(t *testing.T) {
+		task := newTask(1000)
+		require.NoError(t, task.Execute(ctx))
+		require.NoError(t, task.PostExecute(ctx))
+
+		// the first 30 candidates cover only one group, the search is expanded to 60 candidates
+		assert.Equal(t, int64(60), task.SearchRequest.GetTopk())
+		plan := &planpb.PlanNode{}
+		require.NoError(t, proto.Unmarshal(task.SearchRequest.GetSerializedExprPlan(), plan))
+		assert.Equal(t, int64(60), plan.GetVectorAnns().GetQueryInfo().GetTopk())
+		assert.Equal(t, []int64{1, 41, 42}, task.result.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{3}, task.result.GetResults().GetTopks())
+		assert.Equal(t, []int32{0, 40, 41}, task.result.GetResults().GetFieldsData()[0].GetScalars().GetIntData().GetData())
+	})
+
+	t.Run("exhausted", func(t *testing.T) {
+		task := newTask(35)
+		require.NoError(t, task.Execute(ctx))
+		require.NoError(t, task.PostExecute(ctx))
+
+		// all the candidates are searched, there is only one group
+		assert.Equal(t, int64(60), task.SearchRequest.GetTopk())
+		assert.Equal(t, []int64{1}, task.result.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{1}, task.result.GetResults().GetTopks())
+	})
+
+	t.Run("limit reached", func(t *testing.T) {
+		task := newTask(100000)
+		task.SearchRequest.Topk = searchCountLimit
+		expanded, err := task.expandGroupBySearch()
+		assert.NoError(t, err)
+		assert.False(t, expanded)
+
+		task.SearchRequest.Topk = searchCountLimit - 1
+		expanded, err = task.expandGroupBySearch()
+		assert.NoError(t, err)
+		assert.True(t, expanded)
+		assert.Equal(t, int64(searchCountLimit), task.SearchRequest.GetTopk())
+
+		task.SearchRequest.SerializedExprPlan = []byte("invalid")
+		task.SearchRequest.Topk = groupTopk
+		_, err = task.expandGroupBySearch()
+		assert.Error(t, err)
+	})
+}
+
 func TestTaskSearch_parseQueryInfo(t *testing.T) {
 	t.Run("parseSearchInfo no error", func(t *testing.T) {
 		var targetOffset int64 = 200
@@ -1863,6 +2105,77 @@ func TestTaskSearch_parseQueryInfo(t *testing.T) {
 		assert.Equal(t, targetOffset, offset)
 	})
 
+	t.Run("parseSearchInfo range search", func(t *testing.T) {
+		sp := getValidSearchParams()
+		info, _, err := parseSearchInfo(sp)
+		assert.NoError(t, err)
+		assert.False(t, info.GetIsRangeSearch())
+
+		sp = append(sp, &commonpb.KeyValuePair{Key: RadiusKey, Value: "10"})
+		info, _, err = parseSearchInfo(sp)
+		assert.NoError(t, err)
+		assert.True(t, info.GetIsRangeSearch())
+		assert.Equal(t, float32(10), info.GetRadius())
+		assert.Equal(t, float32(-math.MaxFloat32), info.GetRangeFilter())
+
+		info, _, err = parseSearchInfo(append(sp, &commonpb.KeyValuePair{Key: RangeFilterKey, Value: "1"}))
+		assert.NoError(t, err)
+		assert.Equal(t, float32(1), info.GetRangeFilter())
+
+		// range_filter must be less than radius for L2
+		_, _, err = parseSearchInfo(append(sp, &commonpb.KeyValuePair{Key: RangeFilterKey, Value: "20"}))
+		assert.Error(t, err)
+
+		_, _, err = parseSearchInfo(append(sp, &commonpb.KeyValuePair{Key: RangeFilterKey, Value: "invalid"}))
+		assert.Error(t, err)
+
+		_, _, err = parseSearchInfo(append(getValidSearchParams(), &commonpb.KeyValuePair{Key: RadiusKey, Value: "invalid"}))
+		assert.Error(t, err)
+
+		ipParams := []*commonpb.KeyValuePair{
+			{Key: TopKKey, Value: "10"},
+			{Key: common.MetricTypeKey, Value: distance.IP},
+			{Key: SearchParamsKey, Value: `{"nprobe": 10}`},
+			{Key: RadiusKey, Value: "0.5"},
+		}
+		info, _, err = parseSearchInfo(ipParams)
+		assert.NoError(t, err)
+		assert.Equal(t, float32(0.5), info.GetRadius())
+		assert.Equal(t, float32(math.MaxFloat32), info.GetRangeFilter())
+
+		// range_filter must be greater than radius for IP
+		_, _, err = parseSearchInfo(append(ipParams, &commonpb.KeyValuePair{Key: RangeFilterKey, Value: "0.1"}))
+		assert.Error(t, err)
+		info, _, err = parseSearchInfo(append(ipParams, &commonpb.KeyValuePair{Key: RangeFilterKey, Value: "0.9"}))
+		assert.NoError(t, err)
+		assert.Equal(t, float32(0.9), info.GetRangeFilter())
+	})
+
+	t.Run("parseGroupByField", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "doc_id", DataType: schemapb.DataType_VarChar},
+				{FieldID: 102, Name: "score", DataType: schemapb.DataType_Float},
+				{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			},
+		}
+		field, err := parseGroupByField(getValidSearchParams(), schema)
+		assert.NoError(t, err)
+		assert.Nil(t, field)
+
+		field, err = parseGroupByField([]*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "doc_id"}}, schema)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(101), field.GetFieldID())
+
+		_, err = parseGroupByField([]*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "score"}}, schema)
+		assert.Error(t, err)
+		_, err = parseGroupByField([]*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "vec"}}, schema)
+		assert.Error(t, err)
+		_, err = parseGroupByField([]*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "not_exist"}}, schema)
+		assert.Error(t, err)
+	})
+
 	t.Run("parseSearchInfo error", func(t *testing.T) {
 		spNoTopk := []*commonpb.KeyValuePair{{
 			Key:   AnnsFieldKey,
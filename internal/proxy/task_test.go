@@ -818,7 +818,7 @@ func TestHasCollectionTask(t *testing.T) {
 	assert.Equal(t, false, task.result.Value)
 	// createCollection in RootCood and fill GlobalMetaCache
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// success to drop collection
 	err = task.Execute(ctx)
@@ -937,7 +937,7 @@ func TestDescribeCollectionTask_ShardsNum1(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -999,7 +999,7 @@ func TestDescribeCollectionTask_ShardsNum2(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1040,6 +1040,7 @@ func TestCreatePartitionTask(t *testing.T) {
 	dbName := ""
 	collectionName := prefix + funcutil.GenRandomStr()
 	partitionName := prefix + funcutil.GenRandomStr()
+	globalMetaCache = newMockCache()
 
 	task := &createPartitionTask{
 		Condition: NewTaskCondition(ctx),
@@ -1357,7 +1358,7 @@ func TestTask_Int64PrimaryKey(t *testing.T) {
 		})
 	})
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
@@ -1534,6 +1535,113 @@ func TestTask_Int64PrimaryKey(t *testing.T) {
 		}
 		assert.Error(t, task2.PreExecute(ctx))
 	})
+
+	t.Run("upsert", func(t *testing.T) {
+		task := &upsertTask{
+			insertMsg: &BaseInsertTask{
+				InsertRequest: internalpb.InsertRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:  commonpb.MsgType_Insert,
+						MsgID:    0,
+						SourceID: paramtable.GetNodeID(),
+					},
+					DbName:         dbName,
+					CollectionName: collectionName,
+					PartitionName:  partitionName,
+					NumRows:        uint64(nb),
+					Version:        internalpb.InsertDataVersion_ColumnBased,
+				},
+			},
+			deleteMsg: &BaseDeleteTask{
+				DeleteRequest: internalpb.DeleteRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:  commonpb.MsgType_Delete,
+						MsgID:    0,
+						SourceID: paramtable.GetNodeID(),
+					},
+					DbName:         dbName,
+					CollectionName: collectionName,
+					PartitionName:  partitionName,
+				},
+			},
+			Condition:     NewTaskCondition(ctx),
+			ctx:           ctx,
+			idAllocator:   idAllocator,
+			segIDAssigner: segAllocator,
+			chMgr:         chMgr,
+			chTicker:      ticker,
+		}
+
+		for fieldName, dataType := range fieldName2Types {
+			task.insertMsg.FieldsData = append(task.insertMsg.FieldsData, generateFieldData(dataType, fieldName, nb))
+		}
+
+		ts := Timestamp(time.Now().UnixNano())
+		task.SetTs(ts)
+		assert.Equal(t, ts, task.BeginTs())
+		assert.Equal(t, ts, task.EndTs())
+
+		assert.NoError(t, task.OnEnqueue())
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.Equal(t, int64(nb), task.deleteMsg.NumRows)
+		assert.Equal(t, task.result.IDs, task.deleteMsg.PrimaryKeys)
+		for i := 0; i < nb; i++ {
+			assert.Equal(t, ts, task.insertMsg.Timestamps[i])
+			assert.Equal(t, ts, task.deleteMsg.Timestamps[i])
+		}
+		assert.NoError(t, task.Execute(ctx))
+		assert.NoError(t, task.PostExecute(ctx))
+		assert.Equal(t, partitionName, task.insertMsg.PartitionName)
+		assert.Equal(t, task.insertMsg.PartitionID, task.deleteMsg.PartitionID)
+	})
+
+	t.Run("upsert duplicated primary keys", func(t *testing.T) {
+		task := &upsertTask{
+			insertMsg: &BaseInsertTask{
+				InsertRequest: internalpb.InsertRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:  commonpb.MsgType_Insert,
+						SourceID: paramtable.GetNodeID(),
+					},
+					DbName:         dbName,
+					CollectionName: collectionName,
+					PartitionName:  partitionName,
+					NumRows:        uint64(nb),
+					Version:        internalpb.InsertDataVersion_ColumnBased,
+				},
+			},
+			deleteMsg: &BaseDeleteTask{
+				DeleteRequest: internalpb.DeleteRequest{
+					Base: &commonpb.MsgBase{
+						MsgType:  commonpb.MsgType_Delete,
+						SourceID: paramtable.GetNodeID(),
+					},
+					DbName:         dbName,
+					CollectionName: collectionName,
+					PartitionName:  partitionName,
+				},
+			},
+			Condition:     NewTaskCondition(ctx),
+			ctx:           ctx,
+			idAllocator:   idAllocator,
+			segIDAssigner: segAllocator,
+			chMgr:         chMgr,
+			chTicker:      ticker,
+		}
+
+		for fieldName, dataType := range fieldName2Types {
+			fieldData := generateFieldData(dataType, fieldName, nb)
+			if fieldName == testInt64Field {
+				pks := fieldData.GetScalars().GetLongData().GetData()
+				pks[1] = pks[0]
+			}
+			task.insertMsg.FieldsData = append(task.insertMsg.FieldsData, fieldData)
+		}
+
+		task.SetTs(Timestamp(time.Now().UnixNano()))
+		assert.NoError(t, task.OnEnqueue())
+		assert.Error(t, task.PreExecute(ctx))
+	})
 }
 
 func TestTask_VarCharPrimaryKey(t *testing.T) {
@@ -1609,7 +1717,7 @@ func TestTask_VarCharPrimaryKey(t *testing.T) {
 		})
 	})
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
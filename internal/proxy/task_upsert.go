@@ -0,0 +1,380 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// upsertTask replaces entities by primary key. It emits a delete message for the
+// primary keys carried by the request and an insert message for the new rows,
+// both stamped with the same timestamp, in a single message pack.
+type upsertTask struct {
+	Condition
+	insertMsg *BaseInsertTask
+	deleteMsg *BaseDeleteTask
+	ctx       context.Context
+
+	result        *milvuspb.MutationResult
+	idAllocator   *allocator.IDAllocator
+	segIDAssigner *segIDAssigner
+	chMgr         channelsMgr
+	chTicker      channelsTimeTicker
+	vChannels     []vChan
+	pChannels     []pChan
+	schema        *schemapb.CollectionSchema
+}
+
+// TraceCtx returns upsertTask context
+func (ut *upsertTask) TraceCtx() context.Context {
+	return ut.ctx
+}
+
+func (ut *upsertTask) ID() UniqueID {
+	return ut.insertMsg.Base.MsgID
+}
+
+func (ut *upsertTask) SetID(uid UniqueID) {
+	ut.insertMsg.Base.MsgID = uid
+	ut.deleteMsg.Base.MsgID = uid
+}
+
+func (ut *upsertTask) Name() string {
+	return UpsertTaskName
+}
+
+func (ut *upsertTask) Type() commonpb.MsgType {
+	return ut.insertMsg.Base.MsgType
+}
+
+func (ut *upsertTask) BeginTs() Timestamp {
+	return ut.insertMsg.BeginTimestamp
+}
+
+func (ut *upsertTask) SetTs(ts Timestamp) {
+	ut.insertMsg.BeginTimestamp = ts
+	ut.insertMsg.EndTimestamp = ts
+	ut.deleteMsg.Base.Timestamp = ts
+}
+
+func (ut *upsertTask) EndTs() Timestamp {
+	return ut.insertMsg.EndTimestamp
+}
+
+func (ut *upsertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
+	ret := make(map[pChan]pChanStatistics)
+
+	channels, err := ut.getChannels()
+	if err != nil {
+		return ret, err
+	}
+
+	beginTs := ut.BeginTs()
+	endTs := ut.EndTs()
+
+	for _, channel := range channels {
+		ret[channel] = pChanStatistics{
+			minTs: beginTs,
+			maxTs: endTs,
+		}
+	}
+	return ret, nil
+}
+
+func (ut *upsertTask) getChannels() ([]pChan, error) {
+	collID, err := globalMetaCache.GetCollectionID(ut.ctx, ut.insertMsg.GetDbName(), ut.insertMsg.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	return ut.chMgr.getChannels(collID)
+}
+
+func (ut *upsertTask) OnEnqueue() error {
+	return nil
+}
+
+func (ut *upsertTask) PreExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-PreExecute")
+	defer sp.Finish()
+
+	ut.result = &milvuspb.MutationResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		IDs: &schemapb.IDs{
+			IdField: nil,
+		},
+		Timestamp: ut.EndTs(),
+	}
+
+	collectionName := ut.insertMsg.CollectionName
+	if err := validateCollectionName(collectionName); err != nil {
+		log.Error("valid collection name failed", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, ut.insertMsg.GetDbName(), collectionName)
+	if err != nil {
+		log.Error("get collection schema from global meta cache failed", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+	ut.schema = collSchema
+
+	// the rows of a partition key collection are routed to partitions by their partition key
+	if typeutil.HasPartitionKey(collSchema) {
+		if len(ut.insertMsg.PartitionName) > 0 {
+			return errPartitionKeyModeNotSupported
+		}
+	} else {
+		if len(ut.insertMsg.PartitionName) <= 0 {
+			ut.insertMsg.PartitionName = Params.CommonCfg.DefaultPartitionName.GetValue()
+		}
+		partitionTag := ut.insertMsg.PartitionName
+		if err := validatePartitionTag(partitionTag, true); err != nil {
+			log.Error("valid partition name failed", zap.String("partition name", partitionTag), zap.Error(err))
+			return err
+		}
+	}
+
+	// the primary keys of the replaced entities must be provided by user,
+	// an auto generated primary key never matches an existing entity.
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(collSchema)
+	if err != nil {
+		log.Error("get primary field schema failed", zap.String("collectionName", collectionName), zap.Error(err))
+		return err
+	}
+	if primaryFieldSchema.GetAutoID() {
+		return fmt.Errorf("upsert can not be applied on collection with auto id enabled, collection name = %s", collectionName)
+	}
+
+	rowNums := uint32(ut.insertMsg.NRows())
+	// set upsertTask.rowIDs
+	var rowIDBegin UniqueID
+	var rowIDEnd UniqueID
+	tr := timerecord.NewTimeRecorder("applyPK")
+	rowIDBegin, rowIDEnd, _ = ut.idAllocator.Alloc(rowNums)
+	metrics.ProxyApplyPrimaryKeyLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	ut.insertMsg.RowIDs = make([]UniqueID, rowNums)
+	for i := rowIDBegin; i < rowIDEnd; i++ {
+		offset := i - rowIDBegin
+		ut.insertMsg.RowIDs[offset] = i
+	}
+	// insert and delete share the same timestamp, so that a search at any
+	// timestamp observes either the old entities or the new ones.
+	rowNum := ut.insertMsg.NRows()
+	ut.insertMsg.Timestamps = make([]uint64, rowNum)
+	for index := range ut.insertMsg.Timestamps {
+		ut.insertMsg.Timestamps[index] = ut.insertMsg.BeginTimestamp
+	}
+
+	// set result.SuccIndex
+	sliceIndex := make([]uint32, rowNums)
+	for i := uint32(0); i < rowNums; i++ {
+		sliceIndex[i] = i
+	}
+	ut.result.SuccIndex = sliceIndex
+
+	log := log.Ctx(ctx).With(zap.String("collectionName", collectionName))
+	// fill the nullable or defaulted fields absent in the request, the fields could be added after the client cached the schema,
+	// the rows of the absent nullable fields without default value are null.
+	ut.insertMsg.ValidData = typeutil.FillMissingValidData(collSchema, ut.insertMsg.GetFieldsData(), ut.insertMsg.GetValidData(), int(rowNum))
+	ut.insertMsg.FieldsData, err = typeutil.FillMissingFieldData(collSchema, ut.insertMsg.GetFieldsData(), int(rowNum))
+	if err != nil {
+		log.Error("fill default values of absent fields failed", zap.Error(err))
+		return err
+	}
+	ut.insertMsg.SchemaVersion, err = globalMetaCache.GetCollectionSchemaVersion(ctx, ut.insertMsg.GetDbName(), collectionName)
+	if err != nil {
+		log.Error("get collection schema version from global meta cache failed", zap.Error(err))
+		return err
+	}
+
+	ut.result.IDs, err = checkPrimaryFieldData(ut.schema, ut.insertMsg)
+	if err != nil {
+		log.Error("check primary field data and hash primary key failed",
+			zap.Error(err))
+		return err
+	}
+	// the rows of a primary key share the same timestamp, so it's undefined which one survives.
+	if err = checkDuplicatePrimaryKeys(ut.result.IDs); err != nil {
+		log.Error("duplicated primary keys in upsert request",
+			zap.Error(err))
+		return err
+	}
+
+	// set field ID to insert field data
+	err = fillFieldIDBySchema(ut.insertMsg.GetFieldsData(), collSchema)
+	if err != nil {
+		log.Error("set fieldID to fieldData failed",
+			zap.Error(err))
+		return err
+	}
+
+	if err = validateJSONFieldData(ut.insertMsg.GetFieldsData()); err != nil {
+		log.Error("invalid JSON field data",
+			zap.Error(err))
+		return err
+	}
+
+	if err = validateArrayFieldData(ut.insertMsg.GetFieldsData(), collSchema); err != nil {
+		log.Error("invalid array field data",
+			zap.Error(err))
+		return err
+	}
+
+	// check that all field's number rows are equal
+	if err = ut.insertMsg.CheckAligned(); err != nil {
+		log.Error("field data is not aligned",
+			zap.Error(err))
+		return err
+	}
+
+	// the delete part removes the old entities with the same primary keys
+	ut.deleteMsg.PrimaryKeys = ut.result.IDs
+	ut.deleteMsg.NumRows = int64(rowNum)
+	ut.deleteMsg.Timestamps = make([]uint64, rowNum)
+	for index := range ut.deleteMsg.Timestamps {
+		ut.deleteMsg.Timestamps[index] = ut.deleteMsg.Base.Timestamp
+	}
+	ut.result.UpsertCnt = int64(rowNum)
+
+	log.Debug("Proxy Upsert PreExecute done")
+
+	return nil
+}
+
+// checkDuplicatePrimaryKeys returns error if a primary key appears more than once in @ids.
+func checkDuplicatePrimaryKeys(ids *schemapb.IDs) error {
+	size := typeutil.GetSizeOfIDs(ids)
+	pks := make(map[interface{}]struct{}, size)
+	for i := 0; i < size; i++ {
+		pk := typeutil.GetPK(ids, int64(i))
+		if _, ok := pks[pk]; ok {
+			return fmt.Errorf("duplicated primary key %v", pk)
+		}
+		pks[pk] = struct{}{}
+	}
+	return nil
+}
+
+func (ut *upsertTask) Execute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-Execute")
+	defer sp.Finish()
+
+	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute upsert %d", ut.ID()))
+	defer tr.Elapse("upsert execute done")
+
+	collectionName := ut.insertMsg.CollectionName
+	collID, err := globalMetaCache.GetCollectionID(ctx, ut.insertMsg.GetDbName(), collectionName)
+	if err != nil {
+		return err
+	}
+	ut.insertMsg.CollectionID = collID
+	ut.deleteMsg.CollectionID = collID
+
+	keyField, _ := typeutil.GetPartitionKeyFieldSchema(ut.schema)
+	var partitionID UniqueID
+	var partitionNames []string
+	var partitionIDs []UniqueID
+	if keyField != nil {
+		partitionNames, partitionIDs, err = getPartitionKeyPartitions(ctx, ut.insertMsg.GetDbName(), collectionName)
+	} else {
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, ut.insertMsg.GetDbName(), collectionName, ut.insertMsg.PartitionName)
+	}
+	if err != nil {
+		return err
+	}
+	ut.insertMsg.PartitionID = partitionID
+	// if the partition is not specified, the old entities are removed from all partitions
+	if len(ut.deleteMsg.PartitionName) > 0 {
+		ut.deleteMsg.PartitionID = partitionID
+	} else {
+		ut.deleteMsg.PartitionID = common.InvalidPartitionID
+	}
+	tr.Record("get collection id & partition id from cache")
+
+	stream, err := ut.chMgr.getOrCreateDmlStream(collID)
+	if err != nil {
+		return err
+	}
+	tr.Record("get used message stream")
+
+	channelNames, err := ut.chMgr.getVChannels(collID)
+	if err != nil {
+		log.Ctx(ctx).Error("get vChannels failed",
+			zap.Int64("collectionID", collID),
+			zap.Error(err))
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+
+	log.Ctx(ctx).Debug("send upsert request to virtual channels",
+		zap.String("collection", collectionName),
+		zap.String("partition", ut.insertMsg.GetPartitionName()),
+		zap.Int64("collection_id", collID),
+		zap.Int64("partition_id", partitionID),
+		zap.Strings("virtual_channels", channelNames),
+		zap.Int64("task_id", ut.ID()))
+
+	// the delete messages are packed ahead of the insert messages,
+	// both of them are produced within one message pack.
+	msgPack := &msgstream.MsgPack{
+		BeginTs: ut.BeginTs(),
+		EndTs:   ut.EndTs(),
+		Msgs:    repackDeleteMsgByHash(ctx, ut.deleteMsg, channelNames),
+	}
+	tr.Record("pack delete messages")
+
+	// assign segmentID for insert data and repack data by segmentID
+	var insertMsgPack *msgstream.MsgPack
+	if keyField != nil {
+		insertMsgPack, err = assignSegmentIDByPartitionKey(ut.TraceCtx(), ut.insertMsg, ut.result, keyField, partitionNames, partitionIDs, channelNames, ut.idAllocator, ut.segIDAssigner)
+	} else {
+		insertMsgPack, err = assignSegmentID(ut.TraceCtx(), ut.insertMsg, ut.result, channelNames, ut.idAllocator, ut.segIDAssigner)
+	}
+	if err != nil {
+		log.Error("assign segmentID and repack insert data failed",
+			zap.Int64("collectionID", collID),
+			zap.Error(err))
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+	msgPack.Msgs = append(msgPack.Msgs, insertMsgPack.Msgs...)
+	tr.Record("assign segment id")
+
+	err = stream.Produce(msgPack)
+	if err != nil {
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+	sendMsgDur := tr.Record("send upsert request to dml channel")
+	metrics.ProxySendMutationReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel).Observe(float64(sendMsgDur.Milliseconds()))
+
+	log.Debug("Proxy Upsert Execute done",
+		zap.String("collectionName", collectionName))
+
+	return nil
+}
+
+func (ut *upsertTask) PostExecute(ctx context.Context) error {
+	return nil
+}
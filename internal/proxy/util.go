@@ -18,12 +18,14 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
@@ -37,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -46,7 +49,7 @@ const (
 	boundedTS = 2
 
 	// enableMultipleVectorFields indicates whether to enable multiple vector fields.
-	enableMultipleVectorFields = false
+	enableMultipleVectorFields = true
 
 	// maximum length of variable-length strings
 	maxVarCharLengthKey = "max_length"
@@ -130,6 +133,35 @@ func validateCollectionName(collName string) error {
 	return validateCollectionNameOrAlias(collName, "name")
 }
 
+func validateDatabaseName(dbName string) error {
+	dbName = strings.TrimSpace(dbName)
+
+	if dbName == "" {
+		return errors.New("database name should not be empty")
+	}
+
+	invalidMsg := "Invalid database name: " + dbName + ". "
+	if len(dbName) > Params.ProxyCfg.MaxNameLength.GetAsInt() {
+		msg := invalidMsg + "The length of a database name must be less than " + Params.ProxyCfg.MaxNameLength.GetValue() + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := dbName[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of a database name must be an underscore or letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(dbName); i++ {
+		c := dbName[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Database name can only contain numbers, letters and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
 func validatePartitionTag(partitionTag string, strictCheck bool) error {
 	partitionTag = strings.TrimSpace(partitionTag)
 
@@ -276,6 +308,10 @@ func validateFieldType(schema *schemapb.CollectionSchema) error {
 			return errors.New("string data type not supported yet, please use VarChar type instead")
 		case schemapb.DataType_None:
 			return errors.New("data type None is not valid")
+		case typeutil.DataTypeArray:
+			if err := typeutil.ValidateArrayFieldSchema(field); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -328,6 +364,31 @@ func validatePrimaryKey(coll *schemapb.CollectionSchema) error {
 	return nil
 }
 
+// validatePartitionKey check the definition of the partition key field if there is one.
+func validatePartitionKey(coll *schemapb.CollectionSchema) error {
+	idx := -1
+	for i, field := range coll.Fields {
+		if !typeutil.IsPartitionKeyField(field) {
+			continue
+		}
+		if idx != -1 {
+			return fmt.Errorf("there are more than one partition key, field name = %s, %s", coll.Fields[idx].Name, field.Name)
+		}
+
+		if field.IsPrimaryKey {
+			return errors.New("the partition key field must not be primary field")
+		}
+
+		// The type of the partition key field can only be int64 and varchar
+		if field.DataType != schemapb.DataType_Int64 && field.DataType != schemapb.DataType_VarChar {
+			return errors.New("the data type of partition key should be Int64 or VarChar")
+		}
+
+		idx = i
+	}
+	return nil
+}
+
 // RepeatedKeyValToMap transfer the kv pairs to map.
 func RepeatedKeyValToMap(kvPairs []*commonpb.KeyValuePair) (map[string]string, error) {
 	resMap := make(map[string]string)
@@ -556,6 +617,51 @@ func fillFieldIDBySchema(columns []*schemapb.FieldData, schema *schemapb.Collect
 	return nil
 }
 
+// validateJSONFieldData checks that the documents of the JSON fields are valid JSON objects,
+// call after fillFieldIDBySchema.
+func validateJSONFieldData(columns []*schemapb.FieldData) error {
+	for _, fieldData := range columns {
+		if !typeutil.IsJSONType(fieldData.GetType()) {
+			continue
+		}
+		for i, doc := range fieldData.GetScalars().GetBytesData().GetData() {
+			var obj map[string]interface{}
+			if err := json.Unmarshal(doc, &obj); err != nil || obj == nil {
+				return fmt.Errorf("the %dth document of JSON field %s is not a valid JSON object", i, fieldData.GetFieldName())
+			}
+		}
+	}
+	return nil
+}
+
+// validateArrayFieldData checks that every row of the array fields is a marshaled schemapb.ScalarField
+// of the element type, and its length doesn't exceed the max capacity. Call after fillFieldIDBySchema.
+func validateArrayFieldData(columns []*schemapb.FieldData, schema *schemapb.CollectionSchema) error {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return err
+	}
+	for _, fieldData := range columns {
+		if !typeutil.IsArrayType(fieldData.GetType()) {
+			continue
+		}
+		field, err := helper.GetFieldFromID(fieldData.GetFieldId())
+		if err != nil {
+			return err
+		}
+		for i, bytes := range fieldData.GetScalars().GetBytesData().GetData() {
+			array := &schemapb.ScalarField{}
+			if err := proto.Unmarshal(bytes, array); err != nil {
+				return fmt.Errorf("the %dth row of array field %s is invalid: %s", i, field.GetName(), err.Error())
+			}
+			if err := typeutil.ValidateArray(field, array); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func ValidateUsername(username string) error {
 	username = strings.TrimSpace(username)
 
@@ -690,6 +796,10 @@ func ValidatePrivilege(entity string) error {
 }
 
 func GetCurUserFromContext(ctx context.Context) (string, error) {
+	// the user authenticated by an api key or a JWT
+	if username, ok := ctx.Value(authenticatedUserKey{}).(string); ok {
+		return username, nil
+	}
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return "", fmt.Errorf("fail to get md from the context")
@@ -699,6 +809,9 @@ func GetCurUserFromContext(ctx context.Context) (string, error) {
 		return "", fmt.Errorf("fail to get authorization from the md, authorize:[%s]", util.HeaderAuthorize)
 	}
 	token := authorization[0]
+	if strings.HasPrefix(token, bearerPrefix) {
+		return "", fmt.Errorf("the bearer token is not authenticated")
+	}
 	rawToken, err := crypto.Base64Decode(token)
 	if err != nil {
 		return "", fmt.Errorf("fail to decode the token, token: %s", token)
@@ -718,6 +831,34 @@ func GetRole(username string) ([]string, error) {
 	return globalMetaCache.GetUserRole(username), nil
 }
 
+// getApiKeyOperator returns the authenticated user who manages the api keys. The api keys act as their users
+// once the authorization is enabled, so they are only managed by the authenticated users.
+func getApiKeyOperator(ctx context.Context) (string, error) {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return "", fmt.Errorf("the api keys are only managed when the authorization is enabled")
+	}
+	return GetCurUserFromContext(ctx)
+}
+
+// checkApiKeyOwner checks that the api keys of the user are managed by the user itself or an admin,
+// an empty username refers to the api keys of all the users.
+func checkApiKeyOwner(operator string, username string) error {
+	if operator == username || operator == util.UserRoot {
+		return nil
+	}
+	roles, err := GetRole(operator)
+	if err != nil {
+		return err
+	}
+	if funcutil.SliceContain(roles, util.RoleAdmin) {
+		return nil
+	}
+	if username == "" {
+		return fmt.Errorf("only admin is allowed to manage the api keys of all the users")
+	}
+	return fmt.Errorf("user %s is not allowed to manage the api keys of user %s", operator, username)
+}
+
 // PasswordVerify verify password
 func passwordVerify(ctx context.Context, username, rawPwd string, globalMetaCache Cache) bool {
 	// it represents the cache miss if Sha256Password is empty within credInfo, which shall be updated first connection.
@@ -25,11 +25,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
@@ -286,6 +288,68 @@ func TestValidatePrimaryKey(t *testing.T) {
 	}))
 }
 
+func TestValidatePartitionKey(t *testing.T) {
+	partitionKeyParams := []*commonpb.KeyValuePair{{Key: common.PartitionKeyKey, Value: "true"}}
+	pkField := &schemapb.FieldSchema{
+		Name:         "pkField",
+		IsPrimaryKey: true,
+		DataType:     schemapb.DataType_Int64,
+	}
+	int64Field := &schemapb.FieldSchema{
+		Name:       "int64Field",
+		DataType:   schemapb.DataType_Int64,
+		TypeParams: partitionKeyParams,
+	}
+	floatField := &schemapb.FieldSchema{
+		Name:       "floatField",
+		DataType:   schemapb.DataType_Float,
+		TypeParams: partitionKeyParams,
+	}
+	varCharField := &schemapb.FieldSchema{
+		Name:     "varCharField",
+		DataType: schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: "max_length", Value: "100"},
+			{Key: common.PartitionKeyKey, Value: "true"},
+		},
+	}
+
+	// test collection without partition key
+	assert.NoError(t, validatePartitionKey(&schemapb.CollectionSchema{
+		Name:   "coll1",
+		Fields: []*schemapb.FieldSchema{pkField},
+	}))
+
+	// test collection with int64 and varChar partition key
+	assert.NoError(t, validatePartitionKey(&schemapb.CollectionSchema{
+		Name:   "coll1",
+		Fields: []*schemapb.FieldSchema{pkField, int64Field},
+	}))
+	assert.NoError(t, validatePartitionKey(&schemapb.CollectionSchema{
+		Name:   "coll1",
+		Fields: []*schemapb.FieldSchema{pkField, varCharField},
+	}))
+
+	// test collection with multi partition key
+	assert.Error(t, validatePartitionKey(&schemapb.CollectionSchema{
+		Name:   "coll1",
+		Fields: []*schemapb.FieldSchema{pkField, int64Field, varCharField},
+	}))
+
+	// test collection with float partition key
+	assert.Error(t, validatePartitionKey(&schemapb.CollectionSchema{
+		Name:   "coll1",
+		Fields: []*schemapb.FieldSchema{pkField, floatField},
+	}))
+
+	// test collection with primary key as partition key
+	pkField.TypeParams = partitionKeyParams
+	assert.Error(t, validatePartitionKey(&schemapb.CollectionSchema{
+		Name:   "coll1",
+		Fields: []*schemapb.FieldSchema{pkField},
+	}))
+}
+
 func TestValidateFieldType(t *testing.T) {
 	type testCase struct {
 		dt       schemapb.DataType
@@ -336,6 +400,11 @@ func TestValidateFieldType(t *testing.T) {
 			dt:       schemapb.DataType_VarChar,
 			validate: true,
 		},
+		{
+			// element type and max capacity are not specified
+			dt:       typeutil.DataTypeArray,
+			validate: false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -595,6 +664,80 @@ func TestFillFieldIDBySchema(t *testing.T) {
 	assert.Equal(t, int64(1), columns[0].FieldId)
 }
 
+func TestValidateArrayFieldData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:  100,
+				Name:     "tags",
+				DataType: typeutil.DataTypeArray,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: common.ElementTypeKey, Value: "Int64"},
+					{Key: common.MaxCapacityKey, Value: "2"},
+				},
+			},
+		},
+	}
+	assert.NoError(t, validateFieldType(schema))
+
+	newArrayFieldData := func(arrays ...*schemapb.ScalarField) []*schemapb.FieldData {
+		data := make([][]byte, len(arrays))
+		for i, array := range arrays {
+			data[i], _ = proto.Marshal(array)
+		}
+		return []*schemapb.FieldData{
+			{
+				FieldName: "tags",
+				FieldId:   100,
+				Type:      typeutil.DataTypeArray,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_BytesData{BytesData: &schemapb.BytesArray{Data: data}},
+					},
+				},
+			},
+		}
+	}
+	longArray := func(data ...int64) *schemapb.ScalarField {
+		return &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}}
+	}
+
+	assert.NoError(t, validateArrayFieldData(newArrayFieldData(longArray(1, 2), longArray()), schema))
+	assert.Error(t, validateArrayFieldData(newArrayFieldData(longArray(1, 2, 3)), schema))
+	assert.Error(t, validateArrayFieldData(newArrayFieldData(&schemapb.ScalarField{
+		Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a"}}},
+	}), schema))
+
+	invalidBytes := newArrayFieldData()
+	invalidBytes[0].GetScalars().GetBytesData().Data = [][]byte{{0xff}}
+	assert.Error(t, validateArrayFieldData(invalidBytes, schema))
+}
+
+func TestValidateJSONFieldData(t *testing.T) {
+	newJSONFieldData := func(docs ...string) []*schemapb.FieldData {
+		data := make([][]byte, len(docs))
+		for i, doc := range docs {
+			data[i] = []byte(doc)
+		}
+		return []*schemapb.FieldData{
+			{
+				FieldName: "meta",
+				Type:      typeutil.DataTypeJSON,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_BytesData{BytesData: &schemapb.BytesArray{Data: data}},
+					},
+				},
+			},
+		}
+	}
+
+	assert.NoError(t, validateJSONFieldData(newJSONFieldData(`{"color": "red"}`, `{"price": 10, "tags": ["a"]}`)))
+	assert.Error(t, validateJSONFieldData(newJSONFieldData(`{"color": "red"}`, `{"price": `)))
+	assert.Error(t, validateJSONFieldData(newJSONFieldData(`[1, 2]`)))
+	assert.Error(t, validateJSONFieldData(newJSONFieldData(`null`)))
+}
+
 func TestValidateUsername(t *testing.T) {
 	// only spaces
 	res := ValidateUsername(" ")
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
@@ -454,6 +455,125 @@ func (suite *TaskSuite) TestLoadSegmentTask() {
 	}
 }
 
+func (suite *TaskSuite) TestLoadSegmentTaskWithMultipleVectorFields() {
+	ctx := context.Background()
+	timeout := 10 * time.Second
+	targetNode := int64(3)
+	partition := int64(100)
+	channel := &datapb.VchannelInfo{
+		CollectionID: suite.collection,
+		ChannelName:  Params.CommonCfg.RootCoordDml.GetValue() + "-test",
+	}
+	schema := &schemapb.CollectionSchema{
+		Name: "TestLoadSegmentTaskWithMultipleVectorFields",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "float_vector", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "binary_vector", DataType: schemapb.DataType_BinaryVector},
+		},
+	}
+
+	// Expect
+	suite.broker.EXPECT().GetCollectionSchema(mock.Anything, suite.collection).Return(schema, nil)
+	suite.broker.EXPECT().GetPartitions(mock.Anything, suite.collection).Return([]int64{100, 101}, nil)
+	for _, segment := range suite.loadSegments {
+		suite.broker.EXPECT().GetSegmentInfo(mock.Anything, segment).Return(&datapb.GetSegmentInfoResponse{Infos: []*datapb.SegmentInfo{
+			{
+				ID:            segment,
+				CollectionID:  suite.collection,
+				PartitionID:   partition,
+				InsertChannel: channel.ChannelName,
+			}},
+		}, nil)
+		// each vector field has its own index
+		suite.broker.EXPECT().GetIndexInfo(mock.Anything, suite.collection, segment).Return([]*querypb.FieldIndexInfo{
+			{FieldID: 101, EnableIndex: true, IndexID: 1, BuildID: segment*10 + 1},
+			{FieldID: 102, EnableIndex: true, IndexID: 2, BuildID: segment*10 + 2},
+		}, nil)
+	}
+	var (
+		mu       sync.Mutex
+		requests []*querypb.LoadSegmentsRequest
+	)
+	suite.cluster.EXPECT().LoadSegments(mock.Anything, targetNode, mock.Anything).
+		Run(func(ctx context.Context, nodeID int64, req *querypb.LoadSegmentsRequest) {
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, req)
+		}).
+		Return(utils.WrapStatus(commonpb.ErrorCode_Success, ""), nil)
+
+	// Test load segment task
+	suite.dist.ChannelDistManager.Update(targetNode, meta.DmChannelFromVChannel(&datapb.VchannelInfo{
+		CollectionID: suite.collection,
+		ChannelName:  channel.ChannelName,
+	}))
+	tasks := []Task{}
+	segments := make([]*datapb.SegmentBinlogs, 0)
+	for _, segment := range suite.loadSegments {
+		segments = append(segments, &datapb.SegmentBinlogs{
+			SegmentID:     segment,
+			InsertChannel: channel.ChannelName,
+		})
+		task, err := NewSegmentTask(
+			ctx,
+			timeout,
+			0,
+			suite.collection,
+			suite.replica,
+			NewSegmentAction(targetNode, ActionTypeGrow, channel.GetChannelName(), segment),
+		)
+		suite.NoError(err)
+		tasks = append(tasks, task)
+		err = suite.scheduler.Add(task)
+		suite.NoError(err)
+	}
+	suite.broker.EXPECT().GetRecoveryInfo(mock.Anything, suite.collection, int64(1)).Return(nil, segments, nil)
+	suite.target.UpdateCollectionNextTargetWithPartitions(suite.collection, int64(1))
+	segmentsNum := len(suite.loadSegments)
+	suite.AssertTaskNum(0, segmentsNum, 0, segmentsNum)
+
+	// Process tasks
+	suite.dispatchAndWait(targetNode)
+	suite.AssertTaskNum(segmentsNum, 0, 0, segmentsNum)
+
+	// The segments are loaded with the indexes of both vector fields
+	mu.Lock()
+	loaded := make(map[int64]*querypb.SegmentLoadInfo)
+	for _, req := range requests {
+		suite.Equal(schema, req.GetSchema())
+		for _, info := range req.GetInfos() {
+			loaded[info.GetSegmentID()] = info
+		}
+	}
+	mu.Unlock()
+	suite.Len(loaded, segmentsNum)
+	for segment, info := range loaded {
+		suite.Len(info.GetIndexInfos(), 2)
+		for _, index := range info.GetIndexInfos() {
+			suite.Equal(segment*10+index.GetFieldID()-100, index.GetBuildID())
+		}
+	}
+
+	// Process tasks done
+	view := &meta.LeaderView{
+		ID:           targetNode,
+		CollectionID: suite.collection,
+		Segments:     map[int64]*querypb.SegmentDist{},
+	}
+	for _, segment := range suite.loadSegments {
+		view.Segments[segment] = &querypb.SegmentDist{NodeID: targetNode, Version: 0}
+	}
+	suite.dist.LeaderViewManager.Update(targetNode, view)
+	suite.dispatchAndWait(targetNode)
+	suite.AssertTaskNum(0, 0, 0, 0)
+
+	for _, task := range tasks {
+		suite.Equal(TaskStatusSucceeded, task.Status())
+		suite.NoError(task.Err())
+	}
+}
+
 func (suite *TaskSuite) TestSubmitDuplicateLoadSegmentTask() {
 	ctx := context.Background()
 	timeout := 10 * time.Second
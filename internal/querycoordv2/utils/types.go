@@ -82,15 +82,18 @@ func MergeMetaSegmentIntoSegmentInfo(info *querypb.SegmentInfo, segments ...*met
 // packs with index if withIndex is true, this fetch indexes from IndexCoord
 func PackSegmentLoadInfo(segment *datapb.SegmentInfo, indexes []*querypb.FieldIndexInfo) *querypb.SegmentLoadInfo {
 	loadInfo := &querypb.SegmentLoadInfo{
-		SegmentID:     segment.ID,
-		PartitionID:   segment.PartitionID,
-		CollectionID:  segment.CollectionID,
-		BinlogPaths:   segment.Binlogs,
-		NumOfRows:     segment.NumOfRows,
-		Statslogs:     segment.Statslogs,
-		Deltalogs:     segment.Deltalogs,
-		InsertChannel: segment.InsertChannel,
-		IndexInfos:    indexes,
+		SegmentID:       segment.ID,
+		PartitionID:     segment.PartitionID,
+		CollectionID:    segment.CollectionID,
+		BinlogPaths:     segment.Binlogs,
+		NumOfRows:       segment.NumOfRows,
+		Statslogs:       segment.Statslogs,
+		Deltalogs:       segment.Deltalogs,
+		InsertChannel:   segment.InsertChannel,
+		IndexInfos:      indexes,
+		StorageVersion:  segment.StorageVersion,
+		MaxRowTimestamp: segment.MaxRowTimestamp,
+		MinRowTimestamp: segment.MinRowTimestamp,
 	}
 	loadInfo.SegmentSize = calculateSegmentSize(loadInfo)
 	return loadInfo
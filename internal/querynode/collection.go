@@ -49,6 +49,7 @@ type Collection struct {
 	collectionPtr C.CCollection
 	id            UniqueID
 	partitionIDs  []UniqueID
+	schemaMu      sync.RWMutex // guards schema, which is only replaced with mu held
 	schema        *schemapb.CollectionSchema
 
 	// TODO, remove delta channels
@@ -73,9 +74,51 @@ func (c *Collection) ID() UniqueID {
 
 // Schema returns the schema of collection
 func (c *Collection) Schema() *schemapb.CollectionSchema {
+	c.schemaMu.RLock()
+	defer c.schemaMu.RUnlock()
 	return c.schema
 }
 
+// updateSchema replaces the schema if the new one has the fields added by AlterCollection,
+// the segments created afterwards have the new fields, the ones created before keep their schema until reloaded.
+func (c *Collection) updateSchema(schema *schemapb.CollectionSchema) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !hasNewFields(c.schema, schema) {
+		return
+	}
+
+	/*
+		void
+		UpdateSchema(CCollection collection, const char* schema_proto_blob);
+	*/
+	schemaBlob := proto.MarshalTextString(schema)
+	cSchemaBlob := C.CString(schemaBlob)
+	defer C.free(unsafe.Pointer(cSchemaBlob))
+	C.UpdateSchema(c.collectionPtr, cSchemaBlob)
+
+	c.schemaMu.Lock()
+	c.schema = schema
+	c.schemaMu.Unlock()
+
+	log.Info("update collection schema", zap.Int64("collectionID", c.id), zap.Int("numFields", len(schema.GetFields())))
+}
+
+// hasNewFields checks whether there are fields in the new schema but not in the old one.
+func hasNewFields(old, new *schemapb.CollectionSchema) bool {
+	oldFields := typeutil.NewUniqueSet()
+	for _, field := range old.GetFields() {
+		oldFields.Insert(field.GetFieldID())
+	}
+	for _, field := range new.GetFields() {
+		if !oldFields.Contain(field.GetFieldID()) {
+			return true
+		}
+	}
+	return false
+}
+
 // getPartitionIDs return partitionIDs of collection
 func (c *Collection) getPartitionIDs() []UniqueID {
 	dst := make([]UniqueID, len(c.partitionIDs))
@@ -368,7 +411,7 @@ func (c *Collection) getLoadType() loadType {
 
 // getFieldType get the field type according to the field id.
 func (c *Collection) getFieldType(fieldID FieldID) (schemapb.DataType, error) {
-	helper, err := typeutil.CreateSchemaHelper(c.schema)
+	helper, err := typeutil.CreateSchemaHelper(c.Schema())
 	if err != nil {
 		return schemapb.DataType_None, err
 	}
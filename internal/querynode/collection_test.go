@@ -52,6 +52,29 @@ func TestCollection_schema(t *testing.T) {
 	deleteCollection(collection)
 }
 
+func TestCollection_updateSchema(t *testing.T) {
+	collectionID := UniqueID(0)
+	schema := genTestCollectionSchema()
+
+	collection := newCollection(collectionID, schema)
+	defer deleteCollection(collection)
+
+	// the schema without new fields is ignored
+	collection.updateSchema(genTestCollectionSchema())
+	assert.Same(t, schema, collection.Schema())
+
+	altered, addedField := genAlteredCollectionSchema(schema)
+	collection.updateSchema(altered)
+	assert.Same(t, altered, collection.Schema())
+	fieldType, err := collection.getFieldType(addedField.GetFieldID())
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Int64, fieldType)
+
+	// the stale schema doesn't roll back the added fields
+	collection.updateSchema(schema)
+	assert.Same(t, altered, collection.Schema())
+}
+
 func TestCollection_vChannel(t *testing.T) {
 	Params.Init()
 	collectionID := UniqueID(0)
@@ -153,7 +153,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			}
 		}
 
-		insertRecord, err := storage.TransferInsertMsgToInsertRecord(collection.schema, insertMsg)
+		insertRecord, err := storage.TransferInsertMsgToInsertRecord(collection.Schema(), insertMsg)
 		if err != nil {
 			// occurs only when schema doesn't have dim param, this should not happen
 			err = fmt.Errorf("failed to transfer msgStream.insertMsg to storage.InsertRecord, err = %s", err)
@@ -460,7 +460,7 @@ func getPrimaryKeys(msg *msgstream.InsertMsg, metaReplica ReplicaInterface) ([]p
 		return nil, err
 	}
 
-	return getPKs(msg, collection.schema)
+	return getPKs(msg, collection.Schema())
 }
 
 func getPKs(msg *msgstream.InsertMsg, schema *schemapb.CollectionSchema) ([]primaryKey, error) {
@@ -782,7 +782,8 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 		return failRet, nil
 	}
 
-	ret, err := reduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	ret, err := reduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType(),
+		req.Req.GetGroupByFieldId(), req.Req.GetGroupTopk())
 	if err != nil {
 		failRet.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		failRet.Status.Reason = err.Error()
@@ -928,7 +929,8 @@ func (node *QueryNode) searchWithDmlChannel(ctx context.Context, req *querypb.Se
 	tr.CtxElapse(ctx, fmt.Sprintf("do search done in shard cluster, vChannel = %s, segmentIDs = %v", dmlChannel, req.GetSegmentIDs()))
 
 	results = append(results, streamingResult)
-	ret, err2 := reduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	ret, err2 := reduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType(),
+		req.Req.GetGroupByFieldId(), req.Req.GetGroupTopk())
 	if err2 != nil {
 		failRet.Status.Reason = err2.Error()
 		return failRet, nil
@@ -1074,7 +1076,13 @@ func (node *QueryNode) queryWithDmlChannel(ctx context.Context, req *querypb.Que
 		traceID, req.GetFromShardLeader(), dmlChannel, req.GetSegmentIDs()))
 
 	results = append(results, streamingResult)
-	ret, err2 := mergeInternalRetrieveResultsAndFillIfEmpty(ctx, results, req.Req.GetLimit(), req.GetReq().GetOutputFieldsId(), qs.collection.Schema())
+	var ret *internalpb.RetrieveResults
+	var err2 error
+	if len(req.GetReq().GetAggregates()) > 0 {
+		ret, err2 = aggregateInternalRetrieveResults(ctx, results, req.GetReq(), qs.collection.Schema())
+	} else {
+		ret, err2 = mergeInternalRetrieveResultsAndFillIfEmpty(ctx, results, req.Req.GetLimit(), req.GetReq().GetOrderBy(), req.GetReq().GetOutputFieldsId(), qs.collection.Schema())
+	}
 	if err2 != nil {
 		failRet.Status.Reason = err2.Error()
 		return failRet, nil
@@ -1157,7 +1165,15 @@ func (node *QueryNode) Query(ctx context.Context, req *querypb.QueryRequest) (*i
 	if err := runningGp.Wait(); err != nil {
 		return failRet, nil
 	}
-	ret, err := mergeInternalRetrieveResultsAndFillIfEmpty(ctx, toMergeResults, req.GetReq().GetLimit(), req.GetReq().GetOutputFieldsId(), coll.Schema())
+	var ret *internalpb.RetrieveResults
+	if len(req.GetReq().GetAggregates()) > 0 && req.GetFromShardLeader() {
+		// the shard leader aggregates the rows after deduplicating them with the rows of the other segments
+		ret, err = dedupRetrieveResults(toMergeResults)
+	} else if len(req.GetReq().GetAggregates()) > 0 {
+		ret, err = mergeInternalAggregateResults(ctx, toMergeResults, req.GetReq(), coll.Schema())
+	} else {
+		ret, err = mergeInternalRetrieveResultsAndFillIfEmpty(ctx, toMergeResults, req.GetReq().GetLimit(), req.GetReq().GetOrderBy(), req.GetReq().GetOutputFieldsId(), coll.Schema())
+	}
 	if err != nil {
 		failRet.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
 		failRet.Status.Reason = err.Error()
@@ -203,6 +203,8 @@ func (replica *metaReplica) addCollection(collectionID UniqueID, schema *schemap
 	defer replica.mu.Unlock()
 
 	if col, ok := replica.collections[collectionID]; ok {
+		// the schema of later load requests has the fields added by AlterCollection
+		col.updateSchema(schema)
 		return col
 	}
 
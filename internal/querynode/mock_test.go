@@ -476,6 +476,28 @@ func genIndexParams(indexType, metricType string) (map[string]string, map[string
 	return typeParams, indexParams
 }
 
+// genAlteredCollectionSchema returns the schema with an int64 field added by AlterCollection,
+// whose default value is 7.
+func genAlteredCollectionSchema(schema *schemapb.CollectionSchema) (*schemapb.CollectionSchema, *schemapb.FieldSchema) {
+	var maxFieldID int64
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() > maxFieldID {
+			maxFieldID = field.GetFieldID()
+		}
+	}
+	addedField := &schemapb.FieldSchema{
+		FieldID:  maxFieldID + 1,
+		Name:     "added_field",
+		DataType: schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: common.DefaultValueKey, Value: "7"},
+		},
+	}
+	altered := proto.Clone(schema).(*schemapb.CollectionSchema)
+	altered.Fields = append(altered.Fields, addedField)
+	return altered, addedField
+}
+
 func genTestCollectionSchema(pkTypes ...schemapb.DataType) *schemapb.CollectionSchema {
 	fieldBool := genConstantFieldSchema(simpleBoolField)
 	fieldInt8 := genConstantFieldSchema(simpleInt8Field)
@@ -1088,6 +1110,44 @@ func saveBinLog(ctx context.Context,
 	return fieldBinlog, statsBinlog, err
 }
 
+// saveColumnarBinLog saves the binlogs of StorageV2 into MinIO for testing purpose,
+// all the fields refer to the same columnar binlog.
+func saveColumnarBinLog(ctx context.Context,
+	collectionID UniqueID,
+	partitionID UniqueID,
+	segmentID UniqueID,
+	msgLength int,
+	schema *schemapb.CollectionSchema) ([]*datapb.FieldBinlog, error) {
+	tmpSchema := &schemapb.CollectionSchema{
+		Name:   schema.Name,
+		AutoID: schema.AutoID,
+		Fields: []*schemapb.FieldSchema{genConstantFieldSchema(uidField), genConstantFieldSchema(timestampField)},
+	}
+	tmpSchema.Fields = append(tmpSchema.Fields, schema.Fields...)
+	inCodec := storage.NewInsertCodec(genCollectionMeta(collectionID, tmpSchema))
+	insertData, err := genInsertData(msgLength, schema)
+	if err != nil {
+		return nil, err
+	}
+	blob, _, err := inCodec.SerializeV2(partitionID, segmentID, insertData)
+	if err != nil {
+		return nil, err
+	}
+
+	key := path.Join(defaultLocalStorage, "insert-log", JoinIDPath(collectionID, partitionID, segmentID), common.SegmentColumnarLogDir, "1")
+	fieldBinlog := make([]*datapb.FieldBinlog, 0, len(insertData.Data))
+	for fieldID := range insertData.Data {
+		fieldBinlog = append(fieldBinlog, &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{LogPath: key, EntriesNum: int64(msgLength)}},
+		})
+	}
+
+	cm := storage.NewLocalChunkManager(storage.RootPath(defaultLocalStorage))
+	err = cm.Write(ctx, key, blob.Value)
+	return fieldBinlog, err
+}
+
 // saveDeltaLog saves delta logs into MinIO for testing purpose.
 func saveDeltaLog(collectionID UniqueID,
 	partitionID UniqueID,
@@ -1265,7 +1325,7 @@ func genSealedSegment(schema *schemapb.CollectionSchema,
 	numRows := insertRecord.NumRows
 	for _, fieldData := range insertRecord.FieldsData {
 		fieldID := fieldData.FieldId
-		err := seg.segmentLoadFieldData(fieldID, numRows, fieldData)
+		err := seg.segmentLoadFieldData(fieldID, numRows, fieldData, getValidData(insertRecord, fieldID))
 		if err != nil {
 			// TODO: return or continue?
 			return nil, err
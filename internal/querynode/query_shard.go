@@ -71,7 +71,7 @@ func newQueryShard(
 	vectorChunkManager, err := storage.NewVectorChunkManager(ctx, localChunkManager, remoteChunkManager,
 		&etcdpb.CollectionMeta{
 			ID:     collectionID,
-			Schema: collection.schema,
+			Schema: collection.Schema(),
 		}, Params.QueryNodeCfg.CacheMemoryLimit.GetAsInt64(), localCacheEnabled)
 	if err != nil {
 		return nil, err
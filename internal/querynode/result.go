@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 
 	"github.com/golang/protobuf/proto"
@@ -28,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
@@ -77,7 +79,8 @@ func reduceStatisticResponse(results []*internalpb.GetStatisticsResponse) (*inte
 	return ret, nil
 }
 
-func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string) (*internalpb.SearchResults, error) {
+func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string,
+	groupByFieldID int64, groupTopk int64) (*internalpb.SearchResults, error) {
 	searchResultData, err := decodeSearchResults(results)
 	if err != nil {
 		log.Ctx(ctx).Warn("decode search results errors", zap.Error(err))
@@ -86,7 +89,11 @@ func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 	log.Ctx(ctx).Debug("reduceSearchResultData",
 		zap.Int("numbers", len(searchResultData)), zap.Int64("targetNq", nq), zap.Int64("targetTopk", topk))
 
-	reducedResultData, err := reduceSearchResultData(ctx, searchResultData, nq, topk)
+	var truncated [][]bool
+	if groupByFieldID != 0 {
+		truncated = getGroupsTruncated(results, searchResultData)
+	}
+	reducedResultData, groupsTruncated, err := reduceSearchResultData(ctx, searchResultData, nq, topk, groupByFieldID, groupTopk, truncated)
 	if err != nil {
 		log.Ctx(ctx).Warn("reduce search results error", zap.Error(err))
 		return nil, err
@@ -96,6 +103,7 @@ func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 		log.Ctx(ctx).Warn("encode search results error", zap.Error(err))
 		return nil, err
 	}
+	searchResults.GroupsTruncated = groupsTruncated
 	//if searchResults.SlicedBlob == nil {
 	//	log.Debug("shard leader send nil results to proxy",
 	//		zap.String("shard", q.channel))
@@ -108,7 +116,35 @@ func reduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 	return searchResults, nil
 }
 
-func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64) (*schemapb.SearchResultData, error) {
+// getGroupsTruncated returns whether more groups of each query could be found beyond each of the decoded
+// @searchResultData. The grouped results carry it, the results of segments are truncated if they are full of
+// topk candidates.
+func getGroupsTruncated(results []*internalpb.SearchResults, searchResultData []*schemapb.SearchResultData) [][]bool {
+	truncated := make([][]bool, 0, len(searchResultData))
+	for _, result := range results {
+		// the results without blob are skipped when decoding
+		if result.SlicedBlob == nil {
+			continue
+		}
+		data := searchResultData[len(truncated)]
+		if flags := result.GetGroupsTruncated(); len(flags) == len(data.GetTopks()) {
+			truncated = append(truncated, flags)
+			continue
+		}
+		flags := make([]bool, len(data.GetTopks()))
+		for i, topk := range data.GetTopks() {
+			flags[i] = topk >= result.GetTopK()
+		}
+		truncated = append(truncated, flags)
+	}
+	return truncated
+}
+
+// reduceSearchResultData merges the search results into topk results of each query. If groupByFieldID is set,
+// only the best hit of each distinct value of the field is kept, at most groupTopk of them, and whether more groups
+// of each query could be found by searching more candidates is returned, @truncated tells it of the input results.
+func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64,
+	groupByFieldID int64, groupTopk int64, truncated [][]bool) (*schemapb.SearchResultData, []bool, error) {
 	if len(searchResultData) == 0 {
 		return &schemapb.SearchResultData{
 			NumQueries: nq,
@@ -117,7 +153,7 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 			Scores:     make([]float32, 0),
 			Ids:        &schemapb.IDs{},
 			Topks:      make([]int64, 0),
-		}, nil
+		}, nil, nil
 	}
 	ret := &schemapb.SearchResultData{
 		NumQueries: nq,
@@ -128,6 +164,19 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		Topks:      make([]int64, 0),
 	}
 
+	var groupByFieldData []*schemapb.FieldData
+	limit := topk
+	if groupByFieldID != 0 {
+		groupByFieldData = make([]*schemapb.FieldData, len(searchResultData))
+		for i, data := range searchResultData {
+			groupByFieldData[i] = typeutil.GetFieldDataByID(data.GetFieldsData(), groupByFieldID)
+			if groupByFieldData[i] == nil && len(data.GetScores()) > 0 {
+				return nil, nil, fmt.Errorf("group by field %d not found in search results", groupByFieldID)
+			}
+		}
+		limit = groupTopk
+	}
+
 	resultOffsets := make([][]int64, len(searchResultData))
 	for i := 0; i < len(searchResultData); i++ {
 		resultOffsets[i] = make([]int64, len(searchResultData[i].Topks))
@@ -136,13 +185,15 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		}
 	}
 
-	var skipDupCnt int64
+	var groupsTruncated []bool
+	var skipDupCnt, skipGroupCnt int64
 	for i := int64(0); i < nq; i++ {
 		offsets := make([]int64, len(searchResultData))
 
 		var idSet = make(map[interface{}]struct{})
+		var groupSet = make(map[interface{}]struct{})
 		var j int64
-		for j = 0; j < topk; {
+		for j = 0; j < limit; {
 			sel := selectSearchResultData(searchResultData, resultOffsets, offsets, i)
 			if sel == -1 {
 				break
@@ -153,16 +204,27 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 			score := searchResultData[sel].Scores[idx]
 
 			// remove duplicates
-			if _, ok := idSet[id]; !ok {
-				typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
-				typeutil.AppendPKs(ret.Ids, id)
-				ret.Scores = append(ret.Scores, score)
-				idSet[id] = struct{}{}
-				j++
-			} else {
+			if _, ok := idSet[id]; ok {
 				// skip entity with same id
 				skipDupCnt++
+				offsets[sel]++
+				continue
+			}
+			if groupByFieldData != nil {
+				group := typeutil.GetScalarData(groupByFieldData[sel], idx)
+				if _, ok := groupSet[group]; ok {
+					// skip entity of a group which already has a better hit
+					skipGroupCnt++
+					offsets[sel]++
+					continue
+				}
+				groupSet[group] = struct{}{}
 			}
+			typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
+			typeutil.AppendPKs(ret.Ids, id)
+			ret.Scores = append(ret.Scores, score)
+			idSet[id] = struct{}{}
+			j++
 			offsets[sel]++
 		}
 
@@ -171,12 +233,27 @@ func reduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		// 	// return nil, errors.New("the length (topk) between all result of query is different")
 		// }
 		ret.Topks = append(ret.Topks, j)
+
+		if groupByFieldData != nil {
+			// more groups may be found if any input has candidates left or is truncated itself
+			queryTruncated := false
+			for k, data := range searchResultData {
+				if offsets[k] < data.Topks[i] || (k < len(truncated) && int(i) < len(truncated[k]) && truncated[k][i]) {
+					queryTruncated = true
+					break
+				}
+			}
+			groupsTruncated = append(groupsTruncated, queryTruncated)
+		}
 	}
 
 	if skipDupCnt > 0 {
 		log.Ctx(ctx).Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
 	}
-	return ret, nil
+	if skipGroupCnt > 0 {
+		log.Ctx(ctx).Debug("skip grouped search result", zap.Int64("count", skipGroupCnt))
+	}
+	return ret, groupsTruncated, nil
 }
 
 func selectSearchResultData(dataArray []*schemapb.SearchResultData, resultOffsets [][]int64, offsets []int64, qi int64) int {
@@ -252,7 +329,8 @@ func encodeSearchResultData(searchResultData *schemapb.SearchResultData, nq int6
 	return
 }
 
-func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*internalpb.RetrieveResults, limit int64) (*internalpb.RetrieveResults, error) {
+// mergeInternalRetrieveResult merges the retrieve results ordered by primary key, or by the order by fields if they are set.
+func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*internalpb.RetrieveResults, limit int64, orderBy []*internalpb.OrderByField) (*internalpb.RetrieveResults, error) {
 	log.Ctx(ctx).Debug("mergeInternelRetrieveResults",
 		zap.Int64("limit", limit),
 		zap.Int("len(retrieveResults)", len(retrieveResults)),
@@ -283,33 +361,56 @@ func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 		loopEnd = int(limit)
 	}
 
-	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
-	idTsMap := make(map[interface{}]uint64)
+	type rowLocation struct {
+		result int
+		row    int64
+		ts     uint64
+	}
+	// the output rows, the newer row of a duplicated primary key replaces the older one in place
+	var selected []rowLocation
+	pkOutputs := make(map[interface{}]int)
+	replaced := false
 	cursors := make([]int64, len(validRetrieveResults))
 	for j := 0; j < loopEnd; j++ {
-		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
+		sel, err := typeutil.SelectMinByOrder(validRetrieveResults, cursors, orderBy)
+		if err != nil {
+			return nil, err
+		}
 		if sel == -1 {
 			break
 		}
 
 		pk := typeutil.GetPK(validRetrieveResults[sel].GetIds(), cursors[sel])
 		ts := typeutil.GetTS(validRetrieveResults[sel], cursors[sel])
-		if _, ok := idTsMap[pk]; !ok {
-			typeutil.AppendPKs(ret.Ids, pk)
-			typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
-			idTsMap[pk] = ts
+		if idx, ok := pkOutputs[pk]; !ok {
+			pkOutputs[pk] = len(selected)
+			selected = append(selected, rowLocation{result: sel, row: cursors[sel], ts: ts})
 		} else {
-			// primary keys duplicate
+			// primary keys duplicate, e.g. upserted or being handed off, the newest row is returned
 			skipDupCnt++
-			if ts != 0 && ts > idTsMap[pk] {
-				idTsMap[pk] = ts
-				typeutil.DeleteFieldData(ret.FieldsData)
-				typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel].GetFieldsData(), cursors[sel])
+			if ts != 0 && ts > selected[idx].ts {
+				selected[idx] = rowLocation{result: sel, row: cursors[sel], ts: ts}
+				replaced = true
 			}
 		}
 		cursors[sel]++
 	}
 
+	ret.FieldsData = make([]*schemapb.FieldData, len(validRetrieveResults[0].GetFieldsData()))
+	for _, loc := range selected {
+		r := validRetrieveResults[loc.result]
+		typeutil.AppendPKs(ret.Ids, typeutil.GetPK(r.GetIds(), loc.row))
+		typeutil.AppendFieldData(ret.FieldsData, r.GetFieldsData(), loc.row)
+	}
+	// the order by fields of the newer rows may be different from the replaced ones
+	if replaced && len(orderBy) > 0 {
+		rows, err := typeutil.SortRetrieveRows(ret, orderBy, typeutil.Unlimited)
+		if err != nil {
+			return nil, err
+		}
+		ret.Ids, ret.FieldsData = typeutil.SelectRows(ret.GetIds(), ret.GetFieldsData(), rows)
+	}
+
 	if skipDupCnt > 0 {
 		log.Ctx(ctx).Debug("skip duplicated query result while reducing internal.RetrieveResults", zap.Int64("count", skipDupCnt))
 	}
@@ -317,7 +418,9 @@ func mergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 	return ret, nil
 }
 
-func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcorepb.RetrieveResults, limit int64) (*segcorepb.RetrieveResults, error) {
+// mergeSegcoreRetrieveResults merges the retrieve results of segments ordered by primary key. If the order by fields
+// are set, the first limit rows of each segment are sorted by the fields first, then they are merged by the fields.
+func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcorepb.RetrieveResults, limit int64, orderBy []*internalpb.OrderByField) (*segcorepb.RetrieveResults, error) {
 	log.Ctx(ctx).Debug("mergeSegcoreRetrieveResults",
 		zap.Int64("limit", limit),
 		zap.Int("len(retrieveResults)", len(retrieveResults)),
@@ -337,6 +440,12 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 		if r == nil || len(r.GetOffset()) == 0 || size == 0 {
 			continue
 		}
+		if len(orderBy) > 0 {
+			if err := sortSegcoreRetrieveResult(r, orderBy, limit); err != nil {
+				return nil, err
+			}
+			size = typeutil.GetSizeOfIDs(r.GetIds())
+		}
 		validRetrieveResults = append(validRetrieveResults, r)
 		loopEnd += size
 	}
@@ -353,7 +462,10 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 	idSet := make(map[interface{}]struct{})
 	cursors := make([]int64, len(validRetrieveResults))
 	for j := 0; j < loopEnd; j++ {
-		sel := typeutil.SelectMinPK(validRetrieveResults, cursors)
+		sel, err := typeutil.SelectMinByOrder(validRetrieveResults, cursors, orderBy)
+		if err != nil {
+			return nil, err
+		}
 		if sel == -1 {
 			break
 		}
@@ -377,15 +489,31 @@ func mergeSegcoreRetrieveResults(ctx context.Context, retrieveResults []*segcore
 	return ret, nil
 }
 
+// sortSegcoreRetrieveResult keeps the first limit rows of the segment retrieve result ordered by the order by fields.
+func sortSegcoreRetrieveResult(result *segcorepb.RetrieveResults, orderBy []*internalpb.OrderByField, limit int64) error {
+	rows, err := typeutil.SortRetrieveRows(result, orderBy, limit)
+	if err != nil {
+		return err
+	}
+	offsets := make([]int64, len(rows))
+	for i, row := range rows {
+		offsets[i] = result.Offset[row]
+	}
+	result.Ids, result.FieldsData = typeutil.SelectRows(result.GetIds(), result.GetFieldsData(), rows)
+	result.Offset = offsets
+	return nil
+}
+
 func mergeSegcoreRetrieveResultsAndFillIfEmpty(
 	ctx context.Context,
 	retrieveResults []*segcorepb.RetrieveResults,
 	limit int64,
+	orderBy []*internalpb.OrderByField,
 	outputFieldsID []int64,
 	schema *schemapb.CollectionSchema,
 ) (*segcorepb.RetrieveResults, error) {
 
-	mergedResult, err := mergeSegcoreRetrieveResults(ctx, retrieveResults, limit)
+	mergedResult, err := mergeSegcoreRetrieveResults(ctx, retrieveResults, limit, orderBy)
 	if err != nil {
 		return nil, err
 	}
@@ -401,11 +529,12 @@ func mergeInternalRetrieveResultsAndFillIfEmpty(
 	ctx context.Context,
 	retrieveResults []*internalpb.RetrieveResults,
 	limit int64,
+	orderBy []*internalpb.OrderByField,
 	outputFieldsID []int64,
 	schema *schemapb.CollectionSchema,
 ) (*internalpb.RetrieveResults, error) {
 
-	mergedResult, err := mergeInternalRetrieveResult(ctx, retrieveResults, limit)
+	mergedResult, err := mergeInternalRetrieveResult(ctx, retrieveResults, limit, orderBy)
 	if err != nil {
 		return nil, err
 	}
@@ -417,6 +546,114 @@ func mergeInternalRetrieveResultsAndFillIfEmpty(
 	return mergedResult, nil
 }
 
+// dedupRetrieveResults keeps the newest row of each primary key among the retrieve results and concatenates the
+// kept rows. A primary key may be retrieved from several segments, e.g. upserted, or being handed off from a growing
+// segment to a sealed segment on another query node, which should be aggregated only once. So the rows are
+// deduplicated on every query node, and once more by the shard leader which sees all the segments of the channel.
+func dedupRetrieveResults[T typeutil.ResultWithFields](retrieveResults []T) (*internalpb.RetrieveResults, error) {
+	type rowLocation struct {
+		result int
+		row    int64
+		ts     int64
+	}
+	newest := make(map[interface{}]rowLocation)
+	for i, r := range retrieveResults {
+		size := typeutil.GetSizeOfIDs(r.GetIds())
+		if size == 0 {
+			continue
+		}
+		timestamps := typeutil.GetFieldDataByID(r.GetFieldsData(), common.TimeStampField).GetScalars().GetLongData().GetData()
+		if len(timestamps) != size {
+			return nil, fmt.Errorf("timestamps of %d rows not found in retrieve results", size)
+		}
+		for row := 0; row < size; row++ {
+			pk := typeutil.GetPK(r.GetIds(), int64(row))
+			if loc, ok := newest[pk]; ok && loc.ts >= timestamps[row] {
+				continue
+			}
+			newest[pk] = rowLocation{result: i, row: int64(row), ts: timestamps[row]}
+		}
+	}
+
+	rows := make([][]int64, len(retrieveResults))
+	for _, loc := range newest {
+		rows[loc.result] = append(rows[loc.result], loc.row)
+	}
+	deduped := &internalpb.RetrieveResults{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Ids:    &schemapb.IDs{},
+	}
+	for i, r := range retrieveResults {
+		if len(rows[i]) == 0 {
+			continue
+		}
+		if deduped.FieldsData == nil {
+			deduped.FieldsData = make([]*schemapb.FieldData, len(r.GetFieldsData()))
+		}
+		sort.Slice(rows[i], func(a, b int) bool { return rows[i][a] < rows[i][b] })
+		for _, row := range rows[i] {
+			typeutil.AppendPKs(deduped.Ids, typeutil.GetPK(r.GetIds(), row))
+			typeutil.AppendFieldData(deduped.FieldsData, r.GetFieldsData(), row)
+		}
+	}
+	return deduped, nil
+}
+
+// aggregateInternalRetrieveResults aggregates the rows retrieved from all the segments of a channel into the partial
+// aggregates, which are merged by the proxy. The rows of a primary key are all in the same channel.
+func aggregateInternalRetrieveResults(
+	ctx context.Context,
+	retrieveResults []*internalpb.RetrieveResults,
+	req *internalpb.RetrieveRequest,
+	schema *schemapb.CollectionSchema,
+) (*internalpb.RetrieveResults, error) {
+	aggregator, err := typeutil.NewAggregator(schema, req.GetAggregates(), req.GetGroupByFieldId())
+	if err != nil {
+		return nil, err
+	}
+	deduped, err := dedupRetrieveResults(retrieveResults)
+	if err != nil {
+		return nil, err
+	}
+	if size := typeutil.GetSizeOfIDs(deduped.GetIds()); size > 0 {
+		if err := aggregator.AddRows(deduped.GetFieldsData(), size); err != nil {
+			return nil, err
+		}
+	}
+	log.Ctx(ctx).Debug("aggregateInternalRetrieveResults", zap.Int("len(retrieveResults)", len(retrieveResults)))
+
+	return &internalpb.RetrieveResults{
+		Status:         &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Ids:            &schemapb.IDs{},
+		AggregatesData: aggregator.PartialResults(),
+	}, nil
+}
+
+// mergeInternalAggregateResults merges the partial aggregates of the sub query results.
+func mergeInternalAggregateResults(
+	ctx context.Context,
+	retrieveResults []*internalpb.RetrieveResults,
+	req *internalpb.RetrieveRequest,
+	schema *schemapb.CollectionSchema,
+) (*internalpb.RetrieveResults, error) {
+	aggregator, err := typeutil.NewAggregator(schema, req.GetAggregates(), req.GetGroupByFieldId())
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range retrieveResults {
+		if err := aggregator.MergePartialResults(r.GetAggregatesData()); err != nil {
+			return nil, err
+		}
+	}
+	log.Ctx(ctx).Debug("mergeInternalAggregateResults", zap.Int("len(retrieveResults)", len(retrieveResults)))
+
+	return &internalpb.RetrieveResults{
+		Status:         &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Ids:            &schemapb.IDs{},
+		AggregatesData: aggregator.PartialResults(),
+	}, nil
+}
+
 // func printSearchResultData(data *schemapb.SearchResultData, header string) {
 // 	size := len(data.Ids.GetIntId().Data)
 // 	if size != len(data.Scores) {
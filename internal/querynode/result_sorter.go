@@ -60,6 +60,9 @@ func swapFieldData(field *schemapb.FieldData, i int, j int) {
 		case *schemapb.ScalarField_StringData:
 			data := sd.StringData.Data
 			data[i], data[j] = data[j], data[i]
+		case *schemapb.ScalarField_BytesData:
+			data := sd.BytesData.Data
+			data[i], data[j] = data[j], data[i]
 		}
 	case *schemapb.FieldData_Vectors:
 		dim := int(field.GetVectors().GetDim())
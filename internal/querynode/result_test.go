@@ -73,7 +73,7 @@ func TestResult_mergeSegcoreRetrieveResults(t *testing.T) {
 			FieldsData: fieldDataArray2,
 		}
 
-		result, err := mergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{result1, result2}, typeutil.Unlimited)
+		result, err := mergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{result1, result2}, typeutil.Unlimited, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(result.GetFieldsData()))
 		assert.Equal(t, []int64{0, 1}, result.GetIds().GetIntId().GetData())
@@ -82,7 +82,7 @@ func TestResult_mergeSegcoreRetrieveResults(t *testing.T) {
 	})
 
 	t.Run("test nil results", func(t *testing.T) {
-		ret, err := mergeSegcoreRetrieveResults(context.Background(), nil, typeutil.Unlimited)
+		ret, err := mergeSegcoreRetrieveResults(context.Background(), nil, typeutil.Unlimited, nil)
 		assert.NoError(t, err)
 		assert.Empty(t, ret.GetIds())
 		assert.Empty(t, ret.GetFieldsData())
@@ -100,7 +100,7 @@ func TestResult_mergeSegcoreRetrieveResults(t *testing.T) {
 			FieldsData: fieldDataArray1,
 		}
 
-		ret, err := mergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r}, typeutil.Unlimited)
+		ret, err := mergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r}, typeutil.Unlimited, nil)
 		assert.NoError(t, err)
 		assert.Empty(t, ret.GetIds())
 		assert.Empty(t, ret.GetFieldsData())
@@ -150,7 +150,7 @@ func TestResult_mergeSegcoreRetrieveResults(t *testing.T) {
 			resultField0 := []int64{11, 11, 22, 22}
 			for _, test := range tests {
 				t.Run(test.description, func(t *testing.T) {
-					result, err := mergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, test.limit)
+					result, err := mergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, test.limit, nil)
 					assert.Equal(t, 2, len(result.GetFieldsData()))
 					assert.Equal(t, int(test.limit), len(result.GetIds().GetIntId().GetData()))
 					assert.Equal(t, resultIDs[0:test.limit], result.GetIds().GetIntId().GetData())
@@ -162,7 +162,7 @@ func TestResult_mergeSegcoreRetrieveResults(t *testing.T) {
 		})
 
 		t.Run("test int ID", func(t *testing.T) {
-			result, err := mergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, typeutil.Unlimited)
+			result, err := mergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, typeutil.Unlimited, nil)
 			assert.Equal(t, 2, len(result.GetFieldsData()))
 			assert.Equal(t, []int64{1, 2, 3, 4}, result.GetIds().GetIntId().GetData())
 			assert.Equal(t, []int64{11, 11, 22, 22}, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
@@ -183,7 +183,7 @@ func TestResult_mergeSegcoreRetrieveResults(t *testing.T) {
 						Data: []string{"b", "d"},
 					}}}
 
-			result, err := mergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, typeutil.Unlimited)
+			result, err := mergeSegcoreRetrieveResults(context.Background(), []*segcorepb.RetrieveResults{r1, r2}, typeutil.Unlimited, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, 2, len(result.GetFieldsData()))
 			assert.Equal(t, []string{"a", "b", "c", "d"}, result.GetIds().GetStrId().GetData())
@@ -236,7 +236,7 @@ func TestResult_mergeInternalRetrieveResults(t *testing.T) {
 			FieldsData: fieldDataArray2,
 		}
 
-		result, err := mergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{result1, result2}, typeutil.Unlimited)
+		result, err := mergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{result1, result2}, typeutil.Unlimited, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(result.GetFieldsData()))
 		assert.Equal(t, []int64{0, 1}, result.GetIds().GetIntId().GetData())
@@ -245,7 +245,7 @@ func TestResult_mergeInternalRetrieveResults(t *testing.T) {
 	})
 
 	t.Run("test nil results", func(t *testing.T) {
-		ret, err := mergeInternalRetrieveResult(context.Background(), nil, typeutil.Unlimited)
+		ret, err := mergeInternalRetrieveResult(context.Background(), nil, typeutil.Unlimited, nil)
 		assert.NoError(t, err)
 		assert.Empty(t, ret.GetIds())
 		assert.Empty(t, ret.GetFieldsData())
@@ -280,7 +280,7 @@ func TestResult_mergeInternalRetrieveResults(t *testing.T) {
 					[]int64{7, 8}, 1),
 			},
 		}
-		result, err := mergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{ret1, ret2}, typeutil.Unlimited)
+		result, err := mergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{ret1, ret2}, typeutil.Unlimited, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(result.GetFieldsData()))
 		assert.Equal(t, []int64{0, 1}, result.GetIds().GetIntId().GetData())
@@ -329,7 +329,7 @@ func TestResult_mergeInternalRetrieveResults(t *testing.T) {
 			resultField0 := []int64{11, 11, 22, 22}
 			for _, test := range tests {
 				t.Run(test.description, func(t *testing.T) {
-					result, err := mergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r1, r2}, test.limit)
+					result, err := mergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r1, r2}, test.limit, nil)
 					assert.Equal(t, 2, len(result.GetFieldsData()))
 					assert.Equal(t, int(test.limit), len(result.GetIds().GetIntId().GetData()))
 					assert.Equal(t, resultIDs[0:test.limit], result.GetIds().GetIntId().GetData())
@@ -341,7 +341,7 @@ func TestResult_mergeInternalRetrieveResults(t *testing.T) {
 		})
 
 		t.Run("test int ID", func(t *testing.T) {
-			result, err := mergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r1, r2}, typeutil.Unlimited)
+			result, err := mergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r1, r2}, typeutil.Unlimited, nil)
 			assert.Equal(t, 2, len(result.GetFieldsData()))
 			assert.Equal(t, []int64{1, 2, 3, 4}, result.GetIds().GetIntId().GetData())
 			assert.Equal(t, []int64{11, 11, 22, 22}, result.GetFieldsData()[0].GetScalars().GetLongData().Data)
@@ -366,7 +366,7 @@ func TestResult_mergeInternalRetrieveResults(t *testing.T) {
 				},
 			}
 
-			result, err := mergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r1, r2}, typeutil.Unlimited)
+			result, err := mergeInternalRetrieveResult(context.Background(), []*internalpb.RetrieveResults{r1, r2}, typeutil.Unlimited, nil)
 			assert.NoError(t, err)
 			assert.Equal(t, 2, len(result.GetFieldsData()))
 			assert.Equal(t, []string{"a", "b", "c", "d"}, result.GetIds().GetStrId().GetData())
@@ -378,6 +378,120 @@ func TestResult_mergeInternalRetrieveResults(t *testing.T) {
 	})
 }
 
+func TestResult_mergeRetrieveResultsOrderBy(t *testing.T) {
+	newSegcoreResult := func(pks []int64, ages []int64) *segcorepb.RetrieveResults {
+		return &segcorepb.RetrieveResults{
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+			Offset:     pks,
+			FieldsData: []*schemapb.FieldData{genFieldData("age", 101, schemapb.DataType_Int64, ages, 1)},
+		}
+	}
+	orderBy := []*internalpb.OrderByField{{FieldId: 101, Ascending: false}}
+
+	// segments are sorted by age and truncated to limit before merging
+	ctx := context.Background()
+	result, err := mergeSegcoreRetrieveResults(ctx, []*segcorepb.RetrieveResults{
+		newSegcoreResult([]int64{1, 2, 3}, []int64{10, 30, 20}),
+		newSegcoreResult([]int64{4, 5}, []int64{40, 5}),
+	}, 3, orderBy)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 2, 3}, result.GetIds().GetIntId().GetData())
+	assert.Equal(t, []int64{40, 30, 20}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+	internalResult := &internalpb.RetrieveResults{
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{6, 7}}}},
+		FieldsData: []*schemapb.FieldData{genFieldData("age", 101, schemapb.DataType_Int64, []int64{35, 25}, 1)},
+	}
+	merged, err := mergeInternalRetrieveResult(ctx, []*internalpb.RetrieveResults{
+		{Ids: result.GetIds(), FieldsData: result.GetFieldsData()},
+		internalResult,
+	}, 4, orderBy)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 6, 2, 7}, merged.GetIds().GetIntId().GetData())
+
+	t.Run("newest row of duplicated pk", func(t *testing.T) {
+		newInternalResult := func(pks []int64, ages []int64, timestamps []int64) *internalpb.RetrieveResults {
+			return &internalpb.RetrieveResults{
+				Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+				FieldsData: []*schemapb.FieldData{
+					genFieldData("age", 101, schemapb.DataType_Int64, ages, 1),
+					genFieldData(common.TimeStampFieldName, common.TimeStampField, schemapb.DataType_Int64, timestamps, 1),
+				},
+			}
+		}
+		// pk 2 is upserted with a smaller age, the stale row comes first in the order
+		merged, err := mergeInternalRetrieveResult(ctx, []*internalpb.RetrieveResults{
+			newInternalResult([]int64{2, 8}, []int64{30, 20}, []int64{100, 100}),
+			newInternalResult([]int64{2}, []int64{10}, []int64{200}),
+		}, typeutil.Unlimited, orderBy)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{8, 2}, merged.GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{20, 10}, merged.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int64{100, 200}, merged.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+	})
+}
+
+func TestResult_aggregateRetrieveResults(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+	req := &internalpb.RetrieveRequest{
+		Aggregates: []*internalpb.Aggregate{
+			{Type: internalpb.AggregateType_Count},
+			{Type: internalpb.AggregateType_Sum, FieldId: 101},
+		},
+	}
+	newSegcoreResult := func(pks []int64, ages []int64, timestamps []int64) *segcorepb.RetrieveResults {
+		return &segcorepb.RetrieveResults{
+			Ids:    &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+			Offset: make([]int64, len(pks)),
+			FieldsData: []*schemapb.FieldData{
+				genFieldData("age", 101, schemapb.DataType_Int64, ages, 1),
+				genFieldData(common.TimeStampFieldName, common.TimeStampField, schemapb.DataType_Int64, timestamps, 1),
+			},
+		}
+	}
+
+	ctx := context.Background()
+	// pk 2 is upserted into the second segment, only the newest row is aggregated
+	rows1, err := dedupRetrieveResults([]*segcorepb.RetrieveResults{
+		newSegcoreResult([]int64{1, 2}, []int64{10, 15}, []int64{100, 100}),
+		newSegcoreResult([]int64{3, 2}, []int64{30, 20}, []int64{100, 200}),
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1, 3, 2}, rows1.GetIds().GetIntId().GetData())
+	// pk 3 is handed off, the growing segment and the sealed segment on the other query node both have it
+	rows2, err := dedupRetrieveResults([]*segcorepb.RetrieveResults{newSegcoreResult([]int64{4, 3}, []int64{40, 30}, []int64{100, 100})})
+	assert.NoError(t, err)
+	empty, err := dedupRetrieveResults([]*segcorepb.RetrieveResults{newSegcoreResult(nil, nil, nil)})
+	assert.NoError(t, err)
+
+	// the shard leader aggregates the rows of all the segments of the channel
+	result1, err := aggregateInternalRetrieveResults(ctx, []*internalpb.RetrieveResults{rows1, rows2, empty}, req, schema)
+	assert.NoError(t, err)
+	result2, err := aggregateInternalRetrieveResults(ctx, []*internalpb.RetrieveResults{empty}, req, schema)
+	assert.NoError(t, err)
+
+	merged, err := mergeInternalAggregateResults(ctx, []*internalpb.RetrieveResults{result1, result2}, req, schema)
+	assert.NoError(t, err)
+	aggregator, err := typeutil.NewAggregator(schema, req.GetAggregates(), 0)
+	assert.NoError(t, err)
+	assert.NoError(t, aggregator.MergePartialResults(merged.GetAggregatesData()))
+	results := aggregator.Results(0, typeutil.Unlimited)
+	assert.Equal(t, []int64{4}, results[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []int64{100}, results[1].GetScalars().GetLongData().GetData())
+
+	t.Run("without timestamps", func(t *testing.T) {
+		r := newSegcoreResult([]int64{1}, []int64{10}, []int64{100})
+		r.FieldsData = r.FieldsData[:1]
+		_, err := dedupRetrieveResults([]*segcorepb.RetrieveResults{r})
+		assert.Error(t, err)
+	})
+}
+
 func TestResult_reduceSearchResultData(t *testing.T) {
 	const (
 		nq         = 1
@@ -393,7 +507,7 @@ func TestResult_reduceSearchResultData(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, _, err := reduceSearchResultData(context.TODO(), dataArray, nq, topk, 0, 0, nil)
 		assert.Nil(t, err)
 		assert.Equal(t, ids, res.Ids.GetIntId().Data)
 		assert.Equal(t, scores, res.Scores)
@@ -410,10 +524,93 @@ func TestResult_reduceSearchResultData(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, _, err := reduceSearchResultData(context.TODO(), dataArray, nq, topk, 0, 0, nil)
 		assert.Nil(t, err)
 		assert.ElementsMatch(t, []int64{1, 5, 2, 3}, res.Ids.GetIntId().Data)
 	})
+	t.Run("variable length", func(t *testing.T) {
+		// results of range search, the number of results differs between queries
+		data1 := genSearchResultData(2, topk, []int64{1, 2, 3}, []float32{-1.0, -3.0, -2.0}, []int64{2, 1})
+		data2 := genSearchResultData(2, topk, []int64{4}, []float32{-2.0}, []int64{1, 0})
+		res, _, err := reduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{data1, data2}, 2, topk, 0, 0, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 4, 2, 3}, res.Ids.GetIntId().Data)
+		assert.Equal(t, []float32{-1.0, -2.0, -3.0, -2.0}, res.Scores)
+		assert.Equal(t, []int64{3, 1}, res.Topks)
+	})
+	t.Run("group by", func(t *testing.T) {
+		genGroupFieldData := func(data []int64) []*schemapb.FieldData {
+			return []*schemapb.FieldData{{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 101,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}},
+					},
+				},
+			}}
+		}
+		data1 := genSearchResultData(nq, topk, []int64{1, 2, 3, 4}, []float32{-1.0, -2.0, -3.0, -4.0}, []int64{4})
+		data1.FieldsData = genGroupFieldData([]int64{10, 10, 20, 30})
+		data2 := genSearchResultData(nq, topk, []int64{5, 6}, []float32{-1.5, -2.5}, []int64{2})
+		data2.FieldsData = genGroupFieldData([]int64{10, 40})
+		res, truncated, err := reduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{data1, data2}, nq, topk, 101, 3, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{1, 6, 3}, res.Ids.GetIntId().Data)
+		assert.Equal(t, []int64{10, 40, 20}, res.FieldsData[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int64{3}, res.Topks)
+		// the hit 4 of data1 is not consumed
+		assert.Equal(t, []bool{true}, truncated)
+
+		_, _, err = reduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{data1, data2}, nq, topk, 102, 3, nil)
+		assert.Error(t, err)
+	})
+	t.Run("group by one group dominates", func(t *testing.T) {
+		genResults := func(topks []int64, groups []int64) *internalpb.SearchResults {
+			ids := make([]int64, len(groups))
+			scores := make([]float32, len(groups))
+			for i := range groups {
+				ids[i] = int64(i + 1)
+				scores[i] = -float32(i)
+			}
+			data := genSearchResultData(int64(len(topks)), topk, ids, scores, topks)
+			data.FieldsData = []*schemapb.FieldData{{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 101,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: groups}},
+					},
+				},
+			}}
+			results, err := encodeSearchResultData(data, int64(len(topks)), topk, metricType)
+			assert.NoError(t, err)
+			return results
+		}
+
+		// all the topk candidates of the first query belong to one group, the segment of the second query
+		// is exhausted.
+		segment := genResults([]int64{4, 2}, []int64{10, 10, 10, 10, 20, 20})
+		channel, err := reduceSearchResults(context.TODO(), []*internalpb.SearchResults{segment}, 2, topk, metricType, 101, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{true, false}, channel.GetGroupsTruncated())
+
+		// the grouped results keep the flags when merged
+		empty, err := reduceSearchResults(context.TODO(), nil, 2, topk, metricType, 101, 3)
+		assert.NoError(t, err)
+		res, err := reduceSearchResults(context.TODO(), []*internalpb.SearchResults{channel, empty}, 2, topk, metricType, 101, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{true, false}, res.GetGroupsTruncated())
+		data, err := decodeSearchResults([]*internalpb.SearchResults{res})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 5}, data[0].GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{1, 1}, data[0].GetTopks())
+
+		// no flag without group by
+		res, err = reduceSearchResults(context.TODO(), []*internalpb.SearchResults{segment}, 2, topk, metricType, 0, 0)
+		assert.NoError(t, err)
+		assert.Empty(t, res.GetGroupsTruncated())
+	})
 }
 
 func TestResult_selectSearchResultData_int(t *testing.T) {
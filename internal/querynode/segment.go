@@ -83,7 +83,8 @@ type Segment struct {
 	partitionID   UniqueID
 	collectionID  UniqueID
 	version       UniqueID
-	startPosition *internalpb.MsgPosition // for growing segment release
+	startPosition *internalpb.MsgPosition    // for growing segment release
+	schema        *schemapb.CollectionSchema // the collection schema when the segment was created
 
 	vChannelID   Channel
 	lastMemSize  int64
@@ -165,6 +166,8 @@ func (s *Segment) setUnhealthy() {
 	s.destroyed.Store(true)
 }
 
+// newSegment creates a segment with the current schema of the collection,
+// the caller should hold the lock of collection to keep the schema consistent with the segcore collection.
 func newSegment(collection *Collection,
 	segmentID UniqueID,
 	partitionID UniqueID,
@@ -208,6 +211,7 @@ func newSegment(collection *Collection,
 		collectionID:      collectionID,
 		version:           version,
 		startPosition:     startPosition,
+		schema:            collection.Schema(),
 		vChannelID:        vChannelID,
 		indexedFieldInfos: typeutil.NewConcurrentMap[int64, *IndexedFieldInfo](),
 		recentlyModified:  atomic.NewBool(false),
@@ -574,6 +578,10 @@ func (s *Segment) fillIndexedFieldsData(ctx context.Context, collectionID Unique
 		// TODO: optimize here. Now we'll read a whole file from storage every time we retrieve raw data by offset.
 		for i, offset := range result.Offset {
 			dataPath, offsetInBinlog := s.getFieldDataPath(indexedFieldInfo, offset)
+			// only the column of the field is read from the columnar binlog shared by the fields
+			if storage.IsColumnarLogPath(dataPath) {
+				dataPath = storage.ColumnarFieldPath(dataPath, fieldData.FieldId)
+			}
 			endian := common.Endian
 
 			// fill field data that fieldData[i] = dataPath[offsetInBinlog*rowBytes, (offsetInBinlog+1)*rowBytes]
@@ -788,7 +796,9 @@ func (s *Segment) segmentDelete(offset int64, entityIDs []primaryKey, timestamps
 }
 
 // -------------------------------------------------------------------------------------- interfaces for sealed segment
-func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int64, data *schemapb.FieldData) error {
+// segmentLoadFieldData loads the data of the field into the sealed segment, validData marks the null rows
+// of the nullable field, nil means all the rows are valid.
+func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int64, data *schemapb.FieldData, validData []bool) error {
 	/*
 		CStatus
 		LoadFieldData(CSegmentInterface c_segment, CLoadFieldDataInfo load_field_data_info);
@@ -814,6 +824,12 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int64, data *sche
 		blob_size: C.uint64_t(len(dataBlob)),
 		row_count: C.int64_t(rowCount),
 	}
+	if len(validData) > 0 {
+		if int64(len(validData)) != rowCount {
+			return fmt.Errorf("length of valid data %d mismatches row count %d, fieldID = %d", len(validData), rowCount, fieldID)
+		}
+		loadInfo.valid_data = (*C.bool)(unsafe.Pointer(&validData[0]))
+	}
 
 	status := C.LoadFieldData(s.segmentPtr, loadInfo)
 
@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/concurrency"
@@ -53,6 +54,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/panjf2000/ants/v2"
+	"github.com/samber/lo"
 )
 
 const (
@@ -152,7 +154,9 @@ func (loader *segmentLoader) LoadSegment(ctx context.Context, req *querypb.LoadS
 			return nil, err
 		}
 
+		collection.mu.RLock()
 		segment, err := newSegment(collection, segmentID, partitionID, collectionID, vChannelID, segmentType, req.GetVersion(), info.StartPosition)
+		collection.mu.RUnlock()
 		if err != nil {
 			log.Error("load segment failed when create new segment",
 				zap.Int64("partitionID", partitionID),
@@ -244,9 +248,24 @@ func (loader *segmentLoader) loadFiles(ctx context.Context, segment *Segment,
 	defer debug.FreeOSMemory()
 
 	if segment.getType() == segmentTypeSealed {
+		// the rows after the max row timestamp or at or before the min row timestamp are filtered out,
+		// rows is nil if all the rows are kept.
+		var rows []int64
+		numRows := loadInfo.GetNumOfRows()
+		if loadInfo.GetMaxRowTimestamp() != 0 || loadInfo.GetMinRowTimestamp() != 0 {
+			rows, err = loader.filterRowsByTimestamp(ctx, loadInfo)
+			if err != nil {
+				return err
+			}
+			if rows != nil {
+				numRows = int64(len(rows))
+			}
+		}
+
 		fieldID2IndexInfo := make(map[int64]*querypb.FieldIndexInfo)
 		for _, indexInfo := range loadInfo.IndexInfos {
-			if len(indexInfo.IndexFilePaths) > 0 {
+			// the indexes are built on all the rows, so they are not used if some rows are filtered out.
+			if len(indexInfo.IndexFilePaths) > 0 && rows == nil {
 				fieldID := indexInfo.FieldID
 				fieldID2IndexInfo[fieldID] = indexInfo
 			}
@@ -272,7 +291,15 @@ func (loader *segmentLoader) loadFiles(ctx context.Context, segment *Segment,
 		if err := loader.loadIndexedFieldData(ctx, segment, indexedFieldInfos); err != nil {
 			return err
 		}
-		if err := loader.loadSealedSegmentFields(ctx, segment, fieldBinlogs, loadInfo); err != nil {
+		if loadInfo.GetStorageVersion() == storage.StorageV2 {
+			err = loader.loadSealedSegmentFieldsV2(ctx, segment, fieldBinlogs, loadInfo, rows)
+		} else {
+			err = loader.loadSealedSegmentFields(ctx, segment, fieldBinlogs, loadInfo, rows)
+		}
+		if err != nil {
+			return err
+		}
+		if err := loader.loadDefaultFields(segment, loadInfo, numRows); err != nil {
 			return err
 		}
 	} else {
@@ -293,10 +320,100 @@ func (loader *segmentLoader) loadFiles(ctx context.Context, segment *Segment,
 	}
 
 	log.Info("loading delta...", zap.Int64("segmentID", segmentID))
-	err = loader.loadDeltaLogs(ctx, segment, loadInfo.Deltalogs)
+	err = loader.loadDeltaLogs(ctx, segment, loadInfo.Deltalogs, loadInfo.GetMaxRowTimestamp())
 	return err
 }
 
+// filterRowsByTimestamp returns the offsets of the rows whose timestamps are after the min row timestamp
+// and not after the max row timestamp of the segment, nil is returned if all the rows are kept.
+func (loader *segmentLoader) filterRowsByTimestamp(ctx context.Context, loadInfo *querypb.SegmentLoadInfo) ([]int64, error) {
+	var tsBinlog *datapb.FieldBinlog
+	for _, fieldBinlog := range loadInfo.GetBinlogPaths() {
+		if fieldBinlog.GetFieldID() == common.TimeStampField {
+			tsBinlog = fieldBinlog
+			break
+		}
+	}
+	if tsBinlog == nil {
+		return nil, fmt.Errorf("timestamp binlogs not found, segmentID = %d", loadInfo.GetSegmentID())
+	}
+
+	futures := loader.loadFieldBinlogsAsync(ctx, tsBinlog)
+	if err := concurrency.AwaitAll(futures...); err != nil {
+		return nil, err
+	}
+	blobs := make([]*storage.Blob, len(futures))
+	for index, future := range futures {
+		blobs[index] = future.Value().(*storage.Blob)
+	}
+	insertData := storage.InsertData{
+		Data: make(map[int64]storage.FieldData),
+	}
+	iCodec := storage.InsertCodec{}
+	_, _, _, err := iCodec.DeserializeFieldsInto(blobs, []storage.FieldID{common.TimeStampField}, int(loadInfo.GetNumOfRows()), &insertData)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamps := insertData.Data[common.TimeStampField].(*storage.Int64FieldData).Data
+	rows := make([]int64, 0, len(timestamps))
+	for i, ts := range timestamps {
+		if Timestamp(ts) <= loadInfo.GetMinRowTimestamp() {
+			continue
+		}
+		if loadInfo.GetMaxRowTimestamp() == 0 || Timestamp(ts) <= loadInfo.GetMaxRowTimestamp() {
+			rows = append(rows, int64(i))
+		}
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("no rows between the min row timestamp %d and the max row timestamp %d, segmentID = %d",
+			loadInfo.GetMinRowTimestamp(), loadInfo.GetMaxRowTimestamp(), loadInfo.GetSegmentID())
+	}
+	if len(rows) == len(timestamps) {
+		return nil, nil
+	}
+	log.Info("filter rows out of the row timestamp range",
+		zap.Int64("segmentID", loadInfo.GetSegmentID()),
+		zap.Uint64("minRowTimestamp", loadInfo.GetMinRowTimestamp()),
+		zap.Uint64("maxRowTimestamp", loadInfo.GetMaxRowTimestamp()),
+		zap.Int("numRows", len(timestamps)),
+		zap.Int("numKeptRows", len(rows)))
+	return rows, nil
+}
+
+// loadDefaultFields loads the default values of the fields without binlogs into the sealed segment,
+// the segment was flushed before the fields were added to the collection.
+func (loader *segmentLoader) loadDefaultFields(segment *Segment, loadInfo *querypb.SegmentLoadInfo, numRows int64) error {
+	loadedFields := make(map[int64]struct{}, len(loadInfo.GetBinlogPaths()))
+	for _, fieldBinlog := range loadInfo.GetBinlogPaths() {
+		loadedFields[fieldBinlog.GetFieldID()] = struct{}{}
+	}
+
+	for _, field := range segment.schema.GetFields() {
+		if _, ok := loadedFields[field.GetFieldID()]; ok || !typeutil.HasDefaultValue(field) {
+			continue
+		}
+		fieldData, err := typeutil.GenDefaultFieldData(field, int(numRows))
+		if err != nil {
+			return err
+		}
+		// all the rows are null if the nullable field has no default value
+		var validData []bool
+		if typeutil.IsNullByDefault(field) {
+			validData = make([]bool, numRows)
+		}
+		if err := segment.segmentLoadFieldData(field.GetFieldID(), numRows, fieldData, validData); err != nil {
+			return err
+		}
+		log.Info("load default values of field without binlogs",
+			zap.Int64("collectionID", segment.collectionID),
+			zap.Int64("segmentID", segment.segmentID),
+			zap.Int64("fieldID", field.GetFieldID()),
+			zap.Int64("numRows", numRows))
+	}
+	return nil
+}
+
 func (loader *segmentLoader) filterPKStatsBinlogs(fieldBinlogs []*datapb.FieldBinlog, pkFieldID int64) []string {
 	result := make([]string, 0)
 	for _, fieldBinlog := range fieldBinlogs {
@@ -317,12 +434,9 @@ func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segme
 	segmentType := segment.getType()
 	iCodec := storage.InsertCodec{}
 
-	// change all field bin log loading into concurrent
-	loadFutures := make([]*concurrency.Future, 0, len(fieldBinlogs))
-	for _, fieldBinlog := range fieldBinlogs {
-		futures := loader.loadFieldBinlogsAsync(ctx, fieldBinlog)
-		loadFutures = append(loadFutures, futures...)
-	}
+	// change all field bin log loading into concurrent,
+	// the binlogs shared by the fields of StorageV2 are loaded once
+	loadFutures := loader.loadBinlogsAsync(ctx, distinctLogPaths(fieldBinlogs))
 
 	// wait for async load results
 	blobs := make([]*storage.Blob, len(loadFutures))
@@ -362,6 +476,15 @@ func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segme
 			return errors.New("cannot get row ids from insert data")
 		}
 
+		// the segment was flushed before some fields were added to the collection
+		collection, err := loader.metaReplica.getCollectionByID(segment.collectionID)
+		if err != nil {
+			return err
+		}
+		if err := storage.FillMissingFields(collection.Schema(), insertData, len(utss)); err != nil {
+			return err
+		}
+
 		return loader.loadGrowingSegments(segment, rowIDData.(*storage.Int64FieldData).Data, utss, insertData)
 
 	default:
@@ -370,13 +493,13 @@ func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segme
 	}
 }
 
-func (loader *segmentLoader) loadSealedSegmentFields(ctx context.Context, segment *Segment, fields []*datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo) error {
+func (loader *segmentLoader) loadSealedSegmentFields(ctx context.Context, segment *Segment, fields []*datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo, rows []int64) error {
 	runningGroup, groupCtx := errgroup.WithContext(ctx)
 	for _, field := range fields {
 		fieldBinLog := field
 		runningGroup.Go(func() error {
 			// reload data from dml channel
-			return loader.loadSealedField(groupCtx, segment, fieldBinLog, loadInfo)
+			return loader.loadSealedField(groupCtx, segment, fieldBinLog, loadInfo, rows)
 		})
 	}
 	err := runningGroup.Wait()
@@ -394,7 +517,7 @@ func (loader *segmentLoader) loadSealedSegmentFields(ctx context.Context, segmen
 }
 
 // async load field of sealed segment
-func (loader *segmentLoader) loadSealedField(ctx context.Context, segment *Segment, field *datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo) error {
+func (loader *segmentLoader) loadSealedField(ctx context.Context, segment *Segment, field *datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo, rows []int64) error {
 	iCodec := storage.InsertCodec{}
 
 	// Avoid consuming too much memory if no CPU worker ready,
@@ -415,21 +538,85 @@ func (loader *segmentLoader) loadSealedField(ctx context.Context, segment *Segme
 	insertData := storage.InsertData{
 		Data: make(map[int64]storage.FieldData),
 	}
-	_, _, _, err = iCodec.DeserializeInto(blobs, int(loadInfo.GetNumOfRows()), &insertData)
+	// only the field is read if the binlogs are shared by the fields
+	_, _, _, err = iCodec.DeserializeFieldsInto(blobs, []storage.FieldID{field.GetFieldID()}, int(loadInfo.GetNumOfRows()), &insertData)
 
 	if err != nil {
 		log.Warn("failed to load sealed field", zap.Int64("SegmentId", segment.segmentID), zap.Error(err))
 		return err
 	}
 
-	return loader.loadSealedSegments(segment, &insertData)
+	return loader.loadSealedSegments(segment, &insertData, rows)
+}
+
+// loadSealedSegmentFieldsV2 loads the fields of the sealed segment of StorageV2,
+// the columnar binlogs shared by the fields are read once, and only the columns of the fields are decoded.
+func (loader *segmentLoader) loadSealedSegmentFieldsV2(ctx context.Context, segment *Segment, fields []*datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo, rows []int64) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	futures := loader.loadBinlogsAsync(ctx, distinctLogPaths(fields))
+	err := concurrency.AwaitAll(futures...)
+	if err != nil {
+		return err
+	}
+
+	blobs := make([]*storage.Blob, len(futures))
+	for index, future := range futures {
+		blobs[index] = future.Value().(*storage.Blob)
+	}
+
+	fieldIDs := make([]storage.FieldID, 0, len(fields))
+	for _, field := range fields {
+		fieldIDs = append(fieldIDs, field.GetFieldID())
+	}
+	insertData := storage.InsertData{
+		Data: make(map[int64]storage.FieldData),
+	}
+	iCodec := storage.InsertCodec{}
+	_, _, _, err = iCodec.DeserializeFieldsInto(blobs, fieldIDs, int(loadInfo.GetNumOfRows()), &insertData)
+	if err != nil {
+		log.Warn("failed to load sealed fields", zap.Int64("SegmentId", segment.segmentID), zap.Error(err))
+		return err
+	}
+
+	if err := loader.loadSealedSegments(segment, &insertData, rows); err != nil {
+		return err
+	}
+
+	log.Info("load columnar binlogs done for sealed segment",
+		zap.Int64("collection", segment.collectionID),
+		zap.Int64("segment", segment.segmentID),
+		zap.Int("len(field)", len(fields)),
+		zap.Int("len(binlog)", len(blobs)))
+	return nil
+}
+
+// distinctLogPaths returns the paths of the binlogs of the fields in order, the paths shared by the fields appear once.
+func distinctLogPaths(fields []*datapb.FieldBinlog) []string {
+	paths := make([]string, 0)
+	for _, field := range fields {
+		for _, binlog := range field.GetBinlogs() {
+			paths = append(paths, binlog.GetLogPath())
+		}
+	}
+	return lo.Uniq(paths)
 }
 
 // Load binlogs concurrently into memory from KV storage asyncly
 func (loader *segmentLoader) loadFieldBinlogsAsync(ctx context.Context, field *datapb.FieldBinlog) []*concurrency.Future {
-	futures := make([]*concurrency.Future, 0, len(field.Binlogs))
-	for i := range field.Binlogs {
-		path := field.Binlogs[i].GetLogPath()
+	paths := make([]string, 0, len(field.Binlogs))
+	for _, binlog := range field.Binlogs {
+		paths = append(paths, binlog.GetLogPath())
+	}
+	return loader.loadBinlogsAsync(ctx, paths)
+}
+
+func (loader *segmentLoader) loadBinlogsAsync(ctx context.Context, paths []string) []*concurrency.Future {
+	futures := make([]*concurrency.Future, 0, len(paths))
+	for i := range paths {
+		path := paths[i]
 		future := loader.ioPool.Submit(func() (interface{}, error) {
 			binLog, err := loader.cm.Read(ctx, path)
 			if err != nil {
@@ -618,11 +805,16 @@ func (loader *segmentLoader) loadGrowingSegments(segment *Segment,
 	return nil
 }
 
-func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *storage.InsertData) error {
+// loadSealedSegments loads the insert data into the sealed segment, only the rows at the offsets are loaded
+// if rows is not nil.
+func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *storage.InsertData, rows []int64) error {
 	insertRecord, err := storage.TransferInsertDataToInsertRecord(insertData)
 	if err != nil {
 		return err
 	}
+	if rows != nil {
+		insertRecord = selectInsertRecordRows(insertRecord, rows)
+	}
 	numRows := insertRecord.NumRows
 	for _, fieldData := range insertRecord.FieldsData {
 		fieldID := fieldData.FieldId
@@ -631,7 +823,7 @@ func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *st
 			segment.setIDBinlogRowSizes(timestampsData.NumRows)
 		}
 
-		err := segment.segmentLoadFieldData(fieldID, numRows, fieldData)
+		err := segment.segmentLoadFieldData(fieldID, numRows, fieldData, getValidData(insertRecord, fieldID))
 		if err != nil {
 			// TODO: return or continue?
 			return err
@@ -640,6 +832,37 @@ func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *st
 	return nil
 }
 
+// selectInsertRecordRows returns the insert record composed of the rows at the offsets.
+func selectInsertRecordRows(insertRecord *segcorepb.InsertRecord, rows []int64) *segcorepb.InsertRecord {
+	fieldsData := make([]*schemapb.FieldData, len(insertRecord.GetFieldsData()))
+	for _, row := range rows {
+		typeutil.AppendFieldData(fieldsData, insertRecord.GetFieldsData(), row)
+	}
+	validData := make([]*segcorepb.FieldValidData, 0, len(insertRecord.GetValidData()))
+	for _, data := range insertRecord.GetValidData() {
+		valid := make([]bool, 0, len(rows))
+		for _, row := range rows {
+			valid = append(valid, data.GetValid()[row])
+		}
+		validData = append(validData, &segcorepb.FieldValidData{FieldId: data.GetFieldId(), Valid: valid})
+	}
+	return &segcorepb.InsertRecord{
+		FieldsData: fieldsData,
+		NumRows:    int64(len(rows)),
+		ValidData:  validData,
+	}
+}
+
+// getValidData returns the validity of the rows of the field in the insert record, nil means all the rows are valid.
+func getValidData(insertRecord *segcorepb.InsertRecord, fieldID int64) []bool {
+	for _, data := range insertRecord.GetValidData() {
+		if data.GetFieldId() == fieldID {
+			return data.GetValid()
+		}
+	}
+	return nil
+}
+
 func (loader *segmentLoader) loadSegmentBloomFilter(ctx context.Context, segment *Segment, binlogPaths []string) error {
 	if len(binlogPaths) == 0 {
 		log.Info("there are no stats logs saved with segment", zap.Any("segmentID", segment.segmentID))
@@ -675,9 +898,11 @@ func (loader *segmentLoader) loadSegmentBloomFilter(ctx context.Context, segment
 	return nil
 }
 
-func (loader *segmentLoader) loadDeltaLogs(ctx context.Context, segment *Segment, deltaLogs []*datapb.FieldBinlog) error {
+// loadDeltaLogs loads the deletions of the deltalogs into the segment. If maxRowTimestamp is not 0, the segment is
+// cloned, the deltalogs starting before it are shared with the source segment, whose deletions after it are skipped.
+func (loader *segmentLoader) loadDeltaLogs(ctx context.Context, segment *Segment, deltaLogs []*datapb.FieldBinlog, maxRowTimestamp Timestamp) error {
 	dCodec := storage.DeleteCodec{}
-	var blobs []*storage.Blob
+	var blobs, sharedBlobs []*storage.Blob
 	for _, deltaLog := range deltaLogs {
 		for _, bLog := range deltaLog.GetBinlogs() {
 			value, err := loader.cm.Read(ctx, bLog.GetLogPath())
@@ -688,19 +913,42 @@ func (loader *segmentLoader) loadDeltaLogs(ctx context.Context, segment *Segment
 				Key:   bLog.GetLogPath(),
 				Value: value,
 			}
-			blobs = append(blobs, blob)
+			if maxRowTimestamp != 0 && bLog.GetTimestampFrom() <= maxRowTimestamp {
+				sharedBlobs = append(sharedBlobs, blob)
+			} else {
+				blobs = append(blobs, blob)
+			}
 		}
 	}
-	if len(blobs) == 0 {
+	if len(blobs) == 0 && len(sharedBlobs) == 0 {
 		log.Info("there are no delta logs saved with segment, skip loading delete record", zap.Any("segmentID", segment.segmentID))
 		return nil
 	}
-	_, _, deltaData, err := dCodec.Deserialize(blobs)
-	if err != nil {
-		return err
+
+	deltaData := &storage.DeleteData{}
+	if len(blobs) > 0 {
+		_, _, data, err := dCodec.Deserialize(blobs)
+		if err != nil {
+			return err
+		}
+		deltaData = data
+	}
+	if len(sharedBlobs) > 0 {
+		_, _, data, err := dCodec.Deserialize(sharedBlobs)
+		if err != nil {
+			return err
+		}
+		for i, ts := range data.Tss {
+			if ts <= maxRowTimestamp {
+				deltaData.Append(data.Pks[i], ts)
+			}
+		}
+	}
+	if deltaData.RowCount == 0 {
+		return nil
 	}
 
-	err = segment.segmentLoadDeletedRecord(deltaData.Pks, deltaData.Tss, deltaData.RowCount)
+	err := segment.segmentLoadDeletedRecord(deltaData.Pks, deltaData.Tss, deltaData.RowCount)
 	if err != nil {
 		return err
 	}
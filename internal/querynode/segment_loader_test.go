@@ -19,11 +19,13 @@ package querynode
 import (
 	"context"
 	"errors"
+	"math"
 	"math/rand"
 	"runtime"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -37,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -174,6 +177,220 @@ func TestSegmentLoader_loadSegment(t *testing.T) {
 	})
 }
 
+func TestSegmentLoader_loadAddedFields(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	require.NoError(t, err)
+	defer node.Stop()
+
+	// the segment was flushed before the field was added by AlterCollection
+	schema := genTestCollectionSchema()
+	fieldBinlog, statsLog, err := saveBinLog(ctx, defaultCollectionID, defaultPartitionID, defaultSegmentID, defaultMsgLength, schema)
+	require.NoError(t, err)
+	altered, addedField := genAlteredCollectionSchema(schema)
+
+	// the load request after altering carries the new schema
+	node.metaReplica.removeSegment(defaultSegmentID, segmentTypeSealed)
+	collection := node.metaReplica.addCollection(defaultCollectionID, altered)
+	assert.Same(t, altered, collection.Schema())
+
+	req := &querypb.LoadSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadSegments,
+			MsgID:   rand.Int63(),
+		},
+		Schema: altered,
+		Infos: []*querypb.SegmentLoadInfo{
+			{
+				SegmentID:    defaultSegmentID,
+				PartitionID:  defaultPartitionID,
+				CollectionID: defaultCollectionID,
+				BinlogPaths:  fieldBinlog,
+				Statslogs:    statsLog,
+				NumOfRows:    defaultMsgLength,
+			},
+		},
+	}
+	_, err = node.loader.LoadSegment(ctx, req, segmentTypeSealed)
+	require.NoError(t, err)
+
+	segment, err := node.metaReplica.getSegmentByID(defaultSegmentID, segmentTypeSealed)
+	require.NoError(t, err)
+
+	planExpr, err := genSimpleRetrievePlanExpr(altered)
+	require.NoError(t, err)
+	planNode := &planpb.PlanNode{}
+	require.NoError(t, proto.Unmarshal(planExpr, planNode))
+	planNode.OutputFieldIds = append(planNode.OutputFieldIds, addedField.GetFieldID())
+	planExpr, err = proto.Marshal(planNode)
+	require.NoError(t, err)
+	plan, err := createRetrievePlanByExpr(collection, planExpr, Timestamp(1000), 100)
+	require.NoError(t, err)
+	defer plan.delete()
+
+	res, err := segment.retrieve(plan)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.GetIds().GetIntId().GetData())
+	var found bool
+	for _, fieldData := range res.GetFieldsData() {
+		if fieldData.GetFieldId() != addedField.GetFieldID() {
+			continue
+		}
+		found = true
+		values := fieldData.GetScalars().GetLongData().GetData()
+		assert.Equal(t, len(res.GetIds().GetIntId().GetData()), len(values))
+		for _, value := range values {
+			assert.Equal(t, int64(7), value)
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestSegmentLoader_loadClonedSegment(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	require.NoError(t, err)
+	defer node.Stop()
+
+	schema := genTestCollectionSchema()
+	fieldBinlog, statsLog, err := saveBinLog(ctx, defaultCollectionID, defaultPartitionID, defaultSegmentID, defaultMsgLength, schema)
+	require.NoError(t, err)
+	// the deletions are at 100 and 200
+	deltaLogs, err := saveDeltaLog(defaultCollectionID, defaultPartitionID, defaultSegmentID)
+	require.NoError(t, err)
+
+	newRequest := func(segmentID UniqueID, maxRowTimestamp Timestamp) *querypb.LoadSegmentsRequest {
+		return &querypb.LoadSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_LoadSegments,
+				MsgID:   rand.Int63(),
+			},
+			Schema: schema,
+			Infos: []*querypb.SegmentLoadInfo{
+				{
+					SegmentID:       segmentID,
+					PartitionID:     defaultPartitionID,
+					CollectionID:    defaultCollectionID,
+					BinlogPaths:     fieldBinlog,
+					Statslogs:       statsLog,
+					Deltalogs:       deltaLogs,
+					NumOfRows:       defaultMsgLength,
+					MaxRowTimestamp: maxRowTimestamp,
+				},
+			},
+		}
+	}
+
+	t.Run("rows after timestamp", func(t *testing.T) {
+		segmentID := UniqueID(100)
+		// the timestamps of the rows are 1, 1, 2, ..., 99
+		_, err := node.loader.LoadSegment(ctx, newRequest(segmentID, 49), segmentTypeSealed)
+		require.NoError(t, err)
+		segment, err := node.metaReplica.getSegmentByID(segmentID, segmentTypeSealed)
+		require.NoError(t, err)
+		assert.Equal(t, int64(50), segment.getRowCount())
+	})
+
+	t.Run("deletions after timestamp", func(t *testing.T) {
+		segmentID := UniqueID(101)
+		_, err := node.loader.LoadSegment(ctx, newRequest(segmentID, 150), segmentTypeSealed)
+		require.NoError(t, err)
+		segment, err := node.metaReplica.getSegmentByID(segmentID, segmentTypeSealed)
+		require.NoError(t, err)
+		assert.Equal(t, int64(defaultMsgLength), segment.getRowCount())
+		assert.Equal(t, int64(1), segment.getDeletedCount())
+	})
+
+	t.Run("rows before truncate timestamp", func(t *testing.T) {
+		segmentID := UniqueID(102)
+		req := newRequest(segmentID, 0)
+		req.Infos[0].MinRowTimestamp = 49
+		_, err := node.loader.LoadSegment(ctx, req, segmentTypeSealed)
+		require.NoError(t, err)
+		segment, err := node.metaReplica.getSegmentByID(segmentID, segmentTypeSealed)
+		require.NoError(t, err)
+		assert.Equal(t, int64(50), segment.getRowCount())
+	})
+
+	t.Run("filter rows", func(t *testing.T) {
+		// all the rows are kept
+		rows, err := node.loader.filterRowsByTimestamp(ctx, &querypb.SegmentLoadInfo{
+			SegmentID:       defaultSegmentID,
+			BinlogPaths:     fieldBinlog,
+			NumOfRows:       defaultMsgLength,
+			MaxRowTimestamp: 99,
+		})
+		assert.NoError(t, err)
+		assert.Nil(t, rows)
+
+		rows, err = node.loader.filterRowsByTimestamp(ctx, &querypb.SegmentLoadInfo{
+			SegmentID:       defaultSegmentID,
+			BinlogPaths:     fieldBinlog,
+			NumOfRows:       defaultMsgLength,
+			MinRowTimestamp: 10,
+			MaxRowTimestamp: 20,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, rows)
+
+		_, err = node.loader.filterRowsByTimestamp(ctx, &querypb.SegmentLoadInfo{
+			SegmentID:   defaultSegmentID,
+			BinlogPaths: fieldBinlog,
+			NumOfRows:   defaultMsgLength,
+			// all the rows are at or before it
+			MinRowTimestamp: 99,
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestSegmentLoader_loadSegmentV2(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	schema := genTestCollectionSchema()
+	fieldBinlog, err := saveColumnarBinLog(ctx, defaultCollectionID, defaultPartitionID, defaultSegmentID, defaultMsgLength, schema)
+	require.NoError(t, err)
+
+	for _, segType := range []segmentType{segmentTypeSealed, segmentTypeGrowing} {
+		t.Run(segType.String(), func(t *testing.T) {
+			node, err := genSimpleQueryNode(ctx)
+			require.NoError(t, err)
+			defer node.Stop()
+
+			node.metaReplica.removeSegment(defaultSegmentID, segmentTypeSealed)
+			node.metaReplica.removeSegment(defaultSegmentID, segmentTypeGrowing)
+			req := &querypb.LoadSegmentsRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_LoadSegments,
+					MsgID:   rand.Int63(),
+				},
+				Schema: schema,
+				Infos: []*querypb.SegmentLoadInfo{
+					{
+						SegmentID:      defaultSegmentID,
+						PartitionID:    defaultPartitionID,
+						CollectionID:   defaultCollectionID,
+						BinlogPaths:    fieldBinlog,
+						NumOfRows:      defaultMsgLength,
+						StorageVersion: storage.StorageV2,
+					},
+				},
+			}
+
+			_, err = node.loader.LoadSegment(ctx, req, segType)
+			require.NoError(t, err)
+			segment, err := node.metaReplica.getSegmentByID(defaultSegmentID, segType)
+			require.NoError(t, err)
+			assert.Equal(t, int64(defaultMsgLength), segment.getRowCount())
+		})
+	}
+}
+
 func TestSegmentLoader_loadSegmentFieldsData(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -241,7 +458,7 @@ func TestSegmentLoader_loadSegmentFieldsData(t *testing.T) {
 		binlog, _, err := saveBinLog(ctx, defaultCollectionID, defaultPartitionID, defaultSegmentID, defaultMsgLength, schema)
 		assert.NoError(t, err)
 
-		err = loader.loadSealedSegmentFields(ctx, segment, binlog, &querypb.SegmentLoadInfo{})
+		err = loader.loadSealedSegmentFields(ctx, segment, binlog, &querypb.SegmentLoadInfo{}, nil)
 		assert.NoError(t, err)
 	}
 
@@ -266,6 +483,121 @@ func TestSegmentLoader_loadSegmentFieldsData(t *testing.T) {
 	//})
 }
 
+func TestSegmentLoader_loadMultipleVectorFields(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the test collection has a float vector field and a binary vector field
+	schema := genTestCollectionSchema()
+	fieldBinlog, statsLog, err := saveBinLog(ctx, defaultCollectionID, defaultPartitionID, defaultSegmentID, defaultMsgLength, schema)
+	require.NoError(t, err)
+
+	node, err := genSimpleQueryNode(ctx)
+	require.NoError(t, err)
+	defer node.Stop()
+
+	node.metaReplica.removeSegment(defaultSegmentID, segmentTypeSealed)
+	req := &querypb.LoadSegmentsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_LoadSegments,
+			MsgID:   rand.Int63(),
+		},
+		Schema: schema,
+		Infos: []*querypb.SegmentLoadInfo{
+			{
+				SegmentID:    defaultSegmentID,
+				PartitionID:  defaultPartitionID,
+				CollectionID: defaultCollectionID,
+				BinlogPaths:  fieldBinlog,
+				Statslogs:    statsLog,
+			},
+		},
+	}
+	_, err = node.loader.LoadSegment(ctx, req, segmentTypeSealed)
+	require.NoError(t, err)
+
+	segment, err := node.metaReplica.getSegmentByID(defaultSegmentID, segmentTypeSealed)
+	require.NoError(t, err)
+	assert.Equal(t, int64(defaultMsgLength), segment.getRowCount())
+	collection, err := node.metaReplica.getCollectionByID(defaultCollectionID)
+	require.NoError(t, err)
+
+	const (
+		nq   = 2
+		topk = 10
+	)
+	searchVectorField := func(field vecFieldParam, placeholder *commonpb.PlaceholderValue) {
+		expr, err := proto.Marshal(&planpb.PlanNode{
+			Node: &planpb.PlanNode_VectorAnns{
+				VectorAnns: &planpb.VectorANNS{
+					IsBinary: field.vecType == schemapb.DataType_BinaryVector,
+					FieldId:  field.id,
+					QueryInfo: &planpb.QueryInfo{
+						Topk:         topk,
+						MetricType:   field.metricType,
+						SearchParams: `{"nprobe": 10}`,
+						RoundDecimal: -1,
+					},
+					PlaceholderTag: "$0",
+				},
+			},
+		})
+		require.NoError(t, err)
+		placeholderGroup, err := proto.Marshal(&commonpb.PlaceholderGroup{Placeholders: []*commonpb.PlaceholderValue{placeholder}})
+		require.NoError(t, err)
+
+		searchReq, err := newSearchRequest(collection, &querypb.SearchRequest{
+			Req: &internalpb.SearchRequest{
+				Base:               &commonpb.MsgBase{MsgType: commonpb.MsgType_Search},
+				CollectionID:       defaultCollectionID,
+				DslType:            commonpb.DslType_BoolExprV1,
+				SerializedExprPlan: expr,
+				PlaceholderGroup:   placeholderGroup,
+				Nq:                 nq,
+			},
+		}, placeholderGroup)
+		require.NoError(t, err)
+		defer searchReq.delete()
+		assert.Equal(t, field.id, searchReq.searchFieldID)
+
+		result, err := segment.search(ctx, searchReq)
+		require.NoError(t, err)
+		defer deleteSearchResults([]*SearchResult{result})
+		blobs, err := reduceSearchResultsAndFillData(searchReq.plan, []*SearchResult{result}, 1, []int64{nq}, []int64{topk})
+		require.NoError(t, err)
+		defer deleteSearchResultDataBlobs(blobs)
+		blob, err := getSearchResultDataBlob(blobs, 0)
+		require.NoError(t, err)
+
+		data := &schemapb.SearchResultData{}
+		require.NoError(t, proto.Unmarshal(blob, data))
+		assert.Equal(t, []int64{topk, topk}, data.GetTopks())
+		assert.Equal(t, nq*topk, len(data.GetIds().GetIntId().GetData()))
+	}
+
+	t.Run("search float vector field", func(t *testing.T) {
+		vectors := generateFloatVectors(nq, defaultDim)
+		placeholder := &commonpb.PlaceholderValue{Tag: "$0", Type: commonpb.PlaceholderType_FloatVector}
+		for i := 0; i < nq; i++ {
+			value := make([]byte, 4*defaultDim)
+			for j, v := range vectors[i*defaultDim : (i+1)*defaultDim] {
+				common.Endian.PutUint32(value[4*j:], math.Float32bits(v))
+			}
+			placeholder.Values = append(placeholder.Values, value)
+		}
+		searchVectorField(simpleFloatVecField, placeholder)
+	})
+
+	t.Run("search binary vector field", func(t *testing.T) {
+		vectors := generateBinaryVectors(nq, defaultDim)
+		placeholder := &commonpb.PlaceholderValue{Tag: "$0", Type: commonpb.PlaceholderType_BinaryVector}
+		for i := 0; i < nq; i++ {
+			placeholder.Values = append(placeholder.Values, vectors[i*defaultDim/8:(i+1)*defaultDim/8])
+		}
+		searchVectorField(simpleBinVecField, placeholder)
+	})
+}
+
 func TestSegmentLoader_invalid(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -403,7 +735,7 @@ func TestSegmentLoader_invalid(t *testing.T) {
 		binlog, _, err := saveBinLog(ctx, defaultCollectionID, defaultPartitionID, defaultSegmentID, defaultMsgLength, schema)
 		assert.NoError(t, err)
 
-		err = loader.loadSealedSegmentFields(ctx, segment, binlog, &querypb.SegmentLoadInfo{})
+		err = loader.loadSealedSegmentFields(ctx, segment, binlog, &querypb.SegmentLoadInfo{}, nil)
 		assert.Error(t, err)
 	})
 
@@ -84,7 +84,13 @@ func (q *queryTask) queryOnStreaming() error {
 	}
 
 	q.tr.RecordSpan()
-	mergedResult, err := mergeSegcoreRetrieveResultsAndFillIfEmpty(ctx, sResults, q.iReq.GetLimit(), q.iReq.GetOutputFieldsId(), coll.Schema())
+	if len(q.iReq.GetAggregates()) > 0 {
+		// the rows are aggregated by the shard leader, see aggregateInternalRetrieveResults
+		q.Ret, err = dedupRetrieveResults(sResults)
+		q.reduceDur = q.tr.RecordSpan()
+		return err
+	}
+	mergedResult, err := mergeSegcoreRetrieveResultsAndFillIfEmpty(ctx, sResults, q.iReq.GetLimit(), q.iReq.GetOrderBy(), q.iReq.GetOutputFieldsId(), coll.Schema())
 	if err != nil {
 		return err
 	}
@@ -127,7 +133,12 @@ func (q *queryTask) queryOnHistorical() error {
 		return err
 	}
 
-	mergedResult, err := mergeSegcoreRetrieveResultsAndFillIfEmpty(ctx, retrieveResults, q.req.GetReq().GetLimit(), q.iReq.GetOutputFieldsId(), coll.Schema())
+	if len(q.iReq.GetAggregates()) > 0 {
+		// the rows are aggregated by the shard leader, see aggregateInternalRetrieveResults
+		q.Ret, err = dedupRetrieveResults(retrieveResults)
+		return err
+	}
+	mergedResult, err := mergeSegcoreRetrieveResultsAndFillIfEmpty(ctx, retrieveResults, q.req.GetReq().GetLimit(), q.iReq.GetOrderBy(), q.iReq.GetOutputFieldsId(), coll.Schema())
 	if err != nil {
 		return err
 	}
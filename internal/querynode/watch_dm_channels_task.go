@@ -187,14 +187,15 @@ func (w *watchDmChannelsTask) LoadGrowingSegments(ctx context.Context, collectio
 			}
 			if len(ufInfo.GetBinlogs()) > 0 {
 				unFlushedSegments = append(unFlushedSegments, &queryPb.SegmentLoadInfo{
-					SegmentID:     ufInfo.ID,
-					PartitionID:   ufInfo.PartitionID,
-					CollectionID:  ufInfo.CollectionID,
-					BinlogPaths:   ufInfo.Binlogs,
-					NumOfRows:     ufInfo.NumOfRows,
-					Statslogs:     ufInfo.Statslogs,
-					Deltalogs:     ufInfo.Deltalogs,
-					InsertChannel: ufInfo.InsertChannel,
+					SegmentID:      ufInfo.ID,
+					PartitionID:    ufInfo.PartitionID,
+					CollectionID:   ufInfo.CollectionID,
+					BinlogPaths:    ufInfo.Binlogs,
+					NumOfRows:      ufInfo.NumOfRows,
+					Statslogs:      ufInfo.Statslogs,
+					Deltalogs:      ufInfo.Deltalogs,
+					InsertChannel:  ufInfo.InsertChannel,
+					StorageVersion: ufInfo.StorageVersion,
 				})
 				unFlushedSegmentIDs = append(unFlushedSegmentIDs, ufInfo.GetID())
 			} else {
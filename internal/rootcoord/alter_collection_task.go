@@ -5,10 +5,17 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 )
 
 type alterCollectionTask struct {
@@ -24,13 +31,87 @@ func (a *alterCollectionTask) Prepare(ctx context.Context) error {
 	return nil
 }
 
+// splitAddedField separates the JSON encoded schema of the field to add from the collection properties.
+func splitAddedField(pairs []*commonpb.KeyValuePair) (*schemapb.FieldSchema, []*commonpb.KeyValuePair, error) {
+	var field *schemapb.FieldSchema
+	var properties []*commonpb.KeyValuePair
+	for _, pair := range pairs {
+		if pair.GetKey() != common.CollectionAddFieldKey {
+			properties = append(properties, pair)
+			continue
+		}
+		if field != nil {
+			return nil, nil, errors.New("only one field could be added at a time")
+		}
+		field = &schemapb.FieldSchema{}
+		if err := jsonpb.UnmarshalString(pair.GetValue(), field); err != nil {
+			return nil, nil, fmt.Errorf("invalid schema of the field to add: %s", err.Error())
+		}
+	}
+	return field, properties, nil
+}
+
+// validateAddedField checks the field could be added to the collection: only nullable or defaulted scalar
+// fields are allowed, so that the existing rows could read back a value of the field.
+func validateAddedField(coll *model.Collection, field *schemapb.FieldSchema) error {
+	if field.GetName() == "" {
+		return errors.New("the name of the field to add is empty")
+	}
+	for _, f := range coll.Fields {
+		if f.Name == field.GetName() {
+			return fmt.Errorf("field %s already exists in collection %s", field.GetName(), coll.Name)
+		}
+	}
+	if field.GetIsPrimaryKey() || field.GetAutoID() || typeutil.IsPartitionKeyField(field) {
+		return fmt.Errorf("the added field %s could not be primary key, auto id or partition key", field.GetName())
+	}
+	if typeutil.IsVectorType(field.GetDataType()) {
+		return fmt.Errorf("vector field %s could not be added to an existing collection", field.GetName())
+	}
+	if !typeutil.HasDefaultValue(field) {
+		return fmt.Errorf("the added field %s should be nullable or have a default value", field.GetName())
+	}
+	if typeutil.IsStringType(field.GetDataType()) {
+		if _, err := typeutil.NewKvPairs(field.GetTypeParams()).Get("max_length"); err != nil {
+			return fmt.Errorf("max_length not specified for VarChar field %s", field.GetName())
+		}
+	}
+	if typeutil.IsArrayType(field.GetDataType()) {
+		if err := typeutil.ValidateArrayFieldSchema(field); err != nil {
+			return err
+		}
+	}
+	return validateNullableField(field)
+}
+
+// addField appends the field to the collection with the next field id and bumps the schema version.
+func addField(coll *model.Collection, field *schemapb.FieldSchema) {
+	fieldID := int64(common.StartOfUserFieldID)
+	for _, f := range coll.Fields {
+		if f.FieldID >= fieldID {
+			fieldID = f.FieldID + 1
+		}
+	}
+	field.FieldID = fieldID
+	coll.Fields = append(coll.Fields, model.UnmarshalFieldModel(field))
+	coll.SchemaVersion++
+}
+
 func (a *alterCollectionTask) Execute(ctx context.Context) error {
-	// Now we only support alter properties of collection
-	if a.Req.GetProperties() == nil {
-		return errors.New("only support alter collection properties, but collection properties is empty")
+	field, properties, err := splitAddedField(a.Req.GetProperties())
+	if err != nil {
+		return err
 	}
 
-	oldColl, err := a.core.meta.GetCollectionByName(ctx, a.Req.GetCollectionName(), a.ts)
+	// Now we only support alter properties of collection and adding a field
+	if properties == nil && field == nil {
+		return errors.New("only support alter collection properties or adding a field, but both are empty")
+	}
+	if _, err := storage.GetBinlogCompression(properties); err != nil {
+		return err
+	}
+
+	oldColl, err := a.core.meta.GetCollectionByName(ctx, a.Req.GetDbName(), a.Req.GetCollectionName(), a.ts)
 	if err != nil {
 		log.Warn("get collection failed during changing collection state",
 			zap.String("collectionName", a.Req.GetCollectionName()), zap.Uint64("ts", a.ts))
@@ -38,7 +119,18 @@ func (a *alterCollectionTask) Execute(ctx context.Context) error {
 	}
 
 	newColl := oldColl.Clone()
-	newColl.Properties = a.Req.GetProperties()
+	if properties != nil {
+		newColl.Properties = properties
+	}
+	if field != nil {
+		if err := validateAddedField(oldColl, field); err != nil {
+			return err
+		}
+		addField(newColl, field)
+		log.Info("add field to collection", zap.String("collectionName", oldColl.Name),
+			zap.String("fieldName", field.GetName()), zap.Int64("fieldID", field.GetFieldID()),
+			zap.Int32("schemaVersion", newColl.SchemaVersion))
+	}
 
 	ts := a.GetTs()
 	redoTask := newBaseRedoTask(a.core.stepExecutor)
@@ -51,12 +143,15 @@ func (a *alterCollectionTask) Execute(ctx context.Context) error {
 
 	redoTask.AddSyncStep(&expireCacheStep{
 		baseStep:        baseStep{core: a.core},
+		dbName:          a.Req.GetDbName(),
 		collectionNames: []string{oldColl.Name},
 		collectionID:    oldColl.CollectionID,
 		ts:              ts,
 	})
 
 	a.Req.CollectionID = oldColl.CollectionID
+	// the field to add is never persisted as property, broadcast the properties after altered.
+	a.Req.Properties = newColl.Properties
 	redoTask.AddSyncStep(&BroadcastAlteredCollectionStep{
 		baseStep: baseStep{core: a.core},
 		req:      a.Req,
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 )
 
 func Test_alterCollectionTask_Prepare(t *testing.T) {
@@ -48,6 +49,21 @@ func Test_alterCollectionTask_Execute(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("invalid binlog compression", func(t *testing.T) {
+		task := &alterCollectionTask{
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				Properties: []*commonpb.KeyValuePair{
+					{Key: common.CollectionBinlogCompressionKey, Value: "lz4"},
+					{Key: common.CollectionBinlogCompressionLevelKey, Value: "high"},
+				},
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
 	t.Run("failed to create alias", func(t *testing.T) {
 		core := newTestCore(withInvalidMeta())
 		task := &alterCollectionTask{
@@ -64,7 +80,7 @@ func Test_alterCollectionTask_Execute(t *testing.T) {
 
 	t.Run("alter step failed", func(t *testing.T) {
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return &model.Collection{CollectionID: int64(1)}, nil
 		}
 		meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
@@ -87,7 +103,7 @@ func Test_alterCollectionTask_Execute(t *testing.T) {
 
 	t.Run("broadcast step failed", func(t *testing.T) {
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return &model.Collection{CollectionID: int64(1)}, nil
 		}
 		meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
@@ -115,7 +131,7 @@ func Test_alterCollectionTask_Execute(t *testing.T) {
 
 	t.Run("alter successfully", func(t *testing.T) {
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return &model.Collection{CollectionID: int64(1)}, nil
 		}
 		meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
@@ -141,3 +157,87 @@ func Test_alterCollectionTask_Execute(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func Test_alterCollectionTask_AddField(t *testing.T) {
+	addFieldProperty := func(value string) *commonpb.KeyValuePair {
+		return &commonpb.KeyValuePair{Key: common.CollectionAddFieldKey, Value: value}
+	}
+	oldColl := &model.Collection{
+		CollectionID: int64(1),
+		Name:         "cn",
+		Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+		Properties: []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "3600"}},
+	}
+
+	t.Run("invalid field", func(t *testing.T) {
+		invalidFields := []string{
+			`{"name": "price", "data_type": "Int64"`,
+			`{"data_type": "Int64", "type_params": [{"key": "nullable", "value": "true"}]}`,
+			`{"name": "pk", "data_type": "Int64", "type_params": [{"key": "nullable", "value": "true"}]}`,
+			`{"name": "price", "data_type": "Int64"}`,
+			`{"name": "price", "data_type": "Int64", "type_params": [{"key": "default_value", "value": "a"}]}`,
+			`{"name": "vec2", "data_type": "FloatVector", "type_params": [{"key": "nullable", "value": "true"}]}`,
+			`{"name": "color", "data_type": "VarChar", "type_params": [{"key": "default_value", "value": "red"}]}`,
+		}
+		for _, value := range invalidFields {
+			meta := newMockMetaTable()
+			meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+				return oldColl.Clone(), nil
+			}
+			core := newTestCore(withMeta(meta))
+			task := &alterCollectionTask{
+				baseTask: baseTask{core: core},
+				Req: &milvuspb.AlterCollectionRequest{
+					Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+					CollectionName: "cn",
+					Properties:     []*commonpb.KeyValuePair{addFieldProperty(value)},
+				},
+			}
+			err := task.Execute(context.Background())
+			assert.Error(t, err, value)
+		}
+	})
+
+	t.Run("add field successfully", func(t *testing.T) {
+		var altered *model.Collection
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return oldColl.Clone(), nil
+		}
+		meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+			altered = newColl
+			return nil
+		}
+
+		var broadcastProperties []*commonpb.KeyValuePair
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			broadcastProperties = req.GetProperties()
+			return nil
+		}
+
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+		task := &alterCollectionTask{
+			baseTask: baseTask{core: core},
+			Req: &milvuspb.AlterCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				Properties: []*commonpb.KeyValuePair{
+					addFieldProperty(`{"name": "price", "data_type": "Int64", "type_params": [{"key": "default_value", "value": "10"}]}`),
+				},
+			},
+		}
+
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(altered.Fields))
+		assert.Equal(t, int64(102), altered.Fields[2].FieldID)
+		assert.Equal(t, "price", altered.Fields[2].Name)
+		assert.Equal(t, int32(1), altered.SchemaVersion)
+		assert.Equal(t, oldColl.Properties, altered.Properties)
+		assert.Equal(t, oldColl.Properties, broadcastProperties)
+	})
+}
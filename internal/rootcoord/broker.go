@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
@@ -22,6 +24,9 @@ import (
 	"go.uber.org/zap"
 )
 
+// flushCheckInterval is the interval to check whether the sealed segments are flushed.
+var flushCheckInterval = time.Second
+
 type watchInfo struct {
 	ts             Timestamp
 	collectionID   UniqueID
@@ -44,6 +49,10 @@ type Broker interface {
 	Import(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
 	UnsetIsImportingState(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
 	MarkSegmentsDropped(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)
+	DropCollectionSegments(ctx context.Context, collID UniqueID, ts Timestamp) error
+	CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) error
+	WaitSegmentsFlushed(ctx context.Context, collID UniqueID) error
+	GetFlushedSegments(ctx context.Context, collID UniqueID, partIDs []UniqueID) ([]*datapb.SegmentInfo, error)
 
 	DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error
 	GetSegmentIndexState(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
@@ -203,6 +212,147 @@ func (b *ServerBroker) MarkSegmentsDropped(ctx context.Context, req *datapb.Mark
 	return b.s.dataCoord.MarkSegmentsDropped(ctx, req)
 }
 
+// DropCollectionSegments flushes the segments of the collection and drops the rows of the flushed ones at or before ts,
+// the segments started after ts only have the data inserted after the DDL and are kept. The segments straddling ts
+// are kept by DataCoord with the rows at or before ts filtered out when loaded, the others are marked dropped,
+// and their binlogs are recycled by the garbage collector of DataCoord.
+func (b *ServerBroker) DropCollectionSegments(ctx context.Context, collID UniqueID, ts Timestamp) error {
+	log.Info("dropping segments of collection", zap.Int64("collection", collID), zap.Uint64("ts", ts))
+
+	// the data nodes keep writing the binlogs of the unflushed segments, only the flushed ones can be dropped.
+	if err := b.WaitSegmentsFlushed(ctx, collID); err != nil {
+		return err
+	}
+	segments, err := b.GetFlushedSegments(ctx, collID, nil)
+	if err != nil {
+		return err
+	}
+	segIDs := make([]UniqueID, 0, len(segments))
+	for _, segment := range segments {
+		if segment.GetStartPosition() != nil && segment.GetStartPosition().GetTimestamp() > ts {
+			continue
+		}
+		segIDs = append(segIDs, segment.GetID())
+	}
+	if len(segIDs) == 0 {
+		return nil
+	}
+
+	status, err := b.MarkSegmentsDropped(ctx, &datapb.MarkSegmentsDroppedRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(b.s.session.ServerID),
+		),
+		SegmentIds: segIDs,
+		Timestamp:  ts,
+	})
+	if err != nil {
+		return err
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("failed to mark segments dropped, code: %s, reason: %s", status.GetErrorCode(), status.GetReason())
+	}
+
+	log.Info("done to drop segments of collection", zap.Int64("collection", collID), zap.Int64s("segments", segIDs))
+	return nil
+}
+
+// CloneSegments adds the flushed segments of the source collection to the target collection,
+// the binlogs are shared instead of being copied.
+func (b *ServerBroker) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) error {
+	log.Info("cloning segments of collection", zap.Int64("source", req.GetSourceCollectionID()),
+		zap.Int64("target", req.GetTargetCollectionID()), zap.Uint64("timestamp", req.GetTimestamp()))
+
+	status, err := b.s.dataCoord.CloneSegments(ctx, req)
+	if err != nil {
+		return err
+	}
+	if status.GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("failed to clone segments, code: %s, reason: %s", status.GetErrorCode(), status.GetReason())
+	}
+
+	log.Info("done to clone segments of collection", zap.Int64("source", req.GetSourceCollectionID()),
+		zap.Int64("target", req.GetTargetCollectionID()))
+	return nil
+}
+
+// WaitSegmentsFlushed seals the growing segments of the collection and waits until they are flushed.
+func (b *ServerBroker) WaitSegmentsFlushed(ctx context.Context, collID UniqueID) error {
+	resp, err := b.s.dataCoord.Flush(ctx, &datapb.FlushRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_Flush),
+			commonpbutil.WithSourceID(b.s.session.ServerID),
+		),
+		CollectionID: collID,
+	})
+	if err != nil {
+		return errors.New("failed to call flush to data coordinator: " + err.Error())
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return errors.New(resp.GetStatus().GetReason())
+	}
+	if len(resp.GetSegmentIDs()) == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(flushCheckInterval)
+	defer ticker.Stop()
+	for {
+		state, err := b.s.dataCoord.GetFlushState(ctx, &milvuspb.GetFlushStateRequest{SegmentIDs: resp.GetSegmentIDs()})
+		if err != nil {
+			return err
+		}
+		if state.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return fmt.Errorf("failed to get flush state, code: %s, reason: %s", state.GetStatus().GetErrorCode(), state.GetStatus().GetReason())
+		}
+		if state.GetFlushed() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to wait segments flushed: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetFlushedSegments returns the infos of the flushed segments in the partitions of the collection,
+// all the partitions are included if @partIDs is empty.
+func (b *ServerBroker) GetFlushedSegments(ctx context.Context, collID UniqueID, partIDs []UniqueID) ([]*datapb.SegmentInfo, error) {
+	if len(partIDs) == 0 {
+		partIDs = []UniqueID{common.InvalidPartitionID}
+	}
+	var segIDs []UniqueID
+	for _, partID := range partIDs {
+		resp, err := b.s.dataCoord.GetFlushedSegments(ctx, &datapb.GetFlushedSegmentsRequest{
+			Base:         commonpbutil.NewMsgBase(commonpbutil.WithSourceID(b.s.session.ServerID)),
+			CollectionID: collID,
+			PartitionID:  partID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("failed to get flushed segments, code: %s, reason: %s", resp.GetStatus().GetErrorCode(), resp.GetStatus().GetReason())
+		}
+		segIDs = append(segIDs, resp.GetSegments()...)
+	}
+	if len(segIDs) == 0 {
+		return nil, nil
+	}
+
+	resp, err := b.s.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		Base:       commonpbutil.NewMsgBase(commonpbutil.WithSourceID(b.s.session.ServerID)),
+		SegmentIDs: segIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, fmt.Errorf("failed to get segment info, code: %s, reason: %s", resp.GetStatus().GetErrorCode(), resp.GetStatus().GetReason())
+	}
+	return resp.GetInfos(), nil
+}
+
 func (b *ServerBroker) DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error {
 	rsp, err := b.s.indexCoord.DropIndex(ctx, &indexpb.DropIndexRequest{
 		CollectionID: collID,
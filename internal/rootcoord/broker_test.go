@@ -13,6 +13,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -187,6 +188,45 @@ func TestServerBroker_Flush(t *testing.T) {
 	})
 }
 
+func TestServerBroker_DropCollectionSegments(t *testing.T) {
+	dc := newMockDataCoord()
+	dc.FlushFunc = func(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
+		return &datapb.FlushResponse{Status: succStatus(), SegmentIDs: []int64{3}}, nil
+	}
+	dc.GetFlushStateFunc = func(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+		return &milvuspb.GetFlushStateResponse{Status: succStatus(), Flushed: true}, nil
+	}
+	dc.GetFlushedSegmentsFunc = func(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+		return &datapb.GetFlushedSegmentsResponse{Status: succStatus(), Segments: []int64{1, 2, 3}}, nil
+	}
+	// segment 2 started after the truncate ts, it only has the data inserted after the DDL.
+	dc.GetSegmentInfoFunc = func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+		return &datapb.GetSegmentInfoResponse{
+			Status: succStatus(),
+			Infos: []*datapb.SegmentInfo{
+				{ID: 1, StartPosition: &internalpb.MsgPosition{Timestamp: 50}},
+				{ID: 2, StartPosition: &internalpb.MsgPosition{Timestamp: 200}},
+				{ID: 3, StartPosition: &internalpb.MsgPosition{Timestamp: 80}},
+			},
+		}, nil
+	}
+	var dropped []int64
+	var ts Timestamp
+	dc.MarkSegmentsDroppedFunc = func(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
+		dropped = req.GetSegmentIds()
+		ts = req.GetTimestamp()
+		return succStatus(), nil
+	}
+
+	c := newTestCore(withDataCoord(dc))
+	b := newServerBroker(c)
+	err := b.DropCollectionSegments(context.Background(), 1, 100)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1, 3}, dropped)
+	// the rows of the segments after the truncate ts are kept by DataCoord.
+	assert.Equal(t, Timestamp(100), ts)
+}
+
 func TestServerBroker_Import(t *testing.T) {
 	t.Run("failed to execute", func(t *testing.T) {
 		c := newTestCore(withInvalidDataCoord())
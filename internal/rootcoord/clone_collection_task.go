@@ -0,0 +1,251 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// cloneCollectionTask creates a collection with the same schema as the source collection,
+// the flushed segments of the source collection are added to the new collection without copying the binlogs.
+type cloneCollectionTask struct {
+	baseTask
+	Req *rootcoordpb.CloneCollectionRequest
+
+	source   *model.Collection
+	collID   UniqueID
+	channels collectionChannels
+
+	partIDs        []UniqueID
+	partitionNames []string
+	// source partition id -> target partition id
+	partitionMapping map[UniqueID]UniqueID
+}
+
+func (t *cloneCollectionTask) validate(ctx context.Context) error {
+	if t.Req.GetSourceCollectionName() == "" || t.Req.GetTargetCollectionName() == "" {
+		return errors.New("the source and target collection name should not be empty")
+	}
+	if t.Req.GetSourceCollectionName() == t.Req.GetTargetCollectionName() {
+		return fmt.Errorf("the target collection name is the same as the source one: %s", t.Req.GetSourceCollectionName())
+	}
+	// the rows after the task timestamp may be not in the segments flushed by the task.
+	if t.Req.GetTimestamp() > t.GetTs() {
+		return fmt.Errorf("the clone timestamp %d is later than the current timestamp %d", t.Req.GetTimestamp(), t.GetTs())
+	}
+	if t.core.meta.IsAlias(t.Req.GetDbName(), t.Req.GetSourceCollectionName()) {
+		return fmt.Errorf("cannot clone the collection via alias = %s", t.Req.GetSourceCollectionName())
+	}
+	if _, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetTargetCollectionName(), typeutil.MaxTimestamp); err == nil {
+		return fmt.Errorf("collection %s already exists", t.Req.GetTargetCollectionName())
+	}
+	return nil
+}
+
+func (t *cloneCollectionTask) assignCollectionID() error {
+	var err error
+	t.collID, err = t.core.idAllocator.AllocOne()
+	return err
+}
+
+// assignPartitionIDs allocates a new id for each available partition of the source collection, the names are kept.
+func (t *cloneCollectionTask) assignPartitionIDs() error {
+	partitions := make([]*model.Partition, 0, len(t.source.Partitions))
+	for _, partition := range t.source.Partitions {
+		if partition.Available() {
+			partitions = append(partitions, partition)
+		}
+	}
+	start, end, err := t.core.idAllocator.Alloc(uint32(len(partitions)))
+	if err != nil {
+		return err
+	}
+	t.partIDs = make([]UniqueID, 0, end-start)
+	t.partitionNames = make([]string, 0, end-start)
+	t.partitionMapping = make(map[UniqueID]UniqueID, end-start)
+	for i, partition := range partitions {
+		partID := start + UniqueID(i)
+		t.partIDs = append(t.partIDs, partID)
+		t.partitionNames = append(t.partitionNames, partition.PartitionName)
+		t.partitionMapping[partition.PartitionID] = partID
+	}
+	return nil
+}
+
+func (t *cloneCollectionTask) assignChannels() error {
+	var err error
+	t.channels, err = assignChannels(t.core, t.collID, int(t.source.ShardsNum))
+	return err
+}
+
+func (t *cloneCollectionTask) Prepare(ctx context.Context) error {
+	if err := t.validate(ctx); err != nil {
+		return err
+	}
+
+	var err error
+	t.source, err = t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetSourceCollectionName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	if len(t.source.VirtualChannelNames) != int(t.source.ShardsNum) {
+		return fmt.Errorf("inconsistent shards of collection %s, shards num: %d, channels: %v",
+			t.source.Name, t.source.ShardsNum, t.source.VirtualChannelNames)
+	}
+
+	if err := t.assignCollectionID(); err != nil {
+		return err
+	}
+
+	if err := t.assignPartitionIDs(); err != nil {
+		return err
+	}
+
+	return t.assignChannels()
+}
+
+// genCloneSegmentsRequest maps the partitions and shards of the source collection to the new collection,
+// the i-th virtual channel of the source collection is mapped to the i-th one of the new collection.
+func (t *cloneCollectionTask) genCloneSegmentsRequest(startPositions []*commonpb.KeyDataPair, ts Timestamp) *datapb.CloneSegmentsRequest {
+	channels := make(map[string]string, len(t.source.VirtualChannelNames))
+	for i, vchannel := range t.source.VirtualChannelNames {
+		channels[vchannel] = t.channels.virtualChannels[i]
+	}
+	timestamp := t.Req.GetTimestamp()
+	if timestamp == 0 {
+		timestamp = ts
+	}
+	return &datapb.CloneSegmentsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithTimeStamp(ts),
+			commonpbutil.WithSourceID(t.core.session.ServerID),
+		),
+		SourceCollectionID: t.source.CollectionID,
+		TargetCollectionID: t.collID,
+		PartitionIDs:       t.partitionMapping,
+		Channels:           channels,
+		StartPositions:     startPositions,
+		Timestamp:          timestamp,
+	}
+}
+
+func (t *cloneCollectionTask) Execute(ctx context.Context) error {
+	collID := t.collID
+	ts := t.GetTs()
+	chanNames := t.channels.physicalChannels
+	schema := &schemapb.CollectionSchema{
+		Name:        t.Req.GetTargetCollectionName(),
+		Description: t.source.Description,
+		AutoID:      t.source.AutoID,
+		Fields:      model.MarshalFieldModels(t.source.Fields),
+	}
+
+	t.core.chanTimeTick.addDmlChannels(chanNames...)
+	startPositions, err := t.core.chanTimeTick.broadcastMarkDmlChannels(chanNames, genCreateCollectionMsg(ctx, ts, collID, t.partIDs, schema, t.channels))
+	if err != nil {
+		t.core.chanTimeTick.removeDmlChannels(chanNames...)
+		return err
+	}
+
+	partitions := make([]*model.Partition, len(t.partIDs))
+	for i, partID := range t.partIDs {
+		partitions[i] = &model.Partition{
+			PartitionID:               partID,
+			PartitionName:             t.partitionNames[i],
+			PartitionCreatedTimestamp: ts,
+			CollectionID:              collID,
+			State:                     pb.PartitionState_PartitionCreated,
+		}
+	}
+
+	collInfo := model.Collection{
+		CollectionID:         collID,
+		DBID:                 t.source.DBID,
+		Name:                 t.Req.GetTargetCollectionName(),
+		Description:          t.source.Description,
+		AutoID:               t.source.AutoID,
+		Fields:               model.CloneFields(t.source.Fields),
+		VirtualChannelNames:  t.channels.virtualChannels,
+		PhysicalChannelNames: chanNames,
+		ShardsNum:            t.source.ShardsNum,
+		ConsistencyLevel:     t.source.ConsistencyLevel,
+		StartPositions:       toKeyDataPairs(startPositions),
+		CreateTime:           ts,
+		State:                pb.CollectionState_CollectionCreating,
+		Partitions:           partitions,
+		Properties:           common.CloneKeyValuePairs(t.source.Properties),
+		SchemaVersion:        t.source.SchemaVersion,
+	}
+
+	undoTask := newBaseUndoTask(t.core.stepExecutor)
+	undoTask.AddStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		dbName:          t.Req.GetDbName(),
+		collectionNames: []string{t.Req.GetTargetCollectionName()},
+		collectionID:    InvalidCollectionID,
+		ts:              ts,
+	}, &nullStep{})
+	undoTask.AddStep(&nullStep{}, &removeDmlChannelsStep{
+		baseStep:  baseStep{core: t.core},
+		pChannels: chanNames,
+	}) // remove dml channels if any error occurs.
+	// the growing segments of the source collection are flushed first,
+	// so that all the rows before the clone timestamp are in the flushed segments.
+	undoTask.AddStep(&flushCollectionSegmentsStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: t.source.CollectionID,
+	}, &nullStep{})
+	undoTask.AddStep(&addCollectionMetaStep{
+		baseStep: baseStep{core: t.core},
+		coll:     &collInfo,
+	}, &deleteCollectionMetaStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collID,
+		ts:           ts,
+	})
+	// serve for this case: some of the segments are cloned but the step failed.
+	undoTask.AddStep(&nullStep{}, &dropCollectionSegmentsStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collID,
+		ts:           ts,
+	})
+	// the segments must be cloned before the channels are watched,
+	// so that the flushed segments are recovered by the data nodes.
+	undoTask.AddStep(&cloneSegmentsStep{
+		baseStep: baseStep{core: t.core},
+		req:      t.genCloneSegmentsRequest(collInfo.StartPositions, ts),
+	}, &nullStep{})
+	undoTask.AddStep(&nullStep{}, &unwatchChannelsStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collID,
+		channels:     t.channels,
+	})
+	undoTask.AddStep(&watchChannelsStep{
+		baseStep: baseStep{core: t.core},
+		info: &watchInfo{
+			ts:             ts,
+			collectionID:   collID,
+			vChannels:      t.channels.virtualChannels,
+			startPositions: collInfo.StartPositions,
+			schema:         schema,
+		},
+	}, &nullStep{})
+	undoTask.AddStep(&changeCollectionStateStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collID,
+		state:        pb.CollectionState_CollectionCreated,
+		ts:           ts,
+	}, &nullStep{}) // We'll remove the whole collection anyway.
+
+	return undoTask.Execute(ctx)
+}
@@ -0,0 +1,334 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCloneSourceCollection(pchans []string) *model.Collection {
+	vchans := make([]string, 0, len(pchans))
+	for _, pchan := range pchans {
+		vchans = append(vchans, fmt.Sprintf("%s_1v%d", pchan, len(vchans)))
+	}
+	return &model.Collection{
+		CollectionID:         1,
+		DBID:                 2,
+		Name:                 "source",
+		Fields:               []*model.Field{{FieldID: 100, Name: "pk"}, {FieldID: 101, Name: "vec"}},
+		VirtualChannelNames:  vchans,
+		PhysicalChannelNames: pchans,
+		ShardsNum:            int32(len(pchans)),
+		Partitions: []*model.Partition{
+			{PartitionID: 10, PartitionName: "_default", State: etcdpb.PartitionState_PartitionCreated},
+			{PartitionID: 11, PartitionName: "dropping", State: etcdpb.PartitionState_PartitionDropping},
+			{PartitionID: 12, PartitionName: "p1", State: etcdpb.PartitionState_PartitionCreated},
+		},
+		SchemaVersion: 1,
+	}
+}
+
+func withCloneIDAllocator() Opt {
+	idAllocator := newMockIDAllocator()
+	idAllocator.AllocOneF = func() (allocator.UniqueID, error) {
+		return 1000, nil
+	}
+	idAllocator.AllocF = func(count uint32) (allocator.UniqueID, allocator.UniqueID, error) {
+		return 2000, 2000 + int64(count), nil
+	}
+	return withIDAllocator(idAllocator)
+}
+
+func Test_cloneCollectionTask_Prepare(t *testing.T) {
+	t.Run("empty name", func(t *testing.T) {
+		task := &cloneCollectionTask{
+			Req: &rootcoordpb.CloneCollectionRequest{SourceCollectionName: funcutil.GenRandomStr()},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("same name", func(t *testing.T) {
+		task := &cloneCollectionTask{
+			Req: &rootcoordpb.CloneCollectionRequest{SourceCollectionName: "coll", TargetCollectionName: "coll"},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("timestamp later than now", func(t *testing.T) {
+		task := &cloneCollectionTask{
+			baseTask: baseTask{ts: 100},
+			Req: &rootcoordpb.CloneCollectionRequest{
+				SourceCollectionName: "source",
+				TargetCollectionName: "target",
+				Timestamp:            101,
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("clone via alias", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.IsAliasFunc = func(dbName string, name string) bool {
+			return true
+		}
+		core := newTestCore(withMeta(meta))
+		task := &cloneCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.CloneCollectionRequest{SourceCollectionName: "source", TargetCollectionName: "target"},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("target already exists", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.IsAliasFunc = func(dbName string, name string) bool {
+			return false
+		}
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{Name: collectionName}, nil
+		}
+		core := newTestCore(withMeta(meta))
+		task := &cloneCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.CloneCollectionRequest{SourceCollectionName: "source", TargetCollectionName: "target"},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("source not exist", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.IsAliasFunc = func(dbName string, name string) bool {
+			return false
+		}
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return nil, errors.New("error mock GetCollectionByName")
+		}
+		core := newTestCore(withMeta(meta))
+		task := &cloneCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.CloneCollectionRequest{SourceCollectionName: "source", TargetCollectionName: "target"},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to allocate id", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.IsAliasFunc = func(dbName string, name string) bool {
+			return false
+		}
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			if collectionName == "source" {
+				return newCloneSourceCollection([]string{"ch1"}), nil
+			}
+			return nil, errors.New("error mock GetCollectionByName")
+		}
+		core := newTestCore(withMeta(meta), withInvalidIDAllocator())
+		task := &cloneCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.CloneCollectionRequest{SourceCollectionName: "source", TargetCollectionName: "target"},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		defer cleanTestEnv()
+		ticker := newRocksMqTtSynchronizer()
+
+		meta := newMockMetaTable()
+		meta.IsAliasFunc = func(dbName string, name string) bool {
+			return false
+		}
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			if collectionName == "source" {
+				return newCloneSourceCollection(ticker.getDmlChannelNames(2)), nil
+			}
+			return nil, errors.New("error mock GetCollectionByName")
+		}
+		core := newTestCore(withMeta(meta), withCloneIDAllocator(), withTtSynchronizer(ticker))
+		task := &cloneCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.CloneCollectionRequest{SourceCollectionName: "source", TargetCollectionName: "target"},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, UniqueID(1000), task.collID)
+		assert.Equal(t, []UniqueID{2000, 2001}, task.partIDs)
+		assert.Equal(t, []string{"_default", "p1"}, task.partitionNames)
+		assert.Equal(t, map[UniqueID]UniqueID{10: 2000, 12: 2001}, task.partitionMapping)
+		assert.Equal(t, 2, len(task.channels.virtualChannels))
+	})
+}
+
+func Test_cloneCollectionTask_Execute(t *testing.T) {
+	t.Run("normal case", func(t *testing.T) {
+		defer cleanTestEnv()
+		ticker := newRocksMqTtSynchronizer()
+		source := newCloneSourceCollection(ticker.getDmlChannelNames(2))
+
+		meta := newMockMetaTable()
+		var addedColl *model.Collection
+		meta.AddCollectionFunc = func(ctx context.Context, coll *model.Collection) error {
+			addedColl = coll
+			return nil
+		}
+		meta.ChangeCollectionStateFunc = func(ctx context.Context, collectionID UniqueID, state etcdpb.CollectionState, ts Timestamp) error {
+			return nil
+		}
+		broker := newMockBroker()
+		var flushed UniqueID
+		broker.WaitSegmentsFlushedFunc = func(ctx context.Context, collID UniqueID) error {
+			flushed = collID
+			return nil
+		}
+		var cloneReq *datapb.CloneSegmentsRequest
+		broker.CloneSegmentsFunc = func(ctx context.Context, req *datapb.CloneSegmentsRequest) error {
+			assert.Equal(t, source.CollectionID, flushed, "source segments should be flushed before cloning")
+			cloneReq = req
+			return nil
+		}
+		var watched *watchInfo
+		broker.WatchChannelsFunc = func(ctx context.Context, info *watchInfo) error {
+			assert.NotNil(t, cloneReq, "segments should be cloned before watching channels")
+			watched = info
+			return nil
+		}
+		core := newTestCore(withMeta(meta), withCloneIDAllocator(), withTtSynchronizer(ticker),
+			withValidProxyManager(), withBroker(broker))
+
+		task := &cloneCollectionTask{
+			baseTask: baseTask{core: core, ts: 100},
+			Req:      &rootcoordpb.CloneCollectionRequest{SourceCollectionName: "source", TargetCollectionName: "target"},
+			source:   source,
+			collID:   1000,
+			channels: collectionChannels{
+				virtualChannels:  []string{"target_v0", "target_v1"},
+				physicalChannels: ticker.getDmlChannelNames(2),
+			},
+			partIDs:          []UniqueID{2000, 2001},
+			partitionNames:   []string{"_default", "p1"},
+			partitionMapping: map[UniqueID]UniqueID{10: 2000, 12: 2001},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+
+		require.NotNil(t, addedColl)
+		assert.Equal(t, "target", addedColl.Name)
+		assert.Equal(t, source.DBID, addedColl.DBID)
+		assert.True(t, model.CheckFieldsEqual(source.Fields, addedColl.Fields))
+		assert.Equal(t, source.SchemaVersion, addedColl.SchemaVersion)
+		assert.Equal(t, 2, len(addedColl.Partitions))
+
+		require.NotNil(t, cloneReq)
+		assert.Equal(t, source.CollectionID, cloneReq.GetSourceCollectionID())
+		assert.Equal(t, UniqueID(1000), cloneReq.GetTargetCollectionID())
+		assert.Equal(t, Timestamp(100), cloneReq.GetTimestamp())
+		assert.Equal(t, "target_v0", cloneReq.GetChannels()[source.VirtualChannelNames[0]])
+		assert.Equal(t, "target_v1", cloneReq.GetChannels()[source.VirtualChannelNames[1]])
+		assert.Equal(t, task.partitionMapping, cloneReq.GetPartitionIDs())
+		assert.Equal(t, 2, len(cloneReq.GetStartPositions()))
+
+		require.NotNil(t, watched)
+		assert.Equal(t, "target", watched.schema.GetName())
+	})
+
+	t.Run("failed to flush source segments", func(t *testing.T) {
+		defer cleanTestEnv()
+		ticker := newRocksMqTtSynchronizer()
+		source := newCloneSourceCollection(ticker.getDmlChannelNames(1))
+
+		meta := newMockMetaTable()
+		broker := newMockBroker()
+		broker.WaitSegmentsFlushedFunc = func(ctx context.Context, collID UniqueID) error {
+			return errors.New("error mock WaitSegmentsFlushed")
+		}
+		core := newTestCore(withMeta(meta), withCloneIDAllocator(), withTtSynchronizer(ticker),
+			withValidProxyManager(), withBroker(broker))
+
+		task := &cloneCollectionTask{
+			baseTask: baseTask{core: core, ts: 100},
+			Req:      &rootcoordpb.CloneCollectionRequest{SourceCollectionName: "source", TargetCollectionName: "target"},
+			source:   source,
+			collID:   1000,
+			channels: collectionChannels{
+				virtualChannels:  []string{"target_v0"},
+				physicalChannels: ticker.getDmlChannelNames(1),
+			},
+			partIDs:          []UniqueID{2000},
+			partitionNames:   []string{"_default"},
+			partitionMapping: map[UniqueID]UniqueID{10: 2000},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to clone segments, check if undo worked", func(t *testing.T) {
+		defer cleanTestEnv()
+		ticker := newRocksMqTtSynchronizer()
+		source := newCloneSourceCollection(ticker.getDmlChannelNames(1))
+
+		meta := newMockMetaTable()
+		meta.AddCollectionFunc = func(ctx context.Context, coll *model.Collection) error {
+			return nil
+		}
+		removeCollectionChan := make(chan struct{}, 1)
+		meta.RemoveCollectionFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+			removeCollectionChan <- struct{}{}
+			return nil
+		}
+		broker := newMockBroker()
+		broker.WaitSegmentsFlushedFunc = func(ctx context.Context, collID UniqueID) error {
+			return nil
+		}
+		broker.CloneSegmentsFunc = func(ctx context.Context, req *datapb.CloneSegmentsRequest) error {
+			return errors.New("error mock CloneSegments")
+		}
+		droppedChan := make(chan UniqueID, 1)
+		broker.DropCollectionSegmentsFunc = func(ctx context.Context, collID UniqueID, ts Timestamp) error {
+			droppedChan <- collID
+			return nil
+		}
+		core := newTestCore(withMeta(meta), withCloneIDAllocator(), withTtSynchronizer(ticker),
+			withValidProxyManager(), withBroker(broker))
+
+		task := &cloneCollectionTask{
+			baseTask: baseTask{core: core, ts: 100},
+			Req: &rootcoordpb.CloneCollectionRequest{
+				SourceCollectionName: "source",
+				TargetCollectionName: "target",
+				Timestamp:            50,
+			},
+			source: source,
+			collID: 1000,
+			channels: collectionChannels{
+				virtualChannels:  []string{"target_v0"},
+				physicalChannels: ticker.getDmlChannelNames(1),
+			},
+			partIDs:          []UniqueID{2000, 2001},
+			partitionNames:   []string{"_default", "p1"},
+			partitionMapping: map[UniqueID]UniqueID{10: 2000, 12: 2001},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+
+		// the undo steps are executed asynchronously.
+		assert.Equal(t, UniqueID(1000), <-droppedChan)
+		<-removeCollectionChan
+	})
+}
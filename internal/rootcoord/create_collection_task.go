@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus/internal/common"
 
 	ms "github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
 
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -37,9 +41,12 @@ type createCollectionTask struct {
 	baseTask
 	Req      *milvuspb.CreateCollectionRequest
 	schema   *schemapb.CollectionSchema
+	dbID     UniqueID
 	collID   UniqueID
-	partID   UniqueID
 	channels collectionChannels
+
+	partIDs        []UniqueID
+	partitionNames []string
 }
 
 func (t *createCollectionTask) validate() error {
@@ -55,6 +62,10 @@ func (t *createCollectionTask) validate() error {
 		return fmt.Errorf("shard num (%d) exceeds limit (%d)", t.Req.GetShardsNum(), maxShardNum)
 	}
 
+	if _, err := storage.GetBinlogCompression(t.Req.GetProperties()); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -74,9 +85,41 @@ func (t *createCollectionTask) validateSchema(schema *schemapb.CollectionSchema)
 	if hasSystemFields(schema, []string{RowIDFieldName, TimeStampFieldName}) {
 		return fmt.Errorf("schema contains system field: %s, %s", RowIDFieldName, TimeStampFieldName)
 	}
+	partitionKeyNum := 0
+	for _, field := range schema.GetFields() {
+		if typeutil.IsPartitionKeyField(field) {
+			partitionKeyNum++
+		}
+		if err := validateNullableField(field); err != nil {
+			return err
+		}
+	}
+	if partitionKeyNum > 1 {
+		return fmt.Errorf("there are more than one partition key, num: %d", partitionKeyNum)
+	}
 	return nil
 }
 
+// validateNullableField checks the nullable flag and default value of the field, the rows without value
+// of the field are filled with the default value, or null if the field has no default value.
+func validateNullableField(field *schemapb.FieldSchema) error {
+	if value, err := typeutil.NewKvPairs(field.GetTypeParams()).Get(common.NullableKey); err == nil {
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("invalid %s value %s of field %s", common.NullableKey, value, field.GetName())
+		}
+	}
+	if !typeutil.HasDefaultValue(field) {
+		return nil
+	}
+	if field.GetIsPrimaryKey() || field.GetAutoID() || typeutil.IsPartitionKeyField(field) {
+		return fmt.Errorf("primary key, auto id or partition key field %s could not be nullable or have a default value", field.GetName())
+	}
+	if typeutil.IsVectorType(field.GetDataType()) {
+		return fmt.Errorf("vector field %s could not be nullable or have a default value", field.GetName())
+	}
+	return typeutil.ValidateDefaultValue(field)
+}
+
 func (t *createCollectionTask) assignFieldID(schema *schemapb.CollectionSchema) {
 	for idx := range schema.GetFields() {
 		schema.Fields[idx].FieldID = int64(idx + StartOfUserFieldID)
@@ -120,35 +163,88 @@ func (t *createCollectionTask) assignShardsNum() {
 	}
 }
 
+func (t *createCollectionTask) assignDBID(ctx context.Context) error {
+	db, err := t.core.meta.GetDatabaseByName(ctx, t.Req.GetDbName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	t.dbID = db.ID
+	return nil
+}
+
 func (t *createCollectionTask) assignCollectionID() error {
 	var err error
 	t.collID, err = t.core.idAllocator.AllocOne()
 	return err
 }
 
-func (t *createCollectionTask) assignPartitionID() error {
-	var err error
-	t.partID, err = t.core.idAllocator.AllocOne()
-	return err
+// getNumPartitions returns the number of hash partitions to create for a partition key collection.
+func (t *createCollectionTask) getNumPartitions() (int64, error) {
+	numPartitions := Params.RootCoordCfg.DefaultNumPartitions.GetAsInt64()
+	for _, kv := range t.Req.GetProperties() {
+		if kv.GetKey() == common.NumPartitionsKey {
+			num, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid %s: %s", common.NumPartitionsKey, kv.GetValue())
+			}
+			numPartitions = num
+		}
+	}
+	maxPartitionNum := Params.RootCoordCfg.MaxPartitionNum.GetAsInt64()
+	if numPartitions <= 0 || numPartitions > maxPartitionNum {
+		return 0, fmt.Errorf("the number of partitions should be in range (0, %d], got: %d", maxPartitionNum, numPartitions)
+	}
+	return numPartitions, nil
 }
 
-func (t *createCollectionTask) assignChannels() error {
-	vchanNames := make([]string, t.Req.GetShardsNum())
+func (t *createCollectionTask) assignPartitionIDs() error {
+	defaultPartitionName := Params.CommonCfg.DefaultPartitionName.GetValue()
+	t.partitionNames = []string{defaultPartitionName}
+	if typeutil.HasPartitionKey(t.schema) {
+		numPartitions, err := t.getNumPartitions()
+		if err != nil {
+			return err
+		}
+		t.partitionNames = make([]string, 0, numPartitions)
+		for i := int64(0); i < numPartitions; i++ {
+			t.partitionNames = append(t.partitionNames, fmt.Sprintf("%s_%d", defaultPartitionName, i))
+		}
+	}
+
+	start, end, err := t.core.idAllocator.Alloc(uint32(len(t.partitionNames)))
+	if err != nil {
+		return err
+	}
+	t.partIDs = make([]UniqueID, len(t.partitionNames))
+	for i := start; i < end; i++ {
+		t.partIDs[i-start] = i
+	}
+	return nil
+}
+
+// assignChannels picks shardsNum dml channels and generates the virtual channels of the collection on them.
+func assignChannels(core *Core, collID UniqueID, shardsNum int) (collectionChannels, error) {
+	vchanNames := make([]string, shardsNum)
 	//physical channel names
-	chanNames := t.core.chanTimeTick.getDmlChannelNames(int(t.Req.GetShardsNum()))
+	chanNames := core.chanTimeTick.getDmlChannelNames(shardsNum)
 
-	if int32(len(chanNames)) < t.Req.GetShardsNum() {
-		return fmt.Errorf("no enough channels, want: %d, got: %d", t.Req.GetShardsNum(), len(chanNames))
+	if len(chanNames) < shardsNum {
+		return collectionChannels{}, fmt.Errorf("no enough channels, want: %d, got: %d", shardsNum, len(chanNames))
 	}
 
-	for i := int32(0); i < t.Req.GetShardsNum(); i++ {
-		vchanNames[i] = fmt.Sprintf("%s_%dv%d", chanNames[i], t.collID, i)
+	for i := 0; i < shardsNum; i++ {
+		vchanNames[i] = fmt.Sprintf("%s_%dv%d", chanNames[i], collID, i)
 	}
-	t.channels = collectionChannels{
+	return collectionChannels{
 		virtualChannels:  vchanNames,
 		physicalChannels: chanNames,
-	}
-	return nil
+	}, nil
+}
+
+func (t *createCollectionTask) assignChannels() error {
+	var err error
+	t.channels, err = assignChannels(t.core, t.collID, int(t.Req.GetShardsNum()))
+	return err
 }
 
 func (t *createCollectionTask) Prepare(ctx context.Context) error {
@@ -162,25 +258,28 @@ func (t *createCollectionTask) Prepare(ctx context.Context) error {
 
 	t.assignShardsNum()
 
+	if err := t.assignDBID(ctx); err != nil {
+		return err
+	}
+
 	if err := t.assignCollectionID(); err != nil {
 		return err
 	}
 
-	if err := t.assignPartitionID(); err != nil {
+	if err := t.assignPartitionIDs(); err != nil {
 		return err
 	}
 
 	return t.assignChannels()
 }
 
-func (t *createCollectionTask) genCreateCollectionMsg(ctx context.Context) *ms.MsgPack {
-	ts := t.GetTs()
-	collectionID := t.collID
-	partitionID := t.partID
+// genCreateCollectionMsg generates the message broadcast to the dml channels of the new collection.
+func genCreateCollectionMsg(ctx context.Context, ts Timestamp, collectionID UniqueID, partitionIDs []UniqueID,
+	schema *schemapb.CollectionSchema, channels collectionChannels) *ms.MsgPack {
 	// error won't happen here.
-	marshaledSchema, _ := proto.Marshal(t.schema)
-	pChannels := t.channels.physicalChannels
-	vChannels := t.channels.virtualChannels
+	marshaledSchema, _ := proto.Marshal(schema)
+	pChannels := channels.physicalChannels
+	vChannels := channels.virtualChannels
 
 	msgPack := ms.MsgPack{}
 	baseMsg := ms.BaseMsg{
@@ -197,7 +296,7 @@ func (t *createCollectionTask) genCreateCollectionMsg(ctx context.Context) *ms.M
 				commonpbutil.WithTimeStamp(ts),
 			),
 			CollectionID:         collectionID,
-			PartitionID:          partitionID,
+			PartitionIDs:         partitionIDs,
 			Schema:               marshaledSchema,
 			VirtualChannelNames:  vChannels,
 			PhysicalChannelNames: pChannels,
@@ -209,13 +308,12 @@ func (t *createCollectionTask) genCreateCollectionMsg(ctx context.Context) *ms.M
 
 func (t *createCollectionTask) addChannelsAndGetStartPositions(ctx context.Context) (map[string][]byte, error) {
 	t.core.chanTimeTick.addDmlChannels(t.channels.physicalChannels...)
-	msg := t.genCreateCollectionMsg(ctx)
+	msg := genCreateCollectionMsg(ctx, t.GetTs(), t.collID, t.partIDs, t.schema, t.channels)
 	return t.core.chanTimeTick.broadcastMarkDmlChannels(t.channels.physicalChannels, msg)
 }
 
 func (t *createCollectionTask) Execute(ctx context.Context) error {
 	collID := t.collID
-	partID := t.partID
 	ts := t.GetTs()
 
 	vchanNames := t.channels.virtualChannels
@@ -228,8 +326,20 @@ func (t *createCollectionTask) Execute(ctx context.Context) error {
 		return err
 	}
 
+	partitions := make([]*model.Partition, len(t.partIDs))
+	for i, partID := range t.partIDs {
+		partitions[i] = &model.Partition{
+			PartitionID:               partID,
+			PartitionName:             t.partitionNames[i],
+			PartitionCreatedTimestamp: ts,
+			CollectionID:              collID,
+			State:                     pb.PartitionState_PartitionCreated,
+		}
+	}
+
 	collInfo := model.Collection{
 		CollectionID:         collID,
+		DBID:                 t.dbID,
 		Name:                 t.schema.Name,
 		Description:          t.schema.Description,
 		AutoID:               t.schema.AutoID,
@@ -241,25 +351,20 @@ func (t *createCollectionTask) Execute(ctx context.Context) error {
 		StartPositions:       toKeyDataPairs(startPositions),
 		CreateTime:           ts,
 		State:                pb.CollectionState_CollectionCreating,
-		Partitions: []*model.Partition{
-			{
-				PartitionID:               partID,
-				PartitionName:             Params.CommonCfg.DefaultPartitionName.GetValue(),
-				PartitionCreatedTimestamp: ts,
-				CollectionID:              collID,
-				State:                     pb.PartitionState_PartitionCreated,
-			},
-		},
-		Properties: t.Req.Properties,
+		Partitions:           partitions,
+		Properties:           t.Req.Properties,
 	}
 
 	// We cannot check the idempotency inside meta table when adding collection, since we'll execute duplicate steps
 	// if add collection successfully due to idempotency check. Some steps may be risky to be duplicate executed if they
 	// are not promised idempotent.
 	clone := collInfo.Clone()
-	clone.Partitions = []*model.Partition{{PartitionName: Params.CommonCfg.DefaultPartitionName.GetValue()}}
+	clone.Partitions = make([]*model.Partition, len(t.partitionNames))
+	for i, partitionName := range t.partitionNames {
+		clone.Partitions[i] = &model.Partition{PartitionName: partitionName}
+	}
 	// need double check in meta table if we can't promise the sequence execution.
-	existedCollInfo, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetCollectionName(), typeutil.MaxTimestamp)
+	existedCollInfo, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), typeutil.MaxTimestamp)
 	if err == nil {
 		equal := existedCollInfo.Equal(*clone)
 		if !equal {
@@ -273,6 +378,7 @@ func (t *createCollectionTask) Execute(ctx context.Context) error {
 	undoTask := newBaseUndoTask(t.core.stepExecutor)
 	undoTask.AddStep(&expireCacheStep{
 		baseStep:        baseStep{core: t.core},
+		dbName:          t.Req.GetDbName(),
 		collectionNames: []string{t.Req.GetCollectionName()},
 		collectionID:    InvalidCollectionID,
 		ts:              ts,
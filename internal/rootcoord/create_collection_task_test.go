@@ -14,6 +14,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -51,6 +52,19 @@ func Test_createCollectionTask_validate(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("invalid binlog compression", func(t *testing.T) {
+		task := createCollectionTask{
+			Req: &milvuspb.CreateCollectionRequest{
+				Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				Properties: []*commonpb.KeyValuePair{
+					{Key: common.CollectionBinlogCompressionKey, Value: "gzip"},
+				},
+			},
+		}
+		err := task.validate()
+		assert.Error(t, err)
+	})
+
 	t.Run("normal case", func(t *testing.T) {
 		task := createCollectionTask{
 			Req: &milvuspb.CreateCollectionRequest{
@@ -97,6 +111,63 @@ func Test_createCollectionTask_validateSchema(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("more than one partition key", func(t *testing.T) {
+		collectionName := funcutil.GenRandomStr()
+		task := createCollectionTask{
+			Req: &milvuspb.CreateCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				CollectionName: collectionName,
+			},
+		}
+		partitionKeyParams := []*commonpb.KeyValuePair{{Key: common.PartitionKeyKey, Value: "true"}}
+		schema := &schemapb.CollectionSchema{
+			Name: collectionName,
+			Fields: []*schemapb.FieldSchema{
+				{Name: "field1", DataType: schemapb.DataType_Int64, TypeParams: partitionKeyParams},
+				{Name: "field2", DataType: schemapb.DataType_Int64, TypeParams: partitionKeyParams},
+			},
+		}
+		err := task.validateSchema(schema)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid nullable or default value", func(t *testing.T) {
+		collectionName := funcutil.GenRandomStr()
+		task := createCollectionTask{
+			Req: &milvuspb.CreateCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				CollectionName: collectionName,
+			},
+		}
+		nullable := &commonpb.KeyValuePair{Key: common.NullableKey, Value: "true"}
+		invalidFields := []*schemapb.FieldSchema{
+			{Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, TypeParams: []*commonpb.KeyValuePair{nullable}},
+			{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{nullable, {Key: "dim", Value: "8"}}},
+			{Name: "flag", DataType: schemapb.DataType_Bool, TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "yes"}}},
+			{Name: "age", DataType: schemapb.DataType_Int8, TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "300"}}},
+			{Name: "name", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{
+				{Key: "max_length", Value: "2"}, {Key: common.DefaultValueKey, Value: "unknown"}}},
+		}
+		for _, field := range invalidFields {
+			schema := &schemapb.CollectionSchema{
+				Name:   collectionName,
+				Fields: []*schemapb.FieldSchema{field},
+			}
+			err := task.validateSchema(schema)
+			assert.Error(t, err, field.GetName())
+		}
+
+		schema := &schemapb.CollectionSchema{
+			Name: collectionName,
+			Fields: []*schemapb.FieldSchema{
+				{Name: "age", DataType: schemapb.DataType_Int8, TypeParams: []*commonpb.KeyValuePair{nullable, {Key: common.DefaultValueKey, Value: "18"}}},
+				{Name: "name", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{nullable, {Key: "max_length", Value: "8"}}},
+			},
+		}
+		err := task.validateSchema(schema)
+		assert.NoError(t, err)
+	})
+
 	t.Run("normal case", func(t *testing.T) {
 		collectionName := funcutil.GenRandomStr()
 		task := createCollectionTask{
@@ -200,6 +271,34 @@ func Test_createCollectionTask_Prepare(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("database not exist", func(t *testing.T) {
+		collectionName := funcutil.GenRandomStr()
+		schema := &schemapb.CollectionSchema{
+			Name:   collectionName,
+			Fields: []*schemapb.FieldSchema{{Name: funcutil.GenRandomStr()}},
+		}
+		marshaledSchema, err := proto.Marshal(schema)
+		assert.NoError(t, err)
+
+		meta := newMockMetaTable()
+		meta.GetDatabaseByNameFunc = func(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error) {
+			return nil, errors.New("error mock GetDatabaseByName")
+		}
+		core := newTestCore(withValidIDAllocator(), withMeta(meta))
+
+		task := createCollectionTask{
+			baseTask: baseTask{core: core},
+			Req: &milvuspb.CreateCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				DbName:         "not_exist",
+				CollectionName: collectionName,
+				Schema:         marshaledSchema,
+			},
+		}
+		err = task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
 	t.Run("failed to assign id", func(t *testing.T) {
 		collectionName := funcutil.GenRandomStr()
 		field1 := funcutil.GenRandomStr()
@@ -214,7 +313,11 @@ func Test_createCollectionTask_Prepare(t *testing.T) {
 		marshaledSchema, err := proto.Marshal(schema)
 		assert.NoError(t, err)
 
-		core := newTestCore(withInvalidIDAllocator())
+		meta := newMockMetaTable()
+		meta.GetDatabaseByNameFunc = func(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error) {
+			return model.NewDefaultDatabase(), nil
+		}
+		core := newTestCore(withInvalidIDAllocator(), withMeta(meta))
 
 		task := createCollectionTask{
 			baseTask: baseTask{core: core},
@@ -236,7 +339,11 @@ func Test_createCollectionTask_Prepare(t *testing.T) {
 
 		ticker := newRocksMqTtSynchronizer()
 
-		core := newTestCore(withValidIDAllocator(), withTtSynchronizer(ticker))
+		meta := newMockMetaTable()
+		meta.GetDatabaseByNameFunc = func(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error) {
+			return model.NewDefaultDatabase(), nil
+		}
+		core := newTestCore(withValidIDAllocator(), withTtSynchronizer(ticker), withMeta(meta))
 
 		schema := &schemapb.CollectionSchema{
 			Name:        collectionName,
@@ -263,6 +370,72 @@ func Test_createCollectionTask_Prepare(t *testing.T) {
 		task.Req.ShardsNum = 1
 		err = task.Prepare(context.Background())
 		assert.NoError(t, err)
+		assert.Equal(t, []string{Params.CommonCfg.DefaultPartitionName.GetValue()}, task.partitionNames)
+		assert.Equal(t, 1, len(task.partIDs))
+	})
+
+	t.Run("partition key", func(t *testing.T) {
+		defer cleanTestEnv()
+
+		collectionName := funcutil.GenRandomStr()
+		ticker := newRocksMqTtSynchronizer()
+
+		meta := newMockMetaTable()
+		meta.GetDatabaseByNameFunc = func(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error) {
+			return model.NewDefaultDatabase(), nil
+		}
+		idAllocator := newMockIDAllocator()
+		idAllocator.AllocF = func(count uint32) (UniqueID, UniqueID, error) {
+			return 100, 100 + UniqueID(count), nil
+		}
+		core := newTestCore(withIDAllocator(idAllocator), withTtSynchronizer(ticker), withMeta(meta))
+
+		schema := &schemapb.CollectionSchema{
+			Name: collectionName,
+			Fields: []*schemapb.FieldSchema{
+				{Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{
+					Name:       "tenant",
+					DataType:   schemapb.DataType_Int64,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.PartitionKeyKey, Value: "true"}},
+				},
+			},
+		}
+		marshaledSchema, err := proto.Marshal(schema)
+		assert.NoError(t, err)
+
+		task := createCollectionTask{
+			baseTask: baseTask{core: core},
+			Req: &milvuspb.CreateCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				CollectionName: collectionName,
+				Schema:         marshaledSchema,
+				ShardsNum:      1,
+				Properties:     []*commonpb.KeyValuePair{{Key: common.NumPartitionsKey, Value: "invalid"}},
+			},
+		}
+		err = task.Prepare(context.Background())
+		assert.Error(t, err)
+
+		task.Req.Properties[0].Value = "0"
+		err = task.Prepare(context.Background())
+		assert.Error(t, err)
+
+		task.Req.Properties[0].Value = "16"
+		err = task.Prepare(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 16, len(task.partitionNames))
+		assert.Equal(t, 16, len(task.partIDs))
+		defaultPartitionName := Params.CommonCfg.DefaultPartitionName.GetValue()
+		assert.Equal(t, defaultPartitionName+"_0", task.partitionNames[0])
+		assert.Equal(t, defaultPartitionName+"_15", task.partitionNames[15])
+		assert.Equal(t, UniqueID(100), task.partIDs[0])
+		assert.Equal(t, UniqueID(115), task.partIDs[15])
+
+		task.Req.Properties = nil
+		err = task.Prepare(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, Params.RootCoordCfg.DefaultNumPartitions.GetAsInt(), len(task.partitionNames))
 	})
 }
 
@@ -276,7 +449,7 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 		coll := &model.Collection{Name: collectionName}
 
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return coll, nil
 		}
 
@@ -321,7 +494,7 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 		}
 
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return coll, nil
 		}
 
@@ -333,9 +506,10 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
 				CollectionName: collectionName,
 			},
-			collID:   collID,
-			schema:   schema,
-			channels: channels,
+			collID:         collID,
+			schema:         schema,
+			channels:       channels,
+			partitionNames: []string{Params.CommonCfg.DefaultPartitionName.GetValue()},
 		}
 
 		err := task.Execute(context.Background())
@@ -369,10 +543,12 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 		pchans := ticker.getDmlChannelNames(shardNum)
 
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return nil, errors.New("error mock GetCollectionByName")
 		}
+		var addedColl *model.Collection
 		meta.AddCollectionFunc = func(ctx context.Context, coll *model.Collection) error {
+			addedColl = coll
 			return nil
 		}
 		meta.ChangeCollectionStateFunc = func(ctx context.Context, collectionID UniqueID, state etcdpb.CollectionState, ts Timestamp) error {
@@ -420,12 +596,17 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 				Schema:         marshaledSchema,
 				ShardsNum:      int32(shardNum),
 			},
-			channels: collectionChannels{physicalChannels: pchans},
-			schema:   schema,
+			channels:       collectionChannels{physicalChannels: pchans},
+			schema:         schema,
+			partIDs:        []UniqueID{100, 101},
+			partitionNames: []string{"_default_0", "_default_1"},
 		}
 
 		err = task.Execute(context.Background())
 		assert.NoError(t, err)
+		assert.Equal(t, 2, len(addedColl.Partitions))
+		assert.Equal(t, UniqueID(101), addedColl.Partitions[1].PartitionID)
+		assert.Equal(t, "_default_1", addedColl.Partitions[1].PartitionName)
 	})
 
 	t.Run("partial error, check if undo worked", func(t *testing.T) {
@@ -439,7 +620,7 @@ func Test_createCollectionTask_Execute(t *testing.T) {
 		pchans := ticker.getDmlChannelNames(shardNum)
 
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return nil, errors.New("error mock GetCollectionByName")
 		}
 		meta.AddCollectionFunc = func(ctx context.Context, coll *model.Collection) error {
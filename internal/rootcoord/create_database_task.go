@@ -0,0 +1,33 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+)
+
+type createDatabaseTask struct {
+	baseTask
+	Req  *rootcoordpb.CreateDatabaseRequest
+	dbID UniqueID
+}
+
+func (t *createDatabaseTask) Prepare(ctx context.Context) error {
+	if t.Req.GetDbName() == "" {
+		return errors.New("database name should not be empty")
+	}
+	var err error
+	t.dbID, err = t.core.idAllocator.AllocOne()
+	return err
+}
+
+func (t *createDatabaseTask) Execute(ctx context.Context) error {
+	// create database is atomic enough.
+	return t.core.meta.CreateDatabase(ctx, &model.Database{
+		ID:          t.dbID,
+		Name:        t.Req.GetDbName(),
+		CreatedTime: t.GetTs(),
+	}, t.GetTs())
+}
@@ -0,0 +1,122 @@
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+func Test_createDatabaseTask_Prepare(t *testing.T) {
+	t.Run("empty name", func(t *testing.T) {
+		task := &createDatabaseTask{Req: &rootcoordpb.CreateDatabaseRequest{}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to allocate id", func(t *testing.T) {
+		core := newTestCore(withInvalidIDAllocator())
+		task := &createDatabaseTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.CreateDatabaseRequest{DbName: "db"},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		core := newTestCore(withValidIDAllocator())
+		task := &createDatabaseTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.CreateDatabaseRequest{DbName: "db"},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_createDatabaseTask_Execute(t *testing.T) {
+	t.Run("failed to create database", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &createDatabaseTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.CreateDatabaseRequest{DbName: "db"},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.CreateDatabaseFunc = func(ctx context.Context, db *model.Database, ts Timestamp) error {
+			assert.Equal(t, "db", db.Name)
+			assert.Equal(t, UniqueID(100), db.ID)
+			return nil
+		}
+		core := newTestCore(withMeta(meta))
+		task := &createDatabaseTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.CreateDatabaseRequest{DbName: "db"},
+			dbID:     100,
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_dropDatabaseTask(t *testing.T) {
+	t.Run("empty name", func(t *testing.T) {
+		task := &dropDatabaseTask{Req: &rootcoordpb.DropDatabaseRequest{}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to drop database", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &dropDatabaseTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.DropDatabaseRequest{DbName: "db"},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+		err = task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func Test_listDatabasesTask_Execute(t *testing.T) {
+	t.Run("failed to list databases", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &listDatabasesTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.ListDatabasesRequest{},
+			Rsp:      &rootcoordpb.ListDatabasesResponse{},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, task.Rsp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.ListDatabasesFunc = func(ctx context.Context, ts Timestamp) ([]*model.Database, error) {
+			return []*model.Database{model.NewDefaultDatabase(), {ID: 2, Name: "db", CreatedTime: 100}}, nil
+		}
+		core := newTestCore(withMeta(meta))
+		task := &listDatabasesTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.ListDatabasesRequest{},
+			Rsp:      &rootcoordpb.ListDatabasesResponse{},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, task.Rsp.GetStatus().GetErrorCode())
+		assert.Equal(t, []string{util.DefaultDBName, "db"}, task.Rsp.GetDbNames())
+		assert.Equal(t, []uint64{0, 100}, task.Rsp.GetCreatedTimestamp())
+	})
+}
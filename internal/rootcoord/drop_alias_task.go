@@ -22,8 +22,8 @@ func (t *dropAliasTask) Prepare(ctx context.Context) error {
 
 func (t *dropAliasTask) Execute(ctx context.Context) error {
 	// drop alias is atomic enough.
-	if err := t.core.ExpireMetaCache(ctx, []string{t.Req.GetAlias()}, InvalidCollectionID, t.GetTs()); err != nil {
+	if err := t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), []string{t.Req.GetAlias()}, InvalidCollectionID, t.GetTs()); err != nil {
 		return err
 	}
-	return t.core.meta.DropAlias(ctx, t.Req.GetAlias(), t.GetTs())
+	return t.core.meta.DropAlias(ctx, t.Req.GetDbName(), t.Req.GetAlias(), t.GetTs())
 }
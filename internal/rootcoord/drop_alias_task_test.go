@@ -63,7 +63,7 @@ func Test_dropAliasTask_Execute(t *testing.T) {
 
 	t.Run("normal case", func(t *testing.T) {
 		meta := newMockMetaTable()
-		meta.DropAliasFunc = func(ctx context.Context, alias string, ts Timestamp) error {
+		meta.DropAliasFunc = func(ctx context.Context, dbName string, alias string, ts Timestamp) error {
 			return nil
 		}
 		core := newTestCore(withValidProxyManager(), withMeta(meta))
@@ -31,7 +31,7 @@ func Test_dropCollectionTask_Prepare(t *testing.T) {
 	t.Run("drop via alias", func(t *testing.T) {
 		collectionName := funcutil.GenRandomStr()
 		meta := newMockMetaTable()
-		meta.IsAliasFunc = func(name string) bool {
+		meta.IsAliasFunc = func(dbName string, name string) bool {
 			return true
 		}
 		core := newTestCore(withMeta(meta))
@@ -49,7 +49,7 @@ func Test_dropCollectionTask_Prepare(t *testing.T) {
 	t.Run("normal case", func(t *testing.T) {
 		collectionName := funcutil.GenRandomStr()
 		meta := newMockMetaTable()
-		meta.IsAliasFunc = func(name string) bool {
+		meta.IsAliasFunc = func(dbName string, name string) bool {
 			return false
 		}
 		core := newTestCore(withMeta(meta))
@@ -72,8 +72,9 @@ func Test_dropCollectionTask_Execute(t *testing.T) {
 		meta.On("GetCollectionByName",
 			mock.Anything, // context.Context.
 			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
 			mock.AnythingOfType("uint64"),
-		).Return(nil, func(ctx context.Context, name string, ts Timestamp) error {
+		).Return(nil, func(ctx context.Context, dbName string, name string, ts Timestamp) error {
 			if collectionName == name {
 				return common.NewCollectionNotExistError("collection not exist")
 			}
@@ -102,6 +103,7 @@ func Test_dropCollectionTask_Execute(t *testing.T) {
 		meta.On("GetCollectionByName",
 			mock.Anything, // context.Context
 			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
 			mock.AnythingOfType("uint64"),
 		).Return(coll.Clone(), nil)
 		meta.On("ListAliasesByID",
@@ -124,7 +126,7 @@ func Test_dropCollectionTask_Execute(t *testing.T) {
 		collectionName := funcutil.GenRandomStr()
 		coll := &model.Collection{Name: collectionName}
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return coll.Clone(), nil
 		}
 		meta.ChangeCollectionStateFunc = func(ctx context.Context, collectionID UniqueID, state etcdpb.CollectionState, ts Timestamp) error {
@@ -157,7 +159,7 @@ func Test_dropCollectionTask_Execute(t *testing.T) {
 
 		coll := &model.Collection{Name: collectionName, ShardsNum: int32(shardNum), PhysicalChannelNames: pchans}
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return coll.Clone(), nil
 		}
 		meta.ChangeCollectionStateFunc = func(ctx context.Context, collectionID UniqueID, state etcdpb.CollectionState, ts Timestamp) error {
@@ -0,0 +1,25 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+)
+
+type dropDatabaseTask struct {
+	baseTask
+	Req *rootcoordpb.DropDatabaseRequest
+}
+
+func (t *dropDatabaseTask) Prepare(ctx context.Context) error {
+	if t.Req.GetDbName() == "" {
+		return errors.New("database name should not be empty")
+	}
+	return nil
+}
+
+func (t *dropDatabaseTask) Execute(ctx context.Context) error {
+	// the database should be empty, so there is no cache of proxy to expire.
+	return t.core.meta.DropDatabase(ctx, t.Req.GetDbName(), t.GetTs())
+}
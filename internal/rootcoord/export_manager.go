@@ -0,0 +1,607 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/exportutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// MaxRunningExportTasks is the max number of the export tasks executed at the same time,
+// the other tasks are pending until a running task is done.
+const MaxRunningExportTasks = 2
+
+// maxExportPinAttempts is the max times to list and pin the flushed segments, which may be compacted meanwhile.
+var maxExportPinAttempts = 5
+
+// exportManager manages the export tasks, which write the rows of a collection visible at a timestamp
+// into the JSON, NumPy or Parquet files under a target path of the object storage.
+// The tasks are executed by RootCoord itself, the files are written segment by segment.
+type exportManager struct {
+	ctx       context.Context
+	taskStore kv.TxnKV // Persistent task info storage.
+
+	pendingTasks []*rootcoordpb.ExportTaskInfo         // pending tasks
+	runningTasks map[int64]*rootcoordpb.ExportTaskInfo // in-progress tasks
+	lock         sync.Mutex                            // lock pending and running tasks
+	wg           sync.WaitGroup                        // wait running tasks
+
+	startOnce sync.Once
+
+	idAllocator         func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error)
+	broker              Broker
+	getCollectionSchema func(ctx context.Context, collID UniqueID) (*schemapb.CollectionSchema, error)
+	newChunkManager     func(ctx context.Context) (storage.ChunkManager, error)
+}
+
+// newExportManager helper function to create an exportManager
+func newExportManager(ctx context.Context, client kv.TxnKV,
+	idAlloc func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error),
+	broker Broker,
+	getCollectionSchema func(ctx context.Context, collID UniqueID) (*schemapb.CollectionSchema, error),
+	newChunkManager func(ctx context.Context) (storage.ChunkManager, error)) *exportManager {
+	return &exportManager{
+		ctx:                 ctx,
+		taskStore:           client,
+		pendingTasks:        make([]*rootcoordpb.ExportTaskInfo, 0),
+		runningTasks:        make(map[int64]*rootcoordpb.ExportTaskInfo),
+		idAllocator:         idAlloc,
+		broker:              broker,
+		getCollectionSchema: getCollectionSchema,
+		newChunkManager:     newChunkManager,
+	}
+}
+
+func (m *exportManager) init() {
+	m.startOnce.Do(func() {
+		// Read tasks from Etcd, the pending tasks are executed again, the started tasks are marked as failed.
+		if _, err := m.loadFromTaskStore(true); err != nil {
+			log.Error("exportManager init failed, read tasks from Etcd failed, about to panic")
+			panic(err)
+		}
+		m.scheduleTasks()
+	})
+}
+
+// cleanupLoop periodically removes the finished tasks which are over `ExportTaskRetention` seconds old.
+func (m *exportManager) cleanupLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(time.Duration(cleanUpLoopInterval) * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Debug("(in cleanupLoop) export manager context done, exit cleanupLoop")
+			return
+		case <-ticker.C:
+			log.Debug("(in cleanupLoop) trying to expire old export tasks from Etcd")
+			m.expireOldTasksFromEtcd()
+		}
+	}
+}
+
+// exportJob creates an export task of the partitions of the collection, the task is executed asynchronously.
+func (m *exportManager) exportJob(ctx context.Context, req *rootcoordpb.ExportRequest, collID UniqueID,
+	partIDs []UniqueID, schema *schemapb.CollectionSchema) *rootcoordpb.ExportResponse {
+	// check the format, the schema and the target path before the task is created.
+	_, err := exportutil.NewWriter(req.GetFormat(), schema, req.GetCollectionName())
+	if err == nil {
+		err = m.checkTargetPath(ctx, req.GetTargetPath())
+	}
+	if err != nil {
+		log.Warn("illegal export request", zap.String("collection", req.GetCollectionName()), zap.Error(err))
+		return &rootcoordpb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_IllegalArgument, err.Error()),
+		}
+	}
+
+	taskID, _, err := m.idAllocator(1)
+	if err != nil {
+		log.Error("failed to allocate ID for export task", zap.Error(err))
+		return &rootcoordpb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "failed to allocate ID for export task: "+err.Error()),
+		}
+	}
+
+	task := &rootcoordpb.ExportTaskInfo{
+		Id:             taskID,
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+		CollectionId:   collID,
+		PartitionIds:   partIDs,
+		Format:         req.GetFormat(),
+		TargetPath:     req.GetTargetPath(),
+		Timestamp:      req.GetTimestamp(),
+		State:          rootcoordpb.ExportState_ExportPending,
+		CreateTs:       time.Now().Unix(),
+	}
+	if err := m.persistTaskInfo(task); err != nil {
+		return &rootcoordpb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "failed to save export task: "+err.Error()),
+		}
+	}
+	log.Info("export task created",
+		zap.Int64("task ID", taskID),
+		zap.String("collection", req.GetCollectionName()),
+		zap.Int64s("partitions", partIDs),
+		zap.String("format", req.GetFormat().String()),
+		zap.String("target path", req.GetTargetPath()),
+		zap.Uint64("timestamp", req.GetTimestamp()))
+
+	m.lock.Lock()
+	m.pendingTasks = append(m.pendingTasks, task)
+	m.lock.Unlock()
+	m.scheduleTasks()
+
+	return &rootcoordpb.ExportResponse{
+		Status: succStatus(),
+		TaskId: taskID,
+	}
+}
+
+// checkTargetPath checks that the target path is not empty, and is outside the root path of the chunk manager,
+// so that the exported files never overwrite or get recycled with the binlogs and the index files.
+func (m *exportManager) checkTargetPath(ctx context.Context, targetPath string) error {
+	if strings.TrimSpace(targetPath) == "" {
+		return errors.New("the target path of export is empty")
+	}
+	chunkManager, err := m.newChunkManager(ctx)
+	if err != nil {
+		return err
+	}
+	dataPaths := []string{chunkManager.RootPath()}
+	if path.Clean("/"+chunkManager.RootPath()) == "/" {
+		// the data files are right under the bucket if the root path is empty.
+		dataPaths = []string{common.SegmentInsertLogPath, common.SegmentDeltaLogPath, common.SegmentStatslogPath, common.SegmentIndexPath}
+	}
+	target := path.Clean("/" + targetPath)
+	for _, dataPath := range dataPaths {
+		dataPath = path.Clean("/" + dataPath)
+		if target == dataPath || strings.HasPrefix(target, dataPath+"/") {
+			return fmt.Errorf("the target path %s is inside the data path %s", targetPath, dataPath)
+		}
+	}
+	return nil
+}
+
+// scheduleTasks starts the pending tasks if the number of running tasks is under MaxRunningExportTasks.
+func (m *exportManager) scheduleTasks() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for len(m.pendingTasks) > 0 && len(m.runningTasks) < MaxRunningExportTasks {
+		task := m.pendingTasks[0]
+		m.pendingTasks = m.pendingTasks[1:]
+		m.runningTasks[task.GetId()] = task
+		m.wg.Add(1)
+		go m.executeTask(task)
+	}
+}
+
+func (m *exportManager) executeTask(task *rootcoordpb.ExportTaskInfo) {
+	defer m.wg.Done()
+	log := log.With(zap.Int64("task ID", task.GetId()), zap.Int64("collection ID", task.GetCollectionId()))
+
+	task.State = rootcoordpb.ExportState_ExportStarted
+	task.StartTs = time.Now().Unix()
+	if err := m.persistTaskInfo(task); err != nil {
+		log.Warn("failed to update the state of export task", zap.Error(err))
+	}
+
+	log.Info("start to export collection")
+	rowCount, files, err := m.export(m.ctx, task)
+	if err != nil {
+		log.Error("failed to export collection", zap.Error(err))
+		task.State = rootcoordpb.ExportState_ExportFailed
+		task.ErrorMessage = err.Error()
+	} else {
+		log.Info("export collection done", zap.Int64("row count", rowCount), zap.Int("file count", len(files)))
+		task.State = rootcoordpb.ExportState_ExportCompleted
+		task.RowCount = rowCount
+		task.Files = files
+	}
+	task.CompleteTs = time.Now().Unix()
+	if err := m.persistTaskInfo(task); err != nil {
+		log.Warn("failed to update the state of export task", zap.Error(err))
+	}
+
+	m.lock.Lock()
+	delete(m.runningTasks, task.GetId())
+	m.lock.Unlock()
+	m.scheduleTasks()
+}
+
+// export writes the rows of the flushed segments of the task into files, returns the number of
+// the exported rows and the paths of the files. The rows inserted after the timestamp of the task are skipped,
+// so are the rows deleted before the timestamp. The written files are removed if the export fails.
+func (m *exportManager) export(ctx context.Context, task *rootcoordpb.ExportTaskInfo) (rowCount int64, files []string, err error) {
+	collID := task.GetCollectionId()
+	schema, err := m.getCollectionSchema(ctx, collID)
+	if err != nil {
+		return 0, nil, err
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// the growing segments are sealed and flushed, so that all the rows are in the binlogs.
+	if err := m.broker.WaitSegmentsFlushed(ctx, collID); err != nil {
+		return 0, nil, err
+	}
+	segments, err := m.pinFlushedSegments(ctx, task)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(segments) == 0 {
+		return 0, nil, nil
+	}
+	defer func() {
+		segIDs := lo.Map(segments, func(segment *datapb.SegmentInfo, _ int) int64 { return segment.GetID() })
+		if err := m.broker.ReleaseSegRefLock(ctx, task.GetId(), segIDs); err != nil {
+			log.Warn("failed to release segment lock of export task", zap.Int64("task ID", task.GetId()), zap.Error(err))
+		}
+	}()
+
+	chunkManager, err := m.newChunkManager(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	// the binlogs may be encrypted, but the exported files are for the others, so they are written as is.
+	targetManager := storage.PlainChunkManager(chunkManager)
+	defer func() {
+		if err != nil && len(files) > 0 {
+			if removeErr := targetManager.MultiRemove(ctx, files); removeErr != nil {
+				log.Warn("failed to remove the files of failed export task", zap.Int64("task ID", task.GetId()), zap.Error(removeErr))
+			}
+			files = nil
+		}
+	}()
+
+	deleted, err := loadDeletedPks(ctx, chunkManager, segments, task.GetTimestamp())
+	if err != nil {
+		return 0, nil, err
+	}
+
+	for _, segment := range segments {
+		if segmentAfter(segment, task.GetTimestamp()) {
+			continue
+		}
+		writer, err := exportutil.NewWriter(task.GetFormat(), schema, strconv.FormatInt(segment.GetID(), 10))
+		if err != nil {
+			return 0, files, err
+		}
+		count, err := exportSegment(ctx, chunkManager, segment, schema, pkField.GetFieldID(), deleted, task.GetTimestamp(), writer)
+		if err != nil {
+			return 0, files, fmt.Errorf("failed to export segment %d: %w", segment.GetID(), err)
+		}
+		contents, err := writer.Finish()
+		if err != nil {
+			return 0, files, err
+		}
+		if count == 0 {
+			continue
+		}
+		for relative, content := range contents {
+			filePath := path.Join(task.GetTargetPath(), relative)
+			if err := targetManager.Write(ctx, filePath, content); err != nil {
+				return 0, files, fmt.Errorf("failed to write %s: %w", filePath, err)
+			}
+			files = append(files, filePath)
+		}
+		rowCount += count
+	}
+	sort.Strings(files)
+	return rowCount, files, nil
+}
+
+// pinFlushedSegments lists the flushed segments of the task and pins them by the segment reference lock,
+// so that their binlogs are not recycled by the compaction during the export. A segment may be compacted
+// after listed and before pinned, then the lock is released and the segments are listed and pinned again.
+func (m *exportManager) pinFlushedSegments(ctx context.Context, task *rootcoordpb.ExportTaskInfo) ([]*datapb.SegmentInfo, error) {
+	var err error
+	for i := 0; i < maxExportPinAttempts; i++ {
+		var segments []*datapb.SegmentInfo
+		segments, err = m.broker.GetFlushedSegments(ctx, task.GetCollectionId(), task.GetPartitionIds())
+		if err != nil || len(segments) == 0 {
+			return nil, err
+		}
+
+		segIDs := lo.Map(segments, func(segment *datapb.SegmentInfo, _ int) int64 { return segment.GetID() })
+		if err = m.broker.AddSegRefLock(ctx, task.GetId(), segIDs); err == nil {
+			// the pinned segments are still flushed, unless they are compacted before pinned.
+			var flushed []*datapb.SegmentInfo
+			flushed, err = m.broker.GetFlushedSegments(ctx, task.GetCollectionId(), task.GetPartitionIds())
+			if err == nil {
+				flushedIDs := lo.Map(flushed, func(segment *datapb.SegmentInfo, _ int) int64 { return segment.GetID() })
+				if compacted, _ := lo.Difference(segIDs, flushedIDs); len(compacted) > 0 {
+					err = fmt.Errorf("segments %v are compacted", compacted)
+				}
+			}
+			if err == nil {
+				return segments, nil
+			}
+			if err := m.broker.ReleaseSegRefLock(ctx, task.GetId(), segIDs); err != nil {
+				return nil, err
+			}
+		}
+		log.Warn("failed to pin the flushed segments of export task, retry", zap.Int64("task ID", task.GetId()),
+			zap.Int("attempt", i+1), zap.Error(err))
+	}
+	return nil, err
+}
+
+// segmentAfter returns true if all the rows of the segment are written after the timestamp.
+func segmentAfter(segment *datapb.SegmentInfo, ts Timestamp) bool {
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if binlog.GetTimestampFrom() <= ts {
+				return false
+			}
+		}
+	}
+	return len(segment.GetBinlogs()) > 0
+}
+
+// loadDeletedPks reads the delta logs of the segments, returns the last timestamp of the deletions
+// of each primary key, the deletions after @ts are ignored.
+func loadDeletedPks(ctx context.Context, chunkManager storage.ChunkManager, segments []*datapb.SegmentInfo, ts Timestamp) (map[interface{}]Timestamp, error) {
+	deleted := make(map[interface{}]Timestamp)
+	codec := storage.NewDeleteCodec()
+	for _, segment := range segments {
+		for _, fieldBinlog := range segment.GetDeltalogs() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				value, err := chunkManager.Read(ctx, binlog.GetLogPath())
+				if err != nil {
+					return nil, fmt.Errorf("failed to read %s: %w", binlog.GetLogPath(), err)
+				}
+				_, _, data, err := codec.Deserialize([]*storage.Blob{{Key: binlog.GetLogPath(), Value: value}})
+				if err != nil {
+					return nil, fmt.Errorf("failed to deserialize %s: %w", binlog.GetLogPath(), err)
+				}
+				for i, pk := range data.Pks {
+					if data.Tss[i] > ts {
+						continue
+					}
+					if data.Tss[i] > deleted[pk.GetValue()] {
+						deleted[pk.GetValue()] = data.Tss[i]
+					}
+				}
+			}
+		}
+	}
+	return deleted, nil
+}
+
+// exportSegment writes the rows of the segment visible at @ts into the writer, returns the number of the written rows.
+// The binlogs are read batch by batch, a batch contains the binlogs of all the fields of a flush.
+func exportSegment(ctx context.Context, chunkManager storage.ChunkManager, segment *datapb.SegmentInfo,
+	schema *schemapb.CollectionSchema, pkFieldID int64, deleted map[interface{}]Timestamp, ts Timestamp, writer exportutil.Writer) (int64, error) {
+	var binlogNum int
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		if len(fieldBinlog.GetBinlogs()) > binlogNum {
+			binlogNum = len(fieldBinlog.GetBinlogs())
+		}
+	}
+
+	var count int64
+	codec := &storage.InsertCodec{}
+	for idx := 0; idx < binlogNum; idx++ {
+		paths := make([]string, 0, len(segment.GetBinlogs()))
+		for _, fieldBinlog := range segment.GetBinlogs() {
+			if idx >= len(fieldBinlog.GetBinlogs()) {
+				return 0, fmt.Errorf("the binlogs of field %d are incomplete", fieldBinlog.GetFieldID())
+			}
+			paths = append(paths, fieldBinlog.GetBinlogs()[idx].GetLogPath())
+		}
+		// the fields share the same columnar binlog if the segment is of StorageV2
+		paths = lo.Uniq(paths)
+		values, err := chunkManager.MultiRead(ctx, paths)
+		if err != nil {
+			return 0, err
+		}
+		blobs := make([]*storage.Blob, len(paths))
+		for i := range paths {
+			blobs[i] = &storage.Blob{Key: paths[i], Value: values[i]}
+		}
+		_, _, _, data, err := codec.DeserializeAll(blobs)
+		if err != nil {
+			return 0, err
+		}
+
+		tsData, ok := data.Data[common.TimeStampField].(*storage.Int64FieldData)
+		if !ok {
+			return 0, fmt.Errorf("the timestamps of the rows are missing")
+		}
+		// the segment was flushed before some fields were added to the collection
+		if err := storage.FillMissingFields(schema, data, len(tsData.Data)); err != nil {
+			return 0, err
+		}
+		pkData, ok := data.Data[pkFieldID]
+		if !ok {
+			return 0, fmt.Errorf("the primary keys of the rows are missing")
+		}
+		offsets := make([]int, 0, len(tsData.Data))
+		for i, rowTs := range tsData.Data {
+			// the rows out of the row timestamp range of the segment are filtered out when it's loaded as well
+			if Timestamp(rowTs) > ts || Timestamp(rowTs) <= segment.GetMinRowTimestamp() ||
+				(segment.GetMaxRowTimestamp() != 0 && Timestamp(rowTs) > segment.GetMaxRowTimestamp()) {
+				continue
+			}
+			if deleteTs, ok := deleted[pkData.GetRow(i)]; ok && Timestamp(rowTs) < deleteTs {
+				continue
+			}
+			offsets = append(offsets, i)
+		}
+		if err := writer.Write(data, offsets); err != nil {
+			return 0, err
+		}
+		count += int64(len(offsets))
+	}
+	return count, nil
+}
+
+// getTaskState returns the info of the export task.
+func (m *exportManager) getTaskState(taskID int64) (*rootcoordpb.ExportTaskInfo, error) {
+	value, err := m.taskStore.Load(BuildExportTaskKey(taskID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find export task %d: %w", taskID, err)
+	}
+	task := &rootcoordpb.ExportTaskInfo{}
+	if err := proto.Unmarshal([]byte(value), task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// listAllTasks returns the export tasks of the collection in ascending order of the ids,
+// all the tasks are returned if @colID is negative, the newest @limit tasks are returned if @limit is positive.
+func (m *exportManager) listAllTasks(colID int64, limit int64) ([]*rootcoordpb.ExportTaskInfo, error) {
+	exportTasks, err := m.loadFromTaskStore(false)
+	if err != nil {
+		log.Error("failed to load from task store", zap.Error(err))
+		return nil, fmt.Errorf("failed to load task list from etcd, error: %w", err)
+	}
+
+	tasks := make([]*rootcoordpb.ExportTaskInfo, 0, len(exportTasks))
+	for _, task := range exportTasks {
+		if colID < 0 || colID == task.GetCollectionId() {
+			tasks = append(tasks, task)
+		}
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].GetId() < tasks[j].GetId()
+	})
+
+	if limit <= 0 || limit >= int64(len(tasks)) {
+		return tasks, nil
+	}
+	return tasks[len(tasks)-int(limit):], nil
+}
+
+// loadFromTaskStore loads the export tasks from Etcd, the pending tasks are put back to the pending list
+// and the started tasks are marked as failed if @load2Mem is true.
+func (m *exportManager) loadFromTaskStore(load2Mem bool) ([]*rootcoordpb.ExportTaskInfo, error) {
+	_, v, err := m.taskStore.LoadWithPrefix(Params.RootCoordCfg.ExportTaskSubPath.GetValue())
+	if err != nil {
+		log.Error("export manager failed to load from Etcd", zap.Error(err))
+		return nil, err
+	}
+	var taskList []*rootcoordpb.ExportTaskInfo
+
+	for i := range v {
+		ti := &rootcoordpb.ExportTaskInfo{}
+		if err := proto.Unmarshal([]byte(v[i]), ti); err != nil {
+			log.Error("failed to unmarshal proto", zap.String("taskInfo", v[i]), zap.Error(err))
+			// Ignore bad protos.
+			continue
+		}
+
+		if !load2Mem {
+			taskList = append(taskList, ti)
+			continue
+		}
+		switch ti.GetState() {
+		case rootcoordpb.ExportState_ExportPending:
+			log.Info("export task has been reloaded as a pending task", zap.Int64("task ID", ti.GetId()))
+			m.lock.Lock()
+			m.pendingTasks = append(m.pendingTasks, ti)
+			m.lock.Unlock()
+		case rootcoordpb.ExportState_ExportStarted:
+			ti.State = rootcoordpb.ExportState_ExportFailed
+			ti.ErrorMessage = "task marked failed as service restarted"
+			ti.CompleteTs = time.Now().Unix()
+			if err := m.persistTaskInfo(ti); err != nil {
+				log.Error("failed to mark an interrupted export task as failed",
+					zap.Int64("task ID", ti.GetId()),
+					zap.Error(err))
+			}
+			log.Info("export task has been marked failed while reloading", zap.Int64("task ID", ti.GetId()))
+		}
+	}
+	return taskList, nil
+}
+
+// persistTaskInfo stores or updates the export task info in Etcd.
+func (m *exportManager) persistTaskInfo(ti *rootcoordpb.ExportTaskInfo) error {
+	taskInfo, err := proto.Marshal(ti)
+	if err != nil {
+		log.Error("failed to marshall task info proto",
+			zap.Int64("task ID", ti.GetId()),
+			zap.Error(err))
+		return err
+	}
+	if err = m.taskStore.Save(BuildExportTaskKey(ti.GetId()), string(taskInfo)); err != nil {
+		log.Error("failed to update export task info in Etcd",
+			zap.Int64("task ID", ti.GetId()),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// expireOldTasksFromEtcd removes the finished tasks from Etcd that are over `ExportTaskRetention` seconds old,
+// the exported files are kept.
+func (m *exportManager) expireOldTasksFromEtcd() {
+	tasks, err := m.loadFromTaskStore(false)
+	if err != nil {
+		log.Error("failed to load export tasks from Etcd during task cleanup")
+		return
+	}
+	for _, ti := range tasks {
+		if ti.GetState() != rootcoordpb.ExportState_ExportCompleted && ti.GetState() != rootcoordpb.ExportState_ExportFailed {
+			continue
+		}
+		if Params.RootCoordCfg.ExportTaskRetention.GetAsFloat() > float64(time.Now().Unix()-ti.GetCreateTs()) {
+			continue
+		}
+		log.Info("an export task has passed retention period and will be removed from Etcd",
+			zap.Int64("task ID", ti.GetId()),
+			zap.Int64("createTs", ti.GetCreateTs()))
+		if err := m.taskStore.Remove(BuildExportTaskKey(ti.GetId())); err != nil {
+			log.Error("failed to remove export task from Etcd",
+				zap.Int64("task ID", ti.GetId()),
+				zap.Error(err))
+		}
+	}
+}
+
+// BuildExportTaskKey constructs and returns an Etcd key with given task ID.
+func BuildExportTaskKey(taskID int64) string {
+	return fmt.Sprintf("%s%s%d", Params.RootCoordCfg.ExportTaskSubPath.GetValue(), delimiter, taskID)
+}
@@ -0,0 +1,430 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"path"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func exportTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "export",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "id", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
+				{Key: "dim", Value: "2"},
+			}},
+		},
+	}
+}
+
+// prepareExportSegment writes the binlogs and the deltalog of a segment into the chunk manager.
+func prepareExportSegment(t *testing.T, cm storage.ChunkManager, segID int64, pks []int64, tss []int64,
+	deletedPks []int64, deletedTss []Timestamp) *datapb.SegmentInfo {
+	ctx := context.Background()
+	schema := exportTestSchema()
+	vectors := make([]float32, 0, len(pks)*2)
+	for _, pk := range pks {
+		vectors = append(vectors, float32(pk), float32(pk))
+	}
+	insertData := &storage.InsertData{Data: map[storage.FieldID]storage.FieldData{
+		common.RowIDField:     &storage.Int64FieldData{Data: pks},
+		common.TimeStampField: &storage.Int64FieldData{Data: tss},
+		100:                   &storage.Int64FieldData{Data: pks},
+		101:                   &storage.FloatVectorFieldData{Data: vectors, Dim: 2},
+	}}
+	blobs, _, err := storage.NewInsertCodec(&etcdpb.CollectionMeta{ID: 1, Schema: schema}).Serialize(10, segID, insertData)
+	require.NoError(t, err)
+
+	segment := &datapb.SegmentInfo{ID: segID, CollectionID: 1, PartitionID: 10}
+	for _, blob := range blobs {
+		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
+		require.NoError(t, err)
+		logPath := path.Join(cm.RootPath(), "insert_log", strconv.FormatInt(segID, 10), blob.GetKey(), "1")
+		require.NoError(t, cm.Write(ctx, logPath, blob.GetValue()))
+		segment.Binlogs = append(segment.Binlogs, &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{LogPath: logPath, TimestampFrom: uint64(tss[0]), TimestampTo: uint64(tss[len(tss)-1])}},
+		})
+	}
+
+	if len(deletedPks) > 0 {
+		deleteData := &storage.DeleteData{}
+		for i, pk := range deletedPks {
+			deleteData.Append(storage.NewInt64PrimaryKey(pk), deletedTss[i])
+		}
+		blob, err := storage.NewDeleteCodec().Serialize(1, 10, segID, deleteData)
+		require.NoError(t, err)
+		logPath := path.Join(cm.RootPath(), "delta_log", strconv.FormatInt(segID, 10), "1")
+		require.NoError(t, cm.Write(ctx, logPath, blob.GetValue()))
+		segment.Deltalogs = []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: logPath}}}}
+	}
+	return segment
+}
+
+func newTestExportManager(ctx context.Context, cm storage.ChunkManager, broker Broker) *exportManager {
+	var countLock sync.Mutex
+	var globalCount = typeutil.UniqueID(0)
+	idAlloc := func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		countLock.Lock()
+		defer countLock.Unlock()
+		globalCount++
+		return globalCount, 0, nil
+	}
+	getCollectionSchema := func(ctx context.Context, collID UniqueID) (*schemapb.CollectionSchema, error) {
+		return exportTestSchema(), nil
+	}
+	newChunkManager := func(ctx context.Context) (storage.ChunkManager, error) {
+		return cm, nil
+	}
+	return newExportManager(ctx, memkv.NewMemoryKV(), idAlloc, broker, getCollectionSchema, newChunkManager)
+}
+
+func TestExportManager_Export(t *testing.T) {
+	ctx := context.Background()
+	paramtable.Get().Save(Params.RootCoordCfg.ExportTaskSubPath.Key, "test_export_task")
+	defer paramtable.Get().Reset(Params.RootCoordCfg.ExportTaskSubPath.Key)
+
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	targetPath := path.Join(t.TempDir(), "export", "target")
+	// the row 2 is deleted before the export timestamp, the deletion of row 3 is after the timestamp.
+	segment1 := prepareExportSegment(t, cm, 1000, []int64{1, 2, 3}, []int64{10, 20, 30}, []int64{2, 3}, []Timestamp{25, 100})
+	// all the rows are inserted after the export timestamp.
+	segment2 := prepareExportSegment(t, cm, 2000, []int64{4, 5}, []int64{60, 70}, nil, nil)
+
+	var locked, released []int64
+	broker := newMockBroker()
+	broker.WaitSegmentsFlushedFunc = func(ctx context.Context, collID UniqueID) error {
+		return nil
+	}
+	broker.GetFlushedSegmentsFunc = func(ctx context.Context, collID UniqueID, partIDs []UniqueID) ([]*datapb.SegmentInfo, error) {
+		return []*datapb.SegmentInfo{segment1, segment2}, nil
+	}
+	broker.AddSegRefLockFunc = func(ctx context.Context, taskID int64, segIDs []int64) error {
+		locked = segIDs
+		return nil
+	}
+	broker.ReleaseSegRefLockFunc = func(ctx context.Context, taskID int64, segIDs []int64) error {
+		released = segIDs
+		return nil
+	}
+	m := newTestExportManager(ctx, cm, broker)
+	m.init()
+
+	resp := m.exportJob(ctx, &rootcoordpb.ExportRequest{
+		CollectionName: "export",
+		Format:         rootcoordpb.ExportFormat_ExportJSON,
+		TargetPath:     targetPath,
+		Timestamp:      50,
+	}, 1, []int64{10}, exportTestSchema())
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+	var task *rootcoordpb.ExportTaskInfo
+	assert.Eventually(t, func() bool {
+		var err error
+		task, err = m.getTaskState(resp.GetTaskId())
+		return err == nil && task.GetState() == rootcoordpb.ExportState_ExportCompleted
+	}, 10*time.Second, 10*time.Millisecond)
+	m.wg.Wait()
+
+	assert.Equal(t, int64(2), task.GetRowCount())
+	assert.Equal(t, []string{path.Join(targetPath, "1000.json")}, task.GetFiles())
+	assert.ElementsMatch(t, []int64{1000, 2000}, locked)
+	assert.ElementsMatch(t, []int64{1000, 2000}, released)
+
+	content, err := cm.Read(ctx, path.Join(targetPath, "1000.json"))
+	assert.NoError(t, err)
+	rows := struct {
+		Rows []map[string]interface{} `json:"rows"`
+	}{}
+	assert.NoError(t, json.Unmarshal(content, &rows))
+	assert.Equal(t, 2, len(rows.Rows))
+	assert.Equal(t, float64(1), rows.Rows[0]["id"])
+	assert.Equal(t, float64(3), rows.Rows[1]["id"])
+
+	t.Run("illegal format", func(t *testing.T) {
+		resp := m.exportJob(ctx, &rootcoordpb.ExportRequest{
+			CollectionName: "export",
+			Format:         rootcoordpb.ExportFormat(100),
+			TargetPath:     targetPath,
+		}, 1, []int64{10}, exportTestSchema())
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("illegal target path", func(t *testing.T) {
+		for _, targetPath := range []string{"", " ", cm.RootPath(), path.Join(cm.RootPath(), "insert_log"), path.Join(cm.RootPath(), "export", "..", "index_files")} {
+			resp := m.exportJob(ctx, &rootcoordpb.ExportRequest{
+				CollectionName: "export",
+				Format:         rootcoordpb.ExportFormat_ExportJSON,
+				TargetPath:     targetPath,
+			}, 1, []int64{10}, exportTestSchema())
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode(), targetPath)
+		}
+
+		// the data files are right under the bucket if the root path is empty.
+		m := newTestExportManager(ctx, storage.NewLocalChunkManager(), broker)
+		for _, targetPath := range []string{"insert_log/1", "/delta_log", "export/../stats_log", "index_files"} {
+			resp := m.exportJob(ctx, &rootcoordpb.ExportRequest{
+				CollectionName: "export",
+				Format:         rootcoordpb.ExportFormat_ExportJSON,
+				TargetPath:     targetPath,
+			}, 1, []int64{10}, exportTestSchema())
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode(), targetPath)
+		}
+	})
+
+	t.Run("added field", func(t *testing.T) {
+		// the field is added after the segment 1000 is flushed.
+		schema := exportTestSchema()
+		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 102, Name: "tag", DataType: schemapb.DataType_Int64,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "7"}}})
+		m := newTestExportManager(ctx, cm, broker)
+		m.getCollectionSchema = func(ctx context.Context, collID UniqueID) (*schemapb.CollectionSchema, error) {
+			return schema, nil
+		}
+		targetPath := path.Join(t.TempDir(), "added")
+		resp := m.exportJob(ctx, &rootcoordpb.ExportRequest{
+			CollectionName: "export",
+			Format:         rootcoordpb.ExportFormat_ExportJSON,
+			TargetPath:     targetPath,
+			Timestamp:      50,
+		}, 1, []int64{10}, schema)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		m.wg.Wait()
+
+		task, err := m.getTaskState(resp.GetTaskId())
+		require.NoError(t, err)
+		require.Equal(t, rootcoordpb.ExportState_ExportCompleted, task.GetState(), task.GetErrorMessage())
+		content, err := cm.Read(ctx, path.Join(targetPath, "1000.json"))
+		require.NoError(t, err)
+		rows := struct {
+			Rows []map[string]interface{} `json:"rows"`
+		}{}
+		require.NoError(t, json.Unmarshal(content, &rows))
+		require.Equal(t, 2, len(rows.Rows))
+		assert.Equal(t, float64(7), rows.Rows[0]["tag"])
+		assert.Equal(t, float64(7), rows.Rows[1]["tag"])
+	})
+
+	t.Run("encrypted binlogs", func(t *testing.T) {
+		keyFile := path.Join(t.TempDir(), "keys.json")
+		masterKey := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+		require.NoError(t, os.WriteFile(keyFile, []byte(`{"current": "key1", "keys": {"key1": "`+masterKey+`"}}`), 0600))
+		kms, err := storage.NewLocalKMS(keyFile)
+		require.NoError(t, err)
+		cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+		ecm := storage.NewEncryptedChunkManager(cm, kms)
+		segment := prepareExportSegment(t, ecm, 3000, []int64{8, 9}, []int64{10, 20}, nil, nil)
+		binlog, err := cm.Read(ctx, segment.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(binlog, []byte("MENC")))
+
+		broker := newMockBroker()
+		broker.WaitSegmentsFlushedFunc = func(ctx context.Context, collID UniqueID) error {
+			return nil
+		}
+		broker.GetFlushedSegmentsFunc = func(ctx context.Context, collID UniqueID, partIDs []UniqueID) ([]*datapb.SegmentInfo, error) {
+			return []*datapb.SegmentInfo{segment}, nil
+		}
+		broker.AddSegRefLockFunc = func(ctx context.Context, taskID int64, segIDs []int64) error {
+			return nil
+		}
+		broker.ReleaseSegRefLockFunc = func(ctx context.Context, taskID int64, segIDs []int64) error {
+			return nil
+		}
+		m := newTestExportManager(ctx, ecm, broker)
+		// the encrypted chunk manager would encrypt the files under the insert_log directory.
+		targetPath := path.Join(t.TempDir(), "insert_log", "1")
+		resp := m.exportJob(ctx, &rootcoordpb.ExportRequest{
+			CollectionName: "export",
+			Format:         rootcoordpb.ExportFormat_ExportJSON,
+			TargetPath:     targetPath,
+			Timestamp:      50,
+		}, 1, []int64{10}, exportTestSchema())
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		m.wg.Wait()
+
+		task, err := m.getTaskState(resp.GetTaskId())
+		require.NoError(t, err)
+		require.Equal(t, rootcoordpb.ExportState_ExportCompleted, task.GetState(), task.GetErrorMessage())
+		content, err := cm.Read(ctx, path.Join(targetPath, "3000.json"))
+		require.NoError(t, err)
+		rows := struct {
+			Rows []map[string]interface{} `json:"rows"`
+		}{}
+		require.NoError(t, json.Unmarshal(content, &rows))
+		assert.Equal(t, 2, len(rows.Rows))
+	})
+
+	t.Run("export failed", func(t *testing.T) {
+		broker.WaitSegmentsFlushedFunc = func(ctx context.Context, collID UniqueID) error {
+			return errors.New("mock flush error")
+		}
+		m := newTestExportManager(ctx, cm, broker)
+		resp := m.exportJob(ctx, &rootcoordpb.ExportRequest{
+			CollectionName: "export",
+			Format:         rootcoordpb.ExportFormat_ExportParquet,
+			TargetPath:     path.Join(t.TempDir(), "failed"),
+			Timestamp:      50,
+		}, 1, []int64{10}, exportTestSchema())
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		m.wg.Wait()
+
+		task, err := m.getTaskState(resp.GetTaskId())
+		assert.NoError(t, err)
+		assert.Equal(t, rootcoordpb.ExportState_ExportFailed, task.GetState())
+		assert.Contains(t, task.GetErrorMessage(), "mock flush error")
+	})
+}
+
+func TestExportManager_pinFlushedSegments(t *testing.T) {
+	ctx := context.Background()
+	task := &rootcoordpb.ExportTaskInfo{Id: 100, CollectionId: 1, PartitionIds: []int64{10}}
+	segment1 := &datapb.SegmentInfo{ID: 1000}
+	segment2 := &datapb.SegmentInfo{ID: 2000}
+	compacted := &datapb.SegmentInfo{ID: 3000}
+
+	var listed [][]*datapb.SegmentInfo
+	locked := make(map[int64][]int64)
+	broker := newMockBroker()
+	broker.GetFlushedSegmentsFunc = func(ctx context.Context, collID UniqueID, partIDs []UniqueID) ([]*datapb.SegmentInfo, error) {
+		segments := listed[0]
+		listed = listed[1:]
+		return segments, nil
+	}
+	broker.AddSegRefLockFunc = func(ctx context.Context, taskID int64, segIDs []int64) error {
+		locked[taskID] = segIDs
+		return nil
+	}
+	broker.ReleaseSegRefLockFunc = func(ctx context.Context, taskID int64, segIDs []int64) error {
+		delete(locked, taskID)
+		return nil
+	}
+	m := newTestExportManager(ctx, nil, broker)
+
+	t.Run("compacted before pinned", func(t *testing.T) {
+		// the segments 1000 and 2000 are compacted into the segment 3000 after listed.
+		listed = [][]*datapb.SegmentInfo{{segment1, segment2}, {compacted}, {compacted}, {compacted}}
+		segments, err := m.pinFlushedSegments(ctx, task)
+		assert.NoError(t, err)
+		assert.Equal(t, []*datapb.SegmentInfo{compacted}, segments)
+		assert.Equal(t, []int64{3000}, locked[task.GetId()])
+		assert.Empty(t, listed)
+	})
+
+	t.Run("compacted all the time", func(t *testing.T) {
+		delete(locked, task.GetId())
+		listed = nil
+		for i := 0; i < maxExportPinAttempts; i++ {
+			listed = append(listed, []*datapb.SegmentInfo{segment1}, []*datapb.SegmentInfo{segment2})
+		}
+		_, err := m.pinFlushedSegments(ctx, task)
+		assert.Error(t, err)
+		assert.Empty(t, locked)
+	})
+
+	t.Run("failed to lock", func(t *testing.T) {
+		listed = [][]*datapb.SegmentInfo{{segment1}, {segment2}, {segment2}}
+		broker.AddSegRefLockFunc = func(ctx context.Context, taskID int64, segIDs []int64) error {
+			if segIDs[0] == segment1.GetID() {
+				return errors.New("segment 1000 is recycled")
+			}
+			locked[taskID] = segIDs
+			return nil
+		}
+		segments, err := m.pinFlushedSegments(ctx, task)
+		assert.NoError(t, err)
+		assert.Equal(t, []*datapb.SegmentInfo{segment2}, segments)
+	})
+}
+
+func TestExportManager_LoadAndList(t *testing.T) {
+	ctx := context.Background()
+	paramtable.Get().Save(Params.RootCoordCfg.ExportTaskSubPath.Key, "test_export_task")
+	defer paramtable.Get().Reset(Params.RootCoordCfg.ExportTaskSubPath.Key)
+
+	m := newTestExportManager(ctx, nil, newMockBroker())
+	tasks := []*rootcoordpb.ExportTaskInfo{
+		{Id: 100, CollectionId: 1, State: rootcoordpb.ExportState_ExportCompleted, CreateTs: time.Now().Unix() - 100000},
+		{Id: 200, CollectionId: 1, State: rootcoordpb.ExportState_ExportStarted, CreateTs: time.Now().Unix()},
+		{Id: 300, CollectionId: 2, State: rootcoordpb.ExportState_ExportCompleted, CreateTs: time.Now().Unix()},
+	}
+	for _, task := range tasks {
+		value, err := proto.Marshal(task)
+		require.NoError(t, err)
+		require.NoError(t, m.taskStore.Save(BuildExportTaskKey(task.GetId()), string(value)))
+	}
+	// the bad task info is ignored
+	require.NoError(t, m.taskStore.Save(BuildExportTaskKey(1), "value"))
+
+	// the started task is marked as failed after restart
+	m.init()
+	task, err := m.getTaskState(200)
+	assert.NoError(t, err)
+	assert.Equal(t, rootcoordpb.ExportState_ExportFailed, task.GetState())
+
+	_, err = m.getTaskState(400)
+	assert.Error(t, err)
+
+	list, err := m.listAllTasks(-1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(list))
+	assert.Equal(t, int64(100), list[0].GetId())
+
+	list, err = m.listAllTasks(1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(list))
+
+	list, err = m.listAllTasks(-1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(list))
+	assert.Equal(t, int64(300), list[0].GetId())
+
+	// the task 100 is over the retention
+	m.expireOldTasksFromEtcd()
+	list, err = m.listAllTasks(-1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(list))
+	assert.Equal(t, int64(200), list[0].GetId())
+}
@@ -60,7 +60,7 @@ func Test_hasPartitionTask_Execute(t *testing.T) {
 
 	t.Run("failed", func(t *testing.T) {
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return &model.Collection{
 				Partitions: []*model.Partition{
 					{
@@ -92,7 +92,7 @@ func Test_hasPartitionTask_Execute(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return &model.Collection{
 				Partitions: []*model.Partition{
 					{
@@ -1184,6 +1184,31 @@ func TestImportManager_isRowbased(t *testing.T) {
 	rb, err = mgr.isRowbased(files)
 	assert.Nil(t, err)
 	assert.False(t, rb)
+
+	files = []string{"1.parquet"}
+	rb, err = mgr.isRowbased(files)
+	assert.Nil(t, err)
+	assert.True(t, rb)
+
+	files = []string{"1.parquet", "2.json"}
+	rb, err = mgr.isRowbased(files)
+	assert.NotNil(t, err)
+	assert.True(t, rb)
+
+	files = []string{"1.parquet", "2.npy"}
+	rb, err = mgr.isRowbased(files)
+	assert.NotNil(t, err)
+	assert.True(t, rb)
+
+	files = []string{"1.csv"}
+	rb, err = mgr.isRowbased(files)
+	assert.Nil(t, err)
+	assert.True(t, rb)
+
+	files = []string{"1.csv", "2.npy"}
+	rb, err = mgr.isRowbased(files)
+	assert.NotNil(t, err)
+	assert.True(t, rb)
 }
 
 func TestImportManager_checkIndexingDone(t *testing.T) {
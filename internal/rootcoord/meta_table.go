@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/metrics"
@@ -38,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/contextutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -68,25 +70,31 @@ const (
 
 //go:generate mockery --name=IMetaTable --outpkg=mockrootcoord
 type IMetaTable interface {
+	CreateDatabase(ctx context.Context, db *model.Database, ts Timestamp) error
+	DropDatabase(ctx context.Context, dbName string, ts Timestamp) error
+	ListDatabases(ctx context.Context, ts Timestamp) ([]*model.Database, error)
+	GetDatabaseByName(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error)
+
 	AddCollection(ctx context.Context, coll *model.Collection) error
 	ChangeCollectionState(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error
 	RemoveCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error
-	GetCollectionByName(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error)
+	GetCollectionByName(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error)
 	GetCollectionByID(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error)
-	ListCollections(ctx context.Context, ts Timestamp) ([]*model.Collection, error)
+	ListCollections(ctx context.Context, dbName string, ts Timestamp) ([]*model.Collection, error)
 	ListAbnormalCollections(ctx context.Context, ts Timestamp) ([]*model.Collection, error)
 	ListCollectionPhysicalChannels() map[typeutil.UniqueID][]string
 	GetCollectionVirtualChannels(colID int64) []string
 	AddPartition(ctx context.Context, partition *model.Partition) error
 	ChangePartitionState(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state pb.PartitionState, ts Timestamp) error
 	RemovePartition(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
-	CreateAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error
-	DropAlias(ctx context.Context, alias string, ts Timestamp) error
-	AlterAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error
+	CreateAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
+	DropAlias(ctx context.Context, dbName string, alias string, ts Timestamp) error
+	AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
 	AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error
+	RenameCollection(ctx context.Context, dbName string, oldName string, newName string, ts Timestamp) error
 
 	// TODO: it'll be a big cost if we handle the time travel logic, since we should always list all aliases in catalog.
-	IsAlias(name string) bool
+	IsAlias(dbName string, name string) bool
 	ListAliasesByID(collID UniqueID) []string
 
 	// TODO: better to accept ctx.
@@ -99,6 +107,10 @@ type IMetaTable interface {
 	DeleteCredential(username string) error
 	AlterCredential(credInfo *internalpb.CredentialInfo) error
 	ListCredentialUsernames() (*milvuspb.ListCredUsersResponse, error)
+	AddApiKey(apiKey *model.ApiKey) error
+	GetApiKey(keyID string) (*model.ApiKey, error)
+	DeleteApiKey(keyID string) error
+	ListApiKeys(username string) ([]*model.ApiKey, error)
 
 	// TODO: better to accept ctx.
 	CreateRole(tenant string, entity *milvuspb.RoleEntity) error
@@ -117,9 +129,10 @@ type MetaTable struct {
 	ctx     context.Context
 	catalog metastore.RootCoordCatalog
 
+	dbName2Meta  map[string]*model.Database              // database name -> database meta
 	collID2Meta  map[typeutil.UniqueID]*model.Collection // collection id -> collection meta
-	collName2ID  map[string]typeutil.UniqueID            // collection name to collection id
-	collAlias2ID map[string]typeutil.UniqueID            // collection alias to collection id
+	collName2ID  map[string]map[string]typeutil.UniqueID // database name -> collection name -> collection id
+	collAlias2ID map[string]map[string]typeutil.UniqueID // database name -> collection alias -> collection id
 
 	ddLock         sync.RWMutex
 	permissionLock sync.RWMutex
@@ -141,35 +154,56 @@ func (mt *MetaTable) reload() error {
 	defer mt.ddLock.Unlock()
 
 	record := timerecord.NewTimeRecorder("rootcoord")
+	mt.dbName2Meta = make(map[string]*model.Database)
 	mt.collID2Meta = make(map[UniqueID]*model.Collection)
-	mt.collName2ID = make(map[string]UniqueID)
-	mt.collAlias2ID = make(map[string]UniqueID)
+	mt.collName2ID = make(map[string]map[string]UniqueID)
+	mt.collAlias2ID = make(map[string]map[string]UniqueID)
 
 	collectionNum := int64(0)
 	partitionNum := int64(0)
 
 	// max ts means listing latest resources, meta table should always cache the latest version of catalog.
-	collections, err := mt.catalog.ListCollections(mt.ctx, typeutil.MaxTimestamp)
+	dbs, err := mt.catalog.ListDatabases(mt.ctx, typeutil.MaxTimestamp)
 	if err != nil {
 		return err
 	}
-	for name, collection := range collections {
-		mt.collID2Meta[collection.CollectionID] = collection
-		mt.collName2ID[name] = collection.CollectionID
-
-		if collection.Available() {
-			collectionNum++
-			partitionNum += int64(collection.GetPartitionNum(true))
+	for _, db := range dbs {
+		mt.dbName2Meta[db.Name] = db
+	}
+	// the default database always exists, persist it if it's missing in catalog, e.g. the meta was written by an older version.
+	if _, ok := mt.dbName2Meta[util.DefaultDBName]; !ok {
+		defaultDB := model.NewDefaultDatabase()
+		if err := mt.catalog.CreateDatabase(mt.ctx, defaultDB, typeutil.ZeroTimestamp); err != nil {
+			return err
 		}
+		mt.dbName2Meta[util.DefaultDBName] = defaultDB
 	}
 
-	// max ts means listing latest resources, meta table should always cache the latest version of catalog.
-	aliases, err := mt.catalog.ListAliases(mt.ctx, typeutil.MaxTimestamp)
-	if err != nil {
-		return err
-	}
-	for _, alias := range aliases {
-		mt.collAlias2ID[alias.Name] = alias.CollectionID
+	for dbName, db := range mt.dbName2Meta {
+		mt.collName2ID[dbName] = make(map[string]UniqueID)
+		mt.collAlias2ID[dbName] = make(map[string]UniqueID)
+
+		collections, err := mt.catalog.ListCollections(mt.ctx, db.ID, typeutil.MaxTimestamp)
+		if err != nil {
+			return err
+		}
+		for name, collection := range collections {
+			mt.collID2Meta[collection.CollectionID] = collection
+			mt.collName2ID[dbName][name] = collection.CollectionID
+
+			if collection.Available() {
+				collectionNum++
+				partitionNum += int64(collection.GetPartitionNum(true))
+			}
+		}
+
+		aliases, err := mt.catalog.ListAliases(mt.ctx, db.ID, typeutil.MaxTimestamp)
+		if err != nil {
+			return err
+		}
+		for _, alias := range aliases {
+			mt.collAlias2ID[dbName][alias.Name] = alias.CollectionID
+		}
 	}
 
 	metrics.RootCoordNumOfCollections.Set(float64(collectionNum))
@@ -179,6 +213,93 @@ func (mt *MetaTable) reload() error {
 	return nil
 }
 
+// normalizeDBName returns the default database name if dbName is empty.
+func normalizeDBName(dbName string) string {
+	if dbName == "" {
+		return util.DefaultDBName
+	}
+	return dbName
+}
+
+// getDBNameByIDInternal returns the name of the database with the given id, should be called with lock held.
+func (mt *MetaTable) getDBNameByIDInternal(dbID UniqueID) (string, bool) {
+	dbID = model.ResolveDBID(dbID)
+	for name, db := range mt.dbName2Meta {
+		if db.ID == dbID {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+func (mt *MetaTable) CreateDatabase(ctx context.Context, db *model.Database, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if _, ok := mt.dbName2Meta[db.Name]; ok {
+		return fmt.Errorf("database already exists: %s", db.Name)
+	}
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.CreateDatabase(ctx1, db, ts); err != nil {
+		return err
+	}
+	mt.dbName2Meta[db.Name] = db.Clone()
+	mt.collName2ID[db.Name] = make(map[string]UniqueID)
+	mt.collAlias2ID[db.Name] = make(map[string]UniqueID)
+	log.Info("create database", zap.String("database", db.Name), zap.Int64("id", db.ID), zap.Uint64("ts", ts))
+	return nil
+}
+
+func (mt *MetaTable) DropDatabase(ctx context.Context, dbName string, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if dbName == util.DefaultDBName {
+		return fmt.Errorf("cannot drop the default database")
+	}
+	db, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		log.Warn("drop a non-existent database", zap.String("database", dbName), zap.Uint64("ts", ts))
+		return nil
+	}
+	if len(mt.collName2ID[dbName]) > 0 {
+		return fmt.Errorf("database is not empty, collections should be dropped first: %s", dbName)
+	}
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.DropDatabase(ctx1, db.ID, ts); err != nil {
+		return err
+	}
+	delete(mt.dbName2Meta, dbName)
+	delete(mt.collName2ID, dbName)
+	delete(mt.collAlias2ID, dbName)
+	log.Info("drop database", zap.String("database", dbName), zap.Int64("id", db.ID), zap.Uint64("ts", ts))
+	return nil
+}
+
+// ListDatabases lists all the databases, only the latest version is cached, so ts is ignored.
+func (mt *MetaTable) ListDatabases(ctx context.Context, ts Timestamp) ([]*model.Database, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	dbs := make([]*model.Database, 0, len(mt.dbName2Meta))
+	for _, db := range mt.dbName2Meta {
+		dbs = append(dbs, db.Clone())
+	}
+	sort.Slice(dbs, func(i, j int) bool { return dbs[i].ID < dbs[j].ID })
+	return dbs, nil
+}
+
+func (mt *MetaTable) GetDatabaseByName(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	db, ok := mt.dbName2Meta[normalizeDBName(dbName)]
+	if !ok {
+		return nil, fmt.Errorf("database not exist: %s", dbName)
+	}
+	return db.Clone(), nil
+}
+
 func (mt *MetaTable) AddCollection(ctx context.Context, coll *model.Collection) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
@@ -190,13 +311,17 @@ func (mt *MetaTable) AddCollection(ctx context.Context, coll *model.Collection)
 	if coll.State != pb.CollectionState_CollectionCreating {
 		return fmt.Errorf("collection state should be creating, collection name: %s, collection id: %d, state: %s", coll.Name, coll.CollectionID, coll.State)
 	}
+	dbName, ok := mt.getDBNameByIDInternal(coll.DBID)
+	if !ok {
+		return fmt.Errorf("database not exist, collection name: %s, database id: %d", coll.Name, coll.DBID)
+	}
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
 	if err := mt.catalog.CreateCollection(ctx1, coll, coll.CreateTime); err != nil {
 		return err
 	}
-	mt.collName2ID[coll.Name] = coll.CollectionID
+	mt.collName2ID[dbName][coll.Name] = coll.CollectionID
 	mt.collID2Meta[coll.CollectionID] = coll.Clone()
-	log.Info("add collection to meta table", zap.String("collection", coll.Name),
+	log.Info("add collection to meta table", zap.String("database", dbName), zap.String("collection", coll.Name),
 		zap.Int64("id", coll.CollectionID), zap.Uint64("ts", coll.CreateTime))
 	return nil
 }
@@ -232,28 +357,28 @@ func (mt *MetaTable) ChangeCollectionState(ctx context.Context, collectionID Uni
 	return nil
 }
 
-func (mt *MetaTable) removeIfNameMatchedInternal(collectionID UniqueID, name string) {
-	id, ok := mt.collName2ID[name]
+func (mt *MetaTable) removeIfNameMatchedInternal(collectionID UniqueID, dbName string, name string) {
+	id, ok := mt.collName2ID[dbName][name]
 	if ok && id == collectionID {
-		delete(mt.collName2ID, name)
+		delete(mt.collName2ID[dbName], name)
 	}
 }
 
-func (mt *MetaTable) removeIfAliasMatchedInternal(collectionID UniqueID, alias string) {
-	id, ok := mt.collAlias2ID[alias]
+func (mt *MetaTable) removeIfAliasMatchedInternal(collectionID UniqueID, dbName string, alias string) {
+	id, ok := mt.collAlias2ID[dbName][alias]
 	if ok && id == collectionID {
-		delete(mt.collAlias2ID, alias)
+		delete(mt.collAlias2ID[dbName], alias)
 	}
 }
 
-func (mt *MetaTable) removeIfMatchedInternal(collectionID UniqueID, name string) {
-	mt.removeIfNameMatchedInternal(collectionID, name)
-	mt.removeIfAliasMatchedInternal(collectionID, name)
+func (mt *MetaTable) removeIfMatchedInternal(collectionID UniqueID, dbName string, name string) {
+	mt.removeIfNameMatchedInternal(collectionID, dbName, name)
+	mt.removeIfAliasMatchedInternal(collectionID, dbName, name)
 }
 
-func (mt *MetaTable) removeAllNamesIfMatchedInternal(collectionID UniqueID, names []string) {
+func (mt *MetaTable) removeAllNamesIfMatchedInternal(collectionID UniqueID, dbName string, names []string) {
 	for _, name := range names {
-		mt.removeIfMatchedInternal(collectionID, name)
+		mt.removeIfMatchedInternal(collectionID, dbName, name)
 	}
 }
 
@@ -268,23 +393,30 @@ func (mt *MetaTable) RemoveCollection(ctx context.Context, collectionID UniqueID
 	// Note: we cannot handle case that dropping collection with `ts1` but a collection exists in catalog with newer ts
 	// which is bigger than `ts1`. So we assume that ts should always be the latest.
 
+	var dbID UniqueID
+	coll, ok := mt.collID2Meta[collectionID]
+	if ok && coll != nil {
+		dbID = coll.DBID
+	}
+
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
 	aliases := mt.listAliasesByID(collectionID)
-	if err := mt.catalog.DropCollection(ctx1, &model.Collection{CollectionID: collectionID, Aliases: aliases}, ts); err != nil {
+	if err := mt.catalog.DropCollection(ctx1, &model.Collection{CollectionID: collectionID, DBID: dbID, Aliases: aliases}, ts); err != nil {
 		return err
 	}
 
 	allNames := common.CloneStringList(aliases)
 
 	var name string
-	coll, ok := mt.collID2Meta[collectionID]
 	if ok && coll != nil {
 		name = coll.Name
 		allNames = append(allNames, name)
 	}
 
 	// We cannot delete the name directly, since newly collection with same name may be created.
-	mt.removeAllNamesIfMatchedInternal(collectionID, allNames)
+	if dbName, ok := mt.getDBNameByIDInternal(dbID); ok {
+		mt.removeAllNamesIfMatchedInternal(collectionID, dbName, allNames)
+	}
 	mt.removeCollectionByIDInternal(collectionID)
 
 	log.Info("remove collection", zap.String("name", name), zap.Int64("id", collectionID), zap.Strings("aliases", aliases))
@@ -339,18 +471,24 @@ func (mt *MetaTable) getCollectionByIDInternal(ctx context.Context, collectionID
 	return filterUnavailable(coll), nil
 }
 
-func (mt *MetaTable) GetCollectionByName(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+func (mt *MetaTable) GetCollectionByName(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
+	dbName = normalizeDBName(dbName)
+	db, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		return nil, common.NewCollectionNotExistError(fmt.Sprintf("can't find collection: %s, database not exist: %s", collectionName, dbName))
+	}
+
 	var collectionID UniqueID
 
-	collectionID, ok := mt.collAlias2ID[collectionName]
+	collectionID, ok = mt.collAlias2ID[dbName][collectionName]
 	if ok {
 		return mt.getCollectionByIDInternal(ctx, collectionID, ts)
 	}
 
-	collectionID, ok = mt.collName2ID[collectionName]
+	collectionID, ok = mt.collName2ID[dbName][collectionName]
 	if ok {
 		return mt.getCollectionByIDInternal(ctx, collectionID, ts)
 	}
@@ -361,7 +499,7 @@ func (mt *MetaTable) GetCollectionByName(ctx context.Context, collectionName str
 
 	// travel meta information from catalog. No need to check time travel logic again, since catalog already did.
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
-	coll, err := mt.catalog.GetCollectionByName(ctx1, collectionName, ts)
+	coll, err := mt.catalog.GetCollectionByName(ctx1, db.ID, collectionName, ts)
 	if err != nil {
 		return nil, err
 	}
@@ -378,17 +516,23 @@ func (mt *MetaTable) GetCollectionByID(ctx context.Context, collectionID UniqueI
 	return mt.getCollectionByIDInternal(ctx, collectionID, ts)
 }
 
-func (mt *MetaTable) ListCollections(ctx context.Context, ts Timestamp) ([]*model.Collection, error) {
+func (mt *MetaTable) ListCollections(ctx context.Context, dbName string, ts Timestamp) ([]*model.Collection, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
+	dbName = normalizeDBName(dbName)
+	db, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		return nil, fmt.Errorf("database not exist: %s", dbName)
+	}
+
 	if isMaxTs(ts) {
-		return mt.listCollectionFromCache()
+		return mt.listCollectionFromCache(db.ID)
 	}
 
 	// list collections should always be loaded from catalog.
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
-	colls, err := mt.catalog.ListCollections(ctx1, ts)
+	colls, err := mt.catalog.ListCollections(ctx1, db.ID, ts)
 	if err != nil {
 		return nil, err
 	}
@@ -401,10 +545,10 @@ func (mt *MetaTable) ListCollections(ctx context.Context, ts Timestamp) ([]*mode
 	return onlineCollections, nil
 }
 
-func (mt *MetaTable) listCollectionFromCache() ([]*model.Collection, error) {
+func (mt *MetaTable) listCollectionFromCache(dbID UniqueID) ([]*model.Collection, error) {
 	collectionFromCache := make([]*model.Collection, 0)
 	for _, meta := range mt.collID2Meta {
-		if meta.Available() {
+		if meta.Available() && model.ResolveDBID(meta.DBID) == model.ResolveDBID(dbID) {
 			collectionFromCache = append(collectionFromCache, meta)
 		}
 	}
@@ -417,14 +561,16 @@ func (mt *MetaTable) ListAbnormalCollections(ctx context.Context, ts Timestamp)
 
 	// list collections should always be loaded from catalog.
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
-	colls, err := mt.catalog.ListCollections(ctx1, ts)
-	if err != nil {
-		return nil, err
-	}
-	abnormalCollections := make([]*model.Collection, 0, len(colls))
-	for _, coll := range colls {
-		if !coll.Available() {
-			abnormalCollections = append(abnormalCollections, coll)
+	abnormalCollections := make([]*model.Collection, 0)
+	for _, db := range mt.dbName2Meta {
+		colls, err := mt.catalog.ListCollections(ctx1, db.ID, ts)
+		if err != nil {
+			return nil, err
+		}
+		for _, coll := range colls {
+			if !coll.Available() {
+				abnormalCollections = append(abnormalCollections, coll)
+			}
 		}
 	}
 	return abnormalCollections, nil
@@ -457,6 +603,48 @@ func (mt *MetaTable) AlterCollection(ctx context.Context, oldColl *model.Collect
 	return nil
 }
 
+// RenameCollection renames the collection within its database. The aliases refer to the collection by id,
+// so they are kept along with the id.
+func (mt *MetaTable) RenameCollection(ctx context.Context, dbName string, oldName string, newName string, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	dbName = normalizeDBName(dbName)
+	if _, ok := mt.dbName2Meta[dbName]; !ok {
+		return fmt.Errorf("database not exist: %s", dbName)
+	}
+
+	if _, ok := mt.collName2ID[dbName][newName]; ok {
+		return fmt.Errorf("cannot rename collection, collection already exists with same name: %s", newName)
+	}
+	if _, ok := mt.collAlias2ID[dbName][newName]; ok {
+		return fmt.Errorf("cannot rename collection, alias already exists with same name: %s", newName)
+	}
+
+	collectionID, ok := mt.collName2ID[dbName][oldName]
+	if !ok {
+		return common.NewCollectionNotExistError(fmt.Sprintf("can't find collection: %s", oldName))
+	}
+	coll, ok := mt.collID2Meta[collectionID]
+	if !ok || !coll.Available() {
+		return common.NewCollectionNotExistError(fmt.Sprintf("can't find collection: %s", oldName))
+	}
+
+	newColl := coll.Clone()
+	newColl.Name = newName
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.AlterCollection(ctx1, coll, newColl, metastore.MODIFY, ts); err != nil {
+		return err
+	}
+
+	delete(mt.collName2ID[dbName], oldName)
+	mt.collName2ID[dbName][newName] = collectionID
+	mt.collID2Meta[collectionID] = newColl
+	log.Info("rename collection", zap.String("database", dbName), zap.String("oldName", oldName),
+		zap.String("newName", newName), zap.Int64("id", collectionID), zap.Uint64("ts", ts))
+	return nil
+}
+
 // GetCollectionVirtualChannels returns virtual channels of a given collection.
 func (mt *MetaTable) GetCollectionVirtualChannels(colID int64) []string {
 	mt.ddLock.RLock()
@@ -557,25 +745,31 @@ func (mt *MetaTable) RemovePartition(ctx context.Context, collectionID UniqueID,
 	return nil
 }
 
-func (mt *MetaTable) CreateAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
+func (mt *MetaTable) CreateAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	// It's ok that we don't read from catalog when cache missed.
 	// Since cache always keep the latest version, and the ts should always be the latest.
 
-	if _, ok := mt.collName2ID[alias]; ok {
+	dbName = normalizeDBName(dbName)
+	db, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		return fmt.Errorf("database not exist: %s", dbName)
+	}
+
+	if _, ok := mt.collName2ID[dbName][alias]; ok {
 		return fmt.Errorf("cannot create alias, collection already exists with same name: %s", alias)
 	}
 
-	collectionID, ok := mt.collName2ID[collectionName]
+	collectionID, ok := mt.collName2ID[dbName][collectionName]
 	if !ok {
 		// you cannot alias to a non-existent collection.
 		return fmt.Errorf("collection not exists: %s", collectionName)
 	}
 
 	// check if alias exists.
-	aliasedCollectionID, ok := mt.collAlias2ID[alias]
+	aliasedCollectionID, ok := mt.collAlias2ID[dbName][alias]
 	if ok && aliasedCollectionID == collectionID {
 		log.Warn("add duplicate alias", zap.String("alias", alias), zap.String("collection", collectionName), zap.Uint64("ts", ts))
 		return nil
@@ -598,39 +792,52 @@ func (mt *MetaTable) CreateAlias(ctx context.Context, alias string, collectionNa
 		CollectionID: collectionID,
 		CreatedTime:  ts,
 		State:        pb.AliasState_AliasCreated,
+		DBID:         db.ID,
 	}, ts); err != nil {
 		return err
 	}
-	mt.collAlias2ID[alias] = collectionID
-	log.Info("create alias", zap.String("alias", alias), zap.String("collection", collectionName), zap.Uint64("ts", ts))
+	mt.collAlias2ID[dbName][alias] = collectionID
+	log.Info("create alias", zap.String("database", dbName), zap.String("alias", alias), zap.String("collection", collectionName), zap.Uint64("ts", ts))
 	return nil
 }
 
-func (mt *MetaTable) DropAlias(ctx context.Context, alias string, ts Timestamp) error {
+func (mt *MetaTable) DropAlias(ctx context.Context, dbName string, alias string, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
+	dbName = normalizeDBName(dbName)
+	db, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		return fmt.Errorf("database not exist: %s", dbName)
+	}
+
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
-	if err := mt.catalog.DropAlias(ctx1, alias, ts); err != nil {
+	if err := mt.catalog.DropAlias(ctx1, db.ID, alias, ts); err != nil {
 		return err
 	}
-	delete(mt.collAlias2ID, alias)
-	log.Info("drop alias", zap.String("alias", alias), zap.Uint64("ts", ts))
+	delete(mt.collAlias2ID[dbName], alias)
+	log.Info("drop alias", zap.String("database", dbName), zap.String("alias", alias), zap.Uint64("ts", ts))
 	return nil
 }
 
-func (mt *MetaTable) AlterAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
+func (mt *MetaTable) AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	// It's ok that we don't read from catalog when cache missed.
 	// Since cache always keep the latest version, and the ts should always be the latest.
 
-	if _, ok := mt.collName2ID[alias]; ok {
+	dbName = normalizeDBName(dbName)
+	db, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		return fmt.Errorf("database not exist: %s", dbName)
+	}
+
+	if _, ok := mt.collName2ID[dbName][alias]; ok {
 		return fmt.Errorf("cannot alter alias, collection already exists with same name: %s", alias)
 	}
 
-	collectionID, ok := mt.collName2ID[collectionName]
+	collectionID, ok := mt.collName2ID[dbName][collectionName]
 	if !ok {
 		// you cannot alias to a non-existent collection.
 		return fmt.Errorf("collection not exists: %s", collectionName)
@@ -643,7 +850,7 @@ func (mt *MetaTable) AlterAlias(ctx context.Context, alias string, collectionNam
 	}
 
 	// check if alias exists.
-	_, ok = mt.collAlias2ID[alias]
+	_, ok = mt.collAlias2ID[dbName][alias]
 	if !ok {
 		//
 		return fmt.Errorf("failed to alter alias, alias does not exist: %s", alias)
@@ -655,29 +862,32 @@ func (mt *MetaTable) AlterAlias(ctx context.Context, alias string, collectionNam
 		CollectionID: collectionID,
 		CreatedTime:  ts,
 		State:        pb.AliasState_AliasCreated,
+		DBID:         db.ID,
 	}, ts); err != nil {
 		return err
 	}
 
 	// alias switch to another collection anyway.
-	mt.collAlias2ID[alias] = collectionID
-	log.Info("alter alias", zap.String("alias", alias), zap.String("collection", collectionName), zap.Uint64("ts", ts))
+	mt.collAlias2ID[dbName][alias] = collectionID
+	log.Info("alter alias", zap.String("database", dbName), zap.String("alias", alias), zap.String("collection", collectionName), zap.Uint64("ts", ts))
 	return nil
 }
 
-func (mt *MetaTable) IsAlias(name string) bool {
+func (mt *MetaTable) IsAlias(dbName string, name string) bool {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	_, ok := mt.collAlias2ID[name]
+	_, ok := mt.collAlias2ID[normalizeDBName(dbName)][name]
 	return ok
 }
 
 func (mt *MetaTable) listAliasesByID(collID UniqueID) []string {
 	ret := make([]string, 0)
-	for alias, id := range mt.collAlias2ID {
-		if id == collID {
-			ret = append(ret, alias)
+	for _, aliases := range mt.collAlias2ID {
+		for alias, id := range aliases {
+			if id == collID {
+				ret = append(ret, alias)
+			}
 		}
 	}
 	return ret
@@ -736,19 +946,6 @@ func (mt *MetaTable) GetPartitionNameByID(collID UniqueID, partitionID UniqueID,
 	return "", fmt.Errorf("partition not exist: %d", partitionID)
 }
 
-// GetCollectionIDByName serve for bulk insert. TODO: why this didn't accept ts?
-// [Deprecated]
-func (mt *MetaTable) GetCollectionIDByName(name string) (UniqueID, error) {
-	mt.ddLock.RLock()
-	defer mt.ddLock.RUnlock()
-
-	id, ok := mt.collName2ID[name]
-	if !ok {
-		return InvalidCollectionID, fmt.Errorf("collection not exists: %s", name)
-	}
-	return id, nil
-}
-
 // GetPartitionByName serve for bulk insert.
 func (mt *MetaTable) GetPartitionByName(collID UniqueID, partitionName string, ts Timestamp) (UniqueID, error) {
 	mt.ddLock.RLock()
@@ -839,11 +1036,23 @@ func (mt *MetaTable) GetCredential(username string) (*internalpb.CredentialInfo,
 	return model.MarshalCredentialModel(credential), err
 }
 
-// DeleteCredential delete credential
+// DeleteCredential delete credential and the api keys of the user
 func (mt *MetaTable) DeleteCredential(username string) error {
 	mt.permissionLock.Lock()
 	defer mt.permissionLock.Unlock()
 
+	apiKeys, err := mt.catalog.ListApiKeys(mt.ctx)
+	if err != nil {
+		return fmt.Errorf("list api keys err:%w", err)
+	}
+	for _, apiKey := range apiKeys {
+		if apiKey.Username != username {
+			continue
+		}
+		if err := mt.catalog.DropApiKey(mt.ctx, apiKey.KeyID); err != nil {
+			return err
+		}
+	}
 	return mt.catalog.DropCredential(mt.ctx, username)
 }
 
@@ -859,6 +1068,60 @@ func (mt *MetaTable) ListCredentialUsernames() (*milvuspb.ListCredUsersResponse,
 	return &milvuspb.ListCredUsersResponse{Usernames: usernames}, nil
 }
 
+// AddApiKey add an api key for an existing user
+func (mt *MetaTable) AddApiKey(apiKey *model.ApiKey) error {
+	if apiKey.KeyID == "" {
+		return fmt.Errorf("api key id is empty")
+	}
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	if _, err := mt.catalog.GetCredential(mt.ctx, apiKey.Username); err != nil {
+		return fmt.Errorf("user not found: %s", apiKey.Username)
+	}
+	if origin, _ := mt.catalog.GetApiKey(mt.ctx, apiKey.KeyID); origin != nil {
+		return fmt.Errorf("api key already exists: %s", apiKey.KeyID)
+	}
+	return mt.catalog.CreateApiKey(mt.ctx, apiKey)
+}
+
+// GetApiKey get api key by key id
+func (mt *MetaTable) GetApiKey(keyID string) (*model.ApiKey, error) {
+	mt.permissionLock.RLock()
+	defer mt.permissionLock.RUnlock()
+
+	return mt.catalog.GetApiKey(mt.ctx, keyID)
+}
+
+// DeleteApiKey delete api key by key id
+func (mt *MetaTable) DeleteApiKey(keyID string) error {
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	return mt.catalog.DropApiKey(mt.ctx, keyID)
+}
+
+// ListApiKeys list the api keys of the user, or all api keys if the username is empty
+func (mt *MetaTable) ListApiKeys(username string) ([]*model.ApiKey, error) {
+	mt.permissionLock.RLock()
+	defer mt.permissionLock.RUnlock()
+
+	apiKeys, err := mt.catalog.ListApiKeys(mt.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list api keys err:%w", err)
+	}
+	if username == "" {
+		return apiKeys, nil
+	}
+	r := make([]*model.ApiKey, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		if apiKey.Username == username {
+			r = append(r, apiKey)
+		}
+	}
+	return r, nil
+}
+
 // CreateRole create role
 func (mt *MetaTable) CreateRole(tenant string, entity *milvuspb.RoleEntity) error {
 	if funcutil.IsEmptyString(entity.Name) {
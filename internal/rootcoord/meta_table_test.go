@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/model"
@@ -77,6 +78,46 @@ func TestRbacAddCredential(t *testing.T) {
 	}
 }
 
+func TestRbacApiKey(t *testing.T) {
+	mt := generateMetaTable(t)
+	err := mt.AddCredential(&internalpb.CredentialInfo{Username: "user1", Tenant: util.DefaultTenant})
+	require.NoError(t, err)
+	err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user2", Tenant: util.DefaultTenant})
+	require.NoError(t, err)
+
+	assert.Error(t, mt.AddApiKey(&model.ApiKey{KeyID: "", Username: "user1"}))
+	assert.Error(t, mt.AddApiKey(&model.ApiKey{KeyID: "key0", Username: "user_not_exist"}))
+	require.NoError(t, mt.AddApiKey(&model.ApiKey{KeyID: "key1", Username: "user1", HashedSecret: "hashed1"}))
+	require.NoError(t, mt.AddApiKey(&model.ApiKey{KeyID: "key2", Username: "user1", HashedSecret: "hashed2"}))
+	require.NoError(t, mt.AddApiKey(&model.ApiKey{KeyID: "key3", Username: "user2", HashedSecret: "hashed3"}))
+	assert.Error(t, mt.AddApiKey(&model.ApiKey{KeyID: "key1", Username: "user2"}))
+
+	apiKey, err := mt.GetApiKey("key1")
+	require.NoError(t, err)
+	assert.Equal(t, "user1", apiKey.Username)
+	assert.Equal(t, "hashed1", apiKey.HashedSecret)
+
+	apiKeys, err := mt.ListApiKeys("")
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(apiKeys))
+	apiKeys, err = mt.ListApiKeys("user1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(apiKeys))
+
+	require.NoError(t, mt.DeleteApiKey("key2"))
+	_, err = mt.GetApiKey("key2")
+	assert.Error(t, err)
+
+	// the api keys of the user are dropped with the user.
+	require.NoError(t, mt.DeleteCredential("user1"))
+	_, err = mt.GetApiKey("key1")
+	assert.Error(t, err)
+	apiKeys, err = mt.ListApiKeys("")
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(apiKeys))
+	assert.Equal(t, "key3", apiKeys[0].KeyID)
+}
+
 func TestRbacCreateRole(t *testing.T) {
 	mt := generateMetaTable(t)
 
@@ -492,8 +533,11 @@ func TestMetaTable_getCollectionByIDInternal(t *testing.T) {
 func TestMetaTable_GetCollectionByName(t *testing.T) {
 	t.Run("get by alias", func(t *testing.T) {
 		meta := &MetaTable{
-			collAlias2ID: map[string]typeutil.UniqueID{
-				"alias": 100,
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+			},
+			collAlias2ID: map[string]map[string]typeutil.UniqueID{
+				util.DefaultDBName: {"alias": 100},
 			},
 			collID2Meta: map[typeutil.UniqueID]*model.Collection{
 				100: {
@@ -507,7 +551,7 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 			},
 		}
 		ctx := context.Background()
-		coll, err := meta.GetCollectionByName(ctx, "alias", 101)
+		coll, err := meta.GetCollectionByName(ctx, "", "alias", 101)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(coll.Partitions))
 		assert.Equal(t, UniqueID(11), coll.Partitions[0].PartitionID)
@@ -516,8 +560,11 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 
 	t.Run("get by name", func(t *testing.T) {
 		meta := &MetaTable{
-			collName2ID: map[string]typeutil.UniqueID{
-				"name": 100,
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+			},
+			collName2ID: map[string]map[string]typeutil.UniqueID{
+				util.DefaultDBName: {"name": 100},
 			},
 			collID2Meta: map[typeutil.UniqueID]*model.Collection{
 				100: {
@@ -531,7 +578,7 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 			},
 		}
 		ctx := context.Background()
-		coll, err := meta.GetCollectionByName(ctx, "name", 101)
+		coll, err := meta.GetCollectionByName(ctx, util.DefaultDBName, "name", 101)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(coll.Partitions))
 		assert.Equal(t, UniqueID(11), coll.Partitions[0].PartitionID)
@@ -542,12 +589,13 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
 		catalog.On("GetCollectionByName",
 			mock.Anything, // context.Context
+			mock.AnythingOfType("int64"),
 			mock.AnythingOfType("string"),
 			mock.AnythingOfType("uint64"),
 		).Return(nil, errors.New("error mock GetCollectionByName"))
-		meta := &MetaTable{catalog: catalog}
+		meta := &MetaTable{catalog: catalog, dbName2Meta: map[string]*model.Database{util.DefaultDBName: model.NewDefaultDatabase()}}
 		ctx := context.Background()
-		_, err := meta.GetCollectionByName(ctx, "name", 101)
+		_, err := meta.GetCollectionByName(ctx, "", "name", 101)
 		assert.Error(t, err)
 	})
 
@@ -555,12 +603,13 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
 		catalog.On("GetCollectionByName",
 			mock.Anything, // context.Context
+			mock.AnythingOfType("int64"),
 			mock.AnythingOfType("string"),
 			mock.AnythingOfType("uint64"),
 		).Return(&model.Collection{State: pb.CollectionState_CollectionDropped}, nil)
-		meta := &MetaTable{catalog: catalog}
+		meta := &MetaTable{catalog: catalog, dbName2Meta: map[string]*model.Database{util.DefaultDBName: model.NewDefaultDatabase()}}
 		ctx := context.Background()
-		_, err := meta.GetCollectionByName(ctx, "name", 101)
+		_, err := meta.GetCollectionByName(ctx, "", "name", 101)
 		assert.Error(t, err)
 		assert.True(t, common.IsCollectionNotExistError(err))
 	})
@@ -569,6 +618,7 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
 		catalog.On("GetCollectionByName",
 			mock.Anything, // context.Context
+			mock.AnythingOfType("int64"),
 			mock.AnythingOfType("string"),
 			mock.AnythingOfType("uint64"),
 		).Return(&model.Collection{
@@ -579,9 +629,9 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 				{PartitionID: 22, PartitionName: "dropped", State: pb.PartitionState_PartitionDropped},
 			},
 		}, nil)
-		meta := &MetaTable{catalog: catalog}
+		meta := &MetaTable{catalog: catalog, dbName2Meta: map[string]*model.Database{util.DefaultDBName: model.NewDefaultDatabase()}}
 		ctx := context.Background()
-		coll, err := meta.GetCollectionByName(ctx, "name", 101)
+		coll, err := meta.GetCollectionByName(ctx, util.DefaultDBName, "name", 101)
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(coll.Partitions))
 		assert.Equal(t, UniqueID(11), coll.Partitions[0].PartitionID)
@@ -590,8 +640,16 @@ func TestMetaTable_GetCollectionByName(t *testing.T) {
 
 	t.Run("get latest version", func(t *testing.T) {
 		ctx := context.Background()
-		meta := &MetaTable{collName2ID: nil}
-		_, err := meta.GetCollectionByName(ctx, "not_exist", typeutil.MaxTimestamp)
+		meta := &MetaTable{collName2ID: nil, dbName2Meta: map[string]*model.Database{util.DefaultDBName: model.NewDefaultDatabase()}}
+		_, err := meta.GetCollectionByName(ctx, "", "not_exist", typeutil.MaxTimestamp)
+		assert.Error(t, err)
+		assert.True(t, common.IsCollectionNotExistError(err))
+	})
+
+	t.Run("database not exist", func(t *testing.T) {
+		ctx := context.Background()
+		meta := &MetaTable{dbName2Meta: map[string]*model.Database{util.DefaultDBName: model.NewDefaultDatabase()}}
+		_, err := meta.GetCollectionByName(ctx, "not_exist", "name", typeutil.MaxTimestamp)
 		assert.Error(t, err)
 		assert.True(t, common.IsCollectionNotExistError(err))
 	})
@@ -639,6 +697,66 @@ func TestMetaTable_AlterCollection(t *testing.T) {
 	})
 }
 
+func TestMetaTable_RenameCollection(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("database not exist", func(t *testing.T) {
+		meta := newTestMetaTableWithDB(nil)
+		err := meta.RenameCollection(ctx, "db3", "test", "test2", 100)
+		assert.Error(t, err)
+	})
+
+	t.Run("new name exists", func(t *testing.T) {
+		meta := newTestMetaTableWithDB(nil)
+		meta.collAlias2ID["db2"]["alias"] = 101
+		err := meta.RenameCollection(ctx, "db2", "test", "alias", 100)
+		assert.Error(t, err)
+		meta.collName2ID["db2"]["test2"] = 102
+		err = meta.RenameCollection(ctx, "db2", "test", "test2", 100)
+		assert.Error(t, err)
+	})
+
+	t.Run("collection not exist", func(t *testing.T) {
+		meta := newTestMetaTableWithDB(nil)
+		err := meta.RenameCollection(ctx, "db2", "test3", "test2", 100)
+		assert.True(t, common.IsCollectionNotExistError(err))
+	})
+
+	t.Run("alter metastore fail", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterCollection",
+			mock.Anything, // context.Context
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(errors.New("error mock AlterCollection"))
+		meta := newTestMetaTableWithDB(catalog)
+		err := meta.RenameCollection(ctx, "db2", "test", "test2", 100)
+		assert.Error(t, err)
+		assert.Equal(t, UniqueID(101), meta.collName2ID["db2"]["test"])
+	})
+
+	t.Run("rename collection ok", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterCollection",
+			mock.Anything, // context.Context
+			mock.MatchedBy(func(coll *model.Collection) bool { return coll.Name == "test" }),
+			mock.MatchedBy(func(coll *model.Collection) bool { return coll.Name == "test2" && coll.CollectionID == 101 }),
+			metastore.MODIFY,
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+		meta := newTestMetaTableWithDB(catalog)
+		err := meta.RenameCollection(ctx, "db2", "test", "test2", 100)
+		assert.NoError(t, err)
+		_, ok := meta.collName2ID["db2"]["test"]
+		assert.False(t, ok)
+		assert.Equal(t, UniqueID(101), meta.collName2ID["db2"]["test2"])
+		assert.Equal(t, "test2", meta.collID2Meta[101].Name)
+		assert.Equal(t, UniqueID(100), meta.collName2ID[util.DefaultDBName]["test"])
+	})
+}
+
 func Test_filterUnavailable(t *testing.T) {
 	coll := &model.Collection{}
 	nPartition := 10
@@ -729,79 +847,153 @@ func TestMetaTable_RemoveCollection(t *testing.T) {
 		).Return(nil)
 		meta := &MetaTable{
 			catalog: catalog,
-			collAlias2ID: map[string]typeutil.UniqueID{
-				"alias1": 100,
-				"alias2": 100,
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+			},
+			collAlias2ID: map[string]map[string]typeutil.UniqueID{
+				util.DefaultDBName: {"alias1": 100, "alias2": 100},
 			},
 			collID2Meta: map[typeutil.UniqueID]*model.Collection{
 				100: {Name: "collection"},
 			},
-			collName2ID: map[string]typeutil.UniqueID{
-				"collection": 100,
+			collName2ID: map[string]map[string]typeutil.UniqueID{
+				util.DefaultDBName: {"collection": 100},
 			},
 		}
 		ctx := context.Background()
 		err := meta.RemoveCollection(ctx, 100, 9999)
 		assert.NoError(t, err)
+		assert.Empty(t, meta.collName2ID[util.DefaultDBName])
+		assert.Empty(t, meta.collAlias2ID[util.DefaultDBName])
 	})
 }
 
 func TestMetaTable_reload(t *testing.T) {
+	t.Run("failed to list databases", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("ListDatabases",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("uint64"),
+		).Return(nil, errors.New("error mock ListDatabases"))
+		meta := &MetaTable{catalog: catalog}
+		err := meta.reload()
+		assert.Error(t, err)
+		assert.Empty(t, meta.dbName2Meta)
+	})
+
+	t.Run("failed to create default database", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("ListDatabases",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("uint64"),
+		).Return([]*model.Database{}, nil)
+		catalog.On("CreateDatabase",
+			mock.Anything, // context.Context
+			mock.Anything, // *model.Database
+			mock.AnythingOfType("uint64"),
+		).Return(errors.New("error mock CreateDatabase"))
+		meta := &MetaTable{catalog: catalog}
+		err := meta.reload()
+		assert.Error(t, err)
+	})
+
 	t.Run("failed to list collections", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("ListDatabases",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("uint64"),
+		).Return([]*model.Database{model.NewDefaultDatabase()}, nil)
 		catalog.On("ListCollections",
 			mock.Anything, // context.Context
+			mock.AnythingOfType("int64"),
 			mock.AnythingOfType("uint64"),
 		).Return(nil, errors.New("error mock ListCollections"))
 		meta := &MetaTable{catalog: catalog}
 		err := meta.reload()
 		assert.Error(t, err)
 		assert.Empty(t, meta.collID2Meta)
-		assert.Empty(t, meta.collName2ID)
-		assert.Empty(t, meta.collAlias2ID)
+		assert.Empty(t, meta.collName2ID[util.DefaultDBName])
+		assert.Empty(t, meta.collAlias2ID[util.DefaultDBName])
 	})
 
 	t.Run("failed to list aliases", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("ListDatabases",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("uint64"),
+		).Return([]*model.Database{model.NewDefaultDatabase()}, nil)
 		catalog.On("ListCollections",
 			mock.Anything, // context.Context
+			mock.AnythingOfType("int64"),
 			mock.AnythingOfType("uint64"),
 		).Return(
 			map[string]*model.Collection{"test": {CollectionID: 100, Name: "test"}},
 			nil)
 		catalog.On("ListAliases",
 			mock.Anything, // context.Context
+			mock.AnythingOfType("int64"),
 			mock.AnythingOfType("uint64"),
 		).Return(nil, errors.New("error mock ListAliases"))
 		meta := &MetaTable{catalog: catalog}
 		err := meta.reload()
 		assert.Error(t, err)
-		assert.Empty(t, meta.collAlias2ID)
+		assert.Empty(t, meta.collAlias2ID[util.DefaultDBName])
 	})
 
 	t.Run("normal case", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
 
+		catalog.On("ListDatabases",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("uint64"),
+		).Return([]*model.Database{{ID: 2, Name: "db2"}}, nil)
+
+		// the default database is missing in catalog, it should be created.
+		catalog.On("CreateDatabase",
+			mock.Anything, // context.Context
+			mock.Anything, // *model.Database
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+
 		catalog.On("ListCollections",
 			mock.Anything, // context.Context
+			util.DefaultDBID,
 			mock.AnythingOfType("uint64"),
 		).Return(
 			map[string]*model.Collection{"test": {CollectionID: 100, Name: "test"}},
 			nil)
+		catalog.On("ListCollections",
+			mock.Anything, // context.Context
+			int64(2),
+			mock.AnythingOfType("uint64"),
+		).Return(
+			map[string]*model.Collection{"test": {CollectionID: 101, Name: "test", DBID: 2}},
+			nil)
 
 		catalog.On("ListAliases",
 			mock.Anything, // context.Context
+			util.DefaultDBID,
 			mock.AnythingOfType("uint64"),
 		).Return(
 			[]*model.Alias{{Name: "alias", CollectionID: 100}},
 			nil)
+		catalog.On("ListAliases",
+			mock.Anything, // context.Context
+			int64(2),
+			mock.AnythingOfType("uint64"),
+		).Return(
+			[]*model.Alias{},
+			nil)
 
 		meta := &MetaTable{catalog: catalog}
 		err := meta.reload()
 		assert.NoError(t, err)
-		assert.Equal(t, 1, len(meta.collID2Meta))
-		assert.Equal(t, 1, len(meta.collName2ID))
-		assert.Equal(t, 1, len(meta.collAlias2ID))
+		assert.Equal(t, 2, len(meta.dbName2Meta))
+		assert.Equal(t, 2, len(meta.collID2Meta))
+		assert.Equal(t, UniqueID(100), meta.collName2ID[util.DefaultDBName]["test"])
+		assert.Equal(t, UniqueID(101), meta.collName2ID["db2"]["test"])
+		assert.Equal(t, 1, len(meta.collAlias2ID[util.DefaultDBName]))
+		assert.Equal(t, 0, len(meta.collAlias2ID["db2"]))
 	})
 }
 
@@ -975,3 +1167,214 @@ func TestMetaTable_ChangePartitionState(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func newTestMetaTableWithDB(catalog *mocks.RootCoordCatalog) *MetaTable {
+	return &MetaTable{
+		catalog: catalog,
+		dbName2Meta: map[string]*model.Database{
+			util.DefaultDBName: model.NewDefaultDatabase(),
+			"db2":              {ID: 2, Name: "db2"},
+		},
+		collID2Meta: map[typeutil.UniqueID]*model.Collection{
+			100: {CollectionID: 100, Name: "test", State: pb.CollectionState_CollectionCreated},
+			101: {CollectionID: 101, Name: "test", DBID: 2, State: pb.CollectionState_CollectionCreated},
+		},
+		collName2ID: map[string]map[string]typeutil.UniqueID{
+			util.DefaultDBName: {"test": 100},
+			"db2":              {"test": 101},
+		},
+		collAlias2ID: map[string]map[string]typeutil.UniqueID{
+			util.DefaultDBName: {},
+			"db2":              {},
+		},
+	}
+}
+
+func TestMetaTable_Database(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("create existed database", func(t *testing.T) {
+		meta := newTestMetaTableWithDB(nil)
+		err := meta.CreateDatabase(ctx, &model.Database{ID: 3, Name: "db2"}, 100)
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to create database", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("CreateDatabase",
+			mock.Anything, // context.Context
+			mock.Anything, // *model.Database
+			mock.AnythingOfType("uint64"),
+		).Return(errors.New("error mock CreateDatabase"))
+		meta := newTestMetaTableWithDB(catalog)
+		err := meta.CreateDatabase(ctx, &model.Database{ID: 3, Name: "db3"}, 100)
+		assert.Error(t, err)
+		_, err = meta.GetDatabaseByName(ctx, "db3", typeutil.MaxTimestamp)
+		assert.Error(t, err)
+	})
+
+	t.Run("create and list databases", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("CreateDatabase",
+			mock.Anything, // context.Context
+			mock.Anything, // *model.Database
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+		meta := newTestMetaTableWithDB(catalog)
+		err := meta.CreateDatabase(ctx, &model.Database{ID: 3, Name: "db3", CreatedTime: 100}, 100)
+		assert.NoError(t, err)
+
+		db, err := meta.GetDatabaseByName(ctx, "db3", typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, UniqueID(3), db.ID)
+
+		db, err = meta.GetDatabaseByName(ctx, "", typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, util.DefaultDBID, db.ID)
+
+		dbs, err := meta.ListDatabases(ctx, typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(dbs))
+		assert.Equal(t, util.DefaultDBName, dbs[0].Name)
+		assert.Equal(t, "db3", dbs[2].Name)
+
+		colls, err := meta.ListCollections(ctx, "db3", typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Empty(t, colls)
+	})
+
+	t.Run("drop default database", func(t *testing.T) {
+		meta := newTestMetaTableWithDB(nil)
+		err := meta.DropDatabase(ctx, util.DefaultDBName, 100)
+		assert.Error(t, err)
+	})
+
+	t.Run("drop non-existent database", func(t *testing.T) {
+		meta := newTestMetaTableWithDB(nil)
+		err := meta.DropDatabase(ctx, "not_exist", 100)
+		assert.NoError(t, err)
+	})
+
+	t.Run("drop non-empty database", func(t *testing.T) {
+		meta := newTestMetaTableWithDB(nil)
+		err := meta.DropDatabase(ctx, "db2", 100)
+		assert.Error(t, err)
+	})
+
+	t.Run("drop database", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("DropDatabase",
+			mock.Anything, // context.Context
+			int64(2),
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+		meta := newTestMetaTableWithDB(catalog)
+		delete(meta.collName2ID["db2"], "test")
+		err := meta.DropDatabase(ctx, "db2", 100)
+		assert.NoError(t, err)
+		_, err = meta.GetDatabaseByName(ctx, "db2", typeutil.MaxTimestamp)
+		assert.Error(t, err)
+		_, err = meta.ListCollections(ctx, "db2", typeutil.MaxTimestamp)
+		assert.Error(t, err)
+	})
+}
+
+func TestMetaTable_CollectionWithDB(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("same name in different databases", func(t *testing.T) {
+		meta := newTestMetaTableWithDB(nil)
+		coll, err := meta.GetCollectionByName(ctx, "", "test", typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, UniqueID(100), coll.CollectionID)
+		coll, err = meta.GetCollectionByName(ctx, "db2", "test", typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, UniqueID(101), coll.CollectionID)
+
+		colls, err := meta.ListCollections(ctx, "db2", typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(colls))
+		assert.Equal(t, UniqueID(101), colls[0].CollectionID)
+	})
+
+	t.Run("add collection to non-existent database", func(t *testing.T) {
+		meta := newTestMetaTableWithDB(nil)
+		err := meta.AddCollection(ctx, &model.Collection{CollectionID: 102, Name: "test", DBID: 3, State: pb.CollectionState_CollectionCreating})
+		assert.Error(t, err)
+	})
+
+	t.Run("add collection", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("CreateCollection",
+			mock.Anything, // context.Context
+			mock.Anything, // *model.Collection
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+		meta := newTestMetaTableWithDB(catalog)
+		err := meta.AddCollection(ctx, &model.Collection{CollectionID: 102, Name: "test2", DBID: 2, State: pb.CollectionState_CollectionCreating})
+		assert.NoError(t, err)
+		assert.Equal(t, UniqueID(102), meta.collName2ID["db2"]["test2"])
+		_, ok := meta.collName2ID[util.DefaultDBName]["test2"]
+		assert.False(t, ok)
+	})
+
+	t.Run("remove collection", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("DropCollection",
+			mock.Anything, // context.Context
+			mock.MatchedBy(func(coll *model.Collection) bool { return coll.DBID == 2 }),
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+		meta := newTestMetaTableWithDB(catalog)
+		err := meta.RemoveCollection(ctx, 101, 100)
+		assert.NoError(t, err)
+		assert.Empty(t, meta.collName2ID["db2"])
+		assert.Equal(t, UniqueID(100), meta.collName2ID[util.DefaultDBName]["test"])
+	})
+}
+
+func TestMetaTable_AliasWithDB(t *testing.T) {
+	ctx := context.Background()
+	catalog := mocks.NewRootCoordCatalog(t)
+	catalog.On("CreateAlias",
+		mock.Anything, // context.Context
+		mock.MatchedBy(func(alias *model.Alias) bool { return alias.DBID == 2 }),
+		mock.AnythingOfType("uint64"),
+	).Return(nil)
+	catalog.On("AlterAlias",
+		mock.Anything, // context.Context
+		mock.MatchedBy(func(alias *model.Alias) bool { return alias.DBID == 2 }),
+		mock.AnythingOfType("uint64"),
+	).Return(nil)
+	catalog.On("DropAlias",
+		mock.Anything, // context.Context
+		int64(2),
+		"alias",
+		mock.AnythingOfType("uint64"),
+	).Return(nil)
+	meta := newTestMetaTableWithDB(catalog)
+
+	err := meta.CreateAlias(ctx, "not_exist", "alias", "test", 100)
+	assert.Error(t, err)
+
+	err = meta.CreateAlias(ctx, "db2", "alias", "test", 100)
+	assert.NoError(t, err)
+	assert.True(t, meta.IsAlias("db2", "alias"))
+	assert.False(t, meta.IsAlias("", "alias"))
+
+	coll, err := meta.GetCollectionByName(ctx, "db2", "alias", typeutil.MaxTimestamp)
+	assert.NoError(t, err)
+	assert.Equal(t, UniqueID(101), coll.CollectionID)
+	_, err = meta.GetCollectionByName(ctx, "", "alias", typeutil.MaxTimestamp)
+	assert.Error(t, err)
+
+	// collections in other databases are invisible.
+	err = meta.AlterAlias(ctx, "db2", "alias", "not_exist", 101)
+	assert.Error(t, err)
+	err = meta.AlterAlias(ctx, "db2", "alias", "test", 101)
+	assert.NoError(t, err)
+
+	err = meta.DropAlias(ctx, "db2", "alias", 102)
+	assert.NoError(t, err)
+	assert.False(t, meta.IsAlias("db2", "alias"))
+}
@@ -35,36 +35,57 @@ const (
 
 type mockMetaTable struct {
 	IMetaTable
-	ListCollectionsFunc              func(ctx context.Context, ts Timestamp) ([]*model.Collection, error)
+	CreateDatabaseFunc               func(ctx context.Context, db *model.Database, ts Timestamp) error
+	DropDatabaseFunc                 func(ctx context.Context, dbName string, ts Timestamp) error
+	ListDatabasesFunc                func(ctx context.Context, ts Timestamp) ([]*model.Database, error)
+	GetDatabaseByNameFunc            func(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error)
+	ListCollectionsFunc              func(ctx context.Context, dbName string, ts Timestamp) ([]*model.Collection, error)
 	AddCollectionFunc                func(ctx context.Context, coll *model.Collection) error
-	GetCollectionByNameFunc          func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error)
+	GetCollectionByNameFunc          func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error)
 	GetCollectionByIDFunc            func(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error)
 	ChangeCollectionStateFunc        func(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error
 	RemoveCollectionFunc             func(ctx context.Context, collectionID UniqueID, ts Timestamp) error
 	AddPartitionFunc                 func(ctx context.Context, partition *model.Partition) error
 	ChangePartitionStateFunc         func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state pb.PartitionState, ts Timestamp) error
 	RemovePartitionFunc              func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, ts Timestamp) error
-	CreateAliasFunc                  func(ctx context.Context, alias string, collectionName string, ts Timestamp) error
-	AlterAliasFunc                   func(ctx context.Context, alias string, collectionName string, ts Timestamp) error
-	DropAliasFunc                    func(ctx context.Context, alias string, ts Timestamp) error
-	IsAliasFunc                      func(name string) bool
+	CreateAliasFunc                  func(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
+	AlterAliasFunc                   func(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
+	DropAliasFunc                    func(ctx context.Context, dbName string, alias string, ts Timestamp) error
+	IsAliasFunc                      func(dbName string, name string) bool
 	ListAliasesByIDFunc              func(collID UniqueID) []string
-	GetCollectionIDByNameFunc        func(name string) (UniqueID, error)
 	GetPartitionByNameFunc           func(collID UniqueID, partitionName string, ts Timestamp) (UniqueID, error)
 	GetCollectionVirtualChannelsFunc func(colID int64) []string
 	AlterCollectionFunc              func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error
+	RenameCollectionFunc             func(ctx context.Context, dbName string, oldName string, newName string, ts Timestamp) error
+	SelectRoleFunc                   func(tenant string, entity *milvuspb.RoleEntity, includeUserInfo bool) ([]*milvuspb.RoleResult, error)
 }
 
-func (m mockMetaTable) ListCollections(ctx context.Context, ts Timestamp) ([]*model.Collection, error) {
-	return m.ListCollectionsFunc(ctx, ts)
+func (m mockMetaTable) CreateDatabase(ctx context.Context, db *model.Database, ts Timestamp) error {
+	return m.CreateDatabaseFunc(ctx, db, ts)
+}
+
+func (m mockMetaTable) DropDatabase(ctx context.Context, dbName string, ts Timestamp) error {
+	return m.DropDatabaseFunc(ctx, dbName, ts)
+}
+
+func (m mockMetaTable) ListDatabases(ctx context.Context, ts Timestamp) ([]*model.Database, error) {
+	return m.ListDatabasesFunc(ctx, ts)
+}
+
+func (m mockMetaTable) GetDatabaseByName(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error) {
+	return m.GetDatabaseByNameFunc(ctx, dbName, ts)
+}
+
+func (m mockMetaTable) ListCollections(ctx context.Context, dbName string, ts Timestamp) ([]*model.Collection, error) {
+	return m.ListCollectionsFunc(ctx, dbName, ts)
 }
 
 func (m mockMetaTable) AddCollection(ctx context.Context, coll *model.Collection) error {
 	return m.AddCollectionFunc(ctx, coll)
 }
 
-func (m mockMetaTable) GetCollectionByName(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
-	return m.GetCollectionByNameFunc(ctx, collectionName, ts)
+func (m mockMetaTable) GetCollectionByName(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+	return m.GetCollectionByNameFunc(ctx, dbName, collectionName, ts)
 }
 
 func (m mockMetaTable) GetCollectionByID(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error) {
@@ -91,20 +112,20 @@ func (m mockMetaTable) RemovePartition(ctx context.Context, collectionID UniqueI
 	return m.RemovePartitionFunc(ctx, collectionID, partitionID, ts)
 }
 
-func (m mockMetaTable) CreateAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
-	return m.CreateAliasFunc(ctx, alias, collectionName, ts)
+func (m mockMetaTable) CreateAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
+	return m.CreateAliasFunc(ctx, dbName, alias, collectionName, ts)
 }
 
-func (m mockMetaTable) AlterAlias(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
-	return m.AlterAliasFunc(ctx, alias, collectionName, ts)
+func (m mockMetaTable) AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
+	return m.AlterAliasFunc(ctx, dbName, alias, collectionName, ts)
 }
 
-func (m mockMetaTable) DropAlias(ctx context.Context, alias string, ts Timestamp) error {
-	return m.DropAliasFunc(ctx, alias, ts)
+func (m mockMetaTable) DropAlias(ctx context.Context, dbName string, alias string, ts Timestamp) error {
+	return m.DropAliasFunc(ctx, dbName, alias, ts)
 }
 
-func (m mockMetaTable) IsAlias(name string) bool {
-	return m.IsAliasFunc(name)
+func (m mockMetaTable) IsAlias(dbName string, name string) bool {
+	return m.IsAliasFunc(dbName, name)
 }
 
 func (m mockMetaTable) ListAliasesByID(collID UniqueID) []string {
@@ -115,8 +136,12 @@ func (m mockMetaTable) AlterCollection(ctx context.Context, oldColl *model.Colle
 	return m.AlterCollectionFunc(ctx, oldColl, newColl, ts)
 }
 
-func (m mockMetaTable) GetCollectionIDByName(name string) (UniqueID, error) {
-	return m.GetCollectionIDByNameFunc(name)
+func (m mockMetaTable) RenameCollection(ctx context.Context, dbName string, oldName string, newName string, ts Timestamp) error {
+	return m.RenameCollectionFunc(ctx, dbName, oldName, newName, ts)
+}
+
+func (m mockMetaTable) SelectRole(tenant string, entity *milvuspb.RoleEntity, includeUserInfo bool) ([]*milvuspb.RoleResult, error) {
+	return m.SelectRoleFunc(tenant, entity, includeUserInfo)
 }
 
 func (m mockMetaTable) GetPartitionByName(collID UniqueID, partitionName string, ts Timestamp) (UniqueID, error) {
@@ -164,6 +189,10 @@ type mockDataCoord struct {
 	ImportFunc                     func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error)
 	UnsetIsImportingStateFunc      func(ctx context.Context, req *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error)
 	broadCastAlteredCollectionFunc func(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error)
+	GetFlushStateFunc              func(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
+	GetFlushedSegmentsFunc         func(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)
+	GetSegmentInfoFunc             func(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)
+	MarkSegmentsDroppedFunc        func(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)
 }
 
 func newMockDataCoord() *mockDataCoord {
@@ -174,6 +203,22 @@ func (m *mockDataCoord) GetComponentStates(ctx context.Context) (*milvuspb.Compo
 	return m.GetComponentStatesFunc(ctx)
 }
 
+func (m *mockDataCoord) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	return m.GetFlushStateFunc(ctx, req)
+}
+
+func (m *mockDataCoord) GetFlushedSegments(ctx context.Context, req *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+	return m.GetFlushedSegmentsFunc(ctx, req)
+}
+
+func (m *mockDataCoord) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	return m.GetSegmentInfoFunc(ctx, req)
+}
+
+func (m *mockDataCoord) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
+	return m.MarkSegmentsDroppedFunc(ctx, req)
+}
+
 func (m *mockDataCoord) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return m.WatchChannelsFunc(ctx, req)
 }
@@ -355,10 +400,22 @@ func withMeta(meta IMetaTable) Opt {
 
 func withInvalidMeta() Opt {
 	meta := newMockMetaTable()
-	meta.ListCollectionsFunc = func(ctx context.Context, ts Timestamp) ([]*model.Collection, error) {
+	meta.CreateDatabaseFunc = func(ctx context.Context, db *model.Database, ts Timestamp) error {
+		return errors.New("error mock CreateDatabase")
+	}
+	meta.DropDatabaseFunc = func(ctx context.Context, dbName string, ts Timestamp) error {
+		return errors.New("error mock DropDatabase")
+	}
+	meta.ListDatabasesFunc = func(ctx context.Context, ts Timestamp) ([]*model.Database, error) {
+		return nil, errors.New("error mock ListDatabases")
+	}
+	meta.GetDatabaseByNameFunc = func(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error) {
+		return nil, errors.New("error mock GetDatabaseByName")
+	}
+	meta.ListCollectionsFunc = func(ctx context.Context, dbName string, ts Timestamp) ([]*model.Collection, error) {
 		return nil, errors.New("error mock ListCollections")
 	}
-	meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+	meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 		return nil, errors.New("error mock GetCollectionByName")
 	}
 	meta.GetCollectionByIDFunc = func(ctx context.Context, collectionID typeutil.UniqueID, ts Timestamp) (*model.Collection, error) {
@@ -370,15 +427,18 @@ func withInvalidMeta() Opt {
 	meta.ChangePartitionStateFunc = func(ctx context.Context, collectionID UniqueID, partitionID UniqueID, state pb.PartitionState, ts Timestamp) error {
 		return errors.New("error mock ChangePartitionState")
 	}
-	meta.CreateAliasFunc = func(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
+	meta.CreateAliasFunc = func(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
 		return errors.New("error mock CreateAlias")
 	}
-	meta.AlterAliasFunc = func(ctx context.Context, alias string, collectionName string, ts Timestamp) error {
+	meta.AlterAliasFunc = func(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error {
 		return errors.New("error mock AlterAlias")
 	}
-	meta.DropAliasFunc = func(ctx context.Context, alias string, ts Timestamp) error {
+	meta.DropAliasFunc = func(ctx context.Context, dbName string, alias string, ts Timestamp) error {
 		return errors.New("error mock DropAlias")
 	}
+	meta.RenameCollectionFunc = func(ctx context.Context, dbName string, oldName string, newName string, ts Timestamp) error {
+		return errors.New("error mock RenameCollection")
+	}
 	return withMeta(meta)
 }
 
@@ -828,6 +888,10 @@ type mockBroker struct {
 	GetSegmentIndexStateFunc func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
 
 	BroadcastAlteredCollectionFunc func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
+	DropCollectionSegmentsFunc     func(ctx context.Context, collID UniqueID, ts Timestamp) error
+	CloneSegmentsFunc              func(ctx context.Context, req *datapb.CloneSegmentsRequest) error
+	WaitSegmentsFlushedFunc        func(ctx context.Context, collID UniqueID) error
+	GetFlushedSegmentsFunc         func(ctx context.Context, collID UniqueID, partIDs []UniqueID) ([]*datapb.SegmentInfo, error)
 }
 
 func newMockBroker() *mockBroker {
@@ -862,6 +926,30 @@ func (b mockBroker) BroadcastAlteredCollection(ctx context.Context, req *milvusp
 	return b.BroadcastAlteredCollectionFunc(ctx, req)
 }
 
+func (b mockBroker) DropCollectionSegments(ctx context.Context, collID UniqueID, ts Timestamp) error {
+	return b.DropCollectionSegmentsFunc(ctx, collID, ts)
+}
+
+func (b mockBroker) CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) error {
+	return b.CloneSegmentsFunc(ctx, req)
+}
+
+func (b mockBroker) WaitSegmentsFlushed(ctx context.Context, collID UniqueID) error {
+	return b.WaitSegmentsFlushedFunc(ctx, collID)
+}
+
+func (b mockBroker) GetFlushedSegments(ctx context.Context, collID UniqueID, partIDs []UniqueID) ([]*datapb.SegmentInfo, error) {
+	return b.GetFlushedSegmentsFunc(ctx, collID, partIDs)
+}
+
+func (b mockBroker) AddSegRefLock(ctx context.Context, taskID int64, segIDs []int64) error {
+	return b.AddSegRefLockFunc(ctx, taskID, segIDs)
+}
+
+func (b mockBroker) ReleaseSegRefLock(ctx context.Context, taskID int64, segIDs []int64) error {
+	return b.ReleaseSegRefLockFunc(ctx, taskID, segIDs)
+}
+
 func withBroker(b Broker) Opt {
 	return func(c *Core) {
 		c.broker = b
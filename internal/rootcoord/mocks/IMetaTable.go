@@ -20,6 +20,20 @@ type IMetaTable struct {
 	mock.Mock
 }
 
+// AddApiKey provides a mock function with given fields: apiKey
+func (_m *IMetaTable) AddApiKey(apiKey *model.ApiKey) error {
+	ret := _m.Called(apiKey)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.ApiKey) error); ok {
+		r0 = rf(apiKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddCollection provides a mock function with given fields: ctx, coll
 func (_m *IMetaTable) AddCollection(ctx context.Context, coll *model.Collection) error {
 	ret := _m.Called(ctx, coll)
@@ -62,13 +76,13 @@ func (_m *IMetaTable) AddPartition(ctx context.Context, partition *model.Partiti
 	return r0
 }
 
-// AlterAlias provides a mock function with given fields: ctx, alias, collectionName, ts
-func (_m *IMetaTable) AlterAlias(ctx context.Context, alias string, collectionName string, ts uint64) error {
-	ret := _m.Called(ctx, alias, collectionName, ts)
+// AlterAlias provides a mock function with given fields: ctx, dbName, alias, collectionName, ts
+func (_m *IMetaTable) AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts uint64) error {
+	ret := _m.Called(ctx, dbName, alias, collectionName, ts)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint64) error); ok {
-		r0 = rf(ctx, alias, collectionName, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint64) error); ok {
+		r0 = rf(ctx, dbName, alias, collectionName, ts)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -132,13 +146,27 @@ func (_m *IMetaTable) ChangePartitionState(ctx context.Context, collectionID int
 	return r0
 }
 
-// CreateAlias provides a mock function with given fields: ctx, alias, collectionName, ts
-func (_m *IMetaTable) CreateAlias(ctx context.Context, alias string, collectionName string, ts uint64) error {
-	ret := _m.Called(ctx, alias, collectionName, ts)
+// CreateAlias provides a mock function with given fields: ctx, dbName, alias, collectionName, ts
+func (_m *IMetaTable) CreateAlias(ctx context.Context, dbName string, alias string, collectionName string, ts uint64) error {
+	ret := _m.Called(ctx, dbName, alias, collectionName, ts)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint64) error); ok {
-		r0 = rf(ctx, alias, collectionName, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint64) error); ok {
+		r0 = rf(ctx, dbName, alias, collectionName, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateDatabase provides a mock function with given fields: ctx, db, ts
+func (_m *IMetaTable) CreateDatabase(ctx context.Context, db *model.Database, ts uint64) error {
+	ret := _m.Called(ctx, db, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Database, uint64) error); ok {
+		r0 = rf(ctx, db, ts)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -160,6 +188,20 @@ func (_m *IMetaTable) CreateRole(tenant string, entity *milvuspb.RoleEntity) err
 	return r0
 }
 
+// DeleteApiKey provides a mock function with given fields: keyID
+func (_m *IMetaTable) DeleteApiKey(keyID string) error {
+	ret := _m.Called(keyID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(keyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteCredential provides a mock function with given fields: username
 func (_m *IMetaTable) DeleteCredential(username string) error {
 	ret := _m.Called(username)
@@ -174,13 +216,27 @@ func (_m *IMetaTable) DeleteCredential(username string) error {
 	return r0
 }
 
-// DropAlias provides a mock function with given fields: ctx, alias, ts
-func (_m *IMetaTable) DropAlias(ctx context.Context, alias string, ts uint64) error {
-	ret := _m.Called(ctx, alias, ts)
+// DropAlias provides a mock function with given fields: ctx, dbName, alias, ts
+func (_m *IMetaTable) DropAlias(ctx context.Context, dbName string, alias string, ts uint64) error {
+	ret := _m.Called(ctx, dbName, alias, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint64) error); ok {
+		r0 = rf(ctx, dbName, alias, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DropDatabase provides a mock function with given fields: ctx, dbName, ts
+func (_m *IMetaTable) DropDatabase(ctx context.Context, dbName string, ts uint64) error {
+	ret := _m.Called(ctx, dbName, ts)
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) error); ok {
-		r0 = rf(ctx, alias, ts)
+		r0 = rf(ctx, dbName, ts)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -216,6 +272,29 @@ func (_m *IMetaTable) DropRole(tenant string, roleName string) error {
 	return r0
 }
 
+// GetApiKey provides a mock function with given fields: keyID
+func (_m *IMetaTable) GetApiKey(keyID string) (*model.ApiKey, error) {
+	ret := _m.Called(keyID)
+
+	var r0 *model.ApiKey
+	if rf, ok := ret.Get(0).(func(string) *model.ApiKey); ok {
+		r0 = rf(keyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApiKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(keyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCollectionByID provides a mock function with given fields: ctx, collectionID, ts
 func (_m *IMetaTable) GetCollectionByID(ctx context.Context, collectionID int64, ts uint64) (*model.Collection, error) {
 	ret := _m.Called(ctx, collectionID, ts)
@@ -239,13 +318,13 @@ func (_m *IMetaTable) GetCollectionByID(ctx context.Context, collectionID int64,
 	return r0, r1
 }
 
-// GetCollectionByName provides a mock function with given fields: ctx, collectionName, ts
-func (_m *IMetaTable) GetCollectionByName(ctx context.Context, collectionName string, ts uint64) (*model.Collection, error) {
-	ret := _m.Called(ctx, collectionName, ts)
+// GetCollectionByName provides a mock function with given fields: ctx, dbName, collectionName, ts
+func (_m *IMetaTable) GetCollectionByName(ctx context.Context, dbName string, collectionName string, ts uint64) (*model.Collection, error) {
+	ret := _m.Called(ctx, dbName, collectionName, ts)
 
 	var r0 *model.Collection
-	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) *model.Collection); ok {
-		r0 = rf(ctx, collectionName, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint64) *model.Collection); ok {
+		r0 = rf(ctx, dbName, collectionName, ts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Collection)
@@ -253,8 +332,8 @@ func (_m *IMetaTable) GetCollectionByName(ctx context.Context, collectionName st
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, uint64) error); ok {
-		r1 = rf(ctx, collectionName, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint64) error); ok {
+		r1 = rf(ctx, dbName, collectionName, ts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -301,6 +380,29 @@ func (_m *IMetaTable) GetCredential(username string) (*internalpb.CredentialInfo
 	return r0, r1
 }
 
+// GetDatabaseByName provides a mock function with given fields: ctx, dbName, ts
+func (_m *IMetaTable) GetDatabaseByName(ctx context.Context, dbName string, ts uint64) (*model.Database, error) {
+	ret := _m.Called(ctx, dbName, ts)
+
+	var r0 *model.Database
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) *model.Database); ok {
+		r0 = rf(ctx, dbName, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Database)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64) error); ok {
+		r1 = rf(ctx, dbName, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPartitionByName provides a mock function with given fields: collID, partitionName, ts
 func (_m *IMetaTable) GetPartitionByName(collID int64, partitionName string, ts uint64) (int64, error) {
 	ret := _m.Called(collID, partitionName, ts)
@@ -343,13 +445,13 @@ func (_m *IMetaTable) GetPartitionNameByID(collID int64, partitionID int64, ts u
 	return r0, r1
 }
 
-// IsAlias provides a mock function with given fields: name
-func (_m *IMetaTable) IsAlias(name string) bool {
-	ret := _m.Called(name)
+// IsAlias provides a mock function with given fields: dbName, name
+func (_m *IMetaTable) IsAlias(dbName string, name string) bool {
+	ret := _m.Called(dbName, name)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(string) bool); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(dbName, name)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
@@ -396,6 +498,29 @@ func (_m *IMetaTable) ListAliasesByID(collID int64) []string {
 	return r0
 }
 
+// ListApiKeys provides a mock function with given fields: username
+func (_m *IMetaTable) ListApiKeys(username string) ([]*model.ApiKey, error) {
+	ret := _m.Called(username)
+
+	var r0 []*model.ApiKey
+	if rf, ok := ret.Get(0).(func(string) []*model.ApiKey); ok {
+		r0 = rf(username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ApiKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListCollectionPhysicalChannels provides a mock function with given fields:
 func (_m *IMetaTable) ListCollectionPhysicalChannels() map[int64][]string {
 	ret := _m.Called()
@@ -412,13 +537,13 @@ func (_m *IMetaTable) ListCollectionPhysicalChannels() map[int64][]string {
 	return r0
 }
 
-// ListCollections provides a mock function with given fields: ctx, ts
-func (_m *IMetaTable) ListCollections(ctx context.Context, ts uint64) ([]*model.Collection, error) {
-	ret := _m.Called(ctx, ts)
+// ListCollections provides a mock function with given fields: ctx, dbName, ts
+func (_m *IMetaTable) ListCollections(ctx context.Context, dbName string, ts uint64) ([]*model.Collection, error) {
+	ret := _m.Called(ctx, dbName, ts)
 
 	var r0 []*model.Collection
-	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*model.Collection); ok {
-		r0 = rf(ctx, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) []*model.Collection); ok {
+		r0 = rf(ctx, dbName, ts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.Collection)
@@ -426,8 +551,8 @@ func (_m *IMetaTable) ListCollections(ctx context.Context, ts uint64) ([]*model.
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
-		r1 = rf(ctx, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64) error); ok {
+		r1 = rf(ctx, dbName, ts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -458,6 +583,29 @@ func (_m *IMetaTable) ListCredentialUsernames() (*milvuspb.ListCredUsersResponse
 	return r0, r1
 }
 
+// ListDatabases provides a mock function with given fields: ctx, ts
+func (_m *IMetaTable) ListDatabases(ctx context.Context, ts uint64) ([]*model.Database, error) {
+	ret := _m.Called(ctx, ts)
+
+	var r0 []*model.Database
+	if rf, ok := ret.Get(0).(func(context.Context, uint64) []*model.Database); ok {
+		r0 = rf(ctx, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Database)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64) error); ok {
+		r1 = rf(ctx, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListPolicy provides a mock function with given fields: tenant
 func (_m *IMetaTable) ListPolicy(tenant string) ([]string, error) {
 	ret := _m.Called(tenant)
@@ -560,6 +708,20 @@ func (_m *IMetaTable) RemovePartition(ctx context.Context, collectionID int64, p
 	return r0
 }
 
+// RenameCollection provides a mock function with given fields: ctx, dbName, oldName, newName, ts
+func (_m *IMetaTable) RenameCollection(ctx context.Context, dbName string, oldName string, newName string, ts uint64) error {
+	ret := _m.Called(ctx, dbName, oldName, newName, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint64) error); ok {
+		r0 = rf(ctx, dbName, oldName, newName, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SelectGrant provides a mock function with given fields: tenant, entity
 func (_m *IMetaTable) SelectGrant(tenant string, entity *milvuspb.GrantEntity) ([]*milvuspb.GrantEntity, error) {
 	ret := _m.Called(tenant, entity)
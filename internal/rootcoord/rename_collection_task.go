@@ -0,0 +1,186 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+)
+
+type renameCollectionTask struct {
+	baseTask
+	Req *rootcoordpb.RenameCollectionRequest
+}
+
+func (t *renameCollectionTask) Prepare(ctx context.Context) error {
+	if t.Req.GetOldName() == "" || t.Req.GetNewName() == "" {
+		return errors.New("the old and new collection name should not be empty")
+	}
+	if t.Req.GetOldName() == t.Req.GetNewName() {
+		return fmt.Errorf("the new collection name is the same as the old one: %s", t.Req.GetOldName())
+	}
+	if t.core.meta.IsAlias(t.Req.GetDbName(), t.Req.GetOldName()) {
+		return fmt.Errorf("cannot rename the collection via alias = %s", t.Req.GetOldName())
+	}
+	return nil
+}
+
+func (t *renameCollectionTask) Execute(ctx context.Context) error {
+	collMeta, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetOldName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	// the proxies may cache the collection by the aliases as well.
+	aliases := t.core.meta.ListAliasesByID(collMeta.CollectionID)
+	ts := t.GetTs()
+
+	undoTask := newBaseUndoTask(t.core.stepExecutor)
+	undoTask.AddStep(&renameCollectionMetaStep{
+		baseStep: baseStep{core: t.core},
+		dbName:   t.Req.GetDbName(),
+		oldName:  t.Req.GetOldName(),
+		newName:  t.Req.GetNewName(),
+		ts:       ts,
+	}, &renameCollectionMetaStep{
+		baseStep: baseStep{core: t.core},
+		dbName:   t.Req.GetDbName(),
+		oldName:  t.Req.GetNewName(),
+		newName:  t.Req.GetOldName(),
+		ts:       ts,
+	})
+	// the grants are moved before the caches are expired, so that the proxies see the renamed collection
+	// and its grants together.
+	undoTask.AddStep(&moveCollectionGrantsStep{
+		baseStep: baseStep{core: t.core},
+		dbName:   t.Req.GetDbName(),
+		oldName:  t.Req.GetOldName(),
+		newName:  t.Req.GetNewName(),
+	}, &moveCollectionGrantsStep{
+		baseStep: baseStep{core: t.core},
+		dbName:   t.Req.GetDbName(),
+		oldName:  t.Req.GetNewName(),
+		newName:  t.Req.GetOldName(),
+	})
+	undoTask.AddStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		dbName:          t.Req.GetDbName(),
+		collectionNames: append(aliases, t.Req.GetOldName(), t.Req.GetNewName()),
+		collectionID:    InvalidCollectionID,
+		ts:              ts,
+	}, &nullStep{})
+
+	return undoTask.Execute(ctx)
+}
+
+// renamedGrantObjectName returns the object name of the grant after the collection is renamed,
+// ok is false if the grant is not on the renamed collection.
+func renamedGrantObjectName(grant *milvuspb.GrantEntity, dbName string, oldName string, newName string) (string, bool) {
+	if grant.GetObject().GetName() != commonpb.ObjectType_Collection.String() {
+		return "", false
+	}
+	switch grant.GetObjectName() {
+	case funcutil.CombineObjectName(dbName, oldName):
+		return funcutil.CombineObjectName(dbName, newName), true
+	case oldName:
+		// the grants without database prefix belong to the default database.
+		if normalizeDBName(dbName) == util.DefaultDBName {
+			return newName, true
+		}
+	}
+	return "", false
+}
+
+// operateGrant grants or revokes the privilege, and refreshes the policy cache of proxies.
+func (c *Core) operateGrant(ctx context.Context, entity *milvuspb.GrantEntity, operateType milvuspb.OperatePrivilegeType) error {
+	if err := c.meta.OperatePrivilege(util.DefaultTenant, entity, operateType); err != nil {
+		if common.IsIgnorableError(err) {
+			return nil
+		}
+		return err
+	}
+	opType := int32(typeutil.CacheGrantPrivilege)
+	if operateType == milvuspb.OperatePrivilegeType_Revoke {
+		opType = int32(typeutil.CacheRevokePrivilege)
+	}
+	return c.proxyClientManager.RefreshPolicyInfoCache(ctx, &proxypb.RefreshPolicyInfoCacheRequest{
+		OpType: opType,
+		OpKey:  funcutil.PolicyForPrivilege(entity.Role.Name, entity.Object.Name, entity.ObjectName, entity.Grantor.Privilege.Name),
+	})
+}
+
+// moveCollectionGrants moves the privileges granted on the collection from the old name to the new name.
+// It's idempotent, the privileges already moved are skipped. If it fails, the privileges moved are moved back,
+// the undo steps of a task don't include the failed step itself.
+func (c *Core) moveCollectionGrants(ctx context.Context, dbName string, oldName string, newName string) error {
+	roles, err := c.meta.SelectRole(util.DefaultTenant, nil, false)
+	if err != nil {
+		return err
+	}
+	var operated []grantOperation
+	operate := func(entity *milvuspb.GrantEntity, operateType milvuspb.OperatePrivilegeType) error {
+		if err := c.operateGrant(ctx, entity, operateType); err != nil {
+			c.rollbackGrants(ctx, operated)
+			return err
+		}
+		operated = append(operated, grantOperation{entity: entity, operateType: operateType})
+		return nil
+	}
+	for _, role := range roles {
+		grants, err := c.meta.SelectGrant(util.DefaultTenant, &milvuspb.GrantEntity{Role: role.GetRole()})
+		if err != nil {
+			c.rollbackGrants(ctx, operated)
+			return err
+		}
+		for _, grant := range grants {
+			objectName, ok := renamedGrantObjectName(grant, dbName, oldName, newName)
+			if !ok {
+				continue
+			}
+			if !util.IsAnyWord(grant.Grantor.Privilege.Name) {
+				grant.Grantor.Privilege.Name = util.PrivilegeNameForMetastore(grant.Grantor.Privilege.Name)
+			}
+			newGrant := proto.Clone(grant).(*milvuspb.GrantEntity)
+			newGrant.ObjectName = objectName
+			if err := operate(newGrant, milvuspb.OperatePrivilegeType_Grant); err != nil {
+				return err
+			}
+			if err := operate(grant, milvuspb.OperatePrivilegeType_Revoke); err != nil {
+				return err
+			}
+			log.Info("move collection grant", zap.String("role", grant.Role.Name), zap.String("privilege", grant.Grantor.Privilege.Name),
+				zap.String("oldObjectName", grant.ObjectName), zap.String("newObjectName", objectName))
+		}
+	}
+	return nil
+}
+
+type grantOperation struct {
+	entity      *milvuspb.GrantEntity
+	operateType milvuspb.OperatePrivilegeType
+}
+
+// rollbackGrants reverts the grant operations in the reverse order, the failures are only logged
+// since the original error is returned to the caller.
+func (c *Core) rollbackGrants(ctx context.Context, operations []grantOperation) {
+	for i := len(operations) - 1; i >= 0; i-- {
+		reverseType := milvuspb.OperatePrivilegeType_Revoke
+		if operations[i].operateType == milvuspb.OperatePrivilegeType_Revoke {
+			reverseType = milvuspb.OperatePrivilegeType_Grant
+		}
+		if err := c.operateGrant(ctx, operations[i].entity, reverseType); err != nil {
+			log.Warn("failed to rollback collection grant", zap.String("role", operations[i].entity.GetRole().GetName()),
+				zap.String("objectName", operations[i].entity.GetObjectName()), zap.Error(err))
+		}
+	}
+}
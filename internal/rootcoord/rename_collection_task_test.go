@@ -0,0 +1,266 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_renameCollectionTask_Prepare(t *testing.T) {
+	t.Run("empty name", func(t *testing.T) {
+		task := &renameCollectionTask{
+			Req: &rootcoordpb.RenameCollectionRequest{OldName: "", NewName: "new"},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("same name", func(t *testing.T) {
+		task := &renameCollectionTask{
+			Req: &rootcoordpb.RenameCollectionRequest{OldName: "coll", NewName: "coll"},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("rename via alias", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.IsAliasFunc = func(dbName string, name string) bool {
+			return true
+		}
+		core := newTestCore(withMeta(meta))
+		task := &renameCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.RenameCollectionRequest{OldName: "old", NewName: "new"},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.IsAliasFunc = func(dbName string, name string) bool {
+			return false
+		}
+		core := newTestCore(withMeta(meta))
+		task := &renameCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.RenameCollectionRequest{OldName: "old", NewName: "new"},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_renameCollectionTask_Execute(t *testing.T) {
+	t.Run("collection not exist", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return nil, errors.New("error mock GetCollectionByName")
+		}
+		core := newTestCore(withMeta(meta))
+		task := &renameCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.RenameCollectionRequest{OldName: "old", NewName: "new"},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to rename meta", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 1, Name: "old"}, nil
+		}
+		meta.ListAliasesByIDFunc = func(collID UniqueID) []string {
+			return []string{}
+		}
+		meta.RenameCollectionFunc = func(ctx context.Context, dbName string, oldName string, newName string, ts Timestamp) error {
+			return errors.New("error mock RenameCollection")
+		}
+		core := newTestCore(withMeta(meta))
+		task := &renameCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.RenameCollectionRequest{OldName: "old", NewName: "new"},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to expire cache, rollback", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 1, Name: "old"}, nil
+		}
+		meta.ListAliasesByIDFunc = func(collID UniqueID) []string {
+			return []string{}
+		}
+		renamed := make(chan string, 2)
+		meta.RenameCollectionFunc = func(ctx context.Context, dbName string, oldName string, newName string, ts Timestamp) error {
+			renamed <- newName
+			return nil
+		}
+		meta.SelectRoleFunc = func(tenant string, entity *milvuspb.RoleEntity, includeUserInfo bool) ([]*milvuspb.RoleResult, error) {
+			return nil, nil
+		}
+		core := newTestCore(withMeta(meta), withInvalidProxyManager())
+		task := &renameCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.RenameCollectionRequest{OldName: "old", NewName: "new"},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, "new", <-renamed)
+		// the meta is renamed back.
+		assert.Equal(t, "old", <-renamed)
+	})
+
+	t.Run("failed to move grants, rollback", func(t *testing.T) {
+		role := &milvuspb.RoleEntity{Name: "role"}
+		grant := &milvuspb.GrantEntity{
+			Role:       role,
+			Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+			ObjectName: "old",
+			Grantor: &milvuspb.GrantorEntity{
+				User:      &milvuspb.UserEntity{Name: util.UserRoot},
+				Privilege: &milvuspb.PrivilegeEntity{Name: util.AnyWord},
+			},
+		}
+		objectNamed := func(objectName string) interface{} {
+			return mock.MatchedBy(func(grant *milvuspb.GrantEntity) bool {
+				return grant.GetObjectName() == objectName
+			})
+		}
+
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.On("GetCollectionByName", mock.Anything, mock.Anything, "old", mock.Anything).
+			Return(&model.Collection{CollectionID: 1, Name: "old"}, nil)
+		meta.On("ListAliasesByID", mock.Anything).Return([]string{})
+		renamed := make(chan string, 2)
+		meta.On("RenameCollection", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { renamed <- args.String(3) }).Return(nil)
+		meta.On("SelectRole", util.DefaultTenant, mock.Anything, false).
+			Return([]*milvuspb.RoleResult{{Role: role}}, nil)
+		meta.On("SelectGrant", util.DefaultTenant, mock.Anything).Return([]*milvuspb.GrantEntity{grant}, nil).Once()
+		// the grant on the new name is added, but the one on the old name fails to be revoked.
+		meta.On("OperatePrivilege", util.DefaultTenant, objectNamed("new"), milvuspb.OperatePrivilegeType_Grant).Return(nil).Once()
+		meta.On("OperatePrivilege", util.DefaultTenant, objectNamed("old"), milvuspb.OperatePrivilegeType_Revoke).
+			Return(errors.New("error mock OperatePrivilege")).Once()
+		// the added grant is revoked by the step itself.
+		meta.On("OperatePrivilege", util.DefaultTenant, objectNamed("new"), milvuspb.OperatePrivilegeType_Revoke).Return(nil).Once()
+
+		core := newTestCore(withMeta(meta), withValidProxyManager())
+		p := core.proxyClientManager.proxyClient[TestProxyID].(*mockProxy)
+		p.RefreshPolicyInfoCacheFunc = func(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+			return succStatus(), nil
+		}
+		task := &renameCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.RenameCollectionRequest{OldName: "old", NewName: "new"},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, "new", <-renamed)
+		// the meta is renamed back.
+		assert.Equal(t, "old", <-renamed)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		collectionName := funcutil.GenRandomStr()
+		newName := collectionName + "_new"
+		role := &milvuspb.RoleEntity{Name: "role"}
+		grantOn := func(objectName string) *milvuspb.GrantEntity {
+			return &milvuspb.GrantEntity{
+				Role:       role,
+				Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+				ObjectName: objectName,
+				Grantor: &milvuspb.GrantorEntity{
+					User:      &milvuspb.UserEntity{Name: util.UserRoot},
+					Privilege: &milvuspb.PrivilegeEntity{Name: util.AnyWord},
+				},
+			}
+		}
+
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.On("GetCollectionByName",
+			mock.Anything,
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("uint64"),
+		).Return(&model.Collection{CollectionID: 1, Name: collectionName}, nil)
+		meta.On("ListAliasesByID", mock.AnythingOfType("int64")).Return([]string{"alias"})
+		meta.On("RenameCollection",
+			mock.Anything,
+			mock.AnythingOfType("string"),
+			collectionName,
+			newName,
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+		meta.On("SelectRole", util.DefaultTenant, mock.Anything, false).
+			Return([]*milvuspb.RoleResult{{Role: role}}, nil)
+		meta.On("SelectGrant", util.DefaultTenant, mock.Anything).
+			Return([]*milvuspb.GrantEntity{grantOn(collectionName), grantOn("other")}, nil)
+		objectNamed := func(objectName string) interface{} {
+			return mock.MatchedBy(func(grant *milvuspb.GrantEntity) bool {
+				return grant.GetObjectName() == objectName
+			})
+		}
+		meta.On("OperatePrivilege", util.DefaultTenant, objectNamed(newName), milvuspb.OperatePrivilegeType_Grant).Return(nil)
+		meta.On("OperatePrivilege", util.DefaultTenant, objectNamed(collectionName), milvuspb.OperatePrivilegeType_Revoke).Return(nil)
+
+		core := newTestCore(withMeta(meta), withValidProxyManager())
+		var invalidated []string
+		p := core.proxyClientManager.proxyClient[TestProxyID].(*mockProxy)
+		p.InvalidateCollectionMetaCacheFunc = func(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
+			invalidated = append(invalidated, request.GetCollectionName())
+			return succStatus(), nil
+		}
+		refreshed := 0
+		p.RefreshPolicyInfoCacheFunc = func(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+			refreshed++
+			return succStatus(), nil
+		}
+
+		task := &renameCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.RenameCollectionRequest{OldName: collectionName, NewName: newName},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"alias", collectionName, newName}, invalidated)
+		assert.Equal(t, 2, refreshed)
+	})
+}
+
+func Test_renamedGrantObjectName(t *testing.T) {
+	grant := &milvuspb.GrantEntity{
+		Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+		ObjectName: "old",
+	}
+	name, ok := renamedGrantObjectName(grant, "", "old", "new")
+	assert.True(t, ok)
+	assert.Equal(t, "new", name)
+
+	_, ok = renamedGrantObjectName(grant, "db1", "old", "new")
+	assert.False(t, ok)
+
+	grant.ObjectName = funcutil.CombineObjectName("db1", "old")
+	name, ok = renamedGrantObjectName(grant, "db1", "old", "new")
+	assert.True(t, ok)
+	assert.Equal(t, funcutil.CombineObjectName("db1", "new"), name)
+
+	grant.Object.Name = commonpb.ObjectType_Global.String()
+	_, ok = renamedGrantObjectName(grant, "db1", "old", "new")
+	assert.False(t, ok)
+}
@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path"
+	"strconv"
 	"sync"
 	"sync/atomic"
 	"syscall"
@@ -35,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/backup"
 	"github.com/milvus-io/milvus/internal/common"
 	pnc "github.com/milvus-io/milvus/internal/distributed/proxy/client"
 	"github.com/milvus-io/milvus/internal/kv"
@@ -131,6 +134,8 @@ type Core struct {
 	factory dependency.Factory
 
 	importManager *importManager
+	exportManager *exportManager
+	backupManager *backup.Manager
 
 	enableActiveStandBy bool
 	activateFunc        func()
@@ -434,6 +439,47 @@ func (c *Core) initImportManager() error {
 	return nil
 }
 
+func (c *Core) initExportManager() error {
+	expTaskKv, err := c.metaKVCreator(Params.EtcdCfg.KvRootPath.GetValue())
+	if err != nil {
+		return err
+	}
+
+	getCollectionSchema := func(ctx context.Context, collID UniqueID) (*schemapb.CollectionSchema, error) {
+		coll, err := c.meta.GetCollectionByID(ctx, collID, typeutil.MaxTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		return &schemapb.CollectionSchema{
+			Name:        coll.Name,
+			Description: coll.Description,
+			AutoID:      coll.AutoID,
+			Fields:      model.MarshalFieldModels(coll.Fields),
+		}, nil
+	}
+	c.exportManager = newExportManager(
+		c.ctx,
+		expTaskKv,
+		IDAllocatorWithCore(c),
+		c.broker,
+		getCollectionSchema,
+		c.factory.NewPersistentStorageChunkManager,
+	)
+	c.exportManager.init()
+
+	return nil
+}
+
+func (c *Core) initBackupManager() error {
+	cm, err := c.factory.NewPersistentStorageChunkManager(c.ctx)
+	if err != nil {
+		return err
+	}
+	rootPath := path.Join(cm.RootPath(), Params.RootCoordCfg.BackupSubPath.GetValue())
+	c.backupManager = backup.NewManager(c, c.dataCoord, c.indexCoord, cm, cm, rootPath)
+	return nil
+}
+
 func (c *Core) initInternal() error {
 	if err := c.initSession(); err != nil {
 		return err
@@ -484,6 +530,14 @@ func (c *Core) initInternal() error {
 		return err
 	}
 
+	if err := c.initExportManager(); err != nil {
+		return err
+	}
+
+	if err := c.initBackupManager(); err != nil {
+		return err
+	}
+
 	if err := c.initCredentials(); err != nil {
 		return err
 	}
@@ -594,21 +648,27 @@ func (c *Core) restore(ctx context.Context) error {
 		}
 	}
 
-	colls, err = c.meta.ListCollections(ctx, typeutil.MaxTimestamp)
+	dbs, err := c.meta.ListDatabases(ctx, typeutil.MaxTimestamp)
 	if err != nil {
 		return err
 	}
-	for _, coll := range colls {
-		for _, part := range coll.Partitions {
-			ts, err := c.tsoAllocator.GenerateTSO(1)
-			if err != nil {
-				return err
-			}
+	for _, db := range dbs {
+		colls, err := c.meta.ListCollections(ctx, db.Name, typeutil.MaxTimestamp)
+		if err != nil {
+			return err
+		}
+		for _, coll := range colls {
+			for _, part := range coll.Partitions {
+				ts, err := c.tsoAllocator.GenerateTSO(1)
+				if err != nil {
+					return err
+				}
 
-			switch part.State {
-			case pb.PartitionState_PartitionDropping:
-				go c.garbageCollector.ReDropPartition(coll.PhysicalChannelNames, part.Clone(), ts)
-			default:
+				switch part.State {
+				case pb.PartitionState_PartitionDropping:
+					go c.garbageCollector.ReDropPartition(coll.PhysicalChannelNames, part.Clone(), ts)
+				default:
+				}
 			}
 		}
 	}
@@ -626,13 +686,14 @@ func (c *Core) startInternal() error {
 		panic(err)
 	}
 
-	c.wg.Add(6)
+	c.wg.Add(7)
 	go c.startTimeTickLoop()
 	go c.tsLoop()
 	go c.chanTimeTick.startWatch(&c.wg)
 	go c.importManager.cleanupLoop(&c.wg)
 	go c.importManager.sendOutTasksLoop(&c.wg)
 	go c.importManager.flipTaskStateLoop(&c.wg)
+	go c.exportManager.cleanupLoop(&c.wg)
 
 	if Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() {
 		go c.quotaCenter.run()
@@ -912,7 +973,7 @@ func (c *Core) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequ
 func (c *Core) describeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest) (*model.Collection, error) {
 	ts := getTravelTs(in)
 	if in.GetCollectionName() != "" {
-		return c.meta.GetCollectionByName(ctx, in.GetCollectionName(), ts)
+		return c.meta.GetCollectionByName(ctx, in.GetDbName(), in.GetCollectionName(), ts)
 	}
 	return c.meta.GetCollectionByID(ctx, in.GetCollectionID(), ts)
 }
@@ -942,6 +1003,12 @@ func convertModelToDesc(collInfo *model.Collection, aliases []string) *milvuspb.
 	resp.StartPositions = collInfo.StartPositions
 	resp.CollectionName = resp.Schema.Name
 	resp.Properties = collInfo.Properties
+	if collInfo.SchemaVersion > 0 {
+		resp.Properties = append(common.CloneKeyValuePairs(collInfo.Properties), &commonpb.KeyValuePair{
+			Key:   common.CollectionSchemaVersionKey,
+			Value: strconv.Itoa(int(collInfo.SchemaVersion)),
+		})
+	}
 	return resp
 }
 
@@ -1044,6 +1111,233 @@ func (c *Core) ShowCollections(ctx context.Context, in *milvuspb.ShowCollections
 	return t.Rsp, nil
 }
 
+// CreateDatabase create a database
+func (c *Core) CreateDatabase(ctx context.Context, in *rootcoordpb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("CreateDatabase")
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("dbName", in.GetDbName()))
+	log.Info("received request to create database")
+
+	t := &createDatabaseTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to create database", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to create database", zap.Error(err), zap.Uint64("ts", t.GetTs()))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateDatabase", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("CreateDatabase").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to create database", zap.Int64("dbID", t.dbID), zap.Uint64("ts", t.GetTs()))
+	return succStatus(), nil
+}
+
+// DropDatabase drop a database, the database should have no collections
+func (c *Core) DropDatabase(ctx context.Context, in *rootcoordpb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("DropDatabase")
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("dbName", in.GetDbName()))
+	log.Info("received request to drop database")
+
+	t := &dropDatabaseTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to drop database", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to drop database", zap.Error(err), zap.Uint64("ts", t.GetTs()))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropDatabase", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("DropDatabase").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to drop database", zap.Uint64("ts", t.GetTs()))
+	return succStatus(), nil
+}
+
+// ListDatabases list all the databases
+func (c *Core) ListDatabases(ctx context.Context, in *rootcoordpb.ListDatabasesRequest) (*rootcoordpb.ListDatabasesResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.ListDatabasesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("ListDatabases")
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole))
+	log.Info("received request to list databases")
+
+	t := &listDatabasesTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+		Rsp:      &rootcoordpb.ListDatabasesResponse{},
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to list databases", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.FailLabel).Inc()
+		return &rootcoordpb.ListDatabasesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ListDatabases failed: "+err.Error()),
+		}, nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to list databases", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.FailLabel).Inc()
+		return &rootcoordpb.ListDatabasesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ListDatabases failed: "+err.Error()),
+		}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("ListDatabases", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("ListDatabases").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to list databases", zap.Int("num of databases", len(t.Rsp.GetDbNames())))
+	return t.Rsp, nil
+}
+
+// RenameCollection rename a collection, the collection id, aliases and privileges are kept
+func (c *Core) RenameCollection(ctx context.Context, in *rootcoordpb.RenameCollectionRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RenameCollection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("RenameCollection")
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("dbName", in.GetDbName()),
+		zap.String("oldName", in.GetOldName()), zap.String("newName", in.GetNewName()))
+	log.Info("received request to rename collection")
+
+	t := &renameCollectionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to rename collection", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RenameCollection", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to rename collection", zap.Error(err), zap.Uint64("ts", t.GetTs()))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RenameCollection", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RenameCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("RenameCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to rename collection", zap.Uint64("ts", t.GetTs()))
+	return succStatus(), nil
+}
+
+// TruncateCollection drop all the data of a collection, the schema, indexes and partitions are kept
+func (c *Core) TruncateCollection(ctx context.Context, in *rootcoordpb.TruncateCollectionRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("TruncateCollection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("TruncateCollection")
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("dbName", in.GetDbName()),
+		zap.String("collection", in.GetCollectionName()))
+	log.Info("received request to truncate collection")
+
+	t := &truncateCollectionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to truncate collection", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("TruncateCollection", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to truncate collection", zap.Error(err), zap.Uint64("ts", t.GetTs()))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("TruncateCollection", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("TruncateCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("TruncateCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to truncate collection", zap.Uint64("ts", t.GetTs()))
+	return succStatus(), nil
+}
+
+// CloneCollection drop all the data of a collection, the schema, indexes and partitions are kept
+func (c *Core) CloneCollection(ctx context.Context, in *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("CloneCollection")
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("dbName", in.GetDbName()),
+		zap.String("source", in.GetSourceCollectionName()), zap.String("target", in.GetTargetCollectionName()),
+		zap.Uint64("timestamp", in.GetTimestamp()))
+	log.Info("received request to clone collection")
+
+	t := &cloneCollectionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to clone collection", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to clone collection", zap.Error(err), zap.Uint64("ts", t.GetTs()))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("CloneCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to clone collection", zap.Uint64("ts", t.GetTs()))
+	return succStatus(), nil
+}
+
 func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	if code, ok := c.checkHealthy(); !ok {
 		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
@@ -1666,7 +1960,7 @@ func (c *Core) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvus
 	// Get collection/partition ID from collection/partition name.
 	var colInfo *model.Collection
 	var err error
-	if colInfo, err = c.meta.GetCollectionByName(ctx, req.GetCollectionName(), typeutil.MaxTimestamp); err != nil {
+	if colInfo, err = c.meta.GetCollectionByName(ctx, "", req.GetCollectionName(), typeutil.MaxTimestamp); err != nil {
 		log.Error("failed to find collection ID from its name",
 			zap.String("collection name", req.GetCollectionName()),
 			zap.Error(err))
@@ -1719,7 +2013,7 @@ func (c *Core) ListImportTasks(ctx context.Context, req *milvuspb.ListImportTask
 	if len(collectionName) != 0 {
 		// if the collection name is specified but not found, user may input a wrong name, the collection doesn't exist or has been dropped.
 		// we will return error to notify user the name is incorrect.
-		colInfo, err := c.meta.GetCollectionByName(ctx, req.GetCollectionName(), typeutil.MaxTimestamp)
+		colInfo, err := c.meta.GetCollectionByName(ctx, "", req.GetCollectionName(), typeutil.MaxTimestamp)
 		if err != nil {
 			err = fmt.Errorf("failed to find collection ID from its name: '%s', error: %w", req.GetCollectionName(), err)
 			log.Error("ListImportTasks failed", zap.Error(err))
@@ -1828,6 +2122,244 @@ func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (
 	}, nil
 }
 
+// Export exports the rows of a collection visible at a timestamp into JSON, NumPy or Parquet files on MinIO/S3 storage.
+func (c *Core) Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	colInfo, err := c.meta.GetCollectionByName(ctx, req.GetDbName(), req.GetCollectionName(), typeutil.MaxTimestamp)
+	if err != nil {
+		err = fmt.Errorf("failed to find collection ID from its name: '%s', error: %w", req.GetCollectionName(), err)
+		log.Error("Export failed", zap.Error(err))
+		return &rootcoordpb.ExportResponse{
+			Status: failStatus(commonpb.ErrorCode_IllegalCollectionName, err.Error()),
+		}, nil
+	}
+	cID := colInfo.CollectionID
+
+	// all the partitions are exported if the partition names are not specified.
+	var pIDs []UniqueID
+	if len(req.GetPartitionNames()) == 0 {
+		for _, partition := range colInfo.Partitions {
+			pIDs = append(pIDs, partition.PartitionID)
+		}
+	}
+	for _, partitionName := range req.GetPartitionNames() {
+		pID, err := c.meta.GetPartitionByName(cID, partitionName, typeutil.MaxTimestamp)
+		if err != nil {
+			err = fmt.Errorf("failed to get partition ID from its name: '%s', error: %w", partitionName, err)
+			log.Error("Export failed", zap.Error(err))
+			return &rootcoordpb.ExportResponse{
+				Status: failStatus(commonpb.ErrorCode_IllegalArgument, err.Error()),
+			}, nil
+		}
+		pIDs = append(pIDs, pID)
+	}
+
+	if req.GetTimestamp() == 0 {
+		ts, err := c.tsoAllocator.GenerateTSO(1)
+		if err != nil {
+			log.Error("failed to allocate timestamp for export", zap.Error(err))
+			return &rootcoordpb.ExportResponse{
+				Status: failStatus(commonpb.ErrorCode_UnexpectedError, "failed to allocate timestamp: "+err.Error()),
+			}, nil
+		}
+		req.Timestamp = ts
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Name:        colInfo.Name,
+		Description: colInfo.Description,
+		AutoID:      colInfo.AutoID,
+		Fields:      model.MarshalFieldModels(colInfo.Fields),
+	}
+	log.Info("RootCoord receive export request",
+		zap.String("collection name", req.GetCollectionName()),
+		zap.Int64("collection ID", cID),
+		zap.Int64s("partition IDs", pIDs),
+		zap.String("format", req.GetFormat().String()),
+		zap.String("target path", req.GetTargetPath()),
+		zap.Uint64("timestamp", req.GetTimestamp()))
+	return c.exportManager.exportJob(ctx, req, cID, pIDs, schema), nil
+}
+
+// GetExportState returns the current state of an export task.
+func (c *Core) GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.GetExportStateResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	info, err := c.exportManager.getTaskState(req.GetTaskId())
+	if err != nil {
+		log.Error("GetExportState failed", zap.Int64("task ID", req.GetTaskId()), zap.Error(err))
+		return &rootcoordpb.GetExportStateResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	return &rootcoordpb.GetExportStateResponse{
+		Status: succStatus(),
+		Info:   info,
+	}, nil
+}
+
+// ListExportTasks returns the export tasks of a collection, or of all the collections if the collection name is empty.
+func (c *Core) ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.ListExportTasksResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	colID := int64(-1)
+	if len(req.GetCollectionName()) != 0 {
+		colInfo, err := c.meta.GetCollectionByName(ctx, req.GetDbName(), req.GetCollectionName(), typeutil.MaxTimestamp)
+		if err != nil {
+			err = fmt.Errorf("failed to find collection ID from its name: '%s', error: %w", req.GetCollectionName(), err)
+			log.Error("ListExportTasks failed", zap.Error(err))
+			return &rootcoordpb.ListExportTasksResponse{
+				Status: failStatus(commonpb.ErrorCode_IllegalCollectionName, err.Error()),
+			}, nil
+		}
+		colID = colInfo.CollectionID
+	}
+
+	tasks, err := c.exportManager.listAllTasks(colID, req.GetLimit())
+	if err != nil {
+		err = fmt.Errorf("failed to list export tasks, collection name: '%s', error: %w", req.GetCollectionName(), err)
+		log.Error("ListExportTasks failed", zap.Error(err))
+		return &rootcoordpb.ListExportTasksResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	return &rootcoordpb.ListExportTasksResponse{
+		Status: succStatus(),
+		Tasks:  tasks,
+	}, nil
+}
+
+func backupInfoToPb(info *backup.Info) *rootcoordpb.BackupInfo {
+	return &rootcoordpb.BackupInfo{
+		Name:           info.Name,
+		BackupTs:       info.BackupTs,
+		CreateTime:     info.CreateTime,
+		DbName:         info.DbName,
+		CollectionName: info.CollectionName,
+		CollectionId:   info.CollectionID,
+		NumSegments:    int64(len(info.Segments)),
+		NumFiles:       int64(len(info.Files)),
+		Size:           info.Size(),
+	}
+}
+
+// CreateBackup backs up a collection at a timestamp, the files of the backup are copied before it returns.
+func (c *Core) CreateBackup(ctx context.Context, req *rootcoordpb.CreateBackupRequest) (*rootcoordpb.CreateBackupResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.CreateBackupResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("backup", req.GetName()), zap.String("collection", req.GetCollectionName()))
+	info, err := c.backupManager.Create(ctx, &backup.CreateRequest{
+		Name:           req.GetName(),
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+		Timestamp:      req.GetTimestamp(),
+	})
+	if err != nil {
+		log.Error("CreateBackup failed", zap.Error(err))
+		return &rootcoordpb.CreateBackupResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	log.Info("CreateBackup done", zap.Uint64("backupTs", info.BackupTs))
+	return &rootcoordpb.CreateBackupResponse{
+		Status: succStatus(),
+		Info:   backupInfoToPb(info),
+	}, nil
+}
+
+// ListBackups returns all the backups, sorted by the create time.
+func (c *Core) ListBackups(ctx context.Context, req *rootcoordpb.ListBackupsRequest) (*rootcoordpb.ListBackupsResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.ListBackupsResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	infos, err := c.backupManager.List(ctx)
+	if err != nil {
+		log.Error("ListBackups failed", zap.Error(err))
+		return &rootcoordpb.ListBackupsResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	backups := make([]*rootcoordpb.BackupInfo, 0, len(infos))
+	for _, info := range infos {
+		backups = append(backups, backupInfoToPb(info))
+	}
+	return &rootcoordpb.ListBackupsResponse{
+		Status:  succStatus(),
+		Backups: backups,
+	}, nil
+}
+
+// VerifyBackup checks that all the files of a backup exist and are not corrupted.
+func (c *Core) VerifyBackup(ctx context.Context, req *rootcoordpb.VerifyBackupRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+
+	if err := c.backupManager.Verify(ctx, req.GetName()); err != nil {
+		log.Error("VerifyBackup failed", zap.String("backup", req.GetName()), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+	return succStatus(), nil
+}
+
+// DeleteBackup removes a backup and all of its files.
+func (c *Core) DeleteBackup(ctx context.Context, req *rootcoordpb.DeleteBackupRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+
+	if err := c.backupManager.Delete(ctx, req.GetName()); err != nil {
+		log.Error("DeleteBackup failed", zap.String("backup", req.GetName()), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+	return succStatus(), nil
+}
+
+// RestoreBackup restores a backup into a new collection, the rows are imported by the returned import tasks.
+func (c *Core) RestoreBackup(ctx context.Context, req *rootcoordpb.RestoreBackupRequest) (*rootcoordpb.RestoreBackupResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.RestoreBackupResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	tasks, err := c.backupManager.Restore(ctx, &backup.RestoreRequest{
+		BackupName:     req.GetBackupName(),
+		CollectionName: req.GetCollectionName(),
+	})
+	if err != nil {
+		log.Error("RestoreBackup failed", zap.String("backup", req.GetBackupName()),
+			zap.String("collection", req.GetCollectionName()), zap.Error(err))
+		return &rootcoordpb.RestoreBackupResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()),
+		}, nil
+	}
+	return &rootcoordpb.RestoreBackupResponse{
+		Status: succStatus(),
+		Tasks:  tasks,
+	}, nil
+}
+
 // ExpireCredCache will call invalidate credential cache
 func (c *Core) ExpireCredCache(ctx context.Context, username string) error {
 	req := proxypb.InvalidateCredCacheRequest{
@@ -2007,6 +2539,154 @@ func (c *Core) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequ
 	}, nil
 }
 
+// CreateApiKey create an api key for an existing user
+//  1. generate a random key
+//  2. hash the secret of the key
+//  3. save the hashed secret in to etcd, the plaintext key is only returned in the response
+func (c *Core) CreateApiKey(ctx context.Context, in *rootcoordpb.CreateApiKeyRequest) (*rootcoordpb.CreateApiKeyResponse, error) {
+	method := "CreateApiKey"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("username", in.GetUsername()))
+	log.Debug(method)
+
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.CreateApiKeyResponse{Status: errorutil.UnhealthyStatus(code)}, nil
+	}
+
+	keyID, secret, err := crypto.GenerateApiKey()
+	if err == nil {
+		err = c.meta.AddApiKey(&model.ApiKey{
+			KeyID:        keyID,
+			Username:     in.GetUsername(),
+			Description:  in.GetDescription(),
+			HashedSecret: crypto.HashApiKeySecret(keyID, secret),
+			CreatedTime:  uint64(time.Now().Unix()),
+		})
+	}
+	if err != nil {
+		log.Error("CreateApiKey save api key failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &rootcoordpb.CreateApiKeyResponse{
+			Status: failStatus(commonpb.ErrorCode_CreateCredentialFailure, "CreateApiKey failed: "+err.Error()),
+		}, nil
+	}
+	log.Info("CreateApiKey success", zap.String("keyID", keyID))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &rootcoordpb.CreateApiKeyResponse{
+		Status: succStatus(),
+		KeyId:  keyID,
+		ApiKey: crypto.FormatApiKey(keyID, secret),
+	}, nil
+}
+
+// ListApiKeys list the api keys of a user, or all api keys if the username is empty, the hashed secrets are not returned
+func (c *Core) ListApiKeys(ctx context.Context, in *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error) {
+	method := "ListApiKeys"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.ListApiKeysResponse{Status: errorutil.UnhealthyStatus(code)}, nil
+	}
+
+	var apiKeys []*model.ApiKey
+	var err error
+	if in.GetUsername() != "" {
+		if _, err = c.meta.GetCredential(in.GetUsername()); err != nil {
+			err = fmt.Errorf("user not found: %s", in.GetUsername())
+		}
+	}
+	if err == nil {
+		apiKeys, err = c.meta.ListApiKeys(in.GetUsername())
+	}
+	if err != nil {
+		log.Ctx(ctx).Error("ListApiKeys query api keys failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("username", in.GetUsername()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &rootcoordpb.ListApiKeysResponse{
+			Status: failStatus(commonpb.ErrorCode_ListCredUsersFailure, "ListApiKeys failed: "+err.Error()),
+		}, nil
+	}
+	infos := make([]*rootcoordpb.ApiKeyInfo, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		info := model.MarshalApiKeyModel(apiKey)
+		info.HashedSecret = ""
+		infos = append(infos, info)
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &rootcoordpb.ListApiKeysResponse{
+		Status:  succStatus(),
+		ApiKeys: infos,
+	}, nil
+}
+
+// RevokeApiKey delete an api key and invalidate the cached keys of the user in proxies
+func (c *Core) RevokeApiKey(ctx context.Context, in *rootcoordpb.RevokeApiKeyRequest) (*commonpb.Status, error) {
+	method := "RevokeApiKey"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("keyID", in.GetKeyId()))
+
+	if code, ok := c.checkHealthy(); !ok {
+		return errorutil.UnhealthyStatus(code), nil
+	}
+
+	apiKey, err := c.meta.GetApiKey(in.GetKeyId())
+	if err == nil {
+		err = c.meta.DeleteApiKey(in.GetKeyId())
+	}
+	if err != nil {
+		log.Error("RevokeApiKey remove api key failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_DeleteCredentialFailure, "RevokeApiKey failed: "+err.Error()), nil
+	}
+	// invalidate proxy's local cache
+	err = c.ExpireCredCache(ctx, apiKey.Username)
+	if err != nil {
+		log.Error("RevokeApiKey expire credential cache failed", zap.String("username", apiKey.Username), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_DeleteCredentialFailure, "RevokeApiKey failed: "+err.Error()), nil
+	}
+	log.Info("RevokeApiKey success", zap.String("username", apiKey.Username))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return succStatus(), nil
+}
+
+// GetApiKey get an api key with its hashed secret, used by proxy to authenticate the requests
+func (c *Core) GetApiKey(ctx context.Context, in *rootcoordpb.GetApiKeyRequest) (*rootcoordpb.GetApiKeyResponse, error) {
+	method := "GetApiKey"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.GetApiKeyResponse{Status: errorutil.UnhealthyStatus(code)}, nil
+	}
+
+	apiKey, err := c.meta.GetApiKey(in.GetKeyId())
+	if err != nil {
+		log.Ctx(ctx).Warn("GetApiKey query api key failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("keyID", in.GetKeyId()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &rootcoordpb.GetApiKeyResponse{
+			Status: failStatus(commonpb.ErrorCode_GetCredentialFailure, "GetApiKey failed: "+err.Error()),
+		}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &rootcoordpb.GetApiKeyResponse{
+		Status: succStatus(),
+		Info:   model.MarshalApiKeyModel(apiKey),
+	}, nil
+}
+
 // CreateRole create role
 // - check the node health
 // - check if the role is existed
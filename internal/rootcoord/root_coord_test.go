@@ -14,6 +14,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/backup"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/model"
@@ -23,6 +24,10 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -33,6 +38,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMain(m *testing.M) {
@@ -201,6 +207,120 @@ func TestRootCoord_DropPartition(t *testing.T) {
 	})
 }
 
+func TestRootCoord_CreateDatabase(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		ctx := context.Background()
+		resp, err := c.CreateDatabase(ctx, &rootcoordpb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.CreateDatabase(ctx, &rootcoordpb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+		ctx := context.Background()
+		resp, err := c.CreateDatabase(ctx, &rootcoordpb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("normal case, everything is ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		ctx := context.Background()
+		resp, err := c.CreateDatabase(ctx, &rootcoordpb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_DropDatabase(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		ctx := context.Background()
+		resp, err := c.DropDatabase(ctx, &rootcoordpb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.DropDatabase(ctx, &rootcoordpb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+		ctx := context.Background()
+		resp, err := c.DropDatabase(ctx, &rootcoordpb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("normal case, everything is ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		ctx := context.Background()
+		resp, err := c.DropDatabase(ctx, &rootcoordpb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_ListDatabases(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		ctx := context.Background()
+		resp, err := c.ListDatabases(ctx, &rootcoordpb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.ListDatabases(ctx, &rootcoordpb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+		ctx := context.Background()
+		resp, err := c.ListDatabases(ctx, &rootcoordpb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case, everything is ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		ctx := context.Background()
+		resp, err := c.ListDatabases(ctx, &rootcoordpb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+}
+
 func TestRootCoord_CreateAlias(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		c := newTestCore(withAbnormalCode())
@@ -819,10 +939,7 @@ func TestCore_Import(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode(),
 			withMeta(meta))
-		meta.GetCollectionIDByNameFunc = func(name string) (UniqueID, error) {
-			return 0, errors.New("error mock GetCollectionIDByName")
-		}
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return nil, errors.New("collection name not found")
 		}
 		_, err := c.Import(ctx, &milvuspb.ImportRequest{
@@ -835,9 +952,6 @@ func TestCore_Import(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode(),
 			withMeta(meta))
-		meta.GetCollectionIDByNameFunc = func(name string) (UniqueID, error) {
-			return 100, nil
-		}
 		meta.GetCollectionVirtualChannelsFunc = func(colID int64) []string {
 			return []string{"ch-1", "ch-2"}
 		}
@@ -854,9 +968,6 @@ func TestCore_Import(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withHealthyCode(),
 			withMeta(meta))
-		meta.GetCollectionIDByNameFunc = func(name string) (UniqueID, error) {
-			return 100, nil
-		}
 		meta.GetCollectionVirtualChannelsFunc = func(colID int64) []string {
 			return []string{"ch-1", "ch-2"}
 		}
@@ -864,7 +975,7 @@ func TestCore_Import(t *testing.T) {
 			return 101, nil
 		}
 		coll := &model.Collection{Name: "a-good-name"}
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			return coll.Clone(), nil
 		}
 		_, err := c.Import(ctx, &milvuspb.ImportRequest{
@@ -980,7 +1091,7 @@ func TestCore_ListImportTasks(t *testing.T) {
 
 	t.Run("normal case", func(t *testing.T) {
 		meta := newMockMetaTable()
-		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 			if collectionName == ti1.CollectionName {
 				return &model.Collection{
 					CollectionID: ti1.CollectionId,
@@ -1086,7 +1197,7 @@ func TestCore_ReportImport(t *testing.T) {
 
 	ticker := newRocksMqTtSynchronizer()
 	meta := newMockMetaTable()
-	meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+	meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
 		return nil, errors.New("error mock GetCollectionByName")
 	}
 	meta.AddCollectionFunc = func(ctx context.Context, coll *model.Collection) error {
@@ -1227,6 +1338,207 @@ func TestCore_ReportImport(t *testing.T) {
 	})
 }
 
+func TestCore_Export(t *testing.T) {
+	ctx := context.Background()
+	meta := newMockMetaTable()
+	meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+		if collectionName != "export" {
+			return nil, errors.New("collection name not found")
+		}
+		return &model.Collection{
+			CollectionID: 1,
+			Name:         "export",
+			Fields:       model.UnmarshalFieldModels(exportTestSchema().GetFields()),
+			Partitions:   []*model.Partition{{PartitionID: 10}, {PartitionID: 11}},
+		}, nil
+	}
+	meta.GetPartitionByNameFunc = func(collID UniqueID, partitionName string, ts Timestamp) (UniqueID, error) {
+		if partitionName != "p1" {
+			return 0, errors.New("partition name not found")
+		}
+		return 11, nil
+	}
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.Export(ctx, &rootcoordpb.ExportRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("bad collection name", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		resp, err := c.Export(ctx, &rootcoordpb.ExportRequest{CollectionName: "a-bad-name"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalCollectionName, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("bad partition name", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		resp, err := c.Export(ctx, &rootcoordpb.ExportRequest{CollectionName: "export", PartitionNames: []string{"p2"}})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed to allocate timestamp", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(), withMeta(meta), withInvalidTsoAllocator())
+		resp, err := c.Export(ctx, &rootcoordpb.ExportRequest{CollectionName: "export"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("illegal format", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(), withMeta(meta), withTsoAllocator(newMockTsoAllocator()))
+		c.exportManager = newTestExportManager(ctx, nil, newMockBroker())
+		resp, err := c.Export(ctx, &rootcoordpb.ExportRequest{CollectionName: "export", Format: rootcoordpb.ExportFormat(100)})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		broker := newMockBroker()
+		var exportedPartIDs []UniqueID
+		broker.WaitSegmentsFlushedFunc = func(ctx context.Context, collID UniqueID) error {
+			return nil
+		}
+		broker.GetFlushedSegmentsFunc = func(ctx context.Context, collID UniqueID, partIDs []UniqueID) ([]*datapb.SegmentInfo, error) {
+			exportedPartIDs = partIDs
+			return nil, nil
+		}
+		c := newTestCore(withHealthyCode(), withMeta(meta), withTsoAllocator(newMockTsoAllocator()))
+		c.exportManager = newTestExportManager(ctx, storage.NewLocalChunkManager(storage.RootPath(t.TempDir())), broker)
+		resp, err := c.Export(ctx, &rootcoordpb.ExportRequest{CollectionName: "export", TargetPath: "export"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		c.exportManager.wg.Wait()
+		assert.ElementsMatch(t, []UniqueID{10, 11}, exportedPartIDs)
+
+		state, err := c.GetExportState(ctx, &rootcoordpb.GetExportStateRequest{TaskId: resp.GetTaskId()})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, state.GetStatus().GetErrorCode())
+		assert.Equal(t, rootcoordpb.ExportState_ExportCompleted, state.GetInfo().GetState())
+
+		resp, err = c.Export(ctx, &rootcoordpb.ExportRequest{CollectionName: "export", PartitionNames: []string{"p1"}, TargetPath: "export"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		c.exportManager.wg.Wait()
+		assert.Equal(t, []UniqueID{11}, exportedPartIDs)
+	})
+}
+
+func TestCore_GetExportState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.GetExportState(ctx, &rootcoordpb.GetExportStateRequest{TaskId: 100})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("task not found", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		c.exportManager = newTestExportManager(ctx, nil, newMockBroker())
+		resp, err := c.GetExportState(ctx, &rootcoordpb.GetExportStateRequest{TaskId: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+}
+
+func TestCore_ListExportTasks(t *testing.T) {
+	ctx := context.Background()
+	meta := newMockMetaTable()
+	meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+		if collectionName != "collection-A" {
+			return nil, errors.New("collection name not found")
+		}
+		return &model.Collection{CollectionID: 1}, nil
+	}
+
+	c := newTestCore(withHealthyCode(), withMeta(meta))
+	c.exportManager = newTestExportManager(ctx, nil, newMockBroker())
+	for _, task := range []*rootcoordpb.ExportTaskInfo{{Id: 100, CollectionId: 1}, {Id: 200, CollectionId: 2}} {
+		require.NoError(t, c.exportManager.persistTaskInfo(task))
+	}
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.ListExportTasks(ctx, &rootcoordpb.ListExportTasksRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("bad collection name", func(t *testing.T) {
+		resp, err := c.ListExportTasks(ctx, &rootcoordpb.ListExportTasksRequest{CollectionName: "a-bad-name"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalCollectionName, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		resp, err := c.ListExportTasks(ctx, &rootcoordpb.ListExportTasksRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 2, len(resp.GetTasks()))
+
+		resp, err = c.ListExportTasks(ctx, &rootcoordpb.ListExportTasksRequest{CollectionName: "collection-A"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(resp.GetTasks()))
+		assert.Equal(t, int64(100), resp.GetTasks()[0].GetId())
+	})
+}
+
+func TestCore_Backup(t *testing.T) {
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	c := newTestCore(withHealthyCode())
+	c.backupManager = backup.NewManager(c, nil, nil, cm, cm, "backup")
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		createResp, err := c.CreateBackup(ctx, &rootcoordpb.CreateBackupRequest{Name: "b1", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, createResp.GetStatus().GetErrorCode())
+		listResp, err := c.ListBackups(ctx, &rootcoordpb.ListBackupsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		status, err := c.VerifyBackup(ctx, &rootcoordpb.VerifyBackupRequest{Name: "b1"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		status, err = c.DeleteBackup(ctx, &rootcoordpb.DeleteBackupRequest{Name: "b1"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		restoreResp, err := c.RestoreBackup(ctx, &rootcoordpb.RestoreBackupRequest{BackupName: "b1", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, restoreResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("invalid backup name", func(t *testing.T) {
+		resp, err := c.CreateBackup(ctx, &rootcoordpb.CreateBackupRequest{Name: "../b1", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("backup not found", func(t *testing.T) {
+		listResp, err := c.ListBackups(ctx, &rootcoordpb.ListBackupsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		assert.Empty(t, listResp.GetBackups())
+
+		status, err := c.VerifyBackup(ctx, &rootcoordpb.VerifyBackupRequest{Name: "b1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+		status, err = c.DeleteBackup(ctx, &rootcoordpb.DeleteBackupRequest{Name: "b1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+		restoreResp, err := c.RestoreBackup(ctx, &rootcoordpb.RestoreBackupRequest{BackupName: "b1", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, restoreResp.GetStatus().GetErrorCode())
+	})
+}
+
 func TestCore_Rbac(t *testing.T) {
 	ctx := context.Background()
 	c := &Core{
@@ -1285,6 +1597,82 @@ func TestCore_Rbac(t *testing.T) {
 	}
 }
 
+func TestCore_ApiKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		createResp, err := c.CreateApiKey(ctx, &rootcoordpb.CreateApiKeyRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, createResp.GetStatus().GetErrorCode())
+		listResp, err := c.ListApiKeys(ctx, &rootcoordpb.ListApiKeysRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		status, err := c.RevokeApiKey(ctx, &rootcoordpb.RevokeApiKeyRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		getResp, err := c.GetApiKey(ctx, &rootcoordpb.GetApiKeyRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, getResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		mt := generateMetaTable(t)
+		err := mt.AddCredential(&internalpb.CredentialInfo{Username: "user1", Tenant: util.DefaultTenant})
+		require.NoError(t, err)
+
+		var invalidated []string
+		p := newMockProxy()
+		p.InvalidateCredentialCacheFunc = func(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+			invalidated = append(invalidated, request.GetUsername())
+			return succStatus(), nil
+		}
+		c := newTestCore(withHealthyCode(), withMeta(mt))
+		c.proxyClientManager = &proxyClientManager{proxyClient: map[UniqueID]types.Proxy{TestProxyID: p}}
+
+		createResp, err := c.CreateApiKey(ctx, &rootcoordpb.CreateApiKeyRequest{Username: "user_not_exist"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, createResp.GetStatus().GetErrorCode())
+
+		createResp, err = c.CreateApiKey(ctx, &rootcoordpb.CreateApiKeyRequest{Username: "user1", Description: "test"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, createResp.GetStatus().GetErrorCode())
+		keyID, secret, ok := crypto.ParseApiKey(createResp.GetApiKey())
+		assert.True(t, ok)
+		assert.Equal(t, createResp.GetKeyId(), keyID)
+
+		getResp, err := c.GetApiKey(ctx, &rootcoordpb.GetApiKeyRequest{KeyId: keyID})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, getResp.GetStatus().GetErrorCode())
+		assert.Equal(t, "user1", getResp.GetInfo().GetUsername())
+		assert.True(t, crypto.VerifyApiKeySecret(keyID, secret, getResp.GetInfo().GetHashedSecret()))
+
+		listResp, err := c.ListApiKeys(ctx, &rootcoordpb.ListApiKeysRequest{Username: "user_not_exist"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+
+		listResp, err = c.ListApiKeys(ctx, &rootcoordpb.ListApiKeysRequest{Username: "user1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(listResp.GetApiKeys()))
+		assert.Equal(t, keyID, listResp.GetApiKeys()[0].GetKeyId())
+		assert.Equal(t, "test", listResp.GetApiKeys()[0].GetDescription())
+		assert.Empty(t, listResp.GetApiKeys()[0].GetHashedSecret())
+
+		status, err := c.RevokeApiKey(ctx, &rootcoordpb.RevokeApiKeyRequest{KeyId: keyID})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Equal(t, []string{"user1"}, invalidated)
+
+		status, err = c.RevokeApiKey(ctx, &rootcoordpb.RevokeApiKeyRequest{KeyId: keyID})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		getResp, err = c.GetApiKey(ctx, &rootcoordpb.GetApiKeyRequest{KeyId: keyID})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, getResp.GetStatus().GetErrorCode())
+	})
+}
+
 func TestCore_sendMinDdlTsAsTt(t *testing.T) {
 	ticker := newRocksMqTtSynchronizer()
 	ddlManager := newMockDdlTsLockManager()
@@ -32,7 +32,7 @@ func (t *showPartitionTask) Execute(ctx context.Context) error {
 	if t.Req.GetCollectionName() == "" {
 		coll, err = t.core.meta.GetCollectionByID(ctx, t.Req.GetCollectionID(), typeutil.MaxTimestamp)
 	} else {
-		coll, err = t.core.meta.GetCollectionByName(ctx, t.Req.GetCollectionName(), typeutil.MaxTimestamp)
+		coll, err = t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), typeutil.MaxTimestamp)
 	}
 	if err != nil {
 		t.Rsp.Status = failStatus(commonpb.ErrorCode_CollectionNotExists, err.Error())
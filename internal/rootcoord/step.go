@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 
@@ -148,6 +149,7 @@ func (s *changeCollectionStateStep) Desc() string {
 
 type expireCacheStep struct {
 	baseStep
+	dbName          string
 	collectionNames []string
 	collectionID    UniqueID
 	ts              Timestamp
@@ -155,7 +157,7 @@ type expireCacheStep struct {
 }
 
 func (s *expireCacheStep) Execute(ctx context.Context) ([]nestedStep, error) {
-	err := s.core.ExpireMetaCache(ctx, s.collectionNames, s.collectionID, s.ts, s.opts...)
+	err := s.core.ExpireMetaCache(ctx, s.dbName, s.collectionNames, s.collectionID, s.ts, s.opts...)
 	return nil, err
 }
 
@@ -374,3 +376,84 @@ func (b *BroadcastAlteredCollectionStep) Execute(ctx context.Context) ([]nestedS
 func (b *BroadcastAlteredCollectionStep) Desc() string {
 	return fmt.Sprintf("broadcast altered collection, collectionID: %d", b.req.CollectionID)
 }
+
+type renameCollectionMetaStep struct {
+	baseStep
+	dbName  string
+	oldName string
+	newName string
+	ts      Timestamp
+}
+
+func (s *renameCollectionMetaStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.meta.RenameCollection(ctx, s.dbName, s.oldName, s.newName, s.ts)
+	return nil, err
+}
+
+func (s *renameCollectionMetaStep) Desc() string {
+	return fmt.Sprintf("rename collection, database: %s, old name: %s, new name: %s, ts: %d", s.dbName, s.oldName, s.newName, s.ts)
+}
+
+type moveCollectionGrantsStep struct {
+	baseStep
+	dbName  string
+	oldName string
+	newName string
+}
+
+func (s *moveCollectionGrantsStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.moveCollectionGrants(ctx, s.dbName, s.oldName, s.newName)
+	return nil, err
+}
+
+func (s *moveCollectionGrantsStep) Desc() string {
+	return fmt.Sprintf("move collection grants, database: %s, old name: %s, new name: %s", s.dbName, s.oldName, s.newName)
+}
+
+type dropCollectionSegmentsStep struct {
+	baseStep
+	collectionID UniqueID
+	ts           Timestamp
+}
+
+func (s *dropCollectionSegmentsStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.DropCollectionSegments(ctx, s.collectionID, s.ts)
+	return nil, err
+}
+
+func (s *dropCollectionSegmentsStep) Desc() string {
+	return fmt.Sprintf("drop collection segments: %d, ts: %d", s.collectionID, s.ts)
+}
+
+func (s *dropCollectionSegmentsStep) Weight() stepPriority {
+	return stepPriorityImportant
+}
+
+type flushCollectionSegmentsStep struct {
+	baseStep
+	collectionID UniqueID
+}
+
+func (s *flushCollectionSegmentsStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.WaitSegmentsFlushed(ctx, s.collectionID)
+	return nil, err
+}
+
+func (s *flushCollectionSegmentsStep) Desc() string {
+	return fmt.Sprintf("flush collection segments: %d", s.collectionID)
+}
+
+type cloneSegmentsStep struct {
+	baseStep
+	req *datapb.CloneSegmentsRequest
+}
+
+func (s *cloneSegmentsStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.CloneSegments(ctx, s.req)
+	return nil, err
+}
+
+func (s *cloneSegmentsStep) Desc() string {
+	return fmt.Sprintf("clone segments, source collection: %d, target collection: %d, ts: %d",
+		s.req.GetSourceCollectionID(), s.req.GetTargetCollectionID(), s.req.GetTimestamp())
+}
@@ -0,0 +1,48 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+type truncateCollectionTask struct {
+	baseTask
+	Req *rootcoordpb.TruncateCollectionRequest
+}
+
+func (t *truncateCollectionTask) Prepare(ctx context.Context) error {
+	if t.Req.GetCollectionName() == "" {
+		return errors.New("collection name should not be empty")
+	}
+	if t.core.meta.IsAlias(t.Req.GetDbName(), t.Req.GetCollectionName()) {
+		return fmt.Errorf("cannot truncate the collection via alias = %s", t.Req.GetCollectionName())
+	}
+	return nil
+}
+
+func (t *truncateCollectionTask) Execute(ctx context.Context) error {
+	collMeta, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+
+	// the schema, indexes and partitions are kept, only the segments with the data before the DDL are dropped.
+	// the collection is released first so that the dropped segments are not served anymore,
+	// it should be loaded again after truncated.
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&releaseCollectionStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collMeta.CollectionID,
+	})
+	redoTask.AddSyncStep(&dropCollectionSegmentsStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collMeta.CollectionID,
+		ts:           t.GetTs(),
+	})
+
+	return redoTask.Execute(ctx)
+}
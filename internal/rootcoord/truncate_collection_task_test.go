@@ -0,0 +1,131 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_truncateCollectionTask_Prepare(t *testing.T) {
+	t.Run("empty name", func(t *testing.T) {
+		task := &truncateCollectionTask{
+			Req: &rootcoordpb.TruncateCollectionRequest{},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("truncate via alias", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.IsAliasFunc = func(dbName string, name string) bool {
+			return true
+		}
+		core := newTestCore(withMeta(meta))
+		task := &truncateCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.TruncateCollectionRequest{CollectionName: funcutil.GenRandomStr()},
+		}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.IsAliasFunc = func(dbName string, name string) bool {
+			return false
+		}
+		core := newTestCore(withMeta(meta))
+		task := &truncateCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.TruncateCollectionRequest{CollectionName: funcutil.GenRandomStr()},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_truncateCollectionTask_Execute(t *testing.T) {
+	t.Run("collection not exist", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return nil, errors.New("error mock GetCollectionByName")
+		}
+		core := newTestCore(withMeta(meta))
+		task := &truncateCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.TruncateCollectionRequest{CollectionName: funcutil.GenRandomStr()},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to release collection", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 1}, nil
+		}
+		broker := newMockBroker()
+		broker.ReleaseCollectionFunc = func(ctx context.Context, collectionID UniqueID) error {
+			return errors.New("error mock ReleaseCollection")
+		}
+		core := newTestCore(withMeta(meta), withBroker(broker))
+		task := &truncateCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.TruncateCollectionRequest{CollectionName: funcutil.GenRandomStr()},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to drop segments", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 1}, nil
+		}
+		broker := newMockBroker()
+		broker.ReleaseCollectionFunc = func(ctx context.Context, collectionID UniqueID) error {
+			return nil
+		}
+		broker.DropCollectionSegmentsFunc = func(ctx context.Context, collID UniqueID, ts Timestamp) error {
+			return errors.New("error mock DropCollectionSegments")
+		}
+		core := newTestCore(withMeta(meta), withBroker(broker))
+		task := &truncateCollectionTask{
+			baseTask: baseTask{core: core},
+			Req:      &rootcoordpb.TruncateCollectionRequest{CollectionName: funcutil.GenRandomStr()},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 1}, nil
+		}
+		broker := newMockBroker()
+		broker.ReleaseCollectionFunc = func(ctx context.Context, collectionID UniqueID) error {
+			return nil
+		}
+		var dropped UniqueID
+		var droppedTs Timestamp
+		broker.DropCollectionSegmentsFunc = func(ctx context.Context, collID UniqueID, ts Timestamp) error {
+			dropped, droppedTs = collID, ts
+			return nil
+		}
+		core := newTestCore(withMeta(meta), withBroker(broker))
+		task := &truncateCollectionTask{
+			baseTask: baseTask{core: core, ts: 100},
+			Req:      &rootcoordpb.TruncateCollectionRequest{CollectionName: funcutil.GenRandomStr()},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, UniqueID(1), dropped)
+		assert.Equal(t, Timestamp(100), droppedTs)
+	})
+}
@@ -0,0 +1,435 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/service"
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+const (
+	// azureMaxRetries is the max retries of the failed requests to azure blob storage,
+	// the requests are retried with exponential backoff.
+	azureMaxRetries = 5
+	// azureTryTimeout is the timeout of a single try of the requests.
+	azureTryTimeout = time.Minute
+	// azureListPageSize is the max number of blobs returned by a list request.
+	azureListPageSize int32 = 1000
+)
+
+// AzureChunkManager is responsible for read and write data stored in azure blob storage.
+// The bucket name is the container name, the access key id and the secret access key are
+// the storage account name and the account key.
+type AzureChunkManager struct {
+	client *container.Client
+
+	containerName string
+	rootPath      string
+}
+
+var _ ChunkManager = (*AzureChunkManager)(nil)
+
+// NewAzureChunkManager creates a new azure blob storage chunk manager.
+// Deprecated: Do not call this directly! Use factory.NewPersistentStorageChunkManager instead.
+func NewAzureChunkManager(ctx context.Context, opts ...Option) (*AzureChunkManager, error) {
+	c := newDefaultConfig()
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return newAzureChunkManagerWithConfig(ctx, c)
+}
+
+func newAzureChunkManagerWithConfig(ctx context.Context, c *config) (*AzureChunkManager, error) {
+	if c.useIAM {
+		return nil, errors.New("iam is not supported by azure blob storage, use the account key instead")
+	}
+	cred, err := service.NewSharedKeyCredential(c.accessKeyID, c.secretAccessKeyID)
+	if err != nil {
+		return nil, err
+	}
+	opts := &service.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Retry: policy.RetryOptions{
+				MaxRetries: azureMaxRetries,
+				TryTimeout: azureTryTimeout,
+			},
+		},
+	}
+	serviceClient, err := service.NewClientWithSharedKeyCredential(azureServiceURL(c), cred, opts)
+	// invalid formatted endpoint, don't need to retry
+	if err != nil {
+		return nil, err
+	}
+	containerClient := serviceClient.NewContainerClient(c.bucketName)
+
+	// check valid in first query
+	checkContainerFn := func() error {
+		_, err := containerClient.GetProperties(ctx, nil)
+		if err == nil {
+			return nil
+		}
+		if !bloberror.HasCode(err, bloberror.ContainerNotFound) {
+			log.Warn("failed to check blob container exist", zap.String("container", c.bucketName), zap.Error(err))
+			return err
+		}
+		if !c.createBucket {
+			return fmt.Errorf("container %s not Existed", c.bucketName)
+		}
+		log.Info("blob container not exist, create container.", zap.String("container", c.bucketName))
+		_, err = containerClient.Create(ctx, nil)
+		if err != nil && !bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+			log.Warn("failed to create blob container", zap.String("container", c.bucketName), zap.Error(err))
+			return err
+		}
+		return nil
+	}
+	err = retry.Do(ctx, checkContainerFn, retry.Attempts(CheckBucketRetryAttempts))
+	if err != nil {
+		return nil, err
+	}
+
+	acm := &AzureChunkManager{
+		client:        containerClient,
+		containerName: c.bucketName,
+		// no leading "/"
+		rootPath: strings.TrimLeft(c.rootPath, "/"),
+	}
+	log.Info("azure chunk manager init success.", zap.String("container", c.bucketName), zap.String("root", acm.RootPath()))
+	return acm, nil
+}
+
+// azureServiceURL returns the url of the blob service of the storage account.
+// The path style url is used for the emulators, like http://127.0.0.1:10000/devstoreaccount1/,
+// the address of which is an ip, localhost or a single label host name.
+// Otherwise the account name is prepended to the address, like https://account.blob.core.windows.net/.
+func azureServiceURL(c *config) string {
+	scheme := "http"
+	if c.useSSL {
+		scheme = "https"
+	}
+	if c.address == "" {
+		return fmt.Sprintf("https://%s.blob.core.windows.net/", c.accessKeyID)
+	}
+	host, _, err := net.SplitHostPort(c.address)
+	if err != nil {
+		host = c.address
+	}
+	if net.ParseIP(host) != nil || host == "localhost" || !strings.Contains(host, ".") {
+		return fmt.Sprintf("%s://%s/%s/", scheme, c.address, c.accessKeyID)
+	}
+	if strings.HasPrefix(host, c.accessKeyID+".") {
+		return fmt.Sprintf("%s://%s/", scheme, c.address)
+	}
+	return fmt.Sprintf("%s://%s.%s/", scheme, c.accessKeyID, c.address)
+}
+
+// RootPath returns azure root path.
+func (acm *AzureChunkManager) RootPath() string {
+	return acm.rootPath
+}
+
+// Path returns the path of azure data if exists.
+func (acm *AzureChunkManager) Path(ctx context.Context, filePath string) (string, error) {
+	exist, err := acm.Exist(ctx, filePath)
+	if err != nil {
+		return "", err
+	}
+	if !exist {
+		return "", errors.New("azure file manage cannot be found with filePath:" + filePath)
+	}
+	return filePath, nil
+}
+
+// Reader returns a reader of the blob, the broken connections are resumed by the reader.
+func (acm *AzureChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	resp, err := acm.client.NewBlobClient(filePath).DownloadStream(ctx, nil)
+	if err != nil {
+		log.Warn("failed to get blob", zap.String("path", filePath), zap.Error(err))
+		return nil, acm.wrapErr(filePath, err)
+	}
+	return resp.NewRetryReader(ctx, &blob.RetryReaderOptions{MaxRetries: azureMaxRetries}), nil
+}
+
+// Size returns the size of the blob.
+func (acm *AzureChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	props, err := acm.client.NewBlobClient(filePath).GetProperties(ctx, nil)
+	if err != nil {
+		log.Warn("failed to get blob properties", zap.String("path", filePath), zap.Error(err))
+		return 0, acm.wrapErr(filePath, err)
+	}
+	if props.ContentLength == nil {
+		return 0, fmt.Errorf("no content length of blob %s", filePath)
+	}
+	return *props.ContentLength, nil
+}
+
+// Write writes the data to azure blob storage, the large data is uploaded in blocks concurrently.
+func (acm *AzureChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	_, err := acm.client.NewBlockBlobClient(filePath).UploadBuffer(ctx, content, nil)
+	if err != nil {
+		log.Warn("failed to upload blob", zap.String("path", filePath), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// MultiWrite saves multiple blobs, the path is the key of @kvs.
+// The blob value is the value of @kvs.
+func (acm *AzureChunkManager) MultiWrite(ctx context.Context, kvs map[string][]byte) error {
+	var el errorutil.ErrorList
+	for key, value := range kvs {
+		err := acm.Write(ctx, key, value)
+		if err != nil {
+			el = append(el, err)
+		}
+	}
+	if len(el) == 0 {
+		return nil
+	}
+	return el
+}
+
+// Exist checks whether the blob is saved to azure blob storage.
+func (acm *AzureChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
+	_, err := acm.client.NewBlobClient(filePath).GetProperties(ctx, nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return false, nil
+		}
+		log.Warn("failed to get blob properties", zap.String("path", filePath), zap.Error(err))
+		return false, err
+	}
+	return true, nil
+}
+
+// Read reads the azure blob storage data if exists.
+func (acm *AzureChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	return acm.download(ctx, filePath, nil)
+}
+
+func (acm *AzureChunkManager) MultiRead(ctx context.Context, keys []string) ([][]byte, error) {
+	var el errorutil.ErrorList
+	var objectsValues [][]byte
+	for _, key := range keys {
+		objectValue, err := acm.Read(ctx, key)
+		if err != nil {
+			el = append(el, err)
+		}
+		objectsValues = append(objectsValues, objectValue)
+	}
+
+	if len(el) == 0 {
+		return objectsValues, nil
+	}
+	return objectsValues, el
+}
+
+func (acm *AzureChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	objectsKeys, _, err := acm.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	objectsValues, err := acm.MultiRead(ctx, objectsKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return objectsKeys, objectsValues, nil
+}
+
+func (acm *AzureChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	return nil, errors.New("this method has not been implemented")
+}
+
+// ReadAt reads specific position data of azure blob storage if exists.
+func (acm *AzureChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if off < 0 || length < 0 {
+		return nil, io.EOF
+	}
+	if length == 0 {
+		return []byte{}, nil
+	}
+	data, err := acm.download(ctx, filePath, &blob.HTTPRange{Offset: off, Count: length})
+	if bloberror.HasCode(err, bloberror.InvalidRange) {
+		return nil, io.EOF
+	}
+	return data, err
+}
+
+// download reads the range of the blob, the whole blob is read if @httpRange is nil.
+// The reading is resumed from the broken point if the connection is broken.
+func (acm *AzureChunkManager) download(ctx context.Context, filePath string, httpRange *blob.HTTPRange) ([]byte, error) {
+	opts := &blob.DownloadStreamOptions{}
+	if httpRange != nil {
+		opts.Range = *httpRange
+	}
+	resp, err := acm.client.NewBlobClient(filePath).DownloadStream(ctx, opts)
+	if err != nil {
+		log.Warn("failed to get blob", zap.String("path", filePath), zap.Error(err))
+		return nil, acm.wrapErr(filePath, err)
+	}
+	reader := resp.NewRetryReader(ctx, &blob.RetryReaderOptions{MaxRetries: azureMaxRetries})
+	defer reader.Close()
+
+	var size int64
+	if resp.ContentLength != nil {
+		size = *resp.ContentLength
+	}
+	data, err := Read(reader, size)
+	if err != nil {
+		log.Warn("failed to read blob", zap.String("path", filePath), zap.Error(err))
+		return nil, acm.wrapErr(filePath, err)
+	}
+	return data, nil
+}
+
+// Remove deletes a blob with @key.
+func (acm *AzureChunkManager) Remove(ctx context.Context, filePath string) error {
+	_, err := acm.client.NewBlobClient(filePath).Delete(ctx, nil)
+	if err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		log.Warn("failed to remove blob", zap.String("path", filePath), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// MultiRemove deletes the blobs with @keys.
+func (acm *AzureChunkManager) MultiRemove(ctx context.Context, keys []string) error {
+	var el errorutil.ErrorList
+	for _, key := range keys {
+		err := acm.Remove(ctx, key)
+		if err != nil {
+			el = append(el, err)
+		}
+	}
+	if len(el) == 0 {
+		return nil
+	}
+	return el
+}
+
+// RemoveWithPrefix removes all blobs with the same prefix @prefix from azure blob storage.
+func (acm *AzureChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	pager := acm.client.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{
+		Prefix:     &prefix,
+		MaxResults: toPtr(azureListPageSize),
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			log.Warn("failed to list blobs", zap.String("prefix", prefix), zap.Error(err))
+			return err
+		}
+		keys := make([]string, 0, len(page.Segment.BlobItems))
+		for _, item := range page.Segment.BlobItems {
+			keys = append(keys, *item.Name)
+		}
+		if err := acm.MultiRemove(ctx, keys); err != nil {
+			log.Warn("failed to remove blobs", zap.String("prefix", prefix), zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// ListWithPrefix returns blobs with provided prefix.
+// by default, if `recursive`=false, list blobs with return blob with path under save level
+// say azure has following blobs: [a, ab, a/b, ab/c]
+// calling `ListWithPrefix` with `prefix` = a && `recursive` = false will only returns [a, ab, a/, ab/]
+// If caller needs all blobs without level limitation, `recursive` shall be true.
+// The blobs are listed page by page, each page holds at most azureListPageSize blobs.
+func (acm *AzureChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
+	var objectsKeys []string
+	var modTimes []time.Time
+
+	appendItems := func(items []*container.BlobItem) {
+		for _, item := range items {
+			objectsKeys = append(objectsKeys, *item.Name)
+			var modTime time.Time
+			if item.Properties != nil && item.Properties.LastModified != nil {
+				modTime = *item.Properties.LastModified
+			}
+			modTimes = append(modTimes, modTime)
+		}
+	}
+
+	if recursive {
+		pager := acm.client.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{
+			Prefix:     &prefix,
+			MaxResults: toPtr(azureListPageSize),
+		})
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				log.Warn("failed to list with prefix", zap.String("prefix", prefix), zap.Error(err))
+				return nil, nil, err
+			}
+			appendItems(page.Segment.BlobItems)
+		}
+		return objectsKeys, modTimes, nil
+	}
+
+	pager := acm.client.NewListBlobsHierarchyPager("/", &container.ListBlobsHierarchyOptions{
+		Prefix:     &prefix,
+		MaxResults: toPtr(azureListPageSize),
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			log.Warn("failed to list with prefix", zap.String("prefix", prefix), zap.Error(err))
+			return nil, nil, err
+		}
+		appendItems(page.Segment.BlobItems)
+		// the "directories" end with "/"
+		for _, blobPrefix := range page.Segment.BlobPrefixes {
+			objectsKeys = append(objectsKeys, *blobPrefix.Name)
+			modTimes = append(modTimes, time.Time{})
+		}
+	}
+	return objectsKeys, modTimes, nil
+}
+
+// wrapErr wraps the blob not found error as ErrNoSuchKey, as the other chunk managers do.
+func (acm *AzureChunkManager) wrapErr(filePath string, err error) error {
+	if bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return WrapErrNoSuchKey(filePath)
+	}
+	return err
+}
+
+func toPtr[T any](v T) *T {
+	return &v
+}
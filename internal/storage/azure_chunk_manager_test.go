@@ -0,0 +1,339 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// the well-known account of azurite, see https://github.com/Azure/Azurite#default-storage-account
+	azuriteAccountName = "devstoreaccount1"
+	azuriteAccountKey  = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+func getAzuriteAddress() string {
+	if addr := os.Getenv("AZURITE_ADDRESS"); addr != "" {
+		return addr
+	}
+	return "localhost:10000"
+}
+
+// newAzureChunkManager creates the chunk manager against the azurite emulator,
+// the test is skipped if the emulator is not running.
+func newAzureChunkManager(ctx context.Context, t *testing.T, containerName string, rootPath string) *AzureChunkManager {
+	address := getAzuriteAddress()
+	conn, err := net.DialTimeout("tcp", address, time.Second)
+	if err != nil {
+		t.Skipf("azurite is not running at %s, skip the test", address)
+	}
+	conn.Close()
+
+	acm, err := NewAzureChunkManager(ctx,
+		RootPath(rootPath),
+		Address(address),
+		AccessKeyID(azuriteAccountName),
+		SecretAccessKeyID(azuriteAccountKey),
+		UseSSL(false),
+		BucketName(containerName),
+		CloudProvider(CloudProviderAzure),
+		CreateBucket(true),
+	)
+	require.NoError(t, err)
+	return acm
+}
+
+func TestAzureServiceURL(t *testing.T) {
+	tests := []struct {
+		address  string
+		useSSL   bool
+		expected string
+	}{
+		{"", true, "https://account.blob.core.windows.net/"},
+		{"localhost:10000", false, "http://localhost:10000/account/"},
+		{"127.0.0.1:10000", false, "http://127.0.0.1:10000/account/"},
+		{"azurite:10000", false, "http://azurite:10000/account/"},
+		{"blob.core.windows.net:443", true, "https://account.blob.core.windows.net:443/"},
+		{"account.blob.core.windows.net:443", true, "https://account.blob.core.windows.net:443/"},
+	}
+	for _, test := range tests {
+		t.Run(test.address, func(t *testing.T) {
+			c := newDefaultConfig()
+			c.address = test.address
+			c.useSSL = test.useSSL
+			c.accessKeyID = "account"
+			assert.Equal(t, test.expected, azureServiceURL(c))
+		})
+	}
+}
+
+func TestAzureCMFail(t *testing.T) {
+	_, err := NewAzureChunkManager(context.Background(),
+		Address(getAzuriteAddress()),
+		AccessKeyID(azuriteAccountName),
+		SecretAccessKeyID(azuriteAccountKey),
+		BucketName("test"),
+		UseIAM(true),
+	)
+	assert.Error(t, err)
+
+	_, err = NewAzureChunkManager(context.Background(),
+		Address(getAzuriteAddress()),
+		AccessKeyID(azuriteAccountName),
+		SecretAccessKeyID("invalid base64 key"),
+		BucketName("test"),
+	)
+	assert.Error(t, err)
+}
+
+func TestAzureCM(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	containerName := "azure-cm-test"
+	testRoot := "test-azure"
+	acm := newAzureChunkManager(ctx, t, containerName, testRoot)
+	defer acm.RemoveWithPrefix(ctx, testRoot)
+	assert.Equal(t, testRoot, acm.RootPath())
+
+	t.Run("test load", func(t *testing.T) {
+		prepareTests := []struct {
+			key   string
+			value []byte
+		}{
+			{"abc", []byte("123")},
+			{"abcd", []byte("1234")},
+			{"key_1", []byte("111")},
+			{"key_2", []byte("222")},
+			{"key_3", []byte("333")},
+		}
+		for _, test := range prepareTests {
+			err := acm.Write(ctx, path.Join(testRoot, test.key), test.value)
+			require.NoError(t, err)
+		}
+
+		got, err := acm.Read(ctx, path.Join(testRoot, "abc"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("123"), got)
+
+		keys, values, err := acm.ReadWithPrefix(ctx, path.Join(testRoot, "key_"))
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{path.Join(testRoot, "key_1"), path.Join(testRoot, "key_2"), path.Join(testRoot, "key_3")}, keys)
+		assert.ElementsMatch(t, [][]byte{[]byte("111"), []byte("222"), []byte("333")}, values)
+
+		values, err = acm.MultiRead(ctx, []string{path.Join(testRoot, "abc"), path.Join(testRoot, "not_exist")})
+		assert.Error(t, err)
+		assert.Equal(t, []byte("123"), values[0])
+	})
+
+	t.Run("test MultiSave", func(t *testing.T) {
+		kvs := map[string][]byte{
+			path.Join(testRoot, "multi", "key_1"): []byte("111"),
+			path.Join(testRoot, "multi", "key_2"): []byte("222"),
+		}
+		err := acm.MultiWrite(ctx, kvs)
+		assert.NoError(t, err)
+		for key, value := range kvs {
+			got, err := acm.Read(ctx, key)
+			assert.NoError(t, err)
+			assert.Equal(t, value, got)
+		}
+	})
+
+	t.Run("test Reader", func(t *testing.T) {
+		key := path.Join(testRoot, "reader")
+		err := acm.Write(ctx, key, []byte("reader value"))
+		require.NoError(t, err)
+
+		reader, err := acm.Reader(ctx, key)
+		require.NoError(t, err)
+		got, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("reader value"), got)
+		assert.NoError(t, reader.Close())
+
+		_, err = acm.Reader(ctx, path.Join(testRoot, "not_exist"))
+		assert.True(t, errors.Is(err, ErrNoSuchKey))
+	})
+
+	t.Run("test Remove", func(t *testing.T) {
+		key := path.Join(testRoot, "remove")
+		err := acm.Write(ctx, key, []byte("value"))
+		require.NoError(t, err)
+
+		exist, err := acm.Exist(ctx, key)
+		assert.NoError(t, err)
+		assert.True(t, exist)
+
+		err = acm.Remove(ctx, key)
+		assert.NoError(t, err)
+		exist, err = acm.Exist(ctx, key)
+		assert.NoError(t, err)
+		assert.False(t, exist)
+
+		// removing a not existed blob is ok
+		err = acm.Remove(ctx, key)
+		assert.NoError(t, err)
+
+		keys := []string{path.Join(testRoot, "remove_1"), path.Join(testRoot, "remove_2")}
+		for _, key := range keys {
+			require.NoError(t, acm.Write(ctx, key, []byte("value")))
+		}
+		err = acm.MultiRemove(ctx, keys)
+		assert.NoError(t, err)
+		for _, key := range keys {
+			exist, err := acm.Exist(ctx, key)
+			assert.NoError(t, err)
+			assert.False(t, exist)
+		}
+	})
+
+	t.Run("test ReadAt", func(t *testing.T) {
+		key := path.Join(testRoot, "read_at")
+		value := []byte("Milvus-ReadAt-Test")
+		err := acm.Write(ctx, key, value)
+		require.NoError(t, err)
+
+		got, err := acm.ReadAt(ctx, key, 0, int64(len(value)))
+		assert.NoError(t, err)
+		assert.Equal(t, value, got)
+
+		got, err = acm.ReadAt(ctx, key, 7, 6)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("ReadAt"), got)
+
+		got, err = acm.ReadAt(ctx, key, 0, 0)
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+
+		_, err = acm.ReadAt(ctx, key, -1, 1)
+		assert.ErrorIs(t, err, io.EOF)
+
+		_, err = acm.ReadAt(ctx, key, int64(len(value))+10, 1)
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("test Size", func(t *testing.T) {
+		key := path.Join(testRoot, "size")
+		err := acm.Write(ctx, key, []byte("12345"))
+		require.NoError(t, err)
+
+		size, err := acm.Size(ctx, key)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 5, size)
+
+		_, err = acm.Size(ctx, path.Join(testRoot, "not_exist"))
+		assert.True(t, errors.Is(err, ErrNoSuchKey))
+	})
+
+	t.Run("test Path", func(t *testing.T) {
+		key := path.Join(testRoot, "path")
+		err := acm.Write(ctx, key, []byte("value"))
+		require.NoError(t, err)
+
+		p, err := acm.Path(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, key, p)
+
+		_, err = acm.Path(ctx, path.Join(testRoot, "not_exist"))
+		assert.Error(t, err)
+	})
+
+	t.Run("test Mmap", func(t *testing.T) {
+		_, err := acm.Mmap(ctx, path.Join(testRoot, "abc"))
+		assert.Error(t, err)
+	})
+
+	t.Run("test Prefix", func(t *testing.T) {
+		prefix := path.Join(testRoot, "prefix")
+		keys := []string{"a", "ab", "a/b", "ab/c", "ab/c/d"}
+		for _, key := range keys {
+			require.NoError(t, acm.Write(ctx, path.Join(prefix, key), []byte(key)))
+		}
+
+		got, modTimes, err := acm.ListWithPrefix(ctx, path.Join(prefix, "a"), true)
+		assert.NoError(t, err)
+		assert.Equal(t, len(keys), len(got))
+		assert.Equal(t, len(got), len(modTimes))
+		for _, modTime := range modTimes {
+			assert.False(t, modTime.IsZero())
+		}
+
+		got, _, err = acm.ListWithPrefix(ctx, path.Join(prefix, "a"), false)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{
+			path.Join(prefix, "a"),
+			path.Join(prefix, "ab"),
+			path.Join(prefix, "a") + "/",
+			path.Join(prefix, "ab") + "/",
+		}, got)
+
+		got, _, err = acm.ListWithPrefix(ctx, path.Join(prefix, "ab")+"/", false)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{path.Join(prefix, "ab", "c"), path.Join(prefix, "ab", "c") + "/"}, got)
+
+		err = acm.RemoveWithPrefix(ctx, path.Join(prefix, "ab"))
+		assert.NoError(t, err)
+		got, _, err = acm.ListWithPrefix(ctx, prefix, true)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{path.Join(prefix, "a"), path.Join(prefix, "a", "b")}, got)
+	})
+
+	t.Run("test pagination", func(t *testing.T) {
+		prefix := path.Join(testRoot, "pagination")
+		count := int(azureListPageSize) + 10
+		kvs := make(map[string][]byte, count)
+		for i := 0; i < count; i++ {
+			kvs[path.Join(prefix, strconv.Itoa(i))] = []byte(strconv.Itoa(i))
+		}
+		require.NoError(t, acm.MultiWrite(ctx, kvs))
+
+		got, _, err := acm.ListWithPrefix(ctx, prefix, true)
+		assert.NoError(t, err)
+		assert.Equal(t, count, len(got))
+
+		got, _, err = acm.ListWithPrefix(ctx, prefix+"/", false)
+		assert.NoError(t, err)
+		assert.Equal(t, count, len(got))
+
+		err = acm.RemoveWithPrefix(ctx, prefix)
+		assert.NoError(t, err)
+		got, _, err = acm.ListWithPrefix(ctx, prefix, true)
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("test NoSuchKey", func(t *testing.T) {
+		key := path.Join(testRoot, "not_exist")
+		_, err := acm.Read(ctx, key)
+		assert.True(t, errors.Is(err, ErrNoSuchKey))
+
+		_, err = acm.ReadAt(ctx, key, 0, 1)
+		assert.True(t, errors.Is(err, ErrNoSuchKey))
+	})
+}
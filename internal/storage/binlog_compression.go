@@ -0,0 +1,82 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+)
+
+// compressionKey is the key of the descriptor event extras recording the codec of the event payloads,
+// the payloads are not compressed if it's absent.
+const compressionKey = "compression"
+
+// BinlogCompression is the codec and the level to compress the payloads of the insert and delete binlogs.
+// The zero value means no compression.
+type BinlogCompression struct {
+	Type  compressor.CompressType
+	Level int
+}
+
+// Enabled tells whether the payloads are compressed.
+func (c BinlogCompression) Enabled() bool {
+	return c.Type != ""
+}
+
+// GetBinlogCompression parses the binlog compression from the collection properties.
+func GetBinlogCompression(properties []*commonpb.KeyValuePair) (BinlogCompression, error) {
+	compression := BinlogCompression{}
+	for _, kv := range properties {
+		switch kv.GetKey() {
+		case common.CollectionBinlogCompressionKey:
+			compression.Type = compressor.CompressType(kv.GetValue())
+		case common.CollectionBinlogCompressionLevelKey:
+			level, err := strconv.Atoi(kv.GetValue())
+			if err != nil {
+				return BinlogCompression{}, fmt.Errorf("invalid binlog compression level %s: %w", kv.GetValue(), err)
+			}
+			compression.Level = level
+		}
+	}
+	switch compression.Type {
+	case "", compressor.CompressTypeZstd, compressor.CompressTypeLZ4, compressor.CompressTypeSnappy:
+	default:
+		return BinlogCompression{}, fmt.Errorf("unsupported binlog compression: %s", compression.Type)
+	}
+	return compression, nil
+}
+
+func compressPayload(compression BinlogCompression, payload []byte) ([]byte, error) {
+	enc, err := compressor.NewCompressor(compression.Type, compression.Level, nil)
+	if err != nil {
+		return nil, err
+	}
+	return enc.CompressBytes(payload, nil), nil
+}
+
+func decompressPayload(compressType compressor.CompressType, payload []byte) ([]byte, error) {
+	dec, err := compressor.NewDecompressor(compressType, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer dec.Close()
+	return dec.DecompressBytes(payload, nil)
+}
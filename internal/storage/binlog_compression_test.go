@@ -0,0 +1,134 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBinlogCompression(t *testing.T) {
+	compression, err := GetBinlogCompression(nil)
+	assert.NoError(t, err)
+	assert.False(t, compression.Enabled())
+
+	compression, err = GetBinlogCompression([]*commonpb.KeyValuePair{
+		{Key: common.CollectionTTLConfigKey, Value: "100"},
+		{Key: common.CollectionBinlogCompressionKey, Value: "lz4"},
+		{Key: common.CollectionBinlogCompressionLevelKey, Value: "9"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, compression.Enabled())
+	assert.Equal(t, BinlogCompression{Type: compressor.CompressTypeLZ4, Level: 9}, compression)
+
+	_, err = GetBinlogCompression([]*commonpb.KeyValuePair{
+		{Key: common.CollectionBinlogCompressionKey, Value: "gzip"},
+	})
+	assert.Error(t, err)
+
+	_, err = GetBinlogCompression([]*commonpb.KeyValuePair{
+		{Key: common.CollectionBinlogCompressionKey, Value: "zstd"},
+		{Key: common.CollectionBinlogCompressionLevelKey, Value: "high"},
+	})
+	assert.Error(t, err)
+}
+
+func TestBinlogCompression(t *testing.T) {
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: StringField, Name: "field_string", DataType: schemapb.DataType_VarChar},
+			},
+		},
+	}
+	rowNum := 1000
+	insertData := &InsertData{Data: map[FieldID]FieldData{
+		RowIDField:     &Int64FieldData{NumRows: []int64{int64(rowNum)}},
+		TimestampField: &Int64FieldData{NumRows: []int64{int64(rowNum)}},
+		Int64Field:     &Int64FieldData{NumRows: []int64{int64(rowNum)}},
+		StringField:    &StringFieldData{NumRows: []int64{int64(rowNum)}},
+	}}
+	deleteData := &DeleteData{}
+	for i := 0; i < rowNum; i++ {
+		insertData.Data[RowIDField].(*Int64FieldData).Data = append(insertData.Data[RowIDField].(*Int64FieldData).Data, int64(i))
+		insertData.Data[TimestampField].(*Int64FieldData).Data = append(insertData.Data[TimestampField].(*Int64FieldData).Data, int64(i+1))
+		insertData.Data[Int64Field].(*Int64FieldData).Data = append(insertData.Data[Int64Field].(*Int64FieldData).Data, int64(i))
+		insertData.Data[StringField].(*StringFieldData).Data = append(insertData.Data[StringField].(*StringFieldData).Data, "the same string")
+		deleteData.Append(NewInt64PrimaryKey(int64(i)), uint64(i+1))
+	}
+
+	plainBlobs, _, err := NewInsertCodec(schema).Serialize(PartitionID, SegmentID, insertData)
+	require.NoError(t, err)
+	plainDeltaBlob, err := NewDeleteCodec().Serialize(CollectionID, PartitionID, SegmentID, deleteData)
+	require.NoError(t, err)
+
+	for _, compressType := range []compressor.CompressType{compressor.CompressTypeZstd, compressor.CompressTypeLZ4, compressor.CompressTypeSnappy} {
+		t.Run(string(compressType), func(t *testing.T) {
+			schema.Properties = []*commonpb.KeyValuePair{
+				{Key: common.CollectionBinlogCompressionKey, Value: string(compressType)},
+				{Key: common.CollectionBinlogCompressionLevelKey, Value: "3"},
+			}
+			insertCodec := NewInsertCodec(schema)
+			blobs, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData)
+			require.NoError(t, err)
+			require.Equal(t, len(plainBlobs), len(blobs))
+			for i, blob := range blobs {
+				assert.Less(t, len(blob.Value), len(plainBlobs[i].Value))
+
+				reader, err := NewBinlogReader(blob.Value)
+				require.NoError(t, err)
+				assert.Equal(t, string(compressType), reader.Extras[compressionKey])
+				reader.Close()
+			}
+			_, _, resultData, err := insertCodec.Deserialize(blobs)
+			require.NoError(t, err)
+			assert.Equal(t, insertData.Data[Int64Field], resultData.Data[Int64Field])
+			assert.Equal(t, insertData.Data[StringField], resultData.Data[StringField])
+
+			compression, err := GetBinlogCompression(schema.Properties)
+			require.NoError(t, err)
+			deleteCodec := NewDeleteCodecWithCompression(compression)
+			deltaBlob, err := deleteCodec.Serialize(CollectionID, PartitionID, SegmentID, deleteData)
+			require.NoError(t, err)
+			assert.Less(t, len(deltaBlob.Value), len(plainDeltaBlob.Value))
+
+			// the reader detects the codec from the descriptor event
+			_, _, resultDelete, err := NewDeleteCodec().Deserialize([]*Blob{deltaBlob})
+			require.NoError(t, err)
+			assert.Equal(t, deleteData, resultDelete)
+		})
+	}
+
+	t.Run("unsupported codec", func(t *testing.T) {
+		schema.Properties = []*commonpb.KeyValuePair{
+			{Key: common.CollectionBinlogCompressionKey, Value: "gzip"},
+		}
+		_, _, err := NewInsertCodec(schema).Serialize(PartitionID, SegmentID, insertData)
+		assert.Error(t, err)
+	})
+}
@@ -90,6 +90,11 @@ func (itr *InsertBinlogIterator) Next() (interface{}, error) {
 
 	m := make(map[FieldID]interface{})
 	for fieldID, fieldData := range itr.data.Data {
+		// the null rows of nullable fields are nil
+		if nullableData, ok := fieldData.(NullableFieldData); ok && nullableData.GetValidData() != nil && !nullableData.GetValidData()[itr.pos] {
+			m[fieldID] = nil
+			continue
+		}
 		m[fieldID] = fieldData.GetRow(itr.pos)
 	}
 	pk, err := GenPrimaryKeyByRawData(itr.data.Data[itr.PKfieldID].GetRow(itr.pos), itr.PkType)
@@ -125,6 +130,118 @@ func (itr *InsertBinlogIterator) isDisposed() bool {
 	return atomic.LoadInt32(&itr.dispose) == 1
 }
 
+// ColumnarBinlogIterator is the iterator of the columnar binlog of StorageV2,
+// the row groups are decoded one by one and only the projected columns are decoded.
+type ColumnarBinlogIterator struct {
+	dispose   int32 // 0: false, 1: true
+	reader    *ColumnarBinlogReader
+	fieldIDs  []FieldID
+	PKfieldID int64
+	PkType    schemapb.DataType
+
+	rowGroup int
+	data     *InsertData
+	pos      int
+}
+
+// NewColumnarBinlogIterator creates a new iterator of the columnar binlog,
+// only the fields of fieldIDs are read besides the row id, timestamp and primary key fields,
+// all the fields are read if fieldIDs is empty.
+func NewColumnarBinlogIterator(blob *Blob, PKfieldID UniqueID, pkType schemapb.DataType, fieldIDs ...FieldID) (*ColumnarBinlogIterator, error) {
+	reader, err := NewColumnarBinlogReader(blob.Value)
+	if err != nil {
+		return nil, err
+	}
+	if len(fieldIDs) > 0 {
+		fieldIDs = append(fieldIDs, common.RowIDField, common.TimeStampField, PKfieldID)
+	}
+	return &ColumnarBinlogIterator{
+		reader:    reader,
+		fieldIDs:  fieldIDs,
+		PKfieldID: PKfieldID,
+		PkType:    pkType,
+	}, nil
+}
+
+// HasNext returns true if the iterator have unread record
+func (itr *ColumnarBinlogIterator) HasNext() bool {
+	if itr.isDisposed() {
+		return false
+	}
+	hasNext, _ := itr.hasNext()
+	return hasNext
+}
+
+// Next returns the next record
+func (itr *ColumnarBinlogIterator) Next() (interface{}, error) {
+	if itr.isDisposed() {
+		return nil, ErrDisposed
+	}
+
+	hasNext, err := itr.hasNext()
+	if err != nil {
+		return nil, err
+	}
+	if !hasNext {
+		return nil, ErrNoMoreRecord
+	}
+
+	m := make(map[FieldID]interface{})
+	for fieldID, fieldData := range itr.data.Data {
+		// the null rows of nullable fields are nil
+		if nullableData, ok := fieldData.(NullableFieldData); ok && nullableData.GetValidData() != nil && !nullableData.GetValidData()[itr.pos] {
+			m[fieldID] = nil
+			continue
+		}
+		m[fieldID] = fieldData.GetRow(itr.pos)
+	}
+	pk, err := GenPrimaryKeyByRawData(itr.data.Data[itr.PKfieldID].GetRow(itr.pos), itr.PkType)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &Value{
+		ID:        itr.data.Data[common.RowIDField].GetRow(itr.pos).(int64),
+		Timestamp: itr.data.Data[common.TimeStampField].GetRow(itr.pos).(int64),
+		PK:        pk,
+		IsDeleted: false,
+		Value:     m,
+	}
+	itr.pos++
+	return v, nil
+}
+
+// Dispose disposes the iterator
+func (itr *ColumnarBinlogIterator) Dispose() {
+	if atomic.CompareAndSwapInt32(&itr.dispose, 0, 1) {
+		itr.reader.Close()
+	}
+}
+
+// hasNext decodes the next row group if the rows of current one are all read.
+func (itr *ColumnarBinlogIterator) hasNext() (bool, error) {
+	for itr.data == nil || itr.pos >= itr.data.Data[common.RowIDField].RowNum() {
+		if itr.rowGroup >= itr.reader.NumRowGroups() {
+			return false, nil
+		}
+		data, err := itr.reader.ReadRowGroup(itr.rowGroup, itr.fieldIDs...)
+		if err != nil {
+			return false, err
+		}
+		if _, ok := data.Data[common.RowIDField]; !ok {
+			return false, errors.New("cannot get row ids from columnar binlog")
+		}
+		itr.data = data
+		itr.rowGroup++
+		itr.pos = 0
+	}
+	return true, nil
+}
+
+func (itr *ColumnarBinlogIterator) isDisposed() bool {
+	return atomic.LoadInt32(&itr.dispose) == 1
+}
+
 /*
 type DeltalogIterator struct {
 	dispose int32
@@ -17,6 +17,7 @@
 package storage
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
@@ -141,6 +142,63 @@ func TestInsertlogIterator(t *testing.T) {
 	})
 }
 
+func TestColumnarBinlogIterator(t *testing.T) {
+	insertCodec := NewInsertCodec(genColumnarTestCollection())
+	blob, _, err := insertCodec.SerializeV2(PartitionID, SegmentID, genColumnarTestData(0, 2), genColumnarTestData(2, 3))
+	assert.Nil(t, err)
+
+	t.Run("test dispose", func(t *testing.T) {
+		itr, err := NewColumnarBinlogIterator(blob, Int64Field, schemapb.DataType_Int64)
+		assert.Nil(t, err)
+
+		itr.Dispose()
+		assert.False(t, itr.HasNext())
+		_, err = itr.Next()
+		assert.Equal(t, ErrDisposed, err)
+	})
+
+	t.Run("iterate across row groups", func(t *testing.T) {
+		itr, err := NewColumnarBinlogIterator(blob, Int64Field, schemapb.DataType_Int64, FloatVectorField, StringField)
+		assert.Nil(t, err)
+		defer itr.Dispose()
+
+		for i := 0; i < 5; i++ {
+			assert.True(t, itr.HasNext())
+			v, err := itr.Next()
+			assert.Nil(t, err)
+			value := v.(*Value)
+
+			var str interface{}
+			if i%2 == 0 {
+				str = fmt.Sprintf("str-%d", i)
+			}
+			expected := &Value{
+				int64(i),
+				&Int64PrimaryKey{Value: int64(i)},
+				int64(i + 1),
+				false,
+				map[FieldID]interface{}{
+					common.RowIDField:     int64(i),
+					common.TimeStampField: int64(i + 1),
+					Int64Field:            int64(i),
+					StringField:           str,
+					FloatVectorField:      []float32{float32(i), float32(i + 1), float32(i + 2), float32(i + 3)},
+				},
+			}
+			assert.EqualValues(t, expected, value)
+		}
+
+		assert.False(t, itr.HasNext())
+		_, err = itr.Next()
+		assert.Equal(t, ErrNoMoreRecord, err)
+	})
+
+	t.Run("invalid blob", func(t *testing.T) {
+		_, err := NewColumnarBinlogIterator(&Blob{Value: []byte("PAR1")}, Int64Field, schemapb.DataType_Int64)
+		assert.NotNil(t, err)
+	})
+}
+
 func TestMergeIterator(t *testing.T) {
 	t.Run("empty iterators", func(t *testing.T) {
 		iterators := make([]Iterator, 0)
@@ -24,6 +24,7 @@ import (
 	"io"
 
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 )
 
 // BinlogReader is an object to read binlog file. Binlog file's format can be
@@ -49,13 +50,19 @@ func (reader *BinlogReader) NextEventReader() (*EventReader, error) {
 		reader.eventReader.Close()
 	}
 	var err error
-	reader.eventReader, err = newEventReader(reader.descriptorEvent.PayloadDataType, reader.buffer)
+	reader.eventReader, err = newEventReader(reader.descriptorEvent.PayloadDataType, reader.buffer, reader.compressType())
 	if err != nil {
 		return nil, err
 	}
 	return reader.eventReader, nil
 }
 
+// compressType returns the codec of the event payloads recorded in the descriptor event.
+func (reader *BinlogReader) compressType() compressor.CompressType {
+	compressType, _ := reader.Extras[compressionKey].(string)
+	return compressor.CompressType(compressType)
+}
+
 func (reader *BinlogReader) readMagicNumber() (int32, error) {
 	var err error
 	reader.magicNumber, err = readMagicNumber(reader.buffer)
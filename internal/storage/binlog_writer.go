@@ -53,12 +53,24 @@ type baseBinlogWriter struct {
 	eventWriters []EventWriter
 	buffer       *bytes.Buffer
 	length       int32
+	compression  BinlogCompression
 }
 
 func (writer *baseBinlogWriter) isClosed() bool {
 	return writer.buffer != nil
 }
 
+// SetCompression sets the codec to compress the payloads of the events created after,
+// the codec is recorded in the descriptor event so the readers decompress the payloads accordingly.
+func (writer *baseBinlogWriter) SetCompression(compression BinlogCompression) {
+	writer.compression = compression
+	if compression.Enabled() {
+		writer.AddExtra(compressionKey, string(compression.Type))
+	} else {
+		delete(writer.Extras, compressionKey)
+	}
+}
+
 // GetEventNums returns the number of event writers
 func (writer *baseBinlogWriter) GetEventNums() int32 {
 	return int32(len(writer.eventWriters))
@@ -167,6 +179,7 @@ func (writer *InsertBinlogWriter) NextInsertEventWriter(dim ...int) (*insertEven
 	if err != nil {
 		return nil, err
 	}
+	event.compression = writer.compression
 
 	writer.eventWriters = append(writer.eventWriters, event)
 	return event, nil
@@ -186,6 +199,7 @@ func (writer *DeleteBinlogWriter) NextDeleteEventWriter() (*deleteEventWriter, e
 	if err != nil {
 		return nil, err
 	}
+	event.compression = writer.compression
 	writer.eventWriters = append(writer.eventWriters, event)
 	return event, nil
 }
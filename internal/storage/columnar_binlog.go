@@ -0,0 +1,644 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/compress"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/metadata"
+	"github.com/apache/arrow/go/v8/parquet/schema"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// StorageV1 is the storage format writing each field of a segment flush into a separate binlog.
+	StorageV1 int64 = 0
+	// StorageV2 is the storage format writing all the fields of a segment flush into a parquet file,
+	// each field is a column, and each batch of the insert data is a row group.
+	StorageV2 int64 = 2
+)
+
+// the keys of the parquet file metadata
+const (
+	columnarCollectionIDKey = "milvus.collection_id"
+	columnarPartitionIDKey  = "milvus.partition_id"
+	columnarSegmentIDKey    = "milvus.segment_id"
+	// columnarSchemaKey is the key of the base64 encoded schema of the fields stored in the file,
+	// the order of the fields is the order of the columns.
+	columnarSchemaKey = "milvus.schema"
+)
+
+var parquetMagic = []byte("PAR1")
+
+// IsColumnarBinlog tells whether the insert binlog is written in the StorageV2 format,
+// the binlogs of StorageV1 start with the binlog magic number instead of the parquet one.
+func IsColumnarBinlog(buf []byte) bool {
+	return bytes.HasPrefix(buf, parquetMagic)
+}
+
+// columnarFieldSep separates the path of a columnar binlog and the field id in the path of a column.
+const columnarFieldSep = "#"
+
+// IsColumnarLogPath tells whether the insert binlog path is of a columnar binlog shared by the fields.
+func IsColumnarLogPath(logPath string) bool {
+	return path.Base(path.Dir(logPath)) == common.SegmentColumnarLogDir
+}
+
+// ColumnarFieldPath returns the path of a field in the columnar binlog, which tells the readers like VectorChunkManager
+// to read only the column of the field.
+func ColumnarFieldPath(logPath string, fieldID FieldID) string {
+	return logPath + columnarFieldSep + strconv.FormatInt(fieldID, 10)
+}
+
+// parseColumnarFieldPath returns the path of the columnar binlog and the field id in the path generated by ColumnarFieldPath.
+func parseColumnarFieldPath(filePath string) (string, FieldID, bool) {
+	idx := strings.LastIndex(filePath, columnarFieldSep)
+	if idx == -1 {
+		return filePath, 0, false
+	}
+	fieldID, err := strconv.ParseInt(filePath[idx+len(columnarFieldSep):], 10, 64)
+	if err != nil {
+		return filePath, 0, false
+	}
+	return filePath[:idx], fieldID, true
+}
+
+// ColumnarBinlogWriter writes the fields of a segment into a parquet file, each Write call appends a row group.
+type ColumnarBinlogWriter struct {
+	collectionID UniqueID
+	partitionID  UniqueID
+	segmentID    UniqueID
+	fields       []*schemapb.FieldSchema
+	compression  BinlogCompression
+
+	buffer *bytes.Buffer
+	writer *file.Writer
+}
+
+// NewColumnarBinlogWriter creates a writer of the segment, all the fields of the collection are written.
+func NewColumnarBinlogWriter(collMeta *etcdpb.CollectionMeta, partitionID, segmentID UniqueID) (*ColumnarBinlogWriter, error) {
+	compression, err := GetBinlogCompression(collMeta.GetProperties())
+	if err != nil {
+		return nil, err
+	}
+	return &ColumnarBinlogWriter{
+		collectionID: collMeta.GetID(),
+		partitionID:  partitionID,
+		segmentID:    segmentID,
+		fields:       collMeta.GetSchema().GetFields(),
+		compression:  compression,
+		buffer:       new(bytes.Buffer),
+	}, nil
+}
+
+// init creates the parquet writer, the dimensions of the vector fields are taken from the first batch.
+func (w *ColumnarBinlogWriter) init(data *InsertData) error {
+	nodes := make(schema.FieldList, 0, len(w.fields))
+	for _, field := range w.fields {
+		node, err := columnNode(field, data.Data[field.GetFieldID()])
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, node)
+	}
+	root, err := schema.NewGroupNode("schema", parquet.Repetitions.Required, nodes, -1)
+	if err != nil {
+		return err
+	}
+
+	schemaBytes, err := proto.Marshal(&schemapb.CollectionSchema{Fields: w.fields})
+	if err != nil {
+		return err
+	}
+	kvs := metadata.NewKeyValueMetadata()
+	kvs.Append(columnarCollectionIDKey, strconv.FormatInt(w.collectionID, 10))
+	kvs.Append(columnarPartitionIDKey, strconv.FormatInt(w.partitionID, 10))
+	kvs.Append(columnarSegmentIDKey, strconv.FormatInt(w.segmentID, 10))
+	kvs.Append(columnarSchemaKey, base64.StdEncoding.EncodeToString(schemaBytes))
+
+	w.writer = file.NewParquetWriter(w.buffer, root,
+		file.WithWriterProps(parquetWriterProperties(w.compression)),
+		file.WithWriteMetadata(kvs))
+	return nil
+}
+
+// parquetWriterProperties uses the parquet codec of the binlog compression.
+// LZ4 isn't implemented by the parquet library, snappy is used instead as both of them favor speed.
+func parquetWriterProperties(compression BinlogCompression) *parquet.WriterProperties {
+	opts := make([]parquet.WriterProperty, 0, 2)
+	switch compression.Type {
+	case compressor.CompressTypeZstd:
+		opts = append(opts, parquet.WithCompression(compress.Codecs.Zstd))
+	case compressor.CompressTypeLZ4, compressor.CompressTypeSnappy:
+		opts = append(opts, parquet.WithCompression(compress.Codecs.Snappy))
+	}
+	if compression.Level != 0 {
+		opts = append(opts, parquet.WithCompressionLevel(compression.Level))
+	}
+	return parquet.NewWriterProperties(opts...)
+}
+
+// columnNode returns the parquet column of the field, the field id of the column is the field id of milvus.
+// The scalar columns are optional to store the null rows, the vectors are stored as fixed length byte arrays.
+func columnNode(field *schemapb.FieldSchema, data FieldData) (schema.Node, error) {
+	name := strconv.FormatInt(field.GetFieldID(), 10)
+	id := int32(field.GetFieldID())
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return schema.NewBooleanNode(name, parquet.Repetitions.Optional, id), nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		return schema.NewInt32Node(name, parquet.Repetitions.Optional, id), nil
+	case schemapb.DataType_Int64:
+		return schema.NewInt64Node(name, parquet.Repetitions.Optional, id), nil
+	case schemapb.DataType_Float:
+		return schema.NewFloat32Node(name, parquet.Repetitions.Optional, id), nil
+	case schemapb.DataType_Double:
+		return schema.NewFloat64Node(name, parquet.Repetitions.Optional, id), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar, typeutil.DataTypeJSON, typeutil.DataTypeArray:
+		return schema.NewByteArrayNode(name, parquet.Repetitions.Optional, id), nil
+	case schemapb.DataType_BinaryVector:
+		vectorData, ok := data.(*BinaryVectorFieldData)
+		if !ok {
+			return nil, fmt.Errorf("missing data of field %d", field.GetFieldID())
+		}
+		return schema.NewFixedLenByteArrayNode(name, parquet.Repetitions.Required, int32(vectorData.Dim/8), id), nil
+	case schemapb.DataType_FloatVector:
+		vectorData, ok := data.(*FloatVectorFieldData)
+		if !ok {
+			return nil, fmt.Errorf("missing data of field %d", field.GetFieldID())
+		}
+		return schema.NewFixedLenByteArrayNode(name, parquet.Repetitions.Required, int32(vectorData.Dim*4), id), nil
+	default:
+		return nil, fmt.Errorf("undefined data type %d", field.GetDataType())
+	}
+}
+
+// Write appends the insert data as a row group.
+func (w *ColumnarBinlogWriter) Write(data *InsertData) error {
+	if w.writer == nil {
+		if err := w.init(data); err != nil {
+			return err
+		}
+	}
+	rowGroup := w.writer.AppendRowGroup()
+	for _, field := range w.fields {
+		fieldData, ok := data.Data[field.GetFieldID()]
+		if !ok {
+			return fmt.Errorf("missing data of field %d", field.GetFieldID())
+		}
+		column, err := rowGroup.NextColumn()
+		if err != nil {
+			return err
+		}
+		if err := writeColumn(column, fieldData); err != nil {
+			return err
+		}
+	}
+	return rowGroup.Close()
+}
+
+// Finish closes the parquet file and returns its content.
+func (w *ColumnarBinlogWriter) Finish() ([]byte, error) {
+	if w.writer == nil {
+		return nil, fmt.Errorf("there's no data in the columnar binlog")
+	}
+	if err := w.writer.Close(); err != nil {
+		return nil, err
+	}
+	return w.buffer.Bytes(), nil
+}
+
+func writeColumn(column file.ColumnChunkWriter, data FieldData) error {
+	var validData []bool
+	if nullableData, ok := data.(NullableFieldData); ok {
+		validData = nullableData.GetValidData()
+	}
+	switch data := data.(type) {
+	case *BoolFieldData:
+		return writeColumnValues[bool, *file.BooleanColumnChunkWriter](column, data.Data, validData, true)
+	case *Int8FieldData:
+		return writeColumnValues[int32, *file.Int32ColumnChunkWriter](column, castValues[int8, int32](data.Data), validData, true)
+	case *Int16FieldData:
+		return writeColumnValues[int32, *file.Int32ColumnChunkWriter](column, castValues[int16, int32](data.Data), validData, true)
+	case *Int32FieldData:
+		return writeColumnValues[int32, *file.Int32ColumnChunkWriter](column, data.Data, validData, true)
+	case *Int64FieldData:
+		return writeColumnValues[int64, *file.Int64ColumnChunkWriter](column, data.Data, validData, true)
+	case *FloatFieldData:
+		return writeColumnValues[float32, *file.Float32ColumnChunkWriter](column, data.Data, validData, true)
+	case *DoubleFieldData:
+		return writeColumnValues[float64, *file.Float64ColumnChunkWriter](column, data.Data, validData, true)
+	case *StringFieldData:
+		values := make([]parquet.ByteArray, len(data.Data))
+		for i, value := range data.Data {
+			values[i] = parquet.ByteArray(value)
+		}
+		return writeColumnValues[parquet.ByteArray, *file.ByteArrayColumnChunkWriter](column, values, validData, true)
+	case *JSONFieldData:
+		values := make([]parquet.ByteArray, len(data.Data))
+		for i, value := range data.Data {
+			values[i] = value
+		}
+		return writeColumnValues[parquet.ByteArray, *file.ByteArrayColumnChunkWriter](column, values, validData, true)
+	case *ArrayFieldData:
+		values := make([]parquet.ByteArray, len(data.Data))
+		for i, value := range data.Data {
+			var err error
+			if values[i], err = proto.Marshal(value); err != nil {
+				return err
+			}
+		}
+		return writeColumnValues[parquet.ByteArray, *file.ByteArrayColumnChunkWriter](column, values, validData, true)
+	case *BinaryVectorFieldData:
+		values := make([]parquet.FixedLenByteArray, data.RowNum())
+		length := data.Dim / 8
+		for i := range values {
+			values[i] = data.Data[i*length : (i+1)*length]
+		}
+		return writeColumnValues[parquet.FixedLenByteArray, *file.FixedLenByteArrayColumnChunkWriter](column, values, nil, false)
+	case *FloatVectorFieldData:
+		values := make([]parquet.FixedLenByteArray, data.RowNum())
+		for i := range values {
+			values[i] = arrow.Float32Traits.CastToBytes(data.Data[i*data.Dim : (i+1)*data.Dim])
+		}
+		return writeColumnValues[parquet.FixedLenByteArray, *file.FixedLenByteArrayColumnChunkWriter](column, values, nil, false)
+	default:
+		return fmt.Errorf("unsupported field data type %T", data)
+	}
+}
+
+// writeColumnValues writes the values of a column chunk, only the valid values are written to the optional columns,
+// the null rows are recorded by the definition levels.
+func writeColumnValues[T any, W interface {
+	WriteBatch([]T, []int16, []int16) (int64, error)
+}](column file.ColumnChunkWriter, values []T, validData []bool, optional bool) error {
+	writer, ok := column.(W)
+	if !ok {
+		return fmt.Errorf("expect type %T, but got %T", *new(W), column)
+	}
+	if !optional {
+		_, err := writer.WriteBatch(values, nil, nil)
+		return err
+	}
+	defLevels := make([]int16, len(values))
+	validValues := values
+	if validData != nil {
+		validValues = make([]T, 0, len(values))
+	}
+	for i := range values {
+		if validData != nil && !validData[i] {
+			continue
+		}
+		defLevels[i] = 1
+		if validData != nil {
+			validValues = append(validValues, values[i])
+		}
+	}
+	_, err := writer.WriteBatch(validValues, defLevels, nil)
+	return err
+}
+
+func castValues[S, D int8 | int16 | int32](values []S) []D {
+	ret := make([]D, len(values))
+	for i, value := range values {
+		ret[i] = D(value)
+	}
+	return ret
+}
+
+// ColumnarBinlogReader reads the fields of a segment from the parquet file written by ColumnarBinlogWriter,
+// only the columns of the requested fields are decoded.
+type ColumnarBinlogReader struct {
+	CollectionID UniqueID
+	PartitionID  UniqueID
+	SegmentID    UniqueID
+
+	reader *file.Reader
+	// fields are in the order of the columns
+	fields []*schemapb.FieldSchema
+}
+
+// NewColumnarBinlogReader creates a reader of the columnar binlog.
+func NewColumnarBinlogReader(buf []byte) (*ColumnarBinlogReader, error) {
+	reader, err := file.NewParquetReader(bytes.NewReader(buf))
+	if err != nil {
+		return nil, err
+	}
+	r := &ColumnarBinlogReader{reader: reader}
+	if err := r.readMetadata(); err != nil {
+		reader.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *ColumnarBinlogReader) readMetadata() error {
+	kvs := r.reader.MetaData().KeyValueMetadata()
+	getID := func(key string) (UniqueID, error) {
+		value := kvs.FindValue(key)
+		if value == nil {
+			return InvalidUniqueID, fmt.Errorf("%s not found in columnar binlog", key)
+		}
+		return strconv.ParseInt(*value, 10, 64)
+	}
+	var err error
+	if r.CollectionID, err = getID(columnarCollectionIDKey); err != nil {
+		return err
+	}
+	if r.PartitionID, err = getID(columnarPartitionIDKey); err != nil {
+		return err
+	}
+	if r.SegmentID, err = getID(columnarSegmentIDKey); err != nil {
+		return err
+	}
+
+	value := kvs.FindValue(columnarSchemaKey)
+	if value == nil {
+		return fmt.Errorf("%s not found in columnar binlog", columnarSchemaKey)
+	}
+	schemaBytes, err := base64.StdEncoding.DecodeString(*value)
+	if err != nil {
+		return err
+	}
+	collSchema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(schemaBytes, collSchema); err != nil {
+		return err
+	}
+	r.fields = collSchema.GetFields()
+	if len(r.fields) != r.reader.MetaData().Schema.NumColumns() {
+		return fmt.Errorf("the columnar binlog has %d columns but %d fields", r.reader.MetaData().Schema.NumColumns(), len(r.fields))
+	}
+	return nil
+}
+
+// Fields returns the schema of the fields stored in the binlog.
+func (r *ColumnarBinlogReader) Fields() []*schemapb.FieldSchema {
+	return r.fields
+}
+
+// NumRowGroups returns the number of the batches written.
+func (r *ColumnarBinlogReader) NumRowGroups() int {
+	return r.reader.NumRowGroups()
+}
+
+// NumRows returns the number of the rows of all the row groups.
+func (r *ColumnarBinlogReader) NumRows() int64 {
+	return r.reader.NumRows()
+}
+
+// ReadRowGroup reads the i-th row group, only the fields of fieldIDs are read,
+// all the fields are read if fieldIDs is empty.
+func (r *ColumnarBinlogReader) ReadRowGroup(i int, fieldIDs ...FieldID) (*InsertData, error) {
+	if i < 0 || i >= r.reader.NumRowGroups() {
+		return nil, fmt.Errorf("row group %d out of range [0, %d)", i, r.reader.NumRowGroups())
+	}
+	projection := typeutil.NewUniqueSet(fieldIDs...)
+	rowGroup := r.reader.RowGroup(i)
+	numRows := rowGroup.NumRows()
+	data := &InsertData{Data: make(map[FieldID]FieldData)}
+	for idx, field := range r.fields {
+		if len(projection) > 0 && !projection.Contain(field.GetFieldID()) {
+			continue
+		}
+		fieldData, err := readColumn(rowGroup.Column(idx), field, numRows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read field %d of row group %d: %w", field.GetFieldID(), i, err)
+		}
+		data.Data[field.GetFieldID()] = fieldData
+	}
+	return data, nil
+}
+
+// ReadInto reads all the row groups and appends the fields of fieldIDs to insertData,
+// all the fields are read if fieldIDs is empty.
+func (r *ColumnarBinlogReader) ReadInto(insertData *InsertData, fieldIDs ...FieldID) error {
+	for i := 0; i < r.reader.NumRowGroups(); i++ {
+		data, err := r.ReadRowGroup(i, fieldIDs...)
+		if err != nil {
+			return err
+		}
+		fids := make([]FieldID, 0, len(data.Data))
+		for fid := range data.Data {
+			fids = append(fids, fid)
+		}
+		sort.Slice(fids, func(i, j int) bool { return fids[i] < fids[j] })
+		for _, fid := range fids {
+			MergeFieldData(insertData, fid, data.Data[fid])
+		}
+	}
+	if _, ok := insertData.Data[common.TimeStampField]; ok {
+		insertData.Infos = append(insertData.Infos, BlobInfo{Length: int(r.NumRows())})
+	}
+	return nil
+}
+
+// Close closes the reader.
+func (r *ColumnarBinlogReader) Close() {
+	r.reader.Close()
+}
+
+func readColumn(column file.ColumnChunkReader, field *schemapb.FieldSchema, numRows int64) (FieldData, error) {
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		values, validData, err := readColumnValues[bool, *file.BooleanColumnChunkReader](column, numRows)
+		return &BoolFieldData{NumRows: []int64{numRows}, Data: values, ValidData: validData}, err
+	case schemapb.DataType_Int8:
+		values, validData, err := readColumnValues[int32, *file.Int32ColumnChunkReader](column, numRows)
+		return &Int8FieldData{NumRows: []int64{numRows}, Data: castValues[int32, int8](values), ValidData: validData}, err
+	case schemapb.DataType_Int16:
+		values, validData, err := readColumnValues[int32, *file.Int32ColumnChunkReader](column, numRows)
+		return &Int16FieldData{NumRows: []int64{numRows}, Data: castValues[int32, int16](values), ValidData: validData}, err
+	case schemapb.DataType_Int32:
+		values, validData, err := readColumnValues[int32, *file.Int32ColumnChunkReader](column, numRows)
+		return &Int32FieldData{NumRows: []int64{numRows}, Data: values, ValidData: validData}, err
+	case schemapb.DataType_Int64:
+		values, validData, err := readColumnValues[int64, *file.Int64ColumnChunkReader](column, numRows)
+		return &Int64FieldData{NumRows: []int64{numRows}, Data: values, ValidData: validData}, err
+	case schemapb.DataType_Float:
+		values, validData, err := readColumnValues[float32, *file.Float32ColumnChunkReader](column, numRows)
+		return &FloatFieldData{NumRows: []int64{numRows}, Data: values, ValidData: validData}, err
+	case schemapb.DataType_Double:
+		values, validData, err := readColumnValues[float64, *file.Float64ColumnChunkReader](column, numRows)
+		return &DoubleFieldData{NumRows: []int64{numRows}, Data: values, ValidData: validData}, err
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		values, validData, err := readColumnValues[parquet.ByteArray, *file.ByteArrayColumnChunkReader](column, numRows)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]string, numRows)
+		for i, value := range values {
+			data[i] = value.String()
+		}
+		return &StringFieldData{NumRows: []int64{numRows}, Data: data, ValidData: validData}, nil
+	case typeutil.DataTypeJSON:
+		values, validData, err := readColumnValues[parquet.ByteArray, *file.ByteArrayColumnChunkReader](column, numRows)
+		if err != nil {
+			return nil, err
+		}
+		data := make([][]byte, numRows)
+		for i, value := range values {
+			data[i] = append([]byte{}, value...)
+		}
+		return &JSONFieldData{NumRows: []int64{numRows}, Data: data, ValidData: validData}, nil
+	case typeutil.DataTypeArray:
+		values, validData, err := readColumnValues[parquet.ByteArray, *file.ByteArrayColumnChunkReader](column, numRows)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]*schemapb.ScalarField, numRows)
+		for i, value := range values {
+			data[i] = &schemapb.ScalarField{}
+			if err := proto.Unmarshal(value, data[i]); err != nil {
+				return nil, err
+			}
+		}
+		elementType, _ := typeutil.GetArrayElementType(field)
+		return &ArrayFieldData{NumRows: []int64{numRows}, ElementType: elementType, Data: data, ValidData: validData}, nil
+	case schemapb.DataType_BinaryVector:
+		length := column.Descriptor().TypeLength()
+		values, _, err := readColumnValues[parquet.FixedLenByteArray, *file.FixedLenByteArrayColumnChunkReader](column, numRows)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, 0, int64(length)*numRows)
+		for _, value := range values {
+			data = append(data, value...)
+		}
+		return &BinaryVectorFieldData{NumRows: []int64{numRows}, Data: data, Dim: length * 8}, nil
+	case schemapb.DataType_FloatVector:
+		dim := column.Descriptor().TypeLength() / 4
+		values, _, err := readColumnValues[parquet.FixedLenByteArray, *file.FixedLenByteArrayColumnChunkReader](column, numRows)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]float32, int64(dim)*numRows)
+		for i, value := range values {
+			copy(arrow.Float32Traits.CastToBytes(data[i*dim:(i+1)*dim]), value)
+		}
+		return &FloatVectorFieldData{NumRows: []int64{numRows}, Data: data, Dim: dim}, nil
+	default:
+		return nil, fmt.Errorf("undefined data type %d", field.GetDataType())
+	}
+}
+
+// readColumnValues reads all the rows of a column chunk, the null rows are read as zero values,
+// validData is nil if all the rows are valid.
+func readColumnValues[T any, E interface {
+	ReadBatch(int64, []T, []int16, []int16) (int64, int, error)
+}](column file.ColumnChunkReader, numRows int64) ([]T, []bool, error) {
+	reader, ok := column.(E)
+	if !ok {
+		return nil, nil, fmt.Errorf("expect type %T, but got %T", *new(E), column)
+	}
+	values := make([]T, numRows)
+	defLevels := make([]int16, numRows)
+	var offset int64
+	hasNull := false
+	for offset < numRows {
+		rowsRead, valuesRead, err := reader.ReadBatch(numRows-offset, values[offset:], defLevels[offset:], nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		if rowsRead == 0 {
+			return nil, nil, fmt.Errorf("expect %d rows, but got %d", numRows, offset)
+		}
+		if int64(valuesRead) < rowsRead {
+			hasNull = true
+			spreadValues(values[offset:offset+rowsRead], defLevels[offset:offset+rowsRead], valuesRead)
+		}
+		offset += rowsRead
+	}
+	if !hasNull || column.Descriptor().MaxDefinitionLevel() == 0 {
+		return values, nil, nil
+	}
+	validData := make([]bool, numRows)
+	for i := range validData {
+		validData[i] = defLevels[i] > 0
+	}
+	return values, validData, nil
+}
+
+// SerializeV2 serializes the batches of the insert data into a columnar binlog of StorageV2,
+// each batch is sorted by row id and written as a row group.
+// The statistics of the primary key are serialized as the StorageV1 does, the keys of the stats blobs are the field ids.
+func (insertCodec *InsertCodec) SerializeV2(partitionID UniqueID, segmentID UniqueID, batches ...*InsertData) (*Blob, []*Blob, error) {
+	writer, err := NewColumnarBinlogWriter(insertCodec.Schema, partitionID, segmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rowNum int64
+	merged := &InsertData{Data: make(map[FieldID]FieldData)}
+	for _, data := range batches {
+		timeFieldData, ok := data.Data[common.TimeStampField]
+		if !ok {
+			return nil, nil, fmt.Errorf("data doesn't contains timestamp field")
+		}
+		if timeFieldData.RowNum() <= 0 {
+			return nil, nil, fmt.Errorf("there's no data in InsertData")
+		}
+		rowNum += int64(timeFieldData.RowNum())
+
+		// sort insert data by rowID
+		sort.Sort(&DataSorter{InsertCodec: insertCodec, InsertData: data})
+		if err := writer.Write(data); err != nil {
+			return nil, nil, err
+		}
+		for _, field := range insertCodec.Schema.GetSchema().GetFields() {
+			if field.GetIsPrimaryKey() {
+				MergeFieldData(merged, field.GetFieldID(), data.Data[field.GetFieldID()])
+			}
+		}
+	}
+	buffer, err := writer.Finish()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statsBlobs := make([]*Blob, 0, 1)
+	for _, field := range insertCodec.Schema.GetSchema().GetFields() {
+		if !field.GetIsPrimaryKey() {
+			continue
+		}
+		statsWriter := &StatsWriter{}
+		err = statsWriter.GeneratePrimaryKeyStats(field.GetFieldID(), field.GetDataType(), merged.Data[field.GetFieldID()])
+		if err != nil {
+			return nil, nil, err
+		}
+		statsBlobs = append(statsBlobs, &Blob{
+			Key:    strconv.FormatInt(field.GetFieldID(), 10),
+			Value:  statsWriter.GetBuffer(),
+			RowNum: rowNum,
+		})
+	}
+	return &Blob{Value: buffer, RowNum: rowNum}, statsBlobs, nil
+}
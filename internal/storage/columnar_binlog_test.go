@@ -0,0 +1,223 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func genColumnarTestCollection() *etcdpb.CollectionMeta {
+	return &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: BoolField, Name: "field_bool", DataType: schemapb.DataType_Bool},
+				{FieldID: Int8Field, Name: "field_int8", DataType: schemapb.DataType_Int8},
+				{FieldID: Int16Field, Name: "field_int16", DataType: schemapb.DataType_Int16},
+				{FieldID: Int32Field, Name: "field_int32", DataType: schemapb.DataType_Int32},
+				{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: FloatField, Name: "field_float", DataType: schemapb.DataType_Float},
+				{FieldID: DoubleField, Name: "field_double", DataType: schemapb.DataType_Double},
+				{FieldID: StringField, Name: "field_string", DataType: schemapb.DataType_VarChar},
+				{FieldID: JSONField, Name: "field_json", DataType: typeutil.DataTypeJSON},
+				{FieldID: ArrayField, Name: "field_array", DataType: typeutil.DataTypeArray,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.ElementTypeKey, Value: schemapb.DataType_Int32.String()}}},
+				{FieldID: BinaryVectorField, Name: "field_binary_vector", DataType: schemapb.DataType_BinaryVector},
+				{FieldID: FloatVectorField, Name: "field_float_vector", DataType: schemapb.DataType_FloatVector},
+			},
+		},
+	}
+}
+
+// genColumnarTestData generates rows [start, start+num), the odd rows of the nullable fields are null.
+func genColumnarTestData(start, num int) *InsertData {
+	data := &InsertData{Data: map[FieldID]FieldData{
+		RowIDField:        &Int64FieldData{NumRows: []int64{int64(num)}},
+		TimestampField:    &Int64FieldData{NumRows: []int64{int64(num)}},
+		BoolField:         &BoolFieldData{NumRows: []int64{int64(num)}},
+		Int8Field:         &Int8FieldData{NumRows: []int64{int64(num)}},
+		Int16Field:        &Int16FieldData{NumRows: []int64{int64(num)}},
+		Int32Field:        &Int32FieldData{NumRows: []int64{int64(num)}},
+		Int64Field:        &Int64FieldData{NumRows: []int64{int64(num)}},
+		FloatField:        &FloatFieldData{NumRows: []int64{int64(num)}},
+		DoubleField:       &DoubleFieldData{NumRows: []int64{int64(num)}},
+		StringField:       &StringFieldData{NumRows: []int64{int64(num)}},
+		JSONField:         &JSONFieldData{NumRows: []int64{int64(num)}},
+		ArrayField:        &ArrayFieldData{NumRows: []int64{int64(num)}, ElementType: schemapb.DataType_Int32},
+		BinaryVectorField: &BinaryVectorFieldData{NumRows: []int64{int64(num)}, Dim: 16},
+		FloatVectorField:  &FloatVectorFieldData{NumRows: []int64{int64(num)}, Dim: 4},
+	}}
+	validData := make([]bool, 0, num)
+	for i := start; i < start+num; i++ {
+		data.Data[RowIDField].(*Int64FieldData).Data = append(data.Data[RowIDField].(*Int64FieldData).Data, int64(i))
+		data.Data[TimestampField].(*Int64FieldData).Data = append(data.Data[TimestampField].(*Int64FieldData).Data, int64(i+1))
+		data.Data[BoolField].(*BoolFieldData).Data = append(data.Data[BoolField].(*BoolFieldData).Data, i%3 == 0)
+		data.Data[Int8Field].(*Int8FieldData).Data = append(data.Data[Int8Field].(*Int8FieldData).Data, int8(i))
+		data.Data[Int16Field].(*Int16FieldData).Data = append(data.Data[Int16Field].(*Int16FieldData).Data, int16(i))
+		data.Data[Int32Field].(*Int32FieldData).Data = append(data.Data[Int32Field].(*Int32FieldData).Data, int32(i))
+		data.Data[Int64Field].(*Int64FieldData).Data = append(data.Data[Int64Field].(*Int64FieldData).Data, int64(i))
+		data.Data[FloatField].(*FloatFieldData).Data = append(data.Data[FloatField].(*FloatFieldData).Data, float32(i))
+		data.Data[DoubleField].(*DoubleFieldData).Data = append(data.Data[DoubleField].(*DoubleFieldData).Data, float64(i))
+		data.Data[StringField].(*StringFieldData).Data = append(data.Data[StringField].(*StringFieldData).Data, fmt.Sprintf("str-%d", i))
+		data.Data[JSONField].(*JSONFieldData).Data = append(data.Data[JSONField].(*JSONFieldData).Data, []byte(fmt.Sprintf(`{"id":%d}`, i)))
+		data.Data[ArrayField].(*ArrayFieldData).Data = append(data.Data[ArrayField].(*ArrayFieldData).Data, &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{int32(i), int32(i + 1)}}},
+		})
+		data.Data[BinaryVectorField].(*BinaryVectorFieldData).Data = append(data.Data[BinaryVectorField].(*BinaryVectorFieldData).Data, byte(i), byte(i+1))
+		data.Data[FloatVectorField].(*FloatVectorFieldData).Data = append(data.Data[FloatVectorField].(*FloatVectorFieldData).Data,
+			float32(i), float32(i+1), float32(i+2), float32(i+3))
+		validData = append(validData, i%2 == 0)
+	}
+	// the values of the null rows are read as zero values
+	for i, valid := range validData {
+		if !valid {
+			data.Data[Int32Field].(*Int32FieldData).Data[i] = 0
+			data.Data[StringField].(*StringFieldData).Data[i] = ""
+		}
+	}
+	data.Data[Int32Field].(*Int32FieldData).ValidData = validData
+	data.Data[StringField].(*StringFieldData).ValidData = append([]bool{}, validData...)
+	return data
+}
+
+func TestColumnarBinlog(t *testing.T) {
+	collMeta := genColumnarTestCollection()
+	batches := []*InsertData{genColumnarTestData(0, 10), genColumnarTestData(10, 5)}
+	expected := MergeInsertData(batches...)
+
+	insertCodec := NewInsertCodec(collMeta)
+	blob, statsBlobs, err := insertCodec.SerializeV2(PartitionID, SegmentID, batches...)
+	require.NoError(t, err)
+	assert.True(t, IsColumnarBinlog(blob.Value))
+	assert.EqualValues(t, 15, blob.RowNum)
+	require.Equal(t, 1, len(statsBlobs))
+	assert.Equal(t, fmt.Sprint(Int64Field), statsBlobs[0].Key)
+
+	t.Run("read row groups", func(t *testing.T) {
+		reader, err := NewColumnarBinlogReader(blob.Value)
+		require.NoError(t, err)
+		defer reader.Close()
+		assert.Equal(t, UniqueID(CollectionID), reader.CollectionID)
+		assert.Equal(t, UniqueID(PartitionID), reader.PartitionID)
+		assert.Equal(t, UniqueID(SegmentID), reader.SegmentID)
+		assert.Equal(t, len(collMeta.Schema.Fields), len(reader.Fields()))
+		assert.EqualValues(t, 15, reader.NumRows())
+		// the row groups are aligned to the batches
+		require.Equal(t, 2, reader.NumRowGroups())
+
+		data, err := reader.ReadRowGroup(1)
+		require.NoError(t, err)
+		assert.Equal(t, len(collMeta.Schema.Fields), len(data.Data))
+		assert.Equal(t, batches[1].Data[Int64Field].(*Int64FieldData).Data, data.Data[Int64Field].(*Int64FieldData).Data)
+		assert.Equal(t, batches[1].Data[FloatVectorField], data.Data[FloatVectorField])
+
+		data, err = reader.ReadRowGroup(0, StringField, BinaryVectorField)
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(data.Data))
+		assert.Equal(t, batches[0].Data[StringField], data.Data[StringField])
+		assert.Equal(t, batches[0].Data[BinaryVectorField], data.Data[BinaryVectorField])
+
+		_, err = reader.ReadRowGroup(2)
+		assert.Error(t, err)
+	})
+
+	t.Run("deserialize", func(t *testing.T) {
+		_, _, _, data, err := insertCodec.DeserializeAll([]*Blob{blob})
+		require.NoError(t, err)
+		for _, field := range collMeta.Schema.Fields {
+			fieldID := field.GetFieldID()
+			assert.Equal(t, expected.Data[fieldID].RowNum(), data.Data[fieldID].RowNum(), fieldID)
+			for i := 0; i < expected.Data[fieldID].RowNum(); i++ {
+				if field.GetDataType() == typeutil.DataTypeArray {
+					assert.True(t, proto.Equal(expected.Data[fieldID].GetRow(i).(*schemapb.ScalarField), data.Data[fieldID].GetRow(i).(*schemapb.ScalarField)))
+					continue
+				}
+				assert.Equal(t, expected.Data[fieldID].GetRow(i), data.Data[fieldID].GetRow(i), fieldID)
+			}
+		}
+		assert.Equal(t, expected.Data[Int32Field].(*Int32FieldData).ValidData, data.Data[Int32Field].(*Int32FieldData).ValidData)
+		assert.Nil(t, data.Data[Int64Field].(*Int64FieldData).ValidData)
+		assert.Equal(t, schemapb.DataType_Int32, data.Data[ArrayField].(*ArrayFieldData).ElementType)
+		assert.Equal(t, []BlobInfo{{Length: 15}}, data.Infos)
+	})
+
+	t.Run("deserialize with projection", func(t *testing.T) {
+		// mix with the binlogs of StorageV1
+		v1Blobs, _, err := insertCodec.Serialize(PartitionID, SegmentID, genColumnarTestData(15, 3))
+		require.NoError(t, err)
+		data := &InsertData{Data: make(map[FieldID]FieldData)}
+		_, _, segmentID, err := insertCodec.DeserializeFieldsInto(append([]*Blob{blob}, v1Blobs...), []FieldID{Int64Field, FloatVectorField}, 0, data)
+		require.NoError(t, err)
+		assert.Equal(t, UniqueID(SegmentID), segmentID)
+		assert.Equal(t, 2, len(data.Data))
+		assert.Equal(t, 18, data.Data[Int64Field].RowNum())
+		assert.Equal(t, 18, data.Data[FloatVectorField].RowNum())
+		assert.Equal(t, int64(17), data.Data[Int64Field].GetRow(17))
+	})
+
+	t.Run("compression", func(t *testing.T) {
+		for _, compressType := range []string{"zstd", "lz4", "snappy"} {
+			compressedMeta := genColumnarTestCollection()
+			compressedMeta.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionBinlogCompressionKey, Value: compressType}}
+			compressedBlob, _, err := NewInsertCodec(compressedMeta).SerializeV2(PartitionID, SegmentID, genColumnarTestData(0, 10))
+			require.NoError(t, err, compressType)
+			_, _, _, data, err := insertCodec.DeserializeAll([]*Blob{compressedBlob})
+			require.NoError(t, err, compressType)
+			assert.Equal(t, batches[0].Data[StringField], data.Data[StringField], compressType)
+		}
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		_, _, err := insertCodec.SerializeV2(PartitionID, SegmentID)
+		assert.Error(t, err)
+
+		data := genColumnarTestData(0, 10)
+		delete(data.Data, FloatField)
+		_, _, err = insertCodec.SerializeV2(PartitionID, SegmentID, data)
+		assert.Error(t, err)
+
+		_, err = NewColumnarBinlogReader([]byte("PAR1 not a parquet file"))
+		assert.Error(t, err)
+	})
+}
+
+func TestColumnarFieldPath(t *testing.T) {
+	logPath := "files/insert_log/1/2/3/columnar/4"
+	assert.True(t, IsColumnarLogPath(logPath))
+	assert.False(t, IsColumnarLogPath("files/insert_log/1/2/3/100/4"))
+
+	filePath, fieldID, ok := parseColumnarFieldPath(ColumnarFieldPath(logPath, 101))
+	assert.True(t, ok)
+	assert.Equal(t, logPath, filePath)
+	assert.Equal(t, FieldID(101), fieldID)
+
+	filePath, _, ok = parseColumnarFieldPath(logPath)
+	assert.False(t, ok)
+	assert.Equal(t, logPath, filePath)
+}
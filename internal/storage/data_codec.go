@@ -25,6 +25,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -109,37 +111,64 @@ type FieldData interface {
 	GetRow(i int) interface{}
 }
 
+// NullableFieldData is the FieldData of the scalar fields which could be nullable,
+// ValidData[i] is false if the i-th row is null, all the rows are valid if ValidData is nil.
+type NullableFieldData interface {
+	FieldData
+	GetValidData() []bool
+	SetValidData(validData []bool)
+}
+
 type BoolFieldData struct {
-	NumRows []int64
-	Data    []bool
+	NumRows   []int64
+	Data      []bool
+	ValidData []bool
 }
 type Int8FieldData struct {
-	NumRows []int64
-	Data    []int8
+	NumRows   []int64
+	Data      []int8
+	ValidData []bool
 }
 type Int16FieldData struct {
-	NumRows []int64
-	Data    []int16
+	NumRows   []int64
+	Data      []int16
+	ValidData []bool
 }
 type Int32FieldData struct {
-	NumRows []int64
-	Data    []int32
+	NumRows   []int64
+	Data      []int32
+	ValidData []bool
 }
 type Int64FieldData struct {
-	NumRows []int64
-	Data    []int64
+	NumRows   []int64
+	Data      []int64
+	ValidData []bool
 }
 type FloatFieldData struct {
-	NumRows []int64
-	Data    []float32
+	NumRows   []int64
+	Data      []float32
+	ValidData []bool
 }
 type DoubleFieldData struct {
-	NumRows []int64
-	Data    []float64
+	NumRows   []int64
+	Data      []float64
+	ValidData []bool
 }
 type StringFieldData struct {
-	NumRows []int64
-	Data    []string
+	NumRows   []int64
+	Data      []string
+	ValidData []bool
+}
+type JSONFieldData struct {
+	NumRows   []int64
+	Data      [][]byte
+	ValidData []bool
+}
+type ArrayFieldData struct {
+	NumRows     []int64
+	ElementType schemapb.DataType
+	Data        []*schemapb.ScalarField
+	ValidData   []bool
 }
 type BinaryVectorFieldData struct {
 	NumRows []int64
@@ -161,6 +190,8 @@ func (data *Int64FieldData) RowNum() int        { return len(data.Data) }
 func (data *FloatFieldData) RowNum() int        { return len(data.Data) }
 func (data *DoubleFieldData) RowNum() int       { return len(data.Data) }
 func (data *StringFieldData) RowNum() int       { return len(data.Data) }
+func (data *JSONFieldData) RowNum() int         { return len(data.Data) }
+func (data *ArrayFieldData) RowNum() int        { return len(data.Data) }
 func (data *BinaryVectorFieldData) RowNum() int { return len(data.Data) * 8 / data.Dim }
 func (data *FloatVectorFieldData) RowNum() int  { return len(data.Data) / data.Dim }
 
@@ -173,6 +204,8 @@ func (data *Int64FieldData) GetRow(i int) interface{}  { return data.Data[i] }
 func (data *FloatFieldData) GetRow(i int) interface{}  { return data.Data[i] }
 func (data *DoubleFieldData) GetRow(i int) interface{} { return data.Data[i] }
 func (data *StringFieldData) GetRow(i int) interface{} { return data.Data[i] }
+func (data *JSONFieldData) GetRow(i int) interface{}   { return data.Data[i] }
+func (data *ArrayFieldData) GetRow(i int) interface{}  { return data.Data[i] }
 func (data *BinaryVectorFieldData) GetRow(i int) interface{} {
 	return data.Data[i*data.Dim/8 : (i+1)*data.Dim/8]
 }
@@ -180,6 +213,30 @@ func (data *FloatVectorFieldData) GetRow(i int) interface{} {
 	return data.Data[i*data.Dim : (i+1)*data.Dim]
 }
 
+// GetValidData implements NullableFieldData.GetValidData
+func (data *BoolFieldData) GetValidData() []bool   { return data.ValidData }
+func (data *Int8FieldData) GetValidData() []bool   { return data.ValidData }
+func (data *Int16FieldData) GetValidData() []bool  { return data.ValidData }
+func (data *Int32FieldData) GetValidData() []bool  { return data.ValidData }
+func (data *Int64FieldData) GetValidData() []bool  { return data.ValidData }
+func (data *FloatFieldData) GetValidData() []bool  { return data.ValidData }
+func (data *DoubleFieldData) GetValidData() []bool { return data.ValidData }
+func (data *StringFieldData) GetValidData() []bool { return data.ValidData }
+func (data *JSONFieldData) GetValidData() []bool   { return data.ValidData }
+func (data *ArrayFieldData) GetValidData() []bool  { return data.ValidData }
+
+// SetValidData implements NullableFieldData.SetValidData
+func (data *BoolFieldData) SetValidData(validData []bool)   { data.ValidData = validData }
+func (data *Int8FieldData) SetValidData(validData []bool)   { data.ValidData = validData }
+func (data *Int16FieldData) SetValidData(validData []bool)  { data.ValidData = validData }
+func (data *Int32FieldData) SetValidData(validData []bool)  { data.ValidData = validData }
+func (data *Int64FieldData) SetValidData(validData []bool)  { data.ValidData = validData }
+func (data *FloatFieldData) SetValidData(validData []bool)  { data.ValidData = validData }
+func (data *DoubleFieldData) SetValidData(validData []bool) { data.ValidData = validData }
+func (data *StringFieldData) SetValidData(validData []bool) { data.ValidData = validData }
+func (data *JSONFieldData) SetValidData(validData []bool)   { data.ValidData = validData }
+func (data *ArrayFieldData) SetValidData(validData []bool)  { data.ValidData = validData }
+
 // why not binary.Size(data) directly? binary.Size(data) return -1
 // binary.Size returns how many bytes Write would generate to encode the value v, which
 // must be a fixed-size value or a slice of fixed-size values, or a pointer to such data.
@@ -187,39 +244,55 @@ func (data *FloatVectorFieldData) GetRow(i int) interface{} {
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *BoolFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int8FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int16FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int32FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int64FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *FloatFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *DoubleFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *StringFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
+}
+
+func (data *JSONFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows) + binary.Size(data.ValidData)
+	for _, doc := range data.Data {
+		size += len(doc)
+	}
+	return size
+}
+
+func (data *ArrayFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows) + binary.Size(data.ElementType) + binary.Size(data.ValidData)
+	for _, array := range data.Data {
+		size += proto.Size(array)
+	}
+	return size
 }
 
 func (data *BinaryVectorFieldData) GetMemorySize() int {
@@ -279,6 +352,10 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		return nil, nil, fmt.Errorf("there's no data in InsertData")
 	}
 	rowNum := int64(timeFieldData.RowNum())
+	compression, err := GetBinlogCompression(insertCodec.Schema.GetProperties())
+	if err != nil {
+		return nil, nil, err
+	}
 
 	ts := timeFieldData.(*Int64FieldData).Data
 	startTs := ts[0]
@@ -296,6 +373,7 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 
 		// encode fields
 		writer = NewInsertBinlogWriter(field.DataType, insertCodec.Schema.ID, partitionID, segmentID, field.FieldID)
+		writer.SetCompression(compression)
 		var eventWriter *insertEventWriter
 		var err error
 		if typeutil.IsVectorType(field.DataType) {
@@ -383,6 +461,26 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				}
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*StringFieldData).GetMemorySize()))
+		case typeutil.DataTypeJSON:
+			for _, singleJSON := range singleData.(*JSONFieldData).Data {
+				err = eventWriter.AddOneJSONToPayload(singleJSON)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, nil, err
+				}
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*JSONFieldData).GetMemorySize()))
+		case typeutil.DataTypeArray:
+			for _, singleArray := range singleData.(*ArrayFieldData).Data {
+				err = eventWriter.AddOneArrayToPayload(singleArray)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, nil, err
+				}
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*ArrayFieldData).GetMemorySize()))
 		case schemapb.DataType_BinaryVector:
 			err = eventWriter.AddBinaryVectorToPayload(singleData.(*BinaryVectorFieldData).Data, singleData.(*BinaryVectorFieldData).Dim)
 			if err != nil {
@@ -405,6 +503,13 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		if err != nil {
 			return nil, nil, err
 		}
+		if nullableData, ok := singleData.(NullableFieldData); ok && nullableData.GetValidData() != nil {
+			if err = eventWriter.AddValidDataToPayload(nullableData.GetValidData()); err != nil {
+				eventWriter.Close()
+				writer.Close()
+				return nil, nil, err
+			}
+		}
 		writer.SetEventTimeStamp(typeutil.Timestamp(startTs), typeutil.Timestamp(endTs))
 
 		err = writer.Finish()
@@ -454,6 +559,17 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 	segmentID UniqueID,
 	data *InsertData,
 	err error,
+) {
+	return insertCodec.DeserializeFields(blobs)
+}
+
+// DeserializeFields is DeserializeAll reading only the fields of fieldIDs, all the fields are read if fieldIDs is empty.
+func (insertCodec *InsertCodec) DeserializeFields(blobs []*Blob, fieldIDs ...FieldID) (
+	collectionID UniqueID,
+	partitionID UniqueID,
+	segmentID UniqueID,
+	data *InsertData,
+	err error,
 ) {
 	if len(blobs) == 0 {
 		return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("blobs is empty")
@@ -465,20 +581,67 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 	data = &InsertData{
 		Data: make(map[FieldID]FieldData),
 	}
-	if collectionID, partitionID, segmentID, err = insertCodec.DeserializeInto(blobs, 0, data); err != nil {
+	if collectionID, partitionID, segmentID, err = insertCodec.deserializeInto(blobs, 0, data, typeutil.NewUniqueSet(fieldIDs...)); err != nil {
 		return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
 	}
 
 	return
 }
 
+// getArrayElementType returns the element type of an array field, DataType_None is returned if the schema is unknown.
+func (insertCodec *InsertCodec) getArrayElementType(fieldID FieldID) schemapb.DataType {
+	for _, field := range insertCodec.Schema.GetSchema().GetFields() {
+		if field.GetFieldID() == fieldID {
+			elementType, _ := typeutil.GetArrayElementType(field)
+			return elementType
+		}
+	}
+	return schemapb.DataType_None
+}
+
 func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int, insertData *InsertData) (
 	collectionID UniqueID,
 	partitionID UniqueID,
 	segmentID UniqueID,
 	err error,
+) {
+	return insertCodec.deserializeInto(fieldBinlogs, rowNum, insertData, nil)
+}
+
+// DeserializeFieldsInto is DeserializeInto reading only the fields of fieldIDs,
+// the binlogs of other fields are skipped and only the projected columns of the columnar binlogs are decoded.
+func (insertCodec *InsertCodec) DeserializeFieldsInto(fieldBinlogs []*Blob, fieldIDs []FieldID, rowNum int, insertData *InsertData) (
+	collectionID UniqueID,
+	partitionID UniqueID,
+	segmentID UniqueID,
+	err error,
+) {
+	return insertCodec.deserializeInto(fieldBinlogs, rowNum, insertData, typeutil.NewUniqueSet(fieldIDs...))
+}
+
+// deserializeInto deserializes the binlogs of both StorageV1 and StorageV2 into insertData,
+// all the fields are read if projection is empty.
+func (insertCodec *InsertCodec) deserializeInto(fieldBinlogs []*Blob, rowNum int, insertData *InsertData, projection typeutil.UniqueSet) (
+	collectionID UniqueID,
+	partitionID UniqueID,
+	segmentID UniqueID,
+	err error,
 ) {
 	for _, blob := range fieldBinlogs {
+		if IsColumnarBinlog(blob.Value) {
+			columnarReader, err := NewColumnarBinlogReader(blob.Value)
+			if err != nil {
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+			}
+			collectionID, partitionID, segmentID = columnarReader.CollectionID, columnarReader.PartitionID, columnarReader.SegmentID
+			err = columnarReader.ReadInto(insertData, projection.Collect()...)
+			columnarReader.Close()
+			if err != nil {
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+			}
+			continue
+		}
+
 		binlogReader, err := NewBinlogReader(blob.Value)
 		if err != nil {
 			return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
@@ -489,6 +652,10 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 
 		dataType := binlogReader.PayloadDataType
 		fieldID := binlogReader.FieldID
+		if len(projection) > 0 && !projection.Contain(fieldID) {
+			binlogReader.Close()
+			continue
+		}
 		totalLength := 0
 		dim := 0
 
@@ -500,6 +667,10 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 			if eventReader == nil {
 				break
 			}
+			rowsBefore := 0
+			if fieldData := insertData.Data[fieldID]; fieldData != nil {
+				rowsBefore = fieldData.RowNum()
+			}
 			switch dataType {
 			case schemapb.DataType_Bool:
 				singleData, err := eventReader.GetBoolFromPayload()
@@ -669,6 +840,49 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				stringFieldData.NumRows = append(stringFieldData.NumRows, int64(len(stringPayload)))
 				insertData.Data[fieldID] = stringFieldData
 
+			case typeutil.DataTypeJSON:
+				jsonPayload, err := eventReader.GetJSONFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &JSONFieldData{
+						NumRows: make([]int64, 0),
+						Data:    make([][]byte, 0, rowNum),
+					}
+				}
+				jsonFieldData := insertData.Data[fieldID].(*JSONFieldData)
+
+				jsonFieldData.Data = append(jsonFieldData.Data, jsonPayload...)
+				totalLength += len(jsonPayload)
+				jsonFieldData.NumRows = append(jsonFieldData.NumRows, int64(len(jsonPayload)))
+				insertData.Data[fieldID] = jsonFieldData
+
+			case typeutil.DataTypeArray:
+				arrayPayload, err := eventReader.GetArrayFromPayload()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+
+				if insertData.Data[fieldID] == nil {
+					insertData.Data[fieldID] = &ArrayFieldData{
+						NumRows:     make([]int64, 0),
+						ElementType: insertCodec.getArrayElementType(fieldID),
+						Data:        make([]*schemapb.ScalarField, 0, rowNum),
+					}
+				}
+				arrayFieldData := insertData.Data[fieldID].(*ArrayFieldData)
+
+				arrayFieldData.Data = append(arrayFieldData.Data, arrayPayload...)
+				totalLength += len(arrayPayload)
+				arrayFieldData.NumRows = append(arrayFieldData.NumRows, int64(len(arrayPayload)))
+				insertData.Data[fieldID] = arrayFieldData
+
 			case schemapb.DataType_BinaryVector:
 				var singleData []byte
 				singleData, dim, err = eventReader.GetBinaryVectorFromPayload()
@@ -732,6 +946,13 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				binlogReader.Close()
 				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, fmt.Errorf("undefined data type %d", dataType)
 			}
+			validData, err := eventReader.GetValidDataFromPayload()
+			if err != nil {
+				eventReader.Close()
+				binlogReader.Close()
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+			}
+			appendValidData(insertData.Data[fieldID], rowsBefore, validData)
 			eventReader.Close()
 		}
 
@@ -825,6 +1046,7 @@ func (data *DeleteData) Append(pk PrimaryKey, ts Timestamp) {
 
 // DeleteCodec serializes and deserializes the delete data
 type DeleteCodec struct {
+	compression BinlogCompression
 }
 
 // NewDeleteCodec returns a DeleteCodec
@@ -832,10 +1054,16 @@ func NewDeleteCodec() *DeleteCodec {
 	return &DeleteCodec{}
 }
 
+// NewDeleteCodecWithCompression returns a DeleteCodec compressing the delete binlogs with @compression
+func NewDeleteCodecWithCompression(compression BinlogCompression) *DeleteCodec {
+	return &DeleteCodec{compression: compression}
+}
+
 // Serialize transfer delete data to blob. .
 // For each delete message, it will save "pk,ts" string to binlog.
 func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID, data *DeleteData) (*Blob, error) {
 	binlogWriter := NewDeleteBinlogWriter(schemapb.DataType_String, collectionID, partitionID, segmentID)
+	binlogWriter.SetCompression(deleteCodec.compression)
 	eventWriter, err := binlogWriter.NextDeleteEventWriter()
 	if err != nil {
 		binlogWriter.Close()
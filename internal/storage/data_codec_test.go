@@ -21,9 +21,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 )
@@ -44,6 +47,8 @@ const (
 	StringField       = 107
 	BinaryVectorField = 108
 	FloatVectorField  = 109
+	JSONField         = 110
+	ArrayField        = 111
 )
 
 func TestInsertCodec(t *testing.T) {
@@ -141,6 +146,24 @@ func TestInsertCodec(t *testing.T) {
 					Description:  "float_vector",
 					DataType:     schemapb.DataType_FloatVector,
 				},
+				{
+					FieldID:      JSONField,
+					Name:         "field_json",
+					IsPrimaryKey: false,
+					Description:  "json",
+					DataType:     typeutil.DataTypeJSON,
+				},
+				{
+					FieldID:      ArrayField,
+					Name:         "field_array",
+					IsPrimaryKey: false,
+					Description:  "array",
+					DataType:     typeutil.DataTypeArray,
+					TypeParams: []*commonpb.KeyValuePair{
+						{Key: common.ElementTypeKey, Value: "Int32"},
+						{Key: common.MaxCapacityKey, Value: "8"},
+					},
+				},
 			},
 		},
 	}
@@ -197,6 +220,18 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []float32{4, 5, 6, 7, 4, 5, 6, 7},
 				Dim:     4,
 			},
+			JSONField: &JSONFieldData{
+				NumRows: []int64{2},
+				Data:    [][]byte{[]byte(`{"batch":2}`), []byte(`{"key":"world"}`)},
+			},
+			ArrayField: &ArrayFieldData{
+				NumRows:     []int64{2},
+				ElementType: schemapb.DataType_Int32,
+				Data: []*schemapb.ScalarField{
+					{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{3, 2, 1}}}},
+					{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{6, 5, 4}}}},
+				},
+			},
 		},
 	}
 
@@ -252,23 +287,37 @@ func TestInsertCodec(t *testing.T) {
 				Data:    []float32{0, 1, 2, 3, 0, 1, 2, 3},
 				Dim:     4,
 			},
+			JSONField: &JSONFieldData{
+				NumRows: []int64{2},
+				Data:    [][]byte{[]byte(`{"batch":1}`), []byte(`{"key":"hello"}`)},
+			},
+			ArrayField: &ArrayFieldData{
+				NumRows:     []int64{2},
+				ElementType: schemapb.DataType_Int32,
+				Data: []*schemapb.ScalarField{
+					{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{1, 2, 3}}}},
+					{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{4, 5, 6}}}},
+				},
+			},
 		},
 	}
 
 	insertDataEmpty := &InsertData{
 		Data: map[int64]FieldData{
-			RowIDField:        &Int64FieldData{[]int64{}, []int64{}},
-			TimestampField:    &Int64FieldData{[]int64{}, []int64{}},
-			BoolField:         &BoolFieldData{[]int64{}, []bool{}},
-			Int8Field:         &Int8FieldData{[]int64{}, []int8{}},
-			Int16Field:        &Int16FieldData{[]int64{}, []int16{}},
-			Int32Field:        &Int32FieldData{[]int64{}, []int32{}},
-			Int64Field:        &Int64FieldData{[]int64{}, []int64{}},
-			FloatField:        &FloatFieldData{[]int64{}, []float32{}},
-			DoubleField:       &DoubleFieldData{[]int64{}, []float64{}},
-			StringField:       &StringFieldData{[]int64{}, []string{}},
+			RowIDField:        &Int64FieldData{[]int64{}, []int64{}, nil},
+			TimestampField:    &Int64FieldData{[]int64{}, []int64{}, nil},
+			BoolField:         &BoolFieldData{[]int64{}, []bool{}, nil},
+			Int8Field:         &Int8FieldData{[]int64{}, []int8{}, nil},
+			Int16Field:        &Int16FieldData{[]int64{}, []int16{}, nil},
+			Int32Field:        &Int32FieldData{[]int64{}, []int32{}, nil},
+			Int64Field:        &Int64FieldData{[]int64{}, []int64{}, nil},
+			FloatField:        &FloatFieldData{[]int64{}, []float32{}, nil},
+			DoubleField:       &DoubleFieldData{[]int64{}, []float64{}, nil},
+			StringField:       &StringFieldData{[]int64{}, []string{}, nil},
 			BinaryVectorField: &BinaryVectorFieldData{[]int64{}, []byte{}, 8},
 			FloatVectorField:  &FloatVectorFieldData{[]int64{}, []float32{}, 4},
+			JSONField:         &JSONFieldData{[]int64{}, [][]byte{}, nil},
+			ArrayField:        &ArrayFieldData{[]int64{}, schemapb.DataType_Int32, []*schemapb.ScalarField{}, nil},
 		},
 	}
 	b, s, err := insertCodec.Serialize(PartitionID, SegmentID, insertDataEmpty)
@@ -306,6 +355,8 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []int64{2, 2}, resultData.Data[StringField].(*StringFieldData).NumRows)
 	assert.Equal(t, []int64{2, 2}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).NumRows)
 	assert.Equal(t, []int64{2, 2}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).NumRows)
+	assert.Equal(t, []int64{2, 2}, resultData.Data[JSONField].(*JSONFieldData).NumRows)
+	assert.Equal(t, []int64{2, 2}, resultData.Data[ArrayField].(*ArrayFieldData).NumRows)
 	assert.Equal(t, []int64{1, 2, 3, 4}, resultData.Data[RowIDField].(*Int64FieldData).Data)
 	assert.Equal(t, []int64{1, 2, 3, 4}, resultData.Data[TimestampField].(*Int64FieldData).Data)
 	assert.Equal(t, []bool{true, false, true, false}, resultData.Data[BoolField].(*BoolFieldData).Data)
@@ -318,6 +369,14 @@ func TestInsertCodec(t *testing.T) {
 	assert.Equal(t, []string{"1", "2", "3", "4"}, resultData.Data[StringField].(*StringFieldData).Data)
 	assert.Equal(t, []byte{0, 255, 0, 255}, resultData.Data[BinaryVectorField].(*BinaryVectorFieldData).Data)
 	assert.Equal(t, []float32{0, 1, 2, 3, 0, 1, 2, 3, 4, 5, 6, 7, 4, 5, 6, 7}, resultData.Data[FloatVectorField].(*FloatVectorFieldData).Data)
+	assert.Equal(t, [][]byte{
+		[]byte(`{"batch":1}`), []byte(`{"key":"hello"}`), []byte(`{"batch":2}`), []byte(`{"key":"world"}`),
+	}, resultData.Data[JSONField].(*JSONFieldData).Data)
+	resultArrays := resultData.Data[ArrayField].(*ArrayFieldData)
+	assert.Equal(t, schemapb.DataType_Int32, resultArrays.ElementType)
+	assert.Equal(t, 4, len(resultArrays.Data))
+	assert.Equal(t, []int32{1, 2, 3}, resultArrays.Data[0].GetIntData().GetData())
+	assert.Equal(t, []int32{6, 5, 4}, resultArrays.Data[3].GetIntData().GetData())
 	log.Debug("Data", zap.Any("Data", resultData.Data))
 	log.Debug("Infos", zap.Any("Infos", resultData.Infos))
 
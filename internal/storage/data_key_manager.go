@@ -0,0 +1,256 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+const (
+	// dataKeySize is the size of the AES-256 keys.
+	dataKeySize = 32
+
+	// encryptionKeyPath is the path of the wrapped data keys, relative to the root path.
+	encryptionKeyPath = "encryption_keys"
+
+	// currentDataKeyTTL is how long the current data key of a collection is cached,
+	// the data key rotated by other nodes is picked up after it.
+	currentDataKeyTTL = 10 * time.Minute
+)
+
+// wrappedDataKey is the persisted data key, wrapped by the master key.
+type wrappedDataKey struct {
+	MasterKeyID string `json:"master_key_id"`
+	WrappedKey  []byte `json:"wrapped_key"`
+}
+
+type currentDataKey struct {
+	id       string
+	expireAt time.Time
+}
+
+// dataKeyManager manages the data keys of the collections, the data keys are wrapped by the KMS and
+// saved at [rootPath]/encryption_keys/[collectionID]/[keyID] of the chunk manager.
+//
+// The ids of the data keys start with the creation time in hex, so the latest created one is the
+// current data key of the collection, the files are always encrypted by the current data key.
+type dataKeyManager struct {
+	cm  ChunkManager
+	kms KMS
+
+	mu      sync.RWMutex
+	keys    map[string]cipher.AEAD // [collectionID]/[keyID] => data key
+	current map[int64]*currentDataKey
+
+	// createMu avoids creating the data key of a collection concurrently in the same node.
+	createMu sync.Mutex
+}
+
+func newDataKeyManager(cm ChunkManager, kms KMS) *dataKeyManager {
+	return &dataKeyManager{
+		cm:      cm,
+		kms:     kms,
+		keys:    make(map[string]cipher.AEAD),
+		current: make(map[int64]*currentDataKey),
+	}
+}
+
+func (m *dataKeyManager) keyPath(collectionID int64, keyID string) string {
+	return path.Join(m.cm.RootPath(), encryptionKeyPath, strconv.FormatInt(collectionID, 10), keyID)
+}
+
+// currentKey returns the current data key of the collection, the data key is created if the collection has none.
+func (m *dataKeyManager) currentKey(ctx context.Context, collectionID int64) (string, cipher.AEAD, error) {
+	m.mu.RLock()
+	current, ok := m.current[collectionID]
+	m.mu.RUnlock()
+	if ok && time.Now().Before(current.expireAt) {
+		key, err := m.getKey(ctx, collectionID, current.id)
+		return current.id, key, err
+	}
+
+	m.createMu.Lock()
+	defer m.createMu.Unlock()
+	keyIDs, _, err := m.cm.ListWithPrefix(ctx, m.keyPath(collectionID, "")+"/", true)
+	if err != nil {
+		return "", nil, err
+	}
+	var keyID string
+	for _, p := range keyIDs {
+		if id := path.Base(p); id > keyID {
+			keyID = id
+		}
+	}
+	if keyID == "" {
+		keyID, err = m.createKey(ctx, collectionID)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	key, err := m.getKey(ctx, collectionID, keyID)
+	if err != nil {
+		return "", nil, err
+	}
+	m.setCurrent(collectionID, keyID)
+	return keyID, key, nil
+}
+
+// getKey returns the data key of @keyID, the key is unwrapped by the KMS for the first time.
+func (m *dataKeyManager) getKey(ctx context.Context, collectionID int64, keyID string) (cipher.AEAD, error) {
+	cacheKey := fmt.Sprintf("%d/%s", collectionID, keyID)
+	m.mu.RLock()
+	key, ok := m.keys[cacheKey]
+	m.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	data, err := m.cm.Read(ctx, m.keyPath(collectionID, keyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data key %s of collection %d: %w", keyID, collectionID, err)
+	}
+	wrapped := wrappedDataKey{}
+	if err := json.Unmarshal(data, &wrapped); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal data key %s of collection %d: %w", keyID, collectionID, err)
+	}
+	plain, err := m.kms.UnwrapKey(ctx, wrapped.MasterKeyID, wrapped.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	key, err = newAEAD(plain)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	m.keys[cacheKey] = key
+	m.mu.Unlock()
+	return key, nil
+}
+
+// createKey generates a new data key for the collection, returns the id of the key.
+func (m *dataKeyManager) createKey(ctx context.Context, collectionID int64) (string, error) {
+	plain := make([]byte, dataKeySize)
+	if _, err := rand.Read(plain); err != nil {
+		return "", err
+	}
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+	keyID := fmt.Sprintf("%016x%s", time.Now().UnixNano(), hex.EncodeToString(suffix))
+
+	masterKeyID, wrapped, err := m.kms.WrapKey(ctx, plain)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(&wrappedDataKey{MasterKeyID: masterKeyID, WrappedKey: wrapped})
+	if err != nil {
+		return "", err
+	}
+	if err := m.cm.Write(ctx, m.keyPath(collectionID, keyID), data); err != nil {
+		return "", err
+	}
+	log.Ctx(ctx).Info("data key created", zap.Int64("collectionID", collectionID),
+		zap.String("keyID", keyID), zap.String("masterKeyID", masterKeyID))
+	return keyID, nil
+}
+
+// DataKeyCreateTime returns the creation time of the data key, which is encoded at the start of @keyID.
+func DataKeyCreateTime(keyID string) (time.Time, error) {
+	if len(keyID) < 16 {
+		return time.Time{}, fmt.Errorf("invalid data key id %s", keyID)
+	}
+	nanos, err := strconv.ParseInt(keyID[:16], 16, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid data key id %s: %w", keyID, err)
+	}
+	return time.Unix(0, nanos), nil
+}
+
+func (m *dataKeyManager) setCurrent(collectionID int64, keyID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.current[collectionID] = &currentDataKey{id: keyID, expireAt: time.Now().Add(currentDataKeyTTL)}
+}
+
+// rotate creates a new data key as the current data key of the collection,
+// the files encrypted by the old data keys are still readable.
+func (m *dataKeyManager) rotate(ctx context.Context, collectionID int64) (string, error) {
+	m.createMu.Lock()
+	defer m.createMu.Unlock()
+	keyID, err := m.createKey(ctx, collectionID)
+	if err != nil {
+		return "", err
+	}
+	m.setCurrent(collectionID, keyID)
+	return keyID, nil
+}
+
+// rewrap wraps all the data keys again by the current master key of the KMS,
+// returns the number of the data keys rewrapped. The files are not touched since the data keys are unchanged.
+func (m *dataKeyManager) rewrap(ctx context.Context) (int, error) {
+	keyPaths, _, err := m.cm.ListWithPrefix(ctx, path.Join(m.cm.RootPath(), encryptionKeyPath)+"/", true)
+	if err != nil {
+		return 0, err
+	}
+	rewrapped := 0
+	for _, keyPath := range keyPaths {
+		data, err := m.cm.Read(ctx, keyPath)
+		if err != nil {
+			return rewrapped, err
+		}
+		wrapped := wrappedDataKey{}
+		if err := json.Unmarshal(data, &wrapped); err != nil {
+			return rewrapped, fmt.Errorf("failed to unmarshal data key %s: %w", keyPath, err)
+		}
+		plain, err := m.kms.UnwrapKey(ctx, wrapped.MasterKeyID, wrapped.WrappedKey)
+		if err != nil {
+			return rewrapped, err
+		}
+		masterKeyID, newWrapped, err := m.kms.WrapKey(ctx, plain)
+		if err != nil {
+			return rewrapped, err
+		}
+		if masterKeyID == wrapped.MasterKeyID {
+			continue
+		}
+		data, err = json.Marshal(&wrappedDataKey{MasterKeyID: masterKeyID, WrappedKey: newWrapped})
+		if err != nil {
+			return rewrapped, err
+		}
+		if err := m.cm.Write(ctx, keyPath, data); err != nil {
+			return rewrapped, err
+		}
+		rewrapped++
+	}
+	log.Ctx(ctx).Info("data keys rewrapped", zap.Int("total", len(keyPaths)), zap.Int("rewrapped", rewrapped))
+	return rewrapped, nil
+}
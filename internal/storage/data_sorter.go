@@ -19,6 +19,7 @@ package storage
 import (
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // DataSorter sorts insert data
@@ -80,6 +81,12 @@ func (ds *DataSorter) Swap(i, j int) {
 		case schemapb.DataType_String, schemapb.DataType_VarChar:
 			data := singleData.(*StringFieldData).Data
 			data[i], data[j] = data[j], data[i]
+		case typeutil.DataTypeJSON:
+			data := singleData.(*JSONFieldData).Data
+			data[i], data[j] = data[j], data[i]
+		case typeutil.DataTypeArray:
+			data := singleData.(*ArrayFieldData).Data
+			data[i], data[j] = data[j], data[i]
 		case schemapb.DataType_BinaryVector:
 			data := singleData.(*BinaryVectorFieldData).Data
 			dim := singleData.(*BinaryVectorFieldData).Dim
@@ -98,6 +105,10 @@ func (ds *DataSorter) Swap(i, j int) {
 			errMsg := "undefined data type " + string(field.DataType)
 			panic(errMsg)
 		}
+		if nullableData, ok := singleData.(NullableFieldData); ok && nullableData.GetValidData() != nil {
+			validData := nullableData.GetValidData()
+			validData[i], validData[j] = validData[j], validData[i]
+		}
 	}
 }
 
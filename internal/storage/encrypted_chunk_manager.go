@@ -0,0 +1,321 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus/internal/common"
+)
+
+// The layout of the encrypted file:
+//
+//	| magic (4 bytes) | version (1 byte) | collection id (8 bytes) | key id length (1 byte) | key id | nonce (12 bytes) | ciphertext | tag (16 bytes) |
+//
+// The header before the nonce is authenticated with the ciphertext by AES-256-GCM.
+var encryptionMagic = []byte("MENC")
+
+const (
+	encryptionVersion         = 1
+	encryptionFixedHeaderSize = 4 + 1 + 8 + 1
+	gcmNonceSize              = 12
+	gcmTagSize                = 16
+)
+
+type collectionIDKey struct{}
+
+// WithCollectionID returns a context carries the collection id of the files to write,
+// the EncryptedChunkManager encrypts the index files with the data key of the collection,
+// since the paths of the index files don't contain the collection id.
+func WithCollectionID(ctx context.Context, collectionID int64) context.Context {
+	return context.WithValue(ctx, collectionIDKey{}, collectionID)
+}
+
+// EncryptedChunkManager encrypts the insert, delta, stats binlogs and the index files with the data key
+// of their collection before writing them to the underlying chunk manager, and decrypts them on reading.
+// The other files are written as is, and the files written before the encryption is enabled are read as is.
+type EncryptedChunkManager struct {
+	ChunkManager
+	keys *dataKeyManager
+}
+
+var _ ChunkManager = (*EncryptedChunkManager)(nil)
+
+// NewEncryptedChunkManager creates an EncryptedChunkManager with the data keys wrapped by @kms,
+// the wrapped data keys are saved in @cm as well.
+func NewEncryptedChunkManager(cm ChunkManager, kms KMS) *EncryptedChunkManager {
+	return &EncryptedChunkManager{
+		ChunkManager: cm,
+		keys:         newDataKeyManager(cm, kms),
+	}
+}
+
+// CurrentKeyID returns the id of the data key to encrypt the files of the collection.
+func (ecm *EncryptedChunkManager) CurrentKeyID(ctx context.Context, collectionID int64) (string, error) {
+	keyID, _, err := ecm.keys.currentKey(ctx, collectionID)
+	return keyID, err
+}
+
+// RotateKey creates a new data key for the collection, the new files of the collection are encrypted by it.
+// The other nodes pick up the new data key in 10 minutes. DataCoord rotates the data keys periodically,
+// see common.security.encryption.keyRotationInterval.
+func (ecm *EncryptedChunkManager) RotateKey(ctx context.Context, collectionID int64) (string, error) {
+	return ecm.keys.rotate(ctx, collectionID)
+}
+
+// RewrapKeys wraps all the data keys by the current master key, should be called after the master key is rotated.
+// DataCoord calls it on start, since the master keys are loaded on start.
+func (ecm *EncryptedChunkManager) RewrapKeys(ctx context.Context) (int, error) {
+	return ecm.keys.rewrap(ctx)
+}
+
+// EncryptionKeyID returns the id of the data key to encrypt the files of the collection,
+// returns empty string if @cm doesn't encrypt the files.
+func EncryptionKeyID(ctx context.Context, cm ChunkManager, collectionID int64) (string, error) {
+	ecm, ok := cm.(*EncryptedChunkManager)
+	if !ok {
+		return "", nil
+	}
+	return ecm.CurrentKeyID(ctx, collectionID)
+}
+
+// PlainChunkManager returns the chunk manager under @cm if it is an EncryptedChunkManager,
+// the files written by the returned chunk manager are never encrypted.
+func PlainChunkManager(cm ChunkManager) ChunkManager {
+	if ecm, ok := cm.(*EncryptedChunkManager); ok {
+		return ecm.ChunkManager
+	}
+	return cm
+}
+
+// Size returns the size of the plaintext of @filePath.
+func (ecm *EncryptedChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	size, err := ecm.ChunkManager.Size(ctx, filePath)
+	if err != nil || size < encryptionFixedHeaderSize {
+		return size, err
+	}
+	header, err := ecm.ChunkManager.ReadAt(ctx, filePath, 0, encryptionFixedHeaderSize)
+	if err != nil {
+		return 0, err
+	}
+	if !isEncrypted(header) {
+		return size, nil
+	}
+	return size - encryptionFixedHeaderSize - int64(header[encryptionFixedHeaderSize-1]) - gcmNonceSize - gcmTagSize, nil
+}
+
+// Write encrypts @content if necessary and writes it to @filePath.
+func (ecm *EncryptedChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	encrypted, err := ecm.encrypt(ctx, filePath, content)
+	if err != nil {
+		return err
+	}
+	return ecm.ChunkManager.Write(ctx, filePath, encrypted)
+}
+
+// MultiWrite encrypts @contents if necessary and writes them.
+func (ecm *EncryptedChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	encrypted := make(map[string][]byte, len(contents))
+	for filePath, content := range contents {
+		value, err := ecm.encrypt(ctx, filePath, content)
+		if err != nil {
+			return err
+		}
+		encrypted[filePath] = value
+	}
+	return ecm.ChunkManager.MultiWrite(ctx, encrypted)
+}
+
+// Read reads @filePath and decrypts the content if it's encrypted.
+func (ecm *EncryptedChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	content, err := ecm.ChunkManager.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return ecm.decrypt(ctx, filePath, content)
+}
+
+// Reader returns a reader of the decrypted content of @filePath.
+func (ecm *EncryptedChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	content, err := ecm.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+// MultiRead reads @filePaths and decrypts the contents if they are encrypted.
+func (ecm *EncryptedChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	contents, err := ecm.ChunkManager.MultiRead(ctx, filePaths)
+	if err != nil {
+		return nil, err
+	}
+	for i, content := range contents {
+		contents[i], err = ecm.decrypt(ctx, filePaths[i], content)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return contents, nil
+}
+
+// ReadWithPrefix reads files with same @prefix and decrypts the contents if they are encrypted.
+func (ecm *EncryptedChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	filePaths, contents, err := ecm.ChunkManager.ReadWithPrefix(ctx, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, content := range contents {
+		contents[i], err = ecm.decrypt(ctx, filePaths[i], content)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return filePaths, contents, nil
+}
+
+// Mmap is not supported for the encrypted files.
+func (ecm *EncryptedChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	if isEncryptedPath(filePath) {
+		return nil, errors.New("mmap is not supported for the encrypted file: " + filePath)
+	}
+	return ecm.ChunkManager.Mmap(ctx, filePath)
+}
+
+// ReadAt reads the decrypted content of @filePath by offset @off.
+// AES-GCM authenticates the ciphertext as a whole, so the whole object is read and decrypted for an encrypted file,
+// the callers reading an encrypted file repeatedly should cache it, like the VectorChunkManager does.
+// The other files are read by range from the underlying chunk manager.
+func (ecm *EncryptedChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if off < 0 || length < 0 {
+		return nil, io.EOF
+	}
+	if !isEncryptedPath(filePath) {
+		return ecm.ChunkManager.ReadAt(ctx, filePath, off, length)
+	}
+	// the files written before the encryption is enabled are read by range as well.
+	header, err := ecm.ChunkManager.ReadAt(ctx, filePath, 0, encryptionFixedHeaderSize)
+	if err == nil && !isEncrypted(header) {
+		return ecm.ChunkManager.ReadAt(ctx, filePath, off, length)
+	}
+	content, err := ecm.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if off+length > int64(len(content)) {
+		return nil, io.EOF
+	}
+	return content[off : off+length], nil
+}
+
+func (ecm *EncryptedChunkManager) encrypt(ctx context.Context, filePath string, content []byte) ([]byte, error) {
+	collectionID, ok, err := encryptedCollectionOf(ctx, filePath)
+	if err != nil || !ok {
+		return content, err
+	}
+	keyID, key, err := ecm.keys.currentKey(ctx, collectionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the data key of collection %d: %w", collectionID, err)
+	}
+
+	headerSize := encryptionFixedHeaderSize + len(keyID)
+	encrypted := make([]byte, headerSize+gcmNonceSize, headerSize+gcmNonceSize+len(content)+gcmTagSize)
+	copy(encrypted, encryptionMagic)
+	encrypted[4] = encryptionVersion
+	binary.LittleEndian.PutUint64(encrypted[5:], uint64(collectionID))
+	encrypted[encryptionFixedHeaderSize-1] = byte(len(keyID))
+	copy(encrypted[encryptionFixedHeaderSize:], keyID)
+	nonce := encrypted[headerSize:]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return key.Seal(encrypted, nonce, content, encrypted[:headerSize]), nil
+}
+
+func (ecm *EncryptedChunkManager) decrypt(ctx context.Context, filePath string, content []byte) ([]byte, error) {
+	if !isEncrypted(content) {
+		return content, nil
+	}
+	headerSize := encryptionFixedHeaderSize + int(content[encryptionFixedHeaderSize-1])
+	if len(content) < headerSize+gcmNonceSize+gcmTagSize {
+		return nil, fmt.Errorf("the encrypted file %s is truncated", filePath)
+	}
+	collectionID := int64(binary.LittleEndian.Uint64(content[5:]))
+	keyID := string(content[encryptionFixedHeaderSize:headerSize])
+	key, err := ecm.keys.getKey(ctx, collectionID, keyID)
+	if err != nil {
+		return nil, err
+	}
+	nonce := content[headerSize : headerSize+gcmNonceSize]
+	plain, err := key.Open(nil, nonce, content[headerSize+gcmNonceSize:], content[:headerSize])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt file %s: %w", filePath, err)
+	}
+	return plain, nil
+}
+
+// isEncrypted tells whether the content starts with the header of encrypted file,
+// the binlogs and the index files start with the binlog magic number, so they never conflict.
+func isEncrypted(content []byte) bool {
+	return len(content) >= encryptionFixedHeaderSize &&
+		bytes.Equal(content[:len(encryptionMagic)], encryptionMagic) &&
+		content[4] == encryptionVersion
+}
+
+// isEncryptedPath tells whether the file of @filePath should be encrypted.
+func isEncryptedPath(filePath string) bool {
+	for _, part := range strings.Split(filePath, "/") {
+		switch part {
+		case common.SegmentInsertLogPath, common.SegmentDeltaLogPath, common.SegmentStatslogPath, common.SegmentIndexPath:
+			return true
+		}
+	}
+	return false
+}
+
+// encryptedCollectionOf returns the collection id of the file if it should be encrypted.
+// The binlog paths are like [rootPath]/[insert_log]/[collectionID]/..., the collection id of
+// the index files is from the context.
+func encryptedCollectionOf(ctx context.Context, filePath string) (int64, bool, error) {
+	parts := strings.Split(filePath, "/")
+	for i, part := range parts {
+		switch part {
+		case common.SegmentInsertLogPath, common.SegmentDeltaLogPath, common.SegmentStatslogPath:
+			if i+1 < len(parts) {
+				if collectionID, err := strconv.ParseInt(parts[i+1], 10, 64); err == nil {
+					return collectionID, true, nil
+				}
+			}
+		case common.SegmentIndexPath:
+			if collectionID, ok := ctx.Value(collectionIDKey{}).(int64); ok {
+				return collectionID, true, nil
+			}
+			return 0, false, fmt.Errorf("the collection of index file %s is unknown", filePath)
+		}
+	}
+	return 0, false, nil
+}
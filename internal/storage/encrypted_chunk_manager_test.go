@@ -0,0 +1,224 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"io"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/common"
+)
+
+func newTestEncryptedChunkManager(t *testing.T, kmsKeyFile []byte) (*EncryptedChunkManager, *LocalChunkManager) {
+	kms, err := newLocalKMS(kmsKeyFile)
+	require.NoError(t, err)
+	cm := NewLocalChunkManager(RootPath(t.TempDir()))
+	return NewEncryptedChunkManager(cm, kms), cm
+}
+
+func TestEncryptedChunkManager(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("binlogs", func(t *testing.T) {
+		ecm, cm := newTestEncryptedChunkManager(t, testKeyFile("key1", "key1"))
+		content := []byte("binlog content")
+		insertLog := path.Join(cm.RootPath(), common.SegmentInsertLogPath, "1/2/3/100/1000")
+		deltaLog := path.Join(cm.RootPath(), common.SegmentDeltaLogPath, "1/2/3/1001")
+		statsLog := path.Join(cm.RootPath(), common.SegmentStatslogPath, "2/2/3/100/1002")
+		require.NoError(t, ecm.Write(ctx, insertLog, content))
+		require.NoError(t, ecm.MultiWrite(ctx, map[string][]byte{deltaLog: content, statsLog: content}))
+
+		for _, filePath := range []string{insertLog, deltaLog, statsLog} {
+			raw, err := cm.Read(ctx, filePath)
+			require.NoError(t, err)
+			assert.True(t, isEncrypted(raw))
+			assert.NotContains(t, string(raw), string(content))
+
+			data, err := ecm.Read(ctx, filePath)
+			require.NoError(t, err)
+			assert.Equal(t, content, data)
+
+			size, err := ecm.Size(ctx, filePath)
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(content)), size)
+		}
+
+		contents, err := ecm.MultiRead(ctx, []string{insertLog, deltaLog})
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{content, content}, contents)
+
+		_, contents, err = ecm.ReadWithPrefix(ctx, path.Join(cm.RootPath(), common.SegmentStatslogPath))
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{content}, contents)
+
+		data, err := ecm.ReadAt(ctx, insertLog, 7, 7)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("content"), data)
+		_, err = ecm.ReadAt(ctx, insertLog, 10, 10)
+		assert.Equal(t, io.EOF, err)
+		_, err = ecm.ReadAt(ctx, insertLog, -1, 10)
+		assert.Equal(t, io.EOF, err)
+
+		reader, err := ecm.Reader(ctx, insertLog)
+		require.NoError(t, err)
+		data, err = io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, content, data)
+		assert.NoError(t, reader.Close())
+
+		_, err = ecm.Mmap(ctx, insertLog)
+		assert.Error(t, err)
+
+		// collection 1 and 2 have their own data keys.
+		keyID1, err := ecm.CurrentKeyID(ctx, 1)
+		require.NoError(t, err)
+		keyID2, err := ecm.CurrentKeyID(ctx, 2)
+		require.NoError(t, err)
+		assert.NotEqual(t, keyID1, keyID2)
+		keyID, err := EncryptionKeyID(ctx, ecm, 1)
+		require.NoError(t, err)
+		assert.Equal(t, keyID1, keyID)
+		keyID, err = EncryptionKeyID(ctx, cm, 1)
+		require.NoError(t, err)
+		assert.Empty(t, keyID)
+
+		assert.Same(t, cm, PlainChunkManager(ecm))
+		assert.Same(t, cm, PlainChunkManager(cm))
+	})
+
+	t.Run("index files", func(t *testing.T) {
+		ecm, cm := newTestEncryptedChunkManager(t, testKeyFile("key1", "key1"))
+		content := []byte("index content")
+		indexFile := path.Join(cm.RootPath(), common.SegmentIndexPath, "1/1/2/3/index")
+		assert.Error(t, ecm.Write(ctx, indexFile, content))
+
+		require.NoError(t, ecm.Write(WithCollectionID(ctx, 1), indexFile, content))
+		raw, err := cm.Read(ctx, indexFile)
+		require.NoError(t, err)
+		assert.True(t, isEncrypted(raw))
+		data, err := ecm.Read(ctx, indexFile)
+		require.NoError(t, err)
+		assert.Equal(t, content, data)
+	})
+
+	t.Run("plain files", func(t *testing.T) {
+		ecm, cm := newTestEncryptedChunkManager(t, testKeyFile("key1", "key1"))
+		content := []byte("plain content")
+		plainFile := path.Join(cm.RootPath(), "backup/meta.json")
+		require.NoError(t, ecm.Write(ctx, plainFile, content))
+		raw, err := cm.Read(ctx, plainFile)
+		require.NoError(t, err)
+		assert.Equal(t, content, raw)
+		data, err := ecm.ReadAt(ctx, plainFile, 6, 7)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("content"), data)
+
+		// the binlogs written before the encryption is enabled are read as is.
+		insertLog := path.Join(cm.RootPath(), common.SegmentInsertLogPath, "1/2/3/100/1000")
+		require.NoError(t, cm.Write(ctx, insertLog, content))
+		data, err = ecm.Read(ctx, insertLog)
+		require.NoError(t, err)
+		assert.Equal(t, content, data)
+		data, err = ecm.ReadAt(ctx, insertLog, 0, 5)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("plain"), data)
+		size, err := ecm.Size(ctx, insertLog)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(content)), size)
+	})
+
+	t.Run("tampered file", func(t *testing.T) {
+		ecm, cm := newTestEncryptedChunkManager(t, testKeyFile("key1", "key1"))
+		insertLog := path.Join(cm.RootPath(), common.SegmentInsertLogPath, "1/2/3/100/1000")
+		require.NoError(t, ecm.Write(ctx, insertLog, []byte("binlog content")))
+		raw, err := cm.Read(ctx, insertLog)
+		require.NoError(t, err)
+
+		tampered := append([]byte{}, raw...)
+		tampered[len(tampered)-1] ^= 1
+		require.NoError(t, cm.Write(ctx, insertLog, tampered))
+		_, err = ecm.Read(ctx, insertLog)
+		assert.Error(t, err)
+
+		// the collection id in the header is authenticated.
+		tampered = append([]byte{}, raw...)
+		tampered[5] = 2
+		require.NoError(t, cm.Write(ctx, insertLog, tampered))
+		_, err = ecm.Read(ctx, insertLog)
+		assert.Error(t, err)
+
+		require.NoError(t, cm.Write(ctx, insertLog, raw[:encryptionFixedHeaderSize+4]))
+		_, err = ecm.Read(ctx, insertLog)
+		assert.Error(t, err)
+	})
+
+	t.Run("rotation", func(t *testing.T) {
+		ecm, cm := newTestEncryptedChunkManager(t, testKeyFile("key1", "key1"))
+		oldLog := path.Join(cm.RootPath(), common.SegmentInsertLogPath, "1/2/3/100/1000")
+		require.NoError(t, ecm.Write(ctx, oldLog, []byte("old")))
+		oldKeyID, err := ecm.CurrentKeyID(ctx, 1)
+		require.NoError(t, err)
+
+		newKeyID, err := ecm.RotateKey(ctx, 1)
+		require.NoError(t, err)
+		assert.Greater(t, newKeyID, oldKeyID)
+		oldCreated, err := DataKeyCreateTime(oldKeyID)
+		require.NoError(t, err)
+		newCreated, err := DataKeyCreateTime(newKeyID)
+		require.NoError(t, err)
+		assert.False(t, newCreated.Before(oldCreated))
+		assert.WithinDuration(t, time.Now(), newCreated, time.Minute)
+		_, err = DataKeyCreateTime("invalid")
+		assert.Error(t, err)
+		keyID, err := ecm.CurrentKeyID(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, newKeyID, keyID)
+		newLog := path.Join(cm.RootPath(), common.SegmentInsertLogPath, "1/2/3/100/1001")
+		require.NoError(t, ecm.Write(ctx, newLog, []byte("new")))
+
+		// rotate the master key, the data keys are rewrapped.
+		kms, err := newLocalKMS(testKeyFile("key2", "key1", "key2"))
+		require.NoError(t, err)
+		rotated := NewEncryptedChunkManager(cm, kms)
+		rewrapped, err := rotated.RewrapKeys(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, rewrapped)
+		rewrapped, err = rotated.RewrapKeys(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, rewrapped)
+
+		// the old master key is not needed any more.
+		kms, err = newLocalKMS([]byte(`{"current": "key2", "keys": {"key2": "` + testMasterKey(2) + `"}}`))
+		require.NoError(t, err)
+		ecm = NewEncryptedChunkManager(cm, kms)
+		keyID, err = ecm.CurrentKeyID(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, newKeyID, keyID)
+		data, err := ecm.Read(ctx, oldLog)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("old"), data)
+		data, err = ecm.Read(ctx, newLog)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("new"), data)
+	})
+}
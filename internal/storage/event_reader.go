@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/compressor"
 )
 
 // EventReader is used to parse the events contained in the Binlog file.
@@ -85,7 +86,8 @@ func (reader *EventReader) Close() {
 	}
 }
 
-func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer) (*EventReader, error) {
+// newEventReader reads the next event from @buffer, the payload is decompressed by @compressType if it's not empty.
+func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer, compressType compressor.CompressType) (*EventReader, error) {
 	reader := &EventReader{
 		eventHeader: eventHeader{
 			baseEventHeader{},
@@ -103,6 +105,13 @@ func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer) (*EventRea
 
 	next := int(reader.EventLength - reader.eventHeader.GetMemoryUsageInBytes() - reader.GetEventDataFixPartSize())
 	payloadBuffer := buffer.Next(next)
+	if compressType != "" {
+		var err error
+		payloadBuffer, err = decompressPayload(compressType, payloadBuffer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress the %s payload: %w", compressType, err)
+		}
+	}
 	payloadReader, err := NewPayloadReader(datatype, payloadBuffer)
 	if err != nil {
 		return nil, err
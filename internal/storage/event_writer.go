@@ -111,10 +111,30 @@ type baseEventWriter struct {
 	offset           int32
 	getEventDataSize func() int32
 	writeEventData   func(buffer io.Writer) error
+
+	// compression is the codec of the payload, the payload is compressed once the writer is finished.
+	compression BinlogCompression
+	compressed  []byte
 }
 
-func (writer *baseEventWriter) GetMemoryUsageInBytes() (int32, error) {
+// payloadBuffer returns the payload to write, which is compressed if the compression is enabled.
+func (writer *baseEventWriter) payloadBuffer() ([]byte, error) {
+	if writer.compressed != nil {
+		return writer.compressed, nil
+	}
 	data, err := writer.GetPayloadBufferFromWriter()
+	if err != nil || !writer.compression.Enabled() || !writer.isFinish {
+		return data, err
+	}
+	writer.compressed, err = compressPayload(writer.compression, data)
+	if err != nil {
+		return nil, err
+	}
+	return writer.compressed, nil
+}
+
+func (writer *baseEventWriter) GetMemoryUsageInBytes() (int32, error) {
+	data, err := writer.payloadBuffer()
 	if err != nil {
 		return -1, err
 	}
@@ -129,7 +149,7 @@ func (writer *baseEventWriter) Write(buffer *bytes.Buffer) error {
 	if err := writer.writeEventData(buffer); err != nil {
 		return err
 	}
-	data, err := writer.GetPayloadBufferFromWriter()
+	data, err := writer.payloadBuffer()
 	if err != nil {
 		return err
 	}
@@ -13,10 +13,19 @@ type ChunkManagerFactory struct {
 }
 
 func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkManagerFactory {
+	encryptionOpts := []Option{
+		EncryptionEnabled(params.CommonCfg.EncryptionEnabled.GetAsBool()),
+		KMSProvider(params.CommonCfg.EncryptionKMS.GetValue()),
+		LocalKeyFile(params.CommonCfg.EncryptionLocalKeyFile.GetValue()),
+	}
 	if params.CommonCfg.StorageType.GetValue() == "local" {
-		return NewChunkManagerFactory("local", RootPath(params.LocalStorageCfg.Path.GetValue()))
+		return NewChunkManagerFactory("local", append(encryptionOpts, RootPath(params.LocalStorageCfg.Path.GetValue()))...)
+	}
+	engine := "minio"
+	if params.MinioCfg.CloudProvider.GetValue() == CloudProviderAzure {
+		engine = "azure"
 	}
-	return NewChunkManagerFactory("minio",
+	return NewChunkManagerFactory(engine, append(encryptionOpts,
 		RootPath(params.MinioCfg.RootPath.GetValue()),
 		Address(params.MinioCfg.Address.GetValue()),
 		AccessKeyID(params.MinioCfg.AccessKeyID.GetValue()),
@@ -26,7 +35,7 @@ func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkMa
 		UseIAM(params.MinioCfg.UseIAM.GetAsBool()),
 		CloudProvider(params.MinioCfg.CloudProvider.GetValue()),
 		IAMEndpoint(params.MinioCfg.IAMEndpoint.GetValue()),
-		CreateBucket(true))
+		CreateBucket(true))...)
 }
 
 func NewChunkManagerFactory(persistentStorage string, opts ...Option) *ChunkManagerFactory {
@@ -46,13 +55,25 @@ func (f *ChunkManagerFactory) newChunkManager(ctx context.Context, engine string
 		return NewLocalChunkManager(RootPath(f.config.rootPath)), nil
 	case "minio":
 		return newMinioChunkManagerWithConfig(ctx, f.config)
+	case "azure":
+		return newAzureChunkManagerWithConfig(ctx, f.config)
 	default:
 		return nil, errors.New("no chunk manager implemented with engine: " + engine)
 	}
 }
 
+// NewPersistentStorageChunkManager creates the chunk manager of the persistent storage,
+// the chunk manager encrypts the binlogs and the index files if the encryption is enabled.
 func (f *ChunkManagerFactory) NewPersistentStorageChunkManager(ctx context.Context) (ChunkManager, error) {
-	return f.newChunkManager(ctx, f.persistentStorage)
+	cm, err := f.newChunkManager(ctx, f.persistentStorage)
+	if err != nil || !f.config.encryptionEnabled {
+		return cm, err
+	}
+	kms, err := newKMS(f.config.kmsProvider, f.config.localKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return NewEncryptedChunkManager(cm, kms), nil
 }
 
 type Factory interface {
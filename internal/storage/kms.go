@@ -0,0 +1,142 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// KMS is the key management service which holds the master keys,
+// the data keys are wrapped by the master keys before they are persisted.
+type KMS interface {
+	// WrapKey encrypts the data key with the current master key, returns the id of the master key and the wrapped key.
+	WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error)
+	// UnwrapKey decrypts the wrapped data key with the master key of @masterKeyID.
+	UnwrapKey(ctx context.Context, masterKeyID string, wrappedKey []byte) ([]byte, error)
+}
+
+// newKMS creates the KMS of the provider.
+func newKMS(provider string, localKeyFile string) (KMS, error) {
+	switch provider {
+	case "local":
+		return NewLocalKMS(localKeyFile)
+	default:
+		return nil, errors.New("no kms implemented with provider: " + provider)
+	}
+}
+
+// LocalKMS is the KMS with the master keys loaded from a local key file, the key file is a JSON like:
+//
+//	{"current": "key2", "keys": {"key1": "<base64 encoded 32 bytes>", "key2": "<base64 encoded 32 bytes>"}}
+//
+// The data keys are always wrapped by the current master key, the old master keys are kept to
+// unwrap the data keys wrapped before the rotation.
+type LocalKMS struct {
+	current string
+	keys    map[string]cipher.AEAD
+}
+
+var _ KMS = (*LocalKMS)(nil)
+
+// NewLocalKMS loads the master keys from the key file.
+func NewLocalKMS(keyFile string) (*LocalKMS, error) {
+	if keyFile == "" {
+		return nil, errors.New("the key file of local kms is not configured")
+	}
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the key file of local kms: %w", err)
+	}
+	return newLocalKMS(data)
+}
+
+func newLocalKMS(data []byte) (*LocalKMS, error) {
+	var file struct {
+		Current string            `json:"current"`
+		Keys    map[string]string `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse the key file of local kms: %w", err)
+	}
+	if _, ok := file.Keys[file.Current]; !ok {
+		return nil, fmt.Errorf("the current master key %s is not in the key file", file.Current)
+	}
+
+	kms := &LocalKMS{
+		current: file.Current,
+		keys:    make(map[string]cipher.AEAD, len(file.Keys)),
+	}
+	for id, encoded := range file.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode master key %s: %w", id, err)
+		}
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("the size of master key %s is %d, should be %d", id, len(key), dataKeySize)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		kms.keys[id] = aead
+	}
+	return kms, nil
+}
+
+// WrapKey encrypts the data key with the current master key.
+func (kms *LocalKMS) WrapKey(ctx context.Context, dataKey []byte) (string, []byte, error) {
+	aead := kms.keys[kms.current]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, err
+	}
+	return kms.current, aead.Seal(nonce, nonce, dataKey, []byte(kms.current)), nil
+}
+
+// UnwrapKey decrypts the wrapped data key with the master key of @masterKeyID.
+func (kms *LocalKMS) UnwrapKey(ctx context.Context, masterKeyID string, wrappedKey []byte) ([]byte, error) {
+	aead, ok := kms.keys[masterKeyID]
+	if !ok {
+		return nil, fmt.Errorf("master key %s not found", masterKeyID)
+	}
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, errors.New("invalid wrapped key")
+	}
+	nonce, ciphertext := wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, ciphertext, []byte(masterKeyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key with master key %s: %w", masterKeyID, err)
+	}
+	return dataKey, nil
+}
+
+// newAEAD returns the AES-256-GCM cipher of the key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
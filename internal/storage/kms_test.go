@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMasterKey(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, dataKeySize))
+}
+
+func testKeyFile(current string, ids ...string) []byte {
+	keys := ""
+	for i, id := range ids {
+		if i > 0 {
+			keys += ","
+		}
+		keys += fmt.Sprintf(`"%s": "%s"`, id, testMasterKey(byte(i+1)))
+	}
+	return []byte(fmt.Sprintf(`{"current": "%s", "keys": {%s}}`, current, keys))
+}
+
+func TestLocalKMS(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("wrap and unwrap", func(t *testing.T) {
+		kms, err := newLocalKMS(testKeyFile("key1", "key1"))
+		require.NoError(t, err)
+
+		dataKey := bytes.Repeat([]byte{9}, dataKeySize)
+		masterKeyID, wrapped, err := kms.WrapKey(ctx, dataKey)
+		require.NoError(t, err)
+		assert.Equal(t, "key1", masterKeyID)
+		assert.NotEqual(t, dataKey, wrapped)
+
+		unwrapped, err := kms.UnwrapKey(ctx, masterKeyID, wrapped)
+		require.NoError(t, err)
+		assert.Equal(t, dataKey, unwrapped)
+
+		_, err = kms.UnwrapKey(ctx, "key2", wrapped)
+		assert.Error(t, err)
+
+		wrapped[len(wrapped)-1] ^= 1
+		_, err = kms.UnwrapKey(ctx, masterKeyID, wrapped)
+		assert.Error(t, err)
+
+		_, err = kms.UnwrapKey(ctx, masterKeyID, []byte{1})
+		assert.Error(t, err)
+	})
+
+	t.Run("rotate master key", func(t *testing.T) {
+		oldKMS, err := newLocalKMS(testKeyFile("key1", "key1"))
+		require.NoError(t, err)
+		dataKey := bytes.Repeat([]byte{9}, dataKeySize)
+		_, wrapped, err := oldKMS.WrapKey(ctx, dataKey)
+		require.NoError(t, err)
+
+		kms, err := newLocalKMS(testKeyFile("key2", "key1", "key2"))
+		require.NoError(t, err)
+		unwrapped, err := kms.UnwrapKey(ctx, "key1", wrapped)
+		require.NoError(t, err)
+		assert.Equal(t, dataKey, unwrapped)
+
+		masterKeyID, _, err := kms.WrapKey(ctx, dataKey)
+		require.NoError(t, err)
+		assert.Equal(t, "key2", masterKeyID)
+	})
+
+	t.Run("invalid key file", func(t *testing.T) {
+		_, err := newLocalKMS([]byte("invalid"))
+		assert.Error(t, err)
+
+		_, err = newLocalKMS(testKeyFile("key2", "key1"))
+		assert.Error(t, err)
+
+		_, err = newLocalKMS([]byte(`{"current": "key1", "keys": {"key1": "invalid base64"}}`))
+		assert.Error(t, err)
+
+		_, err = newLocalKMS([]byte(`{"current": "key1", "keys": {"key1": "MTIz"}}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("load key file", func(t *testing.T) {
+		_, err := NewLocalKMS("")
+		assert.Error(t, err)
+
+		keyFile := filepath.Join(t.TempDir(), "keys.json")
+		_, err = NewLocalKMS(keyFile)
+		assert.Error(t, err)
+
+		require.NoError(t, os.WriteFile(keyFile, testKeyFile("key1", "key1"), 0600))
+		kms, err := NewLocalKMS(keyFile)
+		require.NoError(t, err)
+		assert.Equal(t, "key1", kms.current)
+
+		_, err = newKMS("local", keyFile)
+		assert.NoError(t, err)
+		_, err = newKMS("unknown", keyFile)
+		assert.Error(t, err)
+	})
+}
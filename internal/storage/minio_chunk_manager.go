@@ -41,8 +41,9 @@ var (
 )
 
 const (
-	CloudProviderGCP = "gcp"
-	CloudProviderAWS = "aws"
+	CloudProviderGCP   = "gcp"
+	CloudProviderAWS   = "aws"
+	CloudProviderAzure = "azure"
 )
 
 func WrapErrNoSuchKey(key string) error {
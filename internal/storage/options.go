@@ -12,6 +12,9 @@ type config struct {
 	useIAM            bool
 	cloudProvider     string
 	iamEndpoint       string
+	encryptionEnabled bool
+	kmsProvider       string
+	localKeyFile      string
 }
 
 func newDefaultConfig() *config {
@@ -79,3 +82,21 @@ func IAMEndpoint(iamEndpoint string) Option {
 		c.iamEndpoint = iamEndpoint
 	}
 }
+
+func EncryptionEnabled(enabled bool) Option {
+	return func(c *config) {
+		c.encryptionEnabled = enabled
+	}
+}
+
+func KMSProvider(provider string) Option {
+	return func(c *config) {
+		c.kmsProvider = provider
+	}
+}
+
+func LocalKeyFile(keyFile string) Option {
+	return func(c *config) {
+		c.localKeyFile = keyFile
+	}
+}
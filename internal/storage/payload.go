@@ -29,6 +29,8 @@ import (
 	"reflect"
 	"unsafe"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -45,6 +47,9 @@ type PayloadWriterInterface interface {
 	AddFloatToPayload(msgs []float32) error
 	AddDoubleToPayload(msgs []float64) error
 	AddOneStringToPayload(msgs string) error
+	AddOneJSONToPayload(msg []byte) error
+	AddOneArrayToPayload(msg *schemapb.ScalarField) error
+	AddValidDataToPayload(validData []bool) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
 	FinishPayloadWriter() error
@@ -66,6 +71,9 @@ type PayloadReaderInterface interface {
 	GetFloatFromPayload() ([]float32, error)
 	GetDoubleFromPayload() ([]float64, error)
 	GetStringFromPayload() ([]string, error)
+	GetJSONFromPayload() ([][]byte, error)
+	GetArrayFromPayload() ([]*schemapb.ScalarField, error)
+	GetValidDataFromPayload() ([]bool, error)
 	GetBinaryVectorFromPayload() ([]byte, int, error)
 	GetFloatVectorFromPayload() ([]float32, int, error)
 	GetPayloadLengthFromReader() (int, error)
@@ -149,6 +157,18 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddOneStringToPayload(val)
+		case typeutil.DataTypeJSON:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneJSONToPayload(val)
+		case typeutil.DataTypeArray:
+			val, ok := msgs.(*schemapb.ScalarField)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneArrayToPayload(val)
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -289,6 +309,53 @@ func (w *PayloadWriter) AddOneStringToPayload(msg string) error {
 	return HandleCStatus(&status, "AddOneStringToPayload failed")
 }
 
+// AddOneJSONToPayload adds one JSON document into payload, the document is stored as it is
+func (w *PayloadWriter) AddOneJSONToPayload(msg []byte) error {
+	length := len(msg)
+	if length <= 0 {
+		return errors.New("can't add empty json into payload")
+	}
+	cmsg := (*C.uint8_t)(C.CBytes(msg))
+	clength := C.int(length)
+	defer C.free(unsafe.Pointer(cmsg))
+
+	status := C.AddOneJSONToPayload(w.payloadWriterPtr, cmsg, clength)
+	return HandleCStatus(&status, "AddOneJSONToPayload failed")
+}
+
+// AddOneArrayToPayload adds one array into payload, the array is stored as a marshaled schemapb.ScalarField
+func (w *PayloadWriter) AddOneArrayToPayload(msg *schemapb.ScalarField) error {
+	bytes, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	// an empty array is marshaled to nothing, C.CBytes never returns nil
+	cmsg := (*C.uint8_t)(C.CBytes(bytes))
+	clength := C.int(len(bytes))
+	defer C.free(unsafe.Pointer(cmsg))
+
+	status := C.AddOneArrayToPayload(w.payloadWriterPtr, cmsg, clength)
+	return HandleCStatus(&status, "AddOneArrayToPayload failed")
+}
+
+// AddValidDataToPayload adds the validity of the rows of nullable field into payload, false marks the row null,
+// the rows added without validity are valid.
+func (w *PayloadWriter) AddValidDataToPayload(validData []bool) error {
+	length := len(validData)
+	if length <= 0 {
+		return errors.New("can't add empty valid data into payload")
+	}
+	if typeutil.IsVectorType(w.colType) {
+		return fmt.Errorf("valid data is not supported by %s payload", w.colType.String())
+	}
+
+	cValidData := (*C.bool)(unsafe.Pointer(&validData[0]))
+	cLength := C.int(length)
+
+	status := C.AddValidDataToPayload(w.payloadWriterPtr, cValidData, cLength)
+	return HandleCStatus(&status, "AddValidDataToPayload failed")
+}
+
 // AddBinaryVectorToPayload dimension > 0 && (%8 == 0)
 func (w *PayloadWriter) AddBinaryVectorToPayload(binVec []byte, dim int) error {
 	length := len(binVec)
@@ -8,8 +8,10 @@ import (
 	"github.com/apache/arrow/go/v8/arrow"
 	"github.com/apache/arrow/go/v8/parquet"
 	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/golang/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PayloadReader reads data from payload
@@ -66,6 +68,12 @@ func (r *PayloadReader) GetDataFromPayload() (interface{}, int, error) {
 	case schemapb.DataType_String, schemapb.DataType_VarChar:
 		val, err := r.GetStringFromPayload()
 		return val, 0, err
+	case typeutil.DataTypeJSON:
+		val, err := r.GetJSONFromPayload()
+		return val, 0, err
+	case typeutil.DataTypeArray:
+		val, err := r.GetArrayFromPayload()
+		return val, 0, err
 	default:
 		return nil, 0, errors.New("unknown type")
 	}
@@ -254,6 +262,88 @@ func (r *PayloadReader) GetStringFromPayload() ([]string, error) {
 	return ret, nil
 }
 
+// GetJSONFromPayload returns the JSON documents in payload
+func (r *PayloadReader) GetJSONFromPayload() ([][]byte, error) {
+	if r.colType != typeutil.DataTypeJSON {
+		return nil, fmt.Errorf("failed to get json from datatype %v", r.colType.String())
+	}
+	return r.readBinaryFromPayload()
+}
+
+// GetArrayFromPayload returns the arrays in payload
+func (r *PayloadReader) GetArrayFromPayload() ([]*schemapb.ScalarField, error) {
+	if r.colType != typeutil.DataTypeArray {
+		return nil, fmt.Errorf("failed to get array from datatype %v", r.colType.String())
+	}
+	values, err := r.readBinaryFromPayload()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*schemapb.ScalarField, len(values))
+	for i, value := range values {
+		ret[i] = &schemapb.ScalarField{}
+		if err := proto.Unmarshal(value, ret[i]); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// GetValidDataFromPayload returns the validity of the rows in payload, false marks the row null,
+// nil is returned if all the rows are valid.
+func (r *PayloadReader) GetValidDataFromPayload() ([]bool, error) {
+	if typeutil.IsVectorType(r.colType) || !hasNullRows(r.reader, 0) {
+		return nil, nil
+	}
+
+	validData := make([]bool, r.numRows)
+	var err error
+	switch r.colType {
+	case schemapb.DataType_Bool:
+		_, err = readDataFromAllRowGroups[bool, *file.BooleanColumnChunkReader](r.reader, make([]bool, r.numRows), validData, 0, r.numRows)
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		_, err = readDataFromAllRowGroups[int32, *file.Int32ColumnChunkReader](r.reader, make([]int32, r.numRows), validData, 0, r.numRows)
+	case schemapb.DataType_Int64:
+		_, err = readDataFromAllRowGroups[int64, *file.Int64ColumnChunkReader](r.reader, make([]int64, r.numRows), validData, 0, r.numRows)
+	case schemapb.DataType_Float:
+		_, err = readDataFromAllRowGroups[float32, *file.Float32ColumnChunkReader](r.reader, make([]float32, r.numRows), validData, 0, r.numRows)
+	case schemapb.DataType_Double:
+		_, err = readDataFromAllRowGroups[float64, *file.Float64ColumnChunkReader](r.reader, make([]float64, r.numRows), validData, 0, r.numRows)
+	case schemapb.DataType_String, schemapb.DataType_VarChar, typeutil.DataTypeJSON, typeutil.DataTypeArray:
+		_, err = readDataFromAllRowGroups[parquet.ByteArray, *file.ByteArrayColumnChunkReader](r.reader, make([]parquet.ByteArray, r.numRows), validData, 0, r.numRows)
+	default:
+		return nil, fmt.Errorf("failed to get valid data from datatype %v", r.colType.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, valid := range validData {
+		if !valid {
+			return validData, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *PayloadReader) readBinaryFromPayload() ([][]byte, error) {
+	values := make([]parquet.ByteArray, r.numRows)
+	valuesRead, err := ReadDataFromAllRowGroups[parquet.ByteArray, *file.ByteArrayColumnChunkReader](r.reader, values, 0, r.numRows)
+	if err != nil {
+		return nil, err
+	}
+
+	if valuesRead != r.numRows {
+		return nil, fmt.Errorf("expect %d rows, but got valuesRead = %d", r.numRows, valuesRead)
+	}
+
+	ret := make([][]byte, r.numRows)
+	for i := 0; i < int(r.numRows); i++ {
+		ret[i] = append([]byte{}, values[i]...)
+	}
+	return ret, nil
+}
+
 // GetBinaryVectorFromPayload returns vector, dimension, error
 func (r *PayloadReader) GetBinaryVectorFromPayload() ([]byte, int, error) {
 	if r.colType != schemapb.DataType_BinaryVector {
@@ -313,10 +403,19 @@ func (r *PayloadReader) Close() {
 
 // ReadDataFromAllRowGroups iterates all row groups of file.Reader, and convert column to E.
 // then calls ReadBatch with provided parameters.
+// The null rows of nullable field are read as zero values.
 func ReadDataFromAllRowGroups[T any, E interface {
 	ReadBatch(int64, []T, []int16, []int16) (int64, int, error)
 }](reader *file.Reader, values []T, columnIdx int, numRows int64) (int64, error) {
+	return readDataFromAllRowGroups[T, E](reader, values, nil, columnIdx, numRows)
+}
+
+// readDataFromAllRowGroups is ReadDataFromAllRowGroups which also reads the validity of rows into validData if it's not nil.
+func readDataFromAllRowGroups[T any, E interface {
+	ReadBatch(int64, []T, []int16, []int16) (int64, int, error)
+}](reader *file.Reader, values []T, validData []bool, columnIdx int, numRows int64) (int64, error) {
 	var offset int64
+	defLevels := make([]int16, numRows)
 
 	for i := 0; i < reader.NumRowGroups(); i++ {
 		if columnIdx >= reader.RowGroup(i).NumColumns() {
@@ -329,13 +428,53 @@ func ReadDataFromAllRowGroups[T any, E interface {
 			return -1, fmt.Errorf("expect type %T, but got %T", *new(E), column)
 		}
 
-		_, valuesRead, err := cReader.ReadBatch(numRows, values[offset:], nil, nil)
+		rowsRead, valuesRead, err := cReader.ReadBatch(numRows, values[offset:], defLevels[offset:], nil)
 		if err != nil {
 			return -1, err
 		}
 
-		offset += int64(valuesRead)
+		// the null rows have no value, but definition level 0
+		hasNull := int64(valuesRead) < rowsRead
+		if hasNull {
+			spreadValues(values[offset:offset+rowsRead], defLevels[offset:offset+rowsRead], valuesRead)
+		}
+		if validData != nil {
+			for j := offset; j < offset+rowsRead; j++ {
+				validData[j] = !hasNull || defLevels[j] > 0
+			}
+		}
+		offset += rowsRead
 	}
 
 	return offset, nil
 }
+
+// spreadValues moves the first valuesRead values to the rows whose definition level is not 0,
+// the null rows are set to zero value.
+func spreadValues[T any](values []T, defLevels []int16, valuesRead int) {
+	j := valuesRead - 1
+	for i := len(values) - 1; i >= 0; i-- {
+		if defLevels[i] > 0 {
+			values[i] = values[j]
+			j--
+		} else {
+			var zero T
+			values[i] = zero
+		}
+	}
+}
+
+// hasNullRows returns false if the statistics of the column show there is no null row.
+func hasNullRows(reader *file.Reader, columnIdx int) bool {
+	for i := 0; i < reader.NumRowGroups(); i++ {
+		chunk, err := reader.MetaData().RowGroup(i).ColumnChunk(columnIdx)
+		if err != nil {
+			return true
+		}
+		stats, err := chunk.Statistics()
+		if err != nil || stats == nil || !stats.HasNullCount() || stats.NullCount() > 0 {
+			return true
+		}
+	}
+	return false
+}
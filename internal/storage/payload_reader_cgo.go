@@ -12,9 +12,12 @@ import (
 	"fmt"
 	"unsafe"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PayloadReaderCgo reads data from payload
@@ -71,6 +74,12 @@ func (r *PayloadReaderCgo) GetDataFromPayload() (interface{}, int, error) {
 	case schemapb.DataType_String:
 		val, err := r.GetStringFromPayload()
 		return val, 0, err
+	case typeutil.DataTypeJSON:
+		val, err := r.GetJSONFromPayload()
+		return val, 0, err
+	case typeutil.DataTypeArray:
+		val, err := r.GetArrayFromPayload()
+		return val, 0, err
 	default:
 		return nil, 0, errors.New("unknown type")
 	}
@@ -253,6 +262,52 @@ func (r *PayloadReaderCgo) GetOneStringFromPayload(idx int) (string, error) {
 	return C.GoStringN(cStr, cSize), nil
 }
 
+// GetJSONFromPayload returns the JSON documents in payload
+func (r *PayloadReaderCgo) GetJSONFromPayload() ([][]byte, error) {
+	if r.colType != typeutil.DataTypeJSON {
+		return nil, errors.New("incorrect data type")
+	}
+	return r.readBinaryFromPayload()
+}
+
+// GetArrayFromPayload returns the arrays in payload
+func (r *PayloadReaderCgo) GetArrayFromPayload() ([]*schemapb.ScalarField, error) {
+	if r.colType != typeutil.DataTypeArray {
+		return nil, errors.New("incorrect data type")
+	}
+	values, err := r.readBinaryFromPayload()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*schemapb.ScalarField, len(values))
+	for i, value := range values {
+		ret[i] = &schemapb.ScalarField{}
+		if err := proto.Unmarshal(value, ret[i]); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func (r *PayloadReaderCgo) readBinaryFromPayload() ([][]byte, error) {
+	length, err := r.GetPayloadLengthFromReader()
+	if err != nil {
+		return nil, err
+	}
+	ret := make([][]byte, length)
+	for i := 0; i < length; i++ {
+		var cStr *C.char
+		var cSize C.int
+
+		status := C.GetOneStringFromPayload(r.payloadReaderPtr, C.int(i), &cStr, &cSize)
+		if err := HandleCStatus(&status, "GetOneStringFromPayload failed"); err != nil {
+			return nil, err
+		}
+		ret[i] = C.GoBytes(unsafe.Pointer(cStr), cSize)
+	}
+	return ret, nil
+}
+
 // GetBinaryVectorFromPayload returns vector, dimension, error
 func (r *PayloadReaderCgo) GetBinaryVectorFromPayload() ([]byte, int, error) {
 	if r.colType != schemapb.DataType_BinaryVector {
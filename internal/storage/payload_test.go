@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func TestPayload_ReaderAndWriter(t *testing.T) {
@@ -332,6 +333,90 @@ func TestPayload_ReaderAndWriter(t *testing.T) {
 		w.ReleasePayloadWriter()
 	})
 
+	t.Run("TestAddJSON", func(t *testing.T) {
+		w, err := NewPayloadWriter(typeutil.DataTypeJSON)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		err = w.AddOneJSONToPayload([]byte(`{"color":"red"}`))
+		assert.Nil(t, err)
+		err = w.AddDataToPayload([]byte(`{"price":10}`))
+		assert.Nil(t, err)
+		err = w.AddOneJSONToPayload(nil)
+		assert.NotNil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(typeutil.DataTypeJSON, buffer)
+		assert.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+
+		docs, err := r.GetJSONFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte(`{"color":"red"}`), []byte(`{"price":10}`)}, docs)
+
+		idocs, _, err := r.GetDataFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, docs, idocs.([][]byte))
+
+		_, err = r.GetStringFromPayload()
+		assert.NotNil(t, err)
+		r.ReleasePayloadReader()
+		w.ReleasePayloadWriter()
+	})
+
+	t.Run("TestAddArray", func(t *testing.T) {
+		w, err := NewPayloadWriter(typeutil.DataTypeArray)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		arrays := []*schemapb.ScalarField{
+			{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}}}},
+			{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{4}}}},
+		}
+		err = w.AddOneArrayToPayload(arrays[0])
+		assert.Nil(t, err)
+		err = w.AddDataToPayload(arrays[1])
+		assert.Nil(t, err)
+		err = w.AddDataToPayload([]int64{5})
+		assert.NotNil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(typeutil.DataTypeArray, buffer)
+		assert.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+
+		result, err := r.GetArrayFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(result))
+		assert.Equal(t, []int64{1, 2, 3}, result[0].GetLongData().GetData())
+		assert.Equal(t, []int64{4}, result[1].GetLongData().GetData())
+
+		iresult, _, err := r.GetDataFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(iresult.([]*schemapb.ScalarField)))
+
+		_, err = r.GetJSONFromPayload()
+		assert.NotNil(t, err)
+		r.ReleasePayloadReader()
+		w.ReleasePayloadWriter()
+	})
+
 	t.Run("TestBinaryVector", func(t *testing.T) {
 		w, err := NewPayloadWriter(schemapb.DataType_BinaryVector, 8)
 		require.Nil(t, err)
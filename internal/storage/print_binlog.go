@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PrintBinlogFiles call printBinlogFile in turn for the file list specified by parameter fileList.
@@ -290,6 +291,22 @@ func printPayloadValues(colType schemapb.DataType, reader PayloadReaderInterface
 		for i := 0; i < rows; i++ {
 			fmt.Printf("\t\t%d : %s\n", i, val[i])
 		}
+	case typeutil.DataTypeJSON:
+		val, err := reader.GetJSONFromPayload()
+		if err != nil {
+			return err
+		}
+		for i, v := range val {
+			fmt.Printf("\t\t%d : %s\n", i, v)
+		}
+	case typeutil.DataTypeArray:
+		val, err := reader.GetArrayFromPayload()
+		if err != nil {
+			return err
+		}
+		for i, v := range val {
+			fmt.Printf("\t\t%d : %s\n", i, v)
+		}
 	case schemapb.DataType_BinaryVector:
 		val, dim, err := reader.GetBinaryVectorFromPayload()
 		if err != nil {
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -395,8 +396,14 @@ func RowBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *schemap
 }
 
 func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *schemapb.CollectionSchema) (idata *InsertData, err error) {
+	// the msg may miss the fields added after it was produced
+	validData := typeutil.FillMissingValidData(collSchema, msg.FieldsData, msg.GetValidData(), int(msg.NRows()))
+	fieldsData, err := typeutil.FillMissingFieldData(collSchema, msg.FieldsData, int(msg.NRows()))
+	if err != nil {
+		return nil, err
+	}
 	srcFields := make(map[FieldID]*schemapb.FieldData)
-	for _, field := range msg.FieldsData {
+	for _, field := range fieldsData {
 		srcFields[field.FieldId] = field
 	}
 
@@ -550,12 +557,89 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 
 			fieldData.Data = append(fieldData.Data, srcData...)
 			idata.Data[field.FieldID] = fieldData
+		case typeutil.DataTypeJSON:
+			srcData := srcFields[field.FieldID].GetScalars().GetBytesData().GetData()
+
+			fieldData := &JSONFieldData{
+				NumRows: []int64{int64(msg.NumRows)},
+				Data:    make([][]byte, 0, len(srcData)),
+			}
+
+			fieldData.Data = append(fieldData.Data, srcData...)
+			idata.Data[field.FieldID] = fieldData
+		case typeutil.DataTypeArray:
+			srcData := srcFields[field.FieldID].GetScalars().GetBytesData().GetData()
+
+			elementType, err := typeutil.GetArrayElementType(field)
+			if err != nil {
+				return nil, err
+			}
+			fieldData := &ArrayFieldData{
+				NumRows:     []int64{int64(msg.NumRows)},
+				ElementType: elementType,
+				Data:        make([]*schemapb.ScalarField, 0, len(srcData)),
+			}
+
+			for _, bytes := range srcData {
+				array := &schemapb.ScalarField{}
+				if err := proto.Unmarshal(bytes, array); err != nil {
+					return nil, err
+				}
+				fieldData.Data = append(fieldData.Data, array)
+			}
+			idata.Data[field.FieldID] = fieldData
+		}
+	}
+
+	for _, data := range validData {
+		if nullableData, ok := idata.Data[data.GetFieldId()].(NullableFieldData); ok {
+			nullableData.SetValidData(data.GetValid())
 		}
 	}
 
 	return idata, nil
 }
 
+// GenDefaultFieldData generates numRows rows of the default value of a nullable or defaulted field,
+// the rows are null if the field is nullable without default value.
+func GenDefaultFieldData(field *schemapb.FieldSchema, numRows int) (FieldData, error) {
+	fieldData, err := typeutil.GenDefaultFieldData(field, numRows)
+	if err != nil {
+		return nil, err
+	}
+	msg := &msgstream.InsertMsg{
+		InsertRequest: internalpb.InsertRequest{
+			NumRows:    uint64(numRows),
+			FieldsData: []*schemapb.FieldData{fieldData},
+			Version:    internalpb.InsertDataVersion_ColumnBased,
+		},
+	}
+	data, err := ColumnBasedInsertMsgToInsertData(msg, &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}})
+	if err != nil {
+		return nil, err
+	}
+	if nullableData, ok := data.Data[field.GetFieldID()].(NullableFieldData); ok && typeutil.IsNullByDefault(field) {
+		nullableData.SetValidData(make([]bool, numRows))
+	}
+	return data.Data[field.GetFieldID()], nil
+}
+
+// FillMissingFields fills the default values of the nullable or defaulted fields absent in the insert data,
+// which happens to the data buffered before the fields were added to the collection.
+func FillMissingFields(schema *schemapb.CollectionSchema, data *InsertData, numRows int) error {
+	for _, field := range schema.GetFields() {
+		if _, ok := data.Data[field.GetFieldID()]; ok || !typeutil.HasDefaultValue(field) {
+			continue
+		}
+		fieldData, err := GenDefaultFieldData(field, numRows)
+		if err != nil {
+			return err
+		}
+		data.Data[field.GetFieldID()] = fieldData
+	}
+	return nil
+}
+
 func InsertMsgToInsertData(msg *msgstream.InsertMsg, schema *schemapb.CollectionSchema) (idata *InsertData, err error) {
 	if msg.IsRowBased() {
 		return RowBasedInsertMsgToInsertData(msg, schema)
@@ -667,6 +751,33 @@ func mergeStringField(data *InsertData, fid FieldID, field *StringFieldData) {
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
 
+func mergeJSONField(data *InsertData, fid FieldID, field *JSONFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &JSONFieldData{
+			NumRows: []int64{0},
+			Data:    nil,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*JSONFieldData)
+	fieldData.Data = append(fieldData.Data, field.Data...)
+	fieldData.NumRows[0] += int64(field.RowNum())
+}
+
+func mergeArrayField(data *InsertData, fid FieldID, field *ArrayFieldData) {
+	if _, ok := data.Data[fid]; !ok {
+		fieldData := &ArrayFieldData{
+			NumRows:     []int64{0},
+			ElementType: field.ElementType,
+			Data:        nil,
+		}
+		data.Data[fid] = fieldData
+	}
+	fieldData := data.Data[fid].(*ArrayFieldData)
+	fieldData.Data = append(fieldData.Data, field.Data...)
+	fieldData.NumRows[0] += int64(field.RowNum())
+}
+
 func mergeBinaryVectorField(data *InsertData, fid FieldID, field *BinaryVectorFieldData) {
 	if _, ok := data.Data[fid]; !ok {
 		fieldData := &BinaryVectorFieldData{
@@ -695,11 +806,41 @@ func mergeFloatVectorField(data *InsertData, fid FieldID, field *FloatVectorFiel
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
 
+// newValidData returns the validity of numRows valid rows.
+func newValidData(numRows int) []bool {
+	validData := make([]bool, numRows)
+	for i := range validData {
+		validData[i] = true
+	}
+	return validData
+}
+
+// appendValidData appends the validity of the rows appended to the field data which had rowsBefore rows,
+// validData is nil if all the appended rows are valid.
+func appendValidData(data FieldData, rowsBefore int, validData []bool) {
+	nullableData, ok := data.(NullableFieldData)
+	if !ok || (nullableData.GetValidData() == nil && validData == nil) {
+		return
+	}
+	dst := nullableData.GetValidData()
+	if dst == nil {
+		dst = newValidData(rowsBefore)
+	}
+	if validData == nil {
+		validData = newValidData(data.RowNum() - rowsBefore)
+	}
+	nullableData.SetValidData(append(dst, validData...))
+}
+
 // MergeFieldData merge field into data.
 func MergeFieldData(data *InsertData, fid FieldID, field FieldData) {
 	if field == nil {
 		return
 	}
+	rowsBefore := 0
+	if fieldData, ok := data.Data[fid]; ok {
+		rowsBefore = fieldData.RowNum()
+	}
 	switch field := field.(type) {
 	case *BoolFieldData:
 		mergeBoolField(data, fid, field)
@@ -717,11 +858,18 @@ func MergeFieldData(data *InsertData, fid FieldID, field FieldData) {
 		mergeDoubleField(data, fid, field)
 	case *StringFieldData:
 		mergeStringField(data, fid, field)
+	case *JSONFieldData:
+		mergeJSONField(data, fid, field)
+	case *ArrayFieldData:
+		mergeArrayField(data, fid, field)
 	case *BinaryVectorFieldData:
 		mergeBinaryVectorField(data, fid, field)
 	case *FloatVectorFieldData:
 		mergeFloatVectorField(data, fid, field)
 	}
+	if nullableField, ok := field.(NullableFieldData); ok {
+		appendValidData(data.Data[fid], rowsBefore, nullableField.GetValidData())
+	}
 }
 
 // MergeInsertData merge insert datas. Maybe there are large write zoom if frequent inserts are met.
@@ -808,6 +956,33 @@ func stringFieldDataToPbBytes(field *StringFieldData) ([]byte, error) {
 	return proto.Marshal(arr)
 }
 
+func jsonFieldDataToPbBytes(field *JSONFieldData) ([]byte, error) {
+	arr := &schemapb.BytesArray{Data: field.Data}
+	return proto.Marshal(arr)
+}
+
+// arrayToBytes marshals every array to bytes, it's how the arrays are transferred in schemapb.BytesArray.
+func arrayToBytes(arrays []*schemapb.ScalarField) ([][]byte, error) {
+	ret := make([][]byte, 0, len(arrays))
+	for _, array := range arrays {
+		bytes, err := proto.Marshal(array)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, bytes)
+	}
+	return ret, nil
+}
+
+func arrayFieldDataToPbBytes(field *ArrayFieldData) ([]byte, error) {
+	data, err := arrayToBytes(field.Data)
+	if err != nil {
+		return nil, err
+	}
+	arr := &schemapb.BytesArray{Data: data}
+	return proto.Marshal(arr)
+}
+
 func binaryWrite(endian binary.ByteOrder, data interface{}) ([]byte, error) {
 	buf := new(bytes.Buffer)
 	err := binary.Write(buf, endian, data)
@@ -822,6 +997,8 @@ func binaryWrite(endian binary.ByteOrder, data interface{}) ([]byte, error) {
 // For binary vector, return it directly.
 // For bool data, first transfer to schemapb.BoolArray and then marshal it. (TODO: handle bool like other scalar data.)
 // For variable-length data, such as string, first transfer to schemapb.StringArray and then marshal it.
+// For json data, first transfer to schemapb.BytesArray and then marshal it.
+// For array data, marshal every array, transfer them to schemapb.BytesArray and then marshal it.
 // TODO: find a proper way to store variable-length data. Or we should unify to use protobuf?
 func FieldDataToBytes(endian binary.ByteOrder, fieldData FieldData) ([]byte, error) {
 	switch field := fieldData.(type) {
@@ -830,6 +1007,10 @@ func FieldDataToBytes(endian binary.ByteOrder, fieldData FieldData) ([]byte, err
 		return boolFieldDataToPbBytes(field)
 	case *StringFieldData:
 		return stringFieldDataToPbBytes(field)
+	case *JSONFieldData:
+		return jsonFieldDataToPbBytes(field)
+	case *ArrayFieldData:
+		return arrayFieldDataToPbBytes(field)
 	case *BinaryVectorFieldData:
 		return field.Data, nil
 	case *FloatVectorFieldData:
@@ -976,6 +1157,38 @@ func TransferInsertDataToInsertRecord(insertData *InsertData) (*segcorepb.Insert
 					},
 				},
 			}
+		case *JSONFieldData:
+			fieldData = &schemapb.FieldData{
+				Type:    typeutil.DataTypeJSON,
+				FieldId: fieldID,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_BytesData{
+							BytesData: &schemapb.BytesArray{
+								Data: rawData.Data,
+							},
+						},
+					},
+				},
+			}
+		case *ArrayFieldData:
+			data, err := arrayToBytes(rawData.Data)
+			if err != nil {
+				return insertRecord, err
+			}
+			fieldData = &schemapb.FieldData{
+				Type:    typeutil.DataTypeArray,
+				FieldId: fieldID,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_BytesData{
+							BytesData: &schemapb.BytesArray{
+								Data: data,
+							},
+						},
+					},
+				},
+			}
 		case *FloatVectorFieldData:
 			fieldData = &schemapb.FieldData{
 				Type:    schemapb.DataType_FloatVector,
@@ -1010,6 +1223,13 @@ func TransferInsertDataToInsertRecord(insertData *InsertData) (*segcorepb.Insert
 
 		insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
 		insertRecord.NumRows = int64(rawData.RowNum())
+
+		if nullableData, ok := rawData.(NullableFieldData); ok && nullableData.GetValidData() != nil {
+			insertRecord.ValidData = append(insertRecord.ValidData, &segcorepb.FieldValidData{
+				FieldId: fieldID,
+				Valid:   nullableData.GetValidData(),
+			})
+		}
 	}
 
 	return insertRecord, nil
@@ -1029,7 +1249,32 @@ func TransferInsertMsgToInsertRecord(schema *schemapb.CollectionSchema, msg *msg
 		NumRows: int64(msg.NumRows),
 	}
 
-	insertRecord.FieldsData = append(insertRecord.FieldsData, msg.FieldsData...)
+	// the fields added after the schema was loaded are unknown to segcore, they are visible after reloading the collection
+	fieldIDs := make(map[int64]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fieldIDs[field.GetFieldID()] = struct{}{}
+	}
+	for _, fieldData := range msg.FieldsData {
+		if _, ok := fieldIDs[fieldData.GetFieldId()]; ok {
+			insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
+		}
+	}
+
+	// the messages produced before the fields were added have no data of them
+	validData := typeutil.FillMissingValidData(schema, insertRecord.FieldsData, msg.ValidData, int(msg.NumRows))
+	for _, data := range validData {
+		if _, ok := fieldIDs[data.GetFieldId()]; ok {
+			insertRecord.ValidData = append(insertRecord.ValidData, &segcorepb.FieldValidData{
+				FieldId: data.GetFieldId(),
+				Valid:   data.GetValid(),
+			})
+		}
+	}
+	fieldsData, err := typeutil.FillMissingFieldData(schema, insertRecord.FieldsData, int(msg.NumRows))
+	if err != nil {
+		return nil, err
+	}
+	insertRecord.FieldsData = fieldsData
 
 	return insertRecord, nil
 }
@@ -112,13 +112,13 @@ func (vcm *VectorChunkManager) initCache(ctx context.Context) error {
 // For vector data, we will download vector file from storage. And we will
 // deserialize the file for it has binlog style. At last we store pure vector
 // data to local storage as cache.
-func (vcm *VectorChunkManager) deserializeVectorFile(filePath string, content []byte) ([]byte, error) {
+func (vcm *VectorChunkManager) deserializeVectorFile(filePath string, content []byte, fieldIDs ...FieldID) ([]byte, error) {
 	blob := &Blob{
 		Key:   filePath,
 		Value: content,
 	}
 
-	_, _, data, err := vcm.insertCodec.Deserialize([]*Blob{blob})
+	_, _, _, data, err := vcm.insertCodec.DeserializeFields([]*Blob{blob}, fieldIDs...)
 	if err != nil {
 		return nil, err
 	}
@@ -164,12 +164,22 @@ func (vcm *VectorChunkManager) Exist(ctx context.Context, filePath string) (bool
 	return vcm.vectorStorage.Exist(ctx, filePath)
 }
 
-func (vcm *VectorChunkManager) readFile(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
-	contents, err := vcm.vectorStorage.Read(ctx, filePath)
+// readVectorFile reads the pure vector data from the vector storage,
+// only the column of the field is read if the path is generated by ColumnarFieldPath.
+func (vcm *VectorChunkManager) readVectorFile(ctx context.Context, filePath string) ([]byte, error) {
+	logPath, fieldID, isColumn := parseColumnarFieldPath(filePath)
+	contents, err := vcm.vectorStorage.Read(ctx, logPath)
 	if err != nil {
 		return nil, err
 	}
-	results, err := vcm.deserializeVectorFile(filePath, contents)
+	if isColumn {
+		return vcm.deserializeVectorFile(logPath, contents, fieldID)
+	}
+	return vcm.deserializeVectorFile(logPath, contents)
+}
+
+func (vcm *VectorChunkManager) readFile(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	results, err := vcm.readVectorFile(ctx, filePath)
 	if err != nil {
 		return nil, err
 	}
@@ -203,11 +213,7 @@ func (vcm *VectorChunkManager) Read(ctx context.Context, filePath string) ([]byt
 		}
 		return p, nil
 	}
-	contents, err := vcm.vectorStorage.Read(ctx, filePath)
-	if err != nil {
-		return nil, err
-	}
-	return vcm.deserializeVectorFile(filePath, contents)
+	return vcm.readVectorFile(ctx, filePath)
 }
 
 // MultiRead reads the pure vector data. If cached, it reads from local.
@@ -268,11 +274,7 @@ func (vcm *VectorChunkManager) ReadAt(ctx context.Context, filePath string, off
 		}
 		return p, nil
 	}
-	contents, err := vcm.vectorStorage.Read(ctx, filePath)
-	if err != nil {
-		return nil, err
-	}
-	results, err := vcm.deserializeVectorFile(filePath, contents)
+	results, err := vcm.readVectorFile(ctx, filePath)
 	if err != nil {
 		return nil, err
 	}
@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -463,3 +464,43 @@ func TestVectorChunkManager_Read(t *testing.T) {
 		vcm.Close()
 	}
 }
+
+func TestVectorChunkManager_ReadColumnar(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	meta := initMeta()
+	blob, _, err := NewInsertCodec(meta).SerializeV2(1, 1, &InsertData{
+		Data: map[int64]FieldData{
+			0:   &Int64FieldData{NumRows: []int64{2}, Data: []int64{3, 4}},
+			1:   &Int64FieldData{NumRows: []int64{2}, Data: []int64{3, 4}},
+			101: &Int8FieldData{NumRows: []int64{2}, Data: []int8{3, 4}},
+			108: &BinaryVectorFieldData{NumRows: []int64{2}, Data: []byte{0, 255}, Dim: 8},
+			109: &FloatVectorFieldData{NumRows: []int64{2}, Data: []float32{0, 1, 2, 3, 4, 5, 6, 7, 0, 111, 222, 333, 444, 555, 777, 666}, Dim: 8},
+		},
+	})
+	require.NoError(t, err)
+	logPath := "1/1/1/columnar/100"
+	require.True(t, IsColumnarLogPath(logPath))
+
+	for _, localCache := range []bool{true, false} {
+		vcm, cancel, err := buildVectorChunkManager(localPath, localCache)
+		require.NoError(t, err)
+
+		err = vcm.vectorStorage.Write(ctx, logPath, blob.Value)
+		assert.NoError(t, err)
+
+		content, err := vcm.Read(ctx, ColumnarFieldPath(logPath, 108))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0, 255}, content)
+
+		content, err = vcm.ReadAt(ctx, ColumnarFieldPath(logPath, 109), 32, 4)
+		assert.NoError(t, err)
+		assert.Equal(t, float32(0), typeutil.BytesToFloat32(content))
+
+		err = vcm.RemoveWithPrefix(ctx, "1/1/1")
+		assert.NoError(t, err)
+		cancel()
+		vcm.Close()
+	}
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/cdc"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -329,6 +330,10 @@ type DataCoord interface {
 	// MarkSegmentsDropped marks the given segments as `dropped` state.
 	MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)
 
+	// CloneSegments clones the flushed segments of the source collection to the target collection,
+	// the cloned segments share the binlogs with the source segments.
+	CloneSegments(ctx context.Context, req *datapb.CloneSegmentsRequest) (*commonpb.Status, error)
+
 	BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error)
 
 	CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
@@ -516,6 +521,65 @@ type RootCoord interface {
 	// error is always nil
 	ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
 
+	// CreateDatabase notifies RootCoord to create a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateDatabase(ctx context.Context, req *rootcoordpb.CreateDatabaseRequest) (*commonpb.Status, error)
+
+	// DropDatabase notifies RootCoord to drop a database, the database should have no collections
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropDatabase(ctx context.Context, req *rootcoordpb.DropDatabaseRequest) (*commonpb.Status, error)
+
+	// ListDatabases notifies RootCoord to list all database names and their created timestamps
+	//
+	// ctx is the context to control request deadline and cancellation
+	//
+	// The `Status` in response struct `ListDatabasesResponse` indicates if this operation is processed successfully or fail cause;
+	// other fields in `ListDatabasesResponse` are filled with all database names and created timestamps.
+	// error is always nil
+	ListDatabases(ctx context.Context, req *rootcoordpb.ListDatabasesRequest) (*rootcoordpb.ListDatabasesResponse, error)
+
+	// RenameCollection notifies RootCoord to rename a collection, the collection id, aliases and privileges are kept
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, old and new collection name
+	//
+	// The `ErrorCode` of `Status` is `Success` if rename collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RenameCollection(ctx context.Context, req *rootcoordpb.RenameCollectionRequest) (*commonpb.Status, error)
+
+	// TruncateCollection notifies RootCoord to drop all the data of a collection, the schema, indexes and partitions are kept
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name and collection name
+	//
+	// The `ErrorCode` of `Status` is `Success` if truncate collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	TruncateCollection(ctx context.Context, req *rootcoordpb.TruncateCollectionRequest) (*commonpb.Status, error)
+
+	// CloneCollection notifies RootCoord to create a collection sharing the flushed data of the source collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, source and target collection name and the timestamp
+	//
+	// The `ErrorCode` of `Status` is `Success` if clone collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CloneCollection(ctx context.Context, req *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error)
+
 	// AlterCollection notifies Proxy to create a collection
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -756,6 +820,75 @@ type RootCoord interface {
 	// error is always nil
 	ReportImport(ctx context.Context, req *rootcoordpb.ImportResult) (*commonpb.Status, error)
 
+	// Export writes the rows of a collection at a timestamp into JSON, NumPy or Parquet files on MinIO/S3 storage
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name, file format and target path
+	//
+	// The `Status` in response struct `ExportResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task_id` in `ExportResponse` return the id of the export task.
+	// error is always nil
+	Export(ctx context.Context, req *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error)
+
+	// GetExportState checks the state of an export task
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including a task id
+	//
+	// error is always nil
+	GetExportState(ctx context.Context, req *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error)
+
+	// ListExportTasks lists the export tasks of a collection, or of all the collections if the collection name is empty
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the collection name and the limit of tasks
+	//
+	// error is always nil
+	ListExportTasks(ctx context.Context, req *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error)
+
+	// CreateBackup backs up a collection at a timestamp, the data files are copied when it returns
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the backup name, collection name and timestamp
+	//
+	// The `Status` in response struct `CreateBackupResponse` indicates if this operation is processed successfully or fail cause;
+	// the `info` in `CreateBackupResponse` return the info of the backup.
+	// error is always nil
+	CreateBackup(ctx context.Context, req *rootcoordpb.CreateBackupRequest) (*rootcoordpb.CreateBackupResponse, error)
+
+	// ListBackups lists all the backups
+	//
+	// ctx is the context to control request deadline and cancellation
+	//
+	// error is always nil
+	ListBackups(ctx context.Context, req *rootcoordpb.ListBackupsRequest) (*rootcoordpb.ListBackupsResponse, error)
+
+	// VerifyBackup checks that all the files of a backup exist and are not corrupted
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the backup name
+	//
+	// error is always nil
+	VerifyBackup(ctx context.Context, req *rootcoordpb.VerifyBackupRequest) (*commonpb.Status, error)
+
+	// DeleteBackup removes a backup and all of its files
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the backup name
+	//
+	// error is always nil
+	DeleteBackup(ctx context.Context, req *rootcoordpb.DeleteBackupRequest) (*commonpb.Status, error)
+
+	// RestoreBackup restores a backup into a new collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the backup name and the new collection name
+	//
+	// The `Status` in response struct `RestoreBackupResponse` indicates if this operation is processed successfully or fail cause;
+	// the `tasks` in `RestoreBackupResponse` return the ids of the import tasks of the restore.
+	// error is always nil
+	RestoreBackup(ctx context.Context, req *rootcoordpb.RestoreBackupRequest) (*rootcoordpb.RestoreBackupResponse, error)
+
 	// CreateCredential create new user and password
 	CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error)
 	// UpdateCredential update password for a user
@@ -766,6 +899,14 @@ type RootCoord interface {
 	ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error)
 	// GetCredential get credential by username
 	GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error)
+	// CreateApiKey create a new api key for a user, the plaintext key is only returned in the response
+	CreateApiKey(ctx context.Context, req *rootcoordpb.CreateApiKeyRequest) (*rootcoordpb.CreateApiKeyResponse, error)
+	// ListApiKeys list the api keys of a user, or all api keys if the username is empty
+	ListApiKeys(ctx context.Context, req *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error)
+	// RevokeApiKey delete an api key
+	RevokeApiKey(ctx context.Context, req *rootcoordpb.RevokeApiKeyRequest) (*commonpb.Status, error)
+	// GetApiKey get an api key with its hashed secret by the key id
+	GetApiKey(ctx context.Context, req *rootcoordpb.GetApiKeyRequest) (*rootcoordpb.GetApiKeyResponse, error)
 
 	CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
 	DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error)
@@ -971,6 +1112,167 @@ type ProxyComponent interface {
 	// error is always nil
 	ShowCollections(ctx context.Context, request *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error)
 
+	// CreateDatabase notifies Proxy to create a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateDatabase(ctx context.Context, request *rootcoordpb.CreateDatabaseRequest) (*commonpb.Status, error)
+
+	// DropDatabase notifies Proxy to drop a database, the database should have no collections
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropDatabase(ctx context.Context, request *rootcoordpb.DropDatabaseRequest) (*commonpb.Status, error)
+
+	// ListDatabases notifies Proxy to list all databases
+	//
+	// ctx is the context to control request deadline and cancellation
+	//
+	// The `Status` in response struct `ListDatabasesResponse` indicates if this operation is processed successfully or fail cause;
+	// the `DbNames` in `ListDatabasesResponse` return database names list.
+	// error is always nil
+	ListDatabases(ctx context.Context, request *rootcoordpb.ListDatabasesRequest) (*rootcoordpb.ListDatabasesResponse, error)
+
+	// RenameCollection notifies Proxy to rename a collection, the collection id, aliases and privileges are kept
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, old and new collection name
+	//
+	// The `ErrorCode` of `Status` is `Success` if rename collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RenameCollection(ctx context.Context, request *rootcoordpb.RenameCollectionRequest) (*commonpb.Status, error)
+
+	// TruncateCollection notifies Proxy to drop all the data of a collection, the schema, indexes and partitions are kept
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name and collection name
+	//
+	// The `ErrorCode` of `Status` is `Success` if truncate collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	TruncateCollection(ctx context.Context, request *rootcoordpb.TruncateCollectionRequest) (*commonpb.Status, error)
+
+	// CloneCollection notifies Proxy to create a collection sharing the flushed data of the source collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, source and target collection name and the timestamp
+	//
+	// The `ErrorCode` of `Status` is `Success` if clone collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CloneCollection(ctx context.Context, request *rootcoordpb.CloneCollectionRequest) (*commonpb.Status, error)
+
+	// SubscribeChanges streams the inserts, deletes and DDLs of a collection read from its DML channels
+	//
+	// ctx is the context to control the subscription, the subscription stops when ctx is done
+	// req contains the request params, including database name, collection name, and the start timestamp or checkpoint
+	// send is called with every batch of the changes, the subscription stops if send returns an error
+	//
+	// nil is returned after the collection is dropped, otherwise the error stops the subscription is returned.
+	SubscribeChanges(ctx context.Context, request *cdc.SubscribeRequest, send func(*cdc.Batch) error) error
+
+	// CreateApiKey notifies Proxy to create an api key for a user
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the username and the description of the key
+	//
+	// The keys of a user are only managed by the authenticated user itself or an admin.
+	// The plaintext key is returned in the response only once, it can't be retrieved later.
+	// error is always nil
+	CreateApiKey(ctx context.Context, request *rootcoordpb.CreateApiKeyRequest) (*rootcoordpb.CreateApiKeyResponse, error)
+
+	// ListApiKeys notifies Proxy to list the api keys of a user, the secrets of the keys are not returned
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the username, all keys are listed to an admin if it's empty
+	//
+	// error is always nil
+	ListApiKeys(ctx context.Context, request *rootcoordpb.ListApiKeysRequest) (*rootcoordpb.ListApiKeysResponse, error)
+
+	// RevokeApiKey notifies Proxy to revoke an api key
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the key id
+	//
+	// The `ErrorCode` of `Status` is `Success` if revoke the key successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RevokeApiKey(ctx context.Context, request *rootcoordpb.RevokeApiKeyRequest) (*commonpb.Status, error)
+
+	// Export notifies Proxy to export the rows of a collection into files
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name, file format and target path
+	//
+	// The `Status` in response struct `ExportResponse` indicates if this operation is processed successfully or fail cause;
+	// the `task_id` in `ExportResponse` return the id of the export task.
+	// error is always nil
+	Export(ctx context.Context, request *rootcoordpb.ExportRequest) (*rootcoordpb.ExportResponse, error)
+
+	// GetExportState notifies Proxy to check the state of an export task
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including a task id
+	//
+	// error is always nil
+	GetExportState(ctx context.Context, request *rootcoordpb.GetExportStateRequest) (*rootcoordpb.GetExportStateResponse, error)
+
+	// ListExportTasks notifies Proxy to list the export tasks
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the collection name and the limit of tasks
+	//
+	// error is always nil
+	ListExportTasks(ctx context.Context, request *rootcoordpb.ListExportTasksRequest) (*rootcoordpb.ListExportTasksResponse, error)
+
+	// CreateBackup notifies Proxy to back up a collection at a timestamp
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the backup name, collection name and timestamp
+	//
+	// error is always nil
+	CreateBackup(ctx context.Context, request *rootcoordpb.CreateBackupRequest) (*rootcoordpb.CreateBackupResponse, error)
+
+	// ListBackups notifies Proxy to list all the backups
+	//
+	// ctx is the context to control request deadline and cancellation
+	//
+	// error is always nil
+	ListBackups(ctx context.Context, request *rootcoordpb.ListBackupsRequest) (*rootcoordpb.ListBackupsResponse, error)
+
+	// VerifyBackup notifies Proxy to check the files of a backup
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the backup name
+	//
+	// error is always nil
+	VerifyBackup(ctx context.Context, request *rootcoordpb.VerifyBackupRequest) (*commonpb.Status, error)
+
+	// DeleteBackup notifies Proxy to remove a backup
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the backup name
+	//
+	// error is always nil
+	DeleteBackup(ctx context.Context, request *rootcoordpb.DeleteBackupRequest) (*commonpb.Status, error)
+
+	// RestoreBackup notifies Proxy to restore a backup into a new collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the backup name and the new collection name
+	//
+	// error is always nil
+	RestoreBackup(ctx context.Context, request *rootcoordpb.RestoreBackupRequest) (*rootcoordpb.RestoreBackupResponse, error)
+
 	// AlterCollection notifies Proxy to create a collection
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1136,6 +1438,18 @@ type ProxyComponent interface {
 	// error is always nil
 	Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error)
 
+	// Upsert notifies Proxy to replace rows by primary key
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition name(optional), fields data
+	//
+	// The `Status` in response struct `MutationResult` indicates if this operation is processed successfully or fail cause;
+	// the `IDs` in `MutationResult` return the id list of upserted rows.
+	// the `UpsertCnt` in `MutationResult` return the number of upserted rows.
+	// the `ErrIndex` in `MutationResult` return the failed number of upsert rows.
+	// error is always nil
+	Upsert(ctx context.Context, request *milvuspb.InsertRequest) (*milvuspb.MutationResult, error)
+
 	// Search notifies Proxy to do search
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1,6 +1,7 @@
 package compressor
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/klauspost/compress/zstd"
@@ -9,7 +10,9 @@ import (
 type CompressType string
 
 const (
-	CompressTypeZstd CompressType = "zstd"
+	CompressTypeZstd   CompressType = "zstd"
+	CompressTypeLZ4    CompressType = "lz4"
+	CompressTypeSnappy CompressType = "snappy"
 
 	DefaultCompressAlgorithm CompressType = CompressTypeZstd
 )
@@ -36,6 +39,40 @@ var (
 	_ Decompressor = (*ZstdDecompressor)(nil)
 )
 
+// NewCompressor creates the compressor of the compress type with the compression level,
+// the level is ignored by snappy, 0 means the default level of the algorithm.
+// For compressing small blocks, pass nil to the `out` parameter
+func NewCompressor(typ CompressType, level int, out io.Writer) (Compressor, error) {
+	switch typ {
+	case CompressTypeZstd:
+		if level == 0 {
+			return NewZstdCompressor(out)
+		}
+		return NewZstdCompressor(out, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	case CompressTypeLZ4:
+		return NewLZ4Compressor(out, level), nil
+	case CompressTypeSnappy:
+		return NewSnappyCompressor(out), nil
+	default:
+		return nil, fmt.Errorf("unsupported compress type: %s", typ)
+	}
+}
+
+// NewDecompressor creates the decompressor of the compress type.
+// For decompressing small blocks, pass nil to the `in` parameter
+func NewDecompressor(typ CompressType, in io.Reader) (Decompressor, error) {
+	switch typ {
+	case CompressTypeZstd:
+		return NewZstdDecompressor(in)
+	case CompressTypeLZ4:
+		return NewLZ4Decompressor(in), nil
+	case CompressTypeSnappy:
+		return NewSnappyDecompressor(in), nil
+	default:
+		return nil, fmt.Errorf("unsupported compress type: %s", typ)
+	}
+}
+
 type ZstdCompressor struct {
 	encoder *zstd.Encoder
 }
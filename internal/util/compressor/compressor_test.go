@@ -38,6 +38,82 @@ func TestZstdCompress(t *testing.T) {
 	assert.Equal(t, dec.GetType(), CompressTypeZstd)
 }
 
+func TestLZ4Compress(t *testing.T) {
+	for _, level := range []int{0, 9} {
+		data := fmt.Sprintf("hello lz4 algorithm with level %d!", level)
+		compressed := new(bytes.Buffer)
+		origin := new(bytes.Buffer)
+
+		enc := NewLZ4Compressor(compressed, level)
+		testCompress(t, data, enc, compressed, origin)
+
+		// Reuse test
+		compressed.Reset()
+		origin.Reset()
+
+		enc.ResetWriter(compressed)
+
+		testCompress(t, data+": reuse", enc, compressed, origin)
+
+		// Test type
+		dec := NewLZ4Decompressor(nil)
+		assert.Equal(t, enc.GetType(), CompressTypeLZ4)
+		assert.Equal(t, dec.GetType(), CompressTypeLZ4)
+	}
+}
+
+func TestSnappyCompress(t *testing.T) {
+	data := "hello snappy algorithm!"
+	compressed := new(bytes.Buffer)
+	origin := new(bytes.Buffer)
+
+	enc := NewSnappyCompressor(compressed)
+	testCompress(t, data, enc, compressed, origin)
+
+	// Reuse test
+	compressed.Reset()
+	origin.Reset()
+
+	enc.ResetWriter(compressed)
+
+	testCompress(t, data+": reuse", enc, compressed, origin)
+
+	// Test type
+	dec := NewSnappyDecompressor(nil)
+	assert.Equal(t, enc.GetType(), CompressTypeSnappy)
+	assert.Equal(t, dec.GetType(), CompressTypeSnappy)
+}
+
+func TestNewCompressor(t *testing.T) {
+	data := []byte(strings.Repeat("hello compressor! ", 10000))
+	for _, typ := range []CompressType{CompressTypeZstd, CompressTypeLZ4, CompressTypeSnappy} {
+		for _, level := range []int{0, 3} {
+			enc, err := NewCompressor(typ, level, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, typ, enc.GetType())
+			compressed := enc.CompressBytes(data, []byte("prefix"))
+			assert.Equal(t, []byte("prefix"), compressed[:6])
+			assert.Less(t, len(compressed), len(data))
+
+			dec, err := NewDecompressor(typ, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, typ, dec.GetType())
+			origin, err := dec.DecompressBytes(compressed[6:], nil)
+			assert.NoError(t, err)
+			assert.Equal(t, data, origin)
+
+			// Corrupted data
+			_, err = dec.DecompressBytes(data[:100], nil)
+			assert.Error(t, err)
+		}
+	}
+
+	_, err := NewCompressor("unknown", 0, nil)
+	assert.Error(t, err)
+	_, err = NewDecompressor("unknown", nil)
+	assert.Error(t, err)
+}
+
 func testCompress(t *testing.T, data string, enc Compressor, compressed, origin *bytes.Buffer) {
 	compressedBytes := make([]byte, 0)
 	originBytes := make([]byte, 0)
@@ -53,7 +129,7 @@ func testCompress(t *testing.T, data string, enc Compressor, compressed, origin
 	err = enc.Close()
 	assert.NoError(t, err)
 
-	dec, err := NewZstdDecompressor(compressed)
+	dec, err := NewDecompressor(enc.GetType(), compressed)
 	assert.NoError(t, err)
 	err = dec.Decompress(origin)
 	assert.NoError(t, err)
@@ -70,12 +146,12 @@ func testCompress(t *testing.T, data string, enc Compressor, compressed, origin
 	err = enc.Compress(errReader)
 	assert.ErrorIs(t, err, errReader.Err)
 
-	dec.ResetReader(bytes.NewReader(compressed.Bytes()))
+	dec.ResetReader(bytes.NewReader(compressedBytes))
 	err = dec.Decompress(errWriter)
 	assert.ErrorIs(t, err, errWriter.Err)
 
 	// Use closed decompressor
-	dec.ResetReader(bytes.NewReader(compressed.Bytes()))
+	dec.ResetReader(bytes.NewReader(compressedBytes))
 	dec.Close()
 	err = dec.Decompress(origin)
 	assert.Error(t, err)
@@ -0,0 +1,117 @@
+package compressor
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/pierrec/lz4"
+)
+
+var (
+	_ Compressor   = (*LZ4Compressor)(nil)
+	_ Decompressor = (*LZ4Decompressor)(nil)
+
+	errDecompressorClosed = errors.New("decompressor is closed")
+)
+
+// LZ4Compressor compresses the data into the LZ4 frame format
+type LZ4Compressor struct {
+	writer *lz4.Writer
+	level  int
+	closed bool
+}
+
+// The level 0 is the fastest compression, the higher level compresses better.
+// For compressing small blocks, pass nil to the `out` parameter
+func NewLZ4Compressor(out io.Writer, level int) *LZ4Compressor {
+	writer := lz4.NewWriter(out)
+	writer.Header.CompressionLevel = level
+	return &LZ4Compressor{writer: writer, level: level}
+}
+
+// Use case: compress stream
+// Call Close() to make sure the data is flushed to the underlying writer
+// after the last Compress() call
+func (c *LZ4Compressor) Compress(in io.Reader) error {
+	_, err := io.Copy(c.writer, in)
+	return err
+}
+
+// Use case: compress small blocks
+// This compresses the src bytes and appends it to the dst bytes, then return the result
+// This can be called concurrently
+func (c *LZ4Compressor) CompressBytes(src []byte, dst []byte) []byte {
+	buf := bytes.NewBuffer(dst)
+	writer := lz4.NewWriter(buf)
+	writer.Header.CompressionLevel = c.level
+	// writing to bytes.Buffer never fails
+	_, _ = writer.Write(src)
+	_ = writer.Close()
+	return buf.Bytes()
+}
+
+// Reset the writer to reuse the compressor
+func (c *LZ4Compressor) ResetWriter(out io.Writer) {
+	c.writer.Reset(out)
+	c.closed = false
+}
+
+// The compressor is still re-used after calling this
+func (c *LZ4Compressor) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.writer.Close()
+}
+
+func (c *LZ4Compressor) GetType() CompressType {
+	return CompressTypeLZ4
+}
+
+// LZ4Decompressor decompresses the data in the LZ4 frame format
+type LZ4Decompressor struct {
+	reader *lz4.Reader
+	closed bool
+}
+
+// For decompressing small blocks, pass nil to the `in` parameter
+func NewLZ4Decompressor(in io.Reader) *LZ4Decompressor {
+	return &LZ4Decompressor{reader: lz4.NewReader(in)}
+}
+
+// Usa case: decompress stream
+// Write the decompressed data into `out`
+func (dec *LZ4Decompressor) Decompress(out io.Writer) error {
+	if dec.closed {
+		return errDecompressorClosed
+	}
+	_, err := io.Copy(out, dec.reader)
+	return err
+}
+
+// Use case: decompress small blocks
+// This decompresses the src bytes and appends it to the dst bytes, then return the result
+// This can be called concurrently
+func (dec *LZ4Decompressor) DecompressBytes(src []byte, dst []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(dst)
+	if _, err := io.Copy(buf, lz4.NewReader(bytes.NewReader(src))); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Reset the reader to reuse the decompressor
+func (dec *LZ4Decompressor) ResetReader(in io.Reader) {
+	dec.reader.Reset(in)
+}
+
+// NOTICE: not like compressor, the decompressor is not usable after calling this
+func (dec *LZ4Decompressor) Close() {
+	dec.closed = true
+}
+
+func (dec *LZ4Decompressor) GetType() CompressType {
+	return CompressTypeLZ4
+}
@@ -0,0 +1,110 @@
+package compressor
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/golang/snappy"
+)
+
+var (
+	_ Compressor   = (*SnappyCompressor)(nil)
+	_ Decompressor = (*SnappyDecompressor)(nil)
+)
+
+// SnappyCompressor compresses the data into the snappy framing format,
+// snappy has no compression level.
+type SnappyCompressor struct {
+	writer *snappy.Writer
+	closed bool
+}
+
+// For compressing small blocks, pass nil to the `out` parameter
+func NewSnappyCompressor(out io.Writer) *SnappyCompressor {
+	return &SnappyCompressor{writer: snappy.NewBufferedWriter(out)}
+}
+
+// Use case: compress stream
+// Call Close() to make sure the data is flushed to the underlying writer
+// after the last Compress() call
+func (c *SnappyCompressor) Compress(in io.Reader) error {
+	_, err := io.Copy(c.writer, in)
+	return err
+}
+
+// Use case: compress small blocks
+// This compresses the src bytes and appends it to the dst bytes, then return the result
+// This can be called concurrently
+func (c *SnappyCompressor) CompressBytes(src []byte, dst []byte) []byte {
+	buf := bytes.NewBuffer(dst)
+	writer := snappy.NewBufferedWriter(buf)
+	// writing to bytes.Buffer never fails
+	_, _ = writer.Write(src)
+	_ = writer.Close()
+	return buf.Bytes()
+}
+
+// Reset the writer to reuse the compressor
+func (c *SnappyCompressor) ResetWriter(out io.Writer) {
+	c.writer.Reset(out)
+	c.closed = false
+}
+
+// The compressor is still re-used after calling this
+func (c *SnappyCompressor) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.writer.Close()
+}
+
+func (c *SnappyCompressor) GetType() CompressType {
+	return CompressTypeSnappy
+}
+
+// SnappyDecompressor decompresses the data in the snappy framing format
+type SnappyDecompressor struct {
+	reader *snappy.Reader
+	closed bool
+}
+
+// For decompressing small blocks, pass nil to the `in` parameter
+func NewSnappyDecompressor(in io.Reader) *SnappyDecompressor {
+	return &SnappyDecompressor{reader: snappy.NewReader(in)}
+}
+
+// Usa case: decompress stream
+// Write the decompressed data into `out`
+func (dec *SnappyDecompressor) Decompress(out io.Writer) error {
+	if dec.closed {
+		return errDecompressorClosed
+	}
+	_, err := io.Copy(out, dec.reader)
+	return err
+}
+
+// Use case: decompress small blocks
+// This decompresses the src bytes and appends it to the dst bytes, then return the result
+// This can be called concurrently
+func (dec *SnappyDecompressor) DecompressBytes(src []byte, dst []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(dst)
+	if _, err := io.Copy(buf, snappy.NewReader(bytes.NewReader(src))); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Reset the reader to reuse the decompressor
+func (dec *SnappyDecompressor) ResetReader(in io.Reader) {
+	dec.reader.Reset(in)
+}
+
+// NOTICE: not like compressor, the decompressor is not usable after calling this
+func (dec *SnappyDecompressor) Close() {
+	dec.closed = true
+}
+
+func (dec *SnappyDecompressor) GetType() CompressType {
+	return CompressTypeSnappy
+}
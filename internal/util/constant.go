@@ -50,6 +50,11 @@ const (
 
 	PrivilegeWord = "Privilege"
 	AnyWord       = "*"
+
+	// DefaultDBName is the database which the collections belong to if no database is specified,
+	// all the collections created before databases were introduced belong to it as well.
+	DefaultDBName = "default"
+	DefaultDBID   = int64(1)
 )
 
 const (
@@ -0,0 +1,49 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+const apiKeySep = "."
+
+// GenerateApiKey generates a random api key, the key is in format `<keyID>.<secret>`.
+func GenerateApiKey() (keyID string, secret string, err error) {
+	id := make([]byte, 8)
+	if _, err = rand.Read(id); err != nil {
+		return "", "", err
+	}
+	s := make([]byte, 32)
+	if _, err = rand.Read(s); err != nil {
+		return "", "", err
+	}
+	return hex.EncodeToString(id), base64.RawURLEncoding.EncodeToString(s), nil
+}
+
+// FormatApiKey joins the key id and the secret into the api key.
+func FormatApiKey(keyID string, secret string) string {
+	return keyID + apiKeySep + secret
+}
+
+// ParseApiKey splits the api key into the key id and the secret.
+func ParseApiKey(apiKey string) (keyID string, secret string, ok bool) {
+	keyID, secret, ok = strings.Cut(apiKey, apiKeySep)
+	if !ok || keyID == "" || secret == "" {
+		return "", "", false
+	}
+	return keyID, secret, true
+}
+
+// HashApiKeySecret hashes the secret of the api key, the secrets are generated randomly with enough entropy,
+// so a salted sha256 is enough and cheap to verify for every request.
+func HashApiKeySecret(keyID string, secret string) string {
+	return SHA256(secret, keyID)
+}
+
+// VerifyApiKeySecret checks the secret against the hashed secret in constant time.
+func VerifyApiKeySecret(keyID string, secret string, hashedSecret string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashApiKeySecret(keyID, secret)), []byte(hashedSecret)) == 1
+}
@@ -45,3 +45,33 @@ func TestBcryptCost(t *testing.T) {
 func TestMD5(t *testing.T) {
 	assert.Equal(t, "67f48520697662a2", MD5("These pretzels are making me thirsty."))
 }
+
+func TestApiKey(t *testing.T) {
+	keyID, secret, err := GenerateApiKey()
+	assert.NoError(t, err)
+	assert.Len(t, keyID, 16)
+	assert.NotEmpty(t, secret)
+
+	apiKey := FormatApiKey(keyID, secret)
+	parsedID, parsedSecret, ok := ParseApiKey(apiKey)
+	assert.True(t, ok)
+	assert.Equal(t, keyID, parsedID)
+	assert.Equal(t, secret, parsedSecret)
+	assert.False(t, IsJWT(apiKey))
+
+	for _, invalid := range []string{"", "abc", ".abc", "abc."} {
+		_, _, ok = ParseApiKey(invalid)
+		assert.False(t, ok, invalid)
+	}
+
+	hashed := HashApiKeySecret(keyID, secret)
+	assert.NotEqual(t, secret, hashed)
+	assert.True(t, VerifyApiKeySecret(keyID, secret, hashed))
+	assert.False(t, VerifyApiKeySecret(keyID, secret+"x", hashed))
+	assert.False(t, VerifyApiKeySecret("other", secret, hashed))
+
+	otherID, otherSecret, err := GenerateApiKey()
+	assert.NoError(t, err)
+	assert.NotEqual(t, keyID, otherID)
+	assert.NotEqual(t, secret, otherSecret)
+}
@@ -0,0 +1,330 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256" // register the hash functions of the signing algorithms
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// jwtLeeway is the allowed clock skew when checking the time claims of the tokens.
+const jwtLeeway = time.Minute
+
+// IsJWT tells whether the bearer token is a JWT, a JWT consists of three parts separated by dots.
+func IsJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// JWTVerifier verifies the signed JWT bearer tokens against a locally configured JSON Web Key Set,
+// and extracts the username from the claims of the tokens.
+//
+// The supported algorithms are RS256, RS384, RS512, ES256, ES384, ES512 and EdDSA.
+type JWTVerifier struct {
+	keys          map[string]crypto.PublicKey
+	issuer        string
+	audience      string
+	usernameClaim string
+	now           func() time.Time
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// NewJWTVerifier creates a JWTVerifier with the JSON Web Key Set, the issuer and the audience are not checked if empty.
+func NewJWTVerifier(jwks []byte, issuer string, audience string, usernameClaim string) (*JWTVerifier, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(jwks, &set); err != nil {
+		return nil, fmt.Errorf("failed to parse the json web key set: %w", err)
+	}
+	if len(set.Keys) == 0 {
+		return nil, errors.New("no key in the json web key set")
+	}
+	if usernameClaim == "" {
+		return nil, errors.New("the username claim is empty")
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the json web key %s: %w", jwk.Kid, err)
+		}
+		if _, ok := keys[jwk.Kid]; ok {
+			return nil, fmt.Errorf("duplicated json web key id: %s", jwk.Kid)
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no signature key in the json web key set")
+	}
+
+	return &JWTVerifier{
+		keys:          keys,
+		issuer:        issuer,
+		audience:      audience,
+		usernameClaim: usernameClaim,
+		now:           time.Now,
+	}, nil
+}
+
+// Verify checks the signature and the claims of the token, returns the username in the token.
+func (v *JWTVerifier) Verify(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", errors.New("malformed token")
+	}
+
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return "", fmt.Errorf("malformed token header: %w", err)
+	}
+	key, err := v.key(header.Kid)
+	if err != nil {
+		return "", err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("malformed token signature: %w", err)
+	}
+	if err := verifyJWTSignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return "", err
+	}
+
+	claims := make(map[string]interface{})
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return "", fmt.Errorf("malformed token claims: %w", err)
+	}
+	if err := v.verifyClaims(claims); err != nil {
+		return "", err
+	}
+	username, ok := claims[v.usernameClaim].(string)
+	if !ok || username == "" {
+		return "", fmt.Errorf("no username claim %s in token", v.usernameClaim)
+	}
+	return username, nil
+}
+
+func (v *JWTVerifier) key(kid string) (crypto.PublicKey, error) {
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	// the key id could be omitted if there is only one key.
+	if kid == "" && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown token key id: %s", kid)
+}
+
+func (v *JWTVerifier) verifyClaims(claims map[string]interface{}) error {
+	now := v.now()
+	exp, ok := claims["exp"].(json.Number)
+	if !ok {
+		return errors.New("no expiration time in token")
+	}
+	expTime, err := exp.Float64()
+	if err != nil {
+		return fmt.Errorf("invalid expiration time in token: %w", err)
+	}
+	if now.Add(-jwtLeeway).After(time.Unix(int64(expTime), 0)) {
+		return errors.New("token is expired")
+	}
+	if nbf, ok := claims["nbf"].(json.Number); ok {
+		nbfTime, err := nbf.Float64()
+		if err != nil {
+			return fmt.Errorf("invalid not before time in token: %w", err)
+		}
+		if now.Add(jwtLeeway).Before(time.Unix(int64(nbfTime), 0)) {
+			return errors.New("token is not valid yet")
+		}
+	}
+	if v.issuer != "" {
+		if iss, _ := claims["iss"].(string); iss != v.issuer {
+			return fmt.Errorf("unexpected token issuer: %s", iss)
+		}
+	}
+	if v.audience != "" && !hasAudience(claims["aud"], v.audience) {
+		return errors.New("token audience mismatch")
+	}
+	return nil
+}
+
+func hasAudience(aud interface{}, audience string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, a := range aud {
+			if s, ok := a.(string); ok && s == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+func verifyJWTSignature(alg string, key crypto.PublicKey, signed []byte, signature []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "ES512":
+		hash = crypto.SHA512
+	case "EdDSA":
+	default:
+		return fmt.Errorf("unsupported token algorithm: %s", alg)
+	}
+
+	var verified bool
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if !strings.HasPrefix(alg, "RS") {
+			return fmt.Errorf("token algorithm %s mismatches the rsa key", alg)
+		}
+		h := hash.New()
+		h.Write(signed)
+		verified = rsa.VerifyPKCS1v15(key, hash, h.Sum(nil), signature) == nil
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(alg, "ES") || key.Curve != ecdsaCurveOf(alg) {
+			return fmt.Errorf("token algorithm %s mismatches the ecdsa key", alg)
+		}
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid token signature")
+		}
+		h := hash.New()
+		h.Write(signed)
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		verified = ecdsa.Verify(key, h.Sum(nil), r, s)
+	case ed25519.PublicKey:
+		if alg != "EdDSA" {
+			return fmt.Errorf("token algorithm %s mismatches the ed25519 key", alg)
+		}
+		verified = ed25519.Verify(key, signed, signature)
+	}
+	if !verified {
+		return errors.New("invalid token signature")
+	}
+	return nil
+}
+
+func ecdsaCurveOf(alg string) elliptic.Curve {
+	switch alg {
+	case "ES256":
+		return elliptic.P256()
+	case "ES384":
+		return elliptic.P384()
+	case "ES512":
+		return elliptic.P521()
+	}
+	return nil
+}
+
+func (jwk *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeJWKInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeJWKInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid rsa public exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve: %s", jwk.Crv)
+		}
+		x, err := decodeJWKInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeJWKInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("the point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if jwk.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve: %s", jwk.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 public key size")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("unsupported key type: %s", jwk.Kty)
+}
+
+func decodeJWKInt(s string) (*big.Int, error) {
+	if s == "" {
+		return nil, errors.New("missing key parameter")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
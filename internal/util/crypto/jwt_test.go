@@ -0,0 +1,169 @@
+package crypto
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signJWT(t *testing.T, alg string, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := b64(header) + "." + b64(payload)
+
+	var signature []byte
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		h := crypto.SHA256.New()
+		h.Write([]byte(signed))
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, h.Sum(nil))
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		h := crypto.SHA256.New()
+		h.Write([]byte(signed))
+		r, s, err := ecdsa.Sign(rand.Reader, key, h.Sum(nil))
+		require.NoError(t, err)
+		signature = make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(key, []byte(signed))
+	}
+	return signed + "." + b64(signature)
+}
+
+func TestJWTVerifier(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	jwks, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{
+			{"kty": "RSA", "kid": "rsa", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": b64(ecKey.X.Bytes()), "y": b64(ecKey.Y.Bytes())},
+			{"kty": "OKP", "kid": "ed", "crv": "Ed25519", "x": b64(edPub)},
+			{"kty": "oct", "kid": "enc", "use": "enc"},
+		},
+	})
+	require.NoError(t, err)
+
+	verifier, err := NewJWTVerifier(jwks, "issuer", "milvus", "sub")
+	require.NoError(t, err)
+	now := time.Now()
+	verifier.now = func() time.Time { return now }
+
+	claims := func(modify func(map[string]interface{})) map[string]interface{} {
+		c := map[string]interface{}{
+			"sub": "user1",
+			"iss": "issuer",
+			"aud": []string{"other", "milvus"},
+			"exp": now.Add(time.Hour).Unix(),
+			"nbf": now.Add(-time.Hour).Unix(),
+		}
+		if modify != nil {
+			modify(c)
+		}
+		return c
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for _, token := range []string{
+			signJWT(t, "RS256", "rsa", rsaKey, claims(nil)),
+			signJWT(t, "ES256", "ec", ecKey, claims(nil)),
+			signJWT(t, "EdDSA", "ed", edKey, claims(func(c map[string]interface{}) { c["aud"] = "milvus" })),
+		} {
+			assert.True(t, IsJWT(token))
+			username, err := verifier.Verify(token)
+			assert.NoError(t, err)
+			assert.Equal(t, "user1", username)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tokens := map[string]string{
+			"malformed":           "a.b",
+			"unknown kid":         signJWT(t, "RS256", "unknown", rsaKey, claims(nil)),
+			"alg mismatch":        signJWT(t, "EdDSA", "rsa", rsaKey, claims(nil)),
+			"alg none":            signJWT(t, "none", "rsa", rsaKey, claims(nil)),
+			"wrong key":           signJWT(t, "RS256", "rsa", mustRSAKey(t), claims(nil)),
+			"expired":             signJWT(t, "RS256", "rsa", rsaKey, claims(func(c map[string]interface{}) { c["exp"] = now.Add(-time.Hour).Unix() })),
+			"no exp":              signJWT(t, "RS256", "rsa", rsaKey, claims(func(c map[string]interface{}) { delete(c, "exp") })),
+			"not valid yet":       signJWT(t, "RS256", "rsa", rsaKey, claims(func(c map[string]interface{}) { c["nbf"] = now.Add(time.Hour).Unix() })),
+			"wrong issuer":        signJWT(t, "RS256", "rsa", rsaKey, claims(func(c map[string]interface{}) { c["iss"] = "other" })),
+			"wrong audience":      signJWT(t, "RS256", "rsa", rsaKey, claims(func(c map[string]interface{}) { c["aud"] = "other" })),
+			"no username":         signJWT(t, "RS256", "rsa", rsaKey, claims(func(c map[string]interface{}) { delete(c, "sub") })),
+			"non string username": signJWT(t, "RS256", "rsa", rsaKey, claims(func(c map[string]interface{}) { c["sub"] = 1 })),
+		}
+		for name, token := range tokens {
+			_, err := verifier.Verify(token)
+			assert.Error(t, err, name)
+		}
+
+		// tamper the claims
+		token := signJWT(t, "RS256", "rsa", rsaKey, claims(nil))
+		other := signJWT(t, "RS256", "rsa", rsaKey, claims(func(c map[string]interface{}) { c["sub"] = "root" }))
+		parts, otherParts := strings.Split(token, "."), strings.Split(other, ".")
+		_, err := verifier.Verify(parts[0] + "." + otherParts[1] + "." + parts[2])
+		assert.Error(t, err)
+	})
+
+	t.Run("single key without kid", func(t *testing.T) {
+		jwks, err := json.Marshal(map[string]interface{}{
+			"keys": []map[string]string{{"kty": "OKP", "crv": "Ed25519", "x": b64(edPub)}},
+		})
+		require.NoError(t, err)
+		v, err := NewJWTVerifier(jwks, "", "", "name")
+		require.NoError(t, err)
+
+		username, err := v.Verify(signJWT(t, "EdDSA", "", edKey, claims(func(c map[string]interface{}) { c["name"] = "user2" })))
+		assert.NoError(t, err)
+		assert.Equal(t, "user2", username)
+	})
+}
+
+func TestNewJWTVerifier_Invalid(t *testing.T) {
+	for name, jwks := range map[string]string{
+		"not json":        "keys",
+		"empty":           `{"keys":[]}`,
+		"no sig key":      `{"keys":[{"kty":"oct","use":"enc"}]}`,
+		"unknown kty":     `{"keys":[{"kty":"oct"}]}`,
+		"unknown curve":   `{"keys":[{"kty":"EC","crv":"P-224","x":"AQ","y":"AQ"}]}`,
+		"not on curve":    `{"keys":[{"kty":"EC","crv":"P-256","x":"AQ","y":"AQ"}]}`,
+		"missing rsa n":   `{"keys":[{"kty":"RSA","e":"AQAB"}]}`,
+		"bad ed25519":     `{"keys":[{"kty":"OKP","crv":"Ed25519","x":"AQ"}]}`,
+		"duplicated kids": `{"keys":[{"kty":"RSA","kid":"a","n":"AQ","e":"AQAB"},{"kty":"RSA","kid":"a","n":"AQ","e":"AQAB"}]}`,
+	} {
+		_, err := NewJWTVerifier([]byte(jwks), "", "", "sub")
+		assert.Error(t, err, name)
+	}
+
+	_, err := NewJWTVerifier([]byte(`{"keys":[{"kty":"RSA","n":"AQ","e":"AQAB"}]}`), "", "", "")
+	assert.Error(t, err)
+}
+
+func mustRSAKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
@@ -0,0 +1,115 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// jsonWriter writes the rows into a row-based JSON file in the layout of the bulk insert:
+//
+//	{"rows": [{"field1": value, "field2": value}, ...]}
+//
+// the binary vectors are written as arrays of uint8, the null values are written as null.
+type jsonWriter struct {
+	fields []*schemapb.FieldSchema
+	name   string
+	buf    *bytes.Buffer
+	rows   int
+}
+
+func newJSONWriter(fields []*schemapb.FieldSchema, name string) *jsonWriter {
+	buf := new(bytes.Buffer)
+	buf.WriteString(`{"` + importutil.RowRootNode + `":[`)
+	return &jsonWriter{
+		fields: fields,
+		name:   name,
+		buf:    buf,
+	}
+}
+
+func (w *jsonWriter) Write(data *storage.InsertData, offsets []int) error {
+	fieldsData := make([]storage.FieldData, len(w.fields))
+	for i, field := range w.fields {
+		fieldData, err := getFieldData(data, field)
+		if err != nil {
+			return err
+		}
+		fieldsData[i] = fieldData
+	}
+
+	for _, offset := range offsets {
+		if w.rows > 0 {
+			w.buf.WriteByte(',')
+		}
+		w.buf.WriteByte('{')
+		for i, field := range w.fields {
+			value, err := jsonValue(field, fieldsData[i], offset)
+			if err != nil {
+				return err
+			}
+			key, _ := json.Marshal(field.GetName())
+			bs, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("failed to marshal the value of field '%s', error: %w", field.GetName(), err)
+			}
+			if i > 0 {
+				w.buf.WriteByte(',')
+			}
+			w.buf.Write(key)
+			w.buf.WriteByte(':')
+			w.buf.Write(bs)
+		}
+		w.buf.WriteByte('}')
+		w.rows++
+	}
+	return nil
+}
+
+func (w *jsonWriter) Finish() (map[string][]byte, error) {
+	w.buf.WriteString("]}")
+	return map[string][]byte{w.name + importutil.JSONFileExt: w.buf.Bytes()}, nil
+}
+
+func jsonValue(field *schemapb.FieldSchema, data storage.FieldData, i int) (interface{}, error) {
+	if isNull(data, i) {
+		return nil, nil
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_BinaryVector:
+		// []byte is marshaled as a base64 string, the bulk insert requires an array of uint8.
+		bytesValue := data.GetRow(i).([]byte)
+		value := make([]int, len(bytesValue))
+		for j, b := range bytesValue {
+			value[j] = int(b)
+		}
+		return value, nil
+	case typeutil.DataTypeJSON:
+		return json.RawMessage(data.GetRow(i).([]byte)), nil
+	case typeutil.DataTypeArray:
+		return arrayElements(data.GetRow(i).(*schemapb.ScalarField))
+	default:
+		return data.GetRow(i), nil
+	}
+}
@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+)
+
+func TestJSONWriter(t *testing.T) {
+	w, err := NewWriter(rootcoordpb.ExportFormat_ExportJSON, sampleSchema(true), "1")
+	assert.NoError(t, err)
+
+	assert.NoError(t, w.Write(sampleInsertData(true, true), []int{0, 2}))
+	assert.NoError(t, w.Write(sampleInsertData(true, true), []int{}))
+	assert.NoError(t, w.Write(sampleInsertData(true, true), []int{1}))
+	files, err := w.Finish()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(files))
+	content, ok := files["1.json"]
+	assert.True(t, ok)
+
+	doc := struct {
+		Rows []map[string]interface{} `json:"rows"`
+	}{}
+	assert.NoError(t, json.Unmarshal(content, &doc))
+	assert.Equal(t, 3, len(doc.Rows))
+
+	row := doc.Rows[0]
+	assert.Equal(t, 6, len(row))
+	assert.Equal(t, float64(1000), row["id"])
+	assert.Equal(t, "a", row["name"])
+	assert.Equal(t, map[string]interface{}{"x": float64(1)}, row["meta"])
+	assert.Equal(t, []interface{}{float64(1), float64(1)}, row["vec"])
+	assert.Equal(t, []interface{}{float64(1), float64(2)}, row["bin"])
+	assert.Equal(t, []interface{}{float64(1)}, row["tags"])
+
+	// the null value is written as null
+	row = doc.Rows[1]
+	assert.Equal(t, float64(3000), row["id"])
+	value, ok := row["name"]
+	assert.True(t, ok)
+	assert.Nil(t, value)
+
+	assert.Equal(t, float64(2000), doc.Rows[2]["id"])
+	assert.Equal(t, []interface{}{float64(2), float64(2)}, doc.Rows[2]["tags"])
+}
+
+func TestJSONWriter_Empty(t *testing.T) {
+	w, err := NewWriter(rootcoordpb.ExportFormat_ExportJSON, sampleSchema(false), "1")
+	assert.NoError(t, err)
+	files, err := w.Finish()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"rows":[]}`, string(files["1.json"]))
+}
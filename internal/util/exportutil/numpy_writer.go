@@ -0,0 +1,168 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// numpyWriter writes each field into a NumPy file named by the field under the directory @name,
+// the float vectors are 2-dimensional float32 arrays, the binary vectors are 2-dimensional uint8 arrays,
+// the JSON values are written as strings. The NumPy files don't support the array fields and the null values.
+type numpyWriter struct {
+	fields  []*schemapb.FieldSchema
+	name    string
+	dims    []int
+	columns []interface{}
+}
+
+func newNumpyWriter(fields []*schemapb.FieldSchema, name string) (*numpyWriter, error) {
+	w := &numpyWriter{
+		fields:  fields,
+		name:    name,
+		dims:    make([]int, len(fields)),
+		columns: make([]interface{}, len(fields)),
+	}
+	for i, field := range fields {
+		switch field.GetDataType() {
+		case schemapb.DataType_Bool:
+			w.columns[i] = []bool{}
+		case schemapb.DataType_Int8:
+			w.columns[i] = []int8{}
+		case schemapb.DataType_Int16:
+			w.columns[i] = []int16{}
+		case schemapb.DataType_Int32:
+			w.columns[i] = []int32{}
+		case schemapb.DataType_Int64:
+			w.columns[i] = []int64{}
+		case schemapb.DataType_Float:
+			w.columns[i] = []float32{}
+		case schemapb.DataType_Double:
+			w.columns[i] = []float64{}
+		case schemapb.DataType_String, schemapb.DataType_VarChar, typeutil.DataTypeJSON:
+			w.columns[i] = []string{}
+		case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
+			dim, err := typeutil.GetDim(field)
+			if err != nil {
+				return nil, err
+			}
+			if field.GetDataType() == schemapb.DataType_FloatVector {
+				w.dims[i] = int(dim)
+				w.columns[i] = []float32{}
+			} else {
+				w.dims[i] = int(dim) / 8
+				w.columns[i] = []uint8{}
+			}
+		case typeutil.DataTypeArray:
+			return nil, fmt.Errorf("array field '%s' is not supported by numpy files, please use JSON or Parquet files instead",
+				field.GetName())
+		default:
+			return nil, fmt.Errorf("the data type %s of field '%s' is not supported by numpy files",
+				field.GetDataType().String(), field.GetName())
+		}
+	}
+	return w, nil
+}
+
+func (w *numpyWriter) Write(data *storage.InsertData, offsets []int) error {
+	for i, field := range w.fields {
+		fieldData, err := getFieldData(data, field)
+		if err != nil {
+			return err
+		}
+		for _, offset := range offsets {
+			if isNull(fieldData, offset) {
+				return fmt.Errorf("the null value of field '%s' is not supported by numpy files", field.GetName())
+			}
+		}
+
+		switch fd := fieldData.(type) {
+		case *storage.BoolFieldData:
+			w.columns[i] = appendRows(w.columns[i].([]bool), fd.Data, offsets, 1)
+		case *storage.Int8FieldData:
+			w.columns[i] = appendRows(w.columns[i].([]int8), fd.Data, offsets, 1)
+		case *storage.Int16FieldData:
+			w.columns[i] = appendRows(w.columns[i].([]int16), fd.Data, offsets, 1)
+		case *storage.Int32FieldData:
+			w.columns[i] = appendRows(w.columns[i].([]int32), fd.Data, offsets, 1)
+		case *storage.Int64FieldData:
+			w.columns[i] = appendRows(w.columns[i].([]int64), fd.Data, offsets, 1)
+		case *storage.FloatFieldData:
+			w.columns[i] = appendRows(w.columns[i].([]float32), fd.Data, offsets, 1)
+		case *storage.DoubleFieldData:
+			w.columns[i] = appendRows(w.columns[i].([]float64), fd.Data, offsets, 1)
+		case *storage.StringFieldData:
+			w.columns[i] = appendRows(w.columns[i].([]string), fd.Data, offsets, 1)
+		case *storage.JSONFieldData:
+			column := w.columns[i].([]string)
+			for _, offset := range offsets {
+				column = append(column, string(fd.Data[offset]))
+			}
+			w.columns[i] = column
+		case *storage.FloatVectorFieldData:
+			w.columns[i] = appendRows(w.columns[i].([]float32), fd.Data, offsets, w.dims[i])
+		case *storage.BinaryVectorFieldData:
+			w.columns[i] = appendRows(w.columns[i].([]uint8), fd.Data, offsets, w.dims[i])
+		default:
+			return fmt.Errorf("unexpected data of field '%s': %T", field.GetName(), fieldData)
+		}
+	}
+	return nil
+}
+
+func (w *numpyWriter) Finish() (map[string][]byte, error) {
+	files := make(map[string][]byte, len(w.fields))
+	for i, field := range w.fields {
+		column := w.columns[i]
+		if w.dims[i] > 0 {
+			column = reshape(column, w.dims[i])
+		}
+		content, err := importutil.CreateNumpyData(column)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write numpy file of field '%s', error: %w", field.GetName(), err)
+		}
+		files[path.Join(w.name, field.GetName()+importutil.NumpyFileExt)] = content
+	}
+	return files, nil
+}
+
+// appendRows appends the rows at @offsets of @src to @dst, each row contains @width elements.
+func appendRows[T any](dst []T, src []T, offsets []int, width int) []T {
+	for _, offset := range offsets {
+		dst = append(dst, src[offset*width:(offset+1)*width]...)
+	}
+	return dst
+}
+
+// reshape converts the flat slice of the vectors into a slice of arrays, which is written as a 2-dimensional
+// numpy array, for example, []float32 of 2*4 elements is converted into [][4]float32 of 2 elements.
+func reshape(flat interface{}, dim int) interface{} {
+	src := reflect.ValueOf(flat)
+	rows := src.Len() / dim
+	dst := reflect.MakeSlice(reflect.SliceOf(reflect.ArrayOf(dim, src.Type().Elem())), rows, rows)
+	for i := 0; i < rows; i++ {
+		reflect.Copy(dst.Index(i).Slice(0, dim), src.Slice(i*dim, (i+1)*dim))
+	}
+	return dst.Interface()
+}
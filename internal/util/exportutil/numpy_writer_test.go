@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sbinet/npyio"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+)
+
+func TestNumpyWriter(t *testing.T) {
+	w, err := NewWriter(rootcoordpb.ExportFormat_ExportNumpy, sampleSchema(false), "1")
+	assert.NoError(t, err)
+
+	assert.NoError(t, w.Write(sampleInsertData(false, false), []int{0, 2}))
+	files, err := w.Finish()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, len(files))
+
+	read := func(name string, data interface{}) []int {
+		content, ok := files[name]
+		assert.True(t, ok)
+		r, err := npyio.NewReader(bytes.NewReader(content))
+		assert.NoError(t, err)
+		assert.NoError(t, r.Read(data))
+		return r.Header.Descr.Shape
+	}
+
+	ids := make([]int64, 0)
+	shape := read("1/id.npy", &ids)
+	assert.Equal(t, []int{2}, shape)
+	assert.Equal(t, []int64{1000, 3000}, ids)
+
+	readString := func(name string) []string {
+		adapter, err := importutil.NewNumpyAdapter(bytes.NewReader(files[name]))
+		assert.NoError(t, err)
+		values, err := adapter.ReadString(2)
+		assert.NoError(t, err)
+		return values
+	}
+	assert.Equal(t, []string{"a", "c"}, readString("1/name.npy"))
+	assert.Equal(t, []string{`{"x":1}`, `{"x":3}`}, readString("1/meta.npy"))
+
+	vectors := make([]float32, 0)
+	shape = read("1/vec.npy", &vectors)
+	assert.Equal(t, []int{2, 2}, shape)
+	assert.Equal(t, []float32{1, 1, 3, 3}, vectors)
+
+	binaries := make([]uint8, 0)
+	shape = read("1/bin.npy", &binaries)
+	assert.Equal(t, []int{2, 2}, shape)
+	assert.Equal(t, []uint8{1, 2, 5, 6}, binaries)
+}
+
+func TestNumpyWriter_Null(t *testing.T) {
+	w, err := NewWriter(rootcoordpb.ExportFormat_ExportNumpy, sampleSchema(false), "1")
+	assert.NoError(t, err)
+
+	assert.NoError(t, w.Write(sampleInsertData(false, true), []int{0, 1}))
+	err = w.Write(sampleInsertData(false, true), []int{2})
+	assert.Error(t, err)
+}
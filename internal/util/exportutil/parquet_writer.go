@@ -0,0 +1,231 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/compress"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// parquetWriter writes the rows into a Parquet file, each field is a column named by the field,
+// the vectors are lists, the float vectors of float32, the binary vectors of uint8. The vectors are not
+// written as fixed size lists, since the Parquet writer of arrow drops their values.
+// The JSON values are written as strings, the arrays are lists, and each Write makes a row group.
+type parquetWriter struct {
+	fields  []*schemapb.FieldSchema
+	name    string
+	buf     *bytes.Buffer
+	builder *array.RecordBuilder
+	writer  *pqarrow.FileWriter
+}
+
+func newParquetWriter(fields []*schemapb.FieldSchema, name string) (*parquetWriter, error) {
+	arrowFields := make([]arrow.Field, 0, len(fields))
+	for _, field := range fields {
+		dataType, err := arrowDataType(field)
+		if err != nil {
+			return nil, err
+		}
+		arrowFields = append(arrowFields, arrow.Field{
+			Name:     field.GetName(),
+			Type:     dataType,
+			Nullable: typeutil.IsFieldNullable(field),
+		})
+	}
+	schema := arrow.NewSchema(arrowFields, nil)
+
+	buf := new(bytes.Buffer)
+	writer, err := pqarrow.NewFileWriter(schema, buf,
+		parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Zstd)),
+		pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()))
+	if err != nil {
+		return nil, err
+	}
+	return &parquetWriter{
+		fields:  fields,
+		name:    name,
+		buf:     buf,
+		builder: array.NewRecordBuilder(memory.DefaultAllocator, schema),
+		writer:  writer,
+	}, nil
+}
+
+func arrowDataType(field *schemapb.FieldSchema) (arrow.DataType, error) {
+	switch field.GetDataType() {
+	case schemapb.DataType_FloatVector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Float32), nil
+	case schemapb.DataType_BinaryVector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Uint8), nil
+	case typeutil.DataTypeJSON:
+		return arrow.BinaryTypes.String, nil
+	case typeutil.DataTypeArray:
+		elementType, err := typeutil.GetArrayElementType(field)
+		if err != nil {
+			return nil, err
+		}
+		dataType, err := arrowScalarType(elementType)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported element type of array field '%s': %w", field.GetName(), err)
+		}
+		return arrow.ListOf(dataType), nil
+	default:
+		dataType, err := arrowScalarType(field.GetDataType())
+		if err != nil {
+			return nil, fmt.Errorf("unsupported data type of field '%s': %w", field.GetName(), err)
+		}
+		return dataType, nil
+	}
+}
+
+func arrowScalarType(dataType schemapb.DataType) (arrow.DataType, error) {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case schemapb.DataType_Int8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case schemapb.DataType_Int16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case schemapb.DataType_Int32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case schemapb.DataType_Int64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case schemapb.DataType_Float:
+		return arrow.PrimitiveTypes.Float32, nil
+	case schemapb.DataType_Double:
+		return arrow.PrimitiveTypes.Float64, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return arrow.BinaryTypes.String, nil
+	default:
+		return nil, fmt.Errorf("data type %d", dataType)
+	}
+}
+
+func (w *parquetWriter) Write(data *storage.InsertData, offsets []int) error {
+	if len(offsets) == 0 {
+		return nil
+	}
+	for i, field := range w.fields {
+		fieldData, err := getFieldData(data, field)
+		if err != nil {
+			return err
+		}
+		for _, offset := range offsets {
+			if isNull(fieldData, offset) {
+				w.builder.Field(i).AppendNull()
+				continue
+			}
+			if err := appendArrowValue(w.builder.Field(i), fieldData.GetRow(offset)); err != nil {
+				return fmt.Errorf("failed to write the value of field '%s', error: %w", field.GetName(), err)
+			}
+		}
+	}
+
+	record := w.builder.NewRecord()
+	defer record.Release()
+	return w.writer.Write(record)
+}
+
+func (w *parquetWriter) Finish() (map[string][]byte, error) {
+	defer w.builder.Release()
+	if err := w.writer.Close(); err != nil {
+		return nil, err
+	}
+	return map[string][]byte{w.name + importutil.ParquetFileExt: w.buf.Bytes()}, nil
+}
+
+func appendArrowValue(builder array.Builder, value interface{}) error {
+	switch b := builder.(type) {
+	case *array.BooleanBuilder:
+		b.Append(value.(bool))
+	case *array.Int8Builder:
+		b.Append(value.(int8))
+	case *array.Int16Builder:
+		b.Append(value.(int16))
+	case *array.Int32Builder:
+		b.Append(value.(int32))
+	case *array.Int64Builder:
+		b.Append(value.(int64))
+	case *array.Float32Builder:
+		b.Append(value.(float32))
+	case *array.Float64Builder:
+		b.Append(value.(float64))
+	case *array.StringBuilder:
+		switch v := value.(type) {
+		case string:
+			b.Append(v)
+		case []byte:
+			b.Append(string(v))
+		default:
+			return fmt.Errorf("unexpected value %T of string column", value)
+		}
+	case *array.ListBuilder:
+		b.Append(true)
+		switch v := value.(type) {
+		case []float32:
+			b.ValueBuilder().(*array.Float32Builder).AppendValues(v, nil)
+		case []byte:
+			b.ValueBuilder().(*array.Uint8Builder).AppendValues(v, nil)
+		case *schemapb.ScalarField:
+			return appendArrowElements(b.ValueBuilder(), v)
+		default:
+			return fmt.Errorf("unexpected value %T of list column", value)
+		}
+	default:
+		return fmt.Errorf("unexpected column builder %T", builder)
+	}
+	return nil
+}
+
+func appendArrowElements(builder array.Builder, value *schemapb.ScalarField) error {
+	switch b := builder.(type) {
+	case *array.BooleanBuilder:
+		b.AppendValues(value.GetBoolData().GetData(), nil)
+	case *array.Int8Builder:
+		for _, v := range value.GetIntData().GetData() {
+			b.Append(int8(v))
+		}
+	case *array.Int16Builder:
+		for _, v := range value.GetIntData().GetData() {
+			b.Append(int16(v))
+		}
+	case *array.Int32Builder:
+		b.AppendValues(value.GetIntData().GetData(), nil)
+	case *array.Int64Builder:
+		b.AppendValues(value.GetLongData().GetData(), nil)
+	case *array.Float32Builder:
+		b.AppendValues(value.GetFloatData().GetData(), nil)
+	case *array.Float64Builder:
+		b.AppendValues(value.GetDoubleData().GetData(), nil)
+	case *array.StringBuilder:
+		b.AppendValues(value.GetStringData().GetData(), nil)
+	default:
+		return fmt.Errorf("unexpected element builder %T", builder)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+)
+
+func TestParquetWriter(t *testing.T) {
+	w, err := NewWriter(rootcoordpb.ExportFormat_ExportParquet, sampleSchema(true), "1")
+	assert.NoError(t, err)
+
+	assert.NoError(t, w.Write(sampleInsertData(true, true), []int{0, 2}))
+	assert.NoError(t, w.Write(sampleInsertData(true, true), []int{}))
+	assert.NoError(t, w.Write(sampleInsertData(true, true), []int{1}))
+	files, err := w.Finish()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(files))
+	content, ok := files["1.parquet"]
+	assert.True(t, ok)
+
+	pr, err := file.NewParquetReader(bytes.NewReader(content))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, pr.NumRowGroups())
+	fr, err := pqarrow.NewFileReader(pr, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	assert.NoError(t, err)
+	table, err := fr.ReadTable(context.Background())
+	assert.NoError(t, err)
+	defer table.Release()
+
+	assert.Equal(t, int64(3), table.NumRows())
+	assert.Equal(t, int64(6), table.NumCols())
+
+	schema := table.Schema()
+	names := make([]string, 0, len(schema.Fields()))
+	for _, field := range schema.Fields() {
+		names = append(names, field.Name)
+	}
+	assert.Equal(t, []string{"id", "name", "meta", "vec", "bin", "tags"}, names)
+	assert.False(t, schema.Field(0).Nullable)
+	assert.True(t, schema.Field(1).Nullable)
+	assert.True(t, arrow.TypeEqual(arrow.ListOf(arrow.PrimitiveTypes.Float32), schema.Field(3).Type))
+	assert.True(t, arrow.TypeEqual(arrow.ListOf(arrow.PrimitiveTypes.Uint8), schema.Field(4).Type))
+	assert.True(t, arrow.TypeEqual(arrow.ListOf(arrow.PrimitiveTypes.Int64), schema.Field(5).Type))
+
+	ids := make([]int64, 0)
+	for _, chunk := range table.Column(0).Data().Chunks() {
+		ids = append(ids, chunk.(*array.Int64).Int64Values()...)
+	}
+	assert.Equal(t, []int64{1000, 3000, 2000}, ids)
+
+	nameChunk := table.Column(1).Data().Chunk(0).(*array.String)
+	assert.Equal(t, "a", nameChunk.Value(0))
+	assert.True(t, nameChunk.IsNull(1))
+
+	vecChunk := table.Column(3).Data().Chunk(0).(*array.List)
+	assert.Equal(t, []float32{1, 1, 3, 3, 2, 2}, vecChunk.ListValues().(*array.Float32).Float32Values())
+}
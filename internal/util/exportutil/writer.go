@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exportutil writes the rows of a collection into the files which could be imported by the bulk insert,
+// the row-based JSON files, the column-based NumPy files and the Parquet files.
+package exportutil
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// Writer converts the rows of the insert data into the files of an export format.
+type Writer interface {
+	// Write appends the rows at @offsets of @data, the data must contain all the fields of the schema.
+	Write(data *storage.InsertData, offsets []int) error
+	// Finish returns the contents of the written files, keyed by the paths relative to the export target path.
+	// The writer can't be used after Finish is called.
+	Finish() (map[string][]byte, error)
+}
+
+// NewWriter creates a Writer of @format for the collection of @schema, @name is the base name of the files,
+// the system fields are not exported.
+func NewWriter(format rootcoordpb.ExportFormat, schema *schemapb.CollectionSchema, name string) (Writer, error) {
+	if name == "" {
+		return nil, fmt.Errorf("the file name of export is empty")
+	}
+	fields := exportedFields(schema)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("no field to export in collection '%s'", schema.GetName())
+	}
+
+	switch format {
+	case rootcoordpb.ExportFormat_ExportJSON:
+		return newJSONWriter(fields, name), nil
+	case rootcoordpb.ExportFormat_ExportNumpy:
+		return newNumpyWriter(fields, name)
+	case rootcoordpb.ExportFormat_ExportParquet:
+		return newParquetWriter(fields, name)
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format.String())
+	}
+}
+
+func exportedFields(schema *schemapb.CollectionSchema) []*schemapb.FieldSchema {
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() == common.RowIDField || field.GetFieldID() == common.TimeStampField {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func getFieldData(data *storage.InsertData, field *schemapb.FieldSchema) (storage.FieldData, error) {
+	fieldData, ok := data.Data[field.GetFieldID()]
+	if !ok {
+		return nil, fmt.Errorf("the data of field '%s' is missing", field.GetName())
+	}
+	return fieldData, nil
+}
+
+// isNull returns true if the i-th row of the nullable field data is null.
+func isNull(data storage.FieldData, i int) bool {
+	nullable, ok := data.(storage.NullableFieldData)
+	if !ok {
+		return false
+	}
+	validData := nullable.GetValidData()
+	return len(validData) > 0 && !validData[i]
+}
+
+// arrayElements returns the elements of the value of an array field.
+func arrayElements(value *schemapb.ScalarField) (interface{}, error) {
+	switch v := value.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		return v.BoolData.GetData(), nil
+	case *schemapb.ScalarField_IntData:
+		return v.IntData.GetData(), nil
+	case *schemapb.ScalarField_LongData:
+		return v.LongData.GetData(), nil
+	case *schemapb.ScalarField_FloatData:
+		return v.FloatData.GetData(), nil
+	case *schemapb.ScalarField_DoubleData:
+		return v.DoubleData.GetData(), nil
+	case *schemapb.ScalarField_StringData:
+		return v.StringData.GetData(), nil
+	case nil:
+		return []interface{}{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported element type of array: %T", v)
+	}
+}
@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+func sampleSchema(withArray bool) *schemapb.CollectionSchema {
+	schema := &schemapb.CollectionSchema{
+		Name: "export",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "id", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "name", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{
+				{Key: "max_length", Value: "64"},
+				{Key: common.NullableKey, Value: "true"},
+			}},
+			{FieldID: 102, Name: "meta", DataType: typeutil.DataTypeJSON},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
+				{Key: "dim", Value: "2"},
+			}},
+			{FieldID: 104, Name: "bin", DataType: schemapb.DataType_BinaryVector, TypeParams: []*commonpb.KeyValuePair{
+				{Key: "dim", Value: "16"},
+			}},
+		},
+	}
+	if withArray {
+		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+			FieldID: 105, Name: "tags", DataType: typeutil.DataTypeArray, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.ElementTypeKey, Value: schemapb.DataType_Int64.String()},
+				{Key: "max_capacity", Value: "8"},
+			},
+		})
+	}
+	return schema
+}
+
+func sampleInsertData(withArray bool, withNull bool) *storage.InsertData {
+	data := &storage.InsertData{Data: map[storage.FieldID]storage.FieldData{
+		common.RowIDField:     &storage.Int64FieldData{Data: []int64{1, 2, 3}},
+		common.TimeStampField: &storage.Int64FieldData{Data: []int64{10, 20, 30}},
+		100:                   &storage.Int64FieldData{Data: []int64{1000, 2000, 3000}},
+		101:                   &storage.StringFieldData{Data: []string{"a", "b", "c"}},
+		102:                   &storage.JSONFieldData{Data: [][]byte{[]byte(`{"x":1}`), []byte(`{"x":2}`), []byte(`{"x":3}`)}},
+		103:                   &storage.FloatVectorFieldData{Data: []float32{1, 1, 2, 2, 3, 3}, Dim: 2},
+		104:                   &storage.BinaryVectorFieldData{Data: []byte{1, 2, 3, 4, 5, 6}, Dim: 16},
+	}}
+	if withArray {
+		data.Data[105] = &storage.ArrayFieldData{
+			ElementType: schemapb.DataType_Int64,
+			Data: []*schemapb.ScalarField{
+				{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1}}}},
+				{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{2, 2}}}},
+				{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{3, 3, 3}}}},
+			},
+		}
+	}
+	if withNull {
+		data.Data[101].(*storage.StringFieldData).ValidData = []bool{true, true, false}
+	}
+	return data
+}
+
+func TestNewWriter(t *testing.T) {
+	schema := sampleSchema(true)
+
+	_, err := NewWriter(rootcoordpb.ExportFormat(100), schema, "1")
+	assert.Error(t, err)
+
+	_, err = NewWriter(rootcoordpb.ExportFormat_ExportJSON, schema, "")
+	assert.Error(t, err)
+
+	_, err = NewWriter(rootcoordpb.ExportFormat_ExportJSON, &schemapb.CollectionSchema{Fields: schema.GetFields()[:2]}, "1")
+	assert.Error(t, err)
+
+	// numpy files don't support array fields
+	_, err = NewWriter(rootcoordpb.ExportFormat_ExportNumpy, schema, "1")
+	assert.Error(t, err)
+
+	for _, format := range []rootcoordpb.ExportFormat{rootcoordpb.ExportFormat_ExportJSON, rootcoordpb.ExportFormat_ExportParquet} {
+		w, err := NewWriter(format, schema, "1")
+		assert.NoError(t, err)
+		assert.NotNil(t, w)
+
+		// the data of the fields must be complete
+		err = w.Write(&storage.InsertData{Data: map[storage.FieldID]storage.FieldData{}}, []int{0})
+		assert.Error(t, err)
+	}
+}
+
+func TestExportedFields(t *testing.T) {
+	fields := exportedFields(sampleSchema(false))
+	assert.Equal(t, 5, len(fields))
+	for _, field := range fields {
+		assert.GreaterOrEqual(t, field.GetFieldID(), int64(common.StartOfUserFieldID))
+	}
+}
@@ -328,6 +328,8 @@ func GetNumRowOfFieldData(fieldData *schemapb.FieldData) (uint64, error) {
 			fieldNumRows = getNumRowsOfScalarField(scalarField.GetDoubleData().Data)
 		case *schemapb.ScalarField_StringData:
 			fieldNumRows = getNumRowsOfScalarField(scalarField.GetStringData().Data)
+		case *schemapb.ScalarField_BytesData:
+			fieldNumRows = getNumRowsOfScalarField(scalarField.GetBytesData().Data)
 		default:
 			return 0, fmt.Errorf("%s is not supported now", scalarType)
 		}
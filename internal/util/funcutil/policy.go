@@ -97,3 +97,11 @@ func PolicyForPrivilege(roleName string, objectType string, objectName string, p
 func PolicyForResource(objectType string, objectName string) string {
 	return fmt.Sprintf("%s-%s", objectType, objectName)
 }
+
+// CombineObjectName returns the object name scoped by database, like "db1.col1".
+func CombineObjectName(dbName string, objectName string) string {
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	return fmt.Sprintf("%s.%s", dbName, objectName)
+}
@@ -69,3 +69,8 @@ func Test_PolicyForResource(t *testing.T) {
 		`COLLECTION-col1`,
 		PolicyForResource("COLLECTION", "col1"))
 }
+
+func Test_CombineObjectName(t *testing.T) {
+	assert.Equal(t, "db1.col1", CombineObjectName("db1", "col1"))
+	assert.Equal(t, "default.col1", CombineObjectName("", "col1"))
+}
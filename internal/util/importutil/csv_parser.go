@@ -0,0 +1,483 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+)
+
+// csvReader reads the records of a CSV file. Unlike the encoding/csv package which only accepts the double quote,
+// both the delimiter and the quote character are configurable. A quoted value can contain delimiters, line breaks,
+// and quote characters which are escaped by doubling them.
+type csvReader struct {
+	reader    *bufio.Reader
+	delimiter rune
+	quote     rune
+	line      int // the number of lines have been read
+}
+
+func newCSVReader(r io.Reader, delimiter rune, quote rune) *csvReader {
+	return &csvReader{
+		reader:    bufio.NewReader(r),
+		delimiter: delimiter,
+		quote:     quote,
+	}
+}
+
+// readLine returns the next line without the line break, returns io.EOF if there is no more line
+func (r *csvReader) readLine() (string, error) {
+	line, err := r.reader.ReadString('\n')
+	if err != nil && (err != io.EOF || len(line) == 0) {
+		return "", err
+	}
+	if r.line == 0 {
+		// the files saved by some spreadsheet softwares start with a byte order mark
+		line = strings.TrimPrefix(line, "\ufeff")
+	}
+	r.line++
+	line = strings.TrimSuffix(line, "\n")
+	line = strings.TrimSuffix(line, "\r")
+	return line, nil
+}
+
+// readRecord returns the values of the next record, whether each value is quoted, and the line number where
+// the record begins. Empty lines are skipped, returns io.EOF if there is no more record.
+func (r *csvReader) readRecord() ([]string, []bool, int, error) {
+	text := ""
+	for len(text) == 0 {
+		var err error
+		text, err = r.readLine()
+		if err != nil {
+			return nil, nil, 0, err
+		}
+	}
+	startLine := r.line
+
+	quoteLen := utf8.RuneLen(r.quote)
+	delimiterLen := utf8.RuneLen(r.delimiter)
+	values := make([]string, 0)
+	quoted := make([]bool, 0)
+	for {
+		if !strings.HasPrefix(text, string(r.quote)) {
+			// unquoted value ends with the delimiter or the line end
+			end := strings.IndexRune(text, r.delimiter)
+			value := text
+			if end >= 0 {
+				value = text[:end]
+			}
+			if strings.ContainsRune(value, r.quote) {
+				return nil, nil, 0, fmt.Errorf("bare quote %q in unquoted value at line %d", r.quote, r.line)
+			}
+			values = append(values, value)
+			quoted = append(quoted, false)
+			if end < 0 {
+				return values, quoted, startLine, nil
+			}
+			text = text[end+delimiterLen:]
+			continue
+		}
+
+		// quoted value ends with a single quote character, which may be in the following lines
+		valueLine := r.line
+		text = text[quoteLen:]
+		var value strings.Builder
+		for {
+			end := strings.IndexRune(text, r.quote)
+			if end < 0 {
+				value.WriteString(text)
+				value.WriteByte('\n')
+				var err error
+				text, err = r.readLine()
+				if err == io.EOF {
+					return nil, nil, 0, fmt.Errorf("quoted value at line %d is not closed", valueLine)
+				}
+				if err != nil {
+					return nil, nil, 0, err
+				}
+				continue
+			}
+			value.WriteString(text[:end])
+			text = text[end+quoteLen:]
+			if strings.HasPrefix(text, string(r.quote)) {
+				// escaped quote character
+				value.WriteRune(r.quote)
+				text = text[quoteLen:]
+				continue
+			}
+			break
+		}
+		values = append(values, value.String())
+		quoted = append(quoted, true)
+
+		// the closing quote must be followed by the delimiter or the line end
+		if len(text) == 0 {
+			return values, quoted, startLine, nil
+		}
+		if !strings.HasPrefix(text, string(r.delimiter)) {
+			return nil, nil, 0, fmt.Errorf("unexpected character after the closing quote at line %d", r.line)
+		}
+		text = text[delimiterLen:]
+	}
+}
+
+// CSVParser parses a CSV file whose first line is the header of field names. The values are converted to
+// the same form as the values decoded from a JSON file, so that the rows can be consumed by JSONRowHandler:
+// numbers are json.Number, vectors and arrays are JSON arrays, and JSON fields are JSON objects in text.
+type CSVParser struct {
+	ctx            context.Context // for canceling parse process
+	bufSize        int64           // max rows in a buffer
+	delimiter      rune
+	quote          rune
+	name2Field     map[string]*schemapb.FieldSchema
+	optionalFields map[storage.FieldID]struct{} // nullable or defaulted fields which could be missed
+}
+
+// NewCSVParser helper function to create a CSVParser, the default delimiter and quote are used if they are not set
+func NewCSVParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema, delimiter rune, quote rune) *CSVParser {
+	if delimiter == 0 {
+		delimiter = DefaultCSVDelimiter
+	}
+	if quote == 0 {
+		quote = DefaultCSVQuote
+	}
+
+	name2Field := make(map[string]*schemapb.FieldSchema)
+	optionalFields := make(map[storage.FieldID]struct{})
+	for i := 0; i < len(collectionSchema.Fields); i++ {
+		schema := collectionSchema.Fields[i]
+		// RowIDField and TimeStampField is internal field, no need to parse
+		if schema.GetFieldID() == common.RowIDField || schema.GetFieldID() == common.TimeStampField {
+			continue
+		}
+
+		name2Field[schema.GetName()] = schema
+		if typeutil.HasDefaultValue(schema) {
+			optionalFields[schema.GetFieldID()] = struct{}{}
+		}
+	}
+
+	return &CSVParser{
+		ctx:            ctx,
+		bufSize:        estimateBufSize(collectionSchema),
+		delimiter:      delimiter,
+		quote:          quote,
+		name2Field:     name2Field,
+		optionalFields: optionalFields,
+	}
+}
+
+// parseHeader returns the fields corresponding to the columns of the header
+func (p *CSVParser) parseHeader(header []string, line int) ([]*schemapb.FieldSchema, error) {
+	columns := make([]*schemapb.FieldSchema, 0, len(header))
+	provided := make(map[string]struct{})
+	for _, name := range header {
+		name = strings.TrimSpace(name)
+		field, ok := p.name2Field[name]
+		if !ok {
+			log.Error("CSV parser: the column is not defined in collection schema", zap.String("columnName", name))
+			return nil, fmt.Errorf("the column '%s' in the header at line %d is not defined in collection schema", name, line)
+		}
+		if field.GetAutoID() {
+			log.Error("CSV parser: the primary key is auto-generated, no need to provide", zap.String("fieldName", name))
+			return nil, fmt.Errorf("the primary key '%s' in the header at line %d is auto-generated, no need to provide", name, line)
+		}
+		if _, ok := provided[name]; ok {
+			log.Error("CSV parser: duplicate column", zap.String("columnName", name))
+			return nil, fmt.Errorf("duplicate column '%s' in the header at line %d", name, line)
+		}
+		provided[name] = struct{}{}
+		columns = append(columns, field)
+	}
+
+	for name, field := range p.name2Field {
+		_, optional := p.optionalFields[field.GetFieldID()]
+		if _, ok := provided[name]; ok || optional || field.GetAutoID() {
+			continue
+		}
+		log.Error("CSV parser: a field is missed in the header", zap.String("fieldName", name))
+		return nil, fmt.Errorf("there is no column corresponding to field '%s' in the header at line %d", name, line)
+	}
+
+	return columns, nil
+}
+
+func (p *CSVParser) ParseRows(r io.Reader, handler JSONRowHandler) error {
+	if handler == nil {
+		log.Error("CSV parse handler is nil")
+		return errors.New("CSV parse handler is nil")
+	}
+	if p.delimiter == p.quote || p.delimiter == '\r' || p.delimiter == '\n' || p.quote == '\r' || p.quote == '\n' {
+		log.Error("CSV parser: illegal delimiter or quote", zap.String("delimiter", string(p.delimiter)),
+			zap.String("quote", string(p.quote)))
+		return fmt.Errorf("illegal delimiter %q or quote %q", p.delimiter, p.quote)
+	}
+
+	reader := newCSVReader(r, p.delimiter, p.quote)
+	header, _, headerLine, err := reader.readRecord()
+	if err == io.EOF {
+		log.Error("CSV parser: the header is not found")
+		return errors.New("the header is not found, the first line of CSV file should be the field names")
+	}
+	if err != nil {
+		log.Error("CSV parser: failed to read the header", zap.Error(err))
+		return fmt.Errorf("failed to read the header, error: %w", err)
+	}
+
+	columns, err := p.parseHeader(header, headerLine)
+	if err != nil {
+		return err
+	}
+	convertFuncs := make([]func(value string) (interface{}, error), 0, len(columns))
+	for _, field := range columns {
+		convertFunc, err := csvConvertFunc(field)
+		if err != nil {
+			log.Error("CSV parser: unsupported field", zap.String("fieldName", field.GetName()), zap.Error(err))
+			return err
+		}
+		convertFuncs = append(convertFuncs, convertFunc)
+	}
+
+	isEmpty := true
+	firstLine := 0
+	buf := make([]map[storage.FieldID]interface{}, 0, MinBufferSize)
+	handle := func(lastLine int) error {
+		isEmpty = false
+		if err := handler.Handle(buf); err != nil {
+			log.Error("CSV parser: failed to convert row value to entity", zap.Int("firstLine", firstLine),
+				zap.Int("lastLine", lastLine), zap.Error(err))
+			return fmt.Errorf("failed to convert row value between line %d and line %d to entity, error: %w",
+				firstLine, lastLine, err)
+		}
+
+		// clear the buffer
+		buf = make([]map[storage.FieldID]interface{}, 0, MinBufferSize)
+
+		// outside context might be canceled(service stop, or future enhancement for canceling import task)
+		if isCanceled(p.ctx) {
+			log.Error("CSV parser: import task was canceled")
+			return errors.New("import task was canceled")
+		}
+		return nil
+	}
+
+	lastLine := headerLine
+	for {
+		values, quoted, line, err := reader.readRecord()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Error("CSV parser: failed to read row", zap.Error(err))
+			return fmt.Errorf("failed to read row, error: %w", err)
+		}
+		if len(values) != len(columns) {
+			log.Error("CSV parser: the count of values doesn't equal to the count of columns", zap.Int("line", line),
+				zap.Int("valueCount", len(values)), zap.Int("columnCount", len(columns)))
+			return fmt.Errorf("the row at line %d has %d values, but the header has %d columns", line, len(values), len(columns))
+		}
+
+		row := make(map[storage.FieldID]interface{}, len(columns))
+		for i, field := range columns {
+			// an unquoted empty value of nullable or defaulted field is a missing value
+			if _, optional := p.optionalFields[field.GetFieldID()]; optional && !quoted[i] && len(values[i]) == 0 {
+				continue
+			}
+			value, err := convertFuncs[i](values[i])
+			if err != nil {
+				log.Error("CSV parser: failed to parse value", zap.String("fieldName", field.GetName()),
+					zap.Int("line", line), zap.Error(err))
+				return fmt.Errorf("failed to parse the value of field '%s' at line %d, error: %w", field.GetName(), line, err)
+			}
+			row[field.GetFieldID()] = value
+		}
+
+		if len(buf) == 0 {
+			firstLine = line
+		}
+		buf = append(buf, row)
+		lastLine = reader.line
+		if len(buf) >= int(p.bufSize) {
+			if err := handle(lastLine); err != nil {
+				return err
+			}
+		}
+	}
+
+	// some rows in buffer not parsed, parse them
+	if len(buf) > 0 {
+		if err := handle(lastLine); err != nil {
+			return err
+		}
+	}
+
+	if isEmpty {
+		log.Error("CSV parser: row count is 0")
+		return errors.New("row count is 0")
+	}
+
+	// send nil to notify the handler all have done
+	return handler.Handle(nil)
+}
+
+// decodeJSONArray decodes a JSON array, the numbers in the array are json.Number
+func decodeJSONArray(value string) ([]interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(value))
+	dec.UseNumber()
+	var arr []interface{}
+	if err := dec.Decode(&arr); err != nil {
+		return nil, fmt.Errorf("'%s' is not a JSON array, error: %w", value, err)
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("'%s' is not a JSON array, unexpected content after the array", value)
+	}
+	return arr, nil
+}
+
+// csvConvertFunc returns the function to convert the text of a CSV value to the form decoded from a JSON file,
+// the values are fully validated so that errors can be reported with line numbers
+func csvConvertFunc(field *schemapb.FieldSchema) (func(value string) (interface{}, error), error) {
+	fieldName := field.GetName()
+	parseInt := func(bitSize int) func(value string) (interface{}, error) {
+		return func(value string) (interface{}, error) {
+			value = strings.TrimSpace(value)
+			if _, err := strconv.ParseInt(value, 0, bitSize); err != nil {
+				return nil, fmt.Errorf("illegal value '%s' for int%d type field '%s'", value, bitSize, fieldName)
+			}
+			return json.Number(value), nil
+		}
+	}
+	parseFloatNumber := func(bitSize int) func(value string) (interface{}, error) {
+		return func(value string) (interface{}, error) {
+			value = strings.TrimSpace(value)
+			if _, err := parseFloat(value, bitSize, fieldName); err != nil {
+				return nil, err
+			}
+			return json.Number(value), nil
+		}
+	}
+
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return func(value string) (interface{}, error) {
+			b, err := strconv.ParseBool(strings.TrimSpace(value))
+			if err != nil {
+				return nil, fmt.Errorf("illegal value '%s' for bool type field '%s'", value, fieldName)
+			}
+			return b, nil
+		}, nil
+	case schemapb.DataType_Int8:
+		return parseInt(8), nil
+	case schemapb.DataType_Int16:
+		return parseInt(16), nil
+	case schemapb.DataType_Int32:
+		return parseInt(32), nil
+	case schemapb.DataType_Int64:
+		return parseInt(64), nil
+	case schemapb.DataType_Float:
+		return parseFloatNumber(32), nil
+	case schemapb.DataType_Double:
+		return parseFloatNumber(64), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return func(value string) (interface{}, error) {
+			return value, nil
+		}, nil
+	case typeutil.DataTypeJSON:
+		return func(value string) (interface{}, error) {
+			if _, err := parseJSONValue(value, fieldName); err != nil {
+				return nil, err
+			}
+			return value, nil
+		}, nil
+	case typeutil.DataTypeArray:
+		return func(value string) (interface{}, error) {
+			arr, err := decodeJSONArray(value)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := parseArrayValue(arr, field); err != nil {
+				return nil, err
+			}
+			return arr, nil
+		}, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := getFieldDimension(field)
+		if err != nil {
+			return nil, err
+		}
+		return func(value string) (interface{}, error) {
+			arr, err := decodeJSONArray(value)
+			if err != nil {
+				return nil, err
+			}
+			// we use uint8 to represent binary vector, each uint8 value represents 8 dimensions.
+			if len(arr)*8 != dim {
+				return nil, fmt.Errorf("bit size %d doesn't equal to vector dimension %d of field '%s'", len(arr)*8, dim, fieldName)
+			}
+			for _, element := range arr {
+				num, ok := element.(json.Number)
+				if !ok {
+					return nil, fmt.Errorf("illegal value '%v' for binary vector field '%s'", element, fieldName)
+				}
+				if _, err := strconv.ParseUint(string(num), 0, 8); err != nil {
+					return nil, fmt.Errorf("failed to parse value '%v' for binary vector field '%s', error: %w", num, fieldName, err)
+				}
+			}
+			return arr, nil
+		}, nil
+	case schemapb.DataType_FloatVector:
+		dim, err := getFieldDimension(field)
+		if err != nil {
+			return nil, err
+		}
+		return func(value string) (interface{}, error) {
+			arr, err := decodeJSONArray(value)
+			if err != nil {
+				return nil, err
+			}
+			if len(arr) != dim {
+				return nil, fmt.Errorf("array size %d doesn't equal to vector dimension %d of field '%s'", len(arr), dim, fieldName)
+			}
+			for _, element := range arr {
+				num, ok := element.(json.Number)
+				if !ok {
+					return nil, fmt.Errorf("illegal value '%v' for float vector field '%s'", element, fieldName)
+				}
+				if _, err := parseFloat(string(num), 32, fieldName); err != nil {
+					return nil, err
+				}
+			}
+			return arr, nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupport data type: %s", getTypeName(field.GetDataType()))
+	}
+}
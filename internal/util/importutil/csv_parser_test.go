@@ -0,0 +1,288 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const sampleCSVHeader = "FieldBool,FieldInt8,FieldInt16,FieldInt32,FieldInt64,FieldFloat,FieldDouble,FieldString,FieldBinaryVector,FieldFloatVector\n"
+
+func Test_CSVReaderReadRecord(t *testing.T) {
+	readAll := func(content string, delimiter rune, quote rune) ([][]string, [][]bool, []int, error) {
+		reader := newCSVReader(strings.NewReader(content), delimiter, quote)
+		records := make([][]string, 0)
+		quotes := make([][]bool, 0)
+		lines := make([]int, 0)
+		for {
+			values, quoted, line, err := reader.readRecord()
+			if err == io.EOF {
+				return records, quotes, lines, nil
+			}
+			if err != nil {
+				return records, quotes, lines, err
+			}
+			records = append(records, values)
+			quotes = append(quotes, quoted)
+			lines = append(lines, line)
+		}
+	}
+
+	t.Run("default delimiter and quote", func(t *testing.T) {
+		content := "\ufeffa,b,c\r\n1,\"x,y\",\n\n2,\"he said \"\"hi\"\"\",\"\"\n3,\"multi\nline\",z"
+		records, quotes, lines, err := readAll(content, ',', '"')
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"a", "b", "c"},
+			{"1", "x,y", ""},
+			{"2", "he said \"hi\"", ""},
+			{"3", "multi\nline", "z"},
+		}, records)
+		assert.Equal(t, []bool{false, true, true}, quotes[2])
+		assert.Equal(t, []int{1, 2, 4, 5}, lines)
+	})
+
+	t.Run("custom delimiter and quote", func(t *testing.T) {
+		records, _, _, err := readAll("a|b\n'1|2'|'it''s'\n", '|', '\'')
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"a", "b"}, {"1|2", "it's"}}, records)
+	})
+
+	t.Run("illegal quotes", func(t *testing.T) {
+		_, _, _, err := readAll("a,b\n1,x\"y\n", ',', '"')
+		assert.ErrorContains(t, err, "at line 2")
+
+		_, _, _, err = readAll("a,b\n1,\"xy\"z\n", ',', '"')
+		assert.ErrorContains(t, err, "at line 2")
+
+		_, _, _, err = readAll("a,b\n\n1,\"xy\nz", ',', '"')
+		assert.ErrorContains(t, err, "at line 3")
+	})
+}
+
+func Test_NewCSVParser(t *testing.T) {
+	ctx := context.Background()
+
+	parser := NewCSVParser(ctx, sampleSchema(), 0, 0)
+	assert.NotNil(t, parser)
+	assert.Equal(t, rune(DefaultCSVDelimiter), parser.delimiter)
+	assert.Equal(t, rune(DefaultCSVQuote), parser.quote)
+	assert.Equal(t, estimateBufSize(sampleSchema()), parser.bufSize)
+
+	parser = NewCSVParser(ctx, sampleSchema(), '\t', '\'')
+	assert.Equal(t, '\t', parser.delimiter)
+	assert.Equal(t, '\'', parser.quote)
+}
+
+func Test_CSVParserParseRows(t *testing.T) {
+	ctx := context.Background()
+	schema := sampleSchema()
+
+	content := sampleCSVHeader +
+		"true,10,101,1001,10001,3.14,1.56,hello world,\"[254, 0]\",\"[1.1, 1.2, 1.3, 1.4]\"\n" +
+		"false,11,102,1002,10002,3.15,2.56,\"hello, world\",\"[253, 0]\",\"[2.1, 2.2, 2.3, 2.4]\"\n" +
+		"\n" +
+		"true,12,103,1003,10003,3.16,3.56,\"\",\"[252, 0]\",\"[3.1, 3.2, 3.3, 3.4]\"\n"
+
+	t.Run("parse success", func(t *testing.T) {
+		parser := NewCSVParser(ctx, schema, 0, 0)
+		// set bufSize = 2, means call handle() after reading 2 rows
+		parser.bufSize = 2
+		consumer := &mockJSONRowConsumer{}
+		err := parser.ParseRows(strings.NewReader(content), consumer)
+		assert.NoError(t, err)
+		// 2 batches and a nil to notify the end
+		assert.Equal(t, 3, consumer.handleCount)
+		assert.Equal(t, 3, len(consumer.rows))
+
+		row := consumer.rows[1]
+		assert.Equal(t, false, row[102])
+		assert.Equal(t, json.Number("11"), row[103])
+		assert.Equal(t, json.Number("10002"), row[106])
+		assert.Equal(t, json.Number("2.56"), row[108])
+		assert.Equal(t, "hello, world", row[109])
+		assert.Equal(t, []interface{}{json.Number("253"), json.Number("0")}, row[110])
+		assert.Equal(t, 4, len(row[111].([]interface{})))
+		assert.Equal(t, "", consumer.rows[2][109])
+	})
+
+	t.Run("custom delimiter and quote", func(t *testing.T) {
+		content := strings.ReplaceAll(strings.ReplaceAll(content, ",", ";"), "\"", "'")
+		content = strings.ReplaceAll(content, "; ", ", ")
+		parser := NewCSVParser(ctx, schema, ';', '\'')
+		consumer := &mockJSONRowConsumer{}
+		err := parser.ParseRows(strings.NewReader(content), consumer)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(consumer.rows))
+		assert.Equal(t, "hello, world", consumer.rows[1][109])
+	})
+
+	t.Run("illegal content", func(t *testing.T) {
+		parse := func(content string) error {
+			parser := NewCSVParser(ctx, schema, 0, 0)
+			return parser.ParseRows(strings.NewReader(content), &mockJSONRowConsumer{})
+		}
+		row := "true,10,101,1001,10001,3.14,1.56,hello world,\"[254, 0]\",\"[1.1, 1.2, 1.3, 1.4]\"\n"
+
+		// empty file or no rows
+		assert.Error(t, parse(""))
+		assert.ErrorContains(t, parse(sampleCSVHeader), "row count is 0")
+
+		// unknown column, duplicate column, missing column
+		assert.ErrorContains(t, parse("dummy,"+sampleCSVHeader), "'dummy'")
+		assert.ErrorContains(t, parse("FieldBool,"+sampleCSVHeader), "duplicate column 'FieldBool'")
+		assert.ErrorContains(t, parse(strings.TrimPrefix(sampleCSVHeader, "FieldBool,")), "'FieldBool'")
+
+		// the count of values mismatch
+		assert.ErrorContains(t, parse(sampleCSVHeader+row+"\n\ntrue,10\n"), "at line 5")
+
+		// illegal values
+		illegalValues := map[string]string{
+			"FieldBool":         "yes",
+			"FieldInt8":         "128",
+			"FieldInt16":        "",
+			"FieldInt32":        "1.5",
+			"FieldInt64":        "a",
+			"FieldFloat":        "NaN",
+			"FieldDouble":       "1e400",
+			"FieldBinaryVector": "\"[256, 0]\"",
+			"FieldFloatVector":  "\"[1.1, 1.2, 1.3]\"",
+		}
+		header := strings.Split(strings.TrimSuffix(sampleCSVHeader, "\n"), ",")
+		for name, value := range illegalValues {
+			values := []string{"true", "10", "101", "1001", "10001", "3.14", "1.56", "hello world", "\"[254, 0]\"", "\"[1.1, 1.2, 1.3, 1.4]\""}
+			for i := range header {
+				if header[i] == name {
+					values[i] = value
+				}
+			}
+			err := parse(sampleCSVHeader + row + strings.Join(values, ",") + "\n")
+			assert.ErrorContains(t, err, "failed to parse the value of field '"+name+"' at line 3")
+		}
+
+		// vector is not a JSON array
+		assert.ErrorContains(t, parse(sampleCSVHeader+"true,10,101,1001,10001,3.14,1.56,a,254,\"[1.1, 1.2, 1.3, 1.4]\"\n"),
+			"at line 2")
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		parser := NewCSVParser(ctx, schema, 0, 0)
+		err := parser.ParseRows(strings.NewReader(content), nil)
+		assert.Error(t, err)
+
+		err = parser.ParseRows(strings.NewReader(content), &mockJSONRowConsumer{handleErr: errors.New("error")})
+		assert.ErrorContains(t, err, "between line 2 and line 5")
+	})
+
+	t.Run("illegal delimiter", func(t *testing.T) {
+		parser := NewCSVParser(ctx, schema, '"', '"')
+		err := parser.ParseRows(strings.NewReader(content), &mockJSONRowConsumer{})
+		assert.Error(t, err)
+	})
+
+	t.Run("auto-generated primary key", func(t *testing.T) {
+		schema := sampleSchema()
+		schema.Fields[4].AutoID = true
+		parser := NewCSVParser(ctx, schema, 0, 0)
+		err := parser.ParseRows(strings.NewReader(content), &mockJSONRowConsumer{})
+		assert.Error(t, err)
+
+		// no need to provide the primary key
+		content := strings.NewReplacer("FieldInt64,", "", "10001,", "", "10002,", "", "10003,", "").Replace(content)
+		consumer := &mockJSONRowConsumer{}
+		err = parser.ParseRows(strings.NewReader(content), consumer)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(consumer.rows))
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		parser := NewCSVParser(cancelCtx, schema, 0, 0)
+		err := parser.ParseRows(strings.NewReader(content), &mockJSONRowConsumer{})
+		assert.Error(t, err)
+	})
+}
+
+func Test_CSVParserNullableJSONArray(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "ID", IsPrimaryKey: true, DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{
+				{Key: "max_length", Value: "64"},
+			}},
+			{FieldID: 101, Name: "FieldInt32", DataType: schemapb.DataType_Int32, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.NullableKey, Value: "true"},
+			}},
+			{FieldID: 102, Name: "FieldJSON", DataType: typeutil.DataTypeJSON},
+			{FieldID: 103, Name: "FieldArray", DataType: typeutil.DataTypeArray, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.ElementTypeKey, Value: schemapb.DataType_Int64.String()},
+				{Key: common.MaxCapacityKey, Value: "3"},
+			}},
+		},
+	}
+
+	content := "ID,FieldInt32,FieldJSON,FieldArray\n" +
+		"a,1,\"{\"\"x\"\": 1}\",\"[1, 2, 3]\"\n" +
+		"b,,\"{}\",[]\n"
+	parser := NewCSVParser(ctx, schema, 0, 0)
+	consumer := &mockJSONRowConsumer{}
+	err := parser.ParseRows(strings.NewReader(content), consumer)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(consumer.rows))
+	assert.Equal(t, "a", consumer.rows[0][100])
+	assert.Equal(t, json.Number("1"), consumer.rows[0][101])
+	assert.Equal(t, `{"x": 1}`, consumer.rows[0][102])
+	assert.Equal(t, []interface{}{json.Number("1"), json.Number("2"), json.Number("3")}, consumer.rows[0][103])
+	// the empty value of nullable field is missing
+	_, ok := consumer.rows[1][101]
+	assert.False(t, ok)
+	assert.Equal(t, []interface{}{}, consumer.rows[1][103])
+
+	// the nullable column is optional
+	content = "ID,FieldJSON,FieldArray\n" +
+		"a,{},[]\n"
+	consumer = &mockJSONRowConsumer{}
+	err = parser.ParseRows(strings.NewReader(content), consumer)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(consumer.rows))
+
+	// illegal JSON
+	content = "ID,FieldInt32,FieldJSON,FieldArray\n" +
+		"a,1,x,[]\n"
+	err = parser.ParseRows(strings.NewReader(content), &mockJSONRowConsumer{})
+	assert.ErrorContains(t, err, "'FieldJSON' at line 2")
+
+	// the array exceeds the max capacity
+	content = "ID,FieldInt32,FieldJSON,FieldArray\n" +
+		"a,1,{},\"[1, 2, 3, 4]\"\n"
+	err = parser.ParseRows(strings.NewReader(content), &mockJSONRowConsumer{})
+	assert.ErrorContains(t, err, "'FieldArray' at line 2")
+}
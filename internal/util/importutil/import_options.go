@@ -18,9 +18,11 @@ package importutil
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -29,12 +31,21 @@ import (
 
 // Extra option keys to pass through import API
 const (
-	Bucket       = "bucket"   // the source files' minio bucket
-	StartTs      = "start_ts" // start timestamp to filter data, only data between StartTs and EndTs will be imported
-	EndTs        = "end_ts"   // end timestamp to filter data, only data between StartTs and EndTs will be imported
+	Bucket       = "bucket"        // the source files' minio bucket
+	StartTs      = "start_ts"      // start timestamp to filter data, only data between StartTs and EndTs will be imported
+	EndTs        = "end_ts"        // end timestamp to filter data, only data between StartTs and EndTs will be imported
+	EndTso       = "end_tso"       // end hybrid timestamp to filter data, overrides EndTs, used to restore the backups exactly
+	CSVDelimiter = "csv_delimiter" // the character to separate the values of a CSV file, default ','
+	CSVQuote     = "csv_quote"     // the character to quote the values of a CSV file, default '"'
 	OptionFormat = "start_ts: 10-digit physical timestamp, e.g. 1665995420, default 0 \n" +
-		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n"
+		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n" +
+		"end_tso: hybrid timestamp, e.g. 437893657890816000, overrides end_ts \n" +
+		"csv_delimiter: a single character, default ',' \n" +
+		"csv_quote: a single character which is different from csv_delimiter, default '\"' \n"
 	BackupFlag = "backup"
+
+	DefaultCSVDelimiter = ','
+	DefaultCSVQuote     = '"'
 )
 
 type ImportOptions struct {
@@ -42,6 +53,8 @@ type ImportOptions struct {
 	TsStartPoint uint64
 	TsEndPoint   uint64
 	IsBackup     bool // whether is triggered by backup tool
+	CSVDelimiter rune // the delimiter of CSV files, DefaultCSVDelimiter if not set
+	CSVQuote     rune // the quote character of CSV files, DefaultCSVQuote if not set
 }
 
 func DefaultImportOptions() ImportOptions {
@@ -49,6 +62,8 @@ func DefaultImportOptions() ImportOptions {
 		OnlyValidate: false,
 		TsStartPoint: 0,
 		TsEndPoint:   math.MaxUint64,
+		CSVDelimiter: DefaultCSVDelimiter,
+		CSVQuote:     DefaultCSVQuote,
 	}
 	return options
 }
@@ -57,6 +72,8 @@ func DefaultImportOptions() ImportOptions {
 // Illegal options:
 //     start_ts: 10-digit physical timestamp, e.g. 1665995420
 //     end_ts: 10-digit physical timestamp, e.g. 1665995420
+//     end_tso: hybrid timestamp, e.g. 437893657890816000
+//     csv_delimiter, csv_quote: not a single character, or a line break, or the same character
 func ValidateOptions(options []*commonpb.KeyValuePair) error {
 	optionMap := funcutil.KeyValuePair2Map(options)
 	// StartTs should be int
@@ -81,7 +98,18 @@ func ValidateOptions(options []*commonpb.KeyValuePair) error {
 	if startTs > endTs {
 		return errors.New("start_ts shouldn't be larger than end_ts")
 	}
-	return nil
+	// EndTso should be uint64
+	if value, ok := optionMap[EndTso]; ok {
+		endTso, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		if tsoutil.ComposeTS(int64(startTs), 0) > endTso {
+			return errors.New("start_ts shouldn't be larger than end_tso")
+		}
+	}
+	_, _, err = ParseCSVFromOptions(options)
+	return err
 }
 
 // ParseTSFromOptions get (start_ts, end_ts, error) from input options.
@@ -108,6 +136,10 @@ func ParseTSFromOptions(options []*commonpb.KeyValuePair) (uint64, uint64, error
 	} else {
 		tsEnd = math.MaxUint64
 	}
+	value, ok = importOptions[EndTso]
+	if ok {
+		tsEnd, _ = strconv.ParseUint(value, 10, 64)
+	}
 	return tsStart, tsEnd, nil
 }
 
@@ -119,3 +151,33 @@ func IsBackup(options []*commonpb.KeyValuePair) bool {
 	}
 	return true
 }
+
+// ParseCSVFromOptions get (delimiter, quote, error) of CSV files from input options.
+// The delimiter and quote must be different single characters other than line breaks.
+func ParseCSVFromOptions(options []*commonpb.KeyValuePair) (rune, rune, error) {
+	importOptions := funcutil.KeyValuePair2Map(options)
+	parseChar := func(key string, defaultValue rune) (rune, error) {
+		value, ok := importOptions[key]
+		if !ok {
+			return defaultValue, nil
+		}
+		chars := []rune(value)
+		if len(chars) != 1 || chars[0] == '\r' || chars[0] == '\n' || chars[0] == utf8.RuneError {
+			return 0, fmt.Errorf("%s should be a single character other than line break, but get '%s'", key, value)
+		}
+		return chars[0], nil
+	}
+
+	delimiter, err := parseChar(CSVDelimiter, DefaultCSVDelimiter)
+	if err != nil {
+		return 0, 0, err
+	}
+	quote, err := parseChar(CSVQuote, DefaultCSVQuote)
+	if err != nil {
+		return 0, 0, err
+	}
+	if delimiter == quote {
+		return 0, 0, fmt.Errorf("%s and %s should be different characters", CSVDelimiter, CSVQuote)
+	}
+	return delimiter, quote, nil
+}
@@ -51,6 +51,24 @@ func TestValidateOptions(t *testing.T) {
 		{Key: "start_ts", Value: "3.14"},
 		{Key: "end_ts", Value: "1666007457"},
 	}))
+	assert.NoError(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "start_ts", Value: "1666007457"},
+		{Key: "end_tso", Value: "436733858807809"},
+	}))
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "start_ts", Value: "1666007457"},
+		{Key: "end_tso", Value: "436733858807807"},
+	}))
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "end_tso", Value: "-1"},
+	}))
+	assert.NoError(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_delimiter", Value: "\t"},
+		{Key: "csv_quote", Value: "'"},
+	}))
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_delimiter", Value: ";;"},
+	}))
 }
 
 func TestParseTSFromOptions(t *testing.T) {
@@ -79,6 +97,15 @@ func TestParseTSFromOptions(t *testing.T) {
 	assert.Equal(t, uint64(436733858807808), tsEnd)
 	assert.NoError(t, err)
 
+	// the hybrid timestamp is used exactly
+	tsStart, tsEnd, err = ParseTSFromOptions([]*commonpb.KeyValuePair{
+		{Key: "end_ts", Value: "1666007457"},
+		{Key: "end_tso", Value: "436733858807809"},
+	})
+	assert.Equal(t, uint64(0), tsStart)
+	assert.Equal(t, uint64(436733858807809), tsEnd)
+	assert.NoError(t, err)
+
 	tsStart, tsEnd, err = ParseTSFromOptions([]*commonpb.KeyValuePair{
 		{Key: "start_ts", Value: "2"},
 		{Key: "end_ts", Value: "1"},
@@ -88,6 +115,37 @@ func TestParseTSFromOptions(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestParseCSVFromOptions(t *testing.T) {
+	delimiter, quote, err := ParseCSVFromOptions([]*commonpb.KeyValuePair{})
+	assert.NoError(t, err)
+	assert.Equal(t, ',', delimiter)
+	assert.Equal(t, '"', quote)
+
+	delimiter, quote, err = ParseCSVFromOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_delimiter", Value: "|"},
+		{Key: "csv_quote", Value: "'"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, '|', delimiter)
+	assert.Equal(t, '\'', quote)
+
+	_, _, err = ParseCSVFromOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_delimiter", Value: ""},
+	})
+	assert.Error(t, err)
+
+	_, _, err = ParseCSVFromOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_quote", Value: "\n"},
+	})
+	assert.Error(t, err)
+
+	_, _, err = ParseCSVFromOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_delimiter", Value: "'"},
+		{Key: "csv_quote", Value: "'"},
+	})
+	assert.Error(t, err)
+}
+
 func TestIsBackup(t *testing.T) {
 	isBackup := IsBackup([]*commonpb.KeyValuePair{
 		{Key: "backup", Value: "true"},
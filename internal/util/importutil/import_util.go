@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func isCanceled(ctx context.Context) bool {
@@ -113,6 +114,22 @@ func initSegmentData(collectionSchema *schemapb.CollectionSchema) map[storage.Fi
 				Data:    make([]string, 0),
 				NumRows: []int64{0},
 			}
+		case typeutil.DataTypeJSON:
+			segmentData[schema.GetFieldID()] = &storage.JSONFieldData{
+				Data:    make([][]byte, 0),
+				NumRows: []int64{0},
+			}
+		case typeutil.DataTypeArray:
+			elementType, err := typeutil.GetArrayElementType(schema)
+			if err != nil {
+				log.Error("Import util: illegal array field", zap.Error(err))
+				return nil
+			}
+			segmentData[schema.GetFieldID()] = &storage.ArrayFieldData{
+				ElementType: elementType,
+				Data:        make([]*schemapb.ScalarField, 0),
+				NumRows:     []int64{0},
+			}
 		default:
 			log.Error("Import util: unsupported data type", zap.String("DataType", getTypeName(schema.DataType)))
 			return nil
@@ -136,6 +153,128 @@ func parseFloat(s string, bitsize int, fieldName string) (float64, error) {
 	return value, nil
 }
 
+// parseJSONValue converts a value of JSON field to the document to be stored.
+// The value could be a JSON object, or a string holding a JSON object.
+func parseJSONValue(obj interface{}, fieldName string) ([]byte, error) {
+	switch value := obj.(type) {
+	case string:
+		var dummy map[string]interface{}
+		if err := json.Unmarshal([]byte(value), &dummy); err != nil {
+			return nil, fmt.Errorf("illegal value '%v' for JSON type field '%s', error: %w", obj, fieldName, err)
+		}
+		return []byte(value), nil
+	case map[string]interface{}:
+		bytes, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal value '%v' for JSON type field '%s', error: %w", obj, fieldName, err)
+		}
+		return bytes, nil
+	default:
+		return nil, fmt.Errorf("illegal value '%v' for JSON type field '%s'", obj, fieldName)
+	}
+}
+
+// parseArrayValue converts a list value of array field to a schemapb.ScalarField of the element type.
+func parseArrayValue(obj interface{}, schema *schemapb.FieldSchema) (*schemapb.ScalarField, error) {
+	values, ok := obj.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("illegal value '%v' for array type field '%s'", obj, schema.GetName())
+	}
+	elementType, err := typeutil.GetArrayElementType(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	illegalElement := func(value interface{}) error {
+		return fmt.Errorf("illegal element '%v' for array type field '%s', element type is %s", value, schema.GetName(), getTypeName(elementType))
+	}
+	parseInt := func(value interface{}, bitSize int) (int64, error) {
+		num, ok := value.(json.Number)
+		if !ok {
+			return 0, illegalElement(value)
+		}
+		return strconv.ParseInt(string(num), 0, bitSize)
+	}
+
+	array := &schemapb.ScalarField{}
+	switch elementType {
+	case schemapb.DataType_Bool:
+		data := make([]bool, 0, len(values))
+		for _, value := range values {
+			b, ok := value.(bool)
+			if !ok {
+				return nil, illegalElement(value)
+			}
+			data = append(data, b)
+		}
+		array.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		bitSize := map[schemapb.DataType]int{schemapb.DataType_Int8: 8, schemapb.DataType_Int16: 16, schemapb.DataType_Int32: 32}[elementType]
+		data := make([]int32, 0, len(values))
+		for _, value := range values {
+			n, err := parseInt(value, bitSize)
+			if err != nil {
+				return nil, illegalElement(value)
+			}
+			data = append(data, int32(n))
+		}
+		array.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case schemapb.DataType_Int64:
+		data := make([]int64, 0, len(values))
+		for _, value := range values {
+			n, err := parseInt(value, 64)
+			if err != nil {
+				return nil, illegalElement(value)
+			}
+			data = append(data, n)
+		}
+		array.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		floats := make([]float64, 0, len(values))
+		bitSize := 64
+		if elementType == schemapb.DataType_Float {
+			bitSize = 32
+		}
+		for _, value := range values {
+			num, ok := value.(json.Number)
+			if !ok {
+				return nil, illegalElement(value)
+			}
+			f, err := parseFloat(string(num), bitSize, schema.GetName())
+			if err != nil {
+				return nil, err
+			}
+			floats = append(floats, f)
+		}
+		if elementType == schemapb.DataType_Float {
+			data := make([]float32, 0, len(floats))
+			for _, f := range floats {
+				data = append(data, float32(f))
+			}
+			array.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+		} else {
+			array.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: floats}}
+		}
+	case schemapb.DataType_VarChar:
+		data := make([]string, 0, len(values))
+		for _, value := range values {
+			str, ok := value.(string)
+			if !ok {
+				return nil, illegalElement(value)
+			}
+			data = append(data, str)
+		}
+		array.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	default:
+		return nil, fmt.Errorf("unsupported element type %s of array field '%s'", getTypeName(elementType), schema.GetName())
+	}
+
+	if err := typeutil.ValidateArray(schema, array); err != nil {
+		return nil, err
+	}
+	return array, nil
+}
+
 // initValidators constructs valiator methods and data conversion methods
 func initValidators(collectionSchema *schemapb.CollectionSchema, validators map[storage.FieldID]*Validator) error {
 	if collectionSchema == nil {
@@ -323,14 +462,87 @@ func initValidators(collectionSchema *schemapb.CollectionSchema, validators map[
 				}
 				return nil
 			}
+		case typeutil.DataTypeJSON:
+			validators[schema.GetFieldID()].convertFunc = func(obj interface{}, field storage.FieldData) error {
+				value, err := parseJSONValue(obj, schema.GetName())
+				if err != nil {
+					return err
+				}
+				field.(*storage.JSONFieldData).Data = append(field.(*storage.JSONFieldData).Data, value)
+				field.(*storage.JSONFieldData).NumRows[0]++
+				return nil
+			}
+		case typeutil.DataTypeArray:
+			if err := typeutil.ValidateArrayFieldSchema(schema); err != nil {
+				return err
+			}
+			validators[schema.GetFieldID()].convertFunc = func(obj interface{}, field storage.FieldData) error {
+				value, err := parseArrayValue(obj, schema)
+				if err != nil {
+					return err
+				}
+				field.(*storage.ArrayFieldData).Data = append(field.(*storage.ArrayFieldData).Data, value)
+				field.(*storage.ArrayFieldData).NumRows[0]++
+				return nil
+			}
 		default:
 			return fmt.Errorf("unsupport data type: %s", getTypeName(collectionSchema.Fields[i].DataType))
 		}
+
+		// the rows without value of nullable or defaulted field take the default value, or null
+		if typeutil.HasDefaultValue(schema) {
+			if err := wrapDefaultValueConverter(schema, validators[schema.GetFieldID()]); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
+// wrapDefaultValueConverter makes the validator accept the missing value of nullable or defaulted field,
+// which is filled with the default value, or marked null if the field has no default value.
+func wrapDefaultValueConverter(schema *schemapb.FieldSchema, validator *Validator) error {
+	defaultData, err := storage.GenDefaultFieldData(schema, 1)
+	if err != nil {
+		return err
+	}
+	convertFunc := validator.convertFunc
+	validator.convertFunc = func(obj interface{}, field storage.FieldData) error {
+		if obj != nil {
+			if err := convertFunc(obj, field); err != nil {
+				return err
+			}
+			appendRowValidity(field, true)
+			return nil
+		}
+		insertData := &storage.InsertData{Data: map[storage.FieldID]storage.FieldData{schema.GetFieldID(): field}}
+		storage.MergeFieldData(insertData, schema.GetFieldID(), defaultData)
+		return nil
+	}
+	return nil
+}
+
+// appendRowValidity appends the validity of the row just appended to the field data,
+// the valid data is kept nil until a null row comes.
+func appendRowValidity(field storage.FieldData, valid bool) {
+	nullableData, ok := field.(storage.NullableFieldData)
+	if !ok {
+		return
+	}
+	validData := nullableData.GetValidData()
+	if validData == nil {
+		if valid {
+			return
+		}
+		validData = make([]bool, field.RowNum()-1)
+		for i := range validData {
+			validData[i] = true
+		}
+	}
+	nullableData.SetValidData(append(validData, valid))
+}
+
 func printFieldsDataInfo(fieldsData map[storage.FieldID]storage.FieldData, msg string, files []string) {
 	stats := make([]zapcore.Field, 0)
 	for k, v := range fieldsData {
@@ -511,6 +723,10 @@ func getTypeName(dt schemapb.DataType) string {
 		return "Varchar"
 	case schemapb.DataType_String:
 		return "String"
+	case typeutil.DataTypeJSON:
+		return "JSON"
+	case typeutil.DataTypeArray:
+		return "Array"
 	case schemapb.DataType_BinaryVector:
 		return "BinaryVector"
 	case schemapb.DataType_FloatVector:
@@ -24,7 +24,9 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -299,6 +301,139 @@ func Test_parseFloat(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_parseJSONValue(t *testing.T) {
+	value, err := parseJSONValue(`{"color": "red", "price": 10}`, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"color": "red", "price": 10}`), value)
+
+	value, err = parseJSONValue(map[string]interface{}{"color": "red", "price": jsonNumber("10")}, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(`{"color":"red","price":10}`), value)
+
+	value, err = parseJSONValue(`{"color": "red"`, "")
+	assert.Nil(t, value)
+	assert.Error(t, err)
+
+	value, err = parseJSONValue(`[1, 2]`, "")
+	assert.Nil(t, value)
+	assert.Error(t, err)
+
+	value, err = parseJSONValue(jsonNumber("1"), "")
+	assert.Nil(t, value)
+	assert.Error(t, err)
+}
+
+func Test_InitValidatorsJSON(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "FieldInt64", IsPrimaryKey: true, AutoID: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "FieldJSON", DataType: typeutil.DataTypeJSON},
+		},
+	}
+	validators := make(map[storage.FieldID]*Validator)
+	err := initValidators(schema, validators)
+	assert.Nil(t, err)
+
+	fields := initSegmentData(schema)
+	assert.NotNil(t, fields)
+	fieldData, ok := fields[101].(*storage.JSONFieldData)
+	assert.True(t, ok)
+
+	v := validators[101]
+	assert.False(t, v.isString)
+	err = v.convertFunc(map[string]interface{}{"color": "red"}, fieldData)
+	assert.Nil(t, err)
+	err = v.convertFunc(`{"price": 10}`, fieldData)
+	assert.Nil(t, err)
+	err = v.convertFunc(true, fieldData)
+	assert.Error(t, err)
+	assert.Equal(t, 2, fieldData.RowNum())
+	assert.Equal(t, [][]byte{[]byte(`{"color":"red"}`), []byte(`{"price": 10}`)}, fieldData.Data)
+}
+
+func Test_parseArrayValue(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		FieldID:  101,
+		Name:     "FieldArray",
+		DataType: typeutil.DataTypeArray,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: common.ElementTypeKey, Value: schemapb.DataType_Int32.String()},
+			{Key: common.MaxCapacityKey, Value: "3"},
+		},
+	}
+
+	value, err := parseArrayValue([]interface{}{jsonNumber("1"), jsonNumber("2")}, field)
+	assert.Nil(t, err)
+	assert.Equal(t, []int32{1, 2}, value.GetIntData().GetData())
+
+	value, err = parseArrayValue([]interface{}{}, field)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, typeutil.GetArrayLength(value))
+
+	// exceeds max capacity
+	value, err = parseArrayValue([]interface{}{jsonNumber("1"), jsonNumber("2"), jsonNumber("3"), jsonNumber("4")}, field)
+	assert.Nil(t, value)
+	assert.Error(t, err)
+
+	// illegal element
+	value, err = parseArrayValue([]interface{}{"a"}, field)
+	assert.Nil(t, value)
+	assert.Error(t, err)
+
+	value, err = parseArrayValue([]interface{}{jsonNumber("1.5")}, field)
+	assert.Nil(t, value)
+	assert.Error(t, err)
+
+	value, err = parseArrayValue(jsonNumber("1"), field)
+	assert.Nil(t, value)
+	assert.Error(t, err)
+
+	field.TypeParams[0].Value = schemapb.DataType_VarChar.String()
+	field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{Key: "max_length", Value: "8"})
+	value, err = parseArrayValue([]interface{}{"red", "blue"}, field)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"red", "blue"}, value.GetStringData().GetData())
+
+	value, err = parseArrayValue([]interface{}{"red", jsonNumber("1")}, field)
+	assert.Nil(t, value)
+	assert.Error(t, err)
+}
+
+func Test_InitValidatorsArray(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "FieldInt64", IsPrimaryKey: true, AutoID: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "FieldArray", DataType: typeutil.DataTypeArray, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.ElementTypeKey, Value: schemapb.DataType_Bool.String()},
+				{Key: common.MaxCapacityKey, Value: "4"},
+			}},
+		},
+	}
+	validators := make(map[storage.FieldID]*Validator)
+	err := initValidators(schema, validators)
+	assert.Nil(t, err)
+
+	fields := initSegmentData(schema)
+	assert.NotNil(t, fields)
+	fieldData, ok := fields[101].(*storage.ArrayFieldData)
+	assert.True(t, ok)
+
+	v := validators[101]
+	err = v.convertFunc([]interface{}{true, false}, fieldData)
+	assert.Nil(t, err)
+	err = v.convertFunc([]interface{}{jsonNumber("1")}, fieldData)
+	assert.Error(t, err)
+	assert.Equal(t, 1, fieldData.RowNum())
+	assert.Equal(t, []bool{true, false}, fieldData.Data[0].GetBoolData().GetData())
+
+	// the element type is not specified
+	schema.Fields[1].TypeParams = nil
+	err = initValidators(schema, make(map[storage.FieldID]*Validator))
+	assert.Error(t, err)
+}
+
 func Test_InitValidators(t *testing.T) {
 	validators := make(map[storage.FieldID]*Validator)
 	err := initValidators(nil, validators)
@@ -608,6 +743,8 @@ func Test_GetTypeName(t *testing.T) {
 	assert.NotEmpty(t, str)
 	str = getTypeName(schemapb.DataType_FloatVector)
 	assert.NotEmpty(t, str)
+	str = getTypeName(typeutil.DataTypeJSON)
+	assert.Equal(t, "JSON", str)
 	str = getTypeName(schemapb.DataType_None)
 	assert.Equal(t, "InvalidType", str)
 }
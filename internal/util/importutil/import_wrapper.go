@@ -18,10 +18,14 @@ package importutil
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"strconv"
 
+	"github.com/apache/arrow/go/v8/parquet"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -38,8 +42,10 @@ import (
 )
 
 const (
-	JSONFileExt  = ".json"
-	NumpyFileExt = ".npy"
+	JSONFileExt    = ".json"
+	NumpyFileExt   = ".npy"
+	ParquetFileExt = ".parquet"
+	CSVFileExt     = ".csv"
 
 	// supposed size of a single block, to control a binlog file size, the max biglog file size is no more than 2*SingleBlockSize
 	SingleBlockSize = 16 * 1024 * 1024 // 16MB
@@ -177,7 +183,13 @@ func (p *ImportWrapper) Cancel() error {
 
 func (p *ImportWrapper) validateColumnBasedFiles(filePaths []string, collectionSchema *schemapb.CollectionSchema) error {
 	requiredFieldNames := make(map[string]interface{})
+	// the files of nullable or defaulted fields are optional
+	optionalFieldNames := make(map[string]interface{})
 	for _, schema := range p.collectionSchema.Fields {
+		if typeutil.HasDefaultValue(schema) {
+			optionalFieldNames[schema.GetName()] = nil
+			continue
+		}
 		if schema.GetIsPrimaryKey() {
 			if !schema.GetAutoID() {
 				requiredFieldNames[schema.GetName()] = nil
@@ -193,7 +205,8 @@ func (p *ImportWrapper) validateColumnBasedFiles(filePaths []string, collectionS
 		name, _ := GetFileNameAndExt(filePath)
 		fileNames[name] = nil
 		_, ok := requiredFieldNames[name]
-		if !ok {
+		_, optional := optionalFieldNames[name]
+		if !ok && !optional {
 			log.Error("import wrapper: the file has no corresponding field in collection", zap.String("fieldName", name))
 			return fmt.Errorf("the file '%s' has no corresponding field in collection", filePath)
 		}
@@ -211,8 +224,13 @@ func (p *ImportWrapper) validateColumnBasedFiles(filePaths []string, collectionS
 	return nil
 }
 
+// isRowBasedFileType returns true if each file of the type contains all the fields, such as json, parquet and csv
+func isRowBasedFileType(fileType string) bool {
+	return fileType == JSONFileExt || fileType == ParquetFileExt || fileType == CSVFileExt
+}
+
 // fileValidation verify the input paths
-// if all the files are json type, return true
+// if all the files are json, parquet or csv type, return true
 // if all the files are numpy type, return false, and not allow duplicate file name
 func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 	// use this map to check duplicate file name(only for numpy file)
@@ -224,21 +242,21 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 		filePath := filePaths[i]
 		name, fileType := GetFileNameAndExt(filePath)
 
-		// only allow json file or numpy file
-		if fileType != JSONFileExt && fileType != NumpyFileExt {
+		// only allow json file, parquet file, csv file or numpy file
+		if !isRowBasedFileType(fileType) && fileType != NumpyFileExt {
 			log.Error("import wrapper: unsupported file type", zap.String("filePath", filePath))
 			return false, fmt.Errorf("unsupported file type: '%s'", filePath)
 		}
 
 		// we use the first file to determine row-based or column-based
-		if i == 0 && fileType == JSONFileExt {
+		if i == 0 && isRowBasedFileType(fileType) {
 			rowBased = true
 		}
 
 		// check file type
-		// row-based only support json type, column-based only support numpy type
+		// row-based only support json, parquet and csv type, column-based only support numpy type
 		if rowBased {
-			if fileType != JSONFileExt {
+			if !isRowBasedFileType(fileType) {
 				log.Error("import wrapper: unsupported file type for row-based mode", zap.String("filePath", filePath))
 				return rowBased, fmt.Errorf("unsupported file type for row-based mode: '%s'", filePath)
 			}
@@ -318,8 +336,9 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 	tr := timerecord.NewTimeRecorder("Import task")
 	if rowBased {
 		// parse and consume row-based files
-		// for row-based files, the JSONRowConsumer will generate autoid for primary key, and split rows into segments
+		// for row-based json and csv files, the JSONRowConsumer will generate autoid for primary key, and split rows into segments
 		// according to shard number, so the flushFunc will be called in the JSONRowConsumer
+		// for parquet files, each batch of rows is split into segments by splitFieldsData()
 		for i := 0; i < len(filePaths); i++ {
 			filePath := filePaths[i]
 			_, fileType := GetFileNameAndExt(filePath)
@@ -331,6 +350,18 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 					log.Error("import wrapper: failed to parse row-based json file", zap.Error(err), zap.String("filePath", filePath))
 					return err
 				}
+			} else if fileType == ParquetFileExt {
+				err = p.parseParquet(filePath, options.OnlyValidate)
+				if err != nil {
+					log.Error("import wrapper: failed to parse parquet file", zap.Error(err), zap.String("filePath", filePath))
+					return err
+				}
+			} else if fileType == CSVFileExt {
+				err = p.parseCSV(filePath, options)
+				if err != nil {
+					log.Error("import wrapper: failed to parse csv file", zap.Error(err), zap.String("filePath", filePath))
+					return err
+				}
 			} // no need to check else, since the fileValidation() already do this
 
 			// trigger gc after each file finished
@@ -562,6 +593,95 @@ func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) er
 	return nil
 }
 
+// parseCSV is the entry of csv import operation
+func (p *ImportWrapper) parseCSV(filePath string, options ImportOptions) error {
+	tr := timerecord.NewTimeRecorder("csv parser: " + filePath)
+
+	// for minio storage, chunkManager will download file into local memory
+	// for local storage, chunkManager open the file directly
+	file, err := p.chunkManager.Reader(p.ctx, filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// parse file
+	reader := bufio.NewReader(file)
+	parser := NewCSVParser(p.ctx, p.collectionSchema, options.CSVDelimiter, options.CSVQuote)
+
+	// if only validate, we input a empty flushFunc so that the consumer do nothing but only validation.
+	var flushFunc ImportFlushFunc
+	if options.OnlyValidate {
+		flushFunc = func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+			return nil
+		}
+	} else {
+		flushFunc = func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+			var filePaths = []string{filePath}
+			printFieldsDataInfo(fields, "import wrapper: prepare to flush binlogs", filePaths)
+			return p.flushFunc(fields, shardID)
+		}
+	}
+
+	// the csv rows are converted to the same form as json rows, and consumed by JSONRowConsumer
+	consumer, err := NewJSONRowConsumer(p.collectionSchema, p.rowIDAllocator, p.shardNum, SingleBlockSize, flushFunc)
+	if err != nil {
+		return err
+	}
+
+	err = parser.ParseRows(reader, consumer)
+	if err != nil {
+		return err
+	}
+
+	p.importResult.AutoIds = append(p.importResult.AutoIds, consumer.IDRange()...)
+
+	tr.Elapse("parsed")
+	return nil
+}
+
+// parseParquet is the entry of parquet import operation
+func (p *ImportWrapper) parseParquet(filePath string, onlyValidate bool) error {
+	tr := timerecord.NewTimeRecorder("parquet parser: " + filePath)
+
+	// for minio storage, chunkManager will download file into local memory
+	// for local storage, chunkManager open the file directly
+	file, err := p.chunkManager.Reader(p.ctx, filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// the parquet reader seeks the footer and the row groups, read the whole file into memory
+	// if the reader of chunkManager is not seekable
+	reader, ok := file.(parquet.ReaderAtSeeker)
+	if !ok {
+		content, err := io.ReadAll(file)
+		if err != nil {
+			return fmt.Errorf("failed to read parquet file '%s', error: %w", filePath, err)
+		}
+		reader = bytes.NewReader(content)
+	}
+
+	// each batch of rows contains all the fields, split the rows into segments according to shard number
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		printFieldsDataInfo(fields, "import wrapper: prepare to split parquet rows", []string{filePath})
+		return p.splitFieldsData(fields, SingleBlockSize)
+	}
+
+	parser := NewParquetParser(p.ctx, p.collectionSchema, SingleBlockSize, flushFunc)
+	if parser == nil {
+		return errors.New("failed to create parquet parser")
+	}
+	err = parser.Parse(reader, onlyValidate)
+	if err != nil {
+		return err
+	}
+
+	tr.Elapse("parsed")
+	return nil
+}
+
 // parseColumnBasedNumpy is the entry of column-based numpy import operation
 func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool,
 	combineFunc func(fields map[storage.FieldID]storage.FieldData) error) error {
@@ -682,6 +802,20 @@ func (p *ImportWrapper) appendFunc(schema *schemapb.FieldSchema) func(src storag
 			arr.Data = append(arr.Data, src.GetRow(n).(string))
 			return nil
 		}
+	case typeutil.DataTypeJSON:
+		return func(src storage.FieldData, n int, target storage.FieldData) error {
+			arr := target.(*storage.JSONFieldData)
+			arr.Data = append(arr.Data, src.GetRow(n).([]byte))
+			arr.NumRows[0]++
+			return nil
+		}
+	case typeutil.DataTypeArray:
+		return func(src storage.FieldData, n int, target storage.FieldData) error {
+			arr := target.(*storage.ArrayFieldData)
+			arr.Data = append(arr.Data, src.GetRow(n).(*schemapb.ScalarField))
+			arr.NumRows[0]++
+			return nil
+		}
 	default:
 		return nil
 	}
@@ -711,6 +845,10 @@ func (p *ImportWrapper) splitFieldsData(fieldsData map[storage.FieldID]storage.F
 
 		if !schema.GetAutoID() {
 			v, ok := fieldsData[schema.GetFieldID()]
+			// the nullable or defaulted field not provided is filled later
+			if (!ok || v.RowNum() == 0) && typeutil.HasDefaultValue(schema) {
+				continue
+			}
 			if !ok {
 				log.Error("import wrapper: field not provided", zap.String("fieldName", schema.GetName()))
 				return fmt.Errorf("field '%s' not provided", schema.GetName())
@@ -735,6 +873,18 @@ func (p *ImportWrapper) splitFieldsData(fieldsData map[storage.FieldID]storage.F
 	}
 	log.Info("import wrapper: try to split a block with row count", zap.Int("rowCount", rowCount))
 
+	for _, schema := range p.collectionSchema.Fields {
+		if v, ok := fieldsData[schema.GetFieldID()]; (ok && v.RowNum() > 0) || !typeutil.HasDefaultValue(schema) {
+			continue
+		}
+		defaultData, err := storage.GenDefaultFieldData(schema, rowCount)
+		if err != nil {
+			log.Error("import wrapper: failed to generate default value", zap.String("fieldName", schema.GetName()), zap.Error(err))
+			return fmt.Errorf("failed to generate default value for field '%s', error: %w", schema.GetName(), err)
+		}
+		fieldsData[schema.GetFieldID()] = defaultData
+	}
+
 	primaryData, ok := fieldsData[primaryKey.GetFieldID()]
 	if !ok {
 		log.Error("import wrapper: primary key field is not provided", zap.String("keyName", primaryKey.GetName()))
@@ -832,6 +982,9 @@ func (p *ImportWrapper) splitFieldsData(fieldsData map[storage.FieldID]storage.F
 			if err != nil {
 				return err
 			}
+			if nullableData, ok := srcData.(storage.NullableFieldData); ok {
+				appendRowValidity(targetData, nullableData.GetValidData() == nil || nullableData.GetValidData()[i])
+			}
 		}
 
 		// when the estimated size is close to blockSize, force flush
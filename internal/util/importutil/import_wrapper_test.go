@@ -29,6 +29,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/apache/arrow/go/v8/arrow"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/exp/mmap"
 
@@ -310,6 +311,143 @@ func Test_ImportWrapperRowBased(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func Test_ImportWrapperRowBased_parquet(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	content := createParquetData(t, arrow.NewSchema(sampleParquetFields(), nil), 2, 5, appendSampleParquetRow)
+	filePath := TempFilesPath + "rows_1.parquet"
+	err = cm.Write(ctx, filePath, content)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	// success case
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	files := []string{filePath}
+	err = wrapper.Import(files, ImportOptions{OnlyValidate: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, rowCounter.rowCount)
+
+	err = wrapper.Import(files, DefaultImportOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, 10, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// parse error
+	fields := sampleParquetFields()
+	fields[1].Type = arrow.PrimitiveTypes.Int64
+	content = createParquetData(t, arrow.NewSchema(fields, nil), 1, 2, appendSampleParquetRow)
+	filePath = TempFilesPath + "rows_2.parquet"
+	err = cm.Write(ctx, filePath, content)
+	assert.NoError(t, err)
+
+	importResult.State = commonpb.ImportState_ImportStarted
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import([]string{filePath}, ImportOptions{OnlyValidate: true})
+	assert.NotNil(t, err)
+	assert.NotEqual(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// file doesn't exist
+	err = wrapper.Import([]string{"/dummy/dummy.parquet"}, ImportOptions{OnlyValidate: true})
+	assert.NotNil(t, err)
+}
+
+func Test_ImportWrapperRowBased_CSV(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	content := []byte("FieldBool\tFieldInt8\tFieldInt16\tFieldInt32\tFieldInt64\tFieldFloat\tFieldDouble\tFieldString\tFieldBinaryVector\tFieldFloatVector\n" +
+		"true\t10\t101\t1001\t10001\t3.14\t1.56\thello world\t[254, 0]\t[1.1, 1.2, 1.3, 1.4]\n" +
+		"false\t11\t102\t1002\t10002\t3.15\t2.56\thello world\t[253, 0]\t[2.1, 2.2, 2.3, 2.4]\n" +
+		"true\t12\t103\t1003\t10003\t3.16\t3.56\thello world\t[252, 0]\t[3.1, 3.2, 3.3, 3.4]\n")
+
+	filePath := TempFilesPath + "rows_1.csv"
+	err = cm.Write(ctx, filePath, content)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	// success case
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	files := []string{filePath}
+	options := DefaultImportOptions()
+	options.CSVDelimiter = '\t'
+	options.OnlyValidate = true
+	err = wrapper.Import(files, options)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, rowCounter.rowCount)
+
+	options.OnlyValidate = false
+	err = wrapper.Import(files, options)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// parse error, the delimiter is not the default one
+	importResult.State = commonpb.ImportState_ImportStarted
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(files, ImportOptions{OnlyValidate: true})
+	assert.NotNil(t, err)
+	assert.NotEqual(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// file doesn't exist
+	err = wrapper.Import([]string{"/dummy/dummy.csv"}, ImportOptions{OnlyValidate: true})
+	assert.NotNil(t, err)
+}
+
 func createSampleNumpyFiles(t *testing.T, cm storage.ChunkManager) []string {
 	ctx := context.Background()
 	files := make([]string, 0)
@@ -725,12 +863,37 @@ func Test_ImportWrapperFileValidation(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.False(t, rowBased)
 
+	files = []string{"a/uid.parquet", "b/bol.npy"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.NotNil(t, err)
+	assert.True(t, rowBased)
+
+	files = []string{"a/uid.npy", "b/bol.parquet"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.NotNil(t, err)
+	assert.False(t, rowBased)
+
+	files = []string{"a/uid.csv", "b/bol.npy"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.NotNil(t, err)
+	assert.True(t, rowBased)
+
 	// valid cases
 	files = []string{"a/1.json", "b/2.json"}
 	rowBased, err = wrapper.fileValidation(files)
 	assert.Nil(t, err)
 	assert.True(t, rowBased)
 
+	files = []string{"a/1.parquet", "b/2.parquet"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.Nil(t, err)
+	assert.True(t, rowBased)
+
+	files = []string{"a/1.csv", "b/2.json"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.Nil(t, err)
+	assert.True(t, rowBased)
+
 	files = []string{"a/uid.npy", "b/bol.npy"}
 	rowBased, err = wrapper.fileValidation(files)
 	assert.Nil(t, err)
@@ -42,16 +42,18 @@ const (
 )
 
 type JSONParser struct {
-	ctx          context.Context  // for canceling parse process
-	bufSize      int64            // max rows in a buffer
-	fields       map[string]int64 // fields need to be parsed
-	name2FieldID map[string]storage.FieldID
+	ctx            context.Context  // for canceling parse process
+	bufSize        int64            // max rows in a buffer
+	fields         map[string]int64 // fields need to be parsed
+	name2FieldID   map[string]storage.FieldID
+	optionalFields map[storage.FieldID]struct{} // nullable or defaulted fields which could be missed
 }
 
 // NewJSONParser helper function to create a JSONParser
 func NewJSONParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema) *JSONParser {
 	fields := make(map[string]int64)
 	name2FieldID := make(map[string]storage.FieldID)
+	optionalFields := make(map[storage.FieldID]struct{})
 	for i := 0; i < len(collectionSchema.Fields); i++ {
 		schema := collectionSchema.Fields[i]
 		// RowIDField and TimeStampField is internal field, no need to parse
@@ -65,23 +67,27 @@ func NewJSONParser(ctx context.Context, collectionSchema *schemapb.CollectionSch
 
 		fields[schema.GetName()] = 0
 		name2FieldID[schema.GetName()] = schema.GetFieldID()
+		if typeutil.HasDefaultValue(schema) {
+			optionalFields[schema.GetFieldID()] = struct{}{}
+		}
 	}
 
 	parser := &JSONParser{
-		ctx:          ctx,
-		bufSize:      MinBufferSize,
-		fields:       fields,
-		name2FieldID: name2FieldID,
+		ctx:            ctx,
+		bufSize:        estimateBufSize(collectionSchema),
+		fields:         fields,
+		name2FieldID:   name2FieldID,
+		optionalFields: optionalFields,
 	}
-	adjustBufSize(parser, collectionSchema)
 
 	return parser
 }
 
-func adjustBufSize(parser *JSONParser, collectionSchema *schemapb.CollectionSchema) {
+// estimateBufSize returns the max rows in a buffer of row-based parsers
+func estimateBufSize(collectionSchema *schemapb.CollectionSchema) int64 {
 	sizePerRecord, _ := typeutil.EstimateSizePerRecord(collectionSchema)
 	if sizePerRecord <= 0 {
-		return
+		return MinBufferSize
 	}
 
 	// split the file into no more than MaxBatchCount batches to parse
@@ -95,8 +101,8 @@ func adjustBufSize(parser *JSONParser, collectionSchema *schemapb.CollectionSche
 		bufSize = MinBufferSize
 	}
 
-	log.Info("JSON parser: reset bufSize", zap.Int("sizePerRecord", sizePerRecord), zap.Int("bufSize", bufSize))
-	parser.bufSize = int64(bufSize)
+	log.Info("import util: estimate bufSize", zap.Int("sizePerRecord", sizePerRecord), zap.Int("bufSize", bufSize))
+	return int64(bufSize)
 }
 
 func (p *JSONParser) verifyRow(raw interface{}) (map[storage.FieldID]interface{}, error) {
@@ -121,7 +127,7 @@ func (p *JSONParser) verifyRow(raw interface{}) (map[storage.FieldID]interface{}
 	if len(row) != len(p.name2FieldID) {
 		for k, v := range p.name2FieldID {
 			_, ok := row[v]
-			if !ok {
+			if _, optional := p.optionalFields[v]; !ok && !optional {
 				log.Error("JSON parser: a field value is missed", zap.String("fieldName", k))
 				return nil, fmt.Errorf("value of field '%s' is missed", k)
 			}
@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 )
 
@@ -148,6 +149,26 @@ func (p *NumpyParser) validate(adapter *NumpyAdapter, fieldName string) error {
 			return fmt.Errorf("illegal dimension %d of numpy file for binary vector field '%s', dimension should be %d",
 				shape[1]*8, schema.GetName(), p.columnDesc.dimension)
 		}
+	} else if typeutil.DataTypeArray == schema.DataType {
+		// numpy files can't hold variable-length rows, arrays are imported from JSON files
+		log.Error("Numpy parser: array field is not supported", zap.String("fieldName", fieldName))
+		return fmt.Errorf("array field '%s' is not supported by numpy files, please use JSON files instead", schema.GetName())
+	} else if typeutil.DataTypeJSON == schema.DataType {
+		// JSON documents are stored as a string array in numpy file
+		if elementType != schemapb.DataType_VarChar {
+			log.Error("Numpy parser: illegal data type of numpy file for JSON field", zap.Any("dataType", elementType),
+				zap.String("fieldName", fieldName))
+			return fmt.Errorf("illegal data type %s of numpy file for JSON field '%s'", getTypeName(elementType), schema.GetName())
+		}
+
+		// scalar field, the shape should be 1
+		if len(shape) != 1 {
+			log.Error("Numpy parser: illegal shape of numpy file for JSON field, shape should be 1", zap.Int("shape", len(shape)),
+				zap.String("fieldName", fieldName))
+			return fmt.Errorf("illegal shape %d of numpy file for JSON field '%s', shape should be 1", shape, schema.GetName())
+		}
+
+		p.columnDesc.elementCount = shape[0]
 	} else {
 		if elementType != schema.DataType {
 			log.Error("Numpy parser: illegal data type of numpy file for scalar field", zap.Any("numpyDataType", elementType),
@@ -262,6 +283,27 @@ func (p *NumpyParser) consume(adapter *NumpyAdapter) error {
 			NumRows: []int64{int64(p.columnDesc.elementCount)},
 			Data:    data,
 		}
+	case typeutil.DataTypeJSON:
+		data, err := adapter.ReadString(p.columnDesc.elementCount)
+		if err != nil {
+			log.Error("Numpy parser: failed to read JSON array", zap.Error(err))
+			return err
+		}
+
+		docs := make([][]byte, 0, len(data))
+		for _, str := range data {
+			doc, err := parseJSONValue(str, p.columnDesc.name)
+			if err != nil {
+				log.Error("Numpy parser: illegal JSON value", zap.Error(err))
+				return err
+			}
+			docs = append(docs, doc)
+		}
+
+		p.columnData = &storage.JSONFieldData{
+			NumRows: []int64{int64(p.columnDesc.elementCount)},
+			Data:    docs,
+		}
 	case schemapb.DataType_BinaryVector:
 		data, err := adapter.ReadUint8(p.columnDesc.elementCount)
 		if err != nil {
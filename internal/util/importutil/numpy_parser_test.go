@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func Test_NewNumpyParser(t *testing.T) {
@@ -513,6 +514,55 @@ func Test_NumpyParserParse(t *testing.T) {
 	})
 }
 
+func Test_NumpyParserParseJSON(t *testing.T) {
+	ctx := context.Background()
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "FieldInt64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "FieldJSON", DataType: typeutil.DataTypeJSON},
+		},
+	}
+
+	parseFile := func(data interface{}, flushFunc func(field storage.FieldData) error) error {
+		filePath := TempFilesPath + "FieldJSON.npy"
+		err := CreateNumpyFile(filePath, data)
+		assert.Nil(t, err)
+
+		file, err := os.Open(filePath)
+		assert.Nil(t, err)
+		defer file.Close()
+
+		parser := NewNumpyParser(ctx, schema, flushFunc)
+		return parser.Parse(file, "FieldJSON", false)
+	}
+
+	data := []string{`{"color": "red"}`, `{"price": 10}`, `{}`}
+	err = parseFile(data, func(field storage.FieldData) error {
+		assert.Equal(t, len(data), field.RowNum())
+		for i := 0; i < len(data); i++ {
+			assert.Equal(t, []byte(data[i]), field.GetRow(i))
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+
+	// illegal JSON document
+	err = parseFile([]string{`{"color": "red"}`, `dummy`}, func(field storage.FieldData) error {
+		return nil
+	})
+	assert.NotNil(t, err)
+
+	// numpy file is not a string array
+	err = parseFile([]int64{1, 2}, func(field storage.FieldData) error {
+		return nil
+	})
+	assert.NotNil(t, err)
+}
+
 func Test_NumpyParserParse_perf(t *testing.T) {
 	ctx := context.Background()
 	err := os.MkdirAll(TempFilesPath, os.ModePerm)
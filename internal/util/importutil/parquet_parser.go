@@ -0,0 +1,605 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// parquetColumn maps a column of the Parquet file to a field of the collection
+type parquetColumn struct {
+	field       *schemapb.FieldSchema
+	defaultData storage.FieldData                                    // the value of null, nil if the field is neither nullable nor defaulted
+	convertFunc func(column arrow.Array, i int) (interface{}, error) // convert the value of row i to the go type of the field data
+}
+
+// ParquetParser parses a Parquet file, each column is mapped to the field of the same name.
+// The vector fields are lists or fixed size lists of float32(float vector) or uint8(binary vector),
+// the JSON fields are strings, the array fields are lists of the element type.
+// The file is read batch by batch across the row groups, the data of a batch is about blockSize bytes,
+// so the memory cost doesn't grow with the file size.
+type ParquetParser struct {
+	ctx              context.Context            // for canceling parse process
+	collectionSchema *schemapb.CollectionSchema // collection schema
+	blockSize        int64                      // the data size of a batch
+
+	callFlushFunc func(fields map[storage.FieldID]storage.FieldData) error // call back function to output a batch of rows
+}
+
+// NewParquetParser is helper function to create a ParquetParser
+func NewParquetParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema, blockSize int64,
+	flushFunc func(fields map[storage.FieldID]storage.FieldData) error) *ParquetParser {
+	if collectionSchema == nil || flushFunc == nil {
+		return nil
+	}
+
+	if blockSize <= 0 {
+		blockSize = SingleBlockSize
+	}
+
+	return &ParquetParser{
+		ctx:              ctx,
+		collectionSchema: collectionSchema,
+		blockSize:        blockSize,
+		callFlushFunc:    flushFunc,
+	}
+}
+
+// batchRows returns the number of rows read each time, the data of the rows is about blockSize bytes
+func (p *ParquetParser) batchRows() int64 {
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(p.collectionSchema)
+	if err != nil || sizePerRecord <= 0 {
+		return MinBufferSize
+	}
+
+	rows := p.blockSize / int64(sizePerRecord)
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+// mapColumns maps the columns of the file to the fields, the columns not defined in the schema are not allowed,
+// the columns of the nullable or defaulted fields are optional.
+func (p *ParquetParser) mapColumns(arrowSchema *arrow.Schema) ([]*parquetColumn, error) {
+	name2Field := make(map[string]*schemapb.FieldSchema)
+	for _, field := range p.collectionSchema.GetFields() {
+		// RowIDField and TimeStampField is internal field, no need to parse
+		if field.GetFieldID() == common.RowIDField || field.GetFieldID() == common.TimeStampField {
+			continue
+		}
+		name2Field[field.GetName()] = field
+	}
+
+	columns := make([]*parquetColumn, 0, len(arrowSchema.Fields()))
+	provided := make(map[string]struct{})
+	for _, arrowField := range arrowSchema.Fields() {
+		field, ok := name2Field[arrowField.Name]
+		if !ok {
+			log.Error("Parquet parser: the column is not defined in collection schema", zap.String("columnName", arrowField.Name))
+			return nil, fmt.Errorf("the column '%s' is not defined in collection schema", arrowField.Name)
+		}
+		if field.GetAutoID() {
+			log.Error("Parquet parser: the primary key is auto-generated, no need to provide", zap.String("fieldName", field.GetName()))
+			return nil, fmt.Errorf("the primary key '%s' is auto-generated, no need to provide", field.GetName())
+		}
+		if _, ok := provided[arrowField.Name]; ok {
+			log.Error("Parquet parser: duplicate column", zap.String("columnName", arrowField.Name))
+			return nil, fmt.Errorf("duplicate column '%s'", arrowField.Name)
+		}
+		provided[arrowField.Name] = struct{}{}
+
+		convertFunc, err := parquetConvertFunc(field, arrowField.Type)
+		if err != nil {
+			log.Error("Parquet parser: illegal column", zap.String("columnName", arrowField.Name), zap.Error(err))
+			return nil, err
+		}
+		column := &parquetColumn{
+			field:       field,
+			convertFunc: convertFunc,
+		}
+		if typeutil.HasDefaultValue(field) {
+			column.defaultData, err = storage.GenDefaultFieldData(field, 1)
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate default value for field '%s', error: %w", field.GetName(), err)
+			}
+		}
+		columns = append(columns, column)
+	}
+
+	for name, field := range name2Field {
+		if _, ok := provided[name]; ok || field.GetAutoID() || typeutil.HasDefaultValue(field) {
+			continue
+		}
+		log.Error("Parquet parser: there is no column corresponding to field", zap.String("fieldName", name))
+		return nil, fmt.Errorf("there is no column corresponding to field '%s'", name)
+	}
+
+	return columns, nil
+}
+
+// consume converts a batch of rows to the fields data, rowOffset is the index of the first row in the file
+func (p *ParquetParser) consume(columns []*parquetColumn, record arrow.Record, rowOffset int) (map[storage.FieldID]storage.FieldData, error) {
+	fieldsData := initSegmentData(p.collectionSchema)
+	if fieldsData == nil {
+		log.Error("Parquet parser: failed to initialize FieldData list")
+		return nil, errors.New("failed to initialize FieldData list")
+	}
+
+	insertData := &storage.InsertData{Data: fieldsData}
+	for idx, col := range columns {
+		column := record.Column(idx)
+		fieldID := col.field.GetFieldID()
+		for i := 0; i < column.Len(); i++ {
+			if column.IsNull(i) {
+				if col.defaultData == nil {
+					log.Error("Parquet parser: null value of the field which is not nullable",
+						zap.String("fieldName", col.field.GetName()), zap.Int("row", rowOffset+i))
+					return nil, fmt.Errorf("the value of field '%s' at row %d is null, but the field is not nullable",
+						col.field.GetName(), rowOffset+i)
+				}
+				storage.MergeFieldData(insertData, fieldID, col.defaultData)
+				continue
+			}
+
+			value, err := col.convertFunc(column, i)
+			if err != nil {
+				log.Error("Parquet parser: failed to parse value", zap.String("fieldName", col.field.GetName()),
+					zap.Int("row", rowOffset+i), zap.Error(err))
+				return nil, fmt.Errorf("failed to parse the value of field '%s' at row %d, error: %w",
+					col.field.GetName(), rowOffset+i, err)
+			}
+			appendFieldValue(fieldsData[fieldID], value)
+			appendRowValidity(fieldsData[fieldID], true)
+		}
+	}
+
+	return fieldsData, nil
+}
+
+// Parse reads the file batch by batch, and outputs each batch by the flush function.
+// If onlyValidate is true, the data is parsed and validated, but not output.
+func (p *ParquetParser) Parse(reader parquet.ReaderAtSeeker, onlyValidate bool) error {
+	pqReader, err := file.NewParquetReader(reader)
+	if err != nil {
+		log.Error("Parquet parser: failed to open parquet file", zap.Error(err))
+		return fmt.Errorf("failed to open parquet file, error: %w", err)
+	}
+	defer pqReader.Close()
+
+	fileReader, err := pqarrow.NewFileReader(pqReader, pqarrow.ArrowReadProperties{BatchSize: p.batchRows()}, memory.DefaultAllocator)
+	if err != nil {
+		log.Error("Parquet parser: failed to read parquet file", zap.Error(err))
+		return fmt.Errorf("failed to read parquet file, error: %w", err)
+	}
+
+	arrowSchema, err := fileReader.Schema()
+	if err != nil {
+		log.Error("Parquet parser: failed to read the schema of parquet file", zap.Error(err))
+		return fmt.Errorf("failed to read the schema of parquet file, error: %w", err)
+	}
+
+	columns, err := p.mapColumns(arrowSchema)
+	if err != nil {
+		return err
+	}
+
+	// an empty file has no column reader
+	if pqReader.NumRows() == 0 {
+		log.Warn("Parquet parser: the file has no rows")
+		return nil
+	}
+
+	recordReader, err := fileReader.GetRecordReader(p.ctx, nil, nil)
+	if err != nil {
+		log.Error("Parquet parser: failed to read parquet file", zap.Error(err))
+		return fmt.Errorf("failed to read parquet file, error: %w", err)
+	}
+	defer recordReader.Release()
+
+	rowOffset := 0
+	for {
+		if isCanceled(p.ctx) {
+			log.Error("Parquet parser: import task was canceled")
+			return errors.New("import task was canceled")
+		}
+
+		record, err := recordReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Error("Parquet parser: failed to read parquet file", zap.Int("row", rowOffset), zap.Error(err))
+			return fmt.Errorf("failed to read parquet file at row %d, error: %w", rowOffset, err)
+		}
+
+		fieldsData, err := p.consume(columns, record, rowOffset)
+		if err != nil {
+			return err
+		}
+		rowOffset += int(record.NumRows())
+
+		if !onlyValidate {
+			if err := p.callFlushFunc(fieldsData); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Info("Parquet parser: parse finished", zap.Int("rowCount", rowOffset))
+	return nil
+}
+
+// parquetConvertFunc checks the type of the column, returns the function to convert the column value of the field
+func parquetConvertFunc(field *schemapb.FieldSchema, dataType arrow.DataType) (func(column arrow.Array, i int) (interface{}, error), error) {
+	mismatch := func() error {
+		return fmt.Errorf("the type %s of column '%s' doesn't match the data type %s of the field",
+			dataType, field.GetName(), getTypeName(field.GetDataType()))
+	}
+
+	switch field.GetDataType() {
+	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
+		dim, err := getFieldDimension(field)
+		if err != nil {
+			return nil, err
+		}
+		elemType, ok := listElementType(dataType)
+		if !ok {
+			return nil, mismatch()
+		}
+		if field.GetDataType() == schemapb.DataType_BinaryVector {
+			// the binary vector is stored in bytes, each byte holds 8 dimensions
+			dim = dim / 8
+			if elemType.ID() != arrow.UINT8 {
+				return nil, mismatch()
+			}
+		} else if !isArrowFloatType(elemType) {
+			return nil, mismatch()
+		}
+		if fixedType, ok := dataType.(*arrow.FixedSizeListType); ok && int(fixedType.Len()) != dim {
+			return nil, fmt.Errorf("the list size %d of column '%s' doesn't equal to the dimension %d of the field",
+				fixedType.Len(), field.GetName(), dim)
+		}
+
+		return func(column arrow.Array, i int) (interface{}, error) {
+			values, begin, end := listRange(column, i)
+			if end-begin != dim {
+				return nil, fmt.Errorf("the vector has %d elements, but the dimension of field is %d", end-begin, dim)
+			}
+			if values.NullN() > 0 {
+				for k := begin; k < end; k++ {
+					if values.IsNull(k) {
+						return nil, errors.New("the vector has null elements")
+					}
+				}
+			}
+			if field.GetDataType() == schemapb.DataType_BinaryVector {
+				return append([]byte(nil), values.(*array.Uint8).Uint8Values()[begin:end]...), nil
+			}
+			vector := make([]float32, 0, dim)
+			for k := begin; k < end; k++ {
+				value, err := arrowScalarValue(schemapb.DataType_Float, values, k)
+				if err != nil {
+					return nil, err
+				}
+				vector = append(vector, value.(float32))
+			}
+			return vector, nil
+		}, nil
+	case typeutil.DataTypeJSON:
+		if dataType.ID() != arrow.STRING {
+			return nil, mismatch()
+		}
+		return func(column arrow.Array, i int) (interface{}, error) {
+			return parseJSONValue(column.(*array.String).Value(i), field.GetName())
+		}, nil
+	case typeutil.DataTypeArray:
+		elementType, err := typeutil.GetArrayElementType(field)
+		if err != nil {
+			return nil, err
+		}
+		elemType, ok := listElementType(dataType)
+		if !ok || !isArrowTypeCompatible(elementType, elemType) {
+			return nil, mismatch()
+		}
+		return func(column arrow.Array, i int) (interface{}, error) {
+			values, begin, end := listRange(column, i)
+			return arrowArrayValue(field, elementType, values, begin, end)
+		}, nil
+	default:
+		if !isArrowTypeCompatible(field.GetDataType(), dataType) {
+			return nil, mismatch()
+		}
+		return func(column arrow.Array, i int) (interface{}, error) {
+			return arrowScalarValue(field.GetDataType(), column, i)
+		}, nil
+	}
+}
+
+// listElementType returns the element type of the list or fixed size list type
+func listElementType(dataType arrow.DataType) (arrow.DataType, bool) {
+	switch t := dataType.(type) {
+	case *arrow.ListType:
+		return t.Elem(), true
+	case *arrow.FixedSizeListType:
+		return t.Elem(), true
+	default:
+		return nil, false
+	}
+}
+
+// listRange returns the values of the list column, and the range of the elements of row i in the values
+func listRange(column arrow.Array, i int) (arrow.Array, int, int) {
+	switch c := column.(type) {
+	case *array.List:
+		j := i + c.Data().Offset()
+		return c.ListValues(), int(c.Offsets()[j]), int(c.Offsets()[j+1])
+	case *array.FixedSizeList:
+		n := int(c.DataType().(*arrow.FixedSizeListType).Len())
+		j := i + c.Data().Offset()
+		return c.ListValues(), j * n, (j + 1) * n
+	default:
+		return column, 0, 0
+	}
+}
+
+func isArrowIntegerType(dataType arrow.DataType) bool {
+	switch dataType.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64, arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isArrowFloatType(dataType arrow.DataType) bool {
+	return dataType.ID() == arrow.FLOAT32 || dataType.ID() == arrow.FLOAT64
+}
+
+// isArrowTypeCompatible checks whether the values of the arrow type could be stored in the scalar field,
+// the integer fields accept any integer types, the float fields accept any numeric types.
+func isArrowTypeCompatible(fieldType schemapb.DataType, dataType arrow.DataType) bool {
+	switch fieldType {
+	case schemapb.DataType_Bool:
+		return dataType.ID() == arrow.BOOL
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		return isArrowIntegerType(dataType)
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		return isArrowFloatType(dataType) || isArrowIntegerType(dataType)
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return dataType.ID() == arrow.STRING
+	default:
+		return false
+	}
+}
+
+// arrowIntegerValue returns the value at row i of the integer column
+func arrowIntegerValue(column arrow.Array, i int) (int64, error) {
+	switch c := column.(type) {
+	case *array.Int8:
+		return int64(c.Value(i)), nil
+	case *array.Int16:
+		return int64(c.Value(i)), nil
+	case *array.Int32:
+		return int64(c.Value(i)), nil
+	case *array.Int64:
+		return c.Value(i), nil
+	case *array.Uint8:
+		return int64(c.Value(i)), nil
+	case *array.Uint16:
+		return int64(c.Value(i)), nil
+	case *array.Uint32:
+		return int64(c.Value(i)), nil
+	case *array.Uint64:
+		if c.Value(i) > math.MaxInt64 {
+			return 0, fmt.Errorf("value %d overflows int64", c.Value(i))
+		}
+		return int64(c.Value(i)), nil
+	default:
+		return 0, fmt.Errorf("unexpected integer column %s", column.DataType())
+	}
+}
+
+// arrowScalarValue converts the value at row i of the column to the go type of the field data
+func arrowScalarValue(fieldType schemapb.DataType, column arrow.Array, i int) (interface{}, error) {
+	switch fieldType {
+	case schemapb.DataType_Bool:
+		return column.(*array.Boolean).Value(i), nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		value, err := arrowIntegerValue(column, i)
+		if err != nil {
+			return nil, err
+		}
+		switch fieldType {
+		case schemapb.DataType_Int8:
+			if value < math.MinInt8 || value > math.MaxInt8 {
+				return nil, fmt.Errorf("value %d is out of range of Int8", value)
+			}
+			return int8(value), nil
+		case schemapb.DataType_Int16:
+			if value < math.MinInt16 || value > math.MaxInt16 {
+				return nil, fmt.Errorf("value %d is out of range of Int16", value)
+			}
+			return int16(value), nil
+		case schemapb.DataType_Int32:
+			if value < math.MinInt32 || value > math.MaxInt32 {
+				return nil, fmt.Errorf("value %d is out of range of Int32", value)
+			}
+			return int32(value), nil
+		default:
+			return value, nil
+		}
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		var value float64
+		switch c := column.(type) {
+		case *array.Float32:
+			value = float64(c.Value(i))
+		case *array.Float64:
+			value = c.Value(i)
+		default:
+			integer, err := arrowIntegerValue(column, i)
+			if err != nil {
+				return nil, err
+			}
+			value = float64(integer)
+		}
+		// not allow not-a-number and infinity
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return nil, fmt.Errorf("value %v is not a number or infinity", value)
+		}
+		if fieldType == schemapb.DataType_Double {
+			return value, nil
+		}
+		if math.Abs(value) > math.MaxFloat32 {
+			return nil, fmt.Errorf("value %v is out of range of Float", value)
+		}
+		return float32(value), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return column.(*array.String).Value(i), nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %s", getTypeName(fieldType))
+	}
+}
+
+// arrowArrayValue converts the elements in the range [begin, end) of the values to the value of the array field
+func arrowArrayValue(field *schemapb.FieldSchema, elementType schemapb.DataType, values arrow.Array, begin int, end int) (*schemapb.ScalarField, error) {
+	elements := make([]interface{}, 0, end-begin)
+	for k := begin; k < end; k++ {
+		if values.IsNull(k) {
+			return nil, errors.New("the array has null elements")
+		}
+		element, err := arrowScalarValue(elementType, values, k)
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+	}
+
+	result := &schemapb.ScalarField{}
+	switch elementType {
+	case schemapb.DataType_Bool:
+		data := make([]bool, 0, len(elements))
+		for _, element := range elements {
+			data = append(data, element.(bool))
+		}
+		result.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data := make([]int32, 0, len(elements))
+		for _, element := range elements {
+			switch v := element.(type) {
+			case int8:
+				data = append(data, int32(v))
+			case int16:
+				data = append(data, int32(v))
+			case int32:
+				data = append(data, v)
+			}
+		}
+		result.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case schemapb.DataType_Int64:
+		data := make([]int64, 0, len(elements))
+		for _, element := range elements {
+			data = append(data, element.(int64))
+		}
+		result.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case schemapb.DataType_Float:
+		data := make([]float32, 0, len(elements))
+		for _, element := range elements {
+			data = append(data, element.(float32))
+		}
+		result.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+	case schemapb.DataType_Double:
+		data := make([]float64, 0, len(elements))
+		for _, element := range elements {
+			data = append(data, element.(float64))
+		}
+		result.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+	case schemapb.DataType_VarChar:
+		data := make([]string, 0, len(elements))
+		for _, element := range elements {
+			data = append(data, element.(string))
+		}
+		result.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	default:
+		return nil, fmt.Errorf("unsupported element type %s of array field '%s'", getTypeName(elementType), field.GetName())
+	}
+
+	if err := typeutil.ValidateArray(field, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// appendFieldValue appends a value converted by parquetConvertFunc to the field data
+func appendFieldValue(target storage.FieldData, value interface{}) {
+	switch arr := target.(type) {
+	case *storage.BoolFieldData:
+		arr.Data = append(arr.Data, value.(bool))
+		arr.NumRows[0]++
+	case *storage.Int8FieldData:
+		arr.Data = append(arr.Data, value.(int8))
+		arr.NumRows[0]++
+	case *storage.Int16FieldData:
+		arr.Data = append(arr.Data, value.(int16))
+		arr.NumRows[0]++
+	case *storage.Int32FieldData:
+		arr.Data = append(arr.Data, value.(int32))
+		arr.NumRows[0]++
+	case *storage.Int64FieldData:
+		arr.Data = append(arr.Data, value.(int64))
+		arr.NumRows[0]++
+	case *storage.FloatFieldData:
+		arr.Data = append(arr.Data, value.(float32))
+		arr.NumRows[0]++
+	case *storage.DoubleFieldData:
+		arr.Data = append(arr.Data, value.(float64))
+		arr.NumRows[0]++
+	case *storage.StringFieldData:
+		arr.Data = append(arr.Data, value.(string))
+		arr.NumRows[0]++
+	case *storage.JSONFieldData:
+		arr.Data = append(arr.Data, value.([]byte))
+		arr.NumRows[0]++
+	case *storage.ArrayFieldData:
+		arr.Data = append(arr.Data, value.(*schemapb.ScalarField))
+		arr.NumRows[0]++
+	case *storage.BinaryVectorFieldData:
+		arr.Data = append(arr.Data, value.([]byte)...)
+		arr.NumRows[0]++
+	case *storage.FloatVectorFieldData:
+		arr.Data = append(arr.Data, value.([]float32)...)
+		arr.NumRows[0]++
+	}
+}
@@ -0,0 +1,379 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/flight"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// createParquetData writes the rows into a Parquet file in memory, each row group has rowCount rows
+func createParquetData(t *testing.T, schema *arrow.Schema, rowGroups int, rowCount int,
+	appendRow func(builder *array.RecordBuilder, row int)) []byte {
+	buf := new(bytes.Buffer)
+	writer, err := pqarrow.NewFileWriter(schema, buf, parquet.NewWriterProperties(),
+		pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()))
+	assert.NoError(t, err)
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, schema)
+	defer builder.Release()
+	for i := 0; i < rowGroups; i++ {
+		for j := 0; j < rowCount; j++ {
+			appendRow(builder, i*rowCount+j)
+		}
+		record := builder.NewRecord()
+		assert.NoError(t, writer.Write(record))
+		record.Release()
+	}
+	assert.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+// fixedSizeListSchema returns the list schema with the serialized fixed size list schema as the stored arrow schema,
+// the file written by this schema is the same as the file written by pyarrow with fixed size list columns,
+// since the Parquet writer of arrow go doesn't write the values of fixed size lists.
+func fixedSizeListSchema(listFields []arrow.Field, fixedFields []arrow.Field) *arrow.Schema {
+	serialized := flight.SerializeSchema(arrow.NewSchema(fixedFields, nil), memory.DefaultAllocator)
+	metadata := arrow.NewMetadata([]string{"ARROW:schema"}, []string{base64.StdEncoding.EncodeToString(serialized)})
+	return arrow.NewSchema(listFields, &metadata)
+}
+
+func sampleParquetFields() []arrow.Field {
+	return []arrow.Field{
+		{Name: "FieldBool", Type: arrow.FixedWidthTypes.Boolean},
+		{Name: "FieldInt8", Type: arrow.PrimitiveTypes.Int8},
+		{Name: "FieldInt16", Type: arrow.PrimitiveTypes.Int16},
+		{Name: "FieldInt32", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+		{Name: "FieldInt64", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "FieldFloat", Type: arrow.PrimitiveTypes.Float32},
+		{Name: "FieldDouble", Type: arrow.PrimitiveTypes.Float64},
+		{Name: "FieldString", Type: arrow.BinaryTypes.String},
+		{Name: "FieldBinaryVector", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint8)},
+		{Name: "FieldFloatVector", Type: arrow.ListOf(arrow.PrimitiveTypes.Float32)},
+	}
+}
+
+// nullInt32Row is the row whose FieldInt32 value is null, the FieldInt32 field of sampleSchema is not nullable
+var nullInt32Row = -1
+
+func appendSampleParquetRow(builder *array.RecordBuilder, row int) {
+	builder.Field(0).(*array.BooleanBuilder).Append(row%2 == 0)
+	switch int8Builder := builder.Field(1).(type) {
+	case *array.Int8Builder:
+		int8Builder.Append(int8(row))
+	case *array.Int64Builder:
+		// the values are out of the range of Int8 since the second row
+		int8Builder.Append(int64(row * 200))
+	}
+	builder.Field(2).(*array.Int16Builder).Append(int16(row))
+	if row == nullInt32Row {
+		builder.Field(3).AppendNull()
+	} else {
+		builder.Field(3).(*array.Int32Builder).Append(int32(row))
+	}
+	builder.Field(4).(*array.Int64Builder).Append(int64(row))
+	builder.Field(5).(*array.Float32Builder).Append(float32(row) + 0.5)
+	builder.Field(6).(*array.Float64Builder).Append(float64(row) + 0.5)
+	builder.Field(7).(*array.StringBuilder).Append("hello world")
+	binBuilder := builder.Field(8).(*array.ListBuilder)
+	binBuilder.Append(true)
+	binBuilder.ValueBuilder().(*array.Uint8Builder).AppendValues([]uint8{uint8(row), 0}, nil)
+	vecBuilder := builder.Field(9).(*array.ListBuilder)
+	vecBuilder.Append(true)
+	vecBuilder.ValueBuilder().(*array.Float32Builder).AppendValues([]float32{float32(row), 0.1, 0.2, 0.3}, nil)
+}
+
+func Test_NewParquetParser(t *testing.T) {
+	ctx := context.Background()
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		return nil
+	}
+
+	parser := NewParquetParser(ctx, nil, 0, flushFunc)
+	assert.Nil(t, parser)
+
+	parser = NewParquetParser(ctx, sampleSchema(), 0, nil)
+	assert.Nil(t, parser)
+
+	parser = NewParquetParser(ctx, sampleSchema(), 0, flushFunc)
+	assert.NotNil(t, parser)
+	assert.Equal(t, int64(SingleBlockSize), parser.blockSize)
+}
+
+func Test_ParquetParserParse(t *testing.T) {
+	ctx := context.Background()
+	schema := sampleSchema()
+
+	rowCount := 0
+	flushCount := 0
+	var int64Values []int64
+	var vectorValues []float32
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		flushCount++
+		rowCount += fields[106].RowNum()
+		int64Values = append(int64Values, fields[106].(*storage.Int64FieldData).Data...)
+		vectorValues = append(vectorValues, fields[111].(*storage.FloatVectorFieldData).Data...)
+		for _, field := range schema.GetFields() {
+			assert.Equal(t, fields[106].RowNum(), fields[field.GetFieldID()].RowNum())
+		}
+		return nil
+	}
+	reset := func() {
+		rowCount, flushCount = 0, 0
+		int64Values, vectorValues = nil, nil
+	}
+
+	content := createParquetData(t, arrow.NewSchema(sampleParquetFields(), nil), 2, 5, appendSampleParquetRow)
+
+	t.Run("only validate", func(t *testing.T) {
+		reset()
+		parser := NewParquetParser(ctx, schema, SingleBlockSize, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), true)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, flushCount)
+	})
+
+	t.Run("read in one batch", func(t *testing.T) {
+		reset()
+		parser := NewParquetParser(ctx, schema, SingleBlockSize, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, flushCount)
+		assert.Equal(t, 10, rowCount)
+		assert.Equal(t, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, int64Values)
+		assert.Equal(t, []float32{9, 0.1, 0.2, 0.3}, vectorValues[36:])
+	})
+
+	t.Run("read row by row", func(t *testing.T) {
+		reset()
+		// each batch contains only one row if the block size is tiny
+		parser := NewParquetParser(ctx, schema, 1, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, flushCount)
+		assert.Equal(t, 10, rowCount)
+		assert.Equal(t, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, int64Values)
+	})
+
+	t.Run("fixed size list vectors", func(t *testing.T) {
+		reset()
+		fixedFields := sampleParquetFields()
+		fixedFields[8].Type = arrow.FixedSizeListOf(2, arrow.PrimitiveTypes.Uint8)
+		fixedFields[9].Type = arrow.FixedSizeListOf(4, arrow.PrimitiveTypes.Float32)
+		content := createParquetData(t, fixedSizeListSchema(sampleParquetFields(), fixedFields), 2, 5, appendSampleParquetRow)
+
+		parser := NewParquetParser(ctx, schema, 1, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, rowCount)
+		assert.Equal(t, []float32{9, 0.1, 0.2, 0.3}, vectorValues[36:])
+
+		// the list size must be equal to the dimension
+		fixedFields[9].Type = arrow.FixedSizeListOf(3, arrow.PrimitiveTypes.Float32)
+		content = createParquetData(t, fixedSizeListSchema(sampleParquetFields(), fixedFields), 1, 1, appendSampleParquetRow)
+		err = parser.Parse(bytes.NewReader(content), false)
+		assert.ErrorContains(t, err, "FieldFloatVector")
+	})
+
+	t.Run("illegal file", func(t *testing.T) {
+		parser := NewParquetParser(ctx, schema, SingleBlockSize, flushFunc)
+		err := parser.Parse(bytes.NewReader([]byte("dummy")), false)
+		assert.Error(t, err)
+	})
+
+	t.Run("redundant column", func(t *testing.T) {
+		fields := append(sampleParquetFields(), arrow.Field{Name: "dummy", Type: arrow.PrimitiveTypes.Int64})
+		content := createParquetData(t, arrow.NewSchema(fields, nil), 1, 1, func(builder *array.RecordBuilder, row int) {
+			appendSampleParquetRow(builder, row)
+			builder.Field(10).(*array.Int64Builder).Append(1)
+		})
+		parser := NewParquetParser(ctx, schema, SingleBlockSize, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.Error(t, err)
+	})
+
+	t.Run("missing column", func(t *testing.T) {
+		fields := sampleParquetFields()[1:]
+		// the columns are checked before reading the rows
+		content := createParquetData(t, arrow.NewSchema(fields, nil), 0, 0, nil)
+		parser := NewParquetParser(ctx, schema, SingleBlockSize, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.Error(t, err)
+	})
+
+	t.Run("auto-generated primary key", func(t *testing.T) {
+		autoIDSchema := sampleSchema()
+		autoIDSchema.Fields[4].AutoID = true
+		parser := NewParquetParser(ctx, autoIDSchema, SingleBlockSize, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.Error(t, err)
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		fields := sampleParquetFields()
+		fields[0].Type = arrow.PrimitiveTypes.Int64
+		// the columns are checked before reading the rows
+		content := createParquetData(t, arrow.NewSchema(fields, nil), 0, 0, nil)
+		parser := NewParquetParser(ctx, schema, SingleBlockSize, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.Error(t, err)
+	})
+
+	t.Run("null value", func(t *testing.T) {
+		nullInt32Row = 1
+		defer func() { nullInt32Row = -1 }()
+		content := createParquetData(t, arrow.NewSchema(sampleParquetFields(), nil), 1, 2, appendSampleParquetRow)
+		parser := NewParquetParser(ctx, schema, SingleBlockSize, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.ErrorContains(t, err, "at row 1 is null")
+	})
+
+	t.Run("dimension mismatch", func(t *testing.T) {
+		content := createParquetData(t, arrow.NewSchema(sampleParquetFields(), nil), 1, 2, func(builder *array.RecordBuilder, row int) {
+			appendSampleParquetRow(builder, row)
+			vecBuilder := builder.Field(9).(*array.ListBuilder)
+			vecBuilder.ValueBuilder().(*array.Float32Builder).Append(0.4)
+		})
+		parser := NewParquetParser(ctx, schema, SingleBlockSize, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.ErrorContains(t, err, "FieldFloatVector")
+	})
+
+	t.Run("value out of range", func(t *testing.T) {
+		fields := sampleParquetFields()
+		fields[1].Type = arrow.PrimitiveTypes.Int64
+		content := createParquetData(t, arrow.NewSchema(fields, nil), 1, 2, appendSampleParquetRow)
+		parser := NewParquetParser(ctx, schema, SingleBlockSize, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.ErrorContains(t, err, "out of range")
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		parser := NewParquetParser(cancelCtx, schema, SingleBlockSize, flushFunc)
+		err := parser.Parse(bytes.NewReader(content), false)
+		assert.Error(t, err)
+	})
+}
+
+func Test_ParquetParserNullableJSONArray(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "ID", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "FieldString", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{
+				{Key: "max_length", Value: "64"},
+				{Key: common.NullableKey, Value: "true"},
+			}},
+			{FieldID: 102, Name: "FieldJSON", DataType: typeutil.DataTypeJSON},
+			{FieldID: 103, Name: "FieldArray", DataType: typeutil.DataTypeArray, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.ElementTypeKey, Value: schemapb.DataType_Int32.String()},
+				{Key: "max_capacity", Value: "4"},
+			}},
+			{FieldID: 104, Name: "FieldFloatVector", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
+				{Key: "dim", Value: "2"},
+			}},
+		},
+	}
+	fields := []arrow.Field{
+		{Name: "ID", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "FieldString", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "FieldJSON", Type: arrow.BinaryTypes.String},
+		{Name: "FieldArray", Type: arrow.ListOf(arrow.PrimitiveTypes.Int64)},
+		// the float vector accepts double elements
+		{Name: "FieldFloatVector", Type: arrow.ListOf(arrow.PrimitiveTypes.Float64)},
+	}
+	jsonValue := `{"x": 1}`
+	appendRow := func(builder *array.RecordBuilder, row int) {
+		builder.Field(0).(*array.Int64Builder).Append(int64(row))
+		if row%2 == 0 {
+			builder.Field(1).(*array.StringBuilder).Append("a")
+		} else {
+			builder.Field(1).AppendNull()
+		}
+		builder.Field(2).(*array.StringBuilder).Append(jsonValue)
+		arrBuilder := builder.Field(3).(*array.ListBuilder)
+		arrBuilder.Append(true)
+		for i := 0; i <= row; i++ {
+			arrBuilder.ValueBuilder().(*array.Int64Builder).Append(int64(i))
+		}
+		vecBuilder := builder.Field(4).(*array.ListBuilder)
+		vecBuilder.Append(true)
+		vecBuilder.ValueBuilder().(*array.Float64Builder).AppendValues([]float64{1, 2}, nil)
+	}
+
+	var fieldsData map[storage.FieldID]storage.FieldData
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData) error {
+		fieldsData = fields
+		return nil
+	}
+
+	content := createParquetData(t, arrow.NewSchema(fields, nil), 1, 3, appendRow)
+	parser := NewParquetParser(ctx, schema, SingleBlockSize, flushFunc)
+	err := parser.Parse(bytes.NewReader(content), false)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, fieldsData[101].RowNum())
+	assert.Equal(t, []bool{true, false, true}, fieldsData[101].(*storage.StringFieldData).ValidData)
+	assert.Equal(t, []byte(`{"x": 1}`), fieldsData[102].GetRow(2))
+	assert.Equal(t, []int32{0, 1, 2}, fieldsData[103].GetRow(2).(*schemapb.ScalarField).GetIntData().GetData())
+	assert.Equal(t, []float32{1, 2, 1, 2, 1, 2}, fieldsData[104].(*storage.FloatVectorFieldData).Data)
+
+	// the nullable column is optional
+	content = createParquetData(t, arrow.NewSchema([]arrow.Field{fields[0], fields[2], fields[3], fields[4]}, nil), 1, 2,
+		func(builder *array.RecordBuilder, row int) {
+			builder.Field(0).(*array.Int64Builder).Append(int64(row))
+			builder.Field(1).(*array.StringBuilder).Append(`{"x": 1}`)
+			builder.Field(2).(*array.ListBuilder).Append(true)
+			vecBuilder := builder.Field(3).(*array.ListBuilder)
+			vecBuilder.Append(true)
+			vecBuilder.ValueBuilder().(*array.Float64Builder).AppendValues([]float64{1, 2}, nil)
+		})
+	err = parser.Parse(bytes.NewReader(content), false)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, fieldsData[101].RowNum())
+	assert.Equal(t, 2, fieldsData[103].RowNum())
+
+	// illegal JSON
+	jsonValue = "{"
+	content = createParquetData(t, arrow.NewSchema(fields, nil), 1, 1, appendRow)
+	err = parser.Parse(bytes.NewReader(content), false)
+	assert.ErrorContains(t, err, "FieldJSON")
+	jsonValue = `{"x": 1}`
+
+	// the array exceeds the max capacity
+	content = createParquetData(t, arrow.NewSchema(fields, nil), 1, 5, appendRow)
+	err = parser.Parse(bytes.NewReader(content), false)
+	assert.ErrorContains(t, err, "FieldArray")
+}
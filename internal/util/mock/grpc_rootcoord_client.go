@@ -113,6 +113,30 @@ func (m *GrpcRootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.
 	return &milvuspb.ShowCollectionsResponse{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) CreateDatabase(ctx context.Context, in *rootcoordpb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) DropDatabase(ctx context.Context, in *rootcoordpb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListDatabases(ctx context.Context, in *rootcoordpb.ListDatabasesRequest, opts ...grpc.CallOption) (*rootcoordpb.ListDatabasesResponse, error) {
+	return &rootcoordpb.ListDatabasesResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) RenameCollection(ctx context.Context, in *rootcoordpb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) TruncateCollection(ctx context.Context, in *rootcoordpb.TruncateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) CloneCollection(ctx context.Context, in *rootcoordpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -221,6 +245,54 @@ func (m *GrpcRootCoordClient) GetCredential(ctx context.Context, in *rootcoordpb
 	return &rootcoordpb.GetCredentialResponse{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) CreateApiKey(ctx context.Context, in *rootcoordpb.CreateApiKeyRequest, opts ...grpc.CallOption) (*rootcoordpb.CreateApiKeyResponse, error) {
+	return &rootcoordpb.CreateApiKeyResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListApiKeys(ctx context.Context, in *rootcoordpb.ListApiKeysRequest, opts ...grpc.CallOption) (*rootcoordpb.ListApiKeysResponse, error) {
+	return &rootcoordpb.ListApiKeysResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) RevokeApiKey(ctx context.Context, in *rootcoordpb.RevokeApiKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) GetApiKey(ctx context.Context, in *rootcoordpb.GetApiKeyRequest, opts ...grpc.CallOption) (*rootcoordpb.GetApiKeyResponse, error) {
+	return &rootcoordpb.GetApiKeyResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) Export(ctx context.Context, in *rootcoordpb.ExportRequest, opts ...grpc.CallOption) (*rootcoordpb.ExportResponse, error) {
+	return &rootcoordpb.ExportResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) GetExportState(ctx context.Context, in *rootcoordpb.GetExportStateRequest, opts ...grpc.CallOption) (*rootcoordpb.GetExportStateResponse, error) {
+	return &rootcoordpb.GetExportStateResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListExportTasks(ctx context.Context, in *rootcoordpb.ListExportTasksRequest, opts ...grpc.CallOption) (*rootcoordpb.ListExportTasksResponse, error) {
+	return &rootcoordpb.ListExportTasksResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) CreateBackup(ctx context.Context, in *rootcoordpb.CreateBackupRequest, opts ...grpc.CallOption) (*rootcoordpb.CreateBackupResponse, error) {
+	return &rootcoordpb.CreateBackupResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListBackups(ctx context.Context, in *rootcoordpb.ListBackupsRequest, opts ...grpc.CallOption) (*rootcoordpb.ListBackupsResponse, error) {
+	return &rootcoordpb.ListBackupsResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) VerifyBackup(ctx context.Context, in *rootcoordpb.VerifyBackupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) DeleteBackup(ctx context.Context, in *rootcoordpb.DeleteBackupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) RestoreBackup(ctx context.Context, in *rootcoordpb.RestoreBackupRequest, opts ...grpc.CallOption) (*rootcoordpb.RestoreBackupResponse, error) {
+	return &rootcoordpb.RestoreBackupResponse{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -145,12 +145,23 @@ type commonConfig struct {
 	GracefulTime             ParamItem
 	GracefulStopTimeout      ParamItem // unit: s
 
-	StorageType ParamItem
-	SimdType    ParamItem
+	StorageType     ParamItem
+	SimdType        ParamItem
+	EnableStorageV2 ParamItem
 
 	AuthorizationEnabled ParamItem
 	SuperUsers           ParamItem
 
+	JWTJwksFile      ParamItem
+	JWTIssuer        ParamItem
+	JWTAudience      ParamItem
+	JWTUsernameClaim ParamItem
+
+	EncryptionEnabled             ParamItem
+	EncryptionKMS                 ParamItem
+	EncryptionLocalKeyFile        ParamItem
+	EncryptionKeyRotationInterval ParamItem
+
 	ClusterName ParamItem
 
 	SessionTTL        ParamItem
@@ -439,6 +450,13 @@ func (p *commonConfig) init(base *BaseTable) {
 	}
 	p.StorageType.Init(base.mgr)
 
+	p.EnableStorageV2 = ParamItem{
+		Key:          "common.storage.enablev2",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+	}
+	p.EnableStorageV2.Init(base.mgr)
+
 	p.ThreadCoreCoefficient = ParamItem{
 		Key:          "common.threadCoreCoefficient",
 		Version:      "2.0.0",
@@ -459,6 +477,62 @@ func (p *commonConfig) init(base *BaseTable) {
 	}
 	p.SuperUsers.Init(base.mgr)
 
+	p.JWTJwksFile = ParamItem{
+		Key:          "common.security.jwt.jwksFile",
+		Version:      "2.2.3",
+		DefaultValue: "",
+	}
+	p.JWTJwksFile.Init(base.mgr)
+
+	p.JWTIssuer = ParamItem{
+		Key:          "common.security.jwt.issuer",
+		Version:      "2.2.3",
+		DefaultValue: "",
+	}
+	p.JWTIssuer.Init(base.mgr)
+
+	p.JWTAudience = ParamItem{
+		Key:          "common.security.jwt.audience",
+		Version:      "2.2.3",
+		DefaultValue: "",
+	}
+	p.JWTAudience.Init(base.mgr)
+
+	p.JWTUsernameClaim = ParamItem{
+		Key:          "common.security.jwt.usernameClaim",
+		Version:      "2.2.3",
+		DefaultValue: "sub",
+	}
+	p.JWTUsernameClaim.Init(base.mgr)
+
+	p.EncryptionEnabled = ParamItem{
+		Key:          "common.security.encryption.enabled",
+		Version:      "2.2.3",
+		DefaultValue: "false",
+	}
+	p.EncryptionEnabled.Init(base.mgr)
+
+	p.EncryptionKMS = ParamItem{
+		Key:          "common.security.encryption.kms",
+		Version:      "2.2.3",
+		DefaultValue: "local",
+	}
+	p.EncryptionKMS.Init(base.mgr)
+
+	p.EncryptionLocalKeyFile = ParamItem{
+		Key:          "common.security.encryption.localKeyFile",
+		Version:      "2.2.3",
+		DefaultValue: "",
+	}
+	p.EncryptionLocalKeyFile.Init(base.mgr)
+
+	p.EncryptionKeyRotationInterval = ParamItem{
+		Key:          "common.security.encryption.keyRotationInterval",
+		Version:      "2.2.3",
+		DefaultValue: "0",
+	}
+	p.EncryptionKeyRotationInterval.Init(base.mgr)
+
 	p.ClusterName = ParamItem{
 		Key:          "common.cluster.name",
 		Version:      "2.0.0",
@@ -487,10 +561,14 @@ func (p *commonConfig) init(base *BaseTable) {
 type rootCoordConfig struct {
 	DmlChannelNum               ParamItem
 	MaxPartitionNum             ParamItem
+	DefaultNumPartitions        ParamItem
 	MinSegmentSizeToEnableIndex ParamItem
 	ImportTaskExpiration        ParamItem
 	ImportTaskRetention         ParamItem
 	ImportTaskSubPath           ParamItem
+	ExportTaskRetention         ParamItem
+	ExportTaskSubPath           ParamItem
+	BackupSubPath               ParamItem
 	// CreatedTime                 ParamItem
 	// UpdatedTime                 ParamItem
 	EnableActiveStandby ParamItem
@@ -511,6 +589,13 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 	}
 	p.MaxPartitionNum.Init(base.mgr)
 
+	p.DefaultNumPartitions = ParamItem{
+		Key:          "rootCoord.defaultNumPartitions",
+		Version:      "2.2.3",
+		DefaultValue: "64",
+	}
+	p.DefaultNumPartitions.Init(base.mgr)
+
 	p.MinSegmentSizeToEnableIndex = ParamItem{
 		Key:          "rootCoord.minSegmentSizeToEnableIndex",
 		Version:      "2.0.0",
@@ -539,6 +624,27 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 	}
 	p.ImportTaskSubPath.Init(base.mgr)
 
+	p.ExportTaskRetention = ParamItem{
+		Key:          "rootCoord.exportTaskRetention",
+		Version:      "2.2.3",
+		DefaultValue: strconv.Itoa(24 * 60 * 60),
+	}
+	p.ExportTaskRetention.Init(base.mgr)
+
+	p.ExportTaskSubPath = ParamItem{
+		Key:          "rootCoord.exportTaskSubPath",
+		Version:      "2.2.3",
+		DefaultValue: "exporttask",
+	}
+	p.ExportTaskSubPath.Init(base.mgr)
+
+	p.BackupSubPath = ParamItem{
+		Key:          "rootCoord.backupSubPath",
+		Version:      "2.2.3",
+		DefaultValue: "backup",
+	}
+	p.BackupSubPath.Init(base.mgr)
+
 	p.EnableActiveStandby = ParamItem{
 		Key:          "rootCoord.enableActiveStandby",
 		Version:      "2.2.0",
@@ -130,6 +130,16 @@ func TestComponentParam(t *testing.T) {
 
 		params.Save("common.security.superUsers", "")
 		assert.Equal(t, []string{""}, Params.SuperUsers.GetAsStrings())
+
+		assert.Equal(t, "", Params.JWTJwksFile.GetValue())
+		assert.Equal(t, "sub", Params.JWTUsernameClaim.GetValue())
+
+		assert.False(t, Params.EncryptionEnabled.GetAsBool())
+		assert.Equal(t, "local", Params.EncryptionKMS.GetValue())
+		assert.Equal(t, "", Params.EncryptionLocalKeyFile.GetValue())
+		assert.Equal(t, time.Duration(0), Params.EncryptionKeyRotationInterval.GetAsDuration(time.Hour))
+
+		assert.False(t, Params.EnableStorageV2.GetAsBool())
 	})
 
 	t.Run("test rootCoordConfig", func(t *testing.T) {
@@ -137,10 +147,14 @@ func TestComponentParam(t *testing.T) {
 
 		assert.NotEqual(t, Params.MaxPartitionNum.GetAsInt64(), 0)
 		t.Logf("master MaxPartitionNum = %d", Params.MaxPartitionNum.GetAsInt64())
+		assert.Equal(t, int64(64), Params.DefaultNumPartitions.GetAsInt64())
 		assert.NotEqual(t, Params.MinSegmentSizeToEnableIndex.GetAsInt64(), 0)
 		t.Logf("master MinSegmentSizeToEnableIndex = %d", Params.MinSegmentSizeToEnableIndex.GetAsInt64())
 		assert.NotEqual(t, Params.ImportTaskExpiration.GetAsFloat(), 0)
 		t.Logf("master ImportTaskRetention = %f", Params.ImportTaskRetention.GetAsFloat())
+		assert.Equal(t, float64(24*60*60), Params.ExportTaskRetention.GetAsFloat())
+		assert.Equal(t, "exporttask", Params.ExportTaskSubPath.GetValue())
+		assert.Equal(t, "backup", Params.BackupSubPath.GetValue())
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("rootCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
 
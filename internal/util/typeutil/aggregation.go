@@ -0,0 +1,462 @@
+package typeutil
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+// MaxAggregateGroups is the max number of groups of an aggregation, group by is meant for low-cardinality fields.
+const MaxAggregateGroups = 10000
+
+// AggregateName returns the name of the aggregate in the query output fields, such as count(*) and avg(score).
+func AggregateName(aggregateType internalpb.AggregateType, fieldName string) string {
+	if aggregateType == internalpb.AggregateType_Count {
+		fieldName = "*"
+	}
+	return fmt.Sprintf("%s(%s)", strings.ToLower(aggregateType.String()), fieldName)
+}
+
+// aggregateState is the partial result of an aggregate over the rows of a group,
+// count is the number of rows aggregated, only one of the values is used according to the field type.
+type aggregateState struct {
+	count      int64
+	intValue   int64
+	floatValue float64
+	strValue   string
+}
+
+type aggregateGroup struct {
+	key    interface{}
+	states []*aggregateState
+}
+
+// Aggregator computes the aggregates of the retrieved rows, grouped by the group by field if it's set.
+// Querynodes aggregate the rows of their segments into partial results, which are merged by the proxy.
+type Aggregator struct {
+	aggregates []*internalpb.Aggregate
+	// fields are the aggregated fields of the aggregates, nil for count(*)
+	fields       []*schemapb.FieldSchema
+	groupByField *schemapb.FieldSchema
+	groups       map[interface{}]*aggregateGroup
+}
+
+// NewAggregator creates an Aggregator, groupByFieldID is 0 if the rows aren't grouped.
+func NewAggregator(schema *schemapb.CollectionSchema, aggregates []*internalpb.Aggregate, groupByFieldID int64) (*Aggregator, error) {
+	helper, err := CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	aggregator := &Aggregator{
+		aggregates: aggregates,
+		fields:     make([]*schemapb.FieldSchema, len(aggregates)),
+		groups:     make(map[interface{}]*aggregateGroup),
+	}
+	for i, aggregate := range aggregates {
+		if aggregate.GetType() == internalpb.AggregateType_Count {
+			continue
+		}
+		field, err := helper.GetFieldFromID(aggregate.GetFieldId())
+		if err != nil {
+			return nil, err
+		}
+		if err := validateAggregateField(aggregate.GetType(), field); err != nil {
+			return nil, err
+		}
+		aggregator.fields[i] = field
+	}
+	if groupByFieldID != 0 {
+		field, err := helper.GetFieldFromID(groupByFieldID)
+		if err != nil {
+			return nil, err
+		}
+		if !IsBoolType(field.GetDataType()) && !IsIntegerType(field.GetDataType()) && !IsStringType(field.GetDataType()) {
+			return nil, fmt.Errorf("field %s of type %s can't be grouped by", field.GetName(), field.GetDataType().String())
+		}
+		aggregator.groupByField = field
+	}
+	return aggregator, nil
+}
+
+// validateAggregateField checks whether the field could be aggregated, sum and avg are computed on
+// numeric fields, min and max are computed on numeric and string fields.
+func validateAggregateField(aggregateType internalpb.AggregateType, field *schemapb.FieldSchema) error {
+	dataType := field.GetDataType()
+	numeric := IsIntegerType(dataType) || IsFloatingType(dataType)
+	if numeric || (IsStringType(dataType) && (aggregateType == internalpb.AggregateType_Min || aggregateType == internalpb.AggregateType_Max)) {
+		return nil
+	}
+	return fmt.Errorf("%s is not supported on field %s of type %s", strings.ToLower(aggregateType.String()), field.GetName(), dataType.String())
+}
+
+func (a *Aggregator) getGroup(key interface{}) (*aggregateGroup, error) {
+	group, ok := a.groups[key]
+	if ok {
+		return group, nil
+	}
+	if len(a.groups) >= MaxAggregateGroups {
+		return nil, fmt.Errorf("the number of groups exceeds %d, group by should be on a low-cardinality field", MaxAggregateGroups)
+	}
+	group = &aggregateGroup{key: key, states: make([]*aggregateState, len(a.aggregates))}
+	for i := range group.states {
+		group.states[i] = &aggregateState{}
+	}
+	a.groups[key] = group
+	return group, nil
+}
+
+// mergeState merges the aggregate of count rows whose value is in other into state.
+func (a *Aggregator) mergeState(i int, state *aggregateState, other *aggregateState) {
+	if other.count == 0 {
+		return
+	}
+	dataType := schemapb.DataType_None
+	if a.fields[i] != nil {
+		dataType = a.fields[i].GetDataType()
+	}
+	switch a.aggregates[i].GetType() {
+	case internalpb.AggregateType_Sum, internalpb.AggregateType_Avg:
+		state.intValue += other.intValue
+		state.floatValue += other.floatValue
+	case internalpb.AggregateType_Min, internalpb.AggregateType_Max:
+		less := false
+		switch {
+		case IsIntegerType(dataType):
+			less = other.intValue < state.intValue
+		case IsFloatingType(dataType):
+			less = other.floatValue < state.floatValue
+		case IsStringType(dataType):
+			less = other.strValue < state.strValue
+		}
+		if state.count == 0 || less == (a.aggregates[i].GetType() == internalpb.AggregateType_Min) {
+			state.intValue, state.floatValue, state.strValue = other.intValue, other.floatValue, other.strValue
+		}
+	}
+	state.count += other.count
+}
+
+// rowState returns the state of the idx-th row of the aggregated field data.
+func rowState(fieldData *schemapb.FieldData, idx int) (*aggregateState, error) {
+	state := &aggregateState{count: 1}
+	if fieldData == nil {
+		return state, nil
+	}
+	scalars := fieldData.GetScalars()
+	var size int
+	switch fieldData.GetType() {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data := scalars.GetIntData().GetData()
+		if size = len(data); idx < size {
+			state.intValue = int64(data[idx])
+		}
+	case schemapb.DataType_Int64:
+		data := scalars.GetLongData().GetData()
+		if size = len(data); idx < size {
+			state.intValue = data[idx]
+		}
+	case schemapb.DataType_Float:
+		data := scalars.GetFloatData().GetData()
+		if size = len(data); idx < size {
+			state.floatValue = float64(data[idx])
+		}
+	case schemapb.DataType_Double:
+		data := scalars.GetDoubleData().GetData()
+		if size = len(data); idx < size {
+			state.floatValue = data[idx]
+		}
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		data := scalars.GetStringData().GetData()
+		if size = len(data); idx < size {
+			state.strValue = data[idx]
+		}
+	default:
+		return nil, fmt.Errorf("unsupported aggregated data type %s", fieldData.GetType().String())
+	}
+	if idx >= size {
+		return nil, fmt.Errorf("row %d of field %d out of range %d", idx, fieldData.GetFieldId(), size)
+	}
+	return state, nil
+}
+
+// AddRows aggregates the retrieved rows, fieldsData must contain the data of the aggregated fields and the group by field.
+func (a *Aggregator) AddRows(fieldsData []*schemapb.FieldData, numRows int) error {
+	var groupByData *schemapb.FieldData
+	if a.groupByField != nil {
+		if groupByData = GetFieldDataByID(fieldsData, a.groupByField.GetFieldID()); groupByData == nil {
+			return fmt.Errorf("group by field %s not found in retrieve results", a.groupByField.GetName())
+		}
+	}
+	aggregatedData := make([]*schemapb.FieldData, len(a.aggregates))
+	for i, field := range a.fields {
+		if field == nil {
+			continue
+		}
+		if aggregatedData[i] = GetFieldDataByID(fieldsData, field.GetFieldID()); aggregatedData[i] == nil {
+			return fmt.Errorf("aggregated field %s not found in retrieve results", field.GetName())
+		}
+	}
+
+	for row := 0; row < numRows; row++ {
+		var key interface{}
+		if groupByData != nil {
+			if key = GetScalarData(groupByData, int64(row)); key == nil {
+				return fmt.Errorf("row %d of group by field %s out of range", row, a.groupByField.GetName())
+			}
+		}
+		group, err := a.getGroup(key)
+		if err != nil {
+			return err
+		}
+		for i := range a.aggregates {
+			state, err := rowState(aggregatedData[i], row)
+			if err != nil {
+				return err
+			}
+			a.mergeState(i, group.states[i], state)
+		}
+	}
+	return nil
+}
+
+// sortedGroups returns the groups ordered by their keys.
+func (a *Aggregator) sortedGroups() []*aggregateGroup {
+	groups := make([]*aggregateGroup, 0, len(a.groups))
+	for _, group := range a.groups {
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		switch key := groups[i].key.(type) {
+		case bool:
+			return !key && groups[j].key.(bool)
+		case int32:
+			return key < groups[j].key.(int32)
+		case int64:
+			return key < groups[j].key.(int64)
+		case string:
+			return key < groups[j].key.(string)
+		}
+		return false
+	})
+	return groups
+}
+
+func newScalarFieldData(dataType schemapb.DataType, fieldID int64, fieldName string) *schemapb.FieldData {
+	scalars := &schemapb.ScalarField{}
+	switch dataType {
+	case schemapb.DataType_Bool:
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{}}
+	case schemapb.DataType_Int64:
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{}}
+	case schemapb.DataType_Float:
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{}}
+	case schemapb.DataType_Double:
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{}}
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{}}
+	}
+	return &schemapb.FieldData{
+		Type:      dataType,
+		FieldName: fieldName,
+		FieldId:   fieldID,
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}
+}
+
+// appendScalarValue appends the value to the scalar field data created by newScalarFieldData,
+// the value is converted to the data type of the field data.
+func appendScalarValue(fieldData *schemapb.FieldData, value interface{}) {
+	scalars := fieldData.GetScalars()
+	switch v := value.(type) {
+	case bool:
+		scalars.GetBoolData().Data = append(scalars.GetBoolData().Data, v)
+	case int32:
+		appendScalarValue(fieldData, int64(v))
+	case int64:
+		switch fieldData.GetType() {
+		case schemapb.DataType_Int64:
+			scalars.GetLongData().Data = append(scalars.GetLongData().Data, v)
+		case schemapb.DataType_Float, schemapb.DataType_Double:
+			appendScalarValue(fieldData, float64(v))
+		default:
+			scalars.GetIntData().Data = append(scalars.GetIntData().Data, int32(v))
+		}
+	case float64:
+		if fieldData.GetType() == schemapb.DataType_Float {
+			scalars.GetFloatData().Data = append(scalars.GetFloatData().Data, float32(v))
+		} else {
+			scalars.GetDoubleData().Data = append(scalars.GetDoubleData().Data, v)
+		}
+	case string:
+		scalars.GetStringData().Data = append(scalars.GetStringData().Data, v)
+	}
+}
+
+// stateValue returns the value of the aggregate state, in int64, float64 or string according to the field type.
+func (a *Aggregator) stateValue(i int, state *aggregateState) interface{} {
+	if a.fields[i] == nil {
+		return int64(0)
+	}
+	switch dataType := a.fields[i].GetDataType(); {
+	case IsIntegerType(dataType):
+		return state.intValue
+	case IsFloatingType(dataType):
+		return state.floatValue
+	default:
+		return state.strValue
+	}
+}
+
+// stateDataType returns the data type of the partial value of the i-th aggregate.
+func (a *Aggregator) stateDataType(i int) schemapb.DataType {
+	if a.fields[i] == nil {
+		return schemapb.DataType_Int64
+	}
+	switch dataType := a.fields[i].GetDataType(); {
+	case IsIntegerType(dataType):
+		return schemapb.DataType_Int64
+	case IsFloatingType(dataType):
+		return schemapb.DataType_Double
+	default:
+		return schemapb.DataType_VarChar
+	}
+}
+
+// PartialResults returns the partial aggregates to be merged by MergePartialResults. The first column is the group
+// keys if the rows are grouped, followed by a row count column and a value column of each aggregate.
+func (a *Aggregator) PartialResults() []*schemapb.FieldData {
+	var fieldsData []*schemapb.FieldData
+	var keys *schemapb.FieldData
+	if a.groupByField != nil {
+		keys = newScalarFieldData(a.groupByField.GetDataType(), a.groupByField.GetFieldID(), a.groupByField.GetName())
+		fieldsData = append(fieldsData, keys)
+	}
+	for i := range a.aggregates {
+		fieldsData = append(fieldsData,
+			newScalarFieldData(schemapb.DataType_Int64, a.aggregates[i].GetFieldId(), ""),
+			newScalarFieldData(a.stateDataType(i), a.aggregates[i].GetFieldId(), ""))
+	}
+
+	offset := len(fieldsData) - 2*len(a.aggregates)
+	for _, group := range a.sortedGroups() {
+		if keys != nil {
+			appendScalarValue(keys, group.key)
+		}
+		for i, state := range group.states {
+			appendScalarValue(fieldsData[offset+2*i], state.count)
+			appendScalarValue(fieldsData[offset+2*i+1], a.stateValue(i, state))
+		}
+	}
+	return fieldsData
+}
+
+// MergePartialResults merges the partial aggregates returned by PartialResults of another Aggregator.
+func (a *Aggregator) MergePartialResults(fieldsData []*schemapb.FieldData) error {
+	if len(fieldsData) == 0 {
+		return nil
+	}
+	offset := 0
+	if a.groupByField != nil {
+		offset = 1
+	}
+	if len(fieldsData) != offset+2*len(a.aggregates) {
+		return fmt.Errorf("the number of partial aggregates columns %d mismatch, expected %d", len(fieldsData), offset+2*len(a.aggregates))
+	}
+
+	numRows := len(fieldsData[offset].GetScalars().GetLongData().GetData())
+	for row := 0; row < numRows; row++ {
+		var key interface{}
+		if offset > 0 {
+			if key = GetScalarData(fieldsData[0], int64(row)); key == nil {
+				return fmt.Errorf("row %d of partial aggregates group keys out of range", row)
+			}
+		}
+		group, err := a.getGroup(key)
+		if err != nil {
+			return err
+		}
+		for i := range a.aggregates {
+			counts := fieldsData[offset+2*i].GetScalars().GetLongData().GetData()
+			if row >= len(counts) {
+				return fmt.Errorf("row %d of partial aggregates out of range", row)
+			}
+			state := &aggregateState{}
+			if a.fields[i] != nil {
+				if state, err = rowState(fieldsData[offset+2*i+1], row); err != nil {
+					return err
+				}
+			}
+			state.count = counts[row]
+			a.mergeState(i, group.states[i], state)
+		}
+	}
+	return nil
+}
+
+// Results returns the final aggregates of the groups ordered by the group keys, skipping the first offset groups.
+// The first column is the group by field if the rows are grouped, followed by a column of each aggregate named by
+// AggregateName. Without group by, there is always one row even if no rows are aggregated.
+func (a *Aggregator) Results(offset int64, limit int64) []*schemapb.FieldData {
+	groups := a.sortedGroups()
+	if a.groupByField == nil && len(groups) == 0 {
+		group, _ := a.getGroup(nil)
+		groups = append(groups, group)
+	}
+	if offset >= int64(len(groups)) {
+		groups = nil
+	} else {
+		groups = groups[offset:]
+	}
+	if limit != Unlimited && limit < int64(len(groups)) {
+		groups = groups[:limit]
+	}
+
+	var fieldsData []*schemapb.FieldData
+	var keys *schemapb.FieldData
+	if a.groupByField != nil {
+		keys = newScalarFieldData(a.groupByField.GetDataType(), a.groupByField.GetFieldID(), a.groupByField.GetName())
+		fieldsData = append(fieldsData, keys)
+	}
+	columns := make([]*schemapb.FieldData, len(a.aggregates))
+	for i, aggregate := range a.aggregates {
+		var dataType schemapb.DataType
+		var name string
+		switch aggregate.GetType() {
+		case internalpb.AggregateType_Count:
+			dataType, name = schemapb.DataType_Int64, AggregateName(aggregate.GetType(), "")
+		case internalpb.AggregateType_Avg:
+			dataType, name = schemapb.DataType_Double, AggregateName(aggregate.GetType(), a.fields[i].GetName())
+		case internalpb.AggregateType_Sum:
+			dataType, name = a.stateDataType(i), AggregateName(aggregate.GetType(), a.fields[i].GetName())
+		default:
+			dataType, name = a.fields[i].GetDataType(), AggregateName(aggregate.GetType(), a.fields[i].GetName())
+		}
+		columns[i] = newScalarFieldData(dataType, aggregate.GetFieldId(), name)
+	}
+	fieldsData = append(fieldsData, columns...)
+
+	for _, group := range groups {
+		if keys != nil {
+			appendScalarValue(keys, group.key)
+		}
+		for i, state := range group.states {
+			switch a.aggregates[i].GetType() {
+			case internalpb.AggregateType_Count:
+				appendScalarValue(columns[i], state.count)
+			case internalpb.AggregateType_Avg:
+				avg := float64(0)
+				if state.count > 0 {
+					avg = (float64(state.intValue) + state.floatValue) / float64(state.count)
+				}
+				appendScalarValue(columns[i], avg)
+			default:
+				appendScalarValue(columns[i], a.stateValue(i, state))
+			}
+		}
+	}
+	return fieldsData
+}
@@ -0,0 +1,155 @@
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAggregationTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "color", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int32},
+			{FieldID: 103, Name: "score", DataType: schemapb.DataType_Float},
+			{FieldID: 104, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+}
+
+func newAggregationTestRows(colors []string, ages []int32, scores []float32) []*schemapb.FieldData {
+	return []*schemapb.FieldData{
+		{
+			Type:    schemapb.DataType_VarChar,
+			FieldId: 101,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: colors}},
+			}},
+		},
+		{
+			Type:    schemapb.DataType_Int32,
+			FieldId: 102,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: ages}},
+			}},
+		},
+		{
+			Type:    schemapb.DataType_Float,
+			FieldId: 103,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: scores}},
+			}},
+		},
+	}
+}
+
+func TestAggregateName(t *testing.T) {
+	assert.Equal(t, "count(*)", AggregateName(internalpb.AggregateType_Count, ""))
+	assert.Equal(t, "avg(score)", AggregateName(internalpb.AggregateType_Avg, "score"))
+}
+
+func TestNewAggregator(t *testing.T) {
+	schema := newAggregationTestSchema()
+
+	_, err := NewAggregator(schema, []*internalpb.Aggregate{{Type: internalpb.AggregateType_Count}}, 101)
+	assert.NoError(t, err)
+	_, err = NewAggregator(schema, []*internalpb.Aggregate{{Type: internalpb.AggregateType_Max, FieldId: 101}}, 0)
+	assert.NoError(t, err)
+
+	// sum of string field
+	_, err = NewAggregator(schema, []*internalpb.Aggregate{{Type: internalpb.AggregateType_Sum, FieldId: 101}}, 0)
+	assert.Error(t, err)
+	// vector field
+	_, err = NewAggregator(schema, []*internalpb.Aggregate{{Type: internalpb.AggregateType_Min, FieldId: 104}}, 0)
+	assert.Error(t, err)
+	// field not exist
+	_, err = NewAggregator(schema, []*internalpb.Aggregate{{Type: internalpb.AggregateType_Min, FieldId: 105}}, 0)
+	assert.Error(t, err)
+	// group by float field
+	_, err = NewAggregator(schema, []*internalpb.Aggregate{{Type: internalpb.AggregateType_Count}}, 103)
+	assert.Error(t, err)
+}
+
+func TestAggregator(t *testing.T) {
+	schema := newAggregationTestSchema()
+	aggregates := []*internalpb.Aggregate{
+		{Type: internalpb.AggregateType_Count},
+		{Type: internalpb.AggregateType_Min, FieldId: 102},
+		{Type: internalpb.AggregateType_Max, FieldId: 103},
+		{Type: internalpb.AggregateType_Sum, FieldId: 102},
+		{Type: internalpb.AggregateType_Avg, FieldId: 103},
+	}
+
+	t.Run("group by", func(t *testing.T) {
+		// two segments on querynodes
+		segment1, err := NewAggregator(schema, aggregates, 101)
+		require.NoError(t, err)
+		err = segment1.AddRows(newAggregationTestRows([]string{"red", "blue", "red"}, []int32{10, 20, 30}, []float32{1, 2, 3}), 3)
+		require.NoError(t, err)
+		segment2, err := NewAggregator(schema, aggregates, 101)
+		require.NoError(t, err)
+		err = segment2.AddRows(newAggregationTestRows([]string{"blue", "green"}, []int32{5, 40}, []float32{6, 4}), 2)
+		require.NoError(t, err)
+
+		// merged in proxy
+		reduced, err := NewAggregator(schema, aggregates, 101)
+		require.NoError(t, err)
+		require.NoError(t, reduced.MergePartialResults(segment1.PartialResults()))
+		require.NoError(t, reduced.MergePartialResults(segment2.PartialResults()))
+
+		results := reduced.Results(0, Unlimited)
+		require.Equal(t, 6, len(results))
+		assert.Equal(t, "color", results[0].GetFieldName())
+		assert.Equal(t, []string{"blue", "green", "red"}, results[0].GetScalars().GetStringData().GetData())
+		assert.Equal(t, "count(*)", results[1].GetFieldName())
+		assert.Equal(t, []int64{2, 1, 2}, results[1].GetScalars().GetLongData().GetData())
+		assert.Equal(t, "min(age)", results[2].GetFieldName())
+		assert.Equal(t, schemapb.DataType_Int32, results[2].GetType())
+		assert.Equal(t, []int32{5, 40, 10}, results[2].GetScalars().GetIntData().GetData())
+		assert.Equal(t, "max(score)", results[3].GetFieldName())
+		assert.Equal(t, []float32{6, 4, 3}, results[3].GetScalars().GetFloatData().GetData())
+		assert.Equal(t, "sum(age)", results[4].GetFieldName())
+		assert.Equal(t, []int64{25, 40, 40}, results[4].GetScalars().GetLongData().GetData())
+		assert.Equal(t, "avg(score)", results[5].GetFieldName())
+		assert.Equal(t, []float64{4, 4, 2}, results[5].GetScalars().GetDoubleData().GetData())
+
+		// offset and limit apply to the groups
+		results = reduced.Results(1, 1)
+		assert.Equal(t, []string{"green"}, results[0].GetScalars().GetStringData().GetData())
+		results = reduced.Results(3, Unlimited)
+		assert.Equal(t, 0, len(results[0].GetScalars().GetStringData().GetData()))
+	})
+
+	t.Run("without group by", func(t *testing.T) {
+		aggregator, err := NewAggregator(schema, aggregates, 0)
+		require.NoError(t, err)
+		results := aggregator.Results(0, Unlimited)
+		require.Equal(t, 5, len(results))
+		assert.Equal(t, []int64{0}, results[0].GetScalars().GetLongData().GetData())
+
+		err = aggregator.AddRows(newAggregationTestRows([]string{"red", "blue"}, []int32{10, 20}, []float32{1, 2}), 2)
+		require.NoError(t, err)
+		reduced, err := NewAggregator(schema, aggregates, 0)
+		require.NoError(t, err)
+		require.NoError(t, reduced.MergePartialResults(aggregator.PartialResults()))
+		results = reduced.Results(0, Unlimited)
+		assert.Equal(t, []int64{2}, results[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int32{10}, results[1].GetScalars().GetIntData().GetData())
+		assert.Equal(t, []float64{1.5}, results[4].GetScalars().GetDoubleData().GetData())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		aggregator, err := NewAggregator(schema, aggregates, 101)
+		require.NoError(t, err)
+		// group by field not retrieved
+		assert.Error(t, aggregator.AddRows(newAggregationTestRows([]string{"red"}, []int32{10}, []float32{1})[1:], 1))
+		// rows out of range
+		assert.Error(t, aggregator.AddRows(newAggregationTestRows([]string{"red"}, []int32{10}, []float32{1}), 2))
+		// columns mismatch
+		assert.Error(t, aggregator.MergePartialResults(aggregator.PartialResults()[1:]))
+	})
+}
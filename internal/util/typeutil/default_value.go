@@ -0,0 +1,272 @@
+package typeutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+// IsFieldNullable returns true if the field accepts rows without value.
+func IsFieldNullable(field *schemapb.FieldSchema) bool {
+	value, err := NewKvPairs(field.GetTypeParams()).Get(common.NullableKey)
+	if err != nil {
+		return false
+	}
+	nullable, err := strconv.ParseBool(value)
+	return err == nil && nullable
+}
+
+// GetDefaultValue returns the default value of the field in text, ok is false if the field has no default value.
+func GetDefaultValue(field *schemapb.FieldSchema) (value string, ok bool) {
+	value, err := NewKvPairs(field.GetTypeParams()).Get(common.DefaultValueKey)
+	return value, err == nil
+}
+
+// HasDefaultValue returns true if the rows without value of the field could be filled, that is,
+// the field is nullable or has a default value.
+func HasDefaultValue(field *schemapb.FieldSchema) bool {
+	_, ok := GetDefaultValue(field)
+	return ok || IsFieldNullable(field)
+}
+
+// parseDefaultValue parses the default value of the field into the go type of the field data,
+// nullable fields without default value get the zero value.
+func parseDefaultValue(field *schemapb.FieldSchema) (interface{}, error) {
+	text, ok := GetDefaultValue(field)
+	if !ok && !IsFieldNullable(field) {
+		return nil, fmt.Errorf("field %s has no default value and is not nullable", field.GetName())
+	}
+	invalid := func() error {
+		return fmt.Errorf("invalid default value %s of field %s with type %s", text, field.GetName(), field.GetDataType().String())
+	}
+
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		if !ok {
+			return false, nil
+		}
+		value, err := strconv.ParseBool(text)
+		if err != nil {
+			return nil, invalid()
+		}
+		return value, nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		if !ok {
+			return int32(0), nil
+		}
+		bitSize := map[schemapb.DataType]int{schemapb.DataType_Int8: 8, schemapb.DataType_Int16: 16, schemapb.DataType_Int32: 32}[field.GetDataType()]
+		value, err := strconv.ParseInt(text, 10, bitSize)
+		if err != nil {
+			return nil, invalid()
+		}
+		return int32(value), nil
+	case schemapb.DataType_Int64:
+		if !ok {
+			return int64(0), nil
+		}
+		value, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return nil, invalid()
+		}
+		return value, nil
+	case schemapb.DataType_Float:
+		if !ok {
+			return float32(0), nil
+		}
+		value, err := strconv.ParseFloat(text, 32)
+		if err != nil {
+			return nil, invalid()
+		}
+		return float32(value), nil
+	case schemapb.DataType_Double:
+		if !ok {
+			return float64(0), nil
+		}
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, invalid()
+		}
+		return value, nil
+	case schemapb.DataType_VarChar:
+		maxLength, err := NewKvPairs(field.GetTypeParams()).Get("max_length")
+		if err == nil {
+			if limit, err := strconv.Atoi(maxLength); err == nil && len(text) > limit {
+				return nil, fmt.Errorf("the length of default value of field %s exceeds max length %d", field.GetName(), limit)
+			}
+		}
+		return text, nil
+	case DataTypeJSON:
+		if !ok {
+			return []byte("{}"), nil
+		}
+		var obj map[string]interface{}
+		if err := json.Unmarshal([]byte(text), &obj); err != nil || obj == nil {
+			return nil, invalid()
+		}
+		return []byte(text), nil
+	case DataTypeArray:
+		if ok {
+			return nil, fmt.Errorf("default value is not supported by array field %s", field.GetName())
+		}
+		// the marshaled empty array
+		return []byte{}, nil
+	default:
+		return nil, fmt.Errorf("default value is not supported by field %s with type %s", field.GetName(), field.GetDataType().String())
+	}
+}
+
+// ValidateDefaultValue checks the default value of the field could be parsed as the field data type.
+func ValidateDefaultValue(field *schemapb.FieldSchema) error {
+	_, err := parseDefaultValue(field)
+	return err
+}
+
+// GenDefaultFieldData generates numRows rows of the default value of the field,
+// nullable fields without default value are filled with zero values.
+func GenDefaultFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	value, err := parseDefaultValue(field)
+	if err != nil {
+		return nil, err
+	}
+
+	scalars := &schemapb.ScalarField{}
+	switch v := value.(type) {
+	case bool:
+		data := make([]bool, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case int32:
+		data := make([]int32, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case int64:
+		data := make([]int64, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case float32:
+		data := make([]float32, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+	case float64:
+		data := make([]float64, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+	case string:
+		data := make([]string, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	case []byte:
+		data := make([][]byte, numRows)
+		for i := range data {
+			data[i] = v
+		}
+		scalars.Data = &schemapb.ScalarField_BytesData{BytesData: &schemapb.BytesArray{Data: data}}
+	}
+
+	return &schemapb.FieldData{
+		Type:      field.GetDataType(),
+		FieldName: field.GetName(),
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+		FieldId:   field.GetFieldID(),
+	}, nil
+}
+
+// IsNullByDefault returns true if the rows without value of the field are null,
+// that is, the field is nullable and has no default value.
+func IsNullByDefault(field *schemapb.FieldSchema) bool {
+	_, ok := GetDefaultValue(field)
+	return !ok && IsFieldNullable(field)
+}
+
+// missingFields returns the nullable or defaulted fields absent in fieldsData.
+func missingFields(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData) []*schemapb.FieldSchema {
+	// the field data from sdk have no field id yet, they are matched by name.
+	existIDs := make(map[int64]struct{}, len(fieldsData))
+	existNames := make(map[string]struct{}, len(fieldsData))
+	for _, fieldData := range fieldsData {
+		existIDs[fieldData.GetFieldId()] = struct{}{}
+		existNames[fieldData.GetFieldName()] = struct{}{}
+	}
+	var fields []*schemapb.FieldSchema
+	for _, field := range schema.GetFields() {
+		if !HasDefaultValue(field) {
+			continue
+		}
+		_, idExists := existIDs[field.GetFieldID()]
+		_, nameExists := existNames[field.GetName()]
+		if idExists || nameExists {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// FillMissingFieldData appends the default values of the nullable or defaulted fields absent in fieldsData,
+// which happens to the data written before the fields were added to the collection.
+func FillMissingFieldData(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData, numRows int) ([]*schemapb.FieldData, error) {
+	for _, field := range missingFields(schema, fieldsData) {
+		fieldData, err := GenDefaultFieldData(field, numRows)
+		if err != nil {
+			return nil, err
+		}
+		fieldsData = append(fieldsData, fieldData)
+	}
+	return fieldsData, nil
+}
+
+// FillMissingValidData marks all rows of the nullable fields without default value absent in fieldsData as null,
+// it should be called before FillMissingFieldData fills the zero values of them.
+func FillMissingValidData(schema *schemapb.CollectionSchema, fieldsData []*schemapb.FieldData,
+	validData []*internalpb.FieldValidData, numRows int) []*internalpb.FieldValidData {
+	for _, field := range missingFields(schema, fieldsData) {
+		if !IsNullByDefault(field) {
+			continue
+		}
+		validData = append(validData, &internalpb.FieldValidData{
+			FieldId: field.GetFieldID(),
+			Valid:   make([]bool, numRows),
+		})
+	}
+	return validData
+}
+
+// GetValidData returns the validity of the rows of the field, nil means all the rows are valid.
+func GetValidData(validData []*internalpb.FieldValidData, fieldID int64) []bool {
+	for _, data := range validData {
+		if data.GetFieldId() == fieldID {
+			return data.GetValid()
+		}
+	}
+	return nil
+}
+
+// AppendValidData appends the validity of the idx-th row in src to dst, like AppendFieldData.
+func AppendValidData(dst []*internalpb.FieldValidData, src []*internalpb.FieldValidData, idx int64) []*internalpb.FieldValidData {
+	if len(dst) == 0 && len(src) > 0 {
+		dst = make([]*internalpb.FieldValidData, len(src))
+		for i, data := range src {
+			dst[i] = &internalpb.FieldValidData{FieldId: data.GetFieldId()}
+		}
+	}
+	for i, data := range src {
+		dst[i].Valid = append(dst[i].Valid, data.GetValid()[idx])
+	}
+	return dst
+}
@@ -0,0 +1,141 @@
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDefaultValueField(dataType schemapb.DataType, params ...*commonpb.KeyValuePair) *schemapb.FieldSchema {
+	return &schemapb.FieldSchema{
+		FieldID:    101,
+		Name:       "field",
+		DataType:   dataType,
+		TypeParams: params,
+	}
+}
+
+func TestValidateDefaultValue(t *testing.T) {
+	nullable := &commonpb.KeyValuePair{Key: common.NullableKey, Value: "true"}
+	defaultValue := func(value string) *commonpb.KeyValuePair {
+		return &commonpb.KeyValuePair{Key: common.DefaultValueKey, Value: value}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		fields := []*schemapb.FieldSchema{
+			newDefaultValueField(schemapb.DataType_Bool, defaultValue("true")),
+			newDefaultValueField(schemapb.DataType_Int8, defaultValue("127")),
+			newDefaultValueField(schemapb.DataType_Int64, defaultValue("-1")),
+			newDefaultValueField(schemapb.DataType_Float, defaultValue("1.5")),
+			newDefaultValueField(schemapb.DataType_Double, nullable),
+			newDefaultValueField(schemapb.DataType_VarChar, defaultValue("abc"), &commonpb.KeyValuePair{Key: "max_length", Value: "3"}),
+			newDefaultValueField(DataTypeJSON, defaultValue(`{"a": 1}`)),
+			newDefaultValueField(DataTypeArray, nullable),
+		}
+		for _, field := range fields {
+			assert.True(t, HasDefaultValue(field))
+			assert.NoError(t, ValidateDefaultValue(field), field.GetDataType().String())
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		fields := []*schemapb.FieldSchema{
+			newDefaultValueField(schemapb.DataType_Int64),
+			newDefaultValueField(schemapb.DataType_Bool, defaultValue("yes")),
+			newDefaultValueField(schemapb.DataType_Int8, defaultValue("128")),
+			newDefaultValueField(schemapb.DataType_Float, defaultValue("abc")),
+			newDefaultValueField(schemapb.DataType_VarChar, defaultValue("abcd"), &commonpb.KeyValuePair{Key: "max_length", Value: "3"}),
+			newDefaultValueField(DataTypeJSON, defaultValue("[1]")),
+			newDefaultValueField(DataTypeArray, defaultValue("[1]")),
+			newDefaultValueField(schemapb.DataType_FloatVector, nullable),
+		}
+		for _, field := range fields {
+			assert.Error(t, ValidateDefaultValue(field), field.GetDataType().String())
+		}
+	})
+}
+
+func TestGenDefaultFieldData(t *testing.T) {
+	field := newDefaultValueField(schemapb.DataType_Int16, &commonpb.KeyValuePair{Key: common.DefaultValueKey, Value: "7"})
+	fieldData, err := GenDefaultFieldData(field, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Int16, fieldData.GetType())
+	assert.Equal(t, int64(101), fieldData.GetFieldId())
+	assert.Equal(t, []int32{7, 7, 7}, fieldData.GetScalars().GetIntData().GetData())
+
+	field = newDefaultValueField(schemapb.DataType_VarChar, &commonpb.KeyValuePair{Key: common.NullableKey, Value: "true"})
+	fieldData, err = GenDefaultFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", ""}, fieldData.GetScalars().GetStringData().GetData())
+
+	_, err = GenDefaultFieldData(newDefaultValueField(schemapb.DataType_Int64), 2)
+	assert.Error(t, err)
+}
+
+func TestFillMissingFieldData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "18"}}},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Double,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}},
+		},
+	}
+	fieldsData := []*schemapb.FieldData{
+		{FieldName: "pk", Type: schemapb.DataType_Int64},
+		{FieldName: "score", Type: schemapb.DataType_Double},
+	}
+
+	filled, err := FillMissingFieldData(schema, fieldsData, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(filled))
+	assert.Equal(t, "age", filled[2].GetFieldName())
+	assert.Equal(t, []int64{18, 18}, filled[2].GetScalars().GetLongData().GetData())
+
+	// nothing to fill
+	filled, err = FillMissingFieldData(schema, filled, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(filled))
+}
+
+func TestFillMissingValidData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}, {Key: common.DefaultValueKey, Value: "18"}}},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Double,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}},
+		},
+	}
+	assert.False(t, IsNullByDefault(schema.Fields[1]))
+	assert.True(t, IsNullByDefault(schema.Fields[2]))
+
+	// the absent nullable field with default value is filled with the default value
+	validData := FillMissingValidData(schema, []*schemapb.FieldData{{FieldName: "pk"}}, nil, 2)
+	assert.Equal(t, 1, len(validData))
+	assert.Equal(t, []bool{false, false}, GetValidData(validData, 102))
+	assert.Nil(t, GetValidData(validData, 101))
+
+	validData = FillMissingValidData(schema, []*schemapb.FieldData{{FieldName: "pk"}, {FieldName: "score"}}, nil, 2)
+	assert.Empty(t, validData)
+}
+
+func TestAppendValidData(t *testing.T) {
+	src := []*internalpb.FieldValidData{
+		{FieldId: 101, Valid: []bool{true, false, true}},
+		{FieldId: 102, Valid: []bool{false, true, true}},
+	}
+	var dst []*internalpb.FieldValidData
+	dst = AppendValidData(dst, src, 1)
+	dst = AppendValidData(dst, src, 2)
+	assert.Equal(t, []bool{false, true}, GetValidData(dst, 101))
+	assert.Equal(t, []bool{true, true}, GetValidData(dst, 102))
+
+	assert.Nil(t, AppendValidData(nil, nil, 0))
+}
@@ -23,6 +23,8 @@ func fieldDataEmpty(data *schemapb.FieldData) bool {
 			return len(realScalars.DoubleData.GetData()) <= 0
 		case *schemapb.ScalarField_StringData:
 			return len(realScalars.StringData.GetData()) <= 0
+		case *schemapb.ScalarField_BytesData:
+			return len(realScalars.BytesData.GetData()) <= 0
 		}
 	case *schemapb.FieldData_Vectors:
 		switch realVectors := realData.Vectors.Data.(type) {
@@ -100,6 +102,19 @@ func genEmptyVarCharFieldData(field *schemapb.FieldSchema) *schemapb.FieldData {
 	}
 }
 
+func genEmptyBytesFieldData(field *schemapb.FieldSchema) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      field.GetDataType(),
+		FieldName: field.GetName(),
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_BytesData{BytesData: &schemapb.BytesArray{Data: nil}},
+			},
+		},
+		FieldId: field.GetFieldID(),
+	}
+}
+
 func genEmptyBinaryVectorFieldData(field *schemapb.FieldSchema) (*schemapb.FieldData, error) {
 	dim, err := GetDim(field)
 	if err != nil {
@@ -153,6 +168,8 @@ func genEmptyFieldData(field *schemapb.FieldSchema) (*schemapb.FieldData, error)
 		return genEmptyDoubleFieldData(field), nil
 	case schemapb.DataType_VarChar:
 		return genEmptyVarCharFieldData(field), nil
+	case DataTypeJSON, DataTypeArray:
+		return genEmptyBytesFieldData(field), nil
 	case schemapb.DataType_BinaryVector:
 		return genEmptyBinaryVectorFieldData(field)
 	case schemapb.DataType_FloatVector:
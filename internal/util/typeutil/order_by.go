@@ -0,0 +1,137 @@
+package typeutil
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+// ResultWithFields is the retrieve results whose rows could be sorted by the fields.
+type ResultWithFields interface {
+	ResultWithID
+	GetFieldsData() []*schemapb.FieldData
+}
+
+// IsOrderableType returns true if the query results could be ordered by the field of dataType.
+func IsOrderableType(dataType schemapb.DataType) bool {
+	return IsBoolType(dataType) || IsIntegerType(dataType) || IsFloatingType(dataType) || IsStringType(dataType)
+}
+
+// compareScalar compares two values returned by GetScalarData of the same data type.
+func compareScalar(a, b interface{}) int {
+	switch va := a.(type) {
+	case bool:
+		vb := b.(bool)
+		if va == vb {
+			return 0
+		} else if !va {
+			return -1
+		}
+		return 1
+	case int32:
+		return compareOrdered(va, b.(int32))
+	case int64:
+		return compareOrdered(va, b.(int64))
+	case float32:
+		return compareOrdered(va, b.(float32))
+	case float64:
+		return compareOrdered(va, b.(float64))
+	case string:
+		return compareOrdered(va, b.(string))
+	}
+	return 0
+}
+
+func compareOrdered[T int32 | int64 | float32 | float64 | string](a, b T) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+// orderByColumns returns the field data of the order by fields in fieldsData.
+func orderByColumns(fieldsData []*schemapb.FieldData, orderBy []*internalpb.OrderByField) ([]*schemapb.FieldData, error) {
+	columns := make([]*schemapb.FieldData, len(orderBy))
+	for i, field := range orderBy {
+		columns[i] = GetFieldDataByID(fieldsData, field.GetFieldId())
+		if columns[i] == nil {
+			return nil, fmt.Errorf("order by field %d not found in retrieve results", field.GetFieldId())
+		}
+	}
+	return columns, nil
+}
+
+// compareRows compares the a-th row of result a and the b-th row of result b by the order by fields,
+// the rows with equal order by fields are ordered by primary key.
+func compareRows(aColumns []*schemapb.FieldData, aIDs *schemapb.IDs, a int64,
+	bColumns []*schemapb.FieldData, bIDs *schemapb.IDs, b int64, orderBy []*internalpb.OrderByField) int {
+	for i, field := range orderBy {
+		c := compareScalar(GetScalarData(aColumns[i], a), GetScalarData(bColumns[i], b))
+		if c != 0 {
+			if !field.GetAscending() {
+				return -c
+			}
+			return c
+		}
+	}
+	return compareScalar(GetPK(aIDs, a), GetPK(bIDs, b))
+}
+
+// SortRetrieveRows returns the offsets of the first limit rows of the result ordered by the order by fields.
+func SortRetrieveRows(result ResultWithFields, orderBy []*internalpb.OrderByField, limit int64) ([]int64, error) {
+	columns, err := orderByColumns(result.GetFieldsData(), orderBy)
+	if err != nil {
+		return nil, err
+	}
+	ids := result.GetIds()
+	rows := make([]int64, GetSizeOfIDs(ids))
+	for i := range rows {
+		rows[i] = int64(i)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return compareRows(columns, ids, rows[i], columns, ids, rows[j], orderBy) < 0
+	})
+	if limit != Unlimited && limit < int64(len(rows)) {
+		rows = rows[:limit]
+	}
+	return rows, nil
+}
+
+// SelectRows returns the primary keys and the fields data of the rows at offsets.
+func SelectRows(ids *schemapb.IDs, fieldsData []*schemapb.FieldData, offsets []int64) (*schemapb.IDs, []*schemapb.FieldData) {
+	selectedIDs := &schemapb.IDs{}
+	selectedData := make([]*schemapb.FieldData, len(fieldsData))
+	for _, offset := range offsets {
+		AppendPKs(selectedIDs, GetPK(ids, offset))
+		AppendFieldData(selectedData, fieldsData, offset)
+	}
+	return selectedIDs, selectedData
+}
+
+// SelectMinByOrder selects the index of the result whose row at cursor comes first by the order by fields,
+// it's the k-way merge counterpart of SelectMinPK for the results sorted by SortRetrieveRows.
+// The results are ordered by primary key if orderBy is empty.
+func SelectMinByOrder[T ResultWithFields](results []T, cursors []int64, orderBy []*internalpb.OrderByField) (int, error) {
+	if len(orderBy) == 0 {
+		return SelectMinPK(results, cursors), nil
+	}
+	sel := -1
+	var selColumns []*schemapb.FieldData
+	for i, cursor := range cursors {
+		if int(cursor) >= GetSizeOfIDs(results[i].GetIds()) {
+			continue
+		}
+		columns, err := orderByColumns(results[i].GetFieldsData(), orderBy)
+		if err != nil {
+			return -1, err
+		}
+		if sel == -1 || compareRows(columns, results[i].GetIds(), cursor, selColumns, results[sel].GetIds(), cursors[sel], orderBy) < 0 {
+			sel, selColumns = i, columns
+		}
+	}
+	return sel, nil
+}
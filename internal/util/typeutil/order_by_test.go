@@ -0,0 +1,77 @@
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOrderByTestResult(pks []int64, colors []string, scores []float32) *internalpb.RetrieveResults {
+	return &internalpb.RetrieveResults{
+		Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:    schemapb.DataType_VarChar,
+				FieldId: 101,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: colors}},
+				}},
+			},
+			genFieldData("score", 102, schemapb.DataType_Float, scores, 1),
+		},
+	}
+}
+
+func TestSortRetrieveRows(t *testing.T) {
+	result := newOrderByTestResult([]int64{1, 2, 3, 4}, []string{"red", "blue", "red", "blue"}, []float32{1, 2, 3, 2})
+	orderBy := []*internalpb.OrderByField{{FieldId: 101, Ascending: true}, {FieldId: 102, Ascending: false}}
+
+	rows, err := SortRetrieveRows(result, orderBy, Unlimited)
+	require.NoError(t, err)
+	// blue rows have the same score, they are ordered by primary key
+	assert.Equal(t, []int64{1, 3, 2, 0}, rows)
+
+	rows, err = SortRetrieveRows(result, orderBy, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, rows)
+
+	ids, fieldsData := SelectRows(result.GetIds(), result.GetFieldsData(), rows)
+	assert.Equal(t, []int64{2, 4}, ids.GetIntId().GetData())
+	assert.Equal(t, []string{"blue", "blue"}, fieldsData[0].GetScalars().GetStringData().GetData())
+	assert.Equal(t, []float32{2, 2}, fieldsData[1].GetScalars().GetFloatData().GetData())
+
+	_, err = SortRetrieveRows(result, []*internalpb.OrderByField{{FieldId: 103}}, Unlimited)
+	assert.Error(t, err)
+}
+
+func TestSelectMinByOrder(t *testing.T) {
+	results := []*internalpb.RetrieveResults{
+		newOrderByTestResult([]int64{1, 3}, []string{"a", "c"}, []float32{3, 1}),
+		newOrderByTestResult([]int64{2}, []string{"b"}, []float32{2}),
+	}
+
+	orderBy := []*internalpb.OrderByField{{FieldId: 102, Ascending: false}}
+	cursors := make([]int64, len(results))
+	var pks []int64
+	for {
+		sel, err := SelectMinByOrder(results, cursors, orderBy)
+		require.NoError(t, err)
+		if sel == -1 {
+			break
+		}
+		pks = append(pks, GetPK(results[sel].GetIds(), cursors[sel]).(int64))
+		cursors[sel]++
+	}
+	assert.Equal(t, []int64{1, 2, 3}, pks)
+
+	// ordered by primary key without order by fields
+	sel, err := SelectMinByOrder(results, []int64{1, 0}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, sel)
+
+	_, err = SelectMinByOrder(results, []int64{0, 0}, []*internalpb.OrderByField{{FieldId: 103}})
+	assert.Error(t, err)
+}
@@ -29,6 +29,21 @@ import (
 	"go.uber.org/zap"
 )
 
+// DataTypeJSON is the data type of the fields storing JSON documents. The schema proto doesn't
+// define it yet, the documents are transferred verbatim in the BytesData of the scalar field data.
+const DataTypeJSON schemapb.DataType = 23
+
+// defaultJSONAvgLength is the estimated average size of a JSON document, JSON fields have no max length.
+const defaultJSONAvgLength = 256
+
+// DataTypeArray is the data type of the fields storing a list of scalars of the same element type per row.
+// The schema proto doesn't define it yet, every row is transferred as a marshaled schemapb.ScalarField in the
+// BytesData of the scalar field data.
+const DataTypeArray schemapb.DataType = 22
+
+// MaxArrayCapacity is the upper limit of the max capacity of array fields.
+const MaxArrayCapacity = 4096
+
 func GetAvgLengthOfVarLengthField(fieldSchema *schemapb.FieldSchema) (int, error) {
 	maxLength := 0
 	var err error
@@ -50,6 +65,13 @@ func GetAvgLengthOfVarLengthField(fieldSchema *schemapb.FieldSchema) (int, error
 		if err != nil {
 			return 0, err
 		}
+	case DataTypeJSON:
+		return defaultJSONAvgLength, nil
+	case DataTypeArray:
+		maxLength, err = estimateArrayRowSize(fieldSchema)
+		if err != nil {
+			return 0, err
+		}
 	default:
 		return 0, fmt.Errorf("field %s is not a variable-length type", fieldSchema.DataType.String())
 	}
@@ -75,7 +97,7 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 			res += 4
 		case schemapb.DataType_Int64, schemapb.DataType_Double:
 			res += 8
-		case schemapb.DataType_VarChar:
+		case schemapb.DataType_VarChar, DataTypeJSON, DataTypeArray:
 			maxLengthPerRow, err := GetAvgLengthOfVarLengthField(fs)
 			if err != nil {
 				return 0, err
@@ -126,6 +148,11 @@ func EstimateEntitySize(fieldsData []*schemapb.FieldData, rowOffset int) (int, e
 			}
 			//TODO:: check len(varChar) <= maxLengthPerRow
 			res += len(fs.GetScalars().GetStringData().Data[rowOffset])
+		case DataTypeJSON, DataTypeArray:
+			if rowOffset >= len(fs.GetScalars().GetBytesData().GetData()) {
+				return 0, fmt.Errorf("offset out range of field datas")
+			}
+			res += len(fs.GetScalars().GetBytesData().Data[rowOffset])
 		case schemapb.DataType_BinaryVector:
 			res += int(fs.GetVectors().GetDim())
 		case schemapb.DataType_FloatVector:
@@ -271,6 +298,164 @@ func IsStringType(dataType schemapb.DataType) bool {
 	}
 }
 
+// IsJSONType returns true if input is a JSON type, otherwise false
+func IsJSONType(dataType schemapb.DataType) bool {
+	return dataType == DataTypeJSON
+}
+
+// IsArrayType returns true if input is an array type, otherwise false
+func IsArrayType(dataType schemapb.DataType) bool {
+	return dataType == DataTypeArray
+}
+
+// GetArrayElementType returns the data type of the elements of an array field
+func GetArrayElementType(field *schemapb.FieldSchema) (schemapb.DataType, error) {
+	if !IsArrayType(field.GetDataType()) {
+		return schemapb.DataType_None, fmt.Errorf("field %s is not an array field", field.GetName())
+	}
+	typeName, err := NewKvPairs(field.GetTypeParams()).Get(common.ElementTypeKey)
+	if err != nil {
+		return schemapb.DataType_None, fmt.Errorf("%s not specified for array field %s", common.ElementTypeKey, field.GetName())
+	}
+	elementType, ok := schemapb.DataType_value[typeName]
+	if !ok {
+		return schemapb.DataType_None, fmt.Errorf("invalid element type %s of array field %s", typeName, field.GetName())
+	}
+	return schemapb.DataType(elementType), nil
+}
+
+// GetArrayMaxCapacity returns the max number of elements in a row of an array field
+func GetArrayMaxCapacity(field *schemapb.FieldSchema) (int64, error) {
+	if !IsArrayType(field.GetDataType()) {
+		return 0, fmt.Errorf("field %s is not an array field", field.GetName())
+	}
+	capacityStr, err := NewKvPairs(field.GetTypeParams()).Get(common.MaxCapacityKey)
+	if err != nil {
+		return 0, fmt.Errorf("%s not specified for array field %s", common.MaxCapacityKey, field.GetName())
+	}
+	capacity, err := strconv.ParseInt(capacityStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max capacity %s of array field %s", capacityStr, field.GetName())
+	}
+	return capacity, nil
+}
+
+// ValidateArrayFieldSchema checks the element type and the max capacity of an array field
+func ValidateArrayFieldSchema(field *schemapb.FieldSchema) error {
+	elementType, err := GetArrayElementType(field)
+	if err != nil {
+		return err
+	}
+	switch elementType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double:
+	case schemapb.DataType_VarChar:
+		if _, err := NewKvPairs(field.GetTypeParams()).Get("max_length"); err != nil {
+			return fmt.Errorf("max_length not specified for the VarChar elements of array field %s", field.GetName())
+		}
+	default:
+		return fmt.Errorf("element type %s of array field %s is not supported", elementType.String(), field.GetName())
+	}
+
+	capacity, err := GetArrayMaxCapacity(field)
+	if err != nil {
+		return err
+	}
+	if capacity <= 0 || capacity > MaxArrayCapacity {
+		return fmt.Errorf("max capacity of array field %s should be in range (0, %d], but got %d", field.GetName(), MaxArrayCapacity, capacity)
+	}
+	return nil
+}
+
+// estimateArrayRowSize returns the size of an array row filled up to the max capacity
+func estimateArrayRowSize(field *schemapb.FieldSchema) (int, error) {
+	elementType, err := GetArrayElementType(field)
+	if err != nil {
+		return 0, err
+	}
+	capacity, err := GetArrayMaxCapacity(field)
+	if err != nil {
+		return 0, err
+	}
+	elementSize := 8
+	switch elementType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8:
+		elementSize = 1
+	case schemapb.DataType_Int16:
+		elementSize = 2
+	case schemapb.DataType_Int32, schemapb.DataType_Float:
+		elementSize = 4
+	case schemapb.DataType_VarChar:
+		maxLength, err := NewKvPairs(field.GetTypeParams()).Get("max_length")
+		if err != nil {
+			return 0, err
+		}
+		if elementSize, err = strconv.Atoi(maxLength); err != nil {
+			return 0, err
+		}
+	}
+	return elementSize * int(capacity), nil
+}
+
+// GetArrayLength returns the number of elements of an array row
+func GetArrayLength(array *schemapb.ScalarField) int {
+	switch data := array.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		return len(data.BoolData.GetData())
+	case *schemapb.ScalarField_IntData:
+		return len(data.IntData.GetData())
+	case *schemapb.ScalarField_LongData:
+		return len(data.LongData.GetData())
+	case *schemapb.ScalarField_FloatData:
+		return len(data.FloatData.GetData())
+	case *schemapb.ScalarField_DoubleData:
+		return len(data.DoubleData.GetData())
+	case *schemapb.ScalarField_StringData:
+		return len(data.StringData.GetData())
+	default:
+		return 0
+	}
+}
+
+// ValidateArray checks that the elements of an array row match the element type of the field,
+// and the number of elements doesn't exceed the max capacity.
+func ValidateArray(field *schemapb.FieldSchema, array *schemapb.ScalarField) error {
+	elementType, err := GetArrayElementType(field)
+	if err != nil {
+		return err
+	}
+	capacity, err := GetArrayMaxCapacity(field)
+	if err != nil {
+		return err
+	}
+
+	var ok bool
+	switch array.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		ok = elementType == schemapb.DataType_Bool
+	case *schemapb.ScalarField_IntData:
+		ok = elementType == schemapb.DataType_Int8 || elementType == schemapb.DataType_Int16 || elementType == schemapb.DataType_Int32
+	case *schemapb.ScalarField_LongData:
+		ok = elementType == schemapb.DataType_Int64
+	case *schemapb.ScalarField_FloatData:
+		ok = elementType == schemapb.DataType_Float
+	case *schemapb.ScalarField_DoubleData:
+		ok = elementType == schemapb.DataType_Double
+	case *schemapb.ScalarField_StringData:
+		ok = elementType == schemapb.DataType_VarChar
+	case nil:
+		// an empty array
+		ok = true
+	}
+	if !ok {
+		return fmt.Errorf("the elements of array field %s should be %s", field.GetName(), elementType.String())
+	}
+	if length := GetArrayLength(array); int64(length) > capacity {
+		return fmt.Errorf("the length %d of array field %s exceeds the max capacity %d", length, field.GetName(), capacity)
+	}
+	return nil
+}
+
 // AppendFieldData appends fields data of specified index from src to dst
 func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx int64) {
 	for i, fieldData := range src {
@@ -348,6 +533,16 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 				} else {
 					dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data[idx])
 				}
+			case *schemapb.ScalarField_BytesData:
+				if dstScalar.GetBytesData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{
+							Data: [][]byte{srcScalar.BytesData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetBytesData().Data = append(dstScalar.GetBytesData().Data, srcScalar.BytesData.Data[idx])
+				}
 			default:
 				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
 			}
@@ -420,6 +615,8 @@ func DeleteFieldData(dst []*schemapb.FieldData) {
 				dstScalar.GetDoubleData().Data = dstScalar.GetDoubleData().Data[:len(dstScalar.GetDoubleData().Data)-1]
 			case *schemapb.ScalarField_StringData:
 				dstScalar.GetStringData().Data = dstScalar.GetStringData().Data[:len(dstScalar.GetStringData().Data)-1]
+			case *schemapb.ScalarField_BytesData:
+				dstScalar.GetBytesData().Data = dstScalar.GetBytesData().Data[:len(dstScalar.GetBytesData().Data)-1]
 			default:
 				log.Error("wrong field type added", zap.String("field type", fieldData.Type.String()))
 			}
@@ -526,6 +723,16 @@ func MergeFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData) {
 				} else {
 					dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data...)
 				}
+			case *schemapb.ScalarField_BytesData:
+				if dstScalar.GetBytesData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{
+							Data: srcScalar.BytesData.Data,
+						},
+					}
+				} else {
+					dstScalar.GetBytesData().Data = append(dstScalar.GetBytesData().Data, srcScalar.BytesData.Data...)
+				}
 			default:
 				log.Error("Not supported field type", zap.String("field type", srcFieldData.Type.String()))
 			}
@@ -584,6 +791,34 @@ func GetPrimaryFieldSchema(schema *schemapb.CollectionSchema) (*schemapb.FieldSc
 	return nil, errors.New("primary field is not found")
 }
 
+// IsPartitionKeyField returns true if the field is marked as the partition key
+func IsPartitionKeyField(field *schemapb.FieldSchema) bool {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == common.PartitionKeyKey {
+			isKey, err := strconv.ParseBool(kv.GetValue())
+			return err == nil && isKey
+		}
+	}
+	return false
+}
+
+// GetPartitionKeyFieldSchema get partition key field schema from collection schema
+func GetPartitionKeyFieldSchema(schema *schemapb.CollectionSchema) (*schemapb.FieldSchema, error) {
+	for _, fieldSchema := range schema.GetFields() {
+		if IsPartitionKeyField(fieldSchema) {
+			return fieldSchema, nil
+		}
+	}
+
+	return nil, errors.New("partition key field is not found")
+}
+
+// HasPartitionKey returns true if the collection schema contains a partition key field
+func HasPartitionKey(schema *schemapb.CollectionSchema) bool {
+	_, err := GetPartitionKeyFieldSchema(schema)
+	return err == nil
+}
+
 // GetPrimaryFieldData get primary field data from all field data inserted from sdk
 func GetPrimaryFieldData(datas []*schemapb.FieldData, primaryFieldSchema *schemapb.FieldSchema) (*schemapb.FieldData, error) {
 	primaryFieldID := primaryFieldSchema.FieldID
@@ -703,6 +938,53 @@ func GetTS(i *internalpb.RetrieveResults, idx int64) uint64 {
 	return 0
 }
 
+// GetFieldDataByID returns the field data of fieldID, nil if it doesn't exist.
+func GetFieldDataByID(datas []*schemapb.FieldData, fieldID int64) *schemapb.FieldData {
+	for _, fieldData := range datas {
+		if fieldData.GetFieldId() == fieldID {
+			return fieldData
+		}
+	}
+	return nil
+}
+
+// GetScalarData returns the idx-th value of a bool, integer, floating point or string field data,
+// nil if the data type isn't supported or idx is out of range.
+func GetScalarData(fieldData *schemapb.FieldData, idx int64) interface{} {
+	scalars := fieldData.GetScalars()
+	switch fieldData.GetType() {
+	case schemapb.DataType_Bool:
+		if data := scalars.GetBoolData().GetData(); idx < int64(len(data)) {
+			return data[idx]
+		}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		if data := scalars.GetIntData().GetData(); idx < int64(len(data)) {
+			return data[idx]
+		}
+	case schemapb.DataType_Int64:
+		if data := scalars.GetLongData().GetData(); idx < int64(len(data)) {
+			return data[idx]
+		}
+	case schemapb.DataType_Float:
+		if data := scalars.GetFloatData().GetData(); idx < int64(len(data)) {
+			return data[idx]
+		}
+	case schemapb.DataType_Double:
+		if data := scalars.GetDoubleData().GetData(); idx < int64(len(data)) {
+			return data[idx]
+		}
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		if data := scalars.GetStringData().GetData(); idx < int64(len(data)) {
+			return data[idx]
+		}
+	case DataTypeJSON, DataTypeArray:
+		if data := scalars.GetBytesData().GetData(); idx < int64(len(data)) {
+			return data[idx]
+		}
+	}
+	return nil
+}
+
 func AppendPKs(pks *schemapb.IDs, pk interface{}) {
 	switch realPK := pk.(type) {
 	case int64:
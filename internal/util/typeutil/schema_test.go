@@ -124,12 +124,19 @@ func TestSchema(t *testing.T) {
 					},
 				},
 			},
+			{
+				FieldID:      109,
+				Name:         "field_json",
+				IsPrimaryKey: false,
+				Description:  "",
+				DataType:     DataTypeJSON,
+			},
 		},
 	}
 
 	t.Run("EstimateSizePerRecord", func(t *testing.T) {
 		size, err := EstimateSizePerRecord(schema)
-		assert.Equal(t, 680, size)
+		assert.Equal(t, 936, size)
 		assert.Nil(t, err)
 	})
 
@@ -195,6 +202,22 @@ func TestSchema(t *testing.T) {
 		assert.False(t, IsFloatingType(schemapb.DataType_String))
 		assert.False(t, IsFloatingType(schemapb.DataType_BinaryVector))
 		assert.False(t, IsFloatingType(schemapb.DataType_FloatVector))
+
+		assert.True(t, IsJSONType(DataTypeJSON))
+		assert.False(t, IsJSONType(schemapb.DataType_VarChar))
+		assert.False(t, IsStringType(DataTypeJSON))
+	})
+
+	t.Run("EstimateEntitySize", func(t *testing.T) {
+		fieldsData := []*schemapb.FieldData{
+			genFieldData("field_int64", 103, schemapb.DataType_Int64, []int64{1}, 1),
+			genFieldData("field_json", 109, DataTypeJSON, [][]byte{[]byte(`{"color":"red"}`)}, 1),
+		}
+		size, err := EstimateEntitySize(fieldsData, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 8+len(`{"color":"red"}`), size)
+		_, err = EstimateEntitySize(fieldsData, 1)
+		assert.Error(t, err)
 	})
 }
 
@@ -421,6 +444,21 @@ func genFieldData(fieldName string, fieldID int64, fieldType schemapb.DataType,
 			},
 			FieldId: fieldID,
 		}
+	case DataTypeJSON:
+		fieldData = &schemapb.FieldData{
+			Type:      DataTypeJSON,
+			FieldName: fieldName,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_BytesData{
+						BytesData: &schemapb.BytesArray{
+							Data: fieldValue.([][]byte),
+						},
+					},
+				},
+			},
+			FieldId: fieldID,
+		}
 	case schemapb.DataType_BinaryVector:
 		fieldData = &schemapb.FieldData{
 			Type:      schemapb.DataType_BinaryVector,
@@ -468,6 +506,7 @@ func TestAppendFieldData(t *testing.T) {
 		DoubleFieldName       = "DoubleField"
 		BinaryVectorFieldName = "BinaryVectorField"
 		FloatVectorFieldName  = "FloatVectorField"
+		JSONFieldName         = "JSONField"
 		BoolFieldID           = common.StartOfUserFieldID + 1
 		Int32FieldID          = common.StartOfUserFieldID + 2
 		Int64FieldID          = common.StartOfUserFieldID + 3
@@ -475,6 +514,7 @@ func TestAppendFieldData(t *testing.T) {
 		DoubleFieldID         = common.StartOfUserFieldID + 5
 		BinaryVectorFieldID   = common.StartOfUserFieldID + 6
 		FloatVectorFieldID    = common.StartOfUserFieldID + 7
+		JSONFieldID           = common.StartOfUserFieldID + 8
 	)
 	BoolArray := []bool{true, false}
 	Int32Array := []int32{1, 2}
@@ -483,8 +523,9 @@ func TestAppendFieldData(t *testing.T) {
 	DoubleArray := []float64{11.0, 22.0}
 	BinaryVector := []byte{0x12, 0x34}
 	FloatVector := []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 11.0, 22.0, 33.0, 44.0, 55.0, 66.0, 77.0, 88.0}
+	JSONArray := [][]byte{[]byte(`{"color":"red"}`), []byte(`{"price":10}`)}
 
-	result := make([]*schemapb.FieldData, 7)
+	result := make([]*schemapb.FieldData, 8)
 	var fieldDataArray1 []*schemapb.FieldData
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(Int32FieldName, Int32FieldID, schemapb.DataType_Int32, Int32Array[0:1], 1))
@@ -493,6 +534,7 @@ func TestAppendFieldData(t *testing.T) {
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[0:Dim/8], Dim))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[0:Dim], Dim))
+	fieldDataArray1 = append(fieldDataArray1, genFieldData(JSONFieldName, JSONFieldID, DataTypeJSON, JSONArray[0:1], 1))
 
 	var fieldDataArray2 []*schemapb.FieldData
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[1:2], 1))
@@ -502,6 +544,7 @@ func TestAppendFieldData(t *testing.T) {
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[1:2], 1))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[Dim/8:2*Dim/8], Dim))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[Dim:2*Dim], Dim))
+	fieldDataArray2 = append(fieldDataArray2, genFieldData(JSONFieldName, JSONFieldID, DataTypeJSON, JSONArray[1:2], 1))
 
 	AppendFieldData(result, fieldDataArray1, 0)
 	AppendFieldData(result, fieldDataArray2, 0)
@@ -513,6 +556,7 @@ func TestAppendFieldData(t *testing.T) {
 	assert.Equal(t, DoubleArray, result[4].GetScalars().GetDoubleData().Data)
 	assert.Equal(t, BinaryVector, result[5].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector)
 	assert.Equal(t, FloatVector, result[6].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, JSONArray, result[7].GetScalars().GetBytesData().Data)
 }
 
 func TestDeleteFieldData(t *testing.T) {
@@ -525,6 +569,7 @@ func TestDeleteFieldData(t *testing.T) {
 		DoubleFieldName       = "DoubleField"
 		BinaryVectorFieldName = "BinaryVectorField"
 		FloatVectorFieldName  = "FloatVectorField"
+		JSONFieldName         = "JSONField"
 		BoolFieldID           = common.StartOfUserFieldID + 1
 		Int32FieldID          = common.StartOfUserFieldID + 2
 		Int64FieldID          = common.StartOfUserFieldID + 3
@@ -532,6 +577,7 @@ func TestDeleteFieldData(t *testing.T) {
 		DoubleFieldID         = common.StartOfUserFieldID + 5
 		BinaryVectorFieldID   = common.StartOfUserFieldID + 6
 		FloatVectorFieldID    = common.StartOfUserFieldID + 7
+		JSONFieldID           = common.StartOfUserFieldID + 8
 	)
 	BoolArray := []bool{true, false}
 	Int32Array := []int32{1, 2}
@@ -540,9 +586,10 @@ func TestDeleteFieldData(t *testing.T) {
 	DoubleArray := []float64{11.0, 22.0}
 	BinaryVector := []byte{0x12, 0x34}
 	FloatVector := []float32{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0, 11.0, 22.0, 33.0, 44.0, 55.0, 66.0, 77.0, 88.0}
+	JSONArray := [][]byte{[]byte(`{"color":"red"}`), []byte(`{"price":10}`)}
 
-	result1 := make([]*schemapb.FieldData, 7)
-	result2 := make([]*schemapb.FieldData, 7)
+	result1 := make([]*schemapb.FieldData, 8)
+	result2 := make([]*schemapb.FieldData, 8)
 	var fieldDataArray1 []*schemapb.FieldData
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(Int32FieldName, Int32FieldID, schemapb.DataType_Int32, Int32Array[0:1], 1))
@@ -551,6 +598,7 @@ func TestDeleteFieldData(t *testing.T) {
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[0:1], 1))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[0:Dim/8], Dim))
 	fieldDataArray1 = append(fieldDataArray1, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[0:Dim], Dim))
+	fieldDataArray1 = append(fieldDataArray1, genFieldData(JSONFieldName, JSONFieldID, DataTypeJSON, JSONArray[0:1], 1))
 
 	var fieldDataArray2 []*schemapb.FieldData
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BoolFieldName, BoolFieldID, schemapb.DataType_Bool, BoolArray[1:2], 1))
@@ -560,6 +608,7 @@ func TestDeleteFieldData(t *testing.T) {
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(DoubleFieldName, DoubleFieldID, schemapb.DataType_Double, DoubleArray[1:2], 1))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[Dim/8:2*Dim/8], Dim))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[Dim:2*Dim], Dim))
+	fieldDataArray2 = append(fieldDataArray2, genFieldData(JSONFieldName, JSONFieldID, DataTypeJSON, JSONArray[1:2], 1))
 
 	AppendFieldData(result1, fieldDataArray1, 0)
 	AppendFieldData(result1, fieldDataArray2, 0)
@@ -571,6 +620,7 @@ func TestDeleteFieldData(t *testing.T) {
 	assert.Equal(t, DoubleArray[0:1], result1[4].GetScalars().GetDoubleData().Data)
 	assert.Equal(t, BinaryVector[0:Dim/8], result1[5].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector)
 	assert.Equal(t, FloatVector[0:Dim], result1[6].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, JSONArray[0:1], result1[7].GetScalars().GetBytesData().Data)
 
 	AppendFieldData(result2, fieldDataArray2, 0)
 	AppendFieldData(result2, fieldDataArray1, 0)
@@ -582,6 +632,7 @@ func TestDeleteFieldData(t *testing.T) {
 	assert.Equal(t, DoubleArray[1:2], result2[4].GetScalars().GetDoubleData().Data)
 	assert.Equal(t, BinaryVector[Dim/8:2*Dim/8], result2[5].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector)
 	assert.Equal(t, FloatVector[Dim:2*Dim], result2[6].GetVectors().GetFloatVector().Data)
+	assert.Equal(t, JSONArray[1:2], result2[7].GetScalars().GetBytesData().Data)
 }
 
 func TestGetPrimaryFieldSchema(t *testing.T) {
@@ -611,6 +662,94 @@ func TestGetPrimaryFieldSchema(t *testing.T) {
 	assert.Equal(t, schemapb.DataType_Int64, primaryField.DataType)
 }
 
+func TestGetPartitionKeyFieldSchema(t *testing.T) {
+	int64Field := &schemapb.FieldSchema{
+		FieldID:      1,
+		Name:         "int64Field",
+		IsPrimaryKey: true,
+		DataType:     schemapb.DataType_Int64,
+	}
+
+	varCharField := &schemapb.FieldSchema{
+		FieldID:  2,
+		Name:     "varCharField",
+		DataType: schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: "max_length", Value: "128"},
+		},
+	}
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{int64Field, varCharField},
+	}
+
+	// no partition key field error
+	_, err := GetPartitionKeyFieldSchema(schema)
+	assert.Error(t, err)
+	assert.False(t, HasPartitionKey(schema))
+
+	varCharField.TypeParams = append(varCharField.TypeParams, &commonpb.KeyValuePair{Key: common.PartitionKeyKey, Value: "false"})
+	assert.False(t, HasPartitionKey(schema))
+
+	varCharField.TypeParams[1].Value = "true"
+	assert.True(t, IsPartitionKeyField(varCharField))
+	assert.False(t, IsPartitionKeyField(int64Field))
+	partitionKeyField, err := GetPartitionKeyFieldSchema(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), partitionKeyField.GetFieldID())
+	assert.True(t, HasPartitionKey(schema))
+}
+
+func TestArrayFieldSchema(t *testing.T) {
+	newArrayField := func(params ...*commonpb.KeyValuePair) *schemapb.FieldSchema {
+		return &schemapb.FieldSchema{FieldID: 100, Name: "tags", DataType: DataTypeArray, TypeParams: params}
+	}
+	elementType := func(name string) *commonpb.KeyValuePair {
+		return &commonpb.KeyValuePair{Key: common.ElementTypeKey, Value: name}
+	}
+	maxCapacity := func(capacity string) *commonpb.KeyValuePair {
+		return &commonpb.KeyValuePair{Key: common.MaxCapacityKey, Value: capacity}
+	}
+
+	field := newArrayField(elementType("Int64"), maxCapacity("4"))
+	assert.True(t, IsArrayType(field.GetDataType()))
+	assert.False(t, IsArrayType(schemapb.DataType_Int64))
+	assert.NoError(t, ValidateArrayFieldSchema(field))
+	dataType, err := GetArrayElementType(field)
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Int64, dataType)
+	capacity, err := GetArrayMaxCapacity(field)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), capacity)
+	size, err := EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}})
+	assert.NoError(t, err)
+	assert.Equal(t, 32, size)
+
+	assert.NoError(t, ValidateArrayFieldSchema(newArrayField(elementType("VarChar"), maxCapacity("4"), &commonpb.KeyValuePair{Key: "max_length", Value: "16"})))
+
+	invalidFields := []*schemapb.FieldSchema{
+		newArrayField(maxCapacity("4")),
+		newArrayField(elementType("Int64")),
+		newArrayField(elementType("Unknown"), maxCapacity("4")),
+		newArrayField(elementType("FloatVector"), maxCapacity("4")),
+		newArrayField(elementType("VarChar"), maxCapacity("4")),
+		newArrayField(elementType("Int64"), maxCapacity("0")),
+		newArrayField(elementType("Int64"), maxCapacity("4097")),
+		newArrayField(elementType("Int64"), maxCapacity("a")),
+		{FieldID: 100, Name: "tags", DataType: schemapb.DataType_Int64},
+	}
+	for _, f := range invalidFields {
+		assert.Error(t, ValidateArrayFieldSchema(f))
+	}
+
+	longArray := &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}}}}
+	assert.Equal(t, 3, GetArrayLength(longArray))
+	assert.NoError(t, ValidateArray(field, longArray))
+	assert.NoError(t, ValidateArray(field, &schemapb.ScalarField{}))
+	assert.Error(t, ValidateArray(field, &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a"}}}}))
+	assert.Error(t, ValidateArray(field, &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3, 4, 5}}}}))
+}
+
 func TestGetPK(t *testing.T) {
 	type args struct {
 		data *schemapb.IDs
@@ -690,6 +829,40 @@ func TestGetTS(t *testing.T) {
 	assert.Equal(t, timeStampFieldData[4], timeStamp)
 }
 
+func TestGetScalarData(t *testing.T) {
+	strFieldData := &schemapb.FieldData{
+		Type:    schemapb.DataType_VarChar,
+		FieldId: 104,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{
+					StringData: &schemapb.StringArray{
+						Data: []string{"a", "b"},
+					},
+				},
+			},
+		},
+	}
+	fieldsData := []*schemapb.FieldData{
+		genFieldData("bool", 101, schemapb.DataType_Bool, []bool{true, false}, 1),
+		genFieldData("int32", 102, schemapb.DataType_Int32, []int32{1, 2}, 1),
+		genFieldData("int64", 103, schemapb.DataType_Int64, []int64{3, 4}, 1),
+		strFieldData,
+		genFieldData("float", 105, schemapb.DataType_Float, []float32{1.0, 2.0}, 1),
+		genFieldData("json", 106, DataTypeJSON, [][]byte{[]byte(`{"a":1}`), []byte(`{"b":2}`)}, 1),
+	}
+
+	assert.Nil(t, GetFieldDataByID(fieldsData, 100))
+	assert.Equal(t, false, GetScalarData(GetFieldDataByID(fieldsData, 101), 1))
+	assert.Equal(t, int32(2), GetScalarData(GetFieldDataByID(fieldsData, 102), 1))
+	assert.Equal(t, int64(4), GetScalarData(GetFieldDataByID(fieldsData, 103), 1))
+	assert.Equal(t, "b", GetScalarData(GetFieldDataByID(fieldsData, 104), 1))
+	assert.Nil(t, GetScalarData(GetFieldDataByID(fieldsData, 104), 2))
+	assert.Equal(t, float32(2), GetScalarData(GetFieldDataByID(fieldsData, 105), 1))
+	assert.Equal(t, []byte(`{"b":2}`), GetScalarData(GetFieldDataByID(fieldsData, 106), 1))
+	assert.Nil(t, GetScalarData(nil, 0))
+}
+
 func TestAppendPKs(t *testing.T) {
 	intPks := &schemapb.IDs{}
 	AppendPKs(intPks, int64(1))
@@ -9,10 +9,25 @@ CREATE DATABASE if not exists milvus_meta CHARACTER SET utf8mb4;
     2. Query index in community version CANNOT includes tenant_id, since tenant_id is not existed and will miss query index.
  */
 
+-- databases
+CREATE TABLE if not exists milvus_meta.databases (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    db_id BIGINT NOT NULL,
+    db_name VARCHAR(256),
+    ts BIGINT UNSIGNED DEFAULT 0,
+    is_deleted BOOL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tenant_id_db_id_ts (tenant_id, db_id, ts)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
 -- collections
 CREATE TABLE if not exists milvus_meta.collections (
     id     BIGINT NOT NULL AUTO_INCREMENT,
     tenant_id VARCHAR(128) DEFAULT NULL,
+    db_id BIGINT NOT NULL DEFAULT 1,
     collection_id BIGINT NOT NULL,
     collection_name VARCHAR(256),
     description VARCHAR(2048) DEFAULT NULL,
@@ -34,6 +49,7 @@ CREATE TABLE if not exists milvus_meta.collections (
 CREATE TABLE if not exists milvus_meta.collection_aliases (
     id     BIGINT NOT NULL AUTO_INCREMENT,
     tenant_id VARCHAR(128) DEFAULT NULL,
+    db_id BIGINT NOT NULL DEFAULT 1,
     collection_id BIGINT NOT NULL,
     collection_alias VARCHAR(128),
     ts BIGINT UNSIGNED DEFAULT 0,
@@ -41,7 +57,7 @@ CREATE TABLE if not exists milvus_meta.collection_aliases (
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
     PRIMARY KEY (id),
-    UNIQUE KEY uk_tenant_id_collection_alias_ts (tenant_id, collection_alias, ts),
+    UNIQUE KEY uk_tenant_id_db_id_collection_alias_ts (tenant_id, db_id, collection_alias, ts),
     INDEX idx_tenant_id_collection_id_ts (tenant_id, collection_id, ts)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
@@ -216,6 +232,21 @@ CREATE TABLE if not exists milvus_meta.credential_users (
     INDEX idx_tenant_id_username (tenant_id, username)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
 
+-- credential api keys
+CREATE TABLE if not exists milvus_meta.credential_api_keys (
+    id     BIGINT NOT NULL AUTO_INCREMENT,
+    tenant_id VARCHAR(128) DEFAULT NULL,
+    key_id VARCHAR(128) NOT NULL,
+    username VARCHAR(128) NOT NULL,
+    description VARCHAR(2048) DEFAULT NULL,
+    hashed_secret VARCHAR(256) NOT NULL,
+    is_deleted BOOL NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP on update current_timestamp,
+    PRIMARY KEY (id),
+    INDEX idx_tenant_id_key_id (tenant_id, key_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
 -- role
 CREATE TABLE if not exists milvus_meta.role (
     id     BIGINT NOT NULL AUTO_INCREMENT,